@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
 
@@ -15,26 +19,66 @@ import (
 type FacadeHandler interface {
 	GetBalance(address string) (*big.Int, error)
 	GetAccount(address string) (*state.Account, error)
+	GetAccountAtNonce(address string, blockNonce uint64) (*state.Account, error)
+	GetAccountWithOptions(address string, options external.AccountQueryOptions) (*state.Account, *external.AccountBlockInfo, error)
+	GetAccountByUserName(userName string) (string, *state.Account, error)
+	GetNFTs(address string) ([]*external.NFTToken, error)
 	IsInterfaceNil() bool
 }
 
 type accountResponse struct {
-	Address  string `json:"address"`
+	Address      string               `json:"address"`
+	Nonce        uint64               `json:"nonce"`
+	Balance      string               `json:"balance"`
+	Code         string               `json:"code"`
+	CodeHash     []byte               `json:"codeHash"`
+	RootHash     []byte               `json:"rootHash"`
+	UserName     string               `json:"userName"`
+	OwnerAddress string               `json:"ownerAddress"`
+	CodeMetadata codeMetadataResponse `json:"codeMetadata"`
+}
+
+type blockInfoResponse struct {
 	Nonce    uint64 `json:"nonce"`
-	Balance  string `json:"balance"`
-	Code     string `json:"code"`
-	CodeHash []byte `json:"codeHash"`
-	RootHash []byte `json:"rootHash"`
+	Hash     string `json:"hash"`
+	RootHash string `json:"rootHash"`
+}
+
+type codeMetadataResponse struct {
+	Upgradeable bool `json:"upgradeable"`
+	Readable    bool `json:"readable"`
+	Payable     bool `json:"payable"`
+}
+
+type nftResponse struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	Nonce           uint64   `json:"nonce"`
+	Type            string   `json:"type"`
+	Balance         string   `json:"balance"`
+	Name            string   `json:"name"`
+	Creator         string   `json:"creator"`
+	Royalties       uint32   `json:"royalties"`
+	Hash            []byte   `json:"hash"`
+	Attributes      []byte   `json:"attributes"`
+	URIs            [][]byte `json:"uris"`
 }
 
 // Routes defines address related routes
 func Routes(router *gin.RouterGroup) {
 	router.GET("/:address", GetAccount)
 	router.GET("/:address/balance", GetBalance)
+	router.GET("/:address/nfts", GetNFTs)
+}
+
+// UserNameRoutes defines the routes which resolve accounts by their registered user names
+func UserNameRoutes(router *gin.RouterGroup) {
+	router.GET("/:username", GetAccountByUserName)
 }
 
 // GetAccount returns an accountResponse containing information
-//  about the account correlated with provided address
+//  about the account correlated with provided address. The optional blockNonce query parameter
+//  returns the account as it was after the block with that nonce, while the optional onFinalBlock
+//  query parameter reads the account on the last final block, if true, or on the last committed block
 func GetAccount(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
 	if !ok {
@@ -43,6 +87,17 @@ func GetAccount(c *gin.Context) {
 	}
 
 	addr := c.Param("address")
+	blockNonceParam, withBlockNonce := c.GetQuery("blockNonce")
+	if withBlockNonce {
+		getAccountAtNonce(c, ef, addr, blockNonceParam)
+		return
+	}
+	onFinalBlockParam, withOnFinalBlock := c.GetQuery("onFinalBlock")
+	if withOnFinalBlock {
+		getAccountWithOptions(c, ef, addr, onFinalBlockParam)
+		return
+	}
+
 	acc, err := ef.GetAccount(addr)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
@@ -51,6 +106,66 @@ func GetAccount(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"account": accountResponseFromBaseAccount(addr, acc)})
 }
 
+func getAccountAtNonce(c *gin.Context, ef FacadeHandler, addr string, blockNonceParam string) {
+	blockNonce, err := strconv.ParseUint(blockNonceParam, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error())})
+		return
+	}
+
+	acc, err := ef.GetAccountAtNonce(addr, blockNonce)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"account": accountResponseFromBaseAccount(addr, acc)})
+}
+
+func getAccountWithOptions(c *gin.Context, ef FacadeHandler, addr string, onFinalBlockParam string) {
+	options, err := parseAccountQueryOptions(onFinalBlockParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	acc, blockInfo, err := ef.GetAccountWithOptions(addr, options)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"account":   accountResponseFromBaseAccount(addr, acc),
+		"blockInfo": blockInfoResponseFromAccountBlockInfo(blockInfo),
+	})
+}
+
+func parseAccountQueryOptions(onFinalBlockParam string) (external.AccountQueryOptions, error) {
+	onFinalBlock, err := strconv.ParseBool(onFinalBlockParam)
+	if err != nil {
+		return external.AccountQueryOptions{}, errors.ErrInvalidQueryOption
+	}
+
+	return external.AccountQueryOptions{OnFinalBlock: onFinalBlock}, nil
+}
+
+// GetAccountByUserName returns an accountResponse containing information
+//  about the account which registered the provided user name
+func GetAccountByUserName(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	userName := c.Param("username")
+	addr, acc, err := ef.GetAccountByUserName(userName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"account": accountResponseFromBaseAccount(addr, acc)})
+}
+
 // GetBalance returns the balance for the address parameter
 func GetBalance(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
@@ -65,6 +180,12 @@ func GetBalance(c *gin.Context) {
 		return
 	}
 
+	onFinalBlockParam, withOnFinalBlock := c.GetQuery("onFinalBlock")
+	if withOnFinalBlock {
+		getBalanceWithOptions(c, ef, addr, onFinalBlockParam)
+		return
+	}
+
 	balance, err := ef.GetBalance(addr)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error())})
@@ -74,13 +195,93 @@ func GetBalance(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"balance": balance})
 }
 
+func getBalanceWithOptions(c *gin.Context, ef FacadeHandler, addr string, onFinalBlockParam string) {
+	options, err := parseAccountQueryOptions(onFinalBlockParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error())})
+		return
+	}
+
+	acc, blockInfo, err := ef.GetAccountWithOptions(addr, options)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"balance":   acc.Balance,
+		"blockInfo": blockInfoResponseFromAccountBlockInfo(blockInfo),
+	})
+}
+
+// GetNFTs returns the non fungible and semi fungible tokens held by the address parameter
+func GetNFTs(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	tokens, err := ef.GetNFTs(addr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetNFTs.Error(), err.Error())})
+		return
+	}
+
+	response := make([]nftResponse, 0, len(tokens))
+	for _, token := range tokens {
+		response = append(response, nftResponseFromToken(token))
+	}
+
+	c.JSON(http.StatusOK, gin.H{"nfts": response})
+}
+
+func nftResponseFromToken(token *external.NFTToken) nftResponse {
+	response := nftResponse{
+		TokenIdentifier: token.TokenIdentifier,
+		Nonce:           token.Nonce,
+		Type:            esdt.Type(token.Token.Type).String(),
+		Balance:         token.Token.Value.String(),
+	}
+
+	metaData := token.Token.TokenMetaData
+	if metaData != nil {
+		response.Name = string(metaData.Name)
+		response.Creator = hex.EncodeToString(metaData.Creator)
+		response.Royalties = metaData.Royalties
+		response.Hash = metaData.Hash
+		response.Attributes = metaData.Attributes
+		response.URIs = metaData.URIs
+	}
+
+	return response
+}
+
 func accountResponseFromBaseAccount(address string, account *state.Account) accountResponse {
+	codeMetadata := core.CodeMetadataFromBytes(account.GetCodeMetadata())
+
 	return accountResponse{
-		Address:  address,
-		Nonce:    account.Nonce,
-		Balance:  account.Balance.String(),
-		Code:     hex.EncodeToString(account.GetCode()),
-		CodeHash: account.CodeHash,
-		RootHash: account.RootHash,
+		Address:      address,
+		Nonce:        account.Nonce,
+		Balance:      account.Balance.String(),
+		Code:         hex.EncodeToString(account.GetCode()),
+		CodeHash:     account.CodeHash,
+		RootHash:     account.RootHash,
+		UserName:     string(account.GetUserName()),
+		OwnerAddress: hex.EncodeToString(account.GetOwnerAddress()),
+		CodeMetadata: codeMetadataResponse{
+			Upgradeable: codeMetadata.Upgradeable,
+			Readable:    codeMetadata.Readable,
+			Payable:     codeMetadata.Payable,
+		},
+	}
+}
+
+func blockInfoResponseFromAccountBlockInfo(blockInfo *external.AccountBlockInfo) blockInfoResponse {
+	return blockInfoResponse{
+		Nonce:    blockInfo.Nonce,
+		Hash:     hex.EncodeToString(blockInfo.Hash),
+		RootHash: hex.EncodeToString(blockInfo.RootHash),
 	}
 }
@@ -1,6 +1,7 @@
 package address_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,7 +16,10 @@ import (
 	errors2 "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -45,15 +49,45 @@ func init() {
 type AccountResponse struct {
 	GeneralResponse
 	Account struct {
-		Address  string `json:"address"`
-		Nonce    uint64 `json:"nonce"`
-		Balance  string `json:"balance"`
-		Code     string `json:"code"`
-		CodeHash []byte `json:"codeHash"`
-		RootHash []byte `json:"rootHash"`
+		Address      string `json:"address"`
+		Nonce        uint64 `json:"nonce"`
+		Balance      string `json:"balance"`
+		Code         string `json:"code"`
+		CodeHash     []byte `json:"codeHash"`
+		RootHash     []byte `json:"rootHash"`
+		UserName     string `json:"userName"`
+		OwnerAddress string `json:"ownerAddress"`
+		CodeMetadata struct {
+			Upgradeable bool `json:"upgradeable"`
+			Readable    bool `json:"readable"`
+			Payable     bool `json:"payable"`
+		} `json:"codeMetadata"`
 	} `json:"account"`
 }
 
+type AccountWithBlockInfoResponse struct {
+	AccountResponse
+	BlockInfo struct {
+		Nonce    uint64 `json:"nonce"`
+		Hash     string `json:"hash"`
+		RootHash string `json:"rootHash"`
+	} `json:"blockInfo"`
+}
+
+type NFTsResponse struct {
+	GeneralResponse
+	NFTs []struct {
+		TokenIdentifier string   `json:"tokenIdentifier"`
+		Nonce           uint64   `json:"nonce"`
+		Type            string   `json:"type"`
+		Balance         string   `json:"balance"`
+		Name            string   `json:"name"`
+		Creator         string   `json:"creator"`
+		Royalties       uint32   `json:"royalties"`
+		URIs            [][]byte `json:"uris"`
+	} `json:"nfts"`
+}
+
 func TestAddressRoute_EmptyTrailReturns404(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{}
@@ -233,6 +267,327 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccount_WithInvalidBlockNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAccountAtNonceHandler: func(address string, blockNonce uint64) (*state.Account, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockNonce=invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(accountResponse.Error, errors2.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetAccount_WithBlockNonceFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnedError := "historical state queries are disabled"
+	facade := mock.Facade{
+		GetAccountAtNonceHandler: func(address string, blockNonce uint64) (*state.Account, error) {
+			return nil, errors.New(returnedError)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockNonce=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(accountResponse.Error, fmt.Sprintf("%s: %s", errors2.ErrCouldNotGetAccount.Error(), returnedError)))
+}
+
+func TestGetAccount_WithBlockNonceReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAccountHandler: func(address string) (*state.Account, error) {
+			assert.Fail(t, "should have queried the account at the block nonce")
+			return nil, nil
+		},
+		GetAccountAtNonceHandler: func(address string, blockNonce uint64) (*state.Account, error) {
+			assert.Equal(t, uint64(5), blockNonce)
+			return &state.Account{
+				Nonce:   2,
+				Balance: big.NewInt(40),
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	reqAddress := "test"
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s?blockNonce=5", reqAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, reqAddress, accountResponse.Account.Address)
+	assert.Equal(t, uint64(2), accountResponse.Account.Nonce)
+	assert.Equal(t, "40", accountResponse.Account.Balance)
+}
+
+func TestGetAccount_WithInvalidOnFinalBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAccountWithOptionsHandler: func(address string, options external.AccountQueryOptions) (*state.Account, *external.AccountBlockInfo, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?onFinalBlock=invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(accountResponse.Error, errors2.ErrInvalidQueryOption.Error()))
+}
+
+func TestGetAccount_WithOnFinalBlockFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnedError := "missing header"
+	facade := mock.Facade{
+		GetAccountWithOptionsHandler: func(address string, options external.AccountQueryOptions) (*state.Account, *external.AccountBlockInfo, error) {
+			return nil, nil, errors.New(returnedError)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?onFinalBlock=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(accountResponse.Error, fmt.Sprintf("%s: %s", errors2.ErrCouldNotGetAccount.Error(), returnedError)))
+}
+
+func TestGetAccount_WithOnFinalBlockReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAccountHandler: func(address string) (*state.Account, error) {
+			assert.Fail(t, "should have queried the account with options")
+			return nil, nil
+		},
+		GetAccountWithOptionsHandler: func(address string, options external.AccountQueryOptions) (*state.Account, *external.AccountBlockInfo, error) {
+			assert.True(t, options.OnFinalBlock)
+			account := &state.Account{
+				Nonce:   2,
+				Balance: big.NewInt(40),
+			}
+			blockInfo := &external.AccountBlockInfo{
+				Nonce:    8,
+				Hash:     []byte("hash"),
+				RootHash: []byte("root hash"),
+			}
+			return account, blockInfo, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	reqAddress := "test"
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s?onFinalBlock=true", reqAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountWithBlockInfoResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, reqAddress, accountResponse.Account.Address)
+	assert.Equal(t, "40", accountResponse.Account.Balance)
+	assert.Equal(t, uint64(8), accountResponse.BlockInfo.Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), accountResponse.BlockInfo.Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("root hash")), accountResponse.BlockInfo.RootHash)
+}
+
+func TestGetBalance_WithOnFinalBlockReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		BalanceHandler: func(s string) (*big.Int, error) {
+			assert.Fail(t, "should have queried the account with options")
+			return nil, nil
+		},
+		GetAccountWithOptionsHandler: func(address string, options external.AccountQueryOptions) (*state.Account, *external.AccountBlockInfo, error) {
+			assert.False(t, options.OnFinalBlock)
+			return &state.Account{Balance: big.NewInt(45)}, &external.AccountBlockInfo{Nonce: 10}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/balance?onFinalBlock=false", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := NewAddressResponse()
+	loadResponse(resp.Body, response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, big.NewInt(45), response.Balance)
+}
+
+func TestGetAccount_ShouldReturnOwnerAndCodeMetadata(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	metadata := core.CodeMetadata{Upgradeable: true, Payable: true}
+	facade := mock.Facade{
+		GetAccountHandler: func(address string) (*state.Account, error) {
+			return &state.Account{
+				Balance:      big.NewInt(0),
+				OwnerAddress: owner,
+				CodeMetadata: metadata.ToBytes(),
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString(owner), accountResponse.Account.OwnerAddress)
+	assert.True(t, accountResponse.Account.CodeMetadata.Upgradeable)
+	assert.False(t, accountResponse.Account.CodeMetadata.Readable)
+	assert.True(t, accountResponse.Account.CodeMetadata.Payable)
+}
+
+func TestGetAccountByUserName_FailWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+	returnedError := "i am an error"
+	facade := mock.Facade{
+		GetAccountByUserNameHandler: func(userName string) (string, *state.Account, error) {
+			return "", nil, errors.New(returnedError)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/username/alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Empty(t, accountResponse.Account)
+	assert.True(t, strings.Contains(accountResponse.Error, fmt.Sprintf("%s: %s", errors2.ErrCouldNotGetAccount.Error(), returnedError)))
+}
+
+func TestGetAccountByUserName_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+	ownerAddress := "owner"
+	facade := mock.Facade{
+		GetAccountByUserNameHandler: func(userName string) (string, *state.Account, error) {
+			return ownerAddress, &state.Account{
+				Nonce:    1,
+				Balance:  big.NewInt(100),
+				UserName: []byte(userName),
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/username/alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, ownerAddress, accountResponse.Account.Address)
+	assert.Equal(t, "alice", accountResponse.Account.UserName)
+	assert.Equal(t, "100", accountResponse.Account.Balance)
+	assert.Empty(t, accountResponse.Error)
+}
+
+func TestGetNFTs_FailWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+	returnedError := "i am an error"
+	facade := mock.Facade{
+		GetNFTsHandler: func(address string) ([]*external.NFTToken, error) {
+			return nil, errors.New(returnedError)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/nfts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	nftsResponse := NFTsResponse{}
+	loadResponse(resp.Body, &nftsResponse)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Empty(t, nftsResponse.NFTs)
+	assert.True(t, strings.Contains(nftsResponse.Error, fmt.Sprintf("%s: %s", errors2.ErrGetNFTs.Error(), returnedError)))
+}
+
+func TestGetNFTs_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetNFTsHandler: func(address string) ([]*external.NFTToken, error) {
+			return []*external.NFTToken{
+				{
+					TokenIdentifier: "TOKEN",
+					Nonce:           2,
+					Token: &esdt.ESDigitalToken{
+						Type:  uint32(esdt.SemiFungible),
+						Value: big.NewInt(10),
+						TokenMetaData: &esdt.MetaData{
+							Nonce:     2,
+							Name:      []byte("name"),
+							Creator:   []byte("creator"),
+							Royalties: 500,
+							URIs:      [][]byte{[]byte("uri")},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/nfts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	nftsResponse := NFTsResponse{}
+	loadResponse(resp.Body, &nftsResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, nftsResponse.Error)
+	assert.Equal(t, 1, len(nftsResponse.NFTs))
+	assert.Equal(t, "TOKEN", nftsResponse.NFTs[0].TokenIdentifier)
+	assert.Equal(t, uint64(2), nftsResponse.NFTs[0].Nonce)
+	assert.Equal(t, esdt.SemiFungible.String(), nftsResponse.NFTs[0].Type)
+	assert.Equal(t, "10", nftsResponse.NFTs[0].Balance)
+	assert.Equal(t, "name", nftsResponse.NFTs[0].Name)
+	assert.Equal(t, hex.EncodeToString([]byte("creator")), nftsResponse.NFTs[0].Creator)
+	assert.Equal(t, uint32(500), nftsResponse.NFTs[0].Royalties)
+	assert.Equal(t, [][]byte{[]byte("uri")}, nftsResponse.NFTs[0].URIs)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -253,6 +608,11 @@ func startNodeServer(handler address.FacadeHandler) *gin.Engine {
 		addressRoutes.Use(middleware.WithElrondFacade(handler))
 	}
 	address.Routes(addressRoutes)
+	userNameRoutes := ws.Group("/username")
+	if handler != nil {
+		userNameRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	address.UserNameRoutes(userNameRoutes)
 	return ws
 }
 
@@ -7,9 +7,15 @@ import (
 	"reflect"
 
 	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/bridge"
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
+	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
+	apiValidator "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/pprof"
@@ -43,6 +49,17 @@ type MainApiHandler interface {
 
 // Start will boot up the api and appropriate routes, handlers and validators
 func Start(elrondFacade MainApiHandler) error {
+	server, err := CreateServer(elrondFacade)
+	if err != nil {
+		return err
+	}
+
+	return server.ListenAndServe()
+}
+
+// CreateServer creates the http server holding the api routes, handlers and validators, without starting it. The
+// returned server can be stopped gracefully by calling its Shutdown method
+func CreateServer(elrondFacade MainApiHandler) (*http.Server, error) {
 	var ws *gin.Engine
 	if elrondFacade.RestAPIServerDebugMode() {
 		ws = gin.Default()
@@ -55,7 +72,7 @@ func Start(elrondFacade MainApiHandler) error {
 
 	err := registerValidators()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	registerRoutes(ws, elrondFacade)
@@ -63,11 +80,14 @@ func Start(elrondFacade MainApiHandler) error {
 	if elrondFacade.PrometheusMonitoring() {
 		err = joinMonitoringSystem(elrondFacade)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return ws.Run(fmt.Sprintf(":%s", elrondFacade.RestApiPort()))
+	return &http.Server{
+		Addr:    fmt.Sprintf(":%s", elrondFacade.RestApiPort()),
+		Handler: ws,
+	}, nil
 }
 
 func joinMonitoringSystem(elrondFacade MainApiHandler) error {
@@ -106,6 +126,10 @@ func registerRoutes(ws *gin.Engine, elrondFacade middleware.ElrondHandler) {
 	addressRoutes.Use(middleware.WithElrondFacade(elrondFacade))
 	address.Routes(addressRoutes)
 
+	userNameRoutes := ws.Group("/username")
+	userNameRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	address.UserNameRoutes(userNameRoutes)
+
 	txRoutes := ws.Group("/transaction")
 	txRoutes.Use(middleware.WithElrondFacade(elrondFacade))
 	transaction.Routes(txRoutes)
@@ -114,6 +138,29 @@ func registerRoutes(ws *gin.Engine, elrondFacade middleware.ElrondHandler) {
 	vmValuesRoutes.Use(middleware.WithElrondFacade(elrondFacade))
 	vmValues.Routes(vmValuesRoutes)
 
+	networkRoutes := ws.Group("/network")
+	networkRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	network.Routes(networkRoutes)
+
+	hyperBlockRoutes := ws.Group("/hyperblock")
+	hyperBlockRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	hyperblock.Routes(hyperBlockRoutes)
+
+	bridgeRoutes := ws.Group("/bridge")
+	bridgeRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	bridge.Routes(bridgeRoutes)
+
+	delegationRoutes := ws.Group("/delegation")
+	delegationRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	delegation.Routes(delegationRoutes)
+
+	validatorRoutes := ws.Group("/validator")
+	validatorRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	apiValidator.Routes(validatorRoutes)
+
+	logsRoutes := ws.Group("/log")
+	logs.Routes(logsRoutes)
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PrometheusMonitoring() {
 		nodeRoutes.GET("/metrics", gin.WrapH(promhttp.Handler()))
@@ -0,0 +1,39 @@
+package bridge
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetOutgoingOperationsBatches() ([]*bridge.SignedOutgoingOperationsBatch, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines the bridge related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("/outgoing-operations", GetOutgoingOperations)
+}
+
+// GetOutgoingOperations returns the outgoing operations batches collected by the node together with the validators
+// signatures, so that a relayer can carry the confirmed ones to the other chain
+func GetOutgoingOperations(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	batches, err := ef.GetOutgoingOperationsBatches()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetOutgoingOperations.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"batches": batches})
+}
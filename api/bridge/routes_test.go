@@ -0,0 +1,114 @@
+package bridge_test
+
+import (
+	"encoding/json"
+	errs "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/bridge"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	dataBridge "github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type outgoingOperationsResponse struct {
+	Batches []*dataBridge.SignedOutgoingOperationsBatch `json:"batches"`
+	Error   string                                      `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestGetOutgoingOperations_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startBridgeServer(mock.WrongFacade{})
+	req, _ := http.NewRequest("GET", "/bridge/outgoing-operations", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := outgoingOperationsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestGetOutgoingOperations_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetOutgoingOperationsBatchesHandler: func() ([]*dataBridge.SignedOutgoingOperationsBatch, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startBridgeServer(&facade)
+	req, _ := http.NewRequest("GET", "/bridge/outgoing-operations", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := outgoingOperationsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrGetOutgoingOperations.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestGetOutgoingOperations_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBatches := []*dataBridge.SignedOutgoingOperationsBatch{
+		{
+			Hash: []byte("batch hash"),
+			Batch: &dataBridge.OutgoingOperationsBatch{
+				ShardID:    1,
+				BlockNonce: 37,
+				BlockHash:  []byte("block hash"),
+				Operations: []*dataBridge.OutgoingOperation{
+					{TxHash: []byte("tx hash"), Data: []byte("burn@01")},
+				},
+			},
+			Signatures: []*dataBridge.OutgoingOperationsSignature{
+				{BatchHash: []byte("batch hash"), PubKey: []byte("pk"), Signature: []byte("sig")},
+			},
+			Confirmed: true,
+		},
+	}
+	facade := mock.Facade{
+		GetOutgoingOperationsBatchesHandler: func() ([]*dataBridge.SignedOutgoingOperationsBatch, error) {
+			return expectedBatches, nil
+		},
+	}
+
+	ws := startBridgeServer(&facade)
+	req, _ := http.NewRequest("GET", "/bridge/outgoing-operations", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := outgoingOperationsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedBatches, response.Batches)
+}
+
+func startBridgeServer(facade interface{}) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", facade)
+	})
+
+	bridgeRoutes := ws.Group("/bridge")
+	bridge.Routes(bridgeRoutes)
+	return ws
+}
@@ -0,0 +1,125 @@
+package delegation
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetDelegators(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error)
+	GetClaimableRewards(contractAddress string, delegatorAddress string) (*big.Int, error)
+	GetDelegationContractConfig(contractAddress string) (*external.DelegationContractConfig, error)
+	IsInterfaceNil() bool
+}
+
+type delegatorResponse struct {
+	Address string `json:"address"`
+	Stake   string `json:"stake"`
+}
+
+type delegatorsResponse struct {
+	NumDelegators uint64               `json:"numDelegators"`
+	Delegators    []*delegatorResponse `json:"delegators"`
+	NextIndex     uint64               `json:"nextIndex"`
+}
+
+type contractConfigResponse struct {
+	Owner            string `json:"owner"`
+	MaxCap           string `json:"maxCap"`
+	ServiceFee       uint64 `json:"serviceFee"`
+	TotalActiveStake string `json:"totalActiveStake"`
+}
+
+// Routes defines the delegation contracts related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("/:contract/delegators", GetDelegators)
+	router.GET("/:contract/claimable/:address", GetClaimableRewards)
+	router.GET("/:contract/config", GetContractConfig)
+}
+
+// GetDelegators returns a page of the delegators of a delegation contract, in the order they first delegated. The
+// next page is requested starting with the next index returned with the current one
+func GetDelegators(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	startIndex, err := strconv.ParseUint(c.DefaultQuery("start", "0"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidStartIndex.Error())})
+		return
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "0"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidPageSize.Error())})
+		return
+	}
+
+	page, err := ef.GetDelegators(c.Param("contract"), startIndex, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetDelegators.Error(), err.Error())})
+		return
+	}
+
+	response := delegatorsResponse{
+		NumDelegators: page.NumDelegators,
+		Delegators:    make([]*delegatorResponse, 0, len(page.Delegators)),
+		NextIndex:     page.NextIndex,
+	}
+	for _, delegator := range page.Delegators {
+		response.Delegators = append(response.Delegators, &delegatorResponse{
+			Address: delegator.Address,
+			Stake:   delegator.Stake,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetClaimableRewards returns the rewards a delegator can claim from a delegation contract
+func GetClaimableRewards(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	rewards, err := ef.GetClaimableRewards(c.Param("contract"), c.Param("address"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetClaimableRewards.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"claimableRewards": rewards.String()})
+}
+
+// GetContractConfig returns the owner, the max cap, the service fee and the total active stake of a delegation contract
+func GetContractConfig(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	contractConfig, err := ef.GetDelegationContractConfig(c.Param("contract"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetDelegationContractConfig.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"config": contractConfigResponse{
+		Owner:            contractConfig.Owner,
+		MaxCap:           contractConfig.MaxCap,
+		ServiceFee:       contractConfig.ServiceFee,
+		TotalActiveStake: contractConfig.TotalActiveStake,
+	}})
+}
@@ -0,0 +1,255 @@
+package delegation_test
+
+import (
+	"encoding/json"
+	errs "errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type delegatorResponse struct {
+	Address string `json:"address"`
+	Stake   string `json:"stake"`
+}
+
+type delegatorsResponse struct {
+	NumDelegators uint64               `json:"numDelegators"`
+	Delegators    []*delegatorResponse `json:"delegators"`
+	NextIndex     uint64               `json:"nextIndex"`
+	Error         string               `json:"error"`
+}
+
+type claimableRewardsResponse struct {
+	ClaimableRewards string `json:"claimableRewards"`
+	Error            string `json:"error"`
+}
+
+type contractConfigResponse struct {
+	Config struct {
+		Owner            string `json:"owner"`
+		MaxCap           string `json:"maxCap"`
+		ServiceFee       uint64 `json:"serviceFee"`
+		TotalActiveStake string `json:"totalActiveStake"`
+	} `json:"config"`
+	Error string `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func startDelegationServer(facade interface{}) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", facade)
+	})
+
+	delegationRoutes := ws.Group("/delegation")
+	delegation.Routes(delegationRoutes)
+	return ws
+}
+
+//------- GetDelegators
+
+func TestGetDelegators_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startDelegationServer(mock.WrongFacade{})
+	req, _ := http.NewRequest("GET", "/delegation/contract/delegators", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := delegatorsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestGetDelegators_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startDelegationServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/delegation/contract/delegators?start=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := delegatorsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrInvalidStartIndex.Error())
+
+	req, _ = http.NewRequest("GET", "/delegation/contract/delegators?pageSize=abc", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response = delegatorsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrInvalidPageSize.Error())
+}
+
+func TestGetDelegators_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetDelegatorsHandler: func(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startDelegationServer(&facade)
+	req, _ := http.NewRequest("GET", "/delegation/contract/delegators", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := delegatorsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrGetDelegators.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestGetDelegators_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetDelegatorsHandler: func(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error) {
+			assert.Equal(t, "contract", contractAddress)
+			assert.Equal(t, uint64(2), startIndex)
+			assert.Equal(t, 5, pageSize)
+			return &external.DelegatorsPage{
+				NumDelegators: 3,
+				Delegators:    []*external.DelegatorInfo{{Address: "aa", Stake: "30"}},
+				NextIndex:     3,
+			}, nil
+		},
+	}
+
+	ws := startDelegationServer(&facade)
+	req, _ := http.NewRequest("GET", "/delegation/contract/delegators?start=2&pageSize=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := delegatorsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(3), response.NumDelegators)
+	assert.Equal(t, []*delegatorResponse{{Address: "aa", Stake: "30"}}, response.Delegators)
+	assert.Equal(t, uint64(3), response.NextIndex)
+}
+
+//------- GetClaimableRewards
+
+func TestGetClaimableRewards_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetClaimableRewardsHandler: func(contractAddress string, delegatorAddress string) (*big.Int, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startDelegationServer(&facade)
+	req, _ := http.NewRequest("GET", "/delegation/contract/claimable/delegator", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := claimableRewardsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrGetClaimableRewards.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestGetClaimableRewards_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetClaimableRewardsHandler: func(contractAddress string, delegatorAddress string) (*big.Int, error) {
+			assert.Equal(t, "contract", contractAddress)
+			assert.Equal(t, "delegator", delegatorAddress)
+			return big.NewInt(675), nil
+		},
+	}
+
+	ws := startDelegationServer(&facade)
+	req, _ := http.NewRequest("GET", "/delegation/contract/claimable/delegator", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := claimableRewardsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "675", response.ClaimableRewards)
+}
+
+//------- GetContractConfig
+
+func TestGetContractConfig_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetDelegationContractConfigHandler: func(contractAddress string) (*external.DelegationContractConfig, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startDelegationServer(&facade)
+	req, _ := http.NewRequest("GET", "/delegation/contract/config", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := contractConfigResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrGetDelegationContractConfig.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestGetContractConfig_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetDelegationContractConfigHandler: func(contractAddress string) (*external.DelegationContractConfig, error) {
+			return &external.DelegationContractConfig{
+				Owner:            "owner",
+				MaxCap:           "1000",
+				ServiceFee:       500,
+				TotalActiveStake: "400",
+			}, nil
+		},
+	}
+
+	ws := startDelegationServer(&facade)
+	req, _ := http.NewRequest("GET", "/delegation/contract/config", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := contractConfigResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "owner", response.Config.Owner)
+	assert.Equal(t, "1000", response.Config.MaxCap)
+	assert.Equal(t, uint64(500), response.Config.ServiceFee)
+	assert.Equal(t, "400", response.Config.TotalActiveStake)
+}
@@ -51,3 +51,60 @@ var ErrGetTransaction = errors.New("transaction getting failed")
 
 // ErrTxNotFound signals an error happened trying to fetch a transaction
 var ErrTxNotFound = errors.New("transaction was not found")
+
+// ErrTxSimulationFailed signals an error happened while simulating a transaction
+var ErrTxSimulationFailed = errors.New("transaction simulation failed")
+
+// ErrGetNFTs signals an error in getting the non fungible tokens held by an account
+var ErrGetNFTs = errors.New("get non fungible tokens error")
+
+// ErrInvalidBlockNonce signals that the provided block nonce is not a valid number
+var ErrInvalidBlockNonce = errors.New("invalid block nonce")
+
+// ErrGetHyperBlock signals an error in getting a hyperblock
+var ErrGetHyperBlock = errors.New("get hyperblock error")
+
+// ErrInvalidEpoch signals that an invalid epoch parameter has been provided
+var ErrInvalidEpoch = errors.New("invalid epoch")
+
+// ErrGetEpochStatistics signals an error happening when trying to fetch the statistics of an epoch
+var ErrGetEpochStatistics = errors.New("get epoch statistics error")
+
+// ErrGetTransactionsPool signals an error happening when trying to fetch the content of the transactions pool
+var ErrGetTransactionsPool = errors.New("get transactions pool error")
+
+// ErrGetOutgoingOperations signals an error happening when trying to fetch the outgoing operations batches
+var ErrGetOutgoingOperations = errors.New("get outgoing operations error")
+
+// ErrGetValidatorsSelectionAudit signals an error happening when trying to fetch the validators selection audit
+var ErrGetValidatorsSelectionAudit = errors.New("get validators selection audit error")
+
+// ErrInvalidQueryOption signals that an invalid accounts query option has been provided
+var ErrInvalidQueryOption = errors.New("invalid query option")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrExportAccounts signals an error in exporting the accounts
+var ErrExportAccounts = errors.New("export accounts error")
+
+// ErrGetEpochSupply signals an error happening when trying to fetch the supply counters of an epoch
+var ErrGetEpochSupply = errors.New("get epoch supply error")
+
+// ErrGetESDTSupply signals an error happening when trying to fetch the supply of a token
+var ErrGetESDTSupply = errors.New("get token supply error")
+
+// ErrInvalidStartIndex signals that an invalid start index has been provided
+var ErrInvalidStartIndex = errors.New("invalid start index")
+
+// ErrGetDelegators signals an error happening when trying to fetch the delegators of a delegation contract
+var ErrGetDelegators = errors.New("get delegators error")
+
+// ErrGetClaimableRewards signals an error happening when trying to fetch the claimable rewards of a delegator
+var ErrGetClaimableRewards = errors.New("get claimable rewards error")
+
+// ErrGetDelegationContractConfig signals an error happening when trying to fetch the config of a delegation contract
+var ErrGetDelegationContractConfig = errors.New("get delegation contract config error")
+
+// ErrGetAuctionList signals an error happening when trying to build the auction list
+var ErrGetAuctionList = errors.New("get auction list error")
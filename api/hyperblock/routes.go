@@ -0,0 +1,45 @@
+package hyperblock
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetHyperBlockByNonce(nonce uint64) (*external.HyperBlock, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines hyperblock related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("/by-nonce/:nonce", GetHyperBlockByNonce)
+}
+
+// GetHyperBlockByNonce returns the metachain block with the provided nonce together with the shard blocks it notarized
+func GetHyperBlockByNonce(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidBlockNonce.Error()})
+		return
+	}
+
+	hyperBlock, err := ef.GetHyperBlockByNonce(nonce)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetHyperBlock.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"hyperblock": hyperBlock})
+}
@@ -0,0 +1,127 @@
+package hyperblock_test
+
+import (
+	"encoding/json"
+	errs "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type hyperBlockResponse struct {
+	HyperBlock external.HyperBlock `json:"hyperblock"`
+	Error      string              `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestGetHyperBlockByNonce_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startHyperBlockServer(mock.WrongFacade{})
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperBlockResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestGetHyperBlockByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startHyperBlockServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperBlockResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, errors.ErrInvalidBlockNonce.Error(), response.Error)
+}
+
+func TestGetHyperBlockByNonce_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetHyperBlockByNonceHandler: func(nonce uint64) (*external.HyperBlock, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startHyperBlockServer(&facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperBlockResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrGetHyperBlock.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestGetHyperBlockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHyperBlock := &external.HyperBlock{
+		Nonce:   37,
+		Round:   39,
+		Hash:    "aabb",
+		TxCount: 2,
+		ShardBlocks: []*external.NotarizedShardBlock{
+			{
+				Hash:    "ccdd",
+				ShardID: 1,
+				Nonce:   22,
+				TxCount: 2,
+			},
+		},
+	}
+	facade := mock.Facade{
+		GetHyperBlockByNonceHandler: func(nonce uint64) (*external.HyperBlock, error) {
+			assert.Equal(t, expectedHyperBlock.Nonce, nonce)
+			return expectedHyperBlock, nil
+		},
+	}
+
+	ws := startHyperBlockServer(&facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperBlockResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *expectedHyperBlock, response.HyperBlock)
+}
+
+func startHyperBlockServer(facade interface{}) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", facade)
+	})
+
+	hyperBlockRoutes := ws.Group("/hyperblock")
+	hyperblock.Routes(hyperBlockRoutes)
+	return ws
+}
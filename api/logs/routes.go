@@ -0,0 +1,128 @@
+package logs
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+var log = logger.GetOrCreate("api/logs")
+
+const (
+	writeTimeout        = 10 * time.Second
+	readPatternsTimeout = 10 * time.Second
+	maxBufferedLines    = 10000
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// Routes defines logs related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("", GetLogs)
+}
+
+// GetLogs upgrades the connection to a websocket and streams the log lines to the connected client.
+// The first message sent by the client should contain the level patterns used to filter the log lines,
+// in the same format as the one accepted by the logLevel node flag (e.g. *:INFO,process/*:DEBUG)
+func GetLogs(c *gin.Context) {
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Warn("could not upgrade http connection to websocket: " + err.Error())
+		return
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(readPatternsTimeout))
+	_, patterns, err := conn.ReadMessage()
+	if err != nil {
+		log.Warn("could not read the level patterns from websocket: " + err.Error())
+		_ = conn.Close()
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	wsw := newWsLogWriter(conn)
+	err = logger.AddLogObserver(wsw, string(patterns))
+	if err != nil {
+		_ = conn.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseUnsupportedData, err.Error()),
+			time.Now().Add(writeTimeout),
+		)
+		_ = conn.Close()
+		return
+	}
+
+	go wsw.startSendingLines()
+	wsw.waitClientClose()
+
+	err = logger.RemoveLogObserver(wsw)
+	if err != nil {
+		log.Warn("could not remove the websocket log observer: " + err.Error())
+	}
+	wsw.close()
+}
+
+// wsLogWriter is an io.Writer that buffers the log lines and sends them asynchronously on a websocket,
+// so a slow client will not slow down the logging. Lines are dropped if the buffer is full
+type wsLogWriter struct {
+	conn   *websocket.Conn
+	lines  chan []byte
+	closed chan struct{}
+}
+
+func newWsLogWriter(conn *websocket.Conn) *wsLogWriter {
+	return &wsLogWriter{
+		conn:   conn,
+		lines:  make(chan []byte, maxBufferedLines),
+		closed: make(chan struct{}),
+	}
+}
+
+// Write buffers the provided line in order to be sent to the client
+func (wsw *wsLogWriter) Write(p []byte) (int, error) {
+	line := make([]byte, len(p))
+	copy(line, p)
+
+	select {
+	case wsw.lines <- line:
+	default:
+	}
+
+	return len(p), nil
+}
+
+func (wsw *wsLogWriter) startSendingLines() {
+	for {
+		select {
+		case <-wsw.closed:
+			return
+		case line := <-wsw.lines:
+			_ = wsw.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			err := wsw.conn.WriteMessage(websocket.TextMessage, line)
+			if err != nil {
+				_ = wsw.conn.Close()
+				return
+			}
+		}
+	}
+}
+
+// waitClientClose blocks until the client closes the connection or the connection breaks
+func (wsw *wsLogWriter) waitClientClose() {
+	for {
+		_, _, err := wsw.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (wsw *wsLogWriter) close() {
+	close(wsw.closed)
+	_ = wsw.conn.Close()
+}
@@ -0,0 +1,89 @@
+package logs_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/logs"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func startWsServer() *httptest.Server {
+	ws := gin.New()
+	logsRoutes := ws.Group("/log")
+	logs.Routes(logsRoutes)
+
+	return httptest.NewServer(ws)
+}
+
+func TestGetLogs_ShouldStreamMatchingLines(t *testing.T) {
+	server := startWsServer()
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/log"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Nil(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err = conn.WriteMessage(websocket.TextMessage, []byte("*:WARNING"))
+	assert.Nil(t, err)
+
+	testLog := logger.GetOrCreate("api/logs/test")
+	received := make(chan string)
+	go func() {
+		for {
+			_, line, errRead := conn.ReadMessage()
+			if errRead != nil {
+				return
+			}
+			received <- string(line)
+		}
+	}()
+
+	// the observer is registered asynchronously, after the patterns were read by the server
+	timeout := time.After(time.Second * 5)
+	for {
+		testLog.Info("info line")
+		testLog.Warn("warn line")
+
+		select {
+		case line := <-received:
+			assert.True(t, strings.Contains(line, "warn line"))
+			return
+		case <-time.After(time.Millisecond * 100):
+		case <-timeout:
+			assert.Fail(t, "timeout while waiting log lines")
+			return
+		}
+	}
+}
+
+func TestGetLogs_InvalidPatternShouldCloseConnection(t *testing.T) {
+	server := startWsServer()
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/log"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Nil(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err = conn.WriteMessage(websocket.TextMessage, []byte("*:NOT_A_LEVEL"))
+	assert.Nil(t, err)
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseUnsupportedData))
+}
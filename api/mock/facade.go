@@ -5,10 +5,16 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 )
 
 // Facade is the mock implementation of a node router handler
@@ -19,17 +25,41 @@ type Facade struct {
 	GetCurrentPublicKeyHandler                     func() string
 	TpsBenchmarkHandler                            func() *statistics.TpsBenchmark
 	GetHeartbeatsHandler                           func() ([]heartbeat.PubKeyHeartbeat, error)
+	GetPendingMiniBlocksHandler                    func() ([]*block.PendingMiniBlockInfo, error)
+	GetNetworkConfigHandler                        func() (*external.NetworkConfig, error)
+	GetNetworkEconomicsHandler                     func() (*external.NetworkEconomics, error)
+	ExportAccountsHandler                          func(token string, pageSize int) (*external.AccountsExportPage, error)
+	GetEpochSupplyHandler                          func(epoch uint32) (*supply.ShardSupply, error)
+	GetESDTSupplyHandler                           func(token string) (*esdt.TokenSupply, error)
+	GetDelegatorsHandler                           func(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error)
+	GetClaimableRewardsHandler                     func(contractAddress string, delegatorAddress string) (*big.Int, error)
+	GetDelegationContractConfigHandler             func(contractAddress string) (*external.DelegationContractConfig, error)
+	GetAuctionListHandler                          func() (*external.AuctionList, error)
 	BalanceHandler                                 func(string) (*big.Int, error)
 	GetAccountHandler                              func(address string) (*state.Account, error)
+	GetAccountAtNonceHandler                       func(address string, blockNonce uint64) (*state.Account, error)
+	GetAccountWithOptionsHandler                   func(address string, options external.AccountQueryOptions) (*state.Account, *external.AccountBlockInfo, error)
+	GetAccountByUserNameHandler                    func(userName string) (string, *state.Account, error)
+	GetNFTsHandler                                 func(address string) ([]*external.NFTToken, error)
 	GenerateTransactionHandler                     func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler                          func(hash string) (*transaction.Transaction, error)
-	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error)
-	CreateTransactionHandler                       func(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
+	GetTransactionMetadataHandler                  func(hash string) (*dblookupext.MiniblockMetadata, error)
+	GetHyperBlockByNonceHandler                    func(nonce uint64) (*external.HyperBlock, error)
+	GetOutgoingOperationsBatchesHandler            func() ([]*bridge.SignedOutgoingOperationsBatch, error)
+	GetValidatorsSelectionAuditHandler             func(epoch uint32) (*audit.EpochSelectionAudit, error)
+	GetTransactionsPoolHandler                     func(withTransactions bool, senderHex string) (*external.TransactionsPool, error)
+	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte, chainID string, version uint32) (string, error)
+	SendTransactionWithManagedNonceHandler         func(sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, data string) (string, uint64, error)
+	CreateTransactionHandler                       func(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*transaction.Transaction, error)
+	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetDataValueHandler                            func(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
+	GetDataValueAtBlockHandler                     func(address string, funcName string, blockCoordinates external.BlockCoordinates, argsBuff ...[]byte) ([]byte, error)
 	StatusMetricsHandler                           func() external.StatusMetricsHandler
+	SimulateTransactionHandler                     func(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+	GetTransactionWithResultsHandler               func(hash string) (*transaction.Transaction, *transaction.TransactionResults, error)
 }
 
 // IsNodeRunning is the mock implementation of a handler's IsNodeRunning method
@@ -76,11 +106,81 @@ func (f *Facade) GetBalance(address string) (*big.Int, error) {
 	return f.BalanceHandler(address)
 }
 
+// GetPendingMiniBlocks is the mock implementation of a handler's GetPendingMiniBlocks method
+func (f *Facade) GetPendingMiniBlocks() ([]*block.PendingMiniBlockInfo, error) {
+	return f.GetPendingMiniBlocksHandler()
+}
+
+// GetNetworkConfig is the mock implementation of a handler's GetNetworkConfig method
+func (f *Facade) GetNetworkConfig() (*external.NetworkConfig, error) {
+	return f.GetNetworkConfigHandler()
+}
+
+// GetNetworkEconomics is the mock implementation of a handler's GetNetworkEconomics method
+func (f *Facade) GetNetworkEconomics() (*external.NetworkEconomics, error) {
+	return f.GetNetworkEconomicsHandler()
+}
+
+// ExportAccounts is the mock implementation of a handler's ExportAccounts method
+func (f *Facade) ExportAccounts(token string, pageSize int) (*external.AccountsExportPage, error) {
+	return f.ExportAccountsHandler(token, pageSize)
+}
+
+// GetEpochSupply is the mock implementation of a handler's GetEpochSupply method
+func (f *Facade) GetEpochSupply(epoch uint32) (*supply.ShardSupply, error) {
+	return f.GetEpochSupplyHandler(epoch)
+}
+
+// GetESDTSupply is the mock implementation of a handler's GetESDTSupply method
+func (f *Facade) GetESDTSupply(token string) (*esdt.TokenSupply, error) {
+	return f.GetESDTSupplyHandler(token)
+}
+
+// GetDelegators is the mock implementation of a handler's GetDelegators method
+func (f *Facade) GetDelegators(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error) {
+	return f.GetDelegatorsHandler(contractAddress, startIndex, pageSize)
+}
+
+// GetClaimableRewards is the mock implementation of a handler's GetClaimableRewards method
+func (f *Facade) GetClaimableRewards(contractAddress string, delegatorAddress string) (*big.Int, error) {
+	return f.GetClaimableRewardsHandler(contractAddress, delegatorAddress)
+}
+
+// GetDelegationContractConfig is the mock implementation of a handler's GetDelegationContractConfig method
+func (f *Facade) GetDelegationContractConfig(contractAddress string) (*external.DelegationContractConfig, error) {
+	return f.GetDelegationContractConfigHandler(contractAddress)
+}
+
+// GetAuctionList is the mock implementation of a handler's GetAuctionList method
+func (f *Facade) GetAuctionList() (*external.AuctionList, error) {
+	return f.GetAuctionListHandler()
+}
+
 // GetAccount is the mock implementation of a handler's GetAccount method
 func (f *Facade) GetAccount(address string) (*state.Account, error) {
 	return f.GetAccountHandler(address)
 }
 
+// GetAccountAtNonce is the mock implementation of a handler's GetAccountAtNonce method
+func (f *Facade) GetAccountAtNonce(address string, blockNonce uint64) (*state.Account, error) {
+	return f.GetAccountAtNonceHandler(address, blockNonce)
+}
+
+// GetAccountWithOptions is the mock implementation of a handler's GetAccountWithOptions method
+func (f *Facade) GetAccountWithOptions(address string, options external.AccountQueryOptions) (*state.Account, *external.AccountBlockInfo, error) {
+	return f.GetAccountWithOptionsHandler(address, options)
+}
+
+// GetAccountByUserName is the mock implementation of a handler's GetAccountByUserName method
+func (f *Facade) GetAccountByUserName(userName string) (string, *state.Account, error) {
+	return f.GetAccountByUserNameHandler(userName)
+}
+
+// GetNFTs is the mock implementation of a handler's GetNFTs method
+func (f *Facade) GetNFTs(address string) ([]*external.NFTToken, error) {
+	return f.GetNFTsHandler(address)
+}
+
 // GenerateTransaction is the mock implementation of a handler's GenerateTransaction method
 func (f *Facade) GenerateTransaction(sender string, receiver string, value *big.Int,
 	code string) (*transaction.Transaction, error) {
@@ -98,9 +198,11 @@ func (f *Facade) CreateTransaction(
 	data string,
 	signatureHex string,
 	challenge string,
+	chainID string,
+	version uint32,
 ) (*transaction.Transaction, error) {
 
-	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge)
+	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge, chainID, version)
 }
 
 // GetTransaction is the mock implementation of a handler's GetTransaction method
@@ -108,9 +210,44 @@ func (f *Facade) GetTransaction(hash string) (*transaction.Transaction, error) {
 	return f.GetTransactionHandler(hash)
 }
 
+// GetTransactionMetadata is the mock implementation of a handler's GetTransactionMetadata method
+func (f *Facade) GetTransactionMetadata(hash string) (*dblookupext.MiniblockMetadata, error) {
+	return f.GetTransactionMetadataHandler(hash)
+}
+
+// GetHyperBlockByNonce is the mock implementation of a handler's GetHyperBlockByNonce method
+func (f *Facade) GetHyperBlockByNonce(nonce uint64) (*external.HyperBlock, error) {
+	return f.GetHyperBlockByNonceHandler(nonce)
+}
+
+// GetOutgoingOperationsBatches is the mock implementation of a handler's GetOutgoingOperationsBatches method
+func (f *Facade) GetOutgoingOperationsBatches() ([]*bridge.SignedOutgoingOperationsBatch, error) {
+	return f.GetOutgoingOperationsBatchesHandler()
+}
+
+// GetValidatorsSelectionAudit is the mock implementation of a handler's GetValidatorsSelectionAudit method
+func (f *Facade) GetValidatorsSelectionAudit(epoch uint32) (*audit.EpochSelectionAudit, error) {
+	return f.GetValidatorsSelectionAuditHandler(epoch)
+}
+
+// GetTransactionsPool is the mock implementation of a handler's GetTransactionsPool method
+func (f *Facade) GetTransactionsPool(withTransactions bool, senderHex string) (*external.TransactionsPool, error) {
+	return f.GetTransactionsPoolHandler(withTransactions, senderHex)
+}
+
 // SendTransaction is the mock implementation of a handler's SendTransaction method
-func (f *Facade) SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error) {
-	return f.SendTransactionHandler(nonce, sender, receiver, value, gasPrice, gasLimit, code, signature)
+func (f *Facade) SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte, chainID string, version uint32) (string, error) {
+	return f.SendTransactionHandler(nonce, sender, receiver, value, gasPrice, gasLimit, code, signature, chainID, version)
+}
+
+// SendTransactionWithManagedNonce is the mock implementation of a handler's SendTransactionWithManagedNonce method
+func (f *Facade) SendTransactionWithManagedNonce(sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, data string) (string, uint64, error) {
+	return f.SendTransactionWithManagedNonceHandler(sender, receiver, value, gasPrice, gasLimit, data)
+}
+
+// ValidateTransaction is the mock implementation of a handler's ValidateTransaction method
+func (f *Facade) ValidateTransaction(tx *transaction.Transaction) error {
+	return f.ValidateTransactionHandler(tx)
 }
 
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
@@ -132,11 +269,25 @@ func (f *Facade) GetVmValue(address string, funcName string, argsBuff ...[]byte)
 	return f.GetDataValueHandler(address, funcName, argsBuff...)
 }
 
+func (f *Facade) GetVmValueAtBlock(address string, funcName string, blockCoordinates external.BlockCoordinates, argsBuff ...[]byte) ([]byte, error) {
+	return f.GetDataValueAtBlockHandler(address, funcName, blockCoordinates, argsBuff...)
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *Facade) StatusMetrics() external.StatusMetricsHandler {
 	return f.StatusMetricsHandler()
 }
 
+// SimulateTransaction is the mock implementation of a handler's SimulateTransaction method
+func (f *Facade) SimulateTransaction(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+	return f.SimulateTransactionHandler(tx, withTrace)
+}
+
+// GetTransactionWithResults is the mock implementation of a handler's GetTransactionWithResults method
+func (f *Facade) GetTransactionWithResults(hash string) (*transaction.Transaction, *transaction.TransactionResults, error) {
+	return f.GetTransactionWithResultsHandler(hash)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *Facade) IsInterfaceNil() bool {
 	if f == nil {
@@ -0,0 +1,288 @@
+package network
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	StatusMetrics() external.StatusMetricsHandler
+	GetNetworkConfig() (*external.NetworkConfig, error)
+	GetNetworkEconomics() (*external.NetworkEconomics, error)
+	ExportAccounts(token string, pageSize int) (*external.AccountsExportPage, error)
+	GetEpochSupply(epoch uint32) (*supply.ShardSupply, error)
+	GetESDTSupply(token string) (*esdt.TokenSupply, error)
+	IsInterfaceNil() bool
+}
+
+type shardSupplyResponse struct {
+	ShardID           uint32 `json:"shardId"`
+	Epoch             uint32 `json:"epoch"`
+	FirstNonce        uint64 `json:"firstNonce"`
+	LastNonce         uint64 `json:"lastNonce"`
+	Genesis           string `json:"genesis"`
+	Inflation         string `json:"inflation"`
+	FeesBurned        string `json:"feesBurned"`
+	FeesDestroyed     string `json:"feesDestroyed"`
+	Slashed           string `json:"slashed"`
+	TotalSupply       string `json:"totalSupply"`
+	CirculatingSupply string `json:"circulatingSupply"`
+}
+
+type tokenSupplyResponse struct {
+	InitialSupply string `json:"initialSupply"`
+	Minted        string `json:"minted"`
+	Burned        string `json:"burned"`
+	CurrentSupply string `json:"currentSupply"`
+}
+
+type economicsResponse struct {
+	TotalSupply                       string               `json:"totalSupply"`
+	StakedValue                       string               `json:"stakedValue"`
+	TopUpValue                        string               `json:"topUpValue"`
+	APR                               float64              `json:"apr"`
+	ProtocolSustainabilityAddress     interface{}          `json:"protocolSustainabilityAddress"`
+	ProtocolSustainabilityPercentage  interface{}          `json:"protocolSustainabilityPercentage"`
+	ProtocolSustainabilityAccumulated interface{}          `json:"protocolSustainabilityAccumulated"`
+	ShardSupply                       *shardSupplyResponse `json:"shardSupply,omitempty"`
+}
+
+type configResponse struct {
+	ChainID                   string  `json:"chainID"`
+	NumShards                 uint32  `json:"numShards"`
+	RoundDuration             uint64  `json:"roundDuration"`
+	ConsensusGroupSize        uint32  `json:"consensusGroupSize"`
+	MinGasPrice               uint64  `json:"minGasPrice"`
+	MinGasLimit               uint64  `json:"minGasLimit"`
+	GasPriceModifier          float64 `json:"gasPriceModifier"`
+	MaxMoveBalanceGasPerBlock uint64  `json:"maxMoveBalanceGasPerBlock"`
+	MaxSCExecutionGasPerBlock uint64  `json:"maxSCExecutionGasPerBlock"`
+	RewardsValue              string  `json:"rewardsValue"`
+}
+
+type exportedAccountResponse struct {
+	Address  string `json:"address"`
+	Balance  string `json:"balance"`
+	Nonce    uint64 `json:"nonce"`
+	CodeHash string `json:"codeHash"`
+}
+
+type accountsExportResponse struct {
+	Epoch     uint32                     `json:"epoch"`
+	RootHash  string                     `json:"rootHash"`
+	Accounts  []*exportedAccountResponse `json:"accounts"`
+	NextToken string                     `json:"nextToken"`
+}
+
+const csvFormat = "csv"
+
+// Routes defines network related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("/economics", EconomicsMetrics)
+	router.GET("/config", NetworkConfig)
+	router.GET("/accounts/export", ExportAccounts)
+	router.GET("/supply/epoch/:epoch", EpochSupply)
+	router.GET("/esdt/supply/:token", ESDTSupply)
+}
+
+// EconomicsMetrics returns the network economics values, as they are known by the node
+func EconomicsMetrics(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	economics, err := ef.GetNetworkEconomics()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	details, err := ef.StatusMetrics().StatusMetricsMap()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := economicsResponse{
+		TotalSupply:                       economics.TotalSupply.String(),
+		StakedValue:                       economics.StakedValue.String(),
+		TopUpValue:                        economics.TopUpValue.String(),
+		APR:                               economics.APR,
+		ProtocolSustainabilityAddress:     details[core.MetricProtocolSustainabilityAddress],
+		ProtocolSustainabilityPercentage:  details[core.MetricProtocolSustainabilityPercentage],
+		ProtocolSustainabilityAccumulated: details[core.MetricProtocolSustainabilityAccumulated],
+	}
+	if economics.ShardSupply != nil {
+		response.ShardSupply = newShardSupplyResponse(economics.ShardSupply)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"economics": response})
+}
+
+// EpochSupply returns the counters of the value minted and burned by the node's shard, reached at the end of an epoch
+func EpochSupply(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidEpoch.Error()})
+		return
+	}
+
+	epochSupply, err := ef.GetEpochSupply(uint32(epoch))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetEpochSupply.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"supply": newShardSupplyResponse(epochSupply)})
+}
+
+func newShardSupplyResponse(shardSupply *supply.ShardSupply) *shardSupplyResponse {
+	return &shardSupplyResponse{
+		ShardID:           shardSupply.ShardID,
+		Epoch:             shardSupply.Epoch,
+		FirstNonce:        shardSupply.FirstNonce,
+		LastNonce:         shardSupply.LastNonce,
+		Genesis:           shardSupply.Genesis.String(),
+		Inflation:         shardSupply.Inflation.String(),
+		FeesBurned:        shardSupply.FeesBurned.String(),
+		FeesDestroyed:     shardSupply.FeesDestroyed.String(),
+		Slashed:           shardSupply.Slashed.String(),
+		TotalSupply:       shardSupply.TotalSupply().String(),
+		CirculatingSupply: shardSupply.CirculatingSupply().String(),
+	}
+}
+
+// ESDTSupply returns the initial, minted, burned and current supply of a token, as changed by the node's shard
+func ESDTSupply(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	tokenSupply, err := ef.GetESDTSupply(c.Param("token"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetESDTSupply.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"supply": &tokenSupplyResponse{
+		InitialSupply: tokenSupply.Initial.String(),
+		Minted:        tokenSupply.Minted.String(),
+		Burned:        tokenSupply.Burned.String(),
+		CurrentSupply: tokenSupply.CurrentSupply().String(),
+	}})
+}
+
+// NetworkConfig returns the configuration of the network, as it is known by the node
+func NetworkConfig(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	networkConfig, err := ef.GetNetworkConfig()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := configResponse{
+		ChainID:                   networkConfig.ChainID,
+		NumShards:                 networkConfig.NumShards,
+		RoundDuration:             networkConfig.RoundDuration,
+		ConsensusGroupSize:        networkConfig.ConsensusGroupSize,
+		MinGasPrice:               networkConfig.MinGasPrice,
+		MinGasLimit:               networkConfig.MinGasLimit,
+		GasPriceModifier:          networkConfig.GasPriceModifier,
+		MaxMoveBalanceGasPerBlock: networkConfig.MaxMoveBalanceGasPerBlock,
+		MaxSCExecutionGasPerBlock: networkConfig.MaxSCExecutionGasPerBlock,
+		RewardsValue:              networkConfig.RewardsValue.String(),
+	}
+
+	c.JSON(http.StatusOK, gin.H{"config": response})
+}
+
+// ExportAccounts returns a page of the accounts of the node's shard, at the root hash of the last epoch start. The
+// next page is requested with the token returned with the current one. With the csv format, the accounts are written
+// as csv rows while the epoch, the root hash and the next token are returned in headers
+func ExportAccounts(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "0"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidPageSize.Error())})
+		return
+	}
+
+	page, err := ef.ExportAccounts(c.Query("token"), pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrExportAccounts.Error(), err.Error())})
+		return
+	}
+
+	if c.Query("format") == csvFormat {
+		writeAccountsAsCsv(c, page)
+		return
+	}
+
+	response := accountsExportResponse{
+		Epoch:     page.Epoch,
+		RootHash:  page.RootHash,
+		Accounts:  make([]*exportedAccountResponse, 0, len(page.Accounts)),
+		NextToken: page.NextToken,
+	}
+	for _, account := range page.Accounts {
+		response.Accounts = append(response.Accounts, &exportedAccountResponse{
+			Address:  account.Address,
+			Balance:  account.Balance,
+			Nonce:    account.Nonce,
+			CodeHash: account.CodeHash,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{"export": response})
+}
+
+func writeAccountsAsCsv(c *gin.Context, page *external.AccountsExportPage) {
+	c.Header("Content-Type", "text/csv")
+	c.Header("X-Export-Epoch", strconv.FormatUint(uint64(page.Epoch), 10))
+	c.Header("X-Export-Root-Hash", page.RootHash)
+	c.Header("X-Export-Next-Token", page.NextToken)
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	_ = writer.Write([]string{"address", "balance", "nonce", "codeHash"})
+	for _, account := range page.Accounts {
+		_ = writer.Write([]string{
+			account.Address,
+			account.Balance,
+			strconv.FormatUint(account.Nonce, 10),
+			account.CodeHash,
+		})
+	}
+	writer.Flush()
+}
@@ -0,0 +1,512 @@
+package network_test
+
+import (
+	"encoding/json"
+	errs "errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/network"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type economicsResponse struct {
+	Economics struct {
+		TotalSupply                       string  `json:"totalSupply"`
+		StakedValue                       string  `json:"stakedValue"`
+		TopUpValue                        string  `json:"topUpValue"`
+		APR                               float64 `json:"apr"`
+		ProtocolSustainabilityAddress     string  `json:"protocolSustainabilityAddress"`
+		ProtocolSustainabilityPercentage  string  `json:"protocolSustainabilityPercentage"`
+		ProtocolSustainabilityAccumulated string  `json:"protocolSustainabilityAccumulated"`
+		ShardSupply                       *struct {
+			Inflation         string `json:"inflation"`
+			TotalSupply       string `json:"totalSupply"`
+			CirculatingSupply string `json:"circulatingSupply"`
+		} `json:"shardSupply"`
+	} `json:"economics"`
+	Error string `json:"error"`
+}
+
+type epochSupplyResponse struct {
+	Supply struct {
+		ShardID           uint32 `json:"shardId"`
+		Epoch             uint32 `json:"epoch"`
+		LastNonce         uint64 `json:"lastNonce"`
+		FeesBurned        string `json:"feesBurned"`
+		FeesDestroyed     string `json:"feesDestroyed"`
+		TotalSupply       string `json:"totalSupply"`
+		CirculatingSupply string `json:"circulatingSupply"`
+	} `json:"supply"`
+	Error string `json:"error"`
+}
+
+type tokenSupplyResponse struct {
+	Supply struct {
+		InitialSupply string `json:"initialSupply"`
+		Minted        string `json:"minted"`
+		Burned        string `json:"burned"`
+		CurrentSupply string `json:"currentSupply"`
+	} `json:"supply"`
+	Error string `json:"error"`
+}
+
+type configResponse struct {
+	Config struct {
+		ChainID                   string  `json:"chainID"`
+		NumShards                 uint32  `json:"numShards"`
+		RoundDuration             uint64  `json:"roundDuration"`
+		ConsensusGroupSize        uint32  `json:"consensusGroupSize"`
+		MinGasPrice               uint64  `json:"minGasPrice"`
+		MinGasLimit               uint64  `json:"minGasLimit"`
+		GasPriceModifier          float64 `json:"gasPriceModifier"`
+		MaxMoveBalanceGasPerBlock uint64  `json:"maxMoveBalanceGasPerBlock"`
+		MaxSCExecutionGasPerBlock uint64  `json:"maxSCExecutionGasPerBlock"`
+		RewardsValue              string  `json:"rewardsValue"`
+	} `json:"config"`
+	Error string `json:"error"`
+}
+
+type accountsExportResponse struct {
+	Export struct {
+		Epoch    uint32 `json:"epoch"`
+		RootHash string `json:"rootHash"`
+		Accounts []struct {
+			Address  string `json:"address"`
+			Balance  string `json:"balance"`
+			Nonce    uint64 `json:"nonce"`
+			CodeHash string `json:"codeHash"`
+		} `json:"accounts"`
+		NextToken string `json:"nextToken"`
+	} `json:"export"`
+	Error string `json:"error"`
+}
+
+func TestEconomicsMetrics_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNetworkServer(mock.WrongFacade{})
+	req, _ := http.NewRequest("GET", "/network/economics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := economicsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestEconomicsMetrics_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetNetworkEconomicsHandler: func() (*external.NetworkEconomics, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startNetworkServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/economics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := economicsResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestEconomicsMetrics_ShouldReturnEconomics(t *testing.T) {
+	t.Parallel()
+
+	statusMetricsProvider := statusHandler.NewStatusMetrics()
+	statusMetricsProvider.SetStringValue(core.MetricProtocolSustainabilityAddress, "aabb")
+	statusMetricsProvider.SetStringValue(core.MetricProtocolSustainabilityAccumulated, "1000")
+	statusMetricsProvider.SetStringValue(core.MetricProtocolSustainabilityPercentage, "0.100000")
+
+	facade := mock.Facade{
+		GetNetworkEconomicsHandler: func() (*external.NetworkEconomics, error) {
+			return &external.NetworkEconomics{
+				TotalSupply: big.NewInt(20000),
+				StakedValue: big.NewInt(5000),
+				TopUpValue:  big.NewInt(500),
+				APR:         0.15,
+			}, nil
+		},
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return statusMetricsProvider
+		},
+	}
+
+	ws := startNetworkServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/economics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := economicsResponse{}
+	err := json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "20000", response.Economics.TotalSupply)
+	assert.Equal(t, "5000", response.Economics.StakedValue)
+	assert.Equal(t, "500", response.Economics.TopUpValue)
+	assert.Equal(t, 0.15, response.Economics.APR)
+	assert.Equal(t, "aabb", response.Economics.ProtocolSustainabilityAddress)
+	assert.Equal(t, "1000", response.Economics.ProtocolSustainabilityAccumulated)
+	assert.Equal(t, "0.100000", response.Economics.ProtocolSustainabilityPercentage)
+	assert.Nil(t, response.Economics.ShardSupply)
+}
+
+func TestEconomicsMetrics_ShouldReturnTheShardSupply(t *testing.T) {
+	t.Parallel()
+
+	shardSupply := supply.NewShardSupply(0, big.NewInt(1000))
+	shardSupply.Inflation = big.NewInt(200)
+	shardSupply.FeesBurned = big.NewInt(50)
+	facade := mock.Facade{
+		GetNetworkEconomicsHandler: func() (*external.NetworkEconomics, error) {
+			return &external.NetworkEconomics{
+				TotalSupply: big.NewInt(20000),
+				StakedValue: big.NewInt(5000),
+				TopUpValue:  big.NewInt(500),
+				ShardSupply: shardSupply,
+			}, nil
+		},
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return statusHandler.NewStatusMetrics()
+		},
+	}
+
+	ws := startNetworkServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/economics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := economicsResponse{}
+	err := json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "200", response.Economics.ShardSupply.Inflation)
+	assert.Equal(t, "1200", response.Economics.ShardSupply.TotalSupply)
+	assert.Equal(t, "1150", response.Economics.ShardSupply.CirculatingSupply)
+}
+
+func TestNetworkConfig_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNetworkServer(mock.WrongFacade{})
+	req, _ := http.NewRequest("GET", "/network/config", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := configResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestNetworkConfig_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetNetworkConfigHandler: func() (*external.NetworkConfig, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startNetworkServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/config", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := configResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestNetworkConfig_ShouldReturnConfig(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetNetworkConfigHandler: func() (*external.NetworkConfig, error) {
+			return &external.NetworkConfig{
+				ChainID:                   "testnet",
+				NumShards:                 2,
+				RoundDuration:             4000,
+				ConsensusGroupSize:        21,
+				MinGasPrice:               10,
+				MinGasLimit:               5,
+				GasPriceModifier:          0.01,
+				MaxMoveBalanceGasPerBlock: 100,
+				MaxSCExecutionGasPerBlock: 200,
+				RewardsValue:              big.NewInt(1000),
+			}, nil
+		},
+	}
+
+	ws := startNetworkServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/config", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := configResponse{}
+	err := json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "testnet", response.Config.ChainID)
+	assert.Equal(t, uint32(2), response.Config.NumShards)
+	assert.Equal(t, uint64(4000), response.Config.RoundDuration)
+	assert.Equal(t, uint32(21), response.Config.ConsensusGroupSize)
+	assert.Equal(t, uint64(10), response.Config.MinGasPrice)
+	assert.Equal(t, uint64(5), response.Config.MinGasLimit)
+	assert.Equal(t, 0.01, response.Config.GasPriceModifier)
+	assert.Equal(t, uint64(100), response.Config.MaxMoveBalanceGasPerBlock)
+	assert.Equal(t, uint64(200), response.Config.MaxSCExecutionGasPerBlock)
+	assert.Equal(t, "1000", response.Config.RewardsValue)
+}
+
+func createAccountsExportFacade() *mock.Facade {
+	return &mock.Facade{
+		ExportAccountsHandler: func(token string, pageSize int) (*external.AccountsExportPage, error) {
+			return &external.AccountsExportPage{
+				Epoch:    3,
+				RootHash: "root hash",
+				Accounts: []*external.ExportedAccount{
+					{Address: "address", Balance: "100", Nonce: 7, CodeHash: "code hash"},
+				},
+				NextToken: token + "next",
+			}, nil
+		},
+	}
+}
+
+func TestExportAccounts_InvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNetworkServer(createAccountsExportFacade())
+	req, _ := http.NewRequest("GET", "/network/accounts/export?pageSize=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsExportResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrInvalidPageSize.Error())
+}
+
+func TestExportAccounts_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		ExportAccountsHandler: func(token string, pageSize int) (*external.AccountsExportPage, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startNetworkServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/accounts/export", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsExportResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestExportAccounts_ShouldReturnThePageAsJson(t *testing.T) {
+	t.Parallel()
+
+	ws := startNetworkServer(createAccountsExportFacade())
+	req, _ := http.NewRequest("GET", "/network/accounts/export?token=token&pageSize=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsExportResponse{}
+	err := json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(3), response.Export.Epoch)
+	assert.Equal(t, "root hash", response.Export.RootHash)
+	assert.Equal(t, "tokennext", response.Export.NextToken)
+	assert.Equal(t, 1, len(response.Export.Accounts))
+	assert.Equal(t, "address", response.Export.Accounts[0].Address)
+	assert.Equal(t, "100", response.Export.Accounts[0].Balance)
+	assert.Equal(t, uint64(7), response.Export.Accounts[0].Nonce)
+	assert.Equal(t, "code hash", response.Export.Accounts[0].CodeHash)
+}
+
+func TestExportAccounts_ShouldReturnThePageAsCsv(t *testing.T) {
+	t.Parallel()
+
+	ws := startNetworkServer(createAccountsExportFacade())
+	req, _ := http.NewRequest("GET", "/network/accounts/export?format=csv", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "3", resp.Header().Get("X-Export-Epoch"))
+	assert.Equal(t, "next", resp.Header().Get("X-Export-Next-Token"))
+	assert.Equal(t, "address,balance,nonce,codeHash\naddress,100,7,code hash\n", resp.Body.String())
+}
+
+func TestEpochSupply_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNetworkServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/network/supply/epoch/not-an-epoch", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := epochSupplyResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, errors.ErrInvalidEpoch.Error(), response.Error)
+}
+
+func TestEpochSupply_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetEpochSupplyHandler: func(epoch uint32) (*supply.ShardSupply, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startNetworkServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/supply/epoch/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := epochSupplyResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrGetEpochSupply.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestEpochSupply_ShouldReturnTheEpochSupply(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetEpochSupplyHandler: func(epoch uint32) (*supply.ShardSupply, error) {
+			epochSupply := supply.NewShardSupply(1, big.NewInt(1000))
+			epochSupply.Epoch = epoch
+			epochSupply.LastNonce = 42
+			epochSupply.FeesBurned = big.NewInt(10)
+			epochSupply.FeesDestroyed = big.NewInt(15)
+			return epochSupply, nil
+		},
+	}
+
+	ws := startNetworkServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/supply/epoch/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := epochSupplyResponse{}
+	err := json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(1), response.Supply.ShardID)
+	assert.Equal(t, uint32(3), response.Supply.Epoch)
+	assert.Equal(t, uint64(42), response.Supply.LastNonce)
+	assert.Equal(t, "10", response.Supply.FeesBurned)
+	assert.Equal(t, "15", response.Supply.FeesDestroyed)
+	assert.Equal(t, "985", response.Supply.TotalSupply)
+	assert.Equal(t, "975", response.Supply.CirculatingSupply)
+}
+
+func TestESDTSupply_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetESDTSupplyHandler: func(token string) (*esdt.TokenSupply, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startNetworkServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/supply/TKN-0123", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := tokenSupplyResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrGetESDTSupply.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestESDTSupply_ShouldReturnTheTokenSupply(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetESDTSupplyHandler: func(token string) (*esdt.TokenSupply, error) {
+			assert.Equal(t, "TKN-0123", token)
+			return &esdt.TokenSupply{
+				Initial: big.NewInt(1000),
+				Minted:  big.NewInt(50),
+				Burned:  big.NewInt(20),
+			}, nil
+		},
+	}
+
+	ws := startNetworkServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/supply/TKN-0123", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := tokenSupplyResponse{}
+	err := json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "1000", response.Supply.InitialSupply)
+	assert.Equal(t, "50", response.Supply.Minted)
+	assert.Equal(t, "20", response.Supply.Burned)
+	assert.Equal(t, "1030", response.Supply.CurrentSupply)
+}
+
+func startNetworkServer(facade interface{}) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", facade)
+	})
+
+	networkRoutes := ws.Group("/network")
+	network.Routes(networkRoutes)
+	return ws
+}
@@ -1,12 +1,17 @@
 package node
 
 import (
+	"encoding/hex"
+	"fmt"
 	"math/big"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/gin-gonic/gin"
@@ -21,6 +26,8 @@ type FacadeHandler interface {
 	GetHeartbeats() ([]heartbeat.PubKeyHeartbeat, error)
 	TpsBenchmark() *statistics.TpsBenchmark
 	StatusMetrics() external.StatusMetricsHandler
+	GetPendingMiniBlocks() ([]*block.PendingMiniBlockInfo, error)
+	GetValidatorsSelectionAudit(epoch uint32) (*audit.EpochSelectionAudit, error)
 	IsInterfaceNil() bool
 }
 
@@ -35,9 +42,18 @@ type statisticsResponse struct {
 	AverageBlockTxCount   *big.Int                  `json:"averageBlockTxCount"`
 	LastBlockTxCount      uint32                    `json:"lastBlockTxCount"`
 	TotalProcessedTxCount *big.Int                  `json:"totalProcessedTxCount"`
+	AverageBlockTime      uint64                    `json:"averageBlockTime"`
 	ShardStatistics       []shardStatisticsResponse `json:"shardStatistics"`
 }
 
+type pendingMiniBlockResponse struct {
+	Hash                 string `json:"hash"`
+	SenderShardID        uint32 `json:"senderShardID"`
+	ReceiverShardID      uint32 `json:"receiverShardID"`
+	TxCount              uint32 `json:"txCount"`
+	NotarizedInMetaNonce uint64 `json:"notarizedInMetaNonce"`
+}
+
 type shardStatisticsResponse struct {
 	ShardID               uint32   `json:"shardID"`
 	LiveTPS               float64  `json:"liveTPS"`
@@ -47,6 +63,7 @@ type shardStatisticsResponse struct {
 	CurrentBlockNonce     uint64   `json:"currentBlockNonce"`
 	LastBlockTxCount      uint32   `json:"lastBlockTxCount"`
 	TotalProcessedTxCount *big.Int `json:"totalProcessedTxCount"`
+	AverageBlockTime      uint64   `json:"averageBlockTime"`
 }
 
 // Routes defines node related routes
@@ -54,7 +71,10 @@ func Routes(router *gin.RouterGroup) {
 	router.GET("/address", Address)
 	router.GET("/heartbeatstatus", HeartbeatStatus)
 	router.GET("/statistics", Statistics)
+	router.GET("/statistics/epoch/:epoch", EpochStatistics)
 	router.GET("/status", StatusMetrics)
+	router.GET("/pending-miniblocks", PendingMiniBlocks)
+	router.GET("/validators-selection/epoch/:epoch", ValidatorsSelectionAudit)
 }
 
 // Address returns the information about the address passed as parameter
@@ -103,6 +123,59 @@ func Statistics(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"statistics": statsFromTpsBenchmark(ef.TpsBenchmark())})
 }
 
+// EpochStatistics returns the blockchain statistics gathered during the provided epoch
+func EpochStatistics(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidEpoch.Error()})
+		return
+	}
+
+	tpsBenchmark := ef.TpsBenchmark()
+	if tpsBenchmark == nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrGetEpochStatistics.Error()})
+		return
+	}
+
+	epochStatistics, err := tpsBenchmark.EpochStatistics(uint32(epoch))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetEpochStatistics.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"statistics": epochStatistics})
+}
+
+// ValidatorsSelectionAudit returns how many times the eligible validators of the node's shard were selected as
+// leader and as consensus group member during an epoch, compared with the expected number of selections
+func ValidatorsSelectionAudit(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidEpoch.Error()})
+		return
+	}
+
+	epochAudit, err := ef.GetValidatorsSelectionAudit(uint32(epoch))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetValidatorsSelectionAudit.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"audit": epochAudit})
+}
+
 // StatusMetrics returns the node statistics exported by an StatusMetricsHandler
 func StatusMetrics(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
@@ -120,6 +193,35 @@ func StatusMetrics(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"details": details})
 }
 
+// PendingMiniBlocks returns the cross shard miniblocks which were notarized by the metachain but were not yet
+// processed by their destination shards
+func PendingMiniBlocks(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	pendingMiniBlocks, err := ef.GetPendingMiniBlocks()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]pendingMiniBlockResponse, 0, len(pendingMiniBlocks))
+	for _, info := range pendingMiniBlocks {
+		response = append(response, pendingMiniBlockResponse{
+			Hash:                 hex.EncodeToString(info.Hash),
+			SenderShardID:        info.SenderShardId,
+			ReceiverShardID:      info.ReceiverShardId,
+			TxCount:              info.TxCount,
+			NotarizedInMetaNonce: info.NotarizedInMetaNonce,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pendingMiniBlocks": response})
+}
+
 func statsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) statisticsResponse {
 	sr := statisticsResponse{}
 	sr.LiveTPS = tpsBenchmark.LiveTPS()
@@ -133,6 +235,7 @@ func statsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) statisticsResp
 	sr.AverageBlockTxCount = tpsBenchmark.AverageBlockTxCount()
 	sr.LastBlockTxCount = tpsBenchmark.LastBlockTxCount()
 	sr.TotalProcessedTxCount = tpsBenchmark.TotalProcessedTxCount()
+	sr.AverageBlockTime = tpsBenchmark.AverageBlockTime()
 	sr.ShardStatistics = make([]shardStatisticsResponse, tpsBenchmark.NrOfShards())
 
 	for i := 0; i < int(tpsBenchmark.NrOfShards()); i++ {
@@ -146,6 +249,7 @@ func statsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) statisticsResp
 			CurrentBlockNonce:     ss.CurrentBlockNonce(),
 			LastBlockTxCount:      ss.LastBlockTxCount(),
 			TotalProcessedTxCount: ss.TotalProcessedTxCount(),
+			AverageBlockTime:      ss.AverageBlockTime(),
 		}
 	}
 
@@ -16,6 +16,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/node"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -53,6 +55,42 @@ type StatisticsResponse struct {
 	} `json:"statistics"`
 }
 
+type EpochStatisticsResponse struct {
+	GeneralResponse
+	Statistics struct {
+		Epoch                 uint32 `json:"epoch"`
+		NumBlocks             uint64 `json:"numBlocks"`
+		TotalProcessedTxCount uint64 `json:"totalProcessedTxCount"`
+		ShardStatistics       []struct {
+			ShardID uint32 `json:"shardID"`
+		} `json:"shardStatistics"`
+	} `json:"statistics"`
+}
+
+type ValidatorsSelectionAuditResponse struct {
+	GeneralResponse
+	Audit struct {
+		Epoch      uint32 `json:"epoch"`
+		NumRounds  uint64 `json:"numRounds"`
+		Validators []struct {
+			PublicKey           string `json:"publicKey"`
+			NumLeaderSelections uint64 `json:"numLeaderSelections"`
+			Flagged             bool   `json:"flagged"`
+		} `json:"validators"`
+	} `json:"audit"`
+}
+
+type PendingMiniBlocksResponse struct {
+	GeneralResponse
+	PendingMiniBlocks []struct {
+		Hash                 string `json:"hash"`
+		SenderShardID        uint32 `json:"senderShardID"`
+		ReceiverShardID      uint32 `json:"receiverShardID"`
+		TxCount              uint32 `json:"txCount"`
+		NotarizedInMetaNonce uint64 `json:"notarizedInMetaNonce"`
+	} `json:"pendingMiniBlocks"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -239,7 +277,7 @@ func TestStatistics_FailsWithWrongFacadeTypeConversion(t *testing.T) {
 
 func TestStatistics_ReturnsSuccessfully(t *testing.T) {
 	nrOfShards := uint32(10)
-	roundTime := uint64(4)
+	roundTime := uint64(4000)
 	benchmark, _ := statistics.NewTPSBenchmark(nrOfShards, roundTime)
 
 	facade := mock.Facade{}
@@ -258,6 +296,128 @@ func TestStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, statisticsRsp.Statistics.NrOfShards, nrOfShards)
 }
 
+func TestEpochStatistics_InvalidEpochShouldErr(t *testing.T) {
+	facade := mock.Facade{}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/statistics/epoch/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statisticsRsp := EpochStatisticsResponse{}
+	loadResponse(resp.Body, &statisticsRsp)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, errors.ErrInvalidEpoch.Error(), statisticsRsp.Error)
+}
+
+func TestEpochStatistics_NotFoundShouldErr(t *testing.T) {
+	benchmark, _ := statistics.NewTPSBenchmark(2, 4000)
+
+	facade := mock.Facade{}
+	facade.TpsBenchmarkHandler = func() *statistics.TpsBenchmark {
+		return benchmark
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/statistics/epoch/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statisticsRsp := EpochStatisticsResponse{}
+	loadResponse(resp.Body, &statisticsRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(statisticsRsp.Error, statistics.ErrEpochStatisticsNotFound.Error()))
+}
+
+func TestEpochStatistics_ReturnsSuccessfully(t *testing.T) {
+	benchmark, _ := statistics.NewTPSBenchmark(2, 4000)
+	benchmark.Update(&block.MetaBlock{
+		Nonce:     1,
+		Round:     1,
+		TxCount:   12,
+		ShardInfo: []block.ShardData{{ShardId: 0, TxCount: 8}, {ShardId: 1, TxCount: 4}},
+	})
+
+	facade := mock.Facade{}
+	facade.TpsBenchmarkHandler = func() *statistics.TpsBenchmark {
+		return benchmark
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/statistics/epoch/0", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statisticsRsp := EpochStatisticsResponse{}
+	loadResponse(resp.Body, &statisticsRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(1), statisticsRsp.Statistics.NumBlocks)
+	assert.Equal(t, uint64(12), statisticsRsp.Statistics.TotalProcessedTxCount)
+	assert.Equal(t, 2, len(statisticsRsp.Statistics.ShardStatistics))
+}
+
+func TestValidatorsSelectionAudit_InvalidEpochShouldErr(t *testing.T) {
+	facade := mock.Facade{}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/validators-selection/epoch/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	auditRsp := ValidatorsSelectionAuditResponse{}
+	loadResponse(resp.Body, &auditRsp)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, errors.ErrInvalidEpoch.Error(), auditRsp.Error)
+}
+
+func TestValidatorsSelectionAudit_FacadeErrorShouldErr(t *testing.T) {
+	expectedErr := errs.New("audit disabled")
+	facade := mock.Facade{
+		GetValidatorsSelectionAuditHandler: func(epoch uint32) (*audit.EpochSelectionAudit, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/validators-selection/epoch/2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	auditRsp := ValidatorsSelectionAuditResponse{}
+	loadResponse(resp.Body, &auditRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(auditRsp.Error, errors.ErrGetValidatorsSelectionAudit.Error()))
+	assert.True(t, strings.Contains(auditRsp.Error, expectedErr.Error()))
+}
+
+func TestValidatorsSelectionAudit_ReturnsSuccessfully(t *testing.T) {
+	facade := mock.Facade{
+		GetValidatorsSelectionAuditHandler: func(epoch uint32) (*audit.EpochSelectionAudit, error) {
+			return &audit.EpochSelectionAudit{
+				Epoch:     epoch,
+				NumRounds: 100,
+				Validators: []*audit.ValidatorSelection{
+					{PublicKey: "aa", NumLeaderSelections: 60, Flagged: true},
+				},
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/validators-selection/epoch/2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	auditRsp := ValidatorsSelectionAuditResponse{}
+	loadResponse(resp.Body, &auditRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(2), auditRsp.Audit.Epoch)
+	assert.Equal(t, uint64(100), auditRsp.Audit.NumRounds)
+	assert.Equal(t, 1, len(auditRsp.Audit.Validators))
+	assert.Equal(t, "aa", auditRsp.Audit.Validators[0].PublicKey)
+	assert.True(t, auditRsp.Audit.Validators[0].Flagged)
+}
+
 func TestStatusMetrics_ShouldDisplayMetrics(t *testing.T) {
 	statusMetricsProvider := statusHandler.NewStatusMetrics()
 	key := "test-details-key"
@@ -306,6 +466,61 @@ func logError(err error) {
 	}
 }
 
+func TestPendingMiniBlocks_FromFacadeErrors(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetPendingMiniBlocksHandler: func() ([]*block.PendingMiniBlockInfo, error) {
+			return nil, errExpected
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/pending-miniblocks", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := PendingMiniBlocksResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestPendingMiniBlocks_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetPendingMiniBlocksHandler: func() ([]*block.PendingMiniBlockInfo, error) {
+			return []*block.PendingMiniBlockInfo{
+				{
+					ShardMiniBlockHeader: block.ShardMiniBlockHeader{
+						Hash:            []byte{0xaa, 0xbb},
+						SenderShardId:   0,
+						ReceiverShardId: 1,
+						TxCount:         5,
+					},
+					NotarizedInMetaNonce: 7,
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/pending-miniblocks", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := PendingMiniBlocksResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 1, len(response.PendingMiniBlocks))
+	assert.Equal(t, "aabb", response.PendingMiniBlocks[0].Hash)
+	assert.Equal(t, uint32(1), response.PendingMiniBlocks[0].ReceiverShardID)
+	assert.Equal(t, uint32(5), response.PendingMiniBlocks[0].TxCount)
+	assert.Equal(t, uint64(7), response.PendingMiniBlocks[0].NotarizedInMetaNonce)
+}
+
 func startNodeServer(handler node.FacadeHandler) *gin.Engine {
 	server := startNodeServerWithFacade(handler)
 	return server
@@ -5,18 +5,27 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 	"github.com/gin-gonic/gin"
 )
 
 // TxService interface defines methods that can be used from `elrondFacade` context variable
 type TxService interface {
-	CreateTransaction(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
-	SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error)
+	CreateTransaction(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*transaction.Transaction, error)
+	SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte, chainID string, version uint32) (string, error)
+	SendTransactionWithManagedNonce(sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, data string) (string, uint64, error)
+	ValidateTransaction(tx *transaction.Transaction) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	GetTransaction(hash string) (*transaction.Transaction, error)
+	GetTransactionMetadata(hash string) (*dblookupext.MiniblockMetadata, error)
+	GetTransactionWithResults(hash string) (*transaction.Transaction, *transaction.TransactionResults, error)
+	SimulateTransaction(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+	GetTransactionsPool(withTransactions bool, senderHex string) (*external.TransactionsPool, error)
 	IsInterfaceNil() bool
 }
 
@@ -46,6 +55,26 @@ type SendTxRequest struct {
 	GasLimit  uint64   `form:"gasLimit" json:"gasLimit"`
 	Signature string   `form:"signature" json:"signature"`
 	Challenge string   `form:"challenge" json:"challenge"`
+	ChainID   string   `form:"chainID" json:"chainID"`
+	Version   uint32   `form:"version" json:"version"`
+}
+
+// ManagedNonceTxRequest represents the structure that maps and validates user input for publishing a new transaction
+// from a hosted wallet, its nonce being assigned and its signature being computed by the node
+type ManagedNonceTxRequest struct {
+	Sender   string   `form:"sender" json:"sender"`
+	Receiver string   `form:"receiver" json:"receiver"`
+	Value    *big.Int `form:"value" json:"value"`
+	Data     string   `form:"data" json:"data"`
+	GasPrice uint64   `form:"gasPrice" json:"gasPrice"`
+	GasLimit uint64   `form:"gasLimit" json:"gasLimit"`
+}
+
+// MultipleTxResult represents the acceptance report of a single transaction from a bulk of transactions
+type MultipleTxResult struct {
+	Index    int    `json:"index"`
+	Accepted bool   `json:"accepted"`
+	Error    string `json:"error,omitempty"`
 }
 
 //TxResponse represents the structure on which the response will be validated against
@@ -56,12 +85,21 @@ type TxResponse struct {
 	BlockNumber uint64 `json:"blockNumber"`
 	BlockHash   string `json:"blockHash"`
 	Timestamp   uint64 `json:"timestamp"`
+
+	MiniBlockHash string `json:"miniblockHash,omitempty"`
+	Round         uint64 `json:"round,omitempty"`
+	Epoch         uint32 `json:"epoch,omitempty"`
 }
 
+// poolPath is served by the /:txhash route, as the router does not allow a static path next to a parameter
+const poolPath = "pool"
+
 // Routes defines transaction related routes
 func Routes(router *gin.RouterGroup) {
 	router.POST("/send", SendTransaction)
 	router.POST("/send-multiple", SendMultipleTransactions)
+	router.POST("/send-with-managed-nonce", SendTransactionWithManagedNonce)
+	router.POST("/simulate", SimulateTransaction)
 	router.GET("/:txhash", GetTransaction)
 }
 
@@ -86,7 +124,7 @@ func SendTransaction(c *gin.Context) {
 		return
 	}
 
-	txHash, err := ef.SendTransaction(gtx.Nonce, gtx.Sender, gtx.Receiver, gtx.Value, gtx.GasPrice, gtx.GasLimit, gtx.Data, signature)
+	txHash, err := ef.SendTransaction(gtx.Nonce, gtx.Sender, gtx.Receiver, gtx.Value, gtx.GasPrice, gtx.GasLimit, gtx.Data, signature, gtx.ChainID, gtx.Version)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error())})
 		return
@@ -95,7 +133,33 @@ func SendTransaction(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"txHash": txHash})
 }
 
-// SendMultipleTransactions will receive a number of transactions and will propagate them for processing
+// SendTransactionWithManagedNonce will receive a transaction of a hosted wallet from the client, will assign its nonce
+// and sign it, then will propagate it for processing. The response contains the hash and the nonce of the transaction
+func SendTransactionWithManagedNonce(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(TxService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	var gtx = ManagedNonceTxRequest{}
+	err := c.ShouldBindJSON(&gtx)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	txHash, nonce, err := ef.SendTransactionWithManagedNonce(gtx.Sender, gtx.Receiver, gtx.Value, gtx.GasPrice, gtx.GasLimit, gtx.Data)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"txHash": txHash, "nonce": nonce})
+}
+
+// SendMultipleTransactions will receive a number of transactions, will validate each of them and will propagate
+//  the accepted ones for processing. The response contains the acceptance report for every provided transaction
 func SendMultipleTransactions(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(TxService)
 	if !ok {
@@ -110,8 +174,9 @@ func SendMultipleTransactions(c *gin.Context) {
 		return
 	}
 
-	var txs []*transaction.Transaction
-	for _, receivedTx := range gtx {
+	txs := make([]*transaction.Transaction, 0, len(gtx))
+	results := make([]MultipleTxResult, 0, len(gtx))
+	for idx, receivedTx := range gtx {
 		tx, err := ef.CreateTransaction(
 			receivedTx.Nonce,
 			receivedTx.Value,
@@ -122,24 +187,86 @@ func SendMultipleTransactions(c *gin.Context) {
 			receivedTx.Data,
 			receivedTx.Signature,
 			receivedTx.Challenge,
+			receivedTx.ChainID,
+			receivedTx.Version,
 		)
+		if err == nil {
+			err = ef.ValidateTransaction(tx)
+		}
 		if err != nil {
+			results = append(results, MultipleTxResult{Index: idx, Accepted: false, Error: err.Error()})
 			continue
 		}
 
 		txs = append(txs, tx)
+		results = append(results, MultipleTxResult{Index: idx, Accepted: true})
+	}
+
+	numOfSentTxs := uint64(0)
+	if len(txs) > 0 {
+		numOfSentTxs, err = ef.SendBulkTransactions(txs)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrMultipleTxGenerationFailed.Error(), err.Error())})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"txsSent": numOfSentTxs, "results": results})
+}
+
+// SimulateTransaction will receive a transaction from the client and will execute it against the current state,
+//  without propagating it or altering the state, returning the expected outcome. If the withTrace query parameter is
+//  set, the calls made by the VM to the node during a smart contract execution are returned as well
+func SimulateTransaction(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(TxService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	withTrace, err := strconv.ParseBool(c.DefaultQuery("withTrace", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	var gtx = SendTxRequest{}
+	err = c.ShouldBindJSON(&gtx)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	tx, err := ef.CreateTransaction(
+		gtx.Nonce,
+		gtx.Value,
+		gtx.Receiver,
+		gtx.Sender,
+		gtx.GasPrice,
+		gtx.GasLimit,
+		gtx.Data,
+		gtx.Signature,
+		gtx.Challenge,
+		gtx.ChainID,
+		gtx.Version,
+	)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error())})
+		return
 	}
 
-	numOfSentTxs, err := ef.SendBulkTransactions(txs)
+	results, err := ef.SimulateTransaction(tx, withTrace)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrTxSimulationFailed.Error(), err.Error())})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"txsSent": numOfSentTxs})
+	c.JSON(http.StatusOK, gin.H{"result": results})
 }
 
-// GetTransaction returns transaction details for a given txhash
+// GetTransaction returns transaction details for a given txhash. If the withResults query parameter is set, the
+// status of the transaction, the smart contract results it generated and its logs are returned as well. The pool path
+// returns the content of the transactions pool instead
 func GetTransaction(c *gin.Context) {
 
 	ef, ok := c.MustGet("elrondFacade").(TxService)
@@ -149,11 +276,25 @@ func GetTransaction(c *gin.Context) {
 	}
 
 	txhash := c.Param("txhash")
+	if txhash == poolPath {
+		getTransactionsPool(c, ef)
+		return
+	}
 	if txhash == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error())})
 		return
 	}
 
+	withResults, err := strconv.ParseBool(c.DefaultQuery("withResults", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+	if withResults {
+		getTransactionWithResults(c, ef, txhash)
+		return
+	}
+
 	tx, err := ef.GetTransaction(txhash)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrGetTransaction.Error()})
@@ -165,7 +306,57 @@ func GetTransaction(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"transaction": txResponseFromTransaction(tx)})
+	response := txResponseFromTransaction(tx)
+	response.Hash = txhash
+	metadata, err := ef.GetTransactionMetadata(txhash)
+	if err == nil && metadata != nil {
+		addMetadataToTxResponse(&response, metadata)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transaction": response})
+}
+
+// getTransactionsPool returns the content of the transactions pool of the node's shard. The transactions of each
+// sender are listed if the with-transactions query parameter is set, while the by-sender query parameter restricts
+// the result to the transactions of the provided sender
+func getTransactionsPool(c *gin.Context, ef TxService) {
+	withTransactions, err := strconv.ParseBool(c.DefaultQuery("with-transactions", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	pool, err := ef.GetTransactionsPool(withTransactions, c.Query("by-sender"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pool": pool})
+}
+
+func addMetadataToTxResponse(response *TxResponse, metadata *dblookupext.MiniblockMetadata) {
+	response.ShardID = metadata.ShardID
+	response.BlockNumber = metadata.HeaderNonce
+	response.BlockHash = hex.EncodeToString(metadata.HeaderHash)
+	response.MiniBlockHash = hex.EncodeToString(metadata.MiniblockHash)
+	response.Round = metadata.Round
+	response.Epoch = metadata.Epoch
+}
+
+func getTransactionWithResults(c *gin.Context, ef TxService, txhash string) {
+	tx, results, err := ef.GetTransactionWithResults(txhash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransaction.Error(), err.Error())})
+		return
+	}
+
+	if tx == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": errors.ErrTxNotFound.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transaction": txResponseFromTransaction(tx), "results": results})
 }
 
 func txResponseFromTransaction(tx *transaction.Transaction) TxResponse {
@@ -179,6 +370,8 @@ func txResponseFromTransaction(tx *transaction.Transaction) TxResponse {
 	response.Value = tx.Value
 	response.GasLimit = tx.GasLimit
 	response.GasPrice = tx.GasPrice
+	response.ChainID = string(tx.ChainID)
+	response.Version = tx.Version
 
 	return response
 }
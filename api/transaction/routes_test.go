@@ -17,6 +17,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	tr "github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -37,6 +40,34 @@ type TransactionHashResponse struct {
 	TxHash string `json:"txHash,omitempty"`
 }
 
+type ManagedNonceTxResponse struct {
+	GeneralResponse
+	TxHash string `json:"txHash,omitempty"`
+	Nonce  uint64 `json:"nonce"`
+}
+
+type MultipleTxResponse struct {
+	GeneralResponse
+	TxsSent uint64                         `json:"txsSent"`
+	Results []transaction.MultipleTxResult `json:"results"`
+}
+
+type TransactionWithResultsResponse struct {
+	GeneralResponse
+	TxResp  *transaction.TxResponse `json:"transaction,omitempty"`
+	Results *tr.TransactionResults  `json:"results,omitempty"`
+}
+
+type TransactionsPoolResponse struct {
+	GeneralResponse
+	Pool *external.TransactionsPool `json:"pool,omitempty"`
+}
+
+type SimulationResponse struct {
+	GeneralResponse
+	Result *tr.SimulationResults `json:"result,omitempty"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -56,6 +87,9 @@ func TestGetTransaction_WithCorrectHashShouldReturnTransaction(t *testing.T) {
 				Value:   value,
 			}, nil
 		},
+		GetTransactionMetadataHandler: func(hash string) (*dblookupext.MiniblockMetadata, error) {
+			return nil, process.ErrDbLookupExtensionsDisabled
+		},
 	}
 
 	req, _ := http.NewRequest("GET", "/transaction/"+hash, nil)
@@ -73,6 +107,46 @@ func TestGetTransaction_WithCorrectHashShouldReturnTransaction(t *testing.T) {
 	assert.Equal(t, hex.EncodeToString([]byte(receiver)), txResp.Receiver)
 	assert.Equal(t, value, txResp.Value)
 	assert.Equal(t, data, txResp.Data)
+	assert.Equal(t, hash, txResp.Hash)
+	assert.Equal(t, "", txResp.BlockHash)
+}
+
+func TestGetTransaction_WithMetadataShouldReturnBlockDetails(t *testing.T) {
+	hash := "hash"
+	metadata := &dblookupext.MiniblockMetadata{
+		MiniblockHash: []byte("miniblock hash"),
+		HeaderHash:    []byte("header hash"),
+		HeaderNonce:   37,
+		Round:         40,
+		Epoch:         2,
+		ShardID:       1,
+	}
+	facade := mock.Facade{
+		GetTransactionHandler: func(hash string) (i *tr.Transaction, e error) {
+			return &tr.Transaction{Value: big.NewInt(0)}, nil
+		},
+		GetTransactionMetadataHandler: func(hash string) (*dblookupext.MiniblockMetadata, error) {
+			return metadata, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/"+hash, nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	transactionResponse := TransactionResponse{}
+	loadResponse(resp.Body, &transactionResponse)
+
+	txResp := transactionResponse.TxResp
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, metadata.ShardID, txResp.ShardID)
+	assert.Equal(t, metadata.HeaderNonce, txResp.BlockNumber)
+	assert.Equal(t, hex.EncodeToString(metadata.HeaderHash), txResp.BlockHash)
+	assert.Equal(t, hex.EncodeToString(metadata.MiniblockHash), txResp.MiniBlockHash)
+	assert.Equal(t, metadata.Round, txResp.Round)
+	assert.Equal(t, metadata.Epoch, txResp.Epoch)
 }
 
 func TestGetTransaction_WithUnknownHashShouldReturnNil(t *testing.T) {
@@ -108,6 +182,89 @@ func TestGetTransaction_WithUnknownHashShouldReturnNil(t *testing.T) {
 	assert.Nil(t, transactionResponse.TxResp)
 }
 
+func TestGetTransaction_WithResultsShouldReturnTransactionAndResults(t *testing.T) {
+	t.Parallel()
+
+	sender := "sender"
+	facade := mock.Facade{
+		GetTransactionWithResultsHandler: func(hash string) (*tr.Transaction, *tr.TransactionResults, error) {
+			return &tr.Transaction{SndAddr: []byte(sender)},
+				&tr.TransactionResults{
+					Status:               tr.TxStatusSuccess,
+					SmartContractResults: []*tr.ResultsSmartContract{{Hash: "aa"}},
+				},
+				nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/hash?withResults=true", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := TransactionWithResultsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString([]byte(sender)), response.TxResp.Sender)
+	assert.Equal(t, tr.TxStatusSuccess, response.Results.Status)
+	assert.Equal(t, "aa", response.Results.SmartContractResults[0].Hash)
+}
+
+func TestGetTransaction_WithResultsUnknownHashShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionWithResultsHandler: func(hash string) (*tr.Transaction, *tr.TransactionResults, error) {
+			return nil, nil, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/hash?withResults=true", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestGetTransaction_WithResultsFacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionWithResultsHandler: func(hash string) (*tr.Transaction, *tr.TransactionResults, error) {
+			return nil, nil, errors.New("error")
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/hash?withResults=true", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := TransactionWithResultsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrGetTransaction.Error())
+}
+
+func TestGetTransaction_InvalidWithResultsParameterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	req, _ := http.NewRequest("GET", "/transaction/hash?withResults=maybe", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := TransactionWithResultsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrValidation.Error())
+}
+
 func TestGetTransaction_FailsWithWrongFacadeTypeConversion(t *testing.T) {
 	t.Parallel()
 
@@ -209,7 +366,7 @@ func TestSendTransaction_ErrorWhenFacadeSendTransactionError(t *testing.T) {
 
 	facade := mock.Facade{
 		SendTransactionHandler: func(nonce uint64, sender string, receiver string, value *big.Int,
-			gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error) {
+			gasPrice uint64, gasLimit uint64, code string, signature []byte, chainID string, version uint32) (string, error) {
 			return "", errors.New(errorString)
 		},
 	}
@@ -248,7 +405,7 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 
 	facade := mock.Facade{
 		SendTransactionHandler: func(nonce uint64, sender string, receiver string, value *big.Int,
-			gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error) {
+			gasPrice uint64, gasLimit uint64, code string, signature []byte, chainID string, version uint32) (string, error) {
 			return txHash, nil
 		},
 	}
@@ -277,6 +434,498 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, txHashResponse.TxHash, txHash)
 }
 
+func TestSendMultipleTransactions_ErrorWithWrongFacade(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	multipleTxResponse := MultipleTxResponse{}
+	loadResponse(resp.Body, &multipleTxResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors2.ErrInvalidAppContext.Error(), multipleTxResponse.Error)
+}
+
+func TestSendMultipleTransactions_WrongParametersShouldErrorOnValidation(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `[{"nonce": "not a number"}]`
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	multipleTxResponse := MultipleTxResponse{}
+	loadResponse(resp.Body, &multipleTxResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, multipleTxResponse.Error, errors2.ErrValidation.Error())
+}
+
+func TestSendMultipleTransactions_PartialAcceptanceShouldReportPerIndex(t *testing.T) {
+	t.Parallel()
+
+	errCreate := errors.New("create transaction error")
+	errValidate := errors.New("validate transaction error")
+	var sentTxs []*tr.Transaction
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64,
+			gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*tr.Transaction, error) {
+			if nonce == 1 {
+				return nil, errCreate
+			}
+			return &tr.Transaction{Nonce: nonce}, nil
+		},
+		ValidateTransactionHandler: func(tx *tr.Transaction) error {
+			if tx.Nonce == 2 {
+				return errValidate
+			}
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*tr.Transaction) (uint64, error) {
+			sentTxs = txs
+			return uint64(len(txs)), nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `[{"nonce": 0}, {"nonce": 1}, {"nonce": 2}, {"nonce": 3}]`
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	multipleTxResponse := MultipleTxResponse{}
+	loadResponse(resp.Body, &multipleTxResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, multipleTxResponse.Error)
+	assert.Equal(t, uint64(2), multipleTxResponse.TxsSent)
+	assert.Equal(t, 2, len(sentTxs))
+	assert.Equal(t, uint64(0), sentTxs[0].Nonce)
+	assert.Equal(t, uint64(3), sentTxs[1].Nonce)
+
+	expectedResults := []transaction.MultipleTxResult{
+		{Index: 0, Accepted: true},
+		{Index: 1, Accepted: false, Error: errCreate.Error()},
+		{Index: 2, Accepted: false, Error: errValidate.Error()},
+		{Index: 3, Accepted: true},
+	}
+	assert.Equal(t, expectedResults, multipleTxResponse.Results)
+}
+
+func TestSendMultipleTransactions_NoAcceptedTxShouldNotSend(t *testing.T) {
+	t.Parallel()
+
+	sendCalled := false
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64,
+			gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*tr.Transaction, error) {
+			return &tr.Transaction{Nonce: nonce}, nil
+		},
+		ValidateTransactionHandler: func(tx *tr.Transaction) error {
+			return errors.New("invalid transaction")
+		},
+		SendBulkTransactionsHandler: func(txs []*tr.Transaction) (uint64, error) {
+			sendCalled = true
+			return 0, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `[{"nonce": 0}]`
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	multipleTxResponse := MultipleTxResponse{}
+	loadResponse(resp.Body, &multipleTxResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.False(t, sendCalled)
+	assert.Equal(t, uint64(0), multipleTxResponse.TxsSent)
+	assert.Equal(t, 1, len(multipleTxResponse.Results))
+	assert.False(t, multipleTxResponse.Results[0].Accepted)
+}
+
+func TestSendMultipleTransactions_ErrorWhenFacadeSendBulkErrors(t *testing.T) {
+	t.Parallel()
+
+	errSend := errors.New("send bulk error")
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64,
+			gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*tr.Transaction, error) {
+			return &tr.Transaction{Nonce: nonce}, nil
+		},
+		ValidateTransactionHandler: func(tx *tr.Transaction) error {
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*tr.Transaction) (uint64, error) {
+			return 0, errSend
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `[{"nonce": 0}]`
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	multipleTxResponse := MultipleTxResponse{}
+	loadResponse(resp.Body, &multipleTxResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, multipleTxResponse.Error, errSend.Error())
+}
+
+func TestSendTransactionWithManagedNonce_ErrorWithWrongFacade(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("POST", "/transaction/send-with-managed-nonce", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	managedNonceTxResponse := ManagedNonceTxResponse{}
+	loadResponse(resp.Body, &managedNonceTxResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors2.ErrInvalidAppContext.Error(), managedNonceTxResponse.Error)
+}
+
+func TestSendTransactionWithManagedNonce_WrongParametersShouldErrorOnValidation(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"sender":"sender", "receiver":"receiver", "value":"ishouldbeint"}`
+	req, _ := http.NewRequest("POST", "/transaction/send-with-managed-nonce", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	managedNonceTxResponse := ManagedNonceTxResponse{}
+	loadResponse(resp.Body, &managedNonceTxResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, managedNonceTxResponse.Error, errors2.ErrValidation.Error())
+}
+
+func TestSendTransactionWithManagedNonce_ErrorWhenFacadeErrors(t *testing.T) {
+	t.Parallel()
+
+	errSend := errors.New("send error")
+	facade := mock.Facade{
+		SendTransactionWithManagedNonceHandler: func(sender string, receiver string, value *big.Int, gasPrice uint64,
+			gasLimit uint64, data string) (string, uint64, error) {
+			return "", 0, errSend
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"sender":"sender", "receiver":"receiver", "value":10}`
+	req, _ := http.NewRequest("POST", "/transaction/send-with-managed-nonce", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	managedNonceTxResponse := ManagedNonceTxResponse{}
+	loadResponse(resp.Body, &managedNonceTxResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, managedNonceTxResponse.Error, errSend.Error())
+}
+
+func TestSendTransactionWithManagedNonce_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	var receivedSender, receivedData string
+	var receivedGasLimit uint64
+	facade := mock.Facade{
+		SendTransactionWithManagedNonceHandler: func(sender string, receiver string, value *big.Int, gasPrice uint64,
+			gasLimit uint64, data string) (string, uint64, error) {
+			receivedSender = sender
+			receivedData = data
+			receivedGasLimit = gasLimit
+			return "tx hash", 7, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"sender":"sender", "receiver":"receiver", "value":10, "data":"data", "gasPrice":10, "gasLimit":100}`
+	req, _ := http.NewRequest("POST", "/transaction/send-with-managed-nonce", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	managedNonceTxResponse := ManagedNonceTxResponse{}
+	loadResponse(resp.Body, &managedNonceTxResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, managedNonceTxResponse.Error)
+	assert.Equal(t, "tx hash", managedNonceTxResponse.TxHash)
+	assert.Equal(t, uint64(7), managedNonceTxResponse.Nonce)
+	assert.Equal(t, "sender", receivedSender)
+	assert.Equal(t, "data", receivedData)
+	assert.Equal(t, uint64(100), receivedGasLimit)
+}
+
+func TestSimulateTransaction_ErrorWithWrongFacade(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("POST", "/transaction/simulate", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulationResponse := SimulationResponse{}
+	loadResponse(resp.Body, &simulationResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors2.ErrInvalidAppContext.Error(), simulationResponse.Error)
+}
+
+func TestSimulateTransaction_WrongParametersShouldErrorOnValidation(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"sender":"sender", "receiver":"receiver", "value":"ishouldbeint"}`
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulationResponse := SimulationResponse{}
+	loadResponse(resp.Body, &simulationResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulationResponse.Error, errors2.ErrValidation.Error())
+	assert.Nil(t, simulationResponse.Result)
+}
+
+func TestSimulateTransaction_ErrorWhenCreateTransactionErrors(t *testing.T) {
+	t.Parallel()
+
+	errorString := "create transaction error"
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value *big.Int, receiverHex string, senderHex string,
+			gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*tr.Transaction, error) {
+			return nil, errors.New(errorString)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"sender":"sender", "receiver":"receiver", "value":10, "signature":"aabbccdd"}`
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulationResponse := SimulationResponse{}
+	loadResponse(resp.Body, &simulationResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulationResponse.Error, errorString)
+}
+
+func TestSimulateTransaction_ErrorWhenFacadeSimulateErrors(t *testing.T) {
+	t.Parallel()
+
+	errorString := "simulate transaction error"
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value *big.Int, receiverHex string, senderHex string,
+			gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*tr.Transaction, error) {
+			return &tr.Transaction{}, nil
+		},
+		SimulateTransactionHandler: func(tx *tr.Transaction, withTrace bool) (*tr.SimulationResults, error) {
+			return nil, errors.New(errorString)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"sender":"sender", "receiver":"receiver", "value":10, "signature":"aabbccdd"}`
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulationResponse := SimulationResponse{}
+	loadResponse(resp.Body, &simulationResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, simulationResponse.Error, errors2.ErrTxSimulationFailed.Error())
+	assert.Contains(t, simulationResponse.Error, errorString)
+}
+
+func TestSimulateTransaction_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(3)
+	value := big.NewInt(10)
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value *big.Int, receiverHex string, senderHex string,
+			gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*tr.Transaction, error) {
+			return &tr.Transaction{Nonce: nonce, Value: value}, nil
+		},
+		SimulateTransactionHandler: func(tx *tr.Transaction, withTrace bool) (*tr.SimulationResults, error) {
+			return &tr.SimulationResults{
+				Status:  tr.SimulationSuccess,
+				GasUsed: tx.Nonce,
+				Fee:     tx.Value,
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := fmt.Sprintf(`{"nonce": %d, "sender":"sender", "receiver":"receiver", "value":%s, "signature":"aabbccdd"}`,
+		nonce,
+		value,
+	)
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulationResponse := SimulationResponse{}
+	loadResponse(resp.Body, &simulationResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, simulationResponse.Error)
+	assert.Equal(t, tr.SimulationSuccess, simulationResponse.Result.Status)
+	assert.Equal(t, nonce, simulationResponse.Result.GasUsed)
+	assert.Equal(t, value, simulationResponse.Result.Fee)
+}
+
+func TestSimulateTransaction_InvalidWithTraceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"sender":"sender", "receiver":"receiver", "value":10, "signature":"aabbccdd"}`
+	req, _ := http.NewRequest("POST", "/transaction/simulate?withTrace=notabool", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulationResponse := SimulationResponse{}
+	loadResponse(resp.Body, &simulationResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulationResponse.Error, errors2.ErrValidation.Error())
+}
+
+func TestSimulateTransaction_WithTraceShouldReturnTheTrace(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value *big.Int, receiverHex string, senderHex string,
+			gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*tr.Transaction, error) {
+			return &tr.Transaction{}, nil
+		},
+		SimulateTransactionHandler: func(tx *tr.Transaction, withTrace bool) (*tr.SimulationResults, error) {
+			assert.True(t, withTrace)
+			return &tr.SimulationResults{
+				Status: tr.SimulationSuccess,
+				Fee:    big.NewInt(0),
+				Trace: []*tr.SimulationTraceStep{
+					{Function: "getStorage", Arguments: []string{"aa", "01"}, Result: "02"},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"sender":"sender", "receiver":"receiver", "value":10, "signature":"aabbccdd"}`
+	req, _ := http.NewRequest("POST", "/transaction/simulate?withTrace=true", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulationResponse := SimulationResponse{}
+	loadResponse(resp.Body, &simulationResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 1, len(simulationResponse.Result.Trace))
+	assert.Equal(t, "getStorage", simulationResponse.Result.Trace[0].Function)
+}
+
+func TestGetTransactionsPool_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errorString := "pool error"
+	facade := mock.Facade{
+		GetTransactionsPoolHandler: func(withTransactions bool, senderHex string) (*external.TransactionsPool, error) {
+			return nil, errors.New(errorString)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	poolResponse := TransactionsPoolResponse{}
+	loadResponse(resp.Body, &poolResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, poolResponse.Error, errors2.ErrGetTransactionsPool.Error())
+	assert.Contains(t, poolResponse.Error, errorString)
+}
+
+func TestGetTransactionsPool_InvalidWithTransactionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?with-transactions=maybe", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	poolResponse := TransactionsPoolResponse{}
+	loadResponse(resp.Body, &poolResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, poolResponse.Error, errors2.ErrValidation.Error())
+}
+
+func TestGetTransactionsPool_ShouldPassTheQueryParameters(t *testing.T) {
+	t.Parallel()
+
+	sender := "aabb"
+	expectedPool := &external.TransactionsPool{
+		ShardID:         1,
+		NumTransactions: 1,
+		NumSenders:      1,
+		Senders: []*external.PoolSender{
+			{
+				Address:         sender,
+				NumTransactions: 1,
+				Transactions: []*external.PoolTransaction{
+					{Hash: "ccdd", Nonce: 7, GasPrice: 10, GasLimit: 50000, DestinationShard: 1},
+				},
+			},
+		},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolHandler: func(withTransactions bool, senderHex string) (*external.TransactionsPool, error) {
+			assert.True(t, withTransactions)
+			assert.Equal(t, sender, senderHex)
+			return expectedPool, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?with-transactions=true&by-sender="+sender, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	poolResponse := TransactionsPoolResponse{}
+	loadResponse(resp.Body, &poolResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, poolResponse.Error)
+	assert.Equal(t, expectedPool, poolResponse.Pool)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
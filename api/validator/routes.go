@@ -0,0 +1,79 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetAuctionList() (*external.AuctionList, error)
+	IsInterfaceNil() bool
+}
+
+type auctionNodeResponse struct {
+	BlsKey    string `json:"blsKey"`
+	Qualified bool   `json:"qualified"`
+}
+
+type auctionParticipantResponse struct {
+	Owner             string                 `json:"owner"`
+	TotalStake        string                 `json:"totalStake"`
+	TopUpPerNode      string                 `json:"topUpPerNode"`
+	NumQualifiedNodes uint64                 `json:"numQualifiedNodes"`
+	Nodes             []*auctionNodeResponse `json:"nodes"`
+}
+
+type auctionListResponse struct {
+	NodePrice    string                        `json:"nodePrice"`
+	Participants []*auctionParticipantResponse `json:"participants"`
+}
+
+// Routes defines the validators related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("/auction", GetAuctionList)
+}
+
+// GetAuctionList returns the owners which staked nodes, with their top-up per qualified node and the nodes which
+// would be qualified at the start of the next epoch
+func GetAuctionList(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	auctionList, err := ef.GetAuctionList()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetAuctionList.Error(), err.Error())})
+		return
+	}
+
+	response := auctionListResponse{
+		NodePrice:    auctionList.NodePrice,
+		Participants: make([]*auctionParticipantResponse, 0, len(auctionList.Participants)),
+	}
+	for _, participant := range auctionList.Participants {
+		nodes := make([]*auctionNodeResponse, 0, len(participant.Nodes))
+		for _, node := range participant.Nodes {
+			nodes = append(nodes, &auctionNodeResponse{
+				BlsKey:    node.BlsKey,
+				Qualified: node.Qualified,
+			})
+		}
+
+		response.Participants = append(response.Participants, &auctionParticipantResponse{
+			Owner:             participant.Owner,
+			TotalStake:        participant.TotalStake,
+			TopUpPerNode:      participant.TopUpPerNode,
+			NumQualifiedNodes: participant.NumQualifiedNodes,
+			Nodes:             nodes,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{"auctionList": response})
+}
@@ -0,0 +1,135 @@
+package validator_test
+
+import (
+	"encoding/json"
+	errs "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type auctionListResponse struct {
+	AuctionList struct {
+		NodePrice    string `json:"nodePrice"`
+		Participants []struct {
+			Owner             string `json:"owner"`
+			TotalStake        string `json:"totalStake"`
+			TopUpPerNode      string `json:"topUpPerNode"`
+			NumQualifiedNodes uint64 `json:"numQualifiedNodes"`
+			Nodes             []struct {
+				BlsKey    string `json:"blsKey"`
+				Qualified bool   `json:"qualified"`
+			} `json:"nodes"`
+		} `json:"participants"`
+	} `json:"auctionList"`
+	Error string `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func startValidatorServer(facade interface{}) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", facade)
+	})
+
+	validatorRoutes := ws.Group("/validator")
+	validator.Routes(validatorRoutes)
+	return ws
+}
+
+//------- GetAuctionList
+
+func TestGetAuctionList_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startValidatorServer(mock.WrongFacade{})
+	req, _ := http.NewRequest("GET", "/validator/auction", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := auctionListResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestGetAuctionList_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetAuctionListHandler: func() (*external.AuctionList, error) {
+			return nil, errExpected
+		},
+	}
+
+	ws := startValidatorServer(&facade)
+	req, _ := http.NewRequest("GET", "/validator/auction", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := auctionListResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors.ErrGetAuctionList.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestGetAuctionList_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAuctionListHandler: func() (*external.AuctionList, error) {
+			return &external.AuctionList{
+				NodePrice: "100",
+				Participants: []*external.AuctionParticipant{
+					{
+						Owner:             "aa",
+						TotalStake:        "150",
+						TopUpPerNode:      "50",
+						NumQualifiedNodes: 1,
+						Nodes: []*external.AuctionNode{
+							{BlsKey: "bb", Qualified: true},
+							{BlsKey: "cc", Qualified: false},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	ws := startValidatorServer(&facade)
+	req, _ := http.NewRequest("GET", "/validator/auction", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := auctionListResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "100", response.AuctionList.NodePrice)
+	assert.Equal(t, 1, len(response.AuctionList.Participants))
+	participant := response.AuctionList.Participants[0]
+	assert.Equal(t, "aa", participant.Owner)
+	assert.Equal(t, "150", participant.TotalStake)
+	assert.Equal(t, "50", participant.TopUpPerNode)
+	assert.Equal(t, uint64(1), participant.NumQualifiedNodes)
+	assert.Equal(t, 2, len(participant.Nodes))
+	assert.Equal(t, "bb", participant.Nodes[0].BlsKey)
+	assert.True(t, participant.Nodes[0].Qualified)
+	assert.False(t, participant.Nodes[1].Qualified)
+}
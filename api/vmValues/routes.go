@@ -8,20 +8,25 @@ import (
 	"net/http"
 
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
 
 // FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
 type FacadeHandler interface {
 	GetVmValue(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
+	GetVmValueAtBlock(address string, funcName string, blockCoordinates external.BlockCoordinates, argsBuff ...[]byte) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
-// VmValueRequest represents the structure on which user input for generating a new transaction will validate against
+// VmValueRequest represents the structure on which user input for generating a new transaction will validate against.
+// The optional block nonce or block hash run the query against the state committed by that block
 type VmValueRequest struct {
-	ScAddress string   `form:"scAddress" json:"scAddress"`
-	FuncName  string   `form:"funcName" json:"funcName"`
-	Args      []string `form:"args"  json:"args"`
+	ScAddress  string   `form:"scAddress" json:"scAddress"`
+	FuncName   string   `form:"funcName" json:"funcName"`
+	Args       []string `form:"args"  json:"args"`
+	BlockNonce *uint64  `form:"blockNonce" json:"blockNonce,omitempty"`
+	BlockHash  string   `form:"blockHash" json:"blockHash,omitempty"`
 }
 
 // Routes defines address related routes
@@ -62,6 +67,11 @@ func vmValueFromAccount(c *gin.Context) ([]byte, int, error) {
 			errors.New(fmt.Sprintf("'%s' is not a valid hex string: %s", gval.ScAddress, err.Error()))
 	}
 
+	isHistoricalQuery := gval.BlockNonce != nil || len(gval.BlockHash) > 0
+	if isHistoricalQuery {
+		return vmValueAtBlock(ef, gval, string(adrBytes), argsBuff)
+	}
+
 	returnedData, err := ef.GetVmValue(string(adrBytes), gval.FuncName, argsBuff...)
 	if err != nil {
 		return nil, http.StatusBadRequest, err
@@ -70,6 +80,29 @@ func vmValueFromAccount(c *gin.Context) ([]byte, int, error) {
 	return returnedData, http.StatusOK, nil
 }
 
+func vmValueAtBlock(ef FacadeHandler, gval VmValueRequest, address string, argsBuff [][]byte) ([]byte, int, error) {
+	blockCoordinates := external.BlockCoordinates{}
+	if gval.BlockNonce != nil {
+		blockCoordinates.Nonce = *gval.BlockNonce
+	}
+	if len(gval.BlockHash) > 0 {
+		blockHash, err := hex.DecodeString(gval.BlockHash)
+		if err != nil {
+			return nil,
+				http.StatusBadRequest,
+				errors.New(fmt.Sprintf("'%s' is not a valid hex string: %s", gval.BlockHash, err.Error()))
+		}
+		blockCoordinates.Hash = blockHash
+	}
+
+	returnedData, err := ef.GetVmValueAtBlock(address, gval.FuncName, blockCoordinates, argsBuff...)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	return returnedData, http.StatusOK, nil
+}
+
 // GetVmValueAsHexBytes returns the data as byte slice
 func GetVmValueAsHexBytes(c *gin.Context) {
 	data, status, err := vmValueFromAccount(c)
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/json"
@@ -370,3 +371,69 @@ func TestGetDataValueAsInt_WithParametersShouldReturnValueAsHex(t *testing.T) {
 	assert.Equal(t, "", response.Error)
 	assert.Equal(t, valueBuff, response.Data)
 }
+
+//------- GetDataValueAtBlock
+
+func TestGetDataValueAsHexBytes_AtBlockShouldPassTheBlockCoordinates(t *testing.T) {
+	t.Parallel()
+
+	blockHash := []byte("block hash")
+	valueBuff, _ := hex.DecodeString("DEADBEEF")
+
+	facade := mock.Facade{
+		GetDataValueHandler: func(address string, funcName string, argsBuff ...[]byte) (bytes []byte, e error) {
+			assert.Fail(t, "should have queried at the provided block")
+			return nil, nil
+		},
+		GetDataValueAtBlockHandler: func(address string, funcName string, blockCoordinates external.BlockCoordinates, argsBuff ...[]byte) ([]byte, error) {
+			assert.Equal(t, uint64(7), blockCoordinates.Nonce)
+			assert.Equal(t, blockHash, blockCoordinates.Hash)
+			return valueBuff, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	jsonStr := fmt.Sprintf(`{"scAddress":"aaaa", "funcName":"function", "blockNonce":7, "blockHash":"%s"}`,
+		hex.EncodeToString(blockHash))
+	fmt.Printf("Request: %s\n", jsonStr)
+
+	req, _ := http.NewRequest("POST", "/get-values/hex", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, hex.EncodeToString(valueBuff), response.Data)
+}
+
+func TestGetDataValueAsHexBytes_AtBlockHashNotHexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetDataValueAtBlockHandler: func(address string, funcName string, blockCoordinates external.BlockCoordinates, argsBuff ...[]byte) ([]byte, error) {
+			assert.Fail(t, "should have not queried")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"scAddress":"aaaa", "funcName":"function", "blockHash":"not hex"}`
+	fmt.Printf("Request: %s\n", jsonStr)
+
+	req, _ := http.NewRequest("POST", "/get-values/hex", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.NotEqual(t, "", response.Error)
+}
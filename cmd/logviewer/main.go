@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/gorilla/websocket"
+	"github.com/urfave/cli"
+)
+
+const wsLogPath = "/log"
+
+var (
+	logViewerHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// address defines the node's REST API address (host:port) from which the logs will be streamed
+	address = cli.StringFlag{
+		Name:  "address",
+		Usage: "The node's REST API address (host:port) from which the logs will be streamed",
+		Value: "127.0.0.1:8080",
+	}
+	// logLevel defines the level patterns used to filter the received log lines
+	logLevel = cli.StringFlag{
+		Name: "logLevel",
+		Usage: "This flag specifies the level(s) of the received log lines. It can contain multiple comma-separated " +
+			"pattern:LEVEL entries, where the pattern is a package path, optionally ended in *. Example: *:INFO,process/*:DEBUG",
+		Value: "*:" + logger.LogInfo,
+	}
+	// useWss defines a flag that makes the connection use a secure websocket
+	useWss = cli.BoolFlag{
+		Name:  "use-wss",
+		Usage: "Will use wss instead of ws when connecting to the node",
+	}
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = logViewerHelpTemplate
+	app.Name = "Elrond Logviewer App"
+	app.Version = "v0.0.1"
+	app.Usage = "This binary streams the logs of a running Elrond node, through its REST API"
+	app.Flags = []cli.Flag{address, logLevel, useWss}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startLogViewer(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startLogViewer(ctx *cli.Context) error {
+	scheme := "ws"
+	if ctx.GlobalBool(useWss.Name) {
+		scheme = "wss"
+	}
+	u := url.URL{Scheme: scheme, Host: ctx.GlobalString(address.Name), Path: wsLogPath}
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err = conn.WriteMessage(websocket.TextMessage, []byte(ctx.GlobalString(logLevel.Name)))
+	if err != nil {
+		return err
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	done := make(chan error, 1)
+	go func() {
+		for {
+			_, line, errRead := conn.ReadMessage()
+			if errRead != nil {
+				done <- errRead
+				return
+			}
+
+			fmt.Print(string(line))
+		}
+	}()
+
+	select {
+	case err = <-done:
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			return nil
+		}
+		return err
+	case <-sigs:
+		_ = conn.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+			time.Now().Add(time.Second),
+		)
+		return nil
+	}
+}
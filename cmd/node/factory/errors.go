@@ -0,0 +1,21 @@
+package factory
+
+import "errors"
+
+// ErrInvalidCloseTimeout signals that an invalid close timeout has been provided
+var ErrInvalidCloseTimeout = errors.New("invalid close timeout")
+
+// ErrNilCloser signals that a nil closer has been provided
+var ErrNilCloser = errors.New("nil closer")
+
+// ErrCloseTimeout signals that the components could not be closed before the close timeout expired
+var ErrCloseTimeout = errors.New("timeout while closing the components")
+
+// ErrNilProcessComponentsFactoryArgs signals that nil process components factory arguments have been provided
+var ErrNilProcessComponentsFactoryArgs = errors.New("nil process components factory arguments")
+
+// ErrProcessComponentsAlreadyCreated signals that the process components were already created
+var ErrProcessComponentsAlreadyCreated = errors.New("process components already created")
+
+// ErrNilConfig signals that a nil config has been provided
+var ErrNilConfig = errors.New("nil config")
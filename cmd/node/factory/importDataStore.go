@@ -0,0 +1,58 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// CreateImportDataStore opens the storage units of the import database which hold the blocks of a shard node.
+// The import database is only read, so, unlike the node's own storage units, its units are closed and never
+// destroyed if one of them can not be opened. The units have no bloom filters, as a new bloom filter does not know
+// the keys already saved in the database
+func CreateImportDataStore(
+	cfg *config.Config,
+	shardCoordinator sharding.Coordinator,
+	importDBPath string,
+	storageEncryptionKey []byte,
+) (dataRetriever.StorageService, error) {
+	hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardCoordinator.SelfId())
+	storersConfig := map[dataRetriever.UnitType]config.StorageConfig{
+		dataRetriever.TransactionUnit:          cfg.TxStorage,
+		dataRetriever.UnsignedTransactionUnit:  cfg.UnsignedTransactionStorage,
+		dataRetriever.RewardTransactionUnit:    cfg.RewardTxStorage,
+		dataRetriever.MiniBlockUnit:            cfg.MiniBlocksStorage,
+		dataRetriever.BlockHeaderUnit:          cfg.BlockHeaderStorage,
+		dataRetriever.MetaBlockUnit:            cfg.MetaBlockStorage,
+		dataRetriever.MetaHdrNonceHashDataUnit: cfg.MetaHdrNonceHashStorage,
+	}
+
+	store := dataRetriever.NewChainStorer()
+	for unitType, storageConfig := range storersConfig {
+		unit, err := storageUnit.NewStorageUnitFromConf(
+			getCacherFromConfig(storageConfig.Cache),
+			getDBFromConfig(storageConfig.DB, importDBPath, storageEncryptionKey),
+			storageUnit.BloomConfig{})
+		if err != nil {
+			_ = store.CloseAll()
+			return nil, err
+		}
+
+		store.AddStorer(unitType, unit)
+	}
+
+	shardHdrHashNonceUnit, err := storageUnit.NewShardedStorageUnitFromConf(
+		getCacherFromConfig(cfg.ShardHdrNonceHashStorage.Cache),
+		getDBFromConfig(cfg.ShardHdrNonceHashStorage.DB, importDBPath, storageEncryptionKey),
+		storageUnit.BloomConfig{},
+		shardCoordinator.SelfId(),
+	)
+	if err != nil {
+		_ = store.CloseAll()
+		return nil, err
+	}
+	store.AddStorer(hdrNonceHashDataUnit, shardHdrHashNonceUnit)
+
+	return store, nil
+}
@@ -0,0 +1,122 @@
+package factory
+
+import (
+	"sync"
+	"time"
+)
+
+// Closer defines a long running component which has to release its resources when the node shuts down
+type Closer interface {
+	Close() error
+}
+
+// CloserFunc adapts a function to the Closer interface
+type CloserFunc func() error
+
+// Close calls the adapted function
+func (f CloserFunc) Close() error {
+	return f()
+}
+
+type closableComponent struct {
+	name   string
+	closer Closer
+}
+
+// LifecycleManager holds the long running components of the node and closes them when the node shuts down. The
+// components are registered in their creation order, so each component is registered after its dependencies, and
+// they are closed in the reverse order: a component is closed before the components it uses
+type LifecycleManager struct {
+	mutComponents sync.Mutex
+	components    []*closableComponent
+	closeTimeout  time.Duration
+	isClosed      bool
+}
+
+// NewLifecycleManager creates a new lifecycle manager which waits at most closeTimeout for the components to close
+func NewLifecycleManager(closeTimeout time.Duration) (*LifecycleManager, error) {
+	if closeTimeout <= 0 {
+		return nil, ErrInvalidCloseTimeout
+	}
+
+	return &LifecycleManager{
+		components:   make([]*closableComponent, 0),
+		closeTimeout: closeTimeout,
+	}, nil
+}
+
+// Register adds a component which will be closed on shutdown. A component registered after Close was called is
+// closed right away and its close error is returned
+func (lm *LifecycleManager) Register(name string, closer Closer) error {
+	if closer == nil {
+		return ErrNilCloser
+	}
+
+	component := &closableComponent{
+		name:   name,
+		closer: closer,
+	}
+
+	lm.mutComponents.Lock()
+	isClosed := lm.isClosed
+	if !isClosed {
+		lm.components = append(lm.components, component)
+	}
+	lm.mutComponents.Unlock()
+
+	if isClosed {
+		return closeComponent(component)
+	}
+
+	return nil
+}
+
+// Close closes all the registered components, in the reverse order of their registration. It returns ErrCloseTimeout
+// if the components did not close before the close timeout, otherwise the last error returned by a component
+func (lm *LifecycleManager) Close() error {
+	lm.mutComponents.Lock()
+	if lm.isClosed {
+		lm.mutComponents.Unlock()
+		return nil
+	}
+	lm.isClosed = true
+	components := lm.components
+	lm.components = nil
+	lm.mutComponents.Unlock()
+
+	chanErr := make(chan error, 1)
+	go func() {
+		chanErr <- closeComponents(components)
+	}()
+
+	select {
+	case err := <-chanErr:
+		return err
+	case <-time.After(lm.closeTimeout):
+		log.Error("components were not closed in time", "timeout", lm.closeTimeout)
+		return ErrCloseTimeout
+	}
+}
+
+func closeComponents(components []*closableComponent) error {
+	var lastErr error
+	for i := len(components) - 1; i >= 0; i-- {
+		err := closeComponent(components[i])
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+func closeComponent(component *closableComponent) error {
+	log.Debug("closing component", "name", component.name)
+
+	err := component.closer.Close()
+	if err != nil {
+		log.Warn("cannot close component", "name", component.name, "error", err.Error())
+	}
+
+	return err
+}
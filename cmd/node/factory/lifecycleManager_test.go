@@ -0,0 +1,102 @@
+package factory_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLifecycleManager_InvalidCloseTimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lm, err := factory.NewLifecycleManager(0)
+	assert.Nil(t, lm)
+	assert.Equal(t, factory.ErrInvalidCloseTimeout, err)
+}
+
+func TestLifecycleManager_RegisterNilCloserShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lm, _ := factory.NewLifecycleManager(time.Second)
+	err := lm.Register("component", nil)
+	assert.Equal(t, factory.ErrNilCloser, err)
+}
+
+func TestLifecycleManager_CloseShouldCloseInReverseOrder(t *testing.T) {
+	t.Parallel()
+
+	lm, _ := factory.NewLifecycleManager(time.Second)
+
+	closed := make([]string, 0)
+	newCloser := func(name string) factory.CloserFunc {
+		return func() error {
+			closed = append(closed, name)
+			return nil
+		}
+	}
+	_ = lm.Register("storage", newCloser("storage"))
+	_ = lm.Register("messenger", newCloser("messenger"))
+	_ = lm.Register("node", newCloser("node"))
+
+	err := lm.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"node", "messenger", "storage"}, closed)
+
+	err = lm.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(closed))
+}
+
+func TestLifecycleManager_CloseShouldCloseAllAndReturnTheError(t *testing.T) {
+	t.Parallel()
+
+	lm, _ := factory.NewLifecycleManager(time.Second)
+
+	expectedErr := errors.New("expected error")
+	storageClosed := false
+	_ = lm.Register("storage", factory.CloserFunc(func() error {
+		storageClosed = true
+		return nil
+	}))
+	_ = lm.Register("node", factory.CloserFunc(func() error {
+		return expectedErr
+	}))
+
+	err := lm.Close()
+	assert.Equal(t, expectedErr, err)
+	assert.True(t, storageClosed)
+}
+
+func TestLifecycleManager_CloseShouldTimeout(t *testing.T) {
+	t.Parallel()
+
+	lm, _ := factory.NewLifecycleManager(10 * time.Millisecond)
+
+	chanRelease := make(chan struct{})
+	defer close(chanRelease)
+	_ = lm.Register("stuck", factory.CloserFunc(func() error {
+		<-chanRelease
+		return nil
+	}))
+
+	err := lm.Close()
+	assert.Equal(t, factory.ErrCloseTimeout, err)
+}
+
+func TestLifecycleManager_RegisterAfterCloseShouldCloseTheComponent(t *testing.T) {
+	t.Parallel()
+
+	lm, _ := factory.NewLifecycleManager(time.Second)
+	_ = lm.Close()
+
+	closed := false
+	err := lm.Register("late", factory.CloserFunc(func() error {
+		closed = true
+		return nil
+	}))
+	assert.Nil(t, err)
+	assert.True(t, closed)
+}
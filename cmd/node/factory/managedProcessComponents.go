@@ -0,0 +1,139 @@
+package factory
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
+	processFactory "github.com/ElrondNetwork/elrond-go/process/factory"
+)
+
+// ManagedProcessComponents creates the process components and can re-create them while the node is running, for
+// example when a hardfork changes the processing rules. The message processors registered on the network topics by
+// the current components are released before the new components are created
+type ManagedProcessComponents struct {
+	mutProcess sync.RWMutex
+	args       *processComponentsFactoryArgs
+	process    *Process
+}
+
+// NewManagedProcessComponents creates a new managed process components holder, which creates the process components
+// from the provided arguments
+func NewManagedProcessComponents(args *processComponentsFactoryArgs) (*ManagedProcessComponents, error) {
+	if args == nil {
+		return nil, ErrNilProcessComponentsFactoryArgs
+	}
+
+	return &ManagedProcessComponents{
+		args: args,
+	}, nil
+}
+
+// Create creates the process components
+func (mpc *ManagedProcessComponents) Create() error {
+	mpc.mutProcess.Lock()
+	defer mpc.mutProcess.Unlock()
+
+	if mpc.process != nil {
+		return ErrProcessComponentsAlreadyCreated
+	}
+
+	return mpc.createProcessComponents()
+}
+
+// Recreate releases the current process components, if any, and creates new ones from the same arguments
+func (mpc *ManagedProcessComponents) Recreate() error {
+	mpc.mutProcess.Lock()
+	defer mpc.mutProcess.Unlock()
+
+	err := mpc.releaseProcessComponents()
+	if err != nil {
+		return err
+	}
+
+	return mpc.createProcessComponents()
+}
+
+// Reload releases the current process components, if any, and creates new ones with the processing settings of the
+// provided configuration, for example the one reloaded for a hardfork. The other arguments stay the same
+func (mpc *ManagedProcessComponents) Reload(generalConfig *config.Config) error {
+	if generalConfig == nil {
+		return ErrNilConfig
+	}
+
+	mpc.mutProcess.Lock()
+	defer mpc.mutProcess.Unlock()
+
+	err := mpc.releaseProcessComponents()
+	if err != nil {
+		return err
+	}
+
+	args := *mpc.args
+	args.builtInFunctions = generalConfig.BuiltInFunctions
+	args.enableRounds = generalConfig.EnableRounds
+	args.outgoingOperationsConfig = generalConfig.OutgoingOperations
+	args.selectionAuditConfig = generalConfig.ValidatorsSelectionAudit
+	args.interceptorsLimitsConfig = generalConfig.InterceptorsLimits
+	args.resolversAntiflood = generalConfig.ResolversAntiflood
+	args.supplyAccountingConfig = generalConfig.SupplyAccounting
+	args.vmExecutionGuardConfig = generalConfig.VMExecutionGuard
+	mpc.args = &args
+
+	return mpc.createProcessComponents()
+}
+
+// ProcessComponents returns the current process components, or nil if they were not created
+func (mpc *ManagedProcessComponents) ProcessComponents() *Process {
+	mpc.mutProcess.RLock()
+	defer mpc.mutProcess.RUnlock()
+
+	return mpc.process
+}
+
+// Close releases the message processors registered by the current process components, so no more messages reach them
+func (mpc *ManagedProcessComponents) Close() error {
+	mpc.mutProcess.Lock()
+	defer mpc.mutProcess.Unlock()
+
+	return mpc.releaseProcessComponents()
+}
+
+func (mpc *ManagedProcessComponents) createProcessComponents() error {
+	process, err := ProcessComponentsFactory(mpc.args)
+	if err != nil {
+		return err
+	}
+
+	mpc.process = process
+	return nil
+}
+
+func (mpc *ManagedProcessComponents) releaseProcessComponents() error {
+	if mpc.process == nil {
+		return nil
+	}
+
+	messenger := mpc.args.network.NetMessenger
+	for _, topic := range mpc.process.InterceptorsContainer.Keys() {
+		err := messenger.UnregisterMessageProcessor(topic)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, topic := range mpc.process.ResolversFinder.Keys() {
+		err := messenger.UnregisterMessageProcessor(topic + topicResolverSender.TopicRequestSuffix)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := messenger.UnregisterMessageProcessor(processFactory.RoundActivationTopic)
+	if err != nil {
+		return err
+	}
+
+	mpc.process = nil
+	return nil
+}
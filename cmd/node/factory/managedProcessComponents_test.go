@@ -0,0 +1,86 @@
+package factory_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewManagedProcessComponents_NilArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mpc, err := factory.NewManagedProcessComponents(nil)
+	assert.Nil(t, mpc)
+	assert.Equal(t, factory.ErrNilProcessComponentsFactoryArgs, err)
+}
+
+func TestManagedProcessComponents_NotCreatedShouldNotHoldComponents(t *testing.T) {
+	t.Parallel()
+
+	args := factory.NewProcessComponentsFactoryArgs(
+		nil, nil, nil, nil, nil, nil,
+		&factory.Data{},
+		&factory.Core{},
+		&factory.Crypto{},
+		&factory.State{},
+		&factory.Network{},
+		nil,
+		config.BuiltInFunctionsConfig{},
+		config.RoundConfig{},
+		nil,
+		nil,
+		config.OutgoingOperationsConfig{},
+		nil,
+		nil,
+		config.ValidatorsSelectionAuditConfig{},
+		config.InterceptorsLimitsConfig{},
+		config.ResolversAntifloodConfig{},
+		config.SupplyAccountingConfig{},
+		config.ESDTSupplyTrackingConfig{},
+		config.PeerAuthenticationConfig{},
+		config.VMExecutionGuardConfig{},
+		nil,
+	)
+	mpc, err := factory.NewManagedProcessComponents(args)
+	assert.Nil(t, err)
+	assert.Nil(t, mpc.ProcessComponents())
+
+	err = mpc.Close()
+	assert.Nil(t, err)
+}
+
+func TestManagedProcessComponents_ReloadNilConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := factory.NewProcessComponentsFactoryArgs(
+		nil, nil, nil, nil, nil, nil,
+		&factory.Data{},
+		&factory.Core{},
+		&factory.Crypto{},
+		&factory.State{},
+		&factory.Network{},
+		nil,
+		config.BuiltInFunctionsConfig{},
+		config.RoundConfig{},
+		nil,
+		nil,
+		config.OutgoingOperationsConfig{},
+		nil,
+		nil,
+		config.ValidatorsSelectionAuditConfig{},
+		config.InterceptorsLimitsConfig{},
+		config.ResolversAntifloodConfig{},
+		config.SupplyAccountingConfig{},
+		config.ESDTSupplyTrackingConfig{},
+		config.PeerAuthenticationConfig{},
+		config.VMExecutionGuardConfig{},
+		nil,
+	)
+	mpc, _ := factory.NewManagedProcessComponents(args)
+
+	err := mpc.Reload(nil)
+	assert.Equal(t, factory.ErrNilConfig, err)
+	assert.Nil(t, mpc.ProcessComponents())
+}
@@ -16,10 +16,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/alarm"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/genesis"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
+	"github.com/ElrondNetwork/elrond-go/core/statistics/machine"
 	"github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion"
 	factorySoftawareVersion "github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion/factory"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -36,17 +39,22 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state/addressConverters"
 	factoryState "github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/trieProfiler"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/headersPool"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/poolsCapacity"
 	metafactoryDataRetriever "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/metachain"
 	shardfactoryDataRetriever "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/shard"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -56,18 +64,33 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/pendingMb"
 	"github.com/ElrondNetwork/elrond-go/process/block/poolsCleaner"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/outgoingOperations"
+	"github.com/ElrondNetwork/elrond-go/process/peerAuthentication"
+	"github.com/ElrondNetwork/elrond-go/process/peerHonesty"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
+	"github.com/ElrondNetwork/elrond-go/process/selectionAudit"
+	"github.com/ElrondNetwork/elrond-go/process/sigVerifier"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/supply"
 	processSync "github.com/ElrondNetwork/elrond-go/process/sync"
+	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/sharding/networksharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	factoryViews "github.com/ElrondNetwork/elrond-go/statusHandler/factory"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/view"
@@ -93,17 +116,41 @@ const (
 	MaxTxsToRequest = 100
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("cmd/node/factory")
 
 const maxTxNonceDeltaAllowed = 15000
 
+// headerTimestampTolerance is the accepted deviation of a header's timestamp from the start time of its round
+const headerTimestampTolerance = time.Second
+
+// headerSoftwareVersion is set on the proposed headers. The headers holding another version are rejected, so it has to
+// change only together with the headers format
+const headerSoftwareVersion = "1"
+
+// txSignHashingDomain separates the hashes computed for the transactions signatures from any other hashes
+const txSignHashingDomain = "TxSignature"
+
+const (
+	jsonMarshalizer      = "json"
+	gogoProtoMarshalizer = "gogo protobuf"
+)
+
+const (
+	// maxChunksPerPayload limits the payloads received in chunks to 32MB with the core.MaxChunkSize chunks
+	maxChunksPerPayload         = 64
+	maxPendingPayloadsPerSource = 10
+	chunkedPayloadTimeout       = time.Minute
+)
+
 // ErrCreateForkDetector signals that a fork detector could not be created
 //TODO: Extract all others error messages from this file in some defined errors
 var ErrCreateForkDetector = errors.New("could not create fork detector")
 
 // Network struct holds the network components of the Elrond protocol
 type Network struct {
-	NetMessenger p2p.Messenger
+	NetMessenger            p2p.Messenger
+	PeerHonesty             *peerHonesty.PeerHonesty
+	PrivateNetworkProtector p2p.PrivateNetworkProtector
 }
 
 // Core struct holds the core components of the Elrond protocol
@@ -111,55 +158,74 @@ type Core struct {
 	Hasher                   hashing.Hasher
 	Marshalizer              marshal.Marshalizer
 	Trie                     data.Trie
+	TrieStatistics           *trieProfiler.TrieStatistics
+	TrieStorer               storage.Storer
+	TrieStorerEpochHandler   core.EpochSubscriberHandler
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	StatusHandler            core.AppStatusHandler
+	AlarmScheduler           core.TimersScheduler
+	ChainID                  []byte
 }
 
 // State struct holds the state components of the Elrond protocol
 type State struct {
-	AddressConverter  state.AddressConverter
-	AccountsAdapter   state.AccountsAdapter
-	InBalanceForShard map[string]*big.Int
+	AddressConverter   state.AddressConverter
+	AccountsAdapter    state.AccountsAdapter
+	HistoricalAccounts state.HistoricalAccountsProvider
+	InBalanceForShard  map[string]*big.Int
 }
 
 // Data struct holds the data components of the Elrond protocol
 type Data struct {
-	Blkc         data.ChainHandler
-	Store        dataRetriever.StorageService
-	Datapool     dataRetriever.PoolsHolder
-	MetaDatapool dataRetriever.MetaPoolsHolder
+	Blkc              data.ChainHandler
+	Store             dataRetriever.StorageService
+	Datapool          dataRetriever.PoolsHolder
+	MetaDatapool      dataRetriever.MetaPoolsHolder
+	HistoryRepository dblookupext.HistoryRepository
+	PoolsCapacity     *poolsCapacity.CapacityController
 }
 
 // Crypto struct holds the crypto components of the Elrond protocol
 type Crypto struct {
-	TxSingleSigner crypto.SingleSigner
-	SingleSigner   crypto.SingleSigner
-	MultiSigner    crypto.MultiSigner
-	TxSignKeyGen   crypto.KeyGenerator
-	TxSignPrivKey  crypto.PrivateKey
-	TxSignPubKey   crypto.PublicKey
-	InitialPubKeys map[uint32][]string
+	TxSingleSigner  crypto.SingleSigner
+	SingleSigner    crypto.SingleSigner
+	MultiSigner     crypto.MultiSigner
+	BlockSignKeyGen crypto.KeyGenerator
+	TxSignKeyGen    crypto.KeyGenerator
+	TxSignPrivKey   crypto.PrivateKey
+	TxSignPubKey    crypto.PublicKey
+	InitialPubKeys  map[uint32][]string
+	SigVerifier     *sigVerifier.SigVerifier
 }
 
 // Process struct holds the process components of the Elrond protocol
 type Process struct {
-	InterceptorsContainer process.InterceptorsContainer
-	ResolversFinder       dataRetriever.ResolversFinder
-	Rounder               consensus.Rounder
-	ForkDetector          process.ForkDetector
-	BlockProcessor        process.BlockProcessor
+	InterceptorsContainer  process.InterceptorsContainer
+	ResolversFinder        dataRetriever.ResolversFinder
+	Rounder                consensus.Rounder
+	ForkDetector           process.ForkDetector
+	BlockProcessor         process.BlockProcessor
+	PendingMiniBlocks      process.PendingMiniBlocksHandler
+	RoundActivationHandler core.RoundActivationHandler
+	OutgoingOperations     process.OutgoingOperationsHandler
+	SelectionAuditor       process.ValidatorsSelectionAuditor
+	SupplyAccountant       process.SupplyAccountant
+	ESDTSupplyTracker      process.ESDTSupplyTracker
+	DataLimitsChecker      process.InterceptedDataLimitsChecker
 }
 
 type coreComponentsFactoryArgs struct {
-	config   *config.Config
-	uniqueID string
+	config               *config.Config
+	uniqueID             string
+	storageEncryptionKey []byte
 }
 
 // NewCoreComponentsFactoryArgs initializes the arguments necessary for creating the core components
-func NewCoreComponentsFactoryArgs(config *config.Config, uniqueID string) *coreComponentsFactoryArgs {
+func NewCoreComponentsFactoryArgs(config *config.Config, uniqueID string, storageEncryptionKey []byte) *coreComponentsFactoryArgs {
 	return &coreComponentsFactoryArgs{
-		config:   config,
-		uniqueID: uniqueID,
+		config:               config,
+		uniqueID:             uniqueID,
+		storageEncryptionKey: storageEncryptionKey,
 	}
 }
 
@@ -175,7 +241,17 @@ func CoreComponentsFactory(args *coreComponentsFactoryArgs) (*Core, error) {
 		return nil, errors.New("could not create marshalizer: " + err.Error())
 	}
 
-	merkleTrie, err := getTrie(args.config.AccountsTrieStorage, marshalizer, hasher, args.uniqueID)
+	trieStorer, trieStorerEpochHandler, err := getTrieStorer(
+		args.config.AccountsTrieStorage,
+		args.config.AccountsTrieTiering,
+		args.uniqueID,
+		args.storageEncryptionKey,
+	)
+	if err != nil {
+		return nil, errors.New("error creating trie: " + err.Error())
+	}
+
+	merkleTrie, trieStatistics, err := createAccountsTrie(args.config.TrieProfiling, trieStorer, marshalizer, hasher)
 	if err != nil {
 		return nil, errors.New("error creating trie: " + err.Error())
 	}
@@ -185,11 +261,55 @@ func CoreComponentsFactory(args *coreComponentsFactoryArgs) (*Core, error) {
 		Hasher:                   hasher,
 		Marshalizer:              marshalizer,
 		Trie:                     merkleTrie,
+		TrieStatistics:           trieStatistics,
+		TrieStorer:               trieStorer,
+		TrieStorerEpochHandler:   trieStorerEpochHandler,
 		Uint64ByteSliceConverter: uint64ByteSliceConverter,
 		StatusHandler:            statusHandler.NewNilStatusHandler(),
+		AlarmScheduler:           alarm.NewAlarmScheduler(),
+		ChainID:                  []byte(args.config.GeneralSettings.NetworkID),
 	}, nil
 }
 
+// createAccountsTrie creates the accounts trie and, if the trie profiling is enabled, the statistics in which the
+// profiled trie records its operations
+func createAccountsTrie(
+	profilingConfig config.TrieProfilingConfig,
+	trieStorer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (data.Trie, *trieProfiler.TrieStatistics, error) {
+	if !profilingConfig.Enabled {
+		merkleTrie, err := trie.NewTrie(trieStorer, marshalizer, hasher)
+		return merkleTrie, nil, err
+	}
+
+	trieStatistics, err := trieProfiler.NewTrieStatistics(
+		time.Duration(profilingConfig.SlowOperationThresholdInMs)*time.Millisecond,
+		profilingConfig.NumTopKeys,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	countingDB, err := trieProfiler.NewCountingDB(trieStorer, trieStatistics)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	merkleTrie, err := trie.NewTrie(countingDB, marshalizer, hasher)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	profiledTrie, err := trieProfiler.NewTrieProfiler(merkleTrie, trieStatistics)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return profiledTrie, trieStatistics, nil
+}
+
 type stateComponentsFactoryArgs struct {
 	config           *config.Config
 	genesisConfig    *sharding.Genesis
@@ -233,23 +353,33 @@ func StateComponentsFactory(args *stateComponentsFactoryArgs) (*State, error) {
 		return nil, errors.New("could not create accounts adapter: " + err.Error())
 	}
 
+	var historicalAccounts state.HistoricalAccountsProvider
+	if args.config.HistoricalState.Enabled {
+		historicalAccounts, err = state.NewHistoricalAccountsDB(args.core.Trie, args.core.Hasher, args.core.Marshalizer, accountFactory)
+		if err != nil {
+			return nil, errors.New("could not create historical accounts provider: " + err.Error())
+		}
+	}
+
 	inBalanceForShard, err := args.genesisConfig.InitialNodesBalances(args.shardCoordinator, addressConverter)
 	if err != nil {
 		return nil, errors.New("initial balances could not be processed " + err.Error())
 	}
 
 	return &State{
-		AddressConverter:  addressConverter,
-		AccountsAdapter:   accountsAdapter,
-		InBalanceForShard: inBalanceForShard,
+		AddressConverter:   addressConverter,
+		AccountsAdapter:    accountsAdapter,
+		HistoricalAccounts: historicalAccounts,
+		InBalanceForShard:  inBalanceForShard,
 	}, nil
 }
 
 type dataComponentsFactoryArgs struct {
-	config           *config.Config
-	shardCoordinator sharding.Coordinator
-	core             *Core
-	uniqueID         string
+	config               *config.Config
+	shardCoordinator     sharding.Coordinator
+	core                 *Core
+	uniqueID             string
+	storageEncryptionKey []byte
 }
 
 // NewDataComponentsFactoryArgs initializes the arguments necessary for creating the data components
@@ -258,12 +388,14 @@ func NewDataComponentsFactoryArgs(
 	shardCoordinator sharding.Coordinator,
 	core *Core,
 	uniqueID string,
+	storageEncryptionKey []byte,
 ) *dataComponentsFactoryArgs {
 	return &dataComponentsFactoryArgs{
-		config:           config,
-		shardCoordinator: shardCoordinator,
-		core:             core,
-		uniqueID:         uniqueID,
+		config:               config,
+		shardCoordinator:     shardCoordinator,
+		core:                 core,
+		uniqueID:             uniqueID,
+		storageEncryptionKey: storageEncryptionKey,
 	}
 }
 
@@ -276,11 +408,22 @@ func DataComponentsFactory(args *dataComponentsFactoryArgs) (*Data, error) {
 		return nil, errors.New("could not create block chain: " + err.Error())
 	}
 
-	store, err := createDataStoreFromConfig(args.config, args.shardCoordinator, args.uniqueID)
+	store, err := createDataStoreFromConfig(args.config, args.shardCoordinator, args.uniqueID, args.storageEncryptionKey)
 	if err != nil {
 		return nil, errors.New("could not create local data store: " + err.Error())
 	}
 
+	historyRepository, err := createHistoryRepository(
+		args.config.DbLookupExtensions,
+		store,
+		args.core,
+		args.uniqueID,
+		args.storageEncryptionKey,
+	)
+	if err != nil {
+		return nil, errors.New("could not create history repository: " + err.Error())
+	}
+
 	if args.shardCoordinator.SelfId() < args.shardCoordinator.NumberOfShards() {
 		datapool, err = createShardDataPoolFromConfig(args.config, args.core.Uint64ByteSliceConverter)
 		if err != nil {
@@ -297,14 +440,84 @@ func DataComponentsFactory(args *dataComponentsFactoryArgs) (*Data, error) {
 		return nil, errors.New("could not create data pools: ")
 	}
 
+	var capacityController *poolsCapacity.CapacityController
+	if args.config.PoolsCapacity.Enabled {
+		capacityController, err = createPoolsCapacityController(args.config, datapool, metaDatapool)
+		if err != nil {
+			return nil, errors.New("could not create pools capacity controller: " + err.Error())
+		}
+	}
+
 	return &Data{
-		Blkc:         blkc,
-		Store:        store,
-		Datapool:     datapool,
-		MetaDatapool: metaDatapool,
+		Blkc:              blkc,
+		Store:             store,
+		Datapool:          datapool,
+		MetaDatapool:      metaDatapool,
+		HistoryRepository: historyRepository,
+		PoolsCapacity:     capacityController,
 	}, nil
 }
 
+type controlledPool struct {
+	name   string
+	pool   interface{}
+	config config.CacheConfig
+}
+
+func createPoolsCapacityController(
+	config *config.Config,
+	datapool dataRetriever.PoolsHolder,
+	metaDatapool dataRetriever.MetaPoolsHolder,
+) (*poolsCapacity.CapacityController, error) {
+	capacityController, err := poolsCapacity.NewCapacityController(poolsCapacity.ArgCapacityController{
+		Config:      config.PoolsCapacity,
+		MemoryUsage: &machine.SystemMemory{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]controlledPool, 0)
+	if datapool != nil {
+		pools = append(pools,
+			controlledPool{name: "transactions", pool: datapool.Transactions(), config: config.TxDataPool},
+			controlledPool{name: "unsigned transactions", pool: datapool.UnsignedTransactions(), config: config.UnsignedTransactionDataPool},
+			controlledPool{name: "reward transactions", pool: datapool.RewardTransactions(), config: config.RewardTransactionDataPool},
+			controlledPool{name: "headers", pool: datapool.Headers(), config: config.BlockHeaderDataPool},
+			controlledPool{name: "miniblocks", pool: datapool.MiniBlocks(), config: config.TxBlockBodyDataPool},
+			controlledPool{name: "peer changes blocks", pool: datapool.PeerChangesBlocks(), config: config.PeerBlockBodyDataPool},
+			controlledPool{name: "meta blocks", pool: datapool.MetaBlocks(), config: config.MetaBlockBodyDataPool},
+			controlledPool{name: "peer authentications", pool: datapool.PeerAuthentications(), config: config.PeerAuthenticationPool},
+		)
+	}
+	if metaDatapool != nil {
+		pools = append(pools,
+			controlledPool{name: "meta blocks", pool: metaDatapool.MetaBlocks(), config: config.MetaBlockBodyDataPool},
+			controlledPool{name: "miniblocks", pool: metaDatapool.MiniBlocks(), config: config.TxBlockBodyDataPool},
+			controlledPool{name: "shard headers", pool: metaDatapool.ShardHeaders(), config: config.ShardHeadersDataPool},
+			controlledPool{name: "transactions", pool: metaDatapool.Transactions(), config: config.TxDataPool},
+			controlledPool{name: "unsigned transactions", pool: metaDatapool.UnsignedTransactions(), config: config.UnsignedTransactionDataPool},
+			controlledPool{name: "peer authentications", pool: metaDatapool.PeerAuthentications(), config: config.PeerAuthenticationPool},
+		)
+	}
+
+	for _, cp := range pools {
+		resizablePool, ok := cp.pool.(dataRetriever.ResizablePool)
+		if !ok {
+			log.Debug("data pool can not be resized", "pool", cp.name)
+			continue
+		}
+
+		err = capacityController.AddPool(cp.name, resizablePool, int(cp.config.Size))
+		if err != nil {
+			_ = capacityController.Close()
+			return nil, err
+		}
+	}
+
+	return capacityController, nil
+}
+
 type cryptoComponentsFactoryArgs struct {
 	ctx                          *cli.Context
 	config                       *config.Config
@@ -316,6 +529,8 @@ type cryptoComponentsFactoryArgs struct {
 	initialBalancesSkPemFileName string
 	txSignSkName                 string
 	txSignSkIndexName            string
+	hasher                       hashing.Hasher
+	statusHandler                core.AppStatusHandler
 }
 
 // NewCryptoComponentsFactoryArgs initializes the arguments necessary for creating the crypto components
@@ -330,6 +545,8 @@ func NewCryptoComponentsFactoryArgs(
 	initialBalancesSkPemFileName string,
 	txSignSkName string,
 	txSignSkIndexName string,
+	hasher hashing.Hasher,
+	statusHandler core.AppStatusHandler,
 ) *cryptoComponentsFactoryArgs {
 	return &cryptoComponentsFactoryArgs{
 		ctx:                          ctx,
@@ -342,14 +559,48 @@ func NewCryptoComponentsFactoryArgs(
 		initialBalancesSkPemFileName: initialBalancesSkPemFileName,
 		txSignSkName:                 txSignSkName,
 		txSignSkIndexName:            txSignSkIndexName,
+		hasher:                       hasher,
+		statusHandler:                statusHandler,
 	}
 }
 
 // CryptoComponentsFactory creates the crypto components
 func CryptoComponentsFactory(args *cryptoComponentsFactoryArgs) (*Crypto, error) {
 	initialPubKeys := args.nodesConfig.InitialNodesPubKeys()
-	txSingleSigner := &singlesig.SchnorrSigner{}
-	singleSigner, err := createSingleSigner(args.config)
+	sigVerifierCacher, err := storageUnit.NewCache(
+		storageUnit.CacheType(args.config.SigVerifier.Cache.Type),
+		args.config.SigVerifier.Cache.Size,
+		args.config.SigVerifier.Cache.Shards,
+	)
+	if err != nil {
+		return nil, errors.New("could not create the signature verifier cache: " + err.Error())
+	}
+
+	sigVerifierInstance, err := sigVerifier.NewSigVerifier(sigVerifier.ArgSigVerifier{
+		Cacher:        sigVerifierCacher,
+		NumWorkers:    args.config.SigVerifier.NumWorkers,
+		StatusHandler: args.statusHandler,
+	})
+	if err != nil {
+		return nil, errors.New("could not create the signature verifier: " + err.Error())
+	}
+
+	txSignHasher, err := getTxSignHasherFromConfig(args.config)
+	if err != nil {
+		return nil, errors.New("could not create tx sign hasher: " + err.Error())
+	}
+
+	txSingleSigner, err := sigVerifier.NewCachedSingleSigner(&singlesig.SchnorrSigner{}, sigVerifierInstance, txSignHasher)
+	if err != nil {
+		return nil, errors.New("could not create txSingleSigner: " + err.Error())
+	}
+
+	blockSingleSigner, err := createSingleSigner(args.config)
+	if err != nil {
+		return nil, errors.New("could not create singleSigner: " + err.Error())
+	}
+
+	singleSigner, err := sigVerifier.NewCachedSingleSigner(blockSingleSigner, sigVerifierInstance, args.hasher)
 	if err != nil {
 		return nil, errors.New("could not create singleSigner: " + err.Error())
 	}
@@ -383,13 +634,15 @@ func CryptoComponentsFactory(args *cryptoComponentsFactoryArgs) (*Crypto, error)
 	args.log.Info("Starting with tx sign public key: " + GetPkEncoded(txSignPubKey))
 
 	return &Crypto{
-		TxSingleSigner: txSingleSigner,
-		SingleSigner:   singleSigner,
-		MultiSigner:    multiSigner,
-		TxSignKeyGen:   txSignKeyGen,
-		TxSignPrivKey:  txSignPrivKey,
-		TxSignPubKey:   txSignPubKey,
-		InitialPubKeys: initialPubKeys,
+		TxSingleSigner:  txSingleSigner,
+		SingleSigner:    singleSigner,
+		MultiSigner:     multiSigner,
+		BlockSignKeyGen: args.keyGen,
+		TxSignKeyGen:    txSignKeyGen,
+		TxSignPrivKey:   txSignPrivKey,
+		TxSignPubKey:    txSignPubKey,
+		InitialPubKeys:  initialPubKeys,
+		SigVerifier:     sigVerifierInstance,
 	}, nil
 }
 
@@ -402,29 +655,63 @@ func NetworkComponentsFactory(p2pConfig *config.P2PConfig, log *logger.Logger, c
 		randReader = rand.Reader
 	}
 
-	netMessenger, err := createNetMessenger(p2pConfig, log, randReader)
+	netMessenger, protector, err := createNetMessenger(p2pConfig, log, randReader)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Network{
-		NetMessenger: netMessenger,
-	}, nil
+	network := &Network{
+		NetMessenger:            netMessenger,
+		PrivateNetworkProtector: protector,
+	}
+	if !p2pConfig.PeerHonesty.Enabled {
+		return network, nil
+	}
+
+	network.PeerHonesty, err = peerHonesty.NewPeerHonesty(peerHonesty.ArgPeerHonesty{
+		Disconnector: netMessenger,
+		Config:       p2pConfig.PeerHonesty,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = netMessenger.SetPeerHonestyHandler(network.PeerHonesty)
+	if err != nil {
+		return nil, err
+	}
+
+	return network, nil
 }
 
 type processComponentsFactoryArgs struct {
-	genesisConfig        *sharding.Genesis
-	economicsData        *economics.EconomicsData
-	nodesConfig          *sharding.NodesSetup
-	syncer               ntp.SyncTimer
-	shardCoordinator     sharding.Coordinator
-	nodesCoordinator     sharding.NodesCoordinator
-	data                 *Data
-	core                 *Core
-	crypto               *Crypto
-	state                *State
-	network              *Network
-	coreServiceContainer serviceContainer.Core
+	genesisConfig            *sharding.Genesis
+	economicsData            *economics.EconomicsData
+	nodesConfig              *sharding.NodesSetup
+	syncer                   ntp.SyncTimer
+	shardCoordinator         sharding.Coordinator
+	nodesCoordinator         sharding.NodesCoordinator
+	data                     *Data
+	core                     *Core
+	crypto                   *Crypto
+	state                    *State
+	network                  *Network
+	coreServiceContainer     serviceContainer.Core
+	builtInFunctions         config.BuiltInFunctionsConfig
+	enableRounds             config.RoundConfig
+	epochNotifier            process.EpochNotifier
+	gasSchedule              core.GasScheduleNotifier
+	outgoingOperationsConfig config.OutgoingOperationsConfig
+	blockSignPrivKey         crypto.PrivateKey
+	blockSignPubKey          crypto.PublicKey
+	selectionAuditConfig     config.ValidatorsSelectionAuditConfig
+	interceptorsLimitsConfig config.InterceptorsLimitsConfig
+	resolversAntiflood       config.ResolversAntifloodConfig
+	supplyAccountingConfig   config.SupplyAccountingConfig
+	esdtSupplyTracking       config.ESDTSupplyTrackingConfig
+	peerAuthenticationConfig config.PeerAuthenticationConfig
+	vmExecutionGuardConfig   config.VMExecutionGuardConfig
+	genesisChecksum          []byte
 }
 
 // NewProcessComponentsFactoryArgs initializes the arguments necessary for creating the process components
@@ -441,33 +728,154 @@ func NewProcessComponentsFactoryArgs(
 	state *State,
 	network *Network,
 	coreServiceContainer serviceContainer.Core,
+	builtInFunctions config.BuiltInFunctionsConfig,
+	enableRounds config.RoundConfig,
+	epochNotifier process.EpochNotifier,
+	gasSchedule core.GasScheduleNotifier,
+	outgoingOperationsConfig config.OutgoingOperationsConfig,
+	blockSignPrivKey crypto.PrivateKey,
+	blockSignPubKey crypto.PublicKey,
+	selectionAuditConfig config.ValidatorsSelectionAuditConfig,
+	interceptorsLimitsConfig config.InterceptorsLimitsConfig,
+	resolversAntiflood config.ResolversAntifloodConfig,
+	supplyAccountingConfig config.SupplyAccountingConfig,
+	esdtSupplyTracking config.ESDTSupplyTrackingConfig,
+	peerAuthenticationConfig config.PeerAuthenticationConfig,
+	vmExecutionGuardConfig config.VMExecutionGuardConfig,
+	genesisChecksum []byte,
 ) *processComponentsFactoryArgs {
 	return &processComponentsFactoryArgs{
-		genesisConfig:        genesisConfig,
-		economicsData:        economicsData,
-		nodesConfig:          nodesConfig,
-		syncer:               syncer,
-		shardCoordinator:     shardCoordinator,
-		nodesCoordinator:     nodesCoordinator,
-		data:                 data,
-		core:                 core,
-		crypto:               crypto,
-		state:                state,
-		network:              network,
-		coreServiceContainer: coreServiceContainer,
+		genesisConfig:            genesisConfig,
+		economicsData:            economicsData,
+		nodesConfig:              nodesConfig,
+		syncer:                   syncer,
+		shardCoordinator:         shardCoordinator,
+		nodesCoordinator:         nodesCoordinator,
+		data:                     data,
+		core:                     core,
+		crypto:                   crypto,
+		state:                    state,
+		network:                  network,
+		coreServiceContainer:     coreServiceContainer,
+		builtInFunctions:         builtInFunctions,
+		enableRounds:             enableRounds,
+		epochNotifier:            epochNotifier,
+		gasSchedule:              gasSchedule,
+		outgoingOperationsConfig: outgoingOperationsConfig,
+		blockSignPrivKey:         blockSignPrivKey,
+		blockSignPubKey:          blockSignPubKey,
+		selectionAuditConfig:     selectionAuditConfig,
+		supplyAccountingConfig:   supplyAccountingConfig,
+		esdtSupplyTracking:       esdtSupplyTracking,
+		peerAuthenticationConfig: peerAuthenticationConfig,
+		interceptorsLimitsConfig: interceptorsLimitsConfig,
+		resolversAntiflood:       resolversAntiflood,
+		vmExecutionGuardConfig:   vmExecutionGuardConfig,
+		genesisChecksum:          genesisChecksum,
 	}
 }
 
 // ProcessComponentsFactory creates the process components
 func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, error) {
+	argsHeaderSig := &headerCheck.ArgsHeaderSigVerifier{
+		Marshalizer:       args.core.Marshalizer,
+		Hasher:            args.core.Hasher,
+		NodesCoordinator:  args.nodesCoordinator,
+		MultiSigVerifier:  args.crypto.MultiSigner,
+		SingleSigVerifier: args.crypto.SingleSigner,
+		KeyGen:            args.crypto.BlockSignKeyGen,
+	}
+	headerSigVerifier, err := headerCheck.NewHeaderSigVerifier(argsHeaderSig)
+	if err != nil {
+		return nil, err
+	}
+
+	argsHeaderIntegrity := &headerCheck.ArgsHeaderIntegrityVerifier{
+		GenesisTime:        time.Unix(args.nodesConfig.StartTime, 0),
+		RoundDuration:      time.Millisecond * time.Duration(args.nodesConfig.RoundDuration),
+		TimestampTolerance: headerTimestampTolerance,
+		ChainID:            args.core.ChainID,
+		SoftwareVersion:    []byte(headerSoftwareVersion),
+	}
+	headerIntegrityVerifier, err := headerCheck.NewHeaderIntegrityVerifier(argsHeaderIntegrity)
+	if err != nil {
+		return nil, err
+	}
+
+	rounder, err := round.NewRound(
+		time.Unix(args.nodesConfig.StartTime, 0),
+		args.syncer.CurrentTime(),
+		time.Millisecond*time.Duration(args.nodesConfig.RoundDuration),
+		args.syncer)
+	if err != nil {
+		return nil, err
+	}
+
+	roundActivationHandler, err := newRoundActivationHandler(args, rounder)
+	if err != nil {
+		return nil, err
+	}
+
+	roundActivationTopicHandler, err := interceptors.NewRoundActivationTopicHandler(
+		args.network.NetMessenger,
+		roundActivationHandler,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	interceptorsTopicHandler, err := newChunkingTopicHandler(args, roundActivationTopicHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	peerShardMapper, err := newPeerShardMapper(args)
+	if err != nil {
+		return nil, err
+	}
+	err = args.network.NetMessenger.SetPeerShardResolver(peerShardMapper)
+	if err != nil {
+		return nil, err
+	}
+
+	resolversMessenger, err := newChunkingResolversMessenger(args)
+	if err != nil {
+		return nil, err
+	}
+
+	dataLimitsChecker, err := interceptors.NewDataLimitsChecker(interceptors.ArgDataLimitsChecker{
+		MaxTxDataBytes:        args.interceptorsLimitsConfig.MaxTxDataBytes,
+		MaxTxsPerMiniBlock:    args.interceptorsLimitsConfig.MaxTxsPerMiniBlock,
+		MaxMiniBlocksPerBlock: args.interceptorsLimitsConfig.MaxMiniBlocksPerBlock,
+		StatusHandler:         args.core.StatusHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	peerAuthVerifier, err := peerAuthentication.NewPeerAuthenticationVerifier(&peerAuthentication.ArgPeerAuthenticationVerifier{
+		Marshalizer:  args.core.Marshalizer,
+		KeyGen:       args.crypto.BlockSignKeyGen,
+		SingleSigner: args.crypto.SingleSigner,
+		MaxValidity:  time.Second * time.Duration(args.peerAuthenticationConfig.MaxValidityInSec),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	interceptorContainerFactory, resolversContainerFactory, err := newInterceptorAndResolverContainerFactory(
 		args.shardCoordinator,
 		args.nodesCoordinator,
 		args.data, args.core,
 		args.crypto,
 		args.state,
-		args.network,
+		resolversMessenger,
+		interceptorsTopicHandler,
 		args.economicsData,
+		headerSigVerifier,
+		headerIntegrityVerifier,
+		dataLimitsChecker,
+		peerAuthVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -489,15 +897,6 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
-	rounder, err := round.NewRound(
-		time.Unix(args.nodesConfig.StartTime, 0),
-		args.syncer.CurrentTime(),
-		time.Millisecond*time.Duration(args.nodesConfig.RoundDuration),
-		args.syncer)
-	if err != nil {
-		return nil, err
-	}
-
 	forkDetector, err := newForkDetector(rounder, args.shardCoordinator)
 	if err != nil {
 		return nil, err
@@ -509,6 +908,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		args.shardCoordinator,
 		args.nodesConfig,
 		args.genesisConfig,
+		args.genesisChecksum,
 	)
 	if err != nil {
 		return nil, err
@@ -519,6 +919,47 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	pendingMiniBlocks, err := pendingMb.NewPendingMiniBlocks(process.MaxMetaNoncesDelayForPendingMiniBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	crossHeadersNotifier, err := newCrossHeadersNotifier(args)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTracker, err := track.NewBlockTracker(track.ArgBlockTracker{
+		Hasher:           args.core.Hasher,
+		Marshalizer:      args.core.Marshalizer,
+		ShardCoordinator: args.shardCoordinator,
+		StartHeaders:     shardsGenesisBlocks,
+		HeadersNotifier:  crossHeadersNotifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outgoingOperationsHandler, err := newOutgoingOperationsHandler(args)
+	if err != nil {
+		return nil, err
+	}
+
+	selectionAuditor, err := newValidatorsSelectionAuditor(args)
+	if err != nil {
+		return nil, err
+	}
+
+	supplyAccountant, err := newSupplyAccountant(args)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtSupplyTracker, err := newESDTSupplyTracker(args)
+	if err != nil {
+		return nil, err
+	}
+
 	blockProcessor, err := newBlockProcessor(
 		resolversFinder,
 		args.shardCoordinator,
@@ -528,8 +969,19 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		args.core,
 		args.state,
 		forkDetector,
+		blockTracker,
+		crossHeadersNotifier,
 		shardsGenesisBlocks,
 		args.coreServiceContainer,
+		args.builtInFunctions,
+		args.epochNotifier,
+		args.gasSchedule,
+		pendingMiniBlocks,
+		outgoingOperationsHandler,
+		selectionAuditor,
+		supplyAccountant,
+		esdtSupplyTracker,
+		args.vmExecutionGuardConfig,
 	)
 
 	if err != nil {
@@ -537,14 +989,262 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 	}
 
 	return &Process{
-		InterceptorsContainer: interceptorsContainer,
-		ResolversFinder:       resolversFinder,
-		Rounder:               rounder,
-		ForkDetector:          forkDetector,
-		BlockProcessor:        blockProcessor,
+		InterceptorsContainer:  interceptorsContainer,
+		ResolversFinder:        resolversFinder,
+		Rounder:                rounder,
+		ForkDetector:           forkDetector,
+		BlockProcessor:         blockProcessor,
+		PendingMiniBlocks:      pendingMiniBlocks,
+		RoundActivationHandler: roundActivationHandler,
+		OutgoingOperations:     outgoingOperationsHandler,
+		SelectionAuditor:       selectionAuditor,
+		SupplyAccountant:       supplyAccountant,
+		ESDTSupplyTracker:      esdtSupplyTracker,
+		DataLimitsChecker:      dataLimitsChecker,
 	}, nil
 }
 
+// newValidatorsSelectionAuditor creates the auditor of the leaders and consensus groups selected in the own shard
+func newValidatorsSelectionAuditor(args *processComponentsFactoryArgs) (process.ValidatorsSelectionAuditor, error) {
+	if !args.selectionAuditConfig.Enabled {
+		return selectionAudit.NewNilValidatorsSelectionAuditor(), nil
+	}
+
+	return selectionAudit.NewValidatorsSelectionAuditor(selectionAudit.ArgValidatorsSelectionAuditor{
+		NodesCoordinator:   args.nodesCoordinator,
+		ShardCoordinator:   args.shardCoordinator,
+		DeviationThreshold: args.selectionAuditConfig.DeviationThreshold,
+		NumEpochsToKeep:    args.selectionAuditConfig.NumEpochsToKeep,
+	})
+}
+
+// newCrossHeadersNotifier creates the notification hub of the headers notarized by the own shard: the metachain
+// headers for a shard and the shard headers for the metachain
+func newCrossHeadersNotifier(args *processComponentsFactoryArgs) (dataRetriever.HeadersNotifier, error) {
+	if args.shardCoordinator.SelfId() == sharding.MetachainShardId {
+		return headersPool.NewHeadersPool(args.data.MetaDatapool.ShardHeaders())
+	}
+
+	return headersPool.NewHeadersPool(args.data.Datapool.MetaBlocks())
+}
+
+// newSupplyAccountant creates the counters of the value minted and burned by the own shard. The metachain creates no
+// rewards, so its counters are disabled
+func newSupplyAccountant(args *processComponentsFactoryArgs) (process.SupplyAccountant, error) {
+	if !args.supplyAccountingConfig.Enabled || args.shardCoordinator.SelfId() == sharding.MetachainShardId {
+		return supply.NewNilSupplyAccountant(), nil
+	}
+
+	burnAddress, err := hex.DecodeString(args.economicsData.BurnAddress())
+	if err != nil {
+		return nil, err
+	}
+	communityAddress, err := hex.DecodeString(args.economicsData.CommunityAddress())
+	if err != nil {
+		return nil, err
+	}
+	protocolSustainabilityAddress, err := hex.DecodeString(args.economicsData.ProtocolSustainabilityAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	genesisSupply, err := computeShardGenesisSupply(args.genesisConfig, args.shardCoordinator, args.state.AddressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return supply.NewSupplyAccountant(supply.ArgSupplyAccountant{
+		BurnAddress:                   burnAddress,
+		CommunityAddress:              communityAddress,
+		ProtocolSustainabilityAddress: protocolSustainabilityAddress,
+		RewardsHandler:                args.economicsData,
+		ShardCoordinator:              args.shardCoordinator,
+		Storer:                        args.data.Store.GetStorer(dataRetriever.SupplyUnit),
+		Marshalizer:                   args.core.Marshalizer,
+		GenesisSupply:                 genesisSupply,
+	})
+}
+
+// newESDTSupplyTracker creates the tracker of the token supply changes made in the own shard. The metachain runs no
+// token built-in functions, so its tracker is disabled
+func newESDTSupplyTracker(args *processComponentsFactoryArgs) (process.ESDTSupplyTracker, error) {
+	if !args.esdtSupplyTracking.Enabled || args.shardCoordinator.SelfId() == sharding.MetachainShardId {
+		return supply.NewNilESDTSupplyTracker(), nil
+	}
+
+	return supply.NewESDTSupplyTracker(supply.ArgESDTSupplyTracker{
+		Storer:      args.data.Store.GetStorer(dataRetriever.ESDTSupplyUnit),
+		Marshalizer: args.core.Marshalizer,
+	})
+}
+
+// newPeerShardMapper creates the mapper of the peer IDs to the validators keys and shards, learned from the received
+// peer authentications and persisted in the PeerShardMapperUnit
+func newPeerShardMapper(args *processComponentsFactoryArgs) (*networksharding.PeerShardMapper, error) {
+	var peerAuthentications storage.Cacher
+	if args.shardCoordinator.SelfId() == sharding.MetachainShardId {
+		peerAuthentications = args.data.MetaDatapool.PeerAuthentications()
+	} else {
+		peerAuthentications = args.data.Datapool.PeerAuthentications()
+	}
+
+	return networksharding.NewPeerShardMapper(networksharding.ArgPeerShardMapper{
+		NodesCoordinator:    args.nodesCoordinator,
+		ShardCoordinator:    args.shardCoordinator,
+		PeerAuthentications: peerAuthentications,
+		Storer:              args.data.Store.GetStorer(dataRetriever.PeerShardMapperUnit),
+		Marshalizer:         args.core.Marshalizer,
+	})
+}
+
+// computeShardGenesisSupply returns the sum of the initial balances and of the delegated amounts of the own shard
+func computeShardGenesisSupply(
+	genesisConfig *sharding.Genesis,
+	shardCoordinator sharding.Coordinator,
+	addressConverter state.AddressConverter,
+) (*big.Int, error) {
+	balances, err := genesisConfig.InitialNodesBalances(shardCoordinator, addressConverter)
+	if err != nil {
+		return nil, err
+	}
+	delegations, err := genesisConfig.InitialDelegations(shardCoordinator, addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	genesisSupply := big.NewInt(0)
+	for _, balance := range balances {
+		if balance == nil {
+			continue
+		}
+		genesisSupply.Add(genesisSupply, balance)
+	}
+	for _, delegation := range delegations {
+		genesisSupply.Add(genesisSupply, delegation.TotalDelegated())
+	}
+
+	return genesisSupply, nil
+}
+
+// newOutgoingOperationsHandler creates the collector of the outgoing operations and registers it on the topic where
+// the validators of the shard broadcast their signatures. A handler collecting nothing is returned if disabled
+func newOutgoingOperationsHandler(args *processComponentsFactoryArgs) (process.OutgoingOperationsHandler, error) {
+	if !args.outgoingOperationsConfig.Enabled {
+		return outgoingOperations.NewNilOutgoingOperationsHandler(), nil
+	}
+
+	collector, err := outgoingOperations.NewOutgoingOperationsCollector(outgoingOperations.ArgOutgoingOperationsCollector{
+		Subscriptions:    args.outgoingOperationsConfig.Subscriptions,
+		MaxBatches:       args.outgoingOperationsConfig.MaxBatches,
+		Marshalizer:      args.core.Marshalizer,
+		Hasher:           args.core.Hasher,
+		Store:            args.data.Store,
+		ShardCoordinator: args.shardCoordinator,
+		NodesCoordinator: args.nodesCoordinator,
+		KeyGen:           args.crypto.BlockSignKeyGen,
+		SingleSigner:     args.crypto.SingleSigner,
+		PrivateKey:       args.blockSignPrivKey,
+		PublicKey:        args.blockSignPubKey,
+		Messenger:        args.network.NetMessenger,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	topic := outgoingOperations.Topic(args.shardCoordinator, args.shardCoordinator.SelfId())
+	if !args.network.NetMessenger.HasTopic(topic) {
+		err = args.network.NetMessenger.CreateTopic(topic, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = args.network.NetMessenger.RegisterMessageProcessor(topic, collector)
+	if err != nil {
+		return nil, err
+	}
+
+	return collector, nil
+}
+
+func newChunkingTopicHandler(
+	args *processComponentsFactoryArgs,
+	topicHandler process.TopicHandler,
+) (process.TopicHandler, error) {
+	chunkReassembler, err := interceptors.NewChunkReassembler(interceptors.ArgChunkReassembler{
+		Marshalizer:                 args.core.Marshalizer,
+		Hasher:                      args.core.Hasher,
+		MaxChunksPerPayload:         maxChunksPerPayload,
+		MaxChunkSize:                core.MaxChunkSize,
+		MaxPendingPayloadsPerSource: maxPendingPayloadsPerSource,
+		Timeout:                     chunkedPayloadTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return interceptors.NewChunkingTopicHandler(topicHandler, chunkReassembler)
+}
+
+func newChunkingResolversMessenger(args *processComponentsFactoryArgs) (dataRetriever.TopicMessageHandler, error) {
+	payloadChunker, err := partitioning.NewPayloadChunker(args.core.Marshalizer, args.core.Hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	antifloodMessenger, err := topicResolverSender.NewAntifloodTopicMessageHandler(
+		topicResolverSender.ArgAntifloodTopicMessageHandler{
+			Messenger:                     args.network.NetMessenger,
+			MaxRequestsPerPeer:            args.resolversAntiflood.MaxRequestsPerPeer,
+			MaxRequestsPerValidatorPeer:   args.resolversAntiflood.MaxRequestsPerValidatorPeer,
+			QuotaInterval:                 time.Second * time.Duration(args.resolversAntiflood.QuotaIntervalInSec),
+			MaxConcurrentHandlersPerTopic: args.resolversAntiflood.MaxConcurrentHandlersPerTopic,
+			StatusHandler:                 args.core.StatusHandler,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return topicResolverSender.NewChunkingTopicMessageHandler(
+		antifloodMessenger,
+		payloadChunker,
+		core.MaxChunkSize,
+	)
+}
+
+func newRoundActivationHandler(
+	args *processComponentsFactoryArgs,
+	rounder consensus.Rounder,
+) (core.RoundActivationHandler, error) {
+	roundActivationHandler, err := forking.NewRoundActivationHandler(forking.ArgsRoundActivationHandler{
+		RoundConfig:  args.enableRounds,
+		Rounder:      rounder,
+		Marshalizer:  args.core.Marshalizer,
+		KeyGen:       args.crypto.TxSignKeyGen,
+		SingleSigner: args.crypto.TxSingleSigner,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messenger := args.network.NetMessenger
+	//the topic already exists if the process components are re-created
+	if !messenger.HasTopic(factory.RoundActivationTopic) {
+		err = messenger.CreateTopic(factory.RoundActivationTopic, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = messenger.RegisterMessageProcessor(factory.RoundActivationTopic, roundActivationHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return roundActivationHandler, nil
+}
+
 func prepareGenesisBlock(args *processComponentsFactoryArgs, shardsGenesisBlocks map[uint32]data.HeaderHandler) error {
 	genesisBlock, ok := shardsGenesisBlocks[args.shardCoordinator.SelfId()]
 	if !ok {
@@ -660,42 +1360,79 @@ func CreateSoftwareVersionChecker(statusHandler core.AppStatusHandler) (*softwar
 }
 
 func getHasherFromConfig(cfg *config.Config) (hashing.Hasher, error) {
-	switch cfg.Hasher.Type {
-	case "sha256":
-		return sha256.Sha256{}, nil
-	case "blake2b":
-		return blake2b.Blake2b{}, nil
+	return hasherFactory.NewEpochSwitchHasher(cfg.Hasher.Type, cfg.Hasher.MigrationType, cfg.Hasher.MigrationEpoch)
+}
+
+func getTxSignHasherFromConfig(cfg *config.Config) (hashing.Hasher, error) {
+	hasher, err := hasherFactory.NewHasher(cfg.TxSignHasher.Type)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, errors.New("no hasher provided in config file")
+	return hashing.NewDomainSeparatedHasher(hasher, txSignHashingDomain)
 }
 
 func getMarshalizerFromConfig(cfg *config.Config) (marshal.Marshalizer, error) {
-	switch cfg.Marshalizer.Type {
-	case "json":
+	marshalizer, err := createMarshalizer(cfg.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Marshalizer.MigrationType) == 0 {
+		return marshalizer, nil
+	}
+
+	migrationMarshalizer, err := createMarshalizer(cfg.Marshalizer.MigrationType)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshal.NewEpochSwitchMarshalizer(marshalizer, migrationMarshalizer, cfg.Marshalizer.MigrationEpoch)
+}
+
+func createMarshalizer(marshalizerType string) (marshal.Marshalizer, error) {
+	switch marshalizerType {
+	case jsonMarshalizer:
 		return &marshal.JsonMarshalizer{}, nil
+	case gogoProtoMarshalizer:
+		return &marshal.GogoProtoMarshalizer{}, nil
 	}
 
 	return nil, errors.New("no marshalizer provided in config file")
 }
 
-func getTrie(
+// getTrieStorer creates the accounts trie storer. If the tiering is enabled, the storer also returns the handler which
+// moves the data of the ended epochs under the cold path
+func getTrieStorer(
 	cfg config.StorageConfig,
-	marshalizer marshal.Marshalizer,
-	hasher hashing.Hasher,
+	tieringCfg config.TieredStorageConfig,
 	uniqueID string,
-) (data.Trie, error) {
+	storageEncryptionKey []byte,
+) (storage.Storer, core.EpochSubscriberHandler, error) {
+	if tieringCfg.Enabled {
+		coldPath := filepath.Join(tieringCfg.ColdPath, filepath.Base(uniqueID), cfg.DB.FilePath)
+		accountsTrieStorage, tieredDB, err := storageUnit.NewTieredStorageUnitFromConf(
+			getCacherFromConfig(cfg.Cache),
+			getDBFromConfig(cfg.DB, uniqueID, storageEncryptionKey),
+			getBloomFromConfig(cfg.Bloom),
+			coldPath,
+		)
+		if err != nil {
+			return nil, nil, errors.New("error creating tiered accountsTrieStorage: " + err.Error())
+		}
+
+		return accountsTrieStorage, tieredDB, nil
+	}
 
 	accountsTrieStorage, err := storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(cfg.Cache),
-		getDBFromConfig(cfg.DB, uniqueID),
+		getDBFromConfig(cfg.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(cfg.Bloom),
 	)
 	if err != nil {
-		return nil, errors.New("error creating accountsTrieStorage: " + err.Error())
+		return nil, nil, errors.New("error creating accountsTrieStorage: " + err.Error())
 	}
 
-	return trie.NewTrie(accountsTrieStorage, marshalizer, hasher)
+	return accountsTrieStorage, nil, nil
 }
 
 func createBlockChainFromConfig(config *config.Config, coordinator sharding.Coordinator, ash core.AppStatusHandler) (data.ChainHandler, error) {
@@ -744,12 +1481,13 @@ func createDataStoreFromConfig(
 	config *config.Config,
 	shardCoordinator sharding.Coordinator,
 	uniqueID string,
+	storageEncryptionKey []byte,
 ) (dataRetriever.StorageService, error) {
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
-		return createShardDataStoreFromConfig(config, shardCoordinator, uniqueID)
+		return createShardDataStoreFromConfig(config, shardCoordinator, uniqueID, storageEncryptionKey)
 	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
-		return createMetaChainDataStoreFromConfig(config, shardCoordinator, uniqueID)
+		return createMetaChainDataStoreFromConfig(config, shardCoordinator, uniqueID, storageEncryptionKey)
 	}
 	return nil, errors.New("can not create data store")
 }
@@ -758,6 +1496,7 @@ func createShardDataStoreFromConfig(
 	config *config.Config,
 	shardCoordinator sharding.Coordinator,
 	uniqueID string,
+	storageEncryptionKey []byte,
 ) (dataRetriever.StorageService, error) {
 
 	var headerUnit *storageUnit.Unit
@@ -769,6 +1508,11 @@ func createShardDataStoreFromConfig(
 	var rewardTxUnit *storageUnit.Unit
 	var metaHdrHashNonceUnit *storageUnit.Unit
 	var shardHdrHashNonceUnit *storageUnit.Unit
+	var txLogsUnit *storageUnit.Unit
+	var miniBlockHashByTxHashUnit *storageUnit.Unit
+	var supplyUnit *storageUnit.Unit
+	var esdtSupplyUnit *storageUnit.Unit
+	var peerShardMapperUnit *storageUnit.Unit
 	var err error
 
 	defer func() {
@@ -801,12 +1545,27 @@ func createShardDataStoreFromConfig(
 			if shardHdrHashNonceUnit != nil {
 				_ = shardHdrHashNonceUnit.DestroyUnit()
 			}
+			if txLogsUnit != nil {
+				_ = txLogsUnit.DestroyUnit()
+			}
+			if miniBlockHashByTxHashUnit != nil {
+				_ = miniBlockHashByTxHashUnit.DestroyUnit()
+			}
+			if supplyUnit != nil {
+				_ = supplyUnit.DestroyUnit()
+			}
+			if esdtSupplyUnit != nil {
+				_ = esdtSupplyUnit.DestroyUnit()
+			}
+			if peerShardMapperUnit != nil {
+				_ = peerShardMapperUnit.DestroyUnit()
+			}
 		}
 	}()
 
 	txUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.TxStorage.Cache),
-		getDBFromConfig(config.TxStorage.DB, uniqueID),
+		getDBFromConfig(config.TxStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.TxStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -814,7 +1573,7 @@ func createShardDataStoreFromConfig(
 
 	unsignedTxUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.UnsignedTransactionStorage.Cache),
-		getDBFromConfig(config.UnsignedTransactionStorage.DB, uniqueID),
+		getDBFromConfig(config.UnsignedTransactionStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.UnsignedTransactionStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -822,7 +1581,7 @@ func createShardDataStoreFromConfig(
 
 	rewardTxUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.RewardTxStorage.Cache),
-		getDBFromConfig(config.RewardTxStorage.DB, uniqueID),
+		getDBFromConfig(config.RewardTxStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.RewardTxStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -830,7 +1589,7 @@ func createShardDataStoreFromConfig(
 
 	miniBlockUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.MiniBlocksStorage.Cache),
-		getDBFromConfig(config.MiniBlocksStorage.DB, uniqueID),
+		getDBFromConfig(config.MiniBlocksStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.MiniBlocksStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -838,7 +1597,7 @@ func createShardDataStoreFromConfig(
 
 	peerBlockUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.PeerBlockBodyStorage.Cache),
-		getDBFromConfig(config.PeerBlockBodyStorage.DB, uniqueID),
+		getDBFromConfig(config.PeerBlockBodyStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.PeerBlockBodyStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -846,7 +1605,7 @@ func createShardDataStoreFromConfig(
 
 	headerUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.BlockHeaderStorage.Cache),
-		getDBFromConfig(config.BlockHeaderStorage.DB, uniqueID),
+		getDBFromConfig(config.BlockHeaderStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.BlockHeaderStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -854,7 +1613,7 @@ func createShardDataStoreFromConfig(
 
 	metachainHeaderUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.MetaBlockStorage.Cache),
-		getDBFromConfig(config.MetaBlockStorage.DB, uniqueID),
+		getDBFromConfig(config.MetaBlockStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.MetaBlockStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -862,7 +1621,7 @@ func createShardDataStoreFromConfig(
 
 	metaHdrHashNonceUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.MetaHdrNonceHashStorage.Cache),
-		getDBFromConfig(config.MetaHdrNonceHashStorage.DB, uniqueID),
+		getDBFromConfig(config.MetaHdrNonceHashStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.MetaHdrNonceHashStorage.Bloom),
 	)
 	if err != nil {
@@ -871,7 +1630,7 @@ func createShardDataStoreFromConfig(
 
 	shardHdrHashNonceUnit, err = storageUnit.NewShardedStorageUnitFromConf(
 		getCacherFromConfig(config.ShardHdrNonceHashStorage.Cache),
-		getDBFromConfig(config.ShardHdrNonceHashStorage.DB, uniqueID),
+		getDBFromConfig(config.ShardHdrNonceHashStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.ShardHdrNonceHashStorage.Bloom),
 		shardCoordinator.SelfId(),
 	)
@@ -879,9 +1638,49 @@ func createShardDataStoreFromConfig(
 		return nil, err
 	}
 
+	txLogsUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.TxLogsStorage.Cache),
+		getDBFromConfig(config.TxLogsStorage.DB, uniqueID, storageEncryptionKey),
+		getBloomFromConfig(config.TxLogsStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlockHashByTxHashUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.MiniBlockHashByTxHashStorage.Cache),
+		getDBFromConfig(config.MiniBlockHashByTxHashStorage.DB, uniqueID, storageEncryptionKey),
+		getBloomFromConfig(config.MiniBlockHashByTxHashStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
+	supplyUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.SupplyStorage.Cache),
+		getDBFromConfig(config.SupplyStorage.DB, uniqueID, storageEncryptionKey),
+		getBloomFromConfig(config.SupplyStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
+	esdtSupplyUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.ESDTSupplyStorage.Cache),
+		getDBFromConfig(config.ESDTSupplyStorage.DB, uniqueID, storageEncryptionKey),
+		getBloomFromConfig(config.ESDTSupplyStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
+	peerShardMapperUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.PeerShardMapperStorage.Cache),
+		getDBFromConfig(config.PeerShardMapperStorage.DB, uniqueID, storageEncryptionKey),
+		getBloomFromConfig(config.PeerShardMapperStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
 	heartbeatStorageUnit, err := storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.Heartbeat.HeartbeatStorage.Cache),
-		getDBFromConfig(config.Heartbeat.HeartbeatStorage.DB, uniqueID),
+		getDBFromConfig(config.Heartbeat.HeartbeatStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.Heartbeat.HeartbeatStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -899,6 +1698,11 @@ func createShardDataStoreFromConfig(
 	hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardCoordinator.SelfId())
 	store.AddStorer(hdrNonceHashDataUnit, shardHdrHashNonceUnit)
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
+	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	store.AddStorer(dataRetriever.MiniBlockHashByTxHashUnit, miniBlockHashByTxHashUnit)
+	store.AddStorer(dataRetriever.SupplyUnit, supplyUnit)
+	store.AddStorer(dataRetriever.ESDTSupplyUnit, esdtSupplyUnit)
+	store.AddStorer(dataRetriever.PeerShardMapperUnit, peerShardMapperUnit)
 
 	return store, err
 }
@@ -907,9 +1711,10 @@ func createMetaChainDataStoreFromConfig(
 	config *config.Config,
 	shardCoordinator sharding.Coordinator,
 	uniqueID string,
+	storageEncryptionKey []byte,
 ) (dataRetriever.StorageService, error) {
 	var peerDataUnit, shardDataUnit, metaBlockUnit, headerUnit, metaHdrHashNonceUnit *storageUnit.Unit
-	var txUnit, miniBlockUnit, unsignedTxUnit *storageUnit.Unit
+	var txUnit, miniBlockUnit, unsignedTxUnit, statisticsUnit, peerShardMapperUnit *storageUnit.Unit
 	var shardHdrHashNonceUnits []*storageUnit.Unit
 	var err error
 
@@ -945,12 +1750,18 @@ func createMetaChainDataStoreFromConfig(
 			if miniBlockUnit != nil {
 				_ = miniBlockUnit.DestroyUnit()
 			}
+			if statisticsUnit != nil {
+				_ = statisticsUnit.DestroyUnit()
+			}
+			if peerShardMapperUnit != nil {
+				_ = peerShardMapperUnit.DestroyUnit()
+			}
 		}
 	}()
 
 	metaBlockUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.MetaBlockStorage.Cache),
-		getDBFromConfig(config.MetaBlockStorage.DB, uniqueID),
+		getDBFromConfig(config.MetaBlockStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.MetaBlockStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -958,7 +1769,7 @@ func createMetaChainDataStoreFromConfig(
 
 	shardDataUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.ShardDataStorage.Cache),
-		getDBFromConfig(config.ShardDataStorage.DB, uniqueID),
+		getDBFromConfig(config.ShardDataStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.ShardDataStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -966,7 +1777,7 @@ func createMetaChainDataStoreFromConfig(
 
 	peerDataUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.PeerDataStorage.Cache),
-		getDBFromConfig(config.PeerDataStorage.DB, uniqueID),
+		getDBFromConfig(config.PeerDataStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.PeerDataStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -974,7 +1785,7 @@ func createMetaChainDataStoreFromConfig(
 
 	headerUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.BlockHeaderStorage.Cache),
-		getDBFromConfig(config.BlockHeaderStorage.DB, uniqueID),
+		getDBFromConfig(config.BlockHeaderStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.BlockHeaderStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -982,7 +1793,7 @@ func createMetaChainDataStoreFromConfig(
 
 	metaHdrHashNonceUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.MetaHdrNonceHashStorage.Cache),
-		getDBFromConfig(config.MetaHdrNonceHashStorage.DB, uniqueID),
+		getDBFromConfig(config.MetaHdrNonceHashStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.MetaHdrNonceHashStorage.Bloom),
 	)
 	if err != nil {
@@ -993,7 +1804,7 @@ func createMetaChainDataStoreFromConfig(
 	for i := uint32(0); i < shardCoordinator.NumberOfShards(); i++ {
 		shardHdrHashNonceUnits[i], err = storageUnit.NewShardedStorageUnitFromConf(
 			getCacherFromConfig(config.ShardHdrNonceHashStorage.Cache),
-			getDBFromConfig(config.ShardHdrNonceHashStorage.DB, uniqueID),
+			getDBFromConfig(config.ShardHdrNonceHashStorage.DB, uniqueID, storageEncryptionKey),
 			getBloomFromConfig(config.ShardHdrNonceHashStorage.Bloom),
 			i,
 		)
@@ -1004,7 +1815,7 @@ func createMetaChainDataStoreFromConfig(
 
 	heartbeatStorageUnit, err := storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.Heartbeat.HeartbeatStorage.Cache),
-		getDBFromConfig(config.Heartbeat.HeartbeatStorage.DB, uniqueID),
+		getDBFromConfig(config.Heartbeat.HeartbeatStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.Heartbeat.HeartbeatStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -1012,7 +1823,7 @@ func createMetaChainDataStoreFromConfig(
 
 	txUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.TxStorage.Cache),
-		getDBFromConfig(config.TxStorage.DB, uniqueID),
+		getDBFromConfig(config.TxStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.TxStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -1020,7 +1831,7 @@ func createMetaChainDataStoreFromConfig(
 
 	unsignedTxUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.UnsignedTransactionStorage.Cache),
-		getDBFromConfig(config.UnsignedTransactionStorage.DB, uniqueID),
+		getDBFromConfig(config.UnsignedTransactionStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.UnsignedTransactionStorage.Bloom))
 	if err != nil {
 		return nil, err
@@ -1028,12 +1839,28 @@ func createMetaChainDataStoreFromConfig(
 
 	miniBlockUnit, err = storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(config.MiniBlocksStorage.Cache),
-		getDBFromConfig(config.MiniBlocksStorage.DB, uniqueID),
+		getDBFromConfig(config.MiniBlocksStorage.DB, uniqueID, storageEncryptionKey),
 		getBloomFromConfig(config.MiniBlocksStorage.Bloom))
 	if err != nil {
 		return nil, err
 	}
 
+	statisticsUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.StatisticsStorage.Cache),
+		getDBFromConfig(config.StatisticsStorage.DB, uniqueID, storageEncryptionKey),
+		getBloomFromConfig(config.StatisticsStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
+	peerShardMapperUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.PeerShardMapperStorage.Cache),
+		getDBFromConfig(config.PeerShardMapperStorage.DB, uniqueID, storageEncryptionKey),
+		getBloomFromConfig(config.PeerShardMapperStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.MetaBlockUnit, metaBlockUnit)
 	store.AddStorer(dataRetriever.MetaShardDataUnit, shardDataUnit)
@@ -1048,10 +1875,72 @@ func createMetaChainDataStoreFromConfig(
 		store.AddStorer(hdrNonceHashDataUnit, shardHdrHashNonceUnits[i])
 	}
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
+	store.AddStorer(dataRetriever.StatisticsUnit, statisticsUnit)
+	store.AddStorer(dataRetriever.PeerShardMapperUnit, peerShardMapperUnit)
 
 	return store, err
 }
 
+func createHistoryRepository(
+	dbLookupConfig config.DbLookupExtensionsConfig,
+	store dataRetriever.StorageService,
+	core *Core,
+	uniqueID string,
+	storageEncryptionKey []byte,
+) (dblookupext.HistoryRepository, error) {
+	if !dbLookupConfig.Enabled {
+		return dblookupext.NewNilHistoryRepository(), nil
+	}
+
+	err := addDbLookupExtensionsStorers(store, dbLookupConfig, uniqueID, storageEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return dblookupext.NewHistoryRepository(dblookupext.ArgHistoryRepository{
+		MiniblocksMetadataStorer: store.GetStorer(dataRetriever.MiniblocksMetadataUnit),
+		EpochByHashStorer:        store.GetStorer(dataRetriever.EpochByHashUnit),
+		RoundByHashStorer:        store.GetStorer(dataRetriever.RoundByHashUnit),
+		Marshalizer:              core.Marshalizer,
+		Hasher:                   core.Hasher,
+	})
+}
+
+func addDbLookupExtensionsStorers(
+	store dataRetriever.StorageService,
+	dbLookupConfig config.DbLookupExtensionsConfig,
+	uniqueID string,
+	storageEncryptionKey []byte,
+) error {
+	storersConfig := map[dataRetriever.UnitType]config.StorageConfig{
+		dataRetriever.MiniblocksMetadataUnit: dbLookupConfig.MiniblocksMetadataStorage,
+		dataRetriever.EpochByHashUnit:        dbLookupConfig.EpochByHashStorage,
+		dataRetriever.RoundByHashUnit:        dbLookupConfig.RoundByHashStorage,
+	}
+
+	units := make(map[dataRetriever.UnitType]*storageUnit.Unit)
+	for unitType, storageConfig := range storersConfig {
+		unit, err := storageUnit.NewStorageUnitFromConf(
+			getCacherFromConfig(storageConfig.Cache),
+			getDBFromConfig(storageConfig.DB, uniqueID, storageEncryptionKey),
+			getBloomFromConfig(storageConfig.Bloom))
+		if err != nil {
+			for _, createdUnit := range units {
+				_ = createdUnit.DestroyUnit()
+			}
+			return err
+		}
+
+		units[unitType] = unit
+	}
+
+	for unitType, unit := range units {
+		store.AddStorer(unitType, unit)
+	}
+
+	return nil
+}
+
 func createShardDataPoolFromConfig(
 	config *config.Config,
 	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter,
@@ -1117,6 +2006,13 @@ func createShardDataPoolFromConfig(
 		return nil, err
 	}
 
+	cacherCfg = getCacherFromConfig(config.PeerAuthenticationPool)
+	peerAuthentications, err := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
+	if err != nil {
+		log.Info("error creating peer authentication pool")
+		return nil, err
+	}
+
 	return dataPool.NewShardedDataPool(
 		txPool,
 		uTxPool,
@@ -1126,6 +2022,7 @@ func createShardDataPoolFromConfig(
 		txBlockBody,
 		peerChangeBlockBody,
 		metaBlockBody,
+		peerAuthentications,
 	)
 }
 
@@ -1177,7 +2074,22 @@ func createMetaDataPoolFromConfig(
 		return nil, err
 	}
 
-	return dataPool.NewMetaDataPool(metaBlockBody, txBlockBody, shardHeaders, headersNonces, txPool, uTxPool)
+	cacherCfg = getCacherFromConfig(config.PeerAuthenticationPool)
+	peerAuthentications, err := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
+	if err != nil {
+		log.Info("error creating peer authentication pool")
+		return nil, err
+	}
+
+	return dataPool.NewMetaDataPool(
+		metaBlockBody,
+		txBlockBody,
+		shardHeaders,
+		headersNonces,
+		txPool,
+		uTxPool,
+		peerAuthentications,
+	)
 }
 
 func createSingleSigner(config *config.Config) (crypto.SingleSigner, error) {
@@ -1196,17 +2108,11 @@ func getMultisigHasherFromConfig(cfg *config.Config) (hashing.Hasher, error) {
 		return nil, errors.New("wrong multisig hasher provided for bls consensus type")
 	}
 
-	switch cfg.MultisigHasher.Type {
-	case "sha256":
-		return sha256.Sha256{}, nil
-	case "blake2b":
-		if cfg.Consensus.Type == BlsConsensusType {
-			return blake2b.Blake2b{HashSize: BlsHashSize}, nil
-		}
-		return blake2b.Blake2b{}, nil
+	if cfg.Consensus.Type == BlsConsensusType {
+		return blake2b.Blake2b{HashSize: BlsHashSize}, nil
 	}
 
-	return nil, errors.New("no multisig hasher provided in config file")
+	return hasherFactory.NewHasher(cfg.MultisigHasher.Type)
 }
 
 func createMultiSigner(
@@ -1232,21 +2138,29 @@ func createNetMessenger(
 	p2pConfig *config.P2PConfig,
 	log *logger.Logger,
 	randReader io.Reader,
-) (p2p.Messenger, error) {
+) (p2p.Messenger, p2p.PrivateNetworkProtector, error) {
 
 	if p2pConfig.Node.Port < 0 {
-		return nil, errors.New("cannot start node on port < 0")
+		return nil, nil, errors.New("cannot start node on port < 0")
 	}
 
 	pDiscoveryFactory := factoryP2P.NewPeerDiscovererCreator(*p2pConfig)
 	pDiscoverer, err := pDiscoveryFactory.CreatePeerDiscoverer()
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	log.Info(fmt.Sprintf("Starting with peer discovery: %s", pDiscoverer.Name()))
 
+	protector, err := factoryP2P.CreatePrivateNetworkProtector(p2pConfig.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+	if protector != nil {
+		log.Info("Starting in private network mode", "fingerprint", hex.EncodeToString(protector.Fingerprint()))
+	}
+
 	prvKey, _ := ecdsa.GenerateKey(btcec.S256(), randReader)
 	sk := (*libp2pCrypto.Secp256k1PrivateKey)(prvKey)
 
@@ -1258,12 +2172,13 @@ func createNetMessenger(
 		loadBalancer.NewOutgoingChannelLoadBalancer(),
 		pDiscoverer,
 		libp2p.ListenAddrWithIp4AndTcp,
+		protector,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return nm, nil
+	return nm, protector, nil
 }
 
 func newInterceptorAndResolverContainerFactory(
@@ -1273,8 +2188,13 @@ func newInterceptorAndResolverContainerFactory(
 	core *Core,
 	crypto *Crypto,
 	state *State,
-	network *Network,
+	resolversMessenger dataRetriever.TopicMessageHandler,
+	interceptorsTopicHandler process.TopicHandler,
 	economics *economics.EconomicsData,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
+	headerIntegrityVerifier process.HeaderIntegrityVerifier,
+	dataLimitsChecker process.InterceptedDataLimitsChecker,
+	peerAuthVerifier process.PeerAuthenticationVerifier,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, error) {
 
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
@@ -1285,8 +2205,13 @@ func newInterceptorAndResolverContainerFactory(
 			core,
 			crypto,
 			state,
-			network,
+			resolversMessenger,
+			interceptorsTopicHandler,
 			economics,
+			headerSigVerifier,
+			headerIntegrityVerifier,
+			dataLimitsChecker,
+			peerAuthVerifier,
 		)
 	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
@@ -1296,9 +2221,14 @@ func newInterceptorAndResolverContainerFactory(
 			data,
 			core,
 			crypto,
-			network,
+			resolversMessenger,
+			interceptorsTopicHandler,
 			state,
 			economics,
+			headerSigVerifier,
+			headerIntegrityVerifier,
+			dataLimitsChecker,
+			peerAuthVerifier,
 		)
 	}
 
@@ -1312,25 +2242,34 @@ func newShardInterceptorAndResolverContainerFactory(
 	core *Core,
 	crypto *Crypto,
 	state *State,
-	network *Network,
+	resolversMessenger dataRetriever.TopicMessageHandler,
+	interceptorsTopicHandler process.TopicHandler,
 	economics *economics.EconomicsData,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
+	headerIntegrityVerifier process.HeaderIntegrityVerifier,
+	dataLimitsChecker process.InterceptedDataLimitsChecker,
+	peerAuthVerifier process.PeerAuthenticationVerifier,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, error) {
 
 	interceptorContainerFactory, err := shard.NewInterceptorsContainerFactory(
 		state.AccountsAdapter,
 		shardCoordinator,
 		nodesCoordinator,
-		network.NetMessenger,
+		interceptorsTopicHandler,
 		data.Store,
 		core.Marshalizer,
 		core.Hasher,
 		crypto.TxSignKeyGen,
 		crypto.TxSingleSigner,
-		crypto.MultiSigner,
+		headerSigVerifier,
+		headerIntegrityVerifier,
 		data.Datapool,
 		state.AddressConverter,
 		maxTxNonceDeltaAllowed,
 		economics,
+		core.ChainID,
+		dataLimitsChecker,
+		peerAuthVerifier,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1343,7 +2282,7 @@ func newShardInterceptorAndResolverContainerFactory(
 
 	resolversContainerFactory, err := shardfactoryDataRetriever.NewResolversContainerFactory(
 		shardCoordinator,
-		network.NetMessenger,
+		resolversMessenger,
 		data.Store,
 		core.Marshalizer,
 		data.Datapool,
@@ -1363,19 +2302,25 @@ func newMetaInterceptorAndResolverContainerFactory(
 	data *Data,
 	core *Core,
 	crypto *Crypto,
-	network *Network,
+	resolversMessenger dataRetriever.TopicMessageHandler,
+	interceptorsTopicHandler process.TopicHandler,
 	state *State,
 	economics *economics.EconomicsData,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
+	headerIntegrityVerifier process.HeaderIntegrityVerifier,
+	dataLimitsChecker process.InterceptedDataLimitsChecker,
+	peerAuthVerifier process.PeerAuthenticationVerifier,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, error) {
 
 	interceptorContainerFactory, err := metachain.NewInterceptorsContainerFactory(
 		shardCoordinator,
 		nodesCoordinator,
-		network.NetMessenger,
+		interceptorsTopicHandler,
 		data.Store,
 		core.Marshalizer,
 		core.Hasher,
-		crypto.MultiSigner,
+		headerSigVerifier,
+		headerIntegrityVerifier,
 		data.MetaDatapool,
 		state.AccountsAdapter,
 		state.AddressConverter,
@@ -1383,6 +2328,9 @@ func newMetaInterceptorAndResolverContainerFactory(
 		crypto.TxSignKeyGen,
 		maxTxNonceDeltaAllowed,
 		economics,
+		core.ChainID,
+		dataLimitsChecker,
+		peerAuthVerifier,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1395,7 +2343,7 @@ func newMetaInterceptorAndResolverContainerFactory(
 
 	resolversContainerFactory, err := metafactoryDataRetriever.NewResolversContainerFactory(
 		shardCoordinator,
-		network.NetMessenger,
+		resolversMessenger,
 		data.Store,
 		core.Marshalizer,
 		data.MetaDatapool,
@@ -1414,6 +2362,7 @@ func generateGenesisHeadersAndApplyInitialBalances(
 	shardCoordinator sharding.Coordinator,
 	nodesSetup *sharding.NodesSetup,
 	genesisConfig *sharding.Genesis,
+	genesisChecksum []byte,
 ) (map[uint32]data.HeaderHandler, error) {
 	//TODO change this rudimentary startup for metachain nodes
 	// Talk between Adrian, Robert and Iulian, did not want it to be discarded:
@@ -1451,6 +2400,7 @@ func generateGenesisHeadersAndApplyInitialBalances(
 			stateComponents.AddressConverter,
 			genesisConfig,
 			uint64(nodesSetup.StartTime),
+			genesisChecksum,
 		)
 		if err != nil {
 			return nil, err
@@ -1465,6 +2415,7 @@ func generateGenesisHeadersAndApplyInitialBalances(
 		stateComponents.AddressConverter,
 		genesisConfig,
 		uint64(nodesSetup.StartTime),
+		genesisChecksum,
 	)
 	if err != nil {
 		return nil, err
@@ -1492,6 +2443,7 @@ func createGenesisBlockAndApplyInitialBalances(
 	addressConverter state.AddressConverter,
 	genesisConfig *sharding.Genesis,
 	startTime uint64,
+	genesisChecksum []byte,
 ) (data.HeaderHandler, error) {
 
 	initialBalances, err := genesisConfig.InitialNodesBalances(shardCoordinator, addressConverter)
@@ -1499,12 +2451,19 @@ func createGenesisBlockAndApplyInitialBalances(
 		return nil, err
 	}
 
+	delegations, err := genesisConfig.InitialDelegations(shardCoordinator, addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis.CreateShardGenesisBlockFromInitialBalances(
 		accounts,
 		shardCoordinator,
 		addressConverter,
 		initialBalances,
+		delegations,
 		startTime,
+		genesisChecksum,
 	)
 }
 
@@ -1556,13 +2515,25 @@ func newBlockProcessor(
 	core *Core,
 	state *State,
 	forkDetector process.ForkDetector,
+	blockTracker process.BlockTracker,
+	headersNotifier dataRetriever.HeadersNotifier,
 	shardsGenesisBlocks map[uint32]data.HeaderHandler,
 	coreServiceContainer serviceContainer.Core,
+	builtInFunctionsConfig config.BuiltInFunctionsConfig,
+	epochNotifier process.EpochNotifier,
+	gasSchedule core.GasScheduleNotifier,
+	pendingMiniBlocks process.PendingMiniBlocksHandler,
+	outgoingOperations process.OutgoingOperationsHandler,
+	selectionAuditor process.ValidatorsSelectionAuditor,
+	supplyAccountant process.SupplyAccountant,
+	esdtSupplyTracker process.ESDTSupplyTracker,
+	vmExecutionGuardConfig config.VMExecutionGuardConfig,
 ) (process.BlockProcessor, error) {
 
 	communityAddr := economics.CommunityAddress()
 	burnAddr := economics.BurnAddress()
-	if communityAddr == "" || burnAddr == "" {
+	protocolSustainabilityAddr := economics.ProtocolSustainabilityAddress()
+	if communityAddr == "" || burnAddr == "" || protocolSustainabilityAddr == "" {
 		return nil, errors.New("rewards configuration missing")
 	}
 
@@ -1576,9 +2547,15 @@ func newBlockProcessor(
 		return nil, err
 	}
 
+	protocolSustainabilityAddress, err := hex.DecodeString(protocolSustainabilityAddr)
+	if err != nil {
+		return nil, err
+	}
+
 	specialAddressHolder, err := address.NewSpecialAddressHolder(
 		communityAddress,
 		burnAddress,
+		protocolSustainabilityAddress,
 		state.AddressConverter,
 		shardCoordinator,
 		nodesCoordinator,
@@ -1597,9 +2574,19 @@ func newBlockProcessor(
 			core,
 			state,
 			forkDetector,
+			blockTracker,
+			headersNotifier,
 			shardsGenesisBlocks,
 			coreServiceContainer,
 			economics,
+			builtInFunctionsConfig,
+			epochNotifier,
+			gasSchedule,
+			outgoingOperations,
+			selectionAuditor,
+			supplyAccountant,
+			esdtSupplyTracker,
+			vmExecutionGuardConfig,
 		)
 	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
@@ -1612,14 +2599,48 @@ func newBlockProcessor(
 			core,
 			state,
 			forkDetector,
+			blockTracker,
+			headersNotifier,
 			shardsGenesisBlocks,
 			coreServiceContainer,
+			pendingMiniBlocks,
+			outgoingOperations,
+			selectionAuditor,
 		)
 	}
 
 	return nil, errors.New("could not create block processor and tracker")
 }
 
+func guardVMExecutions(vmContainer process.VirtualMachinesContainer, guardConfig config.VMExecutionGuardConfig) error {
+	if !guardConfig.Enabled {
+		return nil
+	}
+
+	for _, key := range vmContainer.Keys() {
+		vm, err := vmContainer.Get(key)
+		if err != nil {
+			return err
+		}
+
+		guard, err := smartContract.NewVMExecutionGuard(
+			vm,
+			time.Duration(guardConfig.MinDurationInMs)*time.Millisecond,
+			time.Duration(guardConfig.DurationPerGasUnitInNs)*time.Nanosecond,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = vmContainer.Replace(key, guard)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func newShardBlockProcessor(
 	resolversFinder dataRetriever.ResolversFinder,
 	shardCoordinator sharding.Coordinator,
@@ -1629,16 +2650,34 @@ func newShardBlockProcessor(
 	core *Core,
 	state *State,
 	forkDetector process.ForkDetector,
+	blockTracker process.BlockTracker,
+	headersNotifier dataRetriever.HeadersNotifier,
 	shardsGenesisBlocks map[uint32]data.HeaderHandler,
 	coreServiceContainer serviceContainer.Core,
 	economics *economics.EconomicsData,
+	builtInFunctionsConfig config.BuiltInFunctionsConfig,
+	epochNotifier process.EpochNotifier,
+	gasSchedule core.GasScheduleNotifier,
+	outgoingOperations process.OutgoingOperationsHandler,
+	selectionAuditor process.ValidatorsSelectionAuditor,
+	supplyAccountant process.SupplyAccountant,
+	esdtSupplyTracker process.ESDTSupplyTracker,
+	vmExecutionGuardConfig config.VMExecutionGuardConfig,
 ) (process.BlockProcessor, error) {
 	argsParser, err := smartContract.NewAtArgumentParser()
 	if err != nil {
 		return nil, err
 	}
 
-	vmFactory, err := shard.NewVMContainerFactory(state.AccountsAdapter, state.AddressConverter)
+	argsHook := hooks.ArgBlockChainHook{
+		Accounts:         state.AccountsAdapter,
+		AddrConv:         state.AddressConverter,
+		StorageService:   data.Store,
+		BlockChain:       data.Blkc,
+		ShardCoordinator: shardCoordinator,
+		Uint64Converter:  core.Uint64ByteSliceConverter,
+	}
+	vmFactory, err := shard.NewVMContainerFactory(argsHook)
 	if err != nil {
 		return nil, err
 	}
@@ -1648,6 +2687,11 @@ func newShardBlockProcessor(
 		return nil, err
 	}
 
+	err = guardVMExecutions(vmContainer, vmExecutionGuardConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
 		core.Marshalizer,
@@ -1687,17 +2731,51 @@ func newShardBlockProcessor(
 		return nil, process.ErrWrongTypeAssertion
 	}
 
+	blockFeesHandler, ok := rewardsTxInterim.(process.BlockFeesHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	builtInFunctionsContainer, err := builtInFunctions.CreateBuiltInFunctionContainer(
+		builtInFunctions.ArgsCreateBuiltInFunctionContainer{
+			Accounts:      state.AccountsAdapter,
+			AddrConv:      state.AddressConverter,
+			Marshalizer:   core.Marshalizer,
+			Hasher:        core.Hasher,
+			SCRForwarder:  scForwarder,
+			EpochHandler:  specialAddressHandler,
+			GasSchedule:   gasSchedule,
+			SupplyTracker: esdtSupplyTracker,
+			Config:        builtInFunctionsConfig,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txLogsProcessor, err := transactionLog.NewTxLogProcessor(
+		data.Store.GetStorer(dataRetriever.TxLogsUnit),
+		core.Marshalizer,
+		core.Hasher,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	scProcessor, err := smartContract.NewSmartContractProcessor(
 		vmContainer,
 		argsParser,
 		core.Hasher,
 		core.Marshalizer,
 		state.AccountsAdapter,
-		vmFactory.VMAccountsDB(),
+		vmFactory.BlockChainHookImpl(),
 		state.AddressConverter,
 		shardCoordinator,
 		scForwarder,
 		rewardsTxHandler,
+		builtInFunctionsContainer,
+		txLogsProcessor,
+		economics,
 	)
 	if err != nil {
 		return nil, err
@@ -1742,6 +2820,8 @@ func newShardBlockProcessor(
 		rewardsTxHandler,
 		txTypeHandler,
 		economics,
+		builtInFunctionsContainer,
+		core.ChainID,
 	)
 	if err != nil {
 		return nil, errors.New("could not create transaction processor: " + err.Error())
@@ -1752,6 +2832,11 @@ func newShardBlockProcessor(
 		return nil, err
 	}
 
+	gasHandler, err := preprocess.NewGasComputation(economics, economics)
+	if err != nil {
+		return nil, err
+	}
+
 	preProcFactory, err := shard.NewPreProcessorsContainerFactory(
 		shardCoordinator,
 		data.Store,
@@ -1768,6 +2853,7 @@ func newShardBlockProcessor(
 		internalTransactionProducer,
 		economics,
 		miniBlocksCompacter,
+		gasHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -1803,6 +2889,8 @@ func newShardBlockProcessor(
 	argumentsBaseProcessor := block.ArgBaseProcessor{
 		Accounts:              state.AccountsAdapter,
 		ForkDetector:          forkDetector,
+		BlockTracker:          blockTracker,
+		HeadersNotifier:       headersNotifier,
 		Hasher:                core.Hasher,
 		Marshalizer:           core.Marshalizer,
 		Store:                 data.Store,
@@ -1812,13 +2900,23 @@ func newShardBlockProcessor(
 		Uint64Converter:       core.Uint64ByteSliceConverter,
 		StartHeaders:          shardsGenesisBlocks,
 		RequestHandler:        requestHandler,
+		HistoryRepository:     data.HistoryRepository,
+		OutgoingOperations:    outgoingOperations,
+		SelectionAuditor:      selectionAuditor,
 		Core:                  coreServiceContainer,
+		ChainID:               core.ChainID,
+		SoftwareVersion:       []byte(headerSoftwareVersion),
 	}
 	arguments := block.ArgShardProcessor{
-		ArgBaseProcessor: argumentsBaseProcessor,
-		DataPool:         data.Datapool,
-		TxCoordinator:    txCoordinator,
-		TxsPoolsCleaner:  txPoolsCleaner,
+		ArgBaseProcessor:  argumentsBaseProcessor,
+		DataPool:          data.Datapool,
+		TxCoordinator:     txCoordinator,
+		TxsPoolsCleaner:   txPoolsCleaner,
+		BlockChainHook:    vmFactory.BlockChainHookImpl(),
+		EpochNotifier:     epochNotifier,
+		SupplyAccountant:  supplyAccountant,
+		BlockFeesHandler:  blockFeesHandler,
+		ESDTSupplyTracker: esdtSupplyTracker,
 	}
 
 	blockProcessor, err := block.NewShardProcessor(arguments)
@@ -1843,8 +2941,13 @@ func newMetaBlockProcessor(
 	core *Core,
 	state *State,
 	forkDetector process.ForkDetector,
+	blockTracker process.BlockTracker,
+	headersNotifier dataRetriever.HeadersNotifier,
 	shardsGenesisBlocks map[uint32]data.HeaderHandler,
 	coreServiceContainer serviceContainer.Core,
+	pendingMiniBlocks process.PendingMiniBlocksHandler,
+	outgoingOperations process.OutgoingOperationsHandler,
+	selectionAuditor process.ValidatorsSelectionAuditor,
 ) (process.BlockProcessor, error) {
 
 	requestHandler, err := requestHandlers.NewMetaResolverRequestHandler(
@@ -1862,6 +2965,8 @@ func newMetaBlockProcessor(
 	argumentsBaseProcessor := block.ArgBaseProcessor{
 		Accounts:              state.AccountsAdapter,
 		ForkDetector:          forkDetector,
+		BlockTracker:          blockTracker,
+		HeadersNotifier:       headersNotifier,
 		Hasher:                core.Hasher,
 		Marshalizer:           core.Marshalizer,
 		Store:                 data.Store,
@@ -1871,11 +2976,17 @@ func newMetaBlockProcessor(
 		Uint64Converter:       core.Uint64ByteSliceConverter,
 		StartHeaders:          shardsGenesisBlocks,
 		RequestHandler:        requestHandler,
+		HistoryRepository:     data.HistoryRepository,
+		OutgoingOperations:    outgoingOperations,
+		SelectionAuditor:      selectionAuditor,
 		Core:                  coreServiceContainer,
+		ChainID:               core.ChainID,
+		SoftwareVersion:       []byte(headerSoftwareVersion),
 	}
 	arguments := block.ArgMetaProcessor{
-		ArgBaseProcessor: argumentsBaseProcessor,
-		DataPool:         data.MetaDatapool,
+		ArgBaseProcessor:  argumentsBaseProcessor,
+		DataPool:          data.MetaDatapool,
+		PendingMiniBlocks: pendingMiniBlocks,
 	}
 
 	metaProcessor, err := block.NewMetaProcessor(arguments)
@@ -1899,13 +3010,15 @@ func getCacherFromConfig(cfg config.CacheConfig) storageUnit.CacheConfig {
 	}
 }
 
-func getDBFromConfig(cfg config.DBConfig, uniquePath string) storageUnit.DBConfig {
+func getDBFromConfig(cfg config.DBConfig, uniquePath string, encryptionKey []byte) storageUnit.DBConfig {
 	return storageUnit.DBConfig{
 		FilePath:          filepath.Join(uniquePath, cfg.FilePath),
 		Type:              storageUnit.DBType(cfg.Type),
 		MaxBatchSize:      cfg.MaxBatchSize,
 		BatchDelaySeconds: cfg.BatchDelaySeconds,
 		MaxOpenFiles:      cfg.MaxOpenFiles,
+		Encrypted:         cfg.Encrypted,
+		EncryptionKey:     encryptionKey,
 	}
 }
 
@@ -24,6 +24,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/cmd/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
+	"github.com/ElrondNetwork/elrond-go/core/genesis"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
@@ -31,19 +34,37 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie/trieProfiler"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/accountsExport"
+	"github.com/ElrondNetwork/elrond-go/node/auction"
+	"github.com/ElrondNetwork/elrond-go/node/delegation"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/hardfork"
+	"github.com/ElrondNetwork/elrond-go/node/managedNonce"
+	"github.com/ElrondNetwork/elrond-go/node/stateConsistency"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/accountsRepository"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	factoryVM "github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/importdb"
+	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	"github.com/ElrondNetwork/elrond-go/process/txstatus"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	factoryViews "github.com/ElrondNetwork/elrond-go/statusHandler/factory"
+	systemVMFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	systemVMProcess "github.com/ElrondNetwork/elrond-go/vm/process"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm/iele/elrond/node/endpoint"
 	"github.com/google/gops/agent"
@@ -57,7 +78,11 @@ const (
 	defaultEpochString = "Epoch"
 	defaultShardString = "Shard"
 	metachainShardName = "metachain"
+	allShardsName      = "all"
 	DefaultRestApiPort = "off"
+	bytesInMegabyte    = 1024 * 1024
+
+	importDBMaxTimeToProcessBlock = time.Minute
 )
 
 var (
@@ -113,12 +138,24 @@ VERSION:
 		Usage: "The economics configuration file to load",
 		Value: "./config/economics.toml",
 	}
+	// configurationRatingsFile defines a flag for the path to the ratings toml configuration file
+	configurationRatingsFile = cli.StringFlag{
+		Name:  "configRatings",
+		Usage: "The ratings configuration file to load",
+		Value: "./config/ratings.toml",
+	}
 	// configurationPreferencesFile defines a flag for the path to the preferences toml configuration file
 	configurationPreferencesFile = cli.StringFlag{
 		Name:  "configPreferences",
 		Usage: "The preferences configuration file to load",
 		Value: "./config/prefs.toml",
 	}
+	// gasScheduleConfigurationDirectory defines a flag for the path to the directory containing the gas schedule files
+	gasScheduleConfigurationDirectory = cli.StringFlag{
+		Name:  "gasScheduleConfigDirectory",
+		Usage: "The directory containing the gas schedule files referenced in the main configuration file",
+		Value: "./config/gasSchedules",
+	}
 	// p2pConfigurationFile defines a flag for the path to the toml file containing P2P configuration
 	p2pConfigurationFile = cli.StringFlag{
 		Name:  "p2pconfig",
@@ -235,11 +272,27 @@ VERSION:
 		Usage: "The file containing the secret keys which ...",
 		Value: "./config/initialNodesSk.pem",
 	}
+	// storageEncryptionKeyFile defines a flag for the path to the pem file holding the key used to encrypt the
+	// storage units marked as encrypted in the main configuration file
+	storageEncryptionKeyFile = cli.StringFlag{
+		Name:  "storage-encryption-key-file",
+		Usage: "The pem file containing the 16, 24 or 32 bytes AES key used to encrypt the storage units marked as encrypted",
+		Value: "",
+	}
+	// importDbDirectory defines a flag for the db directory of another node, whose blocks will be re-processed
+	importDbDirectory = cli.StringFlag{
+		Name: "import-db",
+		Usage: "This flag, if set, makes the node re-process all the blocks found in the provided db directory of " +
+			"another node, rebuilding its state and indexes, after which it exits. It should be used together " +
+			"with the storage-cleanup flag",
+		Value: "",
+	}
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
-		Name:  "logLevel",
-		Usage: "This flag specifies the logger level",
-		Value: logger.LogInfo,
+		Name: "logLevel",
+		Usage: "This flag specifies the logger level(s). It can contain multiple comma-separated pattern:LEVEL " +
+			"entries, where the pattern is a package path, optionally ended in *. Example: *:INFO,process/*:DEBUG",
+		Value: "*:" + logger.LogInfo,
 	}
 	// bootstrapRoundIndex defines a flag that specifies the round index from which node should bootstrap from storage
 	bootstrapRoundIndex = cli.Uint64Flag{
@@ -302,7 +355,9 @@ func main() {
 		port,
 		configurationFile,
 		configurationEconomicsFile,
+		configurationRatingsFile,
 		configurationPreferencesFile,
+		gasScheduleConfigurationDirectory,
 		p2pConfigurationFile,
 		txSignSk,
 		sk,
@@ -313,6 +368,8 @@ func main() {
 		storageCleanup,
 		initialBalancesSkPemFile,
 		initialNodesSkPemFile,
+		storageEncryptionKeyFile,
+		importDbDirectory,
 		gopsEn,
 		serversConfigurationFile,
 		networkID,
@@ -361,7 +418,10 @@ func getSuite(config *config.Config) (crypto.Suite, error) {
 
 func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 	logLevel := ctx.GlobalString(logLevel.Name)
-	log.SetLevel(logLevel)
+	err := logger.SetLogLevel(logLevel)
+	if err != nil {
+		return err
+	}
 
 	enableGopsIfNeeded(ctx, log)
 
@@ -378,6 +438,7 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		return err
 	}
 	log.Info(fmt.Sprintf("Initialized with config from: %s", configurationFileName))
+	logger.ToggleCorrelation(generalConfig.Logger.EnableCorrelation)
 
 	configurationEconomicsFileName := ctx.GlobalString(configurationEconomicsFile.Name)
 	economicsConfig, err := loadEconomicsConfig(configurationEconomicsFileName, log)
@@ -386,6 +447,13 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 	}
 	log.Info(fmt.Sprintf("Initialized with config economics from: %s", configurationEconomicsFileName))
 
+	configurationRatingsFileName := ctx.GlobalString(configurationRatingsFile.Name)
+	ratingsConfig, err := loadRatingsConfig(configurationRatingsFileName, log)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Initialized with config ratings from: %s", configurationRatingsFileName))
+
 	configurationPreferencesFileName := ctx.GlobalString(configurationPreferencesFile.Name)
 	preferencesConfig, err := loadPreferencesConfig(configurationPreferencesFileName, log)
 	if err != nil {
@@ -451,6 +519,11 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 	}
 	log.Info("Starting with public key: " + factory.GetPkEncoded(pubKey))
 
+	storageEncryptionKey, err := loadStorageEncryptionKey(ctx, log)
+	if err != nil {
+		return err
+	}
+
 	if ctx.IsSet(destinationShardAsObserver.Name) {
 		generalConfig.GeneralSettings.DestinationShardAsObserver = ctx.GlobalString(destinationShardAsObserver.Name)
 	}
@@ -463,10 +536,17 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		preferencesConfig.Preferences.NodeDisplayName = ctx.GlobalString(nodeDisplayName.Name)
 	}
 
-	shardCoordinator, nodeType, err := createShardCoordinator(nodesConfig, pubKey, generalConfig.GeneralSettings, log)
+	selfShardIds, nodeType, err := getSelfShardIds(nodesConfig, pubKey, generalConfig.GeneralSettings, log)
+	if err != nil {
+		return err
+	}
+	err = checkAccountsExportConfig(generalConfig, nodeType)
 	if err != nil {
 		return err
 	}
+	if len(selfShardIds) > 1 && generalConfig.Explorer.Enabled {
+		return errors.New("the explorer can not be enabled when following more than one shard")
+	}
 
 	var workingDir = ""
 	if ctx.IsSet(workingDirectory.Name) {
@@ -479,25 +559,6 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		}
 	}
 
-	var shardId = "metachain"
-	if shardCoordinator.SelfId() != sharding.MetachainShardId {
-		shardId = fmt.Sprintf("%d", shardCoordinator.SelfId())
-	}
-
-	uniqueDBFolder := filepath.Join(
-		workingDir,
-		defaultDBPath,
-		fmt.Sprintf("%s_%d", defaultEpochString, 0),
-		fmt.Sprintf("%s_%s", defaultShardString, shardId))
-
-	storageCleanup := ctx.GlobalBool(storageCleanup.Name)
-	if storageCleanup {
-		err = os.RemoveAll(uniqueDBFolder)
-		if err != nil {
-			return err
-		}
-	}
-
 	logDirectory := filepath.Join(workingDir, defaultLogPath)
 
 	err = os.MkdirAll(logDirectory, os.ModePerm)
@@ -505,27 +566,6 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		return err
 	}
 
-	coreArgs := factory.NewCoreComponentsFactoryArgs(generalConfig, uniqueDBFolder)
-	coreComponents, err := factory.CoreComponentsFactory(coreArgs)
-	if err != nil {
-		return err
-	}
-
-	nodesCoordinator, err := createNodesCoordinator(
-		nodesConfig,
-		generalConfig.GeneralSettings,
-		pubKey,
-		coreComponents.Hasher)
-	if err != nil {
-		return err
-	}
-
-	stateArgs := factory.NewStateComponentsFactoryArgs(generalConfig, genesisConfig, shardCoordinator, coreComponents)
-	stateComponents, err := factory.StateComponentsFactory(stateArgs)
-	if err != nil {
-		return err
-	}
-
 	err = initLogFileAndStatsMonitor(generalConfig, pubKey, log, workingDir)
 	if err != nil {
 		return err
@@ -568,52 +608,20 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		log.Warn("No views for current node")
 	}
 
-	statusMetrics := statusHandler.NewStatusMetrics()
-	appStatusHandlers = append(appStatusHandlers, statusMetrics)
-
-	if len(appStatusHandlers) > 0 {
-		coreComponents.StatusHandler, err = statusHandler.NewAppStatusFacadeWithHandlers(appStatusHandlers...)
-		if err != nil {
-			log.Warn("Cannot init AppStatusFacade", err)
-		}
-	} else {
-		coreComponents.StatusHandler = statusHandler.NewNilStatusHandler()
-		log.Info("No AppStatusHandler used. Started with NilStatusHandler")
-	}
-
-	metrics.InitMetrics(coreComponents.StatusHandler, pubKey, nodeType, shardCoordinator, nodesConfig, version, economicsConfig)
-
-	dataArgs := factory.NewDataComponentsFactoryArgs(generalConfig, shardCoordinator, coreComponents, uniqueDBFolder)
-	dataComponents, err := factory.DataComponentsFactory(dataArgs)
+	economicsData, err := economics.NewEconomicsData(economicsConfig)
 	if err != nil {
 		return err
 	}
 
-	cryptoArgs := factory.NewCryptoComponentsFactoryArgs(
-		ctx,
-		generalConfig,
-		nodesConfig,
-		shardCoordinator,
-		keyGen,
-		privKey,
-		log,
-		initialBalancesSkPemFile.Name,
-		txSignSk.Name,
-		txSignSkIndex.Name,
-	)
-	cryptoComponents, err := factory.CryptoComponentsFactory(cryptoArgs)
+	rater, err := rating.NewBlockSigningRater(*ratingsConfig)
 	if err != nil {
 		return err
 	}
 
-	txSignPk := factory.GetPkEncoded(cryptoComponents.TxSignPubKey)
-	metrics.SaveCurrentNodeNameAndPubKey(coreComponents.StatusHandler, txSignPk, preferencesConfig.Preferences.NodeDisplayName)
-
-	sessionInfoFileOutput := fmt.Sprintf("%s:%s\n%s:%s\n%s:%s\n%s:%v\n%s:%s\n%s:%v\n",
+	sessionInfoFileOutput := fmt.Sprintf("%s:%s\n%s:%s\n%s:%v\n%s:%s\n%s:%v\n",
 		"PkBlockSign", factory.GetPkEncoded(pubKey),
-		"PkAccount", factory.GetPkEncoded(cryptoComponents.TxSignPubKey),
-		"ShardId", shardId,
-		"TotalShards", shardCoordinator.NumberOfShards(),
+		"ShardId", shardIdsToString(selfShardIds),
+		"TotalShards", nodesConfig.NumberOfShards(),
 		"AppVersion", version,
 		"GenesisTimeStamp", startTime.Unix(),
 	)
@@ -629,174 +637,748 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 	err = ioutil.WriteFile(filepath.Join(logDirectory, "session.info"), []byte(sessionInfoFileOutput), os.ModePerm)
 	log.LogIfError(err)
 
-	networkComponents, err := factory.NetworkComponentsFactory(p2pConfig, log, coreComponents)
+	shutdownTimeout := time.Duration(generalConfig.GeneralSettings.ShutdownTimeoutInSec) * time.Second
+	lifecycleManager, err := factory.NewLifecycleManager(shutdownTimeout)
 	if err != nil {
 		return err
 	}
 
-	tpsBenchmark, err := statistics.NewTPSBenchmark(shardCoordinator.NumberOfShards(), nodesConfig.RoundDuration/1000)
-	if err != nil {
-		return err
-	}
+	isImportDBMode := len(ctx.GlobalString(importDbDirectory.Name)) > 0
+	facades := make([]*facade.ElrondNodeFacade, 0, len(selfShardIds))
+	for index, selfShardId := range selfShardIds {
+		pipelineStatusHandlers := make([]core.AppStatusHandler, 0)
+		if index == 0 {
+			pipelineStatusHandlers = append(pipelineStatusHandlers, appStatusHandlers...)
+		}
 
-	if generalConfig.Explorer.Enabled {
-		serversConfigurationFileName := ctx.GlobalString(serversConfigurationFile.Name)
-		dbIndexer, err = createElasticIndexer(
-			ctx,
-			serversConfigurationFileName,
-			generalConfig.Explorer.IndexerURL,
-			shardCoordinator,
-			coreComponents.Marshalizer,
-			coreComponents.Hasher,
-			log)
+		ef, err := startShardPipeline(&shardPipelineArgs{
+			ctx:               ctx,
+			log:               log,
+			version:           version,
+			index:             index,
+			selfShardId:       selfShardId,
+			nodeType:          nodeType,
+			generalConfig:     generalConfig,
+			preferencesConfig: preferencesConfig,
+			p2pConfig:         p2pConfig,
+			genesisConfig:     genesisConfig,
+			nodesConfig:       nodesConfig,
+			economicsConfig:   economicsConfig,
+			economicsData:     economicsData,
+			rater:             rater,
+			syncer:            syncer,
+			keyGen:            keyGen,
+			privKey:           privKey,
+			pubKey:            pubKey,
+			workingDir:        workingDir,
+			appStatusHandlers: pipelineStatusHandlers,
+			useTermui:         useTermui,
+			usePrometheus:     usePrometheusBool && index == 0,
+			prometheusJoinUrl: prometheusJoinUrl,
+			lifecycleManager:  lifecycleManager,
+
+			storageEncryptionKey: storageEncryptionKey,
+		})
 		if err != nil {
+			log.LogIfError(lifecycleManager.Close())
 			return err
 		}
 
-		err = setServiceContainer(shardCoordinator, tpsBenchmark)
+		facades = append(facades, ef)
+	}
+
+	if isImportDBMode {
+		log.Info("import db finished, closing the node components...")
+		return lifecycleManager.Close()
+	}
+
+	if !ctx.Bool(withUI.Name) {
+		log.Info("Bootstrapping node....")
+		for _, ef := range facades {
+			err = ef.StartNode()
+			if err != nil {
+				log.Error("starting node failed", err.Error())
+				log.LogIfError(lifecycleManager.Close())
+				return err
+			}
+		}
+	}
+
+	go func() {
+		<-sigs
+		log.Info("terminating at user's signal...")
+		stop <- true
+
+		<-sigs
+		log.Warn("forced termination at user's second signal, the components were not closed")
+		os.Exit(1)
+	}()
+
+	log.Info("Application is now running...")
+	<-stop
+
+	log.Info("closing the node components...")
+	err = lifecycleManager.Close()
+	log.LogIfError(err)
+
+	if rm != nil {
+		err = rm.Close()
+		log.LogIfError(err)
+	}
+	return nil
+}
+
+// shardPipelineArgs holds the components shared by all the shards followed by the node and the position
+// of the shard in the list of followed shards
+type shardPipelineArgs struct {
+	ctx               *cli.Context
+	log               *logger.Logger
+	version           string
+	index             int
+	selfShardId       uint32
+	nodeType          core.NodeType
+	generalConfig     *config.Config
+	preferencesConfig *config.ConfigPreferences
+	p2pConfig         *config.P2PConfig
+	genesisConfig     *sharding.Genesis
+	nodesConfig       *sharding.NodesSetup
+	economicsConfig   *config.ConfigEconomics
+	economicsData     *economics.EconomicsData
+	rater             process.RaterHandler
+	syncer            ntp.SyncTimer
+	keyGen            crypto.KeyGenerator
+	privKey           crypto.PrivateKey
+	pubKey            crypto.PublicKey
+	workingDir        string
+	appStatusHandlers []core.AppStatusHandler
+	useTermui         bool
+	usePrometheus     bool
+	prometheusJoinUrl string
+	lifecycleManager  *factory.LifecycleManager
+
+	storageEncryptionKey []byte
+}
+
+// startShardPipeline creates the storage, processing components, node and facade for one of the shards followed by
+// the node. Every shard after the first one gets its own p2p and REST API ports, next to the configured ones
+func startShardPipeline(args *shardPipelineArgs) (*facade.ElrondNodeFacade, error) {
+	ctx := args.ctx
+	log := args.log
+	generalConfig := args.generalConfig
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(args.nodesConfig.NumberOfShards(), args.selfShardId)
+	if err != nil {
+		return nil, err
+	}
+
+	shardId := shardIdToString(shardCoordinator.SelfId())
+	log.Info(fmt.Sprintf("Starting in shard: %s", shardId))
+
+	uniqueDBFolder := filepath.Join(
+		args.workingDir,
+		defaultDBPath,
+		fmt.Sprintf("%s_%d", defaultEpochString, 0),
+		fmt.Sprintf("%s_%s", defaultShardString, shardId))
+
+	storageCleanup := ctx.GlobalBool(storageCleanup.Name)
+	if storageCleanup {
+		err = os.RemoveAll(uniqueDBFolder)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	economicsData, err := economics.NewEconomicsData(economicsConfig)
+	coreArgs := factory.NewCoreComponentsFactoryArgs(generalConfig, uniqueDBFolder, args.storageEncryptionKey)
+	coreComponents, err := factory.CoreComponentsFactory(coreArgs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	processArgs := factory.NewProcessComponentsFactoryArgs(
-		genesisConfig,
-		economicsData,
-		nodesConfig,
-		syncer,
-		shardCoordinator,
-		nodesCoordinator,
-		dataComponents,
-		coreComponents,
-		cryptoComponents,
-		stateComponents,
-		networkComponents,
-		coreServiceContainer,
-	)
-	processComponents, err := factory.ProcessComponentsFactory(processArgs)
+	err = args.lifecycleManager.Register("accounts trie storer", coreComponents.TrieStorer)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var elasticIndexer indexer.Indexer
-	if coreServiceContainer == nil || coreServiceContainer.IsInterfaceNil() {
-		elasticIndexer = nil
-	} else {
-		elasticIndexer = coreServiceContainer.Indexer()
+	nodesCoordinator, err := createNodesCoordinator(
+		args.nodesConfig,
+		args.selfShardId,
+		generalConfig.ConsensusGroupSelection,
+		generalConfig.ConsensusByEpochs,
+		args.pubKey,
+		coreComponents.Hasher,
+		args.rater)
+	if err != nil {
+		return nil, err
 	}
 
-	currentNode, err := createNode(
-		generalConfig,
-		preferencesConfig,
-		nodesConfig,
-		syncer,
-		keyGen,
-		privKey,
-		pubKey,
-		shardCoordinator,
-		nodesCoordinator,
-		coreComponents,
-		stateComponents,
-		dataComponents,
-		cryptoComponents,
-		processComponents,
-		networkComponents,
-		ctx.GlobalUint64(bootstrapRoundIndex.Name),
-		version,
-		elasticIndexer,
-	)
+	stateArgs := factory.NewStateComponentsFactoryArgs(generalConfig, args.genesisConfig, shardCoordinator, coreComponents)
+	stateComponents, err := factory.StateComponentsFactory(stateArgs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	softwareVersionChecker, err := factory.CreateSoftwareVersionChecker(coreComponents.StatusHandler)
+	statusMetrics := statusHandler.NewStatusMetrics()
+	appStatusHandlers := append(args.appStatusHandlers, statusMetrics)
+	coreComponents.StatusHandler, err = statusHandler.NewAppStatusFacadeWithHandlers(appStatusHandlers...)
 	if err != nil {
-		log.Info("nil software version checker", err)
-	} else {
-		softwareVersionChecker.StartCheckSoftwareVersion()
+		log.Warn("Cannot init AppStatusFacade", err)
 	}
 
-	if shardCoordinator.SelfId() == sharding.MetachainShardId {
-		indexValidatorsListIfNeeded(elasticIndexer, nodesCoordinator)
+	appStatusHandler := coreComponents.StatusHandler
+	err = args.lifecycleManager.Register("status handler", factory.CloserFunc(func() error {
+		appStatusHandler.Close()
+		return nil
+	}))
+	if err != nil {
+		return nil, err
 	}
 
-	vmAccountsDB, err := hooks.NewVMAccountsDB(
-		stateComponents.AccountsAdapter,
-		stateComponents.AddressConverter,
+	metrics.InitMetrics(
+		coreComponents.StatusHandler,
+		args.pubKey,
+		args.nodeType,
+		shardCoordinator,
+		args.nodesConfig,
+		args.version,
+		args.economicsConfig,
+	)
+
+	dataArgs := factory.NewDataComponentsFactoryArgs(
+		generalConfig,
+		shardCoordinator,
+		coreComponents,
+		uniqueDBFolder,
+		args.storageEncryptionKey,
 	)
+	dataComponents, err := factory.DataComponentsFactory(dataArgs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	apiResolver, err := createApiResolver(vmAccountsDB, statusMetrics)
+	err = args.lifecycleManager.Register("data storage", factory.CloserFunc(dataComponents.Store.CloseAll))
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if dataComponents.PoolsCapacity != nil {
+		err = args.lifecycleManager.Register("pools capacity controller", dataComponents.PoolsCapacity)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	err = metrics.StartStatusPolling(
-		currentNode.GetAppStatusHandler(),
-		generalConfig.GeneralSettings.StatusPollingIntervalSec,
-		networkComponents,
-		processComponents,
+	cryptoArgs := factory.NewCryptoComponentsFactoryArgs(
+		ctx,
+		generalConfig,
+		args.nodesConfig,
+		shardCoordinator,
+		args.keyGen,
+		args.privKey,
+		log,
+		initialBalancesSkPemFile.Name,
+		txSignSk.Name,
+		txSignSkIndex.Name,
+		coreComponents.Hasher,
+		coreComponents.StatusHandler,
 	)
+	cryptoComponents, err := factory.CryptoComponentsFactory(cryptoArgs)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	updateMachineStatisticsDurationSec := 1
-	err = metrics.StartMachineStatisticsPolling(coreComponents.StatusHandler, updateMachineStatisticsDurationSec)
+	err = args.lifecycleManager.Register("signature verifier", cryptoComponents.SigVerifier)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	restAPIServerDebugMode := !useTermui
-	ef := facade.NewElrondNodeFacade(currentNode, apiResolver, restAPIServerDebugMode)
+	txSignPk := factory.GetPkEncoded(cryptoComponents.TxSignPubKey)
+	metrics.SaveCurrentNodeNameAndPubKey(coreComponents.StatusHandler, txSignPk, args.preferencesConfig.Preferences.NodeDisplayName)
 
-	efConfig := &config.FacadeConfig{
-		RestApiPort:       ctx.GlobalString(restApiPort.Name),
-		PprofEnabled:      ctx.GlobalBool(profileMode.Name),
-		Prometheus:        usePrometheusBool,
-		PrometheusJoinURL: prometheusJoinUrl,
-		PrometheusJobName: generalConfig.GeneralSettings.NetworkID,
+	networkComponents, err := factory.NetworkComponentsFactory(shardP2PConfig(args.p2pConfig, args.index, shardId), log, coreComponents)
+	if err != nil {
+		return nil, err
 	}
 
-	ef.SetLogger(log)
-	ef.SetSyncer(syncer)
-	ef.SetTpsBenchmark(tpsBenchmark)
-	ef.SetConfig(efConfig)
-
-	wg := sync.WaitGroup{}
-	go ef.StartBackgroundServices(&wg)
-	wg.Wait()
+	err = args.lifecycleManager.Register("network messenger", networkComponents.NetMessenger)
+	if err != nil {
+		return nil, err
+	}
 
-	if !ctx.Bool(withUI.Name) {
-		log.Info("Bootstrapping node....")
-		err = ef.StartNode()
+	if networkComponents.PeerHonesty != nil {
+		err = args.lifecycleManager.Register("peer honesty", networkComponents.PeerHonesty)
 		if err != nil {
-			log.Error("starting node failed", err.Error())
-			return err
+			return nil, err
 		}
 	}
 
-	go func() {
-		<-sigs
-		log.Info("terminating at user's signal...")
-		stop <- true
-	}()
+	if args.generalConfig.StateConsistency.Enabled {
+		stateConsistencyChecker, err := stateConsistency.NewStateConsistencyChecker(stateConsistency.ArgStateConsistencyChecker{
+			Messenger:        networkComponents.NetMessenger,
+			Marshalizer:      coreComponents.Marshalizer,
+			BlockChain:       dataComponents.Blkc,
+			ShardCoordinator: shardCoordinator,
+			StatusHandler:    coreComponents.StatusHandler,
+			Config:           args.generalConfig.StateConsistency,
+		})
+		if err != nil {
+			return nil, err
+		}
 
-	log.Info("Application is now running...")
-	<-stop
+		err = args.lifecycleManager.Register("state consistency checker", stateConsistencyChecker)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	if rm != nil {
-		err = rm.Close()
-		log.LogIfError(err)
+	tpsBenchmark, err := statistics.NewTPSBenchmark(shardCoordinator.NumberOfShards(), args.nodesConfig.RoundDuration)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+
+	if shardCoordinator.SelfId() == sharding.MetachainShardId {
+		err = setupTpsBenchmark(tpsBenchmark, coreComponents, dataComponents)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if generalConfig.Explorer.Enabled {
+		serversConfigurationFileName := ctx.GlobalString(serversConfigurationFile.Name)
+		dbIndexer, err = createElasticIndexer(
+			ctx,
+			serversConfigurationFileName,
+			generalConfig.Explorer.IndexerURL,
+			shardCoordinator,
+			coreComponents.Marshalizer,
+			coreComponents.Hasher,
+			log)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if coreComponents.TrieStatistics != nil {
+		err = coreComponents.TrieStatistics.SetAppStatusHandler(coreComponents.StatusHandler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = setServiceContainer(shardCoordinator, tpsBenchmark, coreComponents.TrieStatistics)
+	if err != nil {
+		return nil, err
+	}
+
+	// the genesis checksum is computed before the epoch notifications, with the hasher of the genesis epoch
+	genesisChecksum, err := genesis.ComputeGenesisChecksum(
+		coreComponents.Hasher,
+		args.genesisConfig,
+		args.nodesConfig,
+		args.economicsConfig,
+	)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("genesis checksum", "checksum", genesisChecksum)
+
+	epochNotifier := forking.NewEpochNotifier()
+	marshalizerEpochHandler, ok := coreComponents.Marshalizer.(core.EpochSubscriberHandler)
+	if ok {
+		epochNotifier.RegisterNotifyHandler(marshalizerEpochHandler)
+	}
+	hasherEpochHandler, ok := coreComponents.Hasher.(core.EpochSubscriberHandler)
+	if ok {
+		epochNotifier.RegisterNotifyHandler(hasherEpochHandler)
+	}
+	nodesCoordinatorEpochHandler, ok := nodesCoordinator.(core.EpochSubscriberHandler)
+	if ok {
+		epochNotifier.RegisterNotifyHandler(nodesCoordinatorEpochHandler)
+	}
+	if coreComponents.TrieStorerEpochHandler != nil {
+		epochNotifier.RegisterNotifyHandler(coreComponents.TrieStorerEpochHandler)
+	}
+	gasScheduleConfigDirectory := ctx.GlobalString(gasScheduleConfigurationDirectory.Name)
+	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(forking.ArgsNewGasScheduleNotifier{
+		GasScheduleConfig: generalConfig.GasSchedule,
+		ConfigDir:         gasScheduleConfigDirectory,
+		EpochNotifier:     epochNotifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info(fmt.Sprintf("Initialized with gas schedules from: %s", gasScheduleConfigDirectory))
+
+	processArgs := factory.NewProcessComponentsFactoryArgs(
+		args.genesisConfig,
+		args.economicsData,
+		args.nodesConfig,
+		args.syncer,
+		shardCoordinator,
+		nodesCoordinator,
+		dataComponents,
+		coreComponents,
+		cryptoComponents,
+		stateComponents,
+		networkComponents,
+		coreServiceContainer,
+		generalConfig.BuiltInFunctions,
+		generalConfig.EnableRounds,
+		epochNotifier,
+		gasScheduleNotifier,
+		generalConfig.OutgoingOperations,
+		args.privKey,
+		args.pubKey,
+		generalConfig.ValidatorsSelectionAudit,
+		generalConfig.InterceptorsLimits,
+		generalConfig.ResolversAntiflood,
+		generalConfig.SupplyAccounting,
+		generalConfig.ESDTSupplyTracking,
+		generalConfig.PeerAuthentication,
+		generalConfig.VMExecutionGuard,
+		genesisChecksum,
+	)
+	managedProcessComponents, err := factory.NewManagedProcessComponents(processArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = managedProcessComponents.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	err = args.lifecycleManager.Register("process components", managedProcessComponents)
+	if err != nil {
+		return nil, err
+	}
+	processComponents := managedProcessComponents.ProcessComponents()
+
+	importDBDirectory := ctx.GlobalString(importDbDirectory.Name)
+	if len(importDBDirectory) > 0 {
+		importDBFolder := filepath.Join(
+			importDBDirectory,
+			fmt.Sprintf("%s_%d", defaultEpochString, 0),
+			fmt.Sprintf("%s_%s", defaultShardString, shardId))
+
+		return nil, importDB(
+			args,
+			importDBFolder,
+			uniqueDBFolder,
+			shardCoordinator,
+			coreComponents,
+			stateComponents,
+			dataComponents,
+			processComponents,
+		)
+	}
+
+	var elasticIndexer indexer.Indexer
+	if coreServiceContainer == nil || coreServiceContainer.IsInterfaceNil() {
+		elasticIndexer = nil
+	} else {
+		elasticIndexer = coreServiceContainer.Indexer()
+	}
+
+	currentNode, err := createNode(
+		generalConfig,
+		args.preferencesConfig,
+		args.nodesConfig,
+		args.genesisConfig,
+		args.economicsData,
+		args.syncer,
+		args.keyGen,
+		args.privKey,
+		args.pubKey,
+		shardCoordinator,
+		nodesCoordinator,
+		coreComponents,
+		stateComponents,
+		dataComponents,
+		cryptoComponents,
+		processComponents,
+		networkComponents,
+		ctx.GlobalUint64(bootstrapRoundIndex.Name),
+		args.version,
+		elasticIndexer,
+		genesisChecksum,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = args.lifecycleManager.Register("alarm scheduler", factory.CloserFunc(func() error {
+		coreComponents.AlarmScheduler.Close()
+		return nil
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	err = args.lifecycleManager.Register("consensus", factory.CloserFunc(currentNode.StopConsensus))
+	if err != nil {
+		return nil, err
+	}
+
+	if generalConfig.Hardfork.Enabled {
+		hardforkTrigger, err := createHardforkTrigger(
+			args,
+			currentNode,
+			managedProcessComponents,
+			shardCoordinator,
+			coreComponents,
+			stateComponents,
+			dataComponents,
+			cryptoComponents,
+			networkComponents,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = args.lifecycleManager.Register("hardfork trigger", hardforkTrigger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if generalConfig.Heartbeat.MaintenanceDurationOnShutdownInSec > 0 {
+		maintenanceDuration := time.Second * time.Duration(generalConfig.Heartbeat.MaintenanceDurationOnShutdownInSec)
+		err = args.lifecycleManager.Register("maintenance announcement", factory.CloserFunc(func() error {
+			return currentNode.AnnounceMaintenance(maintenanceDuration)
+		}))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	softwareVersionChecker, err := factory.CreateSoftwareVersionChecker(coreComponents.StatusHandler)
+	if err != nil {
+		log.Info("nil software version checker", err)
+	} else {
+		softwareVersionChecker.StartCheckSoftwareVersion()
+	}
+
+	if shardCoordinator.SelfId() == sharding.MetachainShardId {
+		indexValidatorsListIfNeeded(elasticIndexer, nodesCoordinator)
+	}
+
+	vmAccountsDB, err := hooks.NewVMAccountsDB(
+		stateComponents.AccountsAdapter,
+		stateComponents.AddressConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResolver, err := createApiResolver(
+		vmAccountsDB,
+		stateComponents,
+		dataComponents,
+		coreComponents,
+		shardCoordinator,
+		args.economicsData,
+		statusMetrics,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = metrics.StartStatusPolling(
+		currentNode.GetAppStatusHandler(),
+		generalConfig.GeneralSettings.StatusPollingIntervalSec,
+		networkComponents,
+		processComponents,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	updateMachineStatisticsDurationSec := 1
+	err = metrics.StartMachineStatisticsPolling(coreComponents.StatusHandler, updateMachineStatisticsDurationSec)
+	if err != nil {
+		return nil, err
+	}
+
+	restApiPort, err := shardRestApiPort(ctx.GlobalString(restApiPort.Name), args.index)
+	if err != nil {
+		return nil, err
+	}
+
+	restAPIServerDebugMode := !args.useTermui
+	ef := facade.NewElrondNodeFacade(currentNode, apiResolver, restAPIServerDebugMode)
+
+	efConfig := &config.FacadeConfig{
+		RestApiPort:       restApiPort,
+		PprofEnabled:      ctx.GlobalBool(profileMode.Name) && args.index == 0,
+		Prometheus:        args.usePrometheus,
+		PrometheusJoinURL: args.prometheusJoinUrl,
+		PrometheusJobName: generalConfig.GeneralSettings.NetworkID,
+	}
+
+	ef.SetLogger(log)
+	ef.SetSyncer(args.syncer)
+	ef.SetTpsBenchmark(tpsBenchmark)
+	ef.SetConfig(efConfig)
+
+	if generalConfig.ManagedNonce.Enabled {
+		managedNonceSender, err := createManagedNonceSender(
+			generalConfig,
+			currentNode,
+			coreComponents,
+			cryptoComponents,
+			stateComponents,
+			shardCoordinator,
+			log,
+		)
+		if err != nil {
+			return nil, err
+		}
+		ef.SetManagedNonceSender(managedNonceSender)
+	}
+
+	if generalConfig.AccountsExport.Enabled {
+		accountsExporter, err := accountsExport.NewAccountsExporter(accountsExport.ArgAccountsExporter{
+			Accounts:         stateComponents.AccountsAdapter,
+			BlockChain:       dataComponents.Blkc,
+			Marshalizer:      coreComponents.Marshalizer,
+			Hasher:           coreComponents.Hasher,
+			AddressConverter: stateComponents.AddressConverter,
+			MaxPageSize:      generalConfig.AccountsExport.MaxPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		epochNotifier.RegisterNotifyHandler(accountsExporter)
+		ef.SetAccountsExporter(accountsExporter)
+	}
+
+	systemSCDataGetter, err := createSystemSCDataGetter(vmAccountsDB)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationQuerier, err := delegation.NewDelegationQuerier(delegation.ArgDelegationQuerier{
+		ScDataGetter:     systemSCDataGetter,
+		AddressConverter: stateComponents.AddressConverter,
+		MaxPageSize:      generalConfig.DelegationQueries.MaxPageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ef.SetDelegationQuerier(delegationQuerier)
+
+	auctionListQuerier, err := auction.NewAuctionListQuerier(auction.ArgAuctionListQuerier{
+		ScDataGetter:     systemSCDataGetter,
+		AddressConverter: stateComponents.AddressConverter,
+		StakingSCAddress: systemVMFactory.StakingSCAddress,
+		PageSize:         generalConfig.AuctionListQueries.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ef.SetAuctionListQuerier(auctionListQuerier)
+
+	err = args.lifecycleManager.Register("rest api", ef)
+	if err != nil {
+		return nil, err
+	}
+
+	wg := sync.WaitGroup{}
+	go ef.StartBackgroundServices(&wg)
+	wg.Wait()
+
+	return ef, nil
+}
+
+// importDB re-processes, on top of the node's own storage, all the blocks found in the import database folder
+func importDB(
+	args *shardPipelineArgs,
+	importDBFolder string,
+	uniqueDBFolder string,
+	shardCoordinator sharding.Coordinator,
+	coreComponents *factory.Core,
+	stateComponents *factory.State,
+	dataComponents *factory.Data,
+	processComponents *factory.Process,
+) error {
+	if shardCoordinator.SelfId() == sharding.MetachainShardId {
+		return errors.New("import db is not supported on the metachain")
+	}
+
+	absImportDBFolder, err := filepath.Abs(importDBFolder)
+	if err != nil {
+		return err
+	}
+	absUniqueDBFolder, err := filepath.Abs(uniqueDBFolder)
+	if err != nil {
+		return err
+	}
+	if absImportDBFolder == absUniqueDBFolder {
+		return errors.New("the import db folder can not be the db folder of the node")
+	}
+
+	importStore, err := factory.CreateImportDataStore(
+		args.generalConfig,
+		shardCoordinator,
+		importDBFolder,
+		args.storageEncryptionKey,
+	)
+	if err != nil {
+		return errors.New("could not open the import db: " + err.Error())
+	}
+	defer func() {
+		args.log.LogIfError(importStore.CloseAll())
+	}()
+
+	importer, err := importdb.NewShardDBImporter(importdb.ArgShardDBImporter{
+		ImportStore:           importStore,
+		Marshalizer:           coreComponents.Marshalizer,
+		Uint64Converter:       coreComponents.Uint64ByteSliceConverter,
+		BlockProcessor:        processComponents.BlockProcessor,
+		BlockChain:            dataComponents.Blkc,
+		DataPool:              dataComponents.Datapool,
+		Accounts:              stateComponents.AccountsAdapter,
+		ShardCoordinator:      shardCoordinator,
+		MaxTimeToProcessBlock: importDBMaxTimeToProcessBlock,
+	})
+	if err != nil {
+		return err
+	}
+
+	args.log.Info(fmt.Sprintf("importing the blocks from %s", importDBFolder))
+
+	return importer.ImportDB()
+}
+
+// shardP2PConfig returns the p2p config of a followed shard. As all shards run in the same process, each shard after
+// the first one listens on the next port, or on a random one if no port was set, and derives its identity from
+// its own seed
+func shardP2PConfig(p2pConfig *config.P2PConfig, index int, shardId string) *config.P2PConfig {
+	if index == 0 {
+		return p2pConfig
+	}
+
+	shardConfig := *p2pConfig
+	if shardConfig.Node.Port != 0 {
+		shardConfig.Node.Port += index
+	}
+	if shardConfig.Node.Seed != "" {
+		shardConfig.Node.Seed = fmt.Sprintf("%s_%s", shardConfig.Node.Seed, shardId)
+	}
+
+	return &shardConfig
+}
+
+// shardRestApiPort returns the REST API port of a followed shard, each shard after the first one using the next port
+func shardRestApiPort(restApiPort string, index int) (string, error) {
+	if index == 0 || restApiPort == DefaultRestApiPort {
+		return restApiPort, nil
+	}
+
+	port, err := strconv.Atoi(restApiPort)
+	if err != nil {
+		return "", errors.New("error parsing the rest api port: " + err.Error())
+	}
+
+	return strconv.Itoa(port + index), nil
 }
 
 func indexValidatorsListIfNeeded(elasticIndexer indexer.Indexer, coordinator sharding.NodesCoordinator) {
@@ -853,6 +1435,21 @@ func enableGopsIfNeeded(ctx *cli.Context, log *logger.Logger) {
 	}
 }
 
+// loadStorageEncryptionKey reads the key used to encrypt the storage units, if a key file was provided
+func loadStorageEncryptionKey(ctx *cli.Context, log *logger.Logger) ([]byte, error) {
+	keyFileName := ctx.GlobalString(storageEncryptionKeyFile.Name)
+	if len(keyFileName) == 0 {
+		return nil, nil
+	}
+
+	key, err := core.LoadSkFromPemFile(keyFileName, log, 0)
+	if err != nil {
+		return nil, errors.New("could not load the storage encryption key: " + err.Error())
+	}
+
+	return key, nil
+}
+
 func loadMainConfig(filepath string, log *logger.Logger) (*config.Config, error) {
 	cfg := &config.Config{}
 	err := core.LoadTomlFile(cfg, filepath, log)
@@ -873,6 +1470,16 @@ func loadEconomicsConfig(filepath string, log *logger.Logger) (*config.ConfigEco
 	return cfg, nil
 }
 
+func loadRatingsConfig(filepath string, log *logger.Logger) (*config.RatingsConfig, error) {
+	cfg := &config.RatingsConfig{}
+	err := core.LoadTomlFile(cfg, filepath, log)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
 func loadPreferencesConfig(filepath string, log *logger.Logger) (*config.ConfigPreferences, error) {
 	cfg := &config.ConfigPreferences{}
 	err := core.LoadTomlFile(cfg, filepath, log)
@@ -901,55 +1508,50 @@ func getShardIdFromNodePubKey(pubKey crypto.PublicKey, nodesConfig *sharding.Nod
 	return selfShardId, err
 }
 
-func createShardCoordinator(
+// checkAccountsExportConfig verifies that the accounts export is enabled on an observer
+func checkAccountsExportConfig(generalConfig *config.Config, nodeType core.NodeType) error {
+	if !generalConfig.AccountsExport.Enabled {
+		return nil
+	}
+	if nodeType != core.NodeTypeObserver {
+		return errors.New("the accounts export can not be enabled on a validator")
+	}
+
+	return nil
+}
+
+func getSelfShardIds(
 	nodesConfig *sharding.NodesSetup,
 	pubKey crypto.PublicKey,
 	settingsConfig config.GeneralSettingsConfig,
 	log *logger.Logger,
-) (sharding.Coordinator, core.NodeType, error) {
+) ([]uint32, core.NodeType, error) {
 	selfShardId, err := getShardIdFromNodePubKey(pubKey, nodesConfig)
-	nodeType := core.NodeTypeValidator
-	if err == sharding.ErrPublicKeyNotFoundInGenesis {
-		nodeType = core.NodeTypeObserver
-		log.Info("Starting as observer node...")
-
-		selfShardId, err = processDestinationShardAsObserver(settingsConfig)
+	if err == nil {
+		return []uint32{selfShardId}, core.NodeTypeValidator, nil
 	}
-	if err != nil {
+	if err != sharding.ErrPublicKeyNotFoundInGenesis {
 		return nil, "", err
 	}
 
-	var shardName string
-	if selfShardId == sharding.MetachainShardId {
-		shardName = metachainShardName
-	} else {
-		shardName = fmt.Sprintf("%d", selfShardId)
-	}
-	log.Info(fmt.Sprintf("Starting in shard: %s", shardName))
-
-	shardCoordinator, err := sharding.NewMultiShardCoordinator(nodesConfig.NumberOfShards(), selfShardId)
+	log.Info("Starting as observer node...")
+	selfShardIds, err := processDestinationShardAsObserver(settingsConfig, nodesConfig.NumberOfShards())
 	if err != nil {
 		return nil, "", err
 	}
 
-	return shardCoordinator, nodeType, nil
+	return selfShardIds, core.NodeTypeObserver, nil
 }
 
 func createNodesCoordinator(
 	nodesConfig *sharding.NodesSetup,
-	settingsConfig config.GeneralSettingsConfig,
+	selfShardId uint32,
+	selectionConfig config.TypeConfig,
+	consensusByEpochs []config.ConsensusByEpochs,
 	pubKey crypto.PublicKey,
 	hasher hashing.Hasher,
+	rater process.RaterHandler,
 ) (sharding.NodesCoordinator, error) {
-
-	shardId, err := getShardIdFromNodePubKey(pubKey, nodesConfig)
-	if err == sharding.ErrPublicKeyNotFoundInGenesis {
-		shardId, err = processDestinationShardAsObserver(settingsConfig)
-	}
-	if err != nil {
-		return nil, err
-	}
-
 	nbShards := nodesConfig.NumberOfShards()
 	shardConsensusGroupSize := int(nodesConfig.ConsensusGroupSize)
 	metaConsensusGroupSize := int(nodesConfig.MetaChainConsensusGroupSize)
@@ -959,7 +1561,7 @@ func createNodesCoordinator(
 	for shardId, nodeInfoList := range initNodesInfo {
 		validators := make([]sharding.Validator, 0)
 		for _, nodeInfo := range nodeInfoList {
-			validator, err := sharding.NewValidator(big.NewInt(0), 0, nodeInfo.PubKey(), nodeInfo.Address())
+			validator, err := sharding.NewValidator(big.NewInt(0), int32(rater.GetStartRating()), nodeInfo.PubKey(), nodeInfo.Address())
 			if err != nil {
 				return nil, err
 			}
@@ -978,10 +1580,12 @@ func createNodesCoordinator(
 		ShardConsensusGroupSize: shardConsensusGroupSize,
 		MetaConsensusGroupSize:  metaConsensusGroupSize,
 		Hasher:                  hasher,
-		ShardId:                 shardId,
+		ConsensusGroupSelection: selectionConfig.Type,
+		ShardId:                 selfShardId,
 		NbShards:                nbShards,
 		Nodes:                   initValidators,
 		SelfPublicKey:           pubKeyBytes,
+		ConsensusByEpoch:        createConsensusByEpoch(consensusByEpochs),
 	}
 	nodesCoordinator, err := sharding.NewIndexHashedNodesCoordinator(argumentsNodesCoordinator)
 	if err != nil {
@@ -991,11 +1595,58 @@ func createNodesCoordinator(
 	return nodesCoordinator, nil
 }
 
-func processDestinationShardAsObserver(settingsConfig config.GeneralSettingsConfig) (uint32, error) {
-	destShard := strings.ToLower(settingsConfig.DestinationShardAsObserver)
-	if len(destShard) == 0 {
-		return 0, errors.New("option DestinationShardAsObserver is not set in config.toml")
+func createConsensusByEpoch(consensusByEpochs []config.ConsensusByEpochs) []sharding.ConsensusForEpoch {
+	consensusByEpoch := make([]sharding.ConsensusForEpoch, len(consensusByEpochs))
+	for i, consensus := range consensusByEpochs {
+		consensusByEpoch[i] = sharding.ConsensusForEpoch{
+			StartEpoch:              consensus.StartEpoch,
+			ShardConsensusGroupSize: int(consensus.ShardConsensusGroupSize),
+			MetaConsensusGroupSize:  int(consensus.MetaConsensusGroupSize),
+			ShardThresholdFraction:  consensus.ShardThresholdFraction,
+			MetaThresholdFraction:   consensus.MetaThresholdFraction,
+		}
 	}
+
+	return consensusByEpoch
+}
+
+// processDestinationShardAsObserver returns the shards an observer follows. The option holds a comma separated list of
+// shards, "metachain" standing for the metachain, or "all" for all the shards and the metachain
+func processDestinationShardAsObserver(settingsConfig config.GeneralSettingsConfig, numberOfShards uint32) ([]uint32, error) {
+	destShards := strings.ToLower(strings.TrimSpace(settingsConfig.DestinationShardAsObserver))
+	if len(destShards) == 0 {
+		return nil, errors.New("option DestinationShardAsObserver is not set in config.toml")
+	}
+	if destShards == allShardsName {
+		shardIds := make([]uint32, 0, numberOfShards+1)
+		for shardId := uint32(0); shardId < numberOfShards; shardId++ {
+			shardIds = append(shardIds, shardId)
+		}
+		return append(shardIds, sharding.MetachainShardId), nil
+	}
+
+	shardIds := make([]uint32, 0)
+	seenShards := make(map[uint32]struct{})
+	for _, destShard := range strings.Split(destShards, ",") {
+		shardId, err := parseDestinationShard(strings.TrimSpace(destShard))
+		if err != nil {
+			return nil, err
+		}
+		if shardId >= numberOfShards && shardId != sharding.MetachainShardId {
+			return nil, fmt.Errorf("error parsing DestinationShardAsObserver option: shard %d does not exist", shardId)
+		}
+		if _, ok := seenShards[shardId]; ok {
+			continue
+		}
+
+		seenShards[shardId] = struct{}{}
+		shardIds = append(shardIds, shardId)
+	}
+
+	return shardIds, nil
+}
+
+func parseDestinationShard(destShard string) (uint32, error) {
 	if destShard == metachainShardName {
 		return sharding.MetachainShardId, nil
 	}
@@ -1008,6 +1659,23 @@ func processDestinationShardAsObserver(settingsConfig config.GeneralSettingsConf
 	return uint32(val), err
 }
 
+func shardIdToString(shardId uint32) string {
+	if shardId == sharding.MetachainShardId {
+		return metachainShardName
+	}
+
+	return fmt.Sprintf("%d", shardId)
+}
+
+func shardIdsToString(shardIds []uint32) string {
+	shardNames := make([]string, len(shardIds))
+	for i, shardId := range shardIds {
+		shardNames[i] = shardIdToString(shardId)
+	}
+
+	return strings.Join(shardNames, ",")
+}
+
 // createElasticIndexer creates a new elasticIndexer where the server listens on the url,
 // authentication for the server is using the username and password
 func createElasticIndexer(
@@ -1055,6 +1723,8 @@ func createNode(
 	config *config.Config,
 	preferencesConfig *config.ConfigPreferences,
 	nodesConfig *sharding.NodesSetup,
+	genesisConfig *sharding.Genesis,
+	economicsData *economics.EconomicsData,
 	syncer ntp.SyncTimer,
 	keyGen crypto.KeyGenerator,
 	privKey crypto.PrivateKey,
@@ -1070,6 +1740,7 @@ func createNode(
 	bootstrapRoundIndex uint64,
 	version string,
 	indexer indexer.Indexer,
+	genesisChecksum []byte,
 ) (*node.Node, error) {
 	consensusGroupSize, err := getConsensusGroupSize(nodesConfig, shardCoordinator)
 	if err != nil {
@@ -1105,16 +1776,31 @@ func createNode(
 		node.WithInterceptorsContainer(process.InterceptorsContainer),
 		node.WithResolversFinder(process.ResolversFinder),
 		node.WithConsensusType(config.Consensus.Type),
+		node.WithMaxBlockCreationRoundTimePercent(config.Consensus.MaxBlockCreationRoundTimePercent),
 		node.WithTxSingleSigner(crypto.TxSingleSigner),
+		node.WithTxSignKeyGen(crypto.TxSignKeyGen),
 		node.WithTxStorageSize(config.TxStorage.Cache.Size),
 		node.WithBootstrapRoundIndex(bootstrapRoundIndex),
 		node.WithAppStatusHandler(core.StatusHandler),
+		node.WithAlarmScheduler(core.AlarmScheduler),
 		node.WithIndexer(indexer),
+		node.WithChainID(config.GeneralSettings.NetworkID),
+		node.WithEconomicsData(economicsData),
+		node.WithTxFeeHandler(economicsData),
+		node.WithGenesisTotalSupply(genesisConfig.TotalSupply()),
+		node.WithGenesisChecksum(genesisChecksum),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
 	}
 
+	if network.PeerHonesty != nil {
+		err = nd.ApplyOptions(node.WithPeerMaintenanceHandler(network.PeerHonesty))
+		if err != nil {
+			return nil, errors.New("error creating node: " + err.Error())
+		}
+	}
+
 	err = nd.StartHeartbeat(config.Heartbeat, version, preferencesConfig.Preferences.NodeDisplayName)
 	if err != nil {
 		return nil, err
@@ -1133,8 +1819,49 @@ func createNode(
 			return nil, err
 		}
 	}
+	err = nd.ApplyOptions(node.WithHistoryRepository(data.HistoryRepository))
+	if err != nil {
+		return nil, errors.New("error creating node: " + err.Error())
+	}
+	err = nd.ApplyOptions(node.WithOutgoingOperations(process.OutgoingOperations))
+	if err != nil {
+		return nil, errors.New("error creating node: " + err.Error())
+	}
+	err = nd.ApplyOptions(node.WithSelectionAuditor(process.SelectionAuditor))
+	if err != nil {
+		return nil, errors.New("error creating node: " + err.Error())
+	}
+	err = nd.ApplyOptions(node.WithSupplyAccountant(process.SupplyAccountant))
+	if err != nil {
+		return nil, errors.New("error creating node: " + err.Error())
+	}
+	err = nd.ApplyOptions(node.WithESDTSupplyTracker(process.ESDTSupplyTracker))
+	if err != nil {
+		return nil, errors.New("error creating node: " + err.Error())
+	}
+	err = nd.ApplyOptions(node.WithInterceptedDataLimitsChecker(process.DataLimitsChecker))
+	if err != nil {
+		return nil, errors.New("error creating node: " + err.Error())
+	}
+	accountsRepository, err := createAccountsRepository(core, state, data, process, shardCoordinator)
+	if err != nil {
+		return nil, err
+	}
+	err = nd.ApplyOptions(node.WithAccountsRepository(accountsRepository))
+	if err != nil {
+		return nil, errors.New("error creating node: " + err.Error())
+	}
+	if state.HistoricalAccounts != nil {
+		err = nd.ApplyOptions(node.WithHistoricalAccounts(state.HistoricalAccounts))
+		if err != nil {
+			return nil, errors.New("error creating node: " + err.Error())
+		}
+	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
-		err = nd.ApplyOptions(node.WithMetaDataPool(data.MetaDatapool))
+		err = nd.ApplyOptions(
+			node.WithMetaDataPool(data.MetaDatapool),
+			node.WithPendingMiniBlocksHandler(process.PendingMiniBlocks),
+		)
 		if err != nil {
 			return nil, errors.New("error creating meta-node: " + err.Error())
 		}
@@ -1142,6 +1869,44 @@ func createNode(
 	return nd, nil
 }
 
+// createAccountsRepository creates the repository answering the accounts queries on the state of the committed
+// blocks. The committed states are recreated from the trie storage even if the historical state queries are disabled
+func createAccountsRepository(
+	coreComponents *factory.Core,
+	stateComponents *factory.State,
+	dataComponents *factory.Data,
+	processComponents *factory.Process,
+	shardCoordinator sharding.Coordinator,
+) (node.AccountsRepository, error) {
+	historicalAccounts := stateComponents.HistoricalAccounts
+	if historicalAccounts == nil || historicalAccounts.IsInterfaceNil() {
+		accountFactory, err := factoryState.NewAccountFactoryCreator(factoryState.UserAccount)
+		if err != nil {
+			return nil, err
+		}
+
+		historicalAccounts, err = state.NewHistoricalAccountsDB(
+			coreComponents.Trie,
+			coreComponents.Hasher,
+			coreComponents.Marshalizer,
+			accountFactory,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return accountsRepository.NewAccountsRepository(accountsRepository.ArgAccountsRepository{
+		HistoricalAccounts: historicalAccounts,
+		BlockChain:         dataComponents.Blkc,
+		ForkDetector:       processComponents.ForkDetector,
+		Store:              dataComponents.Store,
+		Marshalizer:        coreComponents.Marshalizer,
+		Uint64Converter:    coreComponents.Uint64ByteSliceConverter,
+		ShardCoordinator:   shardCoordinator,
+	})
+}
+
 func initLogFileAndStatsMonitor(config *config.Config, pubKey crypto.PublicKey, log *logger.Logger,
 	workingDir string) error {
 	publicKey, err := pubKey.ToByteArray()
@@ -1150,10 +1915,18 @@ func initLogFileAndStatsMonitor(config *config.Config, pubKey crypto.PublicKey,
 	}
 
 	hexPublicKey := core.GetTrimmedPk(hex.EncodeToString(publicKey))
-	err = log.ApplyOptions(
+	logOptions := []logger.Option{
 		logger.WithFileRotation(hexPublicKey, filepath.Join(workingDir, defaultLogPath), "log"),
 		logger.WithStderrRedirect(),
-	)
+	}
+	if config.Logger.MaxFileSizeInMB > 0 {
+		logOptions = append(logOptions, logger.WithFileRotationBySize(int64(config.Logger.MaxFileSizeInMB)*bytesInMegabyte))
+	}
+	if len(config.Logger.Format) > 0 {
+		logOptions = append(logOptions, logger.WithFormat(config.Logger.Format))
+	}
+
+	err = log.ApplyOptions(logOptions...)
 	if err != nil {
 		return err
 	}
@@ -1169,19 +1942,43 @@ func initLogFileAndStatsMonitor(config *config.Config, pubKey crypto.PublicKey,
 	return nil
 }
 
-func setServiceContainer(shardCoordinator sharding.Coordinator, tpsBenchmark *statistics.TpsBenchmark) error {
+func setupTpsBenchmark(
+	tpsBenchmark *statistics.TpsBenchmark,
+	coreComponents *factory.Core,
+	dataComponents *factory.Data,
+) error {
+	err := tpsBenchmark.SetAppStatusHandler(coreComponents.StatusHandler)
+	if err != nil {
+		return err
+	}
+
+	return tpsBenchmark.SetStatisticsStorer(
+		dataComponents.Store.GetStorer(dataRetriever.StatisticsUnit),
+		coreComponents.Marshalizer,
+	)
+}
+
+func setServiceContainer(
+	shardCoordinator sharding.Coordinator,
+	tpsBenchmark *statistics.TpsBenchmark,
+	trieStatistics *trieProfiler.TrieStatistics,
+) error {
+	options := []serviceContainer.Option{serviceContainer.WithIndexer(dbIndexer)}
+	if trieStatistics != nil {
+		options = append(options, serviceContainer.WithTrieStatistics(trieStatistics))
+	}
+
 	var err error
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
-		coreServiceContainer, err = serviceContainer.NewServiceContainer(serviceContainer.WithIndexer(dbIndexer))
+		coreServiceContainer, err = serviceContainer.NewServiceContainer(options...)
 		if err != nil {
 			return err
 		}
 		return nil
 	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
-		coreServiceContainer, err = serviceContainer.NewServiceContainer(
-			serviceContainer.WithIndexer(dbIndexer),
-			serviceContainer.WithTPSBenchmark(tpsBenchmark))
+		options = append(options, serviceContainer.WithTPSBenchmark(tpsBenchmark))
+		coreServiceContainer, err = serviceContainer.NewServiceContainer(options...)
 		if err != nil {
 			return err
 		}
@@ -1215,7 +2012,62 @@ func startStatisticsMonitor(file *os.File, config config.ResourceStatsConfig, lo
 	return nil
 }
 
-func createApiResolver(vmAccountsDB vmcommon.BlockchainHook, statusMetrics external.StatusMetricsHandler) (facade.ApiResolver, error) {
+// createManagedNonceSender creates the component sending the transactions of the hosted wallets, whose secret keys are
+// all the keys found in the configured pem file
+func createManagedNonceSender(
+	generalConfig *config.Config,
+	currentNode *node.Node,
+	coreComponents *factory.Core,
+	cryptoComponents *factory.Crypto,
+	stateComponents *factory.State,
+	shardCoordinator sharding.Coordinator,
+	log *logger.Logger,
+) (facade.ManagedNonceSender, error) {
+	privateKeys := make([]crypto.PrivateKey, 0)
+	for skIndex := 0; ; skIndex++ {
+		encodedSk, err := core.LoadSkFromPemFile(generalConfig.ManagedNonce.WalletsSkPemFile, log, skIndex)
+		if err == core.ErrInvalidIndex && skIndex > 0 {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		skBytes, err := hex.DecodeString(string(encodedSk))
+		if err != nil {
+			return nil, err
+		}
+		privateKey, err := cryptoComponents.TxSignKeyGen.PrivateKeyFromByteArray(skBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKeys = append(privateKeys, privateKey)
+	}
+
+	args := managedNonce.ArgManagedNonceSender{
+		Node:             currentNode,
+		Marshalizer:      coreComponents.Marshalizer,
+		SingleSigner:     cryptoComponents.TxSingleSigner,
+		AddressConverter: stateComponents.AddressConverter,
+		ShardCoordinator: shardCoordinator,
+		PrivateKeys:      privateKeys,
+		ChainID:          generalConfig.GeneralSettings.NetworkID,
+		GapRecoveryTime:  time.Duration(generalConfig.ManagedNonce.GapRecoveryTimeInSec) * time.Second,
+	}
+
+	return managedNonce.NewManagedNonceSender(args)
+}
+
+func createApiResolver(
+	vmAccountsDB vmcommon.BlockchainHook,
+	stateComponents *factory.State,
+	dataComponents *factory.Data,
+	coreComponents *factory.Core,
+	shardCoordinator sharding.Coordinator,
+	economicsData *economics.EconomicsData,
+	statusMetrics external.StatusMetricsHandler,
+) (facade.ApiResolver, error) {
 	//TODO replace this with a vm factory
 	cryptoHook := hooks.NewVMCryptoHook()
 	ieleVM := endpoint.NewElrondIeleVM(factoryVM.IELEVirtualMachine, endpoint.ElrondTestnet, vmAccountsDB, cryptoHook)
@@ -1225,5 +2077,199 @@ func createApiResolver(vmAccountsDB vmcommon.BlockchainHook, statusMetrics exter
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scDataGetter, statusMetrics)
+	// the queries against the state of past blocks run on their own VM instances, reading the recreated accounts
+	stateScDataGetter, err := smartContract.NewStateSCDataGetter(func(accounts state.AccountsAdapter) (vmcommon.VMExecutionHandler, error) {
+		stateAccountsDB, errCreate := hooks.NewVMAccountsDB(accounts, stateComponents.AddressConverter)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		return endpoint.NewElrondIeleVM(factoryVM.IELEVirtualMachine, endpoint.ElrondTestnet, stateAccountsDB, cryptoHook), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txSimulator, err := createTxSimulator(stateComponents, shardCoordinator, economicsData)
+	if err != nil {
+		return nil, err
+	}
+
+	txStatusComputer, err := createTxStatusComputer(dataComponents, coreComponents, shardCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	return external.NewNodeApiResolver(scDataGetter, stateScDataGetter, statusMetrics, txSimulator, txStatusComputer)
+}
+
+// createSystemSCDataGetter creates the SC data getter answering the REST API queries over the system smart
+// contracts. The contracts' view functions run on a system VM instance of their own, reading the node's accounts state
+func createSystemSCDataGetter(vmAccountsDB vmcommon.BlockchainHook) (delegation.ScDataGetter, error) {
+	systemEI, err := systemSmartContracts.NewVMContext(vmAccountsDB, hooks.NewVMCryptoHook())
+	if err != nil {
+		return nil, err
+	}
+
+	scFactory, err := systemVMFactory.NewSystemSCFactory(systemEI)
+	if err != nil {
+		return nil, err
+	}
+
+	systemContracts, err := scFactory.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	systemVM, err := systemVMProcess.NewSystemVM(systemEI, systemContracts, factoryVM.SystemVirtualMachine)
+	if err != nil {
+		return nil, err
+	}
+
+	return smartContract.NewSCDataGetter(systemVM)
+}
+
+func createTxStatusComputer(
+	dataComponents *factory.Data,
+	coreComponents *factory.Core,
+	shardCoordinator sharding.Coordinator,
+) (external.TransactionStatusComputer, error) {
+	args := txstatus.ArgTxStatusComputer{
+		Store:            dataComponents.Store,
+		Marshalizer:      coreComponents.Marshalizer,
+		ShardCoordinator: shardCoordinator,
+	}
+
+	if shardCoordinator.SelfId() == sharding.MetachainShardId {
+		args.TxPool = dataComponents.MetaDatapool.Transactions()
+		args.ScrPool = dataComponents.MetaDatapool.UnsignedTransactions()
+	} else {
+		args.TxPool = dataComponents.Datapool.Transactions()
+		args.ScrPool = dataComponents.Datapool.UnsignedTransactions()
+	}
+
+	return txstatus.NewTxStatusComputer(args)
+}
+
+func createTxSimulator(
+	stateComponents *factory.State,
+	shardCoordinator sharding.Coordinator,
+	economicsData *economics.EconomicsData,
+) (external.TransactionSimulator, error) {
+	// the simulator uses its own accounts wrapper and VM instance as the temporary accounts added while
+	// simulating must not be seen by the other API calls
+	simulatorAccountsDB, err := hooks.NewVMAccountsDB(
+		stateComponents.AccountsAdapter,
+		stateComponents.AddressConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tracingHook, err := txsimulator.NewTracingBlockchainHook(simulatorAccountsDB)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoHook := hooks.NewVMCryptoHook()
+	ieleVM := endpoint.NewElrondIeleVM(factoryVM.IELEVirtualMachine, endpoint.ElrondTestnet, tracingHook, cryptoHook)
+
+	argsParser, err := smartContract.NewAtArgumentParser()
+	if err != nil {
+		return nil, err
+	}
+
+	return txsimulator.NewTxSimulator(txsimulator.ArgTxSimulator{
+		Accounts:         stateComponents.AccountsAdapter,
+		AddrConv:         stateComponents.AddressConverter,
+		ShardCoordinator: shardCoordinator,
+		VM:               ieleVM,
+		TempAccounts:     simulatorAccountsDB,
+		ArgsParser:       argsParser,
+		EconomicsFee:     economicsData,
+		Tracer:           tracingHook,
+	})
+}
+
+// createHardforkTrigger creates the hardfork trigger which soft restarts the node at the hardfork stop block: the state
+// is exported, the main configuration file is read again and the process components are recreated from it
+func createHardforkTrigger(
+	args *shardPipelineArgs,
+	currentNode *node.Node,
+	managedProcessComponents *factory.ManagedProcessComponents,
+	shardCoordinator sharding.Coordinator,
+	coreComponents *factory.Core,
+	stateComponents *factory.State,
+	dataComponents *factory.Data,
+	cryptoComponents *factory.Crypto,
+	networkComponents *factory.Network,
+) (factory.Closer, error) {
+	hardforkConfig := args.generalConfig.Hardfork
+	stateExporter, err := hardfork.NewStateExporter(
+		stateComponents.AccountsAdapter,
+		filepath.Join(args.workingDir, hardforkConfig.ExportStateDirectory),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	reloader := hardfork.ComponentsReloaderFunc(func() error {
+		newConfig, errLoad := loadMainConfig(args.ctx.GlobalString(configurationFile.Name), args.log)
+		if errLoad != nil {
+			return errLoad
+		}
+
+		errLoad = managedProcessComponents.Reload(newConfig)
+		if errLoad != nil {
+			return errLoad
+		}
+
+		// creating the process components applies the genesis balances, so the committed state is loaded again
+		currentHeader := dataComponents.Blkc.GetCurrentBlockHeader()
+		if !check.IfNil(currentHeader) {
+			errLoad = stateComponents.AccountsAdapter.RecreateTrie(currentHeader.GetRootHash())
+			if errLoad != nil {
+				return errLoad
+			}
+		}
+
+		processComponents := managedProcessComponents.ProcessComponents()
+		options := []node.Option{
+			node.WithRounder(processComponents.Rounder),
+			node.WithForkDetector(processComponents.ForkDetector),
+			node.WithBlockProcessor(processComponents.BlockProcessor),
+			node.WithInterceptorsContainer(processComponents.InterceptorsContainer),
+			node.WithResolversFinder(processComponents.ResolversFinder),
+			node.WithOutgoingOperations(processComponents.OutgoingOperations),
+			node.WithSelectionAuditor(processComponents.SelectionAuditor),
+			node.WithSupplyAccountant(processComponents.SupplyAccountant),
+			node.WithESDTSupplyTracker(processComponents.ESDTSupplyTracker),
+			node.WithInterceptedDataLimitsChecker(processComponents.DataLimitsChecker),
+		}
+		if shardCoordinator.SelfId() == sharding.MetachainShardId {
+			options = append(options, node.WithPendingMiniBlocksHandler(processComponents.PendingMiniBlocks))
+		}
+
+		return currentNode.ReplaceComponents(options...)
+	})
+
+	softRestarter, err := hardfork.NewSoftRestarter(currentNode, stateExporter, reloader)
+	if err != nil {
+		return nil, err
+	}
+
+	hardforkTrigger, err := hardfork.NewHardforkTrigger(hardfork.ArgHardforkTrigger{
+		Messenger:          networkComponents.NetMessenger,
+		Marshalizer:        coreComponents.Marshalizer,
+		KeyGen:             cryptoComponents.TxSignKeyGen,
+		SingleSigner:       cryptoComponents.TxSingleSigner,
+		BlockChain:         dataComponents.Blkc,
+		SoftRestartHandler: softRestarter,
+		Config:             hardforkConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hardforkTrigger, nil
 }
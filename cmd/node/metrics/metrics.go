@@ -12,8 +12,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
-const millisecondsInSecond = 1000
-
 // InitMetrics will init metrics for status handler
 func InitMetrics(
 	appStatusHandler core.AppStatusHandler,
@@ -33,7 +31,7 @@ func InitMetrics(
 	appStatusHandler.SetStringValue(core.MetricPublicKeyBlockSign, factory.GetPkEncoded(pubKey))
 	appStatusHandler.SetUInt64Value(core.MetricShardId, shardId)
 	appStatusHandler.SetStringValue(core.MetricNodeType, string(nodeType))
-	appStatusHandler.SetUInt64Value(core.MetricRoundTime, roundDuration/millisecondsInSecond)
+	appStatusHandler.SetUInt64Value(core.MetricRoundTime, roundDuration)
 	appStatusHandler.SetStringValue(core.MetricAppVersion, version)
 	appStatusHandler.SetUInt64Value(core.MetricCountConsensus, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricCountLeader, initUint)
@@ -52,12 +50,19 @@ func InitMetrics(
 	appStatusHandler.SetUInt64Value(core.MetricNumShardHeadersFromPool, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricNumShardHeadersProcessed, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricNumTimesInForkChoice, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricNumRejectedOversizedTxData, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricNumRejectedOversizedMiniBlocks, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricNumRejectedOversizedBlocks, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricNumThrottledResolverRequests, initUint)
 	appStatusHandler.SetStringValue(core.MetricPublicKeyTxSign, initString)
 	appStatusHandler.SetUInt64Value(core.MetricHighestFinalBlockInShard, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricCountConsensusAcceptedBlocks, initUint)
 	appStatusHandler.SetStringValue(core.MetricRewardsValue, economicsConfig.RewardsSettings.RewardsValue)
 	appStatusHandler.SetStringValue(core.MetricLeaderPercentage, fmt.Sprintf("%f", economicsConfig.RewardsSettings.LeaderPercentage))
 	appStatusHandler.SetStringValue(core.MetricCommunityPercentage, fmt.Sprintf("%f", economicsConfig.RewardsSettings.CommunityPercentage))
+	appStatusHandler.SetStringValue(core.MetricProtocolSustainabilityAddress, economicsConfig.EconomicsAddresses.ProtocolSustainabilityAddress)
+	appStatusHandler.SetStringValue(core.MetricProtocolSustainabilityPercentage, fmt.Sprintf("%f", economicsConfig.RewardsSettings.ProtocolSustainabilityPercentage))
+	appStatusHandler.SetStringValue(core.MetricProtocolSustainabilityAccumulated, "0")
 
 	var consensusGroupSize uint32
 	switch {
@@ -109,6 +114,11 @@ func StartStatusPolling(
 		return err
 	}
 
+	err = registerPollRejectedPrivateNetworkHandshakes(appStatusPollingHandler, networkComponents)
+	if err != nil {
+		return err
+	}
+
 	appStatusPollingHandler.Poll()
 
 	return nil
@@ -132,6 +142,28 @@ func registerPollConnectedPeers(
 	return nil
 }
 
+func registerPollRejectedPrivateNetworkHandshakes(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	networkComponents *factory.Network,
+) error {
+
+	protector := networkComponents.PrivateNetworkProtector
+	if protector == nil || protector.IsInterfaceNil() {
+		return nil
+	}
+
+	numRejectedHandshakesHandlerFunc := func(appStatusHandler core.AppStatusHandler) {
+		appStatusHandler.SetUInt64Value(core.MetricNumRejectedPrivateNetworkHandshakes, protector.NumRejectedHandshakes())
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(numRejectedHandshakesHandlerFunc)
+	if err != nil {
+		return errors.New("cannot register handler func for num of rejected private network handshakes")
+	}
+
+	return nil
+}
+
 func registerPollProbableHighestNonce(
 	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
 	processComponents *factory.Process,
@@ -4,9 +4,11 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/signal"
 	"sort"
@@ -14,6 +16,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/statistics"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/display"
@@ -56,6 +59,24 @@ VERSION:
 		Usage: "P2P seed will be used when generating credentials for p2p component. Can be any string.",
 		Value: "seed",
 	}
+	// restApiPort defines a flag for the port on which the connection statistics and the peer lists are served
+	restApiPort = cli.StringFlag{
+		Name:  "rest-api-port",
+		Usage: "The port on which the rest API serving the connection statistics and the peer lists will start on",
+		Value: defaultRestApiPort,
+	}
+	// peerListSize defines a flag for the number of connected peers returned by each peer list response
+	peerListSize = cli.IntFlag{
+		Name:  "peer-list-size",
+		Usage: "The number of connected peers returned by each peer list response of the rest API",
+		Value: 10,
+	}
+
+	// defaultRestApiPort is the rest API port value which disables the rest API
+	defaultRestApiPort = "off"
+
+	// connectionsUpdateInterval is the interval between two updates of the connection statistics
+	connectionsUpdateInterval = time.Second
 
 	p2pConfigurationFile = "./config/p2p.toml"
 
@@ -104,7 +125,7 @@ func main() {
 	cli.AppHelpTemplate = seedNodeHelpTemplate
 	app.Name = "SeedNode CLI App"
 	app.Usage = "This is the entry point for starting a new seed node - the app will help bootnodes connect to the network"
-	app.Flags = []cli.Flag{port, p2pSeed}
+	app.Flags = []cli.Flag{port, p2pSeed, restApiPort, peerListSize}
 	app.Version = "v0.0.1"
 	app.Authors = []cli.Author{
 		{
@@ -153,6 +174,15 @@ func startNode(ctx *cli.Context) error {
 		return err
 	}
 
+	tracker, err := statistics.NewConnectionsTracker(ctx.GlobalInt(peerListSize.Name))
+	if err != nil {
+		return err
+	}
+	err = startRestApi(ctx.GlobalString(restApiPort.Name), tracker)
+	if err != nil {
+		return err
+	}
+
 	go func() {
 		<-sigs
 		fmt.Println("terminating at user's signal...")
@@ -161,16 +191,42 @@ func startNode(ctx *cli.Context) error {
 
 	fmt.Println("Application is now running...")
 	displayMessengerInfo(messenger)
+	lastDisplay := time.Now()
 	for {
 		select {
 		case <-stop:
 			return nil
-		case <-time.After(time.Second * 5):
-			displayMessengerInfo(messenger)
+		case <-time.After(connectionsUpdateInterval):
+			tracker.Update(messenger.ConnectedAddresses())
+			if time.Since(lastDisplay) >= time.Second*5 {
+				displayMessengerInfo(messenger)
+				lastDisplay = time.Now()
+			}
 		}
 	}
 }
 
+func startRestApi(port string, tracker statistics.ConnectionsTrackerHandler) error {
+	if port == defaultRestApiPort {
+		return nil
+	}
+
+	handler, err := statistics.NewHandler(tracker)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		fmt.Printf("Starting the rest API on port %s\n", port)
+		errServe := http.ListenAndServe(":"+port, handler)
+		if errServe != nil {
+			fmt.Printf("rest API stopped: %s\n", errServe.Error())
+		}
+	}()
+
+	return nil
+}
+
 func createNode(p2pConfig *config.P2PConfig) (p2p.Messenger, error) {
 	var randReader io.Reader
 	if p2pConfig.Node.Seed != "" {
@@ -209,6 +265,14 @@ func createNetMessenger(
 
 	fmt.Printf("Starting with peer discovery: %s\n", pDiscoverer.Name())
 
+	protector, err := factoryP2P.CreatePrivateNetworkProtector(p2pConfig.Node)
+	if err != nil {
+		return nil, err
+	}
+	if protector != nil {
+		fmt.Printf("Starting in private network mode, key fingerprint: %s\n", hex.EncodeToString(protector.Fingerprint()))
+	}
+
 	prvKey, _ := ecdsa.GenerateKey(btcec.S256(), randReader)
 	sk := (*libp2pCrypto.Secp256k1PrivateKey)(prvKey)
 
@@ -220,6 +284,7 @@ func createNetMessenger(
 		loadBalancer.NewOutgoingChannelLoadBalancer(),
 		pDiscoverer,
 		libp2p.ListenAddrWithIp4AndTcp,
+		protector,
 	)
 
 	if err != nil {
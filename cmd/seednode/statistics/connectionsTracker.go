@@ -0,0 +1,135 @@
+package statistics
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// ConnectionsStatistics holds the connection and churn statistics of the seed node
+type ConnectionsStatistics struct {
+	NumConnected        int    `json:"numConnected"`
+	MaxConnected        int    `json:"maxConnected"`
+	NumJoined           uint64 `json:"numJoined"`
+	NumLeft             uint64 `json:"numLeft"`
+	NumJoinedLastUpdate int    `json:"numJoinedLastUpdate"`
+	NumLeftLastUpdate   int    `json:"numLeftLastUpdate"`
+	LastUpdateTimestamp int64  `json:"lastUpdateTimestamp"`
+	UptimeInSec         uint64 `json:"uptimeInSec"`
+}
+
+// connectionsTracker computes the churn of the seed node connections from the successive sets of connected addresses
+// and hands out the connected addresses as peer lists, each list starting where the previous one ended, so that the
+// new joiners are spread across the network instead of all connecting to the same peers
+type connectionsTracker struct {
+	mut               sync.RWMutex
+	connected         map[string]struct{}
+	sortedAddresses   []string
+	statistics        ConnectionsStatistics
+	startTime         time.Time
+	peerListSize      int
+	nextPeerListIndex int
+}
+
+// NewConnectionsTracker creates a new connections tracker handing out peer lists of the given size
+func NewConnectionsTracker(peerListSize int) (*connectionsTracker, error) {
+	if peerListSize <= 0 {
+		return nil, ErrInvalidPeerListSize
+	}
+
+	return &connectionsTracker{
+		connected:       make(map[string]struct{}),
+		sortedAddresses: make([]string, 0),
+		startTime:       time.Now(),
+		peerListSize:    peerListSize,
+	}, nil
+}
+
+// Update replaces the tracked connections with the currently connected addresses, counting the joined and the left
+// peers since the previous update
+func (ct *connectionsTracker) Update(connectedAddresses []string) {
+	connected := make(map[string]struct{}, len(connectedAddresses))
+	for _, address := range connectedAddresses {
+		connected[address] = struct{}{}
+	}
+
+	ct.mut.Lock()
+	defer ct.mut.Unlock()
+
+	numJoined := 0
+	for address := range connected {
+		if _, ok := ct.connected[address]; !ok {
+			numJoined++
+		}
+	}
+	numLeft := 0
+	for address := range ct.connected {
+		if _, ok := connected[address]; !ok {
+			numLeft++
+		}
+	}
+
+	sortedAddresses := make([]string, 0, len(connected))
+	for address := range connected {
+		sortedAddresses = append(sortedAddresses, address)
+	}
+	sort.Strings(sortedAddresses)
+
+	ct.connected = connected
+	ct.sortedAddresses = sortedAddresses
+
+	ct.statistics.NumConnected = len(connected)
+	if ct.statistics.NumConnected > ct.statistics.MaxConnected {
+		ct.statistics.MaxConnected = ct.statistics.NumConnected
+	}
+	ct.statistics.NumJoined += uint64(numJoined)
+	ct.statistics.NumLeft += uint64(numLeft)
+	ct.statistics.NumJoinedLastUpdate = numJoined
+	ct.statistics.NumLeftLastUpdate = numLeft
+	ct.statistics.LastUpdateTimestamp = time.Now().Unix()
+}
+
+// Statistics returns the connection and churn statistics
+func (ct *connectionsTracker) Statistics() ConnectionsStatistics {
+	ct.mut.RLock()
+	defer ct.mut.RUnlock()
+
+	statistics := ct.statistics
+	statistics.UptimeInSec = uint64(time.Since(ct.startTime).Seconds())
+
+	return statistics
+}
+
+// NextPeerList returns the next connected addresses, at most the configured peer list size, wrapping around the
+// sorted connected addresses
+func (ct *connectionsTracker) NextPeerList() []string {
+	ct.mut.Lock()
+	defer ct.mut.Unlock()
+
+	numAddresses := len(ct.sortedAddresses)
+	listSize := ct.peerListSize
+	if listSize > numAddresses {
+		listSize = numAddresses
+	}
+
+	peerList := make([]string, 0, listSize)
+	if numAddresses == 0 {
+		return peerList
+	}
+
+	startIndex := ct.nextPeerListIndex % numAddresses
+	for i := 0; i < listSize; i++ {
+		peerList = append(peerList, ct.sortedAddresses[(startIndex+i)%numAddresses])
+	}
+	ct.nextPeerListIndex = (startIndex + listSize) % numAddresses
+
+	return peerList
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ct *connectionsTracker) IsInterfaceNil() bool {
+	if ct == nil {
+		return true
+	}
+	return false
+}
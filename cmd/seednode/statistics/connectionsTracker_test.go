@@ -0,0 +1,88 @@
+package statistics_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+//------- NewConnectionsTracker
+
+func TestNewConnectionsTracker_InvalidPeerListSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ct, err := statistics.NewConnectionsTracker(0)
+
+	assert.Nil(t, ct)
+	assert.Equal(t, statistics.ErrInvalidPeerListSize, err)
+}
+
+func TestNewConnectionsTracker_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ct, err := statistics.NewConnectionsTracker(2)
+
+	assert.Nil(t, err)
+	assert.False(t, ct.IsInterfaceNil())
+	assert.Equal(t, 0, len(ct.NextPeerList()))
+}
+
+//------- Update
+
+func TestConnectionsTracker_UpdateShouldCountTheChurn(t *testing.T) {
+	t.Parallel()
+
+	ct, _ := statistics.NewConnectionsTracker(2)
+
+	ct.Update([]string{"a", "b", "c"})
+	stats := ct.Statistics()
+	assert.Equal(t, 3, stats.NumConnected)
+	assert.Equal(t, 3, stats.MaxConnected)
+	assert.Equal(t, uint64(3), stats.NumJoined)
+	assert.Equal(t, 3, stats.NumJoinedLastUpdate)
+	assert.Equal(t, uint64(0), stats.NumLeft)
+
+	ct.Update([]string{"b", "d"})
+	stats = ct.Statistics()
+	assert.Equal(t, 2, stats.NumConnected)
+	assert.Equal(t, 3, stats.MaxConnected)
+	assert.Equal(t, uint64(4), stats.NumJoined)
+	assert.Equal(t, 1, stats.NumJoinedLastUpdate)
+	assert.Equal(t, uint64(2), stats.NumLeft)
+	assert.Equal(t, 2, stats.NumLeftLastUpdate)
+}
+
+func TestConnectionsTracker_UpdateDuplicatedAddressesShouldCountOnce(t *testing.T) {
+	t.Parallel()
+
+	ct, _ := statistics.NewConnectionsTracker(2)
+
+	ct.Update([]string{"a", "a"})
+
+	assert.Equal(t, 1, ct.Statistics().NumConnected)
+	assert.Equal(t, uint64(1), ct.Statistics().NumJoined)
+}
+
+//------- NextPeerList
+
+func TestConnectionsTracker_NextPeerListShouldRotate(t *testing.T) {
+	t.Parallel()
+
+	ct, _ := statistics.NewConnectionsTracker(2)
+	ct.Update([]string{"c", "a", "b"})
+
+	assert.Equal(t, []string{"a", "b"}, ct.NextPeerList())
+	assert.Equal(t, []string{"c", "a"}, ct.NextPeerList())
+	assert.Equal(t, []string{"b", "c"}, ct.NextPeerList())
+}
+
+func TestConnectionsTracker_NextPeerListFewerPeersThanListSizeShouldReturnAll(t *testing.T) {
+	t.Parallel()
+
+	ct, _ := statistics.NewConnectionsTracker(5)
+	ct.Update([]string{"b", "a"})
+
+	assert.Equal(t, []string{"a", "b"}, ct.NextPeerList())
+	assert.Equal(t, []string{"a", "b"}, ct.NextPeerList())
+}
@@ -0,0 +1,9 @@
+package statistics
+
+import "errors"
+
+// ErrInvalidPeerListSize signals that the size of the peer list responses is not positive
+var ErrInvalidPeerListSize = errors.New("invalid peer list size")
+
+// ErrNilConnectionsTracker signals that a nil connections tracker has been provided
+var ErrNilConnectionsTracker = errors.New("nil connections tracker")
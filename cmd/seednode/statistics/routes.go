@@ -0,0 +1,39 @@
+package statistics
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ConnectionsTrackerHandler defines the behaviour of the component which provides the data served by the seed node
+type ConnectionsTrackerHandler interface {
+	Statistics() ConnectionsStatistics
+	NextPeerList() []string
+	IsInterfaceNil() bool
+}
+
+// NewHandler creates the http handler serving the connection statistics on /statistics and a rotating list of the
+// connected peers on /peers
+func NewHandler(tracker ConnectionsTrackerHandler) (http.Handler, error) {
+	if tracker == nil || tracker.IsInterfaceNil() {
+		return nil, ErrNilConnectionsTracker
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/statistics", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"statistics": tracker.Statistics()})
+	})
+	mux.HandleFunc("/peers", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"peers": tracker.NextPeerList()})
+	})
+
+	return mux, nil
+}
+
+func writeJSON(w http.ResponseWriter, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,64 @@
+package statistics_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+type statisticsResponse struct {
+	Statistics statistics.ConnectionsStatistics `json:"statistics"`
+}
+
+type peersResponse struct {
+	Peers []string `json:"peers"`
+}
+
+func TestNewHandler_NilTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	handler, err := statistics.NewHandler(nil)
+
+	assert.Nil(t, handler)
+	assert.Equal(t, statistics.ErrNilConnectionsTracker, err)
+}
+
+func TestHandler_StatisticsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ct, _ := statistics.NewConnectionsTracker(2)
+	ct.Update([]string{"a", "b"})
+	handler, _ := statistics.NewHandler(ct)
+
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/statistics", nil))
+
+	response := statisticsResponse{}
+	err := json.NewDecoder(resp.Body).Decode(&response)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 2, response.Statistics.NumConnected)
+	assert.Equal(t, uint64(2), response.Statistics.NumJoined)
+}
+
+func TestHandler_PeersShouldReturnTheRotatingPeerList(t *testing.T) {
+	t.Parallel()
+
+	ct, _ := statistics.NewConnectionsTracker(1)
+	ct.Update([]string{"a", "b"})
+	handler, _ := statistics.NewHandler(ct)
+
+	for _, expectedPeer := range []string{"a", "b", "a"} {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/peers", nil))
+
+		response := peersResponse{}
+		err := json.NewDecoder(resp.Body).Decode(&response)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{expectedPeer}, response.Peers)
+	}
+}
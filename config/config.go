@@ -16,6 +16,7 @@ type DBConfig struct {
 	BatchDelaySeconds int    `json:"batchDelaySeconds"`
 	MaxBatchSize      int    `json:"maxBatchSize"`
 	MaxOpenFiles      int    `json:"maxOpenFiles"`
+	Encrypted         bool   `json:"encrypted"`
 }
 
 // BloomFilterConfig will map the json bloom filter configuration
@@ -31,10 +32,20 @@ type StorageConfig struct {
 	Bloom BloomFilterConfig `json:"bloom"`
 }
 
+// TieredStorageConfig will hold the configuration of a storage keeping the data of the active epoch on the fast
+// storage path and moving the data of the older epochs under the cold path
+type TieredStorageConfig struct {
+	Enabled  bool
+	ColdPath string
+}
+
 // LoggerConfig will map the json logger configuration
 type LoggerConfig struct {
-	Path            string `json:"path"`
-	StackTraceDepth int    `json:"stackTraceDepth"`
+	Path              string `json:"path"`
+	StackTraceDepth   int    `json:"stackTraceDepth"`
+	MaxFileSizeInMB   int    `json:"maxFileSizeInMB"`
+	Format            string `json:"format"`
+	EnableCorrelation bool   `json:"enableCorrelation"`
 }
 
 // AddressConfig will map the json address configuration
@@ -48,6 +59,29 @@ type TypeConfig struct {
 	Type string `json:"type"`
 }
 
+// ConsensusConfig will hold the consensus type and the percentage of the round time after which the leader stops
+// adding data to the block it proposes
+type ConsensusConfig struct {
+	Type                             string `json:"type"`
+	MaxBlockCreationRoundTimePercent uint32 `json:"maxBlockCreationRoundTimePercent"`
+}
+
+// HasherConfig will hold the hasher type and, optionally, the hasher type the network migrates to starting with the
+// migration epoch
+type HasherConfig struct {
+	Type           string `json:"type"`
+	MigrationType  string `json:"migrationType"`
+	MigrationEpoch uint32 `json:"migrationEpoch"`
+}
+
+// MarshalizerConfig will hold the marshalizer type and, optionally, the marshalizer type the network migrates to
+// starting with the migration epoch
+type MarshalizerConfig struct {
+	Type           string `json:"type"`
+	MigrationType  string `json:"migrationType"`
+	MigrationEpoch uint32 `json:"migrationEpoch"`
+}
+
 // NTPConfig will hold the configuration for NTP queries
 type NTPConfig struct {
 	Host    string
@@ -58,20 +92,27 @@ type NTPConfig struct {
 
 // Config will hold the entire application configuration parameters
 type Config struct {
-	MiniBlocksStorage          StorageConfig
-	PeerBlockBodyStorage       StorageConfig
-	BlockHeaderStorage         StorageConfig
-	TxStorage                  StorageConfig
-	UnsignedTransactionStorage StorageConfig
-	RewardTxStorage            StorageConfig
-	ShardHdrNonceHashStorage   StorageConfig
-	MetaHdrNonceHashStorage    StorageConfig
-
-	ShardDataStorage StorageConfig
-	MetaBlockStorage StorageConfig
-	PeerDataStorage  StorageConfig
+	MiniBlocksStorage            StorageConfig
+	PeerBlockBodyStorage         StorageConfig
+	BlockHeaderStorage           StorageConfig
+	TxStorage                    StorageConfig
+	UnsignedTransactionStorage   StorageConfig
+	RewardTxStorage              StorageConfig
+	ShardHdrNonceHashStorage     StorageConfig
+	MetaHdrNonceHashStorage      StorageConfig
+	TxLogsStorage                StorageConfig
+	MiniBlockHashByTxHashStorage StorageConfig
+	SupplyStorage                StorageConfig
+	ESDTSupplyStorage            StorageConfig
+	PeerShardMapperStorage       StorageConfig
+
+	ShardDataStorage  StorageConfig
+	MetaBlockStorage  StorageConfig
+	PeerDataStorage   StorageConfig
+	StatisticsStorage StorageConfig
 
 	AccountsTrieStorage StorageConfig
+	AccountsTrieTiering TieredStorageConfig
 	BadBlocksCache      CacheConfig
 
 	TxBlockBodyDataPool         CacheConfig
@@ -83,6 +124,7 @@ type Config struct {
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	MetaBlockBodyDataPool       CacheConfig
+	PeerAuthenticationPool      CacheConfig
 
 	MiniBlockHeaderHashesDataPool CacheConfig
 	ShardHeadersDataPool          CacheConfig
@@ -90,23 +132,49 @@ type Config struct {
 
 	Logger         LoggerConfig
 	Address        AddressConfig
-	Hasher         TypeConfig
+	Hasher         HasherConfig
 	MultisigHasher TypeConfig
-	Marshalizer    TypeConfig
+	TxSignHasher   TypeConfig
+	Marshalizer    MarshalizerConfig
 
-	ResourceStats   ResourceStatsConfig
-	Heartbeat       HeartbeatConfig
-	GeneralSettings GeneralSettingsConfig
-	Consensus       TypeConfig
-	Explorer        ExplorerConfig
+	ResourceStats            ResourceStatsConfig
+	Heartbeat                HeartbeatConfig
+	GeneralSettings          GeneralSettingsConfig
+	Consensus                ConsensusConfig
+	ConsensusGroupSelection  TypeConfig
+	ConsensusByEpochs        []ConsensusByEpochs
+	EnableRounds             RoundConfig
+	Explorer                 ExplorerConfig
+	HistoricalState          HistoricalStateConfig
+	DbLookupExtensions       DbLookupExtensionsConfig
+	SigVerifier              SigVerifierConfig
+	InterceptorsLimits       InterceptorsLimitsConfig
+	ResolversAntiflood       ResolversAntifloodConfig
+	OutgoingOperations       OutgoingOperationsConfig
+	ValidatorsSelectionAudit ValidatorsSelectionAuditConfig
+	ManagedNonce             ManagedNonceConfig
+	AccountsExport           AccountsExportConfig
+	SupplyAccounting         SupplyAccountingConfig
+	PoolsCapacity            PoolsCapacityConfig
+	StateConsistency         StateConsistencyConfig
+	TrieProfiling            TrieProfilingConfig
+	VMExecutionGuard         VMExecutionGuardConfig
+	Hardfork                 HardforkConfig
+	DelegationQueries        DelegationQueriesConfig
+	AuctionListQueries       AuctionListQueriesConfig
+	ESDTSupplyTracking       ESDTSupplyTrackingConfig
+	PeerAuthentication       PeerAuthenticationConfig
 
-	NTPConfig NTPConfig
+	NTPConfig        NTPConfig
+	BuiltInFunctions BuiltInFunctionsConfig
+	GasSchedule      GasScheduleConfig
 }
 
 // NodeConfig will hold basic p2p settings
 type NodeConfig struct {
-	Port int
-	Seed string
+	Port              int
+	Seed              string
+	PrivateNetworkKey string
 }
 
 // KadDhtPeerDiscoveryConfig will hold the kad-dht discovery config settings
@@ -117,10 +185,26 @@ type KadDhtPeerDiscoveryConfig struct {
 	InitialPeerList      []string
 }
 
+// PeerHonestyConfig will hold the settings used to rate the connected peers
+type PeerHonestyConfig struct {
+	Enabled               bool
+	ValidMessageBonus     float64
+	InvalidMessagePenalty float64
+	TimelyResponseBonus   float64
+	MissedResponsePenalty float64
+	ResponseTimeoutInMs   int
+	DecayFactor           float64
+	SweepIntervalInSec    int
+	MinScore              float64
+	MaxScore              float64
+	DisconnectThreshold   float64
+}
+
 // P2PConfig will hold all the P2P settings
 type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
+	PeerHonesty         PeerHonestyConfig
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -135,14 +219,126 @@ type HeartbeatConfig struct {
 	MinTimeToWaitBetweenBroadcastsInSec int
 	MaxTimeToWaitBetweenBroadcastsInSec int
 	DurationInSecToConsiderUnresponsive int
+	MaxMaintenanceDurationInSec         int
+	MaintenanceDurationOnShutdownInSec  int
 	HeartbeatStorage                    StorageConfig
 }
 
+// PoolsCapacityConfig will hold the settings used to adapt the capacity of the data pools to the received load
+type PoolsCapacityConfig struct {
+	Enabled               bool
+	AdjustIntervalInSec   int
+	RetentionInSec        int
+	MinSizeRatio          float64
+	MaxSizeRatio          float64
+	MaxMemoryUsagePercent uint64
+}
+
+// StateConsistencyConfig will hold the settings used to compare the committed state with the one of the peers
+type StateConsistencyConfig struct {
+	Enabled            bool
+	CheckIntervalInSec int
+	NumPeersToQuery    int
+	Quorum             int
+}
+
+// TrieProfilingConfig will hold the settings used to measure the accounts trie operations of each block
+type TrieProfilingConfig struct {
+	Enabled                    bool
+	SlowOperationThresholdInMs int
+	NumTopKeys                 int
+}
+
+// VMExecutionGuardConfig will hold the settings of the wall-clock deadline applied to the smart contract executions
+type VMExecutionGuardConfig struct {
+	Enabled                bool
+	MinDurationInMs        int
+	DurationPerGasUnitInNs int
+}
+
+// HardforkConfig will hold the settings of the hardfork trigger, which stops the node at an agreed nonce and restarts
+// it in place with the reloaded configuration
+type HardforkConfig struct {
+	Enabled              bool
+	TriggerPublicKey     string
+	ScheduledEpoch       uint32
+	CheckIntervalInMs    int
+	ExportStateDirectory string
+}
+
+// AuctionListQueriesConfig will hold the configuration of the REST API auction list
+type AuctionListQueriesConfig struct {
+	PageSize int
+}
+
+// DelegationQueriesConfig will hold the configuration of the REST API queries over the delegation contracts
+type DelegationQueriesConfig struct {
+	MaxPageSize int
+}
+
+// ESDTSupplyTrackingConfig will hold the configuration of the token supply changes tracked by the shard nodes
+type ESDTSupplyTrackingConfig struct {
+	Enabled bool
+}
+
+// PeerAuthenticationConfig will hold the configuration of the peer authentication messages
+type PeerAuthenticationConfig struct {
+	MaxValidityInSec uint64
+}
+
 // GeneralSettingsConfig will hold the general settings for a node
 type GeneralSettingsConfig struct {
 	DestinationShardAsObserver string
 	NetworkID                  string
 	StatusPollingIntervalSec   int
+	ShutdownTimeoutInSec       int
+}
+
+// BuiltInFunctionsConfig will hold the epochs from which each of the built-in functions is enabled
+type BuiltInFunctionsConfig struct {
+	ClaimDeveloperRewardsEnableEpoch uint32
+	ChangeOwnerAddressEnableEpoch    uint32
+	SaveKeyValueEnableEpoch          uint32
+	SetUserNameEnableEpoch           uint32
+	ESDTNFTCreateEnableEpoch         uint32
+	ESDTNFTTransferEnableEpoch       uint32
+	ESDTNFTAddQuantityEnableEpoch    uint32
+	MultiESDTNFTTransferEnableEpoch  uint32
+	ESDTNFTBurnEnableEpoch           uint32
+}
+
+// GasScheduleConfig will hold the gas schedule files together with the epochs from which each of them is used
+type GasScheduleConfig struct {
+	GasScheduleByEpochs []GasScheduleByEpochs
+}
+
+// GasScheduleByEpochs will hold the gas schedule file which is used starting with the given epoch
+type GasScheduleByEpochs struct {
+	StartEpoch uint32
+	FileName   string
+}
+
+// ConsensusByEpochs will hold the consensus group sizes and the fractions of the consensus group which have to sign
+// a block, used starting with the given epoch
+type ConsensusByEpochs struct {
+	StartEpoch              uint32
+	ShardConsensusGroupSize uint32
+	MetaConsensusGroupSize  uint32
+	ShardThresholdFraction  float64
+	MetaThresholdFraction   float64
+}
+
+// RoundConfig will hold the behaviors which switch at an exact round and the public key allowed to sign updates of
+// their activation rounds
+type RoundConfig struct {
+	GovernancePublicKey string
+	ActivationRounds    []ActivationRoundByName
+}
+
+// ActivationRoundByName will hold the round from which the named behavior is enabled
+type ActivationRoundByName struct {
+	Name  string
+	Round uint64
 }
 
 // ExplorerConfig will hold the configuration for the explorer indexer
@@ -151,6 +347,61 @@ type ExplorerConfig struct {
 	IndexerURL string
 }
 
+// HistoricalStateConfig will hold the configuration for querying the accounts state at past blocks
+type HistoricalStateConfig struct {
+	Enabled bool
+}
+
+// DbLookupExtensionsConfig will hold the configuration for the secondary indexes written when the blocks are committed
+type DbLookupExtensionsConfig struct {
+	Enabled                   bool
+	MiniblocksMetadataStorage StorageConfig
+	EpochByHashStorage        StorageConfig
+	RoundByHashStorage        StorageConfig
+}
+
+// OutgoingOperationsConfig will hold the configuration of the operations bridged to another chain. The committed
+// transactions matching a subscription are collected in batches signed by the validators, which a relayer can fetch
+type OutgoingOperationsConfig struct {
+	Enabled       bool
+	MaxBatches    int
+	Subscriptions []OutgoingOperationSubscription
+}
+
+// OutgoingOperationSubscription will hold the hex encoded receiver address and the called functions identifying an
+// outgoing operation
+type OutgoingOperationSubscription struct {
+	Address     string
+	Identifiers []string
+}
+
+// ValidatorsSelectionAuditConfig will hold the configuration of the audit comparing, for each epoch, how many times
+// the eligible validators were selected as leader and consensus group member with the expected number of selections
+type ValidatorsSelectionAuditConfig struct {
+	Enabled            bool
+	DeviationThreshold float64
+	NumEpochsToKeep    int
+}
+
+// ManagedNonceConfig will hold the configuration of the hosted wallets, whose transactions are signed by the node
+// after their nonces are assigned on the node
+type ManagedNonceConfig struct {
+	Enabled              bool
+	WalletsSkPemFile     string
+	GapRecoveryTimeInSec int
+}
+
+// AccountsExportConfig will hold the configuration of the accounts export served to the auditors by the observers
+type AccountsExportConfig struct {
+	Enabled     bool
+	MaxPageSize int
+}
+
+// SupplyAccountingConfig will hold the configuration of the minted and burned value counters kept by the shard nodes
+type SupplyAccountingConfig struct {
+	Enabled bool
+}
+
 // ServersConfig will hold all the confidential settings for servers
 type ServersConfig struct {
 	ElasticSearch ElasticSearchConfig
@@ -178,3 +429,26 @@ type FacadeConfig struct {
 	PrometheusJoinURL string
 	PrometheusJobName string
 }
+
+// SigVerifierConfig will hold the settings of the worker pool and cache used when verifying the signatures
+// of the intercepted data
+type SigVerifierConfig struct {
+	NumWorkers int
+	Cache      CacheConfig
+}
+
+// InterceptorsLimitsConfig will hold the max sizes of the intercepted data, checked before their signatures are verified
+type InterceptorsLimitsConfig struct {
+	MaxTxDataBytes        int
+	MaxTxsPerMiniBlock    int
+	MaxMiniBlocksPerBlock int
+}
+
+// ResolversAntifloodConfig will hold the limits of the requests handled by the resolvers: the number of requests
+// accepted from each peer in a quota interval and the number of requests handled at the same time on each topic
+type ResolversAntifloodConfig struct {
+	MaxRequestsPerPeer            uint32
+	MaxRequestsPerValidatorPeer   uint32
+	QuotaIntervalInSec            int
+	MaxConcurrentHandlersPerTopic uint32
+}
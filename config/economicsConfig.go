@@ -2,22 +2,37 @@ package config
 
 // EconomicsAddresses will hold economics addresses
 type EconomicsAddresses struct {
-	CommunityAddress string
-	BurnAddress      string
+	CommunityAddress              string
+	BurnAddress                   string
+	ProtocolSustainabilityAddress string
 }
 
 // RewardsSettings will hold economics rewards settings
 type RewardsSettings struct {
-	RewardsValue        string
-	CommunityPercentage float64
-	LeaderPercentage    float64
-	BurnPercentage      float64
+	RewardsValue                     string
+	CommunityPercentage              float64
+	LeaderPercentage                 float64
+	BurnPercentage                   float64
+	ProtocolSustainabilityPercentage float64
+	DeveloperPercentage              float64
+	FeeBurnEnableEpoch               uint32
 }
 
-// FeeSettings will hold economics fee settings
+// FeeSettings will hold economics fee settings. The gas consumed by smart contract executions is priced at the
+// gas price modifier fraction of the transaction gas price, the move balance gas being priced at the full gas price
 type FeeSettings struct {
-	MinGasPrice string
-	MinGasLimit string
+	MinGasPrice      string
+	MinGasLimit      string
+	GasPriceModifier float64
+}
+
+// GasLimitSettings will hold the maximum gas which can be consumed in one block, separately for move balance
+//  transactions and for smart contract executions, both in total and per destination shard
+type GasLimitSettings struct {
+	MaxMoveBalanceGasPerBlock string
+	MaxSCExecutionGasPerBlock string
+	MaxMoveBalanceGasPerShard string
+	MaxSCExecutionGasPerShard string
 }
 
 // ConfigEconomics will hold economics config
@@ -25,4 +40,5 @@ type ConfigEconomics struct {
 	EconomicsAddresses EconomicsAddresses
 	RewardsSettings    RewardsSettings
 	FeeSettings        FeeSettings
+	GasLimitSettings   GasLimitSettings
 }
@@ -0,0 +1,25 @@
+package config
+
+// RatingsConfig will hold the configuration of the validators ratings
+type RatingsConfig struct {
+	General    GeneralRatingsConfig
+	ShardChain RatingSteps
+	MetaChain  RatingSteps
+}
+
+// GeneralRatingsConfig will hold the rating of the new validators and the bounds of the ratings. The ratings are
+// used as selection weights by the rating consensus group selection, so they should be kept small
+type GeneralRatingsConfig struct {
+	StartRating uint32
+	MaxRating   uint32
+	MinRating   uint32
+}
+
+// RatingSteps will hold the rating changes of a validator for a proposed and for a signed block, when the block is
+// committed (increase) or missed (decrease)
+type RatingSteps struct {
+	ProposerIncreaseRatingStep  uint32
+	ProposerDecreaseRatingStep  uint32
+	ValidatorIncreaseRatingStep uint32
+	ValidatorDecreaseRatingStep uint32
+}
@@ -63,13 +63,13 @@ func TestTomlParser(t *testing.T) {
 				HashFunc: []string{accountsStorageBlomHash1, accountsStorageBlomHash2, accountsStorageBlomHash3},
 			},
 		},
-		Hasher: TypeConfig{
+		Hasher: HasherConfig{
 			Type: hasherType,
 		},
 		MultisigHasher: TypeConfig{
 			Type: multiSigHasherType,
 		},
-		Consensus: TypeConfig{
+		Consensus: ConsensusConfig{
 			Type: consensusType,
 		},
 	}
@@ -127,6 +127,11 @@ func TestTomlEconomicsParser(t *testing.T) {
 	burnPercentage := 0.8
 	minGasPrice := "18446744073709551615"
 	minGasLimit := "18446744073709551615"
+	gasPriceModifier := 0.01
+	maxMoveBalanceGasPerBlock := "1500000"
+	maxSCExecutionGasPerBlock := "2500000"
+	maxMoveBalanceGasPerShard := "500000"
+	maxSCExecutionGasPerShard := "700000"
 
 	cfgEconomicsExpected := ConfigEconomics{
 		EconomicsAddresses: EconomicsAddresses{
@@ -140,8 +145,15 @@ func TestTomlEconomicsParser(t *testing.T) {
 			BurnPercentage:      burnPercentage,
 		},
 		FeeSettings: FeeSettings{
-			MinGasPrice: minGasPrice,
-			MinGasLimit: minGasLimit,
+			MinGasPrice:      minGasPrice,
+			MinGasLimit:      minGasLimit,
+			GasPriceModifier: gasPriceModifier,
+		},
+		GasLimitSettings: GasLimitSettings{
+			MaxMoveBalanceGasPerBlock: maxMoveBalanceGasPerBlock,
+			MaxSCExecutionGasPerBlock: maxSCExecutionGasPerBlock,
+			MaxMoveBalanceGasPerShard: maxMoveBalanceGasPerShard,
+			MaxSCExecutionGasPerShard: maxSCExecutionGasPerShard,
 		},
 	}
 
@@ -157,6 +169,12 @@ func TestTomlEconomicsParser(t *testing.T) {
 [FeeSettings]
     MinGasPrice = "` + minGasPrice + `"
     MinGasLimit = "` + minGasLimit + `"
+    GasPriceModifier = ` + fmt.Sprintf("%.6f", gasPriceModifier) + `
+[GasLimitSettings]
+    MaxMoveBalanceGasPerBlock = "` + maxMoveBalanceGasPerBlock + `"
+    MaxSCExecutionGasPerBlock = "` + maxSCExecutionGasPerBlock + `"
+    MaxMoveBalanceGasPerShard = "` + maxMoveBalanceGasPerShard + `"
+    MaxSCExecutionGasPerShard = "` + maxSCExecutionGasPerShard + `"
 `
 
 	cfg := ConfigEconomics{}
@@ -188,3 +206,49 @@ func TestTomlPreferencesParser(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, cfgPreferencesExpected, cfg)
 }
+
+func TestTomlRatingsParser(t *testing.T) {
+	cfgRatingsExpected := RatingsConfig{
+		General: GeneralRatingsConfig{
+			StartRating: 50,
+			MaxRating:   100,
+			MinRating:   1,
+		},
+		ShardChain: RatingSteps{
+			ProposerIncreaseRatingStep:  2,
+			ProposerDecreaseRatingStep:  4,
+			ValidatorIncreaseRatingStep: 1,
+			ValidatorDecreaseRatingStep: 2,
+		},
+		MetaChain: RatingSteps{
+			ProposerIncreaseRatingStep:  3,
+			ProposerDecreaseRatingStep:  6,
+			ValidatorIncreaseRatingStep: 1,
+			ValidatorDecreaseRatingStep: 3,
+		},
+	}
+
+	testString := `
+[General]
+    StartRating = 50
+    MaxRating = 100
+    MinRating = 1
+[ShardChain]
+    ProposerIncreaseRatingStep = 2
+    ProposerDecreaseRatingStep = 4
+    ValidatorIncreaseRatingStep = 1
+    ValidatorDecreaseRatingStep = 2
+[MetaChain]
+    ProposerIncreaseRatingStep = 3
+    ProposerDecreaseRatingStep = 6
+    ValidatorIncreaseRatingStep = 1
+    ValidatorDecreaseRatingStep = 3
+`
+
+	cfg := RatingsConfig{}
+
+	err := toml.Unmarshal([]byte(testString), &cfg)
+
+	assert.Nil(t, err)
+	assert.Equal(t, cfgRatingsExpected, cfg)
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("consensus/broadcast")
 
 type commonMessenger struct {
 	marshalizer      marshal.Marshalizer
@@ -8,15 +8,21 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/core/watchdog"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("consensus/chronology")
 
 // srBeforeStartRound defines the state which exist before the start of the round
 const srBeforeStartRound = -1
 
+const chronologyAlarmID = "chronology"
+
+// chronologyAlarmTimeout is the time after which a subround which did not finish its work is signaled by the watchdog
+const chronologyAlarmTimeout = time.Minute
+
 // chronology defines the data needed by the chronology
 type chronology struct {
 	genesisTime time.Time
@@ -30,6 +36,10 @@ type chronology struct {
 	subroundHandlers []consensus.SubroundHandler
 	mutSubrounds     sync.RWMutex
 	appStatusHandler core.AppStatusHandler
+	watchdog         core.WatchdogTimer
+
+	chanStopRounds chan struct{}
+	closeOnce      sync.Once
 }
 
 // NewChronology creates a new chronology object
@@ -52,7 +62,10 @@ func NewChronology(
 		genesisTime:      genesisTime,
 		rounder:          rounder,
 		syncTimer:        syncTimer,
-		appStatusHandler: statusHandler.NewNilStatusHandler()}
+		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		watchdog:         &watchdog.DisabledWatchdog{},
+		chanStopRounds:   make(chan struct{}),
+	}
 
 	chr.subroundId = srBeforeStartRound
 
@@ -88,6 +101,16 @@ func (chr *chronology) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetWatchdog will set the watchdog which signals the subrounds stuck in their work
+func (chr *chronology) SetWatchdog(watchdogTimer core.WatchdogTimer) error {
+	if watchdogTimer == nil || watchdogTimer.IsInterfaceNil() {
+		return ErrNilWatchdog
+	}
+
+	chr.watchdog = watchdogTimer
+	return nil
+}
+
 // AddSubround adds new SubroundHandler implementation to the chronology
 func (chr *chronology) AddSubround(subroundHandler consensus.SubroundHandler) {
 	chr.mutSubrounds.Lock()
@@ -111,11 +134,25 @@ func (chr *chronology) RemoveAllSubrounds() {
 // StartRounds actually starts the chronology and calls the DoWork() method of the subroundHandlers loaded
 func (chr *chronology) StartRounds() {
 	for {
-		time.Sleep(time.Millisecond)
+		select {
+		case <-chr.chanStopRounds:
+			return
+		case <-time.After(time.Millisecond):
+		}
+
 		chr.startRound()
 	}
 }
 
+// Close stops the rounds started by StartRounds. The subround in progress, if any, is allowed to finish its work
+func (chr *chronology) Close() error {
+	chr.closeOnce.Do(func() {
+		close(chr.chanStopRounds)
+	})
+
+	return nil
+}
+
 // startRound calls the current subround, given by the finished tasks in this round
 func (chr *chronology) startRound() {
 	if chr.subroundId == srBeforeStartRound {
@@ -135,7 +172,21 @@ func (chr *chronology) startRound() {
 	msg := fmt.Sprintf("SUBROUND %s BEGINS", sr.Name())
 	log.Info(log.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "."))
 
+	chr.watchdog.SetDefault(chronologyAlarmTimeout, chronologyAlarmID)
 	if !sr.DoWork(chr.rounder) {
+		chr.watchdog.Stop(chronologyAlarmID)
+		chr.subroundId = srBeforeStartRound
+		return
+	}
+	chr.watchdog.Stop(chronologyAlarmID)
+
+	if chr.isRoundOver() {
+		// the work of the subround overran the round, so the next subround would run in a round which is already
+		// gone. The chronology resynchronizes on the current round instead, which matters for the short rounds
+		log.Debug("chronology drifted past the end of the round",
+			"round", chr.rounder.Index(),
+			"subround", sr.Name(),
+		)
 		chr.subroundId = srBeforeStartRound
 		return
 	}
@@ -143,6 +194,12 @@ func (chr *chronology) startRound() {
 	chr.subroundId = sr.Next()
 }
 
+// isRoundOver returns true if the current time has passed the end of the round known by the rounder
+func (chr *chronology) isRoundOver() bool {
+	roundEndTime := chr.rounder.TimeStamp().Add(chr.rounder.TimeDuration())
+	return !chr.syncTimer.CurrentTime().Before(roundEndTime)
+}
+
 // updateRound updates rounds and subrounds depending of the current time and the finished tasks
 func (chr *chronology) updateRound() {
 	oldRoundIndex := chr.rounder.Index()
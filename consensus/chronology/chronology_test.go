@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/round"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -181,6 +182,55 @@ func TestChronology_StartRoundShouldWork(t *testing.T) {
 	assert.Equal(t, srm.Next(), chr.SubroundId())
 }
 
+func TestChronology_StartRoundShouldArmTheWatchdogWhileTheSubroundWorks(t *testing.T) {
+	t.Parallel()
+	rounderMock := &mock.RounderMock{}
+	rounderMock.UpdateRound(rounderMock.TimeStamp(), rounderMock.TimeStamp().Add(rounderMock.TimeDuration()))
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		time.Now(),
+		rounderMock,
+		syncTimerMock)
+
+	isArmed := false
+	wasArmedDuringWork := false
+	err := chr.SetWatchdog(&mock.WatchdogTimerStub{
+		SetDefaultCalled: func(duration time.Duration, alarmID string) {
+			isArmed = true
+		},
+		StopCalled: func(alarmID string) {
+			isArmed = false
+		},
+	})
+	assert.Nil(t, err)
+
+	srm := initSubroundHandlerMock()
+	srm.DoWorkCalled = func(rounder consensus.Rounder) bool {
+		wasArmedDuringWork = isArmed
+		return true
+	}
+	chr.AddSubround(srm)
+	chr.SetSubroundId(0)
+	chr.StartRound()
+
+	assert.True(t, wasArmedDuringWork)
+	assert.False(t, isArmed)
+}
+
+func TestChronology_SetWatchdogWithNilValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		syncTimerMock.CurrentTime(),
+		rounderMock,
+		syncTimerMock)
+	err := chr.SetWatchdog(nil)
+
+	assert.Equal(t, chronology.ErrNilWatchdog, err)
+}
+
 func TestChronology_UpdateRoundShouldInitRound(t *testing.T) {
 	t.Parallel()
 	rounderMock := &mock.RounderMock{}
@@ -355,3 +405,126 @@ func TestChronology_CheckIfStatusHandlerWorks(t *testing.T) {
 		assert.Fail(t, "AppStatusHandler not working")
 	}
 }
+
+func TestChronology_CloseShouldStopTheRounds(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		syncTimerMock.CurrentTime(),
+		rounderMock,
+		syncTimerMock)
+
+	chanStopped := make(chan struct{})
+	go func() {
+		chr.StartRounds()
+		close(chanStopped)
+	}()
+
+	err := chr.Close()
+	assert.Nil(t, err)
+
+	select {
+	case <-chanStopped:
+	case <-time.After(time.Second):
+		assert.Fail(t, "rounds were not stopped")
+	}
+
+	err = chr.Close()
+	assert.Nil(t, err)
+}
+
+//------- sub-second rounds
+
+type chronologyStarter interface {
+	AddSubround(subroundHandler consensus.SubroundHandler)
+	StartRound()
+}
+
+func createChronologyWithRealRound(
+	roundDuration time.Duration,
+	currentTime *time.Duration,
+) (consensus.Rounder, chronologyStarter) {
+	genesisTime := time.Unix(0, 0)
+	syncTimerMock := &mock.SyncTimerMock{
+		CurrentTimeCalled: func() time.Time {
+			return genesisTime.Add(*currentTime)
+		},
+	}
+	rnd, _ := round.NewRound(genesisTime, genesisTime, roundDuration, syncTimerMock)
+	chr, _ := chronology.NewChronology(genesisTime, rnd, syncTimerMock)
+
+	return rnd, chr
+}
+
+func createSubroundHandler(current int, next int, doWork func(rounder consensus.Rounder) bool) *mock.SubroundHandlerMock {
+	srm := initSubroundHandlerMock()
+	srm.CurrentCalled = func() int {
+		return current
+	}
+	srm.NextCalled = func() int {
+		return next
+	}
+	srm.DoWorkCalled = doWork
+
+	return srm
+}
+
+func TestChronology_SubSecondRoundsShouldStartEveryRoundOnce(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := 333 * time.Millisecond
+	currentTime := time.Duration(0)
+	_, chr := createChronologyWithRealRound(roundDuration, &currentTime)
+
+	startedRounds := make([]int64, 0)
+	chr.AddSubround(createSubroundHandler(0, 1, func(rounder consensus.Rounder) bool {
+		startedRounds = append(startedRounds, rounder.Index())
+		return true
+	}))
+	chr.AddSubround(createSubroundHandler(1, -1, func(rounder consensus.Rounder) bool {
+		return true
+	}))
+
+	numRounds := int64(100)
+	for currentTime < time.Duration(numRounds+1)*roundDuration {
+		chr.StartRound()
+		currentTime += time.Millisecond
+	}
+
+	assert.Equal(t, int(numRounds), len(startedRounds))
+	for i, index := range startedRounds {
+		assert.Equal(t, int64(i+1), index)
+	}
+}
+
+func TestChronology_SubroundOverrunningTheRoundShouldResynchronize(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := 250 * time.Millisecond
+	currentTime := roundDuration
+	rnd, chr := createChronologyWithRealRound(roundDuration, &currentTime)
+
+	startedRounds := make([]int64, 0)
+	chr.AddSubround(createSubroundHandler(0, 1, func(rounder consensus.Rounder) bool {
+		startedRounds = append(startedRounds, rounder.Index())
+		if len(startedRounds) == 1 {
+			// the work of the first round lasts one round and a half
+			currentTime += roundDuration + roundDuration/2
+		}
+		return true
+	}))
+	numStaleSubrounds := 0
+	chr.AddSubround(createSubroundHandler(1, -1, func(rounder consensus.Rounder) bool {
+		numStaleSubrounds++
+		return true
+	}))
+
+	chr.StartRound()
+	chr.StartRound()
+
+	assert.Equal(t, 0, numStaleSubrounds)
+	assert.Equal(t, []int64{1, 2}, startedRounds)
+	assert.Equal(t, int64(2), rnd.Index())
+}
@@ -12,3 +12,6 @@ var ErrNilSyncTimer = errors.New("sync timer is nil")
 
 // ErrNilAppStatusHandler is raised when the AppStatusHandler is nil when setting it
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
+
+// ErrNilWatchdog is raised when the watchdog is nil when setting it
+var ErrNilWatchdog = errors.New("nil watchdog")
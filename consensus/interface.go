@@ -43,6 +43,8 @@ type ChronologyHandler interface {
 	RemoveAllSubrounds()
 	// StartRounds starts rounds in a sequential manner, one after the other
 	StartRounds()
+	// Close stops the rounds started by StartRounds
+	Close() error
 	IsInterfaceNil() bool
 }
 
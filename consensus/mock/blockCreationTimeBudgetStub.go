@@ -0,0 +1,29 @@
+package mock
+
+import (
+	"time"
+)
+
+// BlockCreationTimeBudgetStub mocks the implementation for a BlockCreationTimeBudgetHandler
+type BlockCreationTimeBudgetStub struct {
+	HaveTimeCalled func(roundStartTime time.Time, subroundEndTime time.Duration) func() bool
+}
+
+// HaveTime returns the function telling if there is still time to add data to the block
+func (bctbs *BlockCreationTimeBudgetStub) HaveTime(roundStartTime time.Time, subroundEndTime time.Duration) func() bool {
+	if bctbs.HaveTimeCalled != nil {
+		return bctbs.HaveTimeCalled(roundStartTime, subroundEndTime)
+	}
+
+	return func() bool {
+		return true
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bctbs *BlockCreationTimeBudgetStub) IsInterfaceNil() bool {
+	if bctbs == nil {
+		return true
+	}
+	return false
+}
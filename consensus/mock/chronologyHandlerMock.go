@@ -8,6 +8,7 @@ type ChronologyHandlerMock struct {
 	AddSubroundCalled        func(consensus.SubroundHandler)
 	RemoveAllSubroundsCalled func()
 	StartRoundCalled         func()
+	CloseCalled              func() error
 }
 
 func (chrm *ChronologyHandlerMock) AddSubround(subroundHandler consensus.SubroundHandler) {
@@ -28,6 +29,13 @@ func (chrm *ChronologyHandlerMock) StartRounds() {
 	}
 }
 
+func (chrm *ChronologyHandlerMock) Close() error {
+	if chrm.CloseCalled != nil {
+		return chrm.CloseCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (chrm *ChronologyHandlerMock) IsInterfaceNil() bool {
 	if chrm == nil {
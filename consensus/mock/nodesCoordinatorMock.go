@@ -10,6 +10,8 @@ type NodesCoordinatorMock struct {
 	ComputeValidatorsGroupCalled        func(randomness []byte, round uint64, shardId uint32) ([]sharding.Validator, error)
 	GetValidatorsPublicKeysCalled       func(randomness []byte, round uint64, shardId uint32) ([]string, error)
 	GetValidatorsRewardsAddressesCalled func(randomness []byte, round uint64, shardId uint32) ([]string, error)
+	ConsensusGroupSizeCalled            func(shardId uint32) int
+	ConsensusThresholdCalled            func(shardId uint32) int
 }
 
 func (ncm *NodesCoordinatorMock) ComputeValidatorsGroup(
@@ -87,15 +89,25 @@ func (ncm *NodesCoordinatorMock) GetValidatorsRewardsAddresses(
 }
 
 func (ncm *NodesCoordinatorMock) ConsensusGroupSize(shardId uint32) int {
-	panic("implement me")
+	if ncm.ConsensusGroupSizeCalled != nil {
+		return ncm.ConsensusGroupSizeCalled(shardId)
+	}
+
+	validators, _ := ncm.ComputeValidatorsGroup(nil, 0, shardId)
+
+	return len(validators)
 }
 
-func (ncm *NodesCoordinatorMock) SetNodesPerShards(map[uint32][]sharding.Validator) error {
-	return nil
+func (ncm *NodesCoordinatorMock) ConsensusThreshold(shardId uint32) int {
+	if ncm.ConsensusThresholdCalled != nil {
+		return ncm.ConsensusThresholdCalled(shardId)
+	}
+
+	return ncm.ConsensusGroupSize(shardId)*2/3 + 1
 }
 
-func (ncm *NodesCoordinatorMock) SetConsensusGroupSize(int) error {
-	panic("implement me")
+func (ncm *NodesCoordinatorMock) SetNodesPerShards(map[uint32][]sharding.Validator) error {
+	return nil
 }
 
 func (ncm *NodesCoordinatorMock) GetSelectedPublicKeys(selection []byte, shardId uint32) (publicKeys []string, err error) {
@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"time"
+)
+
+type WatchdogTimerStub struct {
+	SetCalled        func(callback func(alarmID string), duration time.Duration, alarmID string)
+	SetDefaultCalled func(duration time.Duration, alarmID string)
+	StopCalled       func(alarmID string)
+	ResetCalled      func(alarmID string)
+}
+
+func (wts *WatchdogTimerStub) Set(callback func(alarmID string), duration time.Duration, alarmID string) {
+	if wts.SetCalled != nil {
+		wts.SetCalled(callback, duration, alarmID)
+	}
+}
+
+func (wts *WatchdogTimerStub) SetDefault(duration time.Duration, alarmID string) {
+	if wts.SetDefaultCalled != nil {
+		wts.SetDefaultCalled(duration, alarmID)
+	}
+}
+
+func (wts *WatchdogTimerStub) Stop(alarmID string) {
+	if wts.StopCalled != nil {
+		wts.StopCalled(alarmID)
+	}
+}
+
+func (wts *WatchdogTimerStub) Reset(alarmID string) {
+	if wts.ResetCalled != nil {
+		wts.ResetCalled(alarmID)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wts *WatchdogTimerStub) IsInterfaceNil() bool {
+	if wts == nil {
+		return true
+	}
+	return false
+}
@@ -4,3 +4,6 @@ import "errors"
 
 // ErrNilSyncTimer is raised when a valid sync timer is expected but nil used
 var ErrNilSyncTimer = errors.New("sync timer is nil")
+
+// ErrInvalidRoundTimeDuration is raised when a zero or negative round duration is provided
+var ErrInvalidRoundTimeDuration = errors.New("invalid round time duration")
@@ -1,7 +1,6 @@
 package round
 
 import (
-	"math"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -26,21 +25,31 @@ func NewRound(
 	if syncTimer == nil || syncTimer.IsInterfaceNil() {
 		return nil, ErrNilSyncTimer
 	}
+	if roundTimeDuration <= 0 {
+		return nil, ErrInvalidRoundTimeDuration
+	}
 
 	rnd := round{timeDuration: roundTimeDuration, timeStamp: genesisTimeStamp, syncTimer: syncTimer}
 	rnd.UpdateRound(genesisTimeStamp, currentTimeStamp)
 	return &rnd, nil
 }
 
-// UpdateRound updates the index and the time stamp of the round depending of the genesis time and the current time given
+// UpdateRound updates the index and the time stamp of the round depending of the genesis time and the current time given.
+// The computation is done in integer nanoseconds and every round start is derived from the genesis time, so the sub-second
+// durations or the ones which are not multiples of a second do not accumulate any drift, no matter how many rounds passed
 func (rnd *round) UpdateRound(genesisTimeStamp time.Time, currentTimeStamp time.Time) {
 	delta := currentTimeStamp.Sub(genesisTimeStamp).Nanoseconds()
+	roundDuration := rnd.timeDuration.Nanoseconds()
 
-	index := int64(math.Floor(float64(delta) / float64(rnd.timeDuration.Nanoseconds())))
+	index := delta / roundDuration
+	if delta%roundDuration < 0 {
+		// the integer division truncates toward zero, so the rounds before genesis need one more step down
+		index--
+	}
 
 	if rnd.index != index {
 		rnd.index = index
-		rnd.timeStamp = genesisTimeStamp.Add(time.Duration(int64(index) * rnd.timeDuration.Nanoseconds()))
+		rnd.timeStamp = genesisTimeStamp.Add(time.Duration(index * roundDuration))
 	}
 }
 
@@ -153,3 +153,46 @@ func TestRound_RemainingTimeInCurrentRoundShouldReturnNegativeValue(t *testing.T
 	assert.Equal(t, time.Duration(int64(rnd.TimeDuration())-timeElapsed), remainingTime)
 	assert.True(t, remainingTime < 0)
 }
+
+func TestRound_NewRoundShouldErrInvalidRoundTimeDuration(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Now()
+
+	rnd, err := round.NewRound(genesisTime, genesisTime, 0, &mock.SyncTimerMock{})
+
+	assert.Nil(t, rnd)
+	assert.Equal(t, round.ErrInvalidRoundTimeDuration, err)
+}
+
+func TestRound_UpdateRoundWithSubSecondDurationShouldNotDrift(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(1500000000, 0)
+	subSecondDuration := 333 * time.Millisecond
+	numRounds := int64(100000000)
+
+	rnd, _ := round.NewRound(genesisTime, genesisTime, subSecondDuration, &mock.SyncTimerMock{})
+
+	roundStart := genesisTime.Add(time.Duration(numRounds) * subSecondDuration)
+	rnd.UpdateRound(genesisTime, roundStart)
+	assert.Equal(t, numRounds, rnd.Index())
+	assert.Equal(t, roundStart, rnd.TimeStamp())
+
+	rnd.UpdateRound(genesisTime, roundStart.Add(-time.Nanosecond))
+	assert.Equal(t, numRounds-1, rnd.Index())
+	assert.Equal(t, roundStart.Add(-subSecondDuration), rnd.TimeStamp())
+}
+
+func TestRound_UpdateRoundBeforeGenesisShouldReturnNegativeIndex(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(1500000000, 0)
+	subSecondDuration := 250 * time.Millisecond
+
+	rnd, _ := round.NewRound(genesisTime, genesisTime, subSecondDuration, &mock.SyncTimerMock{})
+	rnd.UpdateRound(genesisTime, genesisTime.Add(-time.Millisecond))
+
+	assert.Equal(t, int64(-1), rnd.Index())
+	assert.Equal(t, genesisTime.Add(-subSecondDuration), rnd.TimeStamp())
+}
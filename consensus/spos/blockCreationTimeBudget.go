@@ -0,0 +1,63 @@
+package spos
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+const fullRoundTimePercent = 100
+
+// blockCreationTimeBudget bounds the time a leader spends adding transactions and miniblocks to the block it
+// proposes, so that the block is created early enough in the round to be broadcast and signed by the consensus group
+type blockCreationTimeBudget struct {
+	rounder             consensus.Rounder
+	maxRoundTimePercent uint32
+}
+
+// NewBlockCreationTimeBudget creates a new block creation time budget which stops the block creation once the given
+// percentage of the round time has elapsed. A zero percentage does not limit the block creation more than its subround
+func NewBlockCreationTimeBudget(rounder consensus.Rounder, maxRoundTimePercent uint32) (*blockCreationTimeBudget, error) {
+	if rounder == nil || rounder.IsInterfaceNil() {
+		return nil, ErrNilRounder
+	}
+	if maxRoundTimePercent > fullRoundTimePercent {
+		return nil, ErrInvalidBlockCreationTimePercent
+	}
+
+	return &blockCreationTimeBudget{
+		rounder:             rounder,
+		maxRoundTimePercent: maxRoundTimePercent,
+	}, nil
+}
+
+// HaveTime returns the function which tells, while creating a block, if there is still time left to add data to it.
+// The time is measured from the round start time and is the minimum between the subround end time and the budget
+func (bctb *blockCreationTimeBudget) HaveTime(roundStartTime time.Time, subroundEndTime time.Duration) func() bool {
+	maxTime := bctb.maxTime(subroundEndTime)
+
+	return func() bool {
+		return bctb.rounder.RemainingTime(roundStartTime, maxTime) > 0
+	}
+}
+
+func (bctb *blockCreationTimeBudget) maxTime(subroundEndTime time.Duration) time.Duration {
+	if bctb.maxRoundTimePercent == 0 {
+		return subroundEndTime
+	}
+
+	budget := bctb.rounder.TimeDuration() * time.Duration(bctb.maxRoundTimePercent) / fullRoundTimePercent
+	if budget < subroundEndTime {
+		return budget
+	}
+
+	return subroundEndTime
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bctb *blockCreationTimeBudget) IsInterfaceNil() bool {
+	if bctb == nil {
+		return true
+	}
+	return false
+}
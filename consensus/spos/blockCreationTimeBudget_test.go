@@ -0,0 +1,78 @@
+package spos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/stretchr/testify/assert"
+)
+
+func createRounderWithElapsedTime(roundDuration time.Duration, elapsedTime time.Duration) *mock.RounderMock {
+	return &mock.RounderMock{
+		TimeDurationCalled: func() time.Duration {
+			return roundDuration
+		},
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return maxTime - elapsedTime
+		},
+	}
+}
+
+func TestNewBlockCreationTimeBudget_NilRounderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bctb, err := spos.NewBlockCreationTimeBudget(nil, 10)
+
+	assert.Nil(t, bctb)
+	assert.Equal(t, spos.ErrNilRounder, err)
+}
+
+func TestNewBlockCreationTimeBudget_InvalidPercentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bctb, err := spos.NewBlockCreationTimeBudget(&mock.RounderMock{}, 101)
+
+	assert.Nil(t, bctb)
+	assert.Equal(t, spos.ErrInvalidBlockCreationTimePercent, err)
+}
+
+func TestNewBlockCreationTimeBudget_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	bctb, err := spos.NewBlockCreationTimeBudget(&mock.RounderMock{}, 100)
+
+	assert.Nil(t, err)
+	assert.False(t, bctb.IsInterfaceNil())
+}
+
+func TestBlockCreationTimeBudget_HaveTimeShouldStopWhenTheBudgetIsConsumed(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := time.Second
+	subroundEndTime := roundDuration * 25 / 100
+
+	bctb, _ := spos.NewBlockCreationTimeBudget(createRounderWithElapsedTime(roundDuration, roundDuration*14/100), 15)
+	assert.True(t, bctb.HaveTime(time.Now(), subroundEndTime)())
+
+	bctb, _ = spos.NewBlockCreationTimeBudget(createRounderWithElapsedTime(roundDuration, roundDuration*16/100), 15)
+	assert.False(t, bctb.HaveTime(time.Now(), subroundEndTime)())
+}
+
+func TestBlockCreationTimeBudget_HaveTimeShouldNotExceedTheSubroundEndTime(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := time.Second
+	subroundEndTime := roundDuration * 25 / 100
+	rounder := createRounderWithElapsedTime(roundDuration, roundDuration*30/100)
+
+	bctb, _ := spos.NewBlockCreationTimeBudget(rounder, 50)
+	assert.False(t, bctb.HaveTime(time.Now(), subroundEndTime)())
+
+	bctb, _ = spos.NewBlockCreationTimeBudget(rounder, 0)
+	assert.False(t, bctb.HaveTime(time.Now(), subroundEndTime)())
+
+	bctb, _ = spos.NewBlockCreationTimeBudget(createRounderWithElapsedTime(roundDuration, roundDuration*20/100), 0)
+	assert.True(t, bctb.HaveTime(time.Now(), subroundEndTime)())
+}
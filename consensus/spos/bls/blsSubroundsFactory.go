@@ -17,8 +17,9 @@ type factory struct {
 	consensusState *spos.ConsensusState
 	worker         spos.WorkerHandler
 
-	appStatusHandler core.AppStatusHandler
-	indexer          indexer.Indexer
+	appStatusHandler        core.AppStatusHandler
+	indexer                 indexer.Indexer
+	blockCreationTimeBudget spos.BlockCreationTimeBudgetHandler
 }
 
 // NewSubroundsFactory creates a new consensusState object
@@ -36,11 +37,17 @@ func NewSubroundsFactory(
 		return nil, err
 	}
 
+	blockCreationTimeBudget, err := spos.NewBlockCreationTimeBudget(consensusDataContainer.Rounder(), 0)
+	if err != nil {
+		return nil, err
+	}
+
 	fct := factory{
-		consensusCore:    consensusDataContainer,
-		consensusState:   consensusState,
-		worker:           worker,
-		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		consensusCore:           consensusDataContainer,
+		consensusState:          consensusState,
+		worker:                  worker,
+		appStatusHandler:        statusHandler.NewNilStatusHandler(),
+		blockCreationTimeBudget: blockCreationTimeBudget,
 	}
 
 	return &fct, nil
@@ -80,6 +87,16 @@ func (fct *factory) SetIndexer(indexer indexer.Indexer) {
 	fct.indexer = indexer
 }
 
+// SetBlockCreationTimeBudget method will update the value of the factory's block creation time budget
+func (fct *factory) SetBlockCreationTimeBudget(blockCreationTimeBudget spos.BlockCreationTimeBudgetHandler) error {
+	if blockCreationTimeBudget == nil || blockCreationTimeBudget.IsInterfaceNil() {
+		return spos.ErrNilBlockCreationTimeBudget
+	}
+
+	fct.blockCreationTimeBudget = blockCreationTimeBudget
+	return nil
+}
+
 // GenerateSubrounds will generate the subrounds used in BLS Cns
 func (fct *factory) GenerateSubrounds() error {
 	fct.initConsensusThreshold()
@@ -136,6 +153,7 @@ func (fct *factory) generateStartRoundSubround() error {
 		processingThresholdPercent,
 		getSubroundName,
 		fct.worker.ExecuteStoredMessages,
+		fct.setConsensusThreshold,
 	)
 	if err != nil {
 		return err
@@ -182,6 +200,11 @@ func (fct *factory) generateBlockSubround() error {
 		return err
 	}
 
+	err = subroundBlock.SetBlockCreationTimeBudget(fct.blockCreationTimeBudget)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtBlockBody, subroundBlock.ReceivedBlockBody)
 	fct.worker.AddReceivedMessageCall(MtBlockHeader, subroundBlock.ReceivedBlockHeader)
 	fct.consensusCore.Chronology().AddSubround(subroundBlock)
@@ -262,8 +285,14 @@ func (fct *factory) generateEndRoundSubround() error {
 
 func (fct *factory) initConsensusThreshold() {
 	pbftThreshold := fct.consensusState.ConsensusGroupSize()*2/3 + 1
+	fct.setConsensusThreshold(pbftThreshold)
+}
+
+// setConsensusThreshold sets the number of agreements needed by the signature subround. It is called at the start
+// of each round, as the threshold can change at an epoch boundary
+func (fct *factory) setConsensusThreshold(threshold int) {
 	fct.consensusState.SetThreshold(SrBlock, 1)
-	fct.consensusState.SetThreshold(SrSignature, pbftThreshold)
+	fct.consensusState.SetThreshold(SrSignature, threshold)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -323,6 +323,26 @@ func TestFactory_NewFactoryShouldWork(t *testing.T) {
 	assert.NotNil(t, fct)
 }
 
+func TestFactory_SetBlockCreationTimeBudgetNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+	err := fct.SetBlockCreationTimeBudget(nil)
+
+	assert.Equal(t, spos.ErrNilBlockCreationTimeBudget, err)
+}
+
+func TestFactory_SetBlockCreationTimeBudgetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+	err := fct.SetBlockCreationTimeBudget(&mock.BlockCreationTimeBudgetStub{})
+
+	assert.Nil(t, err)
+	err = fct.GenerateSubrounds()
+	assert.Nil(t, err)
+}
+
 func TestFactory_GenerateSubroundStartRoundShouldFailWhenNewSubroundFail(t *testing.T) {
 	t.Parallel()
 
@@ -5,7 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("consensus/spos/bn")
 
 const (
 	// SrStartRound defines ID of subround "Start round"
@@ -115,3 +115,6 @@ func getSubroundName(subroundId int) string {
 // executeStoredMessages tries to execute all the messages received which are valid for execution
 func executeStoredMessages() {
 }
+
+func setConsensusThreshold(threshold int) {
+}
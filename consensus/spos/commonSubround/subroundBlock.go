@@ -19,6 +19,7 @@ type SubroundBlock struct {
 	mtBlockHeader                 int
 	processingThresholdPercentage int
 	getSubroundName               func(subroundId int) string
+	blockCreationTimeBudget       spos.BlockCreationTimeBudgetHandler
 }
 
 // NewSubroundBlock creates a SubroundBlock object
@@ -37,12 +38,18 @@ func NewSubroundBlock(
 		return nil, err
 	}
 
+	blockCreationTimeBudget, err := spos.NewBlockCreationTimeBudget(baseSubround.Rounder(), 0)
+	if err != nil {
+		return nil, err
+	}
+
 	srBlock := SubroundBlock{
 		baseSubround,
 		mtBlockBody,
 		mtBlockHeader,
 		processingThresholdPercentage,
 		getSubroundName,
+		blockCreationTimeBudget,
 	}
 
 	srBlock.Job = srBlock.doBlockJob
@@ -68,6 +75,16 @@ func checkNewSubroundBlockParams(
 	return err
 }
 
+// SetBlockCreationTimeBudget method sets the time budget used while creating the proposed block
+func (sr *SubroundBlock) SetBlockCreationTimeBudget(blockCreationTimeBudget spos.BlockCreationTimeBudgetHandler) error {
+	if blockCreationTimeBudget == nil || blockCreationTimeBudget.IsInterfaceNil() {
+		return spos.ErrNilBlockCreationTimeBudget
+	}
+
+	sr.blockCreationTimeBudget = blockCreationTimeBudget
+	return nil
+}
+
 // doBlockJob method does the job of the subround Block
 func (sr *SubroundBlock) doBlockJob() bool {
 	if !sr.IsSelfLeaderInCurrentRound() { // is NOT self leader in this round?
@@ -102,12 +119,7 @@ func (sr *SubroundBlock) doBlockJob() bool {
 
 // sendBlockBody method job the proposed block body in the subround Block
 func (sr *SubroundBlock) sendBlockBody() bool {
-	startTime := time.Time{}
-	startTime = sr.RoundTimeStamp
-	maxTime := time.Duration(sr.EndTime())
-	haveTimeInCurrentSubround := func() bool {
-		return sr.Rounder().RemainingTime(startTime, maxTime) > 0
-	}
+	haveTimeInCurrentSubround := sr.blockCreationTimeBudget.HaveTime(sr.RoundTimeStamp, time.Duration(sr.EndTime()))
 
 	blockBody, err := sr.BlockProcessor().CreateBlockBody(
 		uint64(sr.Rounder().Index()),
@@ -187,12 +199,7 @@ func (sr *SubroundBlock) sendBlockHeader() bool {
 }
 
 func (sr *SubroundBlock) createHeader() (data.HeaderHandler, error) {
-	startTime := time.Time{}
-	startTime = sr.RoundTimeStamp
-	maxTime := time.Duration(sr.EndTime())
-	haveTimeInCurrentSubround := func() bool {
-		return sr.Rounder().RemainingTime(startTime, maxTime) > 0
-	}
+	haveTimeInCurrentSubround := sr.blockCreationTimeBudget.HaveTime(sr.RoundTimeStamp, time.Duration(sr.EndTime()))
 
 	hdr, err := sr.BlockProcessor().CreateBlockHeader(
 		sr.BlockBody,
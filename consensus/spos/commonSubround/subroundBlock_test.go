@@ -310,6 +310,53 @@ func TestSubroundBlock_DoBlockJob(t *testing.T) {
 	assert.Equal(t, uint64(1), sr.Header.GetNonce())
 }
 
+func TestSubroundBlock_SetBlockCreationTimeBudgetNilShouldFail(t *testing.T) {
+	t.Parallel()
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+
+	err := sr.SetBlockCreationTimeBudget(nil)
+	assert.Equal(t, spos.ErrNilBlockCreationTimeBudget, err)
+}
+
+func TestSubroundBlock_DoBlockJobShouldCreateTheBlockWithinTheTimeBudget(t *testing.T) {
+	t.Parallel()
+	container := mock.InitConsensusCore()
+	bpm := mock.InitBlockProcessorMock()
+	haveTimeInBody := true
+	bpm.CreateBlockCalled = func(round uint64, haveTime func() bool) (data.BodyHandler, error) {
+		haveTimeInBody = haveTime()
+		return make(block.Body, 0), nil
+	}
+	container.SetBlockProcessor(bpm)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			return nil
+		},
+	})
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+
+	var budgetSubroundEndTime time.Duration
+	err := sr.SetBlockCreationTimeBudget(&mock.BlockCreationTimeBudgetStub{
+		HaveTimeCalled: func(roundStartTime time.Time, subroundEndTime time.Duration) func() bool {
+			budgetSubroundEndTime = subroundEndTime
+			return func() bool {
+				return false
+			}
+		},
+	})
+	assert.Nil(t, err)
+
+	r := sr.DoBlockJob()
+	assert.True(t, r)
+	assert.False(t, haveTimeInBody)
+	assert.Equal(t, time.Duration(sr.EndTime()), budgetSubroundEndTime)
+}
+
 func TestSubroundBlock_ReceivedBlock(t *testing.T) {
 	t.Parallel()
 	container := mock.InitConsensusCore()
@@ -12,7 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("consensus/spos/commonSubround")
 
 // SubroundStartRound defines the data needed by the subround StartRound
 type SubroundStartRound struct {
@@ -20,6 +20,7 @@ type SubroundStartRound struct {
 	processingThresholdPercentage int
 	getSubroundName               func(subroundId int) string
 	executeStoredMessages         func()
+	setConsensusThreshold         func(threshold int)
 
 	appStatusHandler core.AppStatusHandler
 	indexer          indexer.Indexer
@@ -32,6 +33,7 @@ func NewSubroundStartRound(
 	processingThresholdPercentage int,
 	getSubroundName func(subroundId int) string,
 	executeStoredMessages func(),
+	setConsensusThreshold func(threshold int),
 ) (*SubroundStartRound, error) {
 	err := checkNewSubroundStartRoundParams(
 		baseSubround,
@@ -39,12 +41,16 @@ func NewSubroundStartRound(
 	if err != nil {
 		return nil, err
 	}
+	if setConsensusThreshold == nil {
+		return nil, spos.ErrNilSetConsensusThreshold
+	}
 
 	srStartRound := SubroundStartRound{
 		baseSubround,
 		processingThresholdPercentage,
 		getSubroundName,
 		executeStoredMessages,
+		setConsensusThreshold,
 		statusHandler.NewNilStatusHandler(),
 		indexer.NewNilIndexer(),
 	}
@@ -251,6 +257,10 @@ func (sr *SubroundStartRound) generateNextConsensusGroup(roundIndex int64) error
 
 	sr.SetConsensusGroup(nextConsensusGroup)
 
+	// the consensus group size and threshold can change at an epoch boundary
+	sr.SetConsensusGroupSize(len(nextConsensusGroup))
+	sr.setConsensusThreshold(sr.NodesCoordinator().ConsensusThreshold(shardId))
+
 	sr.BlockProcessor().SetConsensusData(randomSeed, uint64(sr.RoundIndex), currentHeader.GetEpoch(), shardId)
 
 	return nil
@@ -2,6 +2,7 @@ package commonSubround_test
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ func defaultSubroundStartRoundFromSubround(sr *spos.Subround) (*commonSubround.S
 		processingThresholdPercent,
 		getSubroundName,
 		executeStoredMessages,
+		setConsensusThreshold,
 	)
 
 	return startRound, err
@@ -54,6 +56,7 @@ func initSubroundStartRoundWithContainer(container spos.ConsensusCoreHandler) *c
 		processingThresholdPercent,
 		getSubroundName,
 		executeStoredMessages,
+		setConsensusThreshold,
 	)
 
 	return srStartRound
@@ -73,12 +76,34 @@ func TestSubroundStartRound_NewSubroundStartRoundNilSubroundShouldFail(t *testin
 		processingThresholdPercent,
 		getSubroundName,
 		executeStoredMessages,
+		setConsensusThreshold,
 	)
 
 	assert.Nil(t, srStartRound)
 	assert.Equal(t, spos.ErrNilSubround, err)
 }
 
+func TestSubroundStartRound_NewSubroundStartRoundNilSetConsensusThresholdShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+
+	sr, _ := defaultSubround(consensusState, ch, container)
+	srStartRound, err := commonSubround.NewSubroundStartRound(
+		sr,
+		extend,
+		processingThresholdPercent,
+		getSubroundName,
+		executeStoredMessages,
+		nil,
+	)
+
+	assert.Nil(t, srStartRound)
+	assert.Equal(t, spos.ErrNilSetConsensusThreshold, err)
+}
+
 func TestSubroundStartRound_NewSubroundStartRoundNilBlockChainShouldFail(t *testing.T) {
 	t.Parallel()
 
@@ -425,3 +450,46 @@ func TestSubroundStartRound_GenerateNextConsensusGroupShouldReturnErr(t *testing
 
 	assert.Equal(t, err, err2)
 }
+
+func TestSubroundStartRound_GenerateNextConsensusGroupShouldSetConsensusSizeAndThreshold(t *testing.T) {
+	t.Parallel()
+
+	validatorGroupSelector := &mock.NodesCoordinatorMock{}
+	validatorGroupSelector.ComputeValidatorsGroupCalled = func(
+		bytes []byte,
+		round uint64,
+		shardId uint32,
+	) ([]sharding.Validator, error) {
+		return []sharding.Validator{
+			mock.NewValidatorMock(big.NewInt(0), 0, []byte("A"), []byte("AA")),
+			mock.NewValidatorMock(big.NewInt(0), 0, []byte("B"), []byte("BB")),
+			mock.NewValidatorMock(big.NewInt(0), 0, []byte("C"), []byte("CC")),
+		}, nil
+	}
+	validatorGroupSelector.ConsensusThresholdCalled = func(shardId uint32) int {
+		return 2
+	}
+	container := mock.InitConsensusCore()
+	container.SetValidatorGroupSelector(validatorGroupSelector)
+
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	sr, _ := defaultSubround(consensusState, ch, container)
+	threshold := 0
+	srStartRound, _ := commonSubround.NewSubroundStartRound(
+		sr,
+		extend,
+		processingThresholdPercent,
+		getSubroundName,
+		executeStoredMessages,
+		func(value int) {
+			threshold = value
+		},
+	)
+
+	err := srStartRound.GenerateNextConsensusGroup(0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, srStartRound.ConsensusGroupSize())
+	assert.Equal(t, 2, threshold)
+}
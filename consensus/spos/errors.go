@@ -147,3 +147,18 @@ var ErrNilExecuteStoredMessages = errors.New("executeStoredMessages is nil")
 
 // ErrNilAppStatusHandler defines the error for setting a nil AppStatusHandler
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
+
+// ErrNilSetConsensusThreshold is raised when a valid setConsensusThreshold function is expected but nil used
+var ErrNilSetConsensusThreshold = errors.New("setConsensusThreshold is nil")
+
+// ErrInvalidBlockCreationTimePercent is raised when the block creation time budget is not a valid percentage of the round
+var ErrInvalidBlockCreationTimePercent = errors.New("invalid block creation time percent")
+
+// ErrNilBlockCreationTimeBudget is raised when a valid block creation time budget is expected but nil used
+var ErrNilBlockCreationTimeBudget = errors.New("block creation time budget is nil")
+
+// ErrMessageForFutureRound is raised when a message is for a round too far ahead of the current one
+var ErrMessageForFutureRound = errors.New("message is for future round")
+
+// ErrFutureRoundMessagesBufferFull is raised when no more messages can be buffered for a future round
+var ErrFutureRoundMessagesBufferFull = errors.New("future round messages buffer is full")
@@ -138,3 +138,7 @@ func (wrk *Worker) SetConsensusStateChangedChannel(consensusStateChangedChannel
 func (wrk *Worker) CheckSelfState(cnsDta *consensus.Message) error {
 	return wrk.checkSelfState(cnsDta)
 }
+
+func (wrk *Worker) NumFutureRoundMessages() int {
+	return wrk.futureRoundMessages.len()
+}
@@ -0,0 +1,79 @@
+package spos
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// maxFutureRounds specifies how many rounds ahead of the current one the consensus messages are buffered
+const maxFutureRounds = 2
+
+// maxMessagesPerFutureRound bounds the number of consensus messages buffered for each future round
+const maxMessagesPerFutureRound = 1000
+
+// futureRoundMessages buffers the consensus messages which arrive slightly early, for the next rounds, while the
+// node is still finishing the current one. The buffered messages are released when the round advances
+type futureRoundMessages struct {
+	mut      sync.Mutex
+	messages map[int64][]*consensus.Message
+}
+
+func newFutureRoundMessages() *futureRoundMessages {
+	return &futureRoundMessages{
+		messages: make(map[int64][]*consensus.Message),
+	}
+}
+
+// add buffers the given message if it is in the future rounds window relative to the given round and there is
+// still room for it
+func (frm *futureRoundMessages) add(cnsDta *consensus.Message, currentRound int64) error {
+	if cnsDta.RoundIndex > currentRound+maxFutureRounds {
+		return ErrMessageForFutureRound
+	}
+
+	frm.mut.Lock()
+	defer frm.mut.Unlock()
+
+	if len(frm.messages[cnsDta.RoundIndex]) >= maxMessagesPerFutureRound {
+		return ErrFutureRoundMessagesBufferFull
+	}
+
+	frm.messages[cnsDta.RoundIndex] = append(frm.messages[cnsDta.RoundIndex], cnsDta)
+
+	return nil
+}
+
+// popUpTo removes from the buffer the messages of the rounds up to the given one, returning the messages of the
+// given round. The messages of the older rounds can not be executed anymore and are dropped
+func (frm *futureRoundMessages) popUpTo(currentRound int64) []*consensus.Message {
+	frm.mut.Lock()
+	defer frm.mut.Unlock()
+
+	var currentRoundMessages []*consensus.Message
+	for round, messages := range frm.messages {
+		if round > currentRound {
+			continue
+		}
+		if round == currentRound {
+			currentRoundMessages = messages
+		}
+
+		delete(frm.messages, round)
+	}
+
+	return currentRoundMessages
+}
+
+// len returns the number of buffered messages
+func (frm *futureRoundMessages) len() int {
+	frm.mut.Lock()
+	defer frm.mut.Unlock()
+
+	numMessages := 0
+	for _, messages := range frm.messages {
+		numMessages += len(messages)
+	}
+
+	return numMessages
+}
@@ -1,6 +1,8 @@
 package spos
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -76,6 +78,12 @@ type SubroundsFactory interface {
 	IsInterfaceNil() bool
 }
 
+// BlockCreationTimeBudgetHandler bounds the time spent by the leader while creating the block it proposes
+type BlockCreationTimeBudgetHandler interface {
+	HaveTime(roundStartTime time.Time, subroundEndTime time.Duration) func() bool
+	IsInterfaceNil() bool
+}
+
 //WorkerHandler represents the interface for the SposWorker
 type WorkerHandler interface {
 	//AddReceivedMessageCall adds a new handler function for a received messege type
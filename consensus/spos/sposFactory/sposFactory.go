@@ -21,6 +21,7 @@ func GetSubroundsFactory(
 	consensusType string,
 	appStatusHandler core.AppStatusHandler,
 	indexer indexer.Indexer,
+	blockCreationTimeBudget spos.BlockCreationTimeBudgetHandler,
 ) (spos.SubroundsFactory, error) {
 
 	switch consensusType {
@@ -37,6 +38,11 @@ func GetSubroundsFactory(
 
 		subRoundFactoryBls.SetIndexer(indexer)
 
+		err = subRoundFactoryBls.SetBlockCreationTimeBudget(blockCreationTimeBudget)
+		if err != nil {
+			return nil, err
+		}
+
 		return subRoundFactoryBls, nil
 	case bnConsensusType:
 		subRoundFactoryBn, err := bn.NewSubroundsFactory(consensusDataContainer, consensusState, worker)
@@ -51,6 +57,11 @@ func GetSubroundsFactory(
 
 		subRoundFactoryBn.SetIndexer(indexer)
 
+		err = subRoundFactoryBn.SetBlockCreationTimeBudget(blockCreationTimeBudget)
+		if err != nil {
+			return nil, err
+		}
+
 		return subRoundFactoryBn, nil
 	}
 
@@ -45,6 +45,8 @@ type Worker struct {
 
 	mapHashConsensusMessage map[string][]*consensus.Message
 	mutHashConsensusMessage sync.RWMutex
+
+	futureRoundMessages *futureRoundMessages
 }
 
 // NewWorker creates a new Worker object
@@ -107,6 +109,7 @@ func NewWorker(
 	go wrk.checkChannels()
 
 	wrk.mapHashConsensusMessage = make(map[string][]*consensus.Message)
+	wrk.futureRoundMessages = newFutureRoundMessages()
 
 	return &wrk, nil
 }
@@ -298,11 +301,32 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToS
 		return nil
 	}
 
+	if cnsDta.RoundIndex > wrk.consensusState.RoundIndex {
+		return wrk.bufferFutureRoundMessage(cnsDta)
+	}
+
 	go wrk.executeReceivedMessages(cnsDta)
 
 	return nil
 }
 
+// bufferFutureRoundMessage keeps a message which arrived before the node advanced to its round, so that it would be
+// executed when the round advances instead of being missed
+func (wrk *Worker) bufferFutureRoundMessage(cnsDta *consensus.Message) error {
+	err := wrk.futureRoundMessages.add(cnsDta, wrk.rounder.Index())
+	if err != nil {
+		log.Debug(fmt.Sprintf("dropped early message %s from %s for round %d in round %d: %s\n",
+			wrk.consensusService.GetStringValue(consensus.MessageType(cnsDta.MsgType)),
+			core.GetTrimmedPk(core.ToHex(cnsDta.PubKey)),
+			cnsDta.RoundIndex,
+			wrk.consensusState.RoundIndex,
+			err.Error()))
+		return err
+	}
+
+	return nil
+}
+
 func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
 	if wrk.consensusState.SelfPubKey() == string(cnsDta.PubKey) {
 		return ErrMessageFromItself
@@ -356,6 +380,8 @@ func (wrk *Worker) executeReceivedMessages(cnsDta *consensus.Message) {
 }
 
 func (wrk *Worker) executeStoredMessages() {
+	wrk.releaseFutureRoundMessages()
+
 	for _, i := range wrk.consensusService.GetMessageRange() {
 		cnsDataList := wrk.receivedMessages[i]
 		if len(cnsDataList) == 0 {
@@ -367,6 +393,15 @@ func (wrk *Worker) executeStoredMessages() {
 	}
 }
 
+// releaseFutureRoundMessages moves the buffered messages of the current round among the received messages
+func (wrk *Worker) releaseFutureRoundMessages() {
+	cnsDataList := wrk.futureRoundMessages.popUpTo(wrk.consensusState.RoundIndex)
+	for _, cnsDta := range cnsDataList {
+		msgType := consensus.MessageType(cnsDta.MsgType)
+		wrk.receivedMessages[msgType] = append(wrk.receivedMessages[msgType], cnsDta)
+	}
+}
+
 func (wrk *Worker) executeMessage(cnsDtaList []*consensus.Message) {
 	for i, cnsDta := range cnsDtaList {
 		if cnsDta == nil {
@@ -1384,3 +1384,84 @@ func TestWorker_ExecuteStoredMessagesShouldWork(t *testing.T) {
 	rcvMsg = wrk.ReceivedMessages()
 	assert.Equal(t, 0, len(rcvMsg[msgType]))
 }
+
+func createMarshalizedBlockHeaderMessage(wrk *spos.Worker, roundIndex int64) []byte {
+	message, _ := mock.MarshalizerMock{}.Marshal(&block.Header{})
+	cnsMsg := consensus.NewConsensusMessage(
+		message,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bn.MtBlockHeader),
+		uint64(wrk.Rounder().TimeStamp().Unix()),
+		roundIndex,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+	return buff
+}
+
+func TestWorker_ProcessReceivedMessageForNextRoundShouldBufferIt(t *testing.T) {
+	t.Parallel()
+	wrk := initWorker()
+
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createMarshalizedBlockHeaderMessage(wrk, 1)}, nil)
+	time.Sleep(100 * time.Millisecond)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockHeader]))
+	assert.Equal(t, 1, wrk.NumFutureRoundMessages())
+}
+
+func TestWorker_ProcessReceivedMessageTooFarInTheFutureShouldErr(t *testing.T) {
+	t.Parallel()
+	wrk := initWorker()
+
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createMarshalizedBlockHeaderMessage(wrk, 3)}, nil)
+
+	assert.Equal(t, spos.ErrMessageForFutureRound, err)
+	assert.Equal(t, 0, wrk.NumFutureRoundMessages())
+}
+
+func TestWorker_ProcessReceivedMessageFutureRoundBufferFullShouldErr(t *testing.T) {
+	t.Parallel()
+	wrk := initWorker()
+	buff := createMarshalizedBlockHeaderMessage(wrk, 1)
+
+	var err error
+	for i := 0; i <= 1000; i++ {
+		err = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+	}
+
+	assert.Equal(t, spos.ErrFutureRoundMessagesBufferFull, err)
+	assert.Equal(t, 1000, wrk.NumFutureRoundMessages())
+}
+
+func TestWorker_ExecuteStoredMessagesShouldReleaseTheBufferedMessagesWhenRoundAdvances(t *testing.T) {
+	t.Parallel()
+	wrk := initWorker()
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createMarshalizedBlockHeaderMessage(wrk, 1)}, nil)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createMarshalizedBlockHeaderMessage(wrk, 2)}, nil)
+
+	wrk.ExecuteStoredMessages()
+	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockHeader]))
+	assert.Equal(t, 2, wrk.NumFutureRoundMessages())
+
+	wrk.ConsensusState().RoundIndex = 1
+	wrk.ExecuteStoredMessages()
+	assert.Equal(t, 1, len(wrk.ReceivedMessages()[bn.MtBlockHeader]))
+	assert.Equal(t, int64(1), wrk.ReceivedMessages()[bn.MtBlockHeader][0].RoundIndex)
+	assert.Equal(t, 1, wrk.NumFutureRoundMessages())
+}
+
+func TestWorker_ExecuteStoredMessagesShouldDropTheBufferedMessagesOfPastRounds(t *testing.T) {
+	t.Parallel()
+	wrk := initWorker()
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createMarshalizedBlockHeaderMessage(wrk, 1)}, nil)
+
+	wrk.ConsensusState().RoundIndex = 2
+	wrk.ExecuteStoredMessages()
+
+	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockHeader]))
+	assert.Equal(t, 0, wrk.NumFutureRoundMessages())
+}
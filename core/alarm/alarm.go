@@ -0,0 +1,125 @@
+package alarm
+
+import (
+	"sync"
+	"time"
+)
+
+// alarmItem holds a scheduled alarm. Each (re)scheduling creates a new item, so a timer which was stopped too late
+// can recognize that its alarm was canceled or reset in the meantime
+type alarmItem struct {
+	callback func(alarmID string)
+	duration time.Duration
+	timer    *time.Timer
+}
+
+// alarmScheduler calls the callbacks of the named alarms once their durations have passed. It replaces the
+// goroutines waiting on time.After across the subsystems: an alarm can be canceled or restarted at any moment and
+// all the pending alarms are dropped when the scheduler is closed
+type alarmScheduler struct {
+	mutAlarms sync.Mutex
+	alarms    map[string]*alarmItem
+	isClosed  bool
+}
+
+// NewAlarmScheduler creates a new alarm scheduler
+func NewAlarmScheduler() *alarmScheduler {
+	return &alarmScheduler{
+		alarms: make(map[string]*alarmItem),
+	}
+}
+
+// Add schedules the callback to be called after the provided duration. An alarm already scheduled with the same ID
+// is replaced
+func (as *alarmScheduler) Add(callback func(alarmID string), duration time.Duration, alarmID string) {
+	if callback == nil {
+		return
+	}
+
+	as.mutAlarms.Lock()
+	defer as.mutAlarms.Unlock()
+
+	if as.isClosed {
+		return
+	}
+
+	as.cancelAlarm(alarmID)
+	as.scheduleAlarm(alarmID, callback, duration)
+}
+
+// Cancel removes the alarm with the provided ID, if it did not expire yet
+func (as *alarmScheduler) Cancel(alarmID string) {
+	as.mutAlarms.Lock()
+	as.cancelAlarm(alarmID)
+	as.mutAlarms.Unlock()
+}
+
+// Reset restarts the alarm with the provided ID, so it expires after its whole duration from now on
+func (as *alarmScheduler) Reset(alarmID string) {
+	as.mutAlarms.Lock()
+	defer as.mutAlarms.Unlock()
+
+	item, ok := as.alarms[alarmID]
+	if !ok {
+		return
+	}
+
+	as.cancelAlarm(alarmID)
+	as.scheduleAlarm(alarmID, item.callback, item.duration)
+}
+
+// Close cancels all the pending alarms. The alarms added afterwards are ignored
+func (as *alarmScheduler) Close() {
+	as.mutAlarms.Lock()
+	for alarmID := range as.alarms {
+		as.cancelAlarm(alarmID)
+	}
+	as.isClosed = true
+	as.mutAlarms.Unlock()
+}
+
+func (as *alarmScheduler) scheduleAlarm(alarmID string, callback func(alarmID string), duration time.Duration) {
+	item := &alarmItem{
+		callback: callback,
+		duration: duration,
+	}
+	item.timer = time.AfterFunc(duration, func() {
+		as.alarmExpired(alarmID, item)
+	})
+
+	as.alarms[alarmID] = item
+}
+
+func (as *alarmScheduler) cancelAlarm(alarmID string) {
+	item, ok := as.alarms[alarmID]
+	if !ok {
+		return
+	}
+
+	item.timer.Stop()
+	delete(as.alarms, alarmID)
+}
+
+func (as *alarmScheduler) alarmExpired(alarmID string, item *alarmItem) {
+	as.mutAlarms.Lock()
+	currentItem, ok := as.alarms[alarmID]
+	isCurrentAlarm := ok && currentItem == item
+	if isCurrentAlarm {
+		delete(as.alarms, alarmID)
+	}
+	as.mutAlarms.Unlock()
+
+	if !isCurrentAlarm {
+		return
+	}
+
+	item.callback(alarmID)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (as *alarmScheduler) IsInterfaceNil() bool {
+	if as == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package alarm_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/alarm"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAlarmID = "test alarm"
+
+func TestNewAlarmScheduler(t *testing.T) {
+	t.Parallel()
+
+	as := alarm.NewAlarmScheduler()
+	assert.False(t, as.IsInterfaceNil())
+}
+
+func TestAlarmScheduler_AddShouldCallTheCallbackWhenExpired(t *testing.T) {
+	t.Parallel()
+
+	as := alarm.NewAlarmScheduler()
+	defer as.Close()
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	calledAlarmID := ""
+	as.Add(func(alarmID string) {
+		calledAlarmID = alarmID
+		wg.Done()
+	}, 10*time.Millisecond, testAlarmID)
+
+	wg.Wait()
+	assert.Equal(t, testAlarmID, calledAlarmID)
+}
+
+func TestAlarmScheduler_AddSameIDShouldReplaceTheAlarm(t *testing.T) {
+	t.Parallel()
+
+	as := alarm.NewAlarmScheduler()
+	defer as.Close()
+
+	var firstCalled, secondCalled int32
+	as.Add(func(alarmID string) {
+		atomic.AddInt32(&firstCalled, 1)
+	}, 10*time.Millisecond, testAlarmID)
+	as.Add(func(alarmID string) {
+		atomic.AddInt32(&secondCalled, 1)
+	}, 20*time.Millisecond, testAlarmID)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&firstCalled))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&secondCalled))
+}
+
+func TestAlarmScheduler_CancelShouldNotCallTheCallback(t *testing.T) {
+	t.Parallel()
+
+	as := alarm.NewAlarmScheduler()
+	defer as.Close()
+
+	var called int32
+	as.Add(func(alarmID string) {
+		atomic.AddInt32(&called, 1)
+	}, 20*time.Millisecond, testAlarmID)
+	as.Cancel(testAlarmID)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&called))
+}
+
+func TestAlarmScheduler_ResetShouldPostponeTheAlarm(t *testing.T) {
+	t.Parallel()
+
+	as := alarm.NewAlarmScheduler()
+	defer as.Close()
+
+	var called int32
+	as.Add(func(alarmID string) {
+		atomic.AddInt32(&called, 1)
+	}, 100*time.Millisecond, testAlarmID)
+
+	time.Sleep(60 * time.Millisecond)
+	as.Reset(testAlarmID)
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&called))
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&called))
+}
+
+func TestAlarmScheduler_CloseShouldDropAllTheAlarms(t *testing.T) {
+	t.Parallel()
+
+	as := alarm.NewAlarmScheduler()
+
+	var called int32
+	callback := func(alarmID string) {
+		atomic.AddInt32(&called, 1)
+	}
+	as.Add(callback, 20*time.Millisecond, "alarm 1")
+	as.Add(callback, 20*time.Millisecond, "alarm 2")
+	as.Close()
+	as.Add(callback, 20*time.Millisecond, "alarm 3")
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&called))
+}
@@ -0,0 +1,46 @@
+package core
+
+const (
+	metadataUpgradeable = 1
+	metadataReadable    = 2
+	metadataPayable     = 4
+)
+
+// CodeMetadata holds the flags set on a smart contract when it is deployed or upgraded
+type CodeMetadata struct {
+	// Upgradeable allows the owner of the contract to replace its code
+	Upgradeable bool
+	// Readable allows other contracts to read the storage of the contract
+	Readable bool
+	// Payable allows the contract to receive plain value transfers, without calling any of its functions
+	Payable bool
+}
+
+// CodeMetadataFromBytes creates a metadata object from its byte representation
+func CodeMetadataFromBytes(bytes []byte) CodeMetadata {
+	if len(bytes) == 0 {
+		return CodeMetadata{}
+	}
+
+	return CodeMetadata{
+		Upgradeable: bytes[0]&metadataUpgradeable != 0,
+		Readable:    bytes[0]&metadataReadable != 0,
+		Payable:     bytes[0]&metadataPayable != 0,
+	}
+}
+
+// ToBytes returns the byte representation of the metadata, as it is saved in the account
+func (metadata *CodeMetadata) ToBytes() []byte {
+	bytes := make([]byte, 1)
+	if metadata.Upgradeable {
+		bytes[0] |= metadataUpgradeable
+	}
+	if metadata.Readable {
+		bytes[0] |= metadataReadable
+	}
+	if metadata.Payable {
+		bytes[0] |= metadataPayable
+	}
+
+	return bytes
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeMetadataFromBytes_EmptyShouldHaveNoFlagSet(t *testing.T) {
+	t.Parallel()
+
+	metadata := CodeMetadataFromBytes(nil)
+
+	assert.False(t, metadata.Upgradeable)
+	assert.False(t, metadata.Readable)
+	assert.False(t, metadata.Payable)
+}
+
+func TestCodeMetadataFromBytes_ShouldDecodeEachFlag(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, CodeMetadata{Upgradeable: true}, CodeMetadataFromBytes([]byte{metadataUpgradeable}))
+	assert.Equal(t, CodeMetadata{Readable: true}, CodeMetadataFromBytes([]byte{metadataReadable}))
+	assert.Equal(t, CodeMetadata{Payable: true}, CodeMetadataFromBytes([]byte{metadataPayable}))
+}
+
+func TestCodeMetadata_ToBytesFromBytesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	metadata := CodeMetadata{Upgradeable: true}
+	recovered := CodeMetadataFromBytes(metadata.ToBytes())
+	assert.Equal(t, metadata, recovered)
+
+	metadata = CodeMetadata{Upgradeable: false}
+	recovered = CodeMetadataFromBytes(metadata.ToBytes())
+	assert.Equal(t, metadata, recovered)
+
+	metadata = CodeMetadata{Upgradeable: true, Readable: true, Payable: true}
+	recovered = CodeMetadataFromBytes(metadata.ToBytes())
+	assert.Equal(t, metadata, recovered)
+	assert.Equal(t, []byte{metadataUpgradeable | metadataReadable | metadataPayable}, metadata.ToBytes())
+}
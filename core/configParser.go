@@ -1,11 +1,14 @@
 package core
 
 import (
+	"fmt"
+
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/pelletier/go-toml"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("core")
 
 // LoadP2PConfig returns a P2PConfig by reading the config file provided
 func LoadP2PConfig(filepath string) (*config.P2PConfig, error) {
@@ -26,3 +29,34 @@ func LoadServersPConfig(filepath string) (*config.ServersConfig, error) {
 	}
 	return cfg, nil
 }
+
+// LoadGasScheduleConfig returns the gas schedule held by the provided toml file. The file is made of sections, as
+// the built-in functions costs, each one holding the cost of its operations
+func LoadGasScheduleConfig(filepath string) (map[string]map[string]uint64, error) {
+	tree, err := toml.LoadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	gasSchedule := make(map[string]map[string]uint64)
+	for sectionName, section := range tree.ToMap() {
+		costs, ok := section.(map[string]interface{})
+		if !ok {
+			log.Error(fmt.Sprintf("gas schedule entry %s is not a section", sectionName))
+			return nil, ErrInvalidGasScheduleCost
+		}
+
+		gasSchedule[sectionName] = make(map[string]uint64, len(costs))
+		for operationName, value := range costs {
+			cost, ok := value.(int64)
+			if !ok || cost < 0 {
+				log.Error(fmt.Sprintf("invalid gas schedule cost for %s.%s", sectionName, operationName))
+				return nil, ErrInvalidGasScheduleCost
+			}
+
+			gasSchedule[sectionName][operationName] = uint64(cost)
+		}
+	}
+
+	return gasSchedule, nil
+}
@@ -1,6 +1,7 @@
 package core_test
 
 import (
+	"io/ioutil"
 	"os"
 	"testing"
 
@@ -55,3 +56,39 @@ func TestLoadServersPConfig_ShouldPass(t *testing.T) {
 	assert.NotNil(t, conf)
 	assert.Nil(t, err)
 }
+
+func TestLoadGasScheduleConfig_InvalidFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule, err := core.LoadGasScheduleConfig("testFile05")
+
+	assert.Nil(t, gasSchedule)
+	assert.Error(t, err)
+}
+
+func TestLoadGasScheduleConfig_InvalidCostShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := ioutil.WriteFile("testFile06", []byte("[BuiltInCost]\nSaveKeyValue = -1\n"), 0644)
+	assert.Nil(t, err)
+
+	gasSchedule, err := core.LoadGasScheduleConfig("testFile06")
+	_ = os.Remove("testFile06")
+
+	assert.Nil(t, gasSchedule)
+	assert.Equal(t, core.ErrInvalidGasScheduleCost, err)
+}
+
+func TestLoadGasScheduleConfig_ShouldPass(t *testing.T) {
+	t.Parallel()
+
+	err := ioutil.WriteFile("testFile07", []byte("[BuiltInCost]\nSaveKeyValue = 100\nSetUserName = 200\n"), 0644)
+	assert.Nil(t, err)
+
+	gasSchedule, err := core.LoadGasScheduleConfig("testFile07")
+	_ = os.Remove("testFile07")
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100), gasSchedule[core.GasScheduleBuiltInCost]["SaveKeyValue"])
+	assert.Equal(t, uint64(200), gasSchedule[core.GasScheduleBuiltInCost]["SetUserName"])
+}
@@ -20,12 +20,23 @@ const pkPrefixSize = 12
 //TODO convert this const into a var and read it from config when this code moves to another binary
 const MaxBulkTransactionSize = 2 << 17 //128KB bulks
 
+// ChunksTopicSuffix is the suffix of the topics on which the payloads too large for a single p2p message are sent
+// split in chunks
+const ChunksTopicSuffix = "_CHUNKS"
+
+// MaxChunkSize specifies the maximum size of the data held by one chunk of a large payload. Larger payloads are
+// split in chunks before being sent over the network
+const MaxChunkSize = 2 << 18 //512KB chunks
+
 // ConsensusTopic is the topic used in consensus algorithm
 const ConsensusTopic = "consensus"
 
 // GenesisBlockNonce is the nonce of the genesis block
 const GenesisBlockNonce = 0
 
+// MinTransactionVersion is the minimum transaction version accepted by the interceptors
+const MinTransactionVersion = 1
+
 // MetricCurrentRound is the metric for monitoring the current round of a node
 const MetricCurrentRound = "erd_current_round"
 
@@ -38,6 +49,10 @@ const MetricProbableHighestNonce = "erd_probable_highest_nonce"
 // MetricNumConnectedPeers is the metric for monitoring the number of connected peers
 const MetricNumConnectedPeers = "erd_num_connected_peers"
 
+// MetricNumRejectedPrivateNetworkHandshakes is the metric for monitoring the number of connections rejected because
+// the peer did not use the same private network key
+const MetricNumRejectedPrivateNetworkHandshakes = "erd_num_rejected_private_network_handshakes"
+
 // MetricSynchronizedRound is the metric for monitoring the synchronized round of a node
 const MetricSynchronizedRound = "erd_synchronized_round"
 
@@ -108,7 +123,7 @@ const MetricNetworkSentBps = "erd_network_sent_bps"
 // MetricNetworkSentBpsPeak is the metric for monitoring network sent peak bytes per second
 const MetricNetworkSentBpsPeak = "erd_network_sent_bps_peak"
 
-// MetricRoundTime is the metric for round time in seconds
+// MetricRoundTime is the metric for round time in milliseconds
 const MetricRoundTime = "erd_round_time"
 
 // MetricAppVersion is the metric for the current app version
@@ -144,6 +159,18 @@ const MetricHeaderSize = "erd_current_block_size"
 // MetricMiniBlocksSize is the metric that stores the current block size
 const MetricMiniBlocksSize = "erd_mini_blocks_size"
 
+// MetricLiveTPS is the metric that stores the network tps of the last metachain block
+const MetricLiveTPS = "erd_live_tps"
+
+// MetricPeakTPS is the metric that stores the highest network tps of a metachain block
+const MetricPeakTPS = "erd_peak_tps"
+
+// MetricAverageBlockTime is the metric that stores the average time between two metachain blocks, in seconds
+const MetricAverageBlockTime = "erd_average_block_time"
+
+// MetricNetworkProcessedTxCount is the metric that stores the number of transactions processed by the whole network
+const MetricNetworkProcessedTxCount = "erd_network_processed_tx_count"
+
 // MetricNumShardHeadersFromPool is the metric that stores number of shard header from pool
 const MetricNumShardHeadersFromPool = "erd_num_shard_headers_from_pool"
 
@@ -153,6 +180,63 @@ const MetricNumShardHeadersProcessed = "erd_num_shard_headers_processed"
 // MetricNumTimesInForkChoice is the metric that counts how many time a node was in fork choice
 const MetricNumTimesInForkChoice = "erd_fork_choice_count"
 
+// MetricNumStateRepairs is the metric that counts how many times the committed state did not match the block header
+// and had to be repaired
+const MetricNumStateRepairs = "erd_num_state_repairs"
+
+// MetricRequestsSentPrefix is the prefix of the per topic metric counting the requests sent to the network
+const MetricRequestsSentPrefix = "erd_requests_sent_"
+
+// MetricRequestsFulfilledPrefix is the prefix of the per topic metric counting the requests fulfilled in time
+const MetricRequestsFulfilledPrefix = "erd_requests_fulfilled_"
+
+// MetricRequestsTimedOutPrefix is the prefix of the per topic metric counting the requests that timed out
+const MetricRequestsTimedOutPrefix = "erd_requests_timed_out_"
+
+// MetricRequestsPendingPrefix is the prefix of the per topic metric holding the number of requests still waited for
+const MetricRequestsPendingPrefix = "erd_requests_pending_"
+
+// MetricRequestsAverageLatencyPrefix is the prefix of the per topic metric holding the average resolution
+// latency of the fulfilled requests, in milliseconds
+const MetricRequestsAverageLatencyPrefix = "erd_requests_avg_latency_ms_"
+
+// MetricTrieOperationsPrefix is the prefix of the per operation metric counting the trie operations executed while
+// processing the last committed block
+const MetricTrieOperationsPrefix = "erd_trie_operations_"
+
+// MetricTrieOperationsDurationPrefix is the prefix of the per operation metric holding the time spent in the trie
+// operations executed while processing the last committed block, in milliseconds
+const MetricTrieOperationsDurationPrefix = "erd_trie_operations_duration_ms_"
+
+// MetricTrieNodesRead is the metric counting the trie nodes read from the storage while processing the last
+// committed block
+const MetricTrieNodesRead = "erd_trie_nodes_read"
+
+// MetricTrieNodesWritten is the metric counting the trie nodes written to the storage while committing the last block
+const MetricTrieNodesWritten = "erd_trie_nodes_written"
+
+// MetricSigVerifierCacheHits is the metric counting the signature verifications answered from the cache
+const MetricSigVerifierCacheHits = "erd_sig_verifier_cache_hits"
+
+// MetricSigVerifierCacheMisses is the metric counting the signature verifications done on the worker pool
+const MetricSigVerifierCacheMisses = "erd_sig_verifier_cache_misses"
+
+// MetricSigVerifierCacheHitRatio is the metric holding the percentage of signature verifications answered from the cache
+const MetricSigVerifierCacheHitRatio = "erd_sig_verifier_cache_hit_ratio"
+
+// MetricNumRejectedOversizedTxData is the metric counting the intercepted transactions dropped for their data size
+const MetricNumRejectedOversizedTxData = "erd_num_rejected_oversized_tx_data"
+
+// MetricNumRejectedOversizedMiniBlocks is the metric counting the intercepted data dropped for holding a miniblock
+// with too many transactions
+const MetricNumRejectedOversizedMiniBlocks = "erd_num_rejected_oversized_miniblocks"
+
+// MetricNumRejectedOversizedBlocks is the metric counting the intercepted data dropped for holding too many miniblocks
+const MetricNumRejectedOversizedBlocks = "erd_num_rejected_oversized_blocks"
+
+// MetricNumThrottledResolverRequests is the metric counting the requests dropped by the resolvers antiflood
+const MetricNumThrottledResolverRequests = "erd_num_throttled_resolver_requests"
+
 // MaxMiniBlocksInBlock specifies the max number of mini blocks which can be added in one block
 const MaxMiniBlocksInBlock = 100
 
@@ -182,3 +266,85 @@ const MetricLeaderPercentage = "erd_metric_leader_percentage"
 
 //MetricCommunityPercentage is the metric for community rewards percentage
 const MetricCommunityPercentage = "erd_metric_community_percentage"
+
+//MetricProtocolSustainabilityAddress is the metric for the address receiving the protocol sustainability rewards
+const MetricProtocolSustainabilityAddress = "erd_metric_protocol_sustainability_address"
+
+//MetricProtocolSustainabilityPercentage is the metric for the protocol rewards percentage routed to the protocol
+// sustainability address
+const MetricProtocolSustainabilityPercentage = "erd_metric_protocol_sustainability_percentage"
+
+//MetricProtocolSustainabilityAccumulated is the metric for the protocol sustainability rewards accumulated since the
+// node started
+const MetricProtocolSustainabilityAccumulated = "erd_metric_protocol_sustainability_accumulated"
+
+//MetricStateConsistency is the metric for the outcome of the last check of the committed state against the peers
+const MetricStateConsistency = "erd_metric_state_consistency"
+
+//MetricStateDivergenceNonce is the metric for the nonce of the last block found diverging from the peers' ones
+const MetricStateDivergenceNonce = "erd_metric_state_divergence_nonce"
+
+// BuiltInFunctionClaimDeveloperRewards is the name of the built-in function which transfers the developer
+// rewards accumulated by a smart contract to its owner
+const BuiltInFunctionClaimDeveloperRewards = "ClaimDeveloperRewards"
+
+// BuiltInFunctionChangeOwnerAddress is the name of the built-in function which changes the owner of a smart contract
+const BuiltInFunctionChangeOwnerAddress = "ChangeOwnerAddress"
+
+// BuiltInFunctionSaveKeyValue is the name of the built-in function which saves key-value pairs in the
+// sender's data trie
+const BuiltInFunctionSaveKeyValue = "SaveKeyValue"
+
+// BuiltInFunctionSetUserName is the name of the built-in function which sets the user name of the sender's account
+const BuiltInFunctionSetUserName = "SetUserName"
+
+// BuiltInFunctionESDTNFTCreate is the name of the built-in function which creates a new non fungible or semi
+// fungible token in the sender's account
+const BuiltInFunctionESDTNFTCreate = "ESDTNFTCreate"
+
+// BuiltInFunctionESDTNFTTransfer is the name of the built-in function which transfers a quantity of a non fungible
+// or semi fungible token
+const BuiltInFunctionESDTNFTTransfer = "ESDTNFTTransfer"
+
+// BuiltInFunctionESDTNFTAddQuantity is the name of the built-in function which increases the quantity of a semi
+// fungible token held by its creator
+const BuiltInFunctionESDTNFTAddQuantity = "ESDTNFTAddQuantity"
+
+// BuiltInFunctionMultiESDTNFTTransfer is the name of the built-in function which transfers several non fungible
+// or semi fungible tokens in one transaction
+const BuiltInFunctionMultiESDTNFTTransfer = "MultiESDTNFTTransfer"
+
+// BuiltInFunctionESDTNFTBurn is the name of the built-in function which burns a quantity of a non fungible or semi
+// fungible token held by the sender
+const BuiltInFunctionESDTNFTBurn = "ESDTNFTBurn"
+
+// AsyncCallbackGasLock is the gas locked by the calling contract, out of the gas remaining after an asynchronous
+// call, for the execution of its callback. Only the rest of the gas is forwarded to the called contract
+const AsyncCallbackGasLock = uint64(10000)
+
+// ElrondProtectedKeyPrefix is the prefix of the data trie keys which can be written only by the protocol
+const ElrondProtectedKeyPrefix = "ELROND"
+
+// ESDTKeyIdentifier is the key identifier of the tokens saved in the data tries
+const ESDTKeyIdentifier = "esdt"
+
+// ESDTNFTLatestNonceIdentifier is the key identifier of the last nonce created for a token by its creator
+const ESDTNFTLatestNonceIdentifier = "nonce"
+
+// ESDTNFTIndexIdentifier is the key identifier of the list of non fungible and semi fungible tokens held by an account
+const ESDTNFTIndexIdentifier = "nftindex"
+
+// AsyncCallGasLockIdentifier is the key identifier of the gas locked by a contract for the callback of an
+// asynchronous call, followed in the key by the hash of the transaction which started the call
+const AsyncCallGasLockIdentifier = "asyncgaslock"
+
+// MaxRoyalty is the maximum royalty of a token, expressed in hundredths of a percent
+const MaxRoyalty = uint32(10000)
+
+// GasScheduleBuiltInCost is the gas schedule section holding the cost of each built-in function, keyed by the
+// built-in function name
+const GasScheduleBuiltInCost = "BuiltInCost"
+
+// DisableTopicRoundFlagPrefix is the prefix of the round activation names which drop the intercepted messages of a
+// topic, e.g. "DisableTopic:transactions"
+const DisableTopicRoundFlagPrefix = "DisableTopic:"
@@ -45,3 +45,16 @@ var ErrNotPositiveValue = errors.New("the provided value is not positive")
 
 // ErrNilAppStatusHandler signals that a nil status handler has been provided
 var ErrNilAppStatusHandler = errors.New("appStatusHandler is nil")
+
+// ErrInvalidUserNameLength signals that the provided user name is too short or too long
+var ErrInvalidUserNameLength = errors.New("invalid user name length")
+
+// ErrInvalidUserNameCharacters signals that the provided user name contains characters which are not allowed
+var ErrInvalidUserNameCharacters = errors.New("user name contains invalid characters")
+
+// ErrReservedUserName signals that the provided user name is reserved and can not be registered
+var ErrReservedUserName = errors.New("user name is reserved")
+
+// ErrInvalidGasScheduleCost signals that a gas schedule file holds a cost which is not a non negative integer or is not
+// placed in a section
+var ErrInvalidGasScheduleCost = errors.New("invalid cost in gas schedule")
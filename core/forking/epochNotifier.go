@@ -0,0 +1,79 @@
+package forking
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+)
+
+var log = logger.GetOrCreate("core/forking")
+
+// epochNotifier tracks the epoch of the blocks being created or processed and notifies its subscribers each time
+// the epoch changes. As blocks can be reverted, the epoch can also go back
+type epochNotifier struct {
+	mutHandlers sync.RWMutex
+	handlers    []core.EpochSubscriberHandler
+
+	mutEpoch     sync.RWMutex
+	currentEpoch uint32
+}
+
+// NewEpochNotifier creates a new epoch notifier starting with the epoch 0
+func NewEpochNotifier() *epochNotifier {
+	return &epochNotifier{
+		handlers: make([]core.EpochSubscriberHandler, 0),
+	}
+}
+
+// RegisterNotifyHandler registers a new subscriber, which is notified right away about the current epoch
+func (en *epochNotifier) RegisterNotifyHandler(handler core.EpochSubscriberHandler) {
+	if handler == nil || handler.IsInterfaceNil() {
+		return
+	}
+
+	en.mutHandlers.Lock()
+	en.handlers = append(en.handlers, handler)
+	en.mutHandlers.Unlock()
+
+	handler.EpochConfirmed(en.CurrentEpoch())
+}
+
+// CheckEpoch notifies all the subscribers if the provided epoch differs from the current one
+func (en *epochNotifier) CheckEpoch(epoch uint32) {
+	en.mutEpoch.Lock()
+	if en.currentEpoch == epoch {
+		en.mutEpoch.Unlock()
+		return
+	}
+	en.currentEpoch = epoch
+	en.mutEpoch.Unlock()
+
+	log.Debug(fmt.Sprintf("epoch changed to %d, notifying the subscribers", epoch))
+
+	en.mutHandlers.RLock()
+	handlers := make([]core.EpochSubscriberHandler, len(en.handlers))
+	copy(handlers, en.handlers)
+	en.mutHandlers.RUnlock()
+
+	for _, handler := range handlers {
+		handler.EpochConfirmed(epoch)
+	}
+}
+
+// CurrentEpoch returns the last epoch the subscribers were notified about
+func (en *epochNotifier) CurrentEpoch() uint32 {
+	en.mutEpoch.RLock()
+	defer en.mutEpoch.RUnlock()
+
+	return en.currentEpoch
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (en *epochNotifier) IsInterfaceNil() bool {
+	if en == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package forking_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/forking"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEpochNotifier_RegisterNotifyHandlerShouldNotifyTheCurrentEpoch(t *testing.T) {
+	t.Parallel()
+
+	en := forking.NewEpochNotifier()
+	en.CheckEpoch(3)
+
+	notifiedEpochs := make([]uint32, 0)
+	en.RegisterNotifyHandler(&mock.EpochSubscriberHandlerStub{
+		EpochConfirmedCalled: func(epoch uint32) {
+			notifiedEpochs = append(notifiedEpochs, epoch)
+		},
+	})
+
+	assert.Equal(t, []uint32{3}, notifiedEpochs)
+}
+
+func TestEpochNotifier_RegisterNilHandlerShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	en := forking.NewEpochNotifier()
+	en.RegisterNotifyHandler(nil)
+	en.CheckEpoch(1)
+
+	assert.Equal(t, uint32(1), en.CurrentEpoch())
+}
+
+func TestEpochNotifier_CheckEpochShouldNotifyOnlyOnEpochChange(t *testing.T) {
+	t.Parallel()
+
+	en := forking.NewEpochNotifier()
+	notifiedEpochs := make([]uint32, 0)
+	en.RegisterNotifyHandler(&mock.EpochSubscriberHandlerStub{
+		EpochConfirmedCalled: func(epoch uint32) {
+			notifiedEpochs = append(notifiedEpochs, epoch)
+		},
+	})
+
+	en.CheckEpoch(0)
+	en.CheckEpoch(1)
+	en.CheckEpoch(1)
+	en.CheckEpoch(2)
+	en.CheckEpoch(1)
+
+	assert.Equal(t, []uint32{0, 1, 2, 1}, notifiedEpochs)
+	assert.Equal(t, uint32(1), en.CurrentEpoch())
+}
@@ -0,0 +1,49 @@
+package forking
+
+import "errors"
+
+// ErrNilEpochNotifier signals that a nil epoch notifier has been provided
+var ErrNilEpochNotifier = errors.New("nil epoch notifier")
+
+// ErrEmptyGasScheduleConfig signals that no gas schedule file has been configured
+var ErrEmptyGasScheduleConfig = errors.New("empty gas schedule config")
+
+// ErrMissingGenesisGasSchedule signals that no gas schedule file has been configured to start with the epoch 0
+var ErrMissingGenesisGasSchedule = errors.New("no gas schedule configured for the epoch 0")
+
+// ErrDuplicatedGasScheduleStartEpoch signals that two gas schedule files have been configured for the same epoch
+var ErrDuplicatedGasScheduleStartEpoch = errors.New("duplicated gas schedule start epoch")
+
+// ErrNilRounder signals that a nil rounder has been provided
+var ErrNilRounder = errors.New("nil rounder")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilKeyGen signals that a nil key generator has been provided
+var ErrNilKeyGen = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilMessage signals that a nil message has been received
+var ErrNilMessage = errors.New("nil message")
+
+// ErrEmptyActivationRoundName signals that an activation round has been configured without a name
+var ErrEmptyActivationRoundName = errors.New("empty activation round name")
+
+// ErrDuplicatedActivationRoundName signals that the same name has been configured for more than one activation round
+var ErrDuplicatedActivationRoundName = errors.New("duplicated activation round name")
+
+// ErrInvalidGovernancePublicKey signals that the configured governance public key could not be decoded
+var ErrInvalidGovernancePublicKey = errors.New("invalid governance public key")
+
+// ErrRoundActivationUpdatesDisabled signals that a round activation update was received while no governance
+// public key has been configured
+var ErrRoundActivationUpdatesDisabled = errors.New("round activation updates are disabled")
+
+// ErrOldRoundActivationUpdate signals that a round activation update with an already used nonce was received
+var ErrOldRoundActivationUpdate = errors.New("old round activation update")
+
+// ErrActivationRoundAlreadyPassed signals that a round activation update tried to change a round already reached
+var ErrActivationRoundAlreadyPassed = errors.New("activation round already passed")
@@ -0,0 +1,154 @@
+package forking
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// ArgsNewGasScheduleNotifier holds all dependencies required by the gas schedule notifier in order to create a new
+// instance
+type ArgsNewGasScheduleNotifier struct {
+	GasScheduleConfig config.GasScheduleConfig
+	ConfigDir         string
+	EpochNotifier     core.EpochNotifier
+}
+
+type gasScheduleByEpoch struct {
+	startEpoch  uint32
+	gasSchedule map[string]map[string]uint64
+}
+
+// gasScheduleNotifier holds the gas schedule of the current epoch and notifies its subscribers, the VMs and the
+// built-in functions, each time the epoch changes to one which has its own gas schedule. All the configured gas
+// schedule files are loaded when the notifier is created, so a broken file is found at start up
+type gasScheduleNotifier struct {
+	gasSchedules []*gasScheduleByEpoch
+
+	mutNotifier        sync.RWMutex
+	handlers           []core.GasScheduleSubscribeHandler
+	currentGasSchedule *gasScheduleByEpoch
+}
+
+// NewGasScheduleNotifier creates a new gas schedule notifier and subscribes it to the epoch notifier
+func NewGasScheduleNotifier(args ArgsNewGasScheduleNotifier) (*gasScheduleNotifier, error) {
+	if args.EpochNotifier == nil || args.EpochNotifier.IsInterfaceNil() {
+		return nil, ErrNilEpochNotifier
+	}
+
+	gasSchedules, err := loadGasSchedules(args.GasScheduleConfig, args.ConfigDir)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &gasScheduleNotifier{
+		gasSchedules:       gasSchedules,
+		handlers:           make([]core.GasScheduleSubscribeHandler, 0),
+		currentGasSchedule: gasSchedules[0],
+	}
+	args.EpochNotifier.RegisterNotifyHandler(g)
+
+	return g, nil
+}
+
+func loadGasSchedules(gasScheduleConfig config.GasScheduleConfig, configDir string) ([]*gasScheduleByEpoch, error) {
+	if len(gasScheduleConfig.GasScheduleByEpochs) == 0 {
+		return nil, ErrEmptyGasScheduleConfig
+	}
+
+	gasSchedules := make([]*gasScheduleByEpoch, 0, len(gasScheduleConfig.GasScheduleByEpochs))
+	for _, gasScheduleConf := range gasScheduleConfig.GasScheduleByEpochs {
+		gasSchedule, err := core.LoadGasScheduleConfig(filepath.Join(configDir, gasScheduleConf.FileName))
+		if err != nil {
+			return nil, err
+		}
+
+		gasSchedules = append(gasSchedules, &gasScheduleByEpoch{
+			startEpoch:  gasScheduleConf.StartEpoch,
+			gasSchedule: gasSchedule,
+		})
+	}
+
+	sort.Slice(gasSchedules, func(i, j int) bool {
+		return gasSchedules[i].startEpoch < gasSchedules[j].startEpoch
+	})
+
+	if gasSchedules[0].startEpoch != 0 {
+		return nil, ErrMissingGenesisGasSchedule
+	}
+	for i := 1; i < len(gasSchedules); i++ {
+		if gasSchedules[i].startEpoch == gasSchedules[i-1].startEpoch {
+			return nil, ErrDuplicatedGasScheduleStartEpoch
+		}
+	}
+
+	return gasSchedules, nil
+}
+
+// RegisterNotifyHandler registers a new subscriber, which is notified right away about the current gas schedule
+func (g *gasScheduleNotifier) RegisterNotifyHandler(handler core.GasScheduleSubscribeHandler) {
+	if handler == nil || handler.IsInterfaceNil() {
+		return
+	}
+
+	g.mutNotifier.Lock()
+	g.handlers = append(g.handlers, handler)
+	gasSchedule := g.currentGasSchedule.gasSchedule
+	g.mutNotifier.Unlock()
+
+	handler.GasScheduleChange(gasSchedule)
+}
+
+// EpochConfirmed switches to the gas schedule of the provided epoch, notifying the subscribers if it differs from
+// the current one
+func (g *gasScheduleNotifier) EpochConfirmed(epoch uint32) {
+	newGasSchedule := g.gasScheduleForEpoch(epoch)
+
+	g.mutNotifier.Lock()
+	if newGasSchedule == g.currentGasSchedule {
+		g.mutNotifier.Unlock()
+		return
+	}
+	g.currentGasSchedule = newGasSchedule
+	handlers := make([]core.GasScheduleSubscribeHandler, len(g.handlers))
+	copy(handlers, g.handlers)
+	g.mutNotifier.Unlock()
+
+	log.Debug(fmt.Sprintf("switched to the gas schedule starting with epoch %d", newGasSchedule.startEpoch))
+
+	for _, handler := range handlers {
+		handler.GasScheduleChange(newGasSchedule.gasSchedule)
+	}
+}
+
+func (g *gasScheduleNotifier) gasScheduleForEpoch(epoch uint32) *gasScheduleByEpoch {
+	selected := g.gasSchedules[0]
+	for _, gasSchedule := range g.gasSchedules {
+		if gasSchedule.startEpoch > epoch {
+			break
+		}
+		selected = gasSchedule
+	}
+
+	return selected
+}
+
+// LatestGasSchedule returns the gas schedule of the current epoch. The returned map is shared and must not be altered
+func (g *gasScheduleNotifier) LatestGasSchedule() map[string]map[string]uint64 {
+	g.mutNotifier.RLock()
+	defer g.mutNotifier.RUnlock()
+
+	return g.currentGasSchedule.gasSchedule
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (g *gasScheduleNotifier) IsInterfaceNil() bool {
+	if g == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package forking_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createGasScheduleFiles(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "gasSchedules")
+	assert.Nil(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "gasScheduleV1.toml"), []byte("[BuiltInCost]\nSaveKeyValue = 100\n"), 0644)
+	assert.Nil(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, "gasScheduleV2.toml"), []byte("[BuiltInCost]\nSaveKeyValue = 200\n"), 0644)
+	assert.Nil(t, err)
+
+	return dir
+}
+
+func createMockArgsNewGasScheduleNotifier(configDir string) forking.ArgsNewGasScheduleNotifier {
+	return forking.ArgsNewGasScheduleNotifier{
+		GasScheduleConfig: config.GasScheduleConfig{
+			GasScheduleByEpochs: []config.GasScheduleByEpochs{
+				{StartEpoch: 5, FileName: "gasScheduleV2.toml"},
+				{StartEpoch: 0, FileName: "gasScheduleV1.toml"},
+			},
+		},
+		ConfigDir:     configDir,
+		EpochNotifier: forking.NewEpochNotifier(),
+	}
+}
+
+func TestNewGasScheduleNotifier_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewGasScheduleNotifier("")
+	args.EpochNotifier = nil
+	g, err := forking.NewGasScheduleNotifier(args)
+
+	assert.Nil(t, g)
+	assert.Equal(t, forking.ErrNilEpochNotifier, err)
+}
+
+func TestNewGasScheduleNotifier_EmptyConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewGasScheduleNotifier("")
+	args.GasScheduleConfig.GasScheduleByEpochs = nil
+	g, err := forking.NewGasScheduleNotifier(args)
+
+	assert.Nil(t, g)
+	assert.Equal(t, forking.ErrEmptyGasScheduleConfig, err)
+}
+
+func TestNewGasScheduleNotifier_MissingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	g, err := forking.NewGasScheduleNotifier(createMockArgsNewGasScheduleNotifier("missing directory"))
+
+	assert.Nil(t, g)
+	assert.Error(t, err)
+}
+
+func TestNewGasScheduleNotifier_MissingGenesisGasScheduleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createGasScheduleFiles(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgsNewGasScheduleNotifier(dir)
+	args.GasScheduleConfig.GasScheduleByEpochs = args.GasScheduleConfig.GasScheduleByEpochs[:1]
+	g, err := forking.NewGasScheduleNotifier(args)
+
+	assert.Nil(t, g)
+	assert.Equal(t, forking.ErrMissingGenesisGasSchedule, err)
+}
+
+func TestNewGasScheduleNotifier_DuplicatedStartEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createGasScheduleFiles(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgsNewGasScheduleNotifier(dir)
+	args.GasScheduleConfig.GasScheduleByEpochs[0].StartEpoch = 0
+	g, err := forking.NewGasScheduleNotifier(args)
+
+	assert.Nil(t, g)
+	assert.Equal(t, forking.ErrDuplicatedGasScheduleStartEpoch, err)
+}
+
+func TestGasScheduleNotifier_ShouldSwitchTheGasScheduleOnEpochChange(t *testing.T) {
+	t.Parallel()
+
+	dir := createGasScheduleFiles(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgsNewGasScheduleNotifier(dir)
+	epochNotifier := forking.NewEpochNotifier()
+	args.EpochNotifier = epochNotifier
+	g, err := forking.NewGasScheduleNotifier(args)
+	assert.Nil(t, err)
+
+	notifiedCosts := make([]uint64, 0)
+	g.RegisterNotifyHandler(&mock.GasScheduleSubscribeHandlerStub{
+		GasScheduleChangeCalled: func(gasSchedule map[string]map[string]uint64) {
+			notifiedCosts = append(notifiedCosts, gasSchedule[core.GasScheduleBuiltInCost]["SaveKeyValue"])
+		},
+	})
+
+	epochNotifier.CheckEpoch(4)
+	epochNotifier.CheckEpoch(5)
+	epochNotifier.CheckEpoch(7)
+	epochNotifier.CheckEpoch(2)
+
+	assert.Equal(t, []uint64{100, 200, 100}, notifiedCosts)
+	assert.Equal(t, uint64(100), g.LatestGasSchedule()[core.GasScheduleBuiltInCost]["SaveKeyValue"])
+}
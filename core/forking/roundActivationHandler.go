@@ -0,0 +1,195 @@
+package forking
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// ArgsRoundActivationHandler holds all dependencies required by the round activation handler in order to create a
+// new instance
+type ArgsRoundActivationHandler struct {
+	RoundConfig  config.RoundConfig
+	Rounder      core.RoundHandler
+	Marshalizer  marshal.Marshalizer
+	KeyGen       crypto.KeyGenerator
+	SingleSigner crypto.SingleSigner
+}
+
+// RoundActivationUpdate holds a change of the activation rounds, signed with the governance key. The nonce has to
+// increase with each update so an old update can not be replayed
+type RoundActivationUpdate struct {
+	Nonce            uint64                         `json:"nonce"`
+	ActivationRounds []config.ActivationRoundByName `json:"activationRounds"`
+	Signature        []byte                         `json:"signature"`
+}
+
+// roundActivationHandler tells if the behaviors which have to switch at an exact round, like the quick fixes which
+// can not wait for an epoch change, are enabled. The activation rounds come from the config and can be altered by
+// governance signed updates received on the network, as long as the changed rounds have not been reached yet
+type roundActivationHandler struct {
+	rounder          core.RoundHandler
+	marshalizer      marshal.Marshalizer
+	singleSigner     crypto.SingleSigner
+	governancePubKey crypto.PublicKey
+
+	mutRounds       sync.RWMutex
+	rounds          map[string]uint64
+	lastUpdateNonce uint64
+}
+
+// NewRoundActivationHandler creates a new round activation handler
+func NewRoundActivationHandler(args ArgsRoundActivationHandler) (*roundActivationHandler, error) {
+	if check.IfNil(args.Rounder) {
+		return nil, ErrNilRounder
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.KeyGen) {
+		return nil, ErrNilKeyGen
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+
+	rounds := make(map[string]uint64)
+	for _, activation := range args.RoundConfig.ActivationRounds {
+		if len(activation.Name) == 0 {
+			return nil, ErrEmptyActivationRoundName
+		}
+		_, found := rounds[activation.Name]
+		if found {
+			return nil, fmt.Errorf("%s: %s", ErrDuplicatedActivationRoundName.Error(), activation.Name)
+		}
+		rounds[activation.Name] = activation.Round
+	}
+
+	rah := &roundActivationHandler{
+		rounder:      args.Rounder,
+		marshalizer:  args.Marshalizer,
+		singleSigner: args.SingleSigner,
+		rounds:       rounds,
+	}
+
+	if len(args.RoundConfig.GovernancePublicKey) > 0 {
+		pubKeyBytes, err := hex.DecodeString(args.RoundConfig.GovernancePublicKey)
+		if err != nil {
+			return nil, ErrInvalidGovernancePublicKey
+		}
+
+		rah.governancePubKey, err = args.KeyGen.PublicKeyFromByteArray(pubKeyBytes)
+		if err != nil {
+			return nil, ErrInvalidGovernancePublicKey
+		}
+	}
+
+	return rah, nil
+}
+
+// IsEnabled returns true if the named behavior is enabled in the current round
+func (rah *roundActivationHandler) IsEnabled(name string) bool {
+	return rah.IsEnabledInRound(name, rah.currentRound())
+}
+
+// IsEnabledInRound returns true if the named behavior is enabled in the provided round
+func (rah *roundActivationHandler) IsEnabledInRound(name string, round uint64) bool {
+	rah.mutRounds.RLock()
+	activationRound, found := rah.rounds[name]
+	rah.mutRounds.RUnlock()
+
+	return found && round >= activationRound
+}
+
+// ProcessReceivedMessage verifies and applies a round activation update received on the network
+func (rah *roundActivationHandler) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+	if check.IfNil(rah.governancePubKey) {
+		return ErrRoundActivationUpdatesDisabled
+	}
+
+	update := &RoundActivationUpdate{}
+	err := rah.marshalizer.Unmarshal(update, message.Data())
+	if err != nil {
+		return err
+	}
+
+	err = rah.verifySignature(update)
+	if err != nil {
+		return err
+	}
+
+	return rah.applyUpdate(update)
+}
+
+func (rah *roundActivationHandler) verifySignature(update *RoundActivationUpdate) error {
+	unsignedUpdate := *update
+	unsignedUpdate.Signature = nil
+
+	buff, err := rah.marshalizer.Marshal(&unsignedUpdate)
+	if err != nil {
+		return err
+	}
+
+	return rah.singleSigner.Verify(rah.governancePubKey, buff, update.Signature)
+}
+
+func (rah *roundActivationHandler) applyUpdate(update *RoundActivationUpdate) error {
+	currentRound := rah.currentRound()
+
+	rah.mutRounds.Lock()
+	defer rah.mutRounds.Unlock()
+
+	if update.Nonce <= rah.lastUpdateNonce {
+		return ErrOldRoundActivationUpdate
+	}
+
+	for _, activation := range update.ActivationRounds {
+		if len(activation.Name) == 0 {
+			return ErrEmptyActivationRoundName
+		}
+		if activation.Round <= currentRound {
+			return ErrActivationRoundAlreadyPassed
+		}
+
+		oldRound, found := rah.rounds[activation.Name]
+		if found && oldRound <= currentRound {
+			return ErrActivationRoundAlreadyPassed
+		}
+	}
+
+	for _, activation := range update.ActivationRounds {
+		rah.rounds[activation.Name] = activation.Round
+		log.Info(fmt.Sprintf("activation round of %s changed to %d by the update with nonce %d",
+			activation.Name, activation.Round, update.Nonce))
+	}
+	rah.lastUpdateNonce = update.Nonce
+
+	return nil
+}
+
+func (rah *roundActivationHandler) currentRound() uint64 {
+	index := rah.rounder.Index()
+	if index < 0 {
+		return 0
+	}
+
+	return uint64(index)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rah *roundActivationHandler) IsInterfaceNil() bool {
+	if rah == nil {
+		return true
+	}
+	return false
+}
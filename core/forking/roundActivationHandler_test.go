@@ -0,0 +1,211 @@
+package forking_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/stretchr/testify/assert"
+)
+
+const testActivationName = "DisableTopic:transactions"
+
+func createArgsRoundActivationHandler(round *int64) (forking.ArgsRoundActivationHandler, crypto.PrivateKey) {
+	keyGen := signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519())
+	privateKey, publicKey := keyGen.GeneratePair()
+	pubKeyBytes, _ := publicKey.ToByteArray()
+
+	return forking.ArgsRoundActivationHandler{
+		RoundConfig: config.RoundConfig{
+			GovernancePublicKey: hex.EncodeToString(pubKeyBytes),
+			ActivationRounds: []config.ActivationRoundByName{
+				{Name: testActivationName, Round: 100},
+			},
+		},
+		Rounder: &mock.RounderStub{
+			IndexCalled: func() int64 {
+				return *round
+			},
+		},
+		Marshalizer:  &mock.MarshalizerMock{},
+		KeyGen:       keyGen,
+		SingleSigner: &singlesig.SchnorrSigner{},
+	}, privateKey
+}
+
+func createSignedUpdate(
+	t *testing.T,
+	args forking.ArgsRoundActivationHandler,
+	privateKey crypto.PrivateKey,
+	update *forking.RoundActivationUpdate,
+) *mock.P2PMessageStub {
+	buff, err := args.Marshalizer.Marshal(update)
+	assert.Nil(t, err)
+
+	update.Signature, err = args.SingleSigner.Sign(privateKey, buff)
+	assert.Nil(t, err)
+
+	buff, err = args.Marshalizer.Marshal(update)
+	assert.Nil(t, err)
+
+	return &mock.P2PMessageStub{DataField: buff}
+}
+
+//------- NewRoundActivationHandler
+
+func TestNewRoundActivationHandler_NilRounderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	round := int64(0)
+	args, _ := createArgsRoundActivationHandler(&round)
+	args.Rounder = nil
+
+	rah, err := forking.NewRoundActivationHandler(args)
+
+	assert.Nil(t, rah)
+	assert.Equal(t, forking.ErrNilRounder, err)
+}
+
+func TestNewRoundActivationHandler_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	round := int64(0)
+	args, _ := createArgsRoundActivationHandler(&round)
+	args.SingleSigner = nil
+
+	rah, err := forking.NewRoundActivationHandler(args)
+
+	assert.Nil(t, rah)
+	assert.Equal(t, forking.ErrNilSingleSigner, err)
+}
+
+func TestNewRoundActivationHandler_DuplicatedNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	round := int64(0)
+	args, _ := createArgsRoundActivationHandler(&round)
+	args.RoundConfig.ActivationRounds = append(args.RoundConfig.ActivationRounds,
+		config.ActivationRoundByName{Name: testActivationName, Round: 200})
+
+	rah, err := forking.NewRoundActivationHandler(args)
+
+	assert.Nil(t, rah)
+	assert.NotNil(t, err)
+}
+
+func TestNewRoundActivationHandler_InvalidGovernanceKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	round := int64(0)
+	args, _ := createArgsRoundActivationHandler(&round)
+	args.RoundConfig.GovernancePublicKey = "not hex"
+
+	rah, err := forking.NewRoundActivationHandler(args)
+
+	assert.Nil(t, rah)
+	assert.Equal(t, forking.ErrInvalidGovernancePublicKey, err)
+}
+
+//------- IsEnabled
+
+func TestRoundActivationHandler_IsEnabledShouldSwitchAtTheActivationRound(t *testing.T) {
+	t.Parallel()
+
+	round := int64(99)
+	args, _ := createArgsRoundActivationHandler(&round)
+	rah, _ := forking.NewRoundActivationHandler(args)
+
+	assert.False(t, rah.IsEnabled(testActivationName))
+	assert.False(t, rah.IsEnabled("unknown"))
+
+	round = 100
+	assert.True(t, rah.IsEnabled(testActivationName))
+	assert.True(t, rah.IsEnabledInRound(testActivationName, 101))
+	assert.False(t, rah.IsEnabledInRound(testActivationName, 0))
+}
+
+//------- ProcessReceivedMessage
+
+func TestRoundActivationHandler_ProcessReceivedMessageUpdatesDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	round := int64(0)
+	args, _ := createArgsRoundActivationHandler(&round)
+	args.RoundConfig.GovernancePublicKey = ""
+	rah, _ := forking.NewRoundActivationHandler(args)
+
+	err := rah.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: []byte("update")}, nil)
+
+	assert.Equal(t, forking.ErrRoundActivationUpdatesDisabled, err)
+}
+
+func TestRoundActivationHandler_ProcessReceivedMessageShouldChangeTheActivationRound(t *testing.T) {
+	t.Parallel()
+
+	round := int64(10)
+	args, privateKey := createArgsRoundActivationHandler(&round)
+	rah, _ := forking.NewRoundActivationHandler(args)
+
+	message := createSignedUpdate(t, args, privateKey, &forking.RoundActivationUpdate{
+		Nonce: 1,
+		ActivationRounds: []config.ActivationRoundByName{
+			{Name: testActivationName, Round: 50},
+			{Name: "other", Round: 60},
+		},
+	})
+	err := rah.ProcessReceivedMessage(message, nil)
+
+	assert.Nil(t, err)
+	assert.True(t, rah.IsEnabledInRound(testActivationName, 50))
+	assert.True(t, rah.IsEnabledInRound("other", 60))
+
+	err = rah.ProcessReceivedMessage(message, nil)
+	assert.Equal(t, forking.ErrOldRoundActivationUpdate, err)
+}
+
+func TestRoundActivationHandler_ProcessReceivedMessageWrongSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	round := int64(10)
+	args, _ := createArgsRoundActivationHandler(&round)
+	rah, _ := forking.NewRoundActivationHandler(args)
+
+	otherPrivateKey, _ := args.KeyGen.GeneratePair()
+	message := createSignedUpdate(t, args, otherPrivateKey, &forking.RoundActivationUpdate{
+		Nonce:            1,
+		ActivationRounds: []config.ActivationRoundByName{{Name: testActivationName, Round: 50}},
+	})
+	err := rah.ProcessReceivedMessage(message, nil)
+
+	assert.NotNil(t, err)
+	assert.False(t, rah.IsEnabledInRound(testActivationName, 50))
+}
+
+func TestRoundActivationHandler_ProcessReceivedMessagePassedRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	round := int64(100)
+	args, privateKey := createArgsRoundActivationHandler(&round)
+	rah, _ := forking.NewRoundActivationHandler(args)
+
+	message := createSignedUpdate(t, args, privateKey, &forking.RoundActivationUpdate{
+		Nonce:            1,
+		ActivationRounds: []config.ActivationRoundByName{{Name: "other", Round: 90}},
+	})
+	err := rah.ProcessReceivedMessage(message, nil)
+	assert.Equal(t, forking.ErrActivationRoundAlreadyPassed, err)
+
+	message = createSignedUpdate(t, args, privateKey, &forking.RoundActivationUpdate{
+		Nonce:            2,
+		ActivationRounds: []config.ActivationRoundByName{{Name: testActivationName, Round: 200}},
+	})
+	err = rah.ProcessReceivedMessage(message, nil)
+	assert.Equal(t, forking.ErrActivationRoundAlreadyPassed, err)
+	assert.True(t, rah.IsEnabled(testActivationName))
+}
@@ -0,0 +1,158 @@
+package genesis
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+type checksumBalance struct {
+	PubKey  string
+	Balance string
+}
+
+type checksumDelegation struct {
+	Address    string
+	Owner      string
+	Delegators []checksumBalance
+}
+
+type checksumNode struct {
+	PubKey  string
+	Address string
+}
+
+// checksumData is the canonical form of the genesis configuration. The balances, the delegations and the delegators
+// are sorted and the amounts are normalized, so the checksum does not depend on how the configuration files are
+// written. The initial nodes keep their order, as it decides the shards they are assigned to
+type checksumData struct {
+	Balances                    []checksumBalance
+	Delegations                 []checksumDelegation
+	StartTime                   int64
+	RoundDuration               uint64
+	ConsensusGroupSize          uint32
+	MinNodesPerShard            uint32
+	MetaChainConsensusGroupSize uint32
+	MetaChainMinNodes           uint32
+	InitialNodes                []checksumNode
+	Economics                   config.ConfigEconomics
+}
+
+// ComputeGenesisChecksum computes the checksum of the genesis configuration: the initial balances, the delegation
+// contracts deployed at genesis, the initial nodes setup and the economics configuration. Nodes started from the
+// same genesis configuration compute the same checksum
+func ComputeGenesisChecksum(
+	hasher hashing.Hasher,
+	genesisConfig *sharding.Genesis,
+	nodesSetup *sharding.NodesSetup,
+	economicsConfig *config.ConfigEconomics,
+) ([]byte, error) {
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, process.ErrNilHasher
+	}
+	if genesisConfig == nil || nodesSetup == nil || economicsConfig == nil {
+		return nil, process.ErrNilValue
+	}
+
+	data := checksumData{
+		Balances:                    canonicalBalances(genesisConfig.InitialBalances),
+		Delegations:                 canonicalDelegations(genesisConfig.Delegations),
+		StartTime:                   nodesSetup.StartTime,
+		RoundDuration:               nodesSetup.RoundDuration,
+		ConsensusGroupSize:          nodesSetup.ConsensusGroupSize,
+		MinNodesPerShard:            nodesSetup.MinNodesPerShard,
+		MetaChainConsensusGroupSize: nodesSetup.MetaChainConsensusGroupSize,
+		MetaChainMinNodes:           nodesSetup.MetaChainMinNodes,
+		InitialNodes:                make([]checksumNode, 0, len(nodesSetup.InitialNodes)),
+		Economics:                   *economicsConfig,
+	}
+	for _, node := range nodesSetup.InitialNodes {
+		if node == nil {
+			continue
+		}
+		data.InitialNodes = append(data.InitialNodes, checksumNode{
+			PubKey:  strings.ToLower(node.PubKey),
+			Address: strings.ToLower(node.Address),
+		})
+	}
+
+	buff, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return hasher.Compute(string(buff)), nil
+}
+
+func canonicalBalances(initialBalances []*sharding.InitialBalance) []checksumBalance {
+	balances := make([]checksumBalance, 0, len(initialBalances))
+	for _, initialBalance := range initialBalances {
+		if initialBalance == nil {
+			continue
+		}
+		balances = append(balances, checksumBalance{
+			PubKey:  strings.ToLower(initialBalance.PubKey),
+			Balance: canonicalAmount(initialBalance.Balance),
+		})
+	}
+	sortBalances(balances)
+
+	return balances
+}
+
+func canonicalDelegations(initialDelegations []*sharding.InitialDelegation) []checksumDelegation {
+	delegations := make([]checksumDelegation, 0, len(initialDelegations))
+	for _, initialDelegation := range initialDelegations {
+		if initialDelegation == nil {
+			continue
+		}
+
+		delegators := make([]checksumBalance, 0, len(initialDelegation.Delegators))
+		for _, delegator := range initialDelegation.Delegators {
+			if delegator == nil {
+				continue
+			}
+			delegators = append(delegators, checksumBalance{
+				PubKey:  strings.ToLower(delegator.PubKey),
+				Balance: canonicalAmount(delegator.Amount),
+			})
+		}
+		sortBalances(delegators)
+
+		delegations = append(delegations, checksumDelegation{
+			Address:    strings.ToLower(initialDelegation.Address),
+			Owner:      strings.ToLower(initialDelegation.Owner),
+			Delegators: delegators,
+		})
+	}
+	sort.Slice(delegations, func(i, j int) bool {
+		return delegations[i].Address < delegations[j].Address
+	})
+
+	return delegations
+}
+
+func sortBalances(balances []checksumBalance) {
+	sort.Slice(balances, func(i, j int) bool {
+		if balances[i].PubKey == balances[j].PubKey {
+			return balances[i].Balance < balances[j].Balance
+		}
+		return balances[i].PubKey < balances[j].PubKey
+	})
+}
+
+// canonicalAmount normalizes an amount the way the genesis loader decodes it: the invalid amounts count as 0
+func canonicalAmount(amount string) string {
+	value, ok := big.NewInt(0).SetString(amount, 10)
+	if !ok {
+		return "0"
+	}
+
+	return value.String()
+}
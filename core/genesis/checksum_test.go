@@ -0,0 +1,163 @@
+package genesis_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/genesis"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createGenesisConfigForChecksum() *sharding.Genesis {
+	return &sharding.Genesis{
+		InitialBalances: []*sharding.InitialBalance{
+			{PubKey: "aa", Balance: "100"},
+			{PubKey: "bb", Balance: "200"},
+		},
+		Delegations: []*sharding.InitialDelegation{
+			{
+				Address: "cc",
+				Owner:   "aa",
+				Delegators: []*sharding.InitialDelegator{
+					{PubKey: "aa", Amount: "10"},
+					{PubKey: "bb", Amount: "20"},
+				},
+			},
+		},
+	}
+}
+
+func createNodesSetupForChecksum() *sharding.NodesSetup {
+	return &sharding.NodesSetup{
+		StartTime:          1000,
+		RoundDuration:      4000,
+		ConsensusGroupSize: 1,
+		MinNodesPerShard:   1,
+		InitialNodes: []*sharding.InitialNode{
+			{PubKey: "node1", Address: "aa"},
+			{PubKey: "node2", Address: "bb"},
+		},
+	}
+}
+
+func createEconomicsConfigForChecksum() *config.ConfigEconomics {
+	return &config.ConfigEconomics{
+		FeeSettings: config.FeeSettings{
+			MinGasPrice: "10",
+			MinGasLimit: "10",
+		},
+	}
+}
+
+func TestComputeGenesisChecksum_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	checksum, err := genesis.ComputeGenesisChecksum(
+		nil,
+		createGenesisConfigForChecksum(),
+		createNodesSetupForChecksum(),
+		createEconomicsConfigForChecksum(),
+	)
+
+	assert.Nil(t, checksum)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestComputeGenesisChecksum_NilConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	checksum, err := genesis.ComputeGenesisChecksum(
+		sha256.Sha256{},
+		createGenesisConfigForChecksum(),
+		nil,
+		createEconomicsConfigForChecksum(),
+	)
+
+	assert.Nil(t, checksum)
+	assert.Equal(t, process.ErrNilValue, err)
+}
+
+func TestComputeGenesisChecksum_ShouldNotDependOnTheConfigurationLayout(t *testing.T) {
+	t.Parallel()
+
+	checksum, err := genesis.ComputeGenesisChecksum(
+		sha256.Sha256{},
+		createGenesisConfigForChecksum(),
+		createNodesSetupForChecksum(),
+		createEconomicsConfigForChecksum(),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, sha256.Sha256{}.Size(), len(checksum))
+
+	genesisConfig := createGenesisConfigForChecksum()
+	genesisConfig.InitialBalances[0], genesisConfig.InitialBalances[1] = genesisConfig.InitialBalances[1], genesisConfig.InitialBalances[0]
+	genesisConfig.InitialBalances[0].Balance = "0200"
+	genesisConfig.InitialBalances[1].PubKey = "AA"
+	delegators := genesisConfig.Delegations[0].Delegators
+	delegators[0], delegators[1] = delegators[1], delegators[0]
+
+	reorderedChecksum, err := genesis.ComputeGenesisChecksum(
+		sha256.Sha256{},
+		genesisConfig,
+		createNodesSetupForChecksum(),
+		createEconomicsConfigForChecksum(),
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, checksum, reorderedChecksum)
+}
+
+func TestComputeGenesisChecksum_DifferentConfigurationShouldChangeTheChecksum(t *testing.T) {
+	t.Parallel()
+
+	hasher := sha256.Sha256{}
+	checksum, _ := genesis.ComputeGenesisChecksum(
+		hasher,
+		createGenesisConfigForChecksum(),
+		createNodesSetupForChecksum(),
+		createEconomicsConfigForChecksum(),
+	)
+
+	genesisConfig := createGenesisConfigForChecksum()
+	genesisConfig.InitialBalances[1].Balance = "201"
+	changedBalanceChecksum, _ := genesis.ComputeGenesisChecksum(
+		hasher,
+		genesisConfig,
+		createNodesSetupForChecksum(),
+		createEconomicsConfigForChecksum(),
+	)
+	assert.NotEqual(t, checksum, changedBalanceChecksum)
+
+	genesisConfig = createGenesisConfigForChecksum()
+	genesisConfig.Delegations[0].Delegators[0].Amount = "11"
+	changedDelegationChecksum, _ := genesis.ComputeGenesisChecksum(
+		hasher,
+		genesisConfig,
+		createNodesSetupForChecksum(),
+		createEconomicsConfigForChecksum(),
+	)
+	assert.NotEqual(t, checksum, changedDelegationChecksum)
+
+	nodesSetup := createNodesSetupForChecksum()
+	nodesSetup.InitialNodes[0], nodesSetup.InitialNodes[1] = nodesSetup.InitialNodes[1], nodesSetup.InitialNodes[0]
+	changedNodesChecksum, _ := genesis.ComputeGenesisChecksum(
+		hasher,
+		createGenesisConfigForChecksum(),
+		nodesSetup,
+		createEconomicsConfigForChecksum(),
+	)
+	assert.NotEqual(t, checksum, changedNodesChecksum)
+
+	economicsConfig := createEconomicsConfigForChecksum()
+	economicsConfig.FeeSettings.MinGasPrice = "11"
+	changedEconomicsChecksum, _ := genesis.ComputeGenesisChecksum(
+		hasher,
+		createGenesisConfigForChecksum(),
+		createNodesSetupForChecksum(),
+		economicsConfig,
+	)
+	assert.NotEqual(t, checksum, changedEconomicsChecksum)
+}
@@ -13,15 +13,19 @@ import (
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("core/genesis")
 
-// CreateShardGenesisBlockFromInitialBalances creates the genesis block body from map of account balances
+// CreateShardGenesisBlockFromInitialBalances creates the genesis block body from map of account balances and
+// the delegation contracts that have to be created and funded in this shard. The genesis checksum is stored in the
+// header so the genesis block identifies the genesis configuration it was created from
 func CreateShardGenesisBlockFromInitialBalances(
 	accounts state.AccountsAdapter,
 	shardCoordinator sharding.Coordinator,
 	addrConv state.AddressConverter,
 	initialBalances map[string]*big.Int,
+	delegations []*sharding.InitialDelegation,
 	genesisTime uint64,
+	genesisChecksum []byte,
 ) (data.HeaderHandler, error) {
 
 	if accounts == nil || accounts.IsInterfaceNil() {
@@ -42,20 +46,22 @@ func CreateShardGenesisBlockFromInitialBalances(
 		shardCoordinator,
 		addrConv,
 		initialBalances,
+		delegations,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	header := &block.Header{
-		Nonce:         0,
-		ShardId:       shardCoordinator.SelfId(),
-		BlockBodyType: block.StateBlock,
-		Signature:     rootHash,
-		RootHash:      rootHash,
-		PrevRandSeed:  rootHash,
-		RandSeed:      rootHash,
-		TimeStamp:     genesisTime,
+		Nonce:           0,
+		ShardId:         shardCoordinator.SelfId(),
+		BlockBodyType:   block.StateBlock,
+		Signature:       rootHash,
+		RootHash:        rootHash,
+		PrevRandSeed:    rootHash,
+		RandSeed:        rootHash,
+		TimeStamp:       genesisTime,
+		GenesisChecksum: genesisChecksum,
 	}
 
 	return header, err
@@ -76,12 +82,13 @@ func CreateMetaGenesisBlock(genesisTime uint64, initialPubKeys map[uint32][]stri
 	return header, nil
 }
 
-// setBalancesToTrie adds balances to trie
+// setBalancesToTrie adds balances and delegation contracts to trie
 func setBalancesToTrie(
 	accounts state.AccountsAdapter,
 	shardCoordinator sharding.Coordinator,
 	addrConv state.AddressConverter,
 	initialBalances map[string]*big.Int,
+	delegations []*sharding.InitialDelegation,
 ) (rootHash []byte, err error) {
 
 	if accounts.JournalLen() != 0 {
@@ -96,6 +103,18 @@ func setBalancesToTrie(
 		}
 	}
 
+	for _, delegation := range delegations {
+		err = setDelegationToTrie(accounts, shardCoordinator, addrConv, delegation)
+		if err != nil {
+			errToLog := accounts.RevertToSnapshot(0)
+			if errToLog != nil {
+				log.Error(errToLog.Error())
+			}
+
+			return nil, err
+		}
+	}
+
 	rootHash, err = accounts.Commit()
 	if err != nil {
 		errToLog := accounts.RevertToSnapshot(0)
@@ -141,6 +160,54 @@ func setBalanceToTrie(
 	return account.SetBalanceWithJournal(balance)
 }
 
+// setDelegationToTrie creates the delegation contract account, funds it with the sum of the delegated amounts
+// and records every delegator with its amount in the contract storage
+func setDelegationToTrie(
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	addrConv state.AddressConverter,
+	delegation *sharding.InitialDelegation,
+) error {
+	if delegation == nil {
+		return process.ErrNilValue
+	}
+
+	addrContainer, err := addrConv.CreateAddressFromPublicKeyBytes(delegation.AddressBytes())
+	if err != nil {
+		return err
+	}
+	if shardCoordinator.ComputeId(addrContainer) != shardCoordinator.SelfId() {
+		return process.ErrMintAddressNotInThisShard
+	}
+
+	accWrp, err := accounts.GetAccountWithJournal(addrContainer)
+	if err != nil {
+		return err
+	}
+
+	account, ok := accWrp.(*state.Account)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = account.SetOwnerAddressWithJournal(delegation.OwnerBytes())
+	if err != nil {
+		return err
+	}
+
+	balance := big.NewInt(0).Add(account.Balance, delegation.TotalDelegated())
+	err = account.SetBalanceWithJournal(balance)
+	if err != nil {
+		return err
+	}
+
+	for _, delegator := range delegation.Delegators {
+		account.DataTrieTracker().SaveKeyValue(delegator.PubKeyBytes(), delegator.AmountValue().Bytes())
+	}
+
+	return accounts.SaveDataTrie(account)
+}
+
 func initSystemSmartContracts(
 	accounts state.AccountsAdapter,
 	adrConv state.AddressConverter,
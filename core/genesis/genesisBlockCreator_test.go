@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -80,7 +81,9 @@ func TestCreateGenesisBlockFromInitialBalances_NilAccountsShouldErr(t *testing.T
 		mock.NewOneShardCoordinatorMock(),
 		&mock.AddressConverterMock{},
 		make(map[string]*big.Int),
+		nil,
 		0,
+		nil,
 	)
 
 	assert.Nil(t, header)
@@ -95,7 +98,9 @@ func TestCreateGenesisBlockFromInitialBalances_NilShardCoordinatorShouldErr(t *t
 		nil,
 		&mock.AddressConverterMock{},
 		make(map[string]*big.Int),
+		nil,
 		0,
+		nil,
 	)
 
 	assert.Nil(t, header)
@@ -110,7 +115,9 @@ func TestCreateGenesisBlockFromInitialBalances_NilAddressConverterShouldErr(t *t
 		mock.NewOneShardCoordinatorMock(),
 		nil,
 		make(map[string]*big.Int),
+		nil,
 		0,
+		nil,
 	)
 
 	assert.Nil(t, header)
@@ -125,7 +132,9 @@ func TestCreateGenesisBlockFromInitialBalances_NilBalanceMapShouldErr(t *testing
 		mock.NewOneShardCoordinatorMock(),
 		&mock.AddressConverterMock{},
 		nil,
+		nil,
 		0,
+		nil,
 	)
 
 	assert.Nil(t, header)
@@ -146,7 +155,9 @@ func TestCreateGenesisBlockFromInitialBalances_AccountStateDirtyShouldErr(t *tes
 		mock.NewOneShardCoordinatorMock(),
 		&mock.AddressConverterMock{},
 		make(map[string]*big.Int),
+		nil,
 		0,
+		nil,
 	)
 
 	assert.Nil(t, header)
@@ -173,7 +184,9 @@ func TestCreateGenesisBlockFromInitialBalances_TrieCommitFailsShouldRevert(t *te
 		mock.NewOneShardCoordinatorMock(),
 		&mock.AddressConverterMock{},
 		balances,
+		nil,
 		0,
+		nil,
 	)
 
 	assert.Nil(t, header)
@@ -197,7 +210,9 @@ func TestCreateGenesisBlockFromInitialBalances_AccountsFailShouldErr(t *testing.
 		mock.NewOneShardCoordinatorMock(),
 		&mock.AddressConverterMock{},
 		balances,
+		nil,
 		0,
+		nil,
 	)
 
 	assert.Nil(t, header)
@@ -214,18 +229,21 @@ func TestTxProcessor_SetBalancesToTrieOkValsShouldWork(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.AddressConverterMock{},
 		balances,
+		nil,
 		0,
+		[]byte("genesis checksum"),
 	)
 
 	assert.Equal(t,
 		&dataBlock.Header{
-			Nonce:         0,
-			ShardId:       mock.NewOneShardCoordinatorMock().SelfId(),
-			BlockBodyType: dataBlock.StateBlock,
-			Signature:     rootHash,
-			RootHash:      rootHash,
-			PrevRandSeed:  rootHash,
-			RandSeed:      rootHash,
+			Nonce:           0,
+			ShardId:         mock.NewOneShardCoordinatorMock().SelfId(),
+			BlockBodyType:   dataBlock.StateBlock,
+			Signature:       rootHash,
+			RootHash:        rootHash,
+			PrevRandSeed:    rootHash,
+			RandSeed:        rootHash,
+			GenesisChecksum: []byte("genesis checksum"),
 		},
 		header,
 	)
@@ -233,3 +251,98 @@ func TestTxProcessor_SetBalancesToTrieOkValsShouldWork(t *testing.T) {
 	assert.Equal(t, val1, accnt1.Balance)
 	assert.Equal(t, val2, accnt2.Balance)
 }
+
+func TestCreateGenesisBlockFromInitialBalances_DelegationShouldBeCreatedAndFunded(t *testing.T) {
+	t.Parallel()
+
+	genesisConfig, err := sharding.NewGenesisConfig("testdata/genesisDelegations.json")
+	assert.Nil(t, err)
+
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+	delegationAccount, _ := state.NewAccount(mock.NewAddressMock([]byte("delegation")), tracker)
+	accounts := createAccountStub([]byte("delegation"), nil, delegationAccount, nil)
+	accounts.JournalLenCalled = func() int {
+		return 0
+	}
+	accounts.CommitCalled = func() (i []byte, e error) {
+		return rootHash, nil
+	}
+	saveDataTrieCalled := false
+	accounts.SaveDataTrieCalled = func(accountHandler state.AccountHandler) error {
+		saveDataTrieCalled = true
+		return nil
+	}
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	addrConv := &mock.AddressConverterMock{}
+	delegations, err := genesisConfig.InitialDelegations(shardCoordinator, addrConv)
+	assert.Nil(t, err)
+
+	header, err := genesis.CreateShardGenesisBlockFromInitialBalances(
+		accounts,
+		shardCoordinator,
+		addrConv,
+		make(map[string]*big.Int),
+		delegations,
+		0,
+		nil,
+	)
+
+	assert.NotNil(t, header)
+	assert.Nil(t, err)
+	assert.True(t, saveDataTrieCalled)
+	assert.Equal(t, big.NewInt(300), delegationAccount.Balance)
+	assert.Equal(t, []byte("owner"), delegationAccount.OwnerAddress)
+	dirtyData := delegationAccount.DataTrieTracker().DirtyData()
+	assert.Equal(t, big.NewInt(100).Bytes(), dirtyData["alice"])
+	assert.Equal(t, big.NewInt(200).Bytes(), dirtyData["bob"])
+}
+
+func TestCreateGenesisBlockFromInitialBalances_DelegationSaveFailsShouldRevert(t *testing.T) {
+	t.Parallel()
+
+	genesisConfig, _ := sharding.NewGenesisConfig("testdata/genesisDelegations.json")
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+	delegationAccount, _ := state.NewAccount(mock.NewAddressMock([]byte("delegation")), tracker)
+	accounts := createAccountStub([]byte("delegation"), nil, delegationAccount, nil)
+	accounts.JournalLenCalled = func() int {
+		return 0
+	}
+	revertCalled := false
+	accounts.RevertToSnapshotCalled = func(snapshot int) error {
+		revertCalled = true
+		return nil
+	}
+	errSave := errors.New("save data trie error")
+	accounts.SaveDataTrieCalled = func(accountHandler state.AccountHandler) error {
+		return errSave
+	}
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	addrConv := &mock.AddressConverterMock{}
+	delegations, _ := genesisConfig.InitialDelegations(shardCoordinator, addrConv)
+
+	header, err := genesis.CreateShardGenesisBlockFromInitialBalances(
+		accounts,
+		shardCoordinator,
+		addrConv,
+		make(map[string]*big.Int),
+		delegations,
+		0,
+		nil,
+	)
+
+	assert.Nil(t, header)
+	assert.Equal(t, errSave, err)
+	assert.True(t, revertCalled)
+}
@@ -69,8 +69,8 @@ func newTestMetaBlock() *block.MetaBlock {
 
 func newTestBlockBody() block.Body {
 	return block.Body{
-		{[][]byte{[]byte("tx1"), []byte("tx2")}, 2, 2, 0},
-		{[][]byte{[]byte("tx3")}, 4, 1, 0},
+		{[][]byte{[]byte("tx1"), []byte("tx2")}, 2, 2, 0, nil},
+		{[][]byte{[]byte("tx3")}, 4, 1, 0, nil},
 	}
 }
 
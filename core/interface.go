@@ -1,5 +1,9 @@
 package core
 
+import (
+	"time"
+)
+
 // AppStatusHandler interface will handle different implementations of monitoring tools, such as Prometheus of term-ui
 type AppStatusHandler interface {
 	IsInterfaceNil() bool
@@ -16,3 +20,65 @@ type AppStatusHandler interface {
 type ConnectedAddressesHandler interface {
 	ConnectedAddresses() []string
 }
+
+// EpochSubscriberHandler defines the behavior of a component that can be notified when a new epoch was confirmed
+type EpochSubscriberHandler interface {
+	EpochConfirmed(epoch uint32)
+	IsInterfaceNil() bool
+}
+
+// GasScheduleSubscribeHandler defines the behavior of a component that can be notified when the gas schedule changes
+type GasScheduleSubscribeHandler interface {
+	GasScheduleChange(gasSchedule map[string]map[string]uint64)
+	IsInterfaceNil() bool
+}
+
+// GasScheduleNotifier defines the behavior of a component which holds the gas schedule active in the current epoch
+// and notifies its subscribers each time the schedule changes
+type GasScheduleNotifier interface {
+	RegisterNotifyHandler(handler GasScheduleSubscribeHandler)
+	LatestGasSchedule() map[string]map[string]uint64
+	IsInterfaceNil() bool
+}
+
+// EpochNotifier defines the behavior of a component which tracks the epoch of the processed blocks and notifies its
+// subscribers each time the epoch changes
+type EpochNotifier interface {
+	RegisterNotifyHandler(handler EpochSubscriberHandler)
+	CurrentEpoch() uint32
+	CheckEpoch(epoch uint32)
+	IsInterfaceNil() bool
+}
+
+// RoundHandler defines the behavior of a component which provides the index of the current round
+type RoundHandler interface {
+	Index() int64
+	IsInterfaceNil() bool
+}
+
+// RoundActivationHandler defines the behavior of a component which tells if a named behavior, which has to switch
+// at an exact round, is enabled
+type RoundActivationHandler interface {
+	IsEnabled(name string) bool
+	IsEnabledInRound(name string, round uint64) bool
+	IsInterfaceNil() bool
+}
+
+// TimersScheduler defines the behavior of a component which calls the registered callbacks, identified by their
+// alarm IDs, once their durations have passed
+type TimersScheduler interface {
+	Add(callback func(alarmID string), duration time.Duration, alarmID string)
+	Cancel(alarmID string)
+	Reset(alarmID string)
+	Close()
+	IsInterfaceNil() bool
+}
+
+// WatchdogTimer defines the behavior of a component which signals the operations that did not finish in time
+type WatchdogTimer interface {
+	Set(callback func(alarmID string), duration time.Duration, alarmID string)
+	SetDefault(duration time.Duration, alarmID string)
+	Stop(alarmID string)
+	Reset(alarmID string)
+	IsInterfaceNil() bool
+}
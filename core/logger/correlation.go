@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"encoding/hex"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const correlationHashLength = 4
+
+// correlation holds the coordinates of the header currently processed by the node. When enabled, all logged lines
+// will carry these coordinates so the lines produced by different subsystems for the same header can be tied together
+type correlation struct {
+	mut        sync.RWMutex
+	enabled    bool
+	isSet      bool
+	shardID    uint32
+	epoch      uint32
+	round      uint64
+	nonce      uint64
+	headerHash string
+}
+
+var defaultCorrelation = correlation{}
+
+// ToggleCorrelation enables or disables the output of the correlation fields on the logged lines
+func ToggleCorrelation(enable bool) {
+	defaultCorrelation.mut.Lock()
+	defaultCorrelation.enabled = enable
+	defaultCorrelation.mut.Unlock()
+}
+
+// IsCorrelationEnabled returns true if the correlation fields are output on the logged lines
+func IsCorrelationEnabled() bool {
+	defaultCorrelation.mut.RLock()
+	defer defaultCorrelation.mut.RUnlock()
+
+	return defaultCorrelation.enabled
+}
+
+// SetCorrelationHeader sets the coordinates of the header that is currently processed. Only the first bytes of
+//  the header hash will be output, as they are enough to identify the header
+func SetCorrelationHeader(shardID uint32, epoch uint32, round uint64, nonce uint64, headerHash []byte) {
+	if len(headerHash) > correlationHashLength {
+		headerHash = headerHash[:correlationHashLength]
+	}
+
+	defaultCorrelation.mut.Lock()
+	defaultCorrelation.isSet = true
+	defaultCorrelation.shardID = shardID
+	defaultCorrelation.epoch = epoch
+	defaultCorrelation.round = round
+	defaultCorrelation.nonce = nonce
+	defaultCorrelation.headerHash = hex.EncodeToString(headerHash)
+	defaultCorrelation.mut.Unlock()
+}
+
+// ClearCorrelationHeader removes the header coordinates, should be called when the header processing ended
+func ClearCorrelationHeader() {
+	defaultCorrelation.mut.Lock()
+	defaultCorrelation.isSet = false
+	defaultCorrelation.mut.Unlock()
+}
+
+func (c *correlation) addFields(fields log.Fields) {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+
+	if !c.enabled || !c.isSet {
+		return
+	}
+
+	fields["corr_shard"] = c.shardID
+	fields["corr_epoch"] = c.epoch
+	fields["corr_round"] = c.round
+	fields["corr_nonce"] = c.nonce
+	fields["corr_hash"] = c.headerHash
+}
@@ -0,0 +1,47 @@
+package logger_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorrelation_DisabledShouldNotOutputFields(t *testing.T) {
+	var str bytes.Buffer
+	log := logger.NewElrondLogger()
+	log.SetOutput(&str)
+
+	logger.ToggleCorrelation(false)
+	logger.SetCorrelationHeader(1, 2, 3, 4, []byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee})
+	log.Info("abc")
+	logger.ClearCorrelationHeader()
+
+	assert.False(t, strings.Contains(str.String(), "corr_nonce"))
+}
+
+func TestCorrelation_EnabledShouldOutputFieldsUntilCleared(t *testing.T) {
+	var str bytes.Buffer
+	log := logger.NewElrondLogger()
+	log.SetOutput(&str)
+
+	logger.ToggleCorrelation(true)
+	defer logger.ToggleCorrelation(false)
+	assert.True(t, logger.IsCorrelationEnabled())
+
+	logger.SetCorrelationHeader(1, 2, 3, 4, []byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee})
+	log.Info("abc")
+	logString := str.String()
+	assert.True(t, strings.Contains(logString, `"corr_shard":1`))
+	assert.True(t, strings.Contains(logString, `"corr_epoch":2`))
+	assert.True(t, strings.Contains(logString, `"corr_round":3`))
+	assert.True(t, strings.Contains(logString, `"corr_nonce":4`))
+	assert.True(t, strings.Contains(logString, `"corr_hash":"aabbccdd"`))
+
+	str.Reset()
+	logger.ClearCorrelationHeader()
+	log.Info("abc")
+	assert.False(t, strings.Contains(str.String(), "corr_nonce"))
+}
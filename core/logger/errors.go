@@ -4,3 +4,24 @@ import "errors"
 
 // ErrNilFile signals that the provided file is nil
 var ErrNilFile = errors.New("can not use nil file")
+
+// ErrInvalidLogLevel signals that the provided log level is not one of the known levels
+var ErrInvalidLogLevel = errors.New("invalid log level")
+
+// ErrInvalidLevelPattern signals that the provided level pattern could not be parsed
+var ErrInvalidLevelPattern = errors.New("invalid level pattern")
+
+// ErrInvalidMaxFileSize signals that an invalid maximum log file size has been provided
+var ErrInvalidMaxFileSize = errors.New("invalid maximum log file size")
+
+// ErrInvalidLogFormat signals that the provided log format is not supported
+var ErrInvalidLogFormat = errors.New("invalid log format")
+
+// ErrNilWriter signals that a nil writer has been provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrWriterAlreadyObserving signals that the provided writer is already registered as log observer
+var ErrWriterAlreadyObserving = errors.New("writer is already observing the logs")
+
+// ErrWriterNotObserving signals that the provided writer is not registered as log observer
+var ErrWriterNotObserving = errors.New("writer is not observing the logs")
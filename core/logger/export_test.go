@@ -14,9 +14,13 @@ func RedirectStderr(f *os.File) error {
 }
 
 func (el *Logger) RollFiles() {
-	el.rollLock.Lock()
-	el.rollFiles()
-	el.rollLock.Unlock()
+	el.file.rollLock.Lock()
+	_ = el.file.rollFiles()
+	el.file.rollLock.Unlock()
+}
+
+func (el *Logger) RollFilesIfNeeded() {
+	el.rollFilesIfNeeded()
 }
 
 func (el *Logger) SetCreationTime(t time.Time) {
@@ -24,7 +28,14 @@ func (el *Logger) SetCreationTime(t time.Time) {
 }
 
 func (el *Logger) GetNrOfLogFiles() int {
-	return len(el.logFiles)
+	return len(el.file.logFiles)
+}
+
+func ResetLoggers() {
+	loggersMutex.Lock()
+	loggers = make(map[string]*Logger)
+	levelPatterns = []*levelPattern{{pattern: "*", level: defaultLogLevel}}
+	loggersMutex.Unlock()
 }
 
 func NrOfFilesToRemember() int {
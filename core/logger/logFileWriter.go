@@ -3,6 +3,7 @@ package logger
 import (
 	"fmt"
 	"io"
+	"os"
 	"sync"
 	"time"
 )
@@ -11,13 +12,24 @@ import (
 // While a writer is not provided, it will write to standard output and record all entries in a slice.
 // After the writer will be provided, the buffer slice will be set to nil and the provided writer will be
 // called from this point on.
+// If rotation is started, the writer will be replaced with a new file each time the current one gets too old
+// or, if a maximum size is set, each time the current one gets too big.
 type LogFileWriter struct {
-	creationTime time.Time
-	prefix       string
-	writer       io.Writer
-	buffer       [][]byte
-	writerLock   sync.RWMutex
-	bufferLock   sync.Mutex
+	creationTime  time.Time
+	prefix        string
+	subfolder     string
+	fileExtension string
+	writer        io.Writer
+	buffer        [][]byte
+	writerLock    sync.RWMutex
+	bufferLock    sync.Mutex
+
+	roll        bool
+	rollLock    sync.Mutex
+	logFiles    []*os.File
+	maxFileSize int64
+	currentSize int64
+	sizeLock    sync.Mutex
 }
 
 // Writer will return the current writer
@@ -41,14 +53,18 @@ func (lfw *LogFileWriter) Write(p []byte) (n int, err error) {
 	lfw.writerLock.RUnlock()
 
 	if writer == nil {
+		// the provided slice might be reused by the caller so it has to be copied
+		entry := make([]byte, len(p))
+		copy(entry, p)
+
 		lfw.bufferLock.Lock()
-		lfw.buffer = append(lfw.buffer, p)
+		lfw.buffer = append(lfw.buffer, entry)
 		lfw.bufferLock.Unlock()
 		return 0, nil
 	}
 
 	if lfw.buffer == nil {
-		return writer.Write(p)
+		return lfw.writeAndCount(writer, p)
 	}
 
 	lfw.bufferLock.Lock()
@@ -61,5 +77,92 @@ func (lfw *LogFileWriter) Write(p []byte) (n int, err error) {
 
 	lfw.buffer = nil
 	lfw.bufferLock.Unlock()
-	return writer.Write(p)
+	return lfw.writeAndCount(writer, p)
+}
+
+func (lfw *LogFileWriter) writeAndCount(writer io.Writer, p []byte) (int, error) {
+	n, err := writer.Write(p)
+
+	lfw.sizeLock.Lock()
+	lfw.currentSize += int64(n)
+	lfw.sizeLock.Unlock()
+
+	return n, err
+}
+
+func (lfw *LogFileWriter) startRotation(prefix string, subfolder string, fileExtension string, file *os.File) {
+	lfw.rollLock.Lock()
+	lfw.roll = true
+	lfw.prefix = prefix
+	lfw.subfolder = subfolder
+	lfw.fileExtension = fileExtension
+	lfw.logFiles = append(lfw.logFiles, file)
+	lfw.rollLock.Unlock()
+
+	lfw.resetCurrentSize()
+	lfw.SetWriter(file)
+}
+
+func (lfw *LogFileWriter) setMaxFileSize(maxFileSize int64) {
+	lfw.sizeLock.Lock()
+	lfw.maxFileSize = maxFileSize
+	lfw.sizeLock.Unlock()
+}
+
+func (lfw *LogFileWriter) resetCurrentSize() {
+	lfw.sizeLock.Lock()
+	lfw.currentSize = 0
+	lfw.sizeLock.Unlock()
+}
+
+func (lfw *LogFileWriter) isFileTooBig() bool {
+	lfw.sizeLock.Lock()
+	defer lfw.sizeLock.Unlock()
+
+	return lfw.maxFileSize > 0 && lfw.currentSize >= lfw.maxFileSize
+}
+
+func (lfw *LogFileWriter) rollIfNeeded() error {
+	lfw.rollLock.Lock()
+	defer lfw.rollLock.Unlock()
+
+	if !lfw.roll {
+		return nil
+	}
+
+	duration := time.Now().Sub(lfw.creationTime).Seconds()
+	if duration <= fileLifetimeInSeconds && !lfw.isFileTooBig() {
+		return nil
+	}
+
+	return lfw.rollFiles()
+}
+
+func (lfw *LogFileWriter) rollFiles() error {
+	file, err := newFile(lfw.prefix, lfw.subfolder, lfw.fileExtension)
+	if err != nil {
+		return err
+	}
+	lfw.creationTime = time.Now()
+	lfw.resetCurrentSize()
+	lfw.SetWriter(file)
+
+	var lastErr error
+	err = lfw.logFiles[len(lfw.logFiles)-1].Close()
+	if err != nil {
+		lastErr = err
+	}
+
+	if len(lfw.logFiles) == nrOfFilesToRemember {
+		err = os.Remove(lfw.logFiles[0].Name())
+		if err != nil {
+			lastErr = err
+		}
+
+		lfw.logFiles = lfw.logFiles[1:]
+	}
+
+	lfw.logFiles = append(lfw.logFiles, file)
+
+	return lastErr
 }
@@ -9,6 +9,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -23,6 +24,12 @@ const (
 	LogPanic   = "PANIC"
 )
 
+// These constants are the supported output formats of the logged lines.
+const (
+	LogFormatJSON = "json"
+	LogFormatText = "text"
+)
+
 const (
 	defaultStackTraceDepth = 2
 	maxHeadlineLength      = 100
@@ -32,11 +39,11 @@ const (
 
 // Logger represents the application logger.
 type Logger struct {
+	name            string
 	logger          *log.Logger
 	file            *LogFileWriter
-	logFiles        []*os.File
-	roll            bool
-	rollLock        sync.Mutex
+	level           *uint32
+	fields          log.Fields
 	stackTraceDepth int
 }
 
@@ -48,12 +55,20 @@ type Option func(*Logger) error
 // If the requested log file is writable it will setup a MultiWriter on both the file
 //  and the standard output. Also sets up the level and the format for the logger.
 func NewElrondLogger(opts ...Option) *Logger {
+	level := uint32(log.DebugLevel)
 	el := &Logger{
 		logger:          log.New(),
 		stackTraceDepth: defaultStackTraceDepth,
 		file:            &LogFileWriter{creationTime: time.Now()},
+		level:           &level,
 	}
 
+	el.logger.SetOutput(el.file)
+	el.logger.AddHook(&defaultPrinterHook)
+	el.logger.SetFormatter(&log.JSONFormatter{})
+	// the level filtering is done by each Logger instance, the underlying logger should let everything through
+	el.logger.SetLevel(log.DebugLevel)
+
 	for _, opt := range opts {
 		err := opt(el)
 		if err != nil {
@@ -61,11 +76,6 @@ func NewElrondLogger(opts ...Option) *Logger {
 		}
 	}
 
-	el.logger.SetOutput(el.file)
-	el.logger.AddHook(&defaultPrinterHook)
-	el.logger.SetFormatter(&log.JSONFormatter{})
-	el.logger.SetLevel(log.DebugLevel)
-
 	return el
 }
 
@@ -82,6 +92,7 @@ func DefaultLogger() *Logger {
 		defaultPrinterHook = printerHook{Writer: os.Stdout}
 
 		defaultLogger = NewElrondLogger()
+		defaultLogger.logger.AddHook(&defaultObserversHook)
 		dl = defaultLogger
 	}
 	defaultLoggerMutex.Unlock()
@@ -123,20 +134,60 @@ func (el *Logger) StackTraceDepth() int {
 
 // SetLevel sets the log level according to this package's defined levels.
 func (el *Logger) SetLevel(level string) {
-	switch level {
-	case LogDebug:
-		el.logger.SetLevel(log.DebugLevel)
-	case LogInfo:
-		el.logger.SetLevel(log.InfoLevel)
-	case LogWarning:
-		el.logger.SetLevel(log.WarnLevel)
-	case LogError:
-		el.logger.SetLevel(log.ErrorLevel)
-	case LogPanic:
-		el.logger.SetLevel(log.PanicLevel)
-	default:
+	logLevel, err := parseLevel(level)
+	if err != nil {
 		el.Error("invalid log level")
-		el.logger.SetLevel(log.ErrorLevel)
+		logLevel = log.ErrorLevel
+	}
+
+	el.setLevel(logLevel)
+}
+
+// Level returns the string representation of the current log level
+func (el *Logger) Level() string {
+	return levelToString(el.getLevel())
+}
+
+// Name returns the name under which the logger was registered. The default logger has an empty name
+func (el *Logger) Name() string {
+	return el.name
+}
+
+func (el *Logger) setLevel(level log.Level) {
+	atomic.StoreUint32(el.level, uint32(level))
+}
+
+func (el *Logger) getLevel() log.Level {
+	return log.Level(atomic.LoadUint32(el.level))
+}
+
+func (el *Logger) isEnabled(level log.Level) bool {
+	return el.getLevel() >= level
+}
+
+// With returns a logger that will output the provided key-value pairs as distinct fields on each logged line.
+// The returned logger shares the level and the output with the logger on which the method was called.
+func (el *Logger) With(keyValues ...interface{}) *Logger {
+	fields := make(log.Fields, len(el.fields)+len(keyValues)/2)
+	for k, v := range el.fields {
+		fields[k] = v
+	}
+	for i := 0; i < len(keyValues); i += 2 {
+		key := fmt.Sprintf("%v", keyValues[i])
+		if i+1 >= len(keyValues) {
+			fields[key] = nil
+			break
+		}
+		fields[key] = keyValues[i+1]
+	}
+
+	return &Logger{
+		name:            el.name,
+		logger:          el.logger,
+		file:            el.file,
+		level:           el.level,
+		fields:          fields,
+		stackTraceDepth: el.stackTraceDepth,
 	}
 }
 
@@ -147,13 +198,13 @@ func (el *Logger) SetOutput(out io.Writer) {
 
 // Debug is an alias for Logrus.Debug, adding some default useful fields.
 func (el *Logger) Debug(message string, extra ...interface{}) {
-	cl := el.defaultFields()
-	if el.roll {
-		el.rollLock.Lock()
-		el.rollFiles()
-		el.rollLock.Unlock()
+	if !el.isEnabled(log.DebugLevel) {
+		return
 	}
 
+	cl := el.defaultFields()
+	el.rollFilesIfNeeded()
+
 	cl.WithFields(log.Fields{
 		"extra": extra,
 	}).Debug(message)
@@ -161,13 +212,13 @@ func (el *Logger) Debug(message string, extra ...interface{}) {
 
 // Info is an alias for Logrus.Info, adding some default useful fields.
 func (el *Logger) Info(message string, extra ...interface{}) {
-	cl := el.defaultFields()
-	if el.roll {
-		el.rollLock.Lock()
-		el.rollFiles()
-		el.rollLock.Unlock()
+	if !el.isEnabled(log.InfoLevel) {
+		return
 	}
 
+	cl := el.defaultFields()
+	el.rollFilesIfNeeded()
+
 	cl.WithFields(log.Fields{
 		"extra": extra,
 	}).Info(message)
@@ -175,13 +226,13 @@ func (el *Logger) Info(message string, extra ...interface{}) {
 
 // Warn is an alias for Logrus.Warn, adding some default useful fields.
 func (el *Logger) Warn(message string, extra ...interface{}) {
-	cl := el.defaultFields()
-	if el.roll {
-		el.rollLock.Lock()
-		el.rollFiles()
-		el.rollLock.Unlock()
+	if !el.isEnabled(log.WarnLevel) {
+		return
 	}
 
+	cl := el.defaultFields()
+	el.rollFilesIfNeeded()
+
 	cl.WithFields(log.Fields{
 		"extra": extra,
 	}).Warn(message)
@@ -189,19 +240,23 @@ func (el *Logger) Warn(message string, extra ...interface{}) {
 
 // Error is an alias for Logrus.Error, adding some default useful fields.
 func (el *Logger) Error(message string, extra ...interface{}) {
-	cl := el.defaultFields()
-	if el.roll {
-		el.rollLock.Lock()
-		el.rollFiles()
-		el.rollLock.Unlock()
+	if !el.isEnabled(log.ErrorLevel) {
+		return
 	}
 
+	cl := el.defaultFields()
+	el.rollFilesIfNeeded()
+
 	cl.WithFields(log.Fields{
 		"extra": extra,
 	}).Error(message)
 }
 
 func (el *Logger) errorWithoutFileRoll(message string, extra ...interface{}) {
+	if !el.isEnabled(log.ErrorLevel) {
+		return
+	}
+
 	cl := el.defaultFields()
 	cl.WithFields(log.Fields{
 		"extra": extra,
@@ -210,13 +265,13 @@ func (el *Logger) errorWithoutFileRoll(message string, extra ...interface{}) {
 
 // Panic is an alias for Logrus.Panic, adding some default useful fields.
 func (el *Logger) Panic(message string, extra ...interface{}) {
-	cl := el.defaultFields()
-	if el.roll {
-		el.rollLock.Lock()
-		el.rollFiles()
-		el.rollLock.Unlock()
+	if !el.isEnabled(log.PanicLevel) {
+		return
 	}
 
+	cl := el.defaultFields()
+	el.rollFilesIfNeeded()
+
 	cl.WithFields(log.Fields{
 		"extra": extra,
 	}).Panic(message)
@@ -224,16 +279,12 @@ func (el *Logger) Panic(message string, extra ...interface{}) {
 
 // LogIfError will log if the provided error different than nil
 func (el *Logger) LogIfError(err error) {
-	if err == nil {
+	if err == nil || !el.isEnabled(log.ErrorLevel) {
 		return
 	}
 
 	cl := el.defaultFields()
-	if el.roll {
-		el.rollLock.Lock()
-		el.rollFiles()
-		el.rollLock.Unlock()
-	}
+	el.rollFilesIfNeeded()
 
 	cl.Error(err.Error())
 }
@@ -254,11 +305,20 @@ func (el *Logger) Headline(message string, timestamp string, delimiter string) s
 
 func (el *Logger) defaultFields() *log.Entry {
 	_, file, line, ok := runtime.Caller(el.stackTraceDepth)
-	return el.logger.WithFields(log.Fields{
+	fields := log.Fields{
 		"file":        file,
 		"line_number": line,
 		"caller_ok":   ok,
-	})
+	}
+	if el.name != "" {
+		fields["logger"] = el.name
+	}
+	defaultCorrelation.addFields(fields)
+	for k, v := range el.fields {
+		fields[k] = v
+	}
+
+	return el.logger.WithFields(fields)
 }
 
 // WithFile sets up the file option for the Logger
@@ -277,19 +337,52 @@ func WithFileRotation(prefix string, subfolder string, fileExtension string) Opt
 			return err
 		}
 
-		el.roll = true
-		el.file.prefix = prefix
-		el.logFiles = append(el.logFiles, file)
-		el.file.SetWriter(file)
+		el.file.startRotation(prefix, subfolder, fileExtension, file)
 
 		return nil
 	}
 }
 
-// WithStderrRedirect sets up the option to redirect stderr to file
+// WithFileRotationBySize sets up the option to roll log files also when the current file exceeds
+//  the provided maximum size. It has to be applied after WithFileRotation
+func WithFileRotationBySize(maxFileSizeInBytes int64) Option {
+	return func(el *Logger) error {
+		if maxFileSizeInBytes <= 0 {
+			return ErrInvalidMaxFileSize
+		}
+
+		el.file.setMaxFileSize(maxFileSizeInBytes)
+		return nil
+	}
+}
+
+// WithFormat sets up the format of the logged lines. Supported formats are LogFormatJSON,
+//  which is suited for log shippers, and LogFormatText
+func WithFormat(format string) Option {
+	return func(el *Logger) error {
+		switch format {
+		case LogFormatJSON:
+			el.logger.SetFormatter(&log.JSONFormatter{})
+		case LogFormatText:
+			el.logger.SetFormatter(&log.TextFormatter{DisableColors: true, FullTimestamp: true})
+		default:
+			return ErrInvalidLogFormat
+		}
+
+		return nil
+	}
+}
+
+// WithStderrRedirect sets up the option to redirect stderr to file. The file is created next to the rolled log files,
+//  if the rotation was already set up, or in the logs subfolder otherwise
 func WithStderrRedirect() Option {
 	return func(el *Logger) error {
-		file, err := newFile(el.file.prefix+"_fatalErrors", "logs", "log")
+		subfolder := "logs"
+		if len(el.file.subfolder) > 0 {
+			subfolder = el.file.subfolder
+		}
+
+		file, err := newFile(el.file.prefix+"_fatalErrors", subfolder, "log")
 		if err != nil {
 			return err
 		}
@@ -311,35 +404,11 @@ func WithStackTraceDepth(depth int) Option {
 	}
 }
 
-func (el *Logger) rollFiles() {
-	duration := time.Now().Sub(el.file.creationTime).Seconds()
-	if duration <= fileLifetimeInSeconds {
-		return
-	}
-
-	file, err := newFile(el.file.prefix, "logs", "log")
-	if err != nil {
-		el.errorWithoutFileRoll(err.Error())
-		return
-	}
-	el.file.creationTime = time.Now()
-	el.file.SetWriter(file)
-
-	err = el.logFiles[len(el.logFiles)-1].Close()
+func (el *Logger) rollFilesIfNeeded() {
+	err := el.file.rollIfNeeded()
 	if err != nil {
 		el.errorWithoutFileRoll(err.Error())
 	}
-
-	if len(el.logFiles) == nrOfFilesToRemember {
-		err = os.Remove(el.logFiles[0].Name())
-		if err != nil {
-			el.errorWithoutFileRoll(err.Error())
-		}
-
-		el.logFiles = el.logFiles[1:]
-	}
-
-	el.logFiles = append(el.logFiles, file)
 }
 
 func newFile(prefix string, subfolder string, fileExtension string) (*os.File, error) {
@@ -353,7 +422,7 @@ func newFile(prefix string, subfolder string, fileExtension string) (*os.File, e
 		return nil, err
 	}
 
-	fileName := time.Now().Format("2006-02-01-15-04-05")
+	fileName := time.Now().Format("2006-01-02-15-04-05")
 	if prefix != "" {
 		fileName = prefix + "-" + fileName
 	}
@@ -194,10 +194,22 @@ func TestLazyFileWriter_WriteSomeLinesBeforeProvidingFile(t *testing.T) {
 	assert.Contains(t, str.String(), expectedString)
 }
 
+func createLogsDir(t *testing.T) (string, func()) {
+	logsDir, err := ioutil.TempDir("", "logs")
+	assert.Nil(t, err)
+
+	return logsDir, func() {
+		_ = os.RemoveAll(logsDir)
+	}
+}
+
 func TestWithFileRotation(t *testing.T) {
+	logsDir, removeLogsDir := createLogsDir(t)
+	defer removeLogsDir()
+
 	log := logger.NewElrondLogger()
 
-	err := log.ApplyOptions(logger.WithFileRotation("", "logs", "log"))
+	err := log.ApplyOptions(logger.WithFileRotation("", logsDir, "log"))
 	assert.Nil(t, err)
 
 	file := log.File()
@@ -205,10 +217,20 @@ func TestWithFileRotation(t *testing.T) {
 }
 
 func TestWithStderrRedirect(t *testing.T) {
+	logsDir, removeLogsDir := createLogsDir(t)
+	defer removeLogsDir()
+
 	log := logger.NewElrondLogger()
 
-	err := log.ApplyOptions(logger.WithStderrRedirect())
+	err := log.ApplyOptions(
+		logger.WithFileRotation("", logsDir, "log"),
+		logger.WithStderrRedirect(),
+	)
 	assert.Nil(t, err)
+
+	files, err := ioutil.ReadDir(logsDir)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(files))
 }
 
 func TestHeadline(t *testing.T) {
@@ -224,7 +246,10 @@ func TestHeadline(t *testing.T) {
 }
 
 func TestRedirectStderr(t *testing.T) {
-	file, _ := core.CreateFile("", "logs", "log")
+	logsDir, removeLogsDir := createLogsDir(t)
+	defer removeLogsDir()
+
+	file, _ := core.CreateFile("", logsDir, "log")
 	err := logger.RedirectStderr(file)
 	assert.Nil(t, err)
 
@@ -243,10 +268,13 @@ func TestRedirectStderrWithNilFile(t *testing.T) {
 
 func TestRollFiles(t *testing.T) {
 	t.Parallel()
+	logsDir, removeLogsDir := createLogsDir(t)
+	defer removeLogsDir()
+
 	log := logger.NewElrondLogger()
 	mockTime := time.Date(2019, 1, 1, 1, 1, 1, 1, time.Local)
 
-	err := log.ApplyOptions(logger.WithFileRotation("", "logs", "log"))
+	err := log.ApplyOptions(logger.WithFileRotation("", logsDir, "log"))
 	assert.Nil(t, err)
 
 	for i := 0; i < logger.NrOfFilesToRemember()*2; i++ {
@@ -265,3 +293,77 @@ func swallowPanicLog(t *testing.T, logMsg string, panicMsg string, log *logger.L
 	}()
 	log.Panic(logMsg)
 }
+
+func TestWith_ShouldOutputKeyValueFields(t *testing.T) {
+	t.Parallel()
+	var str bytes.Buffer
+	log := logger.NewElrondLogger()
+	log.SetOutput(&str)
+
+	log.With("nonce", 5, "shard", "meta").Info("abc")
+	logString := str.String()
+	assert.True(t, strings.Contains(logString, `"nonce":5`))
+	assert.True(t, strings.Contains(logString, `"shard":"meta"`))
+	assert.True(t, strings.Contains(logString, `"msg":"abc"`))
+}
+
+func TestWith_ShouldShareLevel(t *testing.T) {
+	t.Parallel()
+	var str bytes.Buffer
+	log := logger.NewElrondLogger()
+	log.SetOutput(&str)
+
+	derived := log.With("key", "value")
+	log.SetLevel(logger.LogError)
+	derived.Info("abc")
+	assert.Equal(t, 0, len(str.String()))
+}
+
+func TestWithFormat_TextShouldNotOutputJson(t *testing.T) {
+	t.Parallel()
+	var str bytes.Buffer
+	log := logger.NewElrondLogger(logger.WithFormat(logger.LogFormatText))
+	log.SetOutput(&str)
+
+	log.Info("abc")
+	assert.True(t, strings.Contains(str.String(), `msg=abc`))
+	assert.False(t, strings.Contains(str.String(), `"msg":"abc"`))
+}
+
+func TestWithFormat_InvalidFormatShouldErr(t *testing.T) {
+	t.Parallel()
+	log := logger.NewElrondLogger()
+
+	err := log.ApplyOptions(logger.WithFormat("xml"))
+	assert.NotNil(t, err)
+}
+
+func TestWithFileRotationBySize_InvalidSizeShouldErr(t *testing.T) {
+	t.Parallel()
+	log := logger.NewElrondLogger()
+
+	err := log.ApplyOptions(logger.WithFileRotationBySize(0))
+	assert.NotNil(t, err)
+}
+
+func TestWithFileRotationBySize_ShouldRollWhenFileIsTooBig(t *testing.T) {
+	logsDir, removeLogsDir := createLogsDir(t)
+	defer removeLogsDir()
+
+	log := logger.NewElrondLogger()
+
+	err := log.ApplyOptions(
+		logger.WithFileRotation("", logsDir, "log"),
+		logger.WithFileRotationBySize(1),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, log.GetNrOfLogFiles())
+
+	log.Warn("this line makes the file exceed the maximum size")
+	log.RollFilesIfNeeded()
+	assert.Equal(t, 2, log.GetNrOfLogFiles())
+
+	files, err := ioutil.ReadDir(logsDir)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, files)
+}
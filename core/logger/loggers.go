@@ -0,0 +1,182 @@
+package logger
+
+import (
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultLogLevel = log.DebugLevel
+const patternSeparator = ","
+const levelSeparator = ":"
+const wildcard = "*"
+
+type levelPattern struct {
+	pattern string
+	level   log.Level
+}
+
+var loggers = make(map[string]*Logger)
+var levelPatterns = []*levelPattern{{pattern: wildcard, level: defaultLogLevel}}
+var loggersMutex = sync.RWMutex{}
+
+// GetOrCreate returns the logger registered under the provided name, creating it if it does not exist.
+// Named loggers share the output, the hooks and the file rotation of the default logger, but each one
+// has its own log level, set through SetLogLevel. By convention, the name is the package path relative
+// to the repository root (e.g. process/block)
+func GetOrCreate(name string) *Logger {
+	defaultLog := DefaultLogger()
+
+	loggersMutex.Lock()
+	defer loggersMutex.Unlock()
+
+	namedLog, ok := loggers[name]
+	if ok {
+		return namedLog
+	}
+
+	level := uint32(levelForName(name))
+	namedLog = &Logger{
+		name:            name,
+		logger:          defaultLog.logger,
+		file:            defaultLog.file,
+		level:           &level,
+		stackTraceDepth: defaultLog.stackTraceDepth,
+	}
+	loggers[name] = namedLog
+
+	return namedLog
+}
+
+// SetLogLevel applies the provided level patterns on the default logger and on all named loggers, the existing
+// and the ones that will be created. The format is a comma separated list of pattern:LEVEL entries,
+// for example "*:INFO,process/*:DEBUG". A pattern can be a logger name, a name prefix ended in * or * alone,
+// which matches all loggers. When more patterns match the same logger, the last one wins.
+// A single level without any pattern (e.g. "INFO") is treated as "*:INFO"
+func SetLogLevel(levels string) error {
+	patterns, err := parseLevelPatterns(levels)
+	if err != nil {
+		return err
+	}
+
+	defaultLog := DefaultLogger()
+
+	loggersMutex.Lock()
+	levelPatterns = patterns
+	for name, namedLog := range loggers {
+		namedLog.setLevel(levelForName(name))
+	}
+	defaultLog.setLevel(levelForName(""))
+	loggersMutex.Unlock()
+
+	return nil
+}
+
+// GetLogLevelPattern returns the level patterns currently applied, in the format accepted by SetLogLevel
+func GetLogLevelPattern() string {
+	loggersMutex.RLock()
+	defer loggersMutex.RUnlock()
+
+	entries := make([]string, 0, len(levelPatterns))
+	for _, lp := range levelPatterns {
+		entries = append(entries, lp.pattern+levelSeparator+levelToString(lp.level))
+	}
+
+	return strings.Join(entries, patternSeparator)
+}
+
+func parseLevelPatterns(levels string) ([]*levelPattern, error) {
+	levels = strings.TrimSpace(levels)
+	if !strings.Contains(levels, levelSeparator) {
+		levels = wildcard + levelSeparator + levels
+	}
+
+	patterns := make([]*levelPattern, 0)
+	for _, entry := range strings.Split(levels, patternSeparator) {
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 {
+			continue
+		}
+
+		parts := strings.Split(entry, levelSeparator)
+		if len(parts) != 2 || len(strings.TrimSpace(parts[0])) == 0 {
+			return nil, ErrInvalidLevelPattern
+		}
+
+		level, err := parseLevel(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, err
+		}
+
+		patterns = append(patterns, &levelPattern{
+			pattern: strings.TrimSpace(parts[0]),
+			level:   level,
+		})
+	}
+
+	if len(patterns) == 0 {
+		return nil, ErrInvalidLevelPattern
+	}
+
+	return patterns, nil
+}
+
+// levelForName should be called under the loggersMutex
+func levelForName(name string) log.Level {
+	return levelFromPatterns(levelPatterns, name)
+}
+
+func levelFromPatterns(patterns []*levelPattern, name string) log.Level {
+	level := defaultLogLevel
+	for _, lp := range patterns {
+		if patternMatches(lp.pattern, name) {
+			level = lp.level
+		}
+	}
+
+	return level
+}
+
+func patternMatches(pattern string, name string) bool {
+	if pattern == wildcard {
+		return true
+	}
+	if strings.HasSuffix(pattern, wildcard) {
+		return strings.HasPrefix(name, strings.TrimSuffix(pattern, wildcard))
+	}
+
+	return pattern == name
+}
+
+func parseLevel(level string) (log.Level, error) {
+	switch strings.ToUpper(level) {
+	case LogDebug:
+		return log.DebugLevel, nil
+	case LogInfo:
+		return log.InfoLevel, nil
+	case LogWarning:
+		return log.WarnLevel, nil
+	case LogError:
+		return log.ErrorLevel, nil
+	case LogPanic:
+		return log.PanicLevel, nil
+	default:
+		return log.ErrorLevel, ErrInvalidLogLevel
+	}
+}
+
+func levelToString(level log.Level) string {
+	switch level {
+	case log.DebugLevel:
+		return LogDebug
+	case log.InfoLevel:
+		return LogInfo
+	case log.WarnLevel:
+		return LogWarning
+	case log.ErrorLevel:
+		return LogError
+	default:
+		return LogPanic
+	}
+}
@@ -0,0 +1,68 @@
+package logger_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrCreate_SameNameShouldReturnSameLogger(t *testing.T) {
+	logger.ResetLoggers()
+
+	log1 := logger.GetOrCreate("process/block")
+	log2 := logger.GetOrCreate("process/block")
+	log3 := logger.GetOrCreate("process/sync")
+
+	assert.True(t, log1 == log2)
+	assert.False(t, log1 == log3)
+	assert.Equal(t, "process/block", log1.Name())
+}
+
+func TestGetOrCreate_ShouldOutputLoggerName(t *testing.T) {
+	logger.ResetLoggers()
+	var str bytes.Buffer
+	_ = logger.DefaultLogger().ApplyOptions(logger.WithFile(&str))
+
+	logger.GetOrCreate("process/block").Warn("abc")
+	assert.True(t, strings.Contains(str.String(), `"logger":"process/block"`))
+}
+
+func TestSetLogLevel_InvalidPatternsShouldErr(t *testing.T) {
+	logger.ResetLoggers()
+
+	assert.Equal(t, logger.ErrInvalidLogLevel, logger.SetLogLevel("*:NOT_A_LEVEL"))
+	assert.Equal(t, logger.ErrInvalidLevelPattern, logger.SetLogLevel(":INFO"))
+	assert.Equal(t, logger.ErrInvalidLevelPattern, logger.SetLogLevel("*:INFO:DEBUG"))
+}
+
+func TestSetLogLevel_ShouldApplyOnExistingAndNewLoggers(t *testing.T) {
+	logger.ResetLoggers()
+
+	blockLog := logger.GetOrCreate("process/block")
+	p2pLog := logger.GetOrCreate("p2p/libp2p")
+
+	err := logger.SetLogLevel("*:WARNING,process/*:DEBUG,process/sync:ERROR")
+	assert.Nil(t, err)
+
+	syncLog := logger.GetOrCreate("process/sync")
+	assert.Equal(t, logger.LogDebug, blockLog.Level())
+	assert.Equal(t, logger.LogWarning, p2pLog.Level())
+	assert.Equal(t, logger.LogError, syncLog.Level())
+	assert.Equal(t, logger.LogWarning, logger.DefaultLogger().Level())
+	assert.Equal(t, "*:WARNING,process/*:DEBUG,process/sync:ERROR", logger.GetLogLevelPattern())
+}
+
+func TestSetLogLevel_SingleLevelShouldApplyOnAll(t *testing.T) {
+	logger.ResetLoggers()
+
+	blockLog := logger.GetOrCreate("process/block")
+	err := logger.SetLogLevel("info")
+	assert.Nil(t, err)
+
+	assert.Equal(t, logger.LogInfo, blockLog.Level())
+	assert.Equal(t, "*:INFO", logger.GetLogLevelPattern())
+	_ = logger.SetLogLevel(logger.LogDebug)
+}
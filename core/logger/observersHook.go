@@ -0,0 +1,117 @@
+package logger
+
+import (
+	"io"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type logObserver struct {
+	writer   io.Writer
+	patterns []*levelPattern
+}
+
+// observersHook is a logrus hook that sends the logged lines to all registered observers. Each observer
+// receives only the lines that match its own level patterns. It is used to stream the logs to remote clients
+type observersHook struct {
+	mutObservers sync.RWMutex
+	observers    []*logObserver
+	formatter    log.Formatter
+}
+
+var defaultObserversHook = observersHook{
+	formatter: &log.TextFormatter{DisableColors: true, FullTimestamp: true},
+}
+
+// AddLogObserver registers a new writer that will receive all log lines matching the provided level patterns.
+// The patterns have the same format as the ones accepted by SetLogLevel. An observer will not receive lines
+// filtered out by the level set on the logger that produced them
+func AddLogObserver(w io.Writer, levels string) error {
+	if w == nil {
+		return ErrNilWriter
+	}
+
+	patterns, err := parseLevelPatterns(levels)
+	if err != nil {
+		return err
+	}
+
+	return defaultObserversHook.addObserver(&logObserver{
+		writer:   w,
+		patterns: patterns,
+	})
+}
+
+// RemoveLogObserver unregisters a previously added writer
+func RemoveLogObserver(w io.Writer) error {
+	if w == nil {
+		return ErrNilWriter
+	}
+
+	return defaultObserversHook.removeObserver(w)
+}
+
+func (h *observersHook) addObserver(observer *logObserver) error {
+	h.mutObservers.Lock()
+	defer h.mutObservers.Unlock()
+
+	for _, lo := range h.observers {
+		if lo.writer == observer.writer {
+			return ErrWriterAlreadyObserving
+		}
+	}
+	h.observers = append(h.observers, observer)
+
+	return nil
+}
+
+func (h *observersHook) removeObserver(w io.Writer) error {
+	h.mutObservers.Lock()
+	defer h.mutObservers.Unlock()
+
+	for i, lo := range h.observers {
+		if lo.writer == w {
+			h.observers = append(h.observers[:i], h.observers[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrWriterNotObserving
+}
+
+// Levels returns the array of levels for which the hook will be applicable
+func (h *observersHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+// Fire represents the action triggered once a logging function will be called
+func (h *observersHook) Fire(entry *log.Entry) error {
+	h.mutObservers.RLock()
+	defer h.mutObservers.RUnlock()
+
+	if len(h.observers) == 0 {
+		return nil
+	}
+
+	loggerName, _ := entry.Data["logger"].(string)
+	var line []byte
+	for _, lo := range h.observers {
+		if levelFromPatterns(lo.patterns, loggerName) < entry.Level {
+			continue
+		}
+
+		if line == nil {
+			var err error
+			line, err = h.formatter.Format(entry)
+			if err != nil {
+				return err
+			}
+		}
+
+		// errors are not propagated as a faulty observer should not affect the others
+		_, _ = lo.writer.Write(line)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package logger_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddLogObserver_NilWriterShouldErr(t *testing.T) {
+	err := logger.AddLogObserver(nil, "*:INFO")
+	assert.Equal(t, logger.ErrNilWriter, err)
+}
+
+func TestAddLogObserver_InvalidPatternShouldErr(t *testing.T) {
+	err := logger.AddLogObserver(&bytes.Buffer{}, "*:NOT_A_LEVEL")
+	assert.Equal(t, logger.ErrInvalidLogLevel, err)
+}
+
+func TestAddLogObserver_SameWriterTwiceShouldErr(t *testing.T) {
+	buff := &bytes.Buffer{}
+	err := logger.AddLogObserver(buff, "*:INFO")
+	assert.Nil(t, err)
+
+	err = logger.AddLogObserver(buff, "*:DEBUG")
+	assert.Equal(t, logger.ErrWriterAlreadyObserving, err)
+
+	_ = logger.RemoveLogObserver(buff)
+}
+
+func TestRemoveLogObserver_NotAddedShouldErr(t *testing.T) {
+	err := logger.RemoveLogObserver(&bytes.Buffer{})
+	assert.Equal(t, logger.ErrWriterNotObserving, err)
+}
+
+func TestLogObserver_ShouldReceiveOnlyMatchingLines(t *testing.T) {
+	logger.ResetLoggers()
+
+	buff := &bytes.Buffer{}
+	err := logger.AddLogObserver(buff, "*:ERROR,process/*:DEBUG")
+	assert.Nil(t, err)
+
+	logger.GetOrCreate("process/block").Debug("process debug line")
+	logger.GetOrCreate("p2p").Warn("p2p warn line")
+	logger.GetOrCreate("p2p").Error("p2p error line")
+
+	err = logger.RemoveLogObserver(buff)
+	assert.Nil(t, err)
+	logger.GetOrCreate("p2p").Error("line after removal")
+
+	lines := buff.String()
+	assert.True(t, strings.Contains(lines, "process debug line"))
+	assert.False(t, strings.Contains(lines, "p2p warn line"))
+	assert.True(t, strings.Contains(lines, "p2p error line"))
+	assert.False(t, strings.Contains(lines, "line after removal"))
+}
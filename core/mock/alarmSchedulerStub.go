@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"time"
+)
+
+type AlarmSchedulerStub struct {
+	AddCalled    func(callback func(alarmID string), duration time.Duration, alarmID string)
+	CancelCalled func(alarmID string)
+	ResetCalled  func(alarmID string)
+	CloseCalled  func()
+}
+
+func (ass *AlarmSchedulerStub) Add(callback func(alarmID string), duration time.Duration, alarmID string) {
+	if ass.AddCalled != nil {
+		ass.AddCalled(callback, duration, alarmID)
+	}
+}
+
+func (ass *AlarmSchedulerStub) Cancel(alarmID string) {
+	if ass.CancelCalled != nil {
+		ass.CancelCalled(alarmID)
+	}
+}
+
+func (ass *AlarmSchedulerStub) Reset(alarmID string) {
+	if ass.ResetCalled != nil {
+		ass.ResetCalled(alarmID)
+	}
+}
+
+func (ass *AlarmSchedulerStub) Close() {
+	if ass.CloseCalled != nil {
+		ass.CloseCalled()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ass *AlarmSchedulerStub) IsInterfaceNil() bool {
+	if ass == nil {
+		return true
+	}
+	return false
+}
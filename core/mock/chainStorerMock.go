@@ -14,6 +14,7 @@ type ChainStorerMock struct {
 	PutCalled       func(unitType dataRetriever.UnitType, key []byte, value []byte) error
 	GetAllCalled    func(unitType dataRetriever.UnitType, keys [][]byte) (map[string][]byte, error)
 	DestroyCalled   func() error
+	CloseAllCalled  func() error
 }
 
 // AddStorer will add a new storer to the chain map
@@ -78,3 +79,11 @@ func (bc *ChainStorerMock) Destroy() error {
 	}
 	return nil
 }
+
+// CloseAll closes all the storers of the storage service
+func (bc *ChainStorerMock) CloseAll() error {
+	if bc.CloseAllCalled != nil {
+		return bc.CloseAllCalled()
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package mock
+
+type EpochSubscriberHandlerStub struct {
+	EpochConfirmedCalled func(epoch uint32)
+}
+
+func (eshs *EpochSubscriberHandlerStub) EpochConfirmed(epoch uint32) {
+	if eshs.EpochConfirmedCalled != nil {
+		eshs.EpochConfirmedCalled(epoch)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eshs *EpochSubscriberHandlerStub) IsInterfaceNil() bool {
+	if eshs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package mock
+
+type GasScheduleSubscribeHandlerStub struct {
+	GasScheduleChangeCalled func(gasSchedule map[string]map[string]uint64)
+}
+
+func (gsshs *GasScheduleSubscribeHandlerStub) GasScheduleChange(gasSchedule map[string]map[string]uint64) {
+	if gsshs.GasScheduleChangeCalled != nil {
+		gsshs.GasScheduleChangeCalled(gasSchedule)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gsshs *GasScheduleSubscribeHandlerStub) IsInterfaceNil() bool {
+	if gsshs == nil {
+		return true
+	}
+	return false
+}
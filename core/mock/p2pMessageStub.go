@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type P2PMessageStub struct {
+	DataField []byte
+}
+
+func (msg *P2PMessageStub) From() []byte {
+	return nil
+}
+
+func (msg *P2PMessageStub) Data() []byte {
+	return msg.DataField
+}
+
+func (msg *P2PMessageStub) SeqNo() []byte {
+	return nil
+}
+
+func (msg *P2PMessageStub) TopicIDs() []string {
+	return nil
+}
+
+func (msg *P2PMessageStub) Signature() []byte {
+	return nil
+}
+
+func (msg *P2PMessageStub) Key() []byte {
+	return nil
+}
+
+func (msg *P2PMessageStub) Peer() p2p.PeerID {
+	return ""
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (msg *P2PMessageStub) IsInterfaceNil() bool {
+	if msg == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package mock
+
+type RounderStub struct {
+	IndexCalled func() int64
+}
+
+func (rs *RounderStub) Index() int64 {
+	if rs.IndexCalled != nil {
+		return rs.IndexCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *RounderStub) IsInterfaceNil() bool {
+	if rs == nil {
+		return true
+	}
+	return false
+}
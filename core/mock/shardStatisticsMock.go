@@ -54,6 +54,11 @@ func (ss *ShardStatisticsMock) TotalProcessedTxCount() *big.Int {
 	return ss.totalProcessedTxCount
 }
 
+// AverageBlockTime returns the average time between two blocks of this shard
+func (ss *ShardStatisticsMock) AverageBlockTime() uint64 {
+	return 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ss *ShardStatisticsMock) IsInterfaceNil() bool {
 	if ss == nil {
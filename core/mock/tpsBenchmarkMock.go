@@ -32,7 +32,7 @@ func (s *TpsBenchmarkMock) ActiveNodes() uint32 {
 	return s.activeNodes
 }
 
-// RoundTime returns the round duration in seconds
+// RoundTime returns the round duration in milliseconds
 func (s *TpsBenchmarkMock) RoundTime() uint64 {
 	return s.roundTime
 }
@@ -77,6 +77,11 @@ func (s *TpsBenchmarkMock) NrOfShards() uint32 {
 	return s.nrOfShards
 }
 
+// AverageBlockTime returns the average time between two blocks
+func (s *TpsBenchmarkMock) AverageBlockTime() uint64 {
+	return 0
+}
+
 // ShardStatistics returns the current statistical state for a given shard
 func (s *TpsBenchmarkMock) ShardStatistics() map[uint32]statistics.ShardStatistic {
 	s.mut.RLock()
@@ -0,0 +1,75 @@
+package partitioning
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// PayloadChunker can split a payload too large for a single p2p message in marshalized chunks. The chunks are
+// referenced by the hash of the whole payload so the receiver can reassemble and check it
+type PayloadChunker struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewPayloadChunker creates a new PayloadChunker instance
+func NewPayloadChunker(marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*PayloadChunker, error) {
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, core.ErrNilMarshalizer
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, core.ErrNilHasher
+	}
+
+	return &PayloadChunker{
+		marshalizer: marshalizer,
+		hasher:      hasher,
+	}, nil
+}
+
+// SplitInChunks splits the provided payload in marshalized chunks holding at most maxChunkSize bytes of data
+func (pc *PayloadChunker) SplitInChunks(payload []byte, maxChunkSize int) ([][]byte, error) {
+	if maxChunkSize < minimumMaxPacketSizeInBytes {
+		return nil, core.ErrInvalidValue
+	}
+	if len(payload) == 0 {
+		return nil, core.ErrNilInputData
+	}
+
+	reference := pc.hasher.Compute(string(payload))
+	maxChunks := (len(payload) + maxChunkSize - 1) / maxChunkSize
+
+	chunks := make([][]byte, 0, maxChunks)
+	for i := 0; i < maxChunks; i++ {
+		start := i * maxChunkSize
+		end := start + maxChunkSize
+		if end > len(payload) {
+			end = len(payload)
+		}
+
+		chunk := &batch.Chunk{
+			Reference:  reference,
+			ChunkIndex: uint32(i),
+			MaxChunks:  uint32(maxChunks),
+			Data:       payload[start:end],
+		}
+		buff, err := pc.marshalizer.Marshal(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks = append(chunks, buff)
+	}
+
+	return chunks, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pc *PayloadChunker) IsInterfaceNil() bool {
+	if pc == nil {
+		return true
+	}
+	return false
+}
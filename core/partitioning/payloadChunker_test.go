@@ -0,0 +1,72 @@
+package partitioning_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPayloadChunker_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pc, err := partitioning.NewPayloadChunker(nil, &mock.HasherMock{})
+
+	assert.Nil(t, pc)
+	assert.Equal(t, core.ErrNilMarshalizer, err)
+}
+
+func TestNewPayloadChunker_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pc, err := partitioning.NewPayloadChunker(&mock.MarshalizerMock{}, nil)
+
+	assert.Nil(t, pc)
+	assert.Equal(t, core.ErrNilHasher, err)
+}
+
+//------- SplitInChunks
+
+func TestPayloadChunker_SplitInChunksInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := partitioning.NewPayloadChunker(&mock.MarshalizerMock{}, &mock.HasherMock{})
+
+	chunks, err := pc.SplitInChunks([]byte("payload"), 0)
+	assert.Nil(t, chunks)
+	assert.Equal(t, core.ErrInvalidValue, err)
+
+	chunks, err = pc.SplitInChunks(nil, 10)
+	assert.Nil(t, chunks)
+	assert.Equal(t, core.ErrNilInputData, err)
+}
+
+func TestPayloadChunker_SplitInChunksShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	pc, _ := partitioning.NewPayloadChunker(marshalizer, hasher)
+	payload := []byte("0123456789abcdefghijk")
+
+	chunks, err := pc.SplitInChunks(payload, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(chunks))
+
+	reassembled := make([]byte, 0)
+	for i, buff := range chunks {
+		chunk := &batch.Chunk{}
+		_ = marshalizer.Unmarshal(chunk, buff)
+
+		assert.Equal(t, hasher.Compute(string(payload)), chunk.Reference)
+		assert.Equal(t, uint32(i), chunk.ChunkIndex)
+		assert.Equal(t, uint32(3), chunk.MaxChunks)
+		reassembled = append(reassembled, chunk.Data...)
+	}
+	assert.True(t, bytes.Equal(payload, reassembled))
+}
@@ -10,5 +10,6 @@ import (
 type Core interface {
 	Indexer() indexer.Indexer
 	TPSBenchmark() statistics.TPSBenchmark
+	TrieStatistics() statistics.TrieStatistics
 	IsInterfaceNil() bool
 }
@@ -6,8 +6,9 @@ import (
 )
 
 type serviceContainer struct {
-	indexer      indexer.Indexer
-	tpsBenchmark statistics.TPSBenchmark
+	indexer        indexer.Indexer
+	tpsBenchmark   statistics.TPSBenchmark
+	trieStatistics statistics.TrieStatistics
 }
 
 // Option represents a functional configuration parameter that
@@ -37,6 +38,11 @@ func (sc *serviceContainer) TPSBenchmark() statistics.TPSBenchmark {
 	return sc.tpsBenchmark
 }
 
+// TrieStatistics returns the core package's trie statistics
+func (sc *serviceContainer) TrieStatistics() statistics.TrieStatistics {
+	return sc.trieStatistics
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sc *serviceContainer) IsInterfaceNil() bool {
 	if sc == nil {
@@ -60,3 +66,11 @@ func WithTPSBenchmark(tpsBenchmark statistics.TPSBenchmark) Option {
 		return nil
 	}
 }
+
+// WithTrieStatistics sets up the trie statistics object for the core serviceContainer
+func WithTrieStatistics(trieStatistics statistics.TrieStatistics) Option {
+	return func(sc *serviceContainer) error {
+		sc.trieStatistics = trieStatistics
+		return nil
+	}
+}
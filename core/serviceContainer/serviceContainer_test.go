@@ -54,3 +54,12 @@ func TestServiceContainer_NewServiceContainerWithNilTPSBenchmark(t *testing.T) {
 	assert.NotNil(t, sc)
 	assert.Nil(t, sc.TPSBenchmark())
 }
+
+func TestServiceContainer_NewServiceContainerWithTrieStatistics(t *testing.T) {
+	trieStatistics := &mock.TrieStatisticsStub{}
+
+	sc, err := serviceContainer.NewServiceContainer(serviceContainer.WithTrieStatistics(trieStatistics))
+	assert.Nil(t, err)
+	assert.NotNil(t, sc)
+	assert.Equal(t, trieStatistics, sc.TrieStatistics())
+}
@@ -0,0 +1,104 @@
+package statistics
+
+import (
+	"math/big"
+)
+
+// EpochStatistics holds the network activity statistics gathered by the metachain during an epoch
+type EpochStatistics struct {
+	Epoch                 uint32                  `json:"epoch"`
+	FirstBlockNonce       uint64                  `json:"firstBlockNonce"`
+	LastBlockNonce        uint64                  `json:"lastBlockNonce"`
+	NumBlocks             uint64                  `json:"numBlocks"`
+	TotalProcessedTxCount *big.Int                `json:"totalProcessedTxCount"`
+	PeakTPS               float64                 `json:"peakTPS"`
+	AverageBlockTime      uint64                  `json:"averageBlockTime"`
+	ShardStatistics       []*EpochShardStatistics `json:"shardStatistics"`
+
+	firstBlockTimeStamp uint64
+	lastBlockTimeStamp  uint64
+}
+
+// EpochShardStatistics holds the statistics of a shard gathered by the metachain during an epoch
+type EpochShardStatistics struct {
+	ShardID               uint32   `json:"shardID"`
+	NumBlocks             uint64   `json:"numBlocks"`
+	TotalProcessedTxCount *big.Int `json:"totalProcessedTxCount"`
+	PeakTPS               float64  `json:"peakTPS"`
+	AverageBlockTime      uint64   `json:"averageBlockTime"`
+
+	firstBlockTimeStamp uint64
+	lastBlockTimeStamp  uint64
+}
+
+func newEpochStatistics(epoch uint32, nrOfShards uint32) *EpochStatistics {
+	es := &EpochStatistics{
+		Epoch:                 epoch,
+		TotalProcessedTxCount: big.NewInt(0),
+		ShardStatistics:       make([]*EpochShardStatistics, 0, nrOfShards),
+	}
+	for i := uint32(0); i < nrOfShards; i++ {
+		es.ShardStatistics = append(es.ShardStatistics, &EpochShardStatistics{
+			ShardID:               i,
+			TotalProcessedTxCount: big.NewInt(0),
+		})
+	}
+
+	return es
+}
+
+func (es *EpochStatistics) addMetaBlock(nonce uint64, timeStamp uint64, txCount uint32, tps float64) {
+	if es.NumBlocks == 0 {
+		es.FirstBlockNonce = nonce
+		es.firstBlockTimeStamp = timeStamp
+	}
+	es.LastBlockNonce = nonce
+	es.lastBlockTimeStamp = timeStamp
+	es.NumBlocks++
+	es.TotalProcessedTxCount.Add(es.TotalProcessedTxCount, big.NewInt(int64(txCount)))
+	if tps > es.PeakTPS {
+		es.PeakTPS = tps
+	}
+	es.AverageBlockTime = averageBlockTime(es.firstBlockTimeStamp, es.lastBlockTimeStamp, es.NumBlocks)
+}
+
+func (es *EpochStatistics) addShardBlock(shardID uint32, timeStamp uint64, txCount uint32, tps float64) {
+	if shardID >= uint32(len(es.ShardStatistics)) {
+		return
+	}
+
+	ss := es.ShardStatistics[shardID]
+	if ss.NumBlocks == 0 {
+		ss.firstBlockTimeStamp = timeStamp
+	}
+	ss.lastBlockTimeStamp = timeStamp
+	ss.NumBlocks++
+	ss.TotalProcessedTxCount.Add(ss.TotalProcessedTxCount, big.NewInt(int64(txCount)))
+	if tps > ss.PeakTPS {
+		ss.PeakTPS = tps
+	}
+	ss.AverageBlockTime = averageBlockTime(ss.firstBlockTimeStamp, ss.lastBlockTimeStamp, ss.NumBlocks)
+}
+
+// averageBlockTime returns the average time between the blocks, in seconds, given the timestamps of the first and
+// of the last of them
+func averageBlockTime(firstTimeStamp uint64, lastTimeStamp uint64, numBlocks uint64) uint64 {
+	if numBlocks < 2 || lastTimeStamp < firstTimeStamp {
+		return 0
+	}
+
+	return (lastTimeStamp - firstTimeStamp) / (numBlocks - 1)
+}
+
+func (es *EpochStatistics) clone() *EpochStatistics {
+	esCopy := *es
+	esCopy.TotalProcessedTxCount = big.NewInt(0).Set(es.TotalProcessedTxCount)
+	esCopy.ShardStatistics = make([]*EpochShardStatistics, 0, len(es.ShardStatistics))
+	for _, ss := range es.ShardStatistics {
+		ssCopy := *ss
+		ssCopy.TotalProcessedTxCount = big.NewInt(0).Set(ss.TotalProcessedTxCount)
+		esCopy.ShardStatistics = append(esCopy.ShardStatistics, &ssCopy)
+	}
+
+	return &esCopy
+}
@@ -12,3 +12,15 @@ var ErrInvalidRoundDuration = errors.New("invalid round duration")
 
 // ErrNilFileToWriteStats signals that the file where statistics should be written is nil
 var ErrNilFileToWriteStats = errors.New("nil file to write statistics")
+
+// ErrNilAppStatusHandler signals that a nil app status handler was provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrEpochStatisticsNotFound signals that no statistics are available for the requested epoch
+var ErrEpochStatisticsNotFound = errors.New("epoch statistics not found")
@@ -19,11 +19,18 @@ type TPSBenchmark interface {
 	LiveTPS() float64
 	PeakTPS() float64
 	NrOfShards() uint32
+	AverageBlockTime() uint64
 	ShardStatistics() map[uint32]ShardStatistic
 	ShardStatistic(shardID uint32) ShardStatistic
 	IsInterfaceNil() bool
 }
 
+// TrieStatistics is an interface used to aggregate the statistics of the trie operations executed for each block
+type TrieStatistics interface {
+	BlockCommitted(header data.HeaderHandler)
+	IsInterfaceNil() bool
+}
+
 // ShardStatistic is an interface used to calculate statistics for the network activity of a specific shard
 type ShardStatistic interface {
 	ShardID() uint32
@@ -34,5 +41,6 @@ type ShardStatistic interface {
 	PeakTPS() float64
 	LastBlockTxCount() uint32
 	TotalProcessedTxCount() *big.Int
+	AverageBlockTime() uint64
 	IsInterfaceNil() bool
 }
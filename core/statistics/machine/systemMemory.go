@@ -0,0 +1,27 @@
+package machine
+
+import (
+	"github.com/shirou/gopsutil/mem"
+)
+
+// SystemMemory reads the memory usage of the whole machine, not only the one of the node
+type SystemMemory struct {
+}
+
+// UsedMemoryPercent returns the percentage of the machine memory currently in use
+func (sm *SystemMemory) UsedMemoryPercent() (uint64, error) {
+	vms, err := mem.VirtualMemory()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(vms.UsedPercent), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sm *SystemMemory) IsInterfaceNil() bool {
+	if sm == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,18 @@
+package machine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemMemory_UsedMemoryPercent(t *testing.T) {
+	t.Parallel()
+
+	sm := &SystemMemory{}
+
+	usedMemoryPercent, err := sm.UsedMemoryPercent()
+	assert.Nil(t, err)
+	assert.True(t, usedMemoryPercent <= 100)
+	assert.False(t, sm.IsInterfaceNil())
+}
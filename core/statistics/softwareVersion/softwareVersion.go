@@ -24,7 +24,7 @@ type SoftwareVersionChecker struct {
 	checkRandInterval         time.Duration
 }
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("core/statistics/softwareVersion")
 
 // NewSoftwareVersionChecker will create an object for software  version checker
 func NewSoftwareVersionChecker(appStatusHandler core.AppStatusHandler) (*SoftwareVersionChecker, error) {
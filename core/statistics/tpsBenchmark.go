@@ -1,13 +1,23 @@
 package statistics
 
 import (
+	"encoding/binary"
 	"math/big"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
+var log = logger.GetOrCreate("core/statistics")
+
+const millisecondsInSecond = 1000
+
 // TpsBenchmark will calculate statistics for the network activity
 type TpsBenchmark struct {
 	mut                   sync.RWMutex
@@ -22,6 +32,13 @@ type TpsBenchmark struct {
 	totalProcessedTxCount *big.Int
 	shardStatistics       map[uint32]ShardStatistic
 	missingNonces         map[uint64]struct{}
+	numBlocks             uint64
+	firstBlockTimeStamp   uint64
+	averageBlockTime      uint64
+	epochStatistics       *EpochStatistics
+	appStatusHandler      core.AppStatusHandler
+	statisticsStorer      storage.Storer
+	marshalizer           marshal.Marshalizer
 }
 
 // ShardStatistics will hold the tps statistics for each shard
@@ -34,10 +51,13 @@ type ShardStatistics struct {
 	averageBlockTxCount   uint32
 	currentBlockNonce     uint64
 	totalProcessedTxCount *big.Int
+	numBlocks             uint64
+	firstBlockTimeStamp   uint64
+	averageBlockTime      uint64
 }
 
 // NewTPSBenchmark instantiates a new object responsible with calculating statistics for each shard tps.
-// nrOfShards represents the total number of shards, roundDuration is the duration for a round in seconds
+// nrOfShards represents the total number of shards, roundDuration is the duration for a round in milliseconds
 func NewTPSBenchmark(nrOfShards uint32, roundDuration uint64) (*TpsBenchmark, error) {
 	if roundDuration == 0 {
 		return nil, ErrInvalidRoundDuration
@@ -57,15 +77,47 @@ func NewTPSBenchmark(nrOfShards uint32, roundDuration uint64) (*TpsBenchmark, er
 		missingNonces:         make(map[uint64]struct{}, 0),
 		totalProcessedTxCount: big.NewInt(0),
 		averageBlockTxCount:   big.NewInt(0),
+		epochStatistics:       newEpochStatistics(0, nrOfShards),
+		appStatusHandler:      statusHandler.NewNilStatusHandler(),
 	}, nil
 }
 
+// SetAppStatusHandler sets the AppStatusHandler which will receive the network statistics metrics
+func (s *TpsBenchmark) SetAppStatusHandler(ash core.AppStatusHandler) error {
+	if ash == nil || ash.IsInterfaceNil() {
+		return ErrNilAppStatusHandler
+	}
+
+	s.mut.Lock()
+	s.appStatusHandler = ash
+	s.mut.Unlock()
+
+	return nil
+}
+
+// SetStatisticsStorer sets the storer where the statistics of each epoch are saved when the epoch ends
+func (s *TpsBenchmark) SetStatisticsStorer(storer storage.Storer, marshalizer marshal.Marshalizer) error {
+	if storer == nil || storer.IsInterfaceNil() {
+		return ErrNilStorer
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return ErrNilMarshalizer
+	}
+
+	s.mut.Lock()
+	s.statisticsStorer = storer
+	s.marshalizer = marshalizer
+	s.mut.Unlock()
+
+	return nil
+}
+
 // ActiveNodes returns the number of active nodes
 func (s *TpsBenchmark) ActiveNodes() uint32 {
 	return s.activeNodes
 }
 
-// RoundTime returns the round duration in seconds
+// RoundTime returns the round duration in milliseconds
 func (s *TpsBenchmark) RoundTime() uint64 {
 	return s.roundTime
 }
@@ -97,7 +149,7 @@ func (s *TpsBenchmark) TotalProcessedTxCount() *big.Int {
 
 // LiveTPS returns tps for the last block
 func (s *TpsBenchmark) LiveTPS() float64 {
-	return float64(uint64(s.lastBlockTxCount) / s.roundTime)
+	return computeTPS(s.lastBlockTxCount, s.roundTime)
 }
 
 // PeakTPS returns tps for the last block
@@ -110,6 +162,41 @@ func (s *TpsBenchmark) NrOfShards() uint32 {
 	return s.nrOfShards
 }
 
+// AverageBlockTime returns the average time between two metachain blocks, in seconds
+func (s *TpsBenchmark) AverageBlockTime() uint64 {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	return s.averageBlockTime
+}
+
+// EpochStatistics returns the statistics gathered during the provided epoch. The statistics of the current epoch are
+// computed live, while the ones of the previous epochs are loaded from the statistics storer
+func (s *TpsBenchmark) EpochStatistics(epoch uint32) (*EpochStatistics, error) {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	if epoch == s.epochStatistics.Epoch {
+		return s.epochStatistics.clone(), nil
+	}
+	if s.statisticsStorer == nil {
+		return nil, ErrEpochStatisticsNotFound
+	}
+
+	buff, err := s.statisticsStorer.Get(epochToKey(epoch))
+	if err != nil {
+		return nil, ErrEpochStatisticsNotFound
+	}
+
+	epochStatistics := &EpochStatistics{}
+	err = s.marshalizer.Unmarshal(epochStatistics, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return epochStatistics, nil
+}
+
 // ShardStatistics returns the current statistical state for a given shard
 func (s *TpsBenchmark) ShardStatistics() map[uint32]ShardStatistic {
 	s.mut.RLock()
@@ -201,11 +288,22 @@ func (s *TpsBenchmark) updateStatistics(header *block.MetaBlock) error {
 	s.totalProcessedTxCount.Add(s.totalProcessedTxCount, big.NewInt(int64(header.TxCount)))
 	s.averageBlockTxCount.Quo(s.totalProcessedTxCount, big.NewInt(int64(header.Nonce)))
 
-	currentTPS := float64(uint64(header.TxCount) / s.roundTime)
+	if s.numBlocks == 0 {
+		s.firstBlockTimeStamp = header.TimeStamp
+	}
+	s.numBlocks++
+	s.averageBlockTime = averageBlockTime(s.firstBlockTimeStamp, header.TimeStamp, s.numBlocks)
+
+	currentTPS := computeTPS(header.TxCount, s.roundTime)
 	if currentTPS > s.peakTPS {
 		s.peakTPS = currentTPS
 	}
 
+	isEpochRelevant := s.updateEpochStatistics(header.Epoch)
+	if isEpochRelevant {
+		s.epochStatistics.addMetaBlock(header.Nonce, header.TimeStamp, header.TxCount, currentTPS)
+	}
+
 	for _, shardInfo := range header.ShardInfo {
 		shardStat, ok := s.shardStatistics[shardInfo.ShardId]
 		if !ok {
@@ -213,7 +311,7 @@ func (s *TpsBenchmark) updateStatistics(header *block.MetaBlock) error {
 		}
 
 		shardPeakTPS := shardStat.PeakTPS()
-		currentShardTPS := float64(uint64(shardInfo.TxCount) / s.roundTime)
+		currentShardTPS := computeTPS(shardInfo.TxCount, s.roundTime)
 		if currentShardTPS > shardStat.PeakTPS() {
 			shardPeakTPS = currentShardTPS
 		}
@@ -223,23 +321,92 @@ func (s *TpsBenchmark) updateStatistics(header *block.MetaBlock) error {
 		roundsPassed := big.NewInt(int64(header.Round))
 		newAverageTPS := big.NewInt(0).Quo(newTotalProcessedTxCount, roundsPassed)
 
+		numShardBlocks := uint64(1)
+		firstShardBlockTimeStamp := header.TimeStamp
+		previousShardStats, ok := shardStat.(*ShardStatistics)
+		if ok && previousShardStats.numBlocks > 0 {
+			numShardBlocks = previousShardStats.numBlocks + 1
+			firstShardBlockTimeStamp = previousShardStats.firstBlockTimeStamp
+		}
+
 		updatedShardStats := &ShardStatistics{
 			shardID:               shardInfo.ShardId,
 			roundTime:             s.roundTime,
 			currentBlockNonce:     header.Nonce,
 			totalProcessedTxCount: newTotalProcessedTxCount,
 
-			averageTPS:       newAverageTPS,
-			peakTPS:          shardPeakTPS,
-			lastBlockTxCount: header.TxCount,
+			averageTPS:          newAverageTPS,
+			peakTPS:             shardPeakTPS,
+			lastBlockTxCount:    header.TxCount,
+			numBlocks:           numShardBlocks,
+			firstBlockTimeStamp: firstShardBlockTimeStamp,
+			averageBlockTime:    averageBlockTime(firstShardBlockTimeStamp, header.TimeStamp, numShardBlocks),
 		}
 
 		s.shardStatistics[shardInfo.ShardId] = updatedShardStats
+
+		if isEpochRelevant {
+			s.epochStatistics.addShardBlock(shardInfo.ShardId, header.TimeStamp, shardInfo.TxCount, currentShardTPS)
+		}
 	}
 
+	s.updateMetrics()
+
 	return nil
 }
 
+// updateEpochStatistics saves the statistics of the ended epoch when the first block of a newer epoch is received.
+// It returns false if the block belongs to an already saved epoch, as it can happen for the late missing nonces
+func (s *TpsBenchmark) updateEpochStatistics(epoch uint32) bool {
+	if epoch < s.epochStatistics.Epoch {
+		return false
+	}
+	if epoch == s.epochStatistics.Epoch {
+		return true
+	}
+
+	s.saveEpochStatistics(s.epochStatistics)
+	s.epochStatistics = newEpochStatistics(epoch, s.nrOfShards)
+
+	return true
+}
+
+func (s *TpsBenchmark) saveEpochStatistics(epochStatistics *EpochStatistics) {
+	if s.statisticsStorer == nil || epochStatistics.NumBlocks == 0 {
+		return
+	}
+
+	buff, err := s.marshalizer.Marshal(epochStatistics)
+	if err != nil {
+		log.Warn("could not marshal the epoch statistics", "epoch", epochStatistics.Epoch, "error", err.Error())
+		return
+	}
+
+	err = s.statisticsStorer.Put(epochToKey(epochStatistics.Epoch), buff)
+	if err != nil {
+		log.Warn("could not save the epoch statistics", "epoch", epochStatistics.Epoch, "error", err.Error())
+	}
+}
+
+func (s *TpsBenchmark) updateMetrics() {
+	s.appStatusHandler.SetUInt64Value(core.MetricLiveTPS, uint64(computeTPS(s.lastBlockTxCount, s.roundTime)))
+	s.appStatusHandler.SetUInt64Value(core.MetricPeakTPS, uint64(s.peakTPS))
+	s.appStatusHandler.SetUInt64Value(core.MetricAverageBlockTime, s.averageBlockTime)
+	s.appStatusHandler.SetStringValue(core.MetricNetworkProcessedTxCount, s.totalProcessedTxCount.String())
+}
+
+// computeTPS returns the number of transactions per second of a block processed in a round of the given duration
+func computeTPS(txCount uint32, roundTimeInMs uint64) float64 {
+	return float64(txCount) * millisecondsInSecond / float64(roundTimeInMs)
+}
+
+func epochToKey(epoch uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, epoch)
+
+	return key
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *TpsBenchmark) IsInterfaceNil() bool {
 	if s == nil {
@@ -270,7 +437,7 @@ func (ss *ShardStatistics) CurrentBlockNonce() uint64 {
 
 // LiveTPS returns tps for the last block
 func (ss *ShardStatistics) LiveTPS() float64 {
-	return float64(uint64(ss.lastBlockTxCount) / ss.roundTime)
+	return computeTPS(ss.lastBlockTxCount, ss.roundTime)
 }
 
 // PeakTPS returns peak tps for for all the blocks of the current shard
@@ -288,6 +455,11 @@ func (ss *ShardStatistics) TotalProcessedTxCount() *big.Int {
 	return ss.totalProcessedTxCount
 }
 
+// AverageBlockTime returns the average time between two blocks of this shard, in seconds, as seen by the metachain
+func (ss *ShardStatistics) AverageBlockTime() uint64 {
+	return ss.averageBlockTime
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ss *ShardStatistics) IsInterfaceNil() bool {
 	if ss == nil {
@@ -1,11 +1,14 @@
 package statistics_test
 
 import (
+	"errors"
 	"math/big"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/stretchr/testify/assert"
@@ -53,7 +56,7 @@ func TestTpsBenchmark_NewTPSBenchmark(t *testing.T) {
 	t.Parallel()
 
 	nrOfShards := uint32(10)
-	roundDuration := uint64(4)
+	roundDuration := uint64(4000)
 	tpsBenchmark, _ := statistics.NewTPSBenchmark(nrOfShards, roundDuration)
 	gotNrOfShards := uint32(len(tpsBenchmark.ShardStatistics()))
 
@@ -64,7 +67,7 @@ func TestTpsBenchmark_NewTPSBenchmark(t *testing.T) {
 func TestTpsBenchmark_BlockNumber(t *testing.T) {
 	t.Parallel()
 
-	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 1)
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 1000)
 	blockNumber := uint64(1)
 	metaBlock := &block.MetaBlock{
 		Nonce: uint64(blockNumber),
@@ -81,7 +84,7 @@ func TestTpsBenchmark_BlockNumber(t *testing.T) {
 func TestTpsBenchmark_UpdateIrrelevantBlock(t *testing.T) {
 	t.Parallel()
 
-	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 1)
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 1000)
 
 	tpsBenchmark.Update(nil)
 	assert.Equal(t, tpsBenchmark.BlockNumber(), uint64(0))
@@ -90,7 +93,7 @@ func TestTpsBenchmark_UpdateIrrelevantBlock(t *testing.T) {
 func TestTpsBenchmark_UpdateSmallerNonce(t *testing.T) {
 	t.Parallel()
 
-	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 1)
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 1000)
 
 	round := uint64(2)
 	blockNumber := uint64(round)
@@ -122,7 +125,7 @@ func TestTpsBenchmark_UpdateSmallerNonce(t *testing.T) {
 func TestTpsBenchmark_UpdateEmptyShardInfoInMiniblock(t *testing.T) {
 	t.Parallel()
 
-	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 1)
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 1000)
 	blockNumber := uint64(1)
 
 	metaBlock := &block.MetaBlock{
@@ -137,7 +140,7 @@ func TestTpsBenchmark_UpdateEmptyShardInfoInMiniblock(t *testing.T) {
 func TestTpsBenchmark_UpdateTotalNumberOfTx(t *testing.T) {
 	t.Parallel()
 
-	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 1)
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 1000)
 	round := uint64(1)
 	blockNumber := uint64(round)
 	txCount := uint32(10)
@@ -170,7 +173,7 @@ func TestTpsBenchmark_UpdatePeakTps(t *testing.T) {
 	t.Parallel()
 
 	nrOfShards := uint32(1)
-	roundDuration := uint64(1)
+	roundDuration := uint64(1000)
 	tpsBenchmark, _ := statistics.NewTPSBenchmark(nrOfShards, roundDuration)
 	round := uint64(1)
 	blockNumber := uint64(round)
@@ -200,11 +203,33 @@ func TestTpsBenchmark_UpdatePeakTps(t *testing.T) {
 	assert.Equal(t, float64(peakTps), tpsBenchmark.PeakTPS())
 }
 
+func TestTpsBenchmark_SubSecondRoundDurationShouldScaleTPS(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := uint64(250)
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, roundDuration)
+	txCount := uint32(10)
+	metaBlock := &block.MetaBlock{
+		Nonce:   1,
+		Round:   1,
+		TxCount: txCount,
+		ShardInfo: []block.ShardData{
+			{ShardId: 0, HeaderHash: []byte{1}, TxCount: txCount},
+		},
+	}
+
+	tpsBenchmark.Update(metaBlock)
+
+	assert.Equal(t, float64(40), tpsBenchmark.LiveTPS())
+	assert.Equal(t, float64(40), tpsBenchmark.PeakTPS())
+	assert.Equal(t, float64(40), tpsBenchmark.ShardStatistic(0).LiveTPS())
+}
+
 func TestTPSBenchmark_GettersAndSetters(t *testing.T) {
 	t.Parallel()
 
 	nrOfShards := uint32(1)
-	roundDuration := uint64(1)
+	roundDuration := uint64(1000)
 	shardId := uint32(0)
 	tpsBenchmark, _ := statistics.NewTPSBenchmark(nrOfShards, roundDuration)
 	round := uint64(1)
@@ -241,7 +266,7 @@ func TestTpsBenchmark_ShouldUpdateSameNonceOnlyOnce(t *testing.T) {
 	t.Parallel()
 
 	nrOfShards := uint32(2)
-	roundDuration := uint64(6)
+	roundDuration := uint64(6000)
 	tpsBenchmark, _ := statistics.NewTPSBenchmark(nrOfShards, roundDuration)
 	txCount := uint32(10)
 
@@ -281,7 +306,7 @@ func TestTpsBenchmark_EmptyBlocksShouldNotUpdateMultipleTimes(t *testing.T) {
 	t.Parallel()
 
 	nrOfShards := uint32(2)
-	roundDuration := uint64(6)
+	roundDuration := uint64(6000)
 	tpsBenchmark, _ := statistics.NewTPSBenchmark(nrOfShards, roundDuration)
 	txCount := uint32(10)
 
@@ -320,7 +345,7 @@ func TestTpsBenchmark_Concurrent(t *testing.T) {
 
 func testTpsBenchmarkConcurrent(t *testing.T) {
 	nrOfShards := uint32(2)
-	roundDuration := uint64(6)
+	roundDuration := uint64(6000)
 	tpsBenchmark, _ := statistics.NewTPSBenchmark(nrOfShards, roundDuration)
 	txCount := uint32(10)
 	nrGoroutines := 8000
@@ -344,7 +369,7 @@ func testTpsBenchmarkConcurrent(t *testing.T) {
 func TestTpsBenchmark_ZeroTxMetaBlockAndShardHeader(t *testing.T) {
 	t.Parallel()
 
-	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 4)
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 4000)
 
 	shardData := block.ShardData{
 		ShardId:               0,
@@ -368,7 +393,7 @@ func TestTpsBenchmark_ZeroTxMetaBlockAndShardHeader(t *testing.T) {
 func TestTpsBenchmark_ZeroTxMetaBlockAndEmptyShardHeader(t *testing.T) {
 	t.Parallel()
 
-	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 4)
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 4000)
 
 	metaBlock := &block.MetaBlock{
 		Nonce:     1,
@@ -382,3 +407,128 @@ func TestTpsBenchmark_ZeroTxMetaBlockAndEmptyShardHeader(t *testing.T) {
 	bigTxCount := big.NewInt(0)
 	assert.Equal(t, bigTxCount, tpsBenchmark.TotalProcessedTxCount())
 }
+
+func TestTpsBenchmark_SetAppStatusHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 4000)
+
+	err := tpsBenchmark.SetAppStatusHandler(nil)
+	assert.Equal(t, statistics.ErrNilAppStatusHandler, err)
+}
+
+func TestTpsBenchmark_SetStatisticsStorerNilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 4000)
+
+	err := tpsBenchmark.SetStatisticsStorer(nil, &mock.MarshalizerMock{})
+	assert.Equal(t, statistics.ErrNilStorer, err)
+
+	err = tpsBenchmark.SetStatisticsStorer(&mock.StorerStub{}, nil)
+	assert.Equal(t, statistics.ErrNilMarshalizer, err)
+}
+
+func TestTpsBenchmark_UpdateShouldComputeAverageBlockTime(t *testing.T) {
+	t.Parallel()
+
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(2, 4000)
+
+	for nonce := uint64(1); nonce <= 4; nonce++ {
+		shardInfo := []block.ShardData{{ShardId: 0, TxCount: 8}}
+		if nonce%2 == 0 {
+			shardInfo = append(shardInfo, block.ShardData{ShardId: 1, TxCount: 4})
+		}
+
+		tpsBenchmark.Update(&block.MetaBlock{
+			Nonce:     nonce,
+			Round:     nonce,
+			TimeStamp: 100 + nonce*6,
+			TxCount:   12,
+			ShardInfo: shardInfo,
+		})
+	}
+
+	assert.Equal(t, uint64(6), tpsBenchmark.AverageBlockTime())
+	assert.Equal(t, uint64(6), tpsBenchmark.ShardStatistic(0).AverageBlockTime())
+	assert.Equal(t, uint64(12), tpsBenchmark.ShardStatistic(1).AverageBlockTime())
+}
+
+func TestTpsBenchmark_UpdateShouldSetMetrics(t *testing.T) {
+	t.Parallel()
+
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 4000)
+	uint64Metrics := make(map[string]uint64)
+	stringMetrics := make(map[string]string)
+	_ = tpsBenchmark.SetAppStatusHandler(&mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			uint64Metrics[key] = value
+		},
+		SetStringValueHandler: func(key string, value string) {
+			stringMetrics[key] = value
+		},
+	})
+
+	tpsBenchmark.Update(&block.MetaBlock{
+		Nonce:     1,
+		Round:     1,
+		TxCount:   40,
+		ShardInfo: []block.ShardData{{ShardId: 0, TxCount: 40}},
+	})
+
+	assert.Equal(t, uint64(10), uint64Metrics[core.MetricLiveTPS])
+	assert.Equal(t, uint64(10), uint64Metrics[core.MetricPeakTPS])
+	assert.Equal(t, uint64(0), uint64Metrics[core.MetricAverageBlockTime])
+	assert.Equal(t, "40", stringMetrics[core.MetricNetworkProcessedTxCount])
+}
+
+func TestTpsBenchmark_EpochStatisticsShouldBeSavedWhenTheEpochChanges(t *testing.T) {
+	t.Parallel()
+
+	tpsBenchmark, _ := statistics.NewTPSBenchmark(1, 4000)
+	savedStatistics := make(map[string][]byte)
+	storer := &mock.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			savedStatistics[string(key)] = data
+			return nil
+		},
+		GetCalled: func(key []byte) ([]byte, error) {
+			buff, ok := savedStatistics[string(key)]
+			if !ok {
+				return nil, errors.New("key not found")
+			}
+			return buff, nil
+		},
+	}
+	err := tpsBenchmark.SetStatisticsStorer(storer, &mock.MarshalizerMock{})
+	assert.Nil(t, err)
+
+	tpsBenchmark.Update(&block.MetaBlock{Nonce: 1, Round: 1, Epoch: 0, TimeStamp: 4, TxCount: 8,
+		ShardInfo: []block.ShardData{{ShardId: 0, TxCount: 8}}})
+	tpsBenchmark.Update(&block.MetaBlock{Nonce: 2, Round: 2, Epoch: 0, TimeStamp: 8, TxCount: 16,
+		ShardInfo: []block.ShardData{{ShardId: 0, TxCount: 16}}})
+	assert.Equal(t, 0, len(savedStatistics))
+
+	tpsBenchmark.Update(&block.MetaBlock{Nonce: 3, Round: 3, Epoch: 1, TimeStamp: 12, TxCount: 4,
+		ShardInfo: []block.ShardData{{ShardId: 0, TxCount: 4}}})
+	assert.Equal(t, 1, len(savedStatistics))
+
+	epochStatistics, err := tpsBenchmark.EpochStatistics(0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), epochStatistics.Epoch)
+	assert.Equal(t, uint64(1), epochStatistics.FirstBlockNonce)
+	assert.Equal(t, uint64(2), epochStatistics.LastBlockNonce)
+	assert.Equal(t, uint64(2), epochStatistics.NumBlocks)
+	assert.Equal(t, big.NewInt(24), epochStatistics.TotalProcessedTxCount)
+	assert.Equal(t, float64(4), epochStatistics.PeakTPS)
+	assert.Equal(t, uint64(4), epochStatistics.AverageBlockTime)
+	assert.Equal(t, uint64(2), epochStatistics.ShardStatistics[0].NumBlocks)
+
+	currentEpochStatistics, err := tpsBenchmark.EpochStatistics(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), currentEpochStatistics.NumBlocks)
+	assert.Equal(t, big.NewInt(4), currentEpochStatistics.TotalProcessedTxCount)
+
+	_, err = tpsBenchmark.EpochStatistics(2)
+	assert.Equal(t, statistics.ErrEpochStatisticsNotFound, err)
+}
@@ -0,0 +1,52 @@
+package core
+
+// MinUserNameLength defines the minimum length of a user name
+const MinUserNameLength = 3
+
+// MaxUserNameLength defines the maximum length of a user name
+const MaxUserNameLength = 32
+
+var reservedUserNames = map[string]struct{}{
+	"admin":     {},
+	"dns":       {},
+	"elrond":    {},
+	"erd":       {},
+	"metachain": {},
+	"root":      {},
+	"staking":   {},
+	"system":    {},
+}
+
+// CheckUserNameFormat verifies that the provided user name can be registered. A valid user name has between
+// MinUserNameLength and MaxUserNameLength characters, contains only lowercase letters, digits and '.', '_' or '-'
+// separators, starts with a letter and is not one of the reserved names
+func CheckUserNameFormat(userName []byte) error {
+	if len(userName) < MinUserNameLength || len(userName) > MaxUserNameLength {
+		return ErrInvalidUserNameLength
+	}
+	if !isLowerCaseLetter(userName[0]) {
+		return ErrInvalidUserNameCharacters
+	}
+
+	for _, c := range userName {
+		isAllowed := isLowerCaseLetter(c) || isDigit(c) || c == '.' || c == '_' || c == '-'
+		if !isAllowed {
+			return ErrInvalidUserNameCharacters
+		}
+	}
+
+	_, isReserved := reservedUserNames[string(userName)]
+	if isReserved {
+		return ErrReservedUserName
+	}
+
+	return nil
+}
+
+func isLowerCaseLetter(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,57 @@
+package core_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUserNameFormat_TooShortShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := core.CheckUserNameFormat([]byte("ab"))
+	assert.Equal(t, core.ErrInvalidUserNameLength, err)
+}
+
+func TestCheckUserNameFormat_TooLongShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := core.CheckUserNameFormat([]byte(strings.Repeat("a", core.MaxUserNameLength+1)))
+	assert.Equal(t, core.ErrInvalidUserNameLength, err)
+}
+
+func TestCheckUserNameFormat_NotStartingWithLetterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := core.CheckUserNameFormat([]byte("1alice"))
+	assert.Equal(t, core.ErrInvalidUserNameCharacters, err)
+}
+
+func TestCheckUserNameFormat_InvalidCharactersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	invalidNames := []string{"Alice", "alice bob", "alice@erd", "alice!"}
+	for _, name := range invalidNames {
+		err := core.CheckUserNameFormat([]byte(name))
+		assert.Equal(t, core.ErrInvalidUserNameCharacters, err, name)
+	}
+}
+
+func TestCheckUserNameFormat_ReservedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := core.CheckUserNameFormat([]byte("elrond"))
+	assert.Equal(t, core.ErrReservedUserName, err)
+}
+
+func TestCheckUserNameFormat_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{"alice", "bob_2", "carol.dev", "dan-the-man"}
+	for _, name := range validNames {
+		err := core.CheckUserNameFormat([]byte(name))
+		assert.Nil(t, err, name)
+	}
+}
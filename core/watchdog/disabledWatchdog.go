@@ -0,0 +1,33 @@
+package watchdog
+
+import (
+	"time"
+)
+
+// DisabledWatchdog is a watchdog which never signals anything
+type DisabledWatchdog struct {
+}
+
+// Set does nothing
+func (dw *DisabledWatchdog) Set(_ func(alarmID string), _ time.Duration, _ string) {
+}
+
+// SetDefault does nothing
+func (dw *DisabledWatchdog) SetDefault(_ time.Duration, _ string) {
+}
+
+// Stop does nothing
+func (dw *DisabledWatchdog) Stop(_ string) {
+}
+
+// Reset does nothing
+func (dw *DisabledWatchdog) Reset(_ string) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dw *DisabledWatchdog) IsInterfaceNil() bool {
+	if dw == nil {
+		return true
+	}
+	return false
+}
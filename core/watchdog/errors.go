@@ -0,0 +1,8 @@
+package watchdog
+
+import (
+	"errors"
+)
+
+// ErrNilAlarmScheduler signals that a nil alarm scheduler was provided
+var ErrNilAlarmScheduler = errors.New("nil alarm scheduler")
@@ -0,0 +1,67 @@
+package watchdog
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+)
+
+var log = logger.GetOrCreate("core/watchdog")
+
+const maxStackTraceSize = 1 << 20
+
+// watchdog signals the operations which did not finish in the expected time. An operation arms an alarm before it
+// starts and stops it when it ends, so the alarm only expires if the operation is stuck
+type watchdog struct {
+	alarmScheduler core.TimersScheduler
+}
+
+// NewWatchdog creates a new watchdog which uses the provided alarm scheduler
+func NewWatchdog(alarmScheduler core.TimersScheduler) (*watchdog, error) {
+	if alarmScheduler == nil || alarmScheduler.IsInterfaceNil() {
+		return nil, ErrNilAlarmScheduler
+	}
+
+	return &watchdog{
+		alarmScheduler: alarmScheduler,
+	}, nil
+}
+
+// Set arms the alarm with the provided ID, which calls the provided callback if it is not stopped in time
+func (w *watchdog) Set(callback func(alarmID string), duration time.Duration, alarmID string) {
+	w.alarmScheduler.Add(callback, duration, alarmID)
+}
+
+// SetDefault arms the alarm with the provided ID, which logs the stack traces of all the goroutines if it is not
+// stopped in time
+func (w *watchdog) SetDefault(duration time.Duration, alarmID string) {
+	w.Set(w.defaultOnExpire, duration, alarmID)
+}
+
+// Stop disarms the alarm with the provided ID
+func (w *watchdog) Stop(alarmID string) {
+	w.alarmScheduler.Cancel(alarmID)
+}
+
+// Reset restarts the alarm with the provided ID
+func (w *watchdog) Reset(alarmID string) {
+	w.alarmScheduler.Reset(alarmID)
+}
+
+func (w *watchdog) defaultOnExpire(alarmID string) {
+	buff := make([]byte, maxStackTraceSize)
+	numBytes := runtime.Stack(buff, true)
+
+	log.Error("watchdog alarm has expired", "alarm", alarmID)
+	log.Warn("goroutines stack traces", "stacks", string(buff[:numBytes]))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (w *watchdog) IsInterfaceNil() bool {
+	if w == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package watchdog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/watchdog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWatchdog_NilAlarmSchedulerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	w, err := watchdog.NewWatchdog(nil)
+	assert.Nil(t, w)
+	assert.Equal(t, watchdog.ErrNilAlarmScheduler, err)
+}
+
+func TestWatchdog_ShouldForwardToTheAlarmScheduler(t *testing.T) {
+	t.Parallel()
+
+	addedAlarmID, canceledAlarmID, resetAlarmID := "", "", ""
+	addedDuration := time.Duration(0)
+	alarmScheduler := &mock.AlarmSchedulerStub{
+		AddCalled: func(callback func(alarmID string), duration time.Duration, alarmID string) {
+			addedAlarmID = alarmID
+			addedDuration = duration
+			callback(alarmID)
+		},
+		CancelCalled: func(alarmID string) {
+			canceledAlarmID = alarmID
+		},
+		ResetCalled: func(alarmID string) {
+			resetAlarmID = alarmID
+		},
+	}
+	w, err := watchdog.NewWatchdog(alarmScheduler)
+	assert.Nil(t, err)
+	assert.False(t, w.IsInterfaceNil())
+
+	expiredAlarmID := ""
+	w.Set(func(alarmID string) {
+		expiredAlarmID = alarmID
+	}, time.Second, "alarm")
+	assert.Equal(t, "alarm", addedAlarmID)
+	assert.Equal(t, "alarm", expiredAlarmID)
+	assert.Equal(t, time.Second, addedDuration)
+
+	w.SetDefault(time.Minute, "default alarm")
+	assert.Equal(t, "default alarm", addedAlarmID)
+	assert.Equal(t, time.Minute, addedDuration)
+
+	w.Stop("alarm")
+	assert.Equal(t, "alarm", canceledAlarmID)
+
+	w.Reset("default alarm")
+	assert.Equal(t, "default alarm", resetAlarmID)
+}
@@ -15,7 +15,7 @@ const basesMillerRabin = 12
 var g = big.NewInt(3)
 var bigZero = big.NewInt(0)
 var bigOne = big.NewInt(1)
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("crypto/accumulator/rsa")
 
 // Modulus taken from https://en.wikipedia.org/wiki/RSA_numbers#RSA-2048
 var Modulus = func() *big.Int {
@@ -9,10 +9,11 @@ import (
 )
 
 type specialAddresses struct {
-	shardConsensusData *data.ConsensusRewardData
-	metaConsensusData  []*data.ConsensusRewardData
-	elrondAddress      []byte
-	burnAddress        []byte
+	shardConsensusData            *data.ConsensusRewardData
+	metaConsensusData             []*data.ConsensusRewardData
+	elrondAddress                 []byte
+	burnAddress                   []byte
+	protocolSustainabilityAddress []byte
 
 	adrConv          state.AddressConverter
 	shardCoordinator sharding.Coordinator
@@ -23,6 +24,7 @@ type specialAddresses struct {
 func NewSpecialAddressHolder(
 	elrondAddress []byte,
 	burnAddress []byte,
+	protocolSustainabilityAddress []byte,
 	adrConv state.AddressConverter,
 	shardCoordinator sharding.Coordinator,
 	nodesCoordinator sharding.NodesCoordinator,
@@ -33,6 +35,9 @@ func NewSpecialAddressHolder(
 	if burnAddress == nil {
 		return nil, data.ErrNilBurnAddress
 	}
+	if protocolSustainabilityAddress == nil {
+		return nil, data.ErrNilProtocolSustainabilityAddress
+	}
 	if adrConv == nil || adrConv.IsInterfaceNil() {
 		return nil, data.ErrNilAddressConverter
 	}
@@ -44,12 +49,13 @@ func NewSpecialAddressHolder(
 	}
 
 	sp := &specialAddresses{
-		elrondAddress:     elrondAddress,
-		burnAddress:       burnAddress,
-		adrConv:           adrConv,
-		shardCoordinator:  shardCoordinator,
-		nodesCoordinator:  nodesCoordinator,
-		metaConsensusData: make([]*data.ConsensusRewardData, 0),
+		elrondAddress:                 elrondAddress,
+		burnAddress:                   burnAddress,
+		protocolSustainabilityAddress: protocolSustainabilityAddress,
+		adrConv:                       adrConv,
+		shardCoordinator:              shardCoordinator,
+		nodesCoordinator:              nodesCoordinator,
+		metaConsensusData:             make([]*data.ConsensusRewardData, 0),
 	}
 
 	return sp, nil
@@ -95,6 +101,11 @@ func (sp *specialAddresses) BurnAddress() []byte {
 	return sp.burnAddress
 }
 
+// ProtocolSustainabilityAddress provides the address which receives the protocol sustainability rewards
+func (sp *specialAddresses) ProtocolSustainabilityAddress() []byte {
+	return sp.protocolSustainabilityAddress
+}
+
 // ConsensusShardRewardData provides the consensus data required for generating the rewards for shard nodes
 func (sp *specialAddresses) ConsensusShardRewardData() *data.ConsensusRewardData {
 	return sp.shardConsensusData
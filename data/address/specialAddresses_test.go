@@ -12,20 +12,22 @@ import (
 )
 
 type Args struct {
-	ElrondCommunityAddress []byte
-	BurnAddress            []byte
-	AddrConv               state.AddressConverter
-	ShardCoordinator       sharding.Coordinator
-	NodesCoordiator        sharding.NodesCoordinator
+	ElrondCommunityAddress        []byte
+	BurnAddress                   []byte
+	ProtocolSustainabilityAddress []byte
+	AddrConv                      state.AddressConverter
+	ShardCoordinator              sharding.Coordinator
+	NodesCoordiator               sharding.NodesCoordinator
 }
 
 func initDefaultArgs() *Args {
 	args := &Args{
-		ElrondCommunityAddress: []byte("community"),
-		BurnAddress:            []byte("burn"),
-		AddrConv:               &mock.AddressConverterMock{},
-		ShardCoordinator:       mock.NewMultiShardsCoordinatorMock(1),
-		NodesCoordiator:        mock.NewNodesCoordinatorMock(),
+		ElrondCommunityAddress:        []byte("community"),
+		BurnAddress:                   []byte("burn"),
+		ProtocolSustainabilityAddress: []byte("protocol sustainability"),
+		AddrConv:                      &mock.AddressConverterMock{},
+		ShardCoordinator:              mock.NewMultiShardsCoordinatorMock(1),
+		NodesCoordiator:               mock.NewNodesCoordinatorMock(),
 	}
 
 	return args
@@ -35,6 +37,7 @@ func createSpecialAddressFromArgs(args *Args) (process.SpecialAddressHandler, er
 	addr, err := NewSpecialAddressHolder(
 		args.ElrondCommunityAddress,
 		args.BurnAddress,
+		args.ProtocolSustainabilityAddress,
 		args.AddrConv,
 		args.ShardCoordinator,
 		args.NodesCoordiator,
@@ -49,6 +52,26 @@ func createDefaultSpecialAddress() process.SpecialAddressHandler {
 	return addr
 }
 
+func TestNewSpecialAddressHolderNilProtocolSustainabilityAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := initDefaultArgs()
+	args.ProtocolSustainabilityAddress = nil
+	addr, err := createSpecialAddressFromArgs(args)
+
+	assert.Nil(t, addr)
+	assert.Equal(t, data.ErrNilProtocolSustainabilityAddress, err)
+}
+
+func TestSpecialAddresses_ProtocolSustainabilityAddressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := initDefaultArgs()
+	addr, _ := createSpecialAddressFromArgs(args)
+
+	assert.Equal(t, args.ProtocolSustainabilityAddress, addr.ProtocolSustainabilityAddress())
+}
+
 func TestNewSpecialAddressHolderNilCommunityAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
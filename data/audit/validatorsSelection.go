@@ -0,0 +1,26 @@
+package audit
+
+// ValidatorSelection holds how many times an eligible validator was selected as leader and as consensus group member
+// during an epoch, compared with the number of selections expected from an uniform selection. The deviations are
+// expressed in standard deviations of the binomial distribution of the selections
+type ValidatorSelection struct {
+	PublicKey                   string  `json:"publicKey"`
+	NumLeaderSelections         uint64  `json:"numLeaderSelections"`
+	ExpectedLeaderSelections    float64 `json:"expectedLeaderSelections"`
+	LeaderDeviation             float64 `json:"leaderDeviation"`
+	NumConsensusSelections      uint64  `json:"numConsensusSelections"`
+	ExpectedConsensusSelections float64 `json:"expectedConsensusSelections"`
+	ConsensusDeviation          float64 `json:"consensusDeviation"`
+	NumSignatures               uint64  `json:"numSignatures"`
+	Flagged                     bool    `json:"flagged"`
+}
+
+// EpochSelectionAudit holds the validators selection audit of a shard for an epoch
+type EpochSelectionAudit struct {
+	Epoch              uint32                `json:"epoch"`
+	ShardID            uint32                `json:"shardId"`
+	NumRounds          uint64                `json:"numRounds"`
+	NumEligible        int                   `json:"numEligible"`
+	ConsensusGroupSize int                   `json:"consensusGroupSize"`
+	Validators         []*ValidatorSelection `json:"validators"`
+}
@@ -0,0 +1,10 @@
+package batch
+
+// Chunk holds a part of a payload too large to be sent in a single p2p message. All the chunks of a payload share the
+// same reference, the hash of the whole payload, which is checked after the payload is reassembled
+type Chunk struct {
+	Reference  []byte `json:"reference"`
+	ChunkIndex uint32 `json:"chunkIndex"`
+	MaxChunks  uint32 `json:"maxChunks"`
+	Data       []byte `json:"data"`
+}
@@ -3,6 +3,7 @@ package block
 import (
 	"fmt"
 	"io"
+	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block/capnp"
@@ -66,6 +67,22 @@ type MiniBlock struct {
 	ReceiverShardID uint32   `capid:"1"`
 	SenderShardID   uint32   `capid:"2"`
 	Type            Type     `capid:"3"`
+	// PartialExecution is set only on the miniblocks holding a part of a cross shard miniblock
+	PartialExecution *PartialExecution `capid:"4" json:",omitempty"`
+}
+
+// PartialExecution identifies the part of a cross shard miniblock executed in a block, when the destination shard
+// can not execute the whole miniblock at once. The parts hold consecutive transactions of the original miniblock
+// and are executed in consecutive blocks, starting with the transaction at index IndexOfFirstTx
+type PartialExecution struct {
+	OriginalHash    []byte `capid:"0"`
+	OriginalTxCount uint32 `capid:"1"`
+	IndexOfFirstTx  uint32 `capid:"2"`
+}
+
+// IsLastPart returns true if the part ends with the last transaction of the original miniblock
+func (pe *PartialExecution) IsLastPart(numTxs int) bool {
+	return pe.IndexOfFirstTx+uint32(numTxs) == pe.OriginalTxCount
 }
 
 // MiniBlockHeader holds the hash of a miniblock together with sender/deastination shard id pair.
@@ -76,6 +93,8 @@ type MiniBlockHeader struct {
 	ReceiverShardID uint32 `capid:"2"`
 	TxCount         uint32 `capid:"3"`
 	Type            Type   `capid:"4"`
+	// PartialExecution is copied from the miniblock, when it holds a part of a cross shard miniblock
+	PartialExecution *PartialExecution `capid:"5" json:",omitempty"`
 }
 
 // PeerChange holds a change in one peer to shard assignation
@@ -103,6 +122,15 @@ type Header struct {
 	RootHash         []byte            `capid:"13"`
 	MetaBlockHashes  [][]byte          `capid:"14"`
 	TxCount          uint32            `capid:"15"`
+	// AccumulatedFees and BurnedFees are set only from the fee burn enable epoch on. They hold the fees paid by the
+	// transactions of the block and the part of them which was destroyed instead of being distributed
+	AccumulatedFees *big.Int `capid:"16" json:",omitempty"`
+	BurnedFees      *big.Int `capid:"17" json:",omitempty"`
+	// GenesisChecksum is set only on the genesis block. It identifies the genesis configuration the chain started from
+	GenesisChecksum []byte `capid:"18" json:",omitempty"`
+	// ChainID and SoftwareVersion identify the chain the header was proposed on and the headers format of its proposer
+	ChainID         []byte `capid:"19" json:",omitempty"`
+	SoftwareVersion []byte `capid:"20" json:",omitempty"`
 }
 
 // Save saves the serialized data of a Block Header into a stream through Capnp protocol
@@ -164,6 +192,11 @@ func HeaderCapnToGo(src capnp.HeaderCapn, dest *Header) *Header {
 	}
 
 	dest.TxCount = src.TxCount()
+	dest.AccumulatedFees = bigIntFromCapnp(src.AccumulatedFees())
+	dest.BurnedFees = bigIntFromCapnp(src.BurnedFees())
+	dest.GenesisChecksum = optionalDataFromCapnp(src.GenesisChecksum())
+	dest.ChainID = optionalDataFromCapnp(src.ChainID())
+	dest.SoftwareVersion = optionalDataFromCapnp(src.SoftwareVersion())
 
 	return dest
 }
@@ -212,6 +245,55 @@ func HeaderGoToCapn(seg *capn.Segment, src *Header) capnp.HeaderCapn {
 	dest.SetMetaHdrHashes(mylist1)
 
 	dest.SetTxCount(src.TxCount)
+	dest.SetAccumulatedFees(bigIntToProto(src.AccumulatedFees))
+	dest.SetBurnedFees(bigIntToProto(src.BurnedFees))
+	dest.SetGenesisChecksum(src.GenesisChecksum)
+	dest.SetChainID(src.ChainID)
+	dest.SetSoftwareVersion(src.SoftwareVersion)
+
+	return dest
+}
+
+// bigIntFromCapnp returns nil for an absent value as capnp does not tell an unset data field from an empty one
+func bigIntFromCapnp(buff []byte) *big.Int {
+	if len(buff) == 0 {
+		return nil
+	}
+
+	return big.NewInt(0).SetBytes(buff)
+}
+
+// optionalDataFromCapnp returns nil for an absent value, for the same reason as bigIntFromCapnp
+func optionalDataFromCapnp(buff []byte) []byte {
+	if len(buff) == 0 {
+		return nil
+	}
+
+	return buff
+}
+
+// PartialExecutionCapnToGo is a helper function to copy fields from a PartialExecutionCapn object to a PartialExecution
+// object. It returns nil if the partial execution was not set
+func PartialExecutionCapnToGo(src capnp.PartialExecutionCapn) *PartialExecution {
+	if capn.Object(src).Type() == capn.TypeNull {
+		return nil
+	}
+
+	return &PartialExecution{
+		OriginalHash:    src.OriginalHash(),
+		OriginalTxCount: src.OriginalTxCount(),
+		IndexOfFirstTx:  src.IndexOfFirstTx(),
+	}
+}
+
+// PartialExecutionGoToCapn is a helper function to copy fields from a PartialExecution object to a PartialExecutionCapn
+// object
+func PartialExecutionGoToCapn(seg *capn.Segment, src *PartialExecution) capnp.PartialExecutionCapn {
+	dest := capnp.AutoNewPartialExecutionCapn(seg)
+
+	dest.SetOriginalHash(src.OriginalHash)
+	dest.SetOriginalTxCount(src.OriginalTxCount)
+	dest.SetIndexOfFirstTx(src.IndexOfFirstTx)
 
 	return dest
 }
@@ -252,6 +334,7 @@ func MiniBlockCapnToGo(src capnp.MiniBlockCapn, dest *MiniBlock) *MiniBlock {
 	dest.ReceiverShardID = src.ReceiverShardID()
 	dest.SenderShardID = src.SenderShardID()
 	dest.Type = Type(src.Type())
+	dest.PartialExecution = PartialExecutionCapnToGo(src.PartialExecution())
 
 	return dest
 }
@@ -268,6 +351,9 @@ func MiniBlockGoToCapn(seg *capn.Segment, src *MiniBlock) capnp.MiniBlockCapn {
 	dest.SetReceiverShardID(src.ReceiverShardID)
 	dest.SetSenderShardID(src.SenderShardID)
 	dest.SetType(uint8(src.Type))
+	if src.PartialExecution != nil {
+		dest.SetPartialExecution(PartialExecutionGoToCapn(seg, src.PartialExecution))
+	}
 
 	return dest
 }
@@ -341,6 +427,7 @@ func MiniBlockHeaderCapnToGo(src capnp.MiniBlockHeaderCapn, dest *MiniBlockHeade
 	dest.SenderShardID = src.SenderShardID()
 	dest.TxCount = src.TxCount()
 	dest.Type = Type(src.Type())
+	dest.PartialExecution = PartialExecutionCapnToGo(src.PartialExecution())
 
 	return dest
 }
@@ -354,6 +441,9 @@ func MiniBlockHeaderGoToCapn(seg *capn.Segment, src *MiniBlockHeader) capnp.Mini
 	dest.SetSenderShardID(src.SenderShardID)
 	dest.SetTxCount(src.TxCount)
 	dest.SetType(uint8(src.Type))
+	if src.PartialExecution != nil {
+		dest.SetPartialExecution(PartialExecutionGoToCapn(seg, src.PartialExecution))
+	}
 
 	return dest
 }
@@ -418,6 +508,16 @@ func (h *Header) GetTxCount() uint32 {
 	return h.TxCount
 }
 
+// GetChainID returns the chain ID the header was proposed on
+func (h *Header) GetChainID() []byte {
+	return h.ChainID
+}
+
+// GetSoftwareVersion returns the headers format version of the header's proposer
+func (h *Header) GetSoftwareVersion() []byte {
+	return h.SoftwareVersion
+}
+
 // SetNonce sets header nonce
 func (h *Header) SetNonce(n uint64) {
 	h.Nonce = n
@@ -473,6 +573,16 @@ func (h *Header) SetTxCount(txCount uint32) {
 	h.TxCount = txCount
 }
 
+// SetChainID sets the chain ID the header is proposed on
+func (h *Header) SetChainID(chainID []byte) {
+	h.ChainID = chainID
+}
+
+// SetSoftwareVersion sets the headers format version of the header's proposer
+func (h *Header) SetSoftwareVersion(version []byte) {
+	h.SoftwareVersion = version
+}
+
 // GetMiniBlockHeadersWithDst as a map of hashes and sender IDs
 func (h *Header) GetMiniBlockHeadersWithDst(destId uint32) map[string]uint32 {
 	hashDst := make(map[string]uint32, 0)
@@ -0,0 +1,333 @@
+package block
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	protobuf "github.com/ElrondNetwork/elrond-go/data/block/proto"
+)
+
+// MarshalProto serializes the miniblock through its gogo protobuf message
+func (mb *MiniBlock) MarshalProto() ([]byte, error) {
+	return miniBlockToProto(mb).Marshal()
+}
+
+// UnmarshalProto loads the miniblock from the data serialized through its gogo protobuf message
+func (mb *MiniBlock) UnmarshalProto(buff []byte) error {
+	pmb := &protobuf.MiniBlock{}
+	err := pmb.Unmarshal(buff)
+	if err != nil {
+		return err
+	}
+
+	*mb = *miniBlockFromProto(pmb)
+	return nil
+}
+
+// MarshalProto serializes the block body through its gogo protobuf message
+func (b Body) MarshalProto() ([]byte, error) {
+	pb := &protobuf.Body{
+		MiniBlocks: make([]*protobuf.MiniBlock, 0, len(b)),
+	}
+	for _, mb := range b {
+		pb.MiniBlocks = append(pb.MiniBlocks, miniBlockToProto(mb))
+	}
+
+	return pb.Marshal()
+}
+
+// UnmarshalProto loads the block body from the data serialized through its gogo protobuf message
+func (b *Body) UnmarshalProto(buff []byte) error {
+	pb := &protobuf.Body{}
+	err := pb.Unmarshal(buff)
+	if err != nil {
+		return err
+	}
+
+	body := make(Body, 0, len(pb.MiniBlocks))
+	for _, pmb := range pb.MiniBlocks {
+		body = append(body, miniBlockFromProto(pmb))
+	}
+
+	*b = body
+	return nil
+}
+
+// MarshalProto serializes the header through its gogo protobuf message
+func (h *Header) MarshalProto() ([]byte, error) {
+	ph := &protobuf.Header{
+		Nonce:            h.Nonce,
+		PrevHash:         h.PrevHash,
+		PrevRandSeed:     h.PrevRandSeed,
+		RandSeed:         h.RandSeed,
+		PubKeysBitmap:    h.PubKeysBitmap,
+		ShardId:          h.ShardId,
+		TimeStamp:        h.TimeStamp,
+		Round:            h.Round,
+		Epoch:            h.Epoch,
+		BlockBodyType:    uint32(h.BlockBodyType),
+		Signature:        h.Signature,
+		MiniBlockHeaders: make([]*protobuf.MiniBlockHeader, 0, len(h.MiniBlockHeaders)),
+		PeerChanges:      make([]*protobuf.PeerChange, 0, len(h.PeerChanges)),
+		RootHash:         h.RootHash,
+		MetaBlockHashes:  h.MetaBlockHashes,
+		TxCount:          h.TxCount,
+		AccumulatedFees:  bigIntToProto(h.AccumulatedFees),
+		BurnedFees:       bigIntToProto(h.BurnedFees),
+		GenesisChecksum:  h.GenesisChecksum,
+		ChainID:          h.ChainID,
+		SoftwareVersion:  h.SoftwareVersion,
+	}
+	for _, mbh := range h.MiniBlockHeaders {
+		ph.MiniBlockHeaders = append(ph.MiniBlockHeaders, &protobuf.MiniBlockHeader{
+			Hash:             mbh.Hash,
+			SenderShardID:    mbh.SenderShardID,
+			ReceiverShardID:  mbh.ReceiverShardID,
+			TxCount:          mbh.TxCount,
+			Type:             uint32(mbh.Type),
+			PartialExecution: partialExecutionToProto(mbh.PartialExecution),
+		})
+	}
+	for _, pc := range h.PeerChanges {
+		ph.PeerChanges = append(ph.PeerChanges, &protobuf.PeerChange{
+			PubKey:      pc.PubKey,
+			ShardIdDest: pc.ShardIdDest,
+		})
+	}
+
+	return ph.Marshal()
+}
+
+// UnmarshalProto loads the header from the data serialized through its gogo protobuf message
+func (h *Header) UnmarshalProto(buff []byte) error {
+	ph := &protobuf.Header{}
+	err := ph.Unmarshal(buff)
+	if err != nil {
+		return err
+	}
+
+	*h = Header{
+		Nonce:           ph.Nonce,
+		PrevHash:        ph.PrevHash,
+		PrevRandSeed:    ph.PrevRandSeed,
+		RandSeed:        ph.RandSeed,
+		PubKeysBitmap:   ph.PubKeysBitmap,
+		ShardId:         ph.ShardId,
+		TimeStamp:       ph.TimeStamp,
+		Round:           ph.Round,
+		Epoch:           ph.Epoch,
+		BlockBodyType:   Type(ph.BlockBodyType),
+		Signature:       ph.Signature,
+		RootHash:        ph.RootHash,
+		MetaBlockHashes: ph.MetaBlockHashes,
+		TxCount:         ph.TxCount,
+		AccumulatedFees: bigIntFromProto(ph.AccumulatedFees),
+		BurnedFees:      bigIntFromProto(ph.BurnedFees),
+		GenesisChecksum: ph.GenesisChecksum,
+		ChainID:         ph.ChainID,
+		SoftwareVersion: ph.SoftwareVersion,
+	}
+	if len(ph.MiniBlockHeaders) > 0 {
+		h.MiniBlockHeaders = make([]MiniBlockHeader, 0, len(ph.MiniBlockHeaders))
+	}
+	for _, pmbh := range ph.MiniBlockHeaders {
+		h.MiniBlockHeaders = append(h.MiniBlockHeaders, MiniBlockHeader{
+			Hash:             pmbh.Hash,
+			SenderShardID:    pmbh.SenderShardID,
+			ReceiverShardID:  pmbh.ReceiverShardID,
+			TxCount:          pmbh.TxCount,
+			Type:             Type(pmbh.Type),
+			PartialExecution: partialExecutionFromProto(pmbh.PartialExecution),
+		})
+	}
+	if len(ph.PeerChanges) > 0 {
+		h.PeerChanges = make([]PeerChange, 0, len(ph.PeerChanges))
+	}
+	for _, ppc := range ph.PeerChanges {
+		h.PeerChanges = append(h.PeerChanges, PeerChange{
+			PubKey:      ppc.PubKey,
+			ShardIdDest: ppc.ShardIdDest,
+		})
+	}
+
+	return nil
+}
+
+// MarshalProto serializes the metablock through its gogo protobuf message
+func (m *MetaBlock) MarshalProto() ([]byte, error) {
+	pm := &protobuf.MetaBlock{
+		Nonce:           m.Nonce,
+		Epoch:           m.Epoch,
+		Round:           m.Round,
+		TimeStamp:       m.TimeStamp,
+		ShardInfo:       make([]*protobuf.ShardData, 0, len(m.ShardInfo)),
+		PeerInfo:        make([]*protobuf.PeerData, 0, len(m.PeerInfo)),
+		Signature:       m.Signature,
+		PubKeysBitmap:   m.PubKeysBitmap,
+		PrevHash:        m.PrevHash,
+		PrevRandSeed:    m.PrevRandSeed,
+		RandSeed:        m.RandSeed,
+		RootHash:        m.RootHash,
+		TxCount:         m.TxCount,
+		ChainID:         m.ChainID,
+		SoftwareVersion: m.SoftwareVersion,
+	}
+	for _, sd := range m.ShardInfo {
+		psd := &protobuf.ShardData{
+			ShardId:               sd.ShardId,
+			HeaderHash:            sd.HeaderHash,
+			ShardMiniBlockHeaders: make([]*protobuf.ShardMiniBlockHeader, 0, len(sd.ShardMiniBlockHeaders)),
+			TxCount:               sd.TxCount,
+		}
+		for _, smbh := range sd.ShardMiniBlockHeaders {
+			psd.ShardMiniBlockHeaders = append(psd.ShardMiniBlockHeaders, &protobuf.ShardMiniBlockHeader{
+				Hash:            smbh.Hash,
+				ReceiverShardId: smbh.ReceiverShardId,
+				SenderShardId:   smbh.SenderShardId,
+				TxCount:         smbh.TxCount,
+			})
+		}
+		pm.ShardInfo = append(pm.ShardInfo, psd)
+	}
+	for _, pd := range m.PeerInfo {
+		value, err := data.BigIntToProtoBytes(pd.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		pm.PeerInfo = append(pm.PeerInfo, &protobuf.PeerData{
+			PublicKey: pd.PublicKey,
+			Action:    uint32(pd.Action),
+			TimeStamp: pd.TimeStamp,
+			Value:     value,
+		})
+	}
+
+	return pm.Marshal()
+}
+
+// UnmarshalProto loads the metablock from the data serialized through its gogo protobuf message
+func (m *MetaBlock) UnmarshalProto(buff []byte) error {
+	pm := &protobuf.MetaBlock{}
+	err := pm.Unmarshal(buff)
+	if err != nil {
+		return err
+	}
+
+	metaBlock := MetaBlock{
+		Nonce:           pm.Nonce,
+		Epoch:           pm.Epoch,
+		Round:           pm.Round,
+		TimeStamp:       pm.TimeStamp,
+		Signature:       pm.Signature,
+		PubKeysBitmap:   pm.PubKeysBitmap,
+		PrevHash:        pm.PrevHash,
+		PrevRandSeed:    pm.PrevRandSeed,
+		RandSeed:        pm.RandSeed,
+		RootHash:        pm.RootHash,
+		TxCount:         pm.TxCount,
+		ChainID:         pm.ChainID,
+		SoftwareVersion: pm.SoftwareVersion,
+	}
+	if len(pm.ShardInfo) > 0 {
+		metaBlock.ShardInfo = make([]ShardData, 0, len(pm.ShardInfo))
+	}
+	for _, psd := range pm.ShardInfo {
+		sd := ShardData{
+			ShardId:    psd.ShardId,
+			HeaderHash: psd.HeaderHash,
+			TxCount:    psd.TxCount,
+		}
+		if len(psd.ShardMiniBlockHeaders) > 0 {
+			sd.ShardMiniBlockHeaders = make([]ShardMiniBlockHeader, 0, len(psd.ShardMiniBlockHeaders))
+		}
+		for _, psmbh := range psd.ShardMiniBlockHeaders {
+			sd.ShardMiniBlockHeaders = append(sd.ShardMiniBlockHeaders, ShardMiniBlockHeader{
+				Hash:            psmbh.Hash,
+				ReceiverShardId: psmbh.ReceiverShardId,
+				SenderShardId:   psmbh.SenderShardId,
+				TxCount:         psmbh.TxCount,
+			})
+		}
+		metaBlock.ShardInfo = append(metaBlock.ShardInfo, sd)
+	}
+	if len(pm.PeerInfo) > 0 {
+		metaBlock.PeerInfo = make([]PeerData, 0, len(pm.PeerInfo))
+	}
+	for _, ppd := range pm.PeerInfo {
+		value, errDecode := data.BigIntFromProtoBytes(ppd.Value)
+		if errDecode != nil {
+			return errDecode
+		}
+
+		metaBlock.PeerInfo = append(metaBlock.PeerInfo, PeerData{
+			PublicKey: ppd.PublicKey,
+			Action:    PeerAction(ppd.Action),
+			TimeStamp: ppd.TimeStamp,
+			Value:     value,
+		})
+	}
+
+	*m = metaBlock
+	return nil
+}
+
+func miniBlockToProto(mb *MiniBlock) *protobuf.MiniBlock {
+	return &protobuf.MiniBlock{
+		TxHashes:         mb.TxHashes,
+		ReceiverShardID:  mb.ReceiverShardID,
+		SenderShardID:    mb.SenderShardID,
+		Type:             uint32(mb.Type),
+		PartialExecution: partialExecutionToProto(mb.PartialExecution),
+	}
+}
+
+func miniBlockFromProto(pmb *protobuf.MiniBlock) *MiniBlock {
+	return &MiniBlock{
+		TxHashes:         pmb.TxHashes,
+		ReceiverShardID:  pmb.ReceiverShardID,
+		SenderShardID:    pmb.SenderShardID,
+		Type:             Type(pmb.Type),
+		PartialExecution: partialExecutionFromProto(pmb.PartialExecution),
+	}
+}
+
+func partialExecutionToProto(pe *PartialExecution) *protobuf.PartialExecution {
+	if pe == nil {
+		return nil
+	}
+
+	return &protobuf.PartialExecution{
+		OriginalHash:    pe.OriginalHash,
+		OriginalTxCount: pe.OriginalTxCount,
+		IndexOfFirstTx:  pe.IndexOfFirstTx,
+	}
+}
+
+func partialExecutionFromProto(ppe *protobuf.PartialExecution) *PartialExecution {
+	if ppe == nil {
+		return nil
+	}
+
+	return &PartialExecution{
+		OriginalHash:    ppe.OriginalHash,
+		OriginalTxCount: ppe.OriginalTxCount,
+		IndexOfFirstTx:  ppe.IndexOfFirstTx,
+	}
+}
+
+func bigIntToProto(value *big.Int) []byte {
+	if value == nil {
+		return nil
+	}
+
+	return value.Bytes()
+}
+
+func bigIntFromProto(buff []byte) *big.Int {
+	if buff == nil {
+		return nil
+	}
+
+	return big.NewInt(0).SetBytes(buff)
+}
@@ -2,6 +2,7 @@ package block_test
 
 import (
 	"bytes"
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -41,6 +42,8 @@ func TestHeader_SaveLoad(t *testing.T) {
 		RootHash:         []byte("root hash"),
 		MetaBlockHashes:  make([][]byte, 0),
 		TxCount:          uint32(10),
+		ChainID:          []byte("chain ID"),
+		SoftwareVersion:  []byte("1"),
 	}
 
 	var b bytes.Buffer
@@ -52,6 +55,28 @@ func TestHeader_SaveLoad(t *testing.T) {
 	assert.Equal(t, loadHeader, h)
 }
 
+func TestHeader_SaveLoadShouldKeepTheFeesAndTheGenesisChecksum(t *testing.T) {
+	t.Parallel()
+
+	h := block.Header{
+		Nonce:           uint64(1),
+		MetaBlockHashes: make([][]byte, 0),
+		AccumulatedFees: big.NewInt(1000),
+		BurnedFees:      big.NewInt(300),
+		GenesisChecksum: []byte("genesis checksum"),
+	}
+
+	var b bytes.Buffer
+	_ = h.Save(&b)
+
+	loadHeader := block.Header{}
+	_ = loadHeader.Load(&b)
+
+	assert.Equal(t, h.AccumulatedFees, loadHeader.AccumulatedFees)
+	assert.Equal(t, h.BurnedFees, loadHeader.BurnedFees)
+	assert.Equal(t, h.GenesisChecksum, loadHeader.GenesisChecksum)
+}
+
 func TestPeerChange_SaveLoad(t *testing.T) {
 	t.Parallel()
 
@@ -87,6 +112,29 @@ func TestMiniBlockHeader_SaveLoad(t *testing.T) {
 	assert.Equal(t, loadMbh, mbh)
 }
 
+func TestMiniBlockHeader_SaveLoadWithPartialExecution(t *testing.T) {
+	t.Parallel()
+
+	mbh := block.MiniBlockHeader{
+		Hash:            []byte("mini block hash"),
+		SenderShardID:   uint32(1),
+		ReceiverShardID: uint32(0),
+		PartialExecution: &block.PartialExecution{
+			OriginalHash:    []byte("original hash"),
+			OriginalTxCount: 10,
+			IndexOfFirstTx:  4,
+		},
+	}
+
+	var b bytes.Buffer
+	_ = mbh.Save(&b)
+
+	loadMbh := block.MiniBlockHeader{}
+	_ = loadMbh.Load(&b)
+
+	assert.Equal(t, loadMbh, mbh)
+}
+
 func TestMiniBlock_SaveLoad(t *testing.T) {
 	t.Parallel()
 
@@ -105,6 +153,29 @@ func TestMiniBlock_SaveLoad(t *testing.T) {
 	assert.Equal(t, loadMb, mb)
 }
 
+func TestMiniBlock_SaveLoadWithPartialExecution(t *testing.T) {
+	t.Parallel()
+
+	mb := block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx hash1"), []byte("tx hash2")},
+		ReceiverShardID: uint32(0),
+		SenderShardID:   uint32(1),
+		PartialExecution: &block.PartialExecution{
+			OriginalHash:    []byte("original hash"),
+			OriginalTxCount: 5,
+			IndexOfFirstTx:  3,
+		},
+	}
+
+	var b bytes.Buffer
+	_ = mb.Save(&b)
+
+	loadMb := block.MiniBlock{}
+	_ = loadMb.Load(&b)
+
+	assert.Equal(t, loadMb, mb)
+}
+
 func TestHeader_GetEpoch(t *testing.T) {
 	t.Parallel()
 
@@ -415,3 +486,16 @@ func TestHeader_GetMiniBlockHeadersWithDstShouldWork(t *testing.T) {
 	assert.Equal(t, uint32(0), hashesWithDest2[string(hash1_0_2)])
 	assert.Equal(t, uint32(0), hashesWithDest2[string(hash2_0_2)])
 }
+
+func TestPartialExecution_IsLastPart(t *testing.T) {
+	t.Parallel()
+
+	pe := &block.PartialExecution{
+		OriginalHash:    []byte("original"),
+		OriginalTxCount: 10,
+		IndexOfFirstTx:  6,
+	}
+
+	assert.False(t, pe.IsLastPart(3))
+	assert.True(t, pe.IsLastPart(4))
+}
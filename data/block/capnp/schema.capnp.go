@@ -12,9 +12,9 @@ import (
 
 type HeaderCapn C.Struct
 
-func NewHeaderCapn(s *C.Segment) HeaderCapn      { return HeaderCapn(s.NewStruct(40, 9)) }
-func NewRootHeaderCapn(s *C.Segment) HeaderCapn  { return HeaderCapn(s.NewRootStruct(40, 9)) }
-func AutoNewHeaderCapn(s *C.Segment) HeaderCapn  { return HeaderCapn(s.NewStructAR(40, 9)) }
+func NewHeaderCapn(s *C.Segment) HeaderCapn      { return HeaderCapn(s.NewStruct(40, 14)) }
+func NewRootHeaderCapn(s *C.Segment) HeaderCapn  { return HeaderCapn(s.NewRootStruct(40, 14)) }
+func AutoNewHeaderCapn(s *C.Segment) HeaderCapn  { return HeaderCapn(s.NewStructAR(40, 14)) }
 func ReadRootHeaderCapn(s *C.Segment) HeaderCapn { return HeaderCapn(s.Root(0).ToStruct()) }
 func (s HeaderCapn) Nonce() uint64               { return C.Struct(s).Get64(0) }
 func (s HeaderCapn) SetNonce(v uint64)           { C.Struct(s).Set64(0, v) }
@@ -54,6 +54,16 @@ func (s HeaderCapn) MetaHdrHashes() C.DataList            { return C.DataList(C.
 func (s HeaderCapn) SetMetaHdrHashes(v C.DataList)        { C.Struct(s).SetObject(8, C.Object(v)) }
 func (s HeaderCapn) TxCount() uint32                      { return C.Struct(s).Get32(36) }
 func (s HeaderCapn) SetTxCount(v uint32)                  { C.Struct(s).Set32(36, v) }
+func (s HeaderCapn) AccumulatedFees() []byte              { return C.Struct(s).GetObject(9).ToData() }
+func (s HeaderCapn) SetAccumulatedFees(v []byte)          { C.Struct(s).SetObject(9, s.Segment.NewData(v)) }
+func (s HeaderCapn) BurnedFees() []byte                   { return C.Struct(s).GetObject(10).ToData() }
+func (s HeaderCapn) SetBurnedFees(v []byte)               { C.Struct(s).SetObject(10, s.Segment.NewData(v)) }
+func (s HeaderCapn) GenesisChecksum() []byte              { return C.Struct(s).GetObject(11).ToData() }
+func (s HeaderCapn) SetGenesisChecksum(v []byte)          { C.Struct(s).SetObject(11, s.Segment.NewData(v)) }
+func (s HeaderCapn) ChainID() []byte                      { return C.Struct(s).GetObject(12).ToData() }
+func (s HeaderCapn) SetChainID(v []byte)                  { C.Struct(s).SetObject(12, s.Segment.NewData(v)) }
+func (s HeaderCapn) SoftwareVersion() []byte              { return C.Struct(s).GetObject(13).ToData() }
+func (s HeaderCapn) SetSoftwareVersion(v []byte)          { C.Struct(s).SetObject(13, s.Segment.NewData(v)) }
 func (s HeaderCapn) WriteJSON(w io.Writer) error {
 	b := bufio.NewWriter(w)
 	var err error
@@ -409,6 +419,101 @@ func (s HeaderCapn) WriteJSON(w io.Writer) error {
 			return err
 		}
 	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"accumulatedFees\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.AccumulatedFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"burnedFees\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.BurnedFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"genesisChecksum\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.GenesisChecksum()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"chainID\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.ChainID()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"softwareVersion\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.SoftwareVersion()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte('}')
 	if err != nil {
 		return err
@@ -776,6 +881,101 @@ func (s HeaderCapn) WriteCapLit(w io.Writer) error {
 			return err
 		}
 	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("accumulatedFees = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.AccumulatedFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("burnedFees = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.BurnedFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("genesisChecksum = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.GenesisChecksum()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("chainID = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.ChainID()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("softwareVersion = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.SoftwareVersion()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte(')')
 	if err != nil {
 		return err
@@ -792,7 +992,7 @@ func (s HeaderCapn) MarshalCapLit() ([]byte, error) {
 type HeaderCapn_List C.PointerList
 
 func NewHeaderCapnList(s *C.Segment, sz int) HeaderCapn_List {
-	return HeaderCapn_List(s.NewCompositeList(40, 9, sz))
+	return HeaderCapn_List(s.NewCompositeList(40, 14, sz))
 }
 func (s HeaderCapn_List) Len() int            { return C.PointerList(s).Len() }
 func (s HeaderCapn_List) At(i int) HeaderCapn { return HeaderCapn(C.PointerList(s).At(i).ToStruct()) }
@@ -809,13 +1009,13 @@ func (s HeaderCapn_List) Set(i int, item HeaderCapn) { C.PointerList(s).Set(i, C
 type MiniBlockHeaderCapn C.Struct
 
 func NewMiniBlockHeaderCapn(s *C.Segment) MiniBlockHeaderCapn {
-	return MiniBlockHeaderCapn(s.NewStruct(16, 1))
+	return MiniBlockHeaderCapn(s.NewStruct(16, 2))
 }
 func NewRootMiniBlockHeaderCapn(s *C.Segment) MiniBlockHeaderCapn {
-	return MiniBlockHeaderCapn(s.NewRootStruct(16, 1))
+	return MiniBlockHeaderCapn(s.NewRootStruct(16, 2))
 }
 func AutoNewMiniBlockHeaderCapn(s *C.Segment) MiniBlockHeaderCapn {
-	return MiniBlockHeaderCapn(s.NewStructAR(16, 1))
+	return MiniBlockHeaderCapn(s.NewStructAR(16, 2))
 }
 func ReadRootMiniBlockHeaderCapn(s *C.Segment) MiniBlockHeaderCapn {
 	return MiniBlockHeaderCapn(s.Root(0).ToStruct())
@@ -830,6 +1030,12 @@ func (s MiniBlockHeaderCapn) TxCount() uint32             { return C.Struct(s).G
 func (s MiniBlockHeaderCapn) SetTxCount(v uint32)         { C.Struct(s).Set32(8, v) }
 func (s MiniBlockHeaderCapn) Type() uint8                 { return C.Struct(s).Get8(12) }
 func (s MiniBlockHeaderCapn) SetType(v uint8)             { C.Struct(s).Set8(12, v) }
+func (s MiniBlockHeaderCapn) PartialExecution() PartialExecutionCapn {
+	return PartialExecutionCapn(C.Struct(s).GetObject(1).ToStruct())
+}
+func (s MiniBlockHeaderCapn) SetPartialExecution(v PartialExecutionCapn) {
+	C.Struct(s).SetObject(1, C.Object(v))
+}
 func (s MiniBlockHeaderCapn) WriteJSON(w io.Writer) error {
 	b := bufio.NewWriter(w)
 	var err error
@@ -930,6 +1136,21 @@ func (s MiniBlockHeaderCapn) WriteJSON(w io.Writer) error {
 			return err
 		}
 	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"partialExecution\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.PartialExecution()
+		err = s.WriteJSON(b)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte('}')
 	if err != nil {
 		return err
@@ -1042,6 +1263,21 @@ func (s MiniBlockHeaderCapn) WriteCapLit(w io.Writer) error {
 			return err
 		}
 	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("partialExecution = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.PartialExecution()
+		err = s.WriteCapLit(b)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte(')')
 	if err != nil {
 		return err
@@ -1058,7 +1294,7 @@ func (s MiniBlockHeaderCapn) MarshalCapLit() ([]byte, error) {
 type MiniBlockHeaderCapn_List C.PointerList
 
 func NewMiniBlockHeaderCapnList(s *C.Segment, sz int) MiniBlockHeaderCapn_List {
-	return MiniBlockHeaderCapn_List(s.NewCompositeList(16, 1, sz))
+	return MiniBlockHeaderCapn_List(s.NewCompositeList(16, 2, sz))
 }
 func (s MiniBlockHeaderCapn_List) Len() int { return C.PointerList(s).Len() }
 func (s MiniBlockHeaderCapn_List) At(i int) MiniBlockHeaderCapn {
@@ -1078,9 +1314,9 @@ func (s MiniBlockHeaderCapn_List) Set(i int, item MiniBlockHeaderCapn) {
 
 type MiniBlockCapn C.Struct
 
-func NewMiniBlockCapn(s *C.Segment) MiniBlockCapn      { return MiniBlockCapn(s.NewStruct(16, 1)) }
-func NewRootMiniBlockCapn(s *C.Segment) MiniBlockCapn  { return MiniBlockCapn(s.NewRootStruct(16, 1)) }
-func AutoNewMiniBlockCapn(s *C.Segment) MiniBlockCapn  { return MiniBlockCapn(s.NewStructAR(16, 1)) }
+func NewMiniBlockCapn(s *C.Segment) MiniBlockCapn      { return MiniBlockCapn(s.NewStruct(16, 2)) }
+func NewRootMiniBlockCapn(s *C.Segment) MiniBlockCapn  { return MiniBlockCapn(s.NewRootStruct(16, 2)) }
+func AutoNewMiniBlockCapn(s *C.Segment) MiniBlockCapn  { return MiniBlockCapn(s.NewStructAR(16, 2)) }
 func ReadRootMiniBlockCapn(s *C.Segment) MiniBlockCapn { return MiniBlockCapn(s.Root(0).ToStruct()) }
 func (s MiniBlockCapn) TxHashes() C.DataList           { return C.DataList(C.Struct(s).GetObject(0)) }
 func (s MiniBlockCapn) SetTxHashes(v C.DataList)       { C.Struct(s).SetObject(0, C.Object(v)) }
@@ -1090,6 +1326,12 @@ func (s MiniBlockCapn) SenderShardID() uint32          { return C.Struct(s).Get3
 func (s MiniBlockCapn) SetSenderShardID(v uint32)      { C.Struct(s).Set32(4, v) }
 func (s MiniBlockCapn) Type() uint8                    { return C.Struct(s).Get8(8) }
 func (s MiniBlockCapn) SetType(v uint8)                { C.Struct(s).Set8(8, v) }
+func (s MiniBlockCapn) PartialExecution() PartialExecutionCapn {
+	return PartialExecutionCapn(C.Struct(s).GetObject(1).ToStruct())
+}
+func (s MiniBlockCapn) SetPartialExecution(v PartialExecutionCapn) {
+	C.Struct(s).SetObject(1, C.Object(v))
+}
 func (s MiniBlockCapn) WriteJSON(w io.Writer) error {
 	b := bufio.NewWriter(w)
 	var err error
@@ -1189,6 +1431,21 @@ func (s MiniBlockCapn) WriteJSON(w io.Writer) error {
 			return err
 		}
 	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"partialExecution\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.PartialExecution()
+		err = s.WriteJSON(b)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte('}')
 	if err != nil {
 		return err
@@ -1300,6 +1557,21 @@ func (s MiniBlockCapn) WriteCapLit(w io.Writer) error {
 			return err
 		}
 	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("partialExecution = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.PartialExecution()
+		err = s.WriteCapLit(b)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte(')')
 	if err != nil {
 		return err
@@ -1316,7 +1588,7 @@ func (s MiniBlockCapn) MarshalCapLit() ([]byte, error) {
 type MiniBlockCapn_List C.PointerList
 
 func NewMiniBlockCapnList(s *C.Segment, sz int) MiniBlockCapn_List {
-	return MiniBlockCapn_List(s.NewCompositeList(16, 1, sz))
+	return MiniBlockCapn_List(s.NewCompositeList(16, 2, sz))
 }
 func (s MiniBlockCapn_List) Len() int { return C.PointerList(s).Len() }
 func (s MiniBlockCapn_List) At(i int) MiniBlockCapn {
@@ -1332,6 +1604,198 @@ func (s MiniBlockCapn_List) ToArray() []MiniBlockCapn {
 }
 func (s MiniBlockCapn_List) Set(i int, item MiniBlockCapn) { C.PointerList(s).Set(i, C.Object(item)) }
 
+type PartialExecutionCapn C.Struct
+
+func NewPartialExecutionCapn(s *C.Segment) PartialExecutionCapn {
+	return PartialExecutionCapn(s.NewStruct(8, 1))
+}
+func NewRootPartialExecutionCapn(s *C.Segment) PartialExecutionCapn {
+	return PartialExecutionCapn(s.NewRootStruct(8, 1))
+}
+func AutoNewPartialExecutionCapn(s *C.Segment) PartialExecutionCapn {
+	return PartialExecutionCapn(s.NewStructAR(8, 1))
+}
+func ReadRootPartialExecutionCapn(s *C.Segment) PartialExecutionCapn {
+	return PartialExecutionCapn(s.Root(0).ToStruct())
+}
+func (s PartialExecutionCapn) OriginalHash() []byte { return C.Struct(s).GetObject(0).ToData() }
+func (s PartialExecutionCapn) SetOriginalHash(v []byte) {
+	C.Struct(s).SetObject(0, s.Segment.NewData(v))
+}
+func (s PartialExecutionCapn) OriginalTxCount() uint32     { return C.Struct(s).Get32(0) }
+func (s PartialExecutionCapn) SetOriginalTxCount(v uint32) { C.Struct(s).Set32(0, v) }
+func (s PartialExecutionCapn) IndexOfFirstTx() uint32      { return C.Struct(s).Get32(4) }
+func (s PartialExecutionCapn) SetIndexOfFirstTx(v uint32)  { C.Struct(s).Set32(4, v) }
+func (s PartialExecutionCapn) WriteJSON(w io.Writer) error {
+	b := bufio.NewWriter(w)
+	var err error
+	var buf []byte
+	_ = buf
+	err = b.WriteByte('{')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"originalHash\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.OriginalHash()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"originalTxCount\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.OriginalTxCount()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"indexOfFirstTx\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.IndexOfFirstTx()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte('}')
+	if err != nil {
+		return err
+	}
+	err = b.Flush()
+	return err
+}
+func (s PartialExecutionCapn) MarshalJSON() ([]byte, error) {
+	b := bytes.Buffer{}
+	err := s.WriteJSON(&b)
+	return b.Bytes(), err
+}
+func (s PartialExecutionCapn) WriteCapLit(w io.Writer) error {
+	b := bufio.NewWriter(w)
+	var err error
+	var buf []byte
+	_ = buf
+	err = b.WriteByte('(')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("originalHash = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.OriginalHash()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("originalTxCount = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.OriginalTxCount()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("indexOfFirstTx = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.IndexOfFirstTx()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(')')
+	if err != nil {
+		return err
+	}
+	err = b.Flush()
+	return err
+}
+func (s PartialExecutionCapn) MarshalCapLit() ([]byte, error) {
+	b := bytes.Buffer{}
+	err := s.WriteCapLit(&b)
+	return b.Bytes(), err
+}
+
+type PartialExecutionCapn_List C.PointerList
+
+func NewPartialExecutionCapnList(s *C.Segment, sz int) PartialExecutionCapn_List {
+	return PartialExecutionCapn_List(s.NewCompositeList(8, 1, sz))
+}
+func (s PartialExecutionCapn_List) Len() int { return C.PointerList(s).Len() }
+func (s PartialExecutionCapn_List) At(i int) PartialExecutionCapn {
+	return PartialExecutionCapn(C.PointerList(s).At(i).ToStruct())
+}
+func (s PartialExecutionCapn_List) ToArray() []PartialExecutionCapn {
+	n := s.Len()
+	a := make([]PartialExecutionCapn, n)
+	for i := 0; i < n; i++ {
+		a[i] = s.At(i)
+	}
+	return a
+}
+func (s PartialExecutionCapn_List) Set(i int, item PartialExecutionCapn) {
+	C.PointerList(s).Set(i, C.Object(item))
+}
+
 type PeerChangeCapn C.Struct
 
 func NewPeerChangeCapn(s *C.Segment) PeerChangeCapn      { return PeerChangeCapn(s.NewStruct(8, 1)) }
@@ -71,6 +71,9 @@ type MetaBlock struct {
 	RandSeed      []byte      `capid:"10"`
 	RootHash      []byte      `capid:"11"`
 	TxCount       uint32      `capid:"12"`
+	// ChainID and SoftwareVersion identify the chain the header was proposed on and the headers format of its proposer
+	ChainID         []byte `capid:"13" json:",omitempty"`
+	SoftwareVersion []byte `capid:"14" json:",omitempty"`
 }
 
 // MetaBlockBody hold the data for metablock body
@@ -267,6 +270,8 @@ func MetaBlockGoToCapn(seg *capn.Segment, src *MetaBlock) capnp.MetaBlockCapn {
 	dest.SetRandSeed(src.RandSeed)
 	dest.SetRootHash(src.RootHash)
 	dest.SetTxCount(src.TxCount)
+	dest.SetChainID(src.ChainID)
+	dest.SetSoftwareVersion(src.SoftwareVersion)
 
 	return dest
 }
@@ -298,6 +303,8 @@ func MetaBlockCapnToGo(src capnp.MetaBlockCapn, dest *MetaBlock) *MetaBlock {
 	dest.RandSeed = src.RandSeed()
 	dest.RootHash = src.RootHash()
 	dest.TxCount = src.TxCount()
+	dest.ChainID = optionalDataFromCapnp(src.ChainID())
+	dest.SoftwareVersion = optionalDataFromCapnp(src.SoftwareVersion())
 
 	return dest
 }
@@ -362,6 +369,16 @@ func (m *MetaBlock) GetTxCount() uint32 {
 	return m.TxCount
 }
 
+// GetChainID returns the chain ID the meta block was proposed on
+func (m *MetaBlock) GetChainID() []byte {
+	return m.ChainID
+}
+
+// GetSoftwareVersion returns the headers format version of the meta block's proposer
+func (m *MetaBlock) GetSoftwareVersion() []byte {
+	return m.SoftwareVersion
+}
+
 // SetNonce sets header nonce
 func (m *MetaBlock) SetNonce(n uint64) {
 	m.Nonce = n
@@ -417,6 +434,16 @@ func (m *MetaBlock) SetTxCount(txCount uint32) {
 	m.TxCount = txCount
 }
 
+// SetChainID sets the chain ID the meta block is proposed on
+func (m *MetaBlock) SetChainID(chainID []byte) {
+	m.ChainID = chainID
+}
+
+// SetSoftwareVersion sets the headers format version of the meta block's proposer
+func (m *MetaBlock) SetSoftwareVersion(version []byte) {
+	m.SoftwareVersion = version
+}
+
 // GetMiniBlockHeadersWithDst as a map of hashes and sender IDs
 func (m *MetaBlock) GetMiniBlockHeadersWithDst(destId uint32) map[string]uint32 {
 	hashDst := make(map[string]uint32, 0)
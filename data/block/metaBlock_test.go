@@ -74,19 +74,21 @@ func TestMetaBlock_SaveLoad(t *testing.T) {
 	}
 
 	mb := block.MetaBlock{
-		Nonce:         uint64(1),
-		Epoch:         uint32(1),
-		Round:         uint64(1),
-		TimeStamp:     uint64(100000),
-		ShardInfo:     []block.ShardData{sd},
-		PeerInfo:      []block.PeerData{pd},
-		Signature:     []byte("signature"),
-		PubKeysBitmap: []byte("pub keys"),
-		PrevHash:      []byte("previous hash"),
-		PrevRandSeed:  []byte("previous random seed"),
-		RandSeed:      []byte("random seed"),
-		RootHash:      []byte("root hash"),
-		TxCount:       uint32(1),
+		Nonce:           uint64(1),
+		Epoch:           uint32(1),
+		Round:           uint64(1),
+		TimeStamp:       uint64(100000),
+		ShardInfo:       []block.ShardData{sd},
+		PeerInfo:        []block.PeerData{pd},
+		Signature:       []byte("signature"),
+		PubKeysBitmap:   []byte("pub keys"),
+		PrevHash:        []byte("previous hash"),
+		PrevRandSeed:    []byte("previous random seed"),
+		RandSeed:        []byte("random seed"),
+		RootHash:        []byte("root hash"),
+		TxCount:         uint32(1),
+		ChainID:         []byte("chain ID"),
+		SoftwareVersion: []byte("1"),
 	}
 	var b bytes.Buffer
 	mb.Save(&b)
@@ -0,0 +1,8 @@
+package block
+
+// PendingMiniBlockInfo holds a cross shard miniblock which was notarized by the metachain as created by its
+// sender shard, but was not yet notarized as processed by its destination shard
+type PendingMiniBlockInfo struct {
+	ShardMiniBlockHeader
+	NotarizedInMetaNonce uint64
+}
@@ -0,0 +1,4443 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: block.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MiniBlock struct {
+	TxHashes         [][]byte          `protobuf:"bytes,1,rep,name=TxHashes,proto3" json:"TxHashes,omitempty"`
+	ReceiverShardID  uint32            `protobuf:"varint,2,opt,name=ReceiverShardID,proto3" json:"ReceiverShardID,omitempty"`
+	SenderShardID    uint32            `protobuf:"varint,3,opt,name=SenderShardID,proto3" json:"SenderShardID,omitempty"`
+	Type             uint32            `protobuf:"varint,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	PartialExecution *PartialExecution `protobuf:"bytes,5,opt,name=PartialExecution,proto3" json:"PartialExecution,omitempty"`
+}
+
+func (m *MiniBlock) Reset()         { *m = MiniBlock{} }
+func (m *MiniBlock) String() string { return proto.CompactTextString(m) }
+func (*MiniBlock) ProtoMessage()    {}
+func (*MiniBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{0}
+}
+func (m *MiniBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MiniBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MiniBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MiniBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MiniBlock.Merge(m, src)
+}
+func (m *MiniBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MiniBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MiniBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MiniBlock proto.InternalMessageInfo
+
+func (m *MiniBlock) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *MiniBlock) GetReceiverShardID() uint32 {
+	if m != nil {
+		return m.ReceiverShardID
+	}
+	return 0
+}
+
+func (m *MiniBlock) GetSenderShardID() uint32 {
+	if m != nil {
+		return m.SenderShardID
+	}
+	return 0
+}
+
+func (m *MiniBlock) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *MiniBlock) GetPartialExecution() *PartialExecution {
+	if m != nil {
+		return m.PartialExecution
+	}
+	return nil
+}
+
+type PartialExecution struct {
+	OriginalHash    []byte `protobuf:"bytes,1,opt,name=OriginalHash,proto3" json:"OriginalHash,omitempty"`
+	OriginalTxCount uint32 `protobuf:"varint,2,opt,name=OriginalTxCount,proto3" json:"OriginalTxCount,omitempty"`
+	IndexOfFirstTx  uint32 `protobuf:"varint,3,opt,name=IndexOfFirstTx,proto3" json:"IndexOfFirstTx,omitempty"`
+}
+
+func (m *PartialExecution) Reset()         { *m = PartialExecution{} }
+func (m *PartialExecution) String() string { return proto.CompactTextString(m) }
+func (*PartialExecution) ProtoMessage()    {}
+func (*PartialExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{1}
+}
+func (m *PartialExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartialExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartialExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartialExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialExecution.Merge(m, src)
+}
+func (m *PartialExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartialExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialExecution proto.InternalMessageInfo
+
+func (m *PartialExecution) GetOriginalHash() []byte {
+	if m != nil {
+		return m.OriginalHash
+	}
+	return nil
+}
+
+func (m *PartialExecution) GetOriginalTxCount() uint32 {
+	if m != nil {
+		return m.OriginalTxCount
+	}
+	return 0
+}
+
+func (m *PartialExecution) GetIndexOfFirstTx() uint32 {
+	if m != nil {
+		return m.IndexOfFirstTx
+	}
+	return 0
+}
+
+type Body struct {
+	MiniBlocks []*MiniBlock `protobuf:"bytes,1,rep,name=MiniBlocks,proto3" json:"MiniBlocks,omitempty"`
+}
+
+func (m *Body) Reset()         { *m = Body{} }
+func (m *Body) String() string { return proto.CompactTextString(m) }
+func (*Body) ProtoMessage()    {}
+func (*Body) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{2}
+}
+func (m *Body) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Body) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Body.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Body) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Body.Merge(m, src)
+}
+func (m *Body) XXX_Size() int {
+	return m.Size()
+}
+func (m *Body) XXX_DiscardUnknown() {
+	xxx_messageInfo_Body.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Body proto.InternalMessageInfo
+
+func (m *Body) GetMiniBlocks() []*MiniBlock {
+	if m != nil {
+		return m.MiniBlocks
+	}
+	return nil
+}
+
+type MiniBlockHeader struct {
+	Hash             []byte            `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	SenderShardID    uint32            `protobuf:"varint,2,opt,name=SenderShardID,proto3" json:"SenderShardID,omitempty"`
+	ReceiverShardID  uint32            `protobuf:"varint,3,opt,name=ReceiverShardID,proto3" json:"ReceiverShardID,omitempty"`
+	TxCount          uint32            `protobuf:"varint,4,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
+	Type             uint32            `protobuf:"varint,5,opt,name=Type,proto3" json:"Type,omitempty"`
+	PartialExecution *PartialExecution `protobuf:"bytes,6,opt,name=PartialExecution,proto3" json:"PartialExecution,omitempty"`
+}
+
+func (m *MiniBlockHeader) Reset()         { *m = MiniBlockHeader{} }
+func (m *MiniBlockHeader) String() string { return proto.CompactTextString(m) }
+func (*MiniBlockHeader) ProtoMessage()    {}
+func (*MiniBlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{3}
+}
+func (m *MiniBlockHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MiniBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MiniBlockHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MiniBlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MiniBlockHeader.Merge(m, src)
+}
+func (m *MiniBlockHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *MiniBlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_MiniBlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MiniBlockHeader proto.InternalMessageInfo
+
+func (m *MiniBlockHeader) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *MiniBlockHeader) GetSenderShardID() uint32 {
+	if m != nil {
+		return m.SenderShardID
+	}
+	return 0
+}
+
+func (m *MiniBlockHeader) GetReceiverShardID() uint32 {
+	if m != nil {
+		return m.ReceiverShardID
+	}
+	return 0
+}
+
+func (m *MiniBlockHeader) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *MiniBlockHeader) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *MiniBlockHeader) GetPartialExecution() *PartialExecution {
+	if m != nil {
+		return m.PartialExecution
+	}
+	return nil
+}
+
+type PeerChange struct {
+	PubKey      []byte `protobuf:"bytes,1,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	ShardIdDest uint32 `protobuf:"varint,2,opt,name=ShardIdDest,proto3" json:"ShardIdDest,omitempty"`
+}
+
+func (m *PeerChange) Reset()         { *m = PeerChange{} }
+func (m *PeerChange) String() string { return proto.CompactTextString(m) }
+func (*PeerChange) ProtoMessage()    {}
+func (*PeerChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{4}
+}
+func (m *PeerChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerChange.Merge(m, src)
+}
+func (m *PeerChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerChange proto.InternalMessageInfo
+
+func (m *PeerChange) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *PeerChange) GetShardIdDest() uint32 {
+	if m != nil {
+		return m.ShardIdDest
+	}
+	return 0
+}
+
+type Header struct {
+	Nonce            uint64             `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	PrevHash         []byte             `protobuf:"bytes,2,opt,name=PrevHash,proto3" json:"PrevHash,omitempty"`
+	PrevRandSeed     []byte             `protobuf:"bytes,3,opt,name=PrevRandSeed,proto3" json:"PrevRandSeed,omitempty"`
+	RandSeed         []byte             `protobuf:"bytes,4,opt,name=RandSeed,proto3" json:"RandSeed,omitempty"`
+	PubKeysBitmap    []byte             `protobuf:"bytes,5,opt,name=PubKeysBitmap,proto3" json:"PubKeysBitmap,omitempty"`
+	ShardId          uint32             `protobuf:"varint,6,opt,name=ShardId,proto3" json:"ShardId,omitempty"`
+	TimeStamp        uint64             `protobuf:"varint,7,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty"`
+	Round            uint64             `protobuf:"varint,8,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch            uint32             `protobuf:"varint,9,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	BlockBodyType    uint32             `protobuf:"varint,10,opt,name=BlockBodyType,proto3" json:"BlockBodyType,omitempty"`
+	Signature        []byte             `protobuf:"bytes,11,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	MiniBlockHeaders []*MiniBlockHeader `protobuf:"bytes,12,rep,name=MiniBlockHeaders,proto3" json:"MiniBlockHeaders,omitempty"`
+	PeerChanges      []*PeerChange      `protobuf:"bytes,13,rep,name=PeerChanges,proto3" json:"PeerChanges,omitempty"`
+	RootHash         []byte             `protobuf:"bytes,14,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	MetaBlockHashes  [][]byte           `protobuf:"bytes,15,rep,name=MetaBlockHashes,proto3" json:"MetaBlockHashes,omitempty"`
+	TxCount          uint32             `protobuf:"varint,16,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
+	AccumulatedFees  []byte             `protobuf:"bytes,17,opt,name=AccumulatedFees,proto3" json:"AccumulatedFees,omitempty"`
+	BurnedFees       []byte             `protobuf:"bytes,18,opt,name=BurnedFees,proto3" json:"BurnedFees,omitempty"`
+	GenesisChecksum  []byte             `protobuf:"bytes,19,opt,name=GenesisChecksum,proto3" json:"GenesisChecksum,omitempty"`
+	ChainID          []byte             `protobuf:"bytes,20,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	SoftwareVersion  []byte             `protobuf:"bytes,21,opt,name=SoftwareVersion,proto3" json:"SoftwareVersion,omitempty"`
+}
+
+func (m *Header) Reset()         { *m = Header{} }
+func (m *Header) String() string { return proto.CompactTextString(m) }
+func (*Header) ProtoMessage()    {}
+func (*Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{5}
+}
+func (m *Header) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Header.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header.Merge(m, src)
+}
+func (m *Header) XXX_Size() int {
+	return m.Size()
+}
+func (m *Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Header proto.InternalMessageInfo
+
+func (m *Header) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Header) GetPrevHash() []byte {
+	if m != nil {
+		return m.PrevHash
+	}
+	return nil
+}
+
+func (m *Header) GetPrevRandSeed() []byte {
+	if m != nil {
+		return m.PrevRandSeed
+	}
+	return nil
+}
+
+func (m *Header) GetRandSeed() []byte {
+	if m != nil {
+		return m.RandSeed
+	}
+	return nil
+}
+
+func (m *Header) GetPubKeysBitmap() []byte {
+	if m != nil {
+		return m.PubKeysBitmap
+	}
+	return nil
+}
+
+func (m *Header) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *Header) GetTimeStamp() uint64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
+}
+
+func (m *Header) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Header) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Header) GetBlockBodyType() uint32 {
+	if m != nil {
+		return m.BlockBodyType
+	}
+	return 0
+}
+
+func (m *Header) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *Header) GetMiniBlockHeaders() []*MiniBlockHeader {
+	if m != nil {
+		return m.MiniBlockHeaders
+	}
+	return nil
+}
+
+func (m *Header) GetPeerChanges() []*PeerChange {
+	if m != nil {
+		return m.PeerChanges
+	}
+	return nil
+}
+
+func (m *Header) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *Header) GetMetaBlockHashes() [][]byte {
+	if m != nil {
+		return m.MetaBlockHashes
+	}
+	return nil
+}
+
+func (m *Header) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *Header) GetAccumulatedFees() []byte {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return nil
+}
+
+func (m *Header) GetBurnedFees() []byte {
+	if m != nil {
+		return m.BurnedFees
+	}
+	return nil
+}
+
+func (m *Header) GetGenesisChecksum() []byte {
+	if m != nil {
+		return m.GenesisChecksum
+	}
+	return nil
+}
+
+func (m *Header) GetChainID() []byte {
+	if m != nil {
+		return m.ChainID
+	}
+	return nil
+}
+
+func (m *Header) GetSoftwareVersion() []byte {
+	if m != nil {
+		return m.SoftwareVersion
+	}
+	return nil
+}
+
+type PeerData struct {
+	PublicKey []byte `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Action    uint32 `protobuf:"varint,2,opt,name=Action,proto3" json:"Action,omitempty"`
+	TimeStamp uint64 `protobuf:"varint,3,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty"`
+	Value     []byte `protobuf:"bytes,4,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (m *PeerData) Reset()         { *m = PeerData{} }
+func (m *PeerData) String() string { return proto.CompactTextString(m) }
+func (*PeerData) ProtoMessage()    {}
+func (*PeerData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{6}
+}
+func (m *PeerData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerData.Merge(m, src)
+}
+func (m *PeerData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerData proto.InternalMessageInfo
+
+func (m *PeerData) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *PeerData) GetAction() uint32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+func (m *PeerData) GetTimeStamp() uint64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
+}
+
+func (m *PeerData) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type ShardMiniBlockHeader struct {
+	Hash            []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	ReceiverShardId uint32 `protobuf:"varint,2,opt,name=ReceiverShardId,proto3" json:"ReceiverShardId,omitempty"`
+	SenderShardId   uint32 `protobuf:"varint,3,opt,name=SenderShardId,proto3" json:"SenderShardId,omitempty"`
+	TxCount         uint32 `protobuf:"varint,4,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
+}
+
+func (m *ShardMiniBlockHeader) Reset()         { *m = ShardMiniBlockHeader{} }
+func (m *ShardMiniBlockHeader) String() string { return proto.CompactTextString(m) }
+func (*ShardMiniBlockHeader) ProtoMessage()    {}
+func (*ShardMiniBlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{7}
+}
+func (m *ShardMiniBlockHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardMiniBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardMiniBlockHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardMiniBlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardMiniBlockHeader.Merge(m, src)
+}
+func (m *ShardMiniBlockHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardMiniBlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardMiniBlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardMiniBlockHeader proto.InternalMessageInfo
+
+func (m *ShardMiniBlockHeader) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *ShardMiniBlockHeader) GetReceiverShardId() uint32 {
+	if m != nil {
+		return m.ReceiverShardId
+	}
+	return 0
+}
+
+func (m *ShardMiniBlockHeader) GetSenderShardId() uint32 {
+	if m != nil {
+		return m.SenderShardId
+	}
+	return 0
+}
+
+func (m *ShardMiniBlockHeader) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+type ShardData struct {
+	ShardId               uint32                  `protobuf:"varint,1,opt,name=ShardId,proto3" json:"ShardId,omitempty"`
+	HeaderHash            []byte                  `protobuf:"bytes,2,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	ShardMiniBlockHeaders []*ShardMiniBlockHeader `protobuf:"bytes,3,rep,name=ShardMiniBlockHeaders,proto3" json:"ShardMiniBlockHeaders,omitempty"`
+	TxCount               uint32                  `protobuf:"varint,4,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
+}
+
+func (m *ShardData) Reset()         { *m = ShardData{} }
+func (m *ShardData) String() string { return proto.CompactTextString(m) }
+func (*ShardData) ProtoMessage()    {}
+func (*ShardData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{8}
+}
+func (m *ShardData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardData.Merge(m, src)
+}
+func (m *ShardData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardData proto.InternalMessageInfo
+
+func (m *ShardData) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ShardData) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *ShardData) GetShardMiniBlockHeaders() []*ShardMiniBlockHeader {
+	if m != nil {
+		return m.ShardMiniBlockHeaders
+	}
+	return nil
+}
+
+func (m *ShardData) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+type MetaBlock struct {
+	Nonce           uint64       `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Epoch           uint32       `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Round           uint64       `protobuf:"varint,3,opt,name=Round,proto3" json:"Round,omitempty"`
+	TimeStamp       uint64       `protobuf:"varint,4,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty"`
+	ShardInfo       []*ShardData `protobuf:"bytes,5,rep,name=ShardInfo,proto3" json:"ShardInfo,omitempty"`
+	PeerInfo        []*PeerData  `protobuf:"bytes,6,rep,name=PeerInfo,proto3" json:"PeerInfo,omitempty"`
+	Signature       []byte       `protobuf:"bytes,7,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	PubKeysBitmap   []byte       `protobuf:"bytes,8,opt,name=PubKeysBitmap,proto3" json:"PubKeysBitmap,omitempty"`
+	PrevHash        []byte       `protobuf:"bytes,9,opt,name=PrevHash,proto3" json:"PrevHash,omitempty"`
+	PrevRandSeed    []byte       `protobuf:"bytes,10,opt,name=PrevRandSeed,proto3" json:"PrevRandSeed,omitempty"`
+	RandSeed        []byte       `protobuf:"bytes,11,opt,name=RandSeed,proto3" json:"RandSeed,omitempty"`
+	RootHash        []byte       `protobuf:"bytes,12,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	TxCount         uint32       `protobuf:"varint,13,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
+	ChainID         []byte       `protobuf:"bytes,14,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	SoftwareVersion []byte       `protobuf:"bytes,15,opt,name=SoftwareVersion,proto3" json:"SoftwareVersion,omitempty"`
+}
+
+func (m *MetaBlock) Reset()         { *m = MetaBlock{} }
+func (m *MetaBlock) String() string { return proto.CompactTextString(m) }
+func (*MetaBlock) ProtoMessage()    {}
+func (*MetaBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{9}
+}
+func (m *MetaBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetaBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetaBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetaBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaBlock.Merge(m, src)
+}
+func (m *MetaBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetaBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaBlock proto.InternalMessageInfo
+
+func (m *MetaBlock) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MetaBlock) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *MetaBlock) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *MetaBlock) GetTimeStamp() uint64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
+}
+
+func (m *MetaBlock) GetShardInfo() []*ShardData {
+	if m != nil {
+		return m.ShardInfo
+	}
+	return nil
+}
+
+func (m *MetaBlock) GetPeerInfo() []*PeerData {
+	if m != nil {
+		return m.PeerInfo
+	}
+	return nil
+}
+
+func (m *MetaBlock) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *MetaBlock) GetPubKeysBitmap() []byte {
+	if m != nil {
+		return m.PubKeysBitmap
+	}
+	return nil
+}
+
+func (m *MetaBlock) GetPrevHash() []byte {
+	if m != nil {
+		return m.PrevHash
+	}
+	return nil
+}
+
+func (m *MetaBlock) GetPrevRandSeed() []byte {
+	if m != nil {
+		return m.PrevRandSeed
+	}
+	return nil
+}
+
+func (m *MetaBlock) GetRandSeed() []byte {
+	if m != nil {
+		return m.RandSeed
+	}
+	return nil
+}
+
+func (m *MetaBlock) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *MetaBlock) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *MetaBlock) GetChainID() []byte {
+	if m != nil {
+		return m.ChainID
+	}
+	return nil
+}
+
+func (m *MetaBlock) GetSoftwareVersion() []byte {
+	if m != nil {
+		return m.SoftwareVersion
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MiniBlock)(nil), "protobuf.MiniBlock")
+	proto.RegisterType((*PartialExecution)(nil), "protobuf.PartialExecution")
+	proto.RegisterType((*Body)(nil), "protobuf.Body")
+	proto.RegisterType((*MiniBlockHeader)(nil), "protobuf.MiniBlockHeader")
+	proto.RegisterType((*PeerChange)(nil), "protobuf.PeerChange")
+	proto.RegisterType((*Header)(nil), "protobuf.Header")
+	proto.RegisterType((*PeerData)(nil), "protobuf.PeerData")
+	proto.RegisterType((*ShardMiniBlockHeader)(nil), "protobuf.ShardMiniBlockHeader")
+	proto.RegisterType((*ShardData)(nil), "protobuf.ShardData")
+	proto.RegisterType((*MetaBlock)(nil), "protobuf.MetaBlock")
+}
+
+func init() { proto.RegisterFile("block.proto", fileDescriptor_8e550b1f5926e92d) }
+
+var fileDescriptor_8e550b1f5926e92d = []byte{
+	// 889 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xad, 0x1f, 0x4b, 0x23, 0xc9, 0x72, 0x37, 0x4e, 0xb1, 0x0d, 0x0a, 0x42, 0x20, 0x82,
+	0x42, 0x27, 0x03, 0x4d, 0x80, 0x5e, 0x7a, 0x8a, 0x2c, 0xab, 0x11, 0x8a, 0x34, 0xc2, 0x4a, 0xc8,
+	0x7d, 0x45, 0xae, 0x2c, 0x22, 0x12, 0x29, 0xf0, 0x27, 0x95, 0xdf, 0xa0, 0xc7, 0x1e, 0xfa, 0x2a,
+	0x7d, 0x87, 0x1e, 0x03, 0xf4, 0xd2, 0x5b, 0x0b, 0xfb, 0x52, 0xa0, 0x2f, 0x51, 0xec, 0x2c, 0x7f,
+	0x96, 0x14, 0x5d, 0x15, 0x39, 0x91, 0xf3, 0xed, 0xec, 0x70, 0x7e, 0xbe, 0xf9, 0x08, 0x9d, 0xe5,
+	0xc6, 0xb7, 0xdf, 0x5f, 0xed, 0x02, 0x3f, 0xf2, 0x49, 0x0b, 0x1f, 0xcb, 0x78, 0x65, 0xfd, 0x6e,
+	0x40, 0xfb, 0x8d, 0xeb, 0xb9, 0x23, 0x79, 0x4a, 0x9e, 0x41, 0x6b, 0xb1, 0x7f, 0xcd, 0xc3, 0xb5,
+	0x08, 0xa9, 0x31, 0xa8, 0x0d, 0xbb, 0x2c, 0xb3, 0xc9, 0x10, 0xfa, 0x4c, 0xd8, 0xc2, 0xfd, 0x20,
+	0x82, 0xf9, 0x9a, 0x07, 0xce, 0x74, 0x4c, 0x4f, 0x07, 0xc6, 0xb0, 0xc7, 0xca, 0x30, 0x79, 0x0e,
+	0xbd, 0xb9, 0xf0, 0x9c, 0xdc, 0xaf, 0x86, 0x7e, 0x45, 0x90, 0x10, 0xa8, 0x2f, 0xee, 0x76, 0x82,
+	0xd6, 0xf1, 0x10, 0xdf, 0xc9, 0x04, 0x2e, 0x66, 0x3c, 0x88, 0x5c, 0xbe, 0xb9, 0xd9, 0x0b, 0x3b,
+	0x8e, 0x5c, 0xdf, 0xa3, 0x8d, 0x81, 0x31, 0xec, 0xbc, 0x78, 0x76, 0x95, 0xa6, 0x7c, 0x55, 0xf6,
+	0x60, 0x07, 0x77, 0xac, 0x9f, 0x8c, 0xc3, 0x40, 0xc4, 0x82, 0xee, 0xdb, 0xc0, 0xbd, 0x75, 0x3d,
+	0xbe, 0x91, 0x25, 0x51, 0x63, 0x60, 0x0c, 0xbb, 0xac, 0x80, 0xc9, 0x22, 0x53, 0x7b, 0xb1, 0xbf,
+	0xf6, 0x63, 0x2f, 0x4a, 0x8b, 0x2c, 0xc1, 0xe4, 0x2b, 0x38, 0x9f, 0x7a, 0x8e, 0xd8, 0xbf, 0x5d,
+	0x4d, 0xdc, 0x20, 0x8c, 0x16, 0xfb, 0xa4, 0xca, 0x12, 0x6a, 0x7d, 0x0b, 0xf5, 0x91, 0xef, 0xdc,
+	0x91, 0x97, 0x00, 0x59, 0x9f, 0x55, 0x73, 0x3b, 0x2f, 0x9e, 0xe4, 0x45, 0x65, 0x67, 0x4c, 0x73,
+	0xb3, 0xfe, 0x31, 0xa0, 0x9f, 0x99, 0xaf, 0x05, 0x77, 0x44, 0x20, 0xfb, 0xa6, 0xa5, 0x8f, 0xef,
+	0x87, 0x1d, 0x3f, 0xad, 0xea, 0x78, 0xc5, 0x04, 0x6b, 0xd5, 0x13, 0xa4, 0x70, 0x96, 0x96, 0xaf,
+	0xc6, 0x93, 0x9a, 0xd9, 0xd4, 0x1a, 0x47, 0xa6, 0xd6, 0xfc, 0x84, 0xa9, 0x4d, 0x00, 0x66, 0x42,
+	0x04, 0xd7, 0x6b, 0xee, 0xdd, 0x0a, 0xf2, 0x39, 0x34, 0x67, 0xf1, 0xf2, 0x7b, 0x71, 0x97, 0x54,
+	0x9a, 0x58, 0x64, 0x00, 0x1d, 0x95, 0xa6, 0x33, 0x16, 0x61, 0x3a, 0x1e, 0x1d, 0xb2, 0xfe, 0x6c,
+	0x40, 0x33, 0x69, 0xd6, 0x25, 0x34, 0x7e, 0xf0, 0x3d, 0x5b, 0x60, 0x8c, 0x3a, 0x53, 0x86, 0xa4,
+	0xf9, 0x2c, 0x10, 0x1f, 0xb0, 0x8d, 0xa7, 0x18, 0x3c, 0xb3, 0x25, 0x4b, 0xe4, 0x3b, 0xe3, 0x9e,
+	0x33, 0x17, 0xc2, 0xc1, 0x0e, 0x75, 0x59, 0x01, 0x93, 0xf7, 0xb3, 0xf3, 0xba, 0xba, 0x9f, 0x9d,
+	0x3d, 0x87, 0x9e, 0x4a, 0x34, 0x1c, 0xb9, 0xd1, 0x96, 0xef, 0xb0, 0x53, 0x5d, 0x56, 0x04, 0x65,
+	0x83, 0x93, 0x8c, 0xb1, 0x53, 0x3d, 0x96, 0x9a, 0xe4, 0x4b, 0x68, 0x2f, 0xdc, 0xad, 0x98, 0x47,
+	0x7c, 0xbb, 0xa3, 0x67, 0x98, 0x75, 0x0e, 0xc8, 0x7a, 0x98, 0x1f, 0x7b, 0x0e, 0x6d, 0xa9, 0x7a,
+	0xd0, 0x90, 0xe8, 0xcd, 0xce, 0xb7, 0xd7, 0xb4, 0x8d, 0xb1, 0x94, 0x21, 0x33, 0x41, 0xde, 0x48,
+	0xfa, 0xe1, 0xcc, 0x40, 0x91, 0xa2, 0x00, 0xca, 0xef, 0xcd, 0xdd, 0x5b, 0x8f, 0x47, 0x71, 0x20,
+	0x68, 0x07, 0x73, 0xcd, 0x01, 0x72, 0x03, 0x17, 0x25, 0xfe, 0x85, 0xb4, 0x8b, 0xdc, 0xfd, 0xa2,
+	0x82, 0xbb, 0xca, 0x83, 0x1d, 0x5c, 0x21, 0xdf, 0x40, 0x27, 0x9f, 0x6c, 0x48, 0x7b, 0x18, 0xe1,
+	0x52, 0x23, 0x47, 0x76, 0xc8, 0x74, 0x47, 0x6c, 0xb4, 0xef, 0x47, 0x38, 0xa8, 0xf3, 0xa4, 0xd1,
+	0x89, 0x2d, 0xd9, 0xfc, 0x46, 0x44, 0x5c, 0x7d, 0x47, 0x49, 0x56, 0x1f, 0x25, 0xab, 0x0c, 0xeb,
+	0x6c, 0xbe, 0x28, 0xb2, 0x79, 0x08, 0xfd, 0x57, 0xb6, 0x1d, 0x6f, 0xe3, 0x0d, 0x8f, 0x84, 0x33,
+	0x11, 0x22, 0xa4, 0x9f, 0xe1, 0x67, 0xca, 0x30, 0x31, 0x01, 0x46, 0x71, 0xe0, 0x25, 0x4e, 0x04,
+	0x9d, 0x34, 0x44, 0x46, 0xfa, 0x4e, 0x78, 0x22, 0x74, 0xc3, 0xeb, 0xb5, 0xb0, 0xdf, 0x87, 0xf1,
+	0x96, 0x3e, 0x51, 0x91, 0x4a, 0xb0, 0xcc, 0xe6, 0x7a, 0xcd, 0x5d, 0x6f, 0x3a, 0xa6, 0x97, 0xe8,
+	0x91, 0x9a, 0x32, 0xc6, 0xdc, 0x5f, 0x45, 0x3f, 0xf2, 0x40, 0xbc, 0x13, 0x41, 0x28, 0xd7, 0xe8,
+	0xa9, 0x8a, 0x51, 0x82, 0xad, 0x08, 0x5a, 0xb2, 0x4d, 0x63, 0x1e, 0x71, 0x39, 0xc0, 0x59, 0xbc,
+	0xdc, 0xb8, 0x76, 0xbe, 0x2a, 0x39, 0x20, 0xb7, 0xe8, 0x95, 0x8d, 0x1b, 0xa9, 0x16, 0x25, 0xb1,
+	0x8a, 0x34, 0xab, 0x55, 0xd0, 0xec, 0x1d, 0xdf, 0xc4, 0x22, 0x61, 0xb7, 0x32, 0xac, 0x5f, 0x0c,
+	0xb8, 0x44, 0x9a, 0xfe, 0x1f, 0x49, 0x3a, 0x10, 0x1b, 0xa7, 0xfa, 0x77, 0xe1, 0x94, 0xc5, 0xcb,
+	0xa9, 0xfa, 0x5d, 0x38, 0x8f, 0x4b, 0x92, 0xf5, 0xab, 0x01, 0x6d, 0xf4, 0xc2, 0x76, 0x68, 0x9b,
+	0x65, 0x14, 0x37, 0xcb, 0x04, 0x50, 0xf9, 0x6a, 0x7b, 0xaf, 0x21, 0x64, 0x01, 0x4f, 0xab, 0xaa,
+	0x0b, 0x69, 0x0d, 0xe9, 0x6a, 0xe6, 0x74, 0xad, 0x72, 0x63, 0xd5, 0x97, 0xff, 0x23, 0xef, 0xbf,
+	0x6b, 0xd0, 0xce, 0xa8, 0xfa, 0x88, 0x52, 0x65, 0x9b, 0x7d, 0xaa, 0x6f, 0x76, 0xa6, 0x02, 0x35,
+	0x5d, 0x05, 0x0a, 0x23, 0xad, 0x97, 0x47, 0xfa, 0x75, 0xd2, 0xa4, 0xa9, 0xb7, 0xf2, 0x69, 0xa3,
+	0xfc, 0xfb, 0xc9, 0xfa, 0xc7, 0x72, 0x2f, 0x72, 0xa5, 0x58, 0x86, 0x37, 0x9a, 0x78, 0x83, 0x14,
+	0x57, 0x16, 0x2f, 0x64, 0x3e, 0x45, 0x29, 0x39, 0x2b, 0x4b, 0xc9, 0x81, 0x30, 0xb6, 0xaa, 0x84,
+	0x51, 0x97, 0xe6, 0xf6, 0x11, 0x69, 0x86, 0x23, 0xd2, 0xdc, 0x29, 0x49, 0xb3, 0xae, 0x26, 0xdd,
+	0x92, 0x9a, 0x68, 0x63, 0xea, 0x15, 0x35, 0x42, 0xdb, 0xd7, 0xf3, 0xa3, 0xfb, 0xda, 0xaf, 0xdc,
+	0xd7, 0x11, 0xfd, 0xed, 0xde, 0x34, 0x3e, 0xde, 0x9b, 0xc6, 0x5f, 0xf7, 0xa6, 0xf1, 0xf3, 0x83,
+	0x79, 0xf2, 0xf1, 0xc1, 0x3c, 0xf9, 0xe3, 0xc1, 0x3c, 0x59, 0x36, 0xb1, 0xa1, 0x2f, 0xff, 0x1d,
+	0x00, 0x4b, 0x5e, 0x19, 0xb0, 0x9f, 0x09, 0x00, 0x00,
+}
+
+func (m *MiniBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MiniBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MiniBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartialExecution != nil {
+		{
+			size, err := m.PartialExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SenderShardID != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.SenderShardID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReceiverShardID != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.ReceiverShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHashes) > 0 {
+		for iNdEx := len(m.TxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxHashes[iNdEx])
+			copy(dAtA[i:], m.TxHashes[iNdEx])
+			i = encodeVarintBlock(dAtA, i, uint64(len(m.TxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartialExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartialExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartialExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IndexOfFirstTx != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.IndexOfFirstTx))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OriginalTxCount != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.OriginalTxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OriginalHash) > 0 {
+		i -= len(m.OriginalHash)
+		copy(dAtA[i:], m.OriginalHash)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.OriginalHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Body) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Body) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Body) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MiniBlocks) > 0 {
+		for iNdEx := len(m.MiniBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MiniBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MiniBlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MiniBlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MiniBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartialExecution != nil {
+		{
+			size, err := m.PartialExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Type != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReceiverShardID != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.ReceiverShardID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SenderShardID != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.SenderShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShardIdDest != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.ShardIdDest))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SoftwareVersion) > 0 {
+		i -= len(m.SoftwareVersion)
+		copy(dAtA[i:], m.SoftwareVersion)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.SoftwareVersion)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.GenesisChecksum) > 0 {
+		i -= len(m.GenesisChecksum)
+		copy(dAtA[i:], m.GenesisChecksum)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.GenesisChecksum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.BurnedFees) > 0 {
+		i -= len(m.BurnedFees)
+		copy(dAtA[i:], m.BurnedFees)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.BurnedFees)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.AccumulatedFees) > 0 {
+		i -= len(m.AccumulatedFees)
+		copy(dAtA[i:], m.AccumulatedFees)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.AccumulatedFees)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.MetaBlockHashes) > 0 {
+		for iNdEx := len(m.MetaBlockHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetaBlockHashes[iNdEx])
+			copy(dAtA[i:], m.MetaBlockHashes[iNdEx])
+			i = encodeVarintBlock(dAtA, i, uint64(len(m.MetaBlockHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.PeerChanges) > 0 {
+		for iNdEx := len(m.PeerChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeerChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.MiniBlockHeaders) > 0 {
+		for iNdEx := len(m.MiniBlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MiniBlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.BlockBodyType != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.BlockBodyType))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Round != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimeStamp != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.TimeStamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PubKeysBitmap) > 0 {
+		i -= len(m.PubKeysBitmap)
+		copy(dAtA[i:], m.PubKeysBitmap)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.PubKeysBitmap)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RandSeed) > 0 {
+		i -= len(m.RandSeed)
+		copy(dAtA[i:], m.RandSeed)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.RandSeed)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PrevRandSeed) > 0 {
+		i -= len(m.PrevRandSeed)
+		copy(dAtA[i:], m.PrevRandSeed)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.PrevRandSeed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PrevHash) > 0 {
+		i -= len(m.PrevHash)
+		copy(dAtA[i:], m.PrevHash)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.PrevHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimeStamp != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.TimeStamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Action != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardMiniBlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardMiniBlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardMiniBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxCount != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SenderShardId != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.SenderShardId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReceiverShardId != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.ReceiverShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxCount != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ShardMiniBlockHeaders) > 0 {
+		for iNdEx := len(m.ShardMiniBlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShardMiniBlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetaBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetaBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetaBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SoftwareVersion) > 0 {
+		i -= len(m.SoftwareVersion)
+		copy(dAtA[i:], m.SoftwareVersion)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.SoftwareVersion)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RandSeed) > 0 {
+		i -= len(m.RandSeed)
+		copy(dAtA[i:], m.RandSeed)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.RandSeed)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PrevRandSeed) > 0 {
+		i -= len(m.PrevRandSeed)
+		copy(dAtA[i:], m.PrevRandSeed)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.PrevRandSeed)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PrevHash) > 0 {
+		i -= len(m.PrevHash)
+		copy(dAtA[i:], m.PrevHash)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.PrevHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PubKeysBitmap) > 0 {
+		i -= len(m.PubKeysBitmap)
+		copy(dAtA[i:], m.PubKeysBitmap)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.PubKeysBitmap)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PeerInfo) > 0 {
+		for iNdEx := len(m.PeerInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeerInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ShardInfo) > 0 {
+		for iNdEx := len(m.ShardInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShardInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TimeStamp != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.TimeStamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Round != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MiniBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxHashes) > 0 {
+		for _, b := range m.TxHashes {
+			l = len(b)
+			n += 1 + l + sovBlock(uint64(l))
+		}
+	}
+	if m.ReceiverShardID != 0 {
+		n += 1 + sovBlock(uint64(m.ReceiverShardID))
+	}
+	if m.SenderShardID != 0 {
+		n += 1 + sovBlock(uint64(m.SenderShardID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovBlock(uint64(m.Type))
+	}
+	if m.PartialExecution != nil {
+		l = m.PartialExecution.Size()
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
+func (m *PartialExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalHash)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if m.OriginalTxCount != 0 {
+		n += 1 + sovBlock(uint64(m.OriginalTxCount))
+	}
+	if m.IndexOfFirstTx != 0 {
+		n += 1 + sovBlock(uint64(m.IndexOfFirstTx))
+	}
+	return n
+}
+
+func (m *Body) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MiniBlocks) > 0 {
+		for _, e := range m.MiniBlocks {
+			l = e.Size()
+			n += 1 + l + sovBlock(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MiniBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if m.SenderShardID != 0 {
+		n += 1 + sovBlock(uint64(m.SenderShardID))
+	}
+	if m.ReceiverShardID != 0 {
+		n += 1 + sovBlock(uint64(m.ReceiverShardID))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovBlock(uint64(m.TxCount))
+	}
+	if m.Type != 0 {
+		n += 1 + sovBlock(uint64(m.Type))
+	}
+	if m.PartialExecution != nil {
+		l = m.PartialExecution.Size()
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
+func (m *PeerChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if m.ShardIdDest != 0 {
+		n += 1 + sovBlock(uint64(m.ShardIdDest))
+	}
+	return n
+}
+
+func (m *Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovBlock(uint64(m.Nonce))
+	}
+	l = len(m.PrevHash)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.PrevRandSeed)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.RandSeed)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.PubKeysBitmap)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovBlock(uint64(m.ShardId))
+	}
+	if m.TimeStamp != 0 {
+		n += 1 + sovBlock(uint64(m.TimeStamp))
+	}
+	if m.Round != 0 {
+		n += 1 + sovBlock(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovBlock(uint64(m.Epoch))
+	}
+	if m.BlockBodyType != 0 {
+		n += 1 + sovBlock(uint64(m.BlockBodyType))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if len(m.MiniBlockHeaders) > 0 {
+		for _, e := range m.MiniBlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovBlock(uint64(l))
+		}
+	}
+	if len(m.PeerChanges) > 0 {
+		for _, e := range m.PeerChanges {
+			l = e.Size()
+			n += 1 + l + sovBlock(uint64(l))
+		}
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if len(m.MetaBlockHashes) > 0 {
+		for _, b := range m.MetaBlockHashes {
+			l = len(b)
+			n += 1 + l + sovBlock(uint64(l))
+		}
+	}
+	if m.TxCount != 0 {
+		n += 2 + sovBlock(uint64(m.TxCount))
+	}
+	l = len(m.AccumulatedFees)
+	if l > 0 {
+		n += 2 + l + sovBlock(uint64(l))
+	}
+	l = len(m.BurnedFees)
+	if l > 0 {
+		n += 2 + l + sovBlock(uint64(l))
+	}
+	l = len(m.GenesisChecksum)
+	if l > 0 {
+		n += 2 + l + sovBlock(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 2 + l + sovBlock(uint64(l))
+	}
+	l = len(m.SoftwareVersion)
+	if l > 0 {
+		n += 2 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
+func (m *PeerData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovBlock(uint64(m.Action))
+	}
+	if m.TimeStamp != 0 {
+		n += 1 + sovBlock(uint64(m.TimeStamp))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardMiniBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if m.ReceiverShardId != 0 {
+		n += 1 + sovBlock(uint64(m.ReceiverShardId))
+	}
+	if m.SenderShardId != 0 {
+		n += 1 + sovBlock(uint64(m.SenderShardId))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovBlock(uint64(m.TxCount))
+	}
+	return n
+}
+
+func (m *ShardData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovBlock(uint64(m.ShardId))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if len(m.ShardMiniBlockHeaders) > 0 {
+		for _, e := range m.ShardMiniBlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovBlock(uint64(l))
+		}
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovBlock(uint64(m.TxCount))
+	}
+	return n
+}
+
+func (m *MetaBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovBlock(uint64(m.Nonce))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovBlock(uint64(m.Epoch))
+	}
+	if m.Round != 0 {
+		n += 1 + sovBlock(uint64(m.Round))
+	}
+	if m.TimeStamp != 0 {
+		n += 1 + sovBlock(uint64(m.TimeStamp))
+	}
+	if len(m.ShardInfo) > 0 {
+		for _, e := range m.ShardInfo {
+			l = e.Size()
+			n += 1 + l + sovBlock(uint64(l))
+		}
+	}
+	if len(m.PeerInfo) > 0 {
+		for _, e := range m.PeerInfo {
+			l = e.Size()
+			n += 1 + l + sovBlock(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.PubKeysBitmap)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.PrevHash)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.PrevRandSeed)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.RandSeed)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovBlock(uint64(m.TxCount))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.SoftwareVersion)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
+func sovBlock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlock(x uint64) (n int) {
+	return sovBlock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MiniBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MiniBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MiniBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, make([]byte, postIndex-iNdEx))
+			copy(m.TxHashes[len(m.TxHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverShardID", wireType)
+			}
+			m.ReceiverShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderShardID", wireType)
+			}
+			m.SenderShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartialExecution == nil {
+				m.PartialExecution = &PartialExecution{}
+			}
+			if err := m.PartialExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartialExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartialExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartialExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalHash = append(m.OriginalHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalHash == nil {
+				m.OriginalHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalTxCount", wireType)
+			}
+			m.OriginalTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginalTxCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOfFirstTx", wireType)
+			}
+			m.IndexOfFirstTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOfFirstTx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Body) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Body: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Body: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlocks = append(m.MiniBlocks, &MiniBlock{})
+			if err := m.MiniBlocks[len(m.MiniBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MiniBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MiniBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MiniBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderShardID", wireType)
+			}
+			m.SenderShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverShardID", wireType)
+			}
+			m.ReceiverShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartialExecution == nil {
+				m.PartialExecution = &PartialExecution{}
+			}
+			if err := m.PartialExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIdDest", wireType)
+			}
+			m.ShardIdDest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardIdDest |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevHash = append(m.PrevHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevHash == nil {
+				m.PrevHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRandSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevRandSeed = append(m.PrevRandSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevRandSeed == nil {
+				m.PrevRandSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandSeed = append(m.RandSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandSeed == nil {
+				m.RandSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeysBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeysBitmap = append(m.PubKeysBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKeysBitmap == nil {
+				m.PubKeysBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeStamp", wireType)
+			}
+			m.TimeStamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeStamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockBodyType", wireType)
+			}
+			m.BlockBodyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockBodyType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlockHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlockHeaders = append(m.MiniBlockHeaders, &MiniBlockHeader{})
+			if err := m.MiniBlockHeaders[len(m.MiniBlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerChanges = append(m.PeerChanges, &PeerChange{})
+			if err := m.PeerChanges[len(m.PeerChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaBlockHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaBlockHashes = append(m.MetaBlockHashes, make([]byte, postIndex-iNdEx))
+			copy(m.MetaBlockHashes[len(m.MetaBlockHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedFees = append(m.AccumulatedFees[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccumulatedFees == nil {
+				m.AccumulatedFees = []byte{}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedFees = append(m.BurnedFees[:0], dAtA[iNdEx:postIndex]...)
+			if m.BurnedFees == nil {
+				m.BurnedFees = []byte{}
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisChecksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisChecksum = append(m.GenesisChecksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisChecksum == nil {
+				m.GenesisChecksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = append(m.ChainID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainID == nil {
+				m.ChainID = []byte{}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftwareVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SoftwareVersion = append(m.SoftwareVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.SoftwareVersion == nil {
+				m.SoftwareVersion = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeStamp", wireType)
+			}
+			m.TimeStamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeStamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardMiniBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardMiniBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardMiniBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverShardId", wireType)
+			}
+			m.ReceiverShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverShardId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderShardId", wireType)
+			}
+			m.SenderShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderShardId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardMiniBlockHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardMiniBlockHeaders = append(m.ShardMiniBlockHeaders, &ShardMiniBlockHeader{})
+			if err := m.ShardMiniBlockHeaders[len(m.ShardMiniBlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetaBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetaBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetaBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeStamp", wireType)
+			}
+			m.TimeStamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeStamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardInfo = append(m.ShardInfo, &ShardData{})
+			if err := m.ShardInfo[len(m.ShardInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerInfo = append(m.PeerInfo, &PeerData{})
+			if err := m.PeerInfo[len(m.PeerInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeysBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeysBitmap = append(m.PubKeysBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKeysBitmap == nil {
+				m.PubKeysBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevHash = append(m.PrevHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevHash == nil {
+				m.PrevHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRandSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevRandSeed = append(m.PrevRandSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevRandSeed == nil {
+				m.PrevRandSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandSeed = append(m.RandSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandSeed == nil {
+				m.RandSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = append(m.ChainID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainID == nil {
+				m.ChainID = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftwareVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SoftwareVersion = append(m.SoftwareVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.SoftwareVersion == nil {
+				m.SoftwareVersion = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlock
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthBlock
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowBlock
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipBlock(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthBlock
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthBlock = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlock   = fmt.Errorf("proto: integer overflow")
+)
@@ -0,0 +1,33 @@
+package bridge
+
+// OutgoingOperation is an operation executed on this chain which has to be replayed on another chain, like a token
+// burn to be followed by a mint on a side chain
+type OutgoingOperation struct {
+	TxHash []byte `json:"txHash"`
+	Data   []byte `json:"data"`
+}
+
+// OutgoingOperationsBatch holds the outgoing operations found in a committed block. Its hash is signed by the
+// validators of the shard
+type OutgoingOperationsBatch struct {
+	ShardID    uint32               `json:"shardId"`
+	BlockNonce uint64               `json:"blockNonce"`
+	BlockHash  []byte               `json:"blockHash"`
+	Operations []*OutgoingOperation `json:"operations"`
+}
+
+// OutgoingOperationsSignature is the signature of a validator over the hash of an outgoing operations batch
+type OutgoingOperationsSignature struct {
+	BatchHash []byte `json:"batchHash"`
+	PubKey    []byte `json:"pubKey"`
+	Signature []byte `json:"signature"`
+}
+
+// SignedOutgoingOperationsBatch holds an outgoing operations batch together with the validators signatures collected
+// for it. The batch is confirmed once the signatures reach the consensus threshold of the shard
+type SignedOutgoingOperationsBatch struct {
+	Hash       []byte                         `json:"hash"`
+	Batch      *OutgoingOperationsBatch       `json:"batch"`
+	Signatures []*OutgoingOperationsSignature `json:"signatures"`
+	Confirmed  bool                           `json:"confirmed"`
+}
@@ -34,6 +34,9 @@ var ErrNilElrondAddress = errors.New("nil elrond address")
 // ErrNilBurnAddress signals that nil burn address was provided
 var ErrNilBurnAddress = errors.New("nil burn address")
 
+// ErrNilProtocolSustainabilityAddress signals that nil protocol sustainability address was provided
+var ErrNilProtocolSustainabilityAddress = errors.New("nil protocol sustainability address")
+
 // ErrNilAddressConverter signals that nil address converter was provided
 var ErrNilAddressConverter = errors.New("nil address converter")
 
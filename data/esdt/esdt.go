@@ -0,0 +1,77 @@
+package esdt
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// Type defines the type of an Elrond standard digital token
+type Type uint32
+
+const (
+	// Fungible defines a token for which all the units are interchangeable
+	Fungible Type = iota
+	// NonFungible defines a unique token, which always has a quantity of one
+	NonFungible
+	// SemiFungible defines a token created with a quantity that can be increased afterwards by its creator
+	SemiFungible
+)
+
+// String returns the human readable name of the token type
+func (t Type) String() string {
+	switch t {
+	case Fungible:
+		return "FungibleESDT"
+	case NonFungible:
+		return "NonFungibleESDT"
+	case SemiFungible:
+		return "SemiFungibleESDT"
+	default:
+		return "Unknown"
+	}
+}
+
+// ESDigitalToken holds the data of a token held by an account
+type ESDigitalToken struct {
+	Type          uint32    `json:"type"`
+	Value         *big.Int  `json:"value"`
+	TokenMetaData *MetaData `json:"metadata,omitempty"`
+}
+
+// MetaData holds the data set at the creation of a non fungible or semi fungible token
+type MetaData struct {
+	Nonce      uint64   `json:"nonce"`
+	Name       []byte   `json:"name"`
+	Creator    []byte   `json:"creator"`
+	Royalties  uint32   `json:"royalties"`
+	Hash       []byte   `json:"hash"`
+	URIs       [][]byte `json:"uris"`
+	Attributes []byte   `json:"attributes"`
+}
+
+// NFTIndexEntry identifies a non fungible or semi fungible token held by an account
+type NFTIndexEntry struct {
+	TokenIdentifier []byte `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce"`
+}
+
+// NFTTokenKey returns the data trie key under which the token with the given identifier and nonce is saved
+func NFTTokenKey(tokenIdentifier []byte, nonce uint64) []byte {
+	nonceBytes := big.NewInt(0).SetUint64(nonce).Bytes()
+
+	key := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	key = append(key, tokenIdentifier...)
+	return append(key, nonceBytes...)
+}
+
+// LatestNonceKey returns the data trie key under which the creator keeps the last nonce created for a token
+func LatestNonceKey(tokenIdentifier []byte) []byte {
+	key := []byte(core.ElrondProtectedKeyPrefix + core.ESDTNFTLatestNonceIdentifier)
+	return append(key, tokenIdentifier...)
+}
+
+// NFTIndexKey returns the data trie key under which an account keeps the list of the tokens it holds
+func NFTIndexKey() []byte {
+	return []byte(core.ElrondProtectedKeyPrefix + core.ESDTNFTIndexIdentifier)
+}
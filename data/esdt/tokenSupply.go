@@ -0,0 +1,36 @@
+package esdt
+
+import (
+	"math/big"
+)
+
+// TokenSupply holds the supply counters of a token, summed over all its nonces: the quantities set when the tokens
+// were created, the quantities added afterwards and the quantities burned
+type TokenSupply struct {
+	Initial *big.Int `json:"initial"`
+	Minted  *big.Int `json:"minted"`
+	Burned  *big.Int `json:"burned"`
+}
+
+// NewTokenSupply creates the supply counters of a token, all set to zero
+func NewTokenSupply() *TokenSupply {
+	return &TokenSupply{
+		Initial: big.NewInt(0),
+		Minted:  big.NewInt(0),
+		Burned:  big.NewInt(0),
+	}
+}
+
+// CurrentSupply returns the initial and the minted quantities, without the burned one
+func (ts *TokenSupply) CurrentSupply() *big.Int {
+	currentSupply := big.NewInt(0).Add(ts.Initial, ts.Minted)
+
+	return currentSupply.Sub(currentSupply, ts.Burned)
+}
+
+// Add adds the counters of the provided supply to the current ones
+func (ts *TokenSupply) Add(other *TokenSupply) {
+	ts.Initial.Add(ts.Initial, other.Initial)
+	ts.Minted.Add(ts.Minted, other.Minted)
+	ts.Burned.Add(ts.Burned, other.Burned)
+}
@@ -0,0 +1,39 @@
+package data
+
+import (
+	"math/big"
+)
+
+// GogoProtoHelper is an interface that defines methods needed for serializing and deserializing Go structures
+// through their generated gogo protobuf messages
+type GogoProtoHelper interface {
+	// MarshalProto serializes the implementer type through its gogo protobuf message
+	MarshalProto() ([]byte, error)
+	// UnmarshalProto loads the data serialized through the gogo protobuf message into the implementer type
+	UnmarshalProto(buff []byte) error
+}
+
+// BigIntToProtoBytes encodes a big integer, sign included, for a bytes field of a gogo protobuf message. A nil
+// value is encoded as an empty slice
+func BigIntToProtoBytes(value *big.Int) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	return value.GobEncode()
+}
+
+// BigIntFromProtoBytes decodes a big integer encoded with BigIntToProtoBytes
+func BigIntFromProtoBytes(buff []byte) (*big.Int, error) {
+	if len(buff) == 0 {
+		return nil, nil
+	}
+
+	value := big.NewInt(0)
+	err := value.GobDecode(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
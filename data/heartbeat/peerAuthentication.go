@@ -0,0 +1,11 @@
+package heartbeat
+
+// PeerAuthentication is the message a node broadcasts to bind its p2p peer ID to its validator public key. It is
+// signed with the validator key, over the marshalized message without the signature, and stops being valid after the
+// expiry, a unix timestamp in seconds
+type PeerAuthentication struct {
+	Pubkey    []byte
+	Signature []byte
+	Pid       []byte
+	Expiry    int64
+}
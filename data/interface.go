@@ -1,6 +1,7 @@
 package data
 
 import (
+	"context"
 	"math/big"
 )
 
@@ -18,6 +19,8 @@ type HeaderHandler interface {
 	GetSignature() []byte
 	GetTimeStamp() uint64
 	GetTxCount() uint32
+	GetChainID() []byte
+	GetSoftwareVersion() []byte
 
 	SetNonce(n uint64)
 	SetEpoch(e uint32)
@@ -30,6 +33,8 @@ type HeaderHandler interface {
 	SetPubKeysBitmap(pkbm []byte)
 	SetSignature(sg []byte)
 	SetTxCount(txCount uint32)
+	SetChainID(chainID []byte)
+	SetSoftwareVersion(version []byte)
 
 	GetMiniBlockHeadersWithDst(destId uint32) map[string]uint32
 
@@ -97,9 +102,16 @@ type Trie interface {
 	Recreate(root []byte) (Trie, error)
 	String() string
 	DeepClone() (Trie, error)
+	GetAllLeavesOnChannel(ctx context.Context, rootHash []byte) (<-chan KeyValueHolder, error)
 	IsInterfaceNil() bool
 }
 
+// KeyValueHolder holds a key and its associated value
+type KeyValueHolder interface {
+	Key() []byte
+	Value() []byte
+}
+
 // DBWriteCacher is used to cache changes made to the trie, and only write to the database when it's needed
 type DBWriteCacher interface {
 	Put(key, val []byte) error
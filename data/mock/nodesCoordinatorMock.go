@@ -127,6 +127,18 @@ func (ncm *NodesCoordinatorMock) GetValidatorsRewardsAddresses(
 	return addresses, nil
 }
 
+func (ncm *NodesCoordinatorMock) ConsensusGroupSize(shardId uint32) int {
+	if shardId == sharding.MetachainShardId {
+		return int(ncm.MetaConsensusSize)
+	}
+
+	return int(ncm.ShardConsensusSize)
+}
+
+func (ncm *NodesCoordinatorMock) ConsensusThreshold(shardId uint32) int {
+	return ncm.ConsensusGroupSize(shardId)*2/3 + 1
+}
+
 func (ncm *NodesCoordinatorMock) SetNodesPerShards(nodes map[uint32][]sharding.Validator) error {
 	if ncm.LoadNodesPerShardsCalled != nil {
 		return ncm.LoadNodesPerShardsCalled(nodes)
@@ -7,6 +7,7 @@ type StorerStub struct {
 	RemoveCalled      func(key []byte) error
 	ClearCacheCalled  func()
 	DestroyUnitCalled func() error
+	CloseCalled       func() error
 }
 
 func (ss *StorerStub) Put(key, data []byte) error {
@@ -33,6 +34,13 @@ func (ss *StorerStub) DestroyUnit() error {
 	return ss.DestroyUnitCalled()
 }
 
+func (ss *StorerStub) Close() error {
+	if ss.CloseCalled != nil {
+		return ss.CloseCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ss *StorerStub) IsInterfaceNil() bool {
 	if ss == nil {
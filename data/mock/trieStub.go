@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"errors"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -9,15 +10,16 @@ import (
 var errNotImplemented = errors.New("not implemented")
 
 type TrieStub struct {
-	GetCalled         func(key []byte) ([]byte, error)
-	UpdateCalled      func(key, value []byte) error
-	DeleteCalled      func(key []byte) error
-	RootCalled        func() ([]byte, error)
-	ProveCalled       func(key []byte) ([][]byte, error)
-	VerifyProofCalled func(proofs [][]byte, key []byte) (bool, error)
-	CommitCalled      func() error
-	RecreateCalled    func(root []byte) (data.Trie, error)
-	DeepCloneCalled   func() (data.Trie, error)
+	GetCalled                   func(key []byte) ([]byte, error)
+	UpdateCalled                func(key, value []byte) error
+	DeleteCalled                func(key []byte) error
+	RootCalled                  func() ([]byte, error)
+	ProveCalled                 func(key []byte) ([][]byte, error)
+	VerifyProofCalled           func(proofs [][]byte, key []byte) (bool, error)
+	CommitCalled                func() error
+	RecreateCalled              func(root []byte) (data.Trie, error)
+	DeepCloneCalled             func() (data.Trie, error)
+	GetAllLeavesOnChannelCalled func(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error)
 }
 
 func (ts *TrieStub) Get(key []byte) ([]byte, error) {
@@ -92,6 +94,14 @@ func (ts *TrieStub) DeepClone() (data.Trie, error) {
 	return ts.DeepCloneCalled()
 }
 
+func (ts *TrieStub) GetAllLeavesOnChannel(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error) {
+	if ts.GetAllLeavesOnChannelCalled != nil {
+		return ts.GetAllLeavesOnChannelCalled(ctx, rootHash)
+	}
+
+	return nil, errNotImplemented
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ts *TrieStub) IsInterfaceNil() bool {
 	if ts == nil {
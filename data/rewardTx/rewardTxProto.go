@@ -0,0 +1,47 @@
+package rewardTx
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	protobuf "github.com/ElrondNetwork/elrond-go/data/rewardTx/proto"
+)
+
+// MarshalProto serializes the reward transaction through its gogo protobuf message
+func (rtx *RewardTx) MarshalProto() ([]byte, error) {
+	value, err := data.BigIntToProtoBytes(rtx.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	prtx := &protobuf.RewardTx{
+		Round:   rtx.Round,
+		Epoch:   rtx.Epoch,
+		Value:   value,
+		RcvAddr: rtx.RcvAddr,
+		ShardId: rtx.ShardId,
+	}
+
+	return prtx.Marshal()
+}
+
+// UnmarshalProto loads the reward transaction from the data serialized through its gogo protobuf message
+func (rtx *RewardTx) UnmarshalProto(buff []byte) error {
+	prtx := &protobuf.RewardTx{}
+	err := prtx.Unmarshal(buff)
+	if err != nil {
+		return err
+	}
+
+	value, err := data.BigIntFromProtoBytes(prtx.Value)
+	if err != nil {
+		return err
+	}
+
+	*rtx = RewardTx{
+		Round:   prtx.Round,
+		Epoch:   prtx.Epoch,
+		Value:   value,
+		RcvAddr: prtx.RcvAddr,
+		ShardId: prtx.ShardId,
+	}
+	return nil
+}
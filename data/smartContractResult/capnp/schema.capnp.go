@@ -12,31 +12,41 @@ import (
 type SmartContractResultCapn C.Struct
 
 func NewSmartContractResultCapn(s *C.Segment) SmartContractResultCapn {
-	return SmartContractResultCapn(s.NewStruct(8, 6))
+	return SmartContractResultCapn(s.NewStruct(32, 7))
 }
 func NewRootSmartContractResultCapn(s *C.Segment) SmartContractResultCapn {
-	return SmartContractResultCapn(s.NewRootStruct(8, 6))
+	return SmartContractResultCapn(s.NewRootStruct(32, 7))
 }
 func AutoNewSmartContractResultCapn(s *C.Segment) SmartContractResultCapn {
-	return SmartContractResultCapn(s.NewStructAR(8, 6))
+	return SmartContractResultCapn(s.NewStructAR(32, 7))
 }
 func ReadRootSmartContractResultCapn(s *C.Segment) SmartContractResultCapn {
 	return SmartContractResultCapn(s.Root(0).ToStruct())
 }
-func (s SmartContractResultCapn) Nonce() uint64       { return C.Struct(s).Get64(0) }
-func (s SmartContractResultCapn) SetNonce(v uint64)   { C.Struct(s).Set64(0, v) }
-func (s SmartContractResultCapn) Value() []byte       { return C.Struct(s).GetObject(0).ToData() }
-func (s SmartContractResultCapn) SetValue(v []byte)   { C.Struct(s).SetObject(0, s.Segment.NewData(v)) }
-func (s SmartContractResultCapn) RcvAddr() []byte     { return C.Struct(s).GetObject(1).ToData() }
-func (s SmartContractResultCapn) SetRcvAddr(v []byte) { C.Struct(s).SetObject(1, s.Segment.NewData(v)) }
-func (s SmartContractResultCapn) SndAddr() []byte     { return C.Struct(s).GetObject(2).ToData() }
-func (s SmartContractResultCapn) SetSndAddr(v []byte) { C.Struct(s).SetObject(2, s.Segment.NewData(v)) }
-func (s SmartContractResultCapn) Code() []byte        { return C.Struct(s).GetObject(3).ToData() }
-func (s SmartContractResultCapn) SetCode(v []byte)    { C.Struct(s).SetObject(3, s.Segment.NewData(v)) }
-func (s SmartContractResultCapn) Data() []byte        { return C.Struct(s).GetObject(4).ToData() }
-func (s SmartContractResultCapn) SetData(v []byte)    { C.Struct(s).SetObject(4, s.Segment.NewData(v)) }
-func (s SmartContractResultCapn) TxHash() []byte      { return C.Struct(s).GetObject(5).ToData() }
-func (s SmartContractResultCapn) SetTxHash(v []byte)  { C.Struct(s).SetObject(5, s.Segment.NewData(v)) }
+func (s SmartContractResultCapn) Nonce() uint64          { return C.Struct(s).Get64(0) }
+func (s SmartContractResultCapn) SetNonce(v uint64)      { C.Struct(s).Set64(0, v) }
+func (s SmartContractResultCapn) Value() []byte          { return C.Struct(s).GetObject(0).ToData() }
+func (s SmartContractResultCapn) SetValue(v []byte)      { C.Struct(s).SetObject(0, s.Segment.NewData(v)) }
+func (s SmartContractResultCapn) RcvAddr() []byte        { return C.Struct(s).GetObject(1).ToData() }
+func (s SmartContractResultCapn) SetRcvAddr(v []byte)    { C.Struct(s).SetObject(1, s.Segment.NewData(v)) }
+func (s SmartContractResultCapn) SndAddr() []byte        { return C.Struct(s).GetObject(2).ToData() }
+func (s SmartContractResultCapn) SetSndAddr(v []byte)    { C.Struct(s).SetObject(2, s.Segment.NewData(v)) }
+func (s SmartContractResultCapn) Code() []byte           { return C.Struct(s).GetObject(3).ToData() }
+func (s SmartContractResultCapn) SetCode(v []byte)       { C.Struct(s).SetObject(3, s.Segment.NewData(v)) }
+func (s SmartContractResultCapn) Data() []byte           { return C.Struct(s).GetObject(4).ToData() }
+func (s SmartContractResultCapn) SetData(v []byte)       { C.Struct(s).SetObject(4, s.Segment.NewData(v)) }
+func (s SmartContractResultCapn) TxHash() []byte         { return C.Struct(s).GetObject(5).ToData() }
+func (s SmartContractResultCapn) SetTxHash(v []byte)     { C.Struct(s).SetObject(5, s.Segment.NewData(v)) }
+func (s SmartContractResultCapn) GasLimit() uint64       { return C.Struct(s).Get64(8) }
+func (s SmartContractResultCapn) SetGasLimit(v uint64)   { C.Struct(s).Set64(8, v) }
+func (s SmartContractResultCapn) GasPrice() uint64       { return C.Struct(s).Get64(16) }
+func (s SmartContractResultCapn) SetGasPrice(v uint64)   { C.Struct(s).Set64(16, v) }
+func (s SmartContractResultCapn) CallType() uint8        { return C.Struct(s).Get8(24) }
+func (s SmartContractResultCapn) SetCallType(v uint8)    { C.Struct(s).Set8(24, v) }
+func (s SmartContractResultCapn) OriginalSender() []byte { return C.Struct(s).GetObject(6).ToData() }
+func (s SmartContractResultCapn) SetOriginalSender(v []byte) {
+	C.Struct(s).SetObject(6, s.Segment.NewData(v))
+}
 func (s SmartContractResultCapn) WriteJSON(w io.Writer) error {
 	b := bufio.NewWriter(w)
 	var err error
@@ -175,6 +185,82 @@ func (s SmartContractResultCapn) WriteJSON(w io.Writer) error {
 			return err
 		}
 	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"gasLimit\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.GasLimit()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"gasPrice\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.GasPrice()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"callType\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.CallType()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"originalSender\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.OriginalSender()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte('}')
 	if err != nil {
 		return err
@@ -325,6 +411,82 @@ func (s SmartContractResultCapn) WriteCapLit(w io.Writer) error {
 			return err
 		}
 	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("gasLimit = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.GasLimit()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("gasPrice = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.GasPrice()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("callType = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.CallType()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("originalSender = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.OriginalSender()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte(')')
 	if err != nil {
 		return err
@@ -341,7 +503,7 @@ func (s SmartContractResultCapn) MarshalCapLit() ([]byte, error) {
 type SmartContractResultCapn_List C.PointerList
 
 func NewSmartContractResultCapnList(s *C.Segment, sz int) SmartContractResultCapn_List {
-	return SmartContractResultCapn_List(s.NewCompositeList(8, 6, sz))
+	return SmartContractResultCapn_List(s.NewCompositeList(32, 7, sz))
 }
 func (s SmartContractResultCapn_List) Len() int { return C.PointerList(s).Len() }
 func (s SmartContractResultCapn_List) At(i int) SmartContractResultCapn {
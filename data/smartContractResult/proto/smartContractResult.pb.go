@@ -0,0 +1,810 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: smartContractResult.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SmartContractResult struct {
+	Nonce          uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Value          []byte `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	RcvAddr        []byte `protobuf:"bytes,3,opt,name=RcvAddr,proto3" json:"RcvAddr,omitempty"`
+	SndAddr        []byte `protobuf:"bytes,4,opt,name=SndAddr,proto3" json:"SndAddr,omitempty"`
+	Code           []byte `protobuf:"bytes,5,opt,name=Code,proto3" json:"Code,omitempty"`
+	Data           []byte `protobuf:"bytes,6,opt,name=Data,proto3" json:"Data,omitempty"`
+	TxHash         []byte `protobuf:"bytes,7,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	GasLimit       uint64 `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	GasPrice       uint64 `protobuf:"varint,9,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+	CallType       uint32 `protobuf:"varint,10,opt,name=CallType,proto3" json:"CallType,omitempty"`
+	OriginalSender []byte `protobuf:"bytes,11,opt,name=OriginalSender,proto3" json:"OriginalSender,omitempty"`
+}
+
+func (m *SmartContractResult) Reset()         { *m = SmartContractResult{} }
+func (m *SmartContractResult) String() string { return proto.CompactTextString(m) }
+func (*SmartContractResult) ProtoMessage()    {}
+func (*SmartContractResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edc1605de0d3d805, []int{0}
+}
+func (m *SmartContractResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SmartContractResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SmartContractResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SmartContractResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SmartContractResult.Merge(m, src)
+}
+func (m *SmartContractResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SmartContractResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SmartContractResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SmartContractResult proto.InternalMessageInfo
+
+func (m *SmartContractResult) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *SmartContractResult) GetRcvAddr() []byte {
+	if m != nil {
+		return m.RcvAddr
+	}
+	return nil
+}
+
+func (m *SmartContractResult) GetSndAddr() []byte {
+	if m != nil {
+		return m.SndAddr
+	}
+	return nil
+}
+
+func (m *SmartContractResult) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *SmartContractResult) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SmartContractResult) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *SmartContractResult) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetCallType() uint32 {
+	if m != nil {
+		return m.CallType
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetOriginalSender() []byte {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SmartContractResult)(nil), "protobuf.SmartContractResult")
+}
+
+func init() { proto.RegisterFile("smartContractResult.proto", fileDescriptor_edc1605de0d3d805) }
+
+var fileDescriptor_edc1605de0d3d805 = []byte{
+	// 267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x4d, 0x4e, 0x84, 0x30,
+	0x14, 0x80, 0xe9, 0xc8, 0x30, 0x58, 0x7f, 0x16, 0xd5, 0x98, 0xa7, 0x8b, 0x86, 0xb8, 0x30, 0xac,
+	0xdc, 0x78, 0x02, 0xc5, 0x44, 0x17, 0x46, 0x0d, 0x4c, 0xdc, 0x77, 0xa0, 0x2a, 0x09, 0xd3, 0x4e,
+	0x4a, 0x31, 0x7a, 0x0b, 0xaf, 0xe2, 0x2d, 0x5c, 0xce, 0xd2, 0xa5, 0x81, 0x8b, 0x98, 0x3e, 0x90,
+	0x85, 0xae, 0xfa, 0xbe, 0xef, 0x6b, 0xd2, 0xe6, 0xd1, 0xc3, 0x7a, 0x29, 0x8c, 0x4d, 0xb4, 0xb2,
+	0x46, 0xe4, 0x36, 0x95, 0x75, 0x53, 0xd9, 0xd3, 0x95, 0xd1, 0x56, 0xb3, 0x10, 0x8f, 0x45, 0xf3,
+	0x78, 0xfc, 0x31, 0xa1, 0x7b, 0xd9, 0xff, 0x7b, 0x6c, 0x9f, 0x4e, 0x6f, 0xb5, 0xca, 0x25, 0x90,
+	0x88, 0xc4, 0x7e, 0xda, 0x83, 0xb3, 0x0f, 0xa2, 0x6a, 0x24, 0x4c, 0x22, 0x12, 0x6f, 0xa7, 0x3d,
+	0x30, 0xa0, 0xb3, 0x34, 0x7f, 0x39, 0x2f, 0x0a, 0x03, 0x1b, 0xe8, 0x7f, 0xd1, 0x95, 0x4c, 0x15,
+	0x58, 0xfc, 0xbe, 0x0c, 0xc8, 0x18, 0xf5, 0x13, 0x5d, 0x48, 0x98, 0xa2, 0xc6, 0xd9, 0xb9, 0x4b,
+	0x61, 0x05, 0x04, 0xbd, 0x73, 0x33, 0x3b, 0xa0, 0xc1, 0xfc, 0xf5, 0x5a, 0xd4, 0xcf, 0x30, 0x43,
+	0x3b, 0x10, 0x3b, 0xa2, 0xe1, 0x95, 0xa8, 0x6f, 0xca, 0x65, 0x69, 0x21, 0xc4, 0x2f, 0x8e, 0x3c,
+	0xb4, 0x7b, 0x53, 0xe6, 0x12, 0x36, 0xc7, 0x86, 0xec, 0x5a, 0x22, 0xaa, 0x6a, 0xfe, 0xb6, 0x92,
+	0x40, 0x23, 0x12, 0xef, 0xa4, 0x23, 0xb3, 0x13, 0xba, 0x7b, 0x67, 0xca, 0xa7, 0x52, 0x89, 0x2a,
+	0x93, 0xaa, 0x90, 0x06, 0xb6, 0xf0, 0xcd, 0x3f, 0xf6, 0x02, 0x3e, 0x5b, 0x4e, 0xd6, 0x2d, 0x27,
+	0xdf, 0x2d, 0x27, 0xef, 0x1d, 0xf7, 0xd6, 0x1d, 0xf7, 0xbe, 0x3a, 0xee, 0x2d, 0x02, 0xdc, 0xeb,
+	0xd9, 0xcf, 0x00, 0x7e, 0x13, 0x69, 0x8a, 0x7b, 0x01, 0x00, 0x00,
+}
+
+func (m *SmartContractResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SmartContractResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SmartContractResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CallType != 0 {
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(m.CallType))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SndAddr) > 0 {
+		i -= len(m.SndAddr)
+		copy(dAtA[i:], m.SndAddr)
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(len(m.SndAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RcvAddr) > 0 {
+		i -= len(m.RcvAddr)
+		copy(dAtA[i:], m.RcvAddr)
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(len(m.RcvAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSmartContractResult(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSmartContractResult(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SmartContractResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovSmartContractResult(uint64(m.Nonce))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSmartContractResult(uint64(l))
+	}
+	l = len(m.RcvAddr)
+	if l > 0 {
+		n += 1 + l + sovSmartContractResult(uint64(l))
+	}
+	l = len(m.SndAddr)
+	if l > 0 {
+		n += 1 + l + sovSmartContractResult(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovSmartContractResult(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovSmartContractResult(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSmartContractResult(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSmartContractResult(uint64(m.GasLimit))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovSmartContractResult(uint64(m.GasPrice))
+	}
+	if m.CallType != 0 {
+		n += 1 + sovSmartContractResult(uint64(m.CallType))
+	}
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovSmartContractResult(uint64(l))
+	}
+	return n
+}
+
+func sovSmartContractResult(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSmartContractResult(x uint64) (n int) {
+	return sovSmartContractResult(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SmartContractResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartContractResult
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SmartContractResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SmartContractResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RcvAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RcvAddr = append(m.RcvAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.RcvAddr == nil {
+				m.RcvAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SndAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SndAddr = append(m.SndAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.SndAddr == nil {
+				m.SndAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallType", wireType)
+			}
+			m.CallType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = append(m.OriginalSender[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalSender == nil {
+				m.OriginalSender = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartContractResult(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSmartContractResult(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSmartContractResult
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSmartContractResult
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthSmartContractResult
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowSmartContractResult
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipSmartContractResult(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthSmartContractResult
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthSmartContractResult = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSmartContractResult   = fmt.Errorf("proto: integer overflow")
+)
@@ -8,15 +8,32 @@ import (
 	capn "github.com/glycerine/go-capnproto"
 )
 
+// CallType specifies how a smart contract result has to be processed by the receiver shard
+type CallType uint8
+
+const (
+	// DirectCall signals a smart contract result which only updates the receiver account
+	DirectCall CallType = iota
+	// AsynchronousCall signals a smart contract result which calls a function of a contract from another shard
+	AsynchronousCall
+	// AsynchronousCallBack signals a smart contract result which returns the outcome of an asynchronous call to the
+	// calling contract
+	AsynchronousCallBack
+)
+
 // SmartContractResult holds all the data needed for a value transfer
 type SmartContractResult struct {
-	Nonce   uint64   `capid:"0" json:"nonce"`
-	Value   *big.Int `capid:"1" json:"value"`
-	RcvAddr []byte   `capid:"2" json:"receiver"`
-	SndAddr []byte   `capid:"3" json:"sender"`
-	Code    []byte   `capid:"4" json:"code,omitempty"`
-	Data    string   `capid:"5" json:"data,omitempty"`
-	TxHash  []byte   `capid:"6" json:"txHash"`
+	Nonce          uint64   `capid:"0" json:"nonce"`
+	Value          *big.Int `capid:"1" json:"value"`
+	RcvAddr        []byte   `capid:"2" json:"receiver"`
+	SndAddr        []byte   `capid:"3" json:"sender"`
+	Code           []byte   `capid:"4" json:"code,omitempty"`
+	Data           string   `capid:"5" json:"data,omitempty"`
+	TxHash         []byte   `capid:"6" json:"txHash"`
+	GasLimit       uint64   `capid:"7" json:"gasLimit,omitempty"`
+	GasPrice       uint64   `capid:"8" json:"gasPrice,omitempty"`
+	CallType       CallType `capid:"9" json:"callType,omitempty"`
+	OriginalSender []byte   `capid:"10" json:"originalSender,omitempty"`
 }
 
 // Save saves the serialized data of a SmartContractResult into a stream through Capnp protocol
@@ -61,6 +78,10 @@ func SmartContractResultCapnToGo(src capnp.SmartContractResultCapn, dest *SmartC
 	dest.Data = string(src.Data())
 	dest.Code = src.Code()
 	dest.TxHash = src.TxHash()
+	dest.GasLimit = src.GasLimit()
+	dest.GasPrice = src.GasPrice()
+	dest.CallType = CallType(src.CallType())
+	dest.OriginalSender = src.OriginalSender()
 
 	return dest
 }
@@ -77,6 +98,10 @@ func SmartContractResultGoToCapn(seg *capn.Segment, src *SmartContractResult) ca
 	dest.SetData([]byte(src.Data))
 	dest.SetCode(src.Code)
 	dest.SetTxHash(src.TxHash)
+	dest.SetGasLimit(src.GasLimit)
+	dest.SetGasPrice(src.GasPrice)
+	dest.SetCallType(uint8(src.CallType))
+	dest.SetOriginalSender(src.OriginalSender)
 
 	return dest
 }
@@ -113,12 +138,12 @@ func (scr *SmartContractResult) GetSndAddress() []byte {
 
 // GetGasLimit returns the gas limit of the smart contract result
 func (scr *SmartContractResult) GetGasLimit() uint64 {
-	return 0
+	return scr.GasLimit
 }
 
 // GetGasPrice returns the gas price of the smart contract result
 func (scr *SmartContractResult) GetGasPrice() uint64 {
-	return 0
+	return scr.GasPrice
 }
 
 // SetValue sets the value of the smart contract result
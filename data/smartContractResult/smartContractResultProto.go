@@ -0,0 +1,59 @@
+package smartContractResult
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	protobuf "github.com/ElrondNetwork/elrond-go/data/smartContractResult/proto"
+)
+
+// MarshalProto serializes the smart contract result through its gogo protobuf message
+func (scr *SmartContractResult) MarshalProto() ([]byte, error) {
+	value, err := data.BigIntToProtoBytes(scr.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	pscr := &protobuf.SmartContractResult{
+		Nonce:          scr.Nonce,
+		Value:          value,
+		RcvAddr:        scr.RcvAddr,
+		SndAddr:        scr.SndAddr,
+		Code:           scr.Code,
+		Data:           []byte(scr.Data),
+		TxHash:         scr.TxHash,
+		GasLimit:       scr.GasLimit,
+		GasPrice:       scr.GasPrice,
+		CallType:       uint32(scr.CallType),
+		OriginalSender: scr.OriginalSender,
+	}
+
+	return pscr.Marshal()
+}
+
+// UnmarshalProto loads the smart contract result from the data serialized through its gogo protobuf message
+func (scr *SmartContractResult) UnmarshalProto(buff []byte) error {
+	pscr := &protobuf.SmartContractResult{}
+	err := pscr.Unmarshal(buff)
+	if err != nil {
+		return err
+	}
+
+	value, err := data.BigIntFromProtoBytes(pscr.Value)
+	if err != nil {
+		return err
+	}
+
+	*scr = SmartContractResult{
+		Nonce:          pscr.Nonce,
+		Value:          value,
+		RcvAddr:        pscr.RcvAddr,
+		SndAddr:        pscr.SndAddr,
+		Code:           pscr.Code,
+		Data:           string(pscr.Data),
+		TxHash:         pscr.TxHash,
+		GasLimit:       pscr.GasLimit,
+		GasPrice:       pscr.GasPrice,
+		CallType:       CallType(pscr.CallType),
+		OriginalSender: pscr.OriginalSender,
+	}
+	return nil
+}
@@ -11,13 +11,17 @@ import (
 
 func TestSmartContractResult_SaveLoad(t *testing.T) {
 	smrS := smartContractResult.SmartContractResult{
-		Nonce:   uint64(1),
-		Value:   big.NewInt(1),
-		RcvAddr: []byte("receiver_address"),
-		SndAddr: []byte("sender_address"),
-		Data:    "scr_data",
-		Code:    []byte("code"),
-		TxHash:  []byte("scrHash"),
+		Nonce:          uint64(1),
+		Value:          big.NewInt(1),
+		RcvAddr:        []byte("receiver_address"),
+		SndAddr:        []byte("sender_address"),
+		Data:           "scr_data",
+		Code:           []byte("code"),
+		TxHash:         []byte("scrHash"),
+		GasLimit:       uint64(2),
+		GasPrice:       uint64(3),
+		CallType:       smartContractResult.AsynchronousCall,
+		OriginalSender: []byte("original_sender"),
 	}
 
 	var b bytes.Buffer
@@ -104,3 +108,12 @@ func TestSmartContractResult_SetValue(t *testing.T) {
 
 	assert.Equal(t, value, scr.Value)
 }
+
+func TestSmartContractResult_GetGasLimitAndGasPrice(t *testing.T) {
+	t.Parallel()
+
+	scr := &smartContractResult.SmartContractResult{GasLimit: 10, GasPrice: 2}
+
+	assert.Equal(t, uint64(10), scr.GetGasLimit())
+	assert.Equal(t, uint64(2), scr.GetGasPrice())
+}
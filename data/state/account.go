@@ -13,6 +13,11 @@ type Account struct {
 	CodeHash []byte
 	RootHash []byte
 
+	OwnerAddress    []byte
+	CodeMetadata    []byte
+	DeveloperReward *big.Int
+	UserName        []byte
+
 	addressContainer AddressContainer
 	code             []byte
 	accountTracker   AccountTracker
@@ -30,6 +35,7 @@ func NewAccount(addressContainer AddressContainer, tracker AccountTracker) (*Acc
 
 	return &Account{
 		Balance:          big.NewInt(0),
+		DeveloperReward:  big.NewInt(0),
 		addressContainer: addressContainer,
 		accountTracker:   tracker,
 		dataTrieTracker:  NewTrackableDataTrie(nil),
@@ -120,6 +126,87 @@ func (a *Account) SetCode(code []byte) {
 	a.code = code
 }
 
+//------- owner / code metadata
+
+// GetOwnerAddress returns the address of the account that deployed the smart contract held by this account
+func (a *Account) GetOwnerAddress() []byte {
+	return a.OwnerAddress
+}
+
+// SetOwnerAddressWithJournal sets the account's owner address, saving the old owner address before changing
+func (a *Account) SetOwnerAddressWithJournal(ownerAddress []byte) error {
+	entry, err := NewJournalEntryOwnerAddress(a, a.OwnerAddress)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.OwnerAddress = ownerAddress
+
+	return a.accountTracker.SaveAccount(a)
+}
+
+// GetCodeMetadata returns the metadata flags of the smart contract held by this account
+func (a *Account) GetCodeMetadata() []byte {
+	return a.CodeMetadata
+}
+
+// SetCodeMetadataWithJournal sets the account's code metadata, saving the old code metadata before changing
+func (a *Account) SetCodeMetadataWithJournal(codeMetadata []byte) error {
+	entry, err := NewJournalEntryCodeMetadata(a, a.CodeMetadata)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.CodeMetadata = codeMetadata
+
+	return a.accountTracker.SaveAccount(a)
+}
+
+//------- developer reward / user name
+
+// GetDeveloperReward returns the reward accumulated by the smart contract held by this account, which can be
+// claimed by the contract's owner
+func (a *Account) GetDeveloperReward() *big.Int {
+	if a.DeveloperReward == nil {
+		return big.NewInt(0)
+	}
+
+	return a.DeveloperReward
+}
+
+// SetDeveloperRewardWithJournal sets the account's developer reward, saving the old developer reward before changing
+func (a *Account) SetDeveloperRewardWithJournal(developerReward *big.Int) error {
+	entry, err := NewJournalEntryDeveloperReward(a, a.DeveloperReward)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.DeveloperReward = developerReward
+
+	return a.accountTracker.SaveAccount(a)
+}
+
+// GetUserName returns the user name associated with the account
+func (a *Account) GetUserName() []byte {
+	return a.UserName
+}
+
+// SetUserNameWithJournal sets the account's user name, saving the old user name before changing
+func (a *Account) SetUserNameWithJournal(userName []byte) error {
+	entry, err := NewJournalEntryUserName(a, a.UserName)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.UserName = userName
+
+	return a.accountTracker.SaveAccount(a)
+}
+
 //------- data trie / root hash
 
 // GetRootHash returns the root hash associated with this account
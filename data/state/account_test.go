@@ -257,3 +257,111 @@ func TestAccount_SetRootHashWithJournal(t *testing.T) {
 	assert.Equal(t, 1, journalizeCalled)
 	assert.Equal(t, 1, saveAccountCalled)
 }
+
+func TestAccount_SetOwnerAddressWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+
+	value := []byte("owner")
+	err = acc.SetOwnerAddressWithJournal(value)
+
+	assert.Nil(t, err)
+	assert.Equal(t, value, acc.GetOwnerAddress())
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
+
+func TestAccount_SetCodeMetadataWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+
+	value := []byte{1}
+	err = acc.SetCodeMetadataWithJournal(value)
+
+	assert.Nil(t, err)
+	assert.Equal(t, value, acc.GetCodeMetadata())
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
+
+func TestAccount_SetDeveloperRewardWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+
+	value := big.NewInt(15)
+	err = acc.SetDeveloperRewardWithJournal(value)
+
+	assert.Nil(t, err)
+	assert.Equal(t, value, acc.GetDeveloperReward())
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
+
+func TestAccount_SetUserNameWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+
+	value := []byte("alice")
+	err = acc.SetUserNameWithJournal(value)
+
+	assert.Nil(t, err)
+	assert.Equal(t, value, acc.GetUserName())
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
@@ -2,6 +2,8 @@ package state
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
 	"errors"
 	"strconv"
 	"sync"
@@ -11,6 +13,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 )
 
+// codeReferencesKeySuffix is appended to a code hash to obtain the trie key holding the number of accounts which use
+// that code
+const codeReferencesKeySuffix = "_references"
+
+const codeReferencesLength = 8
+
 // AccountsDB is the struct used for accessing accounts
 type AccountsDB struct {
 	mainTrie       data.Trie
@@ -53,7 +61,8 @@ func NewAccountsDB(
 }
 
 // PutCode sets the SC plain code in AccountHandler object and trie, code hash in AccountState.
-// Errors if something went wrong
+// The code is stored once per code hash, together with the number of accounts which use it. When the account already
+// had a different code, the reference to the old code is released. Errors if something went wrong
 func (adb *AccountsDB) PutCode(accountHandler AccountHandler, code []byte) error {
 	if code == nil {
 		return ErrNilCode
@@ -63,12 +72,24 @@ func (adb *AccountsDB) PutCode(accountHandler AccountHandler, code []byte) error
 	}
 
 	codeHash := adb.hasher.Compute(string(code))
+	oldCodeHash := accountHandler.GetCodeHash()
+	if bytes.Equal(oldCodeHash, codeHash) {
+		accountHandler.SetCode(code)
+		return nil
+	}
 
-	err := adb.addCodeToTrieIfMissing(codeHash, code)
+	err := adb.addCodeReference(codeHash, code)
 	if err != nil {
 		return err
 	}
 
+	if len(oldCodeHash) != 0 {
+		err = adb.RemoveCode(oldCodeHash)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = accountHandler.SetCodeHashWithJournal(codeHash)
 	if err != nil {
 		return err
@@ -78,27 +99,96 @@ func (adb *AccountsDB) PutCode(accountHandler AccountHandler, code []byte) error
 	return nil
 }
 
-func (adb *AccountsDB) addCodeToTrieIfMissing(codeHash []byte, code []byte) error {
-	val, err := adb.mainTrie.Get(codeHash)
+func (adb *AccountsDB) addCodeReference(codeHash []byte, code []byte) error {
+	numReferences, err := adb.getCodeReferences(codeHash)
 	if err != nil {
 		return err
 	}
-	if val == nil {
-		//append a journal entry as the code needs to be inserted in the trie
-		entry, err := NewBaseJournalEntryCreation(codeHash, adb.mainTrie)
+
+	if numReferences == 0 {
+		err = adb.updateTrieWithJournal(codeHash, code)
 		if err != nil {
 			return err
 		}
-		adb.Journalize(entry)
-		return adb.mainTrie.Update(codeHash, code)
 	}
 
-	return nil
+	return adb.updateTrieWithJournal(codeReferencesKey(codeHash), uint64ToBytes(numReferences+1))
 }
 
-// RemoveCode deletes the code from the trie. It writes an empty byte slice at codeHash "address"
+// RemoveCode releases a reference to the code with the given hash. The code is deleted from the trie when no
+// account uses it anymore
 func (adb *AccountsDB) RemoveCode(codeHash []byte) error {
-	return adb.mainTrie.Update(codeHash, make([]byte, 0))
+	numReferences, err := adb.getCodeReferences(codeHash)
+	if err != nil {
+		return err
+	}
+
+	switch numReferences {
+	case 0:
+		return nil
+	case 1:
+		err = adb.updateTrieWithJournal(codeHash, make([]byte, 0))
+		if err != nil {
+			return err
+		}
+
+		return adb.updateTrieWithJournal(codeReferencesKey(codeHash), make([]byte, 0))
+	default:
+		return adb.updateTrieWithJournal(codeReferencesKey(codeHash), uint64ToBytes(numReferences-1))
+	}
+}
+
+// getCodeReferences returns the number of accounts which use the code with the given hash. The code saved before the
+// references were counted has no references entry, so it is considered used by a single account. Its references entry
+// is written the next time the code is added or removed
+func (adb *AccountsDB) getCodeReferences(codeHash []byte) (uint64, error) {
+	buff, err := adb.mainTrie.Get(codeReferencesKey(codeHash))
+	if err != nil {
+		return 0, err
+	}
+	if len(buff) != 0 {
+		if len(buff) != codeReferencesLength {
+			return 0, ErrInvalidCodeReferences
+		}
+
+		return binary.BigEndian.Uint64(buff), nil
+	}
+
+	code, err := adb.mainTrie.Get(codeHash)
+	if err != nil {
+		return 0, err
+	}
+	if len(code) != 0 {
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+func (adb *AccountsDB) updateTrieWithJournal(key []byte, value []byte) error {
+	oldValue, err := adb.mainTrie.Get(key)
+	if err != nil {
+		return err
+	}
+
+	entry, err := NewBaseJournalEntryTrieUpdate(key, oldValue, adb.mainTrie)
+	if err != nil {
+		return err
+	}
+	adb.Journalize(entry)
+
+	return adb.mainTrie.Update(key, value)
+}
+
+func codeReferencesKey(codeHash []byte) []byte {
+	return append(append(make([]byte, 0, len(codeHash)+len(codeReferencesKeySuffix)), codeHash...), codeReferencesKeySuffix...)
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, codeReferencesLength)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return buff
 }
 
 // LoadDataTrie retrieves and saves the SC data inside accountHandler object.
@@ -476,6 +566,13 @@ func (adb *AccountsDB) RecreateTrie(rootHash []byte) error {
 	return nil
 }
 
+// GetAllLeavesOnChannel sends all the leaves of the accounts trie committed under the given root hash on the returned
+// channel: the keys are the addresses and the values are the marshalized accounts. Canceling the context stops the
+// iteration and closes the channel
+func (adb *AccountsDB) GetAllLeavesOnChannel(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error) {
+	return adb.mainTrie.GetAllLeavesOnChannel(ctx, rootHash)
+}
+
 // Journalize adds a new object to entries list. Concurrent safe.
 func (adb *AccountsDB) Journalize(entry JournalEntry) {
 	if entry == nil || entry.IsInterfaceNil() {
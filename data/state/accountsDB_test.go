@@ -2,6 +2,8 @@ package state_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"testing"
@@ -176,11 +178,65 @@ func TestAccountsDB_PutCodeMalfunction2TrieShouldErr(t *testing.T) {
 func TestAccountsDB_RemoveCodeShouldWork(t *testing.T) {
 	t.Parallel()
 
-	wasCalled := false
+	codeHash := []byte("AAA")
+	updatedKeys := make([][]byte, 0)
+	trieStub := mock.TrieStub{}
+	trieStub.GetCalled = func(key []byte) ([]byte, error) {
+		if bytes.Equal(key, codeHash) {
+			return []byte("code"), nil
+		}
+		return nil, nil
+	}
+	trieStub.UpdateCalled = func(key, value []byte) error {
+		assert.Equal(t, 0, len(value))
+		updatedKeys = append(updatedKeys, key)
+		return nil
+	}
+
+	adb := generateAccountDBFromTrie(&trieStub)
+
+	err := adb.RemoveCode(codeHash)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(updatedKeys))
+	assert.Equal(t, codeHash, updatedKeys[0])
+}
+
+func TestAccountsDB_RemoveCodeMoreReferencesShouldDecrement(t *testing.T) {
+	t.Parallel()
 
+	codeHash := []byte("AAA")
+	references := make([]byte, 8)
+	binary.BigEndian.PutUint64(references, 3)
+	var updatedValue []byte
 	trieStub := mock.TrieStub{}
+	trieStub.GetCalled = func(key []byte) ([]byte, error) {
+		if bytes.Equal(key, codeHash) {
+			return []byte("code"), nil
+		}
+		return references, nil
+	}
 	trieStub.UpdateCalled = func(key, value []byte) error {
-		wasCalled = true
+		assert.NotEqual(t, codeHash, key)
+		updatedValue = value
+		return nil
+	}
+
+	adb := generateAccountDBFromTrie(&trieStub)
+
+	err := adb.RemoveCode(codeHash)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), binary.BigEndian.Uint64(updatedValue))
+}
+
+func TestAccountsDB_RemoveCodeMissingCodeShouldNotUpdate(t *testing.T) {
+	t.Parallel()
+
+	trieStub := mock.TrieStub{}
+	trieStub.GetCalled = func(key []byte) ([]byte, error) {
+		return nil, nil
+	}
+	trieStub.UpdateCalled = func(key, value []byte) error {
+		assert.Fail(t, "should have not called update")
 		return nil
 	}
 
@@ -188,7 +244,20 @@ func TestAccountsDB_RemoveCodeShouldWork(t *testing.T) {
 
 	err := adb.RemoveCode([]byte("AAA"))
 	assert.Nil(t, err)
-	assert.True(t, wasCalled)
+}
+
+func TestAccountsDB_RemoveCodeInvalidReferencesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	trieStub := mock.TrieStub{}
+	trieStub.GetCalled = func(key []byte) ([]byte, error) {
+		return []byte("invalid"), nil
+	}
+
+	adb := generateAccountDBFromTrie(&trieStub)
+
+	err := adb.RemoveCode([]byte("AAA"))
+	assert.Equal(t, state.ErrInvalidCodeReferences, err)
 }
 
 //------- SaveData
@@ -751,3 +820,21 @@ func TestAccountsDB_RecreateTrieOkValsShouldWork(t *testing.T) {
 	assert.True(t, wasCalled)
 
 }
+
+func TestAccountsDB_GetAllLeavesOnChannelShouldCallTheTrie(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	expectedChannel := make(chan data.KeyValueHolder)
+	trieStub := mock.TrieStub{
+		GetAllLeavesOnChannelCalled: func(ctx context.Context, root []byte) (<-chan data.KeyValueHolder, error) {
+			assert.Equal(t, rootHash, root)
+			return expectedChannel, nil
+		},
+	}
+	adb := generateAccountDBFromTrie(&trieStub)
+
+	leavesChannel, err := adb.GetAllLeavesOnChannel(context.Background(), rootHash)
+	assert.Nil(t, err)
+	assert.Equal(t, (<-chan data.KeyValueHolder)(expectedChannel), leavesChannel)
+}
@@ -41,6 +41,45 @@ func (bjec *BaseJournalEntryCreation) IsInterfaceNil() bool {
 	return false
 }
 
+//------- BaseJournalEntryTrieUpdate
+
+// BaseJournalEntryTrieUpdate creates a value change at a key in the state trie
+// through updater it can revert the change.
+type BaseJournalEntryTrieUpdate struct {
+	key      []byte
+	oldValue []byte
+	updater  Updater
+}
+
+// NewBaseJournalEntryTrieUpdate outputs a new BaseJournalEntry implementation used to revert a value change in the trie
+func NewBaseJournalEntryTrieUpdate(key []byte, oldValue []byte, updater Updater) (*BaseJournalEntryTrieUpdate, error) {
+	if updater == nil || updater.IsInterfaceNil() {
+		return nil, ErrNilUpdater
+	}
+	if len(key) == 0 {
+		return nil, ErrNilOrEmptyKey
+	}
+
+	return &BaseJournalEntryTrieUpdate{
+		key:      key,
+		oldValue: oldValue,
+		updater:  updater,
+	}, nil
+}
+
+// Revert applies undo operation
+func (bjetu *BaseJournalEntryTrieUpdate) Revert() (AccountHandler, error) {
+	return nil, bjetu.updater.Update(bjetu.key, bjetu.oldValue)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bjetu *BaseJournalEntryTrieUpdate) IsInterfaceNil() bool {
+	if bjetu == nil {
+		return true
+	}
+	return false
+}
+
 //------- BaseJournalEntryCodeHash
 
 // BaseJournalEntryCodeHash creates a code hash change in account
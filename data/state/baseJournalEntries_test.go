@@ -56,6 +56,55 @@ func TestBaseJournalEntryCreation_RevertOkValsShouldWork(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+//------- BaseJournalEntryTrieUpdate
+
+func TestNewBaseJournalEntryTrieUpdate_NilUpdaterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewBaseJournalEntryTrieUpdate([]byte("key"), []byte("value"), nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilUpdater, err)
+}
+
+func TestNewBaseJournalEntryTrieUpdate_NilKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewBaseJournalEntryTrieUpdate(nil, []byte("value"), &mock.UpdaterStub{})
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilOrEmptyKey, err)
+}
+
+func TestNewBaseJournalEntryTrieUpdate_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewBaseJournalEntryTrieUpdate([]byte("key"), nil, &mock.UpdaterStub{})
+
+	assert.NotNil(t, entry)
+	assert.Nil(t, err)
+}
+
+func TestBaseJournalEntryTrieUpdate_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var revertedKey, revertedValue []byte
+	updater := &mock.UpdaterStub{
+		UpdateCalled: func(key, value []byte) error {
+			revertedKey = key
+			revertedValue = value
+			return nil
+		},
+	}
+
+	entry, _ := state.NewBaseJournalEntryTrieUpdate([]byte("key"), []byte("old value"), updater)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("key"), revertedKey)
+	assert.Equal(t, []byte("old value"), revertedValue)
+}
+
 //------- BaseJournalEntryCodeHash
 
 func TestNewBaseJournalEntryCodeHash_NilAccountShouldErr(t *testing.T) {
@@ -142,3 +142,6 @@ var ErrNilBLSPublicKey = errors.New("bls public key is nil")
 
 // ErrUnknownAccountType signals that the provided account type is unknown
 var ErrUnknownAccountType = errors.New("account type is unknown")
+
+// ErrInvalidCodeReferences signals that the stored number of code references is invalid
+var ErrInvalidCodeReferences = errors.New("invalid code references")
@@ -0,0 +1,66 @@
+package state
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// historicalAccountsDB recreates the accounts state at committed root hashes. As the trie nodes are never pruned,
+// every root hash committed by a block can be recreated from the trie storage
+type historicalAccountsDB struct {
+	mainTrie       data.Trie
+	hasher         hashing.Hasher
+	marshalizer    marshal.Marshalizer
+	accountFactory AccountFactory
+}
+
+// NewHistoricalAccountsDB creates a new historical accounts provider on top of the trie storage of the main trie
+func NewHistoricalAccountsDB(
+	trie data.Trie,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+	accountFactory AccountFactory,
+) (*historicalAccountsDB, error) {
+	if trie == nil || trie.IsInterfaceNil() {
+		return nil, ErrNilTrie
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if accountFactory == nil || accountFactory.IsInterfaceNil() {
+		return nil, ErrNilAccountFactory
+	}
+
+	return &historicalAccountsDB{
+		mainTrie:       trie,
+		hasher:         hasher,
+		marshalizer:    marshalizer,
+		accountFactory: accountFactory,
+	}, nil
+}
+
+// AccountsAtRootHash returns a new accounts adapter reading the state committed under the provided root hash.
+// The returned adapter is not tied to the live state, so it must only be used for queries
+func (hadb *historicalAccountsDB) AccountsAtRootHash(rootHash []byte) (AccountsAdapter, error) {
+	trie, err := hadb.mainTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+	if trie == nil || trie.IsInterfaceNil() {
+		return nil, ErrNilTrie
+	}
+
+	return NewAccountsDB(trie, hadb.hasher, hadb.marshalizer, hadb.accountFactory)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hadb *historicalAccountsDB) IsInterfaceNil() bool {
+	if hadb == nil {
+		return true
+	}
+	return false
+}
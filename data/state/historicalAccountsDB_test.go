@@ -0,0 +1,90 @@
+package state_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHistoricalAccountsDB_WithNilTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hadb, err := state.NewHistoricalAccountsDB(nil, &mock.HasherMock{}, &mock.MarshalizerMock{}, &mock.AccountsFactoryStub{})
+
+	assert.Nil(t, hadb)
+	assert.Equal(t, state.ErrNilTrie, err)
+}
+
+func TestNewHistoricalAccountsDB_WithNilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hadb, err := state.NewHistoricalAccountsDB(&mock.TrieStub{}, nil, &mock.MarshalizerMock{}, &mock.AccountsFactoryStub{})
+
+	assert.Nil(t, hadb)
+	assert.Equal(t, state.ErrNilHasher, err)
+}
+
+func TestNewHistoricalAccountsDB_WithNilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hadb, err := state.NewHistoricalAccountsDB(&mock.TrieStub{}, &mock.HasherMock{}, nil, &mock.AccountsFactoryStub{})
+
+	assert.Nil(t, hadb)
+	assert.Equal(t, state.ErrNilMarshalizer, err)
+}
+
+func TestNewHistoricalAccountsDB_WithNilAccountFactoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hadb, err := state.NewHistoricalAccountsDB(&mock.TrieStub{}, &mock.HasherMock{}, &mock.MarshalizerMock{}, nil)
+
+	assert.Nil(t, hadb)
+	assert.Equal(t, state.ErrNilAccountFactory, err)
+}
+
+func TestHistoricalAccountsDB_AccountsAtRootHashRecreateFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("root hash not found")
+	tr := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return nil, expectedErr
+		},
+	}
+	hadb, _ := state.NewHistoricalAccountsDB(tr, &mock.HasherMock{}, &mock.MarshalizerMock{}, &mock.AccountsFactoryStub{})
+
+	accounts, err := hadb.AccountsAtRootHash([]byte("root hash"))
+
+	assert.Nil(t, accounts)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestHistoricalAccountsDB_AccountsAtRootHashShouldUseRecreatedTrie(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	recreatedTrie := &mock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+	}
+	tr := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			assert.Equal(t, rootHash, root)
+			return recreatedTrie, nil
+		},
+	}
+	hadb, err := state.NewHistoricalAccountsDB(tr, &mock.HasherMock{}, &mock.MarshalizerMock{}, &mock.AccountsFactoryStub{})
+	assert.Nil(t, err)
+	assert.False(t, hadb.IsInterfaceNil())
+
+	accounts, err := hadb.AccountsAtRootHash(rootHash)
+	assert.Nil(t, err)
+
+	accountsRootHash, _ := accounts.RootHash()
+	assert.Equal(t, rootHash, accountsRootHash)
+}
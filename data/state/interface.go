@@ -1,6 +1,8 @@
 package state
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/data"
 )
 
@@ -93,6 +95,13 @@ type AccountsAdapter interface {
 	PutCode(accountHandler AccountHandler, code []byte) error
 	RemoveCode(codeHash []byte) error
 	SaveDataTrie(accountHandler AccountHandler) error
+	GetAllLeavesOnChannel(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error)
+	IsInterfaceNil() bool
+}
+
+// HistoricalAccountsProvider is used to query the accounts state as it was committed under a given root hash
+type HistoricalAccountsProvider interface {
+	AccountsAtRootHash(rootHash []byte) (AccountsAdapter, error)
 	IsInterfaceNil() bool
 }
 
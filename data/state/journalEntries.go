@@ -38,3 +38,143 @@ func (jeb *JournalEntryBalance) IsInterfaceNil() bool {
 	}
 	return false
 }
+
+//------- JournalEntryOwnerAddress
+
+// JournalEntryOwnerAddress is used to revert an owner address change
+type JournalEntryOwnerAddress struct {
+	account         *Account
+	oldOwnerAddress []byte
+}
+
+// NewJournalEntryOwnerAddress outputs a new JournalEntry implementation used to revert an owner address change
+func NewJournalEntryOwnerAddress(account *Account, oldOwnerAddress []byte) (*JournalEntryOwnerAddress, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &JournalEntryOwnerAddress{
+		account:         account,
+		oldOwnerAddress: oldOwnerAddress,
+	}, nil
+}
+
+// Revert applies undo operation
+func (jeoa *JournalEntryOwnerAddress) Revert() (AccountHandler, error) {
+	jeoa.account.OwnerAddress = jeoa.oldOwnerAddress
+
+	return jeoa.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jeoa *JournalEntryOwnerAddress) IsInterfaceNil() bool {
+	if jeoa == nil {
+		return true
+	}
+	return false
+}
+
+//------- JournalEntryCodeMetadata
+
+// JournalEntryCodeMetadata is used to revert a code metadata change
+type JournalEntryCodeMetadata struct {
+	account         *Account
+	oldCodeMetadata []byte
+}
+
+// NewJournalEntryCodeMetadata outputs a new JournalEntry implementation used to revert a code metadata change
+func NewJournalEntryCodeMetadata(account *Account, oldCodeMetadata []byte) (*JournalEntryCodeMetadata, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &JournalEntryCodeMetadata{
+		account:         account,
+		oldCodeMetadata: oldCodeMetadata,
+	}, nil
+}
+
+// Revert applies undo operation
+func (jecm *JournalEntryCodeMetadata) Revert() (AccountHandler, error) {
+	jecm.account.CodeMetadata = jecm.oldCodeMetadata
+
+	return jecm.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jecm *JournalEntryCodeMetadata) IsInterfaceNil() bool {
+	if jecm == nil {
+		return true
+	}
+	return false
+}
+
+//------- JournalEntryDeveloperReward
+
+// JournalEntryDeveloperReward is used to revert a developer reward change
+type JournalEntryDeveloperReward struct {
+	account            *Account
+	oldDeveloperReward *big.Int
+}
+
+// NewJournalEntryDeveloperReward outputs a new JournalEntry implementation used to revert a developer reward change
+func NewJournalEntryDeveloperReward(account *Account, oldDeveloperReward *big.Int) (*JournalEntryDeveloperReward, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &JournalEntryDeveloperReward{
+		account:            account,
+		oldDeveloperReward: oldDeveloperReward,
+	}, nil
+}
+
+// Revert applies undo operation
+func (jedr *JournalEntryDeveloperReward) Revert() (AccountHandler, error) {
+	jedr.account.DeveloperReward = jedr.oldDeveloperReward
+
+	return jedr.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jedr *JournalEntryDeveloperReward) IsInterfaceNil() bool {
+	if jedr == nil {
+		return true
+	}
+	return false
+}
+
+//------- JournalEntryUserName
+
+// JournalEntryUserName is used to revert a user name change
+type JournalEntryUserName struct {
+	account     *Account
+	oldUserName []byte
+}
+
+// NewJournalEntryUserName outputs a new JournalEntry implementation used to revert a user name change
+func NewJournalEntryUserName(account *Account, oldUserName []byte) (*JournalEntryUserName, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &JournalEntryUserName{
+		account:     account,
+		oldUserName: oldUserName,
+	}, nil
+}
+
+// Revert applies undo operation
+func (jeun *JournalEntryUserName) Revert() (AccountHandler, error) {
+	jeun.account.UserName = jeun.oldUserName
+
+	return jeun.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jeun *JournalEntryUserName) IsInterfaceNil() bool {
+	if jeun == nil {
+		return true
+	}
+	return false
+}
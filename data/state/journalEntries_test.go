@@ -41,3 +41,99 @@ func TestNewJournalEntryBalance_RevertOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, balance, accnt.Balance)
 }
+
+//------- JournalEntryOwnerAddress
+
+func TestNewJournalEntryOwnerAddress_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewJournalEntryOwnerAddress(nil, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestNewJournalEntryOwnerAddress_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	oldOwnerAddress := []byte("owner")
+	accnt, _ := state.NewAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	accnt.OwnerAddress = []byte("new value")
+	entry, _ := state.NewJournalEntryOwnerAddress(accnt, oldOwnerAddress)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.Equal(t, oldOwnerAddress, accnt.OwnerAddress)
+}
+
+//------- JournalEntryCodeMetadata
+
+func TestNewJournalEntryCodeMetadata_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewJournalEntryCodeMetadata(nil, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestNewJournalEntryCodeMetadata_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	oldCodeMetadata := []byte{1}
+	accnt, _ := state.NewAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	accnt.CodeMetadata = []byte("new value")
+	entry, _ := state.NewJournalEntryCodeMetadata(accnt, oldCodeMetadata)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.Equal(t, oldCodeMetadata, accnt.CodeMetadata)
+}
+
+//------- JournalEntryDeveloperReward
+
+func TestNewJournalEntryDeveloperReward_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewJournalEntryDeveloperReward(nil, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestNewJournalEntryDeveloperReward_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	oldDeveloperReward := big.NewInt(10)
+	accnt, _ := state.NewAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	accnt.DeveloperReward = big.NewInt(20)
+	entry, _ := state.NewJournalEntryDeveloperReward(accnt, oldDeveloperReward)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.Equal(t, oldDeveloperReward, accnt.DeveloperReward)
+}
+
+//------- JournalEntryUserName
+
+func TestNewJournalEntryUserName_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewJournalEntryUserName(nil, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestNewJournalEntryUserName_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	oldUserName := []byte("alice")
+	accnt, _ := state.NewAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	accnt.UserName = []byte("bob")
+	entry, _ := state.NewJournalEntryUserName(accnt, oldUserName)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.Equal(t, oldUserName, accnt.UserName)
+}
@@ -0,0 +1,60 @@
+package supply
+
+import (
+	"math/big"
+)
+
+// ShardSupply holds the counters of the value minted and burned by a shard, accumulated from the genesis up to the
+// last accounted block. The network supply is the sum of the supplies of all the shards. The fees sent to the burn
+// address stay in its account, so they are part of the total supply but not of the circulating supply, while the
+// fees destroyed once the fee burning is enabled are removed from the total supply
+type ShardSupply struct {
+	ShardID       uint32   `json:"shardId"`
+	Epoch         uint32   `json:"epoch"`
+	FirstNonce    uint64   `json:"firstNonce"`
+	LastNonce     uint64   `json:"lastNonce"`
+	Genesis       *big.Int `json:"genesis"`
+	Inflation     *big.Int `json:"inflation"`
+	FeesBurned    *big.Int `json:"feesBurned"`
+	FeesDestroyed *big.Int `json:"feesDestroyed"`
+	Slashed       *big.Int `json:"slashed"`
+}
+
+// NewShardSupply creates the supply counters of a shard holding only the genesis supply
+func NewShardSupply(shardID uint32, genesis *big.Int) *ShardSupply {
+	return &ShardSupply{
+		ShardID:       shardID,
+		Genesis:       big.NewInt(0).Set(genesis),
+		Inflation:     big.NewInt(0),
+		FeesBurned:    big.NewInt(0),
+		FeesDestroyed: big.NewInt(0),
+		Slashed:       big.NewInt(0),
+	}
+}
+
+// TotalSupply returns the genesis supply and the inflation, without the slashed value and the destroyed fees
+func (ss *ShardSupply) TotalSupply() *big.Int {
+	totalSupply := big.NewInt(0).Add(ss.Genesis, ss.Inflation)
+	totalSupply.Sub(totalSupply, ss.FeesDestroyed)
+
+	return totalSupply.Sub(totalSupply, ss.Slashed)
+}
+
+// CirculatingSupply returns the total supply without the fees sent to the burn address
+func (ss *ShardSupply) CirculatingSupply() *big.Int {
+	totalSupply := ss.TotalSupply()
+
+	return totalSupply.Sub(totalSupply, ss.FeesBurned)
+}
+
+// Clone returns a deep copy of the supply counters
+func (ss *ShardSupply) Clone() *ShardSupply {
+	ssCopy := *ss
+	ssCopy.Genesis = big.NewInt(0).Set(ss.Genesis)
+	ssCopy.Inflation = big.NewInt(0).Set(ss.Inflation)
+	ssCopy.FeesBurned = big.NewInt(0).Set(ss.FeesBurned)
+	ssCopy.FeesDestroyed = big.NewInt(0).Set(ss.FeesDestroyed)
+	ssCopy.Slashed = big.NewInt(0).Set(ss.Slashed)
+
+	return &ssCopy
+}
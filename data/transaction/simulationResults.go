@@ -0,0 +1,50 @@
+package transaction
+
+import (
+	"math/big"
+)
+
+// SimulationSuccess signals that the simulated transaction would have been successfully executed
+const SimulationSuccess = "success"
+
+// SimulationFail signals that the simulated transaction would have failed
+const SimulationFail = "fail"
+
+// SimulationResults holds the outcome of a transaction executed against the current state without
+// committing any of the changes. All byte slices are hex encoded
+type SimulationResults struct {
+	Status         string                     `json:"status"`
+	FailReason     string                     `json:"failReason,omitempty"`
+	GasUsed        uint64                     `json:"gasUsed"`
+	Fee            *big.Int                   `json:"fee"`
+	ReturnCode     string                     `json:"returnCode,omitempty"`
+	ReturnData     []string                   `json:"returnData,omitempty"`
+	Logs           []*SimulationLog           `json:"logs,omitempty"`
+	AccountChanges []*SimulationAccountChange `json:"accountChanges,omitempty"`
+	Trace          []*SimulationTraceStep     `json:"trace,omitempty"`
+}
+
+// SimulationLog is a log entry produced by a smart contract during a simulated execution
+type SimulationLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+}
+
+// SimulationAccountChange holds the changes that a simulated transaction would have made to an account
+type SimulationAccountChange struct {
+	Address        string            `json:"address"`
+	Nonce          uint64            `json:"nonce"`
+	BalanceDelta   *big.Int          `json:"balanceDelta"`
+	StorageUpdates map[string]string `json:"storageUpdates,omitempty"`
+	CodeDeployed   bool              `json:"codeDeployed,omitempty"`
+}
+
+// SimulationTraceStep is a step of a traced smart contract execution: a call made by the VM to the node, such as
+// a storage read, or a storage write resulted from the execution
+type SimulationTraceStep struct {
+	Function  string   `json:"function"`
+	Arguments []string `json:"arguments,omitempty"`
+	Result    string   `json:"result,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
@@ -19,6 +19,8 @@ type Transaction struct {
 	Data      string   `capid:"6" json:"data,omitempty"`
 	Signature []byte   `capid:"7" json:"signature,omitempty"`
 	Challenge []byte   `capid:"8" json:"challenge,omitempty"`
+	ChainID   []byte   `capid:"9" json:"chainID,omitempty"`
+	Version   uint32   `capid:"10" json:"version,omitempty"`
 }
 
 // Save saves the serialized data of a Transaction into a stream through Capnp protocol
@@ -73,6 +75,10 @@ func TransactionCapnToGo(src capnp.TransactionCapn, dest *Transaction) *Transact
 	dest.Signature = src.Signature()
 	// Challenge
 	dest.Challenge = src.Challenge()
+	// ChainID
+	dest.ChainID = src.ChainID()
+	// Version
+	dest.Version = src.Version()
 
 	return dest
 }
@@ -91,6 +97,8 @@ func TransactionGoToCapn(seg *capn.Segment, src *Transaction) capnp.TransactionC
 	dest.SetData(src.Data)
 	dest.SetSignature(src.Signature)
 	dest.SetChallenge(src.Challenge)
+	dest.SetChainID(src.ChainID)
+	dest.SetVersion(src.Version)
 
 	return dest
 }
@@ -0,0 +1,59 @@
+package transaction
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	protobuf "github.com/ElrondNetwork/elrond-go/data/transaction/proto"
+)
+
+// MarshalProto serializes the transaction through its gogo protobuf message
+func (tx *Transaction) MarshalProto() ([]byte, error) {
+	value, err := data.BigIntToProtoBytes(tx.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	ptx := &protobuf.Transaction{
+		Nonce:     tx.Nonce,
+		Value:     value,
+		RcvAddr:   tx.RcvAddr,
+		SndAddr:   tx.SndAddr,
+		GasPrice:  tx.GasPrice,
+		GasLimit:  tx.GasLimit,
+		Data:      []byte(tx.Data),
+		Signature: tx.Signature,
+		Challenge: tx.Challenge,
+		ChainID:   tx.ChainID,
+		Version:   tx.Version,
+	}
+
+	return ptx.Marshal()
+}
+
+// UnmarshalProto loads the transaction from the data serialized through its gogo protobuf message
+func (tx *Transaction) UnmarshalProto(buff []byte) error {
+	ptx := &protobuf.Transaction{}
+	err := ptx.Unmarshal(buff)
+	if err != nil {
+		return err
+	}
+
+	value, err := data.BigIntFromProtoBytes(ptx.Value)
+	if err != nil {
+		return err
+	}
+
+	*tx = Transaction{
+		Nonce:     ptx.Nonce,
+		Value:     value,
+		RcvAddr:   ptx.RcvAddr,
+		SndAddr:   ptx.SndAddr,
+		GasPrice:  ptx.GasPrice,
+		GasLimit:  ptx.GasLimit,
+		Data:      string(ptx.Data),
+		Signature: ptx.Signature,
+		Challenge: ptx.Challenge,
+		ChainID:   ptx.ChainID,
+		Version:   ptx.Version,
+	}
+	return nil
+}
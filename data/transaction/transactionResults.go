@@ -0,0 +1,58 @@
+package transaction
+
+// TxStatus is the status of a transaction as seen by the node which is asked about it
+type TxStatus string
+
+const (
+	// TxStatusPending signals that the transaction was not yet included in a block or that it still waits to be
+	// executed in the destination shard
+	TxStatusPending TxStatus = "pending"
+	// TxStatusSuccess signals that the transaction and all its known smart contract results were successfully executed
+	TxStatusSuccess TxStatus = "success"
+	// TxStatusFail signals that the transaction or one of its known smart contract results failed in the VM
+	TxStatusFail TxStatus = "fail"
+	// TxStatusInvalid signals that the transaction was included in an invalid miniblock, only its fee was consumed
+	TxStatusInvalid TxStatus = "invalid"
+)
+
+// Log holds the outcome of an execution done by the smart contract processor: the VM return code, the logged
+// events and the hashes of the generated smart contract results
+type Log struct {
+	ReturnCode string   `json:"returnCode"`
+	Events     []*Event `json:"events,omitempty"`
+	ScrHashes  [][]byte `json:"scrHashes,omitempty"`
+}
+
+// Event is an entry logged by a smart contract while being executed
+type Event struct {
+	Address []byte   `json:"address"`
+	Topics  [][]byte `json:"topics,omitempty"`
+	Data    []byte   `json:"data,omitempty"`
+}
+
+// TransactionResults holds the status of a transaction together with the smart contract results it generated and
+// the events logged while executing it. All byte slices are hex encoded
+type TransactionResults struct {
+	Status               TxStatus                `json:"status"`
+	ReturnCode           string                  `json:"returnCode,omitempty"`
+	SmartContractResults []*ResultsSmartContract `json:"smartContractResults,omitempty"`
+	Logs                 []*ResultsLog           `json:"logs,omitempty"`
+}
+
+// ResultsSmartContract is a smart contract result generated, directly or through other results, by a transaction
+type ResultsSmartContract struct {
+	Hash       string `json:"hash"`
+	Nonce      uint64 `json:"nonce"`
+	Value      string `json:"value"`
+	Sender     string `json:"sender"`
+	Receiver   string `json:"receiver"`
+	Data       string `json:"data,omitempty"`
+	ReturnCode string `json:"returnCode,omitempty"`
+}
+
+// ResultsLog is a log entry produced by a smart contract while executing a transaction or one of its results
+type ResultsLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics,omitempty"`
+	Data    string   `json:"data,omitempty"`
+}
@@ -20,6 +20,8 @@ func TestTransaction_SaveLoad(t *testing.T) {
 		Data:      "tx_data",
 		Signature: []byte("signature"),
 		Challenge: []byte("challenge"),
+		ChainID:   []byte("chain ID"),
+		Version:   1,
 	}
 
 	var b bytes.Buffer
@@ -2,6 +2,7 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"sync"
@@ -537,3 +538,41 @@ func (bn *branchNode) deepClone() node {
 
 	return clonedNode
 }
+
+func (bn *branchNode) getAllLeavesOnChannel(
+	ctx context.Context,
+	leavesChannel chan data.KeyValueHolder,
+	keyPath []byte,
+	db data.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+) error {
+	err := bn.isEmptyOrNil()
+	if err != nil {
+		return err
+	}
+
+	for i := range bn.children {
+		if isContextDone(ctx) {
+			return ErrContextClosing
+		}
+
+		//the collapsed children are not cached, so the whole trie is not kept in memory while iterating
+		child := bn.children[i]
+		if child == nil && len(bn.EncodedChildren[i]) != 0 {
+			child, err = getNodeFromDBAndDecode(bn.EncodedChildren[i], db, marshalizer)
+			if err != nil {
+				return err
+			}
+		}
+		if child == nil {
+			continue
+		}
+
+		err = child.getAllLeavesOnChannel(ctx, leavesChannel, concat(keyPath, byte(i)), db, marshalizer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -33,3 +33,12 @@ var ErrEmptyNode = errors.New("the node is empty")
 
 // ErrNilNode is raised when we reach a nil node
 var ErrNilNode = errors.New("the node is nil")
+
+// ErrInvalidLength signals that length of the array is invalid
+var ErrInvalidLength = errors.New("invalid array length")
+
+// ErrContextClosing signals that the parent context requested the closing of its children
+var ErrContextClosing = errors.New("context closing")
+
+// ErrNilContext signals that a nil context has been provided
+var ErrNilContext = errors.New("nil context")
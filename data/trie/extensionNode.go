@@ -2,6 +2,7 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"sync"
@@ -417,3 +418,29 @@ func (en *extensionNode) deepClone() node {
 
 	return clonedNode
 }
+
+func (en *extensionNode) getAllLeavesOnChannel(
+	ctx context.Context,
+	leavesChannel chan data.KeyValueHolder,
+	keyPath []byte,
+	db data.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+) error {
+	err := en.isEmptyOrNil()
+	if err != nil {
+		return err
+	}
+	if isContextDone(ctx) {
+		return ErrContextClosing
+	}
+
+	child := en.child
+	if child == nil {
+		child, err = getNodeFromDBAndDecode(en.EncodedChild, db, marshalizer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return child.getAllLeavesOnChannel(ctx, leavesChannel, concat(keyPath, en.Key...), db, marshalizer)
+}
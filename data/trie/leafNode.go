@@ -2,6 +2,7 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"sync"
@@ -272,3 +273,28 @@ func (ln *leafNode) deepClone() node {
 
 	return clonedNode
 }
+
+func (ln *leafNode) getAllLeavesOnChannel(
+	ctx context.Context,
+	leavesChannel chan data.KeyValueHolder,
+	keyPath []byte,
+	_ data.DBWriteCacher,
+	_ marshal.Marshalizer,
+) error {
+	err := ln.isEmptyOrNil()
+	if err != nil {
+		return err
+	}
+
+	key, err := hexToKeyBytes(concat(keyPath, ln.Key...))
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ErrContextClosing
+	case leavesChannel <- NewKeyValStorage(key, ln.Value):
+		return nil
+	}
+}
@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -37,6 +38,7 @@ type node interface {
 	isEmptyOrNil() error
 	print(writer io.Writer, index int)
 	deepClone() node
+	getAllLeavesOnChannel(ctx context.Context, leavesChannel chan data.KeyValueHolder, keyPath []byte, db data.DBWriteCacher, marshalizer marshal.Marshalizer) error
 }
 
 type branchNode struct {
@@ -215,6 +217,33 @@ func keyBytesToHex(str []byte) []byte {
 	return nibbles
 }
 
+// hexToKeyBytes transforms hex nibbles, optionally ended by the hex terminator, back into key bytes
+func hexToKeyBytes(hex []byte) ([]byte, error) {
+	hasTerminator := len(hex) > 0 && hex[len(hex)-1] == hexTerminator
+	if hasTerminator {
+		hex = hex[:len(hex)-1]
+	}
+	if len(hex)%2 != 0 {
+		return nil, ErrInvalidLength
+	}
+
+	key := make([]byte, len(hex)/2)
+	for i := range key {
+		key[i] = hex[i*2]*hexTerminator + hex[i*2+1]
+	}
+
+	return key, nil
+}
+
+func isContextDone(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
 // prefixLen returns the length of the common prefix of a and b.
 func prefixLen(a, b []byte) int {
 	i := 0
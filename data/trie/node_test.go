@@ -410,6 +410,30 @@ func TestKeyBytesToHex(t *testing.T) {
 	}
 }
 
+func TestHexToKeyBytes(t *testing.T) {
+	t.Parallel()
+	var test = []struct {
+		key, hex []byte
+	}{
+		{[]byte("doe"), []byte{6, 4, 6, 15, 6, 5, 16}},
+		{[]byte("dog"), []byte{6, 4, 6, 15, 6, 7}},
+	}
+
+	for i := range test {
+		key, err := hexToKeyBytes(test[i].hex)
+		assert.Nil(t, err)
+		assert.Equal(t, test[i].key, key)
+	}
+}
+
+func TestHexToKeyBytesInvalidLengthShouldErr(t *testing.T) {
+	t.Parallel()
+
+	key, err := hexToKeyBytes([]byte{6, 4, 6, 16})
+	assert.Nil(t, key)
+	assert.Equal(t, ErrInvalidLength, err)
+}
+
 func TestPrefixLen(t *testing.T) {
 	t.Parallel()
 	var test = []struct {
@@ -2,9 +2,11 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -18,6 +20,11 @@ const (
 
 var emptyTrieHash = make([]byte, 32)
 
+var log = logger.GetOrCreate("data/trie")
+
+// leavesChannelSize is the number of leaves which can be buffered on the channel returned by GetAllLeavesOnChannel
+const leavesChannelSize = 100
+
 type patriciaMerkleTrie struct {
 	root         node
 	db           data.DBWriteCacher
@@ -231,6 +238,43 @@ func (tr *patriciaMerkleTrie) Recreate(root []byte) (data.Trie, error) {
 	tr.mutOperation.Lock()
 	defer tr.mutOperation.Unlock()
 
+	return tr.recreate(root)
+}
+
+// GetAllLeavesOnChannel iterates the trie committed under the given root hash and sends all its leaves, as key-value
+// pairs, on the returned channel. The channel is closed when all the leaves were sent or when the context is done, so
+// the caller can stop the iteration at any moment by canceling the context
+func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+
+	tr.mutOperation.RLock()
+	newTrie, err := tr.recreate(rootHash)
+	tr.mutOperation.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	leavesChannel := make(chan data.KeyValueHolder, leavesChannelSize)
+	if newTrie.root == nil {
+		close(leavesChannel)
+		return leavesChannel, nil
+	}
+
+	go func() {
+		err := newTrie.root.getAllLeavesOnChannel(ctx, leavesChannel, make([]byte, 0), tr.db, tr.marshalizer)
+		if err != nil && err != ErrContextClosing {
+			log.Error("cannot get all the trie leaves", "root hash", rootHash, "error", err.Error())
+		}
+
+		close(leavesChannel)
+	}()
+
+	return leavesChannel, nil
+}
+
+func (tr *patriciaMerkleTrie) recreate(root []byte) (*patriciaMerkleTrie, error) {
 	newTr, err := NewTrie(tr.db, tr.marshalizer, tr.hasher)
 	if err != nil {
 		return nil, err
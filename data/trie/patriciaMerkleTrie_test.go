@@ -1,6 +1,7 @@
 package trie_test
 
 import (
+	"context"
 	"strconv"
 	"testing"
 
@@ -662,3 +663,64 @@ func BenchmarkPatriciaMerkleTrie_RootHashAfterChanging30000NodesInBatchesOf200(b
 		}
 	}
 }
+
+func TestPatriciaMerkleTrie_GetAllLeavesOnChannelNilContextShouldErr(t *testing.T) {
+	tr := initTrie()
+
+	leavesChannel, err := tr.GetAllLeavesOnChannel(nil, emptyTrieHash)
+	assert.Nil(t, leavesChannel)
+	assert.Equal(t, trie.ErrNilContext, err)
+}
+
+func TestPatriciaMerkleTrie_GetAllLeavesOnChannelEmptyTrieShouldCloseTheChannel(t *testing.T) {
+	db, _ := mock.NewMemDbMock()
+	tr, _ := trie.NewTrie(db, marshalizer, hasher)
+
+	leavesChannel, err := tr.GetAllLeavesOnChannel(context.Background(), make([]byte, 0))
+	assert.Nil(t, err)
+
+	_, ok := <-leavesChannel
+	assert.False(t, ok)
+}
+
+func TestPatriciaMerkleTrie_GetAllLeavesOnChannelShouldSendAllTheCommittedLeaves(t *testing.T) {
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	_ = tr.Update([]byte("dog"), []byte("not committed"))
+
+	leavesChannel, err := tr.GetAllLeavesOnChannel(context.Background(), rootHash)
+	assert.Nil(t, err)
+
+	leaves := make(map[string]string)
+	for leaf := range leavesChannel {
+		leaves[string(leaf.Key())] = string(leaf.Value())
+	}
+
+	expectedLeaves := map[string]string{
+		"doe":          "reindeer",
+		"dog":          "puppy",
+		"dogglesworth": "cat",
+	}
+	assert.Equal(t, expectedLeaves, leaves)
+}
+
+func TestPatriciaMerkleTrie_GetAllLeavesOnChannelCanceledContextShouldCloseTheChannel(t *testing.T) {
+	tr, values := initTrieMultipleValues(1000)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leavesChannel, err := tr.GetAllLeavesOnChannel(ctx, rootHash)
+	assert.Nil(t, err)
+
+	<-leavesChannel
+	cancel()
+
+	numLeaves := 1
+	for range leavesChannel {
+		numLeaves++
+	}
+	assert.True(t, numLeaves < len(values))
+}
@@ -0,0 +1,49 @@
+package trieProfiler
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// countingDB counts the trie nodes read from and written to the wrapped trie database
+type countingDB struct {
+	db         data.DBWriteCacher
+	statistics *TrieStatistics
+}
+
+// NewCountingDB creates a trie database recording in the statistics each node read and written
+func NewCountingDB(db data.DBWriteCacher, statistics *TrieStatistics) (*countingDB, error) {
+	if check.IfNil(db) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(statistics) {
+		return nil, ErrNilTrieStatistics
+	}
+
+	return &countingDB{
+		db:         db,
+		statistics: statistics,
+	}, nil
+}
+
+// Put writes the node in the wrapped database
+func (cdb *countingDB) Put(key, val []byte) error {
+	cdb.statistics.NodeWritten()
+
+	return cdb.db.Put(key, val)
+}
+
+// Get reads the node from the wrapped database
+func (cdb *countingDB) Get(key []byte) ([]byte, error) {
+	cdb.statistics.NodeRead()
+
+	return cdb.db.Get(key)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cdb *countingDB) IsInterfaceNil() bool {
+	if cdb == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package trieProfiler
+
+import "errors"
+
+// ErrNilTrie signals that a nil trie has been provided
+var ErrNilTrie = errors.New("nil trie")
+
+// ErrNilDatabase signals that a nil database has been provided
+var ErrNilDatabase = errors.New("nil database")
+
+// ErrNilTrieStatistics signals that nil trie statistics have been provided
+var ErrNilTrieStatistics = errors.New("nil trie statistics")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrNegativeThreshold signals that a negative slow operation threshold has been provided
+var ErrNegativeThreshold = errors.New("negative slow operation threshold")
+
+// ErrNegativeNumTopKeys signals that a negative number of top keys has been provided
+var ErrNegativeNumTopKeys = errors.New("negative number of top keys")
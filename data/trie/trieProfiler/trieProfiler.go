@@ -0,0 +1,125 @@
+package trieProfiler
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// trieProfiler measures the operations of the wrapped trie and records them in the trie statistics. The tries
+// recreated or cloned from it are profiled too, so the data tries of the accounts are measured along the accounts
+// trie. The trie is never pruned, so there are no pruning operations to measure
+type trieProfiler struct {
+	trie       data.Trie
+	statistics *TrieStatistics
+}
+
+// NewTrieProfiler creates a new trie profiler wrapping the provided trie
+func NewTrieProfiler(trie data.Trie, statistics *TrieStatistics) (*trieProfiler, error) {
+	if check.IfNil(trie) {
+		return nil, ErrNilTrie
+	}
+	if check.IfNil(statistics) {
+		return nil, ErrNilTrieStatistics
+	}
+
+	return &trieProfiler{
+		trie:       trie,
+		statistics: statistics,
+	}, nil
+}
+
+// Get returns the value of the key from the wrapped trie
+func (tp *trieProfiler) Get(key []byte) ([]byte, error) {
+	start := time.Now()
+	defer func() {
+		tp.statistics.AddOperation(OperationGet, key, time.Since(start))
+	}()
+
+	return tp.trie.Get(key)
+}
+
+// Update sets the value of the key in the wrapped trie
+func (tp *trieProfiler) Update(key, value []byte) error {
+	start := time.Now()
+	defer func() {
+		tp.statistics.AddOperation(OperationUpdate, key, time.Since(start))
+	}()
+
+	return tp.trie.Update(key, value)
+}
+
+// Delete removes the key from the wrapped trie
+func (tp *trieProfiler) Delete(key []byte) error {
+	start := time.Now()
+	defer func() {
+		tp.statistics.AddOperation(OperationDelete, key, time.Since(start))
+	}()
+
+	return tp.trie.Delete(key)
+}
+
+// Commit persists the wrapped trie
+func (tp *trieProfiler) Commit() error {
+	start := time.Now()
+	defer func() {
+		tp.statistics.AddOperation(OperationCommit, nil, time.Since(start))
+	}()
+
+	return tp.trie.Commit()
+}
+
+// Root returns the root hash of the wrapped trie
+func (tp *trieProfiler) Root() ([]byte, error) {
+	return tp.trie.Root()
+}
+
+// Prove returns the Merkle proof of the key from the wrapped trie
+func (tp *trieProfiler) Prove(key []byte) ([][]byte, error) {
+	return tp.trie.Prove(key)
+}
+
+// VerifyProof verifies the Merkle proof of the key against the wrapped trie
+func (tp *trieProfiler) VerifyProof(proofs [][]byte, key []byte) (bool, error) {
+	return tp.trie.VerifyProof(proofs, key)
+}
+
+// Recreate returns a profiled trie recreated from the root hash
+func (tp *trieProfiler) Recreate(root []byte) (data.Trie, error) {
+	newTrie, err := tp.trie.Recreate(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTrieProfiler(newTrie, tp.statistics)
+}
+
+// String returns the content of the wrapped trie
+func (tp *trieProfiler) String() string {
+	return tp.trie.String()
+}
+
+// DeepClone returns a profiled copy of the wrapped trie
+func (tp *trieProfiler) DeepClone() (data.Trie, error) {
+	clonedTrie, err := tp.trie.DeepClone()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTrieProfiler(clonedTrie, tp.statistics)
+}
+
+// GetAllLeavesOnChannel returns the leaves of the wrapped trie at the root hash
+func (tp *trieProfiler) GetAllLeavesOnChannel(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error) {
+	return tp.trie.GetAllLeavesOnChannel(ctx, rootHash)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *trieProfiler) IsInterfaceNil() bool {
+	if tp == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package trieProfiler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/trieProfiler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/stretchr/testify/assert"
+)
+
+func createProfiledTrie(statistics *trieProfiler.TrieStatistics) data.Trie {
+	db, _ := mock.NewMemDbMock()
+	cdb, _ := trieProfiler.NewCountingDB(db, statistics)
+	tr, _ := trie.NewTrie(cdb, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+	tp, _ := trieProfiler.NewTrieProfiler(tr, statistics)
+
+	return tp
+}
+
+//------- NewTrieStatistics
+
+func TestNewTrieStatistics_NegativeThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, err := trieProfiler.NewTrieStatistics(-time.Second, 5)
+
+	assert.Nil(t, ts)
+	assert.Equal(t, trieProfiler.ErrNegativeThreshold, err)
+}
+
+func TestNewTrieStatistics_NegativeNumTopKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, err := trieProfiler.NewTrieStatistics(time.Second, -1)
+
+	assert.Nil(t, ts)
+	assert.Equal(t, trieProfiler.ErrNegativeNumTopKeys, err)
+}
+
+func TestNewTrieStatistics_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts, err := trieProfiler.NewTrieStatistics(0, 0)
+
+	assert.Nil(t, err)
+	assert.False(t, ts.IsInterfaceNil())
+	assert.Equal(t, trieProfiler.ErrNilAppStatusHandler, ts.SetAppStatusHandler(nil))
+}
+
+//------- NewTrieProfiler and NewCountingDB
+
+func TestNewTrieProfiler_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := trieProfiler.NewTrieStatistics(0, 0)
+	tp, err := trieProfiler.NewTrieProfiler(nil, ts)
+	assert.Nil(t, tp)
+	assert.Equal(t, trieProfiler.ErrNilTrie, err)
+
+	tp, err = trieProfiler.NewTrieProfiler(&mock.TrieStub{}, nil)
+	assert.Nil(t, tp)
+	assert.Equal(t, trieProfiler.ErrNilTrieStatistics, err)
+}
+
+func TestNewCountingDB_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := trieProfiler.NewTrieStatistics(0, 0)
+	cdb, err := trieProfiler.NewCountingDB(nil, ts)
+	assert.Nil(t, cdb)
+	assert.Equal(t, trieProfiler.ErrNilDatabase, err)
+
+	db, _ := mock.NewMemDbMock()
+	cdb, err = trieProfiler.NewCountingDB(db, nil)
+	assert.Nil(t, cdb)
+	assert.Equal(t, trieProfiler.ErrNilTrieStatistics, err)
+}
+
+//------- profiling
+
+func TestTrieProfiler_OperationsShouldBePublishedWhenTheBlockIsCommitted(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := trieProfiler.NewTrieStatistics(time.Nanosecond, 2)
+	metrics := statusHandler.NewStatusMetrics()
+	_ = ts.SetAppStatusHandler(metrics)
+	tp := createProfiledTrie(ts)
+
+	_ = tp.Update([]byte("dog"), []byte("puppy"))
+	_ = tp.Update([]byte("doe"), []byte("reindeer"))
+	_, _ = tp.Get([]byte("dog"))
+	_ = tp.Delete([]byte("doe"))
+	_ = tp.Commit()
+	ts.BlockCommitted(&block.Header{Nonce: 1})
+
+	metricsMap, _ := metrics.StatusMetricsMap()
+	assert.Equal(t, uint64(2), metricsMap[core.MetricTrieOperationsPrefix+trieProfiler.OperationUpdate])
+	assert.Equal(t, uint64(1), metricsMap[core.MetricTrieOperationsPrefix+trieProfiler.OperationGet])
+	assert.Equal(t, uint64(1), metricsMap[core.MetricTrieOperationsPrefix+trieProfiler.OperationDelete])
+	assert.Equal(t, uint64(1), metricsMap[core.MetricTrieOperationsPrefix+trieProfiler.OperationCommit])
+	assert.Equal(t, uint64(0), metricsMap[core.MetricTrieNodesRead])
+	assert.Equal(t, uint64(1), metricsMap[core.MetricTrieNodesWritten])
+
+	ts.BlockCommitted(&block.Header{Nonce: 2})
+
+	metricsMap, _ = metrics.StatusMetricsMap()
+	assert.Equal(t, uint64(0), metricsMap[core.MetricTrieOperationsPrefix+trieProfiler.OperationUpdate])
+	assert.Equal(t, uint64(0), metricsMap[core.MetricTrieNodesWritten])
+}
+
+func TestTrieProfiler_RecreatedTrieShouldBeProfiledAndReadNodes(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := trieProfiler.NewTrieStatistics(0, 0)
+	metrics := statusHandler.NewStatusMetrics()
+	_ = ts.SetAppStatusHandler(metrics)
+	tp := createProfiledTrie(ts)
+
+	_ = tp.Update([]byte("dog"), []byte("puppy"))
+	_ = tp.Commit()
+	rootHash, _ := tp.Root()
+	ts.BlockCommitted(&block.Header{Nonce: 1})
+
+	recreated, err := tp.Recreate(rootHash)
+	assert.Nil(t, err)
+	value, _ := recreated.Get([]byte("dog"))
+	assert.Equal(t, []byte("puppy"), value)
+	ts.BlockCommitted(&block.Header{Nonce: 2})
+
+	metricsMap, _ := metrics.StatusMetricsMap()
+	assert.Equal(t, uint64(1), metricsMap[core.MetricTrieOperationsPrefix+trieProfiler.OperationGet])
+	assert.Equal(t, uint64(1), metricsMap[core.MetricTrieNodesRead])
+}
+
+func TestTrieStatistics_BlockCommittedNilHeaderShouldNotReset(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := trieProfiler.NewTrieStatistics(0, 0)
+	metrics := statusHandler.NewStatusMetrics()
+	_ = ts.SetAppStatusHandler(metrics)
+
+	ts.AddOperation(trieProfiler.OperationGet, []byte("key"), time.Millisecond)
+	ts.BlockCommitted(nil)
+	ts.BlockCommitted(&block.Header{})
+
+	metricsMap, _ := metrics.StatusMetricsMap()
+	assert.Equal(t, uint64(1), metricsMap[core.MetricTrieOperationsPrefix+trieProfiler.OperationGet])
+}
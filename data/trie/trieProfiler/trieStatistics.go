@@ -0,0 +1,211 @@
+package trieProfiler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+var log = logger.GetOrCreate("data/trie/trieProfiler")
+
+const (
+	// OperationGet is the name of the trie read operation
+	OperationGet = "get"
+	// OperationUpdate is the name of the trie write operation
+	OperationUpdate = "update"
+	// OperationDelete is the name of the trie removal operation
+	OperationDelete = "delete"
+	// OperationCommit is the name of the trie persisting operation
+	OperationCommit = "commit"
+)
+
+type operationStatistics struct {
+	count       uint64
+	duration    time.Duration
+	maxDuration time.Duration
+}
+
+type keyDuration struct {
+	key      string
+	duration time.Duration
+}
+
+// TrieStatistics aggregates the trie operations executed and the trie nodes read and written between two committed
+// blocks. When a block is committed, the aggregated values are published in the status metrics and reset. The time
+// spent is also accumulated per trie key, so the accounts and the contract storage keys dominating the state costs
+// of a slow block can be logged
+type TrieStatistics struct {
+	slowOperationThreshold time.Duration
+	numTopKeys             int
+
+	mutStatistics sync.Mutex
+	operations    map[string]*operationStatistics
+	keysDuration  map[string]time.Duration
+	nodesRead     uint64
+	nodesWritten  uint64
+	statusHandler core.AppStatusHandler
+}
+
+// NewTrieStatistics creates a new trie statistics instance. The operations and the blocks lasting at least the slow
+// operation threshold are logged, a zero threshold disabling these logs
+func NewTrieStatistics(slowOperationThreshold time.Duration, numTopKeys int) (*TrieStatistics, error) {
+	if slowOperationThreshold < 0 {
+		return nil, ErrNegativeThreshold
+	}
+	if numTopKeys < 0 {
+		return nil, ErrNegativeNumTopKeys
+	}
+
+	return &TrieStatistics{
+		slowOperationThreshold: slowOperationThreshold,
+		numTopKeys:             numTopKeys,
+		operations:             make(map[string]*operationStatistics),
+		keysDuration:           make(map[string]time.Duration),
+		statusHandler:          statusHandler.NewNilStatusHandler(),
+	}, nil
+}
+
+// SetAppStatusHandler sets the AppStatusHandler which will receive the trie statistics metrics
+func (ts *TrieStatistics) SetAppStatusHandler(ash core.AppStatusHandler) error {
+	if check.IfNil(ash) {
+		return ErrNilAppStatusHandler
+	}
+
+	ts.mutStatistics.Lock()
+	ts.statusHandler = ash
+	ts.mutStatistics.Unlock()
+
+	return nil
+}
+
+// AddOperation records a trie operation on the provided key. The key is empty for the operations on the whole trie
+func (ts *TrieStatistics) AddOperation(operation string, key []byte, duration time.Duration) {
+	if ts.isSlow(duration) {
+		log.Debug("slow trie operation",
+			"operation", operation,
+			"key", key,
+			"duration", duration,
+		)
+	}
+
+	ts.mutStatistics.Lock()
+	defer ts.mutStatistics.Unlock()
+
+	opStats, found := ts.operations[operation]
+	if !found {
+		opStats = &operationStatistics{}
+		ts.operations[operation] = opStats
+	}
+	opStats.count++
+	opStats.duration += duration
+	if duration > opStats.maxDuration {
+		opStats.maxDuration = duration
+	}
+
+	if len(key) > 0 {
+		ts.keysDuration[string(key)] += duration
+	}
+}
+
+// NodeRead records a trie node read from the storage
+func (ts *TrieStatistics) NodeRead() {
+	ts.mutStatistics.Lock()
+	ts.nodesRead++
+	ts.mutStatistics.Unlock()
+}
+
+// NodeWritten records a trie node written to the storage
+func (ts *TrieStatistics) NodeWritten() {
+	ts.mutStatistics.Lock()
+	ts.nodesWritten++
+	ts.mutStatistics.Unlock()
+}
+
+// BlockCommitted publishes the statistics aggregated since the previous committed block and resets them
+func (ts *TrieStatistics) BlockCommitted(header data.HeaderHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	ts.mutStatistics.Lock()
+	defer ts.mutStatistics.Unlock()
+
+	totalDuration := time.Duration(0)
+	for _, operation := range []string{OperationGet, OperationUpdate, OperationDelete, OperationCommit} {
+		opStats, found := ts.operations[operation]
+		if !found {
+			opStats = &operationStatistics{}
+		}
+		totalDuration += opStats.duration
+
+		ts.statusHandler.SetUInt64Value(core.MetricTrieOperationsPrefix+operation, opStats.count)
+		ts.statusHandler.SetUInt64Value(core.MetricTrieOperationsDurationPrefix+operation, uint64(opStats.duration/time.Millisecond))
+	}
+	ts.statusHandler.SetUInt64Value(core.MetricTrieNodesRead, ts.nodesRead)
+	ts.statusHandler.SetUInt64Value(core.MetricTrieNodesWritten, ts.nodesWritten)
+
+	if ts.isSlow(totalDuration) {
+		ts.logSlowBlock(header, totalDuration)
+	}
+
+	ts.operations = make(map[string]*operationStatistics)
+	ts.keysDuration = make(map[string]time.Duration)
+	ts.nodesRead = 0
+	ts.nodesWritten = 0
+}
+
+func (ts *TrieStatistics) isSlow(duration time.Duration) bool {
+	return ts.slowOperationThreshold > 0 && duration >= ts.slowOperationThreshold
+}
+
+func (ts *TrieStatistics) logSlowBlock(header data.HeaderHandler, totalDuration time.Duration) {
+	logArgs := []interface{}{
+		"nonce", header.GetNonce(),
+		"total duration", totalDuration,
+		"nodes read", ts.nodesRead,
+		"nodes written", ts.nodesWritten,
+	}
+	for operation, opStats := range ts.operations {
+		logArgs = append(logArgs,
+			operation+" count", opStats.count,
+			operation+" duration", opStats.duration,
+			operation+" max duration", opStats.maxDuration,
+		)
+	}
+	for i, kd := range ts.topKeys() {
+		name := fmt.Sprintf("top key %d", i+1)
+		logArgs = append(logArgs, name, []byte(kd.key), name+" duration", kd.duration)
+	}
+
+	log.Debug("slow trie operations in block", logArgs...)
+}
+
+func (ts *TrieStatistics) topKeys() []keyDuration {
+	keys := make([]keyDuration, 0, len(ts.keysDuration))
+	for key, duration := range ts.keysDuration {
+		keys = append(keys, keyDuration{key: key, duration: duration})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].duration > keys[j].duration
+	})
+	if len(keys) > ts.numTopKeys {
+		keys = keys[:ts.numTopKeys]
+	}
+
+	return keys
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *TrieStatistics) IsInterfaceNil() bool {
+	if ts == nil {
+		return true
+	}
+	return false
+}
@@ -3,9 +3,12 @@ package dataRetriever
 import (
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
+var log = logger.GetOrCreate("dataRetriever")
+
 // ChainStorer is a StorageService implementation that can hold multiple storages
 //  grouped by storage unit type
 type ChainStorer struct {
@@ -125,6 +128,31 @@ func (bc *ChainStorer) Destroy() error {
 	return nil
 }
 
+// CloseAll closes all the storers of the chain. It tries to close every storer, even if some of them fail, and
+// returns the last encountered error. A storer added for more unit types is closed only once
+func (bc *ChainStorer) CloseAll() error {
+	bc.lock.Lock()
+	defer bc.lock.Unlock()
+
+	var lastErr error
+	closedStorers := make(map[storage.Storer]struct{})
+	for unitType, storer := range bc.chain {
+		_, isClosed := closedStorers[storer]
+		if isClosed {
+			continue
+		}
+		closedStorers[storer] = struct{}{}
+
+		err := storer.Close()
+		if err != nil {
+			log.Warn("cannot close storer", "unit", unitType, "error", err.Error())
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bc *ChainStorer) IsInterfaceNil() bool {
 	if bc == nil {
@@ -157,6 +157,30 @@ func TestDestroy_ReturnsCorrectly(t *testing.T) {
 	assert.True(t, destroyCalled)
 }
 
+func TestCloseAll_ShouldCloseEveryStorerOnceAndReturnTheError(t *testing.T) {
+	closeError := errors.New("error")
+	numClosedShared := 0
+	sharedStorer := &mock.StorerStub{
+		CloseCalled: func() error {
+			numClosedShared++
+			return nil
+		},
+	}
+	failingStorer := &mock.StorerStub{
+		CloseCalled: func() error {
+			return closeError
+		},
+	}
+	b := dataRetriever.NewChainStorer()
+	b.AddStorer(1, sharedStorer)
+	b.AddStorer(2, sharedStorer)
+	b.AddStorer(3, failingStorer)
+
+	err := b.CloseAll()
+	assert.Equal(t, closeError, err)
+	assert.Equal(t, 1, numClosedShared)
+}
+
 func TestBlockChain_GetStorer(t *testing.T) {
 	t.Parallel()
 
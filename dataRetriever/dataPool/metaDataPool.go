@@ -12,6 +12,7 @@ type metaDataPool struct {
 	headersNonces        dataRetriever.Uint64SyncMapCacher
 	transactions         dataRetriever.ShardedDataCacherNotifier
 	unsignedTransactions dataRetriever.ShardedDataCacherNotifier
+	peerAuthentications  storage.Cacher
 }
 
 // NewMetaDataPool creates a data pools holder object
@@ -22,6 +23,7 @@ func NewMetaDataPool(
 	headersNonces dataRetriever.Uint64SyncMapCacher,
 	transactions dataRetriever.ShardedDataCacherNotifier,
 	unsignedTransactions dataRetriever.ShardedDataCacherNotifier,
+	peerAuthentications storage.Cacher,
 ) (*metaDataPool, error) {
 
 	if metaBlocks == nil || metaBlocks.IsInterfaceNil() {
@@ -42,6 +44,9 @@ func NewMetaDataPool(
 	if unsignedTransactions == nil || unsignedTransactions.IsInterfaceNil() {
 		return nil, dataRetriever.ErrNilUnsignedTransactionPool
 	}
+	if peerAuthentications == nil || peerAuthentications.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilPeerAuthenticationPool
+	}
 
 	return &metaDataPool{
 		metaBlocks:           metaBlocks,
@@ -50,6 +55,7 @@ func NewMetaDataPool(
 		headersNonces:        headersNonces,
 		transactions:         transactions,
 		unsignedTransactions: unsignedTransactions,
+		peerAuthentications:  peerAuthentications,
 	}, nil
 }
 
@@ -84,6 +90,11 @@ func (mdp *metaDataPool) UnsignedTransactions() dataRetriever.ShardedDataCacherN
 	return mdp.unsignedTransactions
 }
 
+// PeerAuthentications returns the holder for the peer authentication messages, keyed by the validators public keys
+func (mdp *metaDataPool) PeerAuthentications() storage.Cacher {
+	return mdp.peerAuthentications
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (mdp *metaDataPool) IsInterfaceNil() bool {
 	if mdp == nil {
@@ -21,6 +21,7 @@ func TestNewMetaDataPool_NilMetaBlockShouldErr(t *testing.T) {
 		&mock.Uint64SyncMapCacherStub{},
 		&mock.ShardedDataStub{},
 		&mock.ShardedDataStub{},
+		&mock.CacherStub{},
 	)
 
 	assert.Equal(t, dataRetriever.ErrNilMetaBlockPool, err)
@@ -37,6 +38,7 @@ func TestNewMetaDataPool_NilMiniBlockHeaderHashesShouldErr(t *testing.T) {
 		&mock.Uint64SyncMapCacherStub{},
 		&mock.ShardedDataStub{},
 		&mock.ShardedDataStub{},
+		&mock.CacherStub{},
 	)
 
 	assert.Equal(t, dataRetriever.ErrNilMiniBlockHashesPool, err)
@@ -53,6 +55,7 @@ func TestNewMetaDataPool_NilShardHeaderShouldErr(t *testing.T) {
 		&mock.Uint64SyncMapCacherStub{},
 		&mock.ShardedDataStub{},
 		&mock.ShardedDataStub{},
+		&mock.CacherStub{},
 	)
 
 	assert.Equal(t, dataRetriever.ErrNilShardHeaderPool, err)
@@ -69,6 +72,7 @@ func TestNewMetaDataPool_NilHeaderNoncesShouldErr(t *testing.T) {
 		nil,
 		&mock.ShardedDataStub{},
 		&mock.ShardedDataStub{},
+		&mock.CacherStub{},
 	)
 
 	assert.Equal(t, dataRetriever.ErrNilMetaBlockNoncesPool, err)
@@ -85,6 +89,7 @@ func TestNewMetaDataPool_NilTxPoolShouldErr(t *testing.T) {
 		&mock.Uint64SyncMapCacherStub{},
 		nil,
 		&mock.ShardedDataStub{},
+		&mock.CacherStub{},
 	)
 
 	assert.Equal(t, dataRetriever.ErrNilTxDataPool, err)
@@ -101,12 +106,30 @@ func TestNewMetaDataPool_NilUnsingedPoolNoncesShouldErr(t *testing.T) {
 		&mock.Uint64SyncMapCacherStub{},
 		&mock.ShardedDataStub{},
 		nil,
+		&mock.CacherStub{},
 	)
 
 	assert.Equal(t, dataRetriever.ErrNilUnsignedTransactionPool, err)
 	assert.Nil(t, tdp)
 }
 
+func TestNewMetaDataPool_NilPeerAuthenticationsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tdp, err := dataPool.NewMetaDataPool(
+		&mock.CacherStub{},
+		&mock.CacherStub{},
+		&mock.CacherStub{},
+		&mock.Uint64SyncMapCacherStub{},
+		&mock.ShardedDataStub{},
+		&mock.ShardedDataStub{},
+		nil,
+	)
+
+	assert.Equal(t, dataRetriever.ErrNilPeerAuthenticationPool, err)
+	assert.Nil(t, tdp)
+}
+
 func TestNewMetaDataPool_ConfigOk(t *testing.T) {
 	t.Parallel()
 
@@ -116,6 +139,7 @@ func TestNewMetaDataPool_ConfigOk(t *testing.T) {
 	hdrsNonces := &mock.Uint64SyncMapCacherStub{}
 	transactions := &mock.ShardedDataStub{}
 	unsigned := &mock.ShardedDataStub{}
+	peerAuthentications := &mock.CacherStub{}
 
 	tdp, err := dataPool.NewMetaDataPool(
 		metaBlocks,
@@ -124,6 +148,7 @@ func TestNewMetaDataPool_ConfigOk(t *testing.T) {
 		hdrsNonces,
 		transactions,
 		unsigned,
+		peerAuthentications,
 	)
 
 	assert.Nil(t, err)
@@ -134,4 +159,5 @@ func TestNewMetaDataPool_ConfigOk(t *testing.T) {
 	assert.True(t, hdrsNonces == tdp.HeadersNonces())
 	assert.True(t, transactions == tdp.Transactions())
 	assert.True(t, unsigned == tdp.UnsignedTransactions())
+	assert.True(t, peerAuthentications == tdp.PeerAuthentications())
 }
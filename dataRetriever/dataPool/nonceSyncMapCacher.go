@@ -10,7 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("dataRetriever/dataPool")
 
 type nonceSyncMapCacher struct {
 	mergeMut             sync.Mutex
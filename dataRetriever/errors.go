@@ -149,6 +149,9 @@ var ErrNilCacher = errors.New("nil cacher")
 // ErrNilMetaBlockPool signals that a nil meta block data pool was provided
 var ErrNilMetaBlockPool = errors.New("nil meta block data pool")
 
+// ErrNilPeerAuthenticationPool signals that a nil peer authentication data pool was provided
+var ErrNilPeerAuthenticationPool = errors.New("nil peer authentication data pool")
+
 // ErrNilMiniBlockHashesPool signals that a nil meta block data pool was provided
 var ErrNilMiniBlockHashesPool = errors.New("nil meta block mini block hashes data pool")
 
@@ -196,3 +199,58 @@ var ErrInvalidMaxTxRequest = errors.New("max tx request number is invalid")
 
 // ErrNilPeerListCreator signals that a nil peer list creator implementation has been provided
 var ErrNilPeerListCreator = errors.New("nil peer list creator provided")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrInvalidMaxSilentTimeouts signals that an invalid maximum number of silent timeouts has been provided
+var ErrInvalidMaxSilentTimeouts = errors.New("invalid maximum number of silent timeouts")
+
+// ErrNilPayloadChunker signals that a nil payload chunker has been provided
+var ErrNilPayloadChunker = errors.New("nil payload chunker")
+
+// ErrInvalidMaxChunkSize signals that an invalid maximum chunk size has been provided
+var ErrInvalidMaxChunkSize = errors.New("invalid maximum chunk size")
+
+// ErrInvalidMaxRequestsPerPeer signals that an invalid maximum number of requests per peer has been provided
+var ErrInvalidMaxRequestsPerPeer = errors.New("invalid maximum number of requests per peer")
+
+// ErrInvalidMaxRequestsPerValidatorPeer signals that the maximum number of requests per validator peer is lower than
+// the one of the other peers
+var ErrInvalidMaxRequestsPerValidatorPeer = errors.New("invalid maximum number of requests per validator peer")
+
+// ErrInvalidQuotaInterval signals that an invalid quota interval has been provided
+var ErrInvalidQuotaInterval = errors.New("invalid quota interval")
+
+// ErrInvalidMaxConcurrentHandlers signals that an invalid maximum number of concurrent handlers has been provided
+var ErrInvalidMaxConcurrentHandlers = errors.New("invalid maximum number of concurrent handlers")
+
+// ErrPeerQuotaExceeded signals that the peer sent more requests than allowed in the current quota interval
+var ErrPeerQuotaExceeded = errors.New("peer quota exceeded")
+
+// ErrTooManyConcurrentRequests signals that the topic already handles the maximum number of requests
+var ErrTooManyConcurrentRequests = errors.New("too many concurrent requests")
+
+// ErrNilMemoryUsageHandler signals that a nil memory usage handler has been provided
+var ErrNilMemoryUsageHandler = errors.New("nil memory usage handler")
+
+// ErrInvalidAdjustInterval signals that an invalid capacity adjustment interval has been provided
+var ErrInvalidAdjustInterval = errors.New("invalid capacity adjustment interval")
+
+// ErrInvalidRetention signals that an invalid retention period has been provided
+var ErrInvalidRetention = errors.New("invalid retention period")
+
+// ErrInvalidSizeRatio signals that an invalid minimum or maximum size ratio has been provided
+var ErrInvalidSizeRatio = errors.New("invalid size ratio")
+
+// ErrInvalidMaxMemoryUsagePercent signals that an invalid maximum memory usage percent has been provided
+var ErrInvalidMaxMemoryUsagePercent = errors.New("invalid maximum memory usage percent")
+
+// ErrNilResizablePool signals that a nil resizable pool has been provided
+var ErrNilResizablePool = errors.New("nil resizable pool")
+
+// ErrInvalidPoolSize signals that an invalid pool size has been provided
+var ErrInvalidPoolSize = errors.New("invalid pool size")
@@ -86,6 +86,20 @@ func (rc *resolversContainer) Len() int {
 	return rc.objects.Len()
 }
 
+// Keys returns all the keys from the container
+func (rc *resolversContainer) Keys() []string {
+	keys := make([]string, 0)
+	for obj := range rc.objects.Iter() {
+		strKey, ok := obj.Key.(string)
+		if !ok {
+			continue
+		}
+
+		keys = append(keys, strKey)
+	}
+	return keys
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rc *resolversContainer) IsInterfaceNil() bool {
 	if rc == nil {
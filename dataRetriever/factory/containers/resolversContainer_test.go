@@ -222,3 +222,14 @@ func TestResolversContainer_LenShouldWork(t *testing.T) {
 	c.Remove("key1")
 	assert.Equal(t, 1, c.Len())
 }
+
+func TestResolversContainer_KeysShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewResolversContainer()
+
+	_ = c.Add("key1", &mock.ResolverStub{})
+	_ = c.Add("key2", &mock.ResolverStub{})
+
+	assert.ElementsMatch(t, []string{"key1", "key2"}, c.Keys())
+}
@@ -136,9 +136,12 @@ func (rcf *resolversContainerFactory) createTopicAndAssignHandler(
 	createChannel bool,
 ) (dataRetriever.Resolver, error) {
 
-	err := rcf.messenger.CreateTopic(topicName, createChannel)
-	if err != nil {
-		return nil, err
+	//the topic already exists if the container is re-created
+	if !rcf.messenger.HasTopic(topicName) {
+		err := rcf.messenger.CreateTopic(topicName, createChannel)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return resolver, rcf.messenger.RegisterMessageProcessor(topicName, resolver)
@@ -168,9 +168,12 @@ func (rcf *resolversContainerFactory) createTopicAndAssignHandler(
 	createChannel bool,
 ) (dataRetriever.Resolver, error) {
 
-	err := rcf.messenger.CreateTopic(topicName, createChannel)
-	if err != nil {
-		return nil, err
+	//the topic already exists if the container is re-created
+	if !rcf.messenger.HasTopic(topicName) {
+		err := rcf.messenger.CreateTopic(topicName, createChannel)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return resolver, rcf.messenger.RegisterMessageProcessor(topicName, resolver)
@@ -311,6 +314,10 @@ func (rcf *resolversContainerFactory) createTopicHeadersForMetachain() error {
 	shardC := rcf.shardCoordinator
 	identifierHdr := factory.ShardHeadersForMetachainTopic + shardC.CommunicationIdentifier(sharding.MetachainShardId)
 
+	if rcf.messenger.HasTopic(identifierHdr) {
+		return nil
+	}
+
 	return rcf.messenger.CreateTopic(identifierHdr, true)
 }
 
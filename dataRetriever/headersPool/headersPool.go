@@ -0,0 +1,75 @@
+package headersPool
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("dataRetriever/headersPool")
+
+// headersPool is the notification hub of a headers cacher: it resolves each key added in the cacher to the
+// received header and hands the header, together with its hash, to all the registered handlers
+type headersPool struct {
+	cacher storage.Cacher
+
+	mutHandlers sync.RWMutex
+	handlers    []func(header data.HeaderHandler, hash []byte)
+}
+
+// NewHeadersPool creates a new headers pool notifying the headers added in the given cacher
+func NewHeadersPool(cacher storage.Cacher) (*headersPool, error) {
+	if cacher == nil || cacher.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilCacher
+	}
+
+	hp := &headersPool{
+		cacher:   cacher,
+		handlers: make([]func(header data.HeaderHandler, hash []byte), 0),
+	}
+	cacher.RegisterHandler(hp.receivedKey)
+
+	return hp, nil
+}
+
+// RegisterHandler registers a new handler to be called with each header received in the pool
+func (hp *headersPool) RegisterHandler(handler func(header data.HeaderHandler, hash []byte)) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler to a headers pool")
+		return
+	}
+
+	hp.mutHandlers.Lock()
+	hp.handlers = append(hp.handlers, handler)
+	hp.mutHandlers.Unlock()
+}
+
+func (hp *headersPool) receivedKey(key []byte) {
+	obj, ok := hp.cacher.Peek(key)
+	if !ok {
+		return
+	}
+
+	header, ok := obj.(data.HeaderHandler)
+	if !ok || header.IsInterfaceNil() {
+		log.Debug("headers pool holds a value which is not a header")
+		return
+	}
+
+	hp.mutHandlers.RLock()
+	for _, handler := range hp.handlers {
+		go handler(header, key)
+	}
+	hp.mutHandlers.RUnlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hp *headersPool) IsInterfaceNil() bool {
+	if hp == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package headersPool_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/headersPool"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const waitTime = time.Second
+
+// createCacherStub returns a cacher holding the given values together with the function which simulates
+// adding a key in the cacher, by calling the registered handler
+func createCacherStub(values map[string]interface{}) (*mock.CacherStub, func(key []byte)) {
+	var keyHandler func(key []byte)
+	cacher := &mock.CacherStub{
+		PeekCalled: func(key []byte) (interface{}, bool) {
+			value, ok := values[string(key)]
+			return value, ok
+		},
+		RegisterHandlerCalled: func(handler func(key []byte)) {
+			keyHandler = handler
+		},
+	}
+
+	return cacher, func(key []byte) {
+		keyHandler(key)
+	}
+}
+
+func TestNewHeadersPool_NilCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hp, err := headersPool.NewHeadersPool(nil)
+
+	assert.Nil(t, hp)
+	assert.Equal(t, dataRetriever.ErrNilCacher, err)
+}
+
+func TestNewHeadersPool_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	registered := false
+	cacher := &mock.CacherStub{
+		RegisterHandlerCalled: func(handler func(key []byte)) {
+			registered = true
+		},
+	}
+
+	hp, err := headersPool.NewHeadersPool(cacher)
+
+	assert.Nil(t, err)
+	assert.False(t, hp.IsInterfaceNil())
+	assert.True(t, registered)
+}
+
+//------- RegisterHandler
+
+func TestHeadersPool_ReceivedHeaderShouldNotifyAllHandlers(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("hash")
+	header := &block.MetaBlock{Nonce: 7}
+	cacher, addKey := createCacherStub(map[string]interface{}{string(hash): header})
+	hp, _ := headersPool.NewHeadersPool(cacher)
+
+	numHandlers := 3
+	wg := &sync.WaitGroup{}
+	wg.Add(numHandlers)
+	for i := 0; i < numHandlers; i++ {
+		hp.RegisterHandler(func(receivedHeader data.HeaderHandler, receivedHash []byte) {
+			assert.True(t, header == receivedHeader)
+			assert.Equal(t, hash, receivedHash)
+			wg.Done()
+		})
+	}
+
+	addKey(hash)
+
+	chDone := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+	case <-time.After(waitTime):
+		assert.Fail(t, "the handlers were not notified")
+	}
+}
+
+func TestHeadersPool_ReceivedValueNotHeaderShouldNotNotify(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("hash")
+	cacher, addKey := createCacherStub(map[string]interface{}{string(hash): "not a header"})
+	hp, _ := headersPool.NewHeadersPool(cacher)
+
+	chNotified := make(chan struct{}, 1)
+	hp.RegisterHandler(func(header data.HeaderHandler, hash []byte) {
+		chNotified <- struct{}{}
+	})
+
+	addKey(hash)
+	addKey([]byte("missing hash"))
+
+	select {
+	case <-chNotified:
+		assert.Fail(t, "the handler should not have been notified")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestHeadersPool_RegisterNilHandlerShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("hash")
+	cacher, addKey := createCacherStub(map[string]interface{}{string(hash): &block.Header{}})
+	hp, _ := headersPool.NewHeadersPool(cacher)
+
+	hp.RegisterHandler(nil)
+
+	assert.NotPanics(t, func() {
+		addKey(hash)
+	})
+}
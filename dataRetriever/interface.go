@@ -3,6 +3,8 @@ package dataRetriever
 import (
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -34,6 +36,24 @@ const (
 	MetaHdrNonceHashDataUnit UnitType = 9
 	// HeartbeatUnit is the heartbeat storage unit identifier
 	HeartbeatUnit UnitType = 10
+	// TxLogsUnit is the transactions execution logs storage unit identifier
+	TxLogsUnit UnitType = 11
+	// MiniBlockHashByTxHashUnit is the transaction hash - miniblock hash pair data unit identifier
+	MiniBlockHashByTxHashUnit UnitType = 12
+	// MiniblocksMetadataUnit is the transaction hash - miniblock metadata pair data unit identifier
+	MiniblocksMetadataUnit UnitType = 13
+	// EpochByHashUnit is the hash - epoch pair data unit identifier
+	EpochByHashUnit UnitType = 14
+	// RoundByHashUnit is the hash - round pair data unit identifier
+	RoundByHashUnit UnitType = 15
+	// StatisticsUnit is the epoch - network statistics pair data unit identifier
+	StatisticsUnit UnitType = 16
+	// SupplyUnit is the epoch - shard supply pair data unit identifier
+	SupplyUnit UnitType = 17
+	// ESDTSupplyUnit is the token - token supply pair data unit identifier
+	ESDTSupplyUnit UnitType = 18
+	// PeerShardMapperUnit is the peer ID - validator public key and shard pair data unit identifier
+	PeerShardMapperUnit UnitType = 19
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -81,6 +101,7 @@ type ResolversContainer interface {
 	Replace(key string, val Resolver) error
 	Remove(key string)
 	Len() int
+	Keys() []string
 	IsInterfaceNil() bool
 }
 
@@ -102,6 +123,17 @@ type ResolversContainerFactory interface {
 type MessageHandler interface {
 	ConnectedPeersOnTopic(topic string) []p2p.PeerID
 	SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error
+	PeerScore(pid p2p.PeerID) float64
+	GetPeerInfo(pid p2p.PeerID) p2p.PeerInfo
+	IsInterfaceNil() bool
+}
+
+// RequestsTracker defines the component that keeps track of the requests sent on each topic and of their outcome
+type RequestsTracker interface {
+	RequestSent(topic string, key []byte)
+	DataReceived(topic string, key []byte)
+	IsTopicUnresponsive(topic string) bool
+	SetStatusHandler(handler core.AppStatusHandler) error
 	IsInterfaceNil() bool
 }
 
@@ -154,6 +186,12 @@ type Notifier interface {
 	IsInterfaceNil() bool
 }
 
+// HeadersNotifier notifies the registered handlers with each header received in a headers pool
+type HeadersNotifier interface {
+	RegisterHandler(handler func(header data.HeaderHandler, hash []byte))
+	IsInterfaceNil() bool
+}
+
 // PeerListCreator is used to create a peer list
 type PeerListCreator interface {
 	PeerList() []p2p.PeerID
@@ -207,6 +245,7 @@ type PoolsHolder interface {
 	MiniBlocks() storage.Cacher
 	PeerChangesBlocks() storage.Cacher
 	MetaBlocks() storage.Cacher
+	PeerAuthentications() storage.Cacher
 	IsInterfaceNil() bool
 }
 
@@ -218,6 +257,7 @@ type MetaPoolsHolder interface {
 	HeadersNonces() Uint64SyncMapCacher
 	Transactions() ShardedDataCacherNotifier
 	UnsignedTransactions() ShardedDataCacherNotifier
+	PeerAuthentications() storage.Cacher
 	IsInterfaceNil() bool
 }
 
@@ -238,6 +278,8 @@ type StorageService interface {
 	GetAll(unitType UnitType, keys [][]byte) (map[string][]byte, error)
 	// Destroy removes the underlying files/resources used by the storage service
 	Destroy() error
+	// CloseAll closes all the storers, flushing their pending writes to disk
+	CloseAll() error
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -247,3 +289,22 @@ type DataPacker interface {
 	PackDataInChunks(data [][]byte, limit int) ([][]byte, error)
 	IsInterfaceNil() bool
 }
+
+// PayloadChunker can split a payload too large for a single p2p message in marshalized chunks
+type PayloadChunker interface {
+	SplitInChunks(payload []byte, maxChunkSize int) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// ResizablePool is a data pool which notifies the added items and whose capacity can be changed at runtime
+type ResizablePool interface {
+	RegisterHandler(handler func(key []byte))
+	Resize(size int)
+	IsInterfaceNil() bool
+}
+
+// MemoryUsageHandler provides the percentage of the machine memory currently in use
+type MemoryUsageHandler interface {
+	UsedMemoryPercent() (uint64, error)
+	IsInterfaceNil() bool
+}
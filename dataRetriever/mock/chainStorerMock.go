@@ -15,6 +15,7 @@ type ChainStorerMock struct {
 	PutCalled       func(unitType dataRetriever.UnitType, key []byte, value []byte) error
 	GetAllCalled    func(unitType dataRetriever.UnitType, keys [][]byte) (map[string][]byte, error)
 	DestroyCalled   func() error
+	CloseAllCalled  func() error
 }
 
 // AddStorer will add a new storer to the chain map
@@ -80,6 +81,14 @@ func (bc *ChainStorerMock) Destroy() error {
 	return nil
 }
 
+// CloseAll closes all the storers of the storage service
+func (bc *ChainStorerMock) CloseAll() error {
+	if bc.CloseAllCalled != nil {
+		return bc.CloseAllCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bc *ChainStorerMock) IsInterfaceNil() bool {
 	if bc == nil {
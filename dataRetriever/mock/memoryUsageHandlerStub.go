@@ -0,0 +1,20 @@
+package mock
+
+type MemoryUsageHandlerStub struct {
+	UsedMemoryPercentCalled func() (uint64, error)
+}
+
+func (muhs *MemoryUsageHandlerStub) UsedMemoryPercent() (uint64, error) {
+	if muhs.UsedMemoryPercentCalled != nil {
+		return muhs.UsedMemoryPercentCalled()
+	}
+	return 0, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (muhs *MemoryUsageHandlerStub) IsInterfaceNil() bool {
+	if muhs == nil {
+		return true
+	}
+	return false
+}
@@ -7,6 +7,8 @@ import (
 type MessageHandlerStub struct {
 	ConnectedPeersOnTopicCalled func(topic string) []p2p.PeerID
 	SendToConnectedPeerCalled   func(topic string, buff []byte, peerID p2p.PeerID) error
+	PeerScoreCalled             func(pid p2p.PeerID) float64
+	GetPeerInfoCalled           func(pid p2p.PeerID) p2p.PeerInfo
 }
 
 func (mhs *MessageHandlerStub) ConnectedPeersOnTopic(topic string) []p2p.PeerID {
@@ -17,6 +19,20 @@ func (mhs *MessageHandlerStub) SendToConnectedPeer(topic string, buff []byte, pe
 	return mhs.SendToConnectedPeerCalled(topic, buff, peerID)
 }
 
+func (mhs *MessageHandlerStub) PeerScore(pid p2p.PeerID) float64 {
+	if mhs.PeerScoreCalled != nil {
+		return mhs.PeerScoreCalled(pid)
+	}
+	return 0
+}
+
+func (mhs *MessageHandlerStub) GetPeerInfo(pid p2p.PeerID) p2p.PeerInfo {
+	if mhs.GetPeerInfoCalled != nil {
+		return mhs.GetPeerInfoCalled(pid)
+	}
+	return p2p.PeerInfo{PeerType: p2p.UnknownPeer}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (mhs *MessageHandlerStub) IsInterfaceNil() bool {
 	if mhs == nil {
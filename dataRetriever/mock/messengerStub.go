@@ -14,6 +14,7 @@ type MessengerStub struct {
 	IsConnectedCalled                 func(peerID p2p.PeerID) bool
 	ConnectedPeersCalled              func() []p2p.PeerID
 	CreateTopicCalled                 func(name string, createChannelForTopic bool) error
+	CreateOrderedTopicCalled          func(name string, createChannelForTopic bool) error
 	HasTopicCalled                    func(name string) bool
 	HasTopicValidatorCalled           func(name string) bool
 	BroadcastOnChannelCalled          func(channel string, topic string, buff []byte)
@@ -23,6 +24,11 @@ type MessengerStub struct {
 	SendToConnectedPeerCalled         func(topic string, buff []byte, peerID p2p.PeerID) error
 	OutgoingChannelLoadBalancerCalled func() p2p.ChannelLoadBalancer
 	BootstrapCalled                   func() error
+	SetPeerHonestyHandlerCalled       func(handler p2p.PeerHonestyHandler) error
+	PeerScoreCalled                   func(pid p2p.PeerID) float64
+	SetPeerShardResolverCalled        func(resolver p2p.PeerShardResolver) error
+	GetPeerInfoCalled                 func(pid p2p.PeerID) p2p.PeerInfo
+	ClosePeerCalled                   func(pid p2p.PeerID) error
 }
 
 func (ms *MessengerStub) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
@@ -77,6 +83,10 @@ func (ms *MessengerStub) CreateTopic(name string, createChannelForTopic bool) er
 	return ms.CreateTopicCalled(name, createChannelForTopic)
 }
 
+func (ms *MessengerStub) CreateOrderedTopic(name string, createChannelForTopic bool) error {
+	return ms.CreateOrderedTopicCalled(name, createChannelForTopic)
+}
+
 func (ms *MessengerStub) HasTopic(name string) bool {
 	return ms.HasTopicCalled(name)
 }
@@ -97,6 +107,41 @@ func (ms *MessengerStub) Bootstrap() error {
 	return ms.BootstrapCalled()
 }
 
+func (ms *MessengerStub) SetPeerHonestyHandler(handler p2p.PeerHonestyHandler) error {
+	if ms.SetPeerHonestyHandlerCalled != nil {
+		return ms.SetPeerHonestyHandlerCalled(handler)
+	}
+	return nil
+}
+
+func (ms *MessengerStub) PeerScore(pid p2p.PeerID) float64 {
+	if ms.PeerScoreCalled != nil {
+		return ms.PeerScoreCalled(pid)
+	}
+	return 0
+}
+
+func (ms *MessengerStub) SetPeerShardResolver(resolver p2p.PeerShardResolver) error {
+	if ms.SetPeerShardResolverCalled != nil {
+		return ms.SetPeerShardResolverCalled(resolver)
+	}
+	return nil
+}
+
+func (ms *MessengerStub) GetPeerInfo(pid p2p.PeerID) p2p.PeerInfo {
+	if ms.GetPeerInfoCalled != nil {
+		return ms.GetPeerInfoCalled(pid)
+	}
+	return p2p.PeerInfo{PeerType: p2p.UnknownPeer}
+}
+
+func (ms *MessengerStub) ClosePeer(pid p2p.PeerID) error {
+	if ms.ClosePeerCalled != nil {
+		return ms.ClosePeerCalled(pid)
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ms *MessengerStub) IsInterfaceNil() bool {
 	if ms == nil {
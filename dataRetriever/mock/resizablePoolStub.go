@@ -0,0 +1,26 @@
+package mock
+
+type ResizablePoolStub struct {
+	RegisterHandlerCalled func(handler func(key []byte))
+	ResizeCalled          func(size int)
+}
+
+func (rps *ResizablePoolStub) RegisterHandler(handler func(key []byte)) {
+	if rps.RegisterHandlerCalled != nil {
+		rps.RegisterHandlerCalled(handler)
+	}
+}
+
+func (rps *ResizablePoolStub) Resize(size int) {
+	if rps.ResizeCalled != nil {
+		rps.ResizeCalled(size)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rps *ResizablePoolStub) IsInterfaceNil() bool {
+	if rps == nil {
+		return true
+	}
+	return false
+}
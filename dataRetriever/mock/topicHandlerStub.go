@@ -11,7 +11,10 @@ type TopicHandlerStub struct {
 }
 
 func (ths *TopicHandlerStub) HasTopic(name string) bool {
-	return ths.HasTopicCalled(name)
+	if ths.HasTopicCalled != nil {
+		return ths.HasTopicCalled(name)
+	}
+	return false
 }
 
 func (ths *TopicHandlerStub) CreateTopic(name string, createChannelForTopic bool) error {
@@ -0,0 +1,222 @@
+package poolsCapacity
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+var log = logger.GetOrCreate("dataRetriever/poolsCapacity")
+
+// minRelativeChange is the relative capacity change under which a pool is not resized, as resizing some pools
+// means copying their content
+const minRelativeChange = 0.1
+
+// ArgCapacityController is the argument structure used to create a new capacity controller
+type ArgCapacityController struct {
+	Config      config.PoolsCapacityConfig
+	MemoryUsage dataRetriever.MemoryUsageHandler
+}
+
+type controlledPool struct {
+	name     string
+	pool     dataRetriever.ResizablePool
+	minSize  int
+	maxSize  int
+	capacity int
+	numAdded uint64
+}
+
+// CapacityController adapts the capacity of the data pools to the rate of the received items: each pool is sized to
+// hold the items received during the retention period, between a minimum and a maximum derived from its configured
+// size. The pools shrink at most by half on each adjustment and are all halved while the machine memory is short
+type CapacityController struct {
+	cfg            config.PoolsCapacityConfig
+	memoryUsage    dataRetriever.MemoryUsageHandler
+	adjustInterval time.Duration
+	retention      time.Duration
+	mutPools       sync.Mutex
+	pools          []*controlledPool
+	cancel         func()
+}
+
+// NewCapacityController creates a new capacity controller and starts its periodic adjustment
+func NewCapacityController(arg ArgCapacityController) (*CapacityController, error) {
+	err := checkArgs(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cc := &CapacityController{
+		cfg:            arg.Config,
+		memoryUsage:    arg.MemoryUsage,
+		adjustInterval: time.Duration(arg.Config.AdjustIntervalInSec) * time.Second,
+		retention:      time.Duration(arg.Config.RetentionInSec) * time.Second,
+		pools:          make([]*controlledPool, 0),
+		cancel:         cancel,
+	}
+
+	go cc.adjustLoop(ctx)
+
+	return cc, nil
+}
+
+func checkArgs(arg ArgCapacityController) error {
+	if arg.MemoryUsage == nil || arg.MemoryUsage.IsInterfaceNil() {
+		return dataRetriever.ErrNilMemoryUsageHandler
+	}
+	cfg := arg.Config
+	if cfg.AdjustIntervalInSec <= 0 {
+		return dataRetriever.ErrInvalidAdjustInterval
+	}
+	if cfg.RetentionInSec <= 0 {
+		return dataRetriever.ErrInvalidRetention
+	}
+	if cfg.MinSizeRatio <= 0 || cfg.MinSizeRatio > 1 || cfg.MaxSizeRatio < 1 {
+		return dataRetriever.ErrInvalidSizeRatio
+	}
+	if cfg.MaxMemoryUsagePercent == 0 || cfg.MaxMemoryUsagePercent > 100 {
+		return dataRetriever.ErrInvalidMaxMemoryUsagePercent
+	}
+
+	return nil
+}
+
+// AddPool places the pool under the control of the capacity controller. The pool is considered to currently have
+// the configured size, which is also the base of its capacity limits
+func (cc *CapacityController) AddPool(name string, pool dataRetriever.ResizablePool, configuredSize int) error {
+	if pool == nil || pool.IsInterfaceNil() {
+		return dataRetriever.ErrNilResizablePool
+	}
+	if configuredSize <= 0 {
+		return dataRetriever.ErrInvalidPoolSize
+	}
+
+	minSize := int(float64(configuredSize) * cc.cfg.MinSizeRatio)
+	if minSize < 1 {
+		minSize = 1
+	}
+	cp := &controlledPool{
+		name:     name,
+		pool:     pool,
+		minSize:  minSize,
+		maxSize:  int(float64(configuredSize) * cc.cfg.MaxSizeRatio),
+		capacity: configuredSize,
+	}
+	pool.RegisterHandler(func(_ []byte) {
+		atomic.AddUint64(&cp.numAdded, 1)
+	})
+
+	cc.mutPools.Lock()
+	cc.pools = append(cc.pools, cp)
+	cc.mutPools.Unlock()
+
+	return nil
+}
+
+func (cc *CapacityController) adjustLoop(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(cc.adjustInterval):
+			cc.adjustCapacities()
+		case <-ctx.Done():
+			log.Debug("pools capacity controller's go routine is stopping...")
+			return
+		}
+	}
+}
+
+func (cc *CapacityController) adjustCapacities() {
+	isMemoryShort := cc.isMemoryShort()
+
+	cc.mutPools.Lock()
+	defer cc.mutPools.Unlock()
+
+	for _, cp := range cc.pools {
+		numAdded := atomic.SwapUint64(&cp.numAdded, 0)
+		cc.adjustCapacity(cp, numAdded, isMemoryShort)
+	}
+}
+
+func (cc *CapacityController) isMemoryShort() bool {
+	usedPercent, err := cc.memoryUsage.UsedMemoryPercent()
+	if err != nil {
+		log.Debug("cannot read the memory usage", "error", err.Error())
+		return false
+	}
+
+	return usedPercent >= cc.cfg.MaxMemoryUsagePercent
+}
+
+func (cc *CapacityController) adjustCapacity(cp *controlledPool, numAdded uint64, isMemoryShort bool) {
+	target := int(float64(numAdded) * cc.retention.Seconds() / cc.adjustInterval.Seconds())
+	if target < cp.capacity/2 {
+		target = cp.capacity / 2
+	}
+	if isMemoryShort {
+		target = cp.capacity / 2
+	}
+	if target < cp.minSize {
+		target = cp.minSize
+	}
+	if target > cp.maxSize {
+		target = cp.maxSize
+	}
+
+	if target == cp.capacity {
+		return
+	}
+	isAtLimit := target == cp.minSize || target == cp.maxSize
+	relativeChange := float64(target-cp.capacity) / float64(cp.capacity)
+	if relativeChange < 0 {
+		relativeChange = -relativeChange
+	}
+	if relativeChange < minRelativeChange && !isAtLimit {
+		return
+	}
+
+	log.Debug("resizing data pool",
+		"pool", cp.name,
+		"old capacity", cp.capacity,
+		"new capacity", target,
+		"received items", numAdded,
+		"memory short", isMemoryShort,
+	)
+	cp.pool.Resize(target)
+	cp.capacity = target
+}
+
+// Capacity returns the current capacity of the named pool and false if no such pool is controlled
+func (cc *CapacityController) Capacity(name string) (int, bool) {
+	cc.mutPools.Lock()
+	defer cc.mutPools.Unlock()
+
+	for _, cp := range cc.pools {
+		if cp.name == name {
+			return cp.capacity, true
+		}
+	}
+
+	return 0, false
+}
+
+// Close stops the periodic adjustment
+func (cc *CapacityController) Close() error {
+	cc.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cc *CapacityController) IsInterfaceNil() bool {
+	if cc == nil {
+		return true
+	}
+	return false
+}
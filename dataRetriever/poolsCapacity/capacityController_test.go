@@ -0,0 +1,281 @@
+package poolsCapacity_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/poolsCapacity"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArg() poolsCapacity.ArgCapacityController {
+	return poolsCapacity.ArgCapacityController{
+		Config: config.PoolsCapacityConfig{
+			Enabled:               true,
+			AdjustIntervalInSec:   10,
+			RetentionInSec:        100,
+			MinSizeRatio:          0.25,
+			MaxSizeRatio:          4,
+			MaxMemoryUsagePercent: 80,
+		},
+		MemoryUsage: &mock.MemoryUsageHandlerStub{},
+	}
+}
+
+// createPool returns a pool stub recording its last size and a function simulating the addition of items
+func createPool(lastSize *int) (*mock.ResizablePoolStub, func(numItems int)) {
+	var handler func(key []byte)
+	pool := &mock.ResizablePoolStub{
+		RegisterHandlerCalled: func(h func(key []byte)) {
+			handler = h
+		},
+		ResizeCalled: func(size int) {
+			*lastSize = size
+		},
+	}
+	addItems := func(numItems int) {
+		for i := 0; i < numItems; i++ {
+			handler([]byte("key"))
+		}
+	}
+
+	return pool, addItems
+}
+
+//------- NewCapacityController
+
+func TestNewCapacityController_NilMemoryUsageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArg()
+	arg.MemoryUsage = nil
+	cc, err := poolsCapacity.NewCapacityController(arg)
+
+	assert.Nil(t, cc)
+	assert.Equal(t, dataRetriever.ErrNilMemoryUsageHandler, err)
+}
+
+func TestNewCapacityController_InvalidAdjustIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArg()
+	arg.Config.AdjustIntervalInSec = 0
+	cc, err := poolsCapacity.NewCapacityController(arg)
+
+	assert.Nil(t, cc)
+	assert.Equal(t, dataRetriever.ErrInvalidAdjustInterval, err)
+}
+
+func TestNewCapacityController_InvalidRetentionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArg()
+	arg.Config.RetentionInSec = -1
+	cc, err := poolsCapacity.NewCapacityController(arg)
+
+	assert.Nil(t, cc)
+	assert.Equal(t, dataRetriever.ErrInvalidRetention, err)
+}
+
+func TestNewCapacityController_InvalidSizeRatiosShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArg()
+	arg.Config.MinSizeRatio = 1.5
+	cc, err := poolsCapacity.NewCapacityController(arg)
+
+	assert.Nil(t, cc)
+	assert.Equal(t, dataRetriever.ErrInvalidSizeRatio, err)
+
+	arg = createMockArg()
+	arg.Config.MaxSizeRatio = 0.5
+	cc, err = poolsCapacity.NewCapacityController(arg)
+
+	assert.Nil(t, cc)
+	assert.Equal(t, dataRetriever.ErrInvalidSizeRatio, err)
+}
+
+func TestNewCapacityController_InvalidMaxMemoryUsagePercentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArg()
+	arg.Config.MaxMemoryUsagePercent = 101
+	cc, err := poolsCapacity.NewCapacityController(arg)
+
+	assert.Nil(t, cc)
+	assert.Equal(t, dataRetriever.ErrInvalidMaxMemoryUsagePercent, err)
+}
+
+func TestNewCapacityController_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cc, err := poolsCapacity.NewCapacityController(createMockArg())
+
+	assert.Nil(t, err)
+	assert.False(t, cc.IsInterfaceNil())
+	assert.Nil(t, cc.Close())
+}
+
+//------- AddPool
+
+func TestCapacityController_AddPoolNilPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cc, _ := poolsCapacity.NewCapacityController(createMockArg())
+	defer func() {
+		_ = cc.Close()
+	}()
+
+	err := cc.AddPool("txs", nil, 100)
+
+	assert.Equal(t, dataRetriever.ErrNilResizablePool, err)
+}
+
+func TestCapacityController_AddPoolInvalidSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cc, _ := poolsCapacity.NewCapacityController(createMockArg())
+	defer func() {
+		_ = cc.Close()
+	}()
+
+	err := cc.AddPool("txs", &mock.ResizablePoolStub{}, 0)
+
+	assert.Equal(t, dataRetriever.ErrInvalidPoolSize, err)
+}
+
+func TestCapacityController_AddPoolShouldStartFromTheConfiguredSize(t *testing.T) {
+	t.Parallel()
+
+	cc, _ := poolsCapacity.NewCapacityController(createMockArg())
+	defer func() {
+		_ = cc.Close()
+	}()
+
+	err := cc.AddPool("txs", &mock.ResizablePoolStub{}, 100)
+	capacity, found := cc.Capacity("txs")
+
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 100, capacity)
+}
+
+//------- AdjustCapacities
+
+func TestCapacityController_AdjustCapacitiesShouldGrowUpToTheMaximum(t *testing.T) {
+	t.Parallel()
+
+	cc, _ := poolsCapacity.NewCapacityController(createMockArg())
+	defer func() {
+		_ = cc.Close()
+	}()
+	lastSize := 0
+	pool, addItems := createPool(&lastSize)
+	_ = cc.AddPool("txs", pool, 100)
+
+	// 20 items in 10 seconds kept for 100 seconds
+	addItems(20)
+	cc.AdjustCapacities()
+	assert.Equal(t, 200, lastSize)
+
+	addItems(100)
+	cc.AdjustCapacities()
+	assert.Equal(t, 400, lastSize)
+	capacity, _ := cc.Capacity("txs")
+	assert.Equal(t, 400, capacity)
+}
+
+func TestCapacityController_AdjustCapacitiesShouldShrinkAtMostByHalfDownToTheMinimum(t *testing.T) {
+	t.Parallel()
+
+	cc, _ := poolsCapacity.NewCapacityController(createMockArg())
+	defer func() {
+		_ = cc.Close()
+	}()
+	lastSize := 0
+	pool, _ := createPool(&lastSize)
+	_ = cc.AddPool("txs", pool, 100)
+
+	cc.AdjustCapacities()
+	assert.Equal(t, 50, lastSize)
+
+	cc.AdjustCapacities()
+	assert.Equal(t, 25, lastSize)
+
+	lastSize = 0
+	cc.AdjustCapacities()
+	assert.Equal(t, 0, lastSize)
+}
+
+func TestCapacityController_AdjustCapacitiesSmallChangeShouldNotResize(t *testing.T) {
+	t.Parallel()
+
+	cc, _ := poolsCapacity.NewCapacityController(createMockArg())
+	defer func() {
+		_ = cc.Close()
+	}()
+	numResizes := 0
+	pool, addItems := createPool(new(int))
+	pool.ResizeCalled = func(size int) {
+		numResizes++
+	}
+	_ = cc.AddPool("txs", pool, 1000)
+
+	addItems(100)
+	cc.AdjustCapacities()
+	addItems(105)
+	cc.AdjustCapacities()
+	addItems(96)
+	cc.AdjustCapacities()
+
+	assert.Equal(t, 0, numResizes)
+}
+
+func TestCapacityController_AdjustCapacitiesMemoryShortShouldHalveThePools(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArg()
+	arg.MemoryUsage = &mock.MemoryUsageHandlerStub{
+		UsedMemoryPercentCalled: func() (uint64, error) {
+			return 85, nil
+		},
+	}
+	cc, _ := poolsCapacity.NewCapacityController(arg)
+	defer func() {
+		_ = cc.Close()
+	}()
+	lastSize := 0
+	pool, addItems := createPool(&lastSize)
+	_ = cc.AddPool("txs", pool, 100)
+
+	addItems(100)
+	cc.AdjustCapacities()
+
+	assert.Equal(t, 50, lastSize)
+}
+
+func TestCapacityController_AdjustCapacitiesMemoryUsageErrorShouldIgnoreIt(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArg()
+	arg.MemoryUsage = &mock.MemoryUsageHandlerStub{
+		UsedMemoryPercentCalled: func() (uint64, error) {
+			return 0, errors.New("expected error")
+		},
+	}
+	cc, _ := poolsCapacity.NewCapacityController(arg)
+	defer func() {
+		_ = cc.Close()
+	}()
+	lastSize := 0
+	pool, addItems := createPool(&lastSize)
+	_ = cc.AddPool("txs", pool, 100)
+
+	addItems(30)
+	cc.AdjustCapacities()
+
+	assert.Equal(t, 300, lastSize)
+}
@@ -0,0 +1,5 @@
+package poolsCapacity
+
+func (cc *CapacityController) AdjustCapacities() {
+	cc.adjustCapacities()
+}
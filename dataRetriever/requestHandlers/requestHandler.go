@@ -22,7 +22,7 @@ type resolverRequestHandler struct {
 	maxTxsToRequest      int
 }
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("dataRetriever/requestHandlers")
 
 // NewShardResolverRequestHandler creates a requestHandler interface implementation with request functions
 func NewShardResolverRequestHandler(
@@ -0,0 +1,176 @@
+package requestsTracker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+var log = logger.GetOrCreate("dataRetriever/requestsTracker")
+
+type topicRequests struct {
+	pending           map[string]time.Time
+	numSent           uint64
+	numFulfilled      uint64
+	numTimedOut       uint64
+	totalLatency      time.Duration
+	lastDataReceived  time.Time
+	numSilentTimeouts uint32
+}
+
+type requestsTracker struct {
+	requestTimeout    time.Duration
+	maxSilentTimeouts uint32
+	mutTopics         sync.Mutex
+	topics            map[string]*topicRequests
+	statusHandler     core.AppStatusHandler
+}
+
+// NewRequestsTracker creates a tracker which considers a request timed out if its data was not received
+// in the provided request timeout. A topic is considered unresponsive after maxSilentTimeouts consecutive
+// requests timed out without any data being received on that topic. A zero request timeout is allowed, as the sync
+// loop may wait no time at all for the requested data
+func NewRequestsTracker(requestTimeout time.Duration, maxSilentTimeouts uint32) (*requestsTracker, error) {
+	if requestTimeout < 0 {
+		return nil, dataRetriever.ErrInvalidRequestTimeout
+	}
+	if maxSilentTimeouts == 0 {
+		return nil, dataRetriever.ErrInvalidMaxSilentTimeouts
+	}
+
+	return &requestsTracker{
+		requestTimeout:    requestTimeout,
+		maxSilentTimeouts: maxSilentTimeouts,
+		topics:            make(map[string]*topicRequests),
+		statusHandler:     statusHandler.NewNilStatusHandler(),
+	}, nil
+}
+
+// RequestSent records a request for the data identified by key, sent on the provided topic. A request sent
+// again while still pending replaces the previous one
+func (rt *requestsTracker) RequestSent(topic string, key []byte) {
+	rt.mutTopics.Lock()
+	defer rt.mutTopics.Unlock()
+
+	tr := rt.getOrCreateTopicRequests(topic)
+	rt.expireRequests(topic, tr)
+
+	tr.pending[string(key)] = time.Now()
+	tr.numSent++
+
+	log.Debug(fmt.Sprintf("request sent on topic %s for key %s, %d requests pending\n",
+		topic, core.ToB64(key), len(tr.pending)))
+
+	rt.statusHandler.SetUInt64Value(core.MetricRequestsSentPrefix+topic, tr.numSent)
+	rt.statusHandler.SetUInt64Value(core.MetricRequestsPendingPrefix+topic, uint64(len(tr.pending)))
+}
+
+// DataReceived marks the topic as active and fulfills the pending request for the provided key, if any
+func (rt *requestsTracker) DataReceived(topic string, key []byte) {
+	rt.mutTopics.Lock()
+	defer rt.mutTopics.Unlock()
+
+	now := time.Now()
+	tr := rt.getOrCreateTopicRequests(topic)
+	rt.expireRequests(topic, tr)
+	tr.lastDataReceived = now
+	tr.numSilentTimeouts = 0
+
+	sentTime, isPending := tr.pending[string(key)]
+	if !isPending {
+		return
+	}
+
+	delete(tr.pending, string(key))
+	latency := now.Sub(sentTime)
+	tr.numFulfilled++
+	tr.totalLatency += latency
+	averageLatency := tr.totalLatency / time.Duration(tr.numFulfilled)
+
+	log.Debug(fmt.Sprintf("request on topic %s for key %s fulfilled in %v\n", topic, core.ToB64(key), latency))
+
+	rt.statusHandler.SetUInt64Value(core.MetricRequestsFulfilledPrefix+topic, tr.numFulfilled)
+	rt.statusHandler.SetUInt64Value(core.MetricRequestsPendingPrefix+topic, uint64(len(tr.pending)))
+	rt.statusHandler.SetUInt64Value(core.MetricRequestsAverageLatencyPrefix+topic, uint64(averageLatency/time.Millisecond))
+}
+
+// IsTopicUnresponsive returns true if the last requests on the provided topic timed out and no data at all was
+// received on that topic since they were sent. In this case the data is not missing because it is not yet
+// available in the network but because no peer responds on the topic
+func (rt *requestsTracker) IsTopicUnresponsive(topic string) bool {
+	rt.mutTopics.Lock()
+	defer rt.mutTopics.Unlock()
+
+	tr, found := rt.topics[topic]
+	if !found {
+		return false
+	}
+
+	rt.expireRequests(topic, tr)
+
+	return tr.numSilentTimeouts >= rt.maxSilentTimeouts
+}
+
+func (rt *requestsTracker) getOrCreateTopicRequests(topic string) *topicRequests {
+	tr, found := rt.topics[topic]
+	if !found {
+		tr = &topicRequests{
+			pending: make(map[string]time.Time),
+		}
+		rt.topics[topic] = tr
+	}
+
+	return tr
+}
+
+func (rt *requestsTracker) expireRequests(topic string, tr *topicRequests) {
+	now := time.Now()
+	numExpired := 0
+	for key, sentTime := range tr.pending {
+		if now.Sub(sentTime) < rt.requestTimeout {
+			continue
+		}
+
+		delete(tr.pending, key)
+		tr.numTimedOut++
+		numExpired++
+		if sentTime.After(tr.lastDataReceived) {
+			tr.numSilentTimeouts++
+		}
+
+		log.Debug(fmt.Sprintf("request on topic %s for key %s timed out\n", topic, core.ToB64([]byte(key))))
+	}
+
+	if numExpired == 0 {
+		return
+	}
+
+	rt.statusHandler.SetUInt64Value(core.MetricRequestsTimedOutPrefix+topic, tr.numTimedOut)
+	rt.statusHandler.SetUInt64Value(core.MetricRequestsPendingPrefix+topic, uint64(len(tr.pending)))
+}
+
+// SetStatusHandler sets the status handler used to publish the requests metrics
+func (rt *requestsTracker) SetStatusHandler(handler core.AppStatusHandler) error {
+	if handler == nil || handler.IsInterfaceNil() {
+		return dataRetriever.ErrNilAppStatusHandler
+	}
+
+	rt.mutTopics.Lock()
+	rt.statusHandler = handler
+	rt.mutTopics.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *requestsTracker) IsInterfaceNil() bool {
+	if rt == nil {
+		return true
+	}
+	return false
+}
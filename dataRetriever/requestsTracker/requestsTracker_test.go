@@ -0,0 +1,164 @@
+package requestsTracker_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestsTracker"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTopic = "headers"
+
+func createMetricsRecorder() (*mock.AppStatusHandlerStub, func(key string) uint64) {
+	mutMetrics := sync.Mutex{}
+	metrics := make(map[string]uint64)
+	handler := &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			mutMetrics.Lock()
+			metrics[key] = value
+			mutMetrics.Unlock()
+		},
+	}
+	getMetric := func(key string) uint64 {
+		mutMetrics.Lock()
+		defer mutMetrics.Unlock()
+
+		return metrics[key]
+	}
+
+	return handler, getMetric
+}
+
+func TestNewRequestsTracker_InvalidTimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rt, err := requestsTracker.NewRequestsTracker(-1, 1)
+
+	assert.Nil(t, rt)
+	assert.Equal(t, dataRetriever.ErrInvalidRequestTimeout, err)
+}
+
+func TestNewRequestsTracker_ZeroTimeoutShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rt, err := requestsTracker.NewRequestsTracker(0, 1)
+
+	assert.NotNil(t, rt)
+	assert.Nil(t, err)
+}
+
+func TestNewRequestsTracker_InvalidMaxSilentTimeoutsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rt, err := requestsTracker.NewRequestsTracker(time.Second, 0)
+
+	assert.Nil(t, rt)
+	assert.Equal(t, dataRetriever.ErrInvalidMaxSilentTimeouts, err)
+}
+
+func TestNewRequestsTracker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rt, err := requestsTracker.NewRequestsTracker(time.Second, 1)
+
+	assert.Nil(t, err)
+	assert.False(t, rt.IsInterfaceNil())
+}
+
+func TestRequestsTracker_SetNilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := requestsTracker.NewRequestsTracker(time.Second, 1)
+	err := rt.SetStatusHandler(nil)
+
+	assert.Equal(t, dataRetriever.ErrNilAppStatusHandler, err)
+}
+
+func TestRequestsTracker_RequestFulfilledShouldUpdateMetrics(t *testing.T) {
+	t.Parallel()
+
+	handler, getMetric := createMetricsRecorder()
+	rt, _ := requestsTracker.NewRequestsTracker(time.Second, 1)
+	_ = rt.SetStatusHandler(handler)
+
+	rt.RequestSent(testTopic, []byte("key1"))
+	rt.RequestSent(testTopic, []byte("key2"))
+	assert.Equal(t, uint64(2), getMetric(core.MetricRequestsSentPrefix+testTopic))
+	assert.Equal(t, uint64(2), getMetric(core.MetricRequestsPendingPrefix+testTopic))
+
+	rt.DataReceived(testTopic, []byte("key1"))
+	rt.DataReceived(testTopic, []byte("not requested"))
+	assert.Equal(t, uint64(1), getMetric(core.MetricRequestsFulfilledPrefix+testTopic))
+	assert.Equal(t, uint64(1), getMetric(core.MetricRequestsPendingPrefix+testTopic))
+	assert.Equal(t, uint64(0), getMetric(core.MetricRequestsTimedOutPrefix+testTopic))
+}
+
+func TestRequestsTracker_ExpiredRequestShouldTimeOut(t *testing.T) {
+	t.Parallel()
+
+	handler, getMetric := createMetricsRecorder()
+	rt, _ := requestsTracker.NewRequestsTracker(time.Millisecond, 1)
+	_ = rt.SetStatusHandler(handler)
+
+	rt.RequestSent(testTopic, []byte("key"))
+	time.Sleep(time.Millisecond * 10)
+	rt.DataReceived(testTopic, []byte("key"))
+
+	assert.Equal(t, uint64(1), getMetric(core.MetricRequestsTimedOutPrefix+testTopic))
+	assert.Equal(t, uint64(0), getMetric(core.MetricRequestsFulfilledPrefix+testTopic))
+	assert.Equal(t, uint64(0), getMetric(core.MetricRequestsPendingPrefix+testTopic))
+}
+
+func TestRequestsTracker_IsTopicUnresponsiveNoRequestsShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := requestsTracker.NewRequestsTracker(time.Millisecond, 1)
+
+	assert.False(t, rt.IsTopicUnresponsive(testTopic))
+}
+
+func TestRequestsTracker_IsTopicUnresponsiveNothingReceivedShouldReturnTrue(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := requestsTracker.NewRequestsTracker(time.Millisecond, 1)
+
+	rt.RequestSent(testTopic, []byte("key"))
+	assert.False(t, rt.IsTopicUnresponsive(testTopic))
+
+	time.Sleep(time.Millisecond * 10)
+	assert.True(t, rt.IsTopicUnresponsive(testTopic))
+}
+
+func TestRequestsTracker_IsTopicUnresponsiveShouldWaitMaxSilentTimeouts(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := requestsTracker.NewRequestsTracker(time.Millisecond, 2)
+
+	rt.RequestSent(testTopic, []byte("key"))
+	time.Sleep(time.Millisecond * 10)
+	assert.False(t, rt.IsTopicUnresponsive(testTopic))
+
+	rt.RequestSent(testTopic, []byte("key"))
+	time.Sleep(time.Millisecond * 10)
+	assert.True(t, rt.IsTopicUnresponsive(testTopic))
+
+	rt.DataReceived(testTopic, []byte("other key"))
+	assert.False(t, rt.IsTopicUnresponsive(testTopic))
+}
+
+func TestRequestsTracker_IsTopicUnresponsiveOtherDataReceivedShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := requestsTracker.NewRequestsTracker(time.Millisecond*5, 1)
+
+	rt.RequestSent(testTopic, []byte("cold key"))
+	rt.DataReceived(testTopic, []byte("other key"))
+	time.Sleep(time.Millisecond * 10)
+
+	assert.False(t, rt.IsTopicUnresponsive(testTopic))
+}
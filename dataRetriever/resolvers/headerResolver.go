@@ -1,8 +1,6 @@
 package resolvers
 
 import (
-	"fmt"
-
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -11,7 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("dataRetriever/resolvers")
 
 // HeaderResolver is a wrapper over Resolver that is specialized in resolving headers requests
 type HeaderResolver struct {
@@ -91,7 +89,8 @@ func (hdrRes *HeaderResolver) ProcessReceivedMessage(message p2p.MessageP2P, _ f
 		return err
 	}
 	if buff == nil {
-		log.Debug(fmt.Sprintf("missing data: %v", rd))
+		// the missing headers are not replied, without formatting the request as the node might be flooded with them
+		log.Debug("missing header", "request type", rd.Type)
 		return nil
 	}
 
@@ -0,0 +1,179 @@
+package topicResolverSender
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var log = logger.GetOrCreate("dataRetriever/resolvers/topicResolverSender")
+
+// ArgAntifloodTopicMessageHandler is the argument structure used to create a new antiflood topic message handler
+type ArgAntifloodTopicMessageHandler struct {
+	Messenger                     dataRetriever.TopicMessageHandler
+	MaxRequestsPerPeer            uint32
+	MaxRequestsPerValidatorPeer   uint32
+	QuotaInterval                 time.Duration
+	MaxConcurrentHandlersPerTopic uint32
+	StatusHandler                 core.AppStatusHandler
+}
+
+// antifloodTopicMessageHandler decorates a topic message handler so that the requests received by the registered
+// resolvers are throttled: each peer has a quota of requests in each quota interval, counted over all the topics,
+// and each topic has a maximum number of requests handled at the same time. The peers mapped to validators have a
+// larger quota, as they need the data to take part in the consensus. The requests over the limits are dropped before
+// reaching the resolvers, so they do not load the storage
+type antifloodTopicMessageHandler struct {
+	dataRetriever.TopicMessageHandler
+	quota                         *peersQuota
+	maxConcurrentHandlersPerTopic uint32
+	statusHandler                 core.AppStatusHandler
+}
+
+// NewAntifloodTopicMessageHandler creates a new topic message handler throttling the requests of the registered resolvers
+func NewAntifloodTopicMessageHandler(arg ArgAntifloodTopicMessageHandler) (*antifloodTopicMessageHandler, error) {
+	if check.IfNil(arg.Messenger) {
+		return nil, dataRetriever.ErrNilMessenger
+	}
+	if arg.MaxRequestsPerPeer == 0 {
+		return nil, dataRetriever.ErrInvalidMaxRequestsPerPeer
+	}
+	if arg.MaxRequestsPerValidatorPeer < arg.MaxRequestsPerPeer {
+		return nil, dataRetriever.ErrInvalidMaxRequestsPerValidatorPeer
+	}
+	if arg.QuotaInterval <= 0 {
+		return nil, dataRetriever.ErrInvalidQuotaInterval
+	}
+	if arg.MaxConcurrentHandlersPerTopic == 0 {
+		return nil, dataRetriever.ErrInvalidMaxConcurrentHandlers
+	}
+	if check.IfNil(arg.StatusHandler) {
+		return nil, dataRetriever.ErrNilAppStatusHandler
+	}
+
+	return &antifloodTopicMessageHandler{
+		TopicMessageHandler:           arg.Messenger,
+		quota:                         newPeersQuota(arg.MaxRequestsPerPeer, arg.MaxRequestsPerValidatorPeer, arg.QuotaInterval),
+		maxConcurrentHandlersPerTopic: arg.MaxConcurrentHandlersPerTopic,
+		statusHandler:                 arg.StatusHandler,
+	}, nil
+}
+
+// RegisterMessageProcessor registers the handler on the topic, wrapped so that its received requests are throttled
+func (athm *antifloodTopicMessageHandler) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	if check.IfNil(handler) {
+		return athm.TopicMessageHandler.RegisterMessageProcessor(topic, handler)
+	}
+
+	throttledHandler := &antifloodMessageProcessor{
+		topic:         topic,
+		handler:       handler,
+		peerInfo:      athm.TopicMessageHandler,
+		quota:         athm.quota,
+		handlerSlots:  make(chan struct{}, athm.maxConcurrentHandlersPerTopic),
+		statusHandler: athm.statusHandler,
+	}
+
+	return athm.TopicMessageHandler.RegisterMessageProcessor(topic, throttledHandler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (athm *antifloodTopicMessageHandler) IsInterfaceNil() bool {
+	if athm == nil {
+		return true
+	}
+	return false
+}
+
+type antifloodMessageProcessor struct {
+	topic         string
+	handler       p2p.MessageProcessor
+	peerInfo      dataRetriever.MessageHandler
+	quota         *peersQuota
+	handlerSlots  chan struct{}
+	statusHandler core.AppStatusHandler
+}
+
+// ProcessReceivedMessage passes the message to the wrapped handler if the sending peer did not exhaust its quota
+// and the topic has a free handling slot
+func (amp *antifloodMessageProcessor) ProcessReceivedMessage(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+	if check.IfNil(message) {
+		return amp.handler.ProcessReceivedMessage(message, broadcastHandler)
+	}
+
+	isValidator := amp.peerInfo.GetPeerInfo(message.Peer()).PeerType != p2p.UnknownPeer
+	if !amp.quota.tryConsume(message.Peer(), isValidator) {
+		amp.statusHandler.Increment(core.MetricNumThrottledResolverRequests)
+		log.Debug("resolver request dropped, peer quota exceeded", "topic", amp.topic)
+		return dataRetriever.ErrPeerQuotaExceeded
+	}
+
+	select {
+	case amp.handlerSlots <- struct{}{}:
+	default:
+		amp.statusHandler.Increment(core.MetricNumThrottledResolverRequests)
+		log.Debug("resolver request dropped, too many requests in progress", "topic", amp.topic)
+		return dataRetriever.ErrTooManyConcurrentRequests
+	}
+	defer func() {
+		<-amp.handlerSlots
+	}()
+
+	return amp.handler.ProcessReceivedMessage(message, broadcastHandler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (amp *antifloodMessageProcessor) IsInterfaceNil() bool {
+	if amp == nil {
+		return true
+	}
+	return false
+}
+
+// peersQuota counts the requests of each peer in the current quota interval. The counters are all reset when the
+// first request after the end of the interval is received
+type peersQuota struct {
+	maxRequestsPerPeer          uint32
+	maxRequestsPerValidatorPeer uint32
+	quotaInterval               time.Duration
+	mutQuota                    sync.Mutex
+	intervalStart               time.Time
+	numRequests                 map[p2p.PeerID]uint32
+}
+
+func newPeersQuota(maxRequestsPerPeer uint32, maxRequestsPerValidatorPeer uint32, quotaInterval time.Duration) *peersQuota {
+	return &peersQuota{
+		maxRequestsPerPeer:          maxRequestsPerPeer,
+		maxRequestsPerValidatorPeer: maxRequestsPerValidatorPeer,
+		quotaInterval:               quotaInterval,
+		intervalStart:               time.Now(),
+		numRequests:                 make(map[p2p.PeerID]uint32),
+	}
+}
+
+func (pq *peersQuota) tryConsume(pid p2p.PeerID, isValidator bool) bool {
+	pq.mutQuota.Lock()
+	defer pq.mutQuota.Unlock()
+
+	now := time.Now()
+	if now.Sub(pq.intervalStart) >= pq.quotaInterval {
+		pq.intervalStart = now
+		pq.numRequests = make(map[p2p.PeerID]uint32)
+	}
+
+	maxRequests := pq.maxRequestsPerPeer
+	if isValidator {
+		maxRequests = pq.maxRequestsPerValidatorPeer
+	}
+	if pq.numRequests[pid] >= maxRequests {
+		return false
+	}
+	pq.numRequests[pid]++
+
+	return true
+}
@@ -0,0 +1,237 @@
+package topicResolverSender_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgAntiflood(registered map[string]p2p.MessageProcessor) topicResolverSender.ArgAntifloodTopicMessageHandler {
+	messenger := mock.NewTopicMessageHandlerStub()
+	messenger.RegisterMessageProcessorCalled = func(topic string, handler p2p.MessageProcessor) error {
+		registered[topic] = handler
+		return nil
+	}
+
+	return topicResolverSender.ArgAntifloodTopicMessageHandler{
+		Messenger:                     messenger,
+		MaxRequestsPerPeer:            2,
+		MaxRequestsPerValidatorPeer:   3,
+		QuotaInterval:                 time.Hour,
+		MaxConcurrentHandlersPerTopic: 1,
+		StatusHandler: &mock.AppStatusHandlerStub{
+			IncrementHandler: func(key string) {},
+		},
+	}
+}
+
+func sendRequest(handler p2p.MessageProcessor, pid p2p.PeerID) error {
+	return handler.ProcessReceivedMessage(&mock.P2PMessageMock{PeerField: pid}, nil)
+}
+
+//------- NewAntifloodTopicMessageHandler
+
+func TestNewAntifloodTopicMessageHandler_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgAntiflood(make(map[string]p2p.MessageProcessor))
+	arg.Messenger = nil
+	athm, err := topicResolverSender.NewAntifloodTopicMessageHandler(arg)
+
+	assert.Nil(t, athm)
+	assert.Equal(t, dataRetriever.ErrNilMessenger, err)
+}
+
+func TestNewAntifloodTopicMessageHandler_InvalidMaxRequestsPerPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgAntiflood(make(map[string]p2p.MessageProcessor))
+	arg.MaxRequestsPerPeer = 0
+	athm, err := topicResolverSender.NewAntifloodTopicMessageHandler(arg)
+
+	assert.Nil(t, athm)
+	assert.Equal(t, dataRetriever.ErrInvalidMaxRequestsPerPeer, err)
+}
+
+func TestNewAntifloodTopicMessageHandler_InvalidMaxRequestsPerValidatorPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgAntiflood(make(map[string]p2p.MessageProcessor))
+	arg.MaxRequestsPerValidatorPeer = arg.MaxRequestsPerPeer - 1
+	athm, err := topicResolverSender.NewAntifloodTopicMessageHandler(arg)
+
+	assert.Nil(t, athm)
+	assert.Equal(t, dataRetriever.ErrInvalidMaxRequestsPerValidatorPeer, err)
+}
+
+func TestNewAntifloodTopicMessageHandler_InvalidQuotaIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgAntiflood(make(map[string]p2p.MessageProcessor))
+	arg.QuotaInterval = 0
+	athm, err := topicResolverSender.NewAntifloodTopicMessageHandler(arg)
+
+	assert.Nil(t, athm)
+	assert.Equal(t, dataRetriever.ErrInvalidQuotaInterval, err)
+}
+
+func TestNewAntifloodTopicMessageHandler_InvalidMaxConcurrentHandlersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgAntiflood(make(map[string]p2p.MessageProcessor))
+	arg.MaxConcurrentHandlersPerTopic = 0
+	athm, err := topicResolverSender.NewAntifloodTopicMessageHandler(arg)
+
+	assert.Nil(t, athm)
+	assert.Equal(t, dataRetriever.ErrInvalidMaxConcurrentHandlers, err)
+}
+
+func TestNewAntifloodTopicMessageHandler_NilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgAntiflood(make(map[string]p2p.MessageProcessor))
+	arg.StatusHandler = nil
+	athm, err := topicResolverSender.NewAntifloodTopicMessageHandler(arg)
+
+	assert.Nil(t, athm)
+	assert.Equal(t, dataRetriever.ErrNilAppStatusHandler, err)
+}
+
+func TestNewAntifloodTopicMessageHandler_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	athm, err := topicResolverSender.NewAntifloodTopicMessageHandler(createMockArgAntiflood(make(map[string]p2p.MessageProcessor)))
+
+	assert.Nil(t, err)
+	assert.False(t, athm.IsInterfaceNil())
+}
+
+//------- ProcessReceivedMessage
+
+func TestAntifloodTopicMessageHandler_PeerOverQuotaShouldBeDroppedOnAllTopics(t *testing.T) {
+	t.Parallel()
+
+	numProcessed := 0
+	numThrottled := 0
+	registered := make(map[string]p2p.MessageProcessor)
+	arg := createMockArgAntiflood(registered)
+	arg.StatusHandler = &mock.AppStatusHandlerStub{
+		IncrementHandler: func(key string) {
+			assert.Equal(t, core.MetricNumThrottledResolverRequests, key)
+			numThrottled++
+		},
+	}
+	athm, _ := topicResolverSender.NewAntifloodTopicMessageHandler(arg)
+	resolver := &mock.ResolverStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+			numProcessed++
+			return nil
+		},
+	}
+	_ = athm.RegisterMessageProcessor("topic1", resolver)
+	_ = athm.RegisterMessageProcessor("topic2", resolver)
+
+	assert.Nil(t, sendRequest(registered["topic1"], "peer"))
+	assert.Nil(t, sendRequest(registered["topic2"], "peer"))
+	assert.Equal(t, dataRetriever.ErrPeerQuotaExceeded, sendRequest(registered["topic1"], "peer"))
+	assert.Equal(t, dataRetriever.ErrPeerQuotaExceeded, sendRequest(registered["topic2"], "peer"))
+	assert.Nil(t, sendRequest(registered["topic1"], "other peer"))
+
+	assert.Equal(t, 3, numProcessed)
+	assert.Equal(t, 2, numThrottled)
+}
+
+func TestAntifloodTopicMessageHandler_ValidatorPeerShouldHaveTheValidatorsQuota(t *testing.T) {
+	t.Parallel()
+
+	registered := make(map[string]p2p.MessageProcessor)
+	messenger := mock.NewTopicMessageHandlerStub()
+	messenger.RegisterMessageProcessorCalled = func(topic string, handler p2p.MessageProcessor) error {
+		registered[topic] = handler
+		return nil
+	}
+	messenger.GetPeerInfoCalled = func(pid p2p.PeerID) p2p.PeerInfo {
+		if pid == "validator" {
+			return p2p.PeerInfo{PeerType: p2p.CrossShardPeer, ShardID: 1}
+		}
+		return p2p.PeerInfo{PeerType: p2p.UnknownPeer}
+	}
+	arg := createMockArgAntiflood(registered)
+	arg.Messenger = messenger
+	athm, _ := topicResolverSender.NewAntifloodTopicMessageHandler(arg)
+	_ = athm.RegisterMessageProcessor("topic", &mock.ResolverStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+			return nil
+		},
+	})
+
+	for i := uint32(0); i < arg.MaxRequestsPerValidatorPeer; i++ {
+		assert.Nil(t, sendRequest(registered["topic"], "validator"))
+	}
+	assert.Equal(t, dataRetriever.ErrPeerQuotaExceeded, sendRequest(registered["topic"], "validator"))
+
+	for i := uint32(0); i < arg.MaxRequestsPerPeer; i++ {
+		assert.Nil(t, sendRequest(registered["topic"], "peer"))
+	}
+	assert.Equal(t, dataRetriever.ErrPeerQuotaExceeded, sendRequest(registered["topic"], "peer"))
+}
+
+func TestAntifloodTopicMessageHandler_QuotaShouldBeResetAfterTheInterval(t *testing.T) {
+	t.Parallel()
+
+	registered := make(map[string]p2p.MessageProcessor)
+	arg := createMockArgAntiflood(registered)
+	arg.MaxRequestsPerPeer = 1
+	arg.QuotaInterval = time.Millisecond * 10
+	athm, _ := topicResolverSender.NewAntifloodTopicMessageHandler(arg)
+	_ = athm.RegisterMessageProcessor("topic", &mock.ResolverStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+			return nil
+		},
+	})
+
+	assert.Nil(t, sendRequest(registered["topic"], "peer"))
+	assert.Equal(t, dataRetriever.ErrPeerQuotaExceeded, sendRequest(registered["topic"], "peer"))
+	time.Sleep(time.Millisecond * 20)
+	assert.Nil(t, sendRequest(registered["topic"], "peer"))
+}
+
+func TestAntifloodTopicMessageHandler_TooManyConcurrentRequestsShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	registered := make(map[string]p2p.MessageProcessor)
+	athm, _ := topicResolverSender.NewAntifloodTopicMessageHandler(createMockArgAntiflood(registered))
+	handlerStarted := make(chan struct{})
+	releaseHandler := make(chan struct{})
+	_ = athm.RegisterMessageProcessor("topic", &mock.ResolverStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+			handlerStarted <- struct{}{}
+			<-releaseHandler
+			return nil
+		},
+	})
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		assert.Nil(t, sendRequest(registered["topic"], "peer1"))
+		wg.Done()
+	}()
+	<-handlerStarted
+
+	assert.Equal(t, dataRetriever.ErrTooManyConcurrentRequests, sendRequest(registered["topic"], "peer2"))
+
+	close(releaseHandler)
+	wg.Wait()
+	go func() {
+		<-handlerStarted
+	}()
+	assert.Nil(t, sendRequest(registered["topic"], "peer2"))
+}
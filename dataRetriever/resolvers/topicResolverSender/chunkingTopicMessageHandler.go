@@ -0,0 +1,67 @@
+package topicResolverSender
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// chunkingTopicMessageHandler decorates a topic message handler so that the buffers larger than the maximum chunk
+// size are sent split in chunks on the chunks topic, where the receiving interceptors reassemble them
+type chunkingTopicMessageHandler struct {
+	dataRetriever.TopicMessageHandler
+	payloadChunker dataRetriever.PayloadChunker
+	maxChunkSize   int
+}
+
+// NewChunkingTopicMessageHandler creates a new topic message handler able to send large buffers split in chunks
+func NewChunkingTopicMessageHandler(
+	messenger dataRetriever.TopicMessageHandler,
+	payloadChunker dataRetriever.PayloadChunker,
+	maxChunkSize int,
+) (*chunkingTopicMessageHandler, error) {
+	if messenger == nil || messenger.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilMessenger
+	}
+	if payloadChunker == nil || payloadChunker.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilPayloadChunker
+	}
+	if maxChunkSize < 1 {
+		return nil, dataRetriever.ErrInvalidMaxChunkSize
+	}
+
+	return &chunkingTopicMessageHandler{
+		TopicMessageHandler: messenger,
+		payloadChunker:      payloadChunker,
+		maxChunkSize:        maxChunkSize,
+	}, nil
+}
+
+// SendToConnectedPeer sends the buffer to the connected peer, split in chunks if it is too large for a single message
+func (ctmh *chunkingTopicMessageHandler) SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error {
+	if len(buff) <= ctmh.maxChunkSize {
+		return ctmh.TopicMessageHandler.SendToConnectedPeer(topic, buff, peerID)
+	}
+
+	chunks, err := ctmh.payloadChunker.SplitInChunks(buff, ctmh.maxChunkSize)
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range chunks {
+		err = ctmh.TopicMessageHandler.SendToConnectedPeer(topic+core.ChunksTopicSuffix, chunk, peerID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ctmh *chunkingTopicMessageHandler) IsInterfaceNil() bool {
+	if ctmh == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package topicResolverSender_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPayloadChunker() dataRetriever.PayloadChunker {
+	chunker, _ := partitioning.NewPayloadChunker(&mock.MarshalizerMock{}, &mock.HasherMock{})
+	return chunker
+}
+
+//------- NewChunkingTopicMessageHandler
+
+func TestNewChunkingTopicMessageHandler_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctmh, err := topicResolverSender.NewChunkingTopicMessageHandler(nil, createPayloadChunker(), 10)
+
+	assert.Nil(t, ctmh)
+	assert.Equal(t, dataRetriever.ErrNilMessenger, err)
+}
+
+func TestNewChunkingTopicMessageHandler_NilPayloadChunkerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctmh, err := topicResolverSender.NewChunkingTopicMessageHandler(mock.NewTopicMessageHandlerStub(), nil, 10)
+
+	assert.Nil(t, ctmh)
+	assert.Equal(t, dataRetriever.ErrNilPayloadChunker, err)
+}
+
+func TestNewChunkingTopicMessageHandler_InvalidMaxChunkSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctmh, err := topicResolverSender.NewChunkingTopicMessageHandler(
+		mock.NewTopicMessageHandlerStub(),
+		createPayloadChunker(),
+		0,
+	)
+
+	assert.Nil(t, ctmh)
+	assert.Equal(t, dataRetriever.ErrInvalidMaxChunkSize, err)
+}
+
+//------- SendToConnectedPeer
+
+func TestChunkingTopicMessageHandler_SendToConnectedPeerSmallBufferShouldSendItAsIs(t *testing.T) {
+	t.Parallel()
+
+	buff := []byte("small")
+	numSent := 0
+	messenger := mock.NewTopicMessageHandlerStub()
+	messenger.SendToConnectedPeerCalled = func(topic string, buffToSend []byte, peerID p2p.PeerID) error {
+		numSent++
+		assert.Equal(t, "topic", topic)
+		assert.Equal(t, buff, buffToSend)
+		return nil
+	}
+	ctmh, _ := topicResolverSender.NewChunkingTopicMessageHandler(messenger, createPayloadChunker(), 10)
+
+	err := ctmh.SendToConnectedPeer("topic", buff, "peer")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numSent)
+}
+
+func TestChunkingTopicMessageHandler_SendToConnectedPeerLargeBufferShouldSendChunks(t *testing.T) {
+	t.Parallel()
+
+	numSent := 0
+	messenger := mock.NewTopicMessageHandlerStub()
+	messenger.SendToConnectedPeerCalled = func(topic string, buffToSend []byte, peerID p2p.PeerID) error {
+		numSent++
+		assert.Equal(t, "topic"+core.ChunksTopicSuffix, topic)
+		assert.Equal(t, p2p.PeerID("peer"), peerID)
+		return nil
+	}
+	ctmh, _ := topicResolverSender.NewChunkingTopicMessageHandler(messenger, createPayloadChunker(), 10)
+
+	err := ctmh.SendToConnectedPeer("topic", []byte("0123456789abcdefghijk"), "peer")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, numSent)
+}
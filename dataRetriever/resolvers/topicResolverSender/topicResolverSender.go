@@ -1,13 +1,15 @@
 package topicResolverSender
 
 import (
+	"sort"
+
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
-// topicRequestSuffix represents the topic name suffix
-const topicRequestSuffix = "_REQUEST"
+// TopicRequestSuffix represents the topic name suffix
+const TopicRequestSuffix = "_REQUEST"
 
 // NumPeersToQuery number of peers to send the message
 const NumPeersToQuery = 2
@@ -69,7 +71,7 @@ func (trs *topicResolverSender) SendOnRequestTopic(rd *dataRetriever.RequestData
 		return dataRetriever.ErrNoConnectedPeerToSendRequest
 	}
 
-	topicToSendRequest := trs.topicName + topicRequestSuffix
+	topicToSendRequest := trs.topicName + TopicRequestSuffix
 
 	indexes := createIndexList(len(peerList))
 	shuffledIndexes, err := fisherYatesShuffle(indexes, trs.randomizer)
@@ -77,9 +79,14 @@ func (trs *topicResolverSender) SendOnRequestTopic(rd *dataRetriever.RequestData
 		return err
 	}
 
+	shuffledPeers := make([]p2p.PeerID, len(shuffledIndexes))
+	for i, idx := range shuffledIndexes {
+		shuffledPeers[i] = peerList[idx]
+	}
+	trs.sortPeers(shuffledPeers)
+
 	msgSentCounter := 0
-	for idx := range shuffledIndexes {
-		peer := peerList[idx]
+	for _, peer := range shuffledPeers {
 
 		err = trs.messenger.SendToConnectedPeer(topicToSendRequest, buff, peer)
 		if err != nil {
@@ -99,6 +106,26 @@ func (trs *topicResolverSender) SendOnRequestTopic(rd *dataRetriever.RequestData
 	return nil
 }
 
+// sortPeers moves the most trusted peers in front. Between equally rated peers, the ones mapped to validators of the
+// target shard go first, as they hold the requested data, while the random order is kept for the rest
+func (trs *topicResolverSender) sortPeers(peers []p2p.PeerID) {
+	scores := make(map[p2p.PeerID]float64, len(peers))
+	inTargetShard := make(map[p2p.PeerID]bool, len(peers))
+	for _, peer := range peers {
+		scores[peer] = trs.messenger.PeerScore(peer)
+
+		peerInfo := trs.messenger.GetPeerInfo(peer)
+		inTargetShard[peer] = peerInfo.PeerType != p2p.UnknownPeer && peerInfo.ShardID == trs.targetShardId
+	}
+
+	sort.SliceStable(peers, func(i, j int) bool {
+		if scores[peers[i]] != scores[peers[j]] {
+			return scores[peers[i]] > scores[peers[j]]
+		}
+		return inTargetShard[peers[i]] && !inTargetShard[peers[j]]
+	})
+}
+
 func createIndexList(listLength int) []int {
 	indexes := make([]int, listLength)
 	for i := 0; i < listLength; i++ {
@@ -116,7 +143,7 @@ func (trs *topicResolverSender) Send(buff []byte, peer p2p.PeerID) error {
 
 // TopicRequestSuffix returns the suffix that will be added to create a new channel for requests
 func (trs *topicResolverSender) TopicRequestSuffix() string {
-	return topicRequestSuffix
+	return TopicRequestSuffix
 }
 
 // TargetShardID returns the target shard ID for this resolver should serve data
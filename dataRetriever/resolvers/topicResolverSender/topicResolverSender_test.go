@@ -173,6 +173,135 @@ func TestTopicResolverSender_SendOnRequestTopicShouldWork(t *testing.T) {
 	assert.True(t, sentToPid1)
 }
 
+func TestTopicResolverSender_SendOnRequestTopicShouldUseTheShuffledPeers(t *testing.T) {
+	t.Parallel()
+
+	pID1 := p2p.PeerID("peer1")
+	pID2 := p2p.PeerID("peer2")
+	pID3 := p2p.PeerID("peer3")
+	sentToPeers := make([]p2p.PeerID, 0)
+
+	trs, _ := topicResolverSender.NewTopicResolverSender(
+		&mock.MessageHandlerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID p2p.PeerID) error {
+				sentToPeers = append(sentToPeers, peerID)
+				return nil
+			},
+		},
+		"topic",
+		&mock.PeerListCreatorStub{
+			PeerListCalled: func() []p2p.PeerID {
+				return []p2p.PeerID{pID1, pID2, pID3}
+			},
+		},
+		&mock.MarshalizerMock{},
+		&mock.IntRandomizerMock{
+			IntnCalled: func(n int) (int, error) {
+				return 0, nil
+			},
+		},
+		0,
+	)
+
+	err := trs.SendOnRequestTopic(&dataRetriever.RequestData{})
+
+	//the shuffle rotates the first element: 2, 3, 1
+	assert.Nil(t, err)
+	assert.Equal(t, []p2p.PeerID{pID2, pID3}, sentToPeers)
+}
+
+func TestTopicResolverSender_SendOnRequestTopicShouldPreferHighScoredPeers(t *testing.T) {
+	t.Parallel()
+
+	pID1 := p2p.PeerID("peer1")
+	pID2 := p2p.PeerID("peer2")
+	pID3 := p2p.PeerID("peer3")
+	sentToPeers := make([]p2p.PeerID, 0)
+
+	trs, _ := topicResolverSender.NewTopicResolverSender(
+		&mock.MessageHandlerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID p2p.PeerID) error {
+				sentToPeers = append(sentToPeers, peerID)
+				return nil
+			},
+			PeerScoreCalled: func(pid p2p.PeerID) float64 {
+				if pid == pID2 {
+					return -10
+				}
+				if pid == pID1 {
+					return 10
+				}
+				return 0
+			},
+		},
+		"topic",
+		&mock.PeerListCreatorStub{
+			PeerListCalled: func() []p2p.PeerID {
+				return []p2p.PeerID{pID1, pID2, pID3}
+			},
+		},
+		&mock.MarshalizerMock{},
+		&mock.IntRandomizerMock{
+			IntnCalled: func(n int) (int, error) {
+				return 0, nil
+			},
+		},
+		0,
+	)
+
+	err := trs.SendOnRequestTopic(&dataRetriever.RequestData{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []p2p.PeerID{pID1, pID3}, sentToPeers)
+}
+
+func TestTopicResolverSender_SendOnRequestTopicShouldPreferPeersOfTheTargetShard(t *testing.T) {
+	t.Parallel()
+
+	pID1 := p2p.PeerID("peer1")
+	pID2 := p2p.PeerID("peer2")
+	pID3 := p2p.PeerID("peer3")
+	targetShardId := uint32(1)
+	sentToPeers := make([]p2p.PeerID, 0)
+
+	trs, _ := topicResolverSender.NewTopicResolverSender(
+		&mock.MessageHandlerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID p2p.PeerID) error {
+				sentToPeers = append(sentToPeers, peerID)
+				return nil
+			},
+			GetPeerInfoCalled: func(pid p2p.PeerID) p2p.PeerInfo {
+				if pid == pID1 {
+					return p2p.PeerInfo{PeerType: p2p.CrossShardPeer, ShardID: targetShardId}
+				}
+				if pid == pID2 {
+					return p2p.PeerInfo{PeerType: p2p.IntraShardPeer, ShardID: 0}
+				}
+				return p2p.PeerInfo{PeerType: p2p.UnknownPeer}
+			},
+		},
+		"topic",
+		&mock.PeerListCreatorStub{
+			PeerListCalled: func() []p2p.PeerID {
+				return []p2p.PeerID{pID1, pID2, pID3}
+			},
+		},
+		&mock.MarshalizerMock{},
+		&mock.IntRandomizerMock{
+			IntnCalled: func(n int) (int, error) {
+				return 0, nil
+			},
+		},
+		targetShardId,
+	)
+
+	err := trs.SendOnRequestTopic(&dataRetriever.RequestData{})
+
+	//the shuffle rotates the first element: 2, 3, 1 and the peer of the target shard is moved in front
+	assert.Nil(t, err)
+	assert.Equal(t, []p2p.PeerID{pID1, pID2}, sentToPeers)
+}
+
 //------- Send
 
 func TestTopicResolverSender_SendShouldWork(t *testing.T) {
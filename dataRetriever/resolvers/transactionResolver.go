@@ -122,16 +122,20 @@ func (txRes *TxResolver) resolveTxRequestByHashArray(hashesBuff []byte, pid p2p.
 	}
 
 	txsBuffSlice := make([][]byte, 0)
+	numMissing := 0
 	for _, hash := range hashes {
 		tx, err := txRes.fetchTxAsByteSlice(hash)
 		if err != nil {
 			//it might happen to error on a tx (maybe it is missing) but should continue
 			// as to send back as many as it can
-			log.Debug(err.Error())
+			numMissing++
 			continue
 		}
 		txsBuffSlice = append(txsBuffSlice, tx)
 	}
+	if numMissing > 0 {
+		log.Debug("missing transactions", "num missing", numMissing, "num requested", len(hashes))
+	}
 
 	buffsToSend, err := txRes.dataPacker.PackDataInChunks(txsBuffSlice, maxBuffToSendBulkTransactions)
 
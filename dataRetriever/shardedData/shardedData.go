@@ -8,7 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("dataRetriever/shardedData")
 
 // shardedData holds the list of data organised by destination shard
 //
@@ -225,6 +225,28 @@ func (sd *shardedData) ClearShardStore(cacheId string) {
 	mp.Clear()
 }
 
+// Resize changes the maximum number of items of every shard store, including the ones created afterwards. The shard
+// stores whose cacher can not be resized keep their size
+func (sd *shardedData) Resize(size int) {
+	if size <= 0 {
+		log.Debug("attempt to resize a ShardedData object to a size lower than one", "size", size)
+		return
+	}
+
+	sd.mutShardedDataStore.Lock()
+	defer sd.mutShardedDataStore.Unlock()
+
+	sd.cacherConfig.Size = uint32(size)
+	for _, store := range sd.shardedDataStore {
+		resizableCacher, ok := store.DataStore.(storage.ResizableCacher)
+		if !ok {
+			continue
+		}
+
+		resizableCacher.Resize(size)
+	}
+}
+
 // RegisterHandler registers a new handler to be called when a new data is added
 func (sd *shardedData) RegisterHandler(handler func(key []byte)) {
 	if handler == nil {
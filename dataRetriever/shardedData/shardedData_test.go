@@ -315,3 +315,28 @@ func TestShardedData_SearchFirstDataFoundShouldRetResults(t *testing.T) {
 	assert.NotNil(t, value)
 	assert.True(t, ok)
 }
+
+func TestShardedData_ResizeShouldResizeTheExistingAndTheNewShardStores(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := shardedData.NewShardedData(defaultTestConfig)
+	sd.AddData([]byte("tx1"), &transaction.Transaction{Nonce: 1}, "1")
+
+	sd.Resize(10)
+	sd.AddData([]byte("tx2"), &transaction.Transaction{Nonce: 2}, "2")
+
+	assert.Equal(t, 10, sd.ShardDataStore("1").MaxSize())
+	assert.Equal(t, 10, sd.ShardDataStore("2").MaxSize())
+	assert.True(t, sd.ShardDataStore("1").Has([]byte("tx1")))
+}
+
+func TestShardedData_ResizeToZeroShouldNotChangeTheSize(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := shardedData.NewShardedData(defaultTestConfig)
+	sd.AddData([]byte("tx1"), &transaction.Transaction{Nonce: 1}, "1")
+
+	sd.Resize(0)
+
+	assert.Equal(t, int(defaultTestConfig.Size), sd.ShardDataStore("1").MaxSize())
+}
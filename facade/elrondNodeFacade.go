@@ -1,8 +1,10 @@
 package facade
 
 import (
+	"context"
 	"fmt"
 	"math/big"
+	"net/http"
 	"strconv"
 	"sync"
 
@@ -10,11 +12,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 )
 
 // DefaultRestPort is the default port the REST API will start on if not specified
@@ -31,8 +39,16 @@ type ElrondNodeFacade struct {
 	syncer                 ntp.SyncTimer
 	log                    *logger.Logger
 	tpsBenchmark           *statistics.TpsBenchmark
+	managedNonceSender     ManagedNonceSender
+	accountsExporter       AccountsExporter
+	delegationQuerier      DelegationQuerier
+	auctionListQuerier     AuctionListQuerier
 	config                 *config.FacadeConfig
 	restAPIServerDebugMode bool
+
+	mutRestServer sync.Mutex
+	restServer    *http.Server
+	isClosed      bool
 }
 
 // NewElrondNodeFacade creates a new Facade with a NodeWrapper
@@ -71,6 +87,26 @@ func (ef *ElrondNodeFacade) TpsBenchmark() *statistics.TpsBenchmark {
 	return ef.tpsBenchmark
 }
 
+// SetManagedNonceSender sets the component sending the transactions of the hosted wallets with managed nonces
+func (ef *ElrondNodeFacade) SetManagedNonceSender(managedNonceSender ManagedNonceSender) {
+	ef.managedNonceSender = managedNonceSender
+}
+
+// SetAccountsExporter sets the component exporting the accounts of the shard
+func (ef *ElrondNodeFacade) SetAccountsExporter(accountsExporter AccountsExporter) {
+	ef.accountsExporter = accountsExporter
+}
+
+// SetDelegationQuerier sets the component answering the queries over the delegation contracts
+func (ef *ElrondNodeFacade) SetDelegationQuerier(delegationQuerier DelegationQuerier) {
+	ef.delegationQuerier = delegationQuerier
+}
+
+// SetAuctionListQuerier sets the component building the auction list from the staking contract state
+func (ef *ElrondNodeFacade) SetAuctionListQuerier(auctionListQuerier AuctionListQuerier) {
+	ef.auctionListQuerier = auctionListQuerier
+}
+
 // SetConfig sets the configuration options for the facade
 func (ef *ElrondNodeFacade) SetConfig(facadeConfig *config.FacadeConfig) {
 	ef.config = facadeConfig
@@ -154,13 +190,41 @@ func (ef *ElrondNodeFacade) startRest(wg *sync.WaitGroup) {
 		break
 	default:
 		ef.log.Info("Starting web server...")
-		err := api.Start(ef)
+		server, err := api.CreateServer(ef)
 		if err != nil {
 			ef.log.Error("Could not start webserver", err.Error())
+			return
+		}
+
+		ef.mutRestServer.Lock()
+		if ef.isClosed {
+			ef.mutRestServer.Unlock()
+			return
+		}
+		ef.restServer = server
+		ef.mutRestServer.Unlock()
+
+		err = server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			ef.log.Error("Could not start webserver", err.Error())
 		}
 	}
 }
 
+// Close gracefully stops the REST API server, waiting for the requests in progress to finish. The server is not
+// started anymore if Close is called before StartBackgroundServices
+func (ef *ElrondNodeFacade) Close() error {
+	ef.mutRestServer.Lock()
+	defer ef.mutRestServer.Unlock()
+
+	ef.isClosed = true
+	if ef.restServer == nil {
+		return nil
+	}
+
+	return ef.restServer.Shutdown(context.Background())
+}
+
 // GetBalance gets the current balance for a specified address
 func (ef *ElrondNodeFacade) GetBalance(address string) (*big.Int, error) {
 	return ef.node.GetBalance(address)
@@ -177,9 +241,11 @@ func (ef *ElrondNodeFacade) CreateTransaction(
 	data string,
 	signatureHex string,
 	challenge string,
+	chainID string,
+	version uint32,
 ) (*transaction.Transaction, error) {
 
-	return ef.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge)
+	return ef.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge, chainID, version)
 }
 
 // SendTransaction will send a new transaction on the topic channel
@@ -192,9 +258,33 @@ func (ef *ElrondNodeFacade) SendTransaction(
 	gasLimit uint64,
 	transactionData string,
 	signature []byte,
+	chainID string,
+	version uint32,
 ) (string, error) {
 
-	return ef.node.SendTransaction(nonce, senderHex, receiverHex, value, gasPrice, gasLimit, transactionData, signature)
+	return ef.node.SendTransaction(nonce, senderHex, receiverHex, value, gasPrice, gasLimit, transactionData, signature, chainID, version)
+}
+
+// SendTransactionWithManagedNonce will assign the nonce of a transaction sent from a hosted wallet, sign it and send
+// it on the topic channel, returning its hash and nonce
+func (ef *ElrondNodeFacade) SendTransactionWithManagedNonce(
+	senderHex string,
+	receiverHex string,
+	value *big.Int,
+	gasPrice uint64,
+	gasLimit uint64,
+	transactionData string,
+) (string, uint64, error) {
+	if ef.managedNonceSender == nil || ef.managedNonceSender.IsInterfaceNil() {
+		return "", 0, ErrManagedNonceNotEnabled
+	}
+
+	return ef.managedNonceSender.SendTransaction(senderHex, receiverHex, value, gasPrice, gasLimit, transactionData)
+}
+
+// ValidateTransaction checks that the provided transaction is valid
+func (ef *ElrondNodeFacade) ValidateTransaction(tx *transaction.Transaction) error {
+	return ef.node.ValidateTransaction(tx)
 }
 
 // SendBulkTransactions will send a bulk of transactions on the topic channel
@@ -207,12 +297,63 @@ func (ef *ElrondNodeFacade) GetTransaction(hash string) (*transaction.Transactio
 	return ef.node.GetTransaction(hash)
 }
 
+// GetTransactionMetadata returns the miniblock and the block which included the transaction with a specified hash
+func (ef *ElrondNodeFacade) GetTransactionMetadata(hash string) (*dblookupext.MiniblockMetadata, error) {
+	return ef.node.GetTransactionMetadata(hash)
+}
+
+// GetHyperBlockByNonce returns the metachain block with the provided nonce together with the shard blocks it notarized
+func (ef *ElrondNodeFacade) GetHyperBlockByNonce(nonce uint64) (*external.HyperBlock, error) {
+	return ef.node.GetHyperBlockByNonce(nonce)
+}
+
+// GetOutgoingOperationsBatches returns the collected outgoing operations batches together with their signatures
+func (ef *ElrondNodeFacade) GetOutgoingOperationsBatches() ([]*bridge.SignedOutgoingOperationsBatch, error) {
+	return ef.node.GetOutgoingOperationsBatches()
+}
+
+// GetValidatorsSelectionAudit returns how many times the eligible validators were selected as leader and as consensus
+// group member during the given epoch, compared with the expected number of selections
+func (ef *ElrondNodeFacade) GetValidatorsSelectionAudit(epoch uint32) (*audit.EpochSelectionAudit, error) {
+	return ef.node.GetValidatorsSelectionAudit(epoch)
+}
+
+// GetTransactionsPool returns a snapshot of the transactions pool of the node's shard, grouped by sender
+func (ef *ElrondNodeFacade) GetTransactionsPool(withTransactions bool, senderHex string) (*external.TransactionsPool, error) {
+	return ef.node.GetTransactionsPool(withTransactions, senderHex)
+}
+
 // GetAccount returns an accountResponse containing information
 // about the account correlated with provided address
 func (ef *ElrondNodeFacade) GetAccount(address string) (*state.Account, error) {
 	return ef.node.GetAccount(address)
 }
 
+// GetAccountAtNonce returns the account correlated with provided address, as it was after the block with the
+// provided nonce was committed
+func (ef *ElrondNodeFacade) GetAccountAtNonce(address string, blockNonce uint64) (*state.Account, error) {
+	return ef.node.GetAccountAtNonce(address, blockNonce)
+}
+
+// GetAccountWithOptions returns the account correlated with provided address, read on the committed block selected
+// by the query options, together with the coordinates of that block
+func (ef *ElrondNodeFacade) GetAccountWithOptions(
+	address string,
+	options external.AccountQueryOptions,
+) (*state.Account, *external.AccountBlockInfo, error) {
+	return ef.node.GetAccountWithOptions(address, options)
+}
+
+// GetAccountByUserName returns the hex address and the account registered under the provided user name
+func (ef *ElrondNodeFacade) GetAccountByUserName(userName string) (string, *state.Account, error) {
+	return ef.node.GetAccountByUserName(userName)
+}
+
+// GetNFTs returns the non fungible and semi fungible tokens held by the provided address
+func (ef *ElrondNodeFacade) GetNFTs(address string) ([]*external.NFTToken, error) {
+	return ef.node.GetNFTs(address)
+}
+
 // GetCurrentPublicKey gets the current nodes public Key
 func (ef *ElrondNodeFacade) GetCurrentPublicKey() string {
 	return ef.node.GetCurrentPublicKey()
@@ -228,6 +369,76 @@ func (ef *ElrondNodeFacade) GetHeartbeats() ([]heartbeat.PubKeyHeartbeat, error)
 	return hbStatus, nil
 }
 
+// GetPendingMiniBlocks returns the cross shard miniblocks which were not yet processed by their destination shards
+func (ef *ElrondNodeFacade) GetPendingMiniBlocks() ([]*block.PendingMiniBlockInfo, error) {
+	return ef.node.GetPendingMiniBlocks()
+}
+
+// GetNetworkConfig returns the configuration of the network, as known by the node
+func (ef *ElrondNodeFacade) GetNetworkConfig() (*external.NetworkConfig, error) {
+	return ef.node.GetNetworkConfig()
+}
+
+// GetNetworkEconomics returns the chain-level economics values, as known by the node
+func (ef *ElrondNodeFacade) GetNetworkEconomics() (*external.NetworkEconomics, error) {
+	return ef.node.GetNetworkEconomics()
+}
+
+// GetEpochSupply returns the counters of the value minted and burned by the own shard, reached at the end of an epoch
+func (ef *ElrondNodeFacade) GetEpochSupply(epoch uint32) (*supply.ShardSupply, error) {
+	return ef.node.GetEpochSupply(epoch)
+}
+
+// GetESDTSupply returns the initial, minted and burned quantities of a token, as changed by the own shard
+func (ef *ElrondNodeFacade) GetESDTSupply(token string) (*esdt.TokenSupply, error) {
+	return ef.node.GetESDTSupply(token)
+}
+
+// ExportAccounts returns a page of the accounts of the shard, exported at the root hash of the last epoch start
+func (ef *ElrondNodeFacade) ExportAccounts(token string, pageSize int) (*external.AccountsExportPage, error) {
+	if ef.accountsExporter == nil || ef.accountsExporter.IsInterfaceNil() {
+		return nil, ErrAccountsExportNotEnabled
+	}
+
+	return ef.accountsExporter.ExportAccounts(token, pageSize)
+}
+
+// GetDelegators returns a page of the delegators of the provided delegation contract
+func (ef *ElrondNodeFacade) GetDelegators(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error) {
+	if ef.delegationQuerier == nil || ef.delegationQuerier.IsInterfaceNil() {
+		return nil, ErrDelegationQueriesNotAvailable
+	}
+
+	return ef.delegationQuerier.GetDelegators(contractAddress, startIndex, pageSize)
+}
+
+// GetClaimableRewards returns the rewards the provided delegator can claim from the provided delegation contract
+func (ef *ElrondNodeFacade) GetClaimableRewards(contractAddress string, delegatorAddress string) (*big.Int, error) {
+	if ef.delegationQuerier == nil || ef.delegationQuerier.IsInterfaceNil() {
+		return nil, ErrDelegationQueriesNotAvailable
+	}
+
+	return ef.delegationQuerier.GetClaimableRewards(contractAddress, delegatorAddress)
+}
+
+// GetDelegationContractConfig returns the configuration of the provided delegation contract
+func (ef *ElrondNodeFacade) GetDelegationContractConfig(contractAddress string) (*external.DelegationContractConfig, error) {
+	if ef.delegationQuerier == nil || ef.delegationQuerier.IsInterfaceNil() {
+		return nil, ErrDelegationQueriesNotAvailable
+	}
+
+	return ef.delegationQuerier.GetDelegationContractConfig(contractAddress)
+}
+
+// GetAuctionList returns the owners which staked nodes, with the outcome of the next selection of qualified nodes
+func (ef *ElrondNodeFacade) GetAuctionList() (*external.AuctionList, error) {
+	if ef.auctionListQuerier == nil || ef.auctionListQuerier.IsInterfaceNil() {
+		return nil, ErrAuctionListNotAvailable
+	}
+
+	return ef.auctionListQuerier.GetAuctionList()
+}
+
 // StatusMetrics will return the node's status metrics
 func (ef *ElrondNodeFacade) StatusMetrics() external.StatusMetricsHandler {
 	return ef.apiResolver.StatusMetrics()
@@ -238,6 +449,32 @@ func (ef *ElrondNodeFacade) GetVmValue(address string, funcName string, argsBuff
 	return ef.apiResolver.GetVmValue(address, funcName, argsBuff...)
 }
 
+// GetVmValueAtBlock retrieves data from the SC trie as it was after the block with the provided coordinates
+func (ef *ElrondNodeFacade) GetVmValueAtBlock(
+	address string,
+	funcName string,
+	blockCoordinates external.BlockCoordinates,
+	argsBuff ...[]byte,
+) ([]byte, error) {
+	accounts, err := ef.node.GetAccountsAtBlock(blockCoordinates)
+	if err != nil {
+		return nil, err
+	}
+
+	return ef.apiResolver.GetVmValueFromState(accounts, address, funcName, argsBuff...)
+}
+
+// SimulateTransaction executes the provided transaction against the current state, without affecting it. If withTrace
+// is set, the results also hold the trace of the smart contract execution
+func (ef *ElrondNodeFacade) SimulateTransaction(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+	return ef.apiResolver.SimulateTransaction(tx, withTrace)
+}
+
+// GetTransactionWithResults gets the transaction with a specified hash together with its status and results
+func (ef *ElrondNodeFacade) GetTransactionWithResults(hash string) (*transaction.Transaction, *transaction.TransactionResults, error) {
+	return ef.apiResolver.GetTransactionWithResults(hash)
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (ef *ElrondNodeFacade) PprofEnabled() bool {
 	return ef.config.PprofEnabled
@@ -4,14 +4,17 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/facade/mock"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/stretchr/testify/assert"
 )
@@ -279,7 +282,21 @@ func TestElrondNodeFacade_SendTransaction(t *testing.T) {
 		return "", nil
 	}
 	ef := createElrondNodeFacadeWithMockResolver(node)
-	_, _ = ef.SendTransaction(1, "test", "test", big.NewInt(0), 0, 0, "code", []byte{})
+	_, _ = ef.SendTransaction(1, "test", "test", big.NewInt(0), 0, 0, "code", []byte{}, "chainID", 1)
+	assert.Equal(t, called, 1)
+}
+
+func TestElrondNodeFacade_ValidateTransaction(t *testing.T) {
+	expectedErr := errors.New("expected error")
+	called := 0
+	node := &mock.NodeMock{}
+	node.ValidateTransactionHandler = func(tx *transaction.Transaction) error {
+		called++
+		return expectedErr
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+	err := ef.ValidateTransaction(&transaction.Transaction{})
+	assert.Equal(t, expectedErr, err)
 	assert.Equal(t, called, 1)
 }
 
@@ -369,6 +386,44 @@ func TestElrondNodeFacade_GetDataValue(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestElrondNodeFacade_SimulateTransactionShouldCallApiResolver(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			SimulateTransactionHandler: func(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+				wasCalled = true
+				return &transaction.SimulationResults{}, nil
+			},
+		},
+		false,
+	)
+
+	_, _ = ef.SimulateTransaction(&transaction.Transaction{}, false)
+	assert.True(t, wasCalled)
+}
+
+func TestElrondNodeFacade_GetTransactionWithResultsShouldCallApiResolver(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			GetTransactionWithResultsHandler: func(hash string) (*transaction.Transaction, *transaction.TransactionResults, error) {
+				wasCalled = true
+				return &transaction.Transaction{}, &transaction.TransactionResults{}, nil
+			},
+		},
+		false,
+	)
+
+	_, _, _ = ef.GetTransactionWithResults("hash")
+	assert.True(t, wasCalled)
+}
+
 func TestElrondNodeFacade_RestApiPortNilConfig(t *testing.T) {
 	ef := createElrondNodeFacadeWithMockNodeAndResolver()
 	ef.SetConfig(nil)
@@ -403,3 +458,225 @@ func TestElrondNodeFacade_RestApiPortCorrectPortSpecified(t *testing.T) {
 
 	assert.Equal(t, port, ef.RestApiPort())
 }
+
+func TestElrondNodeFacade_GetAccountByUserName(t *testing.T) {
+	called := 0
+	node := &mock.NodeMock{}
+	node.GetAccountByUserNameHandler = func(userName string) (string, *state.Account, error) {
+		called++
+		return "", nil, nil
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+	_, _, _ = ef.GetAccountByUserName("alice")
+	assert.Equal(t, called, 1)
+}
+
+func TestElrondNodeFacade_GetNFTs(t *testing.T) {
+	called := 0
+	node := &mock.NodeMock{}
+	node.GetNFTsHandler = func(address string) ([]*external.NFTToken, error) {
+		called++
+		return nil, nil
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+	_, _ = ef.GetNFTs("address")
+	assert.Equal(t, called, 1)
+}
+
+func TestElrondNodeFacade_GetPendingMiniBlocks(t *testing.T) {
+	called := 0
+	node := &mock.NodeMock{}
+	node.GetPendingMiniBlocksHandler = func() ([]*block.PendingMiniBlockInfo, error) {
+		called++
+		return nil, nil
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+	_, _ = ef.GetPendingMiniBlocks()
+	assert.Equal(t, called, 1)
+}
+
+func TestElrondNodeFacade_GetNetworkConfig(t *testing.T) {
+	called := 0
+	node := &mock.NodeMock{}
+	node.GetNetworkConfigHandler = func() (*external.NetworkConfig, error) {
+		called++
+		return nil, nil
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+	_, _ = ef.GetNetworkConfig()
+	assert.Equal(t, called, 1)
+}
+
+func TestElrondNodeFacade_GetNetworkEconomics(t *testing.T) {
+	called := 0
+	node := &mock.NodeMock{}
+	node.GetNetworkEconomicsHandler = func() (*external.NetworkEconomics, error) {
+		called++
+		return nil, nil
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+	_, _ = ef.GetNetworkEconomics()
+	assert.Equal(t, called, 1)
+}
+
+func TestElrondNodeFacade_SendTransactionWithManagedNonceNotEnabledShouldErr(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+
+	_, _, err := ef.SendTransactionWithManagedNonce("sender", "receiver", big.NewInt(1), 10, 100, "")
+	assert.Equal(t, ErrManagedNonceNotEnabled, err)
+}
+
+func TestElrondNodeFacade_SendTransactionWithManagedNonce(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetManagedNonceSender(&mock.ManagedNonceSenderStub{
+		SendTransactionCalled: func(senderHex string, receiverHex string, value *big.Int, gasPrice uint64,
+			gasLimit uint64, transactionData string) (string, uint64, error) {
+			return "hash", 7, nil
+		},
+	})
+
+	txHash, nonce, err := ef.SendTransactionWithManagedNonce("sender", "receiver", big.NewInt(1), 10, 100, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "hash", txHash)
+	assert.Equal(t, uint64(7), nonce)
+}
+
+func TestElrondNodeFacade_ExportAccountsNotEnabledShouldErr(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+
+	page, err := ef.ExportAccounts("", 10)
+	assert.Nil(t, page)
+	assert.Equal(t, ErrAccountsExportNotEnabled, err)
+}
+
+func TestElrondNodeFacade_ExportAccounts(t *testing.T) {
+	expectedPage := &external.AccountsExportPage{NextToken: "token"}
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetAccountsExporter(&mock.AccountsExporterStub{
+		ExportAccountsCalled: func(token string, pageSize int) (*external.AccountsExportPage, error) {
+			assert.Equal(t, "previous token", token)
+			assert.Equal(t, 10, pageSize)
+			return expectedPage, nil
+		},
+	})
+
+	page, err := ef.ExportAccounts("previous token", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPage, page)
+}
+
+func TestElrondNodeFacade_DelegationQueriesNotAvailableShouldErr(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+
+	page, err := ef.GetDelegators("contract", 0, 10)
+	assert.Nil(t, page)
+	assert.Equal(t, ErrDelegationQueriesNotAvailable, err)
+
+	rewards, err := ef.GetClaimableRewards("contract", "delegator")
+	assert.Nil(t, rewards)
+	assert.Equal(t, ErrDelegationQueriesNotAvailable, err)
+
+	contractConfig, err := ef.GetDelegationContractConfig("contract")
+	assert.Nil(t, contractConfig)
+	assert.Equal(t, ErrDelegationQueriesNotAvailable, err)
+}
+
+func TestElrondNodeFacade_DelegationQueriesShouldCallTheQuerier(t *testing.T) {
+	expectedPage := &external.DelegatorsPage{NumDelegators: 3, NextIndex: 2}
+	expectedConfig := &external.DelegationContractConfig{MaxCap: "1000"}
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetDelegationQuerier(&mock.DelegationQuerierStub{
+		GetDelegatorsCalled: func(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error) {
+			assert.Equal(t, "contract", contractAddress)
+			assert.Equal(t, uint64(1), startIndex)
+			assert.Equal(t, 10, pageSize)
+			return expectedPage, nil
+		},
+		GetClaimableRewardsCalled: func(contractAddress string, delegatorAddress string) (*big.Int, error) {
+			assert.Equal(t, "delegator", delegatorAddress)
+			return big.NewInt(7), nil
+		},
+		GetDelegationContractConfigCalled: func(contractAddress string) (*external.DelegationContractConfig, error) {
+			return expectedConfig, nil
+		},
+	})
+
+	page, err := ef.GetDelegators("contract", 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPage, page)
+
+	rewards, err := ef.GetClaimableRewards("contract", "delegator")
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(7), rewards)
+
+	contractConfig, err := ef.GetDelegationContractConfig("contract")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedConfig, contractConfig)
+}
+
+func TestElrondNodeFacade_GetAuctionListNotAvailableShouldErr(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+
+	auctionList, err := ef.GetAuctionList()
+
+	assert.Nil(t, auctionList)
+	assert.Equal(t, ErrAuctionListNotAvailable, err)
+}
+
+func TestElrondNodeFacade_GetAuctionListShouldCallTheQuerier(t *testing.T) {
+	expectedAuctionList := &external.AuctionList{NodePrice: "100"}
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetAuctionListQuerier(&mock.AuctionListQuerierStub{
+		GetAuctionListCalled: func() (*external.AuctionList, error) {
+			return expectedAuctionList, nil
+		},
+	})
+
+	auctionList, err := ef.GetAuctionList()
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedAuctionList, auctionList)
+}
+
+func TestElrondNodeFacade_CloseNotStartedShouldNotErr(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+
+	err := ef.Close()
+	assert.Nil(t, err)
+}
+
+func TestElrondNodeFacade_CloseShouldStopTheRestServer(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetLogger(logger.DefaultLogger())
+	ef.SetConfig(&config.FacadeConfig{
+		RestApiPort: "0",
+	})
+
+	wg := sync.WaitGroup{}
+	ef.StartBackgroundServices(&wg)
+
+	for i := 0; i < 100; i++ {
+		ef.mutRestServer.Lock()
+		isStarted := ef.restServer != nil
+		ef.mutRestServer.Unlock()
+		if isStarted {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	err := ef.Close()
+	assert.Nil(t, err)
+
+	chanStopped := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(chanStopped)
+	}()
+
+	select {
+	case <-chanStopped:
+	case <-time.After(time.Second):
+		assert.Fail(t, "rest server was not stopped")
+	}
+}
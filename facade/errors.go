@@ -4,3 +4,15 @@ import "github.com/pkg/errors"
 
 // ErrHeartbeatsNotActive signals that the heartbeat system is not active
 var ErrHeartbeatsNotActive = errors.New("heartbeat system not active")
+
+// ErrManagedNonceNotEnabled signals that the nonces of the hosted wallets are not managed by the node
+var ErrManagedNonceNotEnabled = errors.New("managed nonce not enabled")
+
+// ErrAccountsExportNotEnabled signals that the accounts export is not enabled on the node
+var ErrAccountsExportNotEnabled = errors.New("accounts export not enabled")
+
+// ErrDelegationQueriesNotAvailable signals that the node can not answer the queries over the delegation contracts
+var ErrDelegationQueriesNotAvailable = errors.New("delegation queries not available")
+
+// ErrAuctionListNotAvailable signals that the node can not build the auction list
+var ErrAuctionListNotAvailable = errors.New("auction list not available")
@@ -3,10 +3,16 @@ package facade
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 )
 
 //NodeWrapper contains all functions that a node should contain.
@@ -34,10 +40,14 @@ type NodeWrapper interface {
 
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
+		gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*transaction.Transaction, error)
 
 	//SendTransaction will send a new transaction on the 'send transactions pipe' channel
-	SendTransaction(nonce uint64, senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error)
+	SendTransaction(nonce uint64, senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64,
+		transactionData string, signature []byte, chainID string, version uint32) (string, error)
+
+	//ValidateTransaction will check if the provided transaction is valid before being sent
+	ValidateTransaction(tx *transaction.Transaction) error
 
 	//SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
@@ -45,6 +55,21 @@ type NodeWrapper interface {
 	//GetTransaction gets the transaction
 	GetTransaction(hash string) (*transaction.Transaction, error)
 
+	// GetTransactionMetadata returns the miniblock and the block which included the transaction
+	GetTransactionMetadata(hash string) (*dblookupext.MiniblockMetadata, error)
+
+	// GetHyperBlockByNonce returns the metachain block with the provided nonce together with the shard blocks it notarized
+	GetHyperBlockByNonce(nonce uint64) (*external.HyperBlock, error)
+
+	// GetOutgoingOperationsBatches returns the collected outgoing operations batches together with their signatures
+	GetOutgoingOperationsBatches() ([]*bridge.SignedOutgoingOperationsBatch, error)
+
+	// GetValidatorsSelectionAudit returns how many times the eligible validators were selected during the given epoch
+	GetValidatorsSelectionAudit(epoch uint32) (*audit.EpochSelectionAudit, error)
+
+	// GetTransactionsPool returns a snapshot of the transactions pool of the node's shard, grouped by sender
+	GetTransactionsPool(withTransactions bool, senderHex string) (*external.TransactionsPool, error)
+
 	// GetCurrentPublicKey gets the current nodes public Key
 	GetCurrentPublicKey() string
 
@@ -60,9 +85,40 @@ type NodeWrapper interface {
 	//  about the account corelated with provided address
 	GetAccount(address string) (*state.Account, error)
 
+	// GetAccountAtNonce returns the account correlated with provided address, as it was at the provided block nonce
+	GetAccountAtNonce(address string, blockNonce uint64) (*state.Account, error)
+
+	// GetAccountWithOptions returns the account correlated with provided address, read on the committed block
+	// selected by the query options
+	GetAccountWithOptions(address string, options external.AccountQueryOptions) (*state.Account, *external.AccountBlockInfo, error)
+
+	// GetAccountsAtBlock returns the accounts state as it was after the block with the provided coordinates
+	GetAccountsAtBlock(blockCoordinates external.BlockCoordinates) (state.AccountsAdapter, error)
+
+	// GetAccountByUserName returns the hex address and the account of the owner of the provided user name
+	GetAccountByUserName(userName string) (string, *state.Account, error)
+
+	// GetNFTs returns the non fungible and semi fungible tokens held by the provided address
+	GetNFTs(address string) ([]*external.NFTToken, error)
+
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []heartbeat.PubKeyHeartbeat
 
+	// GetPendingMiniBlocks returns the cross shard miniblocks which were not yet processed by their destination shards
+	GetPendingMiniBlocks() ([]*block.PendingMiniBlockInfo, error)
+
+	// GetNetworkConfig returns the configuration of the network, as known by the node
+	GetNetworkConfig() (*external.NetworkConfig, error)
+
+	// GetNetworkEconomics returns the chain-level economics values, as known by the node
+	GetNetworkEconomics() (*external.NetworkEconomics, error)
+
+	// GetEpochSupply returns the counters of the value minted and burned by the own shard at the end of an epoch
+	GetEpochSupply(epoch uint32) (*supply.ShardSupply, error)
+
+	// GetESDTSupply returns the initial, minted and burned quantities of a token, as changed by the own shard
+	GetESDTSupply(tokenID string) (*esdt.TokenSupply, error)
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -70,6 +126,36 @@ type NodeWrapper interface {
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	GetVmValue(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
+	GetVmValueFromState(accounts state.AccountsAdapter, address string, funcName string, argsBuff ...[]byte) ([]byte, error)
 	StatusMetrics() external.StatusMetricsHandler
+	SimulateTransaction(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+	GetTransactionWithResults(hash string) (*transaction.Transaction, *transaction.TransactionResults, error)
+	IsInterfaceNil() bool
+}
+
+// ManagedNonceSender defines a component which assigns the nonces of the transactions sent from the hosted wallets
+type ManagedNonceSender interface {
+	SendTransaction(senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64,
+		transactionData string) (string, uint64, error)
+	IsInterfaceNil() bool
+}
+
+// AccountsExporter defines a component which exports the accounts of the shard, page by page, for audits
+type AccountsExporter interface {
+	ExportAccounts(token string, pageSize int) (*external.AccountsExportPage, error)
+	IsInterfaceNil() bool
+}
+
+// DelegationQuerier defines a component which answers the queries over the delegation contracts
+type DelegationQuerier interface {
+	GetDelegators(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error)
+	GetClaimableRewards(contractAddress string, delegatorAddress string) (*big.Int, error)
+	GetDelegationContractConfig(contractAddress string) (*external.DelegationContractConfig, error)
+	IsInterfaceNil() bool
+}
+
+// AuctionListQuerier defines a component which builds the auction list from the staking contract state
+type AuctionListQuerier interface {
+	GetAuctionList() (*external.AuctionList, error)
 	IsInterfaceNil() bool
 }
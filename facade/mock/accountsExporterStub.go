@@ -0,0 +1,21 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/node/external"
+
+// AccountsExporterStub -
+type AccountsExporterStub struct {
+	ExportAccountsCalled func(token string, pageSize int) (*external.AccountsExportPage, error)
+}
+
+// ExportAccounts -
+func (aes *AccountsExporterStub) ExportAccounts(token string, pageSize int) (*external.AccountsExportPage, error) {
+	return aes.ExportAccountsCalled(token, pageSize)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aes *AccountsExporterStub) IsInterfaceNil() bool {
+	if aes == nil {
+		return true
+	}
+	return false
+}
@@ -1,22 +1,39 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 )
 
 type ApiResolverStub struct {
-	GetVmValueHandler    func(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
-	StatusMetricsHandler func() external.StatusMetricsHandler
+	GetVmValueHandler                func(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
+	GetVmValueFromStateHandler       func(accounts state.AccountsAdapter, address string, funcName string, argsBuff ...[]byte) ([]byte, error)
+	StatusMetricsHandler             func() external.StatusMetricsHandler
+	SimulateTransactionHandler       func(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+	GetTransactionWithResultsHandler func(hash string) (*transaction.Transaction, *transaction.TransactionResults, error)
 }
 
 func (ars *ApiResolverStub) GetVmValue(address string, funcName string, argsBuff ...[]byte) ([]byte, error) {
 	return ars.GetVmValueHandler(address, funcName, argsBuff...)
 }
 
+func (ars *ApiResolverStub) GetVmValueFromState(accounts state.AccountsAdapter, address string, funcName string, argsBuff ...[]byte) ([]byte, error) {
+	return ars.GetVmValueFromStateHandler(accounts, address, funcName, argsBuff...)
+}
+
 func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	return ars.StatusMetricsHandler()
 }
 
+func (ars *ApiResolverStub) SimulateTransaction(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+	return ars.SimulateTransactionHandler(tx, withTrace)
+}
+
+func (ars *ApiResolverStub) GetTransactionWithResults(hash string) (*transaction.Transaction, *transaction.TransactionResults, error) {
+	return ars.GetTransactionWithResultsHandler(hash)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	if ars == nil {
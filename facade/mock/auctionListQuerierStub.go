@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// AuctionListQuerierStub -
+type AuctionListQuerierStub struct {
+	GetAuctionListCalled func() (*external.AuctionList, error)
+}
+
+// GetAuctionList -
+func (alqs *AuctionListQuerierStub) GetAuctionList() (*external.AuctionList, error) {
+	return alqs.GetAuctionListCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (alqs *AuctionListQuerierStub) IsInterfaceNil() bool {
+	if alqs == nil {
+		return true
+	}
+	return false
+}
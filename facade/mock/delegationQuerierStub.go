@@ -0,0 +1,37 @@
+package mock
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// DelegationQuerierStub -
+type DelegationQuerierStub struct {
+	GetDelegatorsCalled               func(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error)
+	GetClaimableRewardsCalled         func(contractAddress string, delegatorAddress string) (*big.Int, error)
+	GetDelegationContractConfigCalled func(contractAddress string) (*external.DelegationContractConfig, error)
+}
+
+// GetDelegators -
+func (dqs *DelegationQuerierStub) GetDelegators(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error) {
+	return dqs.GetDelegatorsCalled(contractAddress, startIndex, pageSize)
+}
+
+// GetClaimableRewards -
+func (dqs *DelegationQuerierStub) GetClaimableRewards(contractAddress string, delegatorAddress string) (*big.Int, error) {
+	return dqs.GetClaimableRewardsCalled(contractAddress, delegatorAddress)
+}
+
+// GetDelegationContractConfig -
+func (dqs *DelegationQuerierStub) GetDelegationContractConfig(contractAddress string) (*external.DelegationContractConfig, error) {
+	return dqs.GetDelegationContractConfigCalled(contractAddress)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dqs *DelegationQuerierStub) IsInterfaceNil() bool {
+	if dqs == nil {
+		return true
+	}
+	return false
+}
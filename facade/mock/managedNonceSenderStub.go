@@ -0,0 +1,29 @@
+package mock
+
+import "math/big"
+
+// ManagedNonceSenderStub -
+type ManagedNonceSenderStub struct {
+	SendTransactionCalled func(senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64,
+		transactionData string) (string, uint64, error)
+}
+
+// SendTransaction -
+func (mnss *ManagedNonceSenderStub) SendTransaction(
+	senderHex string,
+	receiverHex string,
+	value *big.Int,
+	gasPrice uint64,
+	gasLimit uint64,
+	transactionData string,
+) (string, uint64, error) {
+	return mnss.SendTransactionCalled(senderHex, receiverHex, value, gasPrice, gasLimit, transactionData)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mnss *ManagedNonceSenderStub) IsInterfaceNil() bool {
+	if mnss == nil {
+		return true
+	}
+	return false
+}
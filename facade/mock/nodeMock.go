@@ -3,9 +3,16 @@ package mock
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 )
 
 type NodeMock struct {
@@ -21,13 +28,29 @@ type NodeMock struct {
 	CreateTransactionHandler   func(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64,
 		gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
 	GetTransactionHandler                          func(hash string) (*transaction.Transaction, error)
+	GetTransactionMetadataHandler                  func(hash string) (*dblookupext.MiniblockMetadata, error)
+	GetHyperBlockByNonceHandler                    func(nonce uint64) (*external.HyperBlock, error)
+	GetOutgoingOperationsBatchesHandler            func() ([]*bridge.SignedOutgoingOperationsBatch, error)
+	GetValidatorsSelectionAuditHandler             func(epoch uint32) (*audit.EpochSelectionAudit, error)
+	GetEpochSupplyHandler                          func(epoch uint32) (*supply.ShardSupply, error)
+	GetESDTSupplyHandler                           func(tokenID string) (*esdt.TokenSupply, error)
+	GetTransactionsPoolHandler                     func(withTransactions bool, senderHex string) (*external.TransactionsPool, error)
 	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, amount *big.Int, code string, signature []byte) (string, error)
+	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GetAccountHandler                              func(address string) (*state.Account, error)
+	GetAccountAtNonceHandler                       func(address string, blockNonce uint64) (*state.Account, error)
+	GetAccountWithOptionsHandler                   func(address string, options external.AccountQueryOptions) (*state.Account, *external.AccountBlockInfo, error)
+	GetAccountsAtBlockHandler                      func(blockCoordinates external.BlockCoordinates) (state.AccountsAdapter, error)
+	GetAccountByUserNameHandler                    func(userName string) (string, *state.Account, error)
+	GetNFTsHandler                                 func(address string) ([]*external.NFTToken, error)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []heartbeat.PubKeyHeartbeat
+	GetPendingMiniBlocksHandler                    func() ([]*block.PendingMiniBlockInfo, error)
+	GetNetworkConfigHandler                        func() (*external.NetworkConfig, error)
+	GetNetworkEconomicsHandler                     func() (*external.NetworkEconomics, error)
 }
 
 func (nm *NodeMock) Address() (string, error) {
@@ -67,7 +90,7 @@ func (nm *NodeMock) GenerateTransaction(sender string, receiver string, amount *
 }
 
 func (nm *NodeMock) CreateTransaction(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64,
-	gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error) {
+	gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32) (*transaction.Transaction, error) {
 
 	return nm.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge)
 }
@@ -76,10 +99,42 @@ func (nm *NodeMock) GetTransaction(hash string) (*transaction.Transaction, error
 	return nm.GetTransactionHandler(hash)
 }
 
-func (nm *NodeMock) SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error) {
+func (nm *NodeMock) GetTransactionMetadata(hash string) (*dblookupext.MiniblockMetadata, error) {
+	return nm.GetTransactionMetadataHandler(hash)
+}
+
+func (nm *NodeMock) GetHyperBlockByNonce(nonce uint64) (*external.HyperBlock, error) {
+	return nm.GetHyperBlockByNonceHandler(nonce)
+}
+
+func (nm *NodeMock) GetOutgoingOperationsBatches() ([]*bridge.SignedOutgoingOperationsBatch, error) {
+	return nm.GetOutgoingOperationsBatchesHandler()
+}
+
+func (nm *NodeMock) GetValidatorsSelectionAudit(epoch uint32) (*audit.EpochSelectionAudit, error) {
+	return nm.GetValidatorsSelectionAuditHandler(epoch)
+}
+
+func (nm *NodeMock) GetEpochSupply(epoch uint32) (*supply.ShardSupply, error) {
+	return nm.GetEpochSupplyHandler(epoch)
+}
+
+func (nm *NodeMock) GetESDTSupply(tokenID string) (*esdt.TokenSupply, error) {
+	return nm.GetESDTSupplyHandler(tokenID)
+}
+
+func (nm *NodeMock) GetTransactionsPool(withTransactions bool, senderHex string) (*external.TransactionsPool, error) {
+	return nm.GetTransactionsPoolHandler(withTransactions, senderHex)
+}
+
+func (nm *NodeMock) SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte, chainID string, version uint32) (string, error) {
 	return nm.SendTransactionHandler(nonce, sender, receiver, value, transactionData, signature)
 }
 
+func (nm *NodeMock) ValidateTransaction(tx *transaction.Transaction) error {
+	return nm.ValidateTransactionHandler(tx)
+}
+
 func (nm *NodeMock) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return nm.SendBulkTransactionsHandler(txs)
 }
@@ -100,10 +155,42 @@ func (nm *NodeMock) GetAccount(address string) (*state.Account, error) {
 	return nm.GetAccountHandler(address)
 }
 
+func (nm *NodeMock) GetAccountAtNonce(address string, blockNonce uint64) (*state.Account, error) {
+	return nm.GetAccountAtNonceHandler(address, blockNonce)
+}
+
+func (nm *NodeMock) GetAccountWithOptions(address string, options external.AccountQueryOptions) (*state.Account, *external.AccountBlockInfo, error) {
+	return nm.GetAccountWithOptionsHandler(address, options)
+}
+
+func (nm *NodeMock) GetAccountsAtBlock(blockCoordinates external.BlockCoordinates) (state.AccountsAdapter, error) {
+	return nm.GetAccountsAtBlockHandler(blockCoordinates)
+}
+
+func (nm *NodeMock) GetAccountByUserName(userName string) (string, *state.Account, error) {
+	return nm.GetAccountByUserNameHandler(userName)
+}
+
+func (nm *NodeMock) GetNFTs(address string) ([]*external.NFTToken, error) {
+	return nm.GetNFTsHandler(address)
+}
+
 func (nm *NodeMock) GetHeartbeats() []heartbeat.PubKeyHeartbeat {
 	return nm.GetHeartbeatsHandler()
 }
 
+func (nm *NodeMock) GetPendingMiniBlocks() ([]*block.PendingMiniBlockInfo, error) {
+	return nm.GetPendingMiniBlocksHandler()
+}
+
+func (nm *NodeMock) GetNetworkConfig() (*external.NetworkConfig, error) {
+	return nm.GetNetworkConfigHandler()
+}
+
+func (nm *NodeMock) GetNetworkEconomics() (*external.NetworkEconomics, error) {
+	return nm.GetNetworkEconomicsHandler()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nm *NodeMock) IsInterfaceNil() bool {
 	if nm == nil {
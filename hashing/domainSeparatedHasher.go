@@ -0,0 +1,63 @@
+package hashing
+
+import (
+	"encoding/binary"
+)
+
+// domainSeparatedHasher computes the hashes of a single component. Every input is prefixed with the length of the
+// component's domain and the domain itself, so the same data hashed by two components never yields the same hash
+type domainSeparatedHasher struct {
+	hasher Hasher
+	prefix string
+}
+
+// NewDomainSeparatedHasher creates a hasher which separates the hashes of the given domain from any other hashes
+// computed with the same underlying hasher
+func NewDomainSeparatedHasher(hasher Hasher, domain string) (*domainSeparatedHasher, error) {
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+	if len(domain) == 0 {
+		return nil, ErrEmptyHashingDomain
+	}
+
+	return &domainSeparatedHasher{
+		hasher: hasher,
+		prefix: domainPrefix(domain),
+	}, nil
+}
+
+// ComputeWithDomain returns the hash of the given data, computed with the given hasher in the given domain
+func ComputeWithDomain(hasher Hasher, domain string, s string) []byte {
+	return hasher.Compute(domainPrefix(domain) + s)
+}
+
+func domainPrefix(domain string) string {
+	lenBuff := make([]byte, 4)
+	binary.BigEndian.PutUint32(lenBuff, uint32(len(domain)))
+
+	return string(lenBuff) + domain
+}
+
+// Compute returns the hash of the given data in the hasher's domain
+func (dsh *domainSeparatedHasher) Compute(s string) []byte {
+	return dsh.hasher.Compute(dsh.prefix + s)
+}
+
+// EmptyHash returns the hash of the empty string in the hasher's domain
+func (dsh *domainSeparatedHasher) EmptyHash() []byte {
+	return dsh.Compute("")
+}
+
+// Size returns the size, in number of bytes, of the computed hashes
+func (dsh *domainSeparatedHasher) Size() int {
+	return dsh.hasher.Size()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsh *domainSeparatedHasher) IsInterfaceNil() bool {
+	if dsh == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package hashing_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDomainSeparatedHasher_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dsh, err := hashing.NewDomainSeparatedHasher(nil, "domain")
+	assert.Nil(t, dsh)
+	assert.Equal(t, hashing.ErrNilHasher, err)
+
+	dsh, err = hashing.NewDomainSeparatedHasher(blake2b.Blake2b{}, "")
+	assert.Nil(t, dsh)
+	assert.Equal(t, hashing.ErrEmptyHashingDomain, err)
+}
+
+func TestDomainSeparatedHasher(t *testing.T) {
+	dsh, _ := hashing.NewDomainSeparatedHasher(blake2b.Blake2b{}, "domain")
+
+	Suite(t, dsh)
+}
+
+func TestDomainSeparatedHasher_ShouldSeparateTheDomains(t *testing.T) {
+	t.Parallel()
+
+	hasher := blake2b.Blake2b{}
+	first, _ := hashing.NewDomainSeparatedHasher(hasher, "first")
+	second, _ := hashing.NewDomainSeparatedHasher(hasher, "second")
+	prefixed, _ := hashing.NewDomainSeparatedHasher(hasher, "firs")
+
+	assert.False(t, first.IsInterfaceNil())
+	assert.Equal(t, hasher.Size(), first.Size())
+	assert.NotEqual(t, hasher.Compute("data"), first.Compute("data"))
+	assert.NotEqual(t, first.Compute("data"), second.Compute("data"))
+	assert.NotEqual(t, first.Compute("data"), prefixed.Compute("tdata"))
+	assert.Equal(t, first.Compute("data"), hashing.ComputeWithDomain(hasher, "first", "data"))
+}
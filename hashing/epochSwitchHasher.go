@@ -0,0 +1,80 @@
+package hashing
+
+import (
+	"sync"
+)
+
+// epochSwitchHasher computes the hashes with a hasher until the configured switch epoch is confirmed and with a
+// second one afterwards
+type epochSwitchHasher struct {
+	beforeSwitch Hasher
+	afterSwitch  Hasher
+	switchEpoch  uint32
+
+	mutActive sync.RWMutex
+	active    Hasher
+}
+
+// NewEpochSwitchHasher creates a new hasher which switches from the first to the second provided hasher when the
+// switch epoch is confirmed. Both hashers have to produce hashes of the same size
+func NewEpochSwitchHasher(beforeSwitch Hasher, afterSwitch Hasher, switchEpoch uint32) (*epochSwitchHasher, error) {
+	if beforeSwitch == nil || beforeSwitch.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+	if afterSwitch == nil || afterSwitch.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+	if beforeSwitch.Size() != afterSwitch.Size() {
+		return nil, ErrDifferentHashSizes
+	}
+
+	esh := &epochSwitchHasher{
+		beforeSwitch: beforeSwitch,
+		afterSwitch:  afterSwitch,
+		switchEpoch:  switchEpoch,
+	}
+	esh.EpochConfirmed(0)
+
+	return esh, nil
+}
+
+// EpochConfirmed selects the hasher of the provided epoch. The epoch can also go back, when blocks are reverted
+func (esh *epochSwitchHasher) EpochConfirmed(epoch uint32) {
+	esh.mutActive.Lock()
+	if epoch >= esh.switchEpoch {
+		esh.active = esh.afterSwitch
+	} else {
+		esh.active = esh.beforeSwitch
+	}
+	esh.mutActive.Unlock()
+}
+
+func (esh *epochSwitchHasher) activeHasher() Hasher {
+	esh.mutActive.RLock()
+	defer esh.mutActive.RUnlock()
+
+	return esh.active
+}
+
+// Compute returns the hash of the given data, computed with the hasher of the current epoch
+func (esh *epochSwitchHasher) Compute(s string) []byte {
+	return esh.activeHasher().Compute(s)
+}
+
+// EmptyHash returns the hash of the empty string, computed with the hasher of the current epoch
+func (esh *epochSwitchHasher) EmptyHash() []byte {
+	return esh.activeHasher().EmptyHash()
+}
+
+// Size returns the size, in number of bytes, of the computed hashes
+func (esh *epochSwitchHasher) Size() int {
+	return esh.activeHasher().Size()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (esh *epochSwitchHasher) IsInterfaceNil() bool {
+	if esh == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package hashing_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEpochSwitchHasher_InvalidHashersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esh, err := hashing.NewEpochSwitchHasher(nil, keccak.Keccak{}, 1)
+	assert.Nil(t, esh)
+	assert.Equal(t, hashing.ErrNilHasher, err)
+
+	esh, err = hashing.NewEpochSwitchHasher(blake2b.Blake2b{}, nil, 1)
+	assert.Nil(t, esh)
+	assert.Equal(t, hashing.ErrNilHasher, err)
+
+	esh, err = hashing.NewEpochSwitchHasher(blake2b.Blake2b{}, fnv.Fnv{}, 1)
+	assert.Nil(t, esh)
+	assert.Equal(t, hashing.ErrDifferentHashSizes, err)
+}
+
+func TestEpochSwitchHasher(t *testing.T) {
+	esh, _ := hashing.NewEpochSwitchHasher(blake2b.Blake2b{}, keccak.Keccak{}, 0)
+
+	Suite(t, esh)
+}
+
+func TestEpochSwitchHasher_ShouldSwitchAtTheConfiguredEpoch(t *testing.T) {
+	t.Parallel()
+
+	before := blake2b.Blake2b{}
+	after := keccak.Keccak{}
+	esh, err := hashing.NewEpochSwitchHasher(before, after, 2)
+	assert.Nil(t, err)
+	assert.False(t, esh.IsInterfaceNil())
+
+	assert.Equal(t, before.Compute("a"), esh.Compute("a"))
+
+	esh.EpochConfirmed(2)
+	assert.Equal(t, after.Compute("a"), esh.Compute("a"))
+	assert.Equal(t, after.EmptyHash(), esh.EmptyHash())
+
+	esh.EpochConfirmed(1)
+	assert.Equal(t, before.Compute("a"), esh.Compute("a"))
+}
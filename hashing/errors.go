@@ -0,0 +1,12 @@
+package hashing
+
+import "errors"
+
+// ErrNilHasher is raised when a nil hasher is provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrEmptyHashingDomain is raised when an empty domain is provided for domain separated hashing
+var ErrEmptyHashingDomain = errors.New("empty hashing domain")
+
+// ErrDifferentHashSizes is raised when hashers which compute hashes of different sizes are provided
+var ErrDifferentHashSizes = errors.New("different hash sizes")
@@ -0,0 +1,6 @@
+package factory
+
+import "errors"
+
+// ErrUnknownHasherType is raised when an unknown hasher type is provided
+var ErrUnknownHasherType = errors.New("unknown hasher type")
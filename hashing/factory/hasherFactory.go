@@ -0,0 +1,52 @@
+package factory
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+)
+
+const (
+	// Blake2bHasher is the blake2b-256 hasher type, used by default
+	Blake2bHasher = "blake2b"
+	// Sha256Hasher is the sha256 hasher type
+	Sha256Hasher = "sha256"
+	// KeccakHasher is the sha3-Keccak hasher type
+	KeccakHasher = "keccak"
+)
+
+// NewHasher creates the hasher of the provided type. An empty type selects the default blake2b-256 hasher
+func NewHasher(hasherType string) (hashing.Hasher, error) {
+	switch hasherType {
+	case Blake2bHasher, "":
+		return blake2b.Blake2b{}, nil
+	case Sha256Hasher:
+		return sha256.Sha256{}, nil
+	case KeccakHasher:
+		return keccak.Keccak{}, nil
+	}
+
+	return nil, fmt.Errorf("%s: %s", ErrUnknownHasherType.Error(), hasherType)
+}
+
+// NewEpochSwitchHasher creates the hasher of the provided type which, if a migration type is given, switches to the
+// hasher of the migration type starting with the migration epoch
+func NewEpochSwitchHasher(hasherType string, migrationType string, migrationEpoch uint32) (hashing.Hasher, error) {
+	hasher, err := NewHasher(hasherType)
+	if err != nil {
+		return nil, err
+	}
+	if len(migrationType) == 0 {
+		return hasher, nil
+	}
+
+	migrationHasher, err := NewHasher(migrationType)
+	if err != nil {
+		return nil, err
+	}
+
+	return hashing.NewEpochSwitchHasher(hasher, migrationHasher, migrationEpoch)
+}
@@ -0,0 +1,57 @@
+package factory_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHasher_ShouldCreateTheConfiguredHasher(t *testing.T) {
+	t.Parallel()
+
+	hasher, err := factory.NewHasher(factory.Blake2bHasher)
+	assert.Nil(t, err)
+	assert.Equal(t, blake2b.Blake2b{}, hasher)
+
+	hasher, err = factory.NewHasher("")
+	assert.Nil(t, err)
+	assert.Equal(t, blake2b.Blake2b{}, hasher)
+
+	hasher, err = factory.NewHasher(factory.Sha256Hasher)
+	assert.Nil(t, err)
+	assert.Equal(t, sha256.Sha256{}, hasher)
+
+	hasher, err = factory.NewHasher(factory.KeccakHasher)
+	assert.Nil(t, err)
+	assert.Equal(t, keccak.Keccak{}, hasher)
+}
+
+func TestNewHasher_UnknownTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hasher, err := factory.NewHasher("md5")
+
+	assert.Nil(t, hasher)
+	assert.True(t, strings.Contains(err.Error(), factory.ErrUnknownHasherType.Error()))
+}
+
+func TestNewEpochSwitchHasher(t *testing.T) {
+	t.Parallel()
+
+	hasher, err := factory.NewEpochSwitchHasher(factory.Blake2bHasher, "", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, blake2b.Blake2b{}, hasher)
+
+	hasher, err = factory.NewEpochSwitchHasher(factory.Blake2bHasher, factory.KeccakHasher, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, blake2b.Blake2b{}.Compute("a"), hasher.Compute("a"))
+
+	hasher, err = factory.NewEpochSwitchHasher(factory.Blake2bHasher, "md5", 1)
+	assert.Nil(t, hasher)
+	assert.NotNil(t, err)
+}
@@ -14,6 +14,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/core/alarm"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
@@ -210,6 +211,9 @@ func createTestShardDataPool() dataRetriever.PoolsHolder {
 	cacherCfg = storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache}
 	metaBlocks, _ := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
 
+	cacherCfg = storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache}
+	peerAuthentications, _ := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
+
 	dPool, _ := dataPool.NewShardedDataPool(
 		txPool,
 		uTxPool,
@@ -219,6 +223,7 @@ func createTestShardDataPool() dataRetriever.PoolsHolder {
 		txBlockBody,
 		peerChangeBlockBody,
 		metaBlocks,
+		peerAuthentications,
 	)
 
 	return dPool
@@ -394,6 +399,7 @@ func createConsensusOnlyNode(
 		node.WithConsensusGroupSize(int(consensusSize)),
 		node.WithSyncer(syncer),
 		node.WithGenesisTime(time.Unix(startTime, 0)),
+		node.WithAlarmScheduler(alarm.NewAlarmScheduler()),
 		node.WithRounder(rounder),
 		node.WithSingleSigner(singleBlsSigner),
 		node.WithPrivKey(privKey),
@@ -456,6 +462,7 @@ func createNodes(
 			ShardConsensusGroupSize: consensusSize,
 			MetaConsensusGroupSize:  1,
 			Hasher:                  createHasher(consensusType),
+			ConsensusGroupSelection: sharding.RandomSelection,
 			NbShards:                1,
 			Nodes:                   validatorsMap,
 			SelfPublicKey:           []byte(strconv.Itoa(i)),
@@ -6,19 +6,26 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/stretchr/testify/assert"
 )
 
+// The signatures below cover the json payload which also contains the integration tests chain ID and the minimum
+// transaction version. They were generated with the Schnorr signer by the following senders secret keys:
+//  1b7cf3f1...: fc135f03f7984f984a75293599acf020b7fe000ddcc5c214dbd03da67f675b0f
+//  886c9883...: 1f1f43aa48030da2f5cfb756c815fd6cc788f4b47109607e9d536a75705bea09
+//  4fc2a7ec...: 551a3b6c62703f53669eaf60b41d3bf291c441719c1ebce2ef1f68e69c1e0404
+
 func TestInterceptedTxFromFrontendGeneratedParamsAllParams(t *testing.T) {
 	testInterceptedTxFromFrontendGeneratedParams(
 		t,
 		0,
 		big.NewInt(10),
 		"53669be65aac358a6add8e8a8b1251bb994dc1e4a0cc885956f5ecd53396f0d8",
-		"a10e99839fe19bdb2ec8b22e0805da40053d4e5b6ace564949f26d49095e36e8",
-		"e1e38ae48088baeca9da900cf054d71d7500171986a73cd04027d32fe3435241338979db530bd79e5148d8b0146204c9b2d985d201019a1728218841b8454a09",
+		"1b7cf3f1e4a2b4dcb0ebc4c7229c1234f0d3afdb4d55439826191caec354d4af",
+		"4453ef1f49956973825591ee494494557ecb14e93a353dc1fb57af30bcb2810945c7dc78a5f383d32785b326be86d58ab1964cc6f09eb0c306a499fad2394a0e",
 		10,
 		1000,
 		"aa@bbbb@cccc",
@@ -30,9 +37,9 @@ func TestInterceptedTxFromFrontendGeneratedParamsAllParams2(t *testing.T) {
 		t,
 		12,
 		big.NewInt(2),
-		"943643524936191d1c5627e044f7b5e4ca559c7d0ba1c2b85d1b2e6c299ebcd8",
-		"943643524936191d1c5627e044f7b5e4ca559c7d0ba1c2b85d1b2e6c299ebcd8",
-		"1ef83bae21227e93e9717f45a4ec34e3f5c6a110e31dfa438ac2b8c1f5459e5167fd8424d1dfa6de59756437fe599def6872217ddad5717fe61a41853606450c",
+		"886c98836fee1291ec653215d7e6d4eb3f8b39f13feddb60b3927dc45e5fbfad",
+		"886c98836fee1291ec653215d7e6d4eb3f8b39f13feddb60b3927dc45e5fbfad",
+		"2a74d5b28062854fae6181befd82e0fa79e97c51a9252e6ff1d45016578298d30a43f44d42668c9ee34cd22a3fc7058a75da35c7729f9a2e758497d6c93ddf0f",
 		1,
 		10000,
 		"aa@dd@cc",
@@ -45,8 +52,8 @@ func TestInterceptedTxFromFrontendGeneratedParamsGasPriceGasLimitNoData(t *testi
 		0,
 		big.NewInt(10),
 		"53669be65aac358a6add8e8a8b1251bb994dc1e4a0cc885956f5ecd53396f0d8",
-		"6afb8018dcc5a53d22d4dcdda39ceaf25dafd1ea353a9bbe12073057f4e6d262",
-		"1d96166ecd6cae86797046126b64028099fcd026a37a82c4bdd19700bd49828069a822fb5453e0b32f66ed895d4f162af35ea8aca862af498e2831c596250e03",
+		"4fc2a7ecfdd20a6767ba6aadd9f901279f9d254de3d1c57ff699cfcce82e6f56",
+		"661c247e501916ee12e7fa27837b879c4b82c2363273adf929b4fa416ed2732df344fd8ce26996543bd40f95262beb9ab8b7aea34e7fd0d83d1f70eb85c9c503",
 		10,
 		1000,
 		"",
@@ -69,7 +76,6 @@ func testInterceptedTxFromFrontendGeneratedParams(
 	if testing.Short() {
 		t.Skip("this is not a short test")
 	}
-
 	chDone := make(chan struct{})
 
 	maxShards := uint32(1)
@@ -130,6 +136,8 @@ func testInterceptedTxFromFrontendGeneratedParams(
 		GasLimit:  frontendGasLimit,
 		Data:      frontendData,
 		Signature: signatureBytes,
+		ChainID:   integrationTests.ChainID,
+		Version:   core.MinTransactionVersion,
 	})
 
 	assert.Nil(t, err)
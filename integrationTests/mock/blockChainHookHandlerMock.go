@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type BlockChainHookHandlerMock struct {
+	TemporaryAccountsHandlerMock
+	SetCurrentHeaderCalled func(hdr data.HeaderHandler)
+}
+
+func (bhhm *BlockChainHookHandlerMock) SetCurrentHeader(hdr data.HeaderHandler) {
+	if bhhm.SetCurrentHeaderCalled == nil {
+		return
+	}
+
+	bhhm.SetCurrentHeaderCalled(hdr)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bhhm *BlockChainHookHandlerMock) IsInterfaceNil() bool {
+	if bhhm == nil {
+		return true
+	}
+	return false
+}
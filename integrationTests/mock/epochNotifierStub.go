@@ -0,0 +1,19 @@
+package mock
+
+type EpochNotifierStub struct {
+	CheckEpochCalled func(epoch uint32)
+}
+
+func (ens *EpochNotifierStub) CheckEpoch(epoch uint32) {
+	if ens.CheckEpochCalled != nil {
+		ens.CheckEpochCalled(epoch)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ens *EpochNotifierStub) IsInterfaceNil() bool {
+	if ens == nil {
+		return true
+	}
+	return false
+}
@@ -7,11 +7,14 @@ import (
 )
 
 type FeeHandlerStub struct {
-	SetMinGasPriceCalled        func(minasPrice uint64)
-	SetMinGasLimitCalled        func(minGasLimit uint64)
-	ComputeGasLimitCalled       func(tx process.TransactionWithFeeHandler) uint64
-	ComputeFeeCalled            func(tx process.TransactionWithFeeHandler) *big.Int
-	CheckValidityTxValuesCalled func(tx process.TransactionWithFeeHandler) error
+	SetMinGasPriceCalled          func(minasPrice uint64)
+	SetMinGasLimitCalled          func(minGasLimit uint64)
+	ComputeGasLimitCalled         func(tx process.TransactionWithFeeHandler) uint64
+	ComputeFeeCalled              func(tx process.TransactionWithFeeHandler) *big.Int
+	ComputeTxFeeCalled            func(tx process.TransactionWithFeeHandler) *big.Int
+	ComputeFeeForProcessingCalled func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int
+	CheckValidityTxValuesCalled   func(tx process.TransactionWithFeeHandler) error
+	DeveloperPercentageCalled     func() float64
 }
 
 func (fhs *FeeHandlerStub) SetMinGasPrice(minGasPrice uint64) {
@@ -30,10 +33,33 @@ func (fhs *FeeHandlerStub) ComputeFee(tx process.TransactionWithFeeHandler) *big
 	return fhs.ComputeFeeCalled(tx)
 }
 
+func (fhs *FeeHandlerStub) ComputeTxFee(tx process.TransactionWithFeeHandler) *big.Int {
+	if fhs.ComputeTxFeeCalled != nil {
+		return fhs.ComputeTxFeeCalled(tx)
+	}
+	fee := big.NewInt(0).SetUint64(tx.GetGasPrice())
+	return fee.Mul(fee, big.NewInt(0).SetUint64(tx.GetGasLimit()))
+}
+
+func (fhs *FeeHandlerStub) ComputeFeeForProcessing(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
+	if fhs.ComputeFeeForProcessingCalled != nil {
+		return fhs.ComputeFeeForProcessingCalled(tx, gasToUse)
+	}
+	fee := big.NewInt(0).SetUint64(tx.GetGasPrice())
+	return fee.Mul(fee, big.NewInt(0).SetUint64(gasToUse))
+}
+
 func (fhs *FeeHandlerStub) CheckValidityTxValues(tx process.TransactionWithFeeHandler) error {
 	return fhs.CheckValidityTxValuesCalled(tx)
 }
 
+func (fhs *FeeHandlerStub) DeveloperPercentage() float64 {
+	if fhs.DeveloperPercentageCalled != nil {
+		return fhs.DeveloperPercentageCalled()
+	}
+	return 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (fhs *FeeHandlerStub) IsInterfaceNil() bool {
 	if fhs == nil {
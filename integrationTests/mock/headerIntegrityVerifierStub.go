@@ -0,0 +1,25 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// HeaderIntegrityVerifierStub -
+type HeaderIntegrityVerifierStub struct {
+	VerifyCalled func(header data.HeaderHandler) error
+}
+
+// Verify -
+func (hivs *HeaderIntegrityVerifierStub) Verify(header data.HeaderHandler) error {
+	if hivs.VerifyCalled != nil {
+		return hivs.VerifyCalled(header)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (hivs *HeaderIntegrityVerifierStub) IsInterfaceNil() bool {
+	if hivs == nil {
+		return true
+	}
+	return false
+}
@@ -78,6 +78,16 @@ func (ncm *NodesCoordinatorMock) GetValidatorsRewardsAddresses(
 	return addresses, nil
 }
 
+func (ncm *NodesCoordinatorMock) ConsensusGroupSize(shardId uint32) int {
+	validators, _ := ncm.ComputeValidatorsGroup(nil, 0, shardId)
+
+	return len(validators)
+}
+
+func (ncm *NodesCoordinatorMock) ConsensusThreshold(shardId uint32) int {
+	return ncm.ConsensusGroupSize(shardId)*2/3 + 1
+}
+
 func (ncm *NodesCoordinatorMock) SetNodesPerShards(map[uint32][]sharding.Validator) error {
 	return nil
 }
@@ -10,6 +10,7 @@ type ResolversContainerStub struct {
 	ReplaceCalled func(key string, val dataRetriever.Resolver) error
 	RemoveCalled  func(key string)
 	LenCalled     func() int
+	KeysCalled    func() []string
 }
 
 func (rcs *ResolversContainerStub) Get(key string) (dataRetriever.Resolver, error) {
@@ -36,6 +37,13 @@ func (rcs *ResolversContainerStub) Len() int {
 	return rcs.LenCalled()
 }
 
+func (rcs *ResolversContainerStub) Keys() []string {
+	if rcs.KeysCalled != nil {
+		return rcs.KeysCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rcs *ResolversContainerStub) IsInterfaceNil() bool {
 	if rcs == nil {
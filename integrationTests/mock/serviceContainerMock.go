@@ -7,8 +7,9 @@ import (
 
 // ServiceContainerMock is a mock implementation of the Core interface
 type ServiceContainerMock struct {
-	IndexerCalled      func() indexer.Indexer
-	TPSBenchmarkCalled func() statistics.TPSBenchmark
+	IndexerCalled        func() indexer.Indexer
+	TPSBenchmarkCalled   func() statistics.TPSBenchmark
+	TrieStatisticsCalled func() statistics.TrieStatistics
 }
 
 // Indexer returns a mock implementation for core.Indexer
@@ -27,6 +28,14 @@ func (scm *ServiceContainerMock) TPSBenchmark() statistics.TPSBenchmark {
 	return nil
 }
 
+// TrieStatistics returns a mock implementation for statistics.TrieStatistics
+func (scm *ServiceContainerMock) TrieStatistics() statistics.TrieStatistics {
+	if scm.TrieStatisticsCalled != nil {
+		return scm.TrieStatisticsCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (scm *ServiceContainerMock) IsInterfaceNil() bool {
 	if scm == nil {
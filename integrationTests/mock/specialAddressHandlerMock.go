@@ -7,13 +7,14 @@ import (
 )
 
 type SpecialAddressHandlerMock struct {
-	ElrondCommunityAddressCalled func() []byte
-	LeaderAddressCalled          func() []byte
-	BurnAddressCalled            func() []byte
-	ShardIdForAddressCalled      func([]byte) (uint32, error)
-	AdrConv                      state.AddressConverter
-	ShardCoordinator             sharding.Coordinator
-	NodesCoordinator             sharding.NodesCoordinator
+	ElrondCommunityAddressCalled        func() []byte
+	LeaderAddressCalled                 func() []byte
+	BurnAddressCalled                   func() []byte
+	ProtocolSustainabilityAddressCalled func() []byte
+	ShardIdForAddressCalled             func([]byte) (uint32, error)
+	AdrConv                             state.AddressConverter
+	ShardCoordinator                    sharding.Coordinator
+	NodesCoordinator                    sharding.NodesCoordinator
 
 	shardConsensusData *data.ConsensusRewardData
 	metaConsensusData  []*data.ConsensusRewardData
@@ -100,6 +101,14 @@ func (sh *SpecialAddressHandlerMock) BurnAddress() []byte {
 	return sh.BurnAddressCalled()
 }
 
+func (sh *SpecialAddressHandlerMock) ProtocolSustainabilityAddress() []byte {
+	if sh.ProtocolSustainabilityAddressCalled == nil {
+		return []byte("protocol sustainability")
+	}
+
+	return sh.ProtocolSustainabilityAddressCalled()
+}
+
 func (sh *SpecialAddressHandlerMock) ElrondCommunityAddress() []byte {
 	if sh.ElrondCommunityAddressCalled == nil {
 		return []byte("elrond00000000000000000000000000")
@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-vm-common"
+)
+
+type TxLogsProcessorStub struct {
+	SaveLogCalled func(txHash []byte, vmOutput *vmcommon.VMOutput, scrs []data.TransactionHandler) error
+}
+
+func (tlps *TxLogsProcessorStub) SaveLog(txHash []byte, vmOutput *vmcommon.VMOutput, scrs []data.TransactionHandler) error {
+	if tlps.SaveLogCalled == nil {
+		return nil
+	}
+	return tlps.SaveLogCalled(txHash, vmOutput, scrs)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tlps *TxLogsProcessorStub) IsInterfaceNil() bool {
+	if tlps == nil {
+		return true
+	}
+	return false
+}
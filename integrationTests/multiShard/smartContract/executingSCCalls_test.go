@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -45,7 +46,7 @@ func deploySmartContract(t *testing.T, nodeToProcess *testNode, roundNumber uint
 		vm.CreateEmptyAddress().Bytes(),
 		senderNonce,
 		big.NewInt(0),
-		scCode+"@"+hex.EncodeToString(factory.InternalTestingVM),
+		scCode+"@"+hex.EncodeToString(factory.InternalTestingVM)+"@00",
 		initialValueForInternalVariable,
 	)
 
@@ -67,6 +68,7 @@ func createTx(
 
 	txData := fmt.Sprintf("%s@%X", scCodeOrFunc, scValue)
 	tx := &transaction.Transaction{
+		ChainID:  integrationTests.ChainID,
 		Nonce:    senderNonce,
 		Value:    value,
 		RcvAddr:  receiverAddressBytes,
@@ -436,9 +438,12 @@ func processAndTestIntermediateResults(t *testing.T, proposerNodeShardSC *testNo
 
 		tx := &transaction.Transaction{}
 		_ = testMarshalizer.Unmarshal(tx, txBytes)
+		// the smart contract results do not carry a chain ID, the transaction processor requires one
+		tx.ChainID = integrationTests.ChainID
 
 		// Now execute transaction back into the account shard
-		_ = proposerNodeShardAccount.txProcessor.ProcessTransaction(tx, generalRoundNumber)
+		err := proposerNodeShardAccount.txProcessor.ProcessTransaction(tx, generalRoundNumber)
+		assert.Nil(t, err)
 		generalRoundNumber++
 	}
 	_, err := proposerNodeShardAccount.accntState.Commit()
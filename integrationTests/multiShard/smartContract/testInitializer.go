@@ -33,9 +33,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	metafactoryDataRetriever "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/metachain"
 	factoryDataRetriever "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/headersPool"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
@@ -45,15 +47,22 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/pendingMb"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	processContainers "github.com/ElrondNetwork/elrond-go/process/factory/containers"
 	metaProcess "github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/outgoingOperations"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
+	"github.com/ElrondNetwork/elrond-go/process/selectionAudit"
+	"github.com/ElrondNetwork/elrond-go/process/supply"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -72,6 +81,7 @@ var testMarshalizer = &marshal.JsonMarshalizer{}
 var testAddressConverter, _ = addressConverters.NewPlainAddressConverter(32, "0x")
 var testMultiSig = mock.NewMultiSigner(1)
 var rootHash = []byte("root hash")
+var chainID = []byte("chain ID")
 var addrConv, _ = addressConverters.NewPlainAddressConverter(32, "0x")
 
 var opGas = int64(1)
@@ -216,6 +226,8 @@ func createTestShardStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.UnsignedTransactionUnit, createMemUnit())
 	store.AddStorer(dataRetriever.RewardTransactionUnit, createMemUnit())
 	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, createMemUnit())
+	store.AddStorer(dataRetriever.TxLogsUnit, createMemUnit())
+	store.AddStorer(dataRetriever.MiniBlockHashByTxHashUnit, createMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
 		hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
@@ -245,6 +257,9 @@ func createTestShardDataPool() dataRetriever.PoolsHolder {
 	cacherCfg = storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache}
 	metaBlocks, _ := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
 
+	cacherCfg = storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache}
+	peerAuthentications, _ := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
+
 	dPool, _ := dataPool.NewShardedDataPool(
 		txPool,
 		uTxPool,
@@ -254,6 +269,7 @@ func createTestShardDataPool() dataRetriever.PoolsHolder {
 		txBlockBody,
 		peerChangeBlockBody,
 		metaBlocks,
+		peerAuthentications,
 	)
 
 	return dPool
@@ -329,11 +345,15 @@ func createNetNode(
 		testHasher,
 		params.keyGen,
 		params.singleSigner,
-		testMultiSig,
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		dPool,
 		testAddressConverter,
 		maxTxNonceDeltaAllowed,
 		createMockTxFeeHandler(),
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 	interceptorsContainer, err := interceptorContainerFactory.Create()
 	if err != nil {
@@ -383,6 +403,7 @@ func createNetNode(
 	rewardsInter, _ := interimProcContainer.Get(dataBlock.RewardsBlock)
 	rewardsHandler, _ := rewardsInter.(process.TransactionFeeHandler)
 	internalTxProducer, _ := rewardsInter.(process.InternalTransactionProducer)
+	blockFeesHandler, _ := rewardsInter.(process.BlockFeesHandler)
 	rewardProcessor, _ := rewardTransaction.NewRewardTxProcessor(
 		accntAdapter,
 		addrConv,
@@ -406,6 +427,9 @@ func createNetNode(
 		shardCoordinator,
 		scForwarder,
 		rewardsHandler,
+		processContainers.NewBuiltInFunctionsContainer(),
+		&mock.TxLogsProcessorStub{},
+		createMockTxFeeHandler(),
 	)
 
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(addrConv, shardCoordinator, accntAdapter)
@@ -420,6 +444,8 @@ func createNetNode(
 		rewardsHandler,
 		txTypeHandler,
 		createMockTxFeeHandler(),
+		processContainers.NewBuiltInFunctionsContainer(),
+		integrationTests.ChainID,
 	)
 
 	miniBlocksCompacter, _ := preprocess.NewMiniBlocksCompaction(createMockTxFeeHandler(), shardCoordinator)
@@ -440,6 +466,7 @@ func createNetNode(
 		internalTxProducer,
 		createMockTxFeeHandler(),
 		miniBlocksCompacter,
+		&mock.GasHandlerMock{},
 	)
 	container, _ := fact.Create()
 
@@ -453,6 +480,14 @@ func createNetNode(
 	)
 
 	genesisBlocks := createGenesisBlocks(shardCoordinator)
+	headersNotifier, _ := headersPool.NewHeadersPool(dPool.MetaBlocks())
+	blockTracker, _ := track.NewBlockTracker(track.ArgBlockTracker{
+		Hasher:           testHasher,
+		Marshalizer:      testMarshalizer,
+		ShardCoordinator: shardCoordinator,
+		StartHeaders:     genesisBlocks,
+		HeadersNotifier:  headersNotifier,
+	})
 
 	arguments := block.ArgShardProcessor{
 		ArgBaseProcessor: block.ArgBaseProcessor{
@@ -468,6 +503,8 @@ func createNetNode(
 					return 0
 				},
 			},
+			BlockTracker:     blockTracker,
+			HeadersNotifier:  headersNotifier,
 			Hasher:           testHasher,
 			Marshalizer:      testMarshalizer,
 			Store:            store,
@@ -480,12 +517,22 @@ func createNetNode(
 			),
 			Uint64Converter: uint64Converter,
 			StartHeaders:    genesisBlocks,
-			RequestHandler:  requestHandler,
-			Core:            &mock.ServiceContainerMock{},
+			RequestHandler:     requestHandler,
+			Core:               &mock.ServiceContainerMock{},
+			HistoryRepository:  dblookupext.NewNilHistoryRepository(),
+			OutgoingOperations: outgoingOperations.NewNilOutgoingOperationsHandler(),
+			SelectionAuditor:   selectionAudit.NewNilValidatorsSelectionAuditor(),
+			ChainID:            integrationTests.ChainID,
+			SoftwareVersion:    integrationTests.SoftwareVersion,
 		},
-		DataPool:        dPool,
-		TxCoordinator:   tc,
-		TxsPoolsCleaner: &mock.TxPoolsCleanerMock{},
+		DataPool:          dPool,
+		TxCoordinator:     tc,
+		TxsPoolsCleaner:   &mock.TxPoolsCleanerMock{},
+		BlockChainHook:    &mock.BlockChainHookHandlerMock{},
+		EpochNotifier:     &mock.EpochNotifierStub{},
+		SupplyAccountant:  supply.NewNilSupplyAccountant(),
+		BlockFeesHandler:  blockFeesHandler,
+		ESDTSupplyTracker: supply.NewNilESDTSupplyTracker(),
 	}
 
 	blockProcessor, _ := block.NewShardProcessor(arguments)
@@ -592,6 +639,7 @@ func createNodes(
 				ShardConsensusGroupSize: 1,
 				MetaConsensusGroupSize:  1,
 				Hasher:                  testHasher,
+				ConsensusGroupSelection: sharding.RandomSelection,
 				ShardId:                 uint32(shardId),
 				NbShards:                uint32(numOfShards),
 				Nodes:                   validatorsMap,
@@ -669,6 +717,7 @@ func createNodes(
 			ShardConsensusGroupSize: 1,
 			MetaConsensusGroupSize:  1,
 			Hasher:                  testHasher,
+			ConsensusGroupSelection: sharding.RandomSelection,
 			ShardId:                 sharding.MetachainShardId,
 			NbShards:                uint32(numOfShards),
 			Nodes:                   validatorsMap,
@@ -733,6 +782,7 @@ func createTestMetaDataPool() dataRetriever.MetaPoolsHolder {
 
 	txPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache})
 	uTxPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache})
+	peerAuthentications, _ := storageUnit.NewCache(storageUnit.LRUCache, 1000, 1)
 
 	dPool, _ := dataPool.NewMetaDataPool(
 		metaBlocks,
@@ -741,6 +791,7 @@ func createTestMetaDataPool() dataRetriever.MetaPoolsHolder {
 		headersNonces,
 		txPool,
 		uTxPool,
+		peerAuthentications,
 	)
 
 	return dPool
@@ -789,7 +840,8 @@ func createMetaNetNode(
 		store,
 		testMarshalizer,
 		testHasher,
-		testMultiSig,
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		dPool,
 		accntAdapter,
 		testAddressConverter,
@@ -797,6 +849,9 @@ func createMetaNetNode(
 		params.keyGen,
 		maxTxNonceDeltaAllowed,
 		feeHandler,
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 	interceptorsContainer, err := interceptorContainerFactory.Create()
 	if err != nil {
@@ -827,7 +882,16 @@ func createMetaNetNode(
 	)
 
 	genesisBlocks := createGenesisBlocks(shardCoordinator)
+	headersNotifier, _ := headersPool.NewHeadersPool(dPool.ShardHeaders())
+	blockTracker, _ := track.NewBlockTracker(track.ArgBlockTracker{
+		Hasher:           testHasher,
+		Marshalizer:      testMarshalizer,
+		ShardCoordinator: shardCoordinator,
+		StartHeaders:     genesisBlocks,
+		HeadersNotifier:  headersNotifier,
+	})
 
+	pendingMiniBlocks, _ := pendingMb.NewPendingMiniBlocks(process.MaxMetaNoncesDelayForPendingMiniBlocks)
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor: block.ArgBaseProcessor{
 			Accounts: accntAdapter,
@@ -842,6 +906,8 @@ func createMetaNetNode(
 					return 0
 				},
 			},
+			BlockTracker:     blockTracker,
+			HeadersNotifier:  headersNotifier,
 			Hasher:           testHasher,
 			Marshalizer:      testMarshalizer,
 			Store:            store,
@@ -854,10 +920,16 @@ func createMetaNetNode(
 			),
 			Uint64Converter: uint64Converter,
 			StartHeaders:    genesisBlocks,
-			RequestHandler:  requestHandler,
-			Core:            &mock.ServiceContainerMock{},
+			RequestHandler:     requestHandler,
+			Core:               &mock.ServiceContainerMock{},
+			HistoryRepository:  dblookupext.NewNilHistoryRepository(),
+			OutgoingOperations: outgoingOperations.NewNilOutgoingOperationsHandler(),
+			SelectionAuditor:   selectionAudit.NewNilValidatorsSelectionAuditor(),
+			ChainID:            integrationTests.ChainID,
+			SoftwareVersion:    integrationTests.SoftwareVersion,
 		},
-		DataPool: dPool,
+		DataPool:          dPool,
+		PendingMiniBlocks: pendingMiniBlocks,
 	}
 	blkProc, _ := block.NewMetaProcessor(arguments)
 
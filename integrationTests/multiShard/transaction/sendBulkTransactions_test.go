@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
@@ -180,6 +181,8 @@ func generateTx(sender crypto.PrivateKey, receiver crypto.PublicKey) *transactio
 		Data:      "",
 		Signature: nil,
 		Challenge: nil,
+		ChainID:   integrationTests.ChainID,
+		Version:   core.MinTransactionVersion,
 	}
 	marshalizedTxBeforeSigning, _ := json.Marshal(tx)
 	signer := singlesig.SchnorrSigner{}
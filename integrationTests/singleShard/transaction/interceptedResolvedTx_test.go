@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
@@ -63,6 +64,8 @@ func TestNode_RequestInterceptTransactionWithMessenger(t *testing.T) {
 		Data:     txData,
 		GasLimit: integrationTests.MinTxGasLimit + txDataCost,
 		GasPrice: integrationTests.MinTxGasPrice,
+		ChainID:  integrationTests.ChainID,
+		Version:  core.MinTransactionVersion,
 	}
 
 	txBuff, _ := integrationTests.TestMarshalizer.Marshal(&tx)
@@ -30,6 +30,7 @@ func TestExecTransaction_SelfTransactionShouldWork(t *testing.T) {
 
 	//Step 2. create a tx moving 1 from pubKeyBuff to pubKeyBuff
 	tx := &transaction.Transaction{
+		ChainID:  integrationTests.ChainID,
 		Nonce:    nonce,
 		Value:    big.NewInt(1),
 		GasLimit: 2,
@@ -66,6 +67,7 @@ func TestExecTransaction_SelfTransactionWithRevertShouldWork(t *testing.T) {
 
 	//Step 2. create a tx moving 1 from pubKeyBuff to pubKeyBuff
 	tx := &transaction.Transaction{
+		ChainID:  integrationTests.ChainID,
 		Nonce:    nonce,
 		Value:    big.NewInt(1),
 		SndAddr:  address.Bytes(),
@@ -121,6 +123,7 @@ func testExecTransactionsMoreTxWithRevert(
 	//Step 1. execute a lot moving transactions from pubKeyBuff to another pubKeyBuff
 	for i := 0; i < txToGenerate; i++ {
 		tx := &transaction.Transaction{
+			ChainID:  integrationTests.ChainID,
 			Nonce:    initialNonce + uint64(i),
 			Value:    big.NewInt(int64(value)),
 			GasPrice: gasPrice,
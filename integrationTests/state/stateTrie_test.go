@@ -73,6 +73,69 @@ func TestAccountsDB_PutCodeWithSomeValuesShouldWork(t *testing.T) {
 	assert.Equal(t, account.GetCodeHash(), recoveredAccount.GetCodeHash())
 }
 
+func TestAccountsDB_PutSameCodeShouldStoreItOnce(t *testing.T) {
+	t.Parallel()
+
+	code := []byte("Smart contract code")
+	adb, tr, _ := integrationTests.CreateAccountsDB(factory.UserAccount)
+	account1, _ := adb.GetAccountWithJournal(integrationTests.CreateRandomAddress())
+	account2, _ := adb.GetAccountWithJournal(integrationTests.CreateRandomAddress())
+
+	err := adb.PutCode(account1, code)
+	assert.Nil(t, err)
+	err = adb.PutCode(account2, code)
+	assert.Nil(t, err)
+
+	codeHash := account1.GetCodeHash()
+	assert.Equal(t, codeHash, account2.GetCodeHash())
+	storedCode, _ := tr.Get(codeHash)
+	assert.Equal(t, code, storedCode)
+
+	err = adb.RemoveCode(codeHash)
+	assert.Nil(t, err)
+	storedCode, _ = tr.Get(codeHash)
+	assert.Equal(t, code, storedCode)
+
+	err = adb.RemoveCode(codeHash)
+	assert.Nil(t, err)
+	storedCode, _ = tr.Get(codeHash)
+	assert.Equal(t, 0, len(storedCode))
+}
+
+func TestAccountsDB_PutCodeShouldReleaseTheOldCode(t *testing.T) {
+	t.Parallel()
+
+	oldCode := []byte("old code")
+	adb, tr, _ := integrationTests.CreateAccountsDB(factory.UserAccount)
+	account, _ := adb.GetAccountWithJournal(integrationTests.CreateRandomAddress())
+
+	err := adb.PutCode(account, oldCode)
+	assert.Nil(t, err)
+	oldCodeHash := account.GetCodeHash()
+
+	err = adb.PutCode(account, []byte("new code"))
+	assert.Nil(t, err)
+
+	storedCode, _ := tr.Get(oldCodeHash)
+	assert.Equal(t, 0, len(storedCode))
+	storedCode, _ = tr.Get(account.GetCodeHash())
+	assert.Equal(t, []byte("new code"), storedCode)
+}
+
+func TestAccountsDB_RemoveCodeSavedWithoutReferencesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	code := []byte("Smart contract code")
+	adb, tr, _ := integrationTests.CreateAccountsDB(factory.UserAccount)
+	codeHash := integrationTests.TestHasher.Compute(string(code))
+	_ = tr.Update(codeHash, code)
+
+	err := adb.RemoveCode(codeHash)
+	assert.Nil(t, err)
+	storedCode, _ := tr.Get(codeHash)
+	assert.Equal(t, 0, len(storedCode))
+}
+
 func TestAccountsDB_SaveDataNoDirtyShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1028,6 +1091,7 @@ func createAndExecTxs(
 		}
 
 		tx := &transaction2.Transaction{
+			ChainID: integrationTests.ChainID,
 			Nonce:   1,
 			Value:   big.NewInt(int64(txVal)),
 			SndAddr: addr[sender].Bytes(),
@@ -23,7 +23,7 @@ func DeployScTx(nodes []*TestProcessorNode, senderIdx int, scCode string) {
 			value:    big.NewInt(0),
 			rcvAddr:  make([]byte, 32),
 			sndAddr:  nodes[senderIdx].OwnAccount.PkTxSignBytes,
-			data:     scCode + "@" + hex.EncodeToString(factory.IELEVirtualMachine),
+			data:     scCode + "@" + hex.EncodeToString(factory.IELEVirtualMachine) + "@00",
 			gasLimit: 100000,
 			gasPrice: MinTxGasPrice,
 		})
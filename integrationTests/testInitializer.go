@@ -15,6 +15,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
@@ -40,6 +41,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
 	"github.com/ElrondNetwork/elrond-go/process"
 	procFactory "github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	txProc "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -111,6 +113,9 @@ func CreateTestShardDataPool(txPool dataRetriever.ShardedDataCacherNotifier) dat
 	cacherCfg = storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache, Shards: 1}
 	metaBlocks, _ := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
 
+	cacherCfg = storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache, Shards: 1}
+	peerAuthentications, _ := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
+
 	dPool, _ := dataPool.NewShardedDataPool(
 		txPool,
 		uTxPool,
@@ -120,6 +125,7 @@ func CreateTestShardDataPool(txPool dataRetriever.ShardedDataCacherNotifier) dat
 		txBlockBody,
 		peerChangeBlockBody,
 		metaBlocks,
+		peerAuthentications,
 	)
 
 	return dPool
@@ -141,6 +147,7 @@ func CreateTestMetaDataPool() dataRetriever.MetaPoolsHolder {
 
 	txPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache, Shards: 1})
 	uTxPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache, Shards: 1})
+	peerAuthentications, _ := storageUnit.NewCache(storageUnit.LRUCache, 1000, 1)
 
 	dPool, _ := dataPool.NewMetaDataPool(
 		metaBlocks,
@@ -149,6 +156,7 @@ func CreateTestMetaDataPool() dataRetriever.MetaPoolsHolder {
 		shardHeadersNonces,
 		txPool,
 		uTxPool,
+		peerAuthentications,
 	)
 
 	return dPool
@@ -175,6 +183,8 @@ func CreateShardStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.UnsignedTransactionUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.RewardTransactionUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.TxLogsUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.MiniBlockHashByTxHashUnit, CreateMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
 		hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
@@ -453,6 +463,8 @@ func CreateSimpleTxProcessor(accnts state.AccountsAdapter) process.TransactionPr
 				return fee
 			},
 		},
+		containers.NewBuiltInFunctionsContainer(),
+		ChainID,
 	)
 
 	return txProcessor
@@ -790,6 +802,8 @@ func generateTransferTx(
 		Data:     "",
 		GasLimit: gasLimit,
 		GasPrice: gasPrice,
+		ChainID:  ChainID,
+		Version:  core.MinTransactionVersion,
 	}
 	txBuff, _ := TestMarshalizer.Marshal(&tx)
 	signer := &singlesig.SchnorrSigner{}
@@ -811,6 +825,8 @@ func generateTx(
 		GasPrice: args.gasPrice,
 		GasLimit: args.gasLimit,
 		Data:     args.data,
+		ChainID:  ChainID,
+		Version:  core.MinTransactionVersion,
 	}
 	txBuff, _ := TestMarshalizer.Marshal(tx)
 	tx.Signature, _ = signer.Sign(skSign, txBuff)
@@ -1121,6 +1137,7 @@ func generateValidTx(
 		node.WithTxSignPrivKey(skSender),
 		node.WithTxSignPubKey(pkSender),
 		node.WithAccountsAdapter(accnts),
+		node.WithChainID(string(ChainID)),
 	)
 
 	tx, err := mockNode.GenerateTransaction(
@@ -1274,7 +1291,7 @@ func GenValidatorsFromPubKeys(pubKeysMap map[uint32][]string, nbShards uint32) m
 // CreateCryptoParams generates the crypto parameters (key pairs, key generator and suite) for multiple nodes
 func CreateCryptoParams(nodesPerShard int, nbMetaNodes int, nbShards uint32) *CryptoParams {
 	suite := kyber.NewSuitePairingBn256()
-	singleSigner := &singlesig.SchnorrSigner{}
+	singleSigner := TestSingleBlsSigner
 	keyGen := signing.NewKeyGenerator(suite)
 
 	keysMap := make(map[uint32][]*TestKeyPair)
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -24,6 +25,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	metafactoryDataRetriever "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/metachain"
 	factoryDataRetriever "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/headersPool"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
@@ -33,17 +35,26 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/pendingMb"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	metaProcess "github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/outgoingOperations"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
+	"github.com/ElrondNetwork/elrond-go/process/selectionAudit"
+	"github.com/ElrondNetwork/elrond-go/process/supply"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/pkg/errors"
 )
@@ -60,6 +71,9 @@ var TestAddressConverter, _ = addressConverters.NewPlainAddressConverter(32, "0x
 // TestMultiSig represents a mock multisig
 var TestMultiSig = mock.NewMultiSigner(1)
 
+// TestSingleBlsSigner represents a BLS single signer, used for the random seed of the blocks
+var TestSingleBlsSigner = &singlesig.BlsSingleSigner{}
+
 // TestUint64Converter represents an uint64 to byte slice converter
 var TestUint64Converter = uint64ByteSlice.NewBigEndianConverter()
 
@@ -70,6 +84,16 @@ var MinTxGasPrice = uint64(0)
 // MinTxGasLimit minimum gas limit required by a transaction
 var MinTxGasLimit = uint64(4)
 
+// MaxGasLimitPerBlock defines the maximum gas that can be consumed in a block, for each transaction type,
+// either in total or per destination shard
+var MaxGasLimitPerBlock = uint64(3000000000)
+
+// ChainID is the chain identifier used by the test nodes when creating and intercepting transactions
+var ChainID = []byte("integration tests chain ID")
+
+// SoftwareVersion is the headers software version used by the test nodes when creating blocks
+var SoftwareVersion = []byte("1")
+
 const maxTxNonceDeltaAllowed = 8000
 
 // TestKeyPair holds a pair of private/public Keys
@@ -93,8 +117,9 @@ type TestProcessorNode struct {
 	SpecialAddressHandler process.SpecialAddressHandler
 	Messenger             p2p.Messenger
 
-	OwnAccount *TestWalletAccount
-	NodeKeys   *TestKeyPair
+	OwnAccount        *TestWalletAccount
+	NodeKeys          *TestKeyPair
+	HeaderSigVerifier process.InterceptedHeaderSigVerifier
 
 	ShardDataPool dataRetriever.PoolsHolder
 	MetaDataPool  dataRetriever.MetaPoolsHolder
@@ -122,8 +147,11 @@ type TestProcessorNode struct {
 	RewardsProcessor       process.RewardTransactionProcessor
 	PreProcessorsContainer process.PreProcessorsContainer
 	MiniBlocksCompacter    process.MiniBlocksCompacter
+	GasHandler             process.GasHandler
 
 	ForkDetector       process.ForkDetector
+	BlockTracker       process.BlockTracker
+	HeadersNotifier    dataRetriever.HeadersNotifier
 	BlockProcessor     process.BlockProcessor
 	BroadcastMessenger consensus.BroadcastMessenger
 	Bootstrapper       TestBootstrapper
@@ -166,6 +194,7 @@ func NewTestProcessorNode(
 		Pk: pk,
 	}
 	tpn.MultiSigner = TestMultiSig
+	tpn.HeaderSigVerifier = &mock.HeaderSigVerifierStub{}
 	tpn.OwnAccount = CreateTestWalletAccount(shardCoordinator, txSignPrivKeyShardId)
 	tpn.initDataPools()
 	tpn.initTestNode()
@@ -193,6 +222,7 @@ func NewTestProcessorNodeWithCustomDataPool(maxShards uint32, nodeShardId uint32
 		Pk: pk,
 	}
 	tpn.MultiSigner = TestMultiSig
+	tpn.HeaderSigVerifier = &mock.HeaderSigVerifierStub{}
 	tpn.OwnAccount = CreateTestWalletAccount(shardCoordinator, txSignPrivKeyShardId)
 	if tpn.ShardCoordinator.SelfId() != sharding.MetachainShardId {
 		tpn.ShardDataPool = dPool
@@ -259,6 +289,7 @@ func (tpn *TestProcessorNode) initChainHandler() {
 func (tpn *TestProcessorNode) initEconomicsData() {
 	mingGasPrice := strconv.FormatUint(MinTxGasPrice, 10)
 	minGasLimit := strconv.FormatUint(MinTxGasLimit, 10)
+	maxGasLimitPerBlock := strconv.FormatUint(MaxGasLimitPerBlock, 10)
 
 	economicsData, _ := economics.NewEconomicsData(
 		&config.ConfigEconomics{
@@ -273,8 +304,15 @@ func (tpn *TestProcessorNode) initEconomicsData() {
 				BurnPercentage:      0.40,
 			},
 			FeeSettings: config.FeeSettings{
-				MinGasPrice: mingGasPrice,
-				MinGasLimit: minGasLimit,
+				MinGasPrice:      mingGasPrice,
+				MinGasLimit:      minGasLimit,
+				GasPriceModifier: 1,
+			},
+			GasLimitSettings: config.GasLimitSettings{
+				MaxMoveBalanceGasPerBlock: maxGasLimitPerBlock,
+				MaxSCExecutionGasPerBlock: maxGasLimitPerBlock,
+				MaxMoveBalanceGasPerShard: maxGasLimitPerBlock,
+				MaxSCExecutionGasPerShard: maxGasLimitPerBlock,
 			},
 		},
 	)
@@ -294,7 +332,8 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			tpn.Storage,
 			TestMarshalizer,
 			TestHasher,
-			TestMultiSig,
+			tpn.HeaderSigVerifier,
+			&mock.HeaderIntegrityVerifierStub{},
 			tpn.MetaDataPool,
 			tpn.AccntState,
 			TestAddressConverter,
@@ -302,6 +341,9 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			tpn.OwnAccount.KeygenTxSign,
 			maxTxNonceDeltaAllowed,
 			tpn.EconomicsData,
+			ChainID,
+			&mock.InterceptedDataLimitsCheckerStub{},
+			&mock.PeerAuthenticationVerifierStub{},
 		)
 
 		tpn.InterceptorsContainer, err = interceptorContainerFactory.Create()
@@ -319,11 +361,15 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			TestHasher,
 			tpn.OwnAccount.KeygenTxSign,
 			tpn.OwnAccount.SingleSigner,
-			TestMultiSig,
+			tpn.HeaderSigVerifier,
+			&mock.HeaderIntegrityVerifierStub{},
 			tpn.ShardDataPool,
 			TestAddressConverter,
 			maxTxNonceDeltaAllowed,
 			tpn.EconomicsData,
+			ChainID,
+			&mock.InterceptedDataLimitsCheckerStub{},
+			&mock.PeerAuthenticationVerifierStub{},
 		)
 
 		tpn.InterceptorsContainer, err = interceptorContainerFactory.Create()
@@ -420,7 +466,26 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 			return tpn.VmProcessor, nil
 		}}
 
+	builtInFunctionsContainer, _ := builtInFunctions.CreateBuiltInFunctionContainer(
+		builtInFunctions.ArgsCreateBuiltInFunctionContainer{
+			Accounts:      tpn.AccntState,
+			AddrConv:      TestAddressConverter,
+			Marshalizer:   TestMarshalizer,
+			Hasher:        TestHasher,
+			SCRForwarder:  tpn.ScrForwarder,
+			EpochHandler:  tpn.SpecialAddressHandler,
+			GasSchedule:   mock.NewGasScheduleNotifierMock(make(map[string]map[string]uint64)),
+			SupplyTracker: supply.NewNilESDTSupplyTracker(),
+			Config:        config.BuiltInFunctionsConfig{},
+		},
+	)
+
 	tpn.ArgsParser, _ = smartContract.NewAtArgumentParser()
+	txLogsProcessor, _ := transactionLog.NewTxLogProcessor(
+		tpn.Storage.GetStorer(dataRetriever.TxLogsUnit),
+		TestMarshalizer,
+		TestHasher,
+	)
 	tpn.ScProcessor, _ = smartContract.NewSmartContractProcessor(
 		vmContainer,
 		tpn.ArgsParser,
@@ -432,6 +497,9 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		tpn.ShardCoordinator,
 		tpn.ScrForwarder,
 		rewardsHandler,
+		builtInFunctionsContainer,
+		txLogsProcessor,
+		tpn.EconomicsData,
 	)
 
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(TestAddressConverter, tpn.ShardCoordinator, tpn.AccntState)
@@ -446,9 +514,12 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		rewardsHandler,
 		txTypeHandler,
 		tpn.EconomicsData,
+		builtInFunctionsContainer,
+		ChainID,
 	)
 
 	tpn.MiniBlocksCompacter, _ = preprocess.NewMiniBlocksCompaction(tpn.EconomicsData, tpn.ShardCoordinator)
+	tpn.GasHandler, _ = preprocess.NewGasComputation(tpn.EconomicsData, tpn.EconomicsData)
 
 	fact, _ := shard.NewPreProcessorsContainerFactory(
 		tpn.ShardCoordinator,
@@ -466,6 +537,7 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		internalTxProducer,
 		tpn.EconomicsData,
 		tpn.MiniBlocksCompacter,
+		tpn.GasHandler,
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -479,6 +551,45 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 	)
 }
 
+func (tpn *TestProcessorNode) createCrossHeadersNotifier() dataRetriever.HeadersNotifier {
+	var crossHeaders storage.Cacher
+	if tpn.ShardCoordinator.SelfId() == sharding.MetachainShardId {
+		crossHeaders = tpn.MetaDataPool.ShardHeaders()
+	} else {
+		crossHeaders = tpn.ShardDataPool.MetaBlocks()
+	}
+
+	headersNotifier, err := headersPool.NewHeadersPool(crossHeaders)
+	if err != nil {
+		fmt.Printf("Error creating headers notifier: %s\n", err.Error())
+	}
+
+	return headersNotifier
+}
+
+func (tpn *TestProcessorNode) createBlockTracker() process.BlockTracker {
+	tpn.HeadersNotifier = tpn.createCrossHeadersNotifier()
+	blockTracker, err := track.NewBlockTracker(track.ArgBlockTracker{
+		Hasher:           TestHasher,
+		Marshalizer:      TestMarshalizer,
+		ShardCoordinator: tpn.ShardCoordinator,
+		StartHeaders:     tpn.GenesisBlocks,
+		HeadersNotifier:  tpn.HeadersNotifier,
+	})
+	if err != nil {
+		fmt.Printf("Error creating block tracker: %s\n", err.Error())
+	}
+
+	return blockTracker
+}
+
+func (tpn *TestProcessorNode) getBlockFeesHandler() process.BlockFeesHandler {
+	rewardsInter, _ := tpn.InterimProcContainer.Get(dataBlock.RewardsBlock)
+	blockFeesHandler, _ := rewardsInter.(process.BlockFeesHandler)
+
+	return blockFeesHandler
+}
+
 func (tpn *TestProcessorNode) initBlockProcessor() {
 	var err error
 
@@ -494,9 +605,13 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 		},
 	}
 
+	tpn.BlockTracker = tpn.createBlockTracker()
+
 	argumentsBase := block.ArgBaseProcessor{
 		Accounts:              tpn.AccntState,
 		ForkDetector:          tpn.ForkDetector,
+		BlockTracker:          tpn.BlockTracker,
+		HeadersNotifier:       tpn.HeadersNotifier,
 		Hasher:                TestHasher,
 		Marshalizer:           TestMarshalizer,
 		Store:                 tpn.Storage,
@@ -507,22 +622,34 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 		StartHeaders:          tpn.GenesisBlocks,
 		RequestHandler:        tpn.RequestHandler,
 		Core:                  nil,
+		HistoryRepository:     dblookupext.NewNilHistoryRepository(),
+		OutgoingOperations:    outgoingOperations.NewNilOutgoingOperationsHandler(),
+		SelectionAuditor:      selectionAudit.NewNilValidatorsSelectionAuditor(),
+		ChainID:               ChainID,
+		SoftwareVersion:       SoftwareVersion,
 	}
 
 	if tpn.ShardCoordinator.SelfId() == sharding.MetachainShardId {
 		argumentsBase.Core = &mock.ServiceContainerMock{}
+		pendingMiniBlocks, _ := pendingMb.NewPendingMiniBlocks(process.MaxMetaNoncesDelayForPendingMiniBlocks)
 		arguments := block.ArgMetaProcessor{
-			ArgBaseProcessor: argumentsBase,
-			DataPool:         tpn.MetaDataPool,
+			ArgBaseProcessor:  argumentsBase,
+			DataPool:          tpn.MetaDataPool,
+			PendingMiniBlocks: pendingMiniBlocks,
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
 	} else {
 		arguments := block.ArgShardProcessor{
-			ArgBaseProcessor: argumentsBase,
-			DataPool:         tpn.ShardDataPool,
-			TxCoordinator:    tpn.TxCoordinator,
-			TxsPoolsCleaner:  &mock.TxPoolsCleanerMock{},
+			ArgBaseProcessor:  argumentsBase,
+			DataPool:          tpn.ShardDataPool,
+			TxCoordinator:     tpn.TxCoordinator,
+			TxsPoolsCleaner:   &mock.TxPoolsCleanerMock{},
+			BlockChainHook:    &mock.BlockChainHookHandlerMock{},
+			EpochNotifier:     &mock.EpochNotifierStub{},
+			SupplyAccountant:  supply.NewNilSupplyAccountant(),
+			BlockFeesHandler:  tpn.getBlockFeesHandler(),
+			ESDTSupplyTracker: supply.NewNilESDTSupplyTracker(),
 		}
 
 		tpn.BlockProcessor, err = block.NewShardProcessor(arguments)
@@ -566,6 +693,7 @@ func (tpn *TestProcessorNode) initNode() {
 		node.WithBlockProcessor(tpn.BlockProcessor),
 		node.WithTxSingleSigner(tpn.OwnAccount.SingleSigner),
 		node.WithDataStore(tpn.Storage),
+		node.WithChainID(string(ChainID)),
 		node.WithSyncer(&mock.SyncTimerMock{}),
 	)
 	if err != nil {
@@ -598,6 +726,8 @@ func (tpn *TestProcessorNode) SendTransaction(tx *dataTransaction.Transaction) (
 		tx.GasLimit,
 		tx.Data,
 		tx.Signature,
+		string(tx.ChainID),
+		tx.Version,
 	)
 	return txHash, err
 }
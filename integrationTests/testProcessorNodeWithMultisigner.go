@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/stretchr/testify/assert"
 )
@@ -55,6 +56,16 @@ func NewTestProcessorNodeWithCustomNodesCoordinator(
 	if tpn.MultiSigner == nil {
 		fmt.Println("Error generating multisigner")
 	}
+
+	args := &headerCheck.ArgsHeaderSigVerifier{
+		Marshalizer:       TestMarshalizer,
+		Hasher:            TestHasher,
+		NodesCoordinator:  nodesCoordinator,
+		MultiSigVerifier:  tpn.MultiSigner,
+		SingleSigVerifier: cp.SingleSigner,
+		KeyGen:            cp.KeyGen,
+	}
+	tpn.HeaderSigVerifier, _ = headerCheck.NewHeaderSigVerifier(args)
 	accountShardId := nodeShardId
 	if nodeShardId == sharding.MetachainShardId {
 		accountShardId = 0
@@ -85,6 +96,7 @@ func CreateNodesWithNodesCoordinator(
 			ShardConsensusGroupSize: shardConsensusGroupSize,
 			MetaConsensusGroupSize:  metaConsensusGroupSize,
 			Hasher:                  TestHasher,
+			ConsensusGroupSelection: sharding.RandomSelection,
 			ShardId:                 shardId,
 			NbShards:                uint32(nbShards),
 			Nodes:                   validatorsMap,
@@ -137,6 +149,8 @@ func ProposeBlockWithConsensusSignature(
 	// first node is block proposer
 	body, header, txHashes := consensusNodes[0].ProposeBlock(round, nonce)
 	header.SetPrevRandSeed(randomness)
+	randSeed, _ := TestSingleBlsSigner.Sign(consensusNodes[0].NodeKeys.Sk, randomness)
+	header.SetRandSeed(randSeed)
 	header = DoConsensusSigningOnBlock(header, consensusNodes, pubKeys)
 
 	return body, header, txHashes, consensusNodes
@@ -6,7 +6,13 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/pendingMb"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/process/outgoingOperations"
+	"github.com/ElrondNetwork/elrond-go/process/selectionAudit"
+	"github.com/ElrondNetwork/elrond-go/process/supply"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -39,6 +45,7 @@ func NewTestSyncNode(
 	}
 
 	tpn.MultiSigner = TestMultiSig
+	tpn.HeaderSigVerifier = &mock.HeaderSigVerifierStub{}
 	tpn.OwnAccount = CreateTestWalletAccount(shardCoordinator, txSignPrivKeyShardId)
 	tpn.initDataPools()
 	tpn.initTestNodeWithSync()
@@ -79,9 +86,13 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 	var err error
 
+	tpn.BlockTracker = tpn.createBlockTracker()
+
 	argumentsBase := block.ArgBaseProcessor{
 		Accounts:              tpn.AccntState,
 		ForkDetector:          nil,
+		BlockTracker:          tpn.BlockTracker,
+		HeadersNotifier:       tpn.HeadersNotifier,
 		Hasher:                TestHasher,
 		Marshalizer:           TestMarshalizer,
 		Store:                 tpn.Storage,
@@ -92,15 +103,22 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		StartHeaders:          tpn.GenesisBlocks,
 		RequestHandler:        tpn.RequestHandler,
 		Core:                  nil,
+		HistoryRepository:     dblookupext.NewNilHistoryRepository(),
+		OutgoingOperations:    outgoingOperations.NewNilOutgoingOperationsHandler(),
+		SelectionAuditor:      selectionAudit.NewNilValidatorsSelectionAuditor(),
+		ChainID:               ChainID,
+		SoftwareVersion:       SoftwareVersion,
 	}
 
 	if tpn.ShardCoordinator.SelfId() == sharding.MetachainShardId {
 		tpn.ForkDetector, _ = sync.NewMetaForkDetector(tpn.Rounder)
 		argumentsBase.Core = &mock.ServiceContainerMock{}
 		argumentsBase.ForkDetector = tpn.ForkDetector
+		pendingMiniBlocks, _ := pendingMb.NewPendingMiniBlocks(process.MaxMetaNoncesDelayForPendingMiniBlocks)
 		arguments := block.ArgMetaProcessor{
-			ArgBaseProcessor: argumentsBase,
-			DataPool:         tpn.MetaDataPool,
+			ArgBaseProcessor:  argumentsBase,
+			DataPool:          tpn.MetaDataPool,
+			PendingMiniBlocks: pendingMiniBlocks,
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -109,10 +127,15 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		tpn.ForkDetector, _ = sync.NewShardForkDetector(tpn.Rounder)
 		argumentsBase.ForkDetector = tpn.ForkDetector
 		arguments := block.ArgShardProcessor{
-			ArgBaseProcessor: argumentsBase,
-			DataPool:         tpn.ShardDataPool,
-			TxCoordinator:    tpn.TxCoordinator,
-			TxsPoolsCleaner:  &mock.TxPoolsCleanerMock{},
+			ArgBaseProcessor:  argumentsBase,
+			DataPool:          tpn.ShardDataPool,
+			TxCoordinator:     tpn.TxCoordinator,
+			TxsPoolsCleaner:   &mock.TxPoolsCleanerMock{},
+			BlockChainHook:    &mock.BlockChainHookHandlerMock{},
+			EpochNotifier:     &mock.EpochNotifierStub{},
+			SupplyAccountant:  supply.NewNilSupplyAccountant(),
+			BlockFeesHandler:  tpn.getBlockFeesHandler(),
+			ESDTSupplyTracker: supply.NewNilESDTSupplyTracker(),
 		}
 
 		tpn.BlockProcessor, err = block.NewShardProcessor(arguments)
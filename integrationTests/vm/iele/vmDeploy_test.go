@@ -8,6 +8,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/stretchr/testify/assert"
@@ -27,11 +28,12 @@ func TestVMInvalidSmartContractCodeShouldNotGenerateAccount(t *testing.T) {
 	assert.Equal(t, 0, accnts.JournalLen())
 
 	tx := &transaction.Transaction{
+		ChainID:  integrationTests.ChainID,
 		Nonce:    senderNonce,
 		Value:    big.NewInt(0),
 		SndAddr:  senderAddressBytes,
 		RcvAddr:  vm.CreateEmptyAddress().Bytes(),
-		Data:     string(scCode) + "@" + hex.EncodeToString(factory.IELEVirtualMachine),
+		Data:     string(scCode) + "@" + hex.EncodeToString(factory.IELEVirtualMachine) + "@00",
 		GasPrice: gasPrice,
 		GasLimit: gasLimit,
 	}
@@ -57,7 +59,7 @@ func TestVmDeployWithTransferAndGasShouldDeploySCCode(t *testing.T) {
 	transferOnCalls := big.NewInt(50)
 
 	initialValueForInternalVariable := uint64(45)
-	scCode := fmt.Sprintf("0000003B6302690003616464690004676574416700000001616101550468000100016161015406010A6161015506F6000068000200006161005401F6000101@%s@%X",
+	scCode := fmt.Sprintf("0000003B6302690003616464690004676574416700000001616101550468000100016161015406010A6161015506F6000068000200006161005401F6000101@%s@00@%X",
 		hex.EncodeToString(factory.IELEVirtualMachine), initialValueForInternalVariable)
 
 	tx := vm.CreateTx(
@@ -108,7 +110,7 @@ func TestVMDeployWithTransferWithInsufficientGasShouldReturnErr(t *testing.T) {
 	transferOnCalls := big.NewInt(50)
 
 	initialValueForInternalVariable := uint64(45)
-	scCode := fmt.Sprintf("0000003B6302690003616464690004676574416700000001616101550468000100016161015406010A6161015506F6000068000200006161005401F6000101@%s@%X",
+	scCode := fmt.Sprintf("0000003B6302690003616464690004676574416700000001616101550468000100016161015406010A6161015506F6000068000200006161005401F6000101@%s@00@%X",
 		hex.EncodeToString(factory.IELEVirtualMachine), initialValueForInternalVariable)
 
 	tx := vm.CreateTx(
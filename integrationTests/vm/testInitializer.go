@@ -10,11 +10,13 @@ import (
 	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
@@ -102,6 +104,9 @@ func CreateTxProcessorWithOneSCExecutorMockVM(accnts state.AccountsAdapter, opGa
 		oneShardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		containers.NewBuiltInFunctionsContainer(),
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(
@@ -119,6 +124,8 @@ func CreateTxProcessorWithOneSCExecutorMockVM(accnts state.AccountsAdapter, opGa
 		&mock.UnsignedTxHandlerMock{},
 		txTypeHandler,
 		&mock.FeeHandlerStub{},
+		containers.NewBuiltInFunctionsContainer(),
+		integrationTests.ChainID,
 	)
 
 	return txProcessor
@@ -163,6 +170,9 @@ func CreateTxProcessorWithOneSCExecutorIeleVM(
 		oneShardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		containers.NewBuiltInFunctionsContainer(),
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(
@@ -180,6 +190,8 @@ func CreateTxProcessorWithOneSCExecutorIeleVM(
 		&mock.UnsignedTxHandlerMock{},
 		txTypeHandler,
 		&mock.FeeHandlerStub{},
+		containers.NewBuiltInFunctionsContainer(),
+		integrationTests.ChainID,
 	)
 
 	return txProcessor, blockChainHook
@@ -274,6 +286,7 @@ func CreateTx(
 
 	txData := scCodeOrFunc
 	tx := &dataTransaction.Transaction{
+		ChainID:  integrationTests.ChainID,
 		Nonce:    senderNonce,
 		Value:    value,
 		SndAddr:  senderAddressBytes,
@@ -0,0 +1,91 @@
+package marshal
+
+import (
+	"sync"
+)
+
+// epochSwitchMarshalizer serializes with a marshalizer until the configured switch epoch is confirmed and with a
+// second one afterwards. As the data created before the switch is still stored, or can still be received from the
+// peers which did not switch yet, the deserialization falls back to the other marshalizer when the active one fails
+type epochSwitchMarshalizer struct {
+	beforeSwitch Marshalizer
+	afterSwitch  Marshalizer
+	switchEpoch  uint32
+
+	mutActive sync.RWMutex
+	active    Marshalizer
+	inactive  Marshalizer
+}
+
+// NewEpochSwitchMarshalizer creates a new marshalizer which switches from the first to the second provided
+// marshalizer when the switch epoch is confirmed
+func NewEpochSwitchMarshalizer(
+	beforeSwitch Marshalizer,
+	afterSwitch Marshalizer,
+	switchEpoch uint32,
+) (*epochSwitchMarshalizer, error) {
+	if beforeSwitch == nil || beforeSwitch.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if afterSwitch == nil || afterSwitch.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+
+	esm := &epochSwitchMarshalizer{
+		beforeSwitch: beforeSwitch,
+		afterSwitch:  afterSwitch,
+		switchEpoch:  switchEpoch,
+	}
+	esm.EpochConfirmed(0)
+
+	return esm, nil
+}
+
+// EpochConfirmed selects the marshalizer of the provided epoch. The epoch can also go back, when blocks are reverted
+func (esm *epochSwitchMarshalizer) EpochConfirmed(epoch uint32) {
+	esm.mutActive.Lock()
+	if epoch >= esm.switchEpoch {
+		esm.active, esm.inactive = esm.afterSwitch, esm.beforeSwitch
+	} else {
+		esm.active, esm.inactive = esm.beforeSwitch, esm.afterSwitch
+	}
+	esm.mutActive.Unlock()
+}
+
+// Marshal serializes the provided object with the marshalizer of the current epoch
+func (esm *epochSwitchMarshalizer) Marshal(obj interface{}) ([]byte, error) {
+	esm.mutActive.RLock()
+	active := esm.active
+	esm.mutActive.RUnlock()
+
+	return active.Marshal(obj)
+}
+
+// Unmarshal deserializes the provided buffer with the marshalizer of the current epoch, falling back to the other
+// marshalizer if the buffer was created in the other format
+func (esm *epochSwitchMarshalizer) Unmarshal(obj interface{}, buff []byte) error {
+	esm.mutActive.RLock()
+	active := esm.active
+	inactive := esm.inactive
+	esm.mutActive.RUnlock()
+
+	err := active.Unmarshal(obj, buff)
+	if err == nil {
+		return nil
+	}
+
+	errInactive := inactive.Unmarshal(obj, buff)
+	if errInactive != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (esm *epochSwitchMarshalizer) IsInterfaceNil() bool {
+	if esm == nil {
+		return true
+	}
+	return false
+}
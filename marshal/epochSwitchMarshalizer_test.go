@@ -0,0 +1,68 @@
+package marshal_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEpochSwitchMarshalizer_NilMarshalizersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esm, err := marshal.NewEpochSwitchMarshalizer(nil, &marshal.GogoProtoMarshalizer{}, 1)
+	assert.Nil(t, esm)
+	assert.Equal(t, marshal.ErrNilMarshalizer, err)
+
+	esm, err = marshal.NewEpochSwitchMarshalizer(&marshal.JsonMarshalizer{}, nil, 1)
+	assert.Nil(t, esm)
+	assert.Equal(t, marshal.ErrNilMarshalizer, err)
+}
+
+func TestEpochSwitchMarshalizer(t *testing.T) {
+	esm, _ := marshal.NewEpochSwitchMarshalizer(&marshal.JsonMarshalizer{}, &marshal.GogoProtoMarshalizer{}, 0)
+
+	Suite(t, esm)
+}
+
+func TestEpochSwitchMarshalizer_ShouldSwitchAtTheConfiguredEpoch(t *testing.T) {
+	t.Parallel()
+
+	jsonMarshalizer := &marshal.JsonMarshalizer{}
+	protoMarshalizer := &marshal.GogoProtoMarshalizer{}
+	esm, err := marshal.NewEpochSwitchMarshalizer(jsonMarshalizer, protoMarshalizer, 2)
+	assert.Nil(t, err)
+	assert.False(t, esm.IsInterfaceNil())
+
+	hdr := &block.Header{Nonce: 37, Epoch: 2, RootHash: []byte("root hash")}
+	jsonBuff, _ := jsonMarshalizer.Marshal(hdr)
+	protoBuff, _ := protoMarshalizer.Marshal(hdr)
+
+	buff, _ := esm.Marshal(hdr)
+	assert.Equal(t, jsonBuff, buff)
+
+	esm.EpochConfirmed(2)
+	buff, _ = esm.Marshal(hdr)
+	assert.Equal(t, protoBuff, buff)
+
+	esm.EpochConfirmed(1)
+	buff, _ = esm.Marshal(hdr)
+	assert.Equal(t, jsonBuff, buff)
+}
+
+func TestEpochSwitchMarshalizer_UnmarshalShouldFallBackToTheOtherFormat(t *testing.T) {
+	t.Parallel()
+
+	jsonMarshalizer := &marshal.JsonMarshalizer{}
+	esm, _ := marshal.NewEpochSwitchMarshalizer(jsonMarshalizer, &marshal.GogoProtoMarshalizer{}, 1)
+	esm.EpochConfirmed(1)
+
+	hdr := &block.Header{Nonce: 37, RootHash: []byte("root hash")}
+	jsonBuff, _ := jsonMarshalizer.Marshal(hdr)
+
+	recovered := &block.Header{}
+	err := esm.Unmarshal(recovered, jsonBuff)
+	assert.Nil(t, err)
+	assert.Equal(t, hdr, recovered)
+}
@@ -7,3 +7,15 @@ var ErrMarshallingProto = errors.New("can not serialize the object")
 
 // ErrUnmarshallingProto is raised when the object that needs to be unmarshaled does not implement proto.Message
 var ErrUnmarshallingProto = errors.New("obj does not implement proto.Message")
+
+// ErrNilObjectToMarshal is raised when a nil object is provided for serialization
+var ErrNilObjectToMarshal = errors.New("nil object to serialize")
+
+// ErrNilObjectToUnmarshal is raised when a nil object is provided to deserialize into
+var ErrNilObjectToUnmarshal = errors.New("nil object to deserialize into")
+
+// ErrNilBufferToUnmarshal is raised when a nil buffer is provided for deserialization
+var ErrNilBufferToUnmarshal = errors.New("nil byte buffer to deserialize from")
+
+// ErrNilMarshalizer is raised when a nil marshalizer is provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
@@ -0,0 +1,90 @@
+package marshal
+
+import (
+	"reflect"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	protobuf "github.com/ElrondNetwork/elrond-go/data/batch/proto"
+	"github.com/gogo/protobuf/proto"
+)
+
+// GogoProtoMarshalizer implements marshaling through the generated gogo protobuf messages. The data structures
+// which have a protobuf definition (blocks, miniblocks, transactions and the batches of marshalized objects) are
+// serialized as protobuf, while the others fall back to JSON. The format only depends on the type of the object,
+// so the same type is always serialized the same way
+type GogoProtoMarshalizer struct {
+	fallback JsonMarshalizer
+}
+
+// Marshal serializes the provided object
+func (gpm *GogoProtoMarshalizer) Marshal(obj interface{}) ([]byte, error) {
+	if obj == nil {
+		return nil, ErrNilObjectToMarshal
+	}
+
+	switch o := obj.(type) {
+	case data.GogoProtoHelper:
+		return o.MarshalProto()
+	case proto.Message:
+		return proto.Marshal(o)
+	case [][]byte:
+		return (&protobuf.Batch{Data: o}).Marshal()
+	}
+
+	helper, ok := addressableCopy(obj).(data.GogoProtoHelper)
+	if ok {
+		return helper.MarshalProto()
+	}
+
+	return gpm.fallback.Marshal(obj)
+}
+
+// Unmarshal deserializes the provided buffer into the provided object
+func (gpm *GogoProtoMarshalizer) Unmarshal(obj interface{}, buff []byte) error {
+	if obj == nil {
+		return ErrNilObjectToUnmarshal
+	}
+	if buff == nil {
+		return ErrNilBufferToUnmarshal
+	}
+
+	switch o := obj.(type) {
+	case data.GogoProtoHelper:
+		return o.UnmarshalProto(buff)
+	case proto.Message:
+		return proto.Unmarshal(buff, o)
+	case *[][]byte:
+		batch := &protobuf.Batch{}
+		err := batch.Unmarshal(buff)
+		if err != nil {
+			return err
+		}
+
+		*o = batch.Data
+		return nil
+	}
+
+	return gpm.fallback.Unmarshal(obj, buff)
+}
+
+// addressableCopy returns a pointer to a copy of the provided value, so that the methods with pointer receivers can
+// be used on the values passed by value
+func addressableCopy(obj interface{}) interface{} {
+	value := reflect.ValueOf(obj)
+	if value.Kind() == reflect.Ptr {
+		return nil
+	}
+
+	ptr := reflect.New(value.Type())
+	ptr.Elem().Set(value)
+
+	return ptr.Interface()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gpm *GogoProtoMarshalizer) IsInterfaceNil() bool {
+	if gpm == nil {
+		return true
+	}
+	return false
+}
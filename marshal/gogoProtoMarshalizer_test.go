@@ -0,0 +1,246 @@
+package marshal_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	protobuf "github.com/ElrondNetwork/elrond-go/data/trie/proto"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGogoProtoMarshalizer(t *testing.T) {
+	Suite(t, &marshal.GogoProtoMarshalizer{})
+}
+
+func TestGogoProtoMarshalizer_HeaderRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	gpm := &marshal.GogoProtoMarshalizer{}
+	hdr := &block.Header{
+		Nonce:         37,
+		PrevHash:      []byte("prev hash"),
+		ShardId:       1,
+		Round:         40,
+		Epoch:         2,
+		BlockBodyType: block.TxBlock,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: []byte("mb hash"), SenderShardID: 1, ReceiverShardID: 0, TxCount: 5, Type: block.SmartContractResultBlock},
+		},
+		PeerChanges:     []block.PeerChange{{PubKey: []byte("pk"), ShardIdDest: 1}},
+		MetaBlockHashes: [][]byte{[]byte("meta hash")},
+		TxCount:         5,
+		ChainID:         []byte("chain ID"),
+		SoftwareVersion: []byte("1"),
+	}
+
+	buff, err := gpm.Marshal(hdr)
+	assert.Nil(t, err)
+
+	recovered := &block.Header{}
+	err = gpm.Unmarshal(recovered, buff)
+	assert.Nil(t, err)
+	assert.Equal(t, hdr, recovered)
+
+	buffByValue, err := gpm.Marshal(*hdr)
+	assert.Nil(t, err)
+	assert.Equal(t, buff, buffByValue)
+}
+
+func TestGogoProtoMarshalizer_PartiallyExecutedMiniBlockRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	gpm := &marshal.GogoProtoMarshalizer{}
+	mb := &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx2"), []byte("tx3")},
+		ReceiverShardID: 0,
+		SenderShardID:   1,
+		Type:            block.TxBlock,
+		PartialExecution: &block.PartialExecution{
+			OriginalHash:    []byte("original"),
+			OriginalTxCount: 4,
+			IndexOfFirstTx:  2,
+		},
+	}
+
+	buff, err := gpm.Marshal(mb)
+	assert.Nil(t, err)
+
+	recovered := &block.MiniBlock{}
+	err = gpm.Unmarshal(recovered, buff)
+	assert.Nil(t, err)
+	assert.Equal(t, mb, recovered)
+}
+
+func TestGogoProtoMarshalizer_HeaderWithFeesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	gpm := &marshal.GogoProtoMarshalizer{}
+	hdr := &block.Header{
+		Nonce:           38,
+		Epoch:           3,
+		AccumulatedFees: big.NewInt(1500),
+		BurnedFees:      big.NewInt(600),
+	}
+
+	buff, err := gpm.Marshal(hdr)
+	assert.Nil(t, err)
+
+	recovered := &block.Header{}
+	err = gpm.Unmarshal(recovered, buff)
+	assert.Nil(t, err)
+	assert.Equal(t, hdr, recovered)
+}
+
+func TestGogoProtoMarshalizer_MetaBlockRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	gpm := &marshal.GogoProtoMarshalizer{}
+	metaBlock := &block.MetaBlock{
+		Nonce: 7,
+		Epoch: 1,
+		ShardInfo: []block.ShardData{
+			{
+				ShardId:    1,
+				HeaderHash: []byte("hdr hash"),
+				ShardMiniBlockHeaders: []block.ShardMiniBlockHeader{
+					{Hash: []byte("mb hash"), ReceiverShardId: 0, SenderShardId: 1, TxCount: 3},
+				},
+				TxCount: 3,
+			},
+		},
+		PeerInfo: []block.PeerData{
+			{PublicKey: []byte("pk"), Action: block.PeerRegistrantion, TimeStamp: 11, Value: big.NewInt(-5)},
+		},
+		RootHash:        []byte("root hash"),
+		ChainID:         []byte("chain ID"),
+		SoftwareVersion: []byte("1"),
+	}
+
+	buff, err := gpm.Marshal(metaBlock)
+	assert.Nil(t, err)
+
+	recovered := &block.MetaBlock{}
+	err = gpm.Unmarshal(recovered, buff)
+	assert.Nil(t, err)
+	assert.Equal(t, metaBlock, recovered)
+}
+
+func TestGogoProtoMarshalizer_BodyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	gpm := &marshal.GogoProtoMarshalizer{}
+	body := block.Body{
+		{TxHashes: [][]byte{[]byte("tx1"), []byte("tx2")}, ReceiverShardID: 1, Type: block.TxBlock},
+		{TxHashes: [][]byte{[]byte("reward")}, Type: block.RewardsBlock},
+	}
+
+	buff, err := gpm.Marshal(body)
+	assert.Nil(t, err)
+
+	recovered := block.Body{}
+	err = gpm.Unmarshal(&recovered, buff)
+	assert.Nil(t, err)
+	assert.Equal(t, body, recovered)
+}
+
+func TestGogoProtoMarshalizer_TransactionsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	gpm := &marshal.GogoProtoMarshalizer{}
+
+	tx := &transaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(1000),
+		RcvAddr:   []byte("rcv"),
+		SndAddr:   []byte("snd"),
+		GasPrice:  10,
+		GasLimit:  100,
+		Data:      "data",
+		Signature: []byte("sig"),
+		ChainID:   []byte("chain"),
+		Version:   1,
+	}
+	buff, err := gpm.Marshal(tx)
+	assert.Nil(t, err)
+	recoveredTx := &transaction.Transaction{}
+	err = gpm.Unmarshal(recoveredTx, buff)
+	assert.Nil(t, err)
+	assert.Equal(t, tx, recoveredTx)
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:    2,
+		Value:    big.NewInt(3),
+		RcvAddr:  []byte("rcv"),
+		SndAddr:  []byte("snd"),
+		Data:     "callBack",
+		TxHash:   []byte("tx hash"),
+		CallType: smartContractResult.AsynchronousCallBack,
+	}
+	buff, err = gpm.Marshal(scr)
+	assert.Nil(t, err)
+	recoveredScr := &smartContractResult.SmartContractResult{}
+	err = gpm.Unmarshal(recoveredScr, buff)
+	assert.Nil(t, err)
+	assert.Equal(t, scr, recoveredScr)
+
+	reward := &rewardTx.RewardTx{Round: 5, Epoch: 1, Value: big.NewInt(4), RcvAddr: []byte("rcv"), ShardId: 1}
+	buff, err = gpm.Marshal(reward)
+	assert.Nil(t, err)
+	recoveredReward := &rewardTx.RewardTx{}
+	err = gpm.Unmarshal(recoveredReward, buff)
+	assert.Nil(t, err)
+	assert.Equal(t, reward, recoveredReward)
+}
+
+func TestGogoProtoMarshalizer_BatchRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	gpm := &marshal.GogoProtoMarshalizer{}
+	batch := [][]byte{[]byte("tx1"), []byte("tx2")}
+
+	buff, err := gpm.Marshal(batch)
+	assert.Nil(t, err)
+
+	recovered := make([][]byte, 0)
+	err = gpm.Unmarshal(&recovered, buff)
+	assert.Nil(t, err)
+	assert.Equal(t, batch, recovered)
+}
+
+func TestGogoProtoMarshalizer_ProtoMessageRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	gpm := &marshal.GogoProtoMarshalizer{}
+	collapsedBn := &protobuf.CollapsedBn{EncodedChildren: [][]byte{{1, 2}, {3}}}
+
+	buff, err := gpm.Marshal(collapsedBn)
+	assert.Nil(t, err)
+
+	recovered := &protobuf.CollapsedBn{}
+	err = gpm.Unmarshal(recovered, buff)
+	assert.Nil(t, err)
+	assert.Equal(t, collapsedBn.EncodedChildren, recovered.EncodedChildren)
+}
+
+func TestGogoProtoMarshalizer_ShouldBeSmallerThanJson(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.Header{
+		Nonce:            37,
+		PrevHash:         make([]byte, 32),
+		RandSeed:         make([]byte, 32),
+		Signature:        make([]byte, 48),
+		RootHash:         make([]byte, 32),
+		MiniBlockHeaders: []block.MiniBlockHeader{{Hash: make([]byte, 32), TxCount: 100}},
+	}
+
+	protoBuff, _ := (&marshal.GogoProtoMarshalizer{}).Marshal(hdr)
+	jsonBuff, _ := (&marshal.JsonMarshalizer{}).Marshal(hdr)
+
+	assert.True(t, len(protoBuff) < len(jsonBuff))
+}
@@ -0,0 +1,196 @@
+package accountsExport
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+var log = logger.GetOrCreate("node/accountsExport")
+
+// ArgAccountsExporter holds all dependencies required by the accounts exporter in order to create a new instance
+type ArgAccountsExporter struct {
+	Accounts         state.AccountsAdapter
+	BlockChain       data.ChainHandler
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	AddressConverter state.AddressConverter
+	MaxPageSize      int
+}
+
+// accountsExporter exports the accounts of the shard at the root hash of the last epoch start, so the supply can be
+// reconciled on a state which does not change between the requests of the pages. The root hash is recorded when
+// the epoch changes, from the last block of the previous epoch. If the node started in the middle of an epoch, the
+// epoch start root hash is not known until the next epoch change
+type accountsExporter struct {
+	accounts         state.AccountsAdapter
+	blockChain       data.ChainHandler
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	addressConverter state.AddressConverter
+	maxPageSize      int
+
+	mutRootHash sync.RWMutex
+	epoch       uint32
+	rootHash    []byte
+}
+
+// NewAccountsExporter creates a new accounts exporter
+func NewAccountsExporter(args ArgAccountsExporter) (*accountsExporter, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+	if args.MaxPageSize <= 0 {
+		return nil, ErrInvalidMaxPageSize
+	}
+
+	return &accountsExporter{
+		accounts:         args.Accounts,
+		blockChain:       args.BlockChain,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		addressConverter: args.AddressConverter,
+		maxPageSize:      args.MaxPageSize,
+	}, nil
+}
+
+// EpochConfirmed records the root hash of the state the provided epoch started with
+func (ae *accountsExporter) EpochConfirmed(epoch uint32) {
+	var rootHash []byte
+	currentHeader := ae.blockChain.GetCurrentBlockHeader()
+	switch {
+	case epoch == 0:
+		genesisHeader := ae.blockChain.GetGenesisHeader()
+		if !check.IfNil(genesisHeader) {
+			rootHash = genesisHeader.GetRootHash()
+		}
+	case !check.IfNil(currentHeader) && currentHeader.GetEpoch() < epoch:
+		rootHash = currentHeader.GetRootHash()
+	}
+
+	if len(rootHash) == 0 {
+		log.Debug("epoch start root hash not available for the accounts export", "epoch", epoch)
+	}
+
+	ae.mutRootHash.Lock()
+	ae.epoch = epoch
+	ae.rootHash = rootHash
+	ae.mutRootHash.Unlock()
+}
+
+// ExportAccounts returns the page of accounts following the one the token was returned with. An empty token requests
+// the first page, a zero page size requests a page of maximum size. The trie leaves are iterated in the order of their
+// keys, so each page is found by skipping the leaves up to the token, which is the address of the previous page's
+// last account
+func (ae *accountsExporter) ExportAccounts(token string, pageSize int) (*external.AccountsExportPage, error) {
+	if pageSize < 0 || pageSize > ae.maxPageSize {
+		return nil, ErrInvalidPageSize
+	}
+	if pageSize == 0 {
+		pageSize = ae.maxPageSize
+	}
+
+	lastAddress, err := hex.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	ae.mutRootHash.RLock()
+	epoch := ae.epoch
+	rootHash := ae.rootHash
+	ae.mutRootHash.RUnlock()
+	if len(rootHash) == 0 {
+		return nil, ErrEpochStartRootHashNotAvailable
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannel, err := ae.accounts.GetAllLeavesOnChannel(ctx, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &external.AccountsExportPage{
+		Epoch:    epoch,
+		RootHash: hex.EncodeToString(rootHash),
+		Accounts: make([]*external.ExportedAccount, 0, pageSize),
+	}
+	for leaf := range leavesChannel {
+		if len(lastAddress) > 0 && bytes.Compare(leaf.Key(), lastAddress) <= 0 {
+			continue
+		}
+
+		account := ae.decodeAccount(leaf)
+		if account == nil {
+			continue
+		}
+		if len(page.Accounts) == pageSize {
+			page.NextToken = page.Accounts[pageSize-1].Address
+			break
+		}
+
+		page.Accounts = append(page.Accounts, account)
+	}
+
+	return page, nil
+}
+
+// decodeAccount returns nil for the leaves of the accounts trie which do not hold accounts: the smart contracts code,
+// saved under its hash, and the code references counters
+func (ae *accountsExporter) decodeAccount(leaf data.KeyValueHolder) *external.ExportedAccount {
+	if len(leaf.Key()) != ae.addressConverter.AddressLen() {
+		return nil
+	}
+	if bytes.Equal(ae.hasher.Compute(string(leaf.Value())), leaf.Key()) {
+		return nil
+	}
+
+	account := &state.Account{}
+	err := ae.marshalizer.Unmarshal(account, leaf.Value())
+	if err != nil {
+		log.Debug("cannot decode the account for the accounts export", "error", err.Error())
+		return nil
+	}
+
+	balance := "0"
+	if account.Balance != nil {
+		balance = account.Balance.String()
+	}
+
+	return &external.ExportedAccount{
+		Address:  hex.EncodeToString(leaf.Key()),
+		Balance:  balance,
+		Nonce:    account.Nonce,
+		CodeHash: hex.EncodeToString(account.CodeHash),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ae *accountsExporter) IsInterfaceNil() bool {
+	if ae == nil {
+		return true
+	}
+	return false
+}
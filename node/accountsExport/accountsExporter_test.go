@@ -0,0 +1,262 @@
+package accountsExport_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/node/accountsExport"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const addressLen = 32
+
+var genesisRootHash = []byte("genesis root hash")
+
+func createAddress(b byte) []byte {
+	return bytes.Repeat([]byte{b}, addressLen)
+}
+
+func createLeaves() []data.KeyValueHolder {
+	code := []byte("smart contract code")
+	codeHash := sha256.Sum256(code)
+	leaves := make([]data.KeyValueHolder, 0)
+	for i, b := range []byte{'a', 'b'} {
+		buff, _ := json.Marshal(&state.Account{Nonce: uint64(i), Balance: big.NewInt(int64(10 * (i + 1)))})
+		leaves = append(leaves, trie.NewKeyValStorage(createAddress(b), buff))
+	}
+	leaves = append(leaves, trie.NewKeyValStorage(codeHash[:], code))
+	leaves = append(leaves, trie.NewKeyValStorage(append(codeHash[:], []byte("refs")...), []byte{1}))
+	buff, _ := json.Marshal(&state.Account{Nonce: 5, Balance: big.NewInt(30), CodeHash: codeHash[:]})
+	leaves = append(leaves, trie.NewKeyValStorage(createAddress('c'), buff))
+
+	return leaves
+}
+
+func createMockArgs(leaves []data.KeyValueHolder) accountsExport.ArgAccountsExporter {
+	return accountsExport.ArgAccountsExporter{
+		Accounts: &mock.AccountsStub{
+			GetAllLeavesOnChannelCalled: func(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error) {
+				leavesChannel := make(chan data.KeyValueHolder)
+				go func() {
+					defer close(leavesChannel)
+					for _, leaf := range leaves {
+						select {
+						case leavesChannel <- leaf:
+						case <-ctx.Done():
+							return
+						}
+					}
+				}()
+
+				return leavesChannel, nil
+			},
+		},
+		BlockChain: &mock.BlockChainMock{
+			GetGenesisHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: genesisRootHash}
+			},
+		},
+		Marshalizer:      &mock.MarshalizerFake{},
+		Hasher:           &mock.HasherFake{},
+		AddressConverter: mock.NewAddressConverterFake(addressLen, ""),
+		MaxPageSize:      2,
+	}
+}
+
+//------- NewAccountsExporter
+
+func TestNewAccountsExporter_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.Accounts = nil
+	ae, err := accountsExport.NewAccountsExporter(args)
+
+	assert.Nil(t, ae)
+	assert.Equal(t, accountsExport.ErrNilAccountsAdapter, err)
+}
+
+func TestNewAccountsExporter_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.BlockChain = nil
+	ae, err := accountsExport.NewAccountsExporter(args)
+
+	assert.Nil(t, ae)
+	assert.Equal(t, accountsExport.ErrNilBlockChain, err)
+}
+
+func TestNewAccountsExporter_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.Marshalizer = nil
+	ae, err := accountsExport.NewAccountsExporter(args)
+
+	assert.Nil(t, ae)
+	assert.Equal(t, accountsExport.ErrNilMarshalizer, err)
+}
+
+func TestNewAccountsExporter_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.Hasher = nil
+	ae, err := accountsExport.NewAccountsExporter(args)
+
+	assert.Nil(t, ae)
+	assert.Equal(t, accountsExport.ErrNilHasher, err)
+}
+
+func TestNewAccountsExporter_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.AddressConverter = nil
+	ae, err := accountsExport.NewAccountsExporter(args)
+
+	assert.Nil(t, ae)
+	assert.Equal(t, accountsExport.ErrNilAddressConverter, err)
+}
+
+func TestNewAccountsExporter_InvalidMaxPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.MaxPageSize = 0
+	ae, err := accountsExport.NewAccountsExporter(args)
+
+	assert.Nil(t, ae)
+	assert.Equal(t, accountsExport.ErrInvalidMaxPageSize, err)
+}
+
+func TestNewAccountsExporter_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ae, err := accountsExport.NewAccountsExporter(createMockArgs(nil))
+
+	assert.Nil(t, err)
+	assert.False(t, ae.IsInterfaceNil())
+}
+
+//------- EpochConfirmed
+
+func TestAccountsExporter_ExportBeforeAnyEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ae, _ := accountsExport.NewAccountsExporter(createMockArgs(createLeaves()))
+
+	page, err := ae.ExportAccounts("", 0)
+	assert.Nil(t, page)
+	assert.Equal(t, accountsExport.ErrEpochStartRootHashNotAvailable, err)
+}
+
+func TestAccountsExporter_EpochConfirmedShouldRecordTheLastRootHashOfThePreviousEpoch(t *testing.T) {
+	t.Parallel()
+
+	var exportedRootHash []byte
+	currentHeader := &block.Header{Epoch: 3, RootHash: []byte("epoch 3 end root hash")}
+	args := createMockArgs(nil)
+	args.Accounts = &mock.AccountsStub{
+		GetAllLeavesOnChannelCalled: func(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error) {
+			exportedRootHash = rootHash
+			leavesChannel := make(chan data.KeyValueHolder)
+			close(leavesChannel)
+			return leavesChannel, nil
+		},
+	}
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHeader
+		},
+	}
+	ae, _ := accountsExport.NewAccountsExporter(args)
+
+	ae.EpochConfirmed(4)
+	page, err := ae.ExportAccounts("", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(4), page.Epoch)
+	assert.Equal(t, hex.EncodeToString(currentHeader.RootHash), page.RootHash)
+	assert.Equal(t, currentHeader.RootHash, exportedRootHash)
+}
+
+func TestAccountsExporter_EpochConfirmedInTheMiddleOfTheEpochShouldNotRecordTheRootHash(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Epoch: 4, RootHash: []byte("root hash")}
+		},
+	}
+	ae, _ := accountsExport.NewAccountsExporter(args)
+
+	ae.EpochConfirmed(4)
+	page, err := ae.ExportAccounts("", 0)
+	assert.Nil(t, page)
+	assert.Equal(t, accountsExport.ErrEpochStartRootHashNotAvailable, err)
+}
+
+//------- ExportAccounts
+
+func TestAccountsExporter_ExportAccountsInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ae, _ := accountsExport.NewAccountsExporter(createMockArgs(createLeaves()))
+	ae.EpochConfirmed(0)
+
+	_, err := ae.ExportAccounts("", 3)
+	assert.Equal(t, accountsExport.ErrInvalidPageSize, err)
+	_, err = ae.ExportAccounts("", -1)
+	assert.Equal(t, accountsExport.ErrInvalidPageSize, err)
+	_, err = ae.ExportAccounts("not hex", 1)
+	assert.Equal(t, accountsExport.ErrInvalidToken, err)
+}
+
+func TestAccountsExporter_ExportAccountsShouldReturnTheAccountsPageByPage(t *testing.T) {
+	t.Parallel()
+
+	ae, _ := accountsExport.NewAccountsExporter(createMockArgs(createLeaves()))
+	ae.EpochConfirmed(0)
+
+	page, err := ae.ExportAccounts("", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(genesisRootHash), page.RootHash)
+	assert.Equal(t, 2, len(page.Accounts))
+	assert.Equal(t, hex.EncodeToString(createAddress('a')), page.Accounts[0].Address)
+	assert.Equal(t, "10", page.Accounts[0].Balance)
+	assert.Equal(t, hex.EncodeToString(createAddress('b')), page.Accounts[1].Address)
+	assert.Equal(t, uint64(1), page.Accounts[1].Nonce)
+	assert.Equal(t, page.Accounts[1].Address, page.NextToken)
+
+	page, err = ae.ExportAccounts(page.NextToken, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(page.Accounts))
+	assert.Equal(t, hex.EncodeToString(createAddress('c')), page.Accounts[0].Address)
+	assert.Equal(t, "30", page.Accounts[0].Balance)
+	assert.NotEqual(t, "", page.Accounts[0].CodeHash)
+	assert.Equal(t, "", page.NextToken)
+}
+
+func TestAccountsExporter_ExportAccountsLastFullPageShouldNotReturnAToken(t *testing.T) {
+	t.Parallel()
+
+	ae, _ := accountsExport.NewAccountsExporter(createMockArgs(createLeaves()))
+	ae.EpochConfirmed(0)
+
+	page, err := ae.ExportAccounts(hex.EncodeToString(createAddress('a')), 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(page.Accounts))
+	assert.Equal(t, "", page.NextToken)
+}
@@ -0,0 +1,30 @@
+package accountsExport
+
+import "errors"
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilBlockChain signals that a nil block chain has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrInvalidMaxPageSize signals that an invalid maximum page size has been provided
+var ErrInvalidMaxPageSize = errors.New("invalid maximum page size")
+
+// ErrInvalidPageSize signals that an invalid page size has been requested
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrInvalidToken signals that an invalid pagination token has been provided
+var ErrInvalidToken = errors.New("invalid pagination token")
+
+// ErrEpochStartRootHashNotAvailable signals that the root hash of the current epoch start is not known by the node
+var ErrEpochStartRootHashNotAvailable = errors.New("epoch start root hash not available")
@@ -0,0 +1,151 @@
+package auction
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+const qualifiedStatus = "qualified"
+
+// ArgAuctionListQuerier holds all dependencies required by the auction list querier in order to create a new instance
+type ArgAuctionListQuerier struct {
+	ScDataGetter     ScDataGetter
+	AddressConverter state.AddressConverter
+	StakingSCAddress []byte
+	PageSize         int
+}
+
+// auctionListQuerier builds the auction list from the staking contract state. The selection of the qualified nodes
+// is the one of the staking contract itself, which returns, for each owner, the outcome of its next qualified nodes
+// update
+type auctionListQuerier struct {
+	scDataGetter     ScDataGetter
+	addressConverter state.AddressConverter
+	stakingSCAddress []byte
+	pageSize         int
+}
+
+// NewAuctionListQuerier creates a new auction list querier
+func NewAuctionListQuerier(args ArgAuctionListQuerier) (*auctionListQuerier, error) {
+	if check.IfNil(args.ScDataGetter) {
+		return nil, ErrNilScDataGetter
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+	if len(args.StakingSCAddress) == 0 {
+		return nil, ErrEmptyStakingSCAddress
+	}
+	if args.PageSize <= 0 {
+		return nil, ErrInvalidPageSize
+	}
+
+	return &auctionListQuerier{
+		scDataGetter:     args.ScDataGetter,
+		addressConverter: args.AddressConverter,
+		stakingSCAddress: args.StakingSCAddress,
+		pageSize:         args.PageSize,
+	}, nil
+}
+
+// GetAuctionList returns all the owners which staked nodes, read from the staking contract one page at a time
+func (alq *auctionListQuerier) GetAuctionList() (*external.AuctionList, error) {
+	auctionList := &external.AuctionList{
+		Participants: make([]*external.AuctionParticipant, 0),
+	}
+
+	startIndex := uint64(0)
+	for {
+		values, err := alq.scDataGetter.GetAll(
+			alq.stakingSCAddress,
+			"getAuctionList",
+			big.NewInt(0).SetUint64(startIndex).Bytes(),
+			big.NewInt(int64(alq.pageSize)).Bytes(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) < 2 {
+			return nil, ErrInvalidContractResponse
+		}
+
+		numOwners := big.NewInt(0).SetBytes(values[0]).Uint64()
+		auctionList.NodePrice = big.NewInt(0).SetBytes(values[1]).String()
+
+		participants, err := alq.parseParticipants(values[2:])
+		if err != nil {
+			return nil, err
+		}
+
+		auctionList.Participants = append(auctionList.Participants, participants...)
+		startIndex += uint64(len(participants))
+		if len(participants) == 0 || startIndex >= numOwners {
+			return auctionList, nil
+		}
+	}
+}
+
+// parseParticipants parses the owners returned by the staking contract: the owner, its total stake, its top-up per
+// qualified node and the number of its BLS keys, followed by each BLS key and its status
+func (alq *auctionListQuerier) parseParticipants(values [][]byte) ([]*external.AuctionParticipant, error) {
+	participants := make([]*external.AuctionParticipant, 0)
+	for len(values) > 0 {
+		if len(values) < 4 {
+			return nil, ErrInvalidContractResponse
+		}
+
+		numKeys := big.NewInt(0).SetBytes(values[3]).Uint64()
+		participant := &external.AuctionParticipant{
+			Owner:        alq.encodeAddress(values[0]),
+			TotalStake:   big.NewInt(0).SetBytes(values[1]).String(),
+			TopUpPerNode: big.NewInt(0).SetBytes(values[2]).String(),
+			Nodes:        make([]*external.AuctionNode, 0, numKeys),
+		}
+		values = values[4:]
+		if uint64(len(values)) < 2*numKeys {
+			return nil, ErrInvalidContractResponse
+		}
+
+		for i := uint64(0); i < numKeys; i++ {
+			qualified := string(values[2*i+1]) == qualifiedStatus
+			if qualified {
+				participant.NumQualifiedNodes++
+			}
+
+			participant.Nodes = append(participant.Nodes, &external.AuctionNode{
+				BlsKey:    hex.EncodeToString(values[2*i]),
+				Qualified: qualified,
+			})
+		}
+		values = values[2*numKeys:]
+
+		participants = append(participants, participant)
+	}
+
+	return participants, nil
+}
+
+// encodeAddress hex encodes an address returned by the system VM, restoring the leading zero bytes dropped by the
+// big int conversion of the returned values
+func (alq *auctionListQuerier) encodeAddress(address []byte) string {
+	addressLen := alq.addressConverter.AddressLen()
+	if len(address) < addressLen {
+		padded := make([]byte, addressLen)
+		copy(padded[addressLen-len(address):], address)
+		address = padded
+	}
+
+	return hex.EncodeToString(address)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (alq *auctionListQuerier) IsInterfaceNil() bool {
+	if alq == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,197 @@
+package auction_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/node/auction"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const addressLen = 4
+
+var stakingSCAddress = []byte("stkg")
+
+func createMockArgs() auction.ArgAuctionListQuerier {
+	return auction.ArgAuctionListQuerier{
+		ScDataGetter:     &mock.ScDataGetterStub{},
+		AddressConverter: mock.NewAddressConverterFake(addressLen, ""),
+		StakingSCAddress: stakingSCAddress,
+		PageSize:         1,
+	}
+}
+
+// createStakingContract returns a SC data getter answering the auction list queries of a staking contract with the
+// provided owners, each one given as its owner, total stake, top-up per node and BLS key and status pairs
+func createStakingContract(nodePrice int64, owners [][][]byte) *mock.ScDataGetterStub {
+	return &mock.ScDataGetterStub{
+		GetAllCalled: func(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+			if funcName != "getAuctionList" {
+				return nil, errors.New("unexpected function")
+			}
+
+			start := big.NewInt(0).SetBytes(args[0]).Uint64()
+			end := start + big.NewInt(0).SetBytes(args[1]).Uint64()
+			values := [][]byte{big.NewInt(int64(len(owners))).Bytes(), big.NewInt(nodePrice).Bytes()}
+			for i := start; i < end && i < uint64(len(owners)); i++ {
+				owner := owners[i]
+				values = append(values, owner[:3]...)
+				values = append(values, big.NewInt(int64(len(owner[3:])/2)).Bytes())
+				values = append(values, owner[3:]...)
+			}
+			return values, nil
+		},
+	}
+}
+
+//------- NewAuctionListQuerier
+
+func TestNewAuctionListQuerier_NilScDataGetterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ScDataGetter = nil
+	alq, err := auction.NewAuctionListQuerier(args)
+
+	assert.True(t, alq == nil)
+	assert.Equal(t, auction.ErrNilScDataGetter, err)
+}
+
+func TestNewAuctionListQuerier_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.AddressConverter = nil
+	alq, err := auction.NewAuctionListQuerier(args)
+
+	assert.True(t, alq == nil)
+	assert.Equal(t, auction.ErrNilAddressConverter, err)
+}
+
+func TestNewAuctionListQuerier_EmptyStakingSCAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.StakingSCAddress = nil
+	alq, err := auction.NewAuctionListQuerier(args)
+
+	assert.True(t, alq == nil)
+	assert.Equal(t, auction.ErrEmptyStakingSCAddress, err)
+}
+
+func TestNewAuctionListQuerier_InvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.PageSize = 0
+	alq, err := auction.NewAuctionListQuerier(args)
+
+	assert.True(t, alq == nil)
+	assert.Equal(t, auction.ErrInvalidPageSize, err)
+}
+
+func TestNewAuctionListQuerier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	alq, err := auction.NewAuctionListQuerier(createMockArgs())
+
+	assert.False(t, alq == nil)
+	assert.Nil(t, err)
+}
+
+//------- GetAuctionList
+
+func TestAuctionListQuerier_GetAuctionListShouldReadAllThePages(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ScDataGetter = createStakingContract(100, [][][]byte{
+		{{1, 2}, big.NewInt(250).Bytes(), big.NewInt(25).Bytes(),
+			[]byte("key1"), []byte("qualified"), []byte("key2"), []byte("qualified"), []byte("key3"), []byte("notQualified")},
+		{[]byte("own2"), big.NewInt(50).Bytes(), big.NewInt(0).Bytes(),
+			[]byte("key4"), []byte("notQualified")},
+	})
+	alq, _ := auction.NewAuctionListQuerier(args)
+
+	auctionList, err := alq.GetAuctionList()
+
+	assert.Nil(t, err)
+	assert.Equal(t, &external.AuctionList{
+		NodePrice: "100",
+		Participants: []*external.AuctionParticipant{
+			{
+				Owner:             "00000102",
+				TotalStake:        "250",
+				TopUpPerNode:      "25",
+				NumQualifiedNodes: 2,
+				Nodes: []*external.AuctionNode{
+					{BlsKey: hex.EncodeToString([]byte("key1")), Qualified: true},
+					{BlsKey: hex.EncodeToString([]byte("key2")), Qualified: true},
+					{BlsKey: hex.EncodeToString([]byte("key3")), Qualified: false},
+				},
+			},
+			{
+				Owner:             hex.EncodeToString([]byte("own2")),
+				TotalStake:        "50",
+				TopUpPerNode:      "0",
+				NumQualifiedNodes: 0,
+				Nodes: []*external.AuctionNode{
+					{BlsKey: hex.EncodeToString([]byte("key4")), Qualified: false},
+				},
+			},
+		},
+	}, auctionList)
+}
+
+func TestAuctionListQuerier_GetAuctionListNoOwnersShouldReturnEmptyList(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ScDataGetter = createStakingContract(100, make([][][]byte, 0))
+	alq, _ := auction.NewAuctionListQuerier(args)
+
+	auctionList, err := alq.GetAuctionList()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "100", auctionList.NodePrice)
+	assert.Equal(t, 0, len(auctionList.Participants))
+}
+
+func TestAuctionListQuerier_GetAuctionListQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errQuery := errors.New("query error")
+	args := createMockArgs()
+	args.ScDataGetter = &mock.ScDataGetterStub{
+		GetAllCalled: func(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+			return nil, errQuery
+		},
+	}
+	alq, _ := auction.NewAuctionListQuerier(args)
+
+	auctionList, err := alq.GetAuctionList()
+
+	assert.Nil(t, auctionList)
+	assert.Equal(t, errQuery, err)
+}
+
+func TestAuctionListQuerier_GetAuctionListTruncatedResponseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ScDataGetter = &mock.ScDataGetterStub{
+		GetAllCalled: func(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+			return [][]byte{{1}, {100}, []byte("own1"), {100}, {0}, {2}, []byte("key1"), []byte("qualified")}, nil
+		},
+	}
+	alq, _ := auction.NewAuctionListQuerier(args)
+
+	auctionList, err := alq.GetAuctionList()
+
+	assert.Nil(t, auctionList)
+	assert.Equal(t, auction.ErrInvalidContractResponse, err)
+}
@@ -0,0 +1,18 @@
+package auction
+
+import "errors"
+
+// ErrNilScDataGetter signals that a nil SC data getter has been provided
+var ErrNilScDataGetter = errors.New("nil SC data getter")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrEmptyStakingSCAddress signals that an empty staking contract address has been provided
+var ErrEmptyStakingSCAddress = errors.New("empty staking contract address")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrInvalidContractResponse signals that the staking contract returned an unexpected sequence of values
+var ErrInvalidContractResponse = errors.New("invalid staking contract response")
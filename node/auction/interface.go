@@ -0,0 +1,7 @@
+package auction
+
+// ScDataGetter defines how all the values returned by a SC function can be fetched
+type ScDataGetter interface {
+	GetAll(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error)
+	IsInterfaceNil() bool
+}
@@ -14,8 +14,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -63,6 +65,83 @@ func WithAccountsAdapter(accounts state.AccountsAdapter) Option {
 	}
 }
 
+// WithHistoricalAccounts sets up the provider used to query the accounts state at past blocks
+func WithHistoricalAccounts(historicalAccounts state.HistoricalAccountsProvider) Option {
+	return func(n *Node) error {
+		if historicalAccounts == nil || historicalAccounts.IsInterfaceNil() {
+			return ErrNilHistoricalAccountsProvider
+		}
+		n.historicalAccounts = historicalAccounts
+		return nil
+	}
+}
+
+// WithHistoryRepository sets up the repository holding the secondary indexes written when the blocks are committed
+func WithHistoryRepository(historyRepository dblookupext.HistoryRepository) Option {
+	return func(n *Node) error {
+		if historyRepository == nil || historyRepository.IsInterfaceNil() {
+			return ErrNilHistoryRepository
+		}
+		n.historyRepository = historyRepository
+		return nil
+	}
+}
+
+// WithAccountsRepository sets up the repository answering the accounts queries on the committed blocks
+func WithAccountsRepository(accountsRepository AccountsRepository) Option {
+	return func(n *Node) error {
+		if accountsRepository == nil || accountsRepository.IsInterfaceNil() {
+			return ErrNilAccountsRepository
+		}
+		n.accountsRepository = accountsRepository
+		return nil
+	}
+}
+
+// WithOutgoingOperations sets up the handler collecting the outgoing operations of the committed blocks
+func WithOutgoingOperations(outgoingOperations process.OutgoingOperationsHandler) Option {
+	return func(n *Node) error {
+		if outgoingOperations == nil || outgoingOperations.IsInterfaceNil() {
+			return ErrNilOutgoingOperationsHandler
+		}
+		n.outgoingOperations = outgoingOperations
+		return nil
+	}
+}
+
+// WithSelectionAuditor sets up the auditor of the leaders and consensus groups selected in the own shard
+func WithSelectionAuditor(selectionAuditor process.ValidatorsSelectionAuditor) Option {
+	return func(n *Node) error {
+		if selectionAuditor == nil || selectionAuditor.IsInterfaceNil() {
+			return ErrNilValidatorsSelectionAuditor
+		}
+		n.selectionAuditor = selectionAuditor
+		return nil
+	}
+}
+
+// WithSupplyAccountant sets up the counters of the value minted and burned by the own shard
+func WithSupplyAccountant(supplyAccountant process.SupplyAccountant) Option {
+	return func(n *Node) error {
+		if supplyAccountant == nil || supplyAccountant.IsInterfaceNil() {
+			return ErrNilSupplyAccountant
+		}
+		n.supplyAccountant = supplyAccountant
+		return nil
+	}
+}
+
+// WithESDTSupplyTracker sets up the tracker of the token supply changes made by the own shard
+func WithESDTSupplyTracker(esdtSupplyTracker process.ESDTSupplyTracker) Option {
+	return func(n *Node) error {
+		if esdtSupplyTracker == nil || esdtSupplyTracker.IsInterfaceNil() {
+			return ErrNilESDTSupplyTracker
+		}
+		n.esdtSupplyTracker = esdtSupplyTracker
+		return nil
+	}
+}
+
 // WithAddressConverter sets up the address converter adapter option for the Node
 func WithAddressConverter(addrConverter state.AddressConverter) Option {
 	return func(n *Node) error {
@@ -363,6 +442,15 @@ func WithConsensusType(consensusType string) Option {
 	}
 }
 
+// WithMaxBlockCreationRoundTimePercent sets up the percentage of the round time after which the leader stops adding
+// data to the block it proposes. A zero value does not limit the block creation more than its subround
+func WithMaxBlockCreationRoundTimePercent(maxBlockCreationRoundTimePercent uint32) Option {
+	return func(n *Node) error {
+		n.maxBlockCreationRoundTimePercent = maxBlockCreationRoundTimePercent
+		return nil
+	}
+}
+
 // WithTxStorageSize sets up a txStorageSize option for the Node
 func WithTxStorageSize(txStorageSize uint32) Option {
 	return func(n *Node) error {
@@ -398,3 +486,115 @@ func WithIndexer(indexer indexer.Indexer) Option {
 		return nil
 	}
 }
+
+// WithPendingMiniBlocksHandler sets up the tracker of the cross shard miniblocks not yet processed by their
+// destination shards. It is available only on the metachain nodes
+func WithPendingMiniBlocksHandler(pendingMiniBlocks process.PendingMiniBlocksHandler) Option {
+	return func(n *Node) error {
+		if pendingMiniBlocks == nil || pendingMiniBlocks.IsInterfaceNil() {
+			return ErrNilPendingMiniBlocksHandler
+		}
+		n.pendingMiniBlocks = pendingMiniBlocks
+		return nil
+	}
+}
+
+// WithEconomicsData sets up the economics data option for the Node
+func WithEconomicsData(economicsData EconomicsHandler) Option {
+	return func(n *Node) error {
+		if economicsData == nil || economicsData.IsInterfaceNil() {
+			return ErrNilEconomicsData
+		}
+		n.economicsData = economicsData
+		return nil
+	}
+}
+
+// WithGenesisTotalSupply sets up the total supply defined in the genesis file, summed over all the shards
+func WithGenesisTotalSupply(totalSupply *big.Int) Option {
+	return func(n *Node) error {
+		if totalSupply == nil {
+			return ErrNilTotalSupply
+		}
+		n.genesisTotalSupply = big.NewInt(0).Set(totalSupply)
+		return nil
+	}
+}
+
+// WithGenesisChecksum sets up the checksum of the genesis configuration the node started from
+func WithGenesisChecksum(genesisChecksum []byte) Option {
+	return func(n *Node) error {
+		if len(genesisChecksum) == 0 {
+			return ErrEmptyGenesisChecksum
+		}
+		n.genesisChecksum = genesisChecksum
+		return nil
+	}
+}
+
+// WithChainID sets up the identifier of the chain the Node is part of
+func WithChainID(chainID string) Option {
+	return func(n *Node) error {
+		if len(chainID) == 0 {
+			return ErrEmptyChainID
+		}
+		n.chainID = chainID
+		return nil
+	}
+}
+
+// WithTxSignKeyGen sets up the key generator used for the transactions signatures
+func WithTxSignKeyGen(txSignKeyGen crypto.KeyGenerator) Option {
+	return func(n *Node) error {
+		if txSignKeyGen == nil || txSignKeyGen.IsInterfaceNil() {
+			return ErrNilTxSignKeyGen
+		}
+		n.txSignKeyGen = txSignKeyGen
+		return nil
+	}
+}
+
+// WithTxFeeHandler sets up the fee handler used for validating the transactions economics values
+func WithTxFeeHandler(txFeeHandler process.FeeHandler) Option {
+	return func(n *Node) error {
+		if txFeeHandler == nil || txFeeHandler.IsInterfaceNil() {
+			return ErrNilTxFeeHandler
+		}
+		n.txFeeHandler = txFeeHandler
+		return nil
+	}
+}
+
+// WithInterceptedDataLimitsChecker sets up the checker of the limits applied to the intercepted data, so that the
+// transactions sent through the node are validated against the same limits as the ones received from the network
+func WithInterceptedDataLimitsChecker(limitsChecker process.InterceptedDataLimitsChecker) Option {
+	return func(n *Node) error {
+		if limitsChecker == nil || limitsChecker.IsInterfaceNil() {
+			return ErrNilInterceptedDataLimitsChecker
+		}
+		n.limitsChecker = limitsChecker
+		return nil
+	}
+}
+
+// WithAlarmScheduler sets up the alarm scheduler used for the time based callbacks of the node's subsystems
+func WithAlarmScheduler(alarmScheduler core.TimersScheduler) Option {
+	return func(n *Node) error {
+		if alarmScheduler == nil || alarmScheduler.IsInterfaceNil() {
+			return ErrNilAlarmScheduler
+		}
+		n.alarmScheduler = alarmScheduler
+		return nil
+	}
+}
+
+// WithPeerMaintenanceHandler sets up the handler notified when a peer announces a maintenance window
+func WithPeerMaintenanceHandler(handler heartbeat.PeerMaintenanceHandler) Option {
+	return func(n *Node) error {
+		if handler == nil || handler.IsInterfaceNil() {
+			return ErrNilPeerMaintenanceHandler
+		}
+		n.peerMaintenanceHandler = handler
+		return nil
+	}
+}
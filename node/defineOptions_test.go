@@ -115,6 +115,136 @@ func TestWithAccountsAdapter_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithHistoricalAccounts_NilProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithHistoricalAccounts(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.historicalAccounts)
+	assert.Equal(t, ErrNilHistoricalAccountsProvider, err)
+}
+
+func TestWithHistoricalAccounts_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	historicalAccounts := &mock.HistoricalAccountsProviderStub{}
+
+	opt := WithHistoricalAccounts(historicalAccounts)
+	err := opt(node)
+
+	assert.True(t, node.historicalAccounts == historicalAccounts)
+	assert.Nil(t, err)
+}
+
+func TestWithHistoryRepository_NilRepositoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithHistoryRepository(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.historyRepository)
+	assert.Equal(t, ErrNilHistoryRepository, err)
+}
+
+func TestWithHistoryRepository_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	historyRepository := &mock.HistoryRepositoryStub{}
+
+	opt := WithHistoryRepository(historyRepository)
+	err := opt(node)
+
+	assert.True(t, node.historyRepository == historyRepository)
+	assert.Nil(t, err)
+}
+
+func TestWithAccountsRepository_NilRepositoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithAccountsRepository(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.accountsRepository)
+	assert.Equal(t, ErrNilAccountsRepository, err)
+}
+
+func TestWithAccountsRepository_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	accountsRepository := &mock.AccountsRepositoryStub{}
+
+	opt := WithAccountsRepository(accountsRepository)
+	err := opt(node)
+
+	assert.True(t, node.accountsRepository == accountsRepository)
+	assert.Nil(t, err)
+}
+
+func TestWithOutgoingOperations_NilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithOutgoingOperations(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.outgoingOperations)
+	assert.Equal(t, ErrNilOutgoingOperationsHandler, err)
+}
+
+func TestWithOutgoingOperations_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	outgoingOperations := &mock.OutgoingOperationsHandlerStub{}
+
+	opt := WithOutgoingOperations(outgoingOperations)
+	err := opt(node)
+
+	assert.True(t, node.outgoingOperations == outgoingOperations)
+	assert.Nil(t, err)
+}
+
+func TestWithSelectionAuditor_NilAuditorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithSelectionAuditor(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.selectionAuditor)
+	assert.Equal(t, ErrNilValidatorsSelectionAuditor, err)
+}
+
+func TestWithSelectionAuditor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	selectionAuditor := &mock.ValidatorsSelectionAuditorStub{}
+
+	opt := WithSelectionAuditor(selectionAuditor)
+	err := opt(node)
+
+	assert.True(t, node.selectionAuditor == selectionAuditor)
+	assert.Nil(t, err)
+}
+
 func TestWithAddressConverter_NilConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -767,6 +897,18 @@ func TestWithConsensusBls_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithMaxBlockCreationRoundTimePercent(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithMaxBlockCreationRoundTimePercent(20)
+	err := opt(node)
+
+	assert.Equal(t, uint32(20), node.maxBlockCreationRoundTimePercent)
+	assert.Nil(t, err)
+}
+
 func TestWithAppStatusHandler_NilAshShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -802,3 +944,227 @@ func TestWithIndexer_ShouldWork(t *testing.T) {
 	assert.Equal(t, indexer, node.indexer)
 	assert.Nil(t, err)
 }
+
+func TestWithPendingMiniBlocksHandler_NilPendingMiniBlocksHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithPendingMiniBlocksHandler(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.pendingMiniBlocks)
+	assert.Equal(t, ErrNilPendingMiniBlocksHandler, err)
+}
+
+func TestWithPendingMiniBlocksHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	pendingMiniBlocks := &mock.PendingMiniBlocksHandlerStub{}
+	opt := WithPendingMiniBlocksHandler(pendingMiniBlocks)
+	err := opt(node)
+
+	assert.True(t, node.pendingMiniBlocks == pendingMiniBlocks)
+	assert.Nil(t, err)
+}
+
+func TestWithEconomicsData_NilEconomicsDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEconomicsData(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.economicsData)
+	assert.Equal(t, ErrNilEconomicsData, err)
+}
+
+func TestWithEconomicsData_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	economicsData := &mock.EconomicsHandlerStub{}
+	opt := WithEconomicsData(economicsData)
+	err := opt(node)
+
+	assert.True(t, node.economicsData == economicsData)
+	assert.Nil(t, err)
+}
+
+func TestWithGenesisTotalSupply_NilTotalSupplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithGenesisTotalSupply(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.genesisTotalSupply)
+	assert.Equal(t, ErrNilTotalSupply, err)
+}
+
+func TestWithGenesisTotalSupply_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	totalSupply := big.NewInt(1000)
+	opt := WithGenesisTotalSupply(totalSupply)
+	err := opt(node)
+
+	assert.Equal(t, totalSupply, node.genesisTotalSupply)
+	assert.Nil(t, err)
+}
+
+func TestWithGenesisChecksum_EmptyChecksumShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithGenesisChecksum(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.genesisChecksum)
+	assert.Equal(t, ErrEmptyGenesisChecksum, err)
+}
+
+func TestWithGenesisChecksum_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	checksum := []byte("checksum")
+	opt := WithGenesisChecksum(checksum)
+	err := opt(node)
+
+	assert.Equal(t, checksum, node.genesisChecksum)
+	assert.Nil(t, err)
+}
+
+func TestWithChainID_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithChainID("")
+	err := opt(node)
+
+	assert.Equal(t, "", node.chainID)
+	assert.Equal(t, ErrEmptyChainID, err)
+}
+
+func TestWithChainID_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithChainID("testnet")
+	err := opt(node)
+
+	assert.Equal(t, "testnet", node.chainID)
+	assert.Nil(t, err)
+}
+
+func TestWithTxSignKeyGen_NilKeyGenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithTxSignKeyGen(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.txSignKeyGen)
+	assert.Equal(t, ErrNilTxSignKeyGen, err)
+}
+
+func TestWithTxSignKeyGen_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	keyGen := &mock.KeyGenMock{}
+	opt := WithTxSignKeyGen(keyGen)
+	err := opt(node)
+
+	assert.True(t, node.txSignKeyGen == keyGen)
+	assert.Nil(t, err)
+}
+
+func TestWithTxFeeHandler_NilFeeHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithTxFeeHandler(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.txFeeHandler)
+	assert.Equal(t, ErrNilTxFeeHandler, err)
+}
+
+func TestWithTxFeeHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	feeHandler := &mock.FeeHandlerStub{}
+	opt := WithTxFeeHandler(feeHandler)
+	err := opt(node)
+
+	assert.True(t, node.txFeeHandler == feeHandler)
+	assert.Nil(t, err)
+}
+
+func TestWithInterceptedDataLimitsChecker_NilLimitsCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithInterceptedDataLimitsChecker(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.limitsChecker)
+	assert.Equal(t, ErrNilInterceptedDataLimitsChecker, err)
+}
+
+func TestWithInterceptedDataLimitsChecker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	limitsChecker := &mock.InterceptedDataLimitsCheckerStub{}
+	opt := WithInterceptedDataLimitsChecker(limitsChecker)
+	err := opt(node)
+
+	assert.True(t, node.limitsChecker == limitsChecker)
+	assert.Nil(t, err)
+}
+
+func TestWithAlarmScheduler_NilAlarmSchedulerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithAlarmScheduler(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.alarmScheduler)
+	assert.Equal(t, ErrNilAlarmScheduler, err)
+}
+
+func TestWithAlarmScheduler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	alarmScheduler := &mock.AlarmSchedulerStub{}
+	opt := WithAlarmScheduler(alarmScheduler)
+	err := opt(node)
+
+	assert.True(t, node.alarmScheduler == alarmScheduler)
+	assert.Nil(t, err)
+}
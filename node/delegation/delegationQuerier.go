@@ -0,0 +1,167 @@
+package delegation
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// ArgDelegationQuerier holds all dependencies required by the delegation querier in order to create a new instance
+type ArgDelegationQuerier struct {
+	ScDataGetter     ScDataGetter
+	AddressConverter state.AddressConverter
+	MaxPageSize      int
+}
+
+// delegationQuerier answers the API queries over the delegation contracts by running the contracts' view functions
+// on the system VM
+type delegationQuerier struct {
+	scDataGetter     ScDataGetter
+	addressConverter state.AddressConverter
+	maxPageSize      int
+}
+
+// NewDelegationQuerier creates a new delegation querier
+func NewDelegationQuerier(args ArgDelegationQuerier) (*delegationQuerier, error) {
+	if check.IfNil(args.ScDataGetter) {
+		return nil, ErrNilScDataGetter
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+	if args.MaxPageSize <= 0 {
+		return nil, ErrInvalidMaxPageSize
+	}
+
+	return &delegationQuerier{
+		scDataGetter:     args.ScDataGetter,
+		addressConverter: args.AddressConverter,
+		maxPageSize:      args.MaxPageSize,
+	}, nil
+}
+
+// GetDelegators returns a page of the delegators of the provided delegation contract, starting with the delegator at
+// the provided index. A zero page size requests a page of the maximum size
+func (dq *delegationQuerier) GetDelegators(contractAddress string, startIndex uint64, pageSize int) (*external.DelegatorsPage, error) {
+	if pageSize < 0 || pageSize > dq.maxPageSize {
+		return nil, ErrInvalidPageSize
+	}
+	if pageSize == 0 {
+		pageSize = dq.maxPageSize
+	}
+
+	contract, err := dq.addressConverter.CreateAddressFromHex(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := dq.scDataGetter.GetAll(contract.Bytes(), "getNumDelegators")
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 1 {
+		return nil, ErrInvalidContractResponse
+	}
+	numDelegators := big.NewInt(0).SetBytes(values[0]).Uint64()
+
+	values, err = dq.scDataGetter.GetAll(
+		contract.Bytes(),
+		"getDelegatorsList",
+		big.NewInt(0).SetUint64(startIndex).Bytes(),
+		big.NewInt(int64(pageSize)).Bytes(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(values)%2 != 0 {
+		return nil, ErrInvalidContractResponse
+	}
+
+	page := &external.DelegatorsPage{
+		NumDelegators: numDelegators,
+		Delegators:    make([]*external.DelegatorInfo, 0, len(values)/2),
+		NextIndex:     startIndex,
+	}
+	for i := 0; i < len(values); i += 2 {
+		page.Delegators = append(page.Delegators, &external.DelegatorInfo{
+			Address: dq.encodeAddress(values[i]),
+			Stake:   big.NewInt(0).SetBytes(values[i+1]).String(),
+		})
+	}
+	page.NextIndex += uint64(len(page.Delegators))
+	if page.NextIndex > numDelegators {
+		page.NextIndex = numDelegators
+	}
+
+	return page, nil
+}
+
+// GetClaimableRewards returns the rewards the provided delegator can claim from the provided delegation contract
+func (dq *delegationQuerier) GetClaimableRewards(contractAddress string, delegatorAddress string) (*big.Int, error) {
+	contract, err := dq.addressConverter.CreateAddressFromHex(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegator, err := dq.addressConverter.CreateAddressFromHex(delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := dq.scDataGetter.GetAll(contract.Bytes(), "getClaimableRewards", delegator.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 1 {
+		return nil, ErrInvalidContractResponse
+	}
+
+	return big.NewInt(0).SetBytes(values[0]), nil
+}
+
+// GetDelegationContractConfig returns the configuration of the provided delegation contract and the total value
+// staked through it
+func (dq *delegationQuerier) GetDelegationContractConfig(contractAddress string) (*external.DelegationContractConfig, error) {
+	contract, err := dq.addressConverter.CreateAddressFromHex(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := dq.scDataGetter.GetAll(contract.Bytes(), "getContractConfig")
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 4 {
+		return nil, ErrInvalidContractResponse
+	}
+
+	return &external.DelegationContractConfig{
+		Owner:            dq.encodeAddress(values[0]),
+		MaxCap:           big.NewInt(0).SetBytes(values[1]).String(),
+		ServiceFee:       big.NewInt(0).SetBytes(values[2]).Uint64(),
+		TotalActiveStake: big.NewInt(0).SetBytes(values[3]).String(),
+	}, nil
+}
+
+// encodeAddress hex encodes an address returned by the system VM, restoring the leading zero bytes dropped by the
+// big int conversion of the returned values
+func (dq *delegationQuerier) encodeAddress(address []byte) string {
+	addressLen := dq.addressConverter.AddressLen()
+	if len(address) < addressLen {
+		padded := make([]byte, addressLen)
+		copy(padded[addressLen-len(address):], address)
+		address = padded
+	}
+
+	return hex.EncodeToString(address)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dq *delegationQuerier) IsInterfaceNil() bool {
+	if dq == nil {
+		return true
+	}
+	return false
+}
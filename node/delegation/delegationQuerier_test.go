@@ -0,0 +1,258 @@
+package delegation_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/node/delegation"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const addressLen = 4
+
+var contractAddress = []byte("cntr")
+
+func createMockArgs() delegation.ArgDelegationQuerier {
+	return delegation.ArgDelegationQuerier{
+		ScDataGetter:     &mock.ScDataGetterStub{},
+		AddressConverter: mock.NewAddressConverterFake(addressLen, ""),
+		MaxPageSize:      2,
+	}
+}
+
+// createDelegatorsContract returns a SC data getter answering the delegators queries of a contract with the
+// provided delegators, in order
+func createDelegatorsContract(delegators [][]byte, stakes []int64) *mock.ScDataGetterStub {
+	return &mock.ScDataGetterStub{
+		GetAllCalled: func(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+			switch funcName {
+			case "getNumDelegators":
+				return [][]byte{big.NewInt(int64(len(delegators))).Bytes()}, nil
+			case "getDelegatorsList":
+				start := big.NewInt(0).SetBytes(args[0]).Uint64()
+				end := start + big.NewInt(0).SetBytes(args[1]).Uint64()
+				values := make([][]byte, 0)
+				for i := start; i < end && i < uint64(len(delegators)); i++ {
+					// the system VM returns the values as big ints, dropping the leading zero bytes
+					values = append(values, big.NewInt(0).SetBytes(delegators[i]).Bytes(), big.NewInt(stakes[i]).Bytes())
+				}
+				return values, nil
+			}
+			return nil, errors.New("unexpected function")
+		},
+	}
+}
+
+//------- NewDelegationQuerier
+
+func TestNewDelegationQuerier_NilScDataGetterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ScDataGetter = nil
+	dq, err := delegation.NewDelegationQuerier(args)
+
+	assert.True(t, dq == nil)
+	assert.Equal(t, delegation.ErrNilScDataGetter, err)
+}
+
+func TestNewDelegationQuerier_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.AddressConverter = nil
+	dq, err := delegation.NewDelegationQuerier(args)
+
+	assert.True(t, dq == nil)
+	assert.Equal(t, delegation.ErrNilAddressConverter, err)
+}
+
+func TestNewDelegationQuerier_InvalidMaxPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.MaxPageSize = 0
+	dq, err := delegation.NewDelegationQuerier(args)
+
+	assert.True(t, dq == nil)
+	assert.Equal(t, delegation.ErrInvalidMaxPageSize, err)
+}
+
+func TestNewDelegationQuerier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dq, err := delegation.NewDelegationQuerier(createMockArgs())
+
+	assert.False(t, dq == nil)
+	assert.Nil(t, err)
+}
+
+//------- GetDelegators
+
+func TestDelegationQuerier_GetDelegatorsInvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dq, _ := delegation.NewDelegationQuerier(createMockArgs())
+
+	page, err := dq.GetDelegators(hex.EncodeToString(contractAddress), 0, 3)
+	assert.Nil(t, page)
+	assert.Equal(t, delegation.ErrInvalidPageSize, err)
+
+	page, err = dq.GetDelegators(hex.EncodeToString(contractAddress), 0, -1)
+	assert.Nil(t, page)
+	assert.Equal(t, delegation.ErrInvalidPageSize, err)
+}
+
+func TestDelegationQuerier_GetDelegatorsInvalidContractAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dq, _ := delegation.NewDelegationQuerier(createMockArgs())
+
+	page, err := dq.GetDelegators("not hex", 0, 0)
+
+	assert.Nil(t, page)
+	assert.NotNil(t, err)
+}
+
+func TestDelegationQuerier_GetDelegatorsShouldReturnThePages(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ScDataGetter = createDelegatorsContract(
+		[][]byte{{0, 0, 1, 2}, []byte("dlg2"), []byte("dlg3")},
+		[]int64{10, 20, 30},
+	)
+	dq, _ := delegation.NewDelegationQuerier(args)
+
+	page, err := dq.GetDelegators(hex.EncodeToString(contractAddress), 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, &external.DelegatorsPage{
+		NumDelegators: 3,
+		Delegators: []*external.DelegatorInfo{
+			{Address: "00000102", Stake: "10"},
+			{Address: hex.EncodeToString([]byte("dlg2")), Stake: "20"},
+		},
+		NextIndex: 2,
+	}, page)
+
+	page, err = dq.GetDelegators(hex.EncodeToString(contractAddress), page.NextIndex, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, &external.DelegatorsPage{
+		NumDelegators: 3,
+		Delegators: []*external.DelegatorInfo{
+			{Address: hex.EncodeToString([]byte("dlg3")), Stake: "30"},
+		},
+		NextIndex: 3,
+	}, page)
+}
+
+func TestDelegationQuerier_GetDelegatorsOddNumberOfValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ScDataGetter = &mock.ScDataGetterStub{
+		GetAllCalled: func(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+			if funcName == "getNumDelegators" {
+				return [][]byte{{1}}, nil
+			}
+			return [][]byte{[]byte("dlg1")}, nil
+		},
+	}
+	dq, _ := delegation.NewDelegationQuerier(args)
+
+	page, err := dq.GetDelegators(hex.EncodeToString(contractAddress), 0, 0)
+
+	assert.Nil(t, page)
+	assert.Equal(t, delegation.ErrInvalidContractResponse, err)
+}
+
+//------- GetClaimableRewards
+
+func TestDelegationQuerier_GetClaimableRewardsShouldQueryTheContract(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ScDataGetter = &mock.ScDataGetterStub{
+		GetAllCalled: func(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+			assert.Equal(t, contractAddress, scAddress)
+			assert.Equal(t, "getClaimableRewards", funcName)
+			assert.Equal(t, [][]byte{[]byte("dlg1")}, args)
+			return [][]byte{big.NewInt(675).Bytes()}, nil
+		},
+	}
+	dq, _ := delegation.NewDelegationQuerier(args)
+
+	rewards, err := dq.GetClaimableRewards(hex.EncodeToString(contractAddress), hex.EncodeToString([]byte("dlg1")))
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(675), rewards)
+}
+
+func TestDelegationQuerier_GetClaimableRewardsQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errQuery := errors.New("query error")
+	args := createMockArgs()
+	args.ScDataGetter = &mock.ScDataGetterStub{
+		GetAllCalled: func(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+			return nil, errQuery
+		},
+	}
+	dq, _ := delegation.NewDelegationQuerier(args)
+
+	rewards, err := dq.GetClaimableRewards(hex.EncodeToString(contractAddress), hex.EncodeToString([]byte("dlg1")))
+
+	assert.Nil(t, rewards)
+	assert.Equal(t, errQuery, err)
+}
+
+//------- GetDelegationContractConfig
+
+func TestDelegationQuerier_GetDelegationContractConfigShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ScDataGetter = &mock.ScDataGetterStub{
+		GetAllCalled: func(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+			assert.Equal(t, "getContractConfig", funcName)
+			return [][]byte{
+				[]byte("ownr"),
+				big.NewInt(1000).Bytes(),
+				big.NewInt(500).Bytes(),
+				big.NewInt(400).Bytes(),
+			}, nil
+		},
+	}
+	dq, _ := delegation.NewDelegationQuerier(args)
+
+	config, err := dq.GetDelegationContractConfig(hex.EncodeToString(contractAddress))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &external.DelegationContractConfig{
+		Owner:            hex.EncodeToString([]byte("ownr")),
+		MaxCap:           "1000",
+		ServiceFee:       500,
+		TotalActiveStake: "400",
+	}, config)
+}
+
+func TestDelegationQuerier_GetDelegationContractConfigInvalidResponseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ScDataGetter = &mock.ScDataGetterStub{
+		GetAllCalled: func(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+			return [][]byte{[]byte("ownr")}, nil
+		},
+	}
+	dq, _ := delegation.NewDelegationQuerier(args)
+
+	config, err := dq.GetDelegationContractConfig(hex.EncodeToString(contractAddress))
+
+	assert.Nil(t, config)
+	assert.Equal(t, delegation.ErrInvalidContractResponse, err)
+}
@@ -0,0 +1,18 @@
+package delegation
+
+import "errors"
+
+// ErrNilScDataGetter signals that a nil SC data getter has been provided
+var ErrNilScDataGetter = errors.New("nil SC data getter")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrInvalidMaxPageSize signals that an invalid maximum page size has been provided
+var ErrInvalidMaxPageSize = errors.New("invalid maximum page size")
+
+// ErrInvalidPageSize signals that an invalid page size has been requested
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrInvalidContractResponse signals that the delegation contract returned an unexpected number of values
+var ErrInvalidContractResponse = errors.New("invalid delegation contract response")
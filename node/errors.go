@@ -123,3 +123,67 @@ var ErrNilStatusHandler = errors.New("nil AppStatusHandler")
 
 // ErrNoTxToProcess signals that no transaction were sent for processing
 var ErrNoTxToProcess = errors.New("no transaction to process")
+
+// ErrUserNameNotRegistered signals that the requested user name is not registered
+var ErrUserNameNotRegistered = errors.New("user name is not registered")
+
+// ErrNilPendingMiniBlocksHandler signals that a nil pending miniblocks handler has been provided
+var ErrNilPendingMiniBlocksHandler = errors.New("nil pending miniblocks handler")
+
+// ErrNilEconomicsData signals that a nil economics data handler has been provided
+var ErrNilEconomicsData = errors.New("nil economics data")
+
+// ErrNilTotalSupply signals that a nil total supply has been provided
+var ErrNilTotalSupply = errors.New("nil total supply")
+
+// ErrConsensusRunning signals that the components used by the consensus can not be replaced while it is running
+var ErrConsensusRunning = errors.New("consensus is running")
+
+// ErrEmptyGenesisChecksum signals that an empty genesis checksum has been provided
+var ErrEmptyGenesisChecksum = errors.New("empty genesis checksum")
+
+// ErrEmptyChainID signals that an empty chain ID has been provided
+var ErrEmptyChainID = errors.New("empty chain ID")
+
+// ErrNilTxSignKeyGen signals that a nil transaction sign key generator has been provided
+var ErrNilTxSignKeyGen = errors.New("trying to set nil transaction sign key generator")
+
+// ErrNilTxFeeHandler signals that a nil transaction fee handler has been provided
+var ErrNilTxFeeHandler = errors.New("trying to set nil transaction fee handler")
+
+// ErrNilInterceptedDataLimitsChecker signals that a nil intercepted data limits checker has been provided
+var ErrNilInterceptedDataLimitsChecker = errors.New("trying to set nil intercepted data limits checker")
+
+// ErrNilHistoricalAccountsProvider signals that a nil historical accounts provider has been provided
+var ErrNilHistoricalAccountsProvider = errors.New("trying to set nil historical accounts provider")
+
+// ErrHistoricalStateDisabled signals that the accounts state at past blocks was requested while the historical
+// state queries are disabled
+var ErrHistoricalStateDisabled = errors.New("historical state queries are disabled")
+
+// ErrNilHistoryRepository signals that a nil history repository has been provided
+var ErrNilHistoryRepository = errors.New("trying to set nil history repository")
+
+// ErrNilAlarmScheduler signals that a nil alarm scheduler has been provided
+var ErrNilAlarmScheduler = errors.New("trying to set nil alarm scheduler")
+
+// ErrNilPeerMaintenanceHandler signals that a nil peer maintenance handler has been provided
+var ErrNilPeerMaintenanceHandler = errors.New("trying to set nil peer maintenance handler")
+
+// ErrHeartbeatNotStarted signals that the heartbeat subsystem has not been started
+var ErrHeartbeatNotStarted = errors.New("heartbeat subsystem not started")
+
+// ErrNilOutgoingOperationsHandler signals that a nil outgoing operations handler has been provided
+var ErrNilOutgoingOperationsHandler = errors.New("trying to set nil outgoing operations handler")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("trying to set nil accounts repository")
+
+// ErrNilValidatorsSelectionAuditor signals that a nil validators selection auditor has been provided
+var ErrNilValidatorsSelectionAuditor = errors.New("trying to set nil validators selection auditor")
+
+// ErrNilSupplyAccountant signals that a nil supply accountant has been provided
+var ErrNilSupplyAccountant = errors.New("trying to set nil supply accountant")
+
+// ErrNilESDTSupplyTracker signals that a nil token supply tracker has been provided
+var ErrNilESDTSupplyTracker = errors.New("trying to set nil token supply tracker")
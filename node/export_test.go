@@ -1,6 +1,9 @@
 package node
 
-import "github.com/ElrondNetwork/elrond-go/node/heartbeat"
+import (
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
 
 func (n *Node) HeartbeatMonitor() *heartbeat.Monitor {
 	return n.heartbeatMonitor
@@ -9,3 +12,7 @@ func (n *Node) HeartbeatMonitor() *heartbeat.Monitor {
 func (n *Node) HeartbeatSender() *heartbeat.Sender {
 	return n.heartbeatSender
 }
+
+func (n *Node) SetBootstrapper(bootstrapper process.Bootstrapper) {
+	n.bootstrapper = bootstrapper
+}
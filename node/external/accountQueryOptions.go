@@ -0,0 +1,14 @@
+package external
+
+// AccountQueryOptions holds the options of an accounts query. By default the accounts are read on the last committed
+// block, which can still be reverted, while OnFinalBlock reads them on the last final block
+type AccountQueryOptions struct {
+	OnFinalBlock bool
+}
+
+// AccountBlockInfo identifies the block whose state answered an accounts query
+type AccountBlockInfo struct {
+	Nonce    uint64
+	Hash     []byte
+	RootHash []byte
+}
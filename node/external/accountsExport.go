@@ -0,0 +1,18 @@
+package external
+
+// ExportedAccount holds the values of an account exported for audits
+type ExportedAccount struct {
+	Address  string
+	Balance  string
+	Nonce    uint64
+	CodeHash string
+}
+
+// AccountsExportPage holds a page of the accounts exported at the root hash of the last epoch start. An empty next
+// token marks the last page
+type AccountsExportPage struct {
+	Epoch     uint32
+	RootHash  string
+	Accounts  []*ExportedAccount
+	NextToken string
+}
@@ -0,0 +1,24 @@
+package external
+
+// AuctionNode holds the hex encoded BLS key of a node registered in the staking contract and whether it would be
+// qualified at the start of the next epoch
+type AuctionNode struct {
+	BlsKey    string
+	Qualified bool
+}
+
+// AuctionParticipant holds the hex encoded address of an owner which staked nodes, its total stake, its top-up per
+// qualified node and its nodes, in registration order
+type AuctionParticipant struct {
+	Owner             string
+	TotalStake        string
+	TopUpPerNode      string
+	NumQualifiedNodes uint64
+	Nodes             []*AuctionNode
+}
+
+// AuctionList holds the node price and the participants to the selection of the next epoch
+type AuctionList struct {
+	NodePrice    string
+	Participants []*AuctionParticipant
+}
@@ -0,0 +1,7 @@
+package external
+
+// BlockCoordinates identifies a committed block of the node's shard by its hash or, when no hash is set, by its nonce
+type BlockCoordinates struct {
+	Nonce uint64
+	Hash  []byte
+}
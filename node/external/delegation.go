@@ -0,0 +1,24 @@
+package external
+
+// DelegatorInfo holds the hex encoded address of a delegator of a delegation contract, together with its stake
+type DelegatorInfo struct {
+	Address string
+	Stake   string
+}
+
+// DelegatorsPage holds a page of the delegators of a delegation contract, in the order they first delegated. The next
+// page starts at the next index, the last page being reached when the next index equals the number of delegators
+type DelegatorsPage struct {
+	NumDelegators uint64
+	Delegators    []*DelegatorInfo
+	NextIndex     uint64
+}
+
+// DelegationContractConfig holds the configuration of a delegation contract and the total value staked through it.
+// The service fee is expressed in hundredths of percent
+type DelegationContractConfig struct {
+	Owner            string
+	MaxCap           string
+	ServiceFee       uint64
+	TotalActiveStake string
+}
@@ -22,3 +22,12 @@ var ErrNilScDataGetter = errors.New("nil SC data getter")
 
 // ErrNilStatusMetrics signals that a nil status metrics was provided
 var ErrNilStatusMetrics = errors.New("nil status metrics handler")
+
+// ErrNilTransactionSimulator signals that a nil transaction simulator was provided
+var ErrNilTransactionSimulator = errors.New("nil transaction simulator")
+
+// ErrNilTransactionStatusComputer signals that a nil transaction status computer was provided
+var ErrNilTransactionStatusComputer = errors.New("nil transaction status computer")
+
+// ErrNilStateScDataGetter signals that a nil state data getter has been provided
+var ErrNilStateScDataGetter = errors.New("nil state SC data getter")
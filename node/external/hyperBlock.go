@@ -0,0 +1,57 @@
+package external
+
+// HyperBlock is a metachain block together with the shard blocks it notarized
+type HyperBlock struct {
+	Nonce       uint64                 `json:"nonce"`
+	Round       uint64                 `json:"round"`
+	Epoch       uint32                 `json:"epoch"`
+	Hash        string                 `json:"hash"`
+	PrevHash    string                 `json:"prevHash"`
+	Timestamp   uint64                 `json:"timestamp"`
+	TxCount     uint32                 `json:"txCount"`
+	ShardBlocks []*NotarizedShardBlock `json:"shardBlocks"`
+}
+
+// NotarizedShardBlock is a shard block notarized by a metachain block. The header fields are known only if the
+// shard block is in the node's storage, which is always the case on the metachain
+type NotarizedShardBlock struct {
+	Hash       string                `json:"hash"`
+	ShardID    uint32                `json:"shardId"`
+	Nonce      uint64                `json:"nonce,omitempty"`
+	Round      uint64                `json:"round,omitempty"`
+	Epoch      uint32                `json:"epoch,omitempty"`
+	PrevHash   string                `json:"prevHash,omitempty"`
+	RootHash   string                `json:"rootHash,omitempty"`
+	Timestamp  uint64                `json:"timestamp,omitempty"`
+	TxCount    uint32                `json:"txCount"`
+	MiniBlocks []*NotarizedMiniBlock `json:"miniBlocks"`
+}
+
+// NotarizedMiniBlock is a miniblock of a notarized shard block. The transactions are known only if the miniblock and
+// its transactions are in the node's storage
+type NotarizedMiniBlock struct {
+	Hash            string                   `json:"hash"`
+	Type            string                   `json:"type,omitempty"`
+	SenderShardID   uint32                   `json:"senderShardId"`
+	ReceiverShardID uint32                   `json:"receiverShardId"`
+	TxCount         uint32                   `json:"txCount"`
+	Transactions    []*HyperBlockTransaction `json:"transactions,omitempty"`
+}
+
+// HyperBlockTransaction is a transaction, a smart contract result or a reward included in a notarized miniblock.
+// Only the fields of the transaction kind are filled in
+type HyperBlockTransaction struct {
+	Hash             string `json:"hash"`
+	Type             string `json:"type"`
+	Nonce            uint64 `json:"nonce,omitempty"`
+	Value            string `json:"value"`
+	Sender           string `json:"sender,omitempty"`
+	Receiver         string `json:"receiver"`
+	GasPrice         uint64 `json:"gasPrice,omitempty"`
+	GasLimit         uint64 `json:"gasLimit,omitempty"`
+	Data             string `json:"data,omitempty"`
+	Signature        string `json:"signature,omitempty"`
+	OriginalTxHash   string `json:"originalTxHash,omitempty"`
+	SourceShard      uint32 `json:"sourceShard"`
+	DestinationShard uint32 `json:"destinationShard"`
+}
@@ -1,5 +1,10 @@
 package external
 
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
 // ScDataGetter defines how data should be get from a SC account
 type ScDataGetter interface {
 	Get(scAddress []byte, funcName string, args ...[]byte) ([]byte, error)
@@ -11,3 +16,21 @@ type StatusMetricsHandler interface {
 	StatusMetricsMap() (map[string]interface{}, error)
 	IsInterfaceNil() bool
 }
+
+// TransactionSimulator defines how a transaction can be executed without affecting the state
+type TransactionSimulator interface {
+	SimulateTransaction(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+	IsInterfaceNil() bool
+}
+
+// TransactionStatusComputer defines how the status and the results of a transaction can be fetched
+type TransactionStatusComputer interface {
+	GetTransactionWithResults(txHash []byte) (*transaction.Transaction, *transaction.TransactionResults, error)
+	IsInterfaceNil() bool
+}
+
+// StateScDataGetter defines how data should be get from a SC account of the provided accounts state
+type StateScDataGetter interface {
+	GetFromState(accounts state.AccountsAdapter, scAddress []byte, funcName string, args ...[]byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
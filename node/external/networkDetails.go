@@ -0,0 +1,31 @@
+package external
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/supply"
+)
+
+// NetworkConfig holds the configuration values of the network, as known by the node
+type NetworkConfig struct {
+	ChainID                   string
+	NumShards                 uint32
+	RoundDuration             uint64
+	ConsensusGroupSize        uint32
+	MinGasPrice               uint64
+	MinGasLimit               uint64
+	GasPriceModifier          float64
+	MaxMoveBalanceGasPerBlock uint64
+	MaxSCExecutionGasPerBlock uint64
+	RewardsValue              *big.Int
+}
+
+// NetworkEconomics holds the chain-level economics values, as known by the node. The shard supply is nil if the
+// supply accounting is disabled
+type NetworkEconomics struct {
+	TotalSupply *big.Int
+	StakedValue *big.Int
+	TopUpValue  *big.Int
+	APR         float64
+	ShardSupply *supply.ShardSupply
+}
@@ -0,0 +1,10 @@
+package external
+
+import "github.com/ElrondNetwork/elrond-go/data/esdt"
+
+// NFTToken holds a non fungible or semi fungible token held by an account, together with its identifier and nonce
+type NFTToken struct {
+	TokenIdentifier string
+	Nonce           uint64
+	Token           *esdt.ESDigitalToken
+}
@@ -1,23 +1,51 @@
 package external
 
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
 // NodeApiResolver can resolve API requests
 type NodeApiResolver struct {
 	scDataGetter         ScDataGetter
+	stateScDataGetter    StateScDataGetter
 	statusMetricsHandler StatusMetricsHandler
+	txSimulator          TransactionSimulator
+	txStatusComputer     TransactionStatusComputer
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
-func NewNodeApiResolver(scDataGetter ScDataGetter, statusMetricsHandler StatusMetricsHandler) (*NodeApiResolver, error) {
+func NewNodeApiResolver(
+	scDataGetter ScDataGetter,
+	stateScDataGetter StateScDataGetter,
+	statusMetricsHandler StatusMetricsHandler,
+	txSimulator TransactionSimulator,
+	txStatusComputer TransactionStatusComputer,
+) (*NodeApiResolver, error) {
 	if scDataGetter == nil || scDataGetter.IsInterfaceNil() {
 		return nil, ErrNilScDataGetter
 	}
+	if stateScDataGetter == nil || stateScDataGetter.IsInterfaceNil() {
+		return nil, ErrNilStateScDataGetter
+	}
 	if statusMetricsHandler == nil || statusMetricsHandler.IsInterfaceNil() {
 		return nil, ErrNilStatusMetrics
 	}
+	if txSimulator == nil || txSimulator.IsInterfaceNil() {
+		return nil, ErrNilTransactionSimulator
+	}
+	if txStatusComputer == nil || txStatusComputer.IsInterfaceNil() {
+		return nil, ErrNilTransactionStatusComputer
+	}
 
 	return &NodeApiResolver{
 		scDataGetter:         scDataGetter,
+		stateScDataGetter:    stateScDataGetter,
 		statusMetricsHandler: statusMetricsHandler,
+		txSimulator:          txSimulator,
+		txStatusComputer:     txStatusComputer,
 	}, nil
 }
 
@@ -26,11 +54,36 @@ func (nar *NodeApiResolver) GetVmValue(address string, funcName string, argsBuff
 	return nar.scDataGetter.Get([]byte(address), funcName, argsBuff...)
 }
 
+// GetVmValueFromState retrieves data stored in a SC account of the provided accounts state through a VM
+func (nar *NodeApiResolver) GetVmValueFromState(
+	accounts state.AccountsAdapter,
+	address string,
+	funcName string,
+	argsBuff ...[]byte,
+) ([]byte, error) {
+	return nar.stateScDataGetter.GetFromState(accounts, []byte(address), funcName, argsBuff...)
+}
+
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
 func (nar *NodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
 }
 
+// SimulateTransaction executes the provided transaction without affecting the state and returns the results
+func (nar *NodeApiResolver) SimulateTransaction(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+	return nar.txSimulator.SimulateTransaction(tx, withTrace)
+}
+
+// GetTransactionWithResults returns the transaction with the provided hex encoded hash together with its status and results
+func (nar *NodeApiResolver) GetTransactionWithResults(hash string) (*transaction.Transaction, *transaction.TransactionResults, error) {
+	txHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nar.txStatusComputer.GetTransactionWithResults(txHash)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	if nar == nil {
@@ -3,6 +3,8 @@ package external_test
 import (
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/stretchr/testify/assert"
@@ -11,25 +13,43 @@ import (
 func TestNewNodeApiResolver_NilScDataGetterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{})
+	nar, err := external.NewNodeApiResolver(nil, &mock.StateScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.TransactionSimulatorStub{}, &mock.TransactionStatusComputerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilScDataGetter, err)
 }
 
+func TestNewNodeApiResolver_NilStateScDataGetterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, nil, &mock.StatusMetricsStub{}, &mock.TransactionSimulatorStub{}, &mock.TransactionStatusComputerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilStateScDataGetter, err)
+}
+
 func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, nil)
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StateScDataGetterStub{}, nil, &mock.TransactionSimulatorStub{}, &mock.TransactionStatusComputerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilStatusMetrics, err)
 }
 
+func TestNewNodeApiResolver_NilTransactionSimulatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StateScDataGetterStub{}, &mock.StatusMetricsStub{}, nil, &mock.TransactionStatusComputerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilTransactionSimulator, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{})
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StateScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.TransactionSimulatorStub{}, &mock.TransactionStatusComputerStub{})
 
 	assert.NotNil(t, nar)
 	assert.Nil(t, err)
@@ -45,26 +65,121 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 			return make([]byte, 0), nil
 		},
 	},
-		&mock.StatusMetricsStub{})
+		&mock.StateScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionSimulatorStub{}, &mock.TransactionStatusComputerStub{})
 
 	_, _ = nar.GetVmValue("", "")
 
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetVmValueFromStateShouldCallWithTheProvidedState(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{}
+	var receivedAccounts state.AccountsAdapter
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StateScDataGetterStub{
+			GetFromStateCalled: func(accounts state.AccountsAdapter, scAddress []byte, funcName string, args ...[]byte) ([]byte, error) {
+				receivedAccounts = accounts
+				return []byte("value"), nil
+			},
+		},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionSimulatorStub{},
+		&mock.TransactionStatusComputerStub{})
+
+	value, err := nar.GetVmValueFromState(accounts, "address", "func")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+	assert.True(t, receivedAccounts == accounts)
+}
+
 func TestNodeApiResolver_StatusMetricsMapShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.ScDataGetterStub{},
+		&mock.StateScDataGetterStub{},
 		&mock.StatusMetricsStub{
 			StatusMetricsMapCalled: func() (map[string]interface{}, error) {
 				wasCalled = true
 				return nil, nil
 			},
-		})
+		},
+		&mock.TransactionSimulatorStub{}, &mock.TransactionStatusComputerStub{})
 	_, _ = nar.StatusMetrics().StatusMetricsMap()
 
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_SimulateTransactionShouldCall(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StateScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionSimulatorStub{
+			SimulateTransactionCalled: func(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+				wasCalled = true
+				return &transaction.SimulationResults{}, nil
+			},
+		},
+		&mock.TransactionStatusComputerStub{})
+
+	_, _ = nar.SimulateTransaction(&transaction.Transaction{}, false)
+
+	assert.True(t, wasCalled)
+}
+
+func TestNewNodeApiResolver_NilTransactionStatusComputerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StateScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.TransactionSimulatorStub{}, nil)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilTransactionStatusComputer, err)
+}
+
+func TestNodeApiResolver_GetTransactionWithResultsInvalidHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StateScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionSimulatorStub{},
+		&mock.TransactionStatusComputerStub{})
+
+	_, _, err := nar.GetTransactionWithResults("not hex")
+
+	assert.NotNil(t, err)
+}
+
+func TestNodeApiResolver_GetTransactionWithResultsShouldCallWithDecodedHash(t *testing.T) {
+	t.Parallel()
+
+	var receivedHash []byte
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StateScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionSimulatorStub{},
+		&mock.TransactionStatusComputerStub{
+			GetTransactionWithResultsCalled: func(txHash []byte) (*transaction.Transaction, *transaction.TransactionResults, error) {
+				receivedHash = txHash
+				return &transaction.Transaction{}, &transaction.TransactionResults{}, nil
+			},
+		})
+
+	_, _, err := nar.GetTransactionWithResults("0a0b")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{10, 11}, receivedHash)
+}
@@ -0,0 +1,26 @@
+package external
+
+// TransactionsPool is a snapshot of the transactions pool of the node's shard, grouped by sender
+type TransactionsPool struct {
+	ShardID         uint32        `json:"shardId"`
+	NumTransactions int           `json:"numTransactions"`
+	NumSenders      int           `json:"numSenders"`
+	Senders         []*PoolSender `json:"senders"`
+}
+
+// PoolSender holds the transactions of a sender found in the transactions pool. The transactions are listed only
+// when requested and are sorted by nonce, so the gaps which block the sender are easy to spot
+type PoolSender struct {
+	Address         string             `json:"address"`
+	NumTransactions int                `json:"numTransactions"`
+	Transactions    []*PoolTransaction `json:"transactions,omitempty"`
+}
+
+// PoolTransaction is a transaction found in the transactions pool
+type PoolTransaction struct {
+	Hash             string `json:"hash"`
+	Nonce            uint64 `json:"nonce"`
+	GasPrice         uint64 `json:"gasPrice"`
+	GasLimit         uint64 `json:"gasLimit"`
+	DestinationShard uint32 `json:"destinationShard"`
+}
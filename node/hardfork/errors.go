@@ -0,0 +1,61 @@
+package hardfork
+
+import "errors"
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilKeyGen signals that a nil key generator has been provided
+var ErrNilKeyGen = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilBlockChain signals that a nil block chain has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrNilSoftRestartHandler signals that a nil soft restart handler has been provided
+var ErrNilSoftRestartHandler = errors.New("nil soft restart handler")
+
+// ErrNilConsensusHandler signals that a nil consensus handler has been provided
+var ErrNilConsensusHandler = errors.New("nil consensus handler")
+
+// ErrNilStateExporter signals that a nil state exporter has been provided
+var ErrNilStateExporter = errors.New("nil state exporter")
+
+// ErrNilComponentsReloader signals that a nil components reloader has been provided
+var ErrNilComponentsReloader = errors.New("nil components reloader")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrInvalidCheckInterval signals that an invalid check interval has been provided
+var ErrInvalidCheckInterval = errors.New("invalid check interval")
+
+// ErrEmptyExportDirectory signals that no directory has been configured for the exported state
+var ErrEmptyExportDirectory = errors.New("empty export directory")
+
+// ErrInvalidTriggerPublicKey signals that the configured trigger public key could not be decoded
+var ErrInvalidTriggerPublicKey = errors.New("invalid trigger public key")
+
+// ErrNilMessage signals that a nil message has been received
+var ErrNilMessage = errors.New("nil message")
+
+// ErrTriggerMessagesDisabled signals that a hardfork trigger message was received while no trigger public key has
+// been configured
+var ErrTriggerMessagesDisabled = errors.New("hardfork trigger messages are disabled")
+
+// ErrOldTriggerMessage signals that a hardfork trigger message with an already used nonce was received
+var ErrOldTriggerMessage = errors.New("old hardfork trigger message")
+
+// ErrStopNonceAlreadyPassed signals that a hardfork trigger message asked to stop at an already committed nonce
+var ErrStopNonceAlreadyPassed = errors.New("hardfork stop nonce already passed")
+
+// ErrHardforkAlreadyTriggered signals that a hardfork trigger message was received while another hardfork is pending
+var ErrHardforkAlreadyTriggered = errors.New("hardfork already triggered")
@@ -0,0 +1,5 @@
+package hardfork
+
+func (ht *hardforkTrigger) CheckCommittedBlock() {
+	ht.checkCommittedBlock()
+}
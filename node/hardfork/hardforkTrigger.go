@@ -0,0 +1,275 @@
+package hardfork
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var log = logger.GetOrCreate("node/hardfork")
+
+// TriggerTopic is the topic on which the signed hardfork trigger messages are broadcast
+const TriggerTopic = "hardfork"
+
+// ArgHardforkTrigger holds all dependencies required by the hardfork trigger in order to create a new instance
+type ArgHardforkTrigger struct {
+	Messenger          Messenger
+	Marshalizer        marshal.Marshalizer
+	KeyGen             crypto.KeyGenerator
+	SingleSigner       crypto.SingleSigner
+	BlockChain         data.ChainHandler
+	SoftRestartHandler SoftRestartHandler
+	Config             config.HardforkConfig
+}
+
+// TriggerMessage asks all the nodes to stop at the same nonce and restart in place. It is signed with the trigger
+// key and the nonce has to increase with each message so an old message can not be replayed
+type TriggerMessage struct {
+	Nonce     uint64 `json:"nonce"`
+	StopNonce uint64 `json:"stopNonce"`
+	Signature []byte `json:"signature"`
+}
+
+// hardforkTrigger decides the nonce at which the node stops for a hardfork, either from a signed trigger message or
+// as the first block of the scheduled epoch, and soft restarts the node once it committed the block at that nonce.
+// The committed blocks are checked periodically, so the check interval has to be well below the round duration. The
+// scheduled epoch is acted upon only if the node committed blocks of the previous epochs, so a node started after
+// the hardfork does not restart again
+type hardforkTrigger struct {
+	messenger          Messenger
+	marshalizer        marshal.Marshalizer
+	singleSigner       crypto.SingleSigner
+	triggerPubKey      crypto.PublicKey
+	blockChain         data.ChainHandler
+	softRestartHandler SoftRestartHandler
+	scheduledEpoch     uint32
+
+	mutTrigger       sync.Mutex
+	isTriggered      bool
+	stopNonce        uint64
+	lastMessageNonce uint64
+	lastRestartNonce uint64
+	isBeforeSchedule bool
+	cancel           func()
+}
+
+// NewHardforkTrigger creates a new hardfork trigger, registers it on the trigger topic and starts checking the
+// committed blocks
+func NewHardforkTrigger(args ArgHardforkTrigger) (*hardforkTrigger, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ht := &hardforkTrigger{
+		messenger:          args.Messenger,
+		marshalizer:        args.Marshalizer,
+		singleSigner:       args.SingleSigner,
+		blockChain:         args.BlockChain,
+		softRestartHandler: args.SoftRestartHandler,
+		scheduledEpoch:     args.Config.ScheduledEpoch,
+	}
+
+	if len(args.Config.TriggerPublicKey) > 0 {
+		pubKeyBytes, errDecode := hex.DecodeString(args.Config.TriggerPublicKey)
+		if errDecode != nil {
+			return nil, ErrInvalidTriggerPublicKey
+		}
+
+		ht.triggerPubKey, err = args.KeyGen.PublicKeyFromByteArray(pubKeyBytes)
+		if err != nil {
+			return nil, ErrInvalidTriggerPublicKey
+		}
+	}
+
+	if !ht.messenger.HasTopic(TriggerTopic) {
+		err = ht.messenger.CreateTopic(TriggerTopic, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = ht.messenger.RegisterMessageProcessor(TriggerTopic, ht)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ht.cancel = cancel
+	go ht.checkLoop(ctx, time.Duration(args.Config.CheckIntervalInMs)*time.Millisecond)
+
+	return ht, nil
+}
+
+func checkArgs(args ArgHardforkTrigger) error {
+	if check.IfNil(args.Messenger) {
+		return ErrNilMessenger
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.KeyGen) {
+		return ErrNilKeyGen
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(args.BlockChain) {
+		return ErrNilBlockChain
+	}
+	if check.IfNil(args.SoftRestartHandler) {
+		return ErrNilSoftRestartHandler
+	}
+	if args.Config.CheckIntervalInMs <= 0 {
+		return ErrInvalidCheckInterval
+	}
+
+	return nil
+}
+
+func (ht *hardforkTrigger) checkLoop(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-time.After(interval):
+			ht.checkCommittedBlock()
+		case <-ctx.Done():
+			log.Debug("hardfork trigger's go routine is stopping...")
+			return
+		}
+	}
+}
+
+// checkCommittedBlock soft restarts the node if the last committed block is the stop block of a triggered hardfork or
+// the first block of the scheduled epoch
+func (ht *hardforkTrigger) checkCommittedBlock() {
+	header := ht.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		return
+	}
+
+	ht.mutTrigger.Lock()
+	if header.GetNonce() <= ht.lastRestartNonce {
+		ht.mutTrigger.Unlock()
+		return
+	}
+	if header.GetEpoch() < ht.scheduledEpoch {
+		ht.isBeforeSchedule = true
+	}
+	isScheduledEpochReached := ht.isBeforeSchedule && header.GetEpoch() >= ht.scheduledEpoch
+	isStopNonceReached := ht.isTriggered && header.GetNonce() >= ht.stopNonce
+	if !isScheduledEpochReached && !isStopNonceReached {
+		ht.mutTrigger.Unlock()
+		return
+	}
+	ht.isTriggered = false
+	ht.lastRestartNonce = header.GetNonce()
+	if isScheduledEpochReached {
+		// the scheduled epoch is handled only once, the node going on with the new configuration afterwards
+		ht.isBeforeSchedule = false
+		ht.scheduledEpoch = 0
+	}
+	ht.mutTrigger.Unlock()
+
+	log.Info("hardfork stop block committed, soft restarting the node",
+		"nonce", header.GetNonce(),
+		"epoch", header.GetEpoch(),
+	)
+	err := ht.softRestartHandler.SoftRestart(header)
+	if err != nil {
+		log.Error("soft restart failed", "error", err.Error())
+	}
+}
+
+// ProcessReceivedMessage verifies and applies a hardfork trigger message received on the network
+func (ht *hardforkTrigger) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+	if check.IfNil(ht.triggerPubKey) {
+		return ErrTriggerMessagesDisabled
+	}
+
+	trigger := &TriggerMessage{}
+	err := ht.marshalizer.Unmarshal(trigger, message.Data())
+	if err != nil {
+		return err
+	}
+
+	err = ht.verifySignature(trigger)
+	if err != nil {
+		return err
+	}
+
+	return ht.applyTrigger(trigger)
+}
+
+func (ht *hardforkTrigger) verifySignature(trigger *TriggerMessage) error {
+	unsignedTrigger := *trigger
+	unsignedTrigger.Signature = nil
+
+	buff, err := ht.marshalizer.Marshal(&unsignedTrigger)
+	if err != nil {
+		return err
+	}
+
+	return ht.singleSigner.Verify(ht.triggerPubKey, buff, trigger.Signature)
+}
+
+func (ht *hardforkTrigger) applyTrigger(trigger *TriggerMessage) error {
+	committedNonce := uint64(0)
+	header := ht.blockChain.GetCurrentBlockHeader()
+	if !check.IfNil(header) {
+		committedNonce = header.GetNonce()
+	}
+
+	ht.mutTrigger.Lock()
+	defer ht.mutTrigger.Unlock()
+
+	if trigger.Nonce <= ht.lastMessageNonce {
+		return ErrOldTriggerMessage
+	}
+	if ht.isTriggered {
+		return ErrHardforkAlreadyTriggered
+	}
+	if trigger.StopNonce <= committedNonce {
+		return ErrStopNonceAlreadyPassed
+	}
+
+	ht.isTriggered = true
+	ht.stopNonce = trigger.StopNonce
+	ht.lastMessageNonce = trigger.Nonce
+	log.Info("hardfork triggered", "stop nonce", trigger.StopNonce, "trigger nonce", trigger.Nonce)
+
+	return nil
+}
+
+// StopNonce returns the nonce at which the node will stop for the triggered hardfork, if any
+func (ht *hardforkTrigger) StopNonce() (uint64, bool) {
+	ht.mutTrigger.Lock()
+	defer ht.mutTrigger.Unlock()
+
+	return ht.stopNonce, ht.isTriggered
+}
+
+// Close stops checking the committed blocks and stops receiving the trigger messages
+func (ht *hardforkTrigger) Close() error {
+	ht.cancel()
+
+	return ht.messenger.UnregisterMessageProcessor(TriggerTopic)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ht *hardforkTrigger) IsInterfaceNil() bool {
+	if ht == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,384 @@
+package hardfork_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/node/hardfork"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+var triggerPubKey = []byte("trigger public key")
+
+func createMockArgs() hardfork.ArgHardforkTrigger {
+	return hardfork.ArgHardforkTrigger{
+		Messenger:   &mock.StateMessengerStub{},
+		Marshalizer: &mock.MarshalizerFake{},
+		KeyGen: &mock.KeyGenMock{
+			PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+				if !bytes.Equal(b, triggerPubKey) {
+					return nil, errors.New("invalid public key")
+				}
+				return &mock.PublicKeyMock{}, nil
+			},
+		},
+		SingleSigner:       &mock.SinglesignMock{},
+		BlockChain:         &mock.BlockChainMock{},
+		SoftRestartHandler: &mock.SoftRestartHandlerStub{},
+		Config: config.HardforkConfig{
+			Enabled:           true,
+			TriggerPublicKey:  hex.EncodeToString(triggerPubKey),
+			CheckIntervalInMs: 1000000,
+		},
+	}
+}
+
+// committingBlockChain is a block chain whose last committed block can be changed by the test
+type committingBlockChain struct {
+	mut    sync.Mutex
+	header data.HeaderHandler
+}
+
+func (cbc *committingBlockChain) commit(nonce uint64, epoch uint32) {
+	cbc.mut.Lock()
+	cbc.header = &block.Header{Nonce: nonce, Epoch: epoch}
+	cbc.mut.Unlock()
+}
+
+func (cbc *committingBlockChain) blockChain() *mock.BlockChainMock {
+	return &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			cbc.mut.Lock()
+			defer cbc.mut.Unlock()
+
+			return cbc.header
+		},
+	}
+}
+
+func createTriggerMessage(nonce uint64, stopNonce uint64, signature string) p2p.MessageP2P {
+	buff, _ := (&mock.MarshalizerFake{}).Marshal(&hardfork.TriggerMessage{
+		Nonce:     nonce,
+		StopNonce: stopNonce,
+		Signature: []byte(signature),
+	})
+
+	return &mock.P2PMessageStub{DataField: buff}
+}
+
+func createRecordingRestartHandler(restarts *[]uint64) *mock.SoftRestartHandlerStub {
+	return &mock.SoftRestartHandlerStub{
+		SoftRestartCalled: func(stopHeader data.HeaderHandler) error {
+			*restarts = append(*restarts, stopHeader.GetNonce())
+			return nil
+		},
+	}
+}
+
+//------- NewHardforkTrigger
+
+func TestNewHardforkTrigger_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Messenger = nil
+	ht, err := hardfork.NewHardforkTrigger(args)
+
+	assert.True(t, ht == nil)
+	assert.Equal(t, hardfork.ErrNilMessenger, err)
+}
+
+func TestNewHardforkTrigger_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Marshalizer = nil
+	ht, err := hardfork.NewHardforkTrigger(args)
+
+	assert.True(t, ht == nil)
+	assert.Equal(t, hardfork.ErrNilMarshalizer, err)
+}
+
+func TestNewHardforkTrigger_NilKeyGenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.KeyGen = nil
+	ht, err := hardfork.NewHardforkTrigger(args)
+
+	assert.True(t, ht == nil)
+	assert.Equal(t, hardfork.ErrNilKeyGen, err)
+}
+
+func TestNewHardforkTrigger_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.SingleSigner = nil
+	ht, err := hardfork.NewHardforkTrigger(args)
+
+	assert.True(t, ht == nil)
+	assert.Equal(t, hardfork.ErrNilSingleSigner, err)
+}
+
+func TestNewHardforkTrigger_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BlockChain = nil
+	ht, err := hardfork.NewHardforkTrigger(args)
+
+	assert.True(t, ht == nil)
+	assert.Equal(t, hardfork.ErrNilBlockChain, err)
+}
+
+func TestNewHardforkTrigger_NilSoftRestartHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.SoftRestartHandler = nil
+	ht, err := hardfork.NewHardforkTrigger(args)
+
+	assert.True(t, ht == nil)
+	assert.Equal(t, hardfork.ErrNilSoftRestartHandler, err)
+}
+
+func TestNewHardforkTrigger_InvalidCheckIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Config.CheckIntervalInMs = 0
+	ht, err := hardfork.NewHardforkTrigger(args)
+
+	assert.True(t, ht == nil)
+	assert.Equal(t, hardfork.ErrInvalidCheckInterval, err)
+}
+
+func TestNewHardforkTrigger_InvalidTriggerPublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Config.TriggerPublicKey = "not hex"
+	ht, err := hardfork.NewHardforkTrigger(args)
+	assert.True(t, ht == nil)
+	assert.Equal(t, hardfork.ErrInvalidTriggerPublicKey, err)
+
+	args.Config.TriggerPublicKey = hex.EncodeToString([]byte("other key"))
+	ht, err = hardfork.NewHardforkTrigger(args)
+	assert.True(t, ht == nil)
+	assert.Equal(t, hardfork.ErrInvalidTriggerPublicKey, err)
+}
+
+func TestNewHardforkTrigger_ShouldCreateTheTopicAndRegister(t *testing.T) {
+	t.Parallel()
+
+	createdTopic := ""
+	registeredTopic := ""
+	unregisteredTopic := ""
+	args := createMockArgs()
+	args.Messenger = &mock.StateMessengerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			createdTopic = name
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredTopic = topic
+			return nil
+		},
+		UnregisterMessageProcessorCalled: func(topic string) error {
+			unregisteredTopic = topic
+			return nil
+		},
+	}
+	ht, err := hardfork.NewHardforkTrigger(args)
+
+	assert.False(t, ht == nil)
+	assert.Nil(t, err)
+	assert.Equal(t, hardfork.TriggerTopic, createdTopic)
+	assert.Equal(t, hardfork.TriggerTopic, registeredTopic)
+
+	err = ht.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, hardfork.TriggerTopic, unregisteredTopic)
+}
+
+//------- ProcessReceivedMessage
+
+func TestHardforkTrigger_ProcessReceivedMessageWithoutTriggerKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Config.TriggerPublicKey = ""
+	ht, _ := hardfork.NewHardforkTrigger(args)
+	defer func() {
+		_ = ht.Close()
+	}()
+
+	err := ht.ProcessReceivedMessage(createTriggerMessage(1, 10, "signed"), nil)
+
+	assert.Equal(t, hardfork.ErrTriggerMessagesDisabled, err)
+}
+
+func TestHardforkTrigger_ProcessReceivedMessageInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ht, _ := hardfork.NewHardforkTrigger(createMockArgs())
+	defer func() {
+		_ = ht.Close()
+	}()
+
+	err := ht.ProcessReceivedMessage(createTriggerMessage(1, 10, "not signed"), nil)
+
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+	_, isTriggered := ht.StopNonce()
+	assert.False(t, isTriggered)
+}
+
+func TestHardforkTrigger_ProcessReceivedMessageShouldSetTheStopNonce(t *testing.T) {
+	t.Parallel()
+
+	ht, _ := hardfork.NewHardforkTrigger(createMockArgs())
+	defer func() {
+		_ = ht.Close()
+	}()
+
+	err := ht.ProcessReceivedMessage(createTriggerMessage(1, 10, "signed"), nil)
+
+	assert.Nil(t, err)
+	stopNonce, isTriggered := ht.StopNonce()
+	assert.True(t, isTriggered)
+	assert.Equal(t, uint64(10), stopNonce)
+
+	err = ht.ProcessReceivedMessage(createTriggerMessage(2, 20, "signed"), nil)
+	assert.Equal(t, hardfork.ErrHardforkAlreadyTriggered, err)
+}
+
+func TestHardforkTrigger_ProcessReceivedMessagePassedStopNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	chain := &committingBlockChain{}
+	chain.commit(10, 0)
+	args := createMockArgs()
+	args.BlockChain = chain.blockChain()
+	ht, _ := hardfork.NewHardforkTrigger(args)
+	defer func() {
+		_ = ht.Close()
+	}()
+
+	err := ht.ProcessReceivedMessage(createTriggerMessage(1, 10, "signed"), nil)
+
+	assert.Equal(t, hardfork.ErrStopNonceAlreadyPassed, err)
+}
+
+func TestHardforkTrigger_ProcessReceivedMessageReplayedMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	chain := &committingBlockChain{}
+	restarts := make([]uint64, 0)
+	args := createMockArgs()
+	args.BlockChain = chain.blockChain()
+	args.SoftRestartHandler = createRecordingRestartHandler(&restarts)
+	ht, _ := hardfork.NewHardforkTrigger(args)
+	defer func() {
+		_ = ht.Close()
+	}()
+
+	_ = ht.ProcessReceivedMessage(createTriggerMessage(1, 10, "signed"), nil)
+	chain.commit(10, 0)
+	ht.CheckCommittedBlock()
+
+	err := ht.ProcessReceivedMessage(createTriggerMessage(1, 20, "signed"), nil)
+
+	assert.Equal(t, hardfork.ErrOldTriggerMessage, err)
+}
+
+//------- checkCommittedBlock
+
+func TestHardforkTrigger_StopNonceReachedShouldSoftRestartOnce(t *testing.T) {
+	t.Parallel()
+
+	chain := &committingBlockChain{}
+	restarts := make([]uint64, 0)
+	args := createMockArgs()
+	args.BlockChain = chain.blockChain()
+	args.SoftRestartHandler = createRecordingRestartHandler(&restarts)
+	ht, _ := hardfork.NewHardforkTrigger(args)
+	defer func() {
+		_ = ht.Close()
+	}()
+
+	_ = ht.ProcessReceivedMessage(createTriggerMessage(1, 10, "signed"), nil)
+	chain.commit(9, 0)
+	ht.CheckCommittedBlock()
+	assert.Equal(t, 0, len(restarts))
+
+	chain.commit(10, 0)
+	ht.CheckCommittedBlock()
+	ht.CheckCommittedBlock()
+	assert.Equal(t, []uint64{10}, restarts)
+
+	_, isTriggered := ht.StopNonce()
+	assert.False(t, isTriggered)
+
+	err := ht.ProcessReceivedMessage(createTriggerMessage(2, 20, "signed"), nil)
+	assert.Nil(t, err)
+	chain.commit(20, 0)
+	ht.CheckCommittedBlock()
+	assert.Equal(t, []uint64{10, 20}, restarts)
+}
+
+func TestHardforkTrigger_ScheduledEpochReachedShouldSoftRestartOnce(t *testing.T) {
+	t.Parallel()
+
+	chain := &committingBlockChain{}
+	restarts := make([]uint64, 0)
+	args := createMockArgs()
+	args.Config.ScheduledEpoch = 2
+	args.BlockChain = chain.blockChain()
+	args.SoftRestartHandler = createRecordingRestartHandler(&restarts)
+	ht, _ := hardfork.NewHardforkTrigger(args)
+	defer func() {
+		_ = ht.Close()
+	}()
+
+	chain.commit(5, 1)
+	ht.CheckCommittedBlock()
+	assert.Equal(t, 0, len(restarts))
+
+	chain.commit(6, 2)
+	ht.CheckCommittedBlock()
+	chain.commit(7, 2)
+	ht.CheckCommittedBlock()
+	assert.Equal(t, []uint64{6}, restarts)
+}
+
+func TestHardforkTrigger_ScheduledEpochAlreadyPassedShouldNotSoftRestart(t *testing.T) {
+	t.Parallel()
+
+	chain := &committingBlockChain{}
+	restarts := make([]uint64, 0)
+	args := createMockArgs()
+	args.Config.ScheduledEpoch = 2
+	args.BlockChain = chain.blockChain()
+	args.SoftRestartHandler = createRecordingRestartHandler(&restarts)
+	ht, _ := hardfork.NewHardforkTrigger(args)
+	defer func() {
+		_ = ht.Close()
+	}()
+
+	chain.commit(6, 2)
+	ht.CheckCommittedBlock()
+	chain.commit(7, 3)
+	ht.CheckCommittedBlock()
+
+	assert.Equal(t, 0, len(restarts))
+}
@@ -0,0 +1,40 @@
+package hardfork
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// Messenger defines the messenger operations used to receive the hardfork trigger messages
+type Messenger interface {
+	HasTopic(name string) bool
+	CreateTopic(name string, createChannelForTopic bool) error
+	RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error
+	UnregisterMessageProcessor(topic string) error
+	IsInterfaceNil() bool
+}
+
+// SoftRestartHandler defines the component which restarts the node in place once the hardfork stop block is committed
+type SoftRestartHandler interface {
+	SoftRestart(stopHeader data.HeaderHandler) error
+	IsInterfaceNil() bool
+}
+
+// ConsensusHandler defines the component able to stop and start again the consensus and the synchronization
+type ConsensusHandler interface {
+	StopConsensus() error
+	StartConsensus() error
+	IsInterfaceNil() bool
+}
+
+// StateExporter defines the component which exports the state committed by the provided header
+type StateExporter interface {
+	ExportState(header data.HeaderHandler) error
+	IsInterfaceNil() bool
+}
+
+// ComponentsReloader defines the component which recreates the process components with the new configuration
+type ComponentsReloader interface {
+	Reload() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,88 @@
+package hardfork
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// ComponentsReloaderFunc adapts a function to the ComponentsReloader interface
+type ComponentsReloaderFunc func() error
+
+// Reload calls the adapted function
+func (f ComponentsReloaderFunc) Reload() error {
+	return f()
+}
+
+// IsInterfaceNil returns true if there is no function under the interface
+func (f ComponentsReloaderFunc) IsInterfaceNil() bool {
+	return f == nil
+}
+
+// softRestarter restarts the node in place for a hardfork: it stops the consensus and the synchronization, exports the
+// state committed by the stop block, recreates the process components with the new configuration and starts the
+// consensus again. The p2p network, the storage and the state stay up during the whole restart
+type softRestarter struct {
+	consensusHandler   ConsensusHandler
+	stateExporter      StateExporter
+	componentsReloader ComponentsReloader
+}
+
+// NewSoftRestarter creates a new soft restarter
+func NewSoftRestarter(
+	consensusHandler ConsensusHandler,
+	stateExporter StateExporter,
+	componentsReloader ComponentsReloader,
+) (*softRestarter, error) {
+	if check.IfNil(consensusHandler) {
+		return nil, ErrNilConsensusHandler
+	}
+	if check.IfNil(stateExporter) {
+		return nil, ErrNilStateExporter
+	}
+	if check.IfNil(componentsReloader) {
+		return nil, ErrNilComponentsReloader
+	}
+
+	return &softRestarter{
+		consensusHandler:   consensusHandler,
+		stateExporter:      stateExporter,
+		componentsReloader: componentsReloader,
+	}, nil
+}
+
+// SoftRestart stops the node at the provided stop block and restarts it with the reloaded components. The consensus
+// stays stopped if any step fails, as the node can not go on safely with the old components
+func (sr *softRestarter) SoftRestart(stopHeader data.HeaderHandler) error {
+	if check.IfNil(stopHeader) {
+		return ErrNilHeader
+	}
+
+	err := sr.consensusHandler.StopConsensus()
+	if err != nil {
+		return err
+	}
+
+	err = sr.stateExporter.ExportState(stopHeader)
+	if err != nil {
+		return err
+	}
+
+	err = sr.componentsReloader.Reload()
+	if err != nil {
+		return err
+	}
+
+	log.Info("node components reloaded after the hardfork stop block, starting the consensus",
+		"stop nonce", stopHeader.GetNonce(),
+	)
+
+	return sr.consensusHandler.StartConsensus()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sr *softRestarter) IsInterfaceNil() bool {
+	if sr == nil {
+		return true
+	}
+	return false
+}
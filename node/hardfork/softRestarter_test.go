@@ -0,0 +1,130 @@
+package hardfork_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/node/hardfork"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+var errStep = errors.New("step error")
+
+// createRecordingComponents returns components recording, in order, the soft restart steps they are called for. The
+// failing step returns an error
+func createRecordingComponents(calls *[]string, failingStep string) (
+	*mock.ConsensusHandlerStub,
+	*mock.StateExporterStub,
+	hardfork.ComponentsReloaderFunc,
+) {
+	stepResult := func(step string) error {
+		*calls = append(*calls, step)
+		if step == failingStep {
+			return errStep
+		}
+		return nil
+	}
+
+	consensusHandler := &mock.ConsensusHandlerStub{
+		StopConsensusCalled: func() error {
+			return stepResult("stop")
+		},
+		StartConsensusCalled: func() error {
+			return stepResult("start")
+		},
+	}
+	stateExporter := &mock.StateExporterStub{
+		ExportStateCalled: func(header data.HeaderHandler) error {
+			return stepResult("export")
+		},
+	}
+	reloader := hardfork.ComponentsReloaderFunc(func() error {
+		return stepResult("reload")
+	})
+
+	return consensusHandler, stateExporter, reloader
+}
+
+//------- NewSoftRestarter
+
+func TestNewSoftRestarter_NilConsensusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr, err := hardfork.NewSoftRestarter(nil, &mock.StateExporterStub{}, hardfork.ComponentsReloaderFunc(func() error {
+		return nil
+	}))
+
+	assert.True(t, sr == nil)
+	assert.Equal(t, hardfork.ErrNilConsensusHandler, err)
+}
+
+func TestNewSoftRestarter_NilStateExporterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr, err := hardfork.NewSoftRestarter(&mock.ConsensusHandlerStub{}, nil, hardfork.ComponentsReloaderFunc(func() error {
+		return nil
+	}))
+
+	assert.True(t, sr == nil)
+	assert.Equal(t, hardfork.ErrNilStateExporter, err)
+}
+
+func TestNewSoftRestarter_NilComponentsReloaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr, err := hardfork.NewSoftRestarter(&mock.ConsensusHandlerStub{}, &mock.StateExporterStub{}, nil)
+	assert.True(t, sr == nil)
+	assert.Equal(t, hardfork.ErrNilComponentsReloader, err)
+
+	sr, err = hardfork.NewSoftRestarter(&mock.ConsensusHandlerStub{}, &mock.StateExporterStub{}, hardfork.ComponentsReloaderFunc(nil))
+	assert.True(t, sr == nil)
+	assert.Equal(t, hardfork.ErrNilComponentsReloader, err)
+}
+
+//------- SoftRestart
+
+func TestSoftRestarter_SoftRestartNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	calls := make([]string, 0)
+	sr, _ := hardfork.NewSoftRestarter(createRecordingComponents(&calls, ""))
+
+	err := sr.SoftRestart(nil)
+
+	assert.Equal(t, hardfork.ErrNilHeader, err)
+	assert.Equal(t, 0, len(calls))
+}
+
+func TestSoftRestarter_SoftRestartShouldCallTheStepsInOrder(t *testing.T) {
+	t.Parallel()
+
+	calls := make([]string, 0)
+	sr, _ := hardfork.NewSoftRestarter(createRecordingComponents(&calls, ""))
+
+	err := sr.SoftRestart(&block.Header{Nonce: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"stop", "export", "reload", "start"}, calls)
+}
+
+func TestSoftRestarter_SoftRestartFailingStepShouldNotStartTheConsensus(t *testing.T) {
+	t.Parallel()
+
+	expectedCalls := map[string][]string{
+		"stop":   {"stop"},
+		"export": {"stop", "export"},
+		"reload": {"stop", "export", "reload"},
+	}
+	for failingStep, expected := range expectedCalls {
+		calls := make([]string, 0)
+		sr, _ := hardfork.NewSoftRestarter(createRecordingComponents(&calls, failingStep))
+
+		err := sr.SoftRestart(&block.Header{Nonce: 10})
+
+		assert.Equal(t, errStep, err)
+		assert.Equal(t, expected, calls)
+	}
+}
@@ -0,0 +1,128 @@
+package hardfork
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// ExportedStateInfo is the first line of an exported state file, identifying the block which committed the state
+type ExportedStateInfo struct {
+	ShardID  uint32 `json:"shardID"`
+	Nonce    uint64 `json:"nonce"`
+	Epoch    uint32 `json:"epoch"`
+	RootHash string `json:"rootHash"`
+}
+
+// ExportedLeaf is one line of an exported state file, holding a leaf of the accounts trie
+type ExportedLeaf struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// stateExporter writes all the leaves of the accounts trie committed by a block in a file of the export directory,
+// one JSON object per line, so the state the hardfork started from can be checked and used as the new genesis
+type stateExporter struct {
+	accounts  state.AccountsAdapter
+	directory string
+}
+
+// NewStateExporter creates a new state exporter writing in the provided directory
+func NewStateExporter(accounts state.AccountsAdapter, directory string) (*stateExporter, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if len(directory) == 0 {
+		return nil, ErrEmptyExportDirectory
+	}
+
+	return &stateExporter{
+		accounts:  accounts,
+		directory: directory,
+	}, nil
+}
+
+// ExportState writes the state committed by the provided header
+func (se *stateExporter) ExportState(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+
+	err := os.MkdirAll(se.directory, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	fileName := filepath.Join(se.directory, ExportFileName(header.GetShardID(), header.GetNonce()))
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+
+	err = se.writeState(file, header)
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	log.Info("state exported for the hardfork", "file", fileName, "root hash", header.GetRootHash())
+	return nil
+}
+
+func (se *stateExporter) writeState(file *os.File, header data.HeaderHandler) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannel, err := se.accounts.GetAllLeavesOnChannel(ctx, header.GetRootHash())
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	err = encoder.Encode(&ExportedStateInfo{
+		ShardID:  header.GetShardID(),
+		Nonce:    header.GetNonce(),
+		Epoch:    header.GetEpoch(),
+		RootHash: hex.EncodeToString(header.GetRootHash()),
+	})
+	if err != nil {
+		return err
+	}
+
+	for leaf := range leavesChannel {
+		err = encoder.Encode(&ExportedLeaf{
+			Key:   hex.EncodeToString(leaf.Key()),
+			Value: hex.EncodeToString(leaf.Value()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return writer.Flush()
+}
+
+// ExportFileName returns the name of the file holding the state exported for the provided shard and stop nonce
+func ExportFileName(shardID uint32, nonce uint64) string {
+	return fmt.Sprintf("state_%d_%d.json", shardID, nonce)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *stateExporter) IsInterfaceNil() bool {
+	if se == nil {
+		return true
+	}
+	return false
+}
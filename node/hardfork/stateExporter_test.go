@@ -0,0 +1,121 @@
+package hardfork_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/node/hardfork"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createLeavesAccounts(leaves map[string]string) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		GetAllLeavesOnChannelCalled: func(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error) {
+			ch := make(chan data.KeyValueHolder, len(leaves))
+			for key, value := range leaves {
+				ch <- trie.NewKeyValStorage([]byte(key), []byte(value))
+			}
+			close(ch)
+
+			return ch, nil
+		},
+	}
+}
+
+func TestNewStateExporter_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	se, err := hardfork.NewStateExporter(nil, "export")
+
+	assert.True(t, se == nil)
+	assert.Equal(t, hardfork.ErrNilAccountsAdapter, err)
+}
+
+func TestNewStateExporter_EmptyDirectoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	se, err := hardfork.NewStateExporter(&mock.AccountsStub{}, "")
+
+	assert.True(t, se == nil)
+	assert.Equal(t, hardfork.ErrEmptyExportDirectory, err)
+}
+
+func TestStateExporter_ExportStateNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	se, _ := hardfork.NewStateExporter(&mock.AccountsStub{}, "export")
+
+	err := se.ExportState(nil)
+
+	assert.Equal(t, hardfork.ErrNilHeader, err)
+}
+
+func TestStateExporter_ExportStateLeavesErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "hardfork")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	errLeaves := errors.New("leaves error")
+	accounts := &mock.AccountsStub{
+		GetAllLeavesOnChannelCalled: func(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error) {
+			return nil, errLeaves
+		},
+	}
+	se, _ := hardfork.NewStateExporter(accounts, dir)
+
+	err := se.ExportState(&block.Header{Nonce: 10})
+
+	assert.Equal(t, errLeaves, err)
+}
+
+func TestStateExporter_ExportStateShouldWriteAllTheLeaves(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "hardfork")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	leaves := map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	}
+	exportDir := filepath.Join(dir, "export")
+	se, _ := hardfork.NewStateExporter(createLeavesAccounts(leaves), exportDir)
+
+	err := se.ExportState(&block.Header{ShardId: 1, Nonce: 10, Epoch: 2, RootHash: []byte("root hash")})
+	assert.Nil(t, err)
+
+	file, err := os.Open(filepath.Join(exportDir, hardfork.ExportFileName(1, 10)))
+	assert.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	assert.True(t, scanner.Scan())
+	info := &hardfork.ExportedStateInfo{}
+	_ = json.Unmarshal(scanner.Bytes(), info)
+	assert.Equal(t, &hardfork.ExportedStateInfo{ShardID: 1, Nonce: 10, Epoch: 2, RootHash: "726f6f742068617368"}, info)
+
+	exported := make(map[string]string)
+	for scanner.Scan() {
+		leaf := &hardfork.ExportedLeaf{}
+		_ = json.Unmarshal(scanner.Bytes(), leaf)
+		exported[leaf.Key] = leaf.Value
+	}
+	assert.Equal(t, map[string]string{"6b657931": "76616c756531", "6b657932": "76616c756532"}, exported)
+}
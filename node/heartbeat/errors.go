@@ -58,3 +58,12 @@ var ErrUnmarshalGenesisTime = errors.New("monitor: can't unmarshal genesis time"
 
 // ErrMarshalGenesisTime signals that the marshaling of the genesis time didn't work
 var ErrMarshalGenesisTime = errors.New("monitor: can't marshal genesis time")
+
+// ErrNilPeerMaintenanceHandler signals that a nil peer maintenance handler has been provided
+var ErrNilPeerMaintenanceHandler = errors.New("nil peer maintenance handler")
+
+// ErrInvalidMaxMaintenanceDuration signals that a negative maximum maintenance duration has been provided
+var ErrInvalidMaxMaintenanceDuration = errors.New("invalid maximum maintenance duration")
+
+// ErrInvalidMaintenanceDuration signals that a maintenance shorter than a second has been announced
+var ErrInvalidMaintenanceDuration = errors.New("invalid maintenance duration")
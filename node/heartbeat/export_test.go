@@ -70,3 +70,7 @@ func (hbmi *heartbeatMessageInfo) GetTotalDownTime() Duration {
 func (hbmi *heartbeatMessageInfo) GetIsActive() bool {
 	return hbmi.isActive
 }
+
+func (hbmi *heartbeatMessageInfo) GetTotalMaintenanceTime() Duration {
+	return hbmi.totalMaintenanceTime
+}
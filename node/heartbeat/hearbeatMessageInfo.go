@@ -23,6 +23,11 @@ type heartbeatMessageInfo struct {
 	lastUptimeDowntime time.Time
 	genesisTime        time.Time
 	updateMutex        sync.Mutex
+
+	maintenanceUntil     time.Time
+	totalMaintenanceTime Duration
+
+	hasGenesisMismatch bool
 }
 
 // newHeartbeatMessageInfo returns a new instance of a heartbeatMessageInfo
@@ -102,12 +107,33 @@ func (hbmi *heartbeatMessageInfo) updateUpAndDownTime(previousActive bool, crtTi
 	if previousActive && hbmi.isActive {
 		hbmi.totalUpTime.Duration += lastDuration
 	} else {
-		hbmi.totalDownTime.Duration += lastDuration
+		// the time spent down inside an announced maintenance window is not accounted as down time
+		maintenanceDuration := hbmi.maintenanceOverlap(crtTime)
+		hbmi.totalMaintenanceTime.Duration += maintenanceDuration
+		hbmi.totalDownTime.Duration += lastDuration - maintenanceDuration
 	}
 
 	hbmi.lastUptimeDowntime = crtTime
 }
 
+func (hbmi *heartbeatMessageInfo) maintenanceOverlap(crtTime time.Time) time.Duration {
+	end := crtTime
+	if hbmi.maintenanceUntil.Before(end) {
+		end = hbmi.maintenanceUntil
+	}
+
+	return maxDuration(0, end.Sub(hbmi.lastUptimeDowntime))
+}
+
+func (hbmi *heartbeatMessageInfo) isInMaintenance(crtTime time.Time) bool {
+	return crtTime.Before(hbmi.maintenanceUntil)
+}
+
+// MaintenanceAnnounced marks the peer as being down for a planned maintenance until the provided time
+func (hbmi *heartbeatMessageInfo) MaintenanceAnnounced(until time.Time) {
+	hbmi.maintenanceUntil = until
+}
+
 // HeartbeatReceived processes a new message arrived from a peer
 func (hbmi *heartbeatMessageInfo) HeartbeatReceived(
 	computedShardID uint32,
@@ -117,6 +143,8 @@ func (hbmi *heartbeatMessageInfo) HeartbeatReceived(
 ) {
 	crtTime := hbmi.getTimeHandler()
 	hbmi.updateFields(crtTime)
+	// a peer sending heartbeats again is back from its maintenance
+	hbmi.maintenanceUntil = time.Time{}
 	hbmi.computedShardID = computedShardID
 	hbmi.receivedShardID = receivedshardID
 	hbmi.timeStamp = crtTime
@@ -166,6 +166,30 @@ func TestHeartbeatMessageInfo_HeartbeatLongerDurationThanMaxShouldUpdateDownTime
 	assert.Equal(t, expectedTime, hbmi.GetTimeStamp())
 }
 
+func TestHeartbeatMessageInfo_AnnouncedMaintenanceShouldNotCountAsDownTime(t *testing.T) {
+	t.Parallel()
+
+	mockTimer := &mock.MockTimer{}
+	genesisTime := mockTimer.Now()
+	hbmi, _ := heartbeat.NewHeartbeatMessageInfo(
+		500*time.Millisecond,
+		false,
+		genesisTime,
+		mockTimer,
+	)
+
+	mockTimer.IncrementSeconds(1)
+	hbmi.HeartbeatReceived(uint32(0), uint32(0), "v0.1", "undefined")
+	hbmi.MaintenanceAnnounced(mockTimer.Now().Add(3 * time.Second))
+	mockTimer.IncrementSeconds(5)
+	hbmi.HeartbeatReceived(uint32(0), uint32(0), "v0.1", "undefined")
+
+	// 1s before the first heartbeat and 2s after the maintenance window ended
+	assert.Equal(t, 3*time.Second, hbmi.GetTotalDownTime().Duration)
+	assert.Equal(t, 3*time.Second, hbmi.GetTotalMaintenanceTime().Duration)
+	assert.Equal(t, time.Duration(0), hbmi.GetTotalUpTime().Duration)
+}
+
 func TestHeartbeatMessageInfo_HeartbeatBeforeGenesisShouldNotUpdateUpDownTime(t *testing.T) {
 	t.Parallel()
 
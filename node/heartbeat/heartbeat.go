@@ -4,29 +4,37 @@ import (
 	"time"
 )
 
-// Heartbeat represents the heartbeat message that is sent between peers
+// Heartbeat represents the heartbeat message that is sent between peers. A heartbeat with a non zero maintenance
+// duration announces that the node is going down for a planned maintenance. The field is omitted when empty so the
+// regular heartbeats are serialized, and signed, as before. The same goes for the genesis checksum, which identifies
+// the genesis configuration the sender started from
 type Heartbeat struct {
-	Payload         []byte
-	Pubkey          []byte
-	Signature       []byte
-	ShardID         uint32
-	VersionNumber   string
-	NodeDisplayName string
+	Payload                  []byte
+	Pubkey                   []byte
+	Signature                []byte
+	ShardID                  uint32
+	VersionNumber            string
+	NodeDisplayName          string
+	MaintenanceDurationInSec uint64 `json:",omitempty"`
+	GenesisChecksum          []byte `json:",omitempty"`
 }
 
 // PubKeyHeartbeat returns the heartbeat status for a public key
 type PubKeyHeartbeat struct {
-	HexPublicKey    string    `json:"hexPublicKey"`
-	TimeStamp       time.Time `json:"timeStamp"`
-	MaxInactiveTime Duration  `json:"maxInactiveTime"`
-	IsActive        bool      `json:"isActive"`
-	ReceivedShardID uint32    `json:"receivedShardID"`
-	ComputedShardID uint32    `json:"computedShardID"`
-	TotalUpTime     int       `json:"totalUpTimeSec"`
-	TotalDownTime   int       `json:"totalDownTimeSec"`
-	VersionNumber   string    `json:"versionNumber"`
-	IsValidator     bool      `json:"isValidator"`
-	NodeDisplayName string    `json:"nodeDisplayName"`
+	HexPublicKey         string    `json:"hexPublicKey"`
+	TimeStamp            time.Time `json:"timeStamp"`
+	MaxInactiveTime      Duration  `json:"maxInactiveTime"`
+	IsActive             bool      `json:"isActive"`
+	ReceivedShardID      uint32    `json:"receivedShardID"`
+	ComputedShardID      uint32    `json:"computedShardID"`
+	TotalUpTime          int       `json:"totalUpTimeSec"`
+	TotalDownTime        int       `json:"totalDownTimeSec"`
+	VersionNumber        string    `json:"versionNumber"`
+	IsValidator          bool      `json:"isValidator"`
+	NodeDisplayName      string    `json:"nodeDisplayName"`
+	IsInMaintenance      bool      `json:"isInMaintenance"`
+	TotalMaintenanceTime int       `json:"totalMaintenanceTimeSec"`
+	HasGenesisMismatch   bool      `json:"hasGenesisMismatch"`
 }
 
 // HeartbeatDTO is the struct used for handling DB operations for heartbeatMessageInfo struct
@@ -44,4 +52,6 @@ type HeartbeatDTO struct {
 	IsValidator                 bool
 	LastUptimeDowntime          time.Time
 	GenesisTime                 time.Time
+	MaintenanceUntil            time.Time
+	TotalMaintenanceTime        Duration
 }
@@ -24,6 +24,13 @@ type Timer interface {
 	IsInterfaceNil() bool
 }
 
+// PeerMaintenanceHandler defines the behavior of a component which has to be lenient with the peers that announced
+// a planned maintenance, until the end of the announced window
+type PeerMaintenanceHandler interface {
+	PeerInMaintenance(pid p2p.PeerID, until time.Time)
+	IsInterfaceNil() bool
+}
+
 // HeartbeatStorageHandler defines what a heartbeat's storer should do
 type HeartbeatStorageHandler interface {
 	LoadGenesisTime() (time.Time, error)
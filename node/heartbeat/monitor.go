@@ -16,7 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("node/heartbeat")
 
 // Monitor represents the heartbeat component that processes received heartbeat messages
 type Monitor struct {
@@ -33,6 +33,9 @@ type Monitor struct {
 	messageHandler              MessageHandler
 	storer                      HeartbeatStorageHandler
 	timer                       Timer
+	maxMaintenanceDuration      time.Duration
+	peerMaintenanceHandler      PeerMaintenanceHandler
+	genesisChecksum             []byte
 }
 
 // NewMonitor returns a new monitor instance
@@ -160,6 +163,33 @@ func (m *Monitor) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetMaxMaintenanceDuration sets the longest maintenance window honored for a peer which announced a planned
+// maintenance. Longer announced maintenances are cut to this duration, and 0 ignores the announcements
+func (m *Monitor) SetMaxMaintenanceDuration(maxMaintenanceDuration time.Duration) error {
+	if maxMaintenanceDuration < 0 {
+		return ErrInvalidMaxMaintenanceDuration
+	}
+
+	m.maxMaintenanceDuration = maxMaintenanceDuration
+	return nil
+}
+
+// SetPeerMaintenanceHandler sets the component notified about the peers which announced a planned maintenance
+func (m *Monitor) SetPeerMaintenanceHandler(handler PeerMaintenanceHandler) error {
+	if handler == nil || handler.IsInterfaceNil() {
+		return ErrNilPeerMaintenanceHandler
+	}
+
+	m.peerMaintenanceHandler = handler
+	return nil
+}
+
+// SetGenesisChecksum sets the checksum of the genesis the node started from. The peers sending a different genesis
+// checksum are flagged as started from a different genesis configuration. An empty checksum disables the check
+func (m *Monitor) SetGenesisChecksum(genesisChecksum []byte) {
+	m.genesisChecksum = genesisChecksum
+}
+
 // ProcessReceivedMessage satisfies the p2p.MessageProcessor interface so it can be called
 // by the p2p subsystem each time a new heartbeat message arrives
 func (m *Monitor) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
@@ -168,6 +198,11 @@ func (m *Monitor) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSe
 		return err
 	}
 
+	maintenanceDuration := m.maintenanceDuration(hbRecv)
+	if maintenanceDuration > 0 && m.peerMaintenanceHandler != nil {
+		m.peerMaintenanceHandler.PeerInMaintenance(message.Peer(), m.timer.Now().Add(maintenanceDuration))
+	}
+
 	//message is validated, process should be done async, method can return nil
 	go m.addHeartbeatMessageToMap(hbRecv)
 
@@ -196,6 +231,21 @@ func (m *Monitor) addHeartbeatMessageToMap(hb *Heartbeat) {
 
 	hbmi.updateMutex.Lock()
 	hbmi.HeartbeatReceived(computedShardID, hb.ShardID, hb.VersionNumber, hb.NodeDisplayName)
+	maintenanceDuration := m.maintenanceDuration(hb)
+	if maintenanceDuration > 0 {
+		hbmi.MaintenanceAnnounced(hbmi.timeStamp.Add(maintenanceDuration))
+		log.Debug(fmt.Sprintf("peer %s announced a maintenance of %v", hex.EncodeToString(hb.Pubkey), maintenanceDuration))
+	}
+	hasGenesisMismatch := m.hasGenesisMismatch(hb)
+	if hasGenesisMismatch && !hbmi.hasGenesisMismatch {
+		log.Warn("peer started from a different genesis",
+			"pubkey", hb.Pubkey,
+			"node name", hb.NodeDisplayName,
+			"own genesis checksum", m.genesisChecksum,
+			"peer genesis checksum", hb.GenesisChecksum,
+		)
+	}
+	hbmi.hasGenesisMismatch = hasGenesisMismatch
 	hbDTO := m.convertToExportedStruct(hbmi)
 	hbmi.updateMutex.Unlock()
 
@@ -206,6 +256,24 @@ func (m *Monitor) addHeartbeatMessageToMap(hb *Heartbeat) {
 	m.addPeerToFullPeersSlice(hb.Pubkey)
 }
 
+func (m *Monitor) maintenanceDuration(hb *Heartbeat) time.Duration {
+	duration := time.Duration(hb.MaintenanceDurationInSec) * time.Second
+	if duration > m.maxMaintenanceDuration || duration < 0 {
+		return m.maxMaintenanceDuration
+	}
+
+	return duration
+}
+
+// hasGenesisMismatch returns true if both the node and the peer know their genesis checksum and the checksums differ
+func (m *Monitor) hasGenesisMismatch(hb *Heartbeat) bool {
+	if len(m.genesisChecksum) == 0 || len(hb.GenesisChecksum) == 0 {
+		return false
+	}
+
+	return !bytes.Equal(m.genesisChecksum, hb.GenesisChecksum)
+}
+
 func (m *Monitor) addPeerToFullPeersSlice(pubKey []byte) {
 	m.mutFullPeersSlice.Lock()
 	defer m.mutFullPeersSlice.Unlock()
@@ -269,22 +337,26 @@ func (m *Monitor) computeAllHeartbeatMessages() {
 func (m *Monitor) GetHeartbeats() []PubKeyHeartbeat {
 	m.computeAllHeartbeatMessages()
 
+	crtTime := m.timer.Now()
 	m.mutHeartbeatMessages.Lock()
 	status := make([]PubKeyHeartbeat, len(m.heartbeatMessages))
 	idx := 0
 	for k, v := range m.heartbeatMessages {
 		status[idx] = PubKeyHeartbeat{
-			HexPublicKey:    hex.EncodeToString([]byte(k)),
-			TimeStamp:       v.timeStamp,
-			MaxInactiveTime: v.maxInactiveTime,
-			IsActive:        v.isActive,
-			ReceivedShardID: v.receivedShardID,
-			ComputedShardID: v.computedShardID,
-			TotalUpTime:     int(v.totalUpTime.Seconds()),
-			TotalDownTime:   int(v.totalDownTime.Seconds()),
-			VersionNumber:   v.versionNumber,
-			IsValidator:     v.isValidator,
-			NodeDisplayName: v.nodeDisplayName,
+			HexPublicKey:         hex.EncodeToString([]byte(k)),
+			TimeStamp:            v.timeStamp,
+			MaxInactiveTime:      v.maxInactiveTime,
+			IsActive:             v.isActive,
+			ReceivedShardID:      v.receivedShardID,
+			ComputedShardID:      v.computedShardID,
+			TotalUpTime:          int(v.totalUpTime.Seconds()),
+			TotalDownTime:        int(v.totalDownTime.Seconds()),
+			VersionNumber:        v.versionNumber,
+			IsValidator:          v.isValidator,
+			NodeDisplayName:      v.nodeDisplayName,
+			IsInMaintenance:      v.isInMaintenance(crtTime),
+			TotalMaintenanceTime: int(v.totalMaintenanceTime.Seconds()),
+			HasGenesisMismatch:   v.hasGenesisMismatch,
 		}
 		idx++
 	}
@@ -307,18 +379,20 @@ func (m *Monitor) IsInterfaceNil() bool {
 
 func (m *Monitor) convertToExportedStruct(v *heartbeatMessageInfo) HeartbeatDTO {
 	return HeartbeatDTO{
-		TimeStamp:          v.timeStamp,
-		MaxInactiveTime:    v.maxInactiveTime,
-		IsActive:           v.isActive,
-		ReceivedShardID:    v.receivedShardID,
-		ComputedShardID:    v.computedShardID,
-		TotalUpTime:        v.totalUpTime,
-		TotalDownTime:      v.totalDownTime,
-		VersionNumber:      v.versionNumber,
-		IsValidator:        v.isValidator,
-		NodeDisplayName:    v.nodeDisplayName,
-		LastUptimeDowntime: v.lastUptimeDowntime,
-		GenesisTime:        v.genesisTime,
+		TimeStamp:            v.timeStamp,
+		MaxInactiveTime:      v.maxInactiveTime,
+		IsActive:             v.isActive,
+		ReceivedShardID:      v.receivedShardID,
+		ComputedShardID:      v.computedShardID,
+		TotalUpTime:          v.totalUpTime,
+		TotalDownTime:        v.totalDownTime,
+		VersionNumber:        v.versionNumber,
+		IsValidator:          v.isValidator,
+		NodeDisplayName:      v.nodeDisplayName,
+		LastUptimeDowntime:   v.lastUptimeDowntime,
+		GenesisTime:          v.genesisTime,
+		MaintenanceUntil:     v.maintenanceUntil,
+		TotalMaintenanceTime: v.totalMaintenanceTime,
 	}
 }
 
@@ -337,6 +411,8 @@ func (m *Monitor) convertFromExportedStruct(hbDTO HeartbeatDTO, maxDuration time
 		isValidator:                 hbDTO.IsValidator,
 		lastUptimeDowntime:          hbDTO.LastUptimeDowntime,
 		genesisTime:                 hbDTO.GenesisTime,
+		maintenanceUntil:            hbDTO.MaintenanceUntil,
+		totalMaintenanceTime:        hbDTO.TotalMaintenanceTime,
 	}
 
 	return hbmi
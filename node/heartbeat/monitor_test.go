@@ -239,6 +239,157 @@ func TestMonitor_ProcessReceivedMessageShouldWork(t *testing.T) {
 	assert.Equal(t, hex.EncodeToString([]byte(pubKey)), hbStatus[0].HexPublicKey)
 }
 
+func TestMonitor_ProcessReceivedMessageWithMaintenanceShouldNotifyTheHandler(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	pid := p2p.PeerID("pid1")
+
+	th := &mock.MockTimer{}
+	mon, _ := heartbeat.NewMonitor(
+		&mock.MarshalizerMock{},
+		time.Second*1000,
+		map[uint32][]string{0: {pubKey}},
+		time.Now(),
+		&mock.MessageHandlerStub{
+			CreateHeartbeatFromP2pMessageCalled: func(message p2p.MessageP2P) (*heartbeat.Heartbeat, error) {
+				var rcvHb heartbeat.Heartbeat
+				_ = json.Unmarshal(message.Data(), &rcvHb)
+				return &rcvHb, nil
+			},
+		},
+		&mock.HeartbeatStorerStub{
+			UpdateGenesisTimeCalled: func(genesisTime time.Time) error {
+				return nil
+			},
+			LoadHbmiDTOCalled: func(pubKey string) (*heartbeat.HeartbeatDTO, error) {
+				return nil, errors.New("not found")
+			},
+			LoadKeysCalled: func() ([][]byte, error) {
+				return nil, nil
+			},
+			SavePubkeyDataCalled: func(pubkey []byte, heartbeat *heartbeat.HeartbeatDTO) error {
+				return nil
+			},
+			SaveKeysCalled: func(peersSlice [][]byte) error {
+				return nil
+			},
+		},
+		th,
+	)
+
+	var notifiedPid p2p.PeerID
+	var notifiedUntil time.Time
+	err := mon.SetPeerMaintenanceHandler(&mock.PeerMaintenanceHandlerStub{
+		PeerInMaintenanceCalled: func(pid p2p.PeerID, until time.Time) {
+			notifiedPid = pid
+			notifiedUntil = until
+		},
+	})
+	assert.Nil(t, err)
+	err = mon.SetMaxMaintenanceDuration(time.Minute)
+	assert.Nil(t, err)
+
+	hb := heartbeat.Heartbeat{
+		Pubkey:                   []byte(pubKey),
+		MaintenanceDurationInSec: 3600,
+	}
+	hbBytes, _ := json.Marshal(hb)
+	err = mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: hbBytes, PeerField: pid}, nil)
+	assert.Nil(t, err)
+
+	assert.Equal(t, pid, notifiedPid)
+	assert.Equal(t, th.Now().Add(time.Minute), notifiedUntil)
+}
+
+func createMonitorForGenesisChecksum(pubKey string) *heartbeat.Monitor {
+	mon, _ := heartbeat.NewMonitor(
+		&mock.MarshalizerMock{},
+		time.Second*1000,
+		map[uint32][]string{0: {pubKey}},
+		time.Now(),
+		&mock.MessageHandlerStub{
+			CreateHeartbeatFromP2pMessageCalled: func(message p2p.MessageP2P) (*heartbeat.Heartbeat, error) {
+				var rcvHb heartbeat.Heartbeat
+				_ = json.Unmarshal(message.Data(), &rcvHb)
+				return &rcvHb, nil
+			},
+		},
+		&mock.HeartbeatStorerStub{
+			UpdateGenesisTimeCalled: func(genesisTime time.Time) error {
+				return nil
+			},
+			LoadHbmiDTOCalled: func(pubKey string) (*heartbeat.HeartbeatDTO, error) {
+				return nil, errors.New("not found")
+			},
+			LoadKeysCalled: func() ([][]byte, error) {
+				return nil, nil
+			},
+			SavePubkeyDataCalled: func(pubkey []byte, heartbeat *heartbeat.HeartbeatDTO) error {
+				return nil
+			},
+			SaveKeysCalled: func(peersSlice [][]byte) error {
+				return nil
+			},
+		},
+		&mock.MockTimer{},
+	)
+
+	return mon
+}
+
+func TestMonitor_ProcessReceivedMessageWithDifferentGenesisChecksumShouldFlagThePeer(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	mon := createMonitorForGenesisChecksum(pubKey)
+	mon.SetGenesisChecksum([]byte("own checksum"))
+
+	hb := heartbeat.Heartbeat{
+		Pubkey:          []byte(pubKey),
+		GenesisChecksum: []byte("other checksum"),
+	}
+	hbBytes, _ := json.Marshal(hb)
+	err := mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: hbBytes}, nil)
+	assert.Nil(t, err)
+
+	//a delay is mandatory for the go routine to finish its job
+	time.Sleep(time.Second)
+
+	hbStatus := mon.GetHeartbeats()
+	assert.Equal(t, 1, len(hbStatus))
+	assert.True(t, hbStatus[0].HasGenesisMismatch)
+}
+
+func TestMonitor_ProcessReceivedMessageWithSameOrMissingGenesisChecksumShouldNotFlagThePeer(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	mon := createMonitorForGenesisChecksum(pubKey)
+	mon.SetGenesisChecksum([]byte("own checksum"))
+
+	hb := heartbeat.Heartbeat{
+		Pubkey:          []byte(pubKey),
+		GenesisChecksum: []byte("own checksum"),
+	}
+	hbBytes, _ := json.Marshal(hb)
+	err := mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: hbBytes}, nil)
+	assert.Nil(t, err)
+	time.Sleep(time.Second)
+
+	hbStatus := mon.GetHeartbeats()
+	assert.False(t, hbStatus[0].HasGenesisMismatch)
+
+	hb.GenesisChecksum = nil
+	hbBytes, _ = json.Marshal(hb)
+	err = mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: hbBytes}, nil)
+	assert.Nil(t, err)
+	time.Sleep(time.Second)
+
+	hbStatus = mon.GetHeartbeats()
+	assert.False(t, hbStatus[0].HasGenesisMismatch)
+}
+
 func TestMonitor_ProcessReceivedMessageWithNewPublicKey(t *testing.T) {
 	t.Parallel()
 
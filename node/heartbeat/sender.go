@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	dataHeartbeat "github.com/ElrondNetwork/elrond-go/data/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -19,6 +21,10 @@ type Sender struct {
 	shardCoordinator sharding.Coordinator
 	versionNumber    string
 	nodeDisplayName  string
+	genesisChecksum  []byte
+	peerAuthTopic    string
+	pid              p2p.PeerID
+	peerAuthValidity time.Duration
 }
 
 // NewSender will create a new sender instance
@@ -63,14 +69,43 @@ func NewSender(
 	return sender, nil
 }
 
+// SetGenesisChecksum sets the checksum of the genesis the node started from. It is sent in every heartbeat so the
+// peers can detect the nodes started with a different genesis configuration
+func (s *Sender) SetGenesisChecksum(genesisChecksum []byte) {
+	s.genesisChecksum = genesisChecksum
+}
+
+// SetPeerAuthentication makes the sender broadcast, along each heartbeat, a peer authentication message binding the
+// provided peer ID to the node's public key. The message expires after the provided validity
+func (s *Sender) SetPeerAuthentication(topic string, pid p2p.PeerID, validity time.Duration) {
+	s.peerAuthTopic = topic
+	s.pid = pid
+	s.peerAuthValidity = validity
+}
+
 // SendHeartbeat broadcasts a new heartbeat message
 func (s *Sender) SendHeartbeat() error {
+	return s.sendHeartbeat(0)
+}
+
+// SendMaintenanceAnnouncement broadcasts a heartbeat message announcing that the node is going down for a planned
+// maintenance lasting the provided duration
+func (s *Sender) SendMaintenanceAnnouncement(duration time.Duration) error {
+	if duration < time.Second {
+		return ErrInvalidMaintenanceDuration
+	}
 
+	return s.sendHeartbeat(uint64(duration.Seconds()))
+}
+
+func (s *Sender) sendHeartbeat(maintenanceDurationInSec uint64) error {
 	hb := &Heartbeat{
-		Payload:         []byte(fmt.Sprintf("%v", time.Now())),
-		ShardID:         s.shardCoordinator.SelfId(),
-		VersionNumber:   s.versionNumber,
-		NodeDisplayName: s.nodeDisplayName,
+		Payload:                  []byte(fmt.Sprintf("%v", time.Now())),
+		ShardID:                  s.shardCoordinator.SelfId(),
+		VersionNumber:            s.versionNumber,
+		NodeDisplayName:          s.nodeDisplayName,
+		MaintenanceDurationInSec: maintenanceDurationInSec,
+		GenesisChecksum:          s.genesisChecksum,
 	}
 
 	var err error
@@ -96,5 +131,36 @@ func (s *Sender) SendHeartbeat() error {
 
 	s.peerMessenger.Broadcast(s.topic, buffToSend)
 
+	if len(s.peerAuthTopic) == 0 {
+		return nil
+	}
+
+	return s.sendPeerAuthentication(hb.Pubkey)
+}
+
+func (s *Sender) sendPeerAuthentication(pubkey []byte) error {
+	peerAuth := &dataHeartbeat.PeerAuthentication{
+		Pubkey: pubkey,
+		Pid:    []byte(s.pid),
+		Expiry: time.Now().Add(s.peerAuthValidity).Unix(),
+	}
+
+	peerAuthBytes, err := s.marshalizer.Marshal(peerAuth)
+	if err != nil {
+		return err
+	}
+
+	peerAuth.Signature, err = s.singleSigner.Sign(s.privKey, peerAuthBytes)
+	if err != nil {
+		return err
+	}
+
+	buffToSend, err := s.marshalizer.Marshal(peerAuth)
+	if err != nil {
+		return err
+	}
+
+	s.peerMessenger.Broadcast(s.peerAuthTopic, buffToSend)
+
 	return nil
 }
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	dataHeartbeat "github.com/ElrondNetwork/elrond-go/data/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/stretchr/testify/assert"
@@ -312,3 +314,176 @@ func TestSender_SendHeartbeatShouldWork(t *testing.T) {
 	assert.True(t, genPubKeyClled)
 	assert.True(t, marshalCalled)
 }
+
+//------- SendMaintenanceAnnouncement
+
+func TestSender_SendMaintenanceAnnouncementInvalidDurationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sender, _ := heartbeat.NewSender(
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				assert.Fail(t, "should have not broadcast")
+			},
+		},
+		&mock.SinglesignStub{},
+		&mock.PrivateKeyStub{},
+		&mock.MarshalizerMock{},
+		"topic",
+		&mock.ShardCoordinatorMock{},
+		"v0.1",
+		"undefined",
+	)
+
+	err := sender.SendMaintenanceAnnouncement(time.Millisecond)
+
+	assert.Equal(t, heartbeat.ErrInvalidMaintenanceDuration, err)
+}
+
+func TestSender_SendMaintenanceAnnouncementShouldSetTheDuration(t *testing.T) {
+	t.Parallel()
+
+	pubKey := &mock.PublicKeyMock{
+		ToByteArrayHandler: func() (i []byte, e error) {
+			return []byte("pub key"), nil
+		},
+	}
+
+	broadcastCalled := false
+	var signedHeartbeat heartbeat.Heartbeat
+	sender, _ := heartbeat.NewSender(
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				broadcastCalled = true
+			},
+		},
+		&mock.SinglesignStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+				return []byte("signature"), nil
+			},
+		},
+		&mock.PrivateKeyStub{
+			GeneratePublicHandler: func() crypto.PublicKey {
+				return pubKey
+			},
+		},
+		&mock.MarshalizerMock{
+			MarshalHandler: func(obj interface{}) (i []byte, e error) {
+				signedHeartbeat = *obj.(*heartbeat.Heartbeat)
+				return []byte("buff"), nil
+			},
+		},
+		"topic",
+		&mock.ShardCoordinatorMock{},
+		"v0.1",
+		"undefined",
+	)
+
+	err := sender.SendMaintenanceAnnouncement(10 * time.Minute)
+
+	assert.Nil(t, err)
+	assert.True(t, broadcastCalled)
+	assert.Equal(t, uint64(600), signedHeartbeat.MaintenanceDurationInSec)
+}
+
+//------- SetGenesisChecksum
+
+func TestSender_SendHeartbeatShouldSendTheGenesisChecksum(t *testing.T) {
+	t.Parallel()
+
+	pubKey := &mock.PublicKeyMock{
+		ToByteArrayHandler: func() (i []byte, e error) {
+			return []byte("pub key"), nil
+		},
+	}
+
+	var signedHeartbeat heartbeat.Heartbeat
+	sender, _ := heartbeat.NewSender(
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+			},
+		},
+		&mock.SinglesignStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+				return []byte("signature"), nil
+			},
+		},
+		&mock.PrivateKeyStub{
+			GeneratePublicHandler: func() crypto.PublicKey {
+				return pubKey
+			},
+		},
+		&mock.MarshalizerMock{
+			MarshalHandler: func(obj interface{}) (i []byte, e error) {
+				signedHeartbeat = *obj.(*heartbeat.Heartbeat)
+				return []byte("buff"), nil
+			},
+		},
+		"topic",
+		&mock.ShardCoordinatorMock{},
+		"v0.1",
+		"undefined",
+	)
+	sender.SetGenesisChecksum([]byte("genesis checksum"))
+
+	err := sender.SendHeartbeat()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("genesis checksum"), signedHeartbeat.GenesisChecksum)
+}
+
+//------- SetPeerAuthentication
+
+func TestSender_SendHeartbeatShouldSendThePeerAuthentication(t *testing.T) {
+	t.Parallel()
+
+	pubKey := &mock.PublicKeyMock{
+		ToByteArrayHandler: func() (i []byte, e error) {
+			return []byte("pub key"), nil
+		},
+	}
+
+	broadcastTopics := make([]string, 0)
+	var signedPeerAuth dataHeartbeat.PeerAuthentication
+	sender, _ := heartbeat.NewSender(
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				broadcastTopics = append(broadcastTopics, topic)
+			},
+		},
+		&mock.SinglesignStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+				return []byte("signature"), nil
+			},
+		},
+		&mock.PrivateKeyStub{
+			GeneratePublicHandler: func() crypto.PublicKey {
+				return pubKey
+			},
+		},
+		&mock.MarshalizerMock{
+			MarshalHandler: func(obj interface{}) (i []byte, e error) {
+				peerAuth, ok := obj.(*dataHeartbeat.PeerAuthentication)
+				if ok {
+					signedPeerAuth = *peerAuth
+				}
+				return []byte("buff"), nil
+			},
+		},
+		"topic",
+		&mock.ShardCoordinatorMock{},
+		"v0.1",
+		"undefined",
+	)
+	sender.SetPeerAuthentication("peerAuthTopic", "pid", time.Minute)
+
+	err := sender.SendHeartbeat()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"topic", "peerAuthTopic"}, broadcastTopics)
+	assert.Equal(t, []byte("pub key"), signedPeerAuth.Pubkey)
+	assert.Equal(t, []byte("pid"), signedPeerAuth.Pid)
+	assert.Equal(t, []byte("signature"), signedPeerAuth.Signature)
+	assert.True(t, signedPeerAuth.Expiry > time.Now().Unix())
+	assert.True(t, signedPeerAuth.Expiry <= time.Now().Add(time.Minute).Unix())
+}
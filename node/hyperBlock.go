@@ -0,0 +1,212 @@
+package node
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const (
+	normalTxType   = "normal"
+	invalidTxType  = "invalid"
+	unsignedTxType = "unsigned"
+	rewardTxType   = "reward"
+)
+
+// GetHyperBlockByNonce returns the metachain block with the provided nonce together with the shard blocks it
+// notarized, hydrated with their miniblocks and transactions. The details of the shard blocks, of the miniblocks and
+// of the transactions are filled in when they are in the node's storage
+func (n *Node) GetHyperBlockByNonce(nonce uint64) (*external.HyperBlock, error) {
+	if n.store == nil || n.store.IsInterfaceNil() {
+		return nil, ErrNilStore
+	}
+	if n.marshalizer == nil || n.marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+
+	metaBlock, metaBlockHash, err := process.GetMetaHeaderFromStorageWithNonce(
+		nonce,
+		n.store,
+		n.uint64ByteSliceConverter,
+		n.marshalizer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlock := &external.HyperBlock{
+		Nonce:       metaBlock.Nonce,
+		Round:       metaBlock.Round,
+		Epoch:       metaBlock.Epoch,
+		Hash:        hex.EncodeToString(metaBlockHash),
+		PrevHash:    hex.EncodeToString(metaBlock.PrevHash),
+		Timestamp:   metaBlock.TimeStamp,
+		TxCount:     metaBlock.TxCount,
+		ShardBlocks: make([]*external.NotarizedShardBlock, 0, len(metaBlock.ShardInfo)),
+	}
+	for _, shardData := range metaBlock.ShardInfo {
+		hyperBlock.ShardBlocks = append(hyperBlock.ShardBlocks, n.createNotarizedShardBlock(shardData))
+	}
+
+	return hyperBlock, nil
+}
+
+func (n *Node) createNotarizedShardBlock(shardData block.ShardData) *external.NotarizedShardBlock {
+	shardBlock := &external.NotarizedShardBlock{
+		Hash:       hex.EncodeToString(shardData.HeaderHash),
+		ShardID:    shardData.ShardId,
+		TxCount:    shardData.TxCount,
+		MiniBlocks: make([]*external.NotarizedMiniBlock, 0, len(shardData.ShardMiniBlockHeaders)),
+	}
+
+	shardHeader, err := process.GetShardHeaderFromStorage(shardData.HeaderHash, n.marshalizer, n.store)
+	if err == nil {
+		shardBlock.Nonce = shardHeader.Nonce
+		shardBlock.Round = shardHeader.Round
+		shardBlock.Epoch = shardHeader.Epoch
+		shardBlock.PrevHash = hex.EncodeToString(shardHeader.PrevHash)
+		shardBlock.RootHash = hex.EncodeToString(shardHeader.RootHash)
+		shardBlock.Timestamp = shardHeader.TimeStamp
+	}
+
+	for _, miniBlockHeader := range shardData.ShardMiniBlockHeaders {
+		notarizedMiniBlock := &external.NotarizedMiniBlock{
+			Hash:            hex.EncodeToString(miniBlockHeader.Hash),
+			SenderShardID:   miniBlockHeader.SenderShardId,
+			ReceiverShardID: miniBlockHeader.ReceiverShardId,
+			TxCount:         miniBlockHeader.TxCount,
+		}
+		n.hydrateMiniBlock(notarizedMiniBlock, miniBlockHeader.Hash)
+
+		shardBlock.MiniBlocks = append(shardBlock.MiniBlocks, notarizedMiniBlock)
+	}
+
+	return shardBlock
+}
+
+func (n *Node) hydrateMiniBlock(notarizedMiniBlock *external.NotarizedMiniBlock, miniBlockHash []byte) {
+	buff, err := n.store.Get(dataRetriever.MiniBlockUnit, miniBlockHash)
+	if err != nil {
+		return
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = n.marshalizer.Unmarshal(miniBlock, buff)
+	if err != nil {
+		return
+	}
+
+	notarizedMiniBlock.Type = miniBlock.Type.String()
+	notarizedMiniBlock.Transactions = make([]*external.HyperBlockTransaction, 0, len(miniBlock.TxHashes))
+	for _, txHash := range miniBlock.TxHashes {
+		tx, errGet := n.getHyperBlockTransaction(txHash, miniBlock.Type)
+		if errGet != nil {
+			log.Debug("hyperblock transaction not found", "hash", txHash, "error", errGet.Error())
+			continue
+		}
+
+		tx.SourceShard = miniBlock.SenderShardID
+		tx.DestinationShard = miniBlock.ReceiverShardID
+		notarizedMiniBlock.Transactions = append(notarizedMiniBlock.Transactions, tx)
+	}
+}
+
+func (n *Node) getHyperBlockTransaction(txHash []byte, miniBlockType block.Type) (*external.HyperBlockTransaction, error) {
+	switch miniBlockType {
+	case block.SmartContractResultBlock:
+		return n.getHyperBlockUnsignedTransaction(txHash)
+	case block.RewardsBlock:
+		return n.getHyperBlockRewardTransaction(txHash)
+	case block.InvalidBlock:
+		return n.getHyperBlockNormalTransaction(txHash, invalidTxType)
+	default:
+		return n.getHyperBlockNormalTransaction(txHash, normalTxType)
+	}
+}
+
+func (n *Node) getHyperBlockNormalTransaction(txHash []byte, txType string) (*external.HyperBlockTransaction, error) {
+	buff, err := n.store.Get(dataRetriever.TransactionUnit, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.Transaction{}
+	err = n.marshalizer.Unmarshal(tx, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external.HyperBlockTransaction{
+		Hash:      hex.EncodeToString(txHash),
+		Type:      txType,
+		Nonce:     tx.Nonce,
+		Value:     bigIntToString(tx.Value),
+		Sender:    hex.EncodeToString(tx.SndAddr),
+		Receiver:  hex.EncodeToString(tx.RcvAddr),
+		GasPrice:  tx.GasPrice,
+		GasLimit:  tx.GasLimit,
+		Data:      tx.Data,
+		Signature: hex.EncodeToString(tx.Signature),
+	}, nil
+}
+
+func (n *Node) getHyperBlockUnsignedTransaction(txHash []byte) (*external.HyperBlockTransaction, error) {
+	buff, err := n.store.Get(dataRetriever.UnsignedTransactionUnit, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	scr := &smartContractResult.SmartContractResult{}
+	err = n.marshalizer.Unmarshal(scr, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external.HyperBlockTransaction{
+		Hash:           hex.EncodeToString(txHash),
+		Type:           unsignedTxType,
+		Nonce:          scr.Nonce,
+		Value:          bigIntToString(scr.Value),
+		Sender:         hex.EncodeToString(scr.SndAddr),
+		Receiver:       hex.EncodeToString(scr.RcvAddr),
+		GasPrice:       scr.GasPrice,
+		GasLimit:       scr.GasLimit,
+		Data:           scr.Data,
+		OriginalTxHash: hex.EncodeToString(scr.TxHash),
+	}, nil
+}
+
+func (n *Node) getHyperBlockRewardTransaction(txHash []byte) (*external.HyperBlockTransaction, error) {
+	buff, err := n.store.Get(dataRetriever.RewardTransactionUnit, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	reward := &rewardTx.RewardTx{}
+	err = n.marshalizer.Unmarshal(reward, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external.HyperBlockTransaction{
+		Hash:     hex.EncodeToString(txHash),
+		Type:     rewardTxType,
+		Value:    bigIntToString(reward.Value),
+		Receiver: hex.EncodeToString(reward.RcvAddr),
+	}, nil
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
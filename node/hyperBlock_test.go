@@ -0,0 +1,160 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type mapStore map[dataRetriever.UnitType]map[string][]byte
+
+func (ms mapStore) put(unitType dataRetriever.UnitType, key []byte, value []byte) {
+	if ms[unitType] == nil {
+		ms[unitType] = make(map[string][]byte)
+	}
+	ms[unitType][string(key)] = value
+}
+
+func (ms mapStore) get(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+	value, ok := ms[unitType][string(key)]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	return value, nil
+}
+
+func (ms mapStore) chainStorer() dataRetriever.StorageService {
+	return &mock.ChainStorerMock{
+		GetCalled: ms.get,
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					return ms.get(unitType, key)
+				},
+			}
+		},
+	}
+}
+
+func TestNode_GetHyperBlockByNonceMissingMetaBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithMarshalizer(&mock.MarshalizerFake{}),
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithDataStore(mapStore{}.chainStorer()),
+	)
+
+	hyperBlock, err := n.GetHyperBlockByNonce(5)
+
+	assert.Nil(t, hyperBlock)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetHyperBlockByNonceShouldHydrateShardBlocks(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	converter := mock.NewNonceHashConverterMock()
+	store := mapStore{}
+
+	tx := &transaction.Transaction{Nonce: 3, Value: big.NewInt(10), SndAddr: []byte("snd"), RcvAddr: []byte("rcv")}
+	txBuff, _ := marshalizer.Marshal(tx)
+	store.put(dataRetriever.TransactionUnit, []byte("tx"), txBuff)
+
+	scr := &smartContractResult.SmartContractResult{Value: big.NewInt(2), RcvAddr: []byte("rcv"), TxHash: []byte("tx")}
+	scrBuff, _ := marshalizer.Marshal(scr)
+	store.put(dataRetriever.UnsignedTransactionUnit, []byte("scr"), scrBuff)
+
+	reward := &rewardTx.RewardTx{Value: big.NewInt(1), RcvAddr: []byte("leader")}
+	rewardBuff, _ := marshalizer.Marshal(reward)
+	store.put(dataRetriever.RewardTransactionUnit, []byte("reward"), rewardBuff)
+
+	miniBlocks := map[string]*block.MiniBlock{
+		"mb tx":     {TxHashes: [][]byte{[]byte("tx")}, SenderShardID: 0, ReceiverShardID: 1, Type: block.TxBlock},
+		"mb scr":    {TxHashes: [][]byte{[]byte("scr")}, SenderShardID: 1, ReceiverShardID: 0, Type: block.SmartContractResultBlock},
+		"mb reward": {TxHashes: [][]byte{[]byte("reward")}, SenderShardID: 0, ReceiverShardID: 0, Type: block.RewardsBlock},
+	}
+	miniBlockHeaders := make([]block.ShardMiniBlockHeader, 0)
+	for hash, miniBlock := range miniBlocks {
+		buff, _ := marshalizer.Marshal(miniBlock)
+		store.put(dataRetriever.MiniBlockUnit, []byte(hash), buff)
+		miniBlockHeaders = append(miniBlockHeaders, block.ShardMiniBlockHeader{
+			Hash:            []byte(hash),
+			SenderShardId:   miniBlock.SenderShardID,
+			ReceiverShardId: miniBlock.ReceiverShardID,
+			TxCount:         1,
+		})
+	}
+
+	shardHeader := &block.Header{Nonce: 11, Round: 12, ShardId: 0, RootHash: []byte("root hash")}
+	shardHeaderBuff, _ := marshalizer.Marshal(shardHeader)
+	store.put(dataRetriever.BlockHeaderUnit, []byte("shard header"), shardHeaderBuff)
+
+	metaBlock := &block.MetaBlock{
+		Nonce:   5,
+		Round:   13,
+		TxCount: 3,
+		ShardInfo: []block.ShardData{
+			{
+				HeaderHash:            []byte("shard header"),
+				ShardId:               0,
+				TxCount:               3,
+				ShardMiniBlockHeaders: miniBlockHeaders,
+			},
+			{
+				HeaderHash: []byte("unknown shard header"),
+				ShardId:    1,
+			},
+		},
+	}
+	metaBlockBuff, _ := marshalizer.Marshal(metaBlock)
+	store.put(dataRetriever.MetaBlockUnit, []byte("meta header"), metaBlockBuff)
+	store.put(dataRetriever.MetaHdrNonceHashDataUnit, converter.ToByteSlice(metaBlock.Nonce), []byte("meta header"))
+
+	n, _ := node.NewNode(
+		node.WithMarshalizer(marshalizer),
+		node.WithUint64ByteSliceConverter(converter),
+		node.WithDataStore(store.chainStorer()),
+	)
+
+	hyperBlock, err := n.GetHyperBlockByNonce(metaBlock.Nonce)
+	assert.Nil(t, err)
+	assert.Equal(t, metaBlock.Nonce, hyperBlock.Nonce)
+	assert.Equal(t, metaBlock.Round, hyperBlock.Round)
+	assert.Equal(t, hex.EncodeToString([]byte("meta header")), hyperBlock.Hash)
+	assert.Equal(t, 2, len(hyperBlock.ShardBlocks))
+
+	shardBlock := hyperBlock.ShardBlocks[0]
+	assert.Equal(t, shardHeader.Nonce, shardBlock.Nonce)
+	assert.Equal(t, shardHeader.Round, shardBlock.Round)
+	assert.Equal(t, hex.EncodeToString(shardHeader.RootHash), shardBlock.RootHash)
+	assert.Equal(t, 3, len(shardBlock.MiniBlocks))
+
+	txTypes := make(map[string]string)
+	for _, miniBlock := range shardBlock.MiniBlocks {
+		assert.Equal(t, 1, len(miniBlock.Transactions))
+		hyperBlockTx := miniBlock.Transactions[0]
+		assert.Equal(t, miniBlock.SenderShardID, hyperBlockTx.SourceShard)
+		assert.Equal(t, miniBlock.ReceiverShardID, hyperBlockTx.DestinationShard)
+		txTypes[hyperBlockTx.Hash] = hyperBlockTx.Type
+	}
+	assert.Equal(t, "normal", txTypes[hex.EncodeToString([]byte("tx"))])
+	assert.Equal(t, "unsigned", txTypes[hex.EncodeToString([]byte("scr"))])
+	assert.Equal(t, "reward", txTypes[hex.EncodeToString([]byte("reward"))])
+
+	unknownShardBlock := hyperBlock.ShardBlocks[1]
+	assert.Equal(t, uint64(0), unknownShardBlock.Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("unknown shard header")), unknownShardBlock.Hash)
+}
@@ -2,7 +2,10 @@ package node
 
 import (
 	"io"
+	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -14,9 +17,28 @@ type P2PMessenger interface {
 	BroadcastOnChannel(channel string, topic string, buff []byte)
 	BroadcastOnChannelBlocking(channel string, topic string, buff []byte) error
 	CreateTopic(name string, createChannelForTopic bool) error
+	CreateOrderedTopic(name string, createChannelForTopic bool) error
 	HasTopic(name string) bool
 	HasTopicValidator(name string) bool
 	RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error
 	PeerAddress(pid p2p.PeerID) string
+	ID() p2p.PeerID
+	IsInterfaceNil() bool
+}
+
+// EconomicsHandler defines the economics values of the network exposed by the node
+type EconomicsHandler interface {
+	RewardsValue() *big.Int
+	MinGasPrice() uint64
+	MinGasLimit() uint64
+	GasPriceModifier() float64
+	MaxMoveBalanceGasPerBlock() uint64
+	MaxSCExecutionGasPerBlock() uint64
+	IsInterfaceNil() bool
+}
+
+// AccountsRepository answers the accounts queries on the state of a committed block, selected by the query options
+type AccountsRepository interface {
+	GetAccountsAdapter(options external.AccountQueryOptions) (state.AccountsAdapter, *external.AccountBlockInfo, error)
 	IsInterfaceNil() bool
 }
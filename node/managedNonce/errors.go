@@ -0,0 +1,30 @@
+package managedNonce
+
+import "errors"
+
+// ErrNilNodeHandler signals that a nil node handler has been provided
+var ErrNilNodeHandler = errors.New("nil node handler")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNoManagedAddress signals that none of the provided keys manages an address of the node's shard
+var ErrNoManagedAddress = errors.New("no managed address in the node's shard")
+
+// ErrInvalidGapRecoveryTime signals that an invalid gap recovery time has been provided
+var ErrInvalidGapRecoveryTime = errors.New("invalid gap recovery time")
+
+// ErrAddressNotManaged signals that the nonces of the provided sender are not managed by the node
+var ErrAddressNotManaged = errors.New("the nonces of the sender are not managed by the node")
@@ -0,0 +1,17 @@
+package managedNonce
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// NodeHandler defines the node operations used to assign the nonces and to send the transactions
+type NodeHandler interface {
+	GetAccount(address string) (*state.Account, error)
+	GetTransactionsPool(withTransactions bool, senderHex string) (*external.TransactionsPool, error)
+	SendTransaction(nonce uint64, senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64,
+		transactionData string, signature []byte, chainID string, version uint32) (string, error)
+	IsInterfaceNil() bool
+}
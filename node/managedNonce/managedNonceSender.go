@@ -0,0 +1,244 @@
+package managedNonce
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("node/managedNonce")
+
+// ArgManagedNonceSender holds all dependencies required by the managed nonce sender in order to create a new instance
+type ArgManagedNonceSender struct {
+	Node             NodeHandler
+	Marshalizer      marshal.Marshalizer
+	SingleSigner     crypto.SingleSigner
+	AddressConverter state.AddressConverter
+	ShardCoordinator sharding.Coordinator
+	PrivateKeys      []crypto.PrivateKey
+	ChainID          string
+	GapRecoveryTime  time.Duration
+}
+
+type managedWallet struct {
+	mut            sync.Mutex
+	hexAddress     string
+	privateKey     crypto.PrivateKey
+	nextNonce      uint64
+	lastAssignment time.Time
+}
+
+// managedNonceSender signs and sends the transactions of the hosted wallets, assigning their nonces on the node. The
+// nonce of a transaction is the first one, starting from the account nonce, not used by a transaction of the sender
+// from the pool. The nonces assigned and not yet seen in the pool are skipped too, for the gap recovery time: after it,
+// the missing transactions are considered lost and their nonces are assigned again
+type managedNonceSender struct {
+	node             NodeHandler
+	marshalizer      marshal.Marshalizer
+	singleSigner     crypto.SingleSigner
+	addressConverter state.AddressConverter
+	chainID          string
+	gapRecoveryTime  time.Duration
+	wallets          map[string]*managedWallet
+}
+
+// NewManagedNonceSender creates a new managed nonce sender for the wallets of the provided keys. The wallets of other
+// shards are ignored, as their accounts and pool transactions are not known by the node
+func NewManagedNonceSender(args ArgManagedNonceSender) (*managedNonceSender, error) {
+	if check.IfNil(args.Node) {
+		return nil, ErrNilNodeHandler
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.GapRecoveryTime <= 0 {
+		return nil, ErrInvalidGapRecoveryTime
+	}
+
+	mns := &managedNonceSender{
+		node:             args.Node,
+		marshalizer:      args.Marshalizer,
+		singleSigner:     args.SingleSigner,
+		addressConverter: args.AddressConverter,
+		chainID:          args.ChainID,
+		gapRecoveryTime:  args.GapRecoveryTime,
+		wallets:          make(map[string]*managedWallet),
+	}
+
+	for _, privateKey := range args.PrivateKeys {
+		if check.IfNil(privateKey) {
+			return nil, ErrNilPrivateKey
+		}
+
+		pubKey, err := privateKey.GeneratePublic().ToByteArray()
+		if err != nil {
+			return nil, err
+		}
+		address, err := args.AddressConverter.CreateAddressFromPublicKeyBytes(pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		hexAddress := hex.EncodeToString(address.Bytes())
+		if args.ShardCoordinator.ComputeId(address) != args.ShardCoordinator.SelfId() {
+			log.Warn("managed nonce: the wallet is not in the node's shard", "address", hexAddress)
+			continue
+		}
+
+		log.Info("managed nonce: wallet added", "address", hexAddress)
+		mns.wallets[string(address.Bytes())] = &managedWallet{
+			hexAddress: hexAddress,
+			privateKey: privateKey,
+		}
+	}
+
+	if len(mns.wallets) == 0 {
+		return nil, ErrNoManagedAddress
+	}
+
+	return mns, nil
+}
+
+// SendTransaction assigns the nonce of the transaction, signs it with the key of the sender and sends it, returning
+// the hash and the nonce of the transaction. The transactions of a sender are assigned their nonces one at a time
+func (mns *managedNonceSender) SendTransaction(
+	senderHex string,
+	receiverHex string,
+	value *big.Int,
+	gasPrice uint64,
+	gasLimit uint64,
+	transactionData string,
+) (string, uint64, error) {
+
+	sender, err := mns.addressConverter.CreateAddressFromHex(senderHex)
+	if err != nil {
+		return "", 0, err
+	}
+	receiver, err := mns.addressConverter.CreateAddressFromHex(receiverHex)
+	if err != nil {
+		return "", 0, err
+	}
+
+	wallet, ok := mns.wallets[string(sender.Bytes())]
+	if !ok {
+		return "", 0, ErrAddressNotManaged
+	}
+
+	wallet.mut.Lock()
+	defer wallet.mut.Unlock()
+
+	nonce, err := mns.computeNonce(wallet)
+	if err != nil {
+		return "", 0, err
+	}
+
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    value,
+		RcvAddr:  receiver.Bytes(),
+		SndAddr:  sender.Bytes(),
+		GasPrice: gasPrice,
+		GasLimit: gasLimit,
+		Data:     transactionData,
+		ChainID:  []byte(mns.chainID),
+		Version:  core.MinTransactionVersion,
+	}
+	txBuff, err := mns.marshalizer.Marshal(tx)
+	if err != nil {
+		return "", 0, err
+	}
+	signature, err := mns.singleSigner.Sign(wallet.privateKey, txBuff)
+	if err != nil {
+		return "", 0, err
+	}
+
+	txHash, err := mns.node.SendTransaction(
+		nonce,
+		wallet.hexAddress,
+		hex.EncodeToString(receiver.Bytes()),
+		value,
+		gasPrice,
+		gasLimit,
+		transactionData,
+		signature,
+		mns.chainID,
+		core.MinTransactionVersion,
+	)
+	if err != nil {
+		return "", 0, err
+	}
+
+	wallet.nextNonce = nonce + 1
+	wallet.lastAssignment = time.Now()
+
+	return txHash, nonce, nil
+}
+
+func (mns *managedNonceSender) computeNonce(wallet *managedWallet) (uint64, error) {
+	account, err := mns.node.GetAccount(wallet.hexAddress)
+	if err != nil {
+		return 0, err
+	}
+	pool, err := mns.node.GetTransactionsPool(true, wallet.hexAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	poolNonces := make(map[uint64]struct{})
+	for _, sender := range pool.Senders {
+		for _, tx := range sender.Transactions {
+			poolNonces[tx.Nonce] = struct{}{}
+		}
+	}
+
+	nonce := account.Nonce
+	for {
+		_, isUsed := poolNonces[nonce]
+		if !isUsed {
+			break
+		}
+		nonce++
+	}
+
+	if wallet.nextNonce <= nonce {
+		return nonce, nil
+	}
+	if time.Since(wallet.lastAssignment) < mns.gapRecoveryTime {
+		return wallet.nextNonce, nil
+	}
+
+	log.Debug("managed nonce: recovering the nonces of the lost transactions",
+		"address", wallet.hexAddress,
+		"nonce", nonce,
+		"previous next nonce", wallet.nextNonce,
+	)
+
+	return nonce, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mns *managedNonceSender) IsInterfaceNil() bool {
+	if mns == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,279 @@
+package managedNonce_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/managedNonce"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+var walletAddress = []byte("wallet address of 32 bytes......")
+var receiverHex = hex.EncodeToString([]byte("receiver address of 32 bytes...."))
+
+func createPrivateKey(address []byte) crypto.PrivateKey {
+	return &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() ([]byte, error) {
+					return address, nil
+				},
+			}
+		},
+	}
+}
+
+// managedNonceNode keeps the account nonce and the nonces of the pool transactions of the managed wallet
+type managedNonceNode struct {
+	mut          sync.Mutex
+	accountNonce uint64
+	poolNonces   []uint64
+	sentNonces   []uint64
+}
+
+func (mnn *managedNonceNode) stub() *mock.ManagedNonceNodeStub {
+	return &mock.ManagedNonceNodeStub{
+		GetAccountCalled: func(address string) (*state.Account, error) {
+			mnn.mut.Lock()
+			defer mnn.mut.Unlock()
+
+			return &state.Account{Nonce: mnn.accountNonce}, nil
+		},
+		GetTransactionsPoolCalled: func(withTransactions bool, senderHex string) (*external.TransactionsPool, error) {
+			mnn.mut.Lock()
+			defer mnn.mut.Unlock()
+
+			sender := &external.PoolSender{Address: senderHex}
+			for _, nonce := range mnn.poolNonces {
+				sender.Transactions = append(sender.Transactions, &external.PoolTransaction{Nonce: nonce})
+			}
+
+			return &external.TransactionsPool{Senders: []*external.PoolSender{sender}}, nil
+		},
+		SendTransactionCalled: func(nonce uint64, senderHex string, receiverHex string, value *big.Int, gasPrice uint64,
+			gasLimit uint64, transactionData string, signature []byte, chainID string, version uint32) (string, error) {
+			mnn.mut.Lock()
+			defer mnn.mut.Unlock()
+
+			mnn.sentNonces = append(mnn.sentNonces, nonce)
+
+			return "hash", nil
+		},
+	}
+}
+
+func createMockArgManagedNonceSender(node managedNonce.NodeHandler) managedNonce.ArgManagedNonceSender {
+	return managedNonce.ArgManagedNonceSender{
+		Node:             node,
+		Marshalizer:      &mock.MarshalizerMock{},
+		SingleSigner:     &mock.SinglesignMock{},
+		AddressConverter: mock.NewAddressConverterFake(32, ""),
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		PrivateKeys:      []crypto.PrivateKey{createPrivateKey(walletAddress)},
+		ChainID:          "chain ID",
+		GapRecoveryTime:  time.Minute,
+	}
+}
+
+func sendTransaction(mns managedNonceSenderHandler) (uint64, error) {
+	_, nonce, err := mns.SendTransaction(hex.EncodeToString(walletAddress), receiverHex, big.NewInt(1), 10, 100, "")
+
+	return nonce, err
+}
+
+type managedNonceSenderHandler interface {
+	SendTransaction(senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64,
+		transactionData string) (string, uint64, error)
+}
+
+//------- NewManagedNonceSender
+
+func TestNewManagedNonceSender_NilNodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgManagedNonceSender(nil)
+	mns, err := managedNonce.NewManagedNonceSender(args)
+
+	assert.Nil(t, mns)
+	assert.Equal(t, managedNonce.ErrNilNodeHandler, err)
+}
+
+func TestNewManagedNonceSender_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgManagedNonceSender((&managedNonceNode{}).stub())
+	args.SingleSigner = nil
+	mns, err := managedNonce.NewManagedNonceSender(args)
+
+	assert.Nil(t, mns)
+	assert.Equal(t, managedNonce.ErrNilSingleSigner, err)
+}
+
+func TestNewManagedNonceSender_InvalidGapRecoveryTimeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgManagedNonceSender((&managedNonceNode{}).stub())
+	args.GapRecoveryTime = 0
+	mns, err := managedNonce.NewManagedNonceSender(args)
+
+	assert.Nil(t, mns)
+	assert.Equal(t, managedNonce.ErrInvalidGapRecoveryTime, err)
+}
+
+func TestNewManagedNonceSender_NilPrivateKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgManagedNonceSender((&managedNonceNode{}).stub())
+	args.PrivateKeys = []crypto.PrivateKey{nil}
+	mns, err := managedNonce.NewManagedNonceSender(args)
+
+	assert.Nil(t, mns)
+	assert.Equal(t, managedNonce.ErrNilPrivateKey, err)
+}
+
+func TestNewManagedNonceSender_WalletsOfOtherShardsShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgManagedNonceSender((&managedNonceNode{}).stub())
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return 1
+	}
+	args.ShardCoordinator = shardCoordinator
+	mns, err := managedNonce.NewManagedNonceSender(args)
+
+	assert.Nil(t, mns)
+	assert.Equal(t, managedNonce.ErrNoManagedAddress, err)
+}
+
+func TestNewManagedNonceSender_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mns, err := managedNonce.NewManagedNonceSender(createMockArgManagedNonceSender((&managedNonceNode{}).stub()))
+
+	assert.Nil(t, err)
+	assert.False(t, mns.IsInterfaceNil())
+}
+
+//------- SendTransaction
+
+func TestManagedNonceSender_SendTransactionNotManagedSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mns, _ := managedNonce.NewManagedNonceSender(createMockArgManagedNonceSender((&managedNonceNode{}).stub()))
+	_, _, err := mns.SendTransaction(receiverHex, receiverHex, big.NewInt(1), 10, 100, "")
+
+	assert.Equal(t, managedNonce.ErrAddressNotManaged, err)
+}
+
+func TestManagedNonceSender_SendTransactionShouldIncrementTheNonce(t *testing.T) {
+	t.Parallel()
+
+	node := &managedNonceNode{accountNonce: 5}
+	mns, _ := managedNonce.NewManagedNonceSender(createMockArgManagedNonceSender(node.stub()))
+
+	for i := 0; i < 3; i++ {
+		nonce, err := sendTransaction(mns)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(5+i), nonce)
+	}
+	assert.Equal(t, []uint64{5, 6, 7}, node.sentNonces)
+}
+
+func TestManagedNonceSender_SendTransactionShouldSkipTheNoncesFromPool(t *testing.T) {
+	t.Parallel()
+
+	node := &managedNonceNode{
+		accountNonce: 5,
+		poolNonces:   []uint64{6, 5, 8},
+	}
+	mns, _ := managedNonce.NewManagedNonceSender(createMockArgManagedNonceSender(node.stub()))
+
+	nonce, err := sendTransaction(mns)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), nonce)
+}
+
+func TestManagedNonceSender_SendTransactionShouldFollowTheAccountNonce(t *testing.T) {
+	t.Parallel()
+
+	node := &managedNonceNode{}
+	mns, _ := managedNonce.NewManagedNonceSender(createMockArgManagedNonceSender(node.stub()))
+
+	_, _ = sendTransaction(mns)
+	node.accountNonce = 10
+	nonce, _ := sendTransaction(mns)
+
+	assert.Equal(t, uint64(10), nonce)
+}
+
+func TestManagedNonceSender_SendTransactionShouldRecoverTheGapsAfterTheRecoveryTime(t *testing.T) {
+	t.Parallel()
+
+	node := &managedNonceNode{}
+	args := createMockArgManagedNonceSender(node.stub())
+	args.GapRecoveryTime = 50 * time.Millisecond
+	mns, _ := managedNonce.NewManagedNonceSender(args)
+
+	_, _ = sendTransaction(mns)
+	nonce, _ := sendTransaction(mns)
+	assert.Equal(t, uint64(1), nonce)
+
+	time.Sleep(100 * time.Millisecond)
+	nonce, _ = sendTransaction(mns)
+	assert.Equal(t, uint64(0), nonce)
+}
+
+func TestManagedNonceSender_SendTransactionErrorShouldNotIncrementTheNonce(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	node := &managedNonceNode{}
+	stub := node.stub()
+	stub.SendTransactionCalled = func(nonce uint64, senderHex string, receiverHex string, value *big.Int, gasPrice uint64,
+		gasLimit uint64, transactionData string, signature []byte, chainID string, version uint32) (string, error) {
+		return "", expectedErr
+	}
+	mns, _ := managedNonce.NewManagedNonceSender(createMockArgManagedNonceSender(stub))
+
+	_, err := sendTransaction(mns)
+	assert.Equal(t, expectedErr, err)
+
+	stub.SendTransactionCalled = node.stub().SendTransactionCalled
+	nonce, err := sendTransaction(mns)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), nonce)
+}
+
+func TestManagedNonceSender_SendTransactionConcurrentlyShouldAssignDistinctNonces(t *testing.T) {
+	t.Parallel()
+
+	node := &managedNonceNode{}
+	mns, _ := managedNonce.NewManagedNonceSender(createMockArgManagedNonceSender(node.stub()))
+
+	numTransactions := 50
+	wg := sync.WaitGroup{}
+	wg.Add(numTransactions)
+	for i := 0; i < numTransactions; i++ {
+		go func() {
+			_, _ = sendTransaction(mns)
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	assigned := make(map[uint64]struct{})
+	for _, nonce := range node.sentNonces {
+		assigned[nonce] = struct{}{}
+	}
+	assert.Equal(t, numTransactions, len(assigned))
+}
@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+type AccountsRepositoryStub struct {
+	GetAccountsAdapterCalled func(options external.AccountQueryOptions) (state.AccountsAdapter, *external.AccountBlockInfo, error)
+}
+
+func (ars *AccountsRepositoryStub) GetAccountsAdapter(
+	options external.AccountQueryOptions,
+) (state.AccountsAdapter, *external.AccountBlockInfo, error) {
+	return ars.GetAccountsAdapterCalled(options)
+}
+
+func (ars *AccountsRepositoryStub) IsInterfaceNil() bool {
+	if ars == nil {
+		return true
+	}
+	return false
+}
@@ -1,6 +1,9 @@
 package mock
 
 import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 )
 
@@ -19,6 +22,7 @@ type AccountsStub struct {
 	SaveDataTrieCalled          func(acountWrapper state.AccountHandler) error
 	RootHashCalled              func() ([]byte, error)
 	RecreateTrieCalled          func(rootHash []byte) error
+	GetAllLeavesOnChannelCalled func(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error)
 }
 
 func (aam *AccountsStub) AddJournalEntry(je state.JournalEntry) {
@@ -77,6 +81,14 @@ func (aam *AccountsStub) RecreateTrie(rootHash []byte) error {
 	return aam.RecreateTrieCalled(rootHash)
 }
 
+func (aam *AccountsStub) GetAllLeavesOnChannel(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error) {
+	if aam.GetAllLeavesOnChannelCalled != nil {
+		return aam.GetAllLeavesOnChannelCalled(ctx, rootHash)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (aam *AccountsStub) IsInterfaceNil() bool {
 	if aam == nil {
@@ -0,0 +1,28 @@
+package mock
+
+type ConsensusHandlerStub struct {
+	StopConsensusCalled  func() error
+	StartConsensusCalled func() error
+}
+
+func (chs *ConsensusHandlerStub) StopConsensus() error {
+	if chs.StopConsensusCalled != nil {
+		return chs.StopConsensusCalled()
+	}
+	return nil
+}
+
+func (chs *ConsensusHandlerStub) StartConsensus() error {
+	if chs.StartConsensusCalled != nil {
+		return chs.StartConsensusCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (chs *ConsensusHandlerStub) IsInterfaceNil() bool {
+	if chs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package mock
+
+import (
+	"math/big"
+)
+
+type EconomicsHandlerStub struct {
+	RewardsValueCalled              func() *big.Int
+	MinGasPriceCalled               func() uint64
+	MinGasLimitCalled               func() uint64
+	GasPriceModifierCalled          func() float64
+	MaxMoveBalanceGasPerBlockCalled func() uint64
+	MaxSCExecutionGasPerBlockCalled func() uint64
+}
+
+func (ehs *EconomicsHandlerStub) RewardsValue() *big.Int {
+	if ehs.RewardsValueCalled != nil {
+		return ehs.RewardsValueCalled()
+	}
+	return big.NewInt(0)
+}
+
+func (ehs *EconomicsHandlerStub) MinGasPrice() uint64 {
+	if ehs.MinGasPriceCalled != nil {
+		return ehs.MinGasPriceCalled()
+	}
+	return 0
+}
+
+func (ehs *EconomicsHandlerStub) MinGasLimit() uint64 {
+	if ehs.MinGasLimitCalled != nil {
+		return ehs.MinGasLimitCalled()
+	}
+	return 0
+}
+
+func (ehs *EconomicsHandlerStub) GasPriceModifier() float64 {
+	if ehs.GasPriceModifierCalled != nil {
+		return ehs.GasPriceModifierCalled()
+	}
+	return 1
+}
+
+func (ehs *EconomicsHandlerStub) MaxMoveBalanceGasPerBlock() uint64 {
+	if ehs.MaxMoveBalanceGasPerBlockCalled != nil {
+		return ehs.MaxMoveBalanceGasPerBlockCalled()
+	}
+	return 0
+}
+
+func (ehs *EconomicsHandlerStub) MaxSCExecutionGasPerBlock() uint64 {
+	if ehs.MaxSCExecutionGasPerBlockCalled != nil {
+		return ehs.MaxSCExecutionGasPerBlockCalled()
+	}
+	return 0
+}
+
+func (ehs *EconomicsHandlerStub) IsInterfaceNil() bool {
+	if ehs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+type HistoricalAccountsProviderStub struct {
+	AccountsAtRootHashCalled func(rootHash []byte) (state.AccountsAdapter, error)
+}
+
+func (h *HistoricalAccountsProviderStub) AccountsAtRootHash(rootHash []byte) (state.AccountsAdapter, error) {
+	return h.AccountsAtRootHashCalled(rootHash)
+}
+
+func (h *HistoricalAccountsProviderStub) IsInterfaceNil() bool {
+	if h == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
+)
+
+type HistoryRepositoryStub struct {
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
+	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
+	GetEpochByHashCalled               func(hash []byte) (uint32, error)
+	GetRoundByHashCalled               func(hash []byte) (uint64, error)
+	IsEnabledCalled                    func() bool
+}
+
+func (hrs *HistoryRepositoryStub) RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
+	if hrs.RecordBlockCalled != nil {
+		return hrs.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody)
+	}
+	return nil
+}
+
+func (hrs *HistoryRepositoryStub) GetMiniblockMetadataByTxHash(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+	if hrs.GetMiniblockMetadataByTxHashCalled != nil {
+		return hrs.GetMiniblockMetadataByTxHashCalled(hash)
+	}
+	return nil, nil
+}
+
+func (hrs *HistoryRepositoryStub) GetEpochByHash(hash []byte) (uint32, error) {
+	if hrs.GetEpochByHashCalled != nil {
+		return hrs.GetEpochByHashCalled(hash)
+	}
+	return 0, nil
+}
+
+func (hrs *HistoryRepositoryStub) GetRoundByHash(hash []byte) (uint64, error) {
+	if hrs.GetRoundByHashCalled != nil {
+		return hrs.GetRoundByHashCalled(hash)
+	}
+	return 0, nil
+}
+
+func (hrs *HistoryRepositoryStub) IsEnabled() bool {
+	if hrs.IsEnabledCalled != nil {
+		return hrs.IsEnabledCalled()
+	}
+	return false
+}
+
+func (hrs *HistoryRepositoryStub) IsInterfaceNil() bool {
+	if hrs == nil {
+		return true
+	}
+	return false
+}
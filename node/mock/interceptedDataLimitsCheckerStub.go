@@ -0,0 +1,43 @@
+package mock
+
+// InterceptedDataLimitsCheckerStub -
+type InterceptedDataLimitsCheckerStub struct {
+	CheckTxDataSizeCalled        func(dataSize int) error
+	CheckNumTxsInMiniBlockCalled func(numTxs int) error
+	CheckNumMiniBlocksCalled     func(numMiniBlocks int) error
+}
+
+// CheckTxDataSize -
+func (idlcs *InterceptedDataLimitsCheckerStub) CheckTxDataSize(dataSize int) error {
+	if idlcs.CheckTxDataSizeCalled != nil {
+		return idlcs.CheckTxDataSizeCalled(dataSize)
+	}
+
+	return nil
+}
+
+// CheckNumTxsInMiniBlock -
+func (idlcs *InterceptedDataLimitsCheckerStub) CheckNumTxsInMiniBlock(numTxs int) error {
+	if idlcs.CheckNumTxsInMiniBlockCalled != nil {
+		return idlcs.CheckNumTxsInMiniBlockCalled(numTxs)
+	}
+
+	return nil
+}
+
+// CheckNumMiniBlocks -
+func (idlcs *InterceptedDataLimitsCheckerStub) CheckNumMiniBlocks(numMiniBlocks int) error {
+	if idlcs.CheckNumMiniBlocksCalled != nil {
+		return idlcs.CheckNumMiniBlocksCalled(numMiniBlocks)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (idlcs *InterceptedDataLimitsCheckerStub) IsInterfaceNil() bool {
+	if idlcs == nil {
+		return true
+	}
+	return false
+}
@@ -31,6 +31,10 @@ func (ics *InterceptorsContainerStub) Len() int {
 	panic("implement me")
 }
 
+func (ics *InterceptorsContainerStub) Keys() []string {
+	panic("implement me")
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ics *InterceptorsContainerStub) IsInterfaceNil() bool {
 	if ics == nil {
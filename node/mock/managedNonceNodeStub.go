@@ -0,0 +1,50 @@
+package mock
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// ManagedNonceNodeStub -
+type ManagedNonceNodeStub struct {
+	GetAccountCalled          func(address string) (*state.Account, error)
+	GetTransactionsPoolCalled func(withTransactions bool, senderHex string) (*external.TransactionsPool, error)
+	SendTransactionCalled     func(nonce uint64, senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64,
+		transactionData string, signature []byte, chainID string, version uint32) (string, error)
+}
+
+// GetAccount -
+func (mnns *ManagedNonceNodeStub) GetAccount(address string) (*state.Account, error) {
+	return mnns.GetAccountCalled(address)
+}
+
+// GetTransactionsPool -
+func (mnns *ManagedNonceNodeStub) GetTransactionsPool(withTransactions bool, senderHex string) (*external.TransactionsPool, error) {
+	return mnns.GetTransactionsPoolCalled(withTransactions, senderHex)
+}
+
+// SendTransaction -
+func (mnns *ManagedNonceNodeStub) SendTransaction(
+	nonce uint64,
+	senderHex string,
+	receiverHex string,
+	value *big.Int,
+	gasPrice uint64,
+	gasLimit uint64,
+	transactionData string,
+	signature []byte,
+	chainID string,
+	version uint32,
+) (string, error) {
+	return mnns.SendTransactionCalled(nonce, senderHex, receiverHex, value, gasPrice, gasLimit, transactionData, signature, chainID, version)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mnns *ManagedNonceNodeStub) IsInterfaceNil() bool {
+	if mnns == nil {
+		return true
+	}
+	return false
+}
@@ -7,6 +7,7 @@ import (
 type MessengerStub struct {
 	CloseCalled                      func() error
 	CreateTopicCalled                func(name string, createChannelForTopic bool) error
+	CreateOrderedTopicCalled         func(name string, createChannelForTopic bool) error
 	HasTopicCalled                   func(name string) bool
 	HasTopicValidatorCalled          func(name string) bool
 	BroadcastOnChannelCalled         func(channel string, topic string, buff []byte)
@@ -15,6 +16,14 @@ type MessengerStub struct {
 	BootstrapCalled                  func() error
 	PeerAddressCalled                func(pid p2p.PeerID) string
 	BroadcastOnChannelBlockingCalled func(channel string, topic string, buff []byte) error
+	IDCalled                         func() p2p.PeerID
+}
+
+func (ms *MessengerStub) ID() p2p.PeerID {
+	if ms.IDCalled != nil {
+		return ms.IDCalled()
+	}
+	return ""
 }
 
 func (ms *MessengerStub) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
@@ -33,6 +42,10 @@ func (ms *MessengerStub) CreateTopic(name string, createChannelForTopic bool) er
 	return ms.CreateTopicCalled(name, createChannelForTopic)
 }
 
+func (ms *MessengerStub) CreateOrderedTopic(name string, createChannelForTopic bool) error {
+	return ms.CreateOrderedTopicCalled(name, createChannelForTopic)
+}
+
 func (ms *MessengerStub) HasTopic(name string) bool {
 	return ms.HasTopicCalled(name)
 }
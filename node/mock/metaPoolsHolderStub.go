@@ -12,6 +12,7 @@ type MetaPoolsHolderStub struct {
 	HeadersNoncesCalled        func() dataRetriever.Uint64SyncMapCacher
 	TransactionsCalled         func() dataRetriever.ShardedDataCacherNotifier
 	UnsignedTransactionsCalled func() dataRetriever.ShardedDataCacherNotifier
+	PeerAuthenticationsCalled  func() storage.Cacher
 }
 
 func (mphs *MetaPoolsHolderStub) Transactions() dataRetriever.ShardedDataCacherNotifier {
@@ -38,6 +39,10 @@ func (mphs *MetaPoolsHolderStub) HeadersNonces() dataRetriever.Uint64SyncMapCach
 	return mphs.HeadersNoncesCalled()
 }
 
+func (mphs *MetaPoolsHolderStub) PeerAuthentications() storage.Cacher {
+	return mphs.PeerAuthenticationsCalled()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (mphs *MetaPoolsHolderStub) IsInterfaceNil() bool {
 	if mphs == nil {
@@ -90,12 +90,12 @@ func (ncm *NodesCoordinatorMock) ConsensusGroupSize(shardId uint32) int {
 	panic("implement me")
 }
 
-func (ncm *NodesCoordinatorMock) SetNodesPerShards(map[uint32][]sharding.Validator) error {
-	return nil
+func (ncm *NodesCoordinatorMock) ConsensusThreshold(shardId uint32) int {
+	panic("implement me")
 }
 
-func (ncm *NodesCoordinatorMock) SetConsensusGroupSize(int) error {
-	panic("implement me")
+func (ncm *NodesCoordinatorMock) SetNodesPerShards(map[uint32][]sharding.Validator) error {
+	return nil
 }
 
 func (ncm *NodesCoordinatorMock) GetSelectedPublicKeys(selection []byte, shardId uint32) (publicKeys []string, err error) {
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+)
+
+type OutgoingOperationsHandlerStub struct {
+	ProcessCommittedBlockCalled        func(headerHash []byte, header data.HeaderHandler, body data.BodyHandler)
+	GetOutgoingOperationsBatchesCalled func() ([]*bridge.SignedOutgoingOperationsBatch, error)
+	IsEnabledCalled                    func() bool
+}
+
+func (oohs *OutgoingOperationsHandlerStub) ProcessCommittedBlock(headerHash []byte, header data.HeaderHandler, body data.BodyHandler) {
+	if oohs.ProcessCommittedBlockCalled != nil {
+		oohs.ProcessCommittedBlockCalled(headerHash, header, body)
+	}
+}
+
+func (oohs *OutgoingOperationsHandlerStub) GetOutgoingOperationsBatches() ([]*bridge.SignedOutgoingOperationsBatch, error) {
+	if oohs.GetOutgoingOperationsBatchesCalled != nil {
+		return oohs.GetOutgoingOperationsBatchesCalled()
+	}
+	return nil, nil
+}
+
+func (oohs *OutgoingOperationsHandlerStub) IsEnabled() bool {
+	if oohs.IsEnabledCalled != nil {
+		return oohs.IsEnabledCalled()
+	}
+	return false
+}
+
+func (oohs *OutgoingOperationsHandlerStub) IsInterfaceNil() bool {
+	if oohs == nil {
+		return true
+	}
+	return false
+}
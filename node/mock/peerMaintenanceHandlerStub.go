@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// PeerMaintenanceHandlerStub -
+type PeerMaintenanceHandlerStub struct {
+	PeerInMaintenanceCalled func(pid p2p.PeerID, until time.Time)
+}
+
+// PeerInMaintenance -
+func (pmhs *PeerMaintenanceHandlerStub) PeerInMaintenance(pid p2p.PeerID, until time.Time) {
+	if pmhs.PeerInMaintenanceCalled != nil {
+		pmhs.PeerInMaintenanceCalled(pid, until)
+	}
+}
+
+// IsInterfaceNil -
+func (pmhs *PeerMaintenanceHandlerStub) IsInterfaceNil() bool {
+	if pmhs == nil {
+		return true
+	}
+	return false
+}
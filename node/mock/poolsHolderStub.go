@@ -15,6 +15,7 @@ type PoolsHolderStub struct {
 	MiniBlocksCalled           func() storage.Cacher
 	MetaBlocksCalled           func() storage.Cacher
 	MetaHeadersNoncesCalled    func() dataRetriever.Uint64SyncMapCacher
+	PeerAuthenticationsCalled  func() storage.Cacher
 }
 
 func (phs *PoolsHolderStub) Headers() storage.Cacher {
@@ -53,6 +54,10 @@ func (phs *PoolsHolderStub) RewardTransactions() dataRetriever.ShardedDataCacher
 	return phs.RewardTransactionsCalled()
 }
 
+func (phs *PoolsHolderStub) PeerAuthentications() storage.Cacher {
+	return phs.PeerAuthenticationsCalled()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (phs *PoolsHolderStub) IsInterfaceNil() bool {
 	if phs == nil {
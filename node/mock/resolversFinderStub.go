@@ -10,6 +10,7 @@ type ResolversFinderStub struct {
 	ReplaceCalled            func(key string, val dataRetriever.Resolver) error
 	RemoveCalled             func(key string)
 	LenCalled                func() int
+	KeysCalled               func() []string
 	IntraShardResolverCalled func(baseTopic string) (dataRetriever.Resolver, error)
 	MetaChainResolverCalled  func(baseTopic string) (dataRetriever.Resolver, error)
 	CrossShardResolverCalled func(baseTopic string, crossShard uint32) (dataRetriever.Resolver, error)
@@ -39,6 +40,13 @@ func (rfs *ResolversFinderStub) Len() int {
 	return rfs.LenCalled()
 }
 
+func (rfs *ResolversFinderStub) Keys() []string {
+	if rfs.KeysCalled != nil {
+		return rfs.KeysCalled()
+	}
+	return nil
+}
+
 func (rfs *ResolversFinderStub) IntraShardResolver(baseTopic string) (dataRetriever.Resolver, error) {
 	return rfs.IntraShardResolverCalled(baseTopic)
 }
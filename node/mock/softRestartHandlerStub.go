@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type SoftRestartHandlerStub struct {
+	SoftRestartCalled func(stopHeader data.HeaderHandler) error
+}
+
+func (srhs *SoftRestartHandlerStub) SoftRestart(stopHeader data.HeaderHandler) error {
+	if srhs.SoftRestartCalled != nil {
+		return srhs.SoftRestartCalled(stopHeader)
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (srhs *SoftRestartHandlerStub) IsInterfaceNil() bool {
+	if srhs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type StateExporterStub struct {
+	ExportStateCalled func(header data.HeaderHandler) error
+}
+
+func (ses *StateExporterStub) ExportState(header data.HeaderHandler) error {
+	if ses.ExportStateCalled != nil {
+		return ses.ExportStateCalled(header)
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ses *StateExporterStub) IsInterfaceNil() bool {
+	if ses == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type StateMessengerStub struct {
+	HasTopicCalled                   func(name string) bool
+	CreateTopicCalled                func(name string, createChannelForTopic bool) error
+	RegisterMessageProcessorCalled   func(topic string, handler p2p.MessageProcessor) error
+	UnregisterMessageProcessorCalled func(topic string) error
+	ConnectedPeersOnTopicCalled      func(topic string) []p2p.PeerID
+	SendToConnectedPeerCalled        func(topic string, buff []byte, peerID p2p.PeerID) error
+}
+
+func (sms *StateMessengerStub) HasTopic(name string) bool {
+	if sms.HasTopicCalled != nil {
+		return sms.HasTopicCalled(name)
+	}
+	return false
+}
+
+func (sms *StateMessengerStub) CreateTopic(name string, createChannelForTopic bool) error {
+	if sms.CreateTopicCalled != nil {
+		return sms.CreateTopicCalled(name, createChannelForTopic)
+	}
+	return nil
+}
+
+func (sms *StateMessengerStub) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	if sms.RegisterMessageProcessorCalled != nil {
+		return sms.RegisterMessageProcessorCalled(topic, handler)
+	}
+	return nil
+}
+
+func (sms *StateMessengerStub) UnregisterMessageProcessor(topic string) error {
+	if sms.UnregisterMessageProcessorCalled != nil {
+		return sms.UnregisterMessageProcessorCalled(topic)
+	}
+	return nil
+}
+
+func (sms *StateMessengerStub) ConnectedPeersOnTopic(topic string) []p2p.PeerID {
+	if sms.ConnectedPeersOnTopicCalled != nil {
+		return sms.ConnectedPeersOnTopicCalled(topic)
+	}
+	return nil
+}
+
+func (sms *StateMessengerStub) SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error {
+	if sms.SendToConnectedPeerCalled != nil {
+		return sms.SendToConnectedPeerCalled(topic, buff, peerID)
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sms *StateMessengerStub) IsInterfaceNil() bool {
+	if sms == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+type StateScDataGetterStub struct {
+	GetFromStateCalled func(accounts state.AccountsAdapter, scAddress []byte, funcName string, args ...[]byte) ([]byte, error)
+}
+
+func (sscds *StateScDataGetterStub) GetFromState(
+	accounts state.AccountsAdapter,
+	scAddress []byte,
+	funcName string,
+	args ...[]byte,
+) ([]byte, error) {
+	return sscds.GetFromStateCalled(accounts, scAddress, funcName, args...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sscds *StateScDataGetterStub) IsInterfaceNil() bool {
+	if sscds == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/transaction"
+
+type TransactionSimulatorStub struct {
+	SimulateTransactionCalled func(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+}
+
+func (tss *TransactionSimulatorStub) SimulateTransaction(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+	return tss.SimulateTransactionCalled(tx, withTrace)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tss *TransactionSimulatorStub) IsInterfaceNil() bool {
+	if tss == nil {
+		return true
+	}
+	return false
+}
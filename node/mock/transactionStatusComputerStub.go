@@ -0,0 +1,21 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/transaction"
+
+type TransactionStatusComputerStub struct {
+	GetTransactionWithResultsCalled func(txHash []byte) (*transaction.Transaction, *transaction.TransactionResults, error)
+}
+
+func (tscs *TransactionStatusComputerStub) GetTransactionWithResults(
+	txHash []byte,
+) (*transaction.Transaction, *transaction.TransactionResults, error) {
+	return tscs.GetTransactionWithResultsCalled(txHash)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tscs *TransactionStatusComputerStub) IsInterfaceNil() bool {
+	if tscs == nil {
+		return true
+	}
+	return false
+}
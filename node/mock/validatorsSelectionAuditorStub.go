@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+)
+
+type ValidatorsSelectionAuditorStub struct {
+	AuditCommittedBlockCalled func(header data.HeaderHandler)
+	EpochAuditCalled          func(epoch uint32) (*audit.EpochSelectionAudit, error)
+	IsEnabledCalled           func() bool
+}
+
+func (vsas *ValidatorsSelectionAuditorStub) AuditCommittedBlock(header data.HeaderHandler) {
+	if vsas.AuditCommittedBlockCalled != nil {
+		vsas.AuditCommittedBlockCalled(header)
+	}
+}
+
+func (vsas *ValidatorsSelectionAuditorStub) EpochAudit(epoch uint32) (*audit.EpochSelectionAudit, error) {
+	if vsas.EpochAuditCalled != nil {
+		return vsas.EpochAuditCalled(epoch)
+	}
+	return nil, nil
+}
+
+func (vsas *ValidatorsSelectionAuditorStub) IsEnabled() bool {
+	if vsas.IsEnabledCalled != nil {
+		return vsas.IsEnabledCalled()
+	}
+	return false
+}
+
+func (vsas *ValidatorsSelectionAuditorStub) IsInterfaceNil() bool {
+	if vsas == nil {
+		return true
+	}
+	return false
+}
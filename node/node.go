@@ -19,23 +19,33 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go/core/watchdog"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat/storage"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
+	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
 )
 
 // SendTransactionsPipe is the pipe used for sending new transactions
@@ -44,7 +54,11 @@ const SendTransactionsPipe = "send transactions pipe"
 // HeartbeatTopic is the topic used for heartbeat signaling
 const HeartbeatTopic = "heartbeat"
 
-var log = logger.DefaultLogger()
+const millisecondsInYear = 365 * 24 * 3600 * 1000
+
+const heartbeatAlarmID = "heartbeat"
+
+var log = logger.GetOrCreate("node")
 
 // Option represents a functional configuration parameter that can operate
 //  over the None struct.
@@ -66,13 +80,22 @@ type Node struct {
 	blockProcessor           process.BlockProcessor
 	genesisTime              time.Time
 	accounts                 state.AccountsAdapter
+	historicalAccounts       state.HistoricalAccountsProvider
+	historyRepository        dblookupext.HistoryRepository
+	accountsRepository       AccountsRepository
+	outgoingOperations       process.OutgoingOperationsHandler
+	selectionAuditor         process.ValidatorsSelectionAuditor
+	supplyAccountant         process.SupplyAccountant
+	esdtSupplyTracker        process.ESDTSupplyTracker
 	addrConverter            state.AddressConverter
 	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	interceptorsContainer    process.InterceptorsContainer
 	resolversFinder          dataRetriever.ResolversFinder
 	heartbeatMonitor         *heartbeat.Monitor
 	heartbeatSender          *heartbeat.Sender
+	peerMaintenanceHandler   heartbeat.PeerMaintenanceHandler
 	appStatusHandler         core.AppStatusHandler
+	alarmScheduler           core.TimersScheduler
 
 	txSignPrivKey  crypto.PrivateKey
 	txSignPubKey   crypto.PublicKey
@@ -81,6 +104,7 @@ type Node struct {
 	keyGen         crypto.KeyGenerator
 	singleSigner   crypto.SingleSigner
 	txSingleSigner crypto.SingleSigner
+	txSignKeyGen   crypto.KeyGenerator
 	multiSigner    crypto.MultiSigner
 	forkDetector   process.ForkDetector
 
@@ -91,15 +115,27 @@ type Node struct {
 	shardCoordinator sharding.Coordinator
 	nodesCoordinator sharding.NodesCoordinator
 
-	consensusTopic string
-	consensusType  string
+	consensusTopic                   string
+	consensusType                    string
+	maxBlockCreationRoundTimePercent uint32
+
+	chronologyHandler consensus.ChronologyHandler
+	bootstrapper      process.Bootstrapper
 
 	isRunning                bool
 	txStorageSize            uint32
 	currentSendingGoRoutines int32
 	bootstrapRoundIndex      uint64
 
-	indexer indexer.Indexer
+	indexer           indexer.Indexer
+	pendingMiniBlocks process.PendingMiniBlocksHandler
+
+	chainID            string
+	economicsData      EconomicsHandler
+	txFeeHandler       process.FeeHandler
+	limitsChecker      process.InterceptedDataLimitsChecker
+	genesisTotalSupply *big.Int
+	genesisChecksum    []byte
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -116,6 +152,21 @@ func (n *Node) ApplyOptions(opts ...Option) error {
 	return nil
 }
 
+// ReplaceComponents applies the options while the node is running, as long as the consensus is stopped, so a soft
+// restart can swap the components the consensus is started with
+func (n *Node) ReplaceComponents(opts ...Option) error {
+	if n.chronologyHandler != nil || n.bootstrapper != nil {
+		return ErrConsensusRunning
+	}
+	for _, opt := range opts {
+		err := opt(n)
+		if err != nil {
+			return errors.New("error applying option: " + err.Error())
+		}
+	}
+	return nil
+}
+
 // NewNode creates a new Node instance
 func NewNode(opts ...Option) (*Node, error) {
 	node := &Node{
@@ -218,6 +269,10 @@ func (n *Node) StartConsensus() error {
 		return ErrGenesisBlockNotInitialized
 	}
 
+	if n.alarmScheduler == nil || n.alarmScheduler.IsInterfaceNil() {
+		return ErrNilAlarmScheduler
+	}
+
 	chronologyHandler, err := n.createChronologyHandler(n.rounder, n.appStatusHandler)
 	if err != nil {
 		return err
@@ -300,7 +355,20 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
-	fct, err := sposFactory.GetSubroundsFactory(consensusDataContainer, consensusState, worker, n.consensusType, n.appStatusHandler, n.indexer)
+	blockCreationTimeBudget, err := spos.NewBlockCreationTimeBudget(n.rounder, n.maxBlockCreationRoundTimePercent)
+	if err != nil {
+		return err
+	}
+
+	fct, err := sposFactory.GetSubroundsFactory(
+		consensusDataContainer,
+		consensusState,
+		worker,
+		n.consensusType,
+		n.appStatusHandler,
+		n.indexer,
+		blockCreationTimeBudget,
+	)
 	if err != nil {
 		return err
 	}
@@ -310,11 +378,32 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	n.chronologyHandler = chronologyHandler
+	n.bootstrapper = bootstrapper
+
 	go chronologyHandler.StartRounds()
 
 	return nil
 }
 
+// StopConsensus stops the rounds and the synchronization started by StartConsensus, so the node does not process
+// blocks anymore. It does nothing if the consensus was not started
+func (n *Node) StopConsensus() error {
+	if n.bootstrapper != nil {
+		n.bootstrapper.StopSync()
+		n.bootstrapper = nil
+	}
+
+	if n.chronologyHandler == nil {
+		return nil
+	}
+
+	err := n.chronologyHandler.Close()
+	n.chronologyHandler = nil
+
+	return err
+}
+
 // GetBalance gets the balance for a specific address
 func (n *Node) GetBalance(addressHex string) (*big.Int, error) {
 	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() || n.accounts == nil || n.accounts.IsInterfaceNil() {
@@ -358,6 +447,16 @@ func (n *Node) createChronologyHandler(rounder consensus.Rounder, appStatusHandl
 		return nil, err
 	}
 
+	chronologyWatchdog, err := watchdog.NewWatchdog(n.alarmScheduler)
+	if err != nil {
+		return nil, err
+	}
+
+	err = chr.SetWatchdog(chronologyWatchdog)
+	if err != nil {
+		return nil, err
+	}
+
 	return chr, nil
 }
 
@@ -449,7 +548,8 @@ func (n *Node) createConsensusState() (*spos.ConsensusState, error) {
 	return consensusState, nil
 }
 
-// createConsensusTopic creates a consensus topic for node
+// createConsensusTopic creates a consensus topic for node. The topic is ordered so that the consensus messages of a
+// peer are processed in the order they were broadcast
 func (n *Node) createConsensusTopic(messageProcessor p2p.MessageProcessor, shardCoordinator sharding.Coordinator) error {
 	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
 		return ErrNilShardCoordinator
@@ -464,7 +564,7 @@ func (n *Node) createConsensusTopic(messageProcessor p2p.MessageProcessor, shard
 	}
 
 	if !n.messenger.HasTopic(n.consensusTopic) {
-		err := n.messenger.CreateTopic(n.consensusTopic, true)
+		err := n.messenger.CreateOrderedTopic(n.consensusTopic, true)
 		if err != nil {
 			return err
 		}
@@ -482,7 +582,10 @@ func (n *Node) SendTransaction(
 	gasPrice uint64,
 	gasLimit uint64,
 	transactionData string,
-	signature []byte) (string, error) {
+	signature []byte,
+	chainID string,
+	version uint32,
+) (string, error) {
 
 	if n.shardCoordinator == nil || n.shardCoordinator.IsInterfaceNil() {
 		return "", ErrNilShardCoordinator
@@ -509,6 +612,8 @@ func (n *Node) SendTransaction(
 		GasLimit:  gasLimit,
 		Data:      transactionData,
 		Signature: signature,
+		ChainID:   []byte(chainID),
+		Version:   version,
 	}
 
 	txBuff, err := n.marshalizer.Marshal(&tx)
@@ -613,6 +718,8 @@ func (n *Node) CreateTransaction(
 	data string,
 	signatureHex string,
 	challenge string,
+	chainID string,
+	version uint32,
 ) (*transaction.Transaction, error) {
 
 	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
@@ -653,12 +760,144 @@ func (n *Node) CreateTransaction(
 		Data:      data,
 		Signature: signatureBytes,
 		Challenge: challengeBytes,
+		ChainID:   []byte(chainID),
+		Version:   version,
 	}, nil
 }
 
+// ValidateTransaction checks the transaction against the same rules applied by the transactions interceptors, so
+// that a transaction which would be dropped by the network can be rejected before being sent
+func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
+	if tx == nil {
+		return process.ErrNilTransaction
+	}
+	if n.txSignKeyGen == nil || n.txSignKeyGen.IsInterfaceNil() {
+		return ErrNilTxSignKeyGen
+	}
+	if n.txSingleSigner == nil || n.txSingleSigner.IsInterfaceNil() {
+		return ErrNilSingleSig
+	}
+	if n.txFeeHandler == nil || n.txFeeHandler.IsInterfaceNil() {
+		return ErrNilTxFeeHandler
+	}
+	if n.limitsChecker == nil || n.limitsChecker.IsInterfaceNil() {
+		return ErrNilInterceptedDataLimitsChecker
+	}
+
+	txBuff, err := n.marshalizer.Marshal(tx)
+	if err != nil {
+		return err
+	}
+
+	interceptedTx, err := procTx.NewInterceptedTransaction(
+		txBuff,
+		n.marshalizer,
+		n.hasher,
+		n.txSignKeyGen,
+		n.txSingleSigner,
+		n.addrConverter,
+		n.shardCoordinator,
+		n.txFeeHandler,
+		[]byte(n.chainID),
+		n.limitsChecker,
+	)
+	if err != nil {
+		return err
+	}
+
+	return interceptedTx.CheckValidity()
+}
+
 //GetTransaction gets the transaction
+// A nil transaction is returned if no user transaction with the provided hash was committed in a block of the node's
+// shard. The lookup needs the db lookup extensions to be enabled
 func (n *Node) GetTransaction(hash string) (*transaction.Transaction, error) {
-	return nil, fmt.Errorf("not yet implemented")
+	if n.store == nil || n.store.IsInterfaceNil() {
+		return nil, ErrNilStore
+	}
+	if n.marshalizer == nil || n.marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+
+	metadata, err := n.GetTransactionMetadata(hash)
+	if err == process.ErrMissingMiniblockMetadata {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata.Type == block.SmartContractResultBlock || metadata.Type == block.RewardsBlock {
+		return nil, nil
+	}
+
+	txHash, _ := hex.DecodeString(hash)
+	buff, err := n.store.Get(dataRetriever.TransactionUnit, txHash)
+	if err != nil {
+		return nil, nil
+	}
+
+	tx := &transaction.Transaction{}
+	err = n.marshalizer.Unmarshal(tx, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// GetTransactionMetadata returns the miniblock and the block of the node's shard which included the transaction
+// with the provided hash
+func (n *Node) GetTransactionMetadata(hash string) (*dblookupext.MiniblockMetadata, error) {
+	if n.historyRepository == nil || n.historyRepository.IsInterfaceNil() || !n.historyRepository.IsEnabled() {
+		return nil, process.ErrDbLookupExtensionsDisabled
+	}
+
+	txHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.historyRepository.GetMiniblockMetadataByTxHash(txHash)
+}
+
+// GetOutgoingOperationsBatches returns the collected outgoing operations batches together with the validators
+// signatures, to be carried by a relayer to the other chain
+func (n *Node) GetOutgoingOperationsBatches() ([]*bridge.SignedOutgoingOperationsBatch, error) {
+	if n.outgoingOperations == nil || n.outgoingOperations.IsInterfaceNil() || !n.outgoingOperations.IsEnabled() {
+		return nil, process.ErrOutgoingOperationsDisabled
+	}
+
+	return n.outgoingOperations.GetOutgoingOperationsBatches()
+}
+
+// GetValidatorsSelectionAudit returns how many times the eligible validators of the own shard were selected as leader
+// and as consensus group member during the given epoch, compared with the expected number of selections
+func (n *Node) GetValidatorsSelectionAudit(epoch uint32) (*audit.EpochSelectionAudit, error) {
+	if n.selectionAuditor == nil || n.selectionAuditor.IsInterfaceNil() || !n.selectionAuditor.IsEnabled() {
+		return nil, process.ErrValidatorsSelectionAuditDisabled
+	}
+
+	return n.selectionAuditor.EpochAudit(epoch)
+}
+
+// GetEpochSupply returns the counters of the value minted and burned by the own shard, reached at the end of the
+// given epoch
+func (n *Node) GetEpochSupply(epoch uint32) (*supply.ShardSupply, error) {
+	if n.supplyAccountant == nil || n.supplyAccountant.IsInterfaceNil() || !n.supplyAccountant.IsEnabled() {
+		return nil, process.ErrSupplyAccountingDisabled
+	}
+
+	return n.supplyAccountant.EpochSupply(epoch)
+}
+
+// GetESDTSupply returns the initial, minted and burned quantities of a token, as changed by the own shard
+func (n *Node) GetESDTSupply(tokenID string) (*esdt.TokenSupply, error) {
+	if n.esdtSupplyTracker == nil || n.esdtSupplyTracker.IsInterfaceNil() || !n.esdtSupplyTracker.IsEnabled() {
+		return nil, process.ErrESDTSupplyTrackingDisabled
+	}
+
+	return n.esdtSupplyTracker.GetTokenSupply([]byte(tokenID))
 }
 
 // GetCurrentPublicKey will return the current node's public key
@@ -679,12 +918,128 @@ func (n *Node) GetAccount(address string) (*state.Account, error) {
 		return nil, ErrNilAccountsAdapter
 	}
 
+	return n.getAccountFromAdapter(n.accounts, address)
+}
+
+// GetAccountAtNonce will return the account details for a given address, as they were after the block with the
+// provided nonce was committed in the node's shard
+func (n *Node) GetAccountAtNonce(address string, blockNonce uint64) (*state.Account, error) {
+	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
+		return nil, ErrNilAddressConverter
+	}
+
+	accounts, err := n.GetAccountsAtBlock(external.BlockCoordinates{Nonce: blockNonce})
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getAccountFromAdapter(accounts, address)
+}
+
+// GetAccountWithOptions will return the account details for a given address, read on the committed block selected by
+// the query options, together with the coordinates of that block
+func (n *Node) GetAccountWithOptions(
+	address string,
+	options external.AccountQueryOptions,
+) (*state.Account, *external.AccountBlockInfo, error) {
+	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
+		return nil, nil, ErrNilAddressConverter
+	}
+	if n.accountsRepository == nil || n.accountsRepository.IsInterfaceNil() {
+		return nil, nil, ErrNilAccountsRepository
+	}
+
+	accounts, blockInfo, err := n.accountsRepository.GetAccountsAdapter(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, err := n.getAccountFromAdapter(accounts, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, blockInfo, nil
+}
+
+// GetAccountsAtBlock returns the accounts state as it was after the block with the provided coordinates was
+// committed in the node's shard
+func (n *Node) GetAccountsAtBlock(blockCoordinates external.BlockCoordinates) (state.AccountsAdapter, error) {
+	if n.historicalAccounts == nil || n.historicalAccounts.IsInterfaceNil() {
+		return nil, ErrHistoricalStateDisabled
+	}
+	if n.shardCoordinator == nil || n.shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+
+	var rootHash []byte
+	var err error
+	if len(blockCoordinates.Hash) > 0 {
+		rootHash, err = n.getRootHashAtHash(blockCoordinates.Hash)
+	} else {
+		rootHash, err = n.getRootHashAtNonce(blockCoordinates.Nonce)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return n.historicalAccounts.AccountsAtRootHash(rootHash)
+}
+
+func (n *Node) getRootHashAtHash(blockHash []byte) ([]byte, error) {
+	if n.shardCoordinator.SelfId() == sharding.MetachainShardId {
+		hdr, err := process.GetMetaHeaderFromStorage(blockHash, n.marshalizer, n.store)
+		if err != nil {
+			return nil, err
+		}
+
+		return hdr.GetRootHash(), nil
+	}
+
+	hdr, err := process.GetShardHeaderFromStorage(blockHash, n.marshalizer, n.store)
+	if err != nil {
+		return nil, err
+	}
+
+	return hdr.GetRootHash(), nil
+}
+
+func (n *Node) getRootHashAtNonce(blockNonce uint64) ([]byte, error) {
+	if n.shardCoordinator.SelfId() == sharding.MetachainShardId {
+		hdr, _, err := process.GetMetaHeaderFromStorageWithNonce(
+			blockNonce,
+			n.store,
+			n.uint64ByteSliceConverter,
+			n.marshalizer,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return hdr.GetRootHash(), nil
+	}
+
+	hdr, _, err := process.GetShardHeaderFromStorageWithNonce(
+		blockNonce,
+		n.shardCoordinator.SelfId(),
+		n.store,
+		n.uint64ByteSliceConverter,
+		n.marshalizer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return hdr.GetRootHash(), nil
+}
+
+func (n *Node) getAccountFromAdapter(accounts state.AccountsAdapter, address string) (*state.Account, error) {
 	addr, err := n.addrConverter.CreateAddressFromHex(address)
 	if err != nil {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accWrp, err := accounts.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return &state.Account{
@@ -705,6 +1060,122 @@ func (n *Node) GetAccount(address string) (*state.Account, error) {
 	return account, nil
 }
 
+// GetAccountByUserName resolves the provided user name through the dns registry and returns the hex encoded
+// address of the owner together with its account
+func (n *Node) GetAccountByUserName(userName string) (string, *state.Account, error) {
+	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
+		return "", nil, ErrNilAddressConverter
+	}
+	if n.accounts == nil || n.accounts.IsInterfaceNil() {
+		return "", nil, ErrNilAccountsAdapter
+	}
+
+	err := core.CheckUserNameFormat([]byte(userName))
+	if err != nil {
+		return "", nil, err
+	}
+
+	dnsAddress, err := n.addrConverter.CreateAddressFromPublicKeyBytes(vmFactory.DNSSCAddress)
+	if err != nil {
+		return "", nil, err
+	}
+
+	dnsAccount, err := n.accounts.GetExistingAccount(dnsAddress)
+	if err == state.ErrAccNotFound {
+		return "", nil, ErrUserNameNotRegistered
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	registeredOwner, err := dnsAccount.DataTrieTracker().RetrieveValue([]byte(userName))
+	if err != nil && err != state.ErrNilTrie {
+		return "", nil, err
+	}
+	if len(registeredOwner) == 0 {
+		return "", nil, ErrUserNameNotRegistered
+	}
+
+	ownerAddress, err := n.addrConverter.CreateAddressFromPublicKeyBytes(registeredOwner)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ownerHex, err := n.addrConverter.ConvertToHex(ownerAddress)
+	if err != nil {
+		return "", nil, err
+	}
+
+	account, err := n.GetAccount(ownerHex)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return ownerHex, account, nil
+}
+
+// GetNFTs returns the non fungible and semi fungible tokens held by the provided address, as listed in the index
+// kept in the data trie of its account
+func (n *Node) GetNFTs(address string) ([]*external.NFTToken, error) {
+	if n.marshalizer == nil || n.marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+
+	account, err := n.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*external.NFTToken, 0)
+	if account.DataTrieTracker() == nil {
+		return tokens, nil
+	}
+
+	indexBuff, err := n.retrieveAccountValue(account, esdt.NFTIndexKey())
+	if err != nil || len(indexBuff) == 0 {
+		return tokens, err
+	}
+
+	entries := make([]*esdt.NFTIndexEntry, 0)
+	err = n.marshalizer.Unmarshal(&entries, indexBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		tokenBuff, err := n.retrieveAccountValue(account, esdt.NFTTokenKey(entry.TokenIdentifier, entry.Nonce))
+		if err != nil {
+			return nil, err
+		}
+		if len(tokenBuff) == 0 {
+			continue
+		}
+
+		token := &esdt.ESDigitalToken{}
+		err = n.marshalizer.Unmarshal(token, tokenBuff)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, &external.NFTToken{
+			TokenIdentifier: string(entry.TokenIdentifier),
+			Nonce:           entry.Nonce,
+			Token:           token,
+		})
+	}
+
+	return tokens, nil
+}
+
+func (n *Node) retrieveAccountValue(account *state.Account, key []byte) ([]byte, error) {
+	value, err := account.DataTrieTracker().RetrieveValue(key)
+	if err != nil && err != state.ErrNilTrie {
+		return nil, err
+	}
+
+	return value, nil
+}
+
 // StartHeartbeat starts the node's heartbeat processing/signaling module
 func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber string, nodeDisplayName string) error {
 	if !hbConfig.Enabled {
@@ -716,6 +1187,10 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		return err
 	}
 
+	if n.alarmScheduler == nil || n.alarmScheduler.IsInterfaceNil() {
+		return ErrNilAlarmScheduler
+	}
+
 	if n.messenger.HasTopicValidator(HeartbeatTopic) {
 		return ErrValidatorAlreadySet
 	}
@@ -740,6 +1215,12 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 	if err != nil {
 		return err
 	}
+	n.heartbeatSender.SetGenesisChecksum(n.genesisChecksum)
+	n.heartbeatSender.SetPeerAuthentication(
+		factory.PeerAuthenticationTopic,
+		n.messenger.ID(),
+		time.Second*time.Duration(hbConfig.DurationInSecToConsiderUnresponsive),
+	)
 
 	heartbeatStorageUnit := n.store.GetStorer(dataRetriever.HeartbeatUnit)
 	heartBeatMsgProcessor, err := heartbeat.NewMessageProcessor(
@@ -770,12 +1251,27 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		return err
 	}
 
+	err = n.heartbeatMonitor.SetMaxMaintenanceDuration(time.Second * time.Duration(hbConfig.MaxMaintenanceDurationInSec))
+	if err != nil {
+		return err
+	}
+
+	n.heartbeatMonitor.SetGenesisChecksum(n.genesisChecksum)
+
+	if n.peerMaintenanceHandler != nil {
+		err = n.heartbeatMonitor.SetPeerMaintenanceHandler(n.peerMaintenanceHandler)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = n.messenger.RegisterMessageProcessor(HeartbeatTopic, n.heartbeatMonitor)
 	if err != nil {
 		return err
 	}
 
-	go n.startSendingHeartbeats(hbConfig)
+	randomizer := rand.New(rand.NewSource(time.Now().Unix()))
+	n.scheduleNextHeartbeat(hbConfig, randomizer)
 
 	return nil
 }
@@ -796,24 +1292,37 @@ func (n *Node) checkConfigParams(config config.HeartbeatConfig) error {
 	if config.DurationInSecToConsiderUnresponsive <= config.MaxTimeToWaitBetweenBroadcastsInSec {
 		return ErrWrongValues
 	}
+	if config.MaxMaintenanceDurationInSec < 0 || config.MaintenanceDurationOnShutdownInSec < 0 {
+		return ErrWrongValues
+	}
 
 	return nil
 }
 
-func (n *Node) startSendingHeartbeats(config config.HeartbeatConfig) {
-	r := rand.New(rand.NewSource(time.Now().Unix()))
+// AnnounceMaintenance broadcasts a signed heartbeat telling the other peers that this node will be in maintenance
+// for the provided duration, so its absence is not accounted as downtime
+func (n *Node) AnnounceMaintenance(duration time.Duration) error {
+	if n.heartbeatSender == nil {
+		return ErrHeartbeatNotStarted
+	}
 
-	for {
-		diffSeconds := config.MaxTimeToWaitBetweenBroadcastsInSec - config.MinTimeToWaitBetweenBroadcastsInSec
-		diffNanos := int64(diffSeconds) * time.Second.Nanoseconds()
-		randomNanos := r.Int63n(diffNanos)
-		timeToWait := time.Second*time.Duration(config.MinTimeToWaitBetweenBroadcastsInSec) + time.Duration(randomNanos)
+	return n.heartbeatSender.SendMaintenanceAnnouncement(duration)
+}
 
-		time.Sleep(timeToWait)
+// scheduleNextHeartbeat sets the alarm which sends the next heartbeat after a random time in the configured interval.
+// Each heartbeat schedules the following one, so only one alarm is pending at a time
+func (n *Node) scheduleNextHeartbeat(config config.HeartbeatConfig, randomizer *rand.Rand) {
+	diffSeconds := config.MaxTimeToWaitBetweenBroadcastsInSec - config.MinTimeToWaitBetweenBroadcastsInSec
+	diffNanos := int64(diffSeconds) * time.Second.Nanoseconds()
+	randomNanos := randomizer.Int63n(diffNanos)
+	timeToWait := time.Second*time.Duration(config.MinTimeToWaitBetweenBroadcastsInSec) + time.Duration(randomNanos)
 
+	n.alarmScheduler.Add(func(alarmID string) {
 		err := n.heartbeatSender.SendHeartbeat()
 		log.LogIfError(err)
-	}
+
+		n.scheduleNextHeartbeat(config, randomizer)
+	}, timeToWait, heartbeatAlarmID)
 }
 
 // GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
@@ -824,6 +1333,153 @@ func (n *Node) GetHeartbeats() []heartbeat.PubKeyHeartbeat {
 	return n.heartbeatMonitor.GetHeartbeats()
 }
 
+// GetPendingMiniBlocks returns the cross shard miniblocks notarized by the metachain which were not yet processed
+// by their destination shards
+func (n *Node) GetPendingMiniBlocks() ([]*block.PendingMiniBlockInfo, error) {
+	if n.pendingMiniBlocks == nil || n.pendingMiniBlocks.IsInterfaceNil() {
+		return nil, ErrNilPendingMiniBlocksHandler
+	}
+
+	return n.pendingMiniBlocks.PendingMiniBlocks(), nil
+}
+
+// GetNetworkConfig returns the configuration of the network, as known by the node
+func (n *Node) GetNetworkConfig() (*external.NetworkConfig, error) {
+	if n.economicsData == nil || n.economicsData.IsInterfaceNil() {
+		return nil, ErrNilEconomicsData
+	}
+	if n.shardCoordinator == nil || n.shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+
+	return &external.NetworkConfig{
+		ChainID:                   n.chainID,
+		NumShards:                 n.shardCoordinator.NumberOfShards(),
+		RoundDuration:             n.roundDuration,
+		ConsensusGroupSize:        uint32(n.consensusGroupSize),
+		MinGasPrice:               n.economicsData.MinGasPrice(),
+		MinGasLimit:               n.economicsData.MinGasLimit(),
+		GasPriceModifier:          n.economicsData.GasPriceModifier(),
+		MaxMoveBalanceGasPerBlock: n.economicsData.MaxMoveBalanceGasPerBlock(),
+		MaxSCExecutionGasPerBlock: n.economicsData.MaxSCExecutionGasPerBlock(),
+		RewardsValue:              big.NewInt(0).Set(n.economicsData.RewardsValue()),
+	}, nil
+}
+
+// GetNetworkEconomics returns the chain-level economics values, as known by the node
+func (n *Node) GetNetworkEconomics() (*external.NetworkEconomics, error) {
+	if n.economicsData == nil || n.economicsData.IsInterfaceNil() {
+		return nil, ErrNilEconomicsData
+	}
+	if n.shardCoordinator == nil || n.shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if n.genesisTotalSupply == nil {
+		return nil, ErrNilTotalSupply
+	}
+
+	stakeValue, err := vmFactory.InitialStakeValue()
+	if err != nil {
+		return nil, err
+	}
+
+	numValidators := 0
+	for _, pubKeys := range n.initialNodesPubkeys {
+		numValidators += len(pubKeys)
+	}
+	minimumStakedValue := big.NewInt(0).Mul(stakeValue, big.NewInt(int64(numValidators)))
+
+	stakedValue, err := n.getStakedValue(minimumStakedValue)
+	if err != nil {
+		return nil, err
+	}
+
+	topUpValue := big.NewInt(0).Sub(stakedValue, minimumStakedValue)
+	if topUpValue.Sign() < 0 {
+		topUpValue = big.NewInt(0)
+	}
+
+	shardSupply, err := n.getCurrentShardSupply()
+	if err != nil {
+		return nil, err
+	}
+
+	return &external.NetworkEconomics{
+		TotalSupply: big.NewInt(0).Set(n.genesisTotalSupply),
+		StakedValue: stakedValue,
+		TopUpValue:  topUpValue,
+		APR:         n.estimateAPR(stakedValue),
+		ShardSupply: shardSupply,
+	}, nil
+}
+
+// getCurrentShardSupply returns the current supply counters of the own shard or nil if the supply accounting is
+// disabled
+func (n *Node) getCurrentShardSupply() (*supply.ShardSupply, error) {
+	if n.supplyAccountant == nil || n.supplyAccountant.IsInterfaceNil() || !n.supplyAccountant.IsEnabled() {
+		return nil, nil
+	}
+
+	return n.supplyAccountant.CurrentSupply()
+}
+
+// getStakedValue returns the balance of the staking smart contract. The staking smart contract state is held only
+// by the metachain, so the nodes from the other shards return the value staked by the genesis validators
+func (n *Node) getStakedValue(genesisStakedValue *big.Int) (*big.Int, error) {
+	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
+		return nil, ErrNilAddressConverter
+	}
+	if n.accounts == nil || n.accounts.IsInterfaceNil() {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	stakingAddress, err := n.addrConverter.CreateAddressFromPublicKeyBytes(vmFactory.StakingSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.shardCoordinator.ComputeId(stakingAddress) != n.shardCoordinator.SelfId() {
+		return big.NewInt(0).Set(genesisStakedValue), nil
+	}
+
+	accWrp, err := n.accounts.GetExistingAccount(stakingAddress)
+	if err == state.ErrAccNotFound {
+		return big.NewInt(0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := accWrp.(*state.Account)
+	if !ok {
+		return nil, state.ErrWrongTypeAssertion
+	}
+
+	return big.NewInt(0).Set(account.Balance), nil
+}
+
+// estimateAPR estimates the yearly return of the staked value out of the protocol rewards, considering that each
+// shard produces a block in every round and rewards all its consensus group members
+func (n *Node) estimateAPR(stakedValue *big.Int) float64 {
+	if stakedValue.Sign() <= 0 || n.roundDuration == 0 {
+		return 0
+	}
+
+	roundsPerYear := big.NewInt(0).SetUint64(millisecondsInYear / n.roundDuration)
+	rewardsPerRound := big.NewInt(0).Mul(
+		n.economicsData.RewardsValue(),
+		big.NewInt(int64(n.consensusGroupSize)*int64(n.shardCoordinator.NumberOfShards())),
+	)
+	yearlyRewards := big.NewInt(0).Mul(rewardsPerRound, roundsPerYear)
+
+	apr, _ := big.NewFloat(0).Quo(
+		big.NewFloat(0).SetInt(yearlyRewards),
+		big.NewFloat(0).SetInt(stakedValue),
+	).Float64()
+
+	return apr
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (n *Node) IsInterfaceNil() bool {
 	if n == nil {
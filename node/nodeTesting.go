@@ -238,6 +238,8 @@ func (n *Node) generateAndSignSingleTx(
 		RcvAddr:  rcvAddrBytes,
 		SndAddr:  sndAddrBytes,
 		Data:     data,
+		ChainID:  []byte(n.chainID),
+		Version:  core.MinTransactionVersion,
 	}
 
 	marshalizedTx, err := n.marshalizer.Marshal(&tx)
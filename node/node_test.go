@@ -15,19 +15,30 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	consensusMock "github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/alarm"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -582,7 +593,7 @@ func TestCreateTransaction_NilAddrConverterShouldErr(t *testing.T) {
 	signature := "-"
 	challenge := "-"
 
-	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge, "chainID", 1)
 
 	assert.Nil(t, tx)
 	assert.Equal(t, node.ErrNilAddressConverter, err)
@@ -612,7 +623,7 @@ func TestCreateTransaction_NilAccountsAdapterShouldErr(t *testing.T) {
 	signature := "-"
 	challenge := "-"
 
-	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge, "chainID", 1)
 
 	assert.Nil(t, tx)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -643,7 +654,7 @@ func TestCreateTransaction_InvalidSignatureShouldErr(t *testing.T) {
 	signature := "-"
 	challenge := "af4e5"
 
-	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge, "chainID", 1)
 
 	assert.Nil(t, tx)
 	assert.NotNil(t, err)
@@ -673,14 +684,158 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	txData := "-"
 	signature := "617eff4f"
 	challenge := "aff64e"
+	chainID := "chainID"
+	version := uint32(1)
 
-	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge, chainID, version)
 
 	assert.NotNil(t, tx)
 	assert.Nil(t, err)
 	assert.Equal(t, nonce, tx.Nonce)
 	assert.Equal(t, value, tx.Value)
 	assert.True(t, bytes.Equal([]byte(receiver), tx.RcvAddr))
+	assert.Equal(t, []byte(chainID), tx.ChainID)
+	assert.Equal(t, version, tx.Version)
+}
+
+func createNodeForTransactionValidation(feeHandler *mock.FeeHandlerStub) *node.Node {
+	n, _ := node.NewNode(
+		node.WithMarshalizer(&mock.MarshalizerFake{}),
+		node.WithHasher(&mock.HasherFake{}),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "0x")),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithTxSignKeyGen(&mock.KeyGenMock{
+			PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.PublicKeyMock{}, nil
+			},
+		}),
+		node.WithTxSingleSigner(&mock.SinglesignMock{}),
+		node.WithTxFeeHandler(feeHandler),
+		node.WithInterceptedDataLimitsChecker(&mock.InterceptedDataLimitsCheckerStub{}),
+		node.WithChainID("chainID"),
+	)
+
+	return n
+}
+
+func createTransactionForValidation() *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(10),
+		SndAddr:   make([]byte, 32),
+		RcvAddr:   make([]byte, 32),
+		Signature: []byte("signed"),
+		ChainID:   []byte("chainID"),
+		Version:   core.MinTransactionVersion,
+	}
+}
+
+func TestValidateTransaction_NilTxFeeHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithMarshalizer(&mock.MarshalizerFake{}),
+		node.WithTxSignKeyGen(&mock.KeyGenMock{}),
+		node.WithTxSingleSigner(&mock.SinglesignMock{}),
+	)
+
+	err := n.ValidateTransaction(createTransactionForValidation())
+
+	assert.Equal(t, node.ErrNilTxFeeHandler, err)
+}
+
+func TestValidateTransaction_InvalidChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionValidation(&mock.FeeHandlerStub{
+		CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+			return nil
+		},
+	})
+	tx := createTransactionForValidation()
+	tx.ChainID = []byte("other chainID")
+
+	err := n.ValidateTransaction(tx)
+
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestValidateTransaction_InvalidFeeValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionValidation(&mock.FeeHandlerStub{
+		CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+			return process.ErrInsufficientGasPriceInTx
+		},
+	})
+
+	err := n.ValidateTransaction(createTransactionForValidation())
+
+	assert.Equal(t, process.ErrInsufficientGasPriceInTx, err)
+}
+
+func TestValidateTransaction_InvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionValidation(&mock.FeeHandlerStub{
+		CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+			return nil
+		},
+	})
+	tx := createTransactionForValidation()
+	tx.Signature = []byte("bad signature")
+
+	err := n.ValidateTransaction(tx)
+
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+}
+
+func TestValidateTransaction_NilLimitsCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithMarshalizer(&mock.MarshalizerFake{}),
+		node.WithTxSignKeyGen(&mock.KeyGenMock{}),
+		node.WithTxSingleSigner(&mock.SinglesignMock{}),
+		node.WithTxFeeHandler(&mock.FeeHandlerStub{}),
+	)
+
+	err := n.ValidateTransaction(createTransactionForValidation())
+
+	assert.Equal(t, node.ErrNilInterceptedDataLimitsChecker, err)
+}
+
+func TestValidateTransaction_DataTooLargeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionValidation(&mock.FeeHandlerStub{
+		CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+			return nil
+		},
+	})
+	_ = n.ApplyOptions(node.WithInterceptedDataLimitsChecker(&mock.InterceptedDataLimitsCheckerStub{
+		CheckTxDataSizeCalled: func(dataSize int) error {
+			return process.ErrTxDataTooLarge
+		},
+	}))
+
+	err := n.ValidateTransaction(createTransactionForValidation())
+
+	assert.Equal(t, process.ErrTxDataTooLarge, err)
+}
+
+func TestValidateTransaction_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionValidation(&mock.FeeHandlerStub{
+		CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+			return nil
+		},
+	})
+
+	err := n.ValidateTransaction(createTransactionForValidation())
+
+	assert.Nil(t, err)
 }
 
 func TestSendBulkTransactions_NoTxShouldErr(t *testing.T) {
@@ -730,6 +885,8 @@ func TestSendTransaction_ShouldWork(t *testing.T) {
 	receiver := createDummyHexAddress(64)
 	txData := "data"
 	signature := []byte("signature")
+	chainID := "chainID"
+	version := uint32(1)
 
 	senderBuff, _ := adrConverter.CreateAddressFromHex(sender)
 	receiverBuff, _ := adrConverter.CreateAddressFromHex(receiver)
@@ -742,7 +899,10 @@ func TestSendTransaction_ShouldWork(t *testing.T) {
 		0,
 		0,
 		txData,
-		signature)
+		signature,
+		chainID,
+		version,
+	)
 
 	marshalizedTx, _ := marshalizer.Marshal(&transaction.Transaction{
 		Nonce:     nonce,
@@ -751,6 +911,8 @@ func TestSendTransaction_ShouldWork(t *testing.T) {
 		RcvAddr:   receiverBuff.Bytes(),
 		Data:      txData,
 		Signature: signature,
+		ChainID:   []byte(chainID),
+		Version:   version,
 	})
 	txHexHashExpected := hex.EncodeToString(hasher.Compute(string(marshalizedTx)))
 
@@ -992,10 +1154,27 @@ func TestNode_StartHeartbeatInvalidMaxTimeDurationShouldErr(t *testing.T) {
 	assert.Equal(t, node.ErrWrongValues, err)
 }
 
+func TestNode_StartHeartbeatNilAlarmSchedulerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	err := n.StartHeartbeat(config.HeartbeatConfig{
+		MinTimeToWaitBetweenBroadcastsInSec: 1,
+		MaxTimeToWaitBetweenBroadcastsInSec: 2,
+		DurationInSecToConsiderUnresponsive: 3,
+		Enabled:                             true,
+	}, "v0.1",
+		"undefined",
+	)
+
+	assert.Equal(t, node.ErrNilAlarmScheduler, err)
+}
+
 func TestNode_StartHeartbeatNilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	n, _ := node.NewNode(
+		node.WithAlarmScheduler(&mock.AlarmSchedulerStub{}),
 		node.WithSingleSigner(&mock.SinglesignMock{}),
 		node.WithKeyGen(&mock.KeyGenMock{}),
 		node.WithMessenger(&mock.MessengerStub{
@@ -1038,6 +1217,7 @@ func TestNode_StartHeartbeatNilKeygenShouldErr(t *testing.T) {
 	t.Parallel()
 
 	n, _ := node.NewNode(
+		node.WithAlarmScheduler(&mock.AlarmSchedulerStub{}),
 		node.WithMarshalizer(getMarshalizer()),
 		node.WithSingleSigner(&mock.SinglesignMock{}),
 		node.WithMessenger(&mock.MessengerStub{
@@ -1080,6 +1260,7 @@ func TestNode_StartHeartbeatHasTopicValidatorShouldErr(t *testing.T) {
 	t.Parallel()
 
 	n, _ := node.NewNode(
+		node.WithAlarmScheduler(&mock.AlarmSchedulerStub{}),
 		node.WithMarshalizer(getMarshalizer()),
 		node.WithSingleSigner(&mock.SinglesignMock{}),
 		node.WithKeyGen(&mock.KeyGenMock{}),
@@ -1114,6 +1295,7 @@ func TestNode_StartHeartbeatCreateTopicFailsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("expected error")
 	n, _ := node.NewNode(
+		node.WithAlarmScheduler(&mock.AlarmSchedulerStub{}),
 		node.WithMarshalizer(getMarshalizer()),
 		node.WithSingleSigner(&mock.SinglesignMock{}),
 		node.WithKeyGen(&mock.KeyGenMock{}),
@@ -1154,6 +1336,7 @@ func TestNode_StartHeartbeatRegisterMessageProcessorFailsShouldErr(t *testing.T)
 
 	errExpected := errors.New("expected error")
 	n, _ := node.NewNode(
+		node.WithAlarmScheduler(&mock.AlarmSchedulerStub{}),
 		node.WithMarshalizer(&mock.MarshalizerMock{}),
 		node.WithSingleSigner(&mock.SinglesignMock{}),
 		node.WithKeyGen(&mock.KeyGenMock{}),
@@ -1199,6 +1382,7 @@ func TestNode_StartHeartbeatShouldWorkAndCallSendHeartbeat(t *testing.T) {
 	wasBroadcast.Store(false)
 	buffData := []byte("buff data")
 	n, _ := node.NewNode(
+		node.WithAlarmScheduler(alarm.NewAlarmScheduler()),
 		node.WithMarshalizer(&mock.MarshalizerMock{
 			MarshalHandler: func(obj interface{}) (bytes []byte, e error) {
 				return buffData, nil
@@ -1260,6 +1444,7 @@ func TestNode_StartHeartbeatShouldWorkAndHaveAllPublicKeys(t *testing.T) {
 	t.Parallel()
 
 	n, _ := node.NewNode(
+		node.WithAlarmScheduler(&mock.AlarmSchedulerStub{}),
 		node.WithMarshalizer(&mock.MarshalizerMock{
 			MarshalHandler: func(obj interface{}) (bytes []byte, e error) {
 				return make([]byte, 0), nil
@@ -1319,6 +1504,7 @@ func TestNode_StartHeartbeatShouldSetNodesFromInitialPubKeysAsValidators(t *test
 	t.Parallel()
 
 	n, _ := node.NewNode(
+		node.WithAlarmScheduler(&mock.AlarmSchedulerStub{}),
 		node.WithMarshalizer(&mock.MarshalizerMock{
 			MarshalHandler: func(obj interface{}) (bytes []byte, e error) {
 				return make([]byte, 0), nil
@@ -1382,6 +1568,7 @@ func TestNode_StartHeartbeatShouldWorkAndCanCallProcessMessage(t *testing.T) {
 	var registeredHandler p2p.MessageProcessor
 
 	n, _ := node.NewNode(
+		node.WithAlarmScheduler(&mock.AlarmSchedulerStub{}),
 		node.WithMarshalizer(&mock.MarshalizerMock{
 			MarshalHandler: func(obj interface{}) (bytes []byte, e error) {
 				return make([]byte, 0), nil
@@ -1591,146 +1778,986 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	assert.Equal(t, accnt, recovAccnt)
 }
 
-func TestNode_AppStatusHandlersShouldIncrement(t *testing.T) {
-	t.Parallel()
+//------- GetAccountAtNonce
 
-	metricKey := core.MetricCurrentRound
-	incrementCalled := make(chan bool, 1)
+func createStoreWithShardHeader(marshalizer marshal.Marshalizer, hdr *block.Header) dataRetriever.StorageService {
+	hdrHash := []byte("header hash")
+	hdrBuff, _ := marshalizer.Marshal(hdr)
+	units := map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.ShardHdrNonceHashDataUnit: {
+			string(mock.NewNonceHashConverterMock().ToByteSlice(hdr.Nonce)): hdrHash,
+		},
+		dataRetriever.BlockHeaderUnit: {
+			string(hdrHash): hdrBuff,
+		},
+	}
 
-	// create a prometheus status handler which will be passed to the facade
-	appStatusHandlerStub := mock.AppStatusHandlerStub{
-		IncrementHandler: func(key string) {
-			incrementCalled <- true
+	return &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					value, ok := units[unitType][string(key)]
+					if !ok {
+						return nil, errors.New("key not found")
+					}
+					return value, nil
+				},
+			}
 		},
 	}
+}
+
+func TestNode_GetAccountAtNonceHistoricalStateDisabledShouldErr(t *testing.T) {
+	t.Parallel()
 
 	n, _ := node.NewNode(
-		node.WithAppStatusHandler(&appStatusHandlerStub))
-	asf := n.GetAppStatusHandler()
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
 
-	asf.Increment(metricKey)
+	recovAccnt, err := n.GetAccountAtNonce(createDummyHexAddress(64), 1)
 
-	select {
-	case <-incrementCalled:
-	case <-time.After(1 * time.Second):
-		assert.Fail(t, "Timeout - function not called")
-	}
+	assert.Nil(t, recovAccnt)
+	assert.Equal(t, node.ErrHistoricalStateDisabled, err)
 }
 
-func TestNode_AppStatusHandlerShouldDecrement(t *testing.T) {
+func TestNode_GetAccountAtNonceMissingHeaderShouldErr(t *testing.T) {
 	t.Parallel()
 
-	metricKey := core.MetricCurrentRound
-	decrementCalled := make(chan bool, 1)
+	marshalizer := &mock.MarshalizerFake{}
+	n, _ := node.NewNode(
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithMarshalizer(marshalizer),
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithDataStore(createStoreWithShardHeader(marshalizer, &block.Header{Nonce: 5})),
+		node.WithHistoricalAccounts(&mock.HistoricalAccountsProviderStub{
+			AccountsAtRootHashCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+				assert.Fail(t, "should have not recreated the accounts state")
+				return nil, nil
+			},
+		}),
+	)
 
-	// create a prometheus status handler which will be passed to the facade
-	appStatusHandlerStub := mock.AppStatusHandlerStub{
-		DecrementHandler: func(key string) {
-			decrementCalled <- true
-		},
-	}
+	recovAccnt, err := n.GetAccountAtNonce(createDummyHexAddress(64), 6)
 
-	n, _ := node.NewNode(
-		node.WithAppStatusHandler(&appStatusHandlerStub))
-	asf := n.GetAppStatusHandler()
+	assert.Nil(t, recovAccnt)
+	assert.NotNil(t, err)
+}
 
-	asf.Decrement(metricKey)
+func TestNode_GetAccountAtNonceShouldReturnAccountAtHeaderRootHash(t *testing.T) {
+	t.Parallel()
 
-	select {
-	case <-decrementCalled:
-	case <-time.After(1 * time.Second):
-		assert.Fail(t, "Timeout - function not called")
+	rootHash := []byte("root hash at nonce")
+	accnt := &state.Account{
+		Balance: big.NewInt(7),
+		Nonce:   3,
 	}
+	marshalizer := &mock.MarshalizerFake{}
+	n, _ := node.NewNode(
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithMarshalizer(marshalizer),
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithDataStore(createStoreWithShardHeader(marshalizer, &block.Header{Nonce: 5, RootHash: rootHash})),
+		node.WithHistoricalAccounts(&mock.HistoricalAccountsProviderStub{
+			AccountsAtRootHashCalled: func(root []byte) (state.AccountsAdapter, error) {
+				assert.Equal(t, rootHash, root)
+				return &mock.AccountsStub{
+					GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+						return accnt, nil
+					},
+				}, nil
+			},
+		}),
+	)
+
+	recovAccnt, err := n.GetAccountAtNonce(createDummyHexAddress(64), 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, accnt, recovAccnt)
 }
 
-func TestNode_AppStatusHandlerShouldSetInt64Value(t *testing.T) {
+//------- GetAccountWithOptions
+
+func TestNode_GetAccountWithOptionsNilAccountsRepositoryShouldErr(t *testing.T) {
 	t.Parallel()
 
-	metricKey := core.MetricCurrentRound
-	setInt64ValueCalled := make(chan bool, 1)
+	n, _ := node.NewNode(
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
 
-	// create a prometheus status handler which will be passed to the facade
-	appStatusHandlerStub := mock.AppStatusHandlerStub{
-		SetInt64ValueHandler: func(key string, value int64) {
-			setInt64ValueCalled <- true
-		},
-	}
+	recovAccnt, blockInfo, err := n.GetAccountWithOptions(createDummyHexAddress(64), external.AccountQueryOptions{})
+
+	assert.Nil(t, recovAccnt)
+	assert.Nil(t, blockInfo)
+	assert.Equal(t, node.ErrNilAccountsRepository, err)
+}
+
+func TestNode_GetAccountWithOptionsRepositoryErrorsShouldErr(t *testing.T) {
+	t.Parallel()
 
+	errExpected := errors.New("expected error")
 	n, _ := node.NewNode(
-		node.WithAppStatusHandler(&appStatusHandlerStub))
-	asf := n.GetAppStatusHandler()
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithAccountsRepository(&mock.AccountsRepositoryStub{
+			GetAccountsAdapterCalled: func(options external.AccountQueryOptions) (state.AccountsAdapter, *external.AccountBlockInfo, error) {
+				return nil, nil, errExpected
+			},
+		}),
+	)
 
-	asf.SetInt64Value(metricKey, int64(1))
+	recovAccnt, blockInfo, err := n.GetAccountWithOptions(createDummyHexAddress(64), external.AccountQueryOptions{})
 
-	select {
-	case <-setInt64ValueCalled:
-	case <-time.After(1 * time.Second):
-		assert.Fail(t, "Timeout - function not called")
-	}
+	assert.Nil(t, recovAccnt)
+	assert.Nil(t, blockInfo)
+	assert.Equal(t, errExpected, err)
 }
 
-func TestNode_AppStatusHandlerShouldSetUInt64Value(t *testing.T) {
+func TestNode_GetAccountWithOptionsShouldReturnTheAccountAndTheBlockInfo(t *testing.T) {
 	t.Parallel()
 
-	metricKey := core.MetricCurrentRound
-	setUInt64ValueCalled := make(chan bool, 1)
-
-	// create a prometheus status handler which will be passed to the facade
-	appStatusHandlerStub := mock.AppStatusHandlerStub{
-		SetUInt64ValueHandler: func(key string, value uint64) {
-			setUInt64ValueCalled <- true
-		},
+	expectedBlockInfo := &external.AccountBlockInfo{
+		Nonce:    8,
+		Hash:     []byte("final hash"),
+		RootHash: []byte("final root hash"),
 	}
-
 	n, _ := node.NewNode(
-		node.WithAppStatusHandler(&appStatusHandlerStub))
-	asf := n.GetAppStatusHandler()
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithAccountsRepository(&mock.AccountsRepositoryStub{
+			GetAccountsAdapterCalled: func(options external.AccountQueryOptions) (state.AccountsAdapter, *external.AccountBlockInfo, error) {
+				assert.True(t, options.OnFinalBlock)
+				return getAccAdapter(big.NewInt(37)), expectedBlockInfo, nil
+			},
+		}),
+	)
 
-	asf.SetUInt64Value(metricKey, uint64(1))
+	recovAccnt, blockInfo, err := n.GetAccountWithOptions(
+		createDummyHexAddress(64),
+		external.AccountQueryOptions{OnFinalBlock: true},
+	)
 
-	select {
-	case <-setUInt64ValueCalled:
-	case <-time.After(1 * time.Second):
-		assert.Fail(t, "Timeout - function not called")
-	}
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(37), recovAccnt.Balance)
+	assert.Equal(t, expectedBlockInfo, blockInfo)
 }
 
-func TestNode_SendBulkTransactionsMultiShardTxsShouldBeMappedCorrectly(t *testing.T) {
+//------- GetAccountsAtBlock
+
+func TestNode_GetAccountsAtBlockByHashShouldUseTheHeaderRootHash(t *testing.T) {
 	t.Parallel()
 
+	rootHash := []byte("root hash at hash")
+	accounts := &mock.AccountsStub{}
 	marshalizer := &mock.MarshalizerFake{}
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithMarshalizer(marshalizer),
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithDataStore(createStoreWithShardHeader(marshalizer, &block.Header{Nonce: 5, RootHash: rootHash})),
+		node.WithHistoricalAccounts(&mock.HistoricalAccountsProviderStub{
+			AccountsAtRootHashCalled: func(root []byte) (state.AccountsAdapter, error) {
+				assert.Equal(t, rootHash, root)
+				return accounts, nil
+			},
+		}),
+	)
 
-	mutRecoveredTransactions := &sync.RWMutex{}
-	recoveredTransactions := make(map[uint32][]*transaction.Transaction)
-	signer := &mock.SinglesignMock{}
-	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
-	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
-		items := strings.Split(string(address.Bytes()), "senderShard")
-		sId, _ := strconv.ParseUint(items[1], 2, 32)
-		return uint32(sId)
-	}
-
-	var txsToSend []*transaction.Transaction
-	txsToSend = append(txsToSend, &transaction.Transaction{
-		Nonce:     10,
-		Value:     new(big.Int).SetInt64(15),
-		RcvAddr:   []byte("receiver1"),
-		SndAddr:   []byte("senderShard0"),
-		GasPrice:  5,
-		GasLimit:  11,
-		Data:      "",
-		Signature: nil,
-		Challenge: nil,
+	recovAccounts, err := n.GetAccountsAtBlock(external.BlockCoordinates{
+		Nonce: 6,
+		Hash:  []byte("header hash"),
 	})
 
-	txsToSend = append(txsToSend, &transaction.Transaction{
-		Nonce:     11,
-		Value:     new(big.Int).SetInt64(25),
-		RcvAddr:   []byte("receiver2"),
-		SndAddr:   []byte("senderShard0"),
-		GasPrice:  6,
-		GasLimit:  12,
-		Data:      "",
+	assert.Nil(t, err)
+	assert.True(t, accounts == recovAccounts)
+}
+
+func TestNode_GetAccountsAtBlockMissingHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithMarshalizer(marshalizer),
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithDataStore(createStoreWithShardHeader(marshalizer, &block.Header{Nonce: 5})),
+		node.WithHistoricalAccounts(&mock.HistoricalAccountsProviderStub{
+			AccountsAtRootHashCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+				assert.Fail(t, "should have not recreated the accounts state")
+				return nil, nil
+			},
+		}),
+	)
+
+	recovAccounts, err := n.GetAccountsAtBlock(external.BlockCoordinates{
+		Nonce: 5,
+		Hash:  []byte("missing hash"),
+	})
+
+	assert.Nil(t, recovAccounts)
+	assert.NotNil(t, err)
+}
+
+//------- GetTransaction
+
+func TestNode_GetTransactionDbLookupExtensionsDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithDataStore(&mock.ChainStorerMock{}),
+		node.WithMarshalizer(&mock.MarshalizerFake{}),
+		node.WithHistoryRepository(&mock.HistoryRepositoryStub{}),
+	)
+
+	tx, err := n.GetTransaction("aabb")
+
+	assert.Nil(t, tx)
+	assert.Equal(t, process.ErrDbLookupExtensionsDisabled, err)
+}
+
+func TestNode_GetTransactionMissingMetadataShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithDataStore(&mock.ChainStorerMock{}),
+		node.WithMarshalizer(&mock.MarshalizerFake{}),
+		node.WithHistoryRepository(&mock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return nil, process.ErrMissingMiniblockMetadata
+			},
+		}),
+	)
+
+	tx, err := n.GetTransaction("aabb")
+
+	assert.Nil(t, tx)
+	assert.Nil(t, err)
+}
+
+func TestNode_GetTransactionShouldReturnCommittedTransaction(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	expectedTx := &transaction.Transaction{Nonce: 7, Value: big.NewInt(10)}
+	marshalizer := &mock.MarshalizerFake{}
+	txBuff, _ := marshalizer.Marshal(expectedTx)
+	store := &mock.ChainStorerMock{
+		GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+			assert.Equal(t, dataRetriever.TransactionUnit, unitType)
+			assert.Equal(t, txHash, key)
+			return txBuff, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithDataStore(store),
+		node.WithMarshalizer(marshalizer),
+		node.WithHistoryRepository(&mock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				assert.Equal(t, txHash, hash)
+				return &dblookupext.MiniblockMetadata{Type: block.TxBlock}, nil
+			},
+		}),
+	)
+
+	tx, err := n.GetTransaction(hex.EncodeToString(txHash))
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedTx, tx)
+}
+
+//------- GetOutgoingOperationsBatches
+
+func TestNode_GetOutgoingOperationsBatchesDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithOutgoingOperations(&mock.OutgoingOperationsHandlerStub{}),
+	)
+
+	batches, err := n.GetOutgoingOperationsBatches()
+
+	assert.Nil(t, batches)
+	assert.Equal(t, process.ErrOutgoingOperationsDisabled, err)
+}
+
+func TestNode_GetOutgoingOperationsBatchesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBatches := []*bridge.SignedOutgoingOperationsBatch{
+		{Hash: []byte("batch hash"), Confirmed: true},
+	}
+	n, _ := node.NewNode(
+		node.WithOutgoingOperations(&mock.OutgoingOperationsHandlerStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetOutgoingOperationsBatchesCalled: func() ([]*bridge.SignedOutgoingOperationsBatch, error) {
+				return expectedBatches, nil
+			},
+		}),
+	)
+
+	batches, err := n.GetOutgoingOperationsBatches()
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBatches, batches)
+}
+
+//------- GetValidatorsSelectionAudit
+
+func TestNode_GetValidatorsSelectionAuditDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithSelectionAuditor(&mock.ValidatorsSelectionAuditorStub{}),
+	)
+
+	epochAudit, err := n.GetValidatorsSelectionAudit(0)
+
+	assert.Nil(t, epochAudit)
+	assert.Equal(t, process.ErrValidatorsSelectionAuditDisabled, err)
+}
+
+func TestNode_GetValidatorsSelectionAuditShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedAudit := &audit.EpochSelectionAudit{Epoch: 3, NumRounds: 100}
+	n, _ := node.NewNode(
+		node.WithSelectionAuditor(&mock.ValidatorsSelectionAuditorStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			EpochAuditCalled: func(epoch uint32) (*audit.EpochSelectionAudit, error) {
+				if epoch != 3 {
+					return nil, errors.New("unexpected epoch")
+				}
+				return expectedAudit, nil
+			},
+		}),
+	)
+
+	epochAudit, err := n.GetValidatorsSelectionAudit(3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedAudit, epochAudit)
+}
+
+//------- GetEpochSupply
+
+func TestNode_GetEpochSupplyDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithSupplyAccountant(&mock.SupplyAccountantStub{}),
+	)
+
+	epochSupply, err := n.GetEpochSupply(0)
+
+	assert.Nil(t, epochSupply)
+	assert.Equal(t, process.ErrSupplyAccountingDisabled, err)
+}
+
+func TestNode_GetEpochSupplyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedSupply := supply.NewShardSupply(0, big.NewInt(1000))
+	n, _ := node.NewNode(
+		node.WithSupplyAccountant(&mock.SupplyAccountantStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			EpochSupplyCalled: func(epoch uint32) (*supply.ShardSupply, error) {
+				if epoch != 3 {
+					return nil, errors.New("unexpected epoch")
+				}
+				return expectedSupply, nil
+			},
+		}),
+	)
+
+	epochSupply, err := n.GetEpochSupply(3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSupply, epochSupply)
+}
+
+//------- GetESDTSupply
+
+func TestNode_GetESDTSupplyDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithESDTSupplyTracker(&mock.ESDTSupplyTrackerStub{}),
+	)
+
+	tokenSupply, err := n.GetESDTSupply("TKN-0123")
+
+	assert.Nil(t, tokenSupply)
+	assert.Equal(t, process.ErrESDTSupplyTrackingDisabled, err)
+}
+
+func TestNode_GetESDTSupplyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedSupply := esdt.NewTokenSupply()
+	expectedSupply.Initial = big.NewInt(1000)
+	n, _ := node.NewNode(
+		node.WithESDTSupplyTracker(&mock.ESDTSupplyTrackerStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetTokenSupplyCalled: func(tokenID []byte) (*esdt.TokenSupply, error) {
+				if string(tokenID) != "TKN-0123" {
+					return nil, errors.New("unexpected token")
+				}
+				return expectedSupply, nil
+			},
+		}),
+	)
+
+	tokenSupply, err := n.GetESDTSupply("TKN-0123")
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSupply, tokenSupply)
+}
+
+//------- GetAccountByUserName
+
+func TestNode_GetAccountByUserNameInvalidUserNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	addr, recovAccnt, err := n.GetAccountByUserName("Invalid name")
+
+	assert.Empty(t, addr)
+	assert.Nil(t, recovAccnt)
+	assert.Equal(t, core.ErrInvalidUserNameCharacters, err)
+}
+
+func TestNode_GetAccountByUserNameMissingDNSAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return nil, state.ErrAccNotFound
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	addr, recovAccnt, err := n.GetAccountByUserName("alice")
+
+	assert.Empty(t, addr)
+	assert.Nil(t, recovAccnt)
+	assert.Equal(t, node.ErrUserNameNotRegistered, err)
+}
+
+func TestNode_GetAccountByUserNameNotRegisteredShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accDB := &mock.AccountsStub{}
+	dnsAccount, _ := state.NewAccount(state.NewAddress([]byte("dns")), &mock.AccountTrackerStub{})
+	dnsAccount.DataTrieTracker().SaveKeyValue([]byte("bob"), []byte("owner"))
+	accDB.GetExistingAccountCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		return dnsAccount, nil
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	addr, recovAccnt, err := n.GetAccountByUserName("alice")
+
+	assert.Empty(t, addr)
+	assert.Nil(t, recovAccnt)
+	assert.Equal(t, node.ErrUserNameNotRegistered, err)
+}
+
+func TestNode_GetAccountByUserNameShouldReturnOwnerAccount(t *testing.T) {
+	t.Parallel()
+
+	ownerAddress := bytes.Repeat([]byte{1}, 32)
+	ownerAccount := &state.Account{
+		Balance:  big.NewInt(1),
+		Nonce:    2,
+		UserName: []byte("alice"),
+	}
+
+	accDB := &mock.AccountsStub{}
+	dnsAccount, _ := state.NewAccount(state.NewAddress([]byte("dns")), &mock.AccountTrackerStub{})
+	dnsAccount.DataTrieTracker().SaveKeyValue([]byte("alice"), ownerAddress)
+	accDB.GetExistingAccountCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		if bytes.Equal(addressContainer.Bytes(), ownerAddress) {
+			return ownerAccount, nil
+		}
+		return dnsAccount, nil
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	addr, recovAccnt, err := n.GetAccountByUserName("alice")
+
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(ownerAddress), addr)
+	assert.Equal(t, ownerAccount, recovAccnt)
+}
+
+//------- GetNFTs
+
+func TestNode_GetNFTsNoTokensShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return nil, state.ErrAccNotFound
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithMarshalizer(&mock.MarshalizerFake{}),
+	)
+
+	tokens, err := n.GetNFTs(createDummyHexAddress(64))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tokens))
+}
+
+func TestNode_GetNFTsShouldReturnIndexedTokens(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	tokenID := []byte("TOKEN")
+	token := &esdt.ESDigitalToken{
+		Type:          uint32(esdt.NonFungible),
+		Value:         big.NewInt(1),
+		TokenMetaData: &esdt.MetaData{Nonce: 3, Name: []byte("name")},
+	}
+	tokenBuff, _ := marshalizer.Marshal(token)
+	indexBuff, _ := marshalizer.Marshal([]*esdt.NFTIndexEntry{{TokenIdentifier: tokenID, Nonce: 3}})
+
+	accnt, _ := state.NewAccount(state.NewAddress([]byte("address")), &mock.AccountTrackerStub{})
+	accnt.DataTrieTracker().SaveKeyValue(esdt.NFTIndexKey(), indexBuff)
+	accnt.DataTrieTracker().SaveKeyValue(esdt.NFTTokenKey(tokenID, 3), tokenBuff)
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return accnt, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithMarshalizer(marshalizer),
+	)
+
+	tokens, err := n.GetNFTs(createDummyHexAddress(64))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tokens))
+	assert.Equal(t, string(tokenID), tokens[0].TokenIdentifier)
+	assert.Equal(t, uint64(3), tokens[0].Nonce)
+	assert.Equal(t, []byte("name"), tokens[0].Token.TokenMetaData.Name)
+}
+
+//------- GetPendingMiniBlocks
+
+func TestNode_GetPendingMiniBlocksNotMetachainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	pending, err := n.GetPendingMiniBlocks()
+
+	assert.Nil(t, pending)
+	assert.Equal(t, node.ErrNilPendingMiniBlocksHandler, err)
+}
+
+func TestNode_GetPendingMiniBlocksShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expected := []*block.PendingMiniBlockInfo{{NotarizedInMetaNonce: 1}}
+	n, _ := node.NewNode(
+		node.WithPendingMiniBlocksHandler(&mock.PendingMiniBlocksHandlerStub{
+			PendingMiniBlocksCalled: func() []*block.PendingMiniBlockInfo {
+				return expected
+			},
+		}),
+	)
+
+	pending, err := n.GetPendingMiniBlocks()
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, pending)
+}
+
+//------- GetNetworkConfig
+
+func TestNode_GetNetworkConfigNilEconomicsDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewMultiShardsCoordinatorMock(2)),
+	)
+
+	networkConfig, err := n.GetNetworkConfig()
+
+	assert.Nil(t, networkConfig)
+	assert.Equal(t, node.ErrNilEconomicsData, err)
+}
+
+func TestNode_GetNetworkConfigShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewMultiShardsCoordinatorMock(2)),
+		node.WithChainID("testnet"),
+		node.WithRoundDuration(4000),
+		node.WithConsensusGroupSize(21),
+		node.WithEconomicsData(&mock.EconomicsHandlerStub{
+			RewardsValueCalled: func() *big.Int {
+				return big.NewInt(1000)
+			},
+			MinGasPriceCalled: func() uint64 {
+				return 10
+			},
+			MinGasLimitCalled: func() uint64 {
+				return 5
+			},
+			GasPriceModifierCalled: func() float64 {
+				return 0.01
+			},
+			MaxMoveBalanceGasPerBlockCalled: func() uint64 {
+				return 100
+			},
+			MaxSCExecutionGasPerBlockCalled: func() uint64 {
+				return 200
+			},
+		}),
+	)
+
+	networkConfig, err := n.GetNetworkConfig()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "testnet", networkConfig.ChainID)
+	assert.Equal(t, uint32(2), networkConfig.NumShards)
+	assert.Equal(t, uint64(4000), networkConfig.RoundDuration)
+	assert.Equal(t, uint32(21), networkConfig.ConsensusGroupSize)
+	assert.Equal(t, uint64(10), networkConfig.MinGasPrice)
+	assert.Equal(t, uint64(5), networkConfig.MinGasLimit)
+	assert.Equal(t, 0.01, networkConfig.GasPriceModifier)
+	assert.Equal(t, uint64(100), networkConfig.MaxMoveBalanceGasPerBlock)
+	assert.Equal(t, uint64(200), networkConfig.MaxSCExecutionGasPerBlock)
+	assert.Equal(t, big.NewInt(1000), networkConfig.RewardsValue)
+}
+
+//------- GetNetworkEconomics
+
+func TestNode_GetNetworkEconomicsNilEconomicsDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewMultiShardsCoordinatorMock(2)),
+		node.WithGenesisTotalSupply(big.NewInt(1000)),
+	)
+
+	economics, err := n.GetNetworkEconomics()
+
+	assert.Nil(t, economics)
+	assert.Equal(t, node.ErrNilEconomicsData, err)
+}
+
+func TestNode_GetNetworkEconomicsNilTotalSupplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewMultiShardsCoordinatorMock(2)),
+		node.WithEconomicsData(&mock.EconomicsHandlerStub{}),
+	)
+
+	economics, err := n.GetNetworkEconomics()
+
+	assert.Nil(t, economics)
+	assert.Equal(t, node.ErrNilTotalSupply, err)
+}
+
+func TestNode_GetNetworkEconomicsStakingNotInSelfShardShouldUseGenesisValidators(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return sharding.MetachainShardId
+	}
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(shardCoordinator),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithInitialNodesPubKeys(map[uint32][]string{0: {"pk0", "pk1"}, 1: {"pk2"}}),
+		node.WithEconomicsData(&mock.EconomicsHandlerStub{}),
+		node.WithGenesisTotalSupply(big.NewInt(1000)),
+	)
+
+	economics, err := n.GetNetworkEconomics()
+
+	stakeValue, _ := vmFactory.InitialStakeValue()
+	expectedStakedValue := big.NewInt(0).Mul(stakeValue, big.NewInt(3))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), economics.TotalSupply)
+	assert.Equal(t, expectedStakedValue, economics.StakedValue)
+	assert.Equal(t, "0", economics.TopUpValue.String())
+	assert.Equal(t, float64(0), economics.APR)
+}
+
+func TestNode_GetNetworkEconomicsWithSupplyAccountingShouldReturnTheShardSupply(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return sharding.MetachainShardId
+	}
+	shardSupply := supply.NewShardSupply(0, big.NewInt(1000))
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(shardCoordinator),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithInitialNodesPubKeys(map[uint32][]string{0: {"pk0"}}),
+		node.WithEconomicsData(&mock.EconomicsHandlerStub{}),
+		node.WithGenesisTotalSupply(big.NewInt(1000)),
+		node.WithSupplyAccountant(&mock.SupplyAccountantStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			CurrentSupplyCalled: func() (*supply.ShardSupply, error) {
+				return shardSupply, nil
+			},
+		}),
+	)
+
+	economics, err := n.GetNetworkEconomics()
+
+	assert.Nil(t, err)
+	assert.Equal(t, shardSupply, economics.ShardSupply)
+}
+
+func TestNode_GetNetworkEconomicsStakingInSelfShardShouldUseStakingBalance(t *testing.T) {
+	t.Parallel()
+
+	stakeValue, _ := vmFactory.InitialStakeValue()
+	topUp := big.NewInt(500)
+	stakingBalance := big.NewInt(0).Add(stakeValue, topUp)
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewMultiShardsCoordinatorMock(1)),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithAccountsAdapter(&mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				return &state.Account{Balance: stakingBalance}, nil
+			},
+		}),
+		node.WithInitialNodesPubKeys(map[uint32][]string{0: {"pk0"}}),
+		node.WithRoundDuration(1000),
+		node.WithConsensusGroupSize(1),
+		node.WithEconomicsData(&mock.EconomicsHandlerStub{
+			RewardsValueCalled: func() *big.Int {
+				return big.NewInt(1)
+			},
+		}),
+		node.WithGenesisTotalSupply(big.NewInt(1000)),
+	)
+
+	economics, err := n.GetNetworkEconomics()
+
+	roundsPerYear := big.NewInt(365 * 24 * 3600)
+	expectedAPR, _ := big.NewFloat(0).Quo(
+		big.NewFloat(0).SetInt(roundsPerYear),
+		big.NewFloat(0).SetInt(stakingBalance),
+	).Float64()
+	assert.Nil(t, err)
+	assert.Equal(t, stakingBalance, economics.StakedValue)
+	assert.Equal(t, topUp, economics.TopUpValue)
+	assert.Equal(t, expectedAPR, economics.APR)
+}
+
+func TestNode_GetNetworkEconomicsStakingAccountNotFoundShouldReturnZeroStakedValue(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewMultiShardsCoordinatorMock(1)),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithAccountsAdapter(&mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				return nil, state.ErrAccNotFound
+			},
+		}),
+		node.WithEconomicsData(&mock.EconomicsHandlerStub{}),
+		node.WithGenesisTotalSupply(big.NewInt(1000)),
+	)
+
+	economics, err := n.GetNetworkEconomics()
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), economics.StakedValue)
+	assert.Equal(t, big.NewInt(0), economics.TopUpValue)
+}
+
+func TestNode_AppStatusHandlersShouldIncrement(t *testing.T) {
+	t.Parallel()
+
+	metricKey := core.MetricCurrentRound
+	incrementCalled := make(chan bool, 1)
+
+	// create a prometheus status handler which will be passed to the facade
+	appStatusHandlerStub := mock.AppStatusHandlerStub{
+		IncrementHandler: func(key string) {
+			incrementCalled <- true
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAppStatusHandler(&appStatusHandlerStub))
+	asf := n.GetAppStatusHandler()
+
+	asf.Increment(metricKey)
+
+	select {
+	case <-incrementCalled:
+	case <-time.After(1 * time.Second):
+		assert.Fail(t, "Timeout - function not called")
+	}
+}
+
+func TestNode_AppStatusHandlerShouldDecrement(t *testing.T) {
+	t.Parallel()
+
+	metricKey := core.MetricCurrentRound
+	decrementCalled := make(chan bool, 1)
+
+	// create a prometheus status handler which will be passed to the facade
+	appStatusHandlerStub := mock.AppStatusHandlerStub{
+		DecrementHandler: func(key string) {
+			decrementCalled <- true
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAppStatusHandler(&appStatusHandlerStub))
+	asf := n.GetAppStatusHandler()
+
+	asf.Decrement(metricKey)
+
+	select {
+	case <-decrementCalled:
+	case <-time.After(1 * time.Second):
+		assert.Fail(t, "Timeout - function not called")
+	}
+}
+
+func TestNode_AppStatusHandlerShouldSetInt64Value(t *testing.T) {
+	t.Parallel()
+
+	metricKey := core.MetricCurrentRound
+	setInt64ValueCalled := make(chan bool, 1)
+
+	// create a prometheus status handler which will be passed to the facade
+	appStatusHandlerStub := mock.AppStatusHandlerStub{
+		SetInt64ValueHandler: func(key string, value int64) {
+			setInt64ValueCalled <- true
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAppStatusHandler(&appStatusHandlerStub))
+	asf := n.GetAppStatusHandler()
+
+	asf.SetInt64Value(metricKey, int64(1))
+
+	select {
+	case <-setInt64ValueCalled:
+	case <-time.After(1 * time.Second):
+		assert.Fail(t, "Timeout - function not called")
+	}
+}
+
+func TestNode_AppStatusHandlerShouldSetUInt64Value(t *testing.T) {
+	t.Parallel()
+
+	metricKey := core.MetricCurrentRound
+	setUInt64ValueCalled := make(chan bool, 1)
+
+	// create a prometheus status handler which will be passed to the facade
+	appStatusHandlerStub := mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			setUInt64ValueCalled <- true
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAppStatusHandler(&appStatusHandlerStub))
+	asf := n.GetAppStatusHandler()
+
+	asf.SetUInt64Value(metricKey, uint64(1))
+
+	select {
+	case <-setUInt64ValueCalled:
+	case <-time.After(1 * time.Second):
+		assert.Fail(t, "Timeout - function not called")
+	}
+}
+
+func TestNode_SendBulkTransactionsMultiShardTxsShouldBeMappedCorrectly(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+
+	mutRecoveredTransactions := &sync.RWMutex{}
+	recoveredTransactions := make(map[uint32][]*transaction.Transaction)
+	signer := &mock.SinglesignMock{}
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		items := strings.Split(string(address.Bytes()), "senderShard")
+		sId, _ := strconv.ParseUint(items[1], 2, 32)
+		return uint32(sId)
+	}
+
+	var txsToSend []*transaction.Transaction
+	txsToSend = append(txsToSend, &transaction.Transaction{
+		Nonce:     10,
+		Value:     new(big.Int).SetInt64(15),
+		RcvAddr:   []byte("receiver1"),
+		SndAddr:   []byte("senderShard0"),
+		GasPrice:  5,
+		GasLimit:  11,
+		Data:      "",
+		Signature: nil,
+		Challenge: nil,
+	})
+
+	txsToSend = append(txsToSend, &transaction.Transaction{
+		Nonce:     11,
+		Value:     new(big.Int).SetInt64(25),
+		RcvAddr:   []byte("receiver2"),
+		SndAddr:   []byte("senderShard0"),
+		GasPrice:  6,
+		GasLimit:  12,
+		Data:      "",
 		Signature: nil,
 		Challenge: nil,
 	})
@@ -1834,3 +2861,44 @@ func TestNode_SendBulkTransactionsMultiShardTxsShouldBeMappedCorrectly(t *testin
 	assert.Equal(t, len(txsToSend), recTxsSize)
 	mutRecoveredTransactions.RUnlock()
 }
+
+func TestNode_StopConsensusNotStartedShouldNotErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	err := n.StopConsensus()
+	assert.Nil(t, err)
+}
+
+//------- ReplaceComponents
+
+func TestNode_ReplaceComponentsWhileConsensusRunningShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	n.SetBootstrapper(&consensusMock.BootstrapperMock{})
+
+	err := n.ReplaceComponents(node.WithBlockProcessor(&mock.BlockProcessorStub{}))
+	assert.Equal(t, node.ErrConsensusRunning, err)
+}
+
+func TestNode_ReplaceComponentsAfterConsensusStoppedShouldApplyTheOptions(t *testing.T) {
+	t.Parallel()
+
+	stopSyncCalled := false
+	n, _ := node.NewNode()
+	n.SetBootstrapper(&consensusMock.BootstrapperMock{
+		StopSyncCalled: func() {
+			stopSyncCalled = true
+		},
+	})
+	_ = n.StopConsensus()
+	assert.True(t, stopSyncCalled)
+
+	err := n.ReplaceComponents(node.WithBlockProcessor(&mock.BlockProcessorStub{}))
+	assert.Nil(t, err)
+
+	err = n.ReplaceComponents(node.WithBlockProcessor(nil))
+	assert.NotNil(t, err)
+}
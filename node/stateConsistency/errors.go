@@ -0,0 +1,33 @@
+package stateConsistency
+
+import "errors"
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilBlockChain signals that a nil block chain has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidCheckInterval signals that an invalid check interval has been provided
+var ErrInvalidCheckInterval = errors.New("invalid check interval")
+
+// ErrInvalidNumPeersToQuery signals that an invalid number of peers to query has been provided
+var ErrInvalidNumPeersToQuery = errors.New("invalid number of peers to query")
+
+// ErrInvalidQuorum signals that the quorum is not between 1 and the number of queried peers
+var ErrInvalidQuorum = errors.New("invalid quorum")
+
+// ErrNilMessage signals that a nil message has been received
+var ErrNilMessage = errors.New("nil message")
+
+// ErrInvalidMessageType signals that a state message of an unknown type has been received
+var ErrInvalidMessageType = errors.New("invalid state message type")
@@ -0,0 +1,5 @@
+package stateConsistency
+
+func (scc *stateConsistencyChecker) CheckLastCommittedBlock() {
+	scc.checkLastCommittedBlock()
+}
@@ -0,0 +1,14 @@
+package stateConsistency
+
+import "github.com/ElrondNetwork/elrond-go/p2p"
+
+// Messenger defines the messenger operations used to query the peers about their committed blocks
+type Messenger interface {
+	HasTopic(name string) bool
+	CreateTopic(name string, createChannelForTopic bool) error
+	RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error
+	UnregisterMessageProcessor(topic string) error
+	ConnectedPeersOnTopic(topic string) []p2p.PeerID
+	SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,362 @@
+package stateConsistency
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("node/stateConsistency")
+
+// TopicPrefix is the prefix of the topic on which the peers of a shard compare their committed blocks
+const TopicPrefix = "stateConsistency"
+
+// numRememberedBlocks is the number of last committed blocks reported to the querying peers. It also bounds the
+// number of nonces for which the reports are still awaited
+const numRememberedBlocks = 50
+
+const (
+	stateUnknown    = "unknown"
+	stateConsistent = "consistent"
+	stateDiverged   = "diverged"
+)
+
+// ArgStateConsistencyChecker holds all dependencies required by the state consistency checker in order to create
+// a new instance
+type ArgStateConsistencyChecker struct {
+	Messenger        Messenger
+	Marshalizer      marshal.Marshalizer
+	BlockChain       data.ChainHandler
+	ShardCoordinator sharding.Coordinator
+	StatusHandler    core.AppStatusHandler
+	Config           config.StateConsistencyConfig
+}
+
+type committedBlock struct {
+	headerHash []byte
+	rootHash   []byte
+}
+
+type pendingCheck struct {
+	block        committedBlock
+	queried      map[p2p.PeerID]struct{}
+	numMatching  int
+	numDiverging int
+}
+
+// stateConsistencyChecker periodically asks random peers of the shard for the header hash and the state root hash of
+// the block they committed at the nonce of the last block committed by the node. When a quorum of peers report other
+// hashes than the own ones, the node is considered to have diverged and a critical alarm is raised, so the operator
+// can stop it before it proposes blocks built on a bad state. Only the reports of the queried peers are counted
+type stateConsistencyChecker struct {
+	messenger     Messenger
+	marshalizer   marshal.Marshalizer
+	blockChain    data.ChainHandler
+	statusHandler core.AppStatusHandler
+	topic         string
+	numPeers      int
+	quorum        int
+
+	mutState        sync.Mutex
+	remembered      map[uint64]committedBlock
+	pending         map[uint64]*pendingCheck
+	lastCheckedHash []byte
+	isDiverged      bool
+	cancel          func()
+}
+
+// NewStateConsistencyChecker creates a new state consistency checker, registers it on the shard topic and starts
+// its periodic check
+func NewStateConsistencyChecker(args ArgStateConsistencyChecker) (*stateConsistencyChecker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	scc := &stateConsistencyChecker{
+		messenger:     args.Messenger,
+		marshalizer:   args.Marshalizer,
+		blockChain:    args.BlockChain,
+		statusHandler: args.StatusHandler,
+		topic:         TopicPrefix + args.ShardCoordinator.CommunicationIdentifier(args.ShardCoordinator.SelfId()),
+		numPeers:      args.Config.NumPeersToQuery,
+		quorum:        args.Config.Quorum,
+		remembered:    make(map[uint64]committedBlock),
+		pending:       make(map[uint64]*pendingCheck),
+	}
+
+	if !scc.messenger.HasTopic(scc.topic) {
+		err = scc.messenger.CreateTopic(scc.topic, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = scc.messenger.RegisterMessageProcessor(scc.topic, scc)
+	if err != nil {
+		return nil, err
+	}
+
+	scc.statusHandler.SetStringValue(core.MetricStateConsistency, stateUnknown)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	scc.cancel = cancel
+	go scc.checkLoop(ctx, time.Duration(args.Config.CheckIntervalInSec)*time.Second)
+
+	return scc, nil
+}
+
+func checkArgs(args ArgStateConsistencyChecker) error {
+	if check.IfNil(args.Messenger) {
+		return ErrNilMessenger
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.BlockChain) {
+		return ErrNilBlockChain
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+	if args.Config.CheckIntervalInSec <= 0 {
+		return ErrInvalidCheckInterval
+	}
+	if args.Config.NumPeersToQuery <= 0 {
+		return ErrInvalidNumPeersToQuery
+	}
+	if args.Config.Quorum <= 0 || args.Config.Quorum > args.Config.NumPeersToQuery {
+		return ErrInvalidQuorum
+	}
+
+	return nil
+}
+
+func (scc *stateConsistencyChecker) checkLoop(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-time.After(interval):
+			scc.checkLastCommittedBlock()
+		case <-ctx.Done():
+			log.Debug("state consistency checker's go routine is stopping...")
+			return
+		}
+	}
+}
+
+// checkLastCommittedBlock remembers the last committed block and, if it was not already checked, queries random
+// peers about the block they committed at the same nonce
+func (scc *stateConsistencyChecker) checkLastCommittedBlock() {
+	header := scc.blockChain.GetCurrentBlockHeader()
+	headerHash := scc.blockChain.GetCurrentBlockHeaderHash()
+	if check.IfNil(header) || len(headerHash) == 0 {
+		return
+	}
+
+	nonce := header.GetNonce()
+	block := committedBlock{
+		headerHash: headerHash,
+		rootHash:   header.GetRootHash(),
+	}
+
+	scc.mutState.Lock()
+	scc.rememberBlock(nonce, block)
+	if bytes.Equal(scc.lastCheckedHash, headerHash) {
+		scc.mutState.Unlock()
+		return
+	}
+	scc.lastCheckedHash = headerHash
+	peers := scc.selectPeers()
+	pc := &pendingCheck{
+		block:   block,
+		queried: make(map[p2p.PeerID]struct{}, len(peers)),
+	}
+	for _, pid := range peers {
+		pc.queried[pid] = struct{}{}
+	}
+	scc.pending[nonce] = pc
+	scc.mutState.Unlock()
+
+	if len(peers) < scc.quorum {
+		log.Debug("not enough peers to check the state consistency",
+			"nonce", nonce,
+			"num peers", len(peers),
+			"quorum", scc.quorum,
+		)
+	}
+
+	buff, err := scc.marshalizer.Marshal(&StateMessage{Type: QueryMessage, Nonce: nonce})
+	if err != nil {
+		log.Debug("cannot marshal the state query", "error", err.Error())
+		return
+	}
+	for _, pid := range peers {
+		err = scc.messenger.SendToConnectedPeer(scc.topic, buff, pid)
+		if err != nil {
+			log.Debug("cannot send the state query", "peer", pid.Pretty(), "error", err.Error())
+		}
+	}
+}
+
+func (scc *stateConsistencyChecker) rememberBlock(nonce uint64, block committedBlock) {
+	scc.remembered[nonce] = block
+	if nonce < numRememberedBlocks {
+		return
+	}
+
+	oldestNonce := nonce - numRememberedBlocks
+	for n := range scc.remembered {
+		if n <= oldestNonce {
+			delete(scc.remembered, n)
+		}
+	}
+	for n := range scc.pending {
+		if n <= oldestNonce {
+			log.Debug("state consistency check inconclusive", "nonce", n)
+			delete(scc.pending, n)
+		}
+	}
+}
+
+func (scc *stateConsistencyChecker) selectPeers() []p2p.PeerID {
+	peers := scc.messenger.ConnectedPeersOnTopic(scc.topic)
+	rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+	if len(peers) > scc.numPeers {
+		peers = peers[:scc.numPeers]
+	}
+
+	return peers
+}
+
+// ProcessReceivedMessage answers the state queries of the other peers and counts the reports of the queried ones
+func (scc *stateConsistencyChecker) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+
+	stateMessage := &StateMessage{}
+	err := scc.marshalizer.Unmarshal(stateMessage, message.Data())
+	if err != nil {
+		return err
+	}
+
+	switch stateMessage.Type {
+	case QueryMessage:
+		return scc.answerQuery(stateMessage.Nonce, message.Peer())
+	case ReportMessage:
+		scc.countReport(stateMessage, message.Peer())
+		return nil
+	default:
+		return ErrInvalidMessageType
+	}
+}
+
+func (scc *stateConsistencyChecker) answerQuery(nonce uint64, pid p2p.PeerID) error {
+	scc.mutState.Lock()
+	block, found := scc.remembered[nonce]
+	scc.mutState.Unlock()
+	if !found {
+		return nil
+	}
+
+	buff, err := scc.marshalizer.Marshal(&StateMessage{
+		Type:       ReportMessage,
+		Nonce:      nonce,
+		HeaderHash: block.headerHash,
+		RootHash:   block.rootHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	return scc.messenger.SendToConnectedPeer(scc.topic, buff, pid)
+}
+
+func (scc *stateConsistencyChecker) countReport(report *StateMessage, pid p2p.PeerID) {
+	scc.mutState.Lock()
+	defer scc.mutState.Unlock()
+
+	pc, found := scc.pending[report.Nonce]
+	if !found {
+		return
+	}
+	_, isQueried := pc.queried[pid]
+	if !isQueried {
+		return
+	}
+	delete(pc.queried, pid)
+
+	isMatching := bytes.Equal(report.HeaderHash, pc.block.headerHash) && bytes.Equal(report.RootHash, pc.block.rootHash)
+	if isMatching {
+		pc.numMatching++
+	} else {
+		pc.numDiverging++
+	}
+
+	if pc.numDiverging >= scc.quorum {
+		delete(scc.pending, report.Nonce)
+		scc.raiseDivergenceAlarm(report.Nonce, pc, report)
+		return
+	}
+	if pc.numMatching >= scc.quorum {
+		delete(scc.pending, report.Nonce)
+		scc.isDiverged = false
+		scc.statusHandler.SetStringValue(core.MetricStateConsistency, stateConsistent)
+		log.Debug("state consistent with the queried peers", "nonce", report.Nonce)
+	}
+}
+
+func (scc *stateConsistencyChecker) raiseDivergenceAlarm(nonce uint64, pc *pendingCheck, lastReport *StateMessage) {
+	scc.isDiverged = true
+	scc.statusHandler.SetStringValue(core.MetricStateConsistency, stateDiverged)
+	scc.statusHandler.SetUInt64Value(core.MetricStateDivergenceNonce, nonce)
+
+	log.Error("CRITICAL: the committed state diverges from the one of the queried peers, "+
+		"the node should be stopped before proposing blocks",
+		"nonce", nonce,
+		"own header hash", pc.block.headerHash,
+		"own root hash", pc.block.rootHash,
+		"reported header hash", lastReport.HeaderHash,
+		"reported root hash", lastReport.RootHash,
+		"num diverging peers", pc.numDiverging,
+		"num matching peers", pc.numMatching,
+	)
+}
+
+// IsDiverged returns true if the last conclusive check found the committed state diverging from the one of the peers
+func (scc *stateConsistencyChecker) IsDiverged() bool {
+	scc.mutState.Lock()
+	defer scc.mutState.Unlock()
+
+	return scc.isDiverged
+}
+
+// Close stops the periodic check and stops answering the queries
+func (scc *stateConsistencyChecker) Close() error {
+	scc.cancel()
+
+	return scc.messenger.UnregisterMessageProcessor(scc.topic)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (scc *stateConsistencyChecker) IsInterfaceNil() bool {
+	if scc == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,338 @@
+package stateConsistency_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/stateConsistency"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+var peers = []p2p.PeerID{"peer1", "peer2", "peer3"}
+
+type sentMessage struct {
+	message *stateConsistency.StateMessage
+	peer    p2p.PeerID
+}
+
+func createMockArgs() stateConsistency.ArgStateConsistencyChecker {
+	return stateConsistency.ArgStateConsistencyChecker{
+		Messenger:        &mock.StateMessengerStub{},
+		Marshalizer:      &mock.MarshalizerFake{},
+		BlockChain:       &mock.BlockChainMock{},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		StatusHandler:    &mock.AppStatusHandlerStub{SetStringValueHandler: func(key string, value string) {}},
+		Config: config.StateConsistencyConfig{
+			Enabled:            true,
+			CheckIntervalInSec: 1000,
+			NumPeersToQuery:    3,
+			Quorum:             2,
+		},
+	}
+}
+
+// createCommittingArgs returns arguments with a block chain having committed the block at the provided nonce and a
+// messenger recording the messages sent
+func createCommittingArgs(nonce uint64, sent *[]sentMessage, mut *sync.Mutex) stateConsistency.ArgStateConsistencyChecker {
+	args := createMockArgs()
+	marshalizer := &mock.MarshalizerFake{}
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: nonce, RootHash: []byte("root hash")}
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return []byte("header hash")
+		},
+	}
+	args.Messenger = &mock.StateMessengerStub{
+		ConnectedPeersOnTopicCalled: func(topic string) []p2p.PeerID {
+			return append([]p2p.PeerID{}, peers...)
+		},
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID p2p.PeerID) error {
+			message := &stateConsistency.StateMessage{}
+			_ = marshalizer.Unmarshal(message, buff)
+			mut.Lock()
+			*sent = append(*sent, sentMessage{message: message, peer: peerID})
+			mut.Unlock()
+			return nil
+		},
+	}
+
+	return args
+}
+
+func createReport(nonce uint64, headerHash string, pid p2p.PeerID) p2p.MessageP2P {
+	buff, _ := (&mock.MarshalizerFake{}).Marshal(&stateConsistency.StateMessage{
+		Type:       stateConsistency.ReportMessage,
+		Nonce:      nonce,
+		HeaderHash: []byte(headerHash),
+		RootHash:   []byte("root hash"),
+	})
+
+	return &mock.P2PMessageStub{DataField: buff, PeerField: pid}
+}
+
+//------- NewStateConsistencyChecker
+
+func TestNewStateConsistencyChecker_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Messenger = nil
+	scc, err := stateConsistency.NewStateConsistencyChecker(args)
+
+	assert.Nil(t, scc)
+	assert.Equal(t, stateConsistency.ErrNilMessenger, err)
+}
+
+func TestNewStateConsistencyChecker_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Marshalizer = nil
+	scc, err := stateConsistency.NewStateConsistencyChecker(args)
+
+	assert.Nil(t, scc)
+	assert.Equal(t, stateConsistency.ErrNilMarshalizer, err)
+}
+
+func TestNewStateConsistencyChecker_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BlockChain = nil
+	scc, err := stateConsistency.NewStateConsistencyChecker(args)
+
+	assert.Nil(t, scc)
+	assert.Equal(t, stateConsistency.ErrNilBlockChain, err)
+}
+
+func TestNewStateConsistencyChecker_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ShardCoordinator = nil
+	scc, err := stateConsistency.NewStateConsistencyChecker(args)
+
+	assert.Nil(t, scc)
+	assert.Equal(t, stateConsistency.ErrNilShardCoordinator, err)
+}
+
+func TestNewStateConsistencyChecker_NilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.StatusHandler = nil
+	scc, err := stateConsistency.NewStateConsistencyChecker(args)
+
+	assert.Nil(t, scc)
+	assert.Equal(t, stateConsistency.ErrNilAppStatusHandler, err)
+}
+
+func TestNewStateConsistencyChecker_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Config.CheckIntervalInSec = 0
+	_, err := stateConsistency.NewStateConsistencyChecker(args)
+	assert.Equal(t, stateConsistency.ErrInvalidCheckInterval, err)
+
+	args = createMockArgs()
+	args.Config.NumPeersToQuery = 0
+	_, err = stateConsistency.NewStateConsistencyChecker(args)
+	assert.Equal(t, stateConsistency.ErrInvalidNumPeersToQuery, err)
+
+	args = createMockArgs()
+	args.Config.Quorum = 4
+	_, err = stateConsistency.NewStateConsistencyChecker(args)
+	assert.Equal(t, stateConsistency.ErrInvalidQuorum, err)
+}
+
+func TestNewStateConsistencyChecker_ShouldRegisterOnTheShardTopic(t *testing.T) {
+	t.Parallel()
+
+	createdTopic := ""
+	registeredTopic := ""
+	unregisteredTopic := ""
+	args := createMockArgs()
+	args.Messenger = &mock.StateMessengerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			createdTopic = name
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredTopic = topic
+			return nil
+		},
+		UnregisterMessageProcessorCalled: func(topic string) error {
+			unregisteredTopic = topic
+			return nil
+		},
+	}
+	scc, err := stateConsistency.NewStateConsistencyChecker(args)
+
+	assert.Nil(t, err)
+	assert.False(t, scc.IsInterfaceNil())
+	assert.Equal(t, "stateConsistency_0", createdTopic)
+	assert.Equal(t, createdTopic, registeredTopic)
+
+	err = scc.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, createdTopic, unregisteredTopic)
+}
+
+//------- CheckLastCommittedBlock
+
+func TestStateConsistencyChecker_CheckLastCommittedBlockShouldQueryThePeersOnce(t *testing.T) {
+	t.Parallel()
+
+	sent := make([]sentMessage, 0)
+	mut := &sync.Mutex{}
+	scc, _ := stateConsistency.NewStateConsistencyChecker(createCommittingArgs(7, &sent, mut))
+	defer func() {
+		_ = scc.Close()
+	}()
+
+	scc.CheckLastCommittedBlock()
+	scc.CheckLastCommittedBlock()
+
+	mut.Lock()
+	defer mut.Unlock()
+	assert.Equal(t, len(peers), len(sent))
+	for _, sm := range sent {
+		assert.Equal(t, stateConsistency.QueryMessage, sm.message.Type)
+		assert.Equal(t, uint64(7), sm.message.Nonce)
+	}
+}
+
+//------- ProcessReceivedMessage
+
+func TestStateConsistencyChecker_ProcessReceivedMessageInvalidTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	scc, _ := stateConsistency.NewStateConsistencyChecker(createMockArgs())
+	defer func() {
+		_ = scc.Close()
+	}()
+
+	buff, _ := (&mock.MarshalizerFake{}).Marshal(&stateConsistency.StateMessage{Type: 100})
+	err := scc.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buff}, nil)
+
+	assert.Equal(t, stateConsistency.ErrInvalidMessageType, err)
+}
+
+func TestStateConsistencyChecker_ProcessReceivedMessageQueryShouldReportTheRememberedBlock(t *testing.T) {
+	t.Parallel()
+
+	sent := make([]sentMessage, 0)
+	mut := &sync.Mutex{}
+	scc, _ := stateConsistency.NewStateConsistencyChecker(createCommittingArgs(7, &sent, mut))
+	defer func() {
+		_ = scc.Close()
+	}()
+	scc.CheckLastCommittedBlock()
+
+	marshalizer := &mock.MarshalizerFake{}
+	buff, _ := marshalizer.Marshal(&stateConsistency.StateMessage{Type: stateConsistency.QueryMessage, Nonce: 6})
+	err := scc.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buff, PeerField: "querier"}, nil)
+	assert.Nil(t, err)
+
+	buff, _ = marshalizer.Marshal(&stateConsistency.StateMessage{Type: stateConsistency.QueryMessage, Nonce: 7})
+	err = scc.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buff, PeerField: "querier"}, nil)
+	assert.Nil(t, err)
+
+	mut.Lock()
+	defer mut.Unlock()
+	report := sent[len(sent)-1]
+	assert.Equal(t, len(peers)+1, len(sent))
+	assert.Equal(t, p2p.PeerID("querier"), report.peer)
+	assert.Equal(t, stateConsistency.ReportMessage, report.message.Type)
+	assert.Equal(t, []byte("header hash"), report.message.HeaderHash)
+	assert.Equal(t, []byte("root hash"), report.message.RootHash)
+}
+
+func TestStateConsistencyChecker_MatchingReportsShouldBeConsistent(t *testing.T) {
+	t.Parallel()
+
+	sent := make([]sentMessage, 0)
+	mut := &sync.Mutex{}
+	args := createCommittingArgs(7, &sent, mut)
+	state := ""
+	args.StatusHandler = &mock.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			if key == core.MetricStateConsistency {
+				state = value
+			}
+		},
+	}
+	scc, _ := stateConsistency.NewStateConsistencyChecker(args)
+	defer func() {
+		_ = scc.Close()
+	}()
+	scc.CheckLastCommittedBlock()
+
+	_ = scc.ProcessReceivedMessage(createReport(7, "header hash", peers[0]), nil)
+	assert.Equal(t, "unknown", state)
+
+	_ = scc.ProcessReceivedMessage(createReport(7, "header hash", peers[1]), nil)
+	assert.Equal(t, "consistent", state)
+	assert.False(t, scc.IsDiverged())
+}
+
+func TestStateConsistencyChecker_DivergingReportsShouldRaiseTheAlarm(t *testing.T) {
+	t.Parallel()
+
+	sent := make([]sentMessage, 0)
+	mut := &sync.Mutex{}
+	args := createCommittingArgs(7, &sent, mut)
+	state := ""
+	divergenceNonce := uint64(0)
+	args.StatusHandler = &mock.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			state = value
+		},
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			divergenceNonce = value
+		},
+	}
+	scc, _ := stateConsistency.NewStateConsistencyChecker(args)
+	defer func() {
+		_ = scc.Close()
+	}()
+	scc.CheckLastCommittedBlock()
+
+	_ = scc.ProcessReceivedMessage(createReport(7, "other hash", peers[0]), nil)
+	_ = scc.ProcessReceivedMessage(createReport(7, "header hash", peers[1]), nil)
+	assert.False(t, scc.IsDiverged())
+
+	_ = scc.ProcessReceivedMessage(createReport(7, "other hash", peers[2]), nil)
+	assert.True(t, scc.IsDiverged())
+	assert.Equal(t, "diverged", state)
+	assert.Equal(t, uint64(7), divergenceNonce)
+}
+
+func TestStateConsistencyChecker_ReportsOfNotQueriedPeersShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	sent := make([]sentMessage, 0)
+	mut := &sync.Mutex{}
+	scc, _ := stateConsistency.NewStateConsistencyChecker(createCommittingArgs(7, &sent, mut))
+	defer func() {
+		_ = scc.Close()
+	}()
+	scc.CheckLastCommittedBlock()
+
+	_ = scc.ProcessReceivedMessage(createReport(7, "other hash", "intruder1"), nil)
+	_ = scc.ProcessReceivedMessage(createReport(7, "other hash", "intruder2"), nil)
+	_ = scc.ProcessReceivedMessage(createReport(7, "other hash", peers[0]), nil)
+	_ = scc.ProcessReceivedMessage(createReport(7, "other hash", peers[0]), nil)
+	_ = scc.ProcessReceivedMessage(createReport(8, "other hash", peers[1]), nil)
+
+	assert.False(t, scc.IsDiverged())
+}
@@ -0,0 +1,19 @@
+package stateConsistency
+
+// MessageType identifies the kind of a state message
+type MessageType uint8
+
+const (
+	// QueryMessage asks a peer for the block it committed at a nonce
+	QueryMessage MessageType = iota + 1
+	// ReportMessage responds to a query with the hashes of the block committed at the queried nonce
+	ReportMessage
+)
+
+// StateMessage is exchanged between the peers of a shard to compare their committed blocks
+type StateMessage struct {
+	Type       MessageType `json:"type"`
+	Nonce      uint64      `json:"nonce"`
+	HeaderHash []byte      `json:"headerHash,omitempty"`
+	RootHash   []byte      `json:"rootHash,omitempty"`
+}
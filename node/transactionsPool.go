@@ -0,0 +1,111 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// GetTransactionsPool returns a snapshot of the transactions pool of the node's shard, grouped by sender. The
+// transactions of each sender are listed only if withTransactions is set. If senderHex is not empty, only the
+// transactions of that sender are returned, always listed
+func (n *Node) GetTransactionsPool(withTransactions bool, senderHex string) (*external.TransactionsPool, error) {
+	if n.dataPool == nil || n.dataPool.IsInterfaceNil() {
+		return nil, ErrNilDataPool
+	}
+	if n.shardCoordinator == nil || n.shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+
+	var senderFilter []byte
+	if len(senderHex) > 0 {
+		var err error
+		senderFilter, err = hex.DecodeString(senderHex)
+		if err != nil {
+			return nil, err
+		}
+		withTransactions = true
+	}
+
+	selfShardID := n.shardCoordinator.SelfId()
+	senders := make(map[string]*external.PoolSender)
+	pool := &external.TransactionsPool{
+		ShardID: selfShardID,
+		Senders: make([]*external.PoolSender, 0),
+	}
+
+	for _, destinationShardID := range n.poolDestinationShards() {
+		cacheID := process.ShardCacherIdentifier(selfShardID, destinationShardID)
+		cacher := n.dataPool.Transactions().ShardDataStore(cacheID)
+		if cacher == nil {
+			continue
+		}
+
+		for _, txHash := range cacher.Keys() {
+			value, ok := cacher.Peek(txHash)
+			if !ok {
+				continue
+			}
+			tx, ok := value.(*transaction.Transaction)
+			if !ok {
+				continue
+			}
+			if senderFilter != nil && !bytes.Equal(senderFilter, tx.SndAddr) {
+				continue
+			}
+
+			sender, found := senders[string(tx.SndAddr)]
+			if !found {
+				sender = &external.PoolSender{
+					Address: hex.EncodeToString(tx.SndAddr),
+				}
+				senders[string(tx.SndAddr)] = sender
+				pool.Senders = append(pool.Senders, sender)
+			}
+
+			sender.NumTransactions++
+			pool.NumTransactions++
+			if withTransactions {
+				sender.Transactions = append(sender.Transactions, &external.PoolTransaction{
+					Hash:             hex.EncodeToString(txHash),
+					Nonce:            tx.Nonce,
+					GasPrice:         tx.GasPrice,
+					GasLimit:         tx.GasLimit,
+					DestinationShard: destinationShardID,
+				})
+			}
+		}
+	}
+
+	sortPoolSenders(pool.Senders)
+	pool.NumSenders = len(pool.Senders)
+
+	return pool, nil
+}
+
+func (n *Node) poolDestinationShards() []uint32 {
+	numShards := n.shardCoordinator.NumberOfShards()
+	shards := make([]uint32, 0, numShards+1)
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		shards = append(shards, shardID)
+	}
+
+	return append(shards, sharding.MetachainShardId)
+}
+
+func sortPoolSenders(senders []*external.PoolSender) {
+	sort.Slice(senders, func(i, j int) bool {
+		return senders[i].Address < senders[j].Address
+	})
+	for _, sender := range senders {
+		txs := sender.Transactions
+		sort.Slice(txs, func(i, j int) bool {
+			return txs[i].Nonce < txs[j].Nonce
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNodeWithTransactionsPool(t *testing.T) *node.Node {
+	txPool, err := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100, Type: storageUnit.LRUCache})
+	assert.Nil(t, err)
+
+	txPool.AddData([]byte("hash1"), &transaction.Transaction{Nonce: 2, SndAddr: []byte("alice"), GasPrice: 10}, "0")
+	txPool.AddData([]byte("hash2"), &transaction.Transaction{Nonce: 1, SndAddr: []byte("alice"), GasLimit: 5}, "0_1")
+	txPool.AddData([]byte("hash3"), &transaction.Transaction{Nonce: 7, SndAddr: []byte("bob")}, "0_4294967295")
+	txPool.AddData([]byte("hash4"), &transaction.Transaction{Nonce: 3, SndAddr: []byte("carol")}, "1_0")
+
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(2, 0)
+	n, _ := node.NewNode(
+		node.WithDataPool(&mock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return txPool
+			},
+		}),
+		node.WithShardCoordinator(shardCoordinator),
+	)
+
+	return n
+}
+
+func TestNode_GetTransactionsPoolNilDataPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithShardCoordinator(&mock.ShardCoordinatorMock{}))
+
+	pool, err := n.GetTransactionsPool(false, "")
+
+	assert.Nil(t, pool)
+	assert.Equal(t, node.ErrNilDataPool, err)
+}
+
+func TestNode_GetTransactionsPoolShouldCountTheOwnShardTransactions(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithTransactionsPool(t)
+
+	pool, err := n.GetTransactionsPool(false, "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, pool.NumTransactions)
+	assert.Equal(t, 2, pool.NumSenders)
+	assert.Equal(t, hex.EncodeToString([]byte("alice")), pool.Senders[0].Address)
+	assert.Equal(t, 2, pool.Senders[0].NumTransactions)
+	assert.Nil(t, pool.Senders[0].Transactions)
+	assert.Equal(t, hex.EncodeToString([]byte("bob")), pool.Senders[1].Address)
+	assert.Equal(t, 1, pool.Senders[1].NumTransactions)
+}
+
+func TestNode_GetTransactionsPoolBySenderShouldListTheTransactionsSortedByNonce(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithTransactionsPool(t)
+
+	pool, err := n.GetTransactionsPool(false, hex.EncodeToString([]byte("alice")))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, pool.NumTransactions)
+	assert.Equal(t, 1, pool.NumSenders)
+
+	txs := pool.Senders[0].Transactions
+	assert.Equal(t, 2, len(txs))
+	assert.Equal(t, hex.EncodeToString([]byte("hash2")), txs[0].Hash)
+	assert.Equal(t, uint64(1), txs[0].Nonce)
+	assert.Equal(t, uint64(5), txs[0].GasLimit)
+	assert.Equal(t, uint32(1), txs[0].DestinationShard)
+	assert.Equal(t, uint64(2), txs[1].Nonce)
+	assert.Equal(t, uint64(10), txs[1].GasPrice)
+}
+
+func TestNode_GetTransactionsPoolInvalidSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithTransactionsPool(t)
+
+	pool, err := n.GetTransactionsPool(false, "not hex")
+
+	assert.Nil(t, pool)
+	assert.NotNil(t, err)
+}
@@ -13,7 +13,7 @@ import (
 // totalRequests defines the number of requests made to determine an accurate clock offset
 const totalRequests = 10
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("ntp")
 
 // NTPOptions defines configuration options for an NTP query
 type NTPOptions struct {
@@ -84,3 +84,21 @@ var ErrInvalidDurationProvided = errors.New("invalid time.Duration provided")
 
 // ErrTooManyGoroutines is raised when the number of goroutines has exceeded a threshold
 var ErrTooManyGoroutines = errors.New(" number of goroutines exceeded")
+
+// ErrNilPeerHonestyHandler signals that a nil peer honesty handler has been provided
+var ErrNilPeerHonestyHandler = errors.New("nil peer honesty handler")
+
+// ErrNilPeerShardResolver signals that a nil peer shard resolver has been provided
+var ErrNilPeerShardResolver = errors.New("nil peer shard resolver")
+
+// ErrInvalidPrivateNetworkKeyLength signals that the private network key does not have the required length
+var ErrInvalidPrivateNetworkKeyLength = errors.New("invalid private network key length")
+
+// ErrInvalidPrivateNetworkKey signals that the private network key could not be decoded
+var ErrInvalidPrivateNetworkKey = errors.New("invalid private network key")
+
+// ErrPrivateNetworkKeyMismatch signals that the peer does not use the same private network key
+var ErrPrivateNetworkKeyMismatch = errors.New("private network key mismatch")
+
+// ErrInvalidOrderedMessage signals that a message received on an ordered topic does not carry a sequence number
+var ErrInvalidOrderedMessage = errors.New("invalid ordered message")
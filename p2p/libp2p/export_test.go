@@ -1,6 +1,8 @@
 package libp2p
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/libp2p/go-libp2p-core/connmgr"
 	"github.com/libp2p/go-libp2p-pubsub/pb"
@@ -33,3 +35,26 @@ func (ds *directSender) Counter() uint64 {
 func (mh *MutexHolder) Mutexes() *lrucache.LRUCache {
 	return mh.mutexes
 }
+
+type MessagesOrderer = messagesOrderer
+
+func NewMessagesOrderer(timeout time.Duration) *MessagesOrderer {
+	return newMessagesOrderer(timeout)
+}
+
+func (mo *messagesOrderer) WaitTurn(originator string, topic string, seq uint64) {
+	mo.waitTurn(originator, topic, seq)
+}
+
+func (mo *messagesOrderer) Delivered(originator string, topic string, seq uint64) {
+	mo.delivered(originator, topic, seq)
+}
+
+func SequenceMessage(buff []byte, seq uint64) []byte {
+	ts := &topicSequencer{nextSeq: seq - 1}
+	return ts.sequence(buff)
+}
+
+func SplitOrderedMessage(buff []byte) (uint64, []byte, error) {
+	return splitOrderedMessage(buff)
+}
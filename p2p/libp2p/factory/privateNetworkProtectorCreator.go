@@ -0,0 +1,29 @@
+package factory
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+)
+
+// CreatePrivateNetworkProtector creates the private network protector from the hex encoded key of the node config.
+// An empty key means the node joins the public network, so no protector is returned
+func CreatePrivateNetworkProtector(nodeConfig config.NodeConfig) (p2p.PrivateNetworkProtector, error) {
+	if len(nodeConfig.PrivateNetworkKey) == 0 {
+		return nil, nil
+	}
+
+	key, err := hex.DecodeString(nodeConfig.PrivateNetworkKey)
+	if err != nil {
+		return nil, p2p.ErrInvalidPrivateNetworkKey
+	}
+
+	protector, err := libp2p.NewPrivateNetworkProtector(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return protector, nil
+}
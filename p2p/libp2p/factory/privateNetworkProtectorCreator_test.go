@@ -0,0 +1,48 @@
+package factory_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/factory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePrivateNetworkProtector_EmptyKeyShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	protector, err := factory.CreatePrivateNetworkProtector(config.NodeConfig{})
+
+	assert.Nil(t, protector)
+	assert.Nil(t, err)
+}
+
+func TestCreatePrivateNetworkProtector_NotHexKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	protector, err := factory.CreatePrivateNetworkProtector(config.NodeConfig{PrivateNetworkKey: "not a hex key"})
+
+	assert.Nil(t, protector)
+	assert.Equal(t, p2p.ErrInvalidPrivateNetworkKey, err)
+}
+
+func TestCreatePrivateNetworkProtector_InvalidKeyLengthShouldErr(t *testing.T) {
+	t.Parallel()
+
+	protector, err := factory.CreatePrivateNetworkProtector(config.NodeConfig{PrivateNetworkKey: "aabb"})
+
+	assert.Nil(t, protector)
+	assert.Equal(t, p2p.ErrInvalidPrivateNetworkKeyLength, err)
+}
+
+func TestCreatePrivateNetworkProtector_ValidKeyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	protector, err := factory.CreatePrivateNetworkProtector(config.NodeConfig{PrivateNetworkKey: strings.Repeat("ab", 32)})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, protector)
+	assert.False(t, protector.IsInterfaceNil())
+}
@@ -4,7 +4,9 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/connmgr"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/multiformats/go-multiaddr"
 )
 
@@ -16,15 +18,29 @@ var ThresholdMinimumConnectedPeers = 3
 // when there are a lot of peers disconnecting and reconnection to initial nodes succeed
 var DurationBetweenReconnectAttempts = time.Second * 5
 
+// shardPeerTag is the connection manager tag of the peers mapped to validators. The connection manager closes first
+// the connections of the peers with the lowest tags values, so the validators of the own shard are kept the longest
+const shardPeerTag = "shardPeer"
+const intraShardPeerTagValue = 100
+const crossShardPeerTagValue = 50
+
 type libp2pConnectionMonitor struct {
-	chDoReconnect chan struct{}
-	reconnecter   p2p.Reconnecter
+	chDoReconnect   chan struct{}
+	reconnecter     p2p.Reconnecter
+	connManager     connmgr.ConnManager
+	peerInfoHandler func(pid p2p.PeerID) p2p.PeerInfo
 }
 
-func newLibp2pConnectionMonitor(reconnecter p2p.Reconnecter) *libp2pConnectionMonitor {
+func newLibp2pConnectionMonitor(
+	reconnecter p2p.Reconnecter,
+	connManager connmgr.ConnManager,
+	peerInfoHandler func(pid p2p.PeerID) p2p.PeerInfo,
+) *libp2pConnectionMonitor {
 	cm := &libp2pConnectionMonitor{
-		reconnecter:   reconnecter,
-		chDoReconnect: make(chan struct{}, 0),
+		reconnecter:     reconnecter,
+		chDoReconnect:   make(chan struct{}, 0),
+		connManager:     connManager,
+		peerInfoHandler: peerInfoHandler,
 	}
 
 	if reconnecter != nil {
@@ -41,7 +57,20 @@ func (lcm *libp2pConnectionMonitor) Listen(network.Network, multiaddr.Multiaddr)
 func (lcm *libp2pConnectionMonitor) ListenClose(network.Network, multiaddr.Multiaddr) {}
 
 // Connected is called when a connection opened
-func (lcm *libp2pConnectionMonitor) Connected(network.Network, network.Conn) {}
+func (lcm *libp2pConnectionMonitor) Connected(_ network.Network, conn network.Conn) {
+	lcm.tagPeer(conn.RemotePeer())
+}
+
+// tagPeer tags the peer in the connection manager according to the shard of the validator it is mapped to
+func (lcm *libp2pConnectionMonitor) tagPeer(pid peer.ID) {
+	peerInfo := lcm.peerInfoHandler(p2p.PeerID(pid))
+	switch peerInfo.PeerType {
+	case p2p.IntraShardPeer:
+		lcm.connManager.TagPeer(pid, shardPeerTag, intraShardPeerTagValue)
+	case p2p.CrossShardPeer:
+		lcm.connManager.TagPeer(pid, shardPeerTag, crossShardPeerTagValue)
+	}
+}
 
 // Disconnected is called when a connection closed
 func (lcm *libp2pConnectionMonitor) Disconnected(netw network.Network, conn network.Conn) {
@@ -4,8 +4,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/connmgr"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,10 +20,14 @@ func init() {
 var durTimeoutWaiting = time.Second * 2
 var durStartGoRoutine = time.Second
 
+func unknownPeerInfo(_ p2p.PeerID) p2p.PeerInfo {
+	return p2p.PeerInfo{PeerType: p2p.UnknownPeer}
+}
+
 func TestNewLibp2pConnectionMonitor_WithNilReconnecterShouldWork(t *testing.T) {
 	t.Parallel()
 
-	cm := newLibp2pConnectionMonitor(nil)
+	cm := newLibp2pConnectionMonitor(nil, &connmgr.NullConnMgr{}, unknownPeerInfo)
 
 	assert.NotNil(t, cm)
 }
@@ -50,7 +57,7 @@ func TestNewLibp2pConnectionMonitor_OnDisconnectedUnderThresholdShouldCallReconn
 		},
 	}
 
-	cm := newLibp2pConnectionMonitor(&rs)
+	cm := newLibp2pConnectionMonitor(&rs, &connmgr.NullConnMgr{}, unknownPeerInfo)
 	time.Sleep(durStartGoRoutine)
 	cm.Disconnected(&ns, nil)
 
@@ -60,3 +67,36 @@ func TestNewLibp2pConnectionMonitor_OnDisconnectedUnderThresholdShouldCallReconn
 		assert.Fail(t, "timeout waiting to call reconnect")
 	}
 }
+
+func TestLibp2pConnectionMonitor_OnConnectedShouldTagThePeersMappedToValidators(t *testing.T) {
+	t.Parallel()
+
+	peerTypes := map[p2p.PeerID]p2p.PeerType{
+		"intra": p2p.IntraShardPeer,
+		"cross": p2p.CrossShardPeer,
+	}
+	tags := make(map[peer.ID]int)
+	cms := &mock.ConnManagerNotifieeStub{
+		TagPeerCalled: func(p peer.ID, tag string, val int) {
+			tags[p] = val
+		},
+	}
+	cm := newLibp2pConnectionMonitor(nil, cms, func(pid p2p.PeerID) p2p.PeerInfo {
+		peerType, ok := peerTypes[pid]
+		if !ok {
+			return p2p.PeerInfo{PeerType: p2p.UnknownPeer}
+		}
+		return p2p.PeerInfo{PeerType: peerType}
+	})
+
+	for _, pid := range []peer.ID{"intra", "cross", "unknown"} {
+		remotePeer := pid
+		cm.Connected(nil, &mock.ConnStub{
+			RemotePeerCalled: func() peer.ID {
+				return remotePeer
+			},
+		})
+	}
+
+	assert.Equal(t, map[peer.ID]int{"intra": intraShardPeerTagValue, "cross": crossShardPeerTagValue}, tags)
+}
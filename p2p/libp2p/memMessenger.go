@@ -78,5 +78,6 @@ func NewNetworkMessengerOnFreePort(
 		outgoingPLB,
 		peerDiscoverer,
 		ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 }
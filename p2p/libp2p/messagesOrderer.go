@@ -0,0 +1,152 @@
+package libp2p
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// orderedMessageSeqLength is the length of the sequence number prefixing the messages of the ordered topics
+const orderedMessageSeqLength = 8
+
+// reorderTimeout is the maximum time a message waits for the messages broadcast before it by the same peer
+const reorderTimeout = 100 * time.Millisecond
+
+// maxWaitingMessagesPerSequence bounds the number of messages waiting for their turn for each (peer, topic) pair
+const maxWaitingMessagesPerSequence = 16
+
+// maxTrackedSequences bounds the number of (peer, topic) pairs tracked by the messages orderer
+const maxTrackedSequences = 10000
+
+type sequenceKey struct {
+	originator string
+	topic      string
+}
+
+type sequenceState struct {
+	lastSeq      uint64
+	numWaiting   int
+	chAdvanced   chan struct{}
+	hasDelivered bool
+}
+
+// messagesOrderer delivers the messages of the ordered topics, for each originating peer, in the order of their
+// sequence numbers. A message arriving before the previous one waits for it, at most reorderTimeout, in a small
+// reordering buffer. The first message received from a peer starts its sequence and the late messages, with sequence
+// numbers lower than the delivered ones, are not held back
+type messagesOrderer struct {
+	mut       sync.Mutex
+	sequences map[sequenceKey]*sequenceState
+	timeout   time.Duration
+}
+
+func newMessagesOrderer(timeout time.Duration) *messagesOrderer {
+	return &messagesOrderer{
+		sequences: make(map[sequenceKey]*sequenceState),
+		timeout:   timeout,
+	}
+}
+
+// waitTurn blocks until the message with the given sequence number is the next one expected from the originator on
+// the topic, until the reordering timeout elapses or returns immediately if the reordering buffer is full
+func (mo *messagesOrderer) waitTurn(originator string, topic string, seq uint64) {
+	key := sequenceKey{originator: originator, topic: topic}
+	timer := time.NewTimer(mo.timeout)
+	defer timer.Stop()
+
+	mo.mut.Lock()
+	for {
+		state := mo.sequenceState(key)
+		if !state.hasDelivered || seq <= state.lastSeq+1 || state.numWaiting >= maxWaitingMessagesPerSequence {
+			mo.mut.Unlock()
+			return
+		}
+
+		state.numWaiting++
+		chAdvanced := state.chAdvanced
+		mo.mut.Unlock()
+
+		timedOut := false
+		select {
+		case <-chAdvanced:
+		case <-timer.C:
+			timedOut = true
+		}
+
+		mo.mut.Lock()
+		state.numWaiting--
+		if timedOut {
+			mo.mut.Unlock()
+			return
+		}
+	}
+}
+
+// delivered records the delivery of the message with the given sequence number, releasing the message which waits
+// for it
+func (mo *messagesOrderer) delivered(originator string, topic string, seq uint64) {
+	mo.mut.Lock()
+	defer mo.mut.Unlock()
+
+	state := mo.sequenceState(sequenceKey{originator: originator, topic: topic})
+	if state.hasDelivered && seq <= state.lastSeq {
+		return
+	}
+
+	state.lastSeq = seq
+	state.hasDelivered = true
+	close(state.chAdvanced)
+	state.chAdvanced = make(chan struct{})
+}
+
+func (mo *messagesOrderer) sequenceState(key sequenceKey) *sequenceState {
+	state, ok := mo.sequences[key]
+	if ok {
+		return state
+	}
+
+	if len(mo.sequences) >= maxTrackedSequences {
+		log.Debug("messages orderer: too many tracked sequences, pruning the idle ones", "num sequences", len(mo.sequences))
+		for existingKey, existingState := range mo.sequences {
+			if existingState.numWaiting == 0 {
+				delete(mo.sequences, existingKey)
+			}
+		}
+	}
+
+	state = &sequenceState{chAdvanced: make(chan struct{})}
+	mo.sequences[key] = state
+
+	return state
+}
+
+// topicSequencer numbers the messages broadcast by this peer on an ordered topic
+type topicSequencer struct {
+	mut     sync.Mutex
+	nextSeq uint64
+}
+
+// sequence prefixes the buffer with the next sequence number of the topic
+func (ts *topicSequencer) sequence(buff []byte) []byte {
+	ts.mut.Lock()
+	ts.nextSeq++
+	seq := ts.nextSeq
+	ts.mut.Unlock()
+
+	sequenced := make([]byte, orderedMessageSeqLength+len(buff))
+	binary.BigEndian.PutUint64(sequenced, seq)
+	copy(sequenced[orderedMessageSeqLength:], buff)
+
+	return sequenced
+}
+
+// splitOrderedMessage returns the sequence number and the payload of a message received on an ordered topic
+func splitOrderedMessage(buff []byte) (uint64, []byte, error) {
+	if len(buff) < orderedMessageSeqLength {
+		return 0, nil, p2p.ErrInvalidOrderedMessage
+	}
+
+	return binary.BigEndian.Uint64(buff[:orderedMessageSeqLength]), buff[orderedMessageSeqLength:], nil
+}
@@ -0,0 +1,176 @@
+package libp2p_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+//------- sequence numbers
+
+func TestSplitOrderedMessage_ShouldReturnTheSequenceAndThePayload(t *testing.T) {
+	t.Parallel()
+
+	seq, payload, err := libp2p.SplitOrderedMessage(libp2p.SequenceMessage([]byte("payload"), 7))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), seq)
+	assert.Equal(t, []byte("payload"), payload)
+}
+
+func TestSplitOrderedMessage_TooShortMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := libp2p.SplitOrderedMessage([]byte("short"))
+
+	assert.Equal(t, p2p.ErrInvalidOrderedMessage, err)
+}
+
+//------- messagesOrderer
+
+func TestMessagesOrderer_FirstMessageShouldNotWait(t *testing.T) {
+	t.Parallel()
+
+	mo := libp2p.NewMessagesOrderer(time.Hour)
+
+	chDone := make(chan bool)
+	go func() {
+		mo.WaitTurn("peer", "topic", 5)
+		chDone <- true
+	}()
+
+	waitDoneWithTimeout(t, chDone, time.Second)
+}
+
+func TestMessagesOrderer_EarlyMessageShouldWaitForThePreviousOne(t *testing.T) {
+	t.Parallel()
+
+	mo := libp2p.NewMessagesOrderer(time.Hour)
+	mo.Delivered("peer", "topic", 1)
+
+	mutOrder := sync.Mutex{}
+	order := make([]uint64, 0)
+	deliver := func(seq uint64) {
+		mo.WaitTurn("peer", "topic", seq)
+		mutOrder.Lock()
+		order = append(order, seq)
+		mutOrder.Unlock()
+		mo.Delivered("peer", "topic", seq)
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		deliver(3)
+		wg.Done()
+	}()
+	time.Sleep(time.Millisecond * 50)
+	go func() {
+		deliver(2)
+		wg.Done()
+	}()
+	wg.Wait()
+
+	assert.Equal(t, []uint64{2, 3}, order)
+}
+
+func TestMessagesOrderer_MissingMessageShouldReleaseAfterTimeout(t *testing.T) {
+	t.Parallel()
+
+	mo := libp2p.NewMessagesOrderer(time.Millisecond * 50)
+	mo.Delivered("peer", "topic", 1)
+
+	start := time.Now()
+	mo.WaitTurn("peer", "topic", 3)
+
+	assert.True(t, time.Since(start) >= time.Millisecond*50)
+}
+
+func TestMessagesOrderer_LateMessageShouldNotWait(t *testing.T) {
+	t.Parallel()
+
+	mo := libp2p.NewMessagesOrderer(time.Hour)
+	mo.Delivered("peer", "topic", 5)
+
+	chDone := make(chan bool)
+	go func() {
+		mo.WaitTurn("peer", "topic", 3)
+		chDone <- true
+	}()
+
+	waitDoneWithTimeout(t, chDone, time.Second)
+}
+
+func TestMessagesOrderer_SequencesShouldBeIndependentPerPeerAndTopic(t *testing.T) {
+	t.Parallel()
+
+	mo := libp2p.NewMessagesOrderer(time.Hour)
+	mo.Delivered("peer", "topic", 1)
+	mo.Delivered("other peer", "topic", 10)
+	mo.Delivered("peer", "other topic", 20)
+
+	chDone := make(chan bool)
+	go func() {
+		mo.WaitTurn("other peer", "topic", 11)
+		mo.WaitTurn("peer", "other topic", 21)
+		chDone <- true
+	}()
+
+	waitDoneWithTimeout(t, chDone, time.Second)
+}
+
+//------- ordered topics
+
+func TestLibp2pMessenger_OrderedTopicShouldDeliverThePayloadsInOrder(t *testing.T) {
+	netw := mocknet.New(context.Background())
+	mes1, _ := libp2p.NewMemoryMessenger(context.Background(), netw, discovery.NewNullDiscoverer())
+	mes2, _ := libp2p.NewMemoryMessenger(context.Background(), netw, discovery.NewNullDiscoverer())
+	_ = netw.LinkAll()
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	_ = mes1.CreateOrderedTopic("ordered", true)
+	_ = mes2.CreateOrderedTopic("ordered", true)
+
+	numMessages := 20
+	mutReceived := sync.Mutex{}
+	received := make([]byte, 0)
+	chDone := make(chan bool)
+	_ = mes2.RegisterMessageProcessor("ordered", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+			mutReceived.Lock()
+			received = append(received, message.Data()...)
+			if len(received) == numMessages {
+				chDone <- true
+			}
+			mutReceived.Unlock()
+
+			return nil
+		},
+	})
+
+	//wait for the pubsub mesh to form
+	time.Sleep(time.Second)
+
+	//the ordering starts with the first message received from a peer
+	_ = mes1.BroadcastOnChannelBlocking("ordered", "ordered", []byte{0})
+	time.Sleep(time.Millisecond * 200)
+	for i := 1; i < numMessages; i++ {
+		_ = mes1.BroadcastOnChannelBlocking("ordered", "ordered", []byte{byte(i)})
+	}
+
+	waitDoneWithTimeout(t, chDone, timeoutWaitResponses)
+	for i := 0; i < numMessages; i++ {
+		assert.Equal(t, byte(i), received[i])
+	}
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
@@ -41,7 +41,7 @@ const broadcastGoRoutines = 1000
 var messageHeader = 64 * 1024 //64kB
 var maxSendBuffSize = (1 << 20) - messageHeader
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("p2p/libp2p")
 
 type networkMessenger struct {
 	ctxProvider         *Libp2pContext
@@ -51,13 +51,20 @@ type networkMessenger struct {
 	peerDiscoverer      p2p.PeerDiscoverer
 	mutTopics           sync.RWMutex
 	topics              map[string]p2p.MessageProcessor
+	orderedTopics       map[string]*topicSequencer
+	orderer             *messagesOrderer
 	outgoingPLB         p2p.ChannelLoadBalancer
 	poc                 *peersOnChannel
 	goRoutinesThrottler *throttler.NumGoRoutineThrottler
+	mutHonestyHandler   sync.RWMutex
+	honestyHandler      p2p.PeerHonestyHandler
+	mutShardResolver    sync.RWMutex
+	shardResolver       p2p.PeerShardResolver
 }
 
 // NewNetworkMessenger creates a libP2P messenger by opening a port on the current machine
 // Should be used in production!
+// A nil protector joins the public network, otherwise only the peers sharing the private network key are accepted
 func NewNetworkMessenger(
 	ctx context.Context,
 	port int,
@@ -66,6 +73,7 @@ func NewNetworkMessenger(
 	outgoingPLB p2p.ChannelLoadBalancer,
 	peerDiscoverer p2p.PeerDiscoverer,
 	listenAddress string,
+	protector p2p.PrivateNetworkProtector,
 ) (*networkMessenger, error) {
 
 	if ctx == nil {
@@ -96,6 +104,9 @@ func NewNetworkMessenger(
 		libp2p.DisableRelay(),
 		libp2p.NATPortMap(),
 	}
+	if protector != nil && !protector.IsInterfaceNil() {
+		opts = append(opts, libp2p.PrivateNetwork(protector))
+	}
 
 	h, err := libp2p.New(ctx, opts...)
 	if err != nil {
@@ -148,10 +159,12 @@ func createMessenger(
 		ctxProvider:    lctx,
 		pb:             pb,
 		topics:         make(map[string]p2p.MessageProcessor),
+		orderedTopics:  make(map[string]*topicSequencer),
+		orderer:        newMessagesOrderer(reorderTimeout),
 		outgoingPLB:    outgoingPLB,
 		peerDiscoverer: peerDiscoverer,
-		connMonitor:    newLibp2pConnectionMonitor(reconnecter),
 	}
+	netMes.connMonitor = newLibp2pConnectionMonitor(reconnecter, lctx.connHost.ConnManager(), netMes.GetPeerInfo)
 	lctx.connHost.Network().Notify(netMes.connMonitor)
 
 	netMes.ds, err = NewDirectSender(lctx.Context(), lctx.Host(), netMes.directMessageHandler)
@@ -175,7 +188,7 @@ func createMessenger(
 				continue
 			}
 
-			_ = pb.Publish(sendableData.Topic, sendableData.Buff)
+			_ = pb.Publish(sendableData.Topic, netMes.sequenceIfOrdered(sendableData.Topic, sendableData.Buff))
 			time.Sleep(durationBetweenSends)
 		}
 	}(pb, netMes.outgoingPLB)
@@ -246,11 +259,16 @@ func (netMes *networkMessenger) ConnectToPeer(address string) error {
 }
 
 // TrimConnections will trigger a manual sweep onto current connection set reducing the
-// number of connections if needed
+// number of connections if needed. The peers are tagged again before, as they might have been mapped to validators
+// after they connected
 func (netMes *networkMessenger) TrimConnections() {
 	h := netMes.ctxProvider.Host()
 	ctx := netMes.ctxProvider.Context()
 
+	for _, pid := range h.Network().Peers() {
+		netMes.connMonitor.tagPeer(pid)
+	}
+
 	h.ConnManager().TrimOpenConns(ctx)
 }
 
@@ -363,6 +381,37 @@ func (netMes *networkMessenger) CreateTopic(name string, createChannelForTopic b
 	return err
 }
 
+// CreateOrderedTopic opens a new topic whose messages are delivered, for each originating peer, in the order they
+// were broadcast. The messages are numbered by their originator, so all the peers should create the topic as ordered
+func (netMes *networkMessenger) CreateOrderedTopic(name string, createChannelForTopic bool) error {
+	err := netMes.CreateTopic(name, createChannelForTopic)
+	if err != nil {
+		return err
+	}
+
+	netMes.mutTopics.Lock()
+	netMes.orderedTopics[name] = &topicSequencer{}
+	netMes.mutTopics.Unlock()
+
+	return nil
+}
+
+func (netMes *networkMessenger) topicSequencer(topic string) *topicSequencer {
+	netMes.mutTopics.RLock()
+	defer netMes.mutTopics.RUnlock()
+
+	return netMes.orderedTopics[topic]
+}
+
+func (netMes *networkMessenger) sequenceIfOrdered(topic string, buff []byte) []byte {
+	sequencer := netMes.topicSequencer(topic)
+	if sequencer == nil {
+		return buff
+	}
+
+	return sequencer.sequence(buff)
+}
+
 // HasTopic returns true if the topic has been created
 func (netMes *networkMessenger) HasTopic(name string) bool {
 	netMes.mutTopics.RLock()
@@ -444,10 +493,11 @@ func (netMes *networkMessenger) RegisterMessageProcessor(topic string, handler p
 	}
 
 	err := netMes.pb.RegisterTopicValidator(topic, func(ctx context.Context, pid peer.ID, message *pubsub.Message) bool {
-		err := handler.ProcessReceivedMessage(NewMessage(message), broadcastHandler)
+		err := netMes.processReceivedMessage(topic, NewMessage(message), handler, broadcastHandler)
 		if err != nil {
 			log.Debug(err.Error())
 		}
+		netMes.notifyMessageReceived(p2p.PeerID(pid), topic, err)
 
 		return err == nil
 	})
@@ -459,6 +509,31 @@ func (netMes *networkMessenger) RegisterMessageProcessor(topic string, handler p
 	return nil
 }
 
+// processReceivedMessage calls the message processor of the topic. The messages of the ordered topics wait for the
+// messages broadcast before them by the same peer and are processed without their sequence number
+func (netMes *networkMessenger) processReceivedMessage(
+	topic string,
+	message *Message,
+	handler p2p.MessageProcessor,
+	broadcastHandler func(buffToSend []byte),
+) error {
+	if netMes.topicSequencer(topic) == nil {
+		return handler.ProcessReceivedMessage(message, broadcastHandler)
+	}
+
+	seq, payload, err := splitOrderedMessage(message.data)
+	if err != nil {
+		return err
+	}
+	message.data = payload
+
+	originator := string(message.from)
+	netMes.orderer.waitTurn(originator, topic, seq)
+	defer netMes.orderer.delivered(originator, topic, seq)
+
+	return handler.ProcessReceivedMessage(message, broadcastHandler)
+}
+
 // UnregisterMessageProcessor registers a message processes on a topic
 func (netMes *networkMessenger) UnregisterMessageProcessor(topic string) error {
 	netMes.mutTopics.Lock()
@@ -484,7 +559,20 @@ func (netMes *networkMessenger) UnregisterMessageProcessor(topic string) error {
 
 // SendToConnectedPeer sends a direct message to a connected peer
 func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error {
-	return netMes.ds.Send(topic, buff, peerID)
+	err := netMes.ds.Send(topic, buff, peerID)
+	if err != nil {
+		return err
+	}
+
+	netMes.mutHonestyHandler.RLock()
+	handler := netMes.honestyHandler
+	netMes.mutHonestyHandler.RUnlock()
+
+	if handler != nil {
+		handler.MessageSent(peerID, topic)
+	}
+
+	return nil
 }
 
 func (netMes *networkMessenger) directMessageHandler(message p2p.MessageP2P) error {
@@ -504,11 +592,80 @@ func (netMes *networkMessenger) directMessageHandler(message p2p.MessageP2P) err
 		if err != nil {
 			log.Debug(err.Error())
 		}
+		netMes.notifyMessageReceived(msg.Peer(), msg.TopicIDs()[0], err)
 	}(message)
 
 	return nil
 }
 
+func (netMes *networkMessenger) notifyMessageReceived(pid p2p.PeerID, topic string, err error) {
+	netMes.mutHonestyHandler.RLock()
+	handler := netMes.honestyHandler
+	netMes.mutHonestyHandler.RUnlock()
+
+	if handler != nil {
+		handler.MessageReceived(pid, topic, err)
+	}
+}
+
+// SetPeerHonestyHandler sets the peer honesty handler notified about every message exchanged with a peer
+func (netMes *networkMessenger) SetPeerHonestyHandler(handler p2p.PeerHonestyHandler) error {
+	if handler == nil || handler.IsInterfaceNil() {
+		return p2p.ErrNilPeerHonestyHandler
+	}
+
+	netMes.mutHonestyHandler.Lock()
+	netMes.honestyHandler = handler
+	netMes.mutHonestyHandler.Unlock()
+
+	return nil
+}
+
+// PeerScore returns the honesty score of the provided peer or 0 if no peer honesty handler was set
+func (netMes *networkMessenger) PeerScore(pid p2p.PeerID) float64 {
+	netMes.mutHonestyHandler.RLock()
+	handler := netMes.honestyHandler
+	netMes.mutHonestyHandler.RUnlock()
+
+	if handler == nil {
+		return 0
+	}
+
+	return handler.Score(pid)
+}
+
+// SetPeerShardResolver sets the component which maps the peers to validators and shards
+func (netMes *networkMessenger) SetPeerShardResolver(resolver p2p.PeerShardResolver) error {
+	if resolver == nil || resolver.IsInterfaceNil() {
+		return p2p.ErrNilPeerShardResolver
+	}
+
+	netMes.mutShardResolver.Lock()
+	netMes.shardResolver = resolver
+	netMes.mutShardResolver.Unlock()
+
+	return nil
+}
+
+// GetPeerInfo returns the validator and the shard the provided peer is mapped to or an unknown peer info if no peer
+// shard resolver was set
+func (netMes *networkMessenger) GetPeerInfo(pid p2p.PeerID) p2p.PeerInfo {
+	netMes.mutShardResolver.RLock()
+	resolver := netMes.shardResolver
+	netMes.mutShardResolver.RUnlock()
+
+	if resolver == nil {
+		return p2p.PeerInfo{PeerType: p2p.UnknownPeer}
+	}
+
+	return resolver.GetPeerInfo(pid)
+}
+
+// ClosePeer closes all the connections held with the provided peer
+func (netMes *networkMessenger) ClosePeer(pid p2p.PeerID) error {
+	return netMes.ctxProvider.Host().Network().ClosePeer(peer.ID(pid))
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (netMes *networkMessenger) IsInterfaceNil() bool {
 	if netMes == nil {
@@ -198,6 +198,7 @@ func TestNewNetworkMessenger_NilContextShouldErr(t *testing.T) {
 		&mock.ChannelLoadBalancerStub{},
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	assert.Nil(t, mes)
@@ -217,6 +218,7 @@ func TestNewNetworkMessenger_InvalidPortShouldErr(t *testing.T) {
 		&mock.ChannelLoadBalancerStub{},
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	assert.Nil(t, mes)
@@ -234,6 +236,7 @@ func TestNewNetworkMessenger_NilP2PprivateKeyShouldErr(t *testing.T) {
 		&mock.ChannelLoadBalancerStub{},
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	assert.Nil(t, mes)
@@ -253,6 +256,7 @@ func TestNewNetworkMessenger_NilPipeLoadBalancerShouldErr(t *testing.T) {
 		nil,
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	assert.Nil(t, mes)
@@ -277,6 +281,7 @@ func TestNewNetworkMessenger_NoConnMgrShouldWork(t *testing.T) {
 		},
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	assert.NotNil(t, mes)
@@ -314,6 +319,7 @@ func TestNewNetworkMessenger_WithConnMgrShouldWork(t *testing.T) {
 		},
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	assert.NotNil(t, mes)
@@ -346,6 +352,7 @@ func TestNewNetworkMessenger_WithNullPeerDiscoveryShouldWork(t *testing.T) {
 		},
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	assert.NotNil(t, mes)
@@ -372,6 +379,7 @@ func TestNewNetworkMessenger_NilPeerDiscoveryShouldErr(t *testing.T) {
 		},
 		nil,
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	assert.Nil(t, mes)
@@ -407,6 +415,7 @@ func TestNewNetworkMessenger_PeerDiscovererFailsWhenApplyingContextShouldErr(t *
 			},
 		},
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	assert.Nil(t, mes)
@@ -727,6 +736,7 @@ func TestLibp2pMessenger_BroadcastOnChannelBlockingShouldLimitNumberOfGoRoutines
 		},
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	wg := sync.WaitGroup{}
@@ -1245,6 +1255,7 @@ func TestLibp2pMessenger_TrimConnectionsCallsConnManagerTrimConnections(t *testi
 		},
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	mes.TrimConnections()
@@ -1277,6 +1288,7 @@ func TestLibp2pMessenger_SendDataThrottlerShouldReturnCorrectObject(t *testing.T
 		sdt,
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	sdtReturned := mes.OutgoingChannelLoadBalancer()
@@ -1400,6 +1412,7 @@ func TestLibp2pMessenger_SendDirectWithRealNetToConnectedPeerShouldWork(t *testi
 		loadBalancer.NewOutgoingChannelLoadBalancer(),
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	fmt.Println("Messenger 2:")
@@ -1411,6 +1424,7 @@ func TestLibp2pMessenger_SendDirectWithRealNetToConnectedPeerShouldWork(t *testi
 		loadBalancer.NewOutgoingChannelLoadBalancer(),
 		discovery.NewNullDiscoverer(),
 		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
 	)
 
 	err := mes1.ConnectToPeer(getConnectableAddress(mes2))
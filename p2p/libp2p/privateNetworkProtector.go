@@ -0,0 +1,154 @@
+package libp2p
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// PrivateNetworkKeyLength is the length of the pre-shared key of a private network
+const PrivateNetworkKeyLength = 32
+
+const privateNetworkHandshakeTimeout = 10 * time.Second
+
+// privateNetworkMagic is encrypted by each side of a connection, right after its initialization vector, so that the
+// other side can tell a key mismatch from a corrupted stream
+var privateNetworkMagic = []byte("/elrond/pnet/1.0")
+
+var privateNetworkFingerprintPrefix = []byte("elrond private network fingerprint")
+
+type privateNetworkProtector struct {
+	key                   []byte
+	numRejectedHandshakes uint64
+}
+
+// NewPrivateNetworkProtector creates a protector which encrypts all the connections of the host with the given
+// pre-shared key. The peers which do not use the same key are rejected during the connection handshake
+func NewPrivateNetworkProtector(key []byte) (*privateNetworkProtector, error) {
+	if len(key) != PrivateNetworkKeyLength {
+		return nil, p2p.ErrInvalidPrivateNetworkKeyLength
+	}
+
+	return &privateNetworkProtector{
+		key: append([]byte(nil), key...),
+	}, nil
+}
+
+// Protect exchanges the initialization vectors with the peer, checks that the peer uses the same key and wraps the
+// connection so that all the traffic is encrypted
+func (pnp *privateNetworkProtector) Protect(conn net.Conn) (net.Conn, error) {
+	block, err := aes.NewCipher(pnp.key)
+	if err != nil {
+		return nil, err
+	}
+
+	writeIv := make([]byte, aes.BlockSize)
+	_, err = rand.Read(writeIv)
+	if err != nil {
+		return nil, err
+	}
+	writeStream := cipher.NewCTR(block, writeIv)
+
+	handshake := make([]byte, aes.BlockSize+len(privateNetworkMagic))
+	copy(handshake, writeIv)
+	writeStream.XORKeyStream(handshake[aes.BlockSize:], privateNetworkMagic)
+
+	_ = conn.SetDeadline(time.Now().Add(privateNetworkHandshakeTimeout))
+	chWriteErr := make(chan error, 1)
+	go func() {
+		_, errWrite := conn.Write(handshake)
+		chWriteErr <- errWrite
+	}()
+
+	peerHandshake := make([]byte, len(handshake))
+	_, err = io.ReadFull(conn, peerHandshake)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	err = <-chWriteErr
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+
+	readStream := cipher.NewCTR(block, peerHandshake[:aes.BlockSize])
+	peerMagic := peerHandshake[aes.BlockSize:]
+	readStream.XORKeyStream(peerMagic, peerMagic)
+	if !bytes.Equal(peerMagic, privateNetworkMagic) {
+		numRejected := atomic.AddUint64(&pnp.numRejectedHandshakes, 1)
+		log.Debug("private network handshake rejected",
+			"remote address", conn.RemoteAddr().String(),
+			"num rejected", numRejected,
+		)
+		_ = conn.Close()
+		return nil, p2p.ErrPrivateNetworkKeyMismatch
+	}
+
+	return &privateNetworkConn{
+		Conn:        conn,
+		readStream:  readStream,
+		writeStream: writeStream,
+	}, nil
+}
+
+// Fingerprint returns a hash of the key which can be exposed without revealing the key
+func (pnp *privateNetworkProtector) Fingerprint() []byte {
+	fingerprint := sha256.Sum256(append(append([]byte(nil), privateNetworkFingerprintPrefix...), pnp.key...))
+	return fingerprint[:]
+}
+
+// NumRejectedHandshakes returns the number of connections rejected because the peer did not use the same key
+func (pnp *privateNetworkProtector) NumRejectedHandshakes() uint64 {
+	return atomic.LoadUint64(&pnp.numRejectedHandshakes)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pnp *privateNetworkProtector) IsInterfaceNil() bool {
+	if pnp == nil {
+		return true
+	}
+	return false
+}
+
+// privateNetworkConn encrypts the written data and decrypts the read data with the streams set up by the handshake
+type privateNetworkConn struct {
+	net.Conn
+
+	mutRead     sync.Mutex
+	readStream  cipher.Stream
+	mutWrite    sync.Mutex
+	writeStream cipher.Stream
+}
+
+// Read reads and decrypts data from the connection
+func (pnc *privateNetworkConn) Read(b []byte) (int, error) {
+	pnc.mutRead.Lock()
+	defer pnc.mutRead.Unlock()
+
+	n, err := pnc.Conn.Read(b)
+	pnc.readStream.XORKeyStream(b[:n], b[:n])
+
+	return n, err
+}
+
+// Write encrypts and writes data to the connection. The provided buffer is not altered
+func (pnc *privateNetworkConn) Write(b []byte) (int, error) {
+	pnc.mutWrite.Lock()
+	defer pnc.mutWrite.Unlock()
+
+	encrypted := make([]byte, len(b))
+	pnc.writeStream.XORKeyStream(encrypted, b)
+
+	return pnc.Conn.Write(encrypted)
+}
@@ -0,0 +1,173 @@
+package libp2p_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPrivateNetworkKey(fill byte) []byte {
+	return bytes.Repeat([]byte{fill}, libp2p.PrivateNetworkKeyLength)
+}
+
+// protectPipe runs the handshake on both ends of an in memory connection
+func protectPipe(protector1 p2p.PrivateNetworkProtector, protector2 p2p.PrivateNetworkProtector) (net.Conn, error, net.Conn, error) {
+	conn1, conn2 := net.Pipe()
+
+	chDone := make(chan struct{})
+	var protected2 net.Conn
+	var err2 error
+	go func() {
+		protected2, err2 = protector2.Protect(conn2)
+		close(chDone)
+	}()
+
+	protected1, err1 := protector1.Protect(conn1)
+	<-chDone
+
+	return protected1, err1, protected2, err2
+}
+
+//------- NewPrivateNetworkProtector
+
+func TestNewPrivateNetworkProtector_InvalidKeyLengthShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pnp, err := libp2p.NewPrivateNetworkProtector([]byte("short key"))
+
+	assert.Nil(t, pnp)
+	assert.Equal(t, p2p.ErrInvalidPrivateNetworkKeyLength, err)
+}
+
+func TestNewPrivateNetworkProtector_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pnp, err := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(1))
+
+	assert.Nil(t, err)
+	assert.False(t, pnp.IsInterfaceNil())
+	assert.Equal(t, uint64(0), pnp.NumRejectedHandshakes())
+}
+
+func TestPrivateNetworkProtector_FingerprintShouldNotRevealTheKey(t *testing.T) {
+	t.Parallel()
+
+	key := createPrivateNetworkKey(1)
+	pnp1, _ := libp2p.NewPrivateNetworkProtector(key)
+	pnp2, _ := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(1))
+	pnp3, _ := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(2))
+
+	assert.Equal(t, pnp1.Fingerprint(), pnp2.Fingerprint())
+	assert.NotEqual(t, pnp1.Fingerprint(), pnp3.Fingerprint())
+	assert.NotEqual(t, key, pnp1.Fingerprint())
+}
+
+//------- Protect
+
+func TestPrivateNetworkProtector_ProtectSameKeyShouldExchangeData(t *testing.T) {
+	t.Parallel()
+
+	pnp1, _ := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(1))
+	pnp2, _ := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(1))
+
+	conn1, err1, conn2, err2 := protectPipe(pnp1, pnp2)
+	assert.Nil(t, err1)
+	assert.Nil(t, err2)
+
+	sent := []byte("data sent in the private network")
+	go func() {
+		_, _ = conn1.Write(sent)
+	}()
+
+	received := make([]byte, len(sent))
+	_, err := io.ReadFull(conn2, received)
+	assert.Nil(t, err)
+	assert.Equal(t, sent, received)
+	assert.Equal(t, uint64(0), pnp1.NumRejectedHandshakes())
+
+	_ = conn1.Close()
+	_ = conn2.Close()
+}
+
+func TestPrivateNetworkProtector_ProtectKeyMismatchShouldErrAndCountTheRejection(t *testing.T) {
+	t.Parallel()
+
+	pnp1, _ := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(1))
+	pnp2, _ := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(2))
+
+	conn1, err1, conn2, err2 := protectPipe(pnp1, pnp2)
+
+	assert.Nil(t, conn1)
+	assert.Nil(t, conn2)
+	assert.Equal(t, p2p.ErrPrivateNetworkKeyMismatch, err1)
+	assert.Equal(t, p2p.ErrPrivateNetworkKeyMismatch, err2)
+	assert.Equal(t, uint64(1), pnp1.NumRejectedHandshakes())
+	assert.Equal(t, uint64(1), pnp2.NumRejectedHandshakes())
+}
+
+func TestPrivateNetworkProtector_ProtectClosedConnectionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pnp, _ := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(1))
+	conn1, conn2 := net.Pipe()
+	_ = conn2.Close()
+
+	protected, err := pnp.Protect(conn1)
+
+	assert.Nil(t, protected)
+	assert.NotNil(t, err)
+	assert.Equal(t, uint64(0), pnp.NumRejectedHandshakes())
+}
+
+//------- private network messengers
+
+func createPrivateNetworkMessenger(protector p2p.PrivateNetworkProtector) p2p.Messenger {
+	_, sk := createLibP2PCredentialsMessenger()
+	mes, _ := libp2p.NewNetworkMessenger(
+		context.Background(),
+		0,
+		sk,
+		nil,
+		loadBalancer.NewOutgoingChannelLoadBalancer(),
+		discovery.NewNullDiscoverer(),
+		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		protector,
+	)
+
+	return mes
+}
+
+func TestLibp2pMessenger_PrivateNetworkShouldAcceptOnlyThePeersWithTheSameKey(t *testing.T) {
+	//TODO remove skip when external library is concurrent safe
+	if testing.Short() {
+		t.Skip("this test fails with race detector on because of the github.com/koron/go-ssdp lib")
+	}
+
+	pnp1, _ := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(1))
+	pnp2, _ := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(1))
+	pnp3, _ := libp2p.NewPrivateNetworkProtector(createPrivateNetworkKey(2))
+	mes1 := createPrivateNetworkMessenger(pnp1)
+	mes2 := createPrivateNetworkMessenger(pnp2)
+	mes3 := createPrivateNetworkMessenger(pnp3)
+
+	err := mes2.ConnectToPeer(getConnectableAddress(mes1))
+	assert.Nil(t, err)
+	assert.True(t, mes1.IsConnected(mes2.ID()))
+
+	err = mes3.ConnectToPeer(getConnectableAddress(mes1))
+	assert.NotNil(t, err)
+	assert.False(t, mes1.IsConnected(mes3.ID()))
+	assert.True(t, pnp3.NumRejectedHandshakes() > 0)
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+	_ = mes3.Close()
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("p2p/memp2p")
 
 // Messenger is an implementation of the p2p.Messenger interface that
 // uses no real networking code, but instead connects to a network simulated in
@@ -29,6 +29,11 @@ type Messenger struct {
 	Address     string
 	Topics      map[string]p2p.MessageProcessor
 	TopicsMutex sync.RWMutex
+
+	mutHonestyHandler sync.RWMutex
+	honestyHandler    p2p.PeerHonestyHandler
+	mutShardResolver  sync.RWMutex
+	shardResolver     p2p.PeerShardResolver
 }
 
 // NewMessenger constructs a new Messenger that is connected to the
@@ -181,6 +186,12 @@ func (messenger *Messenger) CreateTopic(name string, createChannelForTopic bool)
 	return nil
 }
 
+// CreateOrderedTopic adds the topic provided as argument to the list of topics of this Messenger. The in memory
+// messenger, used in tests, does not order the messages, so the ordered topics are regular topics
+func (messenger *Messenger) CreateOrderedTopic(name string, createChannelForTopic bool) error {
+	return messenger.CreateTopic(name, createChannelForTopic)
+}
+
 // HasTopic returns true if this Messenger has declared interest in the given
 // topic; returns false otherwise.
 func (messenger *Messenger) HasTopic(name string) bool {
@@ -306,6 +317,62 @@ func (messenger *Messenger) parametricBroadcast(topic string, data []byte, async
 	return err
 }
 
+// SetPeerHonestyHandler sets the peer honesty handler used to rate the other messengers
+func (messenger *Messenger) SetPeerHonestyHandler(handler p2p.PeerHonestyHandler) error {
+	if handler == nil || handler.IsInterfaceNil() {
+		return p2p.ErrNilPeerHonestyHandler
+	}
+
+	messenger.mutHonestyHandler.Lock()
+	messenger.honestyHandler = handler
+	messenger.mutHonestyHandler.Unlock()
+
+	return nil
+}
+
+// PeerScore returns the honesty score of the provided peer or 0 if no peer honesty handler was set
+func (messenger *Messenger) PeerScore(pid p2p.PeerID) float64 {
+	messenger.mutHonestyHandler.RLock()
+	defer messenger.mutHonestyHandler.RUnlock()
+
+	if messenger.honestyHandler == nil {
+		return 0
+	}
+
+	return messenger.honestyHandler.Score(pid)
+}
+
+// SetPeerShardResolver sets the component which maps the other messengers to validators and shards
+func (messenger *Messenger) SetPeerShardResolver(resolver p2p.PeerShardResolver) error {
+	if resolver == nil || resolver.IsInterfaceNil() {
+		return p2p.ErrNilPeerShardResolver
+	}
+
+	messenger.mutShardResolver.Lock()
+	messenger.shardResolver = resolver
+	messenger.mutShardResolver.Unlock()
+
+	return nil
+}
+
+// GetPeerInfo returns the validator and the shard the provided peer is mapped to or an unknown peer info if no peer
+// shard resolver was set
+func (messenger *Messenger) GetPeerInfo(pid p2p.PeerID) p2p.PeerInfo {
+	messenger.mutShardResolver.RLock()
+	defer messenger.mutShardResolver.RUnlock()
+
+	if messenger.shardResolver == nil {
+		return p2p.PeerInfo{PeerType: p2p.UnknownPeer}
+	}
+
+	return messenger.shardResolver.GetPeerInfo(pid)
+}
+
+// ClosePeer does nothing as all the messengers are always connected to the in-memory network
+func (messenger *Messenger) ClosePeer(_ p2p.PeerID) error {
+	return nil
+}
+
 // SendToConnectedPeer sends a message directly to the peer specified by the ID.
 func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error {
 	if messenger.IsConnectedToNetwork() {
@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"io"
+	"net"
 
 	"github.com/mr-tron/base58/base58"
 )
@@ -115,6 +116,11 @@ type Messenger interface {
 	// will use a default channel).
 	CreateTopic(name string, createChannelForTopic bool) error
 
+	// CreateOrderedTopic defines a new topic, like CreateTopic, whose messages are
+	// processed, for each originating peer, in the order they were broadcast.
+	// A message received too early waits a short time for the previous ones.
+	CreateOrderedTopic(name string, createChannelForTopic bool) error
+
 	// HasTopic returns true if the Messenger has declared interest in a topic
 	// and it is listening to messages referencing it.
 	HasTopic(name string) bool
@@ -155,10 +161,73 @@ type Messenger interface {
 	// peer, but reuses a connection and a stream if possible.
 	SendToConnectedPeer(topic string, buff []byte, peerID PeerID) error
 
+	// SetPeerHonestyHandler sets the component that is notified about each
+	// message exchanged with a peer and which rates the peers' behaviour.
+	SetPeerHonestyHandler(handler PeerHonestyHandler) error
+
+	// PeerScore returns the current honesty score of the provided peer. If no
+	// peer honesty handler was set, all peers are rated with 0.
+	PeerScore(pid PeerID) float64
+
+	// SetPeerShardResolver sets the component which maps the peers to the
+	// validators behind them and to the shards of those validators.
+	SetPeerShardResolver(resolver PeerShardResolver) error
+
+	// GetPeerInfo returns the validator and the shard the provided peer is
+	// mapped to. If no peer shard resolver was set, all peers are unknown.
+	GetPeerInfo(pid PeerID) PeerInfo
+
+	// ClosePeer closes all the connections held with the provided peer.
+	ClosePeer(pid PeerID) error
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
 
+// PeerHonestyHandler defines the behaviour of a component able to rate peers based on the
+// messages exchanged with them
+type PeerHonestyHandler interface {
+	MessageReceived(pid PeerID, topic string, err error)
+	MessageSent(pid PeerID, topic string)
+	Score(pid PeerID) float64
+	IsInterfaceNil() bool
+}
+
+// PeerType represents the relation between a peer and the shard of the node
+type PeerType string
+
+const (
+	// UnknownPeer is a peer not mapped to any validator
+	UnknownPeer PeerType = "unknown"
+	// IntraShardPeer is a peer mapped to a validator of the node's shard
+	IntraShardPeer PeerType = "intraShard"
+	// CrossShardPeer is a peer mapped to a validator of another shard
+	CrossShardPeer PeerType = "crossShard"
+)
+
+// PeerInfo holds the validator public key a peer is mapped to and the shard of that validator
+type PeerInfo struct {
+	PeerType  PeerType
+	PublicKey []byte
+	ShardID   uint32
+}
+
+// PeerShardResolver defines the behaviour of a component able to map the peers to the validators behind them and to
+// the shards of those validators
+type PeerShardResolver interface {
+	GetPeerInfo(pid PeerID) PeerInfo
+	IsInterfaceNil() bool
+}
+
+// PrivateNetworkProtector defines the behaviour of a component which wraps the connections of a private network,
+// allowing only the peers which share the same network key to join the overlay
+type PrivateNetworkProtector interface {
+	Protect(conn net.Conn) (net.Conn, error)
+	Fingerprint() []byte
+	NumRejectedHandshakes() uint64
+	IsInterfaceNil() bool
+}
+
 // MessageP2P defines what a p2p message can do (should return)
 type MessageP2P interface {
 	From() []byte
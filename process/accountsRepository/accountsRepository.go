@@ -0,0 +1,167 @@
+package accountsRepository
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgAccountsRepository holds all dependencies required by the accounts repository in order to create a new instance
+type ArgAccountsRepository struct {
+	HistoricalAccounts state.HistoricalAccountsProvider
+	BlockChain         data.ChainHandler
+	ForkDetector       process.ForkDetector
+	Store              dataRetriever.StorageService
+	Marshalizer        marshal.Marshalizer
+	Uint64Converter    typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator   sharding.Coordinator
+}
+
+// accountsRepository answers the accounts queries on the state of a committed block instead of the in-memory state,
+// which also holds the changes of the block being processed. The queries can target either the last committed block
+// or the last final block, the latter being safe from reverts
+type accountsRepository struct {
+	historicalAccounts state.HistoricalAccountsProvider
+	blockChain         data.ChainHandler
+	forkDetector       process.ForkDetector
+	store              dataRetriever.StorageService
+	marshalizer        marshal.Marshalizer
+	uint64Converter    typeConverters.Uint64ByteSliceConverter
+	shardCoordinator   sharding.Coordinator
+}
+
+// NewAccountsRepository creates a new accounts repository
+func NewAccountsRepository(args ArgAccountsRepository) (*accountsRepository, error) {
+	if check.IfNil(args.HistoricalAccounts) {
+		return nil, process.ErrNilHistoricalAccountsProvider
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.ForkDetector) {
+		return nil, process.ErrNilForkDetector
+	}
+	if check.IfNil(args.Store) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	return &accountsRepository{
+		historicalAccounts: args.HistoricalAccounts,
+		blockChain:         args.BlockChain,
+		forkDetector:       args.ForkDetector,
+		store:              args.Store,
+		marshalizer:        args.Marshalizer,
+		uint64Converter:    args.Uint64Converter,
+		shardCoordinator:   args.ShardCoordinator,
+	}, nil
+}
+
+// GetAccountsAdapter returns the accounts state of the block selected by the query options, together with the
+// coordinates of that block
+func (ar *accountsRepository) GetAccountsAdapter(
+	options external.AccountQueryOptions,
+) (state.AccountsAdapter, *external.AccountBlockInfo, error) {
+	var blockInfo *external.AccountBlockInfo
+	var err error
+	if options.OnFinalBlock {
+		blockInfo, err = ar.getFinalBlockInfo()
+	} else {
+		blockInfo, err = ar.getCurrentBlockInfo()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accounts, err := ar.historicalAccounts.AccountsAtRootHash(blockInfo.RootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, blockInfo, nil
+}
+
+func (ar *accountsRepository) getCurrentBlockInfo() (*external.AccountBlockInfo, error) {
+	header := ar.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		return ar.getGenesisBlockInfo()
+	}
+
+	return &external.AccountBlockInfo{
+		Nonce:    header.GetNonce(),
+		Hash:     ar.blockChain.GetCurrentBlockHeaderHash(),
+		RootHash: header.GetRootHash(),
+	}, nil
+}
+
+func (ar *accountsRepository) getFinalBlockInfo() (*external.AccountBlockInfo, error) {
+	finalNonce := ar.forkDetector.GetHighestFinalBlockNonce()
+	if finalNonce == 0 {
+		return ar.getGenesisBlockInfo()
+	}
+
+	currentHeader := ar.blockChain.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) && currentHeader.GetNonce() == finalNonce {
+		return ar.getCurrentBlockInfo()
+	}
+
+	header, hash, err := ar.getHeaderFromStorageWithNonce(finalNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external.AccountBlockInfo{
+		Nonce:    header.GetNonce(),
+		Hash:     hash,
+		RootHash: header.GetRootHash(),
+	}, nil
+}
+
+func (ar *accountsRepository) getGenesisBlockInfo() (*external.AccountBlockInfo, error) {
+	header := ar.blockChain.GetGenesisHeader()
+	if check.IfNil(header) {
+		return nil, process.ErrNilBlockHeader
+	}
+
+	return &external.AccountBlockInfo{
+		Nonce:    header.GetNonce(),
+		Hash:     ar.blockChain.GetGenesisHeaderHash(),
+		RootHash: header.GetRootHash(),
+	}, nil
+}
+
+func (ar *accountsRepository) getHeaderFromStorageWithNonce(nonce uint64) (data.HeaderHandler, []byte, error) {
+	if ar.shardCoordinator.SelfId() == sharding.MetachainShardId {
+		return process.GetMetaHeaderFromStorageWithNonce(nonce, ar.store, ar.uint64Converter, ar.marshalizer)
+	}
+
+	return process.GetShardHeaderFromStorageWithNonce(
+		nonce,
+		ar.shardCoordinator.SelfId(),
+		ar.store,
+		ar.uint64Converter,
+		ar.marshalizer,
+	)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ar *accountsRepository) IsInterfaceNil() bool {
+	if ar == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,275 @@
+package accountsRepository_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/accountsRepository"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+var genesisHeader = &block.Header{Nonce: 0, RootHash: []byte("genesis root hash")}
+var currentHeader = &block.Header{Nonce: 10, RootHash: []byte("current root hash")}
+var finalHeader = &block.Header{Nonce: 8, RootHash: []byte("final root hash")}
+
+func createStoreWithHeader(marshalizer *mock.MarshalizerMock, hdr *block.Header, hdrHash []byte) dataRetriever.StorageService {
+	nonceConverter := mock.NewNonceHashConverterMock()
+	hdrBuff, _ := marshalizer.Marshal(hdr)
+	units := map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.ShardHdrNonceHashDataUnit: {
+			string(nonceConverter.ToByteSlice(hdr.Nonce)): hdrHash,
+		},
+		dataRetriever.BlockHeaderUnit: {
+			string(hdrHash): hdrBuff,
+		},
+	}
+
+	return &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					value, ok := units[unitType][string(key)]
+					if !ok {
+						return nil, errors.New("key not found")
+					}
+					return value, nil
+				},
+			}
+		},
+	}
+}
+
+func createMockArgAccountsRepository() accountsRepository.ArgAccountsRepository {
+	marshalizer := &mock.MarshalizerMock{}
+
+	return accountsRepository.ArgAccountsRepository{
+		HistoricalAccounts: &mock.HistoricalAccountsProviderStub{
+			AccountsAtRootHashCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+				return &mock.AccountsStub{}, nil
+			},
+		},
+		BlockChain: &mock.BlockChainMock{
+			GetGenesisHeaderCalled: func() data.HeaderHandler {
+				return genesisHeader
+			},
+			GetGenesisHeaderHashCalled: func() []byte {
+				return []byte("genesis hash")
+			},
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return currentHeader
+			},
+			GetCurrentBlockHeaderHashCalled: func() []byte {
+				return []byte("current hash")
+			},
+		},
+		ForkDetector: &mock.ForkDetectorMock{
+			GetHighestFinalBlockNonceCalled: func() uint64 {
+				return finalHeader.Nonce
+			},
+		},
+		Store:            createStoreWithHeader(marshalizer, finalHeader, []byte("final hash")),
+		Marshalizer:      marshalizer,
+		Uint64Converter:  mock.NewNonceHashConverterMock(),
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+	}
+}
+
+//------- NewAccountsRepository
+
+func TestNewAccountsRepository_NilHistoricalAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgAccountsRepository()
+	args.HistoricalAccounts = nil
+	ar, err := accountsRepository.NewAccountsRepository(args)
+
+	assert.Nil(t, ar)
+	assert.Equal(t, process.ErrNilHistoricalAccountsProvider, err)
+}
+
+func TestNewAccountsRepository_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgAccountsRepository()
+	args.BlockChain = nil
+	ar, err := accountsRepository.NewAccountsRepository(args)
+
+	assert.Nil(t, ar)
+	assert.Equal(t, process.ErrNilBlockChain, err)
+}
+
+func TestNewAccountsRepository_NilForkDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgAccountsRepository()
+	args.ForkDetector = nil
+	ar, err := accountsRepository.NewAccountsRepository(args)
+
+	assert.Nil(t, ar)
+	assert.Equal(t, process.ErrNilForkDetector, err)
+}
+
+func TestNewAccountsRepository_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgAccountsRepository()
+	args.Store = nil
+	ar, err := accountsRepository.NewAccountsRepository(args)
+
+	assert.Nil(t, ar)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewAccountsRepository_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ar, err := accountsRepository.NewAccountsRepository(createMockArgAccountsRepository())
+
+	assert.Nil(t, err)
+	assert.False(t, ar.IsInterfaceNil())
+}
+
+//------- GetAccountsAdapter
+
+func TestAccountsRepository_GetAccountsAdapterOnCurrentBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgAccountsRepository()
+	var recreatedRootHash []byte
+	args.HistoricalAccounts = &mock.HistoricalAccountsProviderStub{
+		AccountsAtRootHashCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+			recreatedRootHash = rootHash
+			return &mock.AccountsStub{}, nil
+		},
+	}
+	ar, _ := accountsRepository.NewAccountsRepository(args)
+
+	accounts, blockInfo, err := ar.GetAccountsAdapter(external.AccountQueryOptions{OnFinalBlock: false})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, accounts)
+	assert.Equal(t, currentHeader.RootHash, recreatedRootHash)
+	assert.Equal(t, &external.AccountBlockInfo{
+		Nonce:    currentHeader.Nonce,
+		Hash:     []byte("current hash"),
+		RootHash: currentHeader.RootHash,
+	}, blockInfo)
+}
+
+func TestAccountsRepository_GetAccountsAdapterOnFinalBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgAccountsRepository()
+	var recreatedRootHash []byte
+	args.HistoricalAccounts = &mock.HistoricalAccountsProviderStub{
+		AccountsAtRootHashCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+			recreatedRootHash = rootHash
+			return &mock.AccountsStub{}, nil
+		},
+	}
+	ar, _ := accountsRepository.NewAccountsRepository(args)
+
+	accounts, blockInfo, err := ar.GetAccountsAdapter(external.AccountQueryOptions{OnFinalBlock: true})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, accounts)
+	assert.Equal(t, finalHeader.RootHash, recreatedRootHash)
+	assert.Equal(t, &external.AccountBlockInfo{
+		Nonce:    finalHeader.Nonce,
+		Hash:     []byte("final hash"),
+		RootHash: finalHeader.RootHash,
+	}, blockInfo)
+}
+
+func TestAccountsRepository_GetAccountsAdapterOnFinalBlockWhenCurrentIsFinal(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgAccountsRepository()
+	args.ForkDetector = &mock.ForkDetectorMock{
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return currentHeader.Nonce
+		},
+	}
+	ar, _ := accountsRepository.NewAccountsRepository(args)
+
+	_, blockInfo, err := ar.GetAccountsAdapter(external.AccountQueryOptions{OnFinalBlock: true})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("current hash"), blockInfo.Hash)
+}
+
+func TestAccountsRepository_GetAccountsAdapterBeforeTheFirstBlockShouldUseGenesis(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgAccountsRepository()
+	args.BlockChain = &mock.BlockChainMock{
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return genesisHeader
+		},
+		GetGenesisHeaderHashCalled: func() []byte {
+			return []byte("genesis hash")
+		},
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+	}
+	args.ForkDetector = &mock.ForkDetectorMock{
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	ar, _ := accountsRepository.NewAccountsRepository(args)
+
+	for _, onFinalBlock := range []bool{false, true} {
+		_, blockInfo, err := ar.GetAccountsAdapter(external.AccountQueryOptions{OnFinalBlock: onFinalBlock})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("genesis hash"), blockInfo.Hash)
+		assert.Equal(t, genesisHeader.RootHash, blockInfo.RootHash)
+	}
+}
+
+func TestAccountsRepository_GetAccountsAdapterMissingFinalHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgAccountsRepository()
+	args.ForkDetector = &mock.ForkDetectorMock{
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 9
+		},
+	}
+	ar, _ := accountsRepository.NewAccountsRepository(args)
+
+	accounts, blockInfo, err := ar.GetAccountsAdapter(external.AccountQueryOptions{OnFinalBlock: true})
+
+	assert.Nil(t, accounts)
+	assert.Nil(t, blockInfo)
+	assert.NotNil(t, err)
+}
+
+func TestAccountsRepository_GetAccountsAdapterRecreateFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	args := createMockArgAccountsRepository()
+	args.HistoricalAccounts = &mock.HistoricalAccountsProviderStub{
+		AccountsAtRootHashCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+			return nil, errExpected
+		},
+	}
+	ar, _ := accountsRepository.NewAccountsRepository(args)
+
+	accounts, blockInfo, err := ar.GetAccountsAdapter(external.AccountQueryOptions{})
+
+	assert.Nil(t, accounts)
+	assert.Nil(t, blockInfo)
+	assert.Equal(t, errExpected, err)
+}
@@ -17,6 +17,8 @@ import (
 type ArgBaseProcessor struct {
 	Accounts              state.AccountsAdapter
 	ForkDetector          process.ForkDetector
+	BlockTracker          process.BlockTracker
+	HeadersNotifier       dataRetriever.HeadersNotifier
 	Hasher                hashing.Hasher
 	Marshalizer           marshal.Marshalizer
 	Store                 dataRetriever.StorageService
@@ -27,20 +29,31 @@ type ArgBaseProcessor struct {
 	StartHeaders          map[uint32]data.HeaderHandler
 	RequestHandler        process.RequestHandler
 	Core                  serviceContainer.Core
+	HistoryRepository     process.HistoryRepository
+	OutgoingOperations    process.OutgoingOperationsHandler
+	SelectionAuditor      process.ValidatorsSelectionAuditor
+	ChainID               []byte
+	SoftwareVersion       []byte
 }
 
 // ArgShardProcessor holds all dependencies required by the process data factory in order to create
 // new instances of shard processor
 type ArgShardProcessor struct {
 	ArgBaseProcessor
-	DataPool        dataRetriever.PoolsHolder
-	TxCoordinator   process.TransactionCoordinator
-	TxsPoolsCleaner process.PoolsCleaner
+	DataPool          dataRetriever.PoolsHolder
+	TxCoordinator     process.TransactionCoordinator
+	TxsPoolsCleaner   process.PoolsCleaner
+	BlockChainHook    process.BlockChainHookHandler
+	EpochNotifier     process.EpochNotifier
+	SupplyAccountant  process.SupplyAccountant
+	BlockFeesHandler  process.BlockFeesHandler
+	ESDTSupplyTracker process.ESDTSupplyTracker
 }
 
 // ArgMetaProcessor holds all dependencies required by the process data factory in order to create
 // new instances of meta processor
 type ArgMetaProcessor struct {
 	ArgBaseProcessor
-	DataPool dataRetriever.MetaPoolsHolder
+	DataPool          dataRetriever.MetaPoolsHolder
+	PendingMiniBlocks process.PendingMiniBlocksHandler
 }
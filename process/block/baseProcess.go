@@ -8,6 +8,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -21,7 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("process/block")
 
 type hashAndHdr struct {
 	hdr  data.HeaderHandler
@@ -55,11 +56,17 @@ type baseProcessor struct {
 	specialAddressHandler process.SpecialAddressHandler
 	accounts              state.AccountsAdapter
 	forkDetector          process.ForkDetector
+	blockTracker          process.BlockTracker
 	hasher                hashing.Hasher
 	marshalizer           marshal.Marshalizer
 	store                 dataRetriever.StorageService
 	uint64Converter       typeConverters.Uint64ByteSliceConverter
 	blockSizeThrottler    process.BlockSizeThrottler
+	historyRepository     process.HistoryRepository
+	outgoingOperations    process.OutgoingOperationsHandler
+	selectionAuditor      process.ValidatorsSelectionAuditor
+	chainID               []byte
+	softwareVersion       []byte
 
 	hdrsForCurrBlock hdrForBlock
 
@@ -201,6 +208,55 @@ func (bp *baseProcessor) verifyStateRoot(rootHash []byte) bool {
 	return bytes.Equal(trieRootHash, rootHash)
 }
 
+// commitState commits the accounts state and verifies that the committed root hash is the one announced in the
+// header. A mismatch means the state read from the local storage is inconsistent. The state is first recreated from
+// the header root hash, which repairs it when the inconsistency is only in the loaded trie. If the header state can not
+// be loaded either, the state of the previous block is recreated and the block is rejected, so that it is requested
+// again from the peers and re-executed on a consistent state instead of leaving the node on a diverged state
+func (bp *baseProcessor) commitState(chainHandler data.ChainHandler, headerHandler data.HeaderHandler) error {
+	committedRootHash, err := bp.accounts.Commit()
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(committedRootHash, headerHandler.GetRootHash()) {
+		return nil
+	}
+
+	log.Error("committed state does not match the block header",
+		"nonce", headerHandler.GetNonce(),
+		"header root hash", headerHandler.GetRootHash(),
+		"committed root hash", committedRootHash,
+	)
+	bp.appStatusHandler.Increment(core.MetricNumStateRepairs)
+
+	err = bp.accounts.RecreateTrie(headerHandler.GetRootHash())
+	if err == nil {
+		log.Info("state repaired from the block header root hash", "nonce", headerHandler.GetNonce())
+		return nil
+	}
+	log.Debug("recreate trie from the block header root hash", "error", err.Error())
+
+	prevRootHash := bp.getPrevRootHash(chainHandler)
+	err = bp.accounts.RecreateTrie(prevRootHash)
+	if err != nil {
+		log.Error("recreate trie from the previous block root hash", "error", err.Error())
+	}
+
+	return process.ErrRootStateDoesNotMatch
+}
+
+func (bp *baseProcessor) getPrevRootHash(chainHandler data.ChainHandler) []byte {
+	currentHeader := chainHandler.GetCurrentBlockHeader()
+	if currentHeader == nil || currentHeader.IsInterfaceNil() {
+		currentHeader = chainHandler.GetGenesisHeader()
+	}
+	if currentHeader == nil || currentHeader.IsInterfaceNil() {
+		return nil
+	}
+
+	return currentHeader.GetRootHash()
+}
+
 // getRootHash returns the accounts merkle tree root hash
 func (bp *baseProcessor) getRootHash() []byte {
 	rootHash, err := bp.accounts.RootHash()
@@ -299,6 +355,21 @@ func (bp *baseProcessor) removeNotarizedHdrsBehindPreviousFinal(hdrsToPreservedB
 	bp.mutNotarizedHdrs.Unlock()
 }
 
+// cleanupBlockTrackerHeaders removes from the block tracker the headers which are behind the last notarized ones
+// preserved by the block processor
+func (bp *baseProcessor) cleanupBlockTrackerHeaders(selfNotarizedNonce uint64) {
+	bp.mutNotarizedHdrs.RLock()
+	defer bp.mutNotarizedHdrs.RUnlock()
+
+	for shardId, notarizedHdrs := range bp.notarizedHdrs {
+		if len(notarizedHdrs) == 0 {
+			continue
+		}
+
+		bp.blockTracker.CleanupHeadersBehindNonce(shardId, selfNotarizedNonce, notarizedHdrs[0].GetNonce())
+	}
+}
+
 func (bp *baseProcessor) removeLastNotarized() {
 	bp.mutNotarizedHdrs.Lock()
 	for shardId := range bp.notarizedHdrs {
@@ -485,6 +556,19 @@ func (bp *baseProcessor) requestHeadersIfMissing(
 	return nil
 }
 
+// publishTrieStatistics publishes the statistics of the trie operations executed since the previous committed block
+func publishTrieStatistics(coreServiceContainer serviceContainer.Core, headerHandler data.HeaderHandler) {
+	if coreServiceContainer == nil || coreServiceContainer.IsInterfaceNil() {
+		return
+	}
+	trieStatistics := coreServiceContainer.TrieStatistics()
+	if trieStatistics == nil || trieStatistics.IsInterfaceNil() {
+		return
+	}
+
+	trieStatistics.BlockCommitted(headerHandler)
+}
+
 func displayHeader(headerHandler data.HeaderHandler) []*display.LineData {
 	lines := make([]*display.LineData, 0)
 
@@ -540,6 +624,12 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if arguments.ForkDetector == nil || arguments.ForkDetector.IsInterfaceNil() {
 		return process.ErrNilForkDetector
 	}
+	if arguments.BlockTracker == nil || arguments.BlockTracker.IsInterfaceNil() {
+		return process.ErrNilBlockTracker
+	}
+	if arguments.HeadersNotifier == nil || arguments.HeadersNotifier.IsInterfaceNil() {
+		return process.ErrNilHeadersNotifier
+	}
 	if arguments.Hasher == nil || arguments.Hasher.IsInterfaceNil() {
 		return process.ErrNilHasher
 	}
@@ -564,10 +654,89 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if arguments.RequestHandler == nil || arguments.RequestHandler.IsInterfaceNil() {
 		return process.ErrNilRequestHandler
 	}
+	if arguments.HistoryRepository == nil || arguments.HistoryRepository.IsInterfaceNil() {
+		return process.ErrNilHistoryRepository
+	}
+	if arguments.OutgoingOperations == nil || arguments.OutgoingOperations.IsInterfaceNil() {
+		return process.ErrNilOutgoingOperationsHandler
+	}
+	if arguments.SelectionAuditor == nil || arguments.SelectionAuditor.IsInterfaceNil() {
+		return process.ErrNilValidatorsSelectionAuditor
+	}
+	if len(arguments.ChainID) == 0 {
+		return process.ErrInvalidChainID
+	}
+	if len(arguments.SoftwareVersion) == 0 {
+		return process.ErrInvalidSoftwareVersion
+	}
 
 	return nil
 }
 
+// recordBlockInHistory writes the secondary indexes of the committed block, if the db lookup extensions are enabled.
+// A failure only affects the lookups, so it is not critical for the commit
+func (bp *baseProcessor) recordBlockInHistory(
+	headerHash []byte,
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+) {
+	if !bp.historyRepository.IsEnabled() {
+		return
+	}
+
+	errNotCritical := bp.historyRepository.RecordBlock(headerHash, headerHandler, bodyHandler)
+	if errNotCritical != nil {
+		log.Debug(fmt.Sprintf("could not record block in history: %s", errNotCritical.Error()))
+	}
+}
+
+// collectOutgoingOperations hands the committed block to the outgoing operations handler, if the outgoing operations
+// are enabled
+func (bp *baseProcessor) collectOutgoingOperations(
+	headerHash []byte,
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+) {
+	if !bp.outgoingOperations.IsEnabled() {
+		return
+	}
+
+	bp.outgoingOperations.ProcessCommittedBlock(headerHash, headerHandler, bodyHandler)
+}
+
+// auditValidatorsSelection hands the committed header to the validators selection auditor, if the audit is enabled
+func (bp *baseProcessor) auditValidatorsSelection(headerHandler data.HeaderHandler) {
+	if !bp.selectionAuditor.IsEnabled() {
+		return
+	}
+
+	bp.selectionAuditor.AuditCommittedBlock(headerHandler)
+}
+
+// setLogCorrelation makes all the following logged lines carry the coordinates of the provided header, if the
+// log correlation is enabled. The header hash is computed only in this case
+func (bp *baseProcessor) setLogCorrelation(headerHandler data.HeaderHandler, headerHash []byte) {
+	if !logger.IsCorrelationEnabled() {
+		return
+	}
+
+	if headerHash == nil {
+		var err error
+		headerHash, err = core.CalculateHash(bp.marshalizer, bp.hasher, headerHandler)
+		if err != nil {
+			log.Debug("could not compute the header hash for log correlation: " + err.Error())
+		}
+	}
+
+	logger.SetCorrelationHeader(
+		headerHandler.GetShardID(),
+		headerHandler.GetEpoch(),
+		headerHandler.GetRound(),
+		headerHandler.GetNonce(),
+		headerHash,
+	)
+}
+
 func (bp *baseProcessor) createBlockStarted() {
 	bp.resetMissingHdrs()
 	bp.hdrsForCurrBlock.mutHdrsForBlock.Lock()
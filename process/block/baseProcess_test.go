@@ -355,6 +355,8 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 		ArgBaseProcessor: blproc.ArgBaseProcessor{
 			Accounts:              &mock.AccountsStub{},
 			ForkDetector:          &mock.ForkDetectorMock{},
+			BlockTracker:          &mock.BlockTrackerStub{},
+			HeadersNotifier:       &mock.HeadersNotifierStub{},
 			Hasher:                &mock.HasherStub{},
 			Marshalizer:           &mock.MarshalizerMock{},
 			Store:                 initStore(),
@@ -365,10 +367,20 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 			StartHeaders:          createGenesisBlocks(mock.NewOneShardCoordinatorMock()),
 			RequestHandler:        &mock.RequestHandlerMock{},
 			Core:                  &mock.ServiceContainerMock{},
+			HistoryRepository:     &mock.HistoryRepositoryStub{},
+			OutgoingOperations:    &mock.OutgoingOperationsHandlerStub{},
+			SelectionAuditor:      &mock.ValidatorsSelectionAuditorStub{},
+			ChainID:               []byte("chain ID"),
+			SoftwareVersion:       []byte("1"),
 		},
-		DataPool:        initDataPool([]byte("")),
-		TxCoordinator:   &mock.TransactionCoordinatorMock{},
-		TxsPoolsCleaner: &mock.TxPoolsCleanerMock{},
+		DataPool:          initDataPool([]byte("")),
+		TxCoordinator:     &mock.TransactionCoordinatorMock{},
+		TxsPoolsCleaner:   &mock.TxPoolsCleanerMock{},
+		BlockChainHook:    &mock.BlockChainHookHandlerMock{},
+		EpochNotifier:     &mock.EpochNotifierStub{},
+		SupplyAccountant:  &mock.SupplyAccountantStub{},
+		BlockFeesHandler:  &mock.BlockFeesHandlerStub{},
+		ESDTSupplyTracker: &mock.ESDTSupplyTrackerStub{},
 	}
 
 	return arguments
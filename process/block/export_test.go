@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -36,8 +37,8 @@ func DisplayHeader(headerHandler data.HeaderHandler) []*display.LineData {
 	return displayHeader(headerHandler)
 }
 
-func (sp *shardProcessor) ReceivedMetaBlock(metaBlockHash []byte) {
-	sp.receivedMetaBlock(metaBlockHash)
+func (sp *shardProcessor) ReceivedMetaBlock(header data.HeaderHandler, metaBlockHash []byte) {
+	sp.receivedMetaBlock(header, metaBlockHash)
 }
 
 func (sp *shardProcessor) CreateMiniBlocks(maxItemsInBlock uint32, round uint64, haveTime func() bool) (block.Body, error) {
@@ -64,6 +65,8 @@ func NewShardProcessorEmptyWith3shards(tdp dataRetriever.PoolsHolder, genesisBlo
 		ArgBaseProcessor: ArgBaseProcessor{
 			Accounts:              &mock.AccountsStub{},
 			ForkDetector:          &mock.ForkDetectorMock{},
+			BlockTracker:          &mock.BlockTrackerStub{},
+			HeadersNotifier:       &mock.HeadersNotifierStub{},
 			Hasher:                &mock.HasherMock{},
 			Marshalizer:           &mock.MarshalizerMock{},
 			Store:                 &mock.ChainStorerMock{},
@@ -74,10 +77,20 @@ func NewShardProcessorEmptyWith3shards(tdp dataRetriever.PoolsHolder, genesisBlo
 			StartHeaders:          genesisBlocks,
 			RequestHandler:        &mock.RequestHandlerMock{},
 			Core:                  &mock.ServiceContainerMock{},
+			HistoryRepository:     &mock.HistoryRepositoryStub{},
+			OutgoingOperations:    &mock.OutgoingOperationsHandlerStub{},
+			SelectionAuditor:      &mock.ValidatorsSelectionAuditorStub{},
+			ChainID:               []byte("chain ID"),
+			SoftwareVersion:       []byte("1"),
 		},
-		DataPool:        tdp,
-		TxCoordinator:   &mock.TransactionCoordinatorMock{},
-		TxsPoolsCleaner: &mock.TxPoolsCleanerMock{},
+		DataPool:          tdp,
+		TxCoordinator:     &mock.TransactionCoordinatorMock{},
+		TxsPoolsCleaner:   &mock.TxPoolsCleanerMock{},
+		BlockChainHook:    &mock.BlockChainHookHandlerMock{},
+		EpochNotifier:     &mock.EpochNotifierStub{},
+		SupplyAccountant:  &mock.SupplyAccountantStub{},
+		BlockFeesHandler:  &mock.BlockFeesHandlerStub{},
+		ESDTSupplyTracker: &mock.ESDTSupplyTrackerStub{},
 	}
 	shardProcessor, err := NewShardProcessor(arguments)
 	return shardProcessor, err
@@ -91,8 +104,8 @@ func (mp *metaProcessor) RemoveBlockInfoFromPool(header *block.MetaBlock) error
 	return mp.removeBlockInfoFromPool(header)
 }
 
-func (mp *metaProcessor) ReceivedShardHeader(shardHeaderHash []byte) {
-	mp.receivedShardHeader(shardHeaderHash)
+func (mp *metaProcessor) ReceivedShardHeader(header data.HeaderHandler, shardHeaderHash []byte) {
+	mp.receivedShardHeader(header, shardHeaderHash)
 }
 
 func (mp *metaProcessor) AddHdrHashToRequestedList(hdr *block.Header, hdrHash []byte) {
@@ -246,6 +259,10 @@ func (sp *shardProcessor) GetOrderedMetaBlocks(round uint64) ([]*hashAndHdr, err
 	return sp.getOrderedMetaBlocks(round)
 }
 
+func (hh *hashAndHdr) GetHdr() data.HeaderHandler {
+	return hh.hdr
+}
+
 func (sp *shardProcessor) CreateAndProcessCrossMiniBlocksDstMe(
 	maxItemsInBlock uint32,
 	round uint64,
@@ -287,13 +304,25 @@ func (sp *shardProcessor) GetAllMiniBlockDstMeFromMeta(
 }
 
 func (sp *shardProcessor) IsMiniBlockProcessed(metaBlockHash []byte, miniBlockHash []byte) bool {
-	return sp.isMiniBlockProcessed(metaBlockHash, miniBlockHash)
+	return sp.processedMiniBlocks.IsMiniBlockFullyProcessed(metaBlockHash, miniBlockHash)
 }
 
 func (sp *shardProcessor) AddProcessedMiniBlock(metaBlockHash []byte, miniBlockHash []byte) {
 	sp.addProcessedMiniBlock(metaBlockHash, miniBlockHash)
 }
 
+func (sp *shardProcessor) SetProcessedMiniBlockInfo(metaBlockHash []byte, miniBlockHash []byte, processedMbInfo *processedMb.ProcessedMiniBlockInfo) {
+	sp.processedMiniBlocks.SetProcessedMiniBlockInfo(metaBlockHash, miniBlockHash, processedMbInfo)
+}
+
+func (sp *shardProcessor) VerifyPartialMiniBlocks(body block.Body) error {
+	return sp.verifyPartialMiniBlocks(body)
+}
+
+func (sp *shardProcessor) VerifyFees(header *block.Header) error {
+	return sp.verifyFees(header)
+}
+
 func (bp *baseProcessor) SetHdrForCurrentBlock(headerHash []byte, headerHandler data.HeaderHandler, usedInBlock bool) {
 	bp.hdrsForCurrBlock.mutHdrsForBlock.Lock()
 	bp.hdrsForCurrBlock.hdrHashAndInfo[string(headerHash)] = &hdrInfo{hdr: headerHandler, usedInBlock: usedInBlock}
@@ -317,3 +346,7 @@ func (sp *shardProcessor) CreateBlockStarted() {
 func (sp *shardProcessor) AddProcessedCrossMiniBlocksFromHeader(header *block.Header) error {
 	return sp.addProcessedCrossMiniBlocksFromHeader(header)
 }
+
+func (mp *metaProcessor) CheckOverdueCrossShardMiniBlocks() error {
+	return mp.checkOverdueCrossShardMiniBlocks()
+}
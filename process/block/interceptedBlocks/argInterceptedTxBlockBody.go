@@ -3,6 +3,7 @@ package interceptedBlocks
 import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -12,4 +13,5 @@ type ArgInterceptedTxBlockBody struct {
 	Marshalizer      marshal.Marshalizer
 	Hasher           hashing.Hasher
 	ShardCoordinator sharding.Coordinator
+	LimitsChecker    process.InterceptedDataLimitsChecker
 }
@@ -1,69 +1,22 @@
 package interceptedBlocks
 
 import (
-	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
-	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
-	"github.com/ElrondNetwork/elrond-go/hashing"
-	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
-// headerMultiSigVerifier is an "abstract" struct that is able to verify the signature of a header handler
-type headerMultiSigVerifier struct {
-	marshalizer          marshal.Marshalizer
-	hasher               hashing.Hasher
-	nodesCoordinator     sharding.NodesCoordinator
-	multiSigVerifier     crypto.MultiSigVerifier
-	copyHeaderWithoutSig func(src data.HeaderHandler) data.HeaderHandler
-}
-
-func (hmsv *headerMultiSigVerifier) verifySig(header data.HeaderHandler) error {
-
-	randSeed := header.GetPrevRandSeed()
-	bitmap := header.GetPubKeysBitmap()
-
-	//TODO: check randSeed = Sig_proposer(prevRandSeed)
-
-	if len(bitmap) == 0 {
-		return process.ErrNilPubKeysBitmap
-	}
-	if bitmap[0]&1 == 0 {
-		return process.ErrBlockProposerSignatureMissing
-	}
-
-	consensusPubKeys, err := hmsv.nodesCoordinator.GetValidatorsPublicKeys(
-		randSeed,
-		header.GetRound(),
-		header.GetShardID(),
-	)
-	if err != nil {
-		return err
-	}
-
-	verifier, err := hmsv.multiSigVerifier.Create(consensusPubKeys, 0)
-	if err != nil {
-		return err
-	}
-
-	err = verifier.SetAggregatedSig(header.GetSignature())
-	if err != nil {
-		return err
-	}
-
-	// get marshalled block header without signature and bitmap
-	// as this is the message that was signed
-	headerCopy := hmsv.copyHeaderWithoutSig(header)
-
-	hash, err := core.CalculateHash(hmsv.marshalizer, hmsv.hasher, headerCopy)
+// verifyHeaderSignatures checks that the header's random seed was produced by the leader and that its aggregated
+// signature belongs to the consensus group
+func verifyHeaderSignatures(header data.HeaderHandler, headerSigVerifier process.InterceptedHeaderSigVerifier) error {
+	err := headerSigVerifier.VerifyRandSeed(header)
 	if err != nil {
 		return err
 	}
 
-	return verifier.Verify(hash, bitmap)
+	return headerSigVerifier.VerifySignature(header)
 }
 
 func checkBlockHeaderArgument(arg *ArgInterceptedBlockHeader) error {
@@ -79,15 +32,18 @@ func checkBlockHeaderArgument(arg *ArgInterceptedBlockHeader) error {
 	if check.IfNil(arg.Hasher) {
 		return process.ErrNilHasher
 	}
-	if check.IfNil(arg.MultiSigVerifier) {
-		return process.ErrNilMultiSigVerifier
-	}
-	if check.IfNil(arg.NodesCoordinator) {
-		return process.ErrNilNodesCoordinator
-	}
 	if check.IfNil(arg.ShardCoordinator) {
 		return process.ErrNilShardCoordinator
 	}
+	if check.IfNil(arg.HeaderSigVerifier) {
+		return process.ErrNilHeaderSigVerifier
+	}
+	if check.IfNil(arg.HeaderIntegrityVerifier) {
+		return process.ErrNilHeaderIntegrityVerifier
+	}
+	if check.IfNil(arg.LimitsChecker) {
+		return process.ErrNilInterceptedDataLimitsChecker
+	}
 
 	return nil
 }
@@ -108,6 +64,9 @@ func checkTxBlockBodyArgument(arg *ArgInterceptedTxBlockBody) error {
 	if check.IfNil(arg.ShardCoordinator) {
 		return process.ErrNilShardCoordinator
 	}
+	if check.IfNil(arg.LimitsChecker) {
+		return process.ErrNilInterceptedDataLimitsChecker
+	}
 
 	return nil
 }
@@ -135,13 +94,17 @@ func checkHeaderHandler(hdr data.HeaderHandler) error {
 	return nil
 }
 
-func checkMetaShardInfo(shardInfo []block.ShardData, coordinator sharding.Coordinator) error {
+func checkMetaShardInfo(
+	shardInfo []block.ShardData,
+	coordinator sharding.Coordinator,
+	limitsChecker process.InterceptedDataLimitsChecker,
+) error {
 	for _, sd := range shardInfo {
 		if sd.ShardId >= coordinator.NumberOfShards() {
 			return process.ErrInvalidShardId
 		}
 
-		err := checkShardData(sd, coordinator)
+		err := checkShardData(sd, coordinator, limitsChecker)
 		if err != nil {
 			return err
 		}
@@ -150,8 +113,22 @@ func checkMetaShardInfo(shardInfo []block.ShardData, coordinator sharding.Coordi
 	return nil
 }
 
-func checkShardData(sd block.ShardData, coordinator sharding.Coordinator) error {
+func checkShardData(
+	sd block.ShardData,
+	coordinator sharding.Coordinator,
+	limitsChecker process.InterceptedDataLimitsChecker,
+) error {
+	err := limitsChecker.CheckNumMiniBlocks(len(sd.ShardMiniBlockHeaders))
+	if err != nil {
+		return err
+	}
+
 	for _, smbh := range sd.ShardMiniBlockHeaders {
+		err = limitsChecker.CheckNumTxsInMiniBlock(int(smbh.TxCount))
+		if err != nil {
+			return err
+		}
+
 		isWrongSenderShardId := smbh.SenderShardId >= coordinator.NumberOfShards()
 		isWrongDestinationShardId := smbh.ReceiverShardId >= coordinator.NumberOfShards()
 		isWrongShardId := isWrongSenderShardId || isWrongDestinationShardId
@@ -163,8 +140,22 @@ func checkShardData(sd block.ShardData, coordinator sharding.Coordinator) error
 	return nil
 }
 
-func checkMiniblocks(miniblocks []block.MiniBlockHeader, coordinator sharding.Coordinator) error {
+func checkMiniblocks(
+	miniblocks []block.MiniBlockHeader,
+	coordinator sharding.Coordinator,
+	limitsChecker process.InterceptedDataLimitsChecker,
+) error {
+	err := limitsChecker.CheckNumMiniBlocks(len(miniblocks))
+	if err != nil {
+		return err
+	}
+
 	for _, miniblock := range miniblocks {
+		err = limitsChecker.CheckNumTxsInMiniBlock(int(miniblock.TxCount))
+		if err != nil {
+			return err
+		}
+
 		isWrongSenderShardId := miniblock.SenderShardID >= coordinator.NumberOfShards()
 		isWrongDestinationShardId := miniblock.ReceiverShardID >= coordinator.NumberOfShards()
 		isWrongShardId := isWrongSenderShardId || isWrongDestinationShardId
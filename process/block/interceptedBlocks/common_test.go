@@ -11,12 +11,13 @@ import (
 
 func createDefaultBlockHeaderArgument() *ArgInterceptedBlockHeader {
 	arg := &ArgInterceptedBlockHeader{
-		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
-		MultiSigVerifier: mock.NewMultiSigner(),
-		Hasher:           mock.HasherMock{},
-		Marshalizer:      &mock.MarshalizerMock{},
-		NodesCoordinator: mock.NewNodesCoordinatorMock(),
-		HdrBuff:          []byte("test buffer"),
+		ShardCoordinator:        mock.NewOneShardCoordinatorMock(),
+		Hasher:                  mock.HasherMock{},
+		Marshalizer:             &mock.MarshalizerMock{},
+		HdrBuff:                 []byte("test buffer"),
+		HeaderSigVerifier:       &mock.HeaderSigVerifierStub{},
+		HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
+		LimitsChecker:           &mock.InterceptedDataLimitsCheckerStub{},
 	}
 
 	return arg
@@ -28,6 +29,7 @@ func createDefaultTxBlockBodyArgument() *ArgInterceptedTxBlockBody {
 		Marshalizer:      &mock.MarshalizerMock{},
 		TxBlockBodyBuff:  []byte("test buffer"),
 		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		LimitsChecker:    &mock.InterceptedDataLimitsCheckerStub{},
 	}
 
 	return arg
@@ -99,26 +101,37 @@ func TestCheckBlockHeaderArgument_NilHasherShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilHasher, err)
 }
 
-func TestCheckBlockHeaderArgument_NilMultiSigVerifierShouldErr(t *testing.T) {
+func TestCheckBlockHeaderArgument_NilHeaderSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultBlockHeaderArgument()
+	arg.HeaderSigVerifier = nil
+
+	err := checkBlockHeaderArgument(arg)
+
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
+}
+
+func TestCheckBlockHeaderArgument_NilHeaderIntegrityVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createDefaultBlockHeaderArgument()
-	arg.MultiSigVerifier = nil
+	arg.HeaderIntegrityVerifier = nil
 
 	err := checkBlockHeaderArgument(arg)
 
-	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+	assert.Equal(t, process.ErrNilHeaderIntegrityVerifier, err)
 }
 
-func TestCheckBlockHeaderArgument_NilChronologyValidatorShouldErr(t *testing.T) {
+func TestCheckBlockHeaderArgument_NilLimitsCheckerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createDefaultBlockHeaderArgument()
-	arg.NodesCoordinator = nil
+	arg.LimitsChecker = nil
 
 	err := checkBlockHeaderArgument(arg)
 
-	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+	assert.Equal(t, process.ErrNilInterceptedDataLimitsChecker, err)
 }
 
 func TestCheckBlockHeaderArgument_NilShardCoordinatorShouldErr(t *testing.T) {
@@ -196,6 +209,17 @@ func TestCheckTxBlockBodyArgument_NilShardCoordinatorShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
 }
 
+func TestCheckTxBlockBodyArgument_NilLimitsCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultTxBlockBodyArgument()
+	arg.LimitsChecker = nil
+
+	err := checkTxBlockBodyArgument(arg)
+
+	assert.Equal(t, process.ErrNilInterceptedDataLimitsChecker, err)
+}
+
 func TestCheckTxBlockBodyArgument_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -303,8 +327,8 @@ func TestCheckMetaShardInfo_WithNilOrEmptyShouldReturnNil(t *testing.T) {
 
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 
-	err1 := checkMetaShardInfo(nil, shardCoordinator)
-	err2 := checkMetaShardInfo(make([]block.ShardData, 0), shardCoordinator)
+	err1 := checkMetaShardInfo(nil, shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
+	err2 := checkMetaShardInfo(make([]block.ShardData, 0), shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
 
 	assert.Nil(t, err1)
 	assert.Nil(t, err2)
@@ -322,7 +346,7 @@ func TestCheckMetaShardInfo_WrongShardIdShouldErr(t *testing.T) {
 		TxCount:               0,
 	}
 
-	err := checkMetaShardInfo([]block.ShardData{sd}, shardCoordinator)
+	err := checkMetaShardInfo([]block.ShardData{sd}, shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
 
 	assert.Equal(t, process.ErrInvalidShardId, err)
 }
@@ -346,7 +370,7 @@ func TestCheckMetaShardInfo_WrongMiniblockSenderShardIdShouldErr(t *testing.T) {
 		TxCount:               0,
 	}
 
-	err := checkMetaShardInfo([]block.ShardData{sd}, shardCoordinator)
+	err := checkMetaShardInfo([]block.ShardData{sd}, shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
 
 	assert.Equal(t, process.ErrInvalidShardId, err)
 }
@@ -370,7 +394,7 @@ func TestCheckMetaShardInfo_WrongMiniblockReceiverShardIdShouldErr(t *testing.T)
 		TxCount:               0,
 	}
 
-	err := checkMetaShardInfo([]block.ShardData{sd}, shardCoordinator)
+	err := checkMetaShardInfo([]block.ShardData{sd}, shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
 
 	assert.Equal(t, process.ErrInvalidShardId, err)
 }
@@ -393,11 +417,56 @@ func TestCheckMetaShardInfo_OkValsShouldWork(t *testing.T) {
 		TxCount:               0,
 	}
 
-	err := checkMetaShardInfo([]block.ShardData{sd}, shardCoordinator)
+	err := checkMetaShardInfo([]block.ShardData{sd}, shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
 
 	assert.Nil(t, err)
 }
 
+func TestCheckMetaShardInfo_TooManyMiniblocksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	sd := block.ShardData{
+		ShardId:               shardCoordinator.SelfId(),
+		ShardMiniBlockHeaders: make([]block.ShardMiniBlockHeader, 3),
+	}
+	limitsChecker := &mock.InterceptedDataLimitsCheckerStub{
+		CheckNumMiniBlocksCalled: func(numMiniBlocks int) error {
+			assert.Equal(t, 3, numMiniBlocks)
+			return process.ErrTooManyMiniBlocksInBlock
+		},
+	}
+
+	err := checkMetaShardInfo([]block.ShardData{sd}, shardCoordinator, limitsChecker)
+
+	assert.Equal(t, process.ErrTooManyMiniBlocksInBlock, err)
+}
+
+func TestCheckMetaShardInfo_TooManyTxsInMiniblockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	miniBlock := block.ShardMiniBlockHeader{
+		ReceiverShardId: shardCoordinator.SelfId(),
+		SenderShardId:   shardCoordinator.SelfId(),
+		TxCount:         7,
+	}
+	sd := block.ShardData{
+		ShardId:               shardCoordinator.SelfId(),
+		ShardMiniBlockHeaders: []block.ShardMiniBlockHeader{miniBlock},
+	}
+	limitsChecker := &mock.InterceptedDataLimitsCheckerStub{
+		CheckNumTxsInMiniBlockCalled: func(numTxs int) error {
+			assert.Equal(t, 7, numTxs)
+			return process.ErrTooManyTxsInMiniBlock
+		},
+	}
+
+	err := checkMetaShardInfo([]block.ShardData{sd}, shardCoordinator, limitsChecker)
+
+	assert.Equal(t, process.ErrTooManyTxsInMiniBlock, err)
+}
+
 //------- checkMiniblocks
 
 func TestCheckMiniblocks_WithNilOrEmptyShouldReturnNil(t *testing.T) {
@@ -405,8 +474,8 @@ func TestCheckMiniblocks_WithNilOrEmptyShouldReturnNil(t *testing.T) {
 
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 
-	err1 := checkMiniblocks(nil, shardCoordinator)
-	err2 := checkMiniblocks(make([]block.MiniBlockHeader, 0), shardCoordinator)
+	err1 := checkMiniblocks(nil, shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
+	err2 := checkMiniblocks(make([]block.MiniBlockHeader, 0), shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
 
 	assert.Nil(t, err1)
 	assert.Nil(t, err2)
@@ -425,7 +494,7 @@ func TestCheckMiniblocks_WrongMiniblockSenderShardIdShouldErr(t *testing.T) {
 		Type:            0,
 	}
 
-	err := checkMiniblocks([]block.MiniBlockHeader{miniblockHeader}, shardCoordinator)
+	err := checkMiniblocks([]block.MiniBlockHeader{miniblockHeader}, shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
 
 	assert.Equal(t, process.ErrInvalidShardId, err)
 }
@@ -443,7 +512,7 @@ func TestCheckMiniblocks_WrongMiniblockReceiverShardIdShouldErr(t *testing.T) {
 		Type:            0,
 	}
 
-	err := checkMiniblocks([]block.MiniBlockHeader{miniblockHeader}, shardCoordinator)
+	err := checkMiniblocks([]block.MiniBlockHeader{miniblockHeader}, shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
 
 	assert.Equal(t, process.ErrInvalidShardId, err)
 }
@@ -460,7 +529,43 @@ func TestCheckMiniblocks_OkValsShouldWork(t *testing.T) {
 		Type:            0,
 	}
 
-	err := checkMiniblocks([]block.MiniBlockHeader{miniblockHeader}, shardCoordinator)
+	err := checkMiniblocks([]block.MiniBlockHeader{miniblockHeader}, shardCoordinator, &mock.InterceptedDataLimitsCheckerStub{})
 
 	assert.Nil(t, err)
 }
+
+func TestCheckMiniblocks_TooManyMiniblocksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	limitsChecker := &mock.InterceptedDataLimitsCheckerStub{
+		CheckNumMiniBlocksCalled: func(numMiniBlocks int) error {
+			assert.Equal(t, 3, numMiniBlocks)
+			return process.ErrTooManyMiniBlocksInBlock
+		},
+	}
+
+	err := checkMiniblocks(make([]block.MiniBlockHeader, 3), mock.NewOneShardCoordinatorMock(), limitsChecker)
+
+	assert.Equal(t, process.ErrTooManyMiniBlocksInBlock, err)
+}
+
+func TestCheckMiniblocks_TooManyTxsInMiniblockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	miniblockHeader := block.MiniBlockHeader{
+		SenderShardID:   shardCoordinator.SelfId(),
+		ReceiverShardID: shardCoordinator.SelfId(),
+		TxCount:         7,
+	}
+	limitsChecker := &mock.InterceptedDataLimitsCheckerStub{
+		CheckNumTxsInMiniBlockCalled: func(numTxs int) error {
+			assert.Equal(t, 7, numTxs)
+			return process.ErrTooManyTxsInMiniBlock
+		},
+	}
+
+	err := checkMiniblocks([]block.MiniBlockHeader{miniblockHeader}, shardCoordinator, limitsChecker)
+
+	assert.Equal(t, process.ErrTooManyTxsInMiniBlock, err)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -12,9 +13,11 @@ import (
 // It implements Newer and Hashed interfaces
 type InterceptedHeader struct {
 	hdr               *block.Header
-	sigVerifier       *headerMultiSigVerifier
+	sigVerifier       process.InterceptedHeaderSigVerifier
+	integrityVerifier process.HeaderIntegrityVerifier
 	hasher            hashing.Hasher
 	shardCoordinator  sharding.Coordinator
+	limitsChecker     process.InterceptedDataLimitsChecker
 	hash              []byte
 	isForCurrentShard bool
 }
@@ -31,21 +34,14 @@ func NewInterceptedHeader(arg *ArgInterceptedBlockHeader) (*InterceptedHeader, e
 		return nil, err
 	}
 
-	sigVerifier := &headerMultiSigVerifier{
-		marshalizer:      arg.Marshalizer,
-		hasher:           arg.Hasher,
-		nodesCoordinator: arg.NodesCoordinator,
-		multiSigVerifier: arg.MultiSigVerifier,
-	}
-
 	inHdr := &InterceptedHeader{
-		hdr:              hdr,
-		hasher:           arg.Hasher,
-		sigVerifier:      sigVerifier,
-		shardCoordinator: arg.ShardCoordinator,
+		hdr:               hdr,
+		hasher:            arg.Hasher,
+		sigVerifier:       arg.HeaderSigVerifier,
+		integrityVerifier: arg.HeaderIntegrityVerifier,
+		shardCoordinator:  arg.ShardCoordinator,
+		limitsChecker:     arg.LimitsChecker,
 	}
-	//wire-up the "virtual" function
-	inHdr.sigVerifier.copyHeaderWithoutSig = inHdr.copyHeaderWithoutSig
 	inHdr.processFields(arg.HdrBuff)
 
 	return inHdr, nil
@@ -64,17 +60,6 @@ func createShardHdr(marshalizer marshal.Marshalizer, hdrBuff []byte) (*block.Hea
 	return hdr, nil
 }
 
-func (inHdr *InterceptedHeader) copyHeaderWithoutSig(header data.HeaderHandler) data.HeaderHandler {
-	//it is virtually impossible here to have a wrong type assertion case
-	hdr := header.(*block.Header)
-
-	headerCopy := *hdr
-	headerCopy.Signature = nil
-	headerCopy.PubKeysBitmap = nil
-
-	return &headerCopy
-}
-
 func (inHdr *InterceptedHeader) processFields(txBuff []byte) {
 	inHdr.hash = inHdr.hasher.Compute(string(txBuff))
 
@@ -90,7 +75,7 @@ func (inHdr *InterceptedHeader) CheckValidity() error {
 		return err
 	}
 
-	return inHdr.sigVerifier.verifySig(inHdr.hdr)
+	return verifyHeaderSignatures(inHdr.hdr, inHdr.sigVerifier)
 }
 
 // integrity checks the integrity of the header block wrapper
@@ -100,12 +85,12 @@ func (inHdr *InterceptedHeader) integrity() error {
 		return err
 	}
 
-	err = checkMiniblocks(inHdr.hdr.MiniBlockHeaders, inHdr.shardCoordinator)
+	err = checkMiniblocks(inHdr.hdr.MiniBlockHeaders, inHdr.shardCoordinator, inHdr.limitsChecker)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return inHdr.integrityVerifier.Verify(inHdr.hdr)
 }
 
 // Hash gets the hash of this header
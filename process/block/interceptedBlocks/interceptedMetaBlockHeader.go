@@ -5,16 +5,19 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // InterceptedMetaHeader represents the wrapper over the meta block header struct
 type InterceptedMetaHeader struct {
-	hdr              *block.MetaBlock
-	sigVerifier      *headerMultiSigVerifier
-	hasher           hashing.Hasher
-	shardCoordinator sharding.Coordinator
-	hash             []byte
+	hdr               *block.MetaBlock
+	sigVerifier       process.InterceptedHeaderSigVerifier
+	integrityVerifier process.HeaderIntegrityVerifier
+	hasher            hashing.Hasher
+	shardCoordinator  sharding.Coordinator
+	limitsChecker     process.InterceptedDataLimitsChecker
+	hash              []byte
 }
 
 // NewInterceptedMetaHeader creates a new instance of InterceptedMetaHeader struct
@@ -29,21 +32,14 @@ func NewInterceptedMetaHeader(arg *ArgInterceptedBlockHeader) (*InterceptedMetaH
 		return nil, err
 	}
 
-	sigVerifier := &headerMultiSigVerifier{
-		marshalizer:      arg.Marshalizer,
-		hasher:           arg.Hasher,
-		nodesCoordinator: arg.NodesCoordinator,
-		multiSigVerifier: arg.MultiSigVerifier,
-	}
-
 	inHdr := &InterceptedMetaHeader{
-		hdr:              hdr,
-		hasher:           arg.Hasher,
-		sigVerifier:      sigVerifier,
-		shardCoordinator: arg.ShardCoordinator,
+		hdr:               hdr,
+		hasher:            arg.Hasher,
+		sigVerifier:       arg.HeaderSigVerifier,
+		integrityVerifier: arg.HeaderIntegrityVerifier,
+		shardCoordinator:  arg.ShardCoordinator,
+		limitsChecker:     arg.LimitsChecker,
 	}
-	//wire-up the "virtual" function
-	inHdr.sigVerifier.copyHeaderWithoutSig = inHdr.copyHeaderWithoutSig
 	inHdr.processFields(arg.HdrBuff)
 
 	return inHdr, nil
@@ -62,17 +58,6 @@ func createMetaHdr(marshalizer marshal.Marshalizer, hdrBuff []byte) (*block.Meta
 	return hdr, nil
 }
 
-func (imh *InterceptedMetaHeader) copyHeaderWithoutSig(header data.HeaderHandler) data.HeaderHandler {
-	//it is virtually impossible here to have a wrong type assertion case
-	hdr := header.(*block.MetaBlock)
-
-	headerCopy := *hdr
-	headerCopy.Signature = nil
-	headerCopy.PubKeysBitmap = nil
-
-	return &headerCopy
-}
-
 func (imh *InterceptedMetaHeader) processFields(txBuff []byte) {
 	imh.hash = imh.hasher.Compute(string(txBuff))
 }
@@ -94,7 +79,7 @@ func (imh *InterceptedMetaHeader) CheckValidity() error {
 		return err
 	}
 
-	return imh.sigVerifier.verifySig(imh.hdr)
+	return verifyHeaderSignatures(imh.hdr, imh.sigVerifier)
 }
 
 // integrity checks the integrity of the meta header block wrapper
@@ -104,12 +89,12 @@ func (imh *InterceptedMetaHeader) integrity() error {
 		return err
 	}
 
-	err = checkMetaShardInfo(imh.hdr.ShardInfo, imh.shardCoordinator)
+	err = checkMetaShardInfo(imh.hdr.ShardInfo, imh.shardCoordinator, imh.limitsChecker)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return imh.integrityVerifier.Verify(imh.hdr)
 }
 
 // IsForCurrentShard always returns true
@@ -14,6 +14,7 @@ type InterceptedTxBlockBody struct {
 	marshalizer       marshal.Marshalizer
 	hasher            hashing.Hasher
 	shardCoordinator  sharding.Coordinator
+	limitsChecker     process.InterceptedDataLimitsChecker
 	hash              []byte
 	isForCurrentShard bool
 }
@@ -35,6 +36,7 @@ func NewInterceptedTxBlockBody(arg *ArgInterceptedTxBlockBody) (*InterceptedTxBl
 		marshalizer:      arg.Marshalizer,
 		hasher:           arg.Hasher,
 		shardCoordinator: arg.ShardCoordinator,
+		limitsChecker:    arg.LimitsChecker,
 	}
 	inTxBody.processFields(arg.TxBlockBodyBuff)
 
@@ -96,11 +98,21 @@ func (inTxBody *InterceptedTxBlockBody) IsForCurrentShard() bool {
 
 // integrity checks the integrity of the tx block body
 func (inTxBody *InterceptedTxBlockBody) integrity() error {
+	err := inTxBody.limitsChecker.CheckNumMiniBlocks(len(inTxBody.txBlockBody))
+	if err != nil {
+		return err
+	}
+
 	for _, miniBlock := range inTxBody.txBlockBody {
 		if miniBlock.TxHashes == nil {
 			return process.ErrNilTxHashes
 		}
 
+		err = inTxBody.limitsChecker.CheckNumTxsInMiniBlock(len(miniBlock.TxHashes))
+		if err != nil {
+			return err
+		}
+
 		if miniBlock.ReceiverShardID >= inTxBody.shardCoordinator.NumberOfShards() {
 			return process.ErrInvalidShardId
 		}
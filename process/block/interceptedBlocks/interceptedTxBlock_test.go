@@ -16,6 +16,7 @@ func createDefaultTxBodyArgument() *interceptedBlocks.ArgInterceptedTxBlockBody
 		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
 		Hasher:           testHasher,
 		Marshalizer:      testMarshalizer,
+		LimitsChecker:    &mock.InterceptedDataLimitsCheckerStub{},
 	}
 
 	txBlockBody := createMockTxBlockBody()
@@ -145,6 +146,41 @@ func TestInterceptedTxBlockBody_ContainsNilHashShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilTxHash, err)
 }
 
+func TestInterceptedTxBlockBody_TooManyMiniblocksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultTxBodyArgument()
+	arg.LimitsChecker = &mock.InterceptedDataLimitsCheckerStub{
+		CheckNumMiniBlocksCalled: func(numMiniBlocks int) error {
+			return process.ErrTooManyMiniBlocksInBlock
+		},
+	}
+	inTxBody, _ := interceptedBlocks.NewInterceptedTxBlockBody(arg)
+
+	err := inTxBody.CheckValidity()
+
+	assert.Equal(t, process.ErrTooManyMiniBlocksInBlock, err)
+}
+
+func TestInterceptedTxBlockBody_TooManyTxsInMiniblockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultTxBodyArgument()
+	arg.LimitsChecker = &mock.InterceptedDataLimitsCheckerStub{
+		CheckNumTxsInMiniBlockCalled: func(numTxs int) error {
+			if numTxs > 1 {
+				return process.ErrTooManyTxsInMiniBlock
+			}
+			return nil
+		},
+	}
+	inTxBody, _ := interceptedBlocks.NewInterceptedTxBlockBody(arg)
+
+	err := inTxBody.CheckValidity()
+
+	assert.Equal(t, process.ErrTooManyTxsInMiniBlock, err)
+}
+
 func TestInterceptedTxBlockBody_ShouldWork(t *testing.T) {
 	t.Parallel()
 
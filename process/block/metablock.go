@@ -7,12 +7,14 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/pendingMb"
 	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -29,6 +31,7 @@ type metaProcessor struct {
 	shardBlockFinality uint32
 	chRcvAllHdrs       chan bool
 	headersCounter     *headersCounter
+	pendingMiniBlocks  process.PendingMiniBlocksHandler
 }
 
 // NewMetaProcessor creates a new metaProcessor object
@@ -44,6 +47,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if arguments.DataPool.ShardHeaders() == nil || arguments.DataPool.ShardHeaders().IsInterfaceNil() {
 		return nil, process.ErrNilHeadersDataPool
 	}
+	if arguments.PendingMiniBlocks == nil || arguments.PendingMiniBlocks.IsInterfaceNil() {
+		return nil, process.ErrNilPendingMiniBlocksHandler
+	}
 
 	blockSizeThrottler, err := throttle.NewBlockSizeThrottle()
 	if err != nil {
@@ -54,6 +60,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		accounts:                      arguments.Accounts,
 		blockSizeThrottler:            blockSizeThrottler,
 		forkDetector:                  arguments.ForkDetector,
+		blockTracker:                  arguments.BlockTracker,
 		hasher:                        arguments.Hasher,
 		marshalizer:                   arguments.Marshalizer,
 		store:                         arguments.Store,
@@ -61,6 +68,11 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		nodesCoordinator:              arguments.NodesCoordinator,
 		specialAddressHandler:         arguments.SpecialAddressHandler,
 		uint64Converter:               arguments.Uint64Converter,
+		historyRepository:             arguments.HistoryRepository,
+		outgoingOperations:            arguments.OutgoingOperations,
+		selectionAuditor:              arguments.SelectionAuditor,
+		chainID:                       arguments.ChainID,
+		softwareVersion:               arguments.SoftwareVersion,
 		onRequestHeaderHandler:        arguments.RequestHandler.RequestHeader,
 		onRequestHeaderHandlerByNonce: arguments.RequestHandler.RequestHeaderByNonce,
 		appStatusHandler:              statusHandler.NewNilStatusHandler(),
@@ -72,18 +84,18 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	}
 
 	mp := metaProcessor{
-		core:           arguments.Core,
-		baseProcessor:  base,
-		dataPool:       arguments.DataPool,
-		headersCounter: NewHeaderCounter(),
+		core:              arguments.Core,
+		baseProcessor:     base,
+		dataPool:          arguments.DataPool,
+		headersCounter:    NewHeaderCounter(),
+		pendingMiniBlocks: arguments.PendingMiniBlocks,
 	}
 
 	mp.hdrsForCurrBlock.hdrHashAndInfo = make(map[string]*hdrInfo)
 	mp.hdrsForCurrBlock.highestHdrNonce = make(map[uint32]uint64)
 	mp.hdrsForCurrBlock.requestedFinalityAttestingHdrs = make(map[uint32][]uint64)
 
-	headerPool := mp.dataPool.ShardHeaders()
-	headerPool.RegisterHandler(mp.receivedShardHeader)
+	arguments.HeadersNotifier.RegisterHandler(mp.receivedShardHeader)
 
 	mp.chRcvAllHdrs = make(chan bool)
 
@@ -119,6 +131,8 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	mp.setLogCorrelation(headerHandler, nil)
+	defer logger.ClearCorrelationHeader()
 	log.Debug(fmt.Sprintf("started processing block with round %d and nonce %d\n",
 		headerHandler.GetRound(),
 		headerHandler.GetNonce()))
@@ -185,6 +199,11 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	err = mp.checkOverdueCrossShardMiniBlocks()
+	if err != nil {
+		return err
+	}
+
 	defer func() {
 		if err != nil {
 			mp.RevertAccountState()
@@ -299,6 +318,7 @@ func (mp *metaProcessor) removeBlockInfoFromPool(header *block.MetaBlock) error
 // RestoreBlockIntoPools restores the block into associated pools
 func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler, bodyHandler data.BodyHandler) error {
 	mp.removeLastNotarized()
+	mp.blockTracker.RemoveLastNotarizedHeaders()
 
 	if headerHandler == nil || headerHandler.IsInterfaceNil() {
 		return process.ErrNilMetaBlockHeader
@@ -319,6 +339,8 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 		return process.ErrNilHeadersNoncesDataPool
 	}
 
+	mp.pendingMiniBlocks.RevertHeaders(header.Nonce)
+
 	hdrHashes := make([][]byte, len(header.ShardInfo))
 	for i := 0; i < len(header.ShardInfo); i++ {
 		hdrHashes[i] = header.ShardInfo[i].HeaderHash
@@ -411,6 +433,7 @@ func (mp *metaProcessor) CommitBlock(
 		if err != nil {
 			mp.RevertAccountState()
 		}
+		logger.ClearCorrelationHeader()
 	}()
 
 	err = checkForNils(chainHandler, headerHandler, bodyHandler)
@@ -439,6 +462,7 @@ func (mp *metaProcessor) CommitBlock(
 	}
 
 	headerHash := mp.hasher.Compute(string(buff))
+	mp.setLogCorrelation(header, headerHash)
 	nonceToByteSlice := mp.uint64Converter.ToByteSlice(header.Nonce)
 	errNotCritical := mp.store.Put(dataRetriever.MetaHdrNonceHashDataUnit, nonceToByteSlice, headerHash)
 	log.LogIfError(errNotCritical)
@@ -467,6 +491,8 @@ func (mp *metaProcessor) CommitBlock(
 		return err
 	}
 
+	notarizedShardHdrs := make([]*block.Header, 0, len(header.ShardInfo))
+	notarizedShardHdrsHashes := make([][]byte, 0, len(header.ShardInfo))
 	mp.hdrsForCurrBlock.mutHdrsForBlock.RLock()
 	for i := 0; i < len(header.ShardInfo); i++ {
 		shardHeaderHash := header.ShardInfo[i].HeaderHash
@@ -483,6 +509,8 @@ func (mp *metaProcessor) CommitBlock(
 		}
 
 		mp.updateShardHeadersNonce(shardBlock.ShardId, shardBlock.Nonce)
+		notarizedShardHdrs = append(notarizedShardHdrs, shardBlock)
+		notarizedShardHdrsHashes = append(notarizedShardHdrsHashes, shardHeaderHash)
 
 		buff, err = mp.marshalizer.Marshal(shardBlock)
 		if err != nil {
@@ -502,16 +530,22 @@ func (mp *metaProcessor) CommitBlock(
 
 	mp.saveMetricCrossCheckBlockHeight()
 
-	err = mp.saveLastNotarizedHeader(header)
+	err = mp.commitState(chainHandler, headerHandler)
 	if err != nil {
 		return err
 	}
+	publishTrieStatistics(mp.core, headerHandler)
 
-	_, err = mp.accounts.Commit()
+	err = mp.saveLastNotarizedHeader(header)
 	if err != nil {
 		return err
 	}
 
+	mp.pendingMiniBlocks.AddCommittedHeaders(header.Nonce, notarizedShardHdrs)
+	for i := 0; i < len(notarizedShardHdrs); i++ {
+		mp.blockTracker.AddCrossNotarizedHeader(notarizedShardHdrs[i].ShardId, notarizedShardHdrs[i], notarizedShardHdrsHashes[i])
+	}
+
 	log.Info(fmt.Sprintf("meta block with nonce %d and hash %s has been committed successfully\n",
 		header.Nonce,
 		core.ToB64(headerHash)))
@@ -532,6 +566,7 @@ func (mp *metaProcessor) CommitBlock(
 
 	hdrsToAttestPreviousFinal := mp.shardBlockFinality + 1
 	mp.removeNotarizedHdrsBehindPreviousFinal(hdrsToAttestPreviousFinal)
+	mp.cleanupBlockTrackerHeaders(mp.forkDetector.GetHighestFinalBlockNonce())
 
 	lastMetaBlock := chainHandler.GetCurrentBlockHeader()
 
@@ -546,6 +581,9 @@ func (mp *metaProcessor) CommitBlock(
 	}
 
 	chainHandler.SetCurrentBlockHeaderHash(headerHash)
+	mp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
+	mp.collectOutgoingOperations(headerHash, headerHandler, bodyHandler)
+	mp.auditValidatorsSelection(headerHandler)
 
 	if mp.core != nil && mp.core.TPSBenchmark() != nil {
 		mp.core.TPSBenchmark().Update(header)
@@ -730,6 +768,55 @@ func (mp *metaProcessor) checkShardHeadersFinality(highestNonceHdrs map[uint32]d
 	return nil
 }
 
+// checkOverdueCrossShardMiniBlocks verifies that every shard header used in the current block processes at least
+// one of the cross shard miniblocks which wait for its shard for more than the maximum allowed delay, if any
+func (mp *metaProcessor) checkOverdueCrossShardMiniBlocks() error {
+	usedShardHdrs := mp.sortHeadersForCurrentBlockByNonce(true)
+	for shardId, hdrsForShard := range usedShardHdrs {
+		overdueMbs := mp.overdueMiniBlocksForShard(shardId)
+		for _, hdr := range hdrsForShard {
+			shardHdr, ok := hdr.(*block.Header)
+			if !ok {
+				return process.ErrWrongTypeAssertion
+			}
+
+			if !solvesOverdueMiniBlocks(shardHdr, overdueMbs) {
+				return process.ErrOverdueCrossShardMiniBlocksNotProcessed
+			}
+		}
+	}
+
+	return nil
+}
+
+func (mp *metaProcessor) overdueMiniBlocksForShard(shardId uint32) map[string]struct{} {
+	overdueMbs := make(map[string]struct{})
+	for _, hash := range mp.pendingMiniBlocks.OverdueMiniBlockHashes(shardId) {
+		overdueMbs[string(hash)] = struct{}{}
+	}
+
+	return overdueMbs
+}
+
+// solvesOverdueMiniBlocks returns true if there are no overdue miniblocks left or the header processes at least
+// one of them. The miniblocks processed by the header are removed from the overdue ones
+func solvesOverdueMiniBlocks(shardHdr *block.Header, overdueMbs map[string]struct{}) bool {
+	if len(overdueMbs) == 0 {
+		return true
+	}
+
+	solvedAny := false
+	for hash := range pendingMb.ProcessedCrossMiniBlocks(shardHdr) {
+		_, isOverdue := overdueMbs[hash]
+		if isOverdue {
+			delete(overdueMbs, hash)
+			solvedAny = true
+		}
+	}
+
+	return solvedAny
+}
+
 func (mp *metaProcessor) isShardHeaderValidFinal(currHdr *block.Header, lastHdr *block.Header, sortedShardHdrs []*block.Header) (bool, []uint32) {
 	if currHdr == nil {
 		return false, nil
@@ -778,18 +865,13 @@ func (mp *metaProcessor) isShardHeaderValidFinal(currHdr *block.Header, lastHdr
 
 // receivedShardHeader is a call back function which is called when a new header
 // is added in the headers pool
-func (mp *metaProcessor) receivedShardHeader(shardHeaderHash []byte) {
+func (mp *metaProcessor) receivedShardHeader(headerHandler data.HeaderHandler, shardHeaderHash []byte) {
 	shardHeaderPool := mp.dataPool.ShardHeaders()
 	if shardHeaderPool == nil {
 		return
 	}
 
-	obj, ok := shardHeaderPool.Peek(shardHeaderHash)
-	if !ok {
-		return
-	}
-
-	shardHeader, ok := obj.(*block.Header)
+	shardHeader, ok := headerHandler.(*block.Header)
 	if !ok {
 		return
 	}
@@ -976,6 +1058,11 @@ func (mp *metaProcessor) createShardInfo(
 	}
 	mp.mutNotarizedHdrs.RUnlock()
 
+	overdueMbsPerShard := make(map[uint32]map[string]struct{}, mp.shardCoordinator.NumberOfShards())
+	for shardId := uint32(0); shardId < mp.shardCoordinator.NumberOfShards(); shardId++ {
+		overdueMbsPerShard[shardId] = mp.overdueMiniBlocksForShard(shardId)
+	}
+
 	mp.hdrsForCurrBlock.mutHdrsForBlock.Lock()
 	for index := range orderedHdrs {
 		shId := orderedHdrs[index].ShardId
@@ -990,6 +1077,13 @@ func (mp *metaProcessor) createShardInfo(
 			continue
 		}
 
+		if !solvesOverdueMiniBlocks(orderedHdrs[index], overdueMbsPerShard[shId]) {
+			log.Debug(fmt.Sprintf("shard header with nonce %d from shard %d does not process any overdue cross shard miniblock\n",
+				orderedHdrs[index].Nonce,
+				shId))
+			continue
+		}
+
 		lastPushedHdr[shId] = orderedHdrs[index]
 
 		shardData := block.ShardData{}
@@ -1083,10 +1177,12 @@ func (mp *metaProcessor) CreateBlockHeader(bodyHandler data.BodyHandler, round u
 	log.Debug(fmt.Sprintf("started creating block header in round %d\n", round))
 	// TODO: add PrevRandSeed and RandSeed when BLS signing is completed
 	header := &block.MetaBlock{
-		ShardInfo:    make([]block.ShardData, 0),
-		PeerInfo:     make([]block.PeerData, 0),
-		PrevRandSeed: make([]byte, 0),
-		RandSeed:     make([]byte, 0),
+		ShardInfo:       make([]block.ShardData, 0),
+		PeerInfo:        make([]block.PeerData, 0),
+		PrevRandSeed:    make([]byte, 0),
+		RandSeed:        make([]byte, 0),
+		ChainID:         mp.chainID,
+		SoftwareVersion: mp.softwareVersion,
 	}
 
 	defer func() {
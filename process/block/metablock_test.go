@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/headersPool"
 	"github.com/ElrondNetwork/elrond-go/process"
 	blproc "github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -27,6 +28,8 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 		ArgBaseProcessor: blproc.ArgBaseProcessor{
 			Accounts:              &mock.AccountsStub{},
 			ForkDetector:          &mock.ForkDetectorMock{},
+			BlockTracker:          &mock.BlockTrackerStub{},
+			HeadersNotifier:       &mock.HeadersNotifierStub{},
 			Hasher:                &mock.HasherStub{},
 			Marshalizer:           &mock.MarshalizerMock{},
 			Store:                 &mock.ChainStorerMock{},
@@ -37,8 +40,14 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 			StartHeaders:          createGenesisBlocks(shardCoordinator),
 			RequestHandler:        &mock.RequestHandlerMock{},
 			Core:                  &mock.ServiceContainerMock{},
+			HistoryRepository:     &mock.HistoryRepositoryStub{},
+			OutgoingOperations:    &mock.OutgoingOperationsHandlerStub{},
+			SelectionAuditor:      &mock.ValidatorsSelectionAuditorStub{},
+			ChainID:               []byte("chain ID"),
+			SoftwareVersion:       []byte("1"),
 		},
-		DataPool: mdp,
+		DataPool:          mdp,
+		PendingMiniBlocks: &mock.PendingMiniBlocksHandlerStub{},
 	}
 	return arguments
 }
@@ -166,6 +175,17 @@ func TestNewMetaProcessor_NilDataPoolShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilPendingMiniBlocksHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.PendingMiniBlocks = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilPendingMiniBlocksHandler, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_NilForkDetectorShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -177,6 +197,28 @@ func TestNewMetaProcessor_NilForkDetectorShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilBlockTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.BlockTracker = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilBlockTracker, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilHeadersNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.HeadersNotifier = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilHeadersNotifier, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_NilShardCoordinatorShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -232,6 +274,61 @@ func TestNewMetaProcessor_NilRequestHeaderHandlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilHistoryRepositoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.HistoryRepository = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilHistoryRepository, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilOutgoingOperationsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.OutgoingOperations = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilOutgoingOperationsHandler, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilSelectionAuditorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.SelectionAuditor = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilValidatorsSelectionAuditor, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.ChainID = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_EmptySoftwareVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.SoftwareVersion = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrInvalidSoftwareVersion, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -543,12 +640,12 @@ func TestMetaProcessor_CommitBlockStorageFailsForHeaderShouldErr(t *testing.T) {
 
 	wasCalled := false
 	errPersister := errors.New("failure")
+	hdr := createMetaBlockHeader()
 	accounts := &mock.AccountsStub{
 		CommitCalled: func() (i []byte, e error) {
-			return nil, nil
+			return hdr.RootHash, nil
 		},
 	}
-	hdr := createMetaBlockHeader()
 	body := &block.MetaBlockBody{}
 	hdrUnit := &mock.StorerStub{
 		PutCalled: func(key, data []byte) error {
@@ -820,6 +917,8 @@ func TestMetaProcessor_CreateBlockHeaderShouldWork(t *testing.T) {
 	hdr, err := mp.CreateBlockHeader(nil, 0, haveTime)
 	assert.Nil(t, err)
 	assert.NotNil(t, hdr)
+	assert.Equal(t, arguments.ChainID, hdr.GetChainID())
+	assert.Equal(t, arguments.SoftwareVersion, hdr.GetSoftwareVersion())
 }
 
 func TestMetaProcessor_CommitBlockShouldRevertAccountStateWhenErr(t *testing.T) {
@@ -866,6 +965,7 @@ func TestMetaProcessor_ReceivedHeaderShouldDecreaseMissing(t *testing.T) {
 	pool := mock.NewMetaPoolsHolderFake()
 	arguments := createMockMetaArguments()
 	arguments.DataPool = pool
+	arguments.HeadersNotifier, _ = headersPool.NewHeadersPool(pool.ShardHeaders())
 	arguments.Store = initStore()
 	mp, _ := blproc.NewMetaProcessor(arguments)
 
@@ -2130,3 +2230,56 @@ func TestMetaProcessor_UpdateShardsHeadersNonce_ShouldWork(t *testing.T) {
 		assert.Equal(t, expectedData[i], mapDates[i])
 	}
 }
+
+func TestMetaProcessor_CheckOverdueCrossShardMiniBlocksNoOverdueShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	mp, _ := blproc.NewMetaProcessor(arguments)
+	mp.AddHdrHashToRequestedList(&block.Header{ShardId: 0, Nonce: 1}, []byte("hdr hash"))
+
+	err := mp.CheckOverdueCrossShardMiniBlocks()
+
+	assert.Nil(t, err)
+}
+
+func TestMetaProcessor_CheckOverdueCrossShardMiniBlocksNotProcessedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.PendingMiniBlocks = &mock.PendingMiniBlocksHandlerStub{
+		OverdueMiniBlockHashesCalled: func(receiverShardId uint32) [][]byte {
+			return [][]byte{[]byte("overdue mb")}
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+	mp.AddHdrHashToRequestedList(&block.Header{ShardId: 0, Nonce: 1}, []byte("hdr hash"))
+
+	err := mp.CheckOverdueCrossShardMiniBlocks()
+
+	assert.Equal(t, process.ErrOverdueCrossShardMiniBlocksNotProcessed, err)
+}
+
+func TestMetaProcessor_CheckOverdueCrossShardMiniBlocksProcessedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.PendingMiniBlocks = &mock.PendingMiniBlocksHandlerStub{
+		OverdueMiniBlockHashesCalled: func(receiverShardId uint32) [][]byte {
+			return [][]byte{[]byte("overdue mb")}
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+	hdr := &block.Header{
+		ShardId: 0,
+		Nonce:   1,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: []byte("overdue mb"), SenderShardID: 1, ReceiverShardID: 0},
+		},
+	}
+	mp.AddHdrHashToRequestedList(hdr, []byte("hdr hash"))
+
+	err := mp.CheckOverdueCrossShardMiniBlocks()
+
+	assert.Nil(t, err)
+}
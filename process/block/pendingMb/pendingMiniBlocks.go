@@ -0,0 +1,221 @@
+package pendingMb
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// maxMetaNoncesToKeepForRevert defines how many meta blocks can be reverted, as the changes made by older meta
+// blocks are forgotten
+const maxMetaNoncesToKeepForRevert = 20
+
+type pendingMiniBlocks struct {
+	mutPending         sync.RWMutex
+	mapPending         map[string]*block.PendingMiniBlockInfo
+	addedInMetaNonce   map[uint64][][]byte
+	removedInMetaNonce map[uint64][]*block.PendingMiniBlockInfo
+	lastMetaNonce      uint64
+	maxDelay           uint64
+}
+
+// NewPendingMiniBlocks creates a new pending miniblocks tracker. A cross shard miniblock becomes overdue when it
+// was not processed by its destination shard after maxDelay meta blocks since it was notarized
+func NewPendingMiniBlocks(maxDelay uint64) (*pendingMiniBlocks, error) {
+	if maxDelay == 0 {
+		return nil, process.ErrInvalidMaxPendingMiniBlocksDelay
+	}
+
+	return &pendingMiniBlocks{
+		mapPending:         make(map[string]*block.PendingMiniBlockInfo),
+		addedInMetaNonce:   make(map[uint64][][]byte),
+		removedInMetaNonce: make(map[uint64][]*block.PendingMiniBlockInfo),
+		maxDelay:           maxDelay,
+	}, nil
+}
+
+// AddCommittedHeaders updates the pending miniblocks with the shard headers notarized in the meta block with the
+// given nonce: the cross shard miniblocks created by the shards become pending and the ones processed by their
+// destination shards are removed
+func (pmb *pendingMiniBlocks) AddCommittedHeaders(metaNonce uint64, shardHdrs []*block.Header) {
+	pmb.mutPending.Lock()
+	defer pmb.mutPending.Unlock()
+
+	processed := make(map[string]struct{})
+	for _, hdr := range shardHdrs {
+		for hash := range ProcessedCrossMiniBlocks(hdr) {
+			processed[hash] = struct{}{}
+		}
+	}
+
+	added := make([][]byte, 0)
+	for _, hdr := range shardHdrs {
+		for _, mbHdr := range createdCrossMiniBlocks(hdr) {
+			_, isProcessed := processed[string(mbHdr.Hash)]
+			if isProcessed {
+				delete(processed, string(mbHdr.Hash))
+				continue
+			}
+
+			pmb.mapPending[string(mbHdr.Hash)] = &block.PendingMiniBlockInfo{
+				ShardMiniBlockHeader: block.ShardMiniBlockHeader{
+					Hash:            mbHdr.Hash,
+					SenderShardId:   mbHdr.SenderShardID,
+					ReceiverShardId: mbHdr.ReceiverShardID,
+					TxCount:         mbHdr.TxCount,
+				},
+				NotarizedInMetaNonce: metaNonce,
+			}
+			added = append(added, mbHdr.Hash)
+		}
+	}
+
+	removed := make([]*block.PendingMiniBlockInfo, 0)
+	for hash := range processed {
+		info, ok := pmb.mapPending[hash]
+		if !ok {
+			continue
+		}
+
+		delete(pmb.mapPending, hash)
+		removed = append(removed, info)
+	}
+
+	pmb.addedInMetaNonce[metaNonce] = added
+	pmb.removedInMetaNonce[metaNonce] = removed
+	pmb.lastMetaNonce = metaNonce
+
+	pmb.removeOldRevertInfo(metaNonce)
+}
+
+func (pmb *pendingMiniBlocks) removeOldRevertInfo(metaNonce uint64) {
+	if metaNonce <= maxMetaNoncesToKeepForRevert {
+		return
+	}
+
+	oldestNonceToKeep := metaNonce - maxMetaNoncesToKeepForRevert
+	for nonce := range pmb.addedInMetaNonce {
+		if nonce < oldestNonceToKeep {
+			delete(pmb.addedInMetaNonce, nonce)
+			delete(pmb.removedInMetaNonce, nonce)
+		}
+	}
+}
+
+// RevertHeaders reverts the changes made by the meta block with the given nonce
+func (pmb *pendingMiniBlocks) RevertHeaders(metaNonce uint64) {
+	pmb.mutPending.Lock()
+	defer pmb.mutPending.Unlock()
+
+	for _, hash := range pmb.addedInMetaNonce[metaNonce] {
+		delete(pmb.mapPending, string(hash))
+	}
+	for _, info := range pmb.removedInMetaNonce[metaNonce] {
+		pmb.mapPending[string(info.Hash)] = info
+	}
+
+	delete(pmb.addedInMetaNonce, metaNonce)
+	delete(pmb.removedInMetaNonce, metaNonce)
+
+	if metaNonce > 0 && pmb.lastMetaNonce >= metaNonce {
+		pmb.lastMetaNonce = metaNonce - 1
+	}
+}
+
+// OverdueMiniBlockHashes returns the hashes of the miniblocks with the given destination shard which waited for
+// more than the maximum allowed delay to be processed, considering that the next meta block will be notarized
+func (pmb *pendingMiniBlocks) OverdueMiniBlockHashes(receiverShardId uint32) [][]byte {
+	pmb.mutPending.RLock()
+	defer pmb.mutPending.RUnlock()
+
+	nextMetaNonce := pmb.lastMetaNonce + 1
+	hashes := make([][]byte, 0)
+	for _, info := range pmb.mapPending {
+		if info.ReceiverShardId != receiverShardId {
+			continue
+		}
+		if info.NotarizedInMetaNonce+pmb.maxDelay >= nextMetaNonce {
+			continue
+		}
+
+		hashes = append(hashes, info.Hash)
+	}
+
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i], hashes[j]) < 0
+	})
+
+	return hashes
+}
+
+// PendingMiniBlocks returns all the pending miniblocks, ordered by the nonce of the meta block which notarized them
+func (pmb *pendingMiniBlocks) PendingMiniBlocks() []*block.PendingMiniBlockInfo {
+	pmb.mutPending.RLock()
+	defer pmb.mutPending.RUnlock()
+
+	pending := make([]*block.PendingMiniBlockInfo, 0, len(pmb.mapPending))
+	for _, info := range pmb.mapPending {
+		infoCopy := *info
+		pending = append(pending, &infoCopy)
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].NotarizedInMetaNonce != pending[j].NotarizedInMetaNonce {
+			return pending[i].NotarizedInMetaNonce < pending[j].NotarizedInMetaNonce
+		}
+		return bytes.Compare(pending[i].Hash, pending[j].Hash) < 0
+	})
+
+	return pending
+}
+
+// ProcessedCrossMiniBlocks returns the hashes of the cross shard miniblocks processed by the shard which created
+// the given header. Miniblocks sent by the metachain are not tracked, so they are not returned either. A partially
+// executed miniblock is returned, by the hash of its original miniblock, only together with its last part
+func ProcessedCrossMiniBlocks(hdr *block.Header) map[string]struct{} {
+	processed := make(map[string]struct{})
+	for _, mbHdr := range hdr.MiniBlockHeaders {
+		isCrossShard := mbHdr.SenderShardID != mbHdr.ReceiverShardID
+		isSentToThisShard := mbHdr.ReceiverShardID == hdr.ShardId
+		isSentByMetachain := mbHdr.SenderShardID == sharding.MetachainShardId
+		if !isCrossShard || !isSentToThisShard || isSentByMetachain {
+			continue
+		}
+
+		if mbHdr.PartialExecution == nil {
+			processed[string(mbHdr.Hash)] = struct{}{}
+			continue
+		}
+		if mbHdr.PartialExecution.IsLastPart(int(mbHdr.TxCount)) {
+			processed[string(mbHdr.PartialExecution.OriginalHash)] = struct{}{}
+		}
+	}
+
+	return processed
+}
+
+func createdCrossMiniBlocks(hdr *block.Header) []block.MiniBlockHeader {
+	created := make([]block.MiniBlockHeader, 0)
+	for _, mbHdr := range hdr.MiniBlockHeaders {
+		isCrossShard := mbHdr.SenderShardID != mbHdr.ReceiverShardID
+		isCreatedByThisShard := mbHdr.SenderShardID == hdr.ShardId
+		isSentToMetachain := mbHdr.ReceiverShardID == sharding.MetachainShardId
+		if isCrossShard && isCreatedByThisShard && !isSentToMetachain {
+			created = append(created, mbHdr)
+		}
+	}
+
+	return created
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pmb *pendingMiniBlocks) IsInterfaceNil() bool {
+	if pmb == nil {
+		return true
+	}
+	return false
+}
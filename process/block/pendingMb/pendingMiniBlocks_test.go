@@ -0,0 +1,156 @@
+package pendingMb_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/pendingMb"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createShardHeader(shardId uint32, mbHdrs ...block.MiniBlockHeader) *block.Header {
+	return &block.Header{
+		ShardId:          shardId,
+		MiniBlockHeaders: mbHdrs,
+	}
+}
+
+func createMiniBlockHeader(hash string, senderShardId uint32, receiverShardId uint32) block.MiniBlockHeader {
+	return block.MiniBlockHeader{
+		Hash:            []byte(hash),
+		SenderShardID:   senderShardId,
+		ReceiverShardID: receiverShardId,
+		TxCount:         1,
+	}
+}
+
+func TestNewPendingMiniBlocks_ZeroMaxDelayShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pmb, err := pendingMb.NewPendingMiniBlocks(0)
+
+	assert.Nil(t, pmb)
+	assert.Equal(t, process.ErrInvalidMaxPendingMiniBlocksDelay, err)
+}
+
+func TestNewPendingMiniBlocks_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pmb, err := pendingMb.NewPendingMiniBlocks(1)
+
+	assert.NotNil(t, pmb)
+	assert.Nil(t, err)
+	assert.False(t, pmb.IsInterfaceNil())
+}
+
+func TestPendingMiniBlocks_AddCommittedHeadersShouldTrackOnlyCrossShardMiniBlocks(t *testing.T) {
+	t.Parallel()
+
+	pmb, _ := pendingMb.NewPendingMiniBlocks(1)
+	hdr := createShardHeader(0,
+		createMiniBlockHeader("intra", 0, 0),
+		createMiniBlockHeader("cross", 0, 1),
+		createMiniBlockHeader("toMeta", 0, sharding.MetachainShardId),
+	)
+
+	pmb.AddCommittedHeaders(1, []*block.Header{hdr})
+
+	pending := pmb.PendingMiniBlocks()
+	assert.Equal(t, 1, len(pending))
+	assert.Equal(t, []byte("cross"), pending[0].Hash)
+	assert.Equal(t, uint32(0), pending[0].SenderShardId)
+	assert.Equal(t, uint32(1), pending[0].ReceiverShardId)
+	assert.Equal(t, uint64(1), pending[0].NotarizedInMetaNonce)
+}
+
+func TestPendingMiniBlocks_AddCommittedHeadersShouldRemoveProcessedMiniBlocks(t *testing.T) {
+	t.Parallel()
+
+	pmb, _ := pendingMb.NewPendingMiniBlocks(1)
+	pmb.AddCommittedHeaders(1, []*block.Header{createShardHeader(0, createMiniBlockHeader("cross", 0, 1))})
+
+	pmb.AddCommittedHeaders(2, []*block.Header{createShardHeader(1, createMiniBlockHeader("cross", 0, 1))})
+
+	assert.Equal(t, 0, len(pmb.PendingMiniBlocks()))
+}
+
+func TestPendingMiniBlocks_OverdueMiniBlockHashes(t *testing.T) {
+	t.Parallel()
+
+	maxDelay := uint64(2)
+	pmb, _ := pendingMb.NewPendingMiniBlocks(maxDelay)
+	pmb.AddCommittedHeaders(1, []*block.Header{createShardHeader(0, createMiniBlockHeader("cross", 0, 1))})
+	pmb.AddCommittedHeaders(2, nil)
+
+	assert.Equal(t, 0, len(pmb.OverdueMiniBlockHashes(1)))
+
+	pmb.AddCommittedHeaders(3, nil)
+
+	assert.Equal(t, [][]byte{[]byte("cross")}, pmb.OverdueMiniBlockHashes(1))
+	assert.Equal(t, 0, len(pmb.OverdueMiniBlockHashes(0)))
+}
+
+func TestPendingMiniBlocks_RevertHeadersShouldRestorePreviousState(t *testing.T) {
+	t.Parallel()
+
+	pmb, _ := pendingMb.NewPendingMiniBlocks(1)
+	pmb.AddCommittedHeaders(1, []*block.Header{createShardHeader(0, createMiniBlockHeader("mb1", 0, 1))})
+	pmb.AddCommittedHeaders(2, []*block.Header{
+		createShardHeader(1, createMiniBlockHeader("mb1", 0, 1)),
+		createShardHeader(0, createMiniBlockHeader("mb2", 0, 1)),
+	})
+
+	pending := pmb.PendingMiniBlocks()
+	assert.Equal(t, 1, len(pending))
+	assert.Equal(t, []byte("mb2"), pending[0].Hash)
+
+	pmb.RevertHeaders(2)
+
+	pending = pmb.PendingMiniBlocks()
+	assert.Equal(t, 1, len(pending))
+	assert.Equal(t, []byte("mb1"), pending[0].Hash)
+	assert.Equal(t, uint64(1), pending[0].NotarizedInMetaNonce)
+}
+
+func TestProcessedCrossMiniBlocks(t *testing.T) {
+	t.Parallel()
+
+	hdr := createShardHeader(1,
+		createMiniBlockHeader("created", 1, 0),
+		createMiniBlockHeader("processed", 0, 1),
+		createMiniBlockHeader("fromMeta", sharding.MetachainShardId, 1),
+	)
+
+	processed := pendingMb.ProcessedCrossMiniBlocks(hdr)
+
+	assert.Equal(t, 1, len(processed))
+	_, ok := processed["processed"]
+	assert.True(t, ok)
+}
+
+func TestProcessedCrossMiniBlocks_PartiallyExecutedShouldReturnOriginalOnLastPart(t *testing.T) {
+	t.Parallel()
+
+	firstPart := createMiniBlockHeader("part1", 0, 1)
+	firstPart.PartialExecution = &block.PartialExecution{
+		OriginalHash:    []byte("original"),
+		OriginalTxCount: 2,
+		IndexOfFirstTx:  0,
+	}
+	lastPart := createMiniBlockHeader("part2", 0, 1)
+	lastPart.PartialExecution = &block.PartialExecution{
+		OriginalHash:    []byte("original"),
+		OriginalTxCount: 2,
+		IndexOfFirstTx:  1,
+	}
+
+	processed := pendingMb.ProcessedCrossMiniBlocks(createShardHeader(1, firstPart))
+	assert.Equal(t, 0, len(processed))
+
+	processed = pendingMb.ProcessedCrossMiniBlocks(createShardHeader(1, lastPart))
+	assert.Equal(t, 1, len(processed))
+	_, ok := processed["original"]
+	assert.True(t, ok)
+}
@@ -124,6 +124,27 @@ func (bpp *basePreProcess) saveTxsToStorage(
 	return nil
 }
 
+// saveMiniBlockHashByTxHashes records, for each transaction of the miniblock, the hash of the miniblock which included
+// it, so that the inclusion of a transaction can later be looked up
+func (bpp *basePreProcess) saveMiniBlockHashByTxHashes(
+	miniBlock *block.MiniBlock,
+	store dataRetriever.StorageService,
+) error {
+	miniBlockHash, err := core.CalculateHash(bpp.marshalizer, bpp.hasher, miniBlock)
+	if err != nil {
+		return err
+	}
+
+	for _, txHash := range miniBlock.TxHashes {
+		errNotCritical := store.Put(dataRetriever.MiniBlockHashByTxHashUnit, txHash, miniBlockHash)
+		if errNotCritical != nil {
+			log.LogIfError(errNotCritical)
+		}
+	}
+
+	return nil
+}
+
 func (bpp *basePreProcess) baseReceivedTransaction(
 	txHash []byte,
 	forBlock *txsForBlock,
@@ -135,7 +156,7 @@ func (bpp *basePreProcess) baseReceivedTransaction(
 		txInfoForHash := forBlock.txHashAndInfo[string(txHash)]
 		if txInfoForHash != nil && txInfoForHash.txShardInfo != nil &&
 			(txInfoForHash.tx == nil || txInfoForHash.tx.IsInterfaceNil()) {
-			tx, _ := process.GetTransactionHandlerFromPool(
+			tx, _ := getTransactionFromPool(
 				txInfoForHash.senderShardID,
 				txInfoForHash.receiverShardID,
 				txHash,
@@ -178,7 +199,7 @@ func (bpp *basePreProcess) computeExistingAndMissing(
 
 		for j := 0; j < len(miniBlock.TxHashes); j++ {
 			txHash := miniBlock.TxHashes[j]
-			tx, err := process.GetTransactionHandlerFromPool(
+			tx, err := getTransactionFromPool(
 				miniBlock.SenderShardID,
 				miniBlock.ReceiverShardID,
 				txHash,
@@ -210,3 +231,30 @@ func (bpp *basePreProcess) isTxAlreadyProcessed(txHash []byte, forBlock *txsForB
 
 	return txAlreadyProcessed
 }
+
+// getTransactionFromPool returns the transaction from the pool of the given sender and receiver shards. The invalid
+// miniblocks are recorded in the sender shard only, so the transactions of an intra shard miniblock are also searched
+// in the pools of the other receiver shards
+func getTransactionFromPool(
+	senderShardID uint32,
+	receiverShardID uint32,
+	txHash []byte,
+	txPool dataRetriever.ShardedDataCacherNotifier,
+) (data.TransactionHandler, error) {
+	tx, err := process.GetTransactionHandlerFromPool(senderShardID, receiverShardID, txHash, txPool)
+	if err == nil || err == process.ErrNilShardedDataCacherNotifier || senderShardID != receiverShardID {
+		return tx, err
+	}
+
+	val, ok := txPool.SearchFirstData(txHash)
+	if !ok {
+		return nil, process.ErrTxNotFound
+	}
+
+	tx, ok = val.(data.TransactionHandler)
+	if !ok {
+		return nil, process.ErrInvalidTxInPool
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,151 @@
+package preprocess
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type gasConsumed struct {
+	moveBalance uint64
+	scExecution uint64
+}
+
+type gasComputation struct {
+	economicsFee process.FeeHandler
+	gasLimits    process.BlockGasLimitsHandler
+
+	mutGasConsumed      sync.RWMutex
+	gasConsumedInBlock  gasConsumed
+	gasConsumedPerShard map[uint32]*gasConsumed
+}
+
+// NewGasComputation creates a new object which computes and accumulates the gas consumed by the transactions
+// of a block, separately for move balance transactions and smart contract executions
+func NewGasComputation(
+	economicsFee process.FeeHandler,
+	gasLimits process.BlockGasLimitsHandler,
+) (*gasComputation, error) {
+
+	if economicsFee == nil || economicsFee.IsInterfaceNil() {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+	if gasLimits == nil || gasLimits.IsInterfaceNil() {
+		return nil, process.ErrNilBlockGasLimitsHandler
+	}
+
+	gc := &gasComputation{
+		economicsFee: economicsFee,
+		gasLimits:    gasLimits,
+	}
+	gc.gasConsumedPerShard = make(map[uint32]*gasConsumed)
+
+	return gc, nil
+}
+
+// Init resets the accumulated gas, it should be called each time a block starts to be created or processed
+func (gc *gasComputation) Init() {
+	gc.mutGasConsumed.Lock()
+	gc.gasConsumedInBlock = gasConsumed{}
+	gc.gasConsumedPerShard = make(map[uint32]*gasConsumed)
+	gc.mutGasConsumed.Unlock()
+}
+
+// ComputeGasConsumedByTx returns the gas consumed by the given transaction as the move balance gas and the smart
+// contract execution gas. For smart contract executions the whole provided gas limit is taken into account
+func (gc *gasComputation) ComputeGasConsumedByTx(tx data.TransactionHandler) (uint64, uint64) {
+	if isSmartContractAddress(tx.GetRecvAddress()) {
+		return 0, tx.GetGasLimit()
+	}
+
+	return gc.economicsFee.ComputeGasLimit(tx), 0
+}
+
+// ComputeGasConsumedByMiniBlock returns the move balance gas and the smart contract execution gas consumed
+// by all the transactions of the given miniblock
+func (gc *gasComputation) ComputeGasConsumedByMiniBlock(
+	miniBlock *block.MiniBlock,
+	mapHashTx map[string]data.TransactionHandler,
+) (uint64, uint64, error) {
+
+	if miniBlock == nil {
+		return 0, 0, process.ErrNilMiniBlocks
+	}
+
+	moveBalanceGas := uint64(0)
+	scExecutionGas := uint64(0)
+	for _, txHash := range miniBlock.TxHashes {
+		tx, ok := mapHashTx[string(txHash)]
+		if !ok || tx == nil || tx.IsInterfaceNil() {
+			return 0, 0, process.ErrMissingTransaction
+		}
+
+		txMoveBalanceGas, txSCExecutionGas := gc.ComputeGasConsumedByTx(tx)
+		moveBalanceGas += txMoveBalanceGas
+		scExecutionGas += txSCExecutionGas
+	}
+
+	return moveBalanceGas, scExecutionGas, nil
+}
+
+// IsMaxGasLimitReached returns true if adding the given gas for the given destination shard would exceed any of
+// the block limits
+func (gc *gasComputation) IsMaxGasLimitReached(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64) bool {
+	gc.mutGasConsumed.RLock()
+	defer gc.mutGasConsumed.RUnlock()
+
+	if gc.gasConsumedInBlock.moveBalance+moveBalanceGas > gc.gasLimits.MaxMoveBalanceGasPerBlock() {
+		return true
+	}
+	if gc.gasConsumedInBlock.scExecution+scExecutionGas > gc.gasLimits.MaxSCExecutionGasPerBlock() {
+		return true
+	}
+
+	consumedInShard := gasConsumed{}
+	if gc.gasConsumedPerShard[dstShardId] != nil {
+		consumedInShard = *gc.gasConsumedPerShard[dstShardId]
+	}
+	if consumedInShard.moveBalance+moveBalanceGas > gc.gasLimits.MaxMoveBalanceGasPerShard() {
+		return true
+	}
+	if consumedInShard.scExecution+scExecutionGas > gc.gasLimits.MaxSCExecutionGasPerShard() {
+		return true
+	}
+
+	return false
+}
+
+// AddGasConsumed accumulates the given gas, both on the block and on the given destination shard
+func (gc *gasComputation) AddGasConsumed(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64) {
+	gc.mutGasConsumed.Lock()
+	defer gc.mutGasConsumed.Unlock()
+
+	gc.gasConsumedInBlock.moveBalance += moveBalanceGas
+	gc.gasConsumedInBlock.scExecution += scExecutionGas
+
+	consumedInShard, ok := gc.gasConsumedPerShard[dstShardId]
+	if !ok {
+		consumedInShard = &gasConsumed{}
+		gc.gasConsumedPerShard[dstShardId] = consumedInShard
+	}
+	consumedInShard.moveBalance += moveBalanceGas
+	consumedInShard.scExecution += scExecutionGas
+}
+
+// GasConsumed returns the move balance gas and the smart contract execution gas accumulated in the current block
+func (gc *gasComputation) GasConsumed() (uint64, uint64) {
+	gc.mutGasConsumed.RLock()
+	defer gc.mutGasConsumed.RUnlock()
+
+	return gc.gasConsumedInBlock.moveBalance, gc.gasConsumedInBlock.scExecution
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gc *gasComputation) IsInterfaceNil() bool {
+	if gc == nil {
+		return true
+	}
+	return false
+}
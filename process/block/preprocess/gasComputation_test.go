@@ -0,0 +1,163 @@
+package preprocess
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createGasLimitsStub(perBlock uint64, perShard uint64) *mock.BlockGasLimitsHandlerStub {
+	return &mock.BlockGasLimitsHandlerStub{
+		MaxMoveBalanceGasPerBlockCalled: func() uint64 {
+			return perBlock
+		},
+		MaxSCExecutionGasPerBlockCalled: func() uint64 {
+			return perBlock
+		},
+		MaxMoveBalanceGasPerShardCalled: func() uint64 {
+			return perShard
+		},
+		MaxSCExecutionGasPerShardCalled: func() uint64 {
+			return perShard
+		},
+	}
+}
+
+func createFeeHandlerForGasComputation() *mock.FeeHandlerStub {
+	return &mock.FeeHandlerStub{
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return 5
+		},
+	}
+}
+
+func TestNewGasComputation_NilEconomicsFeeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gc, err := NewGasComputation(nil, createGasLimitsStub(100, 50))
+
+	assert.Nil(t, gc)
+	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+}
+
+func TestNewGasComputation_NilGasLimitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gc, err := NewGasComputation(createFeeHandlerForGasComputation(), nil)
+
+	assert.Nil(t, gc)
+	assert.Equal(t, process.ErrNilBlockGasLimitsHandler, err)
+}
+
+func TestNewGasComputation_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gc, err := NewGasComputation(createFeeHandlerForGasComputation(), createGasLimitsStub(100, 50))
+
+	assert.NotNil(t, gc)
+	assert.Nil(t, err)
+}
+
+func TestGasComputation_ComputeGasConsumedByTx(t *testing.T) {
+	t.Parallel()
+
+	gc, _ := NewGasComputation(createFeeHandlerForGasComputation(), createGasLimitsStub(100, 50))
+
+	moveBalanceGas, scExecutionGas := gc.ComputeGasConsumedByTx(&transaction.Transaction{
+		GasLimit: 20,
+		RcvAddr:  []byte("012345678910"),
+	})
+	assert.Equal(t, uint64(5), moveBalanceGas)
+	assert.Equal(t, uint64(0), scExecutionGas)
+
+	scAddress, _ := hex.DecodeString("000000000000000000005fed9c659422cd8429ce92f8973bba2a9fb51e0eb3a1")
+	moveBalanceGas, scExecutionGas = gc.ComputeGasConsumedByTx(&transaction.Transaction{
+		GasLimit: 20,
+		RcvAddr:  scAddress,
+	})
+	assert.Equal(t, uint64(0), moveBalanceGas)
+	assert.Equal(t, uint64(20), scExecutionGas)
+}
+
+func TestGasComputation_ComputeGasConsumedByMiniBlockMissingTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gc, _ := NewGasComputation(createFeeHandlerForGasComputation(), createGasLimitsStub(100, 50))
+
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("hash1")}}
+	_, _, err := gc.ComputeGasConsumedByMiniBlock(miniBlock, make(map[string]data.TransactionHandler))
+
+	assert.Equal(t, process.ErrMissingTransaction, err)
+}
+
+func TestGasComputation_ComputeGasConsumedByMiniBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gc, _ := NewGasComputation(createFeeHandlerForGasComputation(), createGasLimitsStub(100, 50))
+
+	scAddress, _ := hex.DecodeString("000000000000000000005fed9c659422cd8429ce92f8973bba2a9fb51e0eb3a1")
+	mapHashTx := map[string]data.TransactionHandler{
+		"hash1": &transaction.Transaction{GasLimit: 20, RcvAddr: []byte("012345678910")},
+		"hash2": &transaction.Transaction{GasLimit: 20, RcvAddr: scAddress},
+		"hash3": &transaction.Transaction{GasLimit: 30, RcvAddr: scAddress},
+	}
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("hash1"), []byte("hash2"), []byte("hash3")}}
+
+	moveBalanceGas, scExecutionGas, err := gc.ComputeGasConsumedByMiniBlock(miniBlock, mapHashTx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), moveBalanceGas)
+	assert.Equal(t, uint64(50), scExecutionGas)
+}
+
+func TestGasComputation_IsMaxGasLimitReachedPerShard(t *testing.T) {
+	t.Parallel()
+
+	gc, _ := NewGasComputation(createFeeHandlerForGasComputation(), createGasLimitsStub(100, 50))
+
+	assert.False(t, gc.IsMaxGasLimitReached(0, 50, 50))
+	assert.True(t, gc.IsMaxGasLimitReached(0, 51, 0))
+	assert.True(t, gc.IsMaxGasLimitReached(0, 0, 51))
+
+	gc.AddGasConsumed(0, 40, 0)
+	assert.True(t, gc.IsMaxGasLimitReached(0, 11, 0))
+	assert.False(t, gc.IsMaxGasLimitReached(1, 11, 0))
+}
+
+func TestGasComputation_IsMaxGasLimitReachedPerBlock(t *testing.T) {
+	t.Parallel()
+
+	gc, _ := NewGasComputation(createFeeHandlerForGasComputation(), createGasLimitsStub(100, 50))
+
+	gc.AddGasConsumed(0, 50, 10)
+	gc.AddGasConsumed(1, 40, 10)
+
+	assert.False(t, gc.IsMaxGasLimitReached(2, 10, 50))
+	assert.True(t, gc.IsMaxGasLimitReached(2, 11, 0))
+
+	moveBalanceGas, scExecutionGas := gc.GasConsumed()
+	assert.Equal(t, uint64(90), moveBalanceGas)
+	assert.Equal(t, uint64(20), scExecutionGas)
+}
+
+func TestGasComputation_InitShouldResetGasConsumed(t *testing.T) {
+	t.Parallel()
+
+	gc, _ := NewGasComputation(createFeeHandlerForGasComputation(), createGasLimitsStub(100, 50))
+
+	gc.AddGasConsumed(0, 50, 50)
+	assert.True(t, gc.IsMaxGasLimitReached(0, 1, 0))
+
+	gc.Init()
+
+	moveBalanceGas, scExecutionGas := gc.GasConsumed()
+	assert.Equal(t, uint64(0), moveBalanceGas)
+	assert.Equal(t, uint64(0), scExecutionGas)
+	assert.False(t, gc.IsMaxGasLimitReached(0, 1, 0))
+}
@@ -30,11 +30,16 @@ type rewardsHandler struct {
 	protocolRewardsMeta []data.TransactionHandler
 	feeRewards          []data.TransactionHandler
 
+	protocolSustainabilityRewards []data.TransactionHandler
+
 	mut               sync.Mutex
 	accumulatedFees   *big.Int
+	burnedFees        *big.Int
 	rewardTxsForBlock map[string]*rewardTx.RewardTx
 	economicsRewards  process.RewardsHandler
 	rewardValue       *big.Int
+
+	protocolSustainabilityValue *big.Int
 }
 
 // NewRewardTxHandler constructor for the reward transaction handler
@@ -73,7 +78,16 @@ func NewRewardTxHandler(
 		return nil, process.ErrNilEconomicsRewardsHandler
 	}
 
-	rewardValue := economicsRewards.RewardsValue()
+	// out of every protocol reward, the protocol sustainability percentage is routed to the protocol sustainability
+	// address, the consensus members receiving the rest
+	rewardValue := big.NewInt(0)
+	protocolSustainabilityValue := big.NewInt(0)
+	if economicsRewards.RewardsValue() != nil {
+		protocolSustainabilityValue = getPercentageOfValue(
+			economicsRewards.RewardsValue(),
+			economicsRewards.ProtocolSustainabilityPercentage())
+		rewardValue.Sub(economicsRewards.RewardsValue(), protocolSustainabilityValue)
+	}
 
 	rtxh := &rewardsHandler{
 		address:          address,
@@ -85,9 +99,12 @@ func NewRewardTxHandler(
 		rewardTxPool:     rewardTxPool,
 		economicsRewards: economicsRewards,
 		rewardValue:      rewardValue,
+
+		protocolSustainabilityValue: protocolSustainabilityValue,
 	}
 
 	rtxh.accumulatedFees = big.NewInt(0)
+	rtxh.burnedFees = big.NewInt(0)
 	rtxh.rewardTxsForBlock = make(map[string]*rewardTx.RewardTx)
 
 	return rtxh, nil
@@ -152,9 +169,14 @@ func (rtxh *rewardsHandler) CreateAllInterMiniBlocks() map[uint32]*block.MiniBlo
 	rtxh.protocolRewardsMeta = rtxh.createProtocolRewardsForMeta()
 	rtxh.addTransactionsToPool(rtxh.protocolRewardsMeta)
 
+	rtxh.protocolSustainabilityRewards = rtxh.createProtocolSustainabilityRewards(
+		len(rtxh.protocolRewards) + len(rtxh.protocolRewardsMeta))
+	rtxh.addTransactionsToPool(rtxh.protocolSustainabilityRewards)
+
 	calculatedRewardTxs := make([]data.TransactionHandler, 0)
 	calculatedRewardTxs = append(calculatedRewardTxs, rtxh.protocolRewards...)
 	calculatedRewardTxs = append(calculatedRewardTxs, rtxh.protocolRewardsMeta...)
+	calculatedRewardTxs = append(calculatedRewardTxs, rtxh.protocolSustainabilityRewards...)
 	calculatedRewardTxs = append(calculatedRewardTxs, rtxh.feeRewards...)
 
 	rtxh.mutGenRewardTxs.Unlock()
@@ -266,6 +288,7 @@ func (rtxh *rewardsHandler) ProcessTransactionFee(cost *big.Int) {
 func (rtxh *rewardsHandler) cleanCachedData() {
 	rtxh.mut.Lock()
 	rtxh.accumulatedFees = big.NewInt(0)
+	rtxh.burnedFees = big.NewInt(0)
 	rtxh.rewardTxsForBlock = make(map[string]*rewardTx.RewardTx)
 	rtxh.mut.Unlock()
 
@@ -273,6 +296,7 @@ func (rtxh *rewardsHandler) cleanCachedData() {
 	rtxh.feeRewards = make([]data.TransactionHandler, 0)
 	rtxh.protocolRewards = make([]data.TransactionHandler, 0)
 	rtxh.protocolRewardsMeta = make([]data.TransactionHandler, 0)
+	rtxh.protocolSustainabilityRewards = make([]data.TransactionHandler, 0)
 	rtxh.mutGenRewardTxs.Unlock()
 }
 
@@ -326,11 +350,13 @@ func (rtxh *rewardsHandler) createCommunityTx() *rewardTx.RewardTx {
 
 // createRewardFromFees creates the reward transactions from accumulated fees
 // According to economic paper, out of the block fees 40% are burned, 50% go to the
-// leader and 10% go to Elrond community fund.
+// leader and 10% go to Elrond community fund. Once the fee burning is enabled, the burned
+// part is destroyed instead of being sent to the burn address
 func (rtxh *rewardsHandler) createRewardFromFees() []data.TransactionHandler {
 	rtxh.mut.Lock()
 	defer rtxh.mut.Unlock()
 
+	rtxh.burnedFees = big.NewInt(0)
 	if rtxh.accumulatedFees.Cmp(big.NewInt(1)) < 0 {
 		rtxh.accumulatedFees = big.NewInt(0)
 		return nil
@@ -338,14 +364,43 @@ func (rtxh *rewardsHandler) createRewardFromFees() []data.TransactionHandler {
 
 	leaderTx := rtxh.createLeaderTx()
 	communityTx := rtxh.createCommunityTx()
-	burnTx := rtxh.createBurnTx()
 
 	currFeeTxs := make([]data.TransactionHandler, 0)
-	currFeeTxs = append(currFeeTxs, leaderTx, communityTx, burnTx)
+	currFeeTxs = append(currFeeTxs, leaderTx, communityTx)
+
+	if rtxh.IsFeeBurnEnabled() {
+		rtxh.burnedFees = getPercentageOfValue(rtxh.accumulatedFees, rtxh.economicsRewards.BurnPercentage())
+		return currFeeTxs
+	}
+
+	burnTx := rtxh.createBurnTx()
+	currFeeTxs = append(currFeeTxs, burnTx)
 
 	return currFeeTxs
 }
 
+// IsFeeBurnEnabled returns true if the burn percentage of the fees of the current block is destroyed
+func (rtxh *rewardsHandler) IsFeeBurnEnabled() bool {
+	return rtxh.address.Epoch() >= rtxh.economicsRewards.FeeBurnEnableEpoch()
+}
+
+// GetAccumulatedFees returns the fees accumulated by the current block
+func (rtxh *rewardsHandler) GetAccumulatedFees() *big.Int {
+	rtxh.mut.Lock()
+	defer rtxh.mut.Unlock()
+
+	return big.NewInt(0).Set(rtxh.accumulatedFees)
+}
+
+// GetBurnedFees returns the part of the fees of the current block which was destroyed when the fee rewards were
+// created
+func (rtxh *rewardsHandler) GetBurnedFees() *big.Int {
+	rtxh.mut.Lock()
+	defer rtxh.mut.Unlock()
+
+	return big.NewInt(0).Set(rtxh.burnedFees)
+}
+
 // createProtocolRewards creates the protocol reward transactions
 func (rtxh *rewardsHandler) createProtocolRewards() []data.TransactionHandler {
 	consensusRewardData := rtxh.address.ConsensusShardRewardData()
@@ -396,12 +451,35 @@ func (rtxh *rewardsHandler) createProtocolRewardsForMeta() []data.TransactionHan
 	return consensusRewardTxs
 }
 
+// createProtocolSustainabilityRewards creates the reward transaction which routes to the protocol sustainability
+// address its percentage out of the given number of protocol rewards
+func (rtxh *rewardsHandler) createProtocolSustainabilityRewards(numProtocolRewards int) []data.TransactionHandler {
+	protocolSustainabilityRewards := make([]data.TransactionHandler, 0)
+
+	totalValue := big.NewInt(0).Mul(rtxh.protocolSustainabilityValue, big.NewInt(int64(numProtocolRewards)))
+	if totalValue.Cmp(big.NewInt(1)) < 0 {
+		return protocolSustainabilityRewards
+	}
+
+	rTx := &rewardTx.RewardTx{}
+	rTx.Value = totalValue
+	rTx.RcvAddr = rtxh.address.ProtocolSustainabilityAddress()
+	rTx.ShardId = rtxh.shardCoordinator.SelfId()
+	rTx.Epoch = rtxh.address.Epoch()
+	rTx.Round = rtxh.address.Round()
+
+	protocolSustainabilityRewards = append(protocolSustainabilityRewards, rTx)
+
+	return protocolSustainabilityRewards
+}
+
 // verifyCreatedRewardsTxs verifies if the calculated rewards transactions and the block reward transactions are the same
 func (rtxh *rewardsHandler) verifyCreatedRewardsTxs() error {
 	calculatedRewardTxs := make([]data.TransactionHandler, 0)
 	rtxh.mutGenRewardTxs.RLock()
 	calculatedRewardTxs = append(calculatedRewardTxs, rtxh.protocolRewards...)
 	calculatedRewardTxs = append(calculatedRewardTxs, rtxh.protocolRewardsMeta...)
+	calculatedRewardTxs = append(calculatedRewardTxs, rtxh.protocolSustainabilityRewards...)
 	calculatedRewardTxs = append(calculatedRewardTxs, rtxh.feeRewards...)
 	rtxh.mutGenRewardTxs.RUnlock()
 
@@ -295,6 +295,70 @@ func TestRewardsHandler_CreateRewardsFromFees(t *testing.T) {
 	assert.Equal(t, currTxFee.Uint64(), totalSum)
 }
 
+func TestRewardsHandler_CreateRewardsFromFeesWithFeeBurnEnabledShouldDestroyTheBurnPercentage(t *testing.T) {
+	t.Parallel()
+
+	rewardsHandler := RewandsHandlerMock()
+	rewardsHandler.FeeBurnEnableEpochCalled = func() uint32 {
+		return 0
+	}
+
+	tdp := initDataPool()
+	th, _ := NewRewardTxHandler(
+		&mock.SpecialAddressHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AddressConverterMock{},
+		&mock.ChainStorerMock{},
+		tdp.RewardTransactions(),
+		rewardsHandler,
+	)
+
+	currTxFee := big.NewInt(50)
+	th.ProcessTransactionFee(currTxFee)
+
+	txs := th.createRewardFromFees()
+	assert.Equal(t, 2, len(txs))
+	assert.Equal(t, big.NewInt(25), txs[0].GetValue())
+	assert.Equal(t, big.NewInt(5), txs[1].GetValue())
+	assert.True(t, th.IsFeeBurnEnabled())
+	assert.Equal(t, currTxFee, th.GetAccumulatedFees())
+	assert.Equal(t, big.NewInt(20), th.GetBurnedFees())
+
+	th.CreateBlockStarted()
+	assert.Equal(t, big.NewInt(0), th.GetAccumulatedFees())
+	assert.Equal(t, big.NewInt(0), th.GetBurnedFees())
+}
+
+func TestRewardsHandler_CreateRewardsFromFeesBeforeFeeBurnEnableEpochShouldNotDestroyFees(t *testing.T) {
+	t.Parallel()
+
+	rewardsHandler := RewandsHandlerMock()
+	rewardsHandler.FeeBurnEnableEpochCalled = func() uint32 {
+		return 1
+	}
+
+	tdp := initDataPool()
+	th, _ := NewRewardTxHandler(
+		&mock.SpecialAddressHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AddressConverterMock{},
+		&mock.ChainStorerMock{},
+		tdp.RewardTransactions(),
+		rewardsHandler,
+	)
+
+	th.ProcessTransactionFee(big.NewInt(50))
+
+	txs := th.createRewardFromFees()
+	assert.Equal(t, 3, len(txs))
+	assert.False(t, th.IsFeeBurnEnabled())
+	assert.Equal(t, big.NewInt(0), th.GetBurnedFees())
+}
+
 func TestRewardsHandler_VerifyCreatedRewardsTxsRewardTxNotFound(t *testing.T) {
 	t.Parallel()
 
@@ -611,3 +675,80 @@ func TestRewardsHandler_SaveCurrentIntermediateTxToStorageShouldWork(t *testing.
 	assert.Nil(t, err)
 	assert.True(t, putWasCalled)
 }
+
+func TestNewRewardTxHandler_ShouldDeductProtocolSustainabilityFromRewardValue(t *testing.T) {
+	t.Parallel()
+
+	rewardsHandler := RewandsHandlerMock()
+	rewardsHandler.ProtocolSustainabilityPercentageCalled = func() float64 {
+		return 0.10
+	}
+
+	tdp := initDataPool()
+	th, _ := NewRewardTxHandler(
+		&mock.SpecialAddressHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AddressConverterMock{},
+		&mock.ChainStorerMock{},
+		tdp.RewardTransactions(),
+		rewardsHandler,
+	)
+
+	assert.Equal(t, big.NewInt(900), th.rewardValue)
+	assert.Equal(t, big.NewInt(100), th.protocolSustainabilityValue)
+}
+
+func TestRewardsHandler_CreateProtocolSustainabilityRewardsShouldAccumulateForAllProtocolRewards(t *testing.T) {
+	t.Parallel()
+
+	rewardsHandler := RewandsHandlerMock()
+	rewardsHandler.ProtocolSustainabilityPercentageCalled = func() float64 {
+		return 0.10
+	}
+
+	protocolSustainabilityAddress := []byte("protocol sustainability address")
+	tdp := initDataPool()
+	th, _ := NewRewardTxHandler(
+		&mock.SpecialAddressHandlerMock{
+			ProtocolSustainabilityAddressCalled: func() []byte {
+				return protocolSustainabilityAddress
+			},
+		},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AddressConverterMock{},
+		&mock.ChainStorerMock{},
+		tdp.RewardTransactions(),
+		rewardsHandler,
+	)
+
+	rewards := th.createProtocolSustainabilityRewards(3)
+
+	assert.Equal(t, 1, len(rewards))
+	assert.Equal(t, big.NewInt(300), rewards[0].GetValue())
+	assert.Equal(t, protocolSustainabilityAddress, rewards[0].GetRecvAddress())
+}
+
+func TestRewardsHandler_CreateProtocolSustainabilityRewardsZeroPercentageShouldNotCreate(t *testing.T) {
+	t.Parallel()
+
+	tdp := initDataPool()
+	th, _ := NewRewardTxHandler(
+		&mock.SpecialAddressHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AddressConverterMock{},
+		&mock.ChainStorerMock{},
+		tdp.RewardTransactions(),
+		RewandsHandlerMock(),
+	)
+
+	rewards := th.createProtocolSustainabilityRewards(3)
+
+	assert.Equal(t, 0, len(rewards))
+	assert.Equal(t, big.NewInt(1000), th.rewardValue)
+}
@@ -22,7 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("process/block/preprocess")
 
 // TODO: increase code coverage with unit tests
 
@@ -40,6 +40,11 @@ type transactions struct {
 	mutOrderedTxs        sync.RWMutex
 	economicsFee         process.FeeHandler
 	miniBlocksCompacter  process.MiniBlocksCompacter
+	gasHandler           process.GasHandler
+
+	mutFailedTxs          sync.RWMutex
+	invalidTxHashes       [][]byte
+	accountsWithFailedTxs map[string]struct{}
 }
 
 // NewTransactionPreprocessor creates a new transaction preprocessor object
@@ -54,6 +59,7 @@ func NewTransactionPreprocessor(
 	onRequestTransaction func(shardID uint32, txHashes [][]byte),
 	economicsFee process.FeeHandler,
 	miniBlocksCompacter process.MiniBlocksCompacter,
+	gasHandler process.GasHandler,
 ) (*transactions, error) {
 
 	if hasher == nil || hasher.IsInterfaceNil() {
@@ -86,6 +92,9 @@ func NewTransactionPreprocessor(
 	if miniBlocksCompacter == nil || miniBlocksCompacter.IsInterfaceNil() {
 		return nil, process.ErrNilMiniBlocksCompacter
 	}
+	if gasHandler == nil || gasHandler.IsInterfaceNil() {
+		return nil, process.ErrNilGasHandler
+	}
 
 	bpp := basePreProcess{
 		hasher:           hasher,
@@ -102,6 +111,7 @@ func NewTransactionPreprocessor(
 		accounts:             accounts,
 		economicsFee:         economicsFee,
 		miniBlocksCompacter:  miniBlocksCompacter,
+		gasHandler:           gasHandler,
 	}
 
 	txs.chRcvAllTxs = make(chan bool)
@@ -110,6 +120,8 @@ func NewTransactionPreprocessor(
 	txs.txsForCurrBlock.txHashAndInfo = make(map[string]*txInfo)
 	txs.orderedTxs = make(map[string][]*transaction.Transaction)
 	txs.orderedTxHashes = make(map[string][][]byte)
+	txs.invalidTxHashes = make([][]byte, 0)
+	txs.accountsWithFailedTxs = make(map[string]struct{})
 
 	return &txs, nil
 }
@@ -152,8 +164,35 @@ func (txs *transactions) RemoveTxBlockFromPools(body block.Body, miniBlockPool s
 	}
 
 	err := txs.removeDataFromPools(body, miniBlockPool, txs.txPool, block.TxBlock)
+	if err != nil {
+		return err
+	}
 
-	return err
+	return txs.removeInvalidTxsFromPools(body, miniBlockPool)
+}
+
+// removeInvalidTxsFromPools removes the invalid miniblocks and their transactions from pools. The transactions are
+// removed from all the shard stores as an invalid miniblock does not hold their receiver shards
+func (txs *transactions) removeInvalidTxsFromPools(body block.Body, miniBlockPool storage.Cacher) error {
+	for i := 0; i < len(body); i++ {
+		miniBlock := body[i]
+		if miniBlock.Type != block.InvalidBlock {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			txs.txPool.RemoveDataFromAllShards(txHash)
+		}
+
+		miniBlockHash, err := core.CalculateHash(txs.marshalizer, txs.hasher, miniBlock)
+		if err != nil {
+			return err
+		}
+
+		miniBlockPool.Remove(miniBlockHash)
+	}
+
+	return nil
 }
 
 // RestoreTxBlockIntoPools restores the transactions and miniblocks to associated pools
@@ -178,7 +217,12 @@ func (txs *transactions) RestoreTxBlockIntoPools(
 				return txsRestored, err
 			}
 
-			txs.txPool.AddData([]byte(txHash), &tx, strCache)
+			txCache := strCache
+			if miniBlock.Type == block.InvalidBlock {
+				txCache = txs.computeInvalidTxCacheIdentifier(&tx)
+			}
+
+			txs.txPool.AddData([]byte(txHash), &tx, txCache)
 
 			err = txs.storage.GetStorer(dataRetriever.TransactionUnit).Remove([]byte(txHash))
 			if err != nil {
@@ -204,10 +248,19 @@ func (txs *transactions) RestoreTxBlockIntoPools(
 	return txsRestored, nil
 }
 
+// computeInvalidTxCacheIdentifier returns the pool in which an invalid transaction was received, computed from its
+// receiver shard as the invalid miniblock is an intra shard one
+func (txs *transactions) computeInvalidTxCacheIdentifier(tx *transaction.Transaction) string {
+	receiverShardID := txs.shardCoordinator.ComputeId(state.NewAddress(tx.RcvAddr))
+
+	return process.ShardCacherIdentifier(txs.shardCoordinator.SelfId(), receiverShardID)
+}
+
 // ProcessBlockTransactions processes all the transaction from the block.Body, updates the state
 func (txs *transactions) ProcessBlockTransactions(body block.Body, round uint64, haveTime func() bool) error {
 	mapHashesAndTxs := txs.GetAllCurrentUsedTxs()
-	expandedMiniBlocks, err := txs.miniBlocksCompacter.Expand(block.MiniBlockSlice(body), mapHashesAndTxs)
+	txMiniBlocks, invalidMiniBlocks := separateInvalidMiniBlocks(body)
+	expandedMiniBlocks, err := txs.miniBlocksCompacter.Expand(txMiniBlocks, mapHashesAndTxs)
 	if err != nil {
 		return err
 	}
@@ -219,38 +272,133 @@ func (txs *transactions) ProcessBlockTransactions(body block.Body, round uint64,
 			continue
 		}
 
+		err = txs.consumeGasForMiniBlock(miniBlock, mapHashesAndTxs)
+		if err != nil {
+			return err
+		}
+
 		for j := 0; j < len(miniBlock.TxHashes); j++ {
 			if !haveTime() {
 				return process.ErrTimeIsOut
 			}
 
 			txHash := miniBlock.TxHashes[j]
-			txs.txsForCurrBlock.mutTxsForBlock.RLock()
-			txInfo := txs.txsForCurrBlock.txHashAndInfo[string(txHash)]
-			txs.txsForCurrBlock.mutTxsForBlock.RUnlock()
+			currTx, err := txs.getTransactionForCurrentBlock(txHash)
+			if err != nil {
+				return err
+			}
 
-			if txInfo == nil || txInfo.tx == nil {
-				return process.ErrMissingTransaction
+			err = txs.processAndRemoveBadTransaction(
+				txHash,
+				currTx,
+				round,
+				miniBlock.SenderShardID,
+				miniBlock.ReceiverShardID,
+			)
+
+			if err != nil {
+				return err
 			}
+		}
+	}
+
+	return txs.processInvalidMiniBlocks(invalidMiniBlocks, round, haveTime)
+}
 
-			currTx, ok := txInfo.tx.(*transaction.Transaction)
-			if !ok {
-				return process.ErrWrongTypeAssertion
+// processInvalidMiniBlocks processes the transactions recorded in the invalid miniblocks. They are processed after
+// all the other transactions of the block and have to fail as they did when the block was created, consuming only
+// their fees
+func (txs *transactions) processInvalidMiniBlocks(
+	miniBlocks block.MiniBlockSlice,
+	round uint64,
+	haveTime func() bool,
+) error {
+	selfID := txs.shardCoordinator.SelfId()
+	for _, miniBlock := range miniBlocks {
+		if miniBlock.SenderShardID != selfID || miniBlock.ReceiverShardID != selfID {
+			return process.ErrInvalidShardId
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			if !haveTime() {
+				return process.ErrTimeIsOut
+			}
+
+			currTx, err := txs.getTransactionForCurrentBlock(txHash)
+			if err != nil {
+				return err
 			}
 
-			err := txs.processAndRemoveBadTransaction(
+			err = txs.processAndRemoveBadTransaction(
 				txHash,
 				currTx,
 				round,
 				miniBlock.SenderShardID,
 				miniBlock.ReceiverShardID,
 			)
-
-			if err != nil {
+			if err == nil {
+				return process.ErrTransactionNotFailed
+			}
+			if err != process.ErrFailedTransaction {
 				return err
 			}
 		}
 	}
+
+	return nil
+}
+
+func (txs *transactions) getTransactionForCurrentBlock(txHash []byte) (*transaction.Transaction, error) {
+	txs.txsForCurrBlock.mutTxsForBlock.RLock()
+	txInfo := txs.txsForCurrBlock.txHashAndInfo[string(txHash)]
+	txs.txsForCurrBlock.mutTxsForBlock.RUnlock()
+
+	if txInfo == nil || txInfo.tx == nil {
+		return nil, process.ErrMissingTransaction
+	}
+
+	currTx, ok := txInfo.tx.(*transaction.Transaction)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return currTx, nil
+}
+
+// separateInvalidMiniBlocks splits the body in the transaction miniblocks and the invalid miniblocks
+func separateInvalidMiniBlocks(body block.Body) (block.MiniBlockSlice, block.MiniBlockSlice) {
+	txMiniBlocks := make(block.MiniBlockSlice, 0, len(body))
+	invalidMiniBlocks := make(block.MiniBlockSlice, 0)
+	for _, miniBlock := range body {
+		if miniBlock.Type == block.InvalidBlock {
+			invalidMiniBlocks = append(invalidMiniBlocks, miniBlock)
+			continue
+		}
+
+		txMiniBlocks = append(txMiniBlocks, miniBlock)
+	}
+
+	return txMiniBlocks, invalidMiniBlocks
+}
+
+// consumeGasForMiniBlock accumulates the gas consumed by the given miniblock, returning error if the block limits
+// would be exceeded
+func (txs *transactions) consumeGasForMiniBlock(
+	miniBlock *block.MiniBlock,
+	mapHashesAndTxs map[string]data.TransactionHandler,
+) error {
+	moveBalanceGas, scExecutionGas, err := txs.gasHandler.ComputeGasConsumedByMiniBlock(miniBlock, mapHashesAndTxs)
+	if err != nil {
+		return err
+	}
+
+	isGasLimitReached := txs.gasHandler.IsMaxGasLimitReached(miniBlock.ReceiverShardID, moveBalanceGas, scExecutionGas)
+	if isGasLimitReached {
+		return process.ErrMaxGasLimitPerBlockReached
+	}
+
+	txs.gasHandler.AddGasConsumed(miniBlock.ReceiverShardID, moveBalanceGas, scExecutionGas)
+
 	return nil
 }
 
@@ -258,7 +406,7 @@ func (txs *transactions) ProcessBlockTransactions(body block.Body, round uint64,
 func (txs *transactions) SaveTxBlockToStorage(body block.Body) error {
 	for i := 0; i < len(body); i++ {
 		miniBlock := (body)[i]
-		if miniBlock.Type != block.TxBlock {
+		if miniBlock.Type != block.TxBlock && miniBlock.Type != block.InvalidBlock {
 			continue
 		}
 
@@ -266,6 +414,11 @@ func (txs *transactions) SaveTxBlockToStorage(body block.Body) error {
 		if err != nil {
 			return err
 		}
+
+		err = txs.saveMiniBlockHashByTxHashes(miniBlock, txs.storage)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -290,10 +443,17 @@ func (txs *transactions) CreateBlockStarted() {
 	txs.txsForCurrBlock.txHashAndInfo = make(map[string]*txInfo)
 	txs.txsForCurrBlock.mutTxsForBlock.Unlock()
 
+	txs.gasHandler.Init()
+
 	txs.mutOrderedTxs.Lock()
 	txs.orderedTxs = make(map[string][]*transaction.Transaction)
 	txs.orderedTxHashes = make(map[string][][]byte)
 	txs.mutOrderedTxs.Unlock()
+
+	txs.mutFailedTxs.Lock()
+	txs.invalidTxHashes = make([][]byte, 0)
+	txs.accountsWithFailedTxs = make(map[string]struct{})
+	txs.mutFailedTxs.Unlock()
 }
 
 // RequestBlockTransactions request for transactions if missing from a block.Body
@@ -335,10 +495,22 @@ func (txs *transactions) computeMissingAndExistingTxsForShards(body block.Body)
 		block.TxBlock,
 		txs.txPool)
 
+	missingInvalidTxsForShard := txs.computeExistingAndMissing(
+		body,
+		&txs.txsForCurrBlock,
+		txs.chRcvAllTxs,
+		block.InvalidBlock,
+		txs.txPool)
+
+	for senderShardID, mbsTxHashes := range missingInvalidTxsForShard {
+		missingTxsForShard[senderShardID] = append(missingTxsForShard[senderShardID], mbsTxHashes...)
+	}
+
 	return missingTxsForShard
 }
 
-// processAndRemoveBadTransactions processed transactions, if txs are with error it removes them from pool
+// processAndRemoveBadTransactions processed transactions, if txs are with error it removes them from pool. A failed
+// transaction is kept for the current block as it is recorded in the invalid miniblock
 func (txs *transactions) processAndRemoveBadTransaction(
 	transactionHash []byte,
 	transaction *transaction.Transaction,
@@ -354,7 +526,7 @@ func (txs *transactions) processAndRemoveBadTransaction(
 		txs.txPool.RemoveData(transactionHash, strCache)
 	}
 
-	if err != nil {
+	if err != nil && err != process.ErrFailedTransaction {
 		return err
 	}
 
@@ -363,7 +535,7 @@ func (txs *transactions) processAndRemoveBadTransaction(
 	txs.txsForCurrBlock.txHashAndInfo[string(transactionHash)] = &txInfo{tx: transaction, txShardInfo: txShardInfo}
 	txs.txsForCurrBlock.mutTxsForBlock.Unlock()
 
-	return nil
+	return err
 }
 
 // RequestTransactionsForMiniBlock requests missing transactions for a certain miniblock
@@ -500,9 +672,54 @@ func (txs *transactions) CreateAndProcessMiniBlocks(
 	mapHashesAndTxs := txs.GetAllCurrentUsedTxs()
 	compactedMiniBlocks := txs.miniBlocksCompacter.Compact(miniBlocks, mapHashesAndTxs)
 
+	invalidMiniBlock := txs.createInvalidMiniBlock()
+	if invalidMiniBlock != nil {
+		compactedMiniBlocks = append(compactedMiniBlocks, invalidMiniBlock)
+	}
+
 	return compactedMiniBlocks, nil
 }
 
+// createInvalidMiniBlock creates the intra shard miniblock which records the failed transactions of the current
+// block, or returns nil if there is none
+func (txs *transactions) createInvalidMiniBlock() *block.MiniBlock {
+	txs.mutFailedTxs.RLock()
+	defer txs.mutFailedTxs.RUnlock()
+
+	if len(txs.invalidTxHashes) == 0 {
+		return nil
+	}
+
+	txHashes := make([][]byte, len(txs.invalidTxHashes))
+	copy(txHashes, txs.invalidTxHashes)
+
+	return &block.MiniBlock{
+		TxHashes:        txHashes,
+		SenderShardID:   txs.shardCoordinator.SelfId(),
+		ReceiverShardID: txs.shardCoordinator.SelfId(),
+		Type:            block.InvalidBlock,
+	}
+}
+
+// addFailedTransaction records a failed transaction in the invalid miniblock. The transactions of its sender and
+// receiver are not added anymore in the current block, as the invalid miniblock is processed after all the others
+func (txs *transactions) addFailedTransaction(txHash []byte, tx *transaction.Transaction) {
+	txs.mutFailedTxs.Lock()
+	txs.invalidTxHashes = append(txs.invalidTxHashes, txHash)
+	txs.accountsWithFailedTxs[string(tx.SndAddr)] = struct{}{}
+	txs.accountsWithFailedTxs[string(tx.RcvAddr)] = struct{}{}
+	txs.mutFailedTxs.Unlock()
+}
+
+func (txs *transactions) isAccountWithFailedTx(tx *transaction.Transaction) bool {
+	txs.mutFailedTxs.RLock()
+	_, isSenderWithFailedTx := txs.accountsWithFailedTxs[string(tx.SndAddr)]
+	_, isReceiverWithFailedTx := txs.accountsWithFailedTxs[string(tx.RcvAddr)]
+	txs.mutFailedTxs.RUnlock()
+
+	return isSenderWithFailedTx || isReceiverWithFailedTx
+}
+
 // CreateAndProcessMiniBlock creates the miniblock from storage and processes the transactions added into the miniblock
 func (txs *transactions) CreateAndProcessMiniBlock(
 	sndShardId uint32,
@@ -547,12 +764,13 @@ func (txs *transactions) CreateAndProcessMiniBlock(
 		if txs.isTxAlreadyProcessed(orderedTxHashes[index], &txs.txsForCurrBlock) {
 			continue
 		}
-
-		currTxGasLimit := txs.economicsFee.ComputeGasLimit(orderedTxs[index])
-		if isSmartContractAddress(orderedTxs[index].RcvAddr) {
-			currTxGasLimit = orderedTxs[index].GasLimit
+		if txs.isAccountWithFailedTx(orderedTxs[index]) {
+			continue
 		}
 
+		moveBalanceGas, scExecutionGas := txs.gasHandler.ComputeGasConsumedByTx(orderedTxs[index])
+		currTxGasLimit := moveBalanceGas + scExecutionGas
+
 		isGasLimitReached := addedGasLimitPerCrossShardMiniblock+currTxGasLimit > process.MaxGasLimitPerMiniBlock
 		if isGasLimitReached {
 			log.Debug(fmt.Sprintf("max gas limit per mini block is reached: added %d txs from %d txs\n",
@@ -561,6 +779,14 @@ func (txs *transactions) CreateAndProcessMiniBlock(
 			continue
 		}
 
+		isGasLimitReached = txs.gasHandler.IsMaxGasLimitReached(dstShardId, moveBalanceGas, scExecutionGas)
+		if isGasLimitReached {
+			log.Debug(fmt.Sprintf("max gas limit per block is reached: added %d txs from %d txs\n",
+				len(miniBlock.TxHashes),
+				len(orderedTxs)))
+			continue
+		}
+
 		snapshot := txs.accounts.JournalLen()
 
 		// execute transaction to change the trie root hash
@@ -572,6 +798,10 @@ func (txs *transactions) CreateAndProcessMiniBlock(
 			miniBlock.ReceiverShardID,
 		)
 
+		if err == process.ErrFailedTransaction {
+			txs.addFailedTransaction(orderedTxHashes[index], orderedTxs[index])
+			continue
+		}
 		if err != nil {
 			log.Debug(err.Error())
 			err = txs.accounts.RevertToSnapshot(snapshot)
@@ -584,6 +814,7 @@ func (txs *transactions) CreateAndProcessMiniBlock(
 		miniBlock.TxHashes = append(miniBlock.TxHashes, orderedTxHashes[index])
 		addedTxs++
 		addedGasLimitPerCrossShardMiniblock += currTxGasLimit
+		txs.gasHandler.AddGasConsumed(dstShardId, moveBalanceGas, scExecutionGas)
 
 		if addedTxs >= spaceRemained { // max transactions count in one block was reached
 			log.Info(fmt.Sprintf("max txs accepted in one block is reached: added %d txs from %d txs\n",
@@ -650,6 +881,19 @@ func (txs *transactions) ProcessMiniBlock(miniBlock *block.MiniBlock, haveTime f
 		return err
 	}
 
+	mapHashesAndTxs := make(map[string]data.TransactionHandler, len(miniBlockTxs))
+	for index, txHash := range miniBlockTxHashes {
+		mapHashesAndTxs[string(txHash)] = miniBlockTxs[index]
+	}
+
+	moveBalanceGas, scExecutionGas, err := txs.gasHandler.ComputeGasConsumedByMiniBlock(miniBlock, mapHashesAndTxs)
+	if err != nil {
+		return err
+	}
+	if txs.gasHandler.IsMaxGasLimitReached(miniBlock.ReceiverShardID, moveBalanceGas, scExecutionGas) {
+		return process.ErrMaxGasLimitPerBlockReached
+	}
+
 	for index := range miniBlockTxs {
 		if !haveTime() {
 			err = process.ErrTimeIsOut
@@ -662,6 +906,8 @@ func (txs *transactions) ProcessMiniBlock(miniBlock *block.MiniBlock, haveTime f
 		}
 	}
 
+	txs.gasHandler.AddGasConsumed(miniBlock.ReceiverShardID, moveBalanceGas, scExecutionGas)
+
 	txShardInfo := &txShardInfo{senderShardID: miniBlock.SenderShardID, receiverShardID: miniBlock.ReceiverShardID}
 
 	txs.txsForCurrBlock.mutTxsForBlock.Lock()
@@ -49,6 +49,17 @@ func miniBlocksCompacterMock() *mock.MiniBlocksCompacterMock {
 	}
 }
 
+func gasHandlerMock() *mock.GasHandlerMock {
+	return &mock.GasHandlerMock{
+		ComputeGasConsumedByTxCalled: func(tx data.TransactionHandler) (uint64, uint64) {
+			if isSmartContractAddress(tx.GetRecvAddress()) {
+				return 0, tx.GetGasLimit()
+			}
+			return 0, 0
+		},
+	}
+}
+
 func initDataPool() *mock.PoolsHolderStub {
 	sdp := &mock.PoolsHolderStub{
 		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
@@ -211,6 +222,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilPool(t *testing.T) {
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	assert.Nil(t, txs)
@@ -233,6 +245,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilStore(t *testing.T) {
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	assert.Nil(t, txs)
@@ -255,6 +268,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilHasher(t *testing.T) {
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	assert.Nil(t, txs)
@@ -277,6 +291,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilMarsalizer(t *testing.T) {
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	assert.Nil(t, txs)
@@ -299,6 +314,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilTxProce(t *testing.T) {
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	assert.Nil(t, txs)
@@ -321,6 +337,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilShardCoord(t *testing.T) {
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	assert.Nil(t, txs)
@@ -343,6 +360,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilAccounts(t *testing.T) {
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	assert.Nil(t, txs)
@@ -364,6 +382,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilRequestFunc(t *testing.T)
 		nil,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	assert.Nil(t, txs)
@@ -386,6 +405,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilFeeHandler(t *testing.T) {
 		requestTransaction,
 		nil,
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	assert.Nil(t, txs)
@@ -408,12 +428,36 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilMiniBlocksCompacter(t *tes
 		requestTransaction,
 		feeHandlerMock(),
 		nil,
+		gasHandlerMock(),
 	)
 
 	assert.Nil(t, txs)
 	assert.Equal(t, process.ErrNilMiniBlocksCompacter, err)
 }
 
+func TestTxsPreprocessor_NewTransactionPreprocessorNilGasHandler(t *testing.T) {
+	t.Parallel()
+
+	tdp := initDataPool()
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	txs, err := NewTransactionPreprocessor(
+		tdp.Transactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		requestTransaction,
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		nil,
+	)
+
+	assert.Nil(t, txs)
+	assert.Equal(t, process.ErrNilGasHandler, err)
+}
+
 func TestTxsPreProcessor_GetTransactionFromPool(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool()
@@ -429,6 +473,7 @@ func TestTxsPreProcessor_GetTransactionFromPool(t *testing.T) {
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 	txHash := []byte("tx1_hash")
 	tx, _ := process.GetTransactionHandlerFromPool(1, 1, txHash, tdp.Transactions())
@@ -452,6 +497,7 @@ func TestTransactionPreprocessor_RequestTransactionFromNetwork(t *testing.T) {
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 	shardId := uint32(1)
 	txHash1 := []byte("tx_hash1")
@@ -481,6 +527,7 @@ func TestTransactionPreprocessor_RequestBlockTransactionFromMiniBlockFromNetwork
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	shardId := uint32(1)
@@ -525,6 +572,7 @@ func TestTransactionPreprocessor_ReceivedTransactionShouldEraseRequested(t *test
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	//add 3 tx hashes on requested list
@@ -600,6 +648,7 @@ func TestTransactionPreprocessor_GetAllTxsFromMiniBlockShouldWork(t *testing.T)
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	mb := &block.MiniBlock{
@@ -636,6 +685,7 @@ func TestTransactionPreprocessor_RemoveBlockTxsFromPoolNilBlockShouldErr(t *test
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 	err := txs.RemoveTxBlockFromPools(nil, tdp.MiniBlocks())
 	assert.NotNil(t, err)
@@ -657,6 +707,7 @@ func TestTransactionPreprocessor_RemoveBlockTxsFromPoolOK(t *testing.T) {
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 	body := make(block.Body, 0)
 	txHash := []byte("txHash")
@@ -693,6 +744,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAll(t *testi
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 	assert.NotNil(t, txs)
 
@@ -737,6 +789,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAllAsNoSCCal
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 	assert.NotNil(t, txs)
 
@@ -783,6 +836,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddOnly5asSCCal
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 	assert.NotNil(t, txs)
 
@@ -810,6 +864,272 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddOnly5asSCCal
 	assert.Equal(t, numTxsToAdd, len(mb.TxHashes))
 }
 
+func TestTransactions_CreateAndProcessMiniBlockShouldStopAtMaxGasLimitPerBlock(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache})
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	hasher := &mock.HasherMock{}
+	marshalizer := &mock.MarshalizerMock{}
+
+	numTxsToAdd := 3
+	gasLimit := uint64(10)
+	gasConsumed := uint64(0)
+	gasHandler := &mock.GasHandlerMock{
+		ComputeGasConsumedByTxCalled: func(tx data.TransactionHandler) (uint64, uint64) {
+			return tx.GetGasLimit(), 0
+		},
+		IsMaxGasLimitReachedCalled: func(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64) bool {
+			return gasConsumed+moveBalanceGas > gasLimit*uint64(numTxsToAdd)
+		},
+		AddGasConsumedCalled: func(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64) {
+			gasConsumed += moveBalanceGas
+		},
+	}
+
+	txs, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		hasher,
+		marshalizer,
+		&mock.TxProcessorMock{ProcessTransactionCalled: func(transaction *transaction.Transaction, round uint64) error {
+			return nil
+		}},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		requestTransaction,
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		gasHandler,
+	)
+	assert.NotNil(t, txs)
+
+	sndShardId := uint32(0)
+	dstShardId := uint32(1)
+	strCache := process.ShardCacherIdentifier(sndShardId, dstShardId)
+
+	for i := 0; i < 10; i++ {
+		newTx := &transaction.Transaction{GasLimit: gasLimit, GasPrice: uint64(i), RcvAddr: []byte("012345678910")}
+
+		txHash, _ := core.CalculateHash(marshalizer, hasher, newTx)
+		txPool.AddData(txHash, newTx, strCache)
+	}
+
+	mb, err := txs.CreateAndProcessMiniBlock(sndShardId, dstShardId, process.MaxItemsInBlock, haveTimeTrue, 10)
+	assert.Nil(t, err)
+
+	assert.Equal(t, numTxsToAdd, len(mb.TxHashes))
+	assert.Equal(t, gasLimit*uint64(numTxsToAdd), gasConsumed)
+}
+
+func TestTransactions_ProcessBlockTransactionsMaxGasLimitPerBlockReachedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx_hash")
+	txs, _ := NewTransactionPreprocessor(
+		initDataPool().Transactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{ProcessTransactionCalled: func(transaction *transaction.Transaction, round uint64) error {
+			assert.Fail(t, "transactions should not be processed")
+			return nil
+		}},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		func(shardID uint32, txHashes [][]byte) {},
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		&mock.GasHandlerMock{
+			IsMaxGasLimitReachedCalled: func(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64) bool {
+				return true
+			},
+		},
+	)
+	txs.txsForCurrBlock.txHashAndInfo[string(txHash)] = &txInfo{tx: &transaction.Transaction{}}
+
+	body := block.Body{
+		&block.MiniBlock{
+			TxHashes: [][]byte{txHash},
+			Type:     block.TxBlock,
+		},
+	}
+	err := txs.ProcessBlockTransactions(body, 10, haveTimeTrue)
+
+	assert.Equal(t, process.ErrMaxGasLimitPerBlockReached, err)
+}
+
+func TestTransactions_CreateAndProcessMiniBlocksFailedTransactionShouldBeRecordedInInvalidMiniBlock(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache})
+	hasher := &mock.HasherMock{}
+	marshalizer := &mock.MarshalizerMock{}
+
+	failedTx := &transaction.Transaction{Nonce: 1, SndAddr: []byte("sender"), RcvAddr: []byte("receiver_address")}
+	skippedTx := &transaction.Transaction{Nonce: 2, SndAddr: []byte("sender"), RcvAddr: []byte("other_address")}
+	validTx := &transaction.Transaction{Nonce: 3, SndAddr: []byte("other_address"), RcvAddr: []byte("another_address")}
+
+	processedTxs := make([]*transaction.Transaction, 0)
+	txs, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		hasher,
+		marshalizer,
+		&mock.TxProcessorMock{ProcessTransactionCalled: func(tx *transaction.Transaction, round uint64) error {
+			processedTxs = append(processedTxs, tx)
+			if tx == failedTx {
+				return process.ErrFailedTransaction
+			}
+			return nil
+		}},
+		mock.NewMultiShardsCoordinatorMock(1),
+		&mock.AccountsStub{
+			RevertToSnapshotCalled: func(snapshot int) error {
+				assert.Fail(t, "failed transaction should not be reverted")
+				return nil
+			},
+		},
+		func(shardID uint32, txHashes [][]byte) {},
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		gasHandlerMock(),
+	)
+
+	strCache := process.ShardCacherIdentifier(0, 0)
+	failedTxHash, _ := core.CalculateHash(marshalizer, hasher, failedTx)
+	txPool.AddData(failedTxHash, failedTx, strCache)
+	skippedTxHash, _ := core.CalculateHash(marshalizer, hasher, skippedTx)
+	txPool.AddData(skippedTxHash, skippedTx, strCache)
+	validTxHash, _ := core.CalculateHash(marshalizer, hasher, validTx)
+	txPool.AddData(validTxHash, validTx, strCache)
+
+	mbs, err := txs.CreateAndProcessMiniBlocks(process.MaxItemsInBlock, process.MaxItemsInBlock, 10, haveTimeTrue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*transaction.Transaction{failedTx, validTx}, processedTxs)
+	assert.Equal(t, 2, len(mbs))
+	assert.Equal(t, block.TxBlock, mbs[0].Type)
+	assert.Equal(t, [][]byte{validTxHash}, mbs[0].TxHashes)
+	assert.Equal(t, block.InvalidBlock, mbs[1].Type)
+	assert.Equal(t, [][]byte{failedTxHash}, mbs[1].TxHashes)
+	assert.Equal(t, uint32(0), mbs[1].SenderShardID)
+	assert.Equal(t, uint32(0), mbs[1].ReceiverShardID)
+
+	txs.CreateBlockStarted()
+	assert.Nil(t, txs.createInvalidMiniBlock())
+}
+
+func createTxsPreprocessorForInvalidMiniBlocks(processTransactionErr error) (*transactions, []byte) {
+	txHash := []byte("tx_hash")
+	txs, _ := NewTransactionPreprocessor(
+		initDataPool().Transactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{ProcessTransactionCalled: func(transaction *transaction.Transaction, round uint64) error {
+			return processTransactionErr
+		}},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		func(shardID uint32, txHashes [][]byte) {},
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		gasHandlerMock(),
+	)
+	txs.txsForCurrBlock.txHashAndInfo[string(txHash)] = &txInfo{tx: &transaction.Transaction{}}
+
+	return txs, txHash
+}
+
+func TestTransactions_ProcessBlockTransactionsInvalidMiniBlockWithFailedTxShouldWork(t *testing.T) {
+	t.Parallel()
+
+	txs, txHash := createTxsPreprocessorForInvalidMiniBlocks(process.ErrFailedTransaction)
+	body := block.Body{
+		&block.MiniBlock{
+			TxHashes: [][]byte{txHash},
+			Type:     block.InvalidBlock,
+		},
+	}
+
+	err := txs.ProcessBlockTransactions(body, 10, haveTimeTrue)
+
+	assert.Nil(t, err)
+}
+
+func TestTransactions_ProcessBlockTransactionsInvalidMiniBlockWithSuccessfulTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txs, txHash := createTxsPreprocessorForInvalidMiniBlocks(nil)
+	body := block.Body{
+		&block.MiniBlock{
+			TxHashes: [][]byte{txHash},
+			Type:     block.InvalidBlock,
+		},
+	}
+
+	err := txs.ProcessBlockTransactions(body, 10, haveTimeTrue)
+
+	assert.Equal(t, process.ErrTransactionNotFailed, err)
+}
+
+func TestTransactions_ProcessBlockTransactionsCrossShardInvalidMiniBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txs, txHash := createTxsPreprocessorForInvalidMiniBlocks(process.ErrFailedTransaction)
+	body := block.Body{
+		&block.MiniBlock{
+			TxHashes:        [][]byte{txHash},
+			ReceiverShardID: 1,
+			Type:            block.InvalidBlock,
+		},
+	}
+
+	err := txs.ProcessBlockTransactions(body, 10, haveTimeTrue)
+
+	assert.Equal(t, process.ErrInvalidShardId, err)
+}
+
+func TestTransactions_SaveTxBlockToStorageShouldSaveMiniBlockHashByTxHash(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx_hash")
+	savedMiniBlockHashes := make(map[string][]byte)
+	store := &mock.ChainStorerMock{
+		PutCalled: func(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+			if unitType == dataRetriever.MiniBlockHashByTxHashUnit {
+				savedMiniBlockHashes[string(key)] = value
+			}
+			return nil
+		},
+	}
+	txs, _ := NewTransactionPreprocessor(
+		initDataPool().Transactions(),
+		store,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		func(shardID uint32, txHashes [][]byte) {},
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		gasHandlerMock(),
+	)
+	txs.txsForCurrBlock.txHashAndInfo[string(txHash)] = &txInfo{tx: &transaction.Transaction{}}
+
+	miniBlock := &block.MiniBlock{
+		TxHashes: [][]byte{txHash},
+		Type:     block.InvalidBlock,
+	}
+	err := txs.SaveTxBlockToStorage(block.Body{miniBlock})
+	assert.Nil(t, err)
+
+	miniBlockHash, _ := core.CalculateHash(&mock.MarshalizerMock{}, &mock.HasherMock{}, miniBlock)
+	assert.Equal(t, miniBlockHash, savedMiniBlockHashes[string(txHash)])
+}
+
 func TestTransactions_isSmartContractAddress(t *testing.T) {
 	t.Parallel()
 
@@ -992,6 +1312,7 @@ func TestMiniBlocksCompaction_CompactAndExpandMiniBlocksShouldResultTheSameMiniB
 		requestTransaction,
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
+		gasHandlerMock(),
 	)
 
 	keygen := signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519())
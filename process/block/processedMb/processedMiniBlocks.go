@@ -0,0 +1,121 @@
+package processedMb
+
+import (
+	"sync"
+)
+
+// ProcessedMiniBlockInfo holds how far the execution of a cross shard miniblock has advanced
+type ProcessedMiniBlockInfo struct {
+	IsFullyProcessed       bool
+	IndexOfLastTxProcessed int32
+}
+
+// ProcessedMiniBlockTracker keeps the cross shard miniblocks processed by the current shard, grouped by the meta
+// block which notarized them. A miniblock which could only be partially executed is kept together with the index of
+// its last executed transaction, so the execution resumes from the next one
+type ProcessedMiniBlockTracker struct {
+	processedMiniBlocks    map[string]map[string]*ProcessedMiniBlockInfo
+	mutProcessedMiniBlocks sync.RWMutex
+}
+
+// NewProcessedMiniBlocks creates a new processed miniblocks tracker
+func NewProcessedMiniBlocks() *ProcessedMiniBlockTracker {
+	return &ProcessedMiniBlockTracker{
+		processedMiniBlocks: make(map[string]map[string]*ProcessedMiniBlockInfo),
+	}
+}
+
+// SetProcessedMiniBlockInfo sets the processing state of the given miniblock, notarized by the given meta block
+func (pmb *ProcessedMiniBlockTracker) SetProcessedMiniBlockInfo(
+	metaBlockHash []byte,
+	miniBlockHash []byte,
+	processedMbInfo *ProcessedMiniBlockInfo,
+) {
+	pmb.mutProcessedMiniBlocks.Lock()
+	defer pmb.mutProcessedMiniBlocks.Unlock()
+
+	miniBlocksProcessed, ok := pmb.processedMiniBlocks[string(metaBlockHash)]
+	if !ok {
+		miniBlocksProcessed = make(map[string]*ProcessedMiniBlockInfo)
+		pmb.processedMiniBlocks[string(metaBlockHash)] = miniBlocksProcessed
+	}
+
+	miniBlocksProcessed[string(miniBlockHash)] = &ProcessedMiniBlockInfo{
+		IsFullyProcessed:       processedMbInfo.IsFullyProcessed,
+		IndexOfLastTxProcessed: processedMbInfo.IndexOfLastTxProcessed,
+	}
+}
+
+// RemoveMetaBlockHash removes all the miniblocks notarized by the given meta block
+func (pmb *ProcessedMiniBlockTracker) RemoveMetaBlockHash(metaBlockHash []byte) {
+	pmb.mutProcessedMiniBlocks.Lock()
+	delete(pmb.processedMiniBlocks, string(metaBlockHash))
+	pmb.mutProcessedMiniBlocks.Unlock()
+}
+
+// RemoveMiniBlockHash removes the given miniblock, whichever meta block notarized it
+func (pmb *ProcessedMiniBlockTracker) RemoveMiniBlockHash(miniBlockHash []byte) {
+	pmb.mutProcessedMiniBlocks.Lock()
+	for _, miniBlocksProcessed := range pmb.processedMiniBlocks {
+		delete(miniBlocksProcessed, string(miniBlockHash))
+	}
+	pmb.mutProcessedMiniBlocks.Unlock()
+}
+
+// GetProcessedMiniBlocksInfo returns a copy of the processing state of the miniblocks notarized by the given meta
+// block
+func (pmb *ProcessedMiniBlockTracker) GetProcessedMiniBlocksInfo(metaBlockHash []byte) map[string]*ProcessedMiniBlockInfo {
+	pmb.mutProcessedMiniBlocks.RLock()
+	defer pmb.mutProcessedMiniBlocks.RUnlock()
+
+	processedMiniBlocksInfo := make(map[string]*ProcessedMiniBlockInfo)
+	for miniBlockHash, processedMbInfo := range pmb.processedMiniBlocks[string(metaBlockHash)] {
+		processedMiniBlocksInfo[miniBlockHash] = &ProcessedMiniBlockInfo{
+			IsFullyProcessed:       processedMbInfo.IsFullyProcessed,
+			IndexOfLastTxProcessed: processedMbInfo.IndexOfLastTxProcessed,
+		}
+	}
+
+	return processedMiniBlocksInfo
+}
+
+// GetProcessedMiniBlockInfo returns the processing state of the given miniblock together with the hash of the meta
+// block which notarized it. A miniblock not processed at all is returned with the index of the last processed
+// transaction set to -1
+func (pmb *ProcessedMiniBlockTracker) GetProcessedMiniBlockInfo(miniBlockHash []byte) (*ProcessedMiniBlockInfo, []byte) {
+	pmb.mutProcessedMiniBlocks.RLock()
+	defer pmb.mutProcessedMiniBlocks.RUnlock()
+
+	for metaBlockHash, miniBlocksProcessed := range pmb.processedMiniBlocks {
+		processedMbInfo, ok := miniBlocksProcessed[string(miniBlockHash)]
+		if !ok {
+			continue
+		}
+
+		return &ProcessedMiniBlockInfo{
+			IsFullyProcessed:       processedMbInfo.IsFullyProcessed,
+			IndexOfLastTxProcessed: processedMbInfo.IndexOfLastTxProcessed,
+		}, []byte(metaBlockHash)
+	}
+
+	return &ProcessedMiniBlockInfo{IndexOfLastTxProcessed: -1}, nil
+}
+
+// IsMiniBlockFullyProcessed returns true if all the transactions of the given miniblock, notarized by the given meta
+// block, were processed
+func (pmb *ProcessedMiniBlockTracker) IsMiniBlockFullyProcessed(metaBlockHash []byte, miniBlockHash []byte) bool {
+	pmb.mutProcessedMiniBlocks.RLock()
+	defer pmb.mutProcessedMiniBlocks.RUnlock()
+
+	miniBlocksProcessed, ok := pmb.processedMiniBlocks[string(metaBlockHash)]
+	if !ok {
+		return false
+	}
+
+	processedMbInfo, ok := miniBlocksProcessed[string(miniBlockHash)]
+	if !ok {
+		return false
+	}
+
+	return processedMbInfo.IsFullyProcessed
+}
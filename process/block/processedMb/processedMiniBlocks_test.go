@@ -0,0 +1,65 @@
+package processedMb_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessedMiniBlockTracker_SetAndRemoveShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pmb := processedMb.NewProcessedMiniBlocks()
+	mtbHash1 := []byte("meta1")
+	mtbHash2 := []byte("meta2")
+	mbHash1 := []byte("mb1")
+	mbHash2 := []byte("mb2")
+	mbHash3 := []byte("mb3")
+	fullyProcessed := &processedMb.ProcessedMiniBlockInfo{IsFullyProcessed: true}
+
+	pmb.SetProcessedMiniBlockInfo(mtbHash1, mbHash1, fullyProcessed)
+	pmb.SetProcessedMiniBlockInfo(mtbHash1, mbHash2, fullyProcessed)
+	pmb.SetProcessedMiniBlockInfo(mtbHash2, mbHash3, fullyProcessed)
+	assert.True(t, pmb.IsMiniBlockFullyProcessed(mtbHash1, mbHash1))
+	assert.True(t, pmb.IsMiniBlockFullyProcessed(mtbHash1, mbHash2))
+	assert.True(t, pmb.IsMiniBlockFullyProcessed(mtbHash2, mbHash3))
+	assert.False(t, pmb.IsMiniBlockFullyProcessed(mtbHash2, mbHash1))
+
+	pmb.RemoveMiniBlockHash(mbHash1)
+	assert.False(t, pmb.IsMiniBlockFullyProcessed(mtbHash1, mbHash1))
+	assert.Equal(t, 1, len(pmb.GetProcessedMiniBlocksInfo(mtbHash1)))
+
+	pmb.RemoveMetaBlockHash(mtbHash1)
+	assert.False(t, pmb.IsMiniBlockFullyProcessed(mtbHash1, mbHash2))
+	assert.Equal(t, 0, len(pmb.GetProcessedMiniBlocksInfo(mtbHash1)))
+	assert.True(t, pmb.IsMiniBlockFullyProcessed(mtbHash2, mbHash3))
+}
+
+func TestProcessedMiniBlockTracker_PartiallyProcessedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pmb := processedMb.NewProcessedMiniBlocks()
+	mtbHash := []byte("meta")
+	mbHash := []byte("mb")
+
+	processedMbInfo, metaBlockHash := pmb.GetProcessedMiniBlockInfo(mbHash)
+	assert.Nil(t, metaBlockHash)
+	assert.False(t, processedMbInfo.IsFullyProcessed)
+	assert.Equal(t, int32(-1), processedMbInfo.IndexOfLastTxProcessed)
+
+	pmb.SetProcessedMiniBlockInfo(mtbHash, mbHash, &processedMb.ProcessedMiniBlockInfo{
+		IsFullyProcessed:       false,
+		IndexOfLastTxProcessed: 4,
+	})
+	assert.False(t, pmb.IsMiniBlockFullyProcessed(mtbHash, mbHash))
+
+	processedMbInfo, metaBlockHash = pmb.GetProcessedMiniBlockInfo(mbHash)
+	assert.Equal(t, mtbHash, metaBlockHash)
+	assert.Equal(t, int32(4), processedMbInfo.IndexOfLastTxProcessed)
+
+	processedMiniBlocksInfo := pmb.GetProcessedMiniBlocksInfo(mtbHash)
+	processedMiniBlocksInfo[string(mbHash)].IndexOfLastTxProcessed = 10
+	processedMbInfo, _ = pmb.GetProcessedMiniBlockInfo(mbHash)
+	assert.Equal(t, int32(4), processedMbInfo.IndexOfLastTxProcessed)
+}
@@ -1,18 +1,21 @@
 package block
 
 import (
+	"bytes"
 	"fmt"
+	"math/big"
 	"sort"
-	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
 	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -23,15 +26,21 @@ const maxCleanTime = time.Second
 // shardProcessor implements shardProcessor interface and actually it tries to execute block
 type shardProcessor struct {
 	*baseProcessor
-	dataPool               dataRetriever.PoolsHolder
-	metaBlockFinality      uint32
-	chRcvAllMetaHdrs       chan bool
-	processedMiniBlocks    map[string]map[string]struct{}
-	mutProcessedMiniBlocks sync.RWMutex
-	core                   serviceContainer.Core
-	txCoordinator          process.TransactionCoordinator
-	txCounter              *transactionCounter
-	txsPoolsCleaner        process.PoolsCleaner
+	dataPool            dataRetriever.PoolsHolder
+	metaBlockFinality   uint32
+	chRcvAllMetaHdrs    chan bool
+	processedMiniBlocks *processedMb.ProcessedMiniBlockTracker
+	core                serviceContainer.Core
+	txCoordinator       process.TransactionCoordinator
+	txCounter           *transactionCounter
+	txsPoolsCleaner     process.PoolsCleaner
+	blockChainHook      process.BlockChainHookHandler
+	epochNotifier       process.EpochNotifier
+	supplyAccountant    process.SupplyAccountant
+	blockFeesHandler    process.BlockFeesHandler
+	esdtSupplyTracker   process.ESDTSupplyTracker
+
+	protocolSustainabilityAccumulated *big.Int
 }
 
 // NewShardProcessor creates a new shardProcessor object
@@ -47,6 +56,21 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 	if arguments.TxCoordinator == nil || arguments.TxCoordinator.IsInterfaceNil() {
 		return nil, process.ErrNilTransactionCoordinator
 	}
+	if arguments.BlockChainHook == nil || arguments.BlockChainHook.IsInterfaceNil() {
+		return nil, process.ErrNilBlockChainHook
+	}
+	if arguments.EpochNotifier == nil || arguments.EpochNotifier.IsInterfaceNil() {
+		return nil, process.ErrNilEpochNotifier
+	}
+	if arguments.SupplyAccountant == nil || arguments.SupplyAccountant.IsInterfaceNil() {
+		return nil, process.ErrNilSupplyAccountant
+	}
+	if arguments.BlockFeesHandler == nil || arguments.BlockFeesHandler.IsInterfaceNil() {
+		return nil, process.ErrNilBlockFeesHandler
+	}
+	if arguments.ESDTSupplyTracker == nil || arguments.ESDTSupplyTracker.IsInterfaceNil() {
+		return nil, process.ErrNilESDTSupplyTracker
+	}
 
 	blockSizeThrottler, err := throttle.NewBlockSizeThrottle()
 	if err != nil {
@@ -57,6 +81,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		accounts:                      arguments.Accounts,
 		blockSizeThrottler:            blockSizeThrottler,
 		forkDetector:                  arguments.ForkDetector,
+		blockTracker:                  arguments.BlockTracker,
 		hasher:                        arguments.Hasher,
 		marshalizer:                   arguments.Marshalizer,
 		store:                         arguments.Store,
@@ -64,6 +89,11 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		nodesCoordinator:              arguments.NodesCoordinator,
 		specialAddressHandler:         arguments.SpecialAddressHandler,
 		uint64Converter:               arguments.Uint64Converter,
+		historyRepository:             arguments.HistoryRepository,
+		outgoingOperations:            arguments.OutgoingOperations,
+		selectionAuditor:              arguments.SelectionAuditor,
+		chainID:                       arguments.ChainID,
+		softwareVersion:               arguments.SoftwareVersion,
 		onRequestHeaderHandlerByNonce: arguments.RequestHandler.RequestHeaderByNonce,
 		appStatusHandler:              statusHandler.NewNilStatusHandler(),
 	}
@@ -77,12 +107,19 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 	}
 
 	sp := shardProcessor{
-		core:            arguments.Core,
-		baseProcessor:   base,
-		dataPool:        arguments.DataPool,
-		txCoordinator:   arguments.TxCoordinator,
-		txCounter:       NewTransactionCounter(),
-		txsPoolsCleaner: arguments.TxsPoolsCleaner,
+		core:              arguments.Core,
+		baseProcessor:     base,
+		dataPool:          arguments.DataPool,
+		txCoordinator:     arguments.TxCoordinator,
+		txCounter:         NewTransactionCounter(),
+		txsPoolsCleaner:   arguments.TxsPoolsCleaner,
+		blockChainHook:    arguments.BlockChainHook,
+		epochNotifier:     arguments.EpochNotifier,
+		supplyAccountant:  arguments.SupplyAccountant,
+		blockFeesHandler:  arguments.BlockFeesHandler,
+		esdtSupplyTracker: arguments.ESDTSupplyTracker,
+
+		protocolSustainabilityAccumulated: big.NewInt(0),
 	}
 	sp.chRcvAllMetaHdrs = make(chan bool)
 
@@ -94,13 +131,13 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 	sp.hdrsForCurrBlock.hdrHashAndInfo = make(map[string]*hdrInfo)
 	sp.hdrsForCurrBlock.highestHdrNonce = make(map[uint32]uint64)
 	sp.hdrsForCurrBlock.requestedFinalityAttestingHdrs = make(map[uint32][]uint64)
-	sp.processedMiniBlocks = make(map[string]map[string]struct{})
+	sp.processedMiniBlocks = processedMb.NewProcessedMiniBlocks()
 
 	metaBlockPool := sp.dataPool.MetaBlocks()
 	if metaBlockPool == nil {
 		return nil, process.ErrNilMetaBlockPool
 	}
-	metaBlockPool.RegisterHandler(sp.receivedMetaBlock)
+	arguments.HeadersNotifier.RegisterHandler(sp.receivedMetaBlock)
 	sp.onRequestHeaderHandler = arguments.RequestHandler.RequestHeader
 
 	sp.metaBlockFinality = process.MetaBlockFinality
@@ -133,6 +170,8 @@ func (sp *shardProcessor) ProcessBlock(
 		return err
 	}
 
+	sp.setLogCorrelation(headerHandler, nil)
+	defer logger.ClearCorrelationHeader()
 	log.Debug(fmt.Sprintf("started processing block with round %d and nonce %d\n",
 		headerHandler.GetRound(),
 		headerHandler.GetNonce()))
@@ -170,6 +209,8 @@ func (sp *shardProcessor) ProcessBlock(
 		return err
 	}
 
+	sp.epochNotifier.CheckEpoch(header.GetEpoch())
+	sp.blockChainHook.SetCurrentHeader(header)
 	sp.txCoordinator.CreateBlockStarted()
 	sp.createBlockStarted()
 	sp.txCoordinator.RequestBlockTransactions(body)
@@ -225,6 +266,11 @@ func (sp *shardProcessor) ProcessBlock(
 		return err
 	}
 
+	err = sp.verifyPartialMiniBlocks(body)
+	if err != nil {
+		return err
+	}
+
 	defer func() {
 		if err != nil {
 			sp.RevertAccountState()
@@ -256,9 +302,47 @@ func (sp *shardProcessor) ProcessBlock(
 		return err
 	}
 
+	err = sp.verifyFees(header)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// verifyFees checks the fees set in the header against the ones computed while processing the block. The fees are
+// set only once the fee burning is enabled
+func (sp *shardProcessor) verifyFees(header *block.Header) error {
+	if !sp.blockFeesHandler.IsFeeBurnEnabled() {
+		if header.AccumulatedFees != nil {
+			return process.ErrAccumulatedFeesDoNotMatch
+		}
+		if header.BurnedFees != nil {
+			return process.ErrBurnedFeesDoNotMatch
+		}
+
+		return nil
+	}
+
+	if !isSameValue(header.AccumulatedFees, sp.blockFeesHandler.GetAccumulatedFees()) {
+		return process.ErrAccumulatedFeesDoNotMatch
+	}
+	if !isSameValue(header.BurnedFees, sp.blockFeesHandler.GetBurnedFees()) {
+		return process.ErrBurnedFeesDoNotMatch
+	}
+
 	return nil
 }
 
+// isSameValue compares a value from the header, which might have been omitted when zero, with a computed value
+func isSameValue(headerValue *big.Int, computedValue *big.Int) bool {
+	if headerValue == nil {
+		return computedValue.Sign() == 0
+	}
+
+	return headerValue.Cmp(computedValue) == 0
+}
+
 func (sp *shardProcessor) setMetaConsensusData(finalizedMetaBlocks []data.HeaderHandler) error {
 	sp.specialAddressHandler.ClearMetaConsensusData()
 
@@ -385,11 +469,25 @@ func (sp *shardProcessor) checkHeaderBodyCorrelation(hdr *block.Header, body blo
 		if mbHdr.SenderShardID != miniBlock.SenderShardID {
 			return process.ErrHeaderBodyMismatch
 		}
+
+		if !isSamePartialExecution(mbHdr.PartialExecution, miniBlock.PartialExecution) {
+			return process.ErrHeaderBodyMismatch
+		}
 	}
 
 	return nil
 }
 
+func isSamePartialExecution(first *block.PartialExecution, second *block.PartialExecution) bool {
+	if first == nil || second == nil {
+		return first == second
+	}
+
+	return bytes.Equal(first.OriginalHash, second.OriginalHash) &&
+		first.OriginalTxCount == second.OriginalTxCount &&
+		first.IndexOfFirstTx == second.IndexOfFirstTx
+}
+
 func (sp *shardProcessor) checkAndRequestIfMetaHeadersMissing(round uint64) {
 	orderedMetaBlocks, err := sp.getOrderedMetaBlocks(round)
 	if err != nil {
@@ -463,6 +561,7 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 // RestoreBlockIntoPools restores the TxBlock and MetaBlock into associated pools
 func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler, bodyHandler data.BodyHandler) error {
 	sp.removeLastNotarized()
+	sp.blockTracker.RemoveLastNotarizedHeaders()
 
 	if headerHandler == nil || headerHandler.IsInterfaceNil() {
 		return process.ErrNilBlockHeader
@@ -493,9 +592,67 @@ func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler
 		return err
 	}
 
+	sp.restorePartialMiniBlocks(header)
+
 	return nil
 }
 
+// restorePartialMiniBlocks rolls back the execution of the partially executed miniblocks from the given header, so
+// it resumes from their first transaction, and puts back in pool the original miniblocks which were completed
+func (sp *shardProcessor) restorePartialMiniBlocks(header *block.Header) {
+	for _, mbHdr := range header.MiniBlockHeaders {
+		partialExecution := mbHdr.PartialExecution
+		if partialExecution == nil {
+			continue
+		}
+
+		if partialExecution.IsLastPart(int(mbHdr.TxCount)) {
+			sp.restoreOriginalMiniBlockIntoPool(partialExecution.OriginalHash)
+		}
+
+		_, metaBlockHash := sp.processedMiniBlocks.GetProcessedMiniBlockInfo(partialExecution.OriginalHash)
+		if metaBlockHash == nil {
+			continue
+		}
+
+		if partialExecution.IndexOfFirstTx == 0 {
+			sp.processedMiniBlocks.RemoveMiniBlockHash(partialExecution.OriginalHash)
+			continue
+		}
+
+		sp.processedMiniBlocks.SetProcessedMiniBlockInfo(
+			metaBlockHash,
+			partialExecution.OriginalHash,
+			&processedMb.ProcessedMiniBlockInfo{
+				IsFullyProcessed:       false,
+				IndexOfLastTxProcessed: int32(partialExecution.IndexOfFirstTx) - 1,
+			},
+		)
+	}
+}
+
+func (sp *shardProcessor) restoreOriginalMiniBlockIntoPool(originalHash []byte) {
+	buff, err := sp.store.Get(dataRetriever.MiniBlockUnit, originalHash)
+	if err != nil {
+		log.Debug(err.Error())
+		return
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = sp.marshalizer.Unmarshal(miniBlock, buff)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	sp.dataPool.MiniBlocks().Put(originalHash, miniBlock)
+
+	err = sp.store.GetStorer(dataRetriever.MiniBlockUnit).Remove(originalHash)
+	if err != nil {
+		log.Error(err.Error())
+	}
+}
+
 func (sp *shardProcessor) restoreMetaBlockIntoPool(miniBlockHashes map[string]uint32, metaBlockHashes [][]byte) error {
 	metaBlockPool := sp.dataPool.MetaBlocks()
 	if metaBlockPool == nil {
@@ -543,7 +700,7 @@ func (sp *shardProcessor) restoreMetaBlockIntoPool(miniBlockHashes map[string]ui
 	}
 
 	for miniBlockHash := range miniBlockHashes {
-		sp.removeProcessedMiniBlock([]byte(miniBlockHash))
+		sp.processedMiniBlocks.RemoveMiniBlockHash([]byte(miniBlockHash))
 	}
 
 	return nil
@@ -553,6 +710,13 @@ func (sp *shardProcessor) restoreMetaBlockIntoPool(miniBlockHashes map[string]ui
 // as long as the transactions limit for the block has not been reached and there is still time to add transactions
 func (sp *shardProcessor) CreateBlockBody(round uint64, haveTime func() bool) (data.BodyHandler, error) {
 	log.Debug(fmt.Sprintf("started creating block body in round %d\n", round))
+	sp.epochNotifier.CheckEpoch(sp.specialAddressHandler.Epoch())
+	// only the round and the epoch of the proposed block are known while its body is created
+	sp.blockChainHook.SetCurrentHeader(&block.Header{
+		Round:   round,
+		Epoch:   sp.specialAddressHandler.Epoch(),
+		ShardId: sp.shardCoordinator.SelfId(),
+	})
 	sp.txCoordinator.CreateBlockStarted()
 	sp.createBlockStarted()
 	sp.blockSizeThrottler.ComputeMaxItems()
@@ -577,6 +741,7 @@ func (sp *shardProcessor) CommitBlock(
 		if err != nil {
 			sp.RevertAccountState()
 		}
+		logger.ClearCorrelationHeader()
 	}()
 
 	err = checkForNils(chainHandler, headerHandler, bodyHandler)
@@ -605,6 +770,7 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	headerHash := sp.hasher.Compute(string(buff))
+	sp.setLogCorrelation(header, headerHash)
 	nonceToByteSlice := sp.uint64Converter.ToByteSlice(header.Nonce)
 	hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(header.ShardId)
 
@@ -666,15 +832,23 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
-	err = sp.saveLastNotarizedHeader(sharding.MetachainShardId, processedMetaHdrs)
+	err = sp.commitState(chainHandler, headerHandler)
 	if err != nil {
 		return err
 	}
-	_, err = sp.accounts.Commit()
+	publishTrieStatistics(sp.core, headerHandler)
+
+	err = sp.saveLastNotarizedHeader(sharding.MetachainShardId, processedMetaHdrs)
 	if err != nil {
 		return err
 	}
 
+	sp.addNotarizedHeadersToBlockTracker(processedMetaHdrs, finalHeaders, finalHeadersHashes)
+	sp.accumulateProtocolSustainabilityRewards()
+	sp.accountSupply(header)
+	sp.esdtSupplyTracker.CommitPendingChanges(header)
+	finalHeaders, finalHeadersHashes = sp.appendLastSelfNotarizedHeader(finalHeaders, finalHeadersHashes)
+
 	log.Info(fmt.Sprintf("shard block with nonce %d and hash %s has been committed successfully\n",
 		header.Nonce,
 		core.ToB64(headerHash)))
@@ -684,6 +858,8 @@ func (sp *shardProcessor) CommitBlock(
 		log.Debug(errNotCritical.Error())
 	}
 
+	sp.removeCompletedOriginalMiniBlocksFromPool(body)
+
 	errNotCritical = sp.removeProcessedMetaBlocksFromPool(processedMetaHdrs)
 	if errNotCritical != nil {
 		log.Debug(errNotCritical.Error())
@@ -701,6 +877,7 @@ func (sp *shardProcessor) CommitBlock(
 
 	hdrsToAttestPreviousFinal := uint32(header.Nonce-highestFinalBlockNonce) + 1
 	sp.removeNotarizedHdrsBehindPreviousFinal(hdrsToAttestPreviousFinal)
+	sp.cleanupBlockTrackerHeaders(highestFinalBlockNonce)
 
 	lastBlockHeader := chainHandler.GetCurrentBlockHeader()
 
@@ -716,6 +893,9 @@ func (sp *shardProcessor) CommitBlock(
 
 	chainHandler.SetCurrentBlockHeaderHash(headerHash)
 	sp.indexBlockIfNeeded(bodyHandler, headerHandler, lastBlockHeader)
+	sp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
+	sp.collectOutgoingOperations(headerHash, headerHandler, bodyHandler)
+	sp.auditValidatorsSelection(headerHandler)
 
 	headerMeta, err := sp.getLastNotarizedHdr(sharding.MetachainShardId)
 	if err != nil {
@@ -755,6 +935,117 @@ func (sp *shardProcessor) CommitBlock(
 	return nil
 }
 
+// removeCompletedOriginalMiniBlocksFromPool moves in storage the original miniblocks whose last part has been
+// executed in the given block body
+func (sp *shardProcessor) removeCompletedOriginalMiniBlocksFromPool(body block.Body) {
+	miniBlocksPool := sp.dataPool.MiniBlocks()
+	for _, miniBlock := range body {
+		partialExecution := miniBlock.PartialExecution
+		if partialExecution == nil || !partialExecution.IsLastPart(len(miniBlock.TxHashes)) {
+			continue
+		}
+
+		obj, ok := miniBlocksPool.Peek(partialExecution.OriginalHash)
+		if !ok {
+			continue
+		}
+
+		buff, err := sp.marshalizer.Marshal(obj)
+		if err != nil {
+			log.Debug(err.Error())
+			continue
+		}
+
+		err = sp.store.Put(dataRetriever.MiniBlockUnit, partialExecution.OriginalHash, buff)
+		log.LogIfError(err)
+
+		miniBlocksPool.Remove(partialExecution.OriginalHash)
+	}
+}
+
+// addNotarizedHeadersToBlockTracker adds in the block tracker the processed meta headers, as cross notarized, and
+// the self headers included in them, as self notarized by metachain
+func (sp *shardProcessor) addNotarizedHeadersToBlockTracker(
+	processedMetaHdrs []data.HeaderHandler,
+	selfNotarizedHdrs []data.HeaderHandler,
+	selfNotarizedHdrsHashes [][]byte,
+) {
+	for _, metaHdr := range processedMetaHdrs {
+		metaHdrHash, err := core.CalculateHash(sp.marshalizer, sp.hasher, metaHdr)
+		if err != nil {
+			log.Debug(err.Error())
+			continue
+		}
+
+		sp.blockTracker.AddCrossNotarizedHeader(sharding.MetachainShardId, metaHdr, metaHdrHash)
+	}
+
+	for i := 0; i < len(selfNotarizedHdrs); i++ {
+		if selfNotarizedHdrs[i].GetNonce() == 0 {
+			continue
+		}
+
+		sp.blockTracker.AddSelfNotarizedHeader(sharding.MetachainShardId, selfNotarizedHdrs[i], selfNotarizedHdrsHashes[i])
+	}
+}
+
+// appendLastSelfNotarizedHeader appends to the given final headers the highest self header notarized by metachain,
+// as it is known by the block tracker, so the fork detector could use it even if it was notarized in older meta blocks
+func (sp *shardProcessor) appendLastSelfNotarizedHeader(
+	finalHeaders []data.HeaderHandler,
+	finalHeadersHashes [][]byte,
+) ([]data.HeaderHandler, [][]byte) {
+	lastSelfNotarizedHdr, lastSelfNotarizedHdrHash, err := sp.blockTracker.GetLastSelfNotarizedHeader(sharding.MetachainShardId)
+	if err != nil {
+		return finalHeaders, finalHeadersHashes
+	}
+
+	for i := 0; i < len(finalHeaders); i++ {
+		if finalHeaders[i].GetNonce() >= lastSelfNotarizedHdr.GetNonce() {
+			return finalHeaders, finalHeadersHashes
+		}
+	}
+
+	return append(finalHeaders, lastSelfNotarizedHdr), append(finalHeadersHashes, lastSelfNotarizedHdrHash)
+}
+
+// accumulateProtocolSustainabilityRewards adds the protocol sustainability rewards processed in the committed block
+// to the accumulated amount, exposed as a status metric
+func (sp *shardProcessor) accumulateProtocolSustainabilityRewards() {
+	protocolSustainabilityAddress := sp.specialAddressHandler.ProtocolSustainabilityAddress()
+	rewardTxs := sp.txCoordinator.GetAllCurrentUsedTxs(block.RewardsBlock)
+
+	accumulated := false
+	for _, rewardTx := range rewardTxs {
+		if !bytes.Equal(rewardTx.GetRecvAddress(), protocolSustainabilityAddress) {
+			continue
+		}
+
+		sp.protocolSustainabilityAccumulated.Add(sp.protocolSustainabilityAccumulated, rewardTx.GetValue())
+		accumulated = true
+	}
+
+	if accumulated {
+		sp.appStatusHandler.SetStringValue(core.MetricProtocolSustainabilityAccumulated, sp.protocolSustainabilityAccumulated.String())
+	}
+}
+
+// accountSupply hands the reward transactions of the committed block to the supply accountant, if the supply
+// accounting is enabled
+func (sp *shardProcessor) accountSupply(header data.HeaderHandler) {
+	if !sp.supplyAccountant.IsEnabled() {
+		return
+	}
+
+	sp.supplyAccountant.AccountCommittedBlock(header, sp.txCoordinator.GetAllCurrentUsedTxs(block.RewardsBlock))
+}
+
+// RevertAccountState reverts the account state and drops the token supply changes made by the failed block
+func (sp *shardProcessor) RevertAccountState() {
+	sp.baseProcessor.RevertAccountState()
+	sp.esdtSupplyTracker.RevertPendingChanges()
+}
+
 func (sp *shardProcessor) cleanTxsPools() {
 	_, err := sp.txsPoolsCleaner.Clean(maxCleanTime)
 	log.LogIfError(err)
@@ -839,7 +1130,16 @@ func (sp *shardProcessor) getOrderedProcessedMetaBlocksFromHeader(header *block.
 
 	miniBlockHashes := make(map[int][]byte, len(header.MiniBlockHeaders))
 	for i := 0; i < len(header.MiniBlockHeaders); i++ {
-		miniBlockHashes[i] = header.MiniBlockHeaders[i].Hash
+		miniBlockHash, isFullyProcessed := getFullyProcessedMiniBlockHash(
+			header.MiniBlockHeaders[i].Hash,
+			header.MiniBlockHeaders[i].PartialExecution,
+			int(header.MiniBlockHeaders[i].TxCount),
+		)
+		if !isFullyProcessed {
+			continue
+		}
+
+		miniBlockHashes[i] = miniBlockHash
 	}
 
 	log.Debug(fmt.Sprintf("cross mini blocks in body: %d\n", len(miniBlockHashes)))
@@ -860,6 +1160,9 @@ func (sp *shardProcessor) addProcessedCrossMiniBlocksFromHeader(header *block.He
 	miniBlockHashes := make(map[int][]byte, len(header.MiniBlockHeaders))
 	for i := 0; i < len(header.MiniBlockHeaders); i++ {
 		miniBlockHashes[i] = header.MiniBlockHeaders[i].Hash
+		if header.MiniBlockHeaders[i].PartialExecution != nil {
+			miniBlockHashes[i] = header.MiniBlockHeaders[i].PartialExecution.OriginalHash
+		}
 	}
 
 	sp.hdrsForCurrBlock.mutHdrsForBlock.RLock()
@@ -883,7 +1186,17 @@ func (sp *shardProcessor) addProcessedCrossMiniBlocksFromHeader(header *block.He
 				continue
 			}
 
-			sp.addProcessedMiniBlock(metaBlockHash, miniBlockHash)
+			processedMbInfo := &processedMb.ProcessedMiniBlockInfo{IsFullyProcessed: true}
+			partialExecution := header.MiniBlockHeaders[key].PartialExecution
+			txCount := header.MiniBlockHeaders[key].TxCount
+			if partialExecution != nil && !partialExecution.IsLastPart(int(txCount)) {
+				processedMbInfo = &processedMb.ProcessedMiniBlockInfo{
+					IsFullyProcessed:       false,
+					IndexOfLastTxProcessed: int32(partialExecution.IndexOfFirstTx+txCount) - 1,
+				}
+			}
+
+			sp.processedMiniBlocks.SetProcessedMiniBlockInfo(metaBlockHash, miniBlockHash, processedMbInfo)
 
 			delete(miniBlockHashes, key)
 		}
@@ -910,6 +1223,15 @@ func (sp *shardProcessor) getOrderedProcessedMetaBlocksFromMiniBlocks(
 			continue
 		}
 
+		miniBlockHash, isFullyProcessed := getFullyProcessedMiniBlockHash(
+			miniBlockHash,
+			usedMiniBlocks[i].PartialExecution,
+			len(usedMiniBlocks[i].TxHashes),
+		)
+		if !isFullyProcessed {
+			continue
+		}
+
 		miniBlockHashes[i] = miniBlockHash
 	}
 
@@ -919,6 +1241,20 @@ func (sp *shardProcessor) getOrderedProcessedMetaBlocksFromMiniBlocks(
 	return processedMetaBlocks, err
 }
 
+// getFullyProcessedMiniBlockHash returns the hash of the miniblock which is fully processed once the given miniblock
+// is executed: the miniblock itself, or its original miniblock when it is the last part of a partially executed one
+func getFullyProcessedMiniBlockHash(
+	miniBlockHash []byte,
+	partialExecution *block.PartialExecution,
+	txCount int,
+) ([]byte, bool) {
+	if partialExecution == nil {
+		return miniBlockHash, true
+	}
+
+	return partialExecution.OriginalHash, partialExecution.IsLastPart(txCount)
+}
+
 func (sp *shardProcessor) getOrderedProcessedMetaBlocksFromMiniBlockHashes(
 	miniBlockHashes map[int][]byte,
 ) ([]data.HeaderHandler, error) {
@@ -942,7 +1278,7 @@ func (sp *shardProcessor) getOrderedProcessedMetaBlocksFromMiniBlockHashes(
 
 		crossMiniBlockHashes := metaBlock.GetMiniBlockHeadersWithDst(sp.shardCoordinator.SelfId())
 		for hash := range crossMiniBlockHashes {
-			processedCrossMiniBlocksHashes[hash] = sp.isMiniBlockProcessed([]byte(metaBlockHash), []byte(hash))
+			processedCrossMiniBlocksHashes[hash] = sp.processedMiniBlocks.IsMiniBlockFullyProcessed([]byte(metaBlockHash), []byte(hash))
 		}
 
 		for key, miniBlockHash := range miniBlockHashes {
@@ -1016,7 +1352,7 @@ func (sp *shardProcessor) removeProcessedMetaBlocksFromPool(processedMetaHdrs []
 
 		sp.dataPool.MetaBlocks().Remove(headerHash)
 		sp.dataPool.HeadersNonces().Remove(hdr.GetNonce(), sharding.MetachainShardId)
-		sp.removeAllProcessedMiniBlocks(headerHash)
+		sp.processedMiniBlocks.RemoveMetaBlockHash(headerHash)
 
 		log.Debug(fmt.Sprintf("metaBlock with round %d nonce %d and hash %s has been processed completely and removed from pool\n",
 			hdr.GetRound(),
@@ -1036,18 +1372,13 @@ func (sp *shardProcessor) removeProcessedMetaBlocksFromPool(processedMetaHdrs []
 // receivedMetaBlock is a callback function when a new metablock was received
 // upon receiving, it parses the new metablock and requests miniblocks and transactions
 // which destination is the current shard
-func (sp *shardProcessor) receivedMetaBlock(metaBlockHash []byte) {
+func (sp *shardProcessor) receivedMetaBlock(headerHandler data.HeaderHandler, metaBlockHash []byte) {
 	metaBlockPool := sp.dataPool.MetaBlocks()
 	if metaBlockPool == nil {
 		return
 	}
 
-	obj, ok := metaBlockPool.Peek(metaBlockHash)
-	if !ok {
-		return
-	}
-
-	metaBlock, ok := obj.(*block.MetaBlock)
+	metaBlock, ok := headerHandler.(*block.MetaBlock)
 	if !ok {
 		return
 	}
@@ -1179,9 +1510,19 @@ func (sp *shardProcessor) verifyCrossShardMiniBlockDstMe(header *block.Header) e
 		return err
 	}
 
-	crossMiniBlockHashes := header.GetMiniBlockHeadersWithDst(sp.shardCoordinator.SelfId())
-	for hash := range crossMiniBlockHashes {
-		if _, ok := miniBlockMetaHashes[hash]; !ok {
+	selfId := sp.shardCoordinator.SelfId()
+	for _, mbHdr := range header.MiniBlockHeaders {
+		isCrossShardDstMe := mbHdr.ReceiverShardID == selfId && mbHdr.SenderShardID != selfId
+		if !isCrossShardDstMe {
+			continue
+		}
+
+		hash := mbHdr.Hash
+		if mbHdr.PartialExecution != nil {
+			hash = mbHdr.PartialExecution.OriginalHash
+		}
+
+		if _, ok := miniBlockMetaHashes[string(hash)]; !ok {
 			return process.ErrCrossShardMBWithoutConfirmationFromMeta
 		}
 	}
@@ -1189,6 +1530,95 @@ func (sp *shardProcessor) verifyCrossShardMiniBlockDstMe(header *block.Header) e
 	return nil
 }
 
+// verifyPartialMiniBlocks checks that every partially executed miniblock from the given body is a sequence of
+// transactions of its original miniblock which resumes the execution from where the previous blocks left it
+func (sp *shardProcessor) verifyPartialMiniBlocks(body block.Body) error {
+	miniBlocksPool := sp.dataPool.MiniBlocks()
+	if miniBlocksPool == nil {
+		return process.ErrNilMiniBlockPool
+	}
+
+	usedOriginalHashes := make(map[string]struct{})
+	for _, miniBlock := range body {
+		if miniBlock.SenderShardID == sp.shardCoordinator.SelfId() {
+			continue
+		}
+
+		partialExecution := miniBlock.PartialExecution
+		if partialExecution == nil {
+			miniBlockHash, err := core.CalculateHash(sp.marshalizer, sp.hasher, miniBlock)
+			if err != nil {
+				return err
+			}
+
+			processedMbInfo, _ := sp.processedMiniBlocks.GetProcessedMiniBlockInfo(miniBlockHash)
+			if !processedMbInfo.IsFullyProcessed && processedMbInfo.IndexOfLastTxProcessed >= 0 {
+				return process.ErrInvalidPartialMiniBlock
+			}
+
+			continue
+		}
+
+		_, isUsed := usedOriginalHashes[string(partialExecution.OriginalHash)]
+		if isUsed {
+			return process.ErrInvalidPartialMiniBlock
+		}
+		usedOriginalHashes[string(partialExecution.OriginalHash)] = struct{}{}
+
+		obj, ok := miniBlocksPool.Peek(partialExecution.OriginalHash)
+		if !ok {
+			return process.ErrMissingOriginalMiniBlock
+		}
+		original, ok := obj.(*block.MiniBlock)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		processedMbInfo, _ := sp.processedMiniBlocks.GetProcessedMiniBlockInfo(partialExecution.OriginalHash)
+		err := checkMiniBlockPart(miniBlock, original, processedMbInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkMiniBlockPart(
+	miniBlock *block.MiniBlock,
+	original *block.MiniBlock,
+	processedMbInfo *processedMb.ProcessedMiniBlockInfo,
+) error {
+	partialExecution := miniBlock.PartialExecution
+	isSameType := miniBlock.SenderShardID == original.SenderShardID &&
+		miniBlock.ReceiverShardID == original.ReceiverShardID &&
+		miniBlock.Type == original.Type
+	if !isSameType {
+		return process.ErrInvalidPartialMiniBlock
+	}
+	if processedMbInfo.IsFullyProcessed {
+		return process.ErrInvalidPartialMiniBlock
+	}
+
+	firstIndex := int(partialExecution.IndexOfFirstTx)
+	lastIndex := firstIndex + len(miniBlock.TxHashes)
+	isValidRange := int(partialExecution.OriginalTxCount) == len(original.TxHashes) &&
+		len(miniBlock.TxHashes) > 0 &&
+		lastIndex <= len(original.TxHashes) &&
+		firstIndex == int(processedMbInfo.IndexOfLastTxProcessed)+1
+	if !isValidRange {
+		return process.ErrInvalidPartialMiniBlock
+	}
+
+	for i, txHash := range miniBlock.TxHashes {
+		if !bytes.Equal(txHash, original.TxHashes[firstIndex+i]) {
+			return process.ErrInvalidPartialMiniBlock
+		}
+	}
+
+	return nil
+}
+
 func (sp *shardProcessor) getAllMiniBlockDstMeFromMeta(header *block.Header) (map[string][]byte, error) {
 	lastHdr, err := sp.getLastNotarizedHdr(sharding.MetachainShardId)
 	if err != nil {
@@ -1239,6 +1669,7 @@ func (sp *shardProcessor) getOrderedMetaBlocks(round uint64) ([]*hashAndHdr, err
 	}
 
 	orderedMetaBlocks := make([]*hashAndHdr, 0)
+	existingHashes := make(map[string]struct{})
 	for _, key := range metaBlocksPool.Keys() {
 		val, _ := metaBlocksPool.Peek(key)
 		if val == nil {
@@ -1261,6 +1692,22 @@ func (sp *shardProcessor) getOrderedMetaBlocks(round uint64) ([]*hashAndHdr, err
 		}
 
 		orderedMetaBlocks = append(orderedMetaBlocks, &hashAndHdr{hdr: hdr, hash: key})
+		existingHashes[string(key)] = struct{}{}
+	}
+
+	// the meta headers from the longest chain known by the block tracker are also candidates, even if they are no
+	// longer in the pool
+	longestChainHdrs, longestChainHdrsHashes := sp.blockTracker.ComputeLongestChain(sharding.MetachainShardId, lastHdr)
+	for i := 0; i < len(longestChainHdrs); i++ {
+		_, exists := existingHashes[string(longestChainHdrsHashes[i])]
+		if exists {
+			continue
+		}
+		if longestChainHdrs[i].GetRound() > round {
+			break
+		}
+
+		orderedMetaBlocks = append(orderedMetaBlocks, &hashAndHdr{hdr: longestChainHdrs[i], hash: longestChainHdrsHashes[i]})
 	}
 
 	if len(orderedMetaBlocks) > 1 {
@@ -1386,10 +1833,10 @@ func (sp *shardProcessor) createAndProcessCrossMiniBlocksDstMe(
 			uint32(len(miniBlocks)))
 
 		if maxTxSpaceRemained > 0 && maxMbSpaceRemained > 0 {
-			processedMiniBlocksHashes := sp.getProcessedMiniBlocksHashes(orderedMetaBlocks[i].hash)
+			processedMiniBlocksInfo := sp.processedMiniBlocks.GetProcessedMiniBlocksInfo(orderedMetaBlocks[i].hash)
 			currMBProcessed, currTxsAdded, hdrProcessFinished := sp.txCoordinator.CreateMbsAndProcessCrossShardTransactionsDstMe(
 				hdr,
-				processedMiniBlocksHashes,
+				processedMiniBlocksInfo,
 				uint32(maxTxSpaceRemained),
 				uint32(maxMbSpaceRemained),
 				round,
@@ -1488,6 +1935,8 @@ func (sp *shardProcessor) CreateBlockHeader(bodyHandler data.BodyHandler, round
 		ShardId:          sp.shardCoordinator.SelfId(),
 		PrevRandSeed:     make([]byte, 0),
 		RandSeed:         make([]byte, 0),
+		ChainID:          sp.chainID,
+		SoftwareVersion:  sp.softwareVersion,
 	}
 
 	defer func() {
@@ -1516,16 +1965,21 @@ func (sp *shardProcessor) CreateBlockHeader(bodyHandler data.BodyHandler, round
 		}
 
 		miniBlockHeaders[i] = block.MiniBlockHeader{
-			Hash:            miniBlockHash,
-			SenderShardID:   body[i].SenderShardID,
-			ReceiverShardID: body[i].ReceiverShardID,
-			TxCount:         uint32(txCount),
-			Type:            body[i].Type,
+			Hash:             miniBlockHash,
+			SenderShardID:    body[i].SenderShardID,
+			ReceiverShardID:  body[i].ReceiverShardID,
+			TxCount:          uint32(txCount),
+			Type:             body[i].Type,
+			PartialExecution: body[i].PartialExecution,
 		}
 	}
 
 	header.MiniBlockHeaders = miniBlockHeaders
 	header.TxCount = uint32(totalTxCount)
+	if sp.blockFeesHandler.IsFeeBurnEnabled() {
+		header.AccumulatedFees = sp.blockFeesHandler.GetAccumulatedFees()
+		header.BurnedFees = sp.blockFeesHandler.GetBurnedFees()
+	}
 	metaBlockHashes := sp.sortHeaderHashesForCurrentBlockByNonce(true)
 	header.MetaBlockHashes = metaBlockHashes[sharding.MetachainShardId]
 
@@ -1621,57 +2075,11 @@ func (sp *shardProcessor) IsInterfaceNil() bool {
 }
 
 func (sp *shardProcessor) addProcessedMiniBlock(metaBlockHash []byte, miniBlockHash []byte) {
-	sp.mutProcessedMiniBlocks.Lock()
-	miniBlocksProcessed, ok := sp.processedMiniBlocks[string(metaBlockHash)]
-	if !ok {
-		miniBlocksProcessed := make(map[string]struct{})
-		miniBlocksProcessed[string(miniBlockHash)] = struct{}{}
-		sp.processedMiniBlocks[string(metaBlockHash)] = miniBlocksProcessed
-		sp.mutProcessedMiniBlocks.Unlock()
-		return
-	}
-
-	miniBlocksProcessed[string(miniBlockHash)] = struct{}{}
-	sp.mutProcessedMiniBlocks.Unlock()
-}
-
-func (sp *shardProcessor) removeProcessedMiniBlock(miniBlockHash []byte) {
-	sp.mutProcessedMiniBlocks.Lock()
-	for _, miniBlocksProcessed := range sp.processedMiniBlocks {
-		_, isProcessed := miniBlocksProcessed[string(miniBlockHash)]
-		if isProcessed {
-			delete(miniBlocksProcessed, string(miniBlockHash))
-		}
-	}
-	sp.mutProcessedMiniBlocks.Unlock()
-}
-
-func (sp *shardProcessor) removeAllProcessedMiniBlocks(metaBlockHash []byte) {
-	sp.mutProcessedMiniBlocks.Lock()
-	delete(sp.processedMiniBlocks, string(metaBlockHash))
-	sp.mutProcessedMiniBlocks.Unlock()
-}
-
-func (sp *shardProcessor) getProcessedMiniBlocksHashes(metaBlockHash []byte) map[string]struct{} {
-	sp.mutProcessedMiniBlocks.RLock()
-	processedMiniBlocksHashes := sp.processedMiniBlocks[string(metaBlockHash)]
-	sp.mutProcessedMiniBlocks.RUnlock()
-
-	return processedMiniBlocksHashes
-}
-
-func (sp *shardProcessor) isMiniBlockProcessed(metaBlockHash []byte, miniBlockHash []byte) bool {
-	sp.mutProcessedMiniBlocks.RLock()
-	miniBlocksProcessed, ok := sp.processedMiniBlocks[string(metaBlockHash)]
-	if !ok {
-		sp.mutProcessedMiniBlocks.RUnlock()
-		return false
-	}
-
-	_, isProcessed := miniBlocksProcessed[string(miniBlockHash)]
-	sp.mutProcessedMiniBlocks.RUnlock()
-
-	return isProcessed
+	sp.processedMiniBlocks.SetProcessedMiniBlockInfo(
+		metaBlockHash,
+		miniBlockHash,
+		&processedMb.ProcessedMiniBlockInfo{IsFullyProcessed: true},
+	)
 }
 
 func (sp *shardProcessor) getMaxMiniBlocksSpaceRemained(
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
@@ -25,6 +27,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	blproc "github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -55,6 +58,9 @@ func createTestShardDataPool() dataRetriever.PoolsHolder {
 	cacherCfg = storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache, Shards: 1}
 	metaBlocks, _ := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
 
+	cacherCfg = storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache, Shards: 1}
+	peerAuthentications, _ := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
+
 	dPool, _ := dataPool.NewShardedDataPool(
 		txPool,
 		uTxPool,
@@ -64,6 +70,7 @@ func createTestShardDataPool() dataRetriever.PoolsHolder {
 		txBlockBody,
 		peerChangeBlockBody,
 		metaBlocks,
+		peerAuthentications,
 	)
 
 	return dPool
@@ -213,6 +220,28 @@ func TestNewShardProcessor_NilForkDetectorShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilBlockTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.BlockTracker = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilBlockTracker, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilHeadersNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.HeadersNotifier = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilHeadersNotifier, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_NilRequestTransactionHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -250,6 +279,61 @@ func TestNewShardProcessor_NilTxCoordinator(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilBlockChainHook(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.BlockChainHook = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilBlockChainHook, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilEpochNotifier(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.EpochNotifier = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilSupplyAccountant(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.SupplyAccountant = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilSupplyAccountant, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilESDTSupplyTracker(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.ESDTSupplyTracker = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilESDTSupplyTracker, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilBlockFeesHandler(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.BlockFeesHandler = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilBlockFeesHandler, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_NilUint64Converter(t *testing.T) {
 	t.Parallel()
 
@@ -261,6 +345,61 @@ func TestNewShardProcessor_NilUint64Converter(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilHistoryRepository(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.HistoryRepository = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilHistoryRepository, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilOutgoingOperations(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.OutgoingOperations = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilOutgoingOperationsHandler, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilSelectionAuditor(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.SelectionAuditor = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilValidatorsSelectionAuditor, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_EmptyChainID(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.ChainID = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrInvalidChainID, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_EmptySoftwareVersion(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.SoftwareVersion = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrInvalidSoftwareVersion, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -470,6 +609,7 @@ func TestShardProcessor_ProcessBlockWithInvalidTransactionShouldErr(t *testing.T
 				return miniBlocks, nil
 			},
 		},
+		&mock.GasHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -664,6 +804,7 @@ func TestShardProcessor_ProcessBlockWithErrOnProcessBlockTransactionsCallShouldR
 				return miniBlocks, nil
 			},
 		},
+		&mock.GasHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -1577,7 +1718,7 @@ func TestShardProcessor_CommitBlockStorageFailsForHeaderShouldErr(t *testing.T)
 	rootHash := []byte("root hash to be tested")
 	accounts := &mock.AccountsStub{
 		CommitCalled: func() ([]byte, error) {
-			return nil, nil
+			return rootHash, nil
 		},
 		RootHashCalled: func() ([]byte, error) {
 			return rootHash, nil
@@ -1644,7 +1785,7 @@ func TestShardProcessor_CommitBlockStorageFailsForBodyShouldWork(t *testing.T) {
 			return rootHash, nil
 		},
 		CommitCalled: func() (i []byte, e error) {
-			return nil, nil
+			return rootHash, nil
 		},
 		RevertToSnapshotCalled: func(snapshot int) error {
 			return nil
@@ -1819,6 +1960,7 @@ func TestShardProcessor_CommitBlockNoTxInPoolShouldErr(t *testing.T) {
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
+		&mock.GasHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -1921,6 +2063,16 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	arguments.Hasher = hasher
 	arguments.Accounts = accounts
 	arguments.ForkDetector = fd
+	var trieStatisticsHeader data.HeaderHandler
+	arguments.Core = &mock.ServiceContainerMock{
+		TrieStatisticsCalled: func() statistics.TrieStatistics {
+			return &mock.TrieStatisticsStub{
+				BlockCommittedCalled: func(header data.HeaderHandler) {
+					trieStatisticsHeader = header
+				},
+			}
+		},
+	}
 	sp, _ := blproc.NewShardProcessor(arguments)
 
 	blkc := createTestBlockchain()
@@ -1936,10 +2088,111 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, forkDetectorAddCalled)
 	assert.Equal(t, hdrHash, blkc.GetCurrentBlockHeaderHash())
+	assert.Equal(t, hdr, trieStatisticsHeader)
+	//this should sleep as there is an async call to display current hdr and block in CommitBlock
+	time.Sleep(time.Second)
+}
+
+func commitBlockWithInconsistentState(recreateTrie func(rootHash []byte) error) (error, bool) {
+	tdp := initDataPool([]byte("tx_hash1"))
+	rootHash := []byte("root hash")
+	prevRootHash := []byte("prev root hash")
+	hdrHash := []byte("header hash")
+	randSeed := []byte("rand seed")
+
+	prevHdr := &block.Header{
+		Nonce:     0,
+		Round:     0,
+		Signature: rootHash,
+		RootHash:  prevRootHash,
+		RandSeed:  randSeed,
+	}
+	hdr := &block.Header{
+		Nonce:         1,
+		Round:         1,
+		PubKeysBitmap: rootHash,
+		PrevHash:      hdrHash,
+		Signature:     rootHash,
+		RootHash:      rootHash,
+		PrevRandSeed:  randSeed,
+	}
+	body := make(block.Body, 0)
+
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() ([]byte, error) {
+			return []byte("corrupted root hash"), nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+		RecreateTrieCalled: recreateTrie,
+	}
+	forkDetectorAddCalled := false
+	hasher := &mock.HasherStub{}
+	hasher.ComputeCalled = func(s string) []byte {
+		return hdrHash
+	}
+
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.DataPool = tdp
+	arguments.Store = initStore()
+	arguments.Hasher = hasher
+	arguments.Accounts = accounts
+	arguments.ForkDetector = &mock.ForkDetectorMock{
+		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, finalHeaders []data.HeaderHandler, finalHeadersHashes [][]byte) error {
+			forkDetectorAddCalled = true
+			return nil
+		},
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	blkc := createTestBlockchain()
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return prevHdr
+	}
+	blkc.GetCurrentBlockHeaderHashCalled = func() []byte {
+		return hdrHash
+	}
+
+	return sp.CommitBlock(blkc, hdr, body), forkDetectorAddCalled
+}
+
+func TestShardProcessor_CommitBlockInconsistentStateShouldRepairFromHeaderRootHash(t *testing.T) {
+	t.Parallel()
+
+	recreatedRootHashes := make([][]byte, 0)
+	err, committed := commitBlockWithInconsistentState(func(rootHash []byte) error {
+		recreatedRootHashes = append(recreatedRootHashes, rootHash)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.True(t, committed)
+	assert.Equal(t, [][]byte{[]byte("root hash")}, recreatedRootHashes)
 	//this should sleep as there is an async call to display current hdr and block in CommitBlock
 	time.Sleep(time.Second)
 }
 
+func TestShardProcessor_CommitBlockInconsistentStateShouldRejectBlockAndRecreatePreviousState(t *testing.T) {
+	t.Parallel()
+
+	recreatedRootHashes := make([][]byte, 0)
+	err, committed := commitBlockWithInconsistentState(func(rootHash []byte) error {
+		recreatedRootHashes = append(recreatedRootHashes, rootHash)
+		if bytes.Equal(rootHash, []byte("root hash")) {
+			return errors.New("missing trie node")
+		}
+		return nil
+	})
+
+	assert.Equal(t, process.ErrRootStateDoesNotMatch, err)
+	assert.False(t, committed)
+	assert.Equal(t, [][]byte{[]byte("root hash"), []byte("prev root hash")}, recreatedRootHashes)
+}
+
 func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
@@ -2309,6 +2562,86 @@ func TestShardProcessor_CreateBlockHeaderReturnsOK(t *testing.T) {
 	})
 	assert.Nil(t, err)
 	assert.Equal(t, len(body), len(mbHeaders.(*block.Header).MiniBlockHeaders))
+	assert.Nil(t, mbHeaders.(*block.Header).AccumulatedFees)
+	assert.Nil(t, mbHeaders.(*block.Header).BurnedFees)
+	assert.Equal(t, arguments.ChainID, mbHeaders.GetChainID())
+	assert.Equal(t, arguments.SoftwareVersion, mbHeaders.GetSoftwareVersion())
+}
+
+func TestShardProcessor_CreateBlockHeaderWithFeeBurnEnabledShouldSetTheFees(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.BlockFeesHandler = createFeeBurnEnabledBlockFeesHandler(big.NewInt(50), big.NewInt(20))
+	bp, _ := blproc.NewShardProcessor(arguments)
+	body := block.Body{
+		{
+			ReceiverShardID: 1,
+			SenderShardID:   0,
+			TxHashes:        [][]byte{[]byte("tx hash")},
+		},
+	}
+
+	hdr, err := bp.CreateBlockHeader(body, 0, func() bool {
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), hdr.(*block.Header).AccumulatedFees)
+	assert.Equal(t, big.NewInt(20), hdr.(*block.Header).BurnedFees)
+}
+
+//------- verifyFees
+
+func createFeeBurnEnabledBlockFeesHandler(accumulatedFees *big.Int, burnedFees *big.Int) *mock.BlockFeesHandlerStub {
+	return &mock.BlockFeesHandlerStub{
+		IsFeeBurnEnabledCalled: func() bool {
+			return true
+		},
+		GetAccumulatedFeesCalled: func() *big.Int {
+			return accumulatedFees
+		},
+		GetBurnedFeesCalled: func() *big.Int {
+			return burnedFees
+		},
+	}
+}
+
+func TestShardProcessor_VerifyFeesFeeBurnDisabledShouldRejectTheFees(t *testing.T) {
+	t.Parallel()
+
+	sp, _ := blproc.NewShardProcessor(CreateMockArguments())
+
+	assert.Nil(t, sp.VerifyFees(&block.Header{}))
+	assert.Equal(t, process.ErrAccumulatedFeesDoNotMatch, sp.VerifyFees(&block.Header{AccumulatedFees: big.NewInt(0)}))
+	assert.Equal(t, process.ErrBurnedFeesDoNotMatch, sp.VerifyFees(&block.Header{BurnedFees: big.NewInt(0)}))
+}
+
+func TestShardProcessor_VerifyFeesFeeBurnEnabledShouldCompareWithTheComputedFees(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.BlockFeesHandler = createFeeBurnEnabledBlockFeesHandler(big.NewInt(50), big.NewInt(20))
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	err := sp.VerifyFees(&block.Header{AccumulatedFees: big.NewInt(50), BurnedFees: big.NewInt(20)})
+	assert.Nil(t, err)
+
+	err = sp.VerifyFees(&block.Header{AccumulatedFees: big.NewInt(49), BurnedFees: big.NewInt(20)})
+	assert.Equal(t, process.ErrAccumulatedFeesDoNotMatch, err)
+
+	err = sp.VerifyFees(&block.Header{AccumulatedFees: big.NewInt(50)})
+	assert.Equal(t, process.ErrBurnedFeesDoNotMatch, err)
+}
+
+func TestShardProcessor_VerifyFeesFeeBurnEnabledOmittedZeroFeesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.BlockFeesHandler = createFeeBurnEnabledBlockFeesHandler(big.NewInt(0), big.NewInt(0))
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	err := sp.VerifyFees(&block.Header{})
+	assert.Nil(t, err)
 }
 
 func TestShardProcessor_CommitBlockShouldRevertAccountStateWhenErr(t *testing.T) {
@@ -2330,6 +2663,31 @@ func TestShardProcessor_CommitBlockShouldRevertAccountStateWhenErr(t *testing.T)
 	assert.Equal(t, 0, journalEntries)
 }
 
+func TestShardProcessor_RevertAccountStateShouldDropThePendingTokenSupplyChanges(t *testing.T) {
+	t.Parallel()
+
+	accountsReverted := false
+	pendingChangesReverted := false
+	arguments := CreateMockArguments()
+	arguments.Accounts = &mock.AccountsStub{
+		RevertToSnapshotCalled: func(snapshot int) error {
+			accountsReverted = true
+			return nil
+		},
+	}
+	arguments.ESDTSupplyTracker = &mock.ESDTSupplyTrackerStub{
+		RevertPendingChangesCalled: func() {
+			pendingChangesReverted = true
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	sp.RevertAccountState()
+
+	assert.True(t, accountsReverted)
+	assert.True(t, pendingChangesReverted)
+}
+
 func TestShardProcessor_MarshalizedDataToBroadcastShouldWork(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
@@ -2370,6 +2728,7 @@ func TestShardProcessor_MarshalizedDataToBroadcastShouldWork(t *testing.T) {
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
+		&mock.GasHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -2474,6 +2833,7 @@ func TestShardProcessor_MarshalizedDataMarshalWithoutSuccess(t *testing.T) {
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
+		&mock.GasHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -2569,7 +2929,7 @@ func TestShardProcessor_ReceivedMetaBlockShouldRequestMissingMiniBlocks(t *testi
 	arguments.TxCoordinator = tc
 
 	bp, _ := blproc.NewShardProcessor(arguments)
-	bp.ReceivedMetaBlock(metaBlockHash)
+	bp.ReceivedMetaBlock(metaBlock, metaBlockHash)
 
 	//we have to wait to be sure txHash1Requested is not incremented by a late call
 	time.Sleep(time.Second)
@@ -2638,10 +2998,57 @@ func TestShardProcessor_ReceivedMetaBlockNoMissingMiniBlocksShouldPass(t *testin
 	arguments.TxCoordinator = tc
 
 	sp, _ := blproc.NewShardProcessor(arguments)
-	sp.ReceivedMetaBlock(metaBlockHash)
+	sp.ReceivedMetaBlock(metaBlock, metaBlockHash)
 	assert.Equal(t, int32(0), atomic.LoadInt32(&noOfMissingMiniBlocks))
 }
 
+func TestShardProcessor_NewShardProcessorShouldRegisterToHeadersNotifier(t *testing.T) {
+	t.Parallel()
+
+	registered := false
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.HeadersNotifier = &mock.HeadersNotifierStub{
+		RegisterHandlerCalled: func(handler func(header data.HeaderHandler, hash []byte)) {
+			registered = true
+		},
+	}
+
+	_, err := blproc.NewShardProcessor(arguments)
+
+	assert.Nil(t, err)
+	assert.True(t, registered)
+}
+
+func TestShardProcessor_GetOrderedMetaBlocksShouldIncludeLongestChainFromBlockTracker(t *testing.T) {
+	t.Parallel()
+
+	datapool := mock.NewPoolsHolderMock()
+	metaBlockInPool := &block.MetaBlock{Nonce: 1, Round: 1}
+	metaBlockInPoolHash := []byte("metablock in pool")
+	datapool.MetaBlocks().Put(metaBlockInPoolHash, metaBlockInPool)
+
+	metaBlockTracked := &block.MetaBlock{Nonce: 2, Round: 2}
+	metaBlockTrackedHash := []byte("metablock tracked")
+	metaBlockTooHigh := &block.MetaBlock{Nonce: 3, Round: 5}
+
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.DataPool = datapool
+	arguments.BlockTracker = &mock.BlockTrackerStub{
+		ComputeLongestChainCalled: func(shardId uint32, header data.HeaderHandler) ([]data.HeaderHandler, [][]byte) {
+			return []data.HeaderHandler{metaBlockInPool, metaBlockTracked, metaBlockTooHigh},
+				[][]byte{metaBlockInPoolHash, metaBlockTrackedHash, []byte("metablock too high")}
+		},
+	}
+
+	sp, _ := blproc.NewShardProcessor(arguments)
+	ordered, err := sp.GetOrderedMetaBlocks(3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ordered))
+	assert.Equal(t, metaBlockInPool, ordered[0].GetHdr())
+	assert.Equal(t, metaBlockTracked, ordered[1].GetHdr())
+}
+
 //--------- createAndProcessCrossMiniBlocksDstMe
 func TestShardProcessor_CreateAndProcessCrossMiniBlocksDstMe(t *testing.T) {
 	t.Parallel()
@@ -2911,6 +3318,7 @@ func TestShardProcessor_CreateMiniBlocksShouldWorkWithIntraShardTxs(t *testing.T
 				return miniBlocks
 			},
 		},
+		&mock.GasHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -3105,6 +3513,7 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
+		&mock.GasHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -4166,6 +4575,61 @@ func TestShardProcessor_GetHighestHdrForOwnShardFromMetachaiMetaHdrsWithOwnHdrSt
 	assert.Equal(t, ownHdr.GetNonce(), hdrs[0].GetNonce())
 }
 
+func TestShardProcessor_VerifyPartialMiniBlocks(t *testing.T) {
+	t.Parallel()
+
+	poolMock := mock.NewPoolsHolderMock()
+	shardC := mock.NewMultiShardsCoordinatorMock(3)
+
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.DataPool = poolMock
+	arguments.ShardCoordinator = shardC
+	arguments.StartHeaders = createGenesisBlocks(shardC)
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	originalHash := []byte("original")
+	original := &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx0"), []byte("tx1"), []byte("tx2")},
+		SenderShardID:   shardC.SelfId() + 1,
+		ReceiverShardID: shardC.SelfId(),
+	}
+	createPart := func(indexOfFirstTx int, numTxs int) *block.MiniBlock {
+		return &block.MiniBlock{
+			TxHashes:        original.TxHashes[indexOfFirstTx : indexOfFirstTx+numTxs],
+			SenderShardID:   original.SenderShardID,
+			ReceiverShardID: original.ReceiverShardID,
+			PartialExecution: &block.PartialExecution{
+				OriginalHash:    originalHash,
+				OriginalTxCount: uint32(len(original.TxHashes)),
+				IndexOfFirstTx:  uint32(indexOfFirstTx),
+			},
+		}
+	}
+
+	err := sp.VerifyPartialMiniBlocks(block.Body{createPart(0, 2)})
+	assert.Equal(t, process.ErrMissingOriginalMiniBlock, err)
+
+	poolMock.MiniBlocks().Put(originalHash, original)
+	err = sp.VerifyPartialMiniBlocks(block.Body{createPart(0, 2)})
+	assert.Nil(t, err)
+
+	err = sp.VerifyPartialMiniBlocks(block.Body{createPart(1, 2)})
+	assert.Equal(t, process.ErrInvalidPartialMiniBlock, err)
+
+	sp.SetProcessedMiniBlockInfo([]byte("meta"), originalHash, &processedMb.ProcessedMiniBlockInfo{
+		IsFullyProcessed:       false,
+		IndexOfLastTxProcessed: 1,
+	})
+	err = sp.VerifyPartialMiniBlocks(block.Body{createPart(2, 1)})
+	assert.Nil(t, err)
+
+	err = sp.VerifyPartialMiniBlocks(block.Body{createPart(0, 2)})
+	assert.Equal(t, process.ErrInvalidPartialMiniBlock, err)
+
+	err = sp.VerifyPartialMiniBlocks(block.Body{createPart(2, 1), createPart(2, 1)})
+	assert.Equal(t, process.ErrInvalidPartialMiniBlock, err)
+}
+
 func TestShardProcessor_RestoreMetaBlockIntoPoolVerifyMiniblocks(t *testing.T) {
 	t.Parallel()
 
@@ -26,6 +26,8 @@ const (
 	SCInvoking
 	// RewardTx defines ID of a reward transaction
 	RewardTx
+	// BuiltInFunctionCall defines ID of a transaction which calls a protocol built-in function
+	BuiltInFunctionCall
 	// InvalidTransaction defines unknown transaction type
 	InvalidTransaction
 )
@@ -41,9 +43,17 @@ const MaxNoncesDifference = 0
 const MaxGasLimitPerMiniBlock = uint64(100000)
 const MaxRequestsWithTimeoutAllowed = 5
 
+// MaxSilentRequestsTimeouts defines the number of consecutive requests on a topic which have to time out, without
+// any data being received on that topic, for the network to be considered unresponsive
+const MaxSilentRequestsTimeouts = 2
+
 // MaxHeadersToRequestInAdvance defines the maximum number of headers which will be requested in advance if they are missing
 const MaxHeadersToRequestInAdvance = 10
 
+// MaxMetaNoncesDelayForPendingMiniBlocks defines the maximum number of meta blocks a cross shard miniblock can wait,
+// after it was notarized, until its destination shard has to process it
+const MaxMetaNoncesDelayForPendingMiniBlocks = 50
+
 // RoundModulusTrigger defines a round modulus on which a trigger for an action will be released
 const RoundModulusTrigger = 10
 
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -35,7 +36,7 @@ type transactionCoordinator struct {
 	onRequestMiniBlock func(shardId uint32, mbHash []byte)
 }
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("process/coordinator")
 
 // NewTransactionCoordinator creates a transaction coordinator to run and coordinate preprocessors and processors
 func NewTransactionCoordinator(
@@ -109,18 +110,24 @@ func NewTransactionCoordinator(
 	return tc, nil
 }
 
-// separateBodyByType creates a map of bodies according to type
+// separateBodyByType creates a map of bodies according to type. The invalid miniblocks are handled by the
+// transactions preprocessor, together with the transaction miniblocks
 func (tc *transactionCoordinator) separateBodyByType(body block.Body) map[block.Type]block.Body {
 	separatedBodies := make(map[block.Type]block.Body)
 
 	for i := 0; i < len(body); i++ {
 		mb := body[i]
 
-		if separatedBodies[mb.Type] == nil {
-			separatedBodies[mb.Type] = block.Body{}
+		mbType := mb.Type
+		if mbType == block.InvalidBlock {
+			mbType = block.TxBlock
 		}
 
-		separatedBodies[mb.Type] = append(separatedBodies[mb.Type], mb)
+		if separatedBodies[mbType] == nil {
+			separatedBodies[mbType] = block.Body{}
+		}
+
+		separatedBodies[mbType] = append(separatedBodies[mbType], mb)
 	}
 
 	return separatedBodies
@@ -366,10 +373,11 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 }
 
 // CreateMbsAndProcessCrossShardTransactionsDstMe creates miniblocks and processes cross shard transaction
-// with destination of current shard
+// with destination of current shard. A miniblock which does not fit in the space or in the gas left in the block is
+// partially executed and its execution resumes, in the next block, from its first unprocessed transaction
 func (tc *transactionCoordinator) CreateMbsAndProcessCrossShardTransactionsDstMe(
 	hdr data.HeaderHandler,
-	processedMiniBlocksHashes map[string]struct{},
+	processedMiniBlocksInfo map[string]*processedMb.ProcessedMiniBlockInfo,
 	maxTxRemaining uint32,
 	maxMbRemaining uint32,
 	round uint64,
@@ -389,10 +397,14 @@ func (tc *transactionCoordinator) CreateMbsAndProcessCrossShardTransactionsDstMe
 			break
 		}
 
-		_, ok := processedMiniBlocksHashes[key]
+		indexOfFirstTx := 0
+		processedMbInfo, ok := processedMiniBlocksInfo[key]
 		if ok {
-			nrMiniBlocksProcessed++
-			continue
+			if processedMbInfo.IsFullyProcessed {
+				nrMiniBlocksProcessed++
+				continue
+			}
+			indexOfFirstTx = int(processedMbInfo.IndexOfLastTxProcessed) + 1
 		}
 
 		miniVal, _ := tc.miniBlockPool.Peek([]byte(key))
@@ -411,8 +423,8 @@ func (tc *transactionCoordinator) CreateMbsAndProcessCrossShardTransactionsDstMe
 			continue
 		}
 
-		// overflow would happen if processing would continue
-		txOverFlow := nrTxAdded+uint32(len(miniBlock.TxHashes)) > maxTxRemaining
+		// no transaction would fit if processing would continue
+		txOverFlow := nrTxAdded >= maxTxRemaining
 		if txOverFlow {
 			return miniBlocks, nrTxAdded, false
 		}
@@ -422,14 +434,29 @@ func (tc *transactionCoordinator) CreateMbsAndProcessCrossShardTransactionsDstMe
 			continue
 		}
 
-		err := tc.processCompleteMiniBlock(preproc, miniBlock, round, haveTime)
+		processedMiniBlock, err := tc.processMiniBlockPart(
+			preproc,
+			miniBlock,
+			[]byte(key),
+			indexOfFirstTx,
+			int(maxTxRemaining-nrTxAdded),
+			round,
+			haveTime,
+		)
 		if err != nil {
 			continue
 		}
 
+		miniBlocks = append(miniBlocks, processedMiniBlock)
+		nrTxAdded = nrTxAdded + uint32(len(processedMiniBlock.TxHashes))
+
+		isPartiallyProcessed := processedMiniBlock.PartialExecution != nil &&
+			!processedMiniBlock.PartialExecution.IsLastPart(len(processedMiniBlock.TxHashes))
+		if isPartiallyProcessed {
+			return miniBlocks, nrTxAdded, false
+		}
+
 		// all txs processed, add to processed miniblocks
-		miniBlocks = append(miniBlocks, miniBlock)
-		nrTxAdded = nrTxAdded + uint32(len(miniBlock.TxHashes))
 		nrMiniBlocksProcessed++
 
 		mbOverFlow := uint32(len(miniBlocks)) >= maxMbRemaining
@@ -680,6 +707,58 @@ func (tc *transactionCoordinator) receivedMiniBlock(miniBlockHash []byte) {
 	_ = preproc.RequestTransactionsForMiniBlock(miniBlock)
 }
 
+// processMiniBlockPart processes the transactions of the miniblock starting with the one at indexOfFirstTx. When
+// they do not fit in the space or in the gas left in the block, the sequence of transactions is halved until it fits.
+// The returned miniblock is the original one if all its transactions were processed at once
+func (tc *transactionCoordinator) processMiniBlockPart(
+	preproc process.PreProcessor,
+	miniBlock *block.MiniBlock,
+	miniBlockHash []byte,
+	indexOfFirstTx int,
+	maxTxs int,
+	round uint64,
+	haveTime func() bool,
+) (*block.MiniBlock, error) {
+
+	numTxs := len(miniBlock.TxHashes) - indexOfFirstTx
+	if numTxs > maxTxs {
+		numTxs = maxTxs
+	}
+
+	for numTxs > 0 {
+		miniBlockPart := createMiniBlockPart(miniBlock, miniBlockHash, indexOfFirstTx, numTxs)
+		err := tc.processCompleteMiniBlock(preproc, miniBlockPart, round, haveTime)
+		if err == nil {
+			return miniBlockPart, nil
+		}
+		if err != process.ErrMaxGasLimitPerBlockReached {
+			return nil, err
+		}
+
+		numTxs = numTxs / 2
+	}
+
+	return nil, process.ErrMaxGasLimitPerBlockReached
+}
+
+func createMiniBlockPart(miniBlock *block.MiniBlock, miniBlockHash []byte, indexOfFirstTx int, numTxs int) *block.MiniBlock {
+	if indexOfFirstTx == 0 && numTxs == len(miniBlock.TxHashes) {
+		return miniBlock
+	}
+
+	return &block.MiniBlock{
+		TxHashes:        miniBlock.TxHashes[indexOfFirstTx : indexOfFirstTx+numTxs],
+		ReceiverShardID: miniBlock.ReceiverShardID,
+		SenderShardID:   miniBlock.SenderShardID,
+		Type:            miniBlock.Type,
+		PartialExecution: &block.PartialExecution{
+			OriginalHash:    miniBlockHash,
+			OriginalTxCount: uint32(len(miniBlock.TxHashes)),
+			IndexOfFirstTx:  uint32(indexOfFirstTx),
+		},
+	}
+}
+
 // processMiniBlockComplete - all transactions must be processed together, otherwise error
 func (tc *transactionCoordinator) processCompleteMiniBlock(
 	preproc process.PreProcessor,
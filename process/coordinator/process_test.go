@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
@@ -50,6 +51,14 @@ func MiniBlocksCompacterMock() *mock.MiniBlocksCompacterMock {
 	}
 }
 
+func GasHandlerMock() *mock.GasHandlerMock {
+	return &mock.GasHandlerMock{
+		ComputeGasConsumedByTxCalled: func(tx data.TransactionHandler) (uint64, uint64) {
+			return 0, tx.GetGasLimit()
+		},
+	}
+}
+
 func createShardedDataChacherNotifier(
 	handler data.TransactionHandler,
 	testHash []byte,
@@ -370,6 +379,27 @@ func TestTransactionCoordinator_SeparateBody(t *testing.T) {
 	assert.Equal(t, 4, len(separated[block.SmartContractResultBlock]))
 }
 
+func TestTransactionCoordinator_SeparateBodyInvalidMiniBlocksShouldBeHandledAsTxBlocks(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := NewTransactionCoordinator(
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.AccountsStub{},
+		mock.NewPoolsHolderMock(),
+		&mock.RequestHandlerMock{},
+		&mock.PreProcessorContainerMock{},
+		&mock.InterimProcessorContainerMock{},
+	)
+
+	invalidMiniBlock := &block.MiniBlock{Type: block.InvalidBlock}
+	body := block.Body{&block.MiniBlock{Type: block.TxBlock}, invalidMiniBlock}
+
+	separated := tc.separateBodyByType(body)
+	assert.Equal(t, 1, len(separated))
+	assert.Equal(t, 2, len(separated[block.TxBlock]))
+	assert.True(t, separated[block.TxBlock][1] == invalidMiniBlock)
+}
+
 func createPreProcessorContainer() process.PreProcessorsContainer {
 	preFactory, _ := shard.NewPreProcessorsContainerFactory(
 		mock.NewMultiShardsCoordinatorMock(5),
@@ -391,6 +421,7 @@ func createPreProcessorContainer() process.PreProcessorsContainer {
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
+		GasHandlerMock(),
 	)
 	container, _ := preFactory.Create()
 
@@ -435,6 +466,7 @@ func createPreProcessorContainerWithDataPool(dataPool dataRetriever.PoolsHolder)
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
+		GasHandlerMock(),
 	)
 	container, _ := preFactory.Create()
 
@@ -708,6 +740,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactions(t *tes
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
+		GasHandlerMock(),
 	)
 	container, _ := preFactory.Create()
 
@@ -744,6 +777,88 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactions(t *tes
 	assert.True(t, finalized)
 }
 
+func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactionsShouldResumePartialExecution(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("txHash")
+	tdp := initDataPool(txHash)
+	cacherCfg := storageUnit.CacheConfig{Size: 100, Type: storageUnit.LRUCache}
+	hdrPool, _ := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
+	tdp.MiniBlocksCalled = func() storage.Cacher {
+		return hdrPool
+	}
+
+	preFactory, _ := shard.NewPreProcessorsContainerFactory(
+		mock.NewMultiShardsCoordinatorMock(5),
+		initStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		tdp,
+		&mock.AddressConverterMock{},
+		&mock.AccountsStub{},
+		&mock.RequestHandlerMock{},
+		&mock.TxProcessorMock{
+			ProcessTransactionCalled: func(transaction *transaction.Transaction, round uint64) error {
+				return nil
+			},
+		},
+		&mock.SCProcessorMock{},
+		&mock.SmartContractResultsProcessorMock{},
+		&mock.RewardTxProcessorMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		FeeHandlerMock(),
+		MiniBlocksCompacterMock(),
+		GasHandlerMock(),
+	)
+	container, _ := preFactory.Create()
+
+	tc, _ := NewTransactionCoordinator(
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.AccountsStub{},
+		tdp,
+		&mock.RequestHandlerMock{},
+		container,
+		&mock.InterimProcessorContainerMock{},
+	)
+
+	haveTime := func() bool {
+		return true
+	}
+	metaHdr := createTestMetablock()
+	crossMbHash := []byte("mb2")
+	for i := 0; i < len(metaHdr.ShardInfo); i++ {
+		for j := 0; j < len(metaHdr.ShardInfo[i].ShardMiniBlockHeaders); j++ {
+			mbHdr := metaHdr.ShardInfo[i].ShardMiniBlockHeaders[j]
+			mb := block.MiniBlock{
+				SenderShardID:   mbHdr.SenderShardId,
+				ReceiverShardID: mbHdr.ReceiverShardId,
+				Type:            block.TxBlock,
+				TxHashes:        [][]byte{txHash, txHash, txHash},
+			}
+			tdp.MiniBlocks().Put(mbHdr.Hash, &mb)
+		}
+	}
+
+	mbs, txs, finalized := tc.CreateMbsAndProcessCrossShardTransactionsDstMe(metaHdr, nil, 2, 15000, 10, haveTime)
+
+	assert.Equal(t, 1, len(mbs))
+	assert.Equal(t, uint32(2), txs)
+	assert.False(t, finalized)
+	assert.Equal(t, &block.PartialExecution{OriginalHash: crossMbHash, OriginalTxCount: 3, IndexOfFirstTx: 0}, mbs[0].PartialExecution)
+
+	processedMiniBlocksInfo := map[string]*processedMb.ProcessedMiniBlockInfo{
+		string(crossMbHash): {IsFullyProcessed: false, IndexOfLastTxProcessed: 1},
+	}
+	tc.CreateBlockStarted()
+	mbs, txs, finalized = tc.CreateMbsAndProcessCrossShardTransactionsDstMe(metaHdr, processedMiniBlocksInfo, 15000, 15000, 10, haveTime)
+
+	assert.Equal(t, 1, len(mbs))
+	assert.Equal(t, uint32(1), txs)
+	assert.True(t, finalized)
+	assert.Equal(t, &block.PartialExecution{OriginalHash: crossMbHash, OriginalTxCount: 3, IndexOfFirstTx: 2}, mbs[0].PartialExecution)
+	assert.True(t, mbs[0].PartialExecution.IsLastPart(len(mbs[0].TxHashes)))
+}
+
 func TestTransactionCoordinator_CreateMbsAndProcessTransactionsFromMeNothingToProcess(t *testing.T) {
 	t.Parallel()
 
@@ -800,6 +915,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessTransactionsFromMeNothingToPr
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
+		GasHandlerMock(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1281,6 +1397,7 @@ func TestTransactionCoordinator_receivedMiniBlockRequestTxs(t *testing.T) {
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
+		GasHandlerMock(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1437,6 +1554,7 @@ func TestTransactionCoordinator_ProcessBlockTransactionProcessTxError(t *testing
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
+		GasHandlerMock(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1559,6 +1677,7 @@ func TestTransactionCoordinator_RequestMiniblocks(t *testing.T) {
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
+		GasHandlerMock(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1676,6 +1795,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithOkTxsShouldExecuteThemAndNot
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
+		GasHandlerMock(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1784,6 +1904,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithErrorWhileProcessShouldCallR
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
+		GasHandlerMock(),
 	)
 	container, _ := preFactory.Create()
 
@@ -0,0 +1,208 @@
+package dblookupext
+
+import (
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const epochSize = 4
+const roundSize = 8
+
+// ArgHistoryRepository holds all dependencies required by the history repository in order to create a new instance
+type ArgHistoryRepository struct {
+	MiniblocksMetadataStorer storage.Storer
+	EpochByHashStorer        storage.Storer
+	RoundByHashStorer        storage.Storer
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+}
+
+// historyRepository writes, when a block is committed, the indexes which allow locating the transactions, the
+// miniblocks and the headers without an external indexer: the miniblock metadata of each transaction hash and the
+// epoch and the round of each hash
+type historyRepository struct {
+	miniblocksMetadataStorer storage.Storer
+	epochByHashStorer        storage.Storer
+	roundByHashStorer        storage.Storer
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+}
+
+// NewHistoryRepository creates a new history repository
+func NewHistoryRepository(args ArgHistoryRepository) (*historyRepository, error) {
+	if args.MiniblocksMetadataStorer == nil || args.MiniblocksMetadataStorer.IsInterfaceNil() {
+		return nil, process.ErrNilMiniblocksMetadataStorer
+	}
+	if args.EpochByHashStorer == nil || args.EpochByHashStorer.IsInterfaceNil() {
+		return nil, process.ErrNilEpochByHashStorer
+	}
+	if args.RoundByHashStorer == nil || args.RoundByHashStorer.IsInterfaceNil() {
+		return nil, process.ErrNilRoundByHashStorer
+	}
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if args.Hasher == nil || args.Hasher.IsInterfaceNil() {
+		return nil, process.ErrNilHasher
+	}
+
+	return &historyRepository{
+		miniblocksMetadataStorer: args.MiniblocksMetadataStorer,
+		epochByHashStorer:        args.EpochByHashStorer,
+		roundByHashStorer:        args.RoundByHashStorer,
+		marshalizer:              args.Marshalizer,
+		hasher:                   args.Hasher,
+	}, nil
+}
+
+// RecordBlock records the indexes of the provided committed block. The metachain blocks have no miniblocks in their
+// body, so only their header hash is recorded
+func (hr *historyRepository) RecordBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	blockBody data.BodyHandler,
+) error {
+	if blockHeader == nil || blockHeader.IsInterfaceNil() {
+		return process.ErrNilBlockHeader
+	}
+
+	epoch := blockHeader.GetEpoch()
+	round := blockHeader.GetRound()
+	err := hr.recordEpochAndRound(blockHeaderHash, epoch, round)
+	if err != nil {
+		return err
+	}
+
+	body, ok := blockBody.(block.Body)
+	if !ok {
+		return nil
+	}
+
+	for _, miniBlock := range body {
+		err = hr.recordMiniBlock(blockHeaderHash, blockHeader, miniBlock)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hr *historyRepository) recordMiniBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	miniBlock *block.MiniBlock,
+) error {
+	miniBlockHash, err := core.CalculateHash(hr.marshalizer, hr.hasher, miniBlock)
+	if err != nil {
+		return err
+	}
+
+	epoch := blockHeader.GetEpoch()
+	round := blockHeader.GetRound()
+	err = hr.recordEpochAndRound(miniBlockHash, epoch, round)
+	if err != nil {
+		return err
+	}
+
+	metadata := &MiniblockMetadata{
+		Type:            miniBlock.Type,
+		MiniblockHash:   miniBlockHash,
+		HeaderHash:      blockHeaderHash,
+		HeaderNonce:     blockHeader.GetNonce(),
+		Round:           round,
+		Epoch:           epoch,
+		ShardID:         blockHeader.GetShardID(),
+		SenderShardID:   miniBlock.SenderShardID,
+		ReceiverShardID: miniBlock.ReceiverShardID,
+	}
+	buff, err := hr.marshalizer.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	for _, txHash := range miniBlock.TxHashes {
+		err = hr.miniblocksMetadataStorer.Put(txHash, buff)
+		if err != nil {
+			return err
+		}
+
+		err = hr.recordEpochAndRound(txHash, epoch, round)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hr *historyRepository) recordEpochAndRound(hash []byte, epoch uint32, round uint64) error {
+	epochBytes := make([]byte, epochSize)
+	binary.BigEndian.PutUint32(epochBytes, epoch)
+	err := hr.epochByHashStorer.Put(hash, epochBytes)
+	if err != nil {
+		return err
+	}
+
+	roundBytes := make([]byte, roundSize)
+	binary.BigEndian.PutUint64(roundBytes, round)
+	return hr.roundByHashStorer.Put(hash, roundBytes)
+}
+
+// GetMiniblockMetadataByTxHash returns the metadata of the miniblock which included the provided transaction
+func (hr *historyRepository) GetMiniblockMetadataByTxHash(txHash []byte) (*MiniblockMetadata, error) {
+	buff, err := hr.miniblocksMetadataStorer.Get(txHash)
+	if err != nil {
+		return nil, process.ErrMissingMiniblockMetadata
+	}
+
+	metadata := &MiniblockMetadata{}
+	err = hr.marshalizer.Unmarshal(metadata, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// GetEpochByHash returns the epoch in which the block, the miniblock or the transaction with the provided hash
+// was committed
+func (hr *historyRepository) GetEpochByHash(hash []byte) (uint32, error) {
+	buff, err := hr.epochByHashStorer.Get(hash)
+	if err != nil || len(buff) != epochSize {
+		return 0, process.ErrMissingEpochForHash
+	}
+
+	return binary.BigEndian.Uint32(buff), nil
+}
+
+// GetRoundByHash returns the round in which the block, the miniblock or the transaction with the provided hash
+// was committed
+func (hr *historyRepository) GetRoundByHash(hash []byte) (uint64, error) {
+	buff, err := hr.roundByHashStorer.Get(hash)
+	if err != nil || len(buff) != roundSize {
+		return 0, process.ErrMissingRoundForHash
+	}
+
+	return binary.BigEndian.Uint64(buff), nil
+}
+
+// IsEnabled returns true as the indexes are written
+func (hr *historyRepository) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hr *historyRepository) IsInterfaceNil() bool {
+	if hr == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package dblookupext_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTestUnit() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.LRUCache, 1000, 1)
+	memDB, _ := memorydb.New()
+	storer, _ := storageUnit.NewStorageUnit(cache, memDB)
+
+	return storer
+}
+
+func createMockArgHistoryRepository() dblookupext.ArgHistoryRepository {
+	return dblookupext.ArgHistoryRepository{
+		MiniblocksMetadataStorer: generateTestUnit(),
+		EpochByHashStorer:        generateTestUnit(),
+		RoundByHashStorer:        generateTestUnit(),
+		Marshalizer:              &mock.MarshalizerMock{},
+		Hasher:                   &mock.HasherMock{},
+	}
+}
+
+func TestNewHistoryRepository_NilMiniblocksMetadataStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgHistoryRepository()
+	args.MiniblocksMetadataStorer = nil
+	hr, err := dblookupext.NewHistoryRepository(args)
+
+	assert.Nil(t, hr)
+	assert.Equal(t, process.ErrNilMiniblocksMetadataStorer, err)
+}
+
+func TestNewHistoryRepository_NilEpochByHashStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgHistoryRepository()
+	args.EpochByHashStorer = nil
+	hr, err := dblookupext.NewHistoryRepository(args)
+
+	assert.Nil(t, hr)
+	assert.Equal(t, process.ErrNilEpochByHashStorer, err)
+}
+
+func TestNewHistoryRepository_NilRoundByHashStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgHistoryRepository()
+	args.RoundByHashStorer = nil
+	hr, err := dblookupext.NewHistoryRepository(args)
+
+	assert.Nil(t, hr)
+	assert.Equal(t, process.ErrNilRoundByHashStorer, err)
+}
+
+func TestNewHistoryRepository_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgHistoryRepository()
+	args.Marshalizer = nil
+	hr, err := dblookupext.NewHistoryRepository(args)
+
+	assert.Nil(t, hr)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewHistoryRepository_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgHistoryRepository()
+	args.Hasher = nil
+	hr, err := dblookupext.NewHistoryRepository(args)
+
+	assert.Nil(t, hr)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewHistoryRepository_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hr, err := dblookupext.NewHistoryRepository(createMockArgHistoryRepository())
+
+	assert.Nil(t, err)
+	assert.False(t, hr.IsInterfaceNil())
+	assert.True(t, hr.IsEnabled())
+}
+
+func TestHistoryRepository_RecordBlockNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := dblookupext.NewHistoryRepository(createMockArgHistoryRepository())
+
+	err := hr.RecordBlock([]byte("header hash"), nil, block.Body{})
+
+	assert.Equal(t, process.ErrNilBlockHeader, err)
+}
+
+func TestHistoryRepository_RecordBlockShouldIndexTransactions(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := dblookupext.NewHistoryRepository(createMockArgHistoryRepository())
+
+	headerHash := []byte("header hash")
+	header := &block.Header{
+		Nonce:   37,
+		Round:   40,
+		Epoch:   2,
+		ShardId: 1,
+	}
+	miniBlock := &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx1"), []byte("tx2")},
+		SenderShardID:   1,
+		ReceiverShardID: 0,
+		Type:            block.TxBlock,
+	}
+
+	err := hr.RecordBlock(headerHash, header, block.Body{miniBlock})
+	assert.Nil(t, err)
+
+	for _, txHash := range miniBlock.TxHashes {
+		metadata, err := hr.GetMiniblockMetadataByTxHash(txHash)
+		assert.Nil(t, err)
+		assert.Equal(t, headerHash, metadata.HeaderHash)
+		assert.Equal(t, header.Nonce, metadata.HeaderNonce)
+		assert.Equal(t, header.Round, metadata.Round)
+		assert.Equal(t, header.Epoch, metadata.Epoch)
+		assert.Equal(t, header.ShardId, metadata.ShardID)
+		assert.Equal(t, miniBlock.SenderShardID, metadata.SenderShardID)
+		assert.Equal(t, miniBlock.ReceiverShardID, metadata.ReceiverShardID)
+		assert.Equal(t, miniBlock.Type, metadata.Type)
+		assert.NotEmpty(t, metadata.MiniblockHash)
+
+		epoch, err := hr.GetEpochByHash(txHash)
+		assert.Nil(t, err)
+		assert.Equal(t, header.Epoch, epoch)
+
+		round, err := hr.GetRoundByHash(txHash)
+		assert.Nil(t, err)
+		assert.Equal(t, header.Round, round)
+	}
+
+	epoch, err := hr.GetEpochByHash(headerHash)
+	assert.Nil(t, err)
+	assert.Equal(t, header.Epoch, epoch)
+
+	round, err := hr.GetRoundByHash(headerHash)
+	assert.Nil(t, err)
+	assert.Equal(t, header.Round, round)
+}
+
+func TestHistoryRepository_GettersOnMissingHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := dblookupext.NewHistoryRepository(createMockArgHistoryRepository())
+
+	metadata, err := hr.GetMiniblockMetadataByTxHash([]byte("missing"))
+	assert.Nil(t, metadata)
+	assert.Equal(t, process.ErrMissingMiniblockMetadata, err)
+
+	_, err = hr.GetEpochByHash([]byte("missing"))
+	assert.Equal(t, process.ErrMissingEpochForHash, err)
+
+	_, err = hr.GetRoundByHash([]byte("missing"))
+	assert.Equal(t, process.ErrMissingRoundForHash, err)
+}
+
+func TestNilHistoryRepository_ShouldBeDisabled(t *testing.T) {
+	t.Parallel()
+
+	hr := dblookupext.NewNilHistoryRepository()
+
+	assert.False(t, hr.IsInterfaceNil())
+	assert.False(t, hr.IsEnabled())
+	assert.Nil(t, hr.RecordBlock([]byte("header hash"), &block.Header{}, block.Body{}))
+
+	_, err := hr.GetMiniblockMetadataByTxHash([]byte("hash"))
+	assert.Equal(t, process.ErrDbLookupExtensionsDisabled, err)
+}
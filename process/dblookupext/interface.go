@@ -0,0 +1,13 @@
+package dblookupext
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// HistoryRepository records the secondary indexes of the committed blocks and answers the lookups done on them
+type HistoryRepository interface {
+	process.HistoryRepository
+	GetMiniblockMetadataByTxHash(txHash []byte) (*MiniblockMetadata, error)
+	GetEpochByHash(hash []byte) (uint32, error)
+	GetRoundByHash(hash []byte) (uint64, error)
+}
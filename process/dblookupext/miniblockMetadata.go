@@ -0,0 +1,18 @@
+package dblookupext
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// MiniblockMetadata locates a transaction: the miniblock which included it and the block which included the miniblock
+type MiniblockMetadata struct {
+	Type            block.Type `json:"type"`
+	MiniblockHash   []byte     `json:"miniblockHash"`
+	HeaderHash      []byte     `json:"headerHash"`
+	HeaderNonce     uint64     `json:"headerNonce"`
+	Round           uint64     `json:"round"`
+	Epoch           uint32     `json:"epoch"`
+	ShardID         uint32     `json:"shardId"`
+	SenderShardID   uint32     `json:"senderShardId"`
+	ReceiverShardID uint32     `json:"receiverShardId"`
+}
@@ -0,0 +1,48 @@
+package dblookupext
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// nilHistoryRepository is used when the db lookup extensions are disabled: nothing is recorded and all the lookups fail
+type nilHistoryRepository struct {
+}
+
+// NewNilHistoryRepository creates a new history repository which does not record anything
+func NewNilHistoryRepository() *nilHistoryRepository {
+	return &nilHistoryRepository{}
+}
+
+// RecordBlock does nothing
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler) error {
+	return nil
+}
+
+// GetMiniblockMetadataByTxHash returns ErrDbLookupExtensionsDisabled
+func (nhr *nilHistoryRepository) GetMiniblockMetadataByTxHash(_ []byte) (*MiniblockMetadata, error) {
+	return nil, process.ErrDbLookupExtensionsDisabled
+}
+
+// GetEpochByHash returns ErrDbLookupExtensionsDisabled
+func (nhr *nilHistoryRepository) GetEpochByHash(_ []byte) (uint32, error) {
+	return 0, process.ErrDbLookupExtensionsDisabled
+}
+
+// GetRoundByHash returns ErrDbLookupExtensionsDisabled
+func (nhr *nilHistoryRepository) GetRoundByHash(_ []byte) (uint64, error) {
+	return 0, process.ErrDbLookupExtensionsDisabled
+}
+
+// IsEnabled returns false as nothing is recorded
+func (nhr *nilHistoryRepository) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
+	if nhr == nil {
+		return true
+	}
+	return false
+}
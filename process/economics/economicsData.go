@@ -11,14 +11,24 @@ import (
 
 // EconomicsData will store information about economics
 type EconomicsData struct {
-	rewardsValue        *big.Int
-	communityPercentage float64
-	leaderPercentage    float64
-	burnPercentage      float64
-	minGasPrice         uint64
-	minGasLimit         uint64
-	communityAddress    string
-	burnAddress         string
+	rewardsValue                     *big.Int
+	communityPercentage              float64
+	leaderPercentage                 float64
+	burnPercentage                   float64
+	protocolSustainabilityPercentage float64
+	developerPercentage              float64
+	feeBurnEnableEpoch               uint32
+	minGasPrice                      uint64
+	minGasLimit                      uint64
+	gasPriceModifier                 float64
+	communityAddress                 string
+	burnAddress                      string
+	protocolSustainabilityAddress    string
+
+	maxMoveBalanceGasPerBlock uint64
+	maxSCExecutionGasPerBlock uint64
+	maxMoveBalanceGasPerShard uint64
+	maxSCExecutionGasPerShard uint64
 }
 
 const float64EqualityThreshold = 1e-9
@@ -41,15 +51,30 @@ func NewEconomicsData(economics *config.ConfigEconomics) (*EconomicsData, error)
 		return nil, err
 	}
 
+	gasLimits, err := convertGasLimits(economics)
+	if err != nil {
+		return nil, err
+	}
+
 	return &EconomicsData{
-		rewardsValue:        rewardsValue,
-		communityPercentage: economics.RewardsSettings.CommunityPercentage,
-		leaderPercentage:    economics.RewardsSettings.LeaderPercentage,
-		burnPercentage:      economics.RewardsSettings.BurnPercentage,
-		minGasPrice:         minGasPrice,
-		minGasLimit:         minGasLimit,
-		communityAddress:    economics.EconomicsAddresses.CommunityAddress,
-		burnAddress:         economics.EconomicsAddresses.BurnAddress,
+		rewardsValue:                     rewardsValue,
+		communityPercentage:              economics.RewardsSettings.CommunityPercentage,
+		leaderPercentage:                 economics.RewardsSettings.LeaderPercentage,
+		burnPercentage:                   economics.RewardsSettings.BurnPercentage,
+		protocolSustainabilityPercentage: economics.RewardsSettings.ProtocolSustainabilityPercentage,
+		developerPercentage:              economics.RewardsSettings.DeveloperPercentage,
+		feeBurnEnableEpoch:               economics.RewardsSettings.FeeBurnEnableEpoch,
+		minGasPrice:                      minGasPrice,
+		minGasLimit:                      minGasLimit,
+		gasPriceModifier:                 economics.FeeSettings.GasPriceModifier,
+		communityAddress:                 economics.EconomicsAddresses.CommunityAddress,
+		burnAddress:                      economics.EconomicsAddresses.BurnAddress,
+		protocolSustainabilityAddress:    economics.EconomicsAddresses.ProtocolSustainabilityAddress,
+
+		maxMoveBalanceGasPerBlock: gasLimits.maxMoveBalanceGasPerBlock,
+		maxSCExecutionGasPerBlock: gasLimits.maxSCExecutionGasPerBlock,
+		maxMoveBalanceGasPerShard: gasLimits.maxMoveBalanceGasPerShard,
+		maxSCExecutionGasPerShard: gasLimits.maxSCExecutionGasPerShard,
 	}, nil
 }
 
@@ -76,12 +101,62 @@ func convertValues(economics *config.ConfigEconomics) (*big.Int, uint64, uint64,
 	return rewardsValue, minGasPrice, minGasLimit, nil
 }
 
+type blockGasLimits struct {
+	maxMoveBalanceGasPerBlock uint64
+	maxSCExecutionGasPerBlock uint64
+	maxMoveBalanceGasPerShard uint64
+	maxSCExecutionGasPerShard uint64
+}
+
+func convertGasLimits(economics *config.ConfigEconomics) (*blockGasLimits, error) {
+	settings := economics.GasLimitSettings
+
+	maxMoveBalanceGasPerBlock, okMoveBalance := parseGasLimit(settings.MaxMoveBalanceGasPerBlock)
+	maxSCExecutionGasPerBlock, okSCExecution := parseGasLimit(settings.MaxSCExecutionGasPerBlock)
+	if !okMoveBalance || !okSCExecution {
+		return nil, process.ErrInvalidMaxGasLimitPerBlock
+	}
+
+	maxMoveBalanceGasPerShard, okMoveBalance := parseGasLimit(settings.MaxMoveBalanceGasPerShard)
+	maxSCExecutionGasPerShard, okSCExecution := parseGasLimit(settings.MaxSCExecutionGasPerShard)
+	if !okMoveBalance || !okSCExecution {
+		return nil, process.ErrInvalidMaxGasLimitPerShard
+	}
+
+	// a single destination shard can not consume more than the whole block
+	if maxMoveBalanceGasPerShard > maxMoveBalanceGasPerBlock || maxSCExecutionGasPerShard > maxSCExecutionGasPerBlock {
+		return nil, process.ErrInvalidMaxGasLimitPerShard
+	}
+
+	return &blockGasLimits{
+		maxMoveBalanceGasPerBlock: maxMoveBalanceGasPerBlock,
+		maxSCExecutionGasPerBlock: maxSCExecutionGasPerBlock,
+		maxMoveBalanceGasPerShard: maxMoveBalanceGasPerShard,
+		maxSCExecutionGasPerShard: maxSCExecutionGasPerShard,
+	}, nil
+}
+
+func parseGasLimit(value string) (uint64, bool) {
+	gasLimit, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || gasLimit == 0 {
+		return 0, false
+	}
+
+	return gasLimit, true
+}
+
 func checkValues(economics *config.ConfigEconomics) error {
 	if isPercentageInvalid(economics.RewardsSettings.BurnPercentage) ||
 		isPercentageInvalid(economics.RewardsSettings.CommunityPercentage) ||
 		isPercentageInvalid(economics.RewardsSettings.LeaderPercentage) {
 		return process.ErrInvalidRewardsPercentages
 	}
+	if isPercentageInvalid(economics.RewardsSettings.ProtocolSustainabilityPercentage) {
+		return process.ErrInvalidProtocolSustainabilityPercentage
+	}
+	if isPercentageInvalid(economics.RewardsSettings.DeveloperPercentage) {
+		return process.ErrInvalidDeveloperPercentage
+	}
 
 	sumPercentage := economics.RewardsSettings.BurnPercentage
 	sumPercentage += economics.RewardsSettings.CommunityPercentage
@@ -91,6 +166,11 @@ func checkValues(economics *config.ConfigEconomics) error {
 		return process.ErrInvalidRewardsPercentages
 	}
 
+	gasPriceModifier := economics.FeeSettings.GasPriceModifier
+	if gasPriceModifier <= 0 || gasPriceModifier > 1.0 {
+		return process.ErrInvalidGasPriceModifier
+	}
+
 	return nil
 }
 
@@ -123,6 +203,23 @@ func (ed *EconomicsData) BurnPercentage() float64 {
 	return ed.burnPercentage
 }
 
+// ProtocolSustainabilityPercentage will return the percentage of the protocol rewards which is routed to the
+// protocol sustainability address
+func (ed *EconomicsData) ProtocolSustainabilityPercentage() float64 {
+	return ed.protocolSustainabilityPercentage
+}
+
+// DeveloperPercentage will return the percentage of the fee consumed by a smart contract call which is accrued to
+// the called contract
+func (ed *EconomicsData) DeveloperPercentage() float64 {
+	return ed.developerPercentage
+}
+
+// FeeBurnEnableEpoch will return the epoch from which the burn percentage of the block fees is destroyed
+func (ed *EconomicsData) FeeBurnEnableEpoch() uint32 {
+	return ed.feeBurnEnableEpoch
+}
+
 // ComputeFee computes the provided transaction's fee
 func (ed *EconomicsData) ComputeFee(tx process.TransactionWithFeeHandler) *big.Int {
 	gasPrice := big.NewInt(0).SetUint64(tx.GetGasPrice())
@@ -131,6 +228,36 @@ func (ed *EconomicsData) ComputeFee(tx process.TransactionWithFeeHandler) *big.I
 	return gasPrice.Mul(gasPrice, gasLimit)
 }
 
+// ComputeTxFee computes the maximum fee the provided transaction pays when it consumes its whole gas limit: the
+// move balance gas is priced at the full gas price, the gas left for the smart contract execution at the modified one
+func (ed *EconomicsData) ComputeTxFee(tx process.TransactionWithFeeHandler) *big.Int {
+	moveBalanceGas := ed.ComputeGasLimit(tx)
+	if tx.GetGasLimit() <= moveBalanceGas {
+		fee := big.NewInt(0).SetUint64(tx.GetGasPrice())
+		return fee.Mul(fee, big.NewInt(0).SetUint64(tx.GetGasLimit()))
+	}
+
+	fee := ed.ComputeFee(tx)
+	processingFee := ed.ComputeFeeForProcessing(tx, tx.GetGasLimit()-moveBalanceGas)
+
+	return fee.Add(fee, processingFee)
+}
+
+// ComputeFeeForProcessing computes the fee of the provided gas consumed by a smart contract execution, priced at the
+// gas price of the transaction multiplied by the gas price modifier
+func (ed *EconomicsData) ComputeFeeForProcessing(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
+	gasPrice := big.NewInt(0).SetUint64(ed.gasPriceForProcessing(tx))
+	gas := big.NewInt(0).SetUint64(gasToUse)
+
+	return gasPrice.Mul(gasPrice, gas)
+}
+
+// gasPriceForProcessing returns the price of the gas consumed by a smart contract execution for the provided
+// transaction
+func (ed *EconomicsData) gasPriceForProcessing(tx process.TransactionWithFeeHandler) uint64 {
+	return uint64(float64(tx.GetGasPrice()) * ed.gasPriceModifier)
+}
+
 // CheckValidityTxValues checks if the provided transaction is economically correct
 func (ed *EconomicsData) CheckValidityTxValues(tx process.TransactionWithFeeHandler) error {
 	if ed.minGasPrice > tx.GetGasPrice() {
@@ -157,6 +284,43 @@ func (ed *EconomicsData) ComputeGasLimit(tx process.TransactionWithFeeHandler) u
 	return gasLimit
 }
 
+// MinGasPrice returns the minimum gas price accepted for a transaction
+func (ed *EconomicsData) MinGasPrice() uint64 {
+	return ed.minGasPrice
+}
+
+// MinGasLimit returns the minimum gas limit accepted for a transaction
+func (ed *EconomicsData) MinGasLimit() uint64 {
+	return ed.minGasLimit
+}
+
+// GasPriceModifier returns the fraction of the gas price paid for the gas consumed by smart contract executions
+func (ed *EconomicsData) GasPriceModifier() float64 {
+	return ed.gasPriceModifier
+}
+
+// MaxMoveBalanceGasPerBlock returns the maximum gas that can be consumed by move balance transactions in one block
+func (ed *EconomicsData) MaxMoveBalanceGasPerBlock() uint64 {
+	return ed.maxMoveBalanceGasPerBlock
+}
+
+// MaxSCExecutionGasPerBlock returns the maximum gas that can be consumed by smart contract executions in one block
+func (ed *EconomicsData) MaxSCExecutionGasPerBlock() uint64 {
+	return ed.maxSCExecutionGasPerBlock
+}
+
+// MaxMoveBalanceGasPerShard returns the maximum gas that can be consumed in one block by move balance transactions
+// having the same destination shard
+func (ed *EconomicsData) MaxMoveBalanceGasPerShard() uint64 {
+	return ed.maxMoveBalanceGasPerShard
+}
+
+// MaxSCExecutionGasPerShard returns the maximum gas that can be consumed in one block by smart contract executions
+// having the same destination shard
+func (ed *EconomicsData) MaxSCExecutionGasPerShard() uint64 {
+	return ed.maxSCExecutionGasPerShard
+}
+
 // CommunityAddress will return community address
 func (ed *EconomicsData) CommunityAddress() string {
 	return ed.communityAddress
@@ -167,6 +331,11 @@ func (ed *EconomicsData) BurnAddress() string {
 	return ed.burnAddress
 }
 
+// ProtocolSustainabilityAddress will return the protocol sustainability address
+func (ed *EconomicsData) ProtocolSustainabilityAddress() string {
+	return ed.protocolSustainabilityAddress
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ed *EconomicsData) IsInterfaceNil() bool {
 	if ed == nil {
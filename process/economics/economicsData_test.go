@@ -18,16 +18,27 @@ func createDummyEconomicsConfig() *config.ConfigEconomics {
 		EconomicsAddresses: config.EconomicsAddresses{
 			CommunityAddress: "addr1",
 			BurnAddress:      "addr2",
+
+			ProtocolSustainabilityAddress: "addr3",
 		},
 		RewardsSettings: config.RewardsSettings{
 			RewardsValue:        "1000000000000000000000000000000000",
 			CommunityPercentage: 0.1,
 			LeaderPercentage:    0.1,
 			BurnPercentage:      0.8,
+
+			ProtocolSustainabilityPercentage: 0.1,
 		},
 		FeeSettings: config.FeeSettings{
-			MinGasPrice: "18446744073709551615",
-			MinGasLimit: "500",
+			MinGasPrice:      "18446744073709551615",
+			MinGasLimit:      "500",
+			GasPriceModifier: 0.01,
+		},
+		GasLimitSettings: config.GasLimitSettings{
+			MaxMoveBalanceGasPerBlock: "1000000",
+			MaxSCExecutionGasPerBlock: "2000000",
+			MaxMoveBalanceGasPerShard: "300000",
+			MaxSCExecutionGasPerShard: "400000",
 		},
 	}
 }
@@ -102,6 +113,88 @@ func TestNewEconomicsData_InvalidMinGasLimitShouldErr(t *testing.T) {
 
 }
 
+func TestNewEconomicsData_InvalidMaxGasLimitPerBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	badGasLimits := []string{
+		"-1",
+		"0",
+		"badValue",
+		"",
+		"10000000000000000000000000000000000000000000000000000000000000",
+	}
+
+	for _, gasLimit := range badGasLimits {
+		economicsConfig := createDummyEconomicsConfig()
+		economicsConfig.GasLimitSettings.MaxMoveBalanceGasPerBlock = gasLimit
+		_, err := economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidMaxGasLimitPerBlock, err)
+
+		economicsConfig = createDummyEconomicsConfig()
+		economicsConfig.GasLimitSettings.MaxSCExecutionGasPerBlock = gasLimit
+		_, err = economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidMaxGasLimitPerBlock, err)
+	}
+}
+
+func TestNewEconomicsData_InvalidMaxGasLimitPerShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	badGasLimits := []string{
+		"-1",
+		"0",
+		"badValue",
+		"",
+		"10000000000000000000000000000000000000000000000000000000000000",
+	}
+
+	for _, gasLimit := range badGasLimits {
+		economicsConfig := createDummyEconomicsConfig()
+		economicsConfig.GasLimitSettings.MaxMoveBalanceGasPerShard = gasLimit
+		_, err := economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidMaxGasLimitPerShard, err)
+
+		economicsConfig = createDummyEconomicsConfig()
+		economicsConfig.GasLimitSettings.MaxSCExecutionGasPerShard = gasLimit
+		_, err = economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidMaxGasLimitPerShard, err)
+	}
+}
+
+func TestNewEconomicsData_MaxGasLimitPerShardHigherThanPerBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.GasLimitSettings.MaxSCExecutionGasPerShard = "2000001"
+
+	_, err := economics.NewEconomicsData(economicsConfig)
+	assert.Equal(t, process.ErrInvalidMaxGasLimitPerShard, err)
+}
+
+func TestNewEconomicsData_InvalidGasPriceModifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	badGasPriceModifiers := []float64{-0.1, 0, 1.1}
+
+	for _, gasPriceModifier := range badGasPriceModifiers {
+		economicsConfig.FeeSettings.GasPriceModifier = gasPriceModifier
+		_, err := economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidGasPriceModifier, err)
+	}
+}
+
+func TestEconomicsData_GasLimitsPerBlock(t *testing.T) {
+	t.Parallel()
+
+	economicsData, _ := economics.NewEconomicsData(createDummyEconomicsConfig())
+
+	assert.Equal(t, uint64(1000000), economicsData.MaxMoveBalanceGasPerBlock())
+	assert.Equal(t, uint64(2000000), economicsData.MaxSCExecutionGasPerBlock())
+	assert.Equal(t, uint64(300000), economicsData.MaxMoveBalanceGasPerShard())
+	assert.Equal(t, uint64(400000), economicsData.MaxSCExecutionGasPerShard())
+}
+
 func TestNewEconomicsData_InvalidBurnPercentageShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -153,6 +246,19 @@ func TestNewEconomicsData_InvalidRewardsPercentageSumShouldErr(t *testing.T) {
 
 }
 
+func TestNewEconomicsData_InvalidProtocolSustainabilityPercentageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	badPercentages := []float64{-0.1, 1.1}
+
+	for _, percentage := range badPercentages {
+		economicsConfig.RewardsSettings.ProtocolSustainabilityPercentage = percentage
+		_, err := economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidProtocolSustainabilityPercentage, err)
+	}
+}
+
 func TestNewEconomicsData_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -215,6 +321,55 @@ func TestEconomicsData_BurnPercentage(t *testing.T) {
 	assert.Equal(t, burnPercentage, value)
 }
 
+func TestNewEconomicsData_InvalidDeveloperPercentageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	badPercentages := []float64{-0.1, 1.1}
+
+	for _, percentage := range badPercentages {
+		economicsConfig.RewardsSettings.DeveloperPercentage = percentage
+		_, err := economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidDeveloperPercentage, err)
+	}
+}
+
+func TestEconomicsData_DeveloperPercentage(t *testing.T) {
+	t.Parallel()
+
+	developerPercentage := 0.3
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.RewardsSettings.DeveloperPercentage = developerPercentage
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+
+	value := economicsData.DeveloperPercentage()
+	assert.Equal(t, developerPercentage, value)
+}
+
+func TestEconomicsData_ProtocolSustainabilityPercentage(t *testing.T) {
+	t.Parallel()
+
+	protocolSustainabilityPercentage := 0.15
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.RewardsSettings.ProtocolSustainabilityPercentage = protocolSustainabilityPercentage
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+
+	value := economicsData.ProtocolSustainabilityPercentage()
+	assert.Equal(t, protocolSustainabilityPercentage, value)
+}
+
+func TestEconomicsData_FeeBurnEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	feeBurnEnableEpoch := uint32(7)
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.RewardsSettings.FeeBurnEnableEpoch = feeBurnEnableEpoch
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+
+	value := economicsData.FeeBurnEnableEpoch()
+	assert.Equal(t, feeBurnEnableEpoch, value)
+}
+
 func TestEconomicsData_ComputeFeeNoTxData(t *testing.T) {
 	t.Parallel()
 
@@ -259,6 +414,59 @@ func TestEconomicsData_ComputeFeeWithTxData(t *testing.T) {
 	assert.Equal(t, expectedCost, cost)
 }
 
+func TestEconomicsData_ComputeTxFeeShouldPriceTheProcessingGasWithTheModifier(t *testing.T) {
+	t.Parallel()
+
+	minGasLimit := uint64(12)
+	txData := "function"
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.FeeSettings.MinGasLimit = strconv.FormatUint(minGasLimit, 10)
+	economicsConfig.FeeSettings.GasPriceModifier = 0.1
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+	tx := &transaction.Transaction{
+		GasPrice: 500,
+		GasLimit: 1020,
+		Data:     txData,
+	}
+
+	cost := economicsData.ComputeTxFee(tx)
+
+	// 20 move balance gas at 500 and 1000 processing gas at 50
+	assert.Equal(t, big.NewInt(60000), cost)
+	assert.Equal(t, 0.1, economicsData.GasPriceModifier())
+}
+
+func TestEconomicsData_ComputeTxFeeGasLimitNotCoveringMoveBalanceShouldUseTheFullGasPrice(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.FeeSettings.MinGasLimit = "12"
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+	tx := &transaction.Transaction{
+		GasPrice: 500,
+		GasLimit: 10,
+	}
+
+	cost := economicsData.ComputeTxFee(tx)
+
+	assert.Equal(t, big.NewInt(5000), cost)
+}
+
+func TestEconomicsData_ComputeFeeForProcessing(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.FeeSettings.GasPriceModifier = 0.01
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+	tx := &transaction.Transaction{
+		GasPrice: 1000,
+	}
+
+	cost := economicsData.ComputeFeeForProcessing(tx, 300)
+
+	assert.Equal(t, big.NewInt(3000), cost)
+}
+
 func TestEconomicsData_TxWithLowerGasPriceShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -335,6 +543,28 @@ func TestEconomicsData_TxWithWithMoreGasPriceLimitShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestEconomicsData_MinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.FeeSettings.MinGasPrice = "10"
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+
+	value := economicsData.MinGasPrice()
+	assert.Equal(t, uint64(10), value)
+}
+
+func TestEconomicsData_MinGasLimit(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.FeeSettings.MinGasLimit = "1000"
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+
+	value := economicsData.MinGasLimit()
+	assert.Equal(t, uint64(1000), value)
+}
+
 func TestEconomicsData_CommunityAddress(t *testing.T) {
 	t.Parallel()
 
@@ -358,3 +588,15 @@ func TestEconomicsData_BurnAddress(t *testing.T) {
 	value := economicsData.BurnAddress()
 	assert.Equal(t, burnAddress, value)
 }
+
+func TestEconomicsData_ProtocolSustainabilityAddress(t *testing.T) {
+	t.Parallel()
+
+	protocolSustainabilityAddress := "addr3"
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.EconomicsAddresses.ProtocolSustainabilityAddress = protocolSustainabilityAddress
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+
+	value := economicsData.ProtocolSustainabilityAddress()
+	assert.Equal(t, protocolSustainabilityAddress, value)
+}
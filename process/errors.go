@@ -502,3 +502,362 @@ var ErrInvalidRewardsPercentages = errors.New("invalid rewards percentages")
 
 // ErrNilMiniBlocksCompacter signals that a nil mini blocks compacter has been provided
 var ErrNilMiniBlocksCompacter = errors.New("nil mini blocks compacter")
+
+// ErrSimulationSenderNotInSelfShard signals that a transaction can not be simulated as its sender is not in the node's shard
+var ErrSimulationSenderNotInSelfShard = errors.New("transaction sender is not in the node's shard, can not simulate")
+
+// ErrNilExecutionTracer signals that a nil execution tracer has been provided
+var ErrNilExecutionTracer = errors.New("nil execution tracer")
+
+// ErrInvalidMaxGasLimitPerBlock signals that an invalid maximum gas limit per block has been read from config file
+var ErrInvalidMaxGasLimitPerBlock = errors.New("invalid maximum gas limit per block")
+
+// ErrInvalidMaxGasLimitPerShard signals that an invalid maximum gas limit per destination shard has been read from config file
+var ErrInvalidMaxGasLimitPerShard = errors.New("invalid maximum gas limit per destination shard")
+
+// ErrNilBlockGasLimitsHandler signals that a nil block gas limits handler has been provided
+var ErrNilBlockGasLimitsHandler = errors.New("nil block gas limits handler")
+
+// ErrNilGasHandler signals that a nil gas handler has been provided
+var ErrNilGasHandler = errors.New("nil gas handler")
+
+// ErrMaxGasLimitPerBlockReached signals that the gas consumed by the block's transactions exceeds the allowed limits
+var ErrMaxGasLimitPerBlockReached = errors.New("max gas limit per block is reached")
+
+// ErrNotEnoughArgumentsToUpgrade signals that there are not enough arguments to upgrade the smart contract
+var ErrNotEnoughArgumentsToUpgrade = errors.New("not enough arguments to upgrade the smart contract")
+
+// ErrUpgradeNotAllowed signals that the smart contract was not deployed as upgradeable
+var ErrUpgradeNotAllowed = errors.New("smart contract upgrade is not allowed")
+
+// ErrNotContractOwner signals that the sender is not the owner of the smart contract
+var ErrNotContractOwner = errors.New("sender is not the owner of the smart contract")
+
+// ErrAccountNotPayable signals that a plain value transfer was sent to a smart contract not deployed as payable
+var ErrAccountNotPayable = errors.New("sending value to non payable contract")
+
+// ErrInvalidAsyncCall signals that an asynchronous call requested by a smart contract is malformed
+var ErrInvalidAsyncCall = errors.New("invalid asynchronous call")
+
+// ErrMultipleAsyncCalls signals that a smart contract requested more than one asynchronous call in the same execution
+var ErrMultipleAsyncCalls = errors.New("only one asynchronous call is allowed per execution")
+
+// ErrAsyncCallInSameShard signals that an asynchronous call was requested towards a contract from the same shard
+var ErrAsyncCallInSameShard = errors.New("asynchronous calls are allowed only towards contracts from other shards")
+
+// ErrNotEnoughGasForAsyncCall signals that the remaining gas can not cover both the asynchronous call and its callback
+var ErrNotEnoughGasForAsyncCall = errors.New("not enough gas for the asynchronous call and its callback")
+
+// ErrNilBuiltInFunctionsContainer signals that a nil built-in functions container has been provided
+var ErrNilBuiltInFunctionsContainer = errors.New("nil built-in functions container")
+
+// ErrNilEpochHandler signals that a nil epoch handler has been provided
+var ErrNilEpochHandler = errors.New("nil epoch handler")
+
+// ErrBuiltInFunctionNotActive signals that a built-in function was called before its activation epoch
+var ErrBuiltInFunctionNotActive = errors.New("built-in function is not active")
+
+// ErrBuiltInFunctionCalledCrossShard signals that a built-in function was called with the sender and the
+// destination in different shards
+var ErrBuiltInFunctionCalledCrossShard = errors.New("built-in function called cross shard")
+
+// ErrInvalidBuiltInFunctionArguments signals that the arguments provided to a built-in function are not valid
+var ErrInvalidBuiltInFunctionArguments = errors.New("invalid built-in function arguments")
+
+// ErrOperationNotPermitted signals that the operation can only be done by the account on itself
+var ErrOperationNotPermitted = errors.New("operation not permitted")
+
+// ErrUserNameAlreadySet signals that the account already has a user name
+var ErrUserNameAlreadySet = errors.New("user name is already set")
+
+// ErrUserNameAlreadyRegistered signals that the requested user name is already registered by another account
+var ErrUserNameAlreadyRegistered = errors.New("user name is already registered")
+
+// ErrNilDNSAddress signals that a nil or empty dns address has been provided
+var ErrNilDNSAddress = errors.New("nil dns address")
+
+// ErrNilPendingMiniBlocksHandler signals that a nil pending miniblocks handler has been provided
+var ErrNilPendingMiniBlocksHandler = errors.New("nil pending miniblocks handler")
+
+// ErrInvalidMaxPendingMiniBlocksDelay signals that an invalid maximum delay for pending miniblocks has been provided
+var ErrInvalidMaxPendingMiniBlocksDelay = errors.New("invalid maximum delay for pending miniblocks")
+
+// ErrOverdueCrossShardMiniBlocksNotProcessed signals that a shard header does not process any of the cross shard
+// miniblocks which are waiting for its shard for more than the maximum allowed delay
+var ErrOverdueCrossShardMiniBlocksNotProcessed = errors.New("overdue cross shard miniblocks were not processed")
+
+// ErrNilBlockTracker signals that a nil block tracker has been provided
+var ErrNilBlockTracker = errors.New("nil block tracker")
+
+// ErrInvalidProtocolSustainabilityPercentage signals that the protocol sustainability percentage is not in [0, 1]
+var ErrInvalidProtocolSustainabilityPercentage = errors.New("invalid protocol sustainability percentage")
+
+// ErrInvalidDeveloperPercentage signals that the developer percentage is not in [0, 1]
+var ErrInvalidDeveloperPercentage = errors.New("invalid developer percentage")
+
+// ErrInvalidChainID signals that an invalid chain ID has been provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrTransactionChainIDMismatch signals that the chain ID of a processed transaction differs from the node's chain ID
+var ErrTransactionChainIDMismatch = errors.New("transaction chain ID does not match the node's chain ID")
+
+// ErrInvalidTransactionVersion signals that an invalid transaction version has been provided
+var ErrInvalidTransactionVersion = errors.New("invalid transaction version")
+
+// ErrProtectedKey signals that a data trie key reserved for the protocol was about to be written
+var ErrProtectedKey = errors.New("protected key")
+
+// ErrInvalidNFTQuantity signals that an invalid quantity of a non fungible or semi fungible token has been provided
+var ErrInvalidNFTQuantity = errors.New("invalid quantity for non fungible or semi fungible token")
+
+// ErrInvalidRoyalties signals that the provided royalties are greater than the maximum royalty
+var ErrInvalidRoyalties = errors.New("invalid royalties")
+
+// ErrNFTTokenNotFound signals that the account does not hold the requested non fungible or semi fungible token
+var ErrNFTTokenNotFound = errors.New("non fungible or semi fungible token not found")
+
+// ErrInsufficientNFTQuantity signals that the account holds a lower quantity of the token than requested
+var ErrInsufficientNFTQuantity = errors.New("insufficient quantity of non fungible or semi fungible token")
+
+// ErrNotNFTCreator signals that the operation can only be done by the creator of the token
+var ErrNotNFTCreator = errors.New("operation can only be done by the token creator")
+
+// ErrNFTQuantityNotChangeable signals that the quantity of a non fungible token can not be changed
+var ErrNFTQuantityNotChangeable = errors.New("quantity of non fungible token can not be changed")
+
+// ErrNFTMetaDataMismatch signals that the destination already holds a token with the same identifier and nonce
+// but created by another account
+var ErrNFTMetaDataMismatch = errors.New("token metadata mismatch")
+
+// ErrCrossShardContractCall signals that a built-in function followed by a contract call was sent cross shard
+var ErrCrossShardContractCall = errors.New("built-in function followed by a contract call can not be sent cross shard")
+
+// ErrFailedTransaction signals that the transaction could not be executed and only its fee was consumed. The
+// transaction is recorded in an invalid miniblock
+var ErrFailedTransaction = errors.New("failed transaction, gas consumed")
+
+// ErrTransactionNotFailed signals that a transaction recorded in an invalid miniblock was executed successfully
+var ErrTransactionNotFailed = errors.New("transaction from invalid miniblock did not fail")
+
+// ErrNilTxLogsProcessor signals that a nil transaction logs processor has been provided
+var ErrNilTxLogsProcessor = errors.New("nil transaction logs processor")
+
+// ErrUnknownVMType signals that no virtual machine is registered for the requested VM type
+var ErrUnknownVMType = errors.New("unknown VM type")
+
+// ErrNilBlockChainHook signals that a nil blockchain hook has been provided
+var ErrNilBlockChainHook = errors.New("nil blockchain hook")
+
+// ErrNilGasScheduleNotifier signals that a nil gas schedule notifier has been provided
+var ErrNilGasScheduleNotifier = errors.New("nil gas schedule notifier")
+
+// ErrNilEpochNotifier signals that a nil epoch notifier has been provided
+var ErrNilEpochNotifier = errors.New("nil epoch notifier")
+
+// ErrNilHeaderSigVerifier signals that a nil header signature verifier has been provided
+var ErrNilHeaderSigVerifier = errors.New("nil header signature verifier")
+
+// ErrEmptyConsensusGroup signals that the consensus group computed for a header is empty
+var ErrEmptyConsensusGroup = errors.New("empty consensus group")
+
+// ErrNilHistoryRepository signals that a nil history repository has been provided
+var ErrNilHistoryRepository = errors.New("nil history repository")
+
+// ErrNilMiniblocksMetadataStorer signals that a nil miniblocks metadata storer has been provided
+var ErrNilMiniblocksMetadataStorer = errors.New("nil miniblocks metadata storer")
+
+// ErrNilEpochByHashStorer signals that a nil epoch by hash storer has been provided
+var ErrNilEpochByHashStorer = errors.New("nil epoch by hash storer")
+
+// ErrNilRoundByHashStorer signals that a nil round by hash storer has been provided
+var ErrNilRoundByHashStorer = errors.New("nil round by hash storer")
+
+// ErrMissingMiniblockMetadata signals that no miniblock metadata was recorded for the provided transaction hash
+var ErrMissingMiniblockMetadata = errors.New("missing miniblock metadata")
+
+// ErrMissingEpochForHash signals that no epoch was recorded for the provided hash
+var ErrMissingEpochForHash = errors.New("missing epoch for hash")
+
+// ErrMissingRoundForHash signals that no round was recorded for the provided hash
+var ErrMissingRoundForHash = errors.New("missing round for hash")
+
+// ErrDbLookupExtensionsDisabled signals that a lookup needing the db lookup extensions was done while they are disabled
+var ErrDbLookupExtensionsDisabled = errors.New("db lookup extensions are disabled")
+
+// ErrNilRoundActivationHandler signals that a nil round activation handler has been provided
+var ErrNilRoundActivationHandler = errors.New("nil round activation handler")
+
+// ErrTopicDisabled signals that a message was received on a topic disabled starting with the current round
+var ErrTopicDisabled = errors.New("topic disabled in the current round")
+
+// ErrMissingOriginalMiniBlock signals that the original miniblock of a partially executed miniblock is missing
+var ErrMissingOriginalMiniBlock = errors.New("missing original miniblock of the partially executed miniblock")
+
+// ErrInvalidPartialMiniBlock signals that a partially executed miniblock does not match its original miniblock
+var ErrInvalidPartialMiniBlock = errors.New("invalid partially executed miniblock")
+
+// ErrNilVMCreator signals that a nil VM creator has been provided
+var ErrNilVMCreator = errors.New("nil VM creator")
+
+// ErrNilChunkReassembler signals that a nil chunk reassembler has been provided
+var ErrNilChunkReassembler = errors.New("nil chunk reassembler")
+
+// ErrInvalidChunkReassemblerLimits signals that the limits provided to the chunk reassembler are invalid
+var ErrInvalidChunkReassemblerLimits = errors.New("invalid chunk reassembler limits")
+
+// ErrInvalidChunk signals that a received chunk is malformed or exceeds the configured limits
+var ErrInvalidChunk = errors.New("invalid chunk")
+
+// ErrTooManyPendingChunkedPayloads signals that a peer has too many payloads waiting for their missing chunks
+var ErrTooManyPendingChunkedPayloads = errors.New("too many pending chunked payloads")
+
+// ErrChunkedPayloadHashMismatch signals that a reassembled payload does not match the hash referenced by its chunks
+var ErrChunkedPayloadHashMismatch = errors.New("reassembled payload hash mismatch")
+
+// ErrNilOutgoingOperationsHandler signals that a nil outgoing operations handler has been provided
+var ErrNilOutgoingOperationsHandler = errors.New("nil outgoing operations handler")
+
+// ErrOutgoingOperationsDisabled signals that the outgoing operations were requested while their collection is disabled
+var ErrOutgoingOperationsDisabled = errors.New("outgoing operations are disabled")
+
+// ErrInvalidOutgoingOperationSubscription signals that an outgoing operation subscription is invalid
+var ErrInvalidOutgoingOperationSubscription = errors.New("invalid outgoing operation subscription")
+
+// ErrSignerNotEligible signals that a signature was produced by a public key which is not an eligible validator
+var ErrSignerNotEligible = errors.New("signer is not an eligible validator")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrInvalidMaxOutgoingOperationsBatches signals that an invalid maximum number of outgoing operations batches has
+// been provided
+var ErrInvalidMaxOutgoingOperationsBatches = errors.New("invalid maximum number of outgoing operations batches")
+
+// ErrNilHistoricalAccountsProvider signals that a nil historical accounts provider has been provided
+var ErrNilHistoricalAccountsProvider = errors.New("nil historical accounts provider")
+
+// ErrNilValidatorsSelectionAuditor signals that a nil validators selection auditor has been provided
+var ErrNilValidatorsSelectionAuditor = errors.New("nil validators selection auditor")
+
+// ErrValidatorsSelectionAuditDisabled signals that the validators selection audit is not enabled on this node
+var ErrValidatorsSelectionAuditDisabled = errors.New("validators selection audit is disabled")
+
+// ErrInvalidDeviationThreshold signals that an invalid deviation threshold has been provided
+var ErrInvalidDeviationThreshold = errors.New("invalid deviation threshold")
+
+// ErrInvalidNumEpochsToKeep signals that an invalid number of epochs to keep has been provided
+var ErrInvalidNumEpochsToKeep = errors.New("invalid number of epochs to keep")
+
+// ErrEpochSelectionAuditNotFound signals that the validators selection audit of the requested epoch is not available
+var ErrEpochSelectionAuditNotFound = errors.New("epoch selection audit not found")
+
+// ErrInvalidGasPriceModifier signals that an invalid gas price modifier has been read from config file
+var ErrInvalidGasPriceModifier = errors.New("invalid gas price modifier")
+
+// ErrMinRatingGreaterThanMaxRating signals that the min rating is greater than the max rating
+var ErrMinRatingGreaterThanMaxRating = errors.New("min rating is greater than max rating")
+
+// ErrStartRatingNotBetweenMinAndMax signals that the start rating is not between the min and the max ratings
+var ErrStartRatingNotBetweenMinAndMax = errors.New("start rating is not between min and max rating")
+
+// ErrNilInterceptedDataLimitsChecker signals that a nil intercepted data limits checker has been provided
+var ErrNilInterceptedDataLimitsChecker = errors.New("nil intercepted data limits checker")
+
+// ErrInvalidInterceptedDataLimit signals that an invalid limit of the intercepted data has been provided
+var ErrInvalidInterceptedDataLimit = errors.New("invalid intercepted data limit")
+
+// ErrTxDataTooLarge signals that the data field of a transaction exceeds the max allowed size
+var ErrTxDataTooLarge = errors.New("transaction data too large")
+
+// ErrTooManyTxsInMiniBlock signals that a miniblock holds more transactions than allowed
+var ErrTooManyTxsInMiniBlock = errors.New("too many transactions in miniblock")
+
+// ErrTooManyMiniBlocksInBlock signals that a block holds more miniblocks than allowed
+var ErrTooManyMiniBlocksInBlock = errors.New("too many miniblocks in block")
+
+// ErrNilHeaderIntegrityVerifier signals that a nil header integrity verifier has been provided
+var ErrNilHeaderIntegrityVerifier = errors.New("nil header integrity verifier")
+
+// ErrInvalidRoundDuration signals that an invalid round duration has been provided
+var ErrInvalidRoundDuration = errors.New("invalid round duration")
+
+// ErrInvalidTimestampTolerance signals that an invalid timestamp tolerance has been provided
+var ErrInvalidTimestampTolerance = errors.New("invalid timestamp tolerance")
+
+// ErrHeaderTimestampMismatch signals that the timestamp of a header is not the start time of its round
+var ErrHeaderTimestampMismatch = errors.New("header timestamp does not match its round")
+
+// ErrInvalidSoftwareVersion signals that an invalid software version has been provided
+var ErrInvalidSoftwareVersion = errors.New("invalid software version")
+
+// ErrHeaderChainIDMismatch signals that the chain ID of a header differs from the node's chain ID
+var ErrHeaderChainIDMismatch = errors.New("header chain ID does not match the node's chain ID")
+
+// ErrHeaderSoftwareVersionMismatch signals that the software version of a header differs from the node's one
+var ErrHeaderSoftwareVersionMismatch = errors.New("header software version does not match the node's software version")
+
+// ErrNilSupplyAccountant signals that a nil supply accountant has been provided
+var ErrNilSupplyAccountant = errors.New("nil supply accountant")
+
+// ErrSupplyAccountingDisabled signals that the supply accounting is not enabled on this node
+var ErrSupplyAccountingDisabled = errors.New("supply accounting is disabled")
+
+// ErrNilGenesisSupply signals that a nil genesis supply has been provided
+var ErrNilGenesisSupply = errors.New("nil genesis supply")
+
+// ErrEpochSupplyNotFound signals that the supply counters of the requested epoch are not available
+var ErrEpochSupplyNotFound = errors.New("epoch supply not found")
+
+// ErrNilEconomicsAddress signals that an empty economics address has been provided
+var ErrNilEconomicsAddress = errors.New("nil economics address")
+
+// ErrNilBlockFeesHandler signals that a nil block fees handler has been provided
+var ErrNilBlockFeesHandler = errors.New("nil block fees handler")
+
+// ErrAccumulatedFeesDoNotMatch signals that the accumulated fees from the header do not match the computed ones
+var ErrAccumulatedFeesDoNotMatch = errors.New("accumulated fees do not match")
+
+// ErrBurnedFeesDoNotMatch signals that the burned fees from the header do not match the computed ones
+var ErrBurnedFeesDoNotMatch = errors.New("burned fees do not match")
+
+// ErrInvalidVMExecutionDuration signals that an invalid VM execution duration has been provided
+var ErrInvalidVMExecutionDuration = errors.New("invalid VM execution duration")
+
+// ErrVMBusy signals that the VM is still busy with a previously aborted execution
+var ErrVMBusy = errors.New("VM is busy with a previously aborted execution")
+
+// ErrNilHeadersNotifier signals that a nil headers notifier has been provided
+var ErrNilHeadersNotifier = errors.New("nil headers notifier")
+
+// ErrNilESDTSupplyTracker signals that a nil token supply tracker has been provided
+var ErrNilESDTSupplyTracker = errors.New("nil token supply tracker")
+
+// ErrESDTSupplyTrackingDisabled signals that the token supply tracking is not enabled on this node
+var ErrESDTSupplyTrackingDisabled = errors.New("token supply tracking is disabled")
+
+// ErrTokenSupplyNotFound signals that no supply change has been recorded for the requested token
+var ErrTokenSupplyNotFound = errors.New("token supply not found")
+
+// ErrNilPeerAuthenticationVerifier signals that a nil peer authentication verifier has been provided
+var ErrNilPeerAuthenticationVerifier = errors.New("nil peer authentication verifier")
+
+// ErrNilPeerAuthentication signals that a nil peer authentication message has been provided
+var ErrNilPeerAuthentication = errors.New("nil peer authentication message")
+
+// ErrInvalidPeerAuthentication signals that a peer authentication message misses its public key, peer ID or signature
+var ErrInvalidPeerAuthentication = errors.New("invalid peer authentication message")
+
+// ErrPeerAuthenticationExpired signals that the expiry of a peer authentication message has passed
+var ErrPeerAuthenticationExpired = errors.New("peer authentication message expired")
+
+// ErrPeerAuthenticationExpiryTooFar signals that a peer authentication message expires later than the accepted
+// validity allows
+var ErrPeerAuthenticationExpiryTooFar = errors.New("peer authentication message expiry is too far in the future")
+
+// ErrInvalidPeerAuthenticationValidity signals that an invalid maximum validity of the peer authentication messages
+// has been provided
+var ErrInvalidPeerAuthenticationValidity = errors.New("invalid peer authentication validity")
+
+// ErrPeerAuthenticationNotFromValidator signals that a peer authentication message was signed by a key outside the
+// validators set
+var ErrPeerAuthenticationNotFromValidator = errors.New("peer authentication message is not from a validator")
@@ -0,0 +1,92 @@
+package containers
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/cornelk/hashmap"
+)
+
+// builtInFunctionsContainer is a built-in functions holder organized by function name
+type builtInFunctionsContainer struct {
+	objects *hashmap.HashMap
+}
+
+// NewBuiltInFunctionsContainer will create a new instance of a container
+func NewBuiltInFunctionsContainer() *builtInFunctionsContainer {
+	return &builtInFunctionsContainer{
+		objects: &hashmap.HashMap{},
+	}
+}
+
+// Get returns the object stored at a certain key.
+// Returns an error if the element does not exist
+func (bfc *builtInFunctionsContainer) Get(key string) (process.BuiltInFunction, error) {
+	value, ok := bfc.objects.Get(key)
+	if !ok {
+		return nil, process.ErrInvalidContainerKey
+	}
+
+	function, ok := value.(process.BuiltInFunction)
+	if !ok {
+		return nil, process.ErrWrongTypeInContainer
+	}
+
+	return function, nil
+}
+
+// Add will add an object at a given key. Returns
+// an error if the element already exists
+func (bfc *builtInFunctionsContainer) Add(key string, function process.BuiltInFunction) error {
+	if function == nil || function.IsInterfaceNil() {
+		return process.ErrNilContainerElement
+	}
+
+	ok := bfc.objects.Insert(key, function)
+
+	if !ok {
+		return process.ErrContainerKeyAlreadyExists
+	}
+
+	return nil
+}
+
+// Replace will add (or replace if it already exists) an object at a given key
+func (bfc *builtInFunctionsContainer) Replace(key string, function process.BuiltInFunction) error {
+	if function == nil || function.IsInterfaceNil() {
+		return process.ErrNilContainerElement
+	}
+
+	bfc.objects.Set(key, function)
+	return nil
+}
+
+// Remove will remove an object at a given key
+func (bfc *builtInFunctionsContainer) Remove(key string) {
+	bfc.objects.Del(key)
+}
+
+// Len returns the length of the added objects
+func (bfc *builtInFunctionsContainer) Len() int {
+	return bfc.objects.Len()
+}
+
+// Keys returns all the keys from the container
+func (bfc *builtInFunctionsContainer) Keys() []string {
+	keys := make([]string, 0)
+	for obj := range bfc.objects.Iter() {
+		strKey, ok := obj.Key.(string)
+		if !ok {
+			continue
+		}
+
+		keys = append(keys, strKey)
+	}
+	return keys
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bfc *builtInFunctionsContainer) IsInterfaceNil() bool {
+	if bfc == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package containers_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBuiltInFunctionsContainer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewBuiltInFunctionsContainer()
+
+	assert.NotNil(t, c)
+}
+
+//------- Add
+
+func TestBuiltInFunctionsContainer_AddAlreadyExistingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewBuiltInFunctionsContainer()
+
+	_ = c.Add("func", &mock.BuiltInFunctionStub{})
+	err := c.Add("func", &mock.BuiltInFunctionStub{})
+
+	assert.Equal(t, process.ErrContainerKeyAlreadyExists, err)
+}
+
+func TestBuiltInFunctionsContainer_AddNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewBuiltInFunctionsContainer()
+
+	err := c.Add("func", nil)
+
+	assert.Equal(t, process.ErrNilContainerElement, err)
+}
+
+func TestBuiltInFunctionsContainer_AddShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewBuiltInFunctionsContainer()
+
+	err := c.Add("func", &mock.BuiltInFunctionStub{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, c.Len())
+}
+
+//------- Get
+
+func TestBuiltInFunctionsContainer_GetNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewBuiltInFunctionsContainer()
+
+	_ = c.Add("func", &mock.BuiltInFunctionStub{})
+	function, err := c.Get("missing func")
+
+	assert.Nil(t, function)
+	assert.Equal(t, process.ErrInvalidContainerKey, err)
+}
+
+func TestBuiltInFunctionsContainer_GetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewBuiltInFunctionsContainer()
+
+	val := &mock.BuiltInFunctionStub{}
+	_ = c.Add("func", val)
+	function, err := c.Get("func")
+
+	assert.True(t, val == function)
+	assert.Nil(t, err)
+}
+
+//------- Replace
+
+func TestBuiltInFunctionsContainer_ReplaceNilValueShouldErrAndNotModify(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewBuiltInFunctionsContainer()
+
+	val := &mock.BuiltInFunctionStub{}
+	_ = c.Add("func", val)
+	err := c.Replace("func", nil)
+
+	function, _ := c.Get("func")
+
+	assert.Equal(t, process.ErrNilContainerElement, err)
+	assert.True(t, val == function)
+}
+
+func TestBuiltInFunctionsContainer_ReplaceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewBuiltInFunctionsContainer()
+
+	_ = c.Add("func", &mock.BuiltInFunctionStub{})
+	val2 := &mock.BuiltInFunctionStub{}
+	err := c.Replace("func", val2)
+
+	function, _ := c.Get("func")
+
+	assert.True(t, val2 == function)
+	assert.Nil(t, err)
+}
+
+//------- Remove, Len, Keys
+
+func TestBuiltInFunctionsContainer_RemoveShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewBuiltInFunctionsContainer()
+
+	_ = c.Add("func", &mock.BuiltInFunctionStub{})
+	c.Remove("func")
+
+	function, err := c.Get("func")
+
+	assert.Nil(t, function)
+	assert.Equal(t, process.ErrInvalidContainerKey, err)
+}
+
+func TestBuiltInFunctionsContainer_LenAndKeysShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewBuiltInFunctionsContainer()
+
+	_ = c.Add("func1", &mock.BuiltInFunctionStub{})
+	_ = c.Add("func2", &mock.BuiltInFunctionStub{})
+
+	assert.Equal(t, 2, c.Len())
+	assert.ElementsMatch(t, []string{"func1", "func2"}, c.Keys())
+}
@@ -86,6 +86,20 @@ func (ic *interceptorsContainer) Len() int {
 	return ic.objects.Len()
 }
 
+// Keys returns all the keys from the container
+func (ic *interceptorsContainer) Keys() []string {
+	keys := make([]string, 0)
+	for obj := range ic.objects.Iter() {
+		strKey, ok := obj.Key.(string)
+		if !ok {
+			continue
+		}
+
+		keys = append(keys, strKey)
+	}
+	return keys
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ic *interceptorsContainer) IsInterfaceNil() bool {
 	if ic == nil {
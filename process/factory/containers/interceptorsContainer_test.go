@@ -212,3 +212,14 @@ func TestInterceptorsContainer_LenShouldWork(t *testing.T) {
 	c.Remove("key1")
 	assert.Equal(t, 1, c.Len())
 }
+
+func TestInterceptorsContainer_KeysShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+
+	_ = c.Add("key1", &mock.InterceptorStub{})
+	_ = c.Add("key2", &mock.InterceptorStub{})
+
+	assert.ElementsMatch(t, []string{"key1", "key2"}, c.Keys())
+}
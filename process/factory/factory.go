@@ -17,6 +17,12 @@ const (
 	MetachainBlocksTopic = "metachainBlocks"
 	// ShardHeadersForMetachainTopic is used for sharing shards block headers to the metachain nodes
 	ShardHeadersForMetachainTopic = "shardHeadersForMetachain"
+	// RoundActivationTopic is used for sharing the governance signed updates of the activation rounds
+	RoundActivationTopic = "roundActivation"
+	// OutgoingOperationsTopic is used for sharing the validators signatures over the outgoing operations batches
+	OutgoingOperationsTopic = "outgoingOperations"
+	// PeerAuthenticationTopic is used for sharing the BLS signed bindings between the peer IDs and the validators keys
+	PeerAuthenticationTopic = "peerAuthentication"
 )
 
 // SystemVirtualMachine is a byte array identifier for the smart contract address created for system VM
@@ -0,0 +1,7 @@
+package metachain
+
+import "github.com/ElrondNetwork/elrond-go/process"
+
+func (icf *interceptorsContainerFactory) GlobalThrottler() process.InterceptorThrottler {
+	return icf.globalThrottler
+}
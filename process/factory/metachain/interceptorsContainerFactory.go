@@ -22,6 +22,9 @@ import (
 
 const numGoRoutines = 2000
 
+// numHeadersGoRoutines is the size of the throttler the headers are intercepted on, apart from the other data
+const numHeadersGoRoutines = 100
+
 type interceptorsContainerFactory struct {
 	accounts               state.AccountsAdapter
 	addrConverter          state.AddressConverter
@@ -36,11 +39,12 @@ type interceptorsContainerFactory struct {
 	dataPool               dataRetriever.MetaPoolsHolder
 	shardCoordinator       sharding.Coordinator
 	messenger              process.TopicHandler
-	multiSigner            crypto.MultiSigner
+	headerSigVerifier      process.InterceptedHeaderSigVerifier
 	nodesCoordinator       sharding.NodesCoordinator
 	tpsBenchmark           *statistics.TpsBenchmark
 	argInterceptorFactory  *interceptorFactory.ArgInterceptedDataFactory
 	globalThrottler        process.InterceptorThrottler
+	headersThrottler       process.InterceptorThrottler
 }
 
 // NewInterceptorsContainerFactory is responsible for creating a new interceptors factory object
@@ -51,7 +55,8 @@ func NewInterceptorsContainerFactory(
 	store dataRetriever.StorageService,
 	marshalizer marshal.Marshalizer,
 	hasher hashing.Hasher,
-	multiSigner crypto.MultiSigner,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
+	headerIntegrityVerifier process.HeaderIntegrityVerifier,
 	dataPool dataRetriever.MetaPoolsHolder,
 	accounts state.AccountsAdapter,
 	addrConverter state.AddressConverter,
@@ -59,6 +64,9 @@ func NewInterceptorsContainerFactory(
 	keyGen crypto.KeyGenerator,
 	maxTxNonceDeltaAllowed int,
 	txFeeHandler process.FeeHandler,
+	chainID []byte,
+	limitsChecker process.InterceptedDataLimitsChecker,
+	peerAuthVerifier process.PeerAuthenticationVerifier,
 ) (*interceptorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -76,8 +84,11 @@ func NewInterceptorsContainerFactory(
 	if check.IfNil(hasher) {
 		return nil, process.ErrNilHasher
 	}
-	if check.IfNil(multiSigner) {
-		return nil, process.ErrNilMultiSigVerifier
+	if check.IfNil(headerSigVerifier) {
+		return nil, process.ErrNilHeaderSigVerifier
+	}
+	if check.IfNil(headerIntegrityVerifier) {
+		return nil, process.ErrNilHeaderIntegrityVerifier
 	}
 	if check.IfNil(dataPool) {
 		return nil, process.ErrNilDataPoolHolder
@@ -100,17 +111,29 @@ func NewInterceptorsContainerFactory(
 	if check.IfNil(txFeeHandler) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if len(chainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
+	if check.IfNil(limitsChecker) {
+		return nil, process.ErrNilInterceptedDataLimitsChecker
+	}
+	if check.IfNil(peerAuthVerifier) {
+		return nil, process.ErrNilPeerAuthenticationVerifier
+	}
 
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
-		Marshalizer:      marshalizer,
-		Hasher:           hasher,
-		ShardCoordinator: shardCoordinator,
-		NodesCoordinator: nodesCoordinator,
-		MultiSigVerifier: multiSigner,
-		KeyGen:           keyGen,
-		Signer:           singleSigner,
-		AddrConv:         addrConverter,
-		FeeHandler:       txFeeHandler,
+		Marshalizer:             marshalizer,
+		Hasher:                  hasher,
+		ShardCoordinator:        shardCoordinator,
+		KeyGen:                  keyGen,
+		Signer:                  singleSigner,
+		AddrConv:                addrConverter,
+		FeeHandler:              txFeeHandler,
+		HeaderSigVerifier:       headerSigVerifier,
+		HeaderIntegrityVerifier: headerIntegrityVerifier,
+		ChainID:                 chainID,
+		LimitsChecker:           limitsChecker,
+		PeerAuthVerifier:        peerAuthVerifier,
 	}
 
 	icf := &interceptorsContainerFactory{
@@ -119,7 +142,7 @@ func NewInterceptorsContainerFactory(
 		store:                  store,
 		marshalizer:            marshalizer,
 		hasher:                 hasher,
-		multiSigner:            multiSigner,
+		headerSigVerifier:      headerSigVerifier,
 		dataPool:               dataPool,
 		nodesCoordinator:       nodesCoordinator,
 		argInterceptorFactory:  argInterceptorFactory,
@@ -133,6 +156,11 @@ func NewInterceptorsContainerFactory(
 		return nil, err
 	}
 
+	icf.headersThrottler, err = throttler.NewNumGoRoutineThrottler(numHeadersGoRoutines)
+	if err != nil {
+		return nil, err
+	}
+
 	return icf, nil
 }
 
@@ -176,6 +204,15 @@ func (icf *interceptorsContainerFactory) Create() (process.InterceptorsContainer
 		return nil, err
 	}
 
+	keys, interceptorSlice, err = icf.generatePeerAuthenticationInterceptor()
+	if err != nil {
+		return nil, err
+	}
+	err = container.AddMultiple(keys, interceptorSlice)
+	if err != nil {
+		return nil, err
+	}
+
 	return container, nil
 }
 
@@ -185,9 +222,12 @@ func (icf *interceptorsContainerFactory) createTopicAndAssignHandler(
 	createChannel bool,
 ) (process.Interceptor, error) {
 
-	err := icf.messenger.CreateTopic(topic, createChannel)
-	if err != nil {
-		return nil, err
+	//the topic already exists if the container is re-created
+	if !icf.messenger.HasTopic(topic) {
+		err := icf.messenger.CreateTopic(topic, createChannel)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return interceptor, icf.messenger.RegisterMessageProcessor(topic, interceptor)
@@ -227,7 +267,7 @@ func (icf *interceptorsContainerFactory) generateMetablockInterceptor() ([]strin
 	interceptor, err := processInterceptors.NewSingleDataInterceptor(
 		hdrFactory,
 		hdrProcessor,
-		icf.globalThrottler,
+		icf.headersThrottler,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -293,7 +333,7 @@ func (icf *interceptorsContainerFactory) createOneShardHeaderInterceptor(topic s
 	interceptor, err := processInterceptors.NewSingleDataInterceptor(
 		hdrFactory,
 		hdrProcessor,
-		icf.globalThrottler,
+		icf.headersThrottler,
 	)
 	if err != nil {
 		return nil, err
@@ -438,6 +478,46 @@ func (icf *interceptorsContainerFactory) createOneMiniBlocksInterceptor(topic st
 	return icf.createTopicAndAssignHandler(topic, interceptor, true)
 }
 
+//------- PeerAuthentication interceptor
+
+func (icf *interceptorsContainerFactory) generatePeerAuthenticationInterceptor() ([]string, []process.Interceptor, error) {
+	identifier := factory.PeerAuthenticationTopic
+
+	peerAuthFactory, err := interceptorFactory.NewMetaInterceptedDataFactory(
+		icf.argInterceptorFactory,
+		interceptorFactory.InterceptedPeerAuthentication,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	argProcessor := &processor.ArgPeerAuthenticationInterceptorProcessor{
+		PeerAuthentications: icf.dataPool.PeerAuthentications(),
+		NodesCoordinator:    icf.nodesCoordinator,
+	}
+	peerAuthProcessor, err := processor.NewPeerAuthenticationInterceptorProcessor(argProcessor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	//only one peer authentication topic, shared by all the shards
+	interceptor, err := interceptors.NewSingleDataInterceptor(
+		peerAuthFactory,
+		peerAuthProcessor,
+		icf.globalThrottler,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = icf.createTopicAndAssignHandler(identifier, interceptor, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return []string{identifier}, []process.Interceptor{interceptor}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (icf *interceptorsContainerFactory) IsInterfaceNil() bool {
 	if icf == nil {
@@ -17,6 +17,8 @@ import (
 
 const maxTxNonceDeltaAllowed = 100
 
+var chainID = []byte("chain ID")
+
 var errExpected = errors.New("expected error")
 
 func createStubTopicHandler(matchStrToErrOnCreate string, matchStrToErrOnRegister string) process.TopicHandler {
@@ -66,6 +68,9 @@ func createDataPools() dataRetriever.MetaPoolsHolder {
 		UnsignedTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
 			return &mock.ShardedDataStub{}
 		},
+		PeerAuthenticationsCalled: func() storage.Cacher {
+			return &mock.CacherStub{}
+		},
 	}
 
 	return pools
@@ -91,7 +96,8 @@ func TestNewInterceptorsContainerFactory_NilShardCoordinatorShouldErr(t *testing
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -99,6 +105,9 @@ func TestNewInterceptorsContainerFactory_NilShardCoordinatorShouldErr(t *testing
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -115,7 +124,8 @@ func TestNewInterceptorsContainerFactory_NilNodesCoordinatorShouldErr(t *testing
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -123,6 +133,9 @@ func TestNewInterceptorsContainerFactory_NilNodesCoordinatorShouldErr(t *testing
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -139,7 +152,8 @@ func TestNewInterceptorsContainerFactory_NilTopicHandlerShouldErr(t *testing.T)
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -147,6 +161,9 @@ func TestNewInterceptorsContainerFactory_NilTopicHandlerShouldErr(t *testing.T)
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -163,7 +180,8 @@ func TestNewInterceptorsContainerFactory_NilBlockchainShouldErr(t *testing.T) {
 		nil,
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -171,6 +189,9 @@ func TestNewInterceptorsContainerFactory_NilBlockchainShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -187,7 +208,8 @@ func TestNewInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 		createStore(),
 		nil,
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -195,6 +217,9 @@ func TestNewInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -211,7 +236,8 @@ func TestNewInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		nil,
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -219,13 +245,16 @@ func TestNewInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilHasher, err)
 }
 
-func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
+func TestNewInterceptorsContainerFactory_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	icf, err := metachain.NewInterceptorsContainerFactory(
@@ -236,6 +265,7 @@ func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
 		nil,
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -243,10 +273,41 @@ func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
-	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
+}
+
+func TestNewInterceptorsContainerFactory_NilHeaderIntegrityVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := metachain.NewInterceptorsContainerFactory(
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.HeaderSigVerifierStub{},
+		nil,
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilHeaderIntegrityVerifier, err)
 }
 
 func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
@@ -259,7 +320,8 @@ func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		nil,
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -267,6 +329,9 @@ func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -283,7 +348,8 @@ func TestNewInterceptorsContainerFactory_NilAccountsShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		nil,
 		&mock.AddressConverterMock{},
@@ -291,6 +357,9 @@ func TestNewInterceptorsContainerFactory_NilAccountsShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -307,7 +376,8 @@ func TestNewInterceptorsContainerFactory_NilAddrConvShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		nil,
@@ -315,6 +385,9 @@ func TestNewInterceptorsContainerFactory_NilAddrConvShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -331,7 +404,8 @@ func TestNewInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T)
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -339,6 +413,9 @@ func TestNewInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T)
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -355,7 +432,8 @@ func TestNewInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -363,6 +441,9 @@ func TestNewInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 		nil,
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -379,7 +460,8 @@ func TestNewInterceptorsContainerFactory_NilFeeHandlerShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -387,12 +469,99 @@ func TestNewInterceptorsContainerFactory_NilFeeHandlerShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		nil,
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewInterceptorsContainerFactory_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := metachain.NewInterceptorsContainerFactory(
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		nil,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestNewInterceptorsContainerFactory_NilLimitsCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := metachain.NewInterceptorsContainerFactory(
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		chainID,
+		nil,
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilInterceptedDataLimitsChecker, err)
+}
+
+func TestNewInterceptorsContainerFactory_NilPeerAuthVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := metachain.NewInterceptorsContainerFactory(
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		nil,
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilPeerAuthenticationVerifier, err)
+}
+
 func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -403,7 +572,8 @@ func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -411,6 +581,9 @@ func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.NotNil(t, icf)
@@ -429,7 +602,8 @@ func TestInterceptorsContainerFactory_CreateTopicMetablocksFailsShouldErr(t *tes
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -437,6 +611,9 @@ func TestInterceptorsContainerFactory_CreateTopicMetablocksFailsShouldErr(t *tes
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -455,7 +632,8 @@ func TestInterceptorsContainerFactory_CreateTopicShardHeadersForMetachainFailsSh
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -463,6 +641,9 @@ func TestInterceptorsContainerFactory_CreateTopicShardHeadersForMetachainFailsSh
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -481,7 +662,8 @@ func TestInterceptorsContainerFactory_CreateRegisterForMetablocksFailsShouldErr(
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -489,6 +671,9 @@ func TestInterceptorsContainerFactory_CreateRegisterForMetablocksFailsShouldErr(
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -507,7 +692,8 @@ func TestInterceptorsContainerFactory_CreateRegisterShardHeadersForMetachainFail
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -515,6 +701,9 @@ func TestInterceptorsContainerFactory_CreateRegisterShardHeadersForMetachainFail
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -540,7 +729,8 @@ func TestInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -548,6 +738,9 @@ func TestInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -586,7 +779,8 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -594,6 +788,9 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -602,9 +799,63 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	numInterceptorsShardHeadersForMetachain := noOfShards
 	numInterceptorsTransactionsForMetachain := noOfShards + 1
 	numInterceptorsUnsignedTxsForMetachain := noOfShards + 1
+	numInterceptorsPeerAuthentication := 1
 	totalInterceptors := numInterceptorsMetablock + numInterceptorsShardHeadersForMetachain +
-		numInterceptorsTransactionsForMetachain + numInterceptorsUnsignedTxsForMetachain
+		numInterceptorsTransactionsForMetachain + numInterceptorsUnsignedTxsForMetachain + numInterceptorsPeerAuthentication
 
 	assert.Nil(t, err)
 	assert.Equal(t, totalInterceptors, container.Len())
 }
+
+func TestInterceptorsContainerFactory_HeadersShouldBeInterceptedWhileTheGlobalThrottlerIsSaturated(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	handlers := make(map[string]p2p.MessageProcessor)
+	icf, _ := metachain.NewInterceptorsContainerFactory(
+		shardCoordinator,
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{
+			CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+				return nil
+			},
+			RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+				handlers[topic] = handler
+				return nil
+			},
+		},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+	_, _ = icf.Create()
+
+	globalThrottler := icf.GlobalThrottler()
+	for globalThrottler.CanProcess() {
+		globalThrottler.StartProcessing()
+	}
+
+	msg := &mock.P2PMessageMock{DataField: []byte("data")}
+	txTopic := factory.TransactionTopic + shardCoordinator.CommunicationIdentifier(shardCoordinator.SelfId())
+	err := handlers[txTopic].ProcessReceivedMessage(msg, nil)
+	assert.Equal(t, process.ErrSystemBusy, err)
+
+	shardHdrTopic := factory.ShardHeadersForMetachainTopic + shardCoordinator.CommunicationIdentifier(0)
+	err = handlers[shardHdrTopic].ProcessReceivedMessage(msg, nil)
+	assert.NotEqual(t, process.ErrSystemBusy, err)
+
+	err = handlers[factory.MetachainBlocksTopic].ProcessReceivedMessage(msg, nil)
+	assert.NotEqual(t, process.ErrSystemBusy, err)
+}
@@ -1,7 +1,6 @@
 package metachain
 
 import (
-	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
@@ -13,35 +12,28 @@ import (
 )
 
 type vmContainerFactory struct {
-	accounts         state.AccountsAdapter
-	addressConverter state.AddressConverter
-	vmAccountsDB     *hooks.VMAccountsDB
-	cryptoHook       vmcommon.CryptoHook
+	blockChainHook *hooks.BlockChainHookImpl
+	cryptoHook     vmcommon.CryptoHook
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
-func NewVMContainerFactory(
-	accounts state.AccountsAdapter,
-	addressConverter state.AddressConverter,
-) (*vmContainerFactory, error) {
-	if accounts == nil || accounts.IsInterfaceNil() {
+func NewVMContainerFactory(argBlockChainHook hooks.ArgBlockChainHook) (*vmContainerFactory, error) {
+	if argBlockChainHook.Accounts == nil || argBlockChainHook.Accounts.IsInterfaceNil() {
 		return nil, process.ErrNilAccountsAdapter
 	}
-	if addressConverter == nil || addressConverter.IsInterfaceNil() {
+	if argBlockChainHook.AddrConv == nil || argBlockChainHook.AddrConv.IsInterfaceNil() {
 		return nil, process.ErrNilAddressConverter
 	}
 
-	vmAccountsDB, err := hooks.NewVMAccountsDB(accounts, addressConverter)
+	blockChainHook, err := hooks.NewBlockChainHookImpl(argBlockChainHook)
 	if err != nil {
 		return nil, err
 	}
 	cryptoHook := hooks.NewVMCryptoHook()
 
 	return &vmContainerFactory{
-		accounts:         accounts,
-		addressConverter: addressConverter,
-		vmAccountsDB:     vmAccountsDB,
-		cryptoHook:       cryptoHook,
+		blockChainHook: blockChainHook,
+		cryptoHook:     cryptoHook,
 	}, nil
 }
 
@@ -63,7 +55,7 @@ func (vmf *vmContainerFactory) Create() (process.VirtualMachinesContainer, error
 }
 
 func (vmf *vmContainerFactory) createSystemVM() (vmcommon.VMExecutionHandler, error) {
-	systemEI, err := systemSmartContracts.NewVMContext(vmf.vmAccountsDB, vmf.cryptoHook)
+	systemEI, err := systemSmartContracts.NewVMContext(vmf.blockChainHook, vmf.cryptoHook)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +69,7 @@ func (vmf *vmContainerFactory) createSystemVM() (vmcommon.VMExecutionHandler, er
 	if err != nil {
 		return nil, err
 	}
+	vmf.blockChainHook.SetSystemSmartContracts(systemContracts.Keys())
 
 	systemVM, err := systemVMProcess.NewSystemVM(systemEI, systemContracts, factory.SystemVirtualMachine)
 	if err != nil {
@@ -86,9 +79,9 @@ func (vmf *vmContainerFactory) createSystemVM() (vmcommon.VMExecutionHandler, er
 	return systemVM, nil
 }
 
-// VMAccountsDB returns the created vmAccountsDB
-func (vmf *vmContainerFactory) VMAccountsDB() *hooks.VMAccountsDB {
-	return vmf.vmAccountsDB
+// BlockChainHookImpl returns the created blockchain hook
+func (vmf *vmContainerFactory) BlockChainHookImpl() *hooks.BlockChainHookImpl {
+	return vmf.blockChainHook
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -6,16 +6,28 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	systemVMFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
 	"github.com/stretchr/testify/assert"
 )
 
+func createMockArgBlockChainHook() hooks.ArgBlockChainHook {
+	return hooks.ArgBlockChainHook{
+		Accounts:         &mock.AccountsStub{},
+		AddrConv:         &mock.AddressConverterMock{},
+		StorageService:   &mock.ChainStorerMock{},
+		BlockChain:       &mock.BlockChainMock{},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		Uint64Converter:  &mock.Uint64ByteSliceConverterMock{},
+	}
+}
+
 func TestNewVMContainerFactory_NilAccountsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	vmf, err := NewVMContainerFactory(
-		nil,
-		&mock.AddressConverterMock{},
-	)
+	args := createMockArgBlockChainHook()
+	args.Accounts = nil
+	vmf, err := NewVMContainerFactory(args)
 
 	assert.Nil(t, vmf)
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -24,22 +36,29 @@ func TestNewVMContainerFactory_NilAccountsShouldErr(t *testing.T) {
 func TestNewVMContainerFactory_NilAddressConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	vmf, err := NewVMContainerFactory(
-		&mock.AccountsStub{},
-		nil,
-	)
+	args := createMockArgBlockChainHook()
+	args.AddrConv = nil
+	vmf, err := NewVMContainerFactory(args)
 
 	assert.Nil(t, vmf)
 	assert.Equal(t, process.ErrNilAddressConverter, err)
 }
 
+func TestNewVMContainerFactory_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgBlockChainHook()
+	args.BlockChain = nil
+	vmf, err := NewVMContainerFactory(args)
+
+	assert.Nil(t, vmf)
+	assert.Equal(t, hooks.ErrNilBlockChain, err)
+}
+
 func TestNewVMContainerFactory_OkValues(t *testing.T) {
 	t.Parallel()
 
-	vmf, err := NewVMContainerFactory(
-		&mock.AccountsStub{},
-		&mock.AddressConverterMock{},
-	)
+	vmf, err := NewVMContainerFactory(createMockArgBlockChainHook())
 
 	assert.NotNil(t, vmf)
 	assert.Nil(t, err)
@@ -48,10 +67,7 @@ func TestNewVMContainerFactory_OkValues(t *testing.T) {
 func TestVmContainerFactory_Create(t *testing.T) {
 	t.Parallel()
 
-	vmf, err := NewVMContainerFactory(
-		&mock.AccountsStub{},
-		&mock.AddressConverterMock{},
-	)
+	vmf, err := NewVMContainerFactory(createMockArgBlockChainHook())
 	assert.NotNil(t, vmf)
 	assert.Nil(t, err)
 
@@ -63,6 +79,7 @@ func TestVmContainerFactory_Create(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, vm)
 
-	acc := vmf.VMAccountsDB()
-	assert.NotNil(t, acc)
+	blockChainHook := vmf.BlockChainHookImpl()
+	assert.NotNil(t, blockChainHook)
+	assert.True(t, blockChainHook.IsSystemSmartContract(systemVMFactory.StakingSCAddress))
 }
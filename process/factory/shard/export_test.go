@@ -0,0 +1,7 @@
+package shard
+
+import "github.com/ElrondNetwork/elrond-go/process"
+
+func (icf *interceptorsContainerFactory) GlobalTxThrottler() process.InterceptorThrottler {
+	return icf.globalTxThrottler
+}
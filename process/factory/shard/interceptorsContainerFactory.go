@@ -21,6 +21,9 @@ import (
 
 const numGoRoutines = 2000
 
+// numHeadersGoRoutines is the size of the throttler the headers are intercepted on, apart from the other data
+const numHeadersGoRoutines = 100
+
 type interceptorsContainerFactory struct {
 	accounts               state.AccountsAdapter
 	shardCoordinator       sharding.Coordinator
@@ -30,12 +33,13 @@ type interceptorsContainerFactory struct {
 	hasher                 hashing.Hasher
 	keyGen                 crypto.KeyGenerator
 	singleSigner           crypto.SingleSigner
-	multiSigner            crypto.MultiSigner
+	headerSigVerifier      process.InterceptedHeaderSigVerifier
 	dataPool               dataRetriever.PoolsHolder
 	addrConverter          state.AddressConverter
 	nodesCoordinator       sharding.NodesCoordinator
 	argInterceptorFactory  *interceptorFactory.ArgInterceptedDataFactory
 	globalTxThrottler      process.InterceptorThrottler
+	headersThrottler       process.InterceptorThrottler
 	maxTxNonceDeltaAllowed int
 }
 
@@ -50,11 +54,15 @@ func NewInterceptorsContainerFactory(
 	hasher hashing.Hasher,
 	keyGen crypto.KeyGenerator,
 	singleSigner crypto.SingleSigner,
-	multiSigner crypto.MultiSigner,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
+	headerIntegrityVerifier process.HeaderIntegrityVerifier,
 	dataPool dataRetriever.PoolsHolder,
 	addrConverter state.AddressConverter,
 	maxTxNonceDeltaAllowed int,
 	txFeeHandler process.FeeHandler,
+	chainID []byte,
+	limitsChecker process.InterceptedDataLimitsChecker,
+	peerAuthVerifier process.PeerAuthenticationVerifier,
 ) (*interceptorsContainerFactory, error) {
 	if accounts == nil || accounts.IsInterfaceNil() {
 		return nil, process.ErrNilAccountsAdapter
@@ -80,8 +88,11 @@ func NewInterceptorsContainerFactory(
 	if singleSigner == nil || singleSigner.IsInterfaceNil() {
 		return nil, process.ErrNilSingleSigner
 	}
-	if multiSigner == nil || multiSigner.IsInterfaceNil() {
-		return nil, process.ErrNilMultiSigVerifier
+	if headerSigVerifier == nil || headerSigVerifier.IsInterfaceNil() {
+		return nil, process.ErrNilHeaderSigVerifier
+	}
+	if headerIntegrityVerifier == nil || headerIntegrityVerifier.IsInterfaceNil() {
+		return nil, process.ErrNilHeaderIntegrityVerifier
 	}
 	if dataPool == nil || dataPool.IsInterfaceNil() {
 		return nil, process.ErrNilDataPoolHolder
@@ -95,17 +106,29 @@ func NewInterceptorsContainerFactory(
 	if txFeeHandler == nil || txFeeHandler.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if len(chainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
+	if limitsChecker == nil || limitsChecker.IsInterfaceNil() {
+		return nil, process.ErrNilInterceptedDataLimitsChecker
+	}
+	if peerAuthVerifier == nil || peerAuthVerifier.IsInterfaceNil() {
+		return nil, process.ErrNilPeerAuthenticationVerifier
+	}
 
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
-		Marshalizer:      marshalizer,
-		Hasher:           hasher,
-		ShardCoordinator: shardCoordinator,
-		MultiSigVerifier: multiSigner,
-		NodesCoordinator: nodesCoordinator,
-		KeyGen:           keyGen,
-		Signer:           singleSigner,
-		AddrConv:         addrConverter,
-		FeeHandler:       txFeeHandler,
+		Marshalizer:             marshalizer,
+		Hasher:                  hasher,
+		ShardCoordinator:        shardCoordinator,
+		KeyGen:                  keyGen,
+		Signer:                  singleSigner,
+		AddrConv:                addrConverter,
+		FeeHandler:              txFeeHandler,
+		HeaderSigVerifier:       headerSigVerifier,
+		HeaderIntegrityVerifier: headerIntegrityVerifier,
+		ChainID:                 chainID,
+		LimitsChecker:           limitsChecker,
+		PeerAuthVerifier:        peerAuthVerifier,
 	}
 
 	icf := &interceptorsContainerFactory{
@@ -117,7 +140,7 @@ func NewInterceptorsContainerFactory(
 		hasher:                 hasher,
 		keyGen:                 keyGen,
 		singleSigner:           singleSigner,
-		multiSigner:            multiSigner,
+		headerSigVerifier:      headerSigVerifier,
 		dataPool:               dataPool,
 		addrConverter:          addrConverter,
 		nodesCoordinator:       nodesCoordinator,
@@ -131,6 +154,11 @@ func NewInterceptorsContainerFactory(
 		return nil, err
 	}
 
+	icf.headersThrottler, err = throttler.NewNumGoRoutineThrottler(numHeadersGoRoutines)
+	if err != nil {
+		return nil, err
+	}
+
 	return icf, nil
 }
 
@@ -198,6 +226,16 @@ func (icf *interceptorsContainerFactory) Create() (process.InterceptorsContainer
 		return nil, err
 	}
 
+	keys, interceptorSlice, err = icf.generatePeerAuthenticationInterceptor()
+	if err != nil {
+		return nil, err
+	}
+
+	err = container.AddMultiple(keys, interceptorSlice)
+	if err != nil {
+		return nil, err
+	}
+
 	return container, nil
 }
 
@@ -207,9 +245,12 @@ func (icf *interceptorsContainerFactory) createTopicAndAssignHandler(
 	createChannel bool,
 ) (process.Interceptor, error) {
 
-	err := icf.messenger.CreateTopic(topic, createChannel)
-	if err != nil {
-		return nil, err
+	//the topic already exists if the container is re-created
+	if !icf.messenger.HasTopic(topic) {
+		err := icf.messenger.CreateTopic(topic, createChannel)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return interceptor, icf.messenger.RegisterMessageProcessor(topic, interceptor)
@@ -443,7 +484,7 @@ func (icf *interceptorsContainerFactory) generateHdrInterceptor() ([]string, []p
 	interceptor, err := interceptors.NewSingleDataInterceptor(
 		hdrFactory,
 		hdrProcessor,
-		icf.globalTxThrottler,
+		icf.headersThrottler,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -556,7 +597,7 @@ func (icf *interceptorsContainerFactory) generateMetachainHeaderInterceptor() ([
 	interceptor, err := interceptors.NewSingleDataInterceptor(
 		hdrFactory,
 		hdrProcessor,
-		icf.globalTxThrottler,
+		icf.headersThrottler,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -570,6 +611,46 @@ func (icf *interceptorsContainerFactory) generateMetachainHeaderInterceptor() ([
 	return []string{identifierHdr}, []process.Interceptor{interceptor}, nil
 }
 
+//------- PeerAuthentication interceptor
+
+func (icf *interceptorsContainerFactory) generatePeerAuthenticationInterceptor() ([]string, []process.Interceptor, error) {
+	identifier := factory.PeerAuthenticationTopic
+
+	peerAuthFactory, err := interceptorFactory.NewShardInterceptedDataFactory(
+		icf.argInterceptorFactory,
+		interceptorFactory.InterceptedPeerAuthentication,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	argProcessor := &processor.ArgPeerAuthenticationInterceptorProcessor{
+		PeerAuthentications: icf.dataPool.PeerAuthentications(),
+		NodesCoordinator:    icf.nodesCoordinator,
+	}
+	peerAuthProcessor, err := processor.NewPeerAuthenticationInterceptorProcessor(argProcessor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	//only one peer authentication topic, shared by all the shards
+	interceptor, err := interceptors.NewSingleDataInterceptor(
+		peerAuthFactory,
+		peerAuthProcessor,
+		icf.globalTxThrottler,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = icf.createTopicAndAssignHandler(identifier, interceptor, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return []string{identifier}, []process.Interceptor{interceptor}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (icf *interceptorsContainerFactory) IsInterfaceNil() bool {
 	if icf == nil {
@@ -19,6 +19,8 @@ var errExpected = errors.New("expected error")
 
 const maxTxNonceDeltaAllowed = 100
 
+var chainID = []byte("chain ID")
+
 func createStubTopicHandler(matchStrToErrOnCreate string, matchStrToErrOnRegister string) process.TopicHandler {
 	return &mock.TopicHandlerStub{
 		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
@@ -70,6 +72,9 @@ func createDataPools() dataRetriever.PoolsHolder {
 	pools.RewardTransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
 		return &mock.ShardedDataStub{}
 	}
+	pools.PeerAuthenticationsCalled = func() storage.Cacher {
+		return &mock.CacherStub{}
+	}
 	return pools
 }
 
@@ -95,11 +100,15 @@ func TestNewInterceptorsContainerFactory_NilAccountsAdapter(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -119,11 +128,15 @@ func TestNewInterceptorsContainerFactory_NilShardCoordinatorShouldErr(t *testing
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -143,11 +156,15 @@ func TestNewInterceptorsContainerFactory_NilNodesCoordinatorShouldErr(t *testing
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -167,11 +184,15 @@ func TestNewInterceptorsContainerFactory_NilTopicHandlerShouldErr(t *testing.T)
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -191,11 +212,15 @@ func TestNewInterceptorsContainerFactory_NilBlockchainShouldErr(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -215,11 +240,15 @@ func TestNewInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -239,11 +268,15 @@ func TestNewInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 		nil,
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -263,11 +296,15 @@ func TestNewInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 		&mock.HasherMock{},
 		nil,
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -287,18 +324,22 @@ func TestNewInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T)
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		nil,
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilSingleSigner, err)
 }
 
-func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
+func TestNewInterceptorsContainerFactory_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	icf, err := shard.NewInterceptorsContainerFactory(
@@ -312,14 +353,46 @@ func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
 		nil,
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
-	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
+}
+
+func TestNewInterceptorsContainerFactory_NilHeaderIntegrityVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		&mock.HeaderSigVerifierStub{},
+		nil,
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilHeaderIntegrityVerifier, err)
 }
 
 func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
@@ -335,11 +408,15 @@ func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		nil,
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -359,11 +436,15 @@ func TestNewInterceptorsContainerFactory_NilAddrConverterShouldErr(t *testing.T)
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		nil,
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -383,17 +464,105 @@ func TestNewInterceptorsContainerFactory_NilTxFeeHandlerShouldErr(t *testing.T)
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		nil,
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewInterceptorsContainerFactory_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		nil,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestNewInterceptorsContainerFactory_NilLimitsCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		chainID,
+		nil,
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilInterceptedDataLimitsChecker, err)
+}
+
+func TestNewInterceptorsContainerFactory_NilPeerAuthVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		nil,
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilPeerAuthenticationVerifier, err)
+}
+
 func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -407,11 +576,15 @@ func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	assert.NotNil(t, icf)
@@ -433,11 +606,15 @@ func TestInterceptorsContainerFactory_CreateTopicCreationTxFailsShouldErr(t *tes
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -459,11 +636,15 @@ func TestInterceptorsContainerFactory_CreateTopicCreationHdrFailsShouldErr(t *te
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -485,11 +666,15 @@ func TestInterceptorsContainerFactory_CreateTopicCreationMiniBlocksFailsShouldEr
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -511,11 +696,15 @@ func TestInterceptorsContainerFactory_CreateTopicCreationMetachainHeadersFailsSh
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -537,11 +726,15 @@ func TestInterceptorsContainerFactory_CreateRegisterTxFailsShouldErr(t *testing.
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -563,11 +756,15 @@ func TestInterceptorsContainerFactory_CreateRegisterHdrFailsShouldErr(t *testing
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -589,11 +786,15 @@ func TestInterceptorsContainerFactory_CreateRegisterMiniBlocksFailsShouldErr(t *
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -615,11 +816,15 @@ func TestInterceptorsContainerFactory_CreateRegisterMetachainHeadersShouldErr(t
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -648,11 +853,15 @@ func TestInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -694,11 +903,15 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
 	)
 
 	container, err := icf.Create()
@@ -709,9 +922,64 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	numInterceptorHeaders := 1
 	numInterceptorMiniBlocks := noOfShards + 1
 	numInterceptorMetachainHeaders := 1
+	numInterceptorPeerAuthentication := 1
 	totalInterceptors := numInterceptorTxs + numInterceptorsUnsignedTxs + numInterceptorsRewardTxs +
-		numInterceptorHeaders + numInterceptorMiniBlocks + numInterceptorMetachainHeaders
+		numInterceptorHeaders + numInterceptorMiniBlocks + numInterceptorMetachainHeaders + numInterceptorPeerAuthentication
 
 	assert.Nil(t, err)
 	assert.Equal(t, totalInterceptors, container.Len())
 }
+
+func TestInterceptorsContainerFactory_HeadersShouldBeInterceptedWhileTheGlobalThrottlerIsSaturated(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	handlers := make(map[string]p2p.MessageProcessor)
+	icf, _ := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		shardCoordinator,
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{
+			CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+				return nil
+			},
+			RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+				handlers[topic] = handler
+				return nil
+			},
+		},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		&mock.HeaderSigVerifierStub{},
+		&mock.HeaderIntegrityVerifierStub{},
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+	_, _ = icf.Create()
+
+	globalThrottler := icf.GlobalTxThrottler()
+	for globalThrottler.CanProcess() {
+		globalThrottler.StartProcessing()
+	}
+
+	msg := &mock.P2PMessageMock{DataField: []byte("data")}
+	txTopic := factory.TransactionTopic + shardCoordinator.CommunicationIdentifier(shardCoordinator.SelfId())
+	err := handlers[txTopic].ProcessReceivedMessage(msg, nil)
+	assert.Equal(t, process.ErrSystemBusy, err)
+
+	hdrTopic := factory.HeadersTopic + shardCoordinator.CommunicationIdentifier(shardCoordinator.SelfId())
+	err = handlers[hdrTopic].ProcessReceivedMessage(msg, nil)
+	assert.NotEqual(t, process.ErrSystemBusy, err)
+
+	metaHdrTopic := factory.MetachainBlocksTopic
+	err = handlers[metaHdrTopic].ProcessReceivedMessage(msg, nil)
+	assert.NotEqual(t, process.ErrSystemBusy, err)
+}
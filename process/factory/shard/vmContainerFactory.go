@@ -1,49 +1,45 @@
 package shard
 
 import (
-	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	systemVMFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	systemVMProcess "github.com/ElrondNetwork/elrond-go/vm/process"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm/iele/elrond/node/endpoint"
 )
 
 type vmContainerFactory struct {
-	accounts         state.AccountsAdapter
-	addressConverter state.AddressConverter
-	vmAccountsDB     *hooks.VMAccountsDB
-	cryptoHook       vmcommon.CryptoHook
+	blockChainHook *hooks.BlockChainHookImpl
+	cryptoHook     vmcommon.CryptoHook
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
-func NewVMContainerFactory(
-	accounts state.AccountsAdapter,
-	addressConverter state.AddressConverter,
-) (*vmContainerFactory, error) {
-	if accounts == nil || accounts.IsInterfaceNil() {
+func NewVMContainerFactory(argBlockChainHook hooks.ArgBlockChainHook) (*vmContainerFactory, error) {
+	if argBlockChainHook.Accounts == nil || argBlockChainHook.Accounts.IsInterfaceNil() {
 		return nil, process.ErrNilAccountsAdapter
 	}
-	if addressConverter == nil || addressConverter.IsInterfaceNil() {
+	if argBlockChainHook.AddrConv == nil || argBlockChainHook.AddrConv.IsInterfaceNil() {
 		return nil, process.ErrNilAddressConverter
 	}
 
-	vmAccountsDB, err := hooks.NewVMAccountsDB(accounts, addressConverter)
+	blockChainHook, err := hooks.NewBlockChainHookImpl(argBlockChainHook)
 	if err != nil {
 		return nil, err
 	}
 	cryptoHook := hooks.NewVMCryptoHook()
 
 	return &vmContainerFactory{
-		accounts:         accounts,
-		addressConverter: addressConverter,
-		vmAccountsDB:     vmAccountsDB,
-		cryptoHook:       cryptoHook,
+		blockChainHook: blockChainHook,
+		cryptoHook:     cryptoHook,
 	}, nil
 }
 
-// Create sets up all the needed virtual machine returning a container of all the VMs
+// Create sets up all the needed virtual machine returning a container of all the VMs. The system smart contracts
+// live next to the user deployed contracts, each call being routed by the VM type found in the contract address
 func (vmf *vmContainerFactory) Create() (process.VirtualMachinesContainer, error) {
 	container := containers.NewVirtualMachinesContainer()
 
@@ -57,17 +53,47 @@ func (vmf *vmContainerFactory) Create() (process.VirtualMachinesContainer, error
 		return nil, err
 	}
 
+	vm, err = vmf.createSystemVM()
+	if err != nil {
+		return nil, err
+	}
+
+	err = container.Add(factory.SystemVirtualMachine, vm)
+	if err != nil {
+		return nil, err
+	}
+
 	return container, nil
 }
 
 func (vmf *vmContainerFactory) createIeleVM() (vmcommon.VMExecutionHandler, error) {
-	ieleVM := endpoint.NewElrondIeleVM(factory.IELEVirtualMachine, endpoint.ElrondTestnet, vmf.vmAccountsDB, vmf.cryptoHook)
+	ieleVM := endpoint.NewElrondIeleVM(factory.IELEVirtualMachine, endpoint.ElrondTestnet, vmf.blockChainHook, vmf.cryptoHook)
 	return ieleVM, nil
 }
 
-// VMAccountsDB returns the created vmAccountsDB
-func (vmf *vmContainerFactory) VMAccountsDB() *hooks.VMAccountsDB {
-	return vmf.vmAccountsDB
+func (vmf *vmContainerFactory) createSystemVM() (vmcommon.VMExecutionHandler, error) {
+	systemEI, err := systemSmartContracts.NewVMContext(vmf.blockChainHook, vmf.cryptoHook)
+	if err != nil {
+		return nil, err
+	}
+
+	scFactory, err := systemVMFactory.NewSystemSCFactory(systemEI)
+	if err != nil {
+		return nil, err
+	}
+
+	systemContracts, err := scFactory.Create()
+	if err != nil {
+		return nil, err
+	}
+	vmf.blockChainHook.SetSystemSmartContracts(systemContracts.Keys())
+
+	return systemVMProcess.NewSystemVM(systemEI, systemContracts, factory.SystemVirtualMachine)
+}
+
+// BlockChainHookImpl returns the created blockchain hook
+func (vmf *vmContainerFactory) BlockChainHookImpl() *hooks.BlockChainHookImpl {
+	return vmf.blockChainHook
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
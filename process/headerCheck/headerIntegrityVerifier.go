@@ -0,0 +1,94 @@
+package headerCheck
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ArgsHeaderIntegrityVerifier is used to store all components that are needed to create a new HeaderIntegrityVerifier
+type ArgsHeaderIntegrityVerifier struct {
+	GenesisTime        time.Time
+	RoundDuration      time.Duration
+	TimestampTolerance time.Duration
+	ChainID            []byte
+	SoftwareVersion    []byte
+}
+
+// HeaderIntegrityVerifier is the component used to reject the malformed headers before their signatures are checked.
+// A header's timestamp has to be the start time of its round, as computed from the genesis time and the round duration,
+// and its chain ID and software version have to be the node's ones
+// TODO: the headers do not hold the hash of the epoch start block, so it can not be checked yet
+type HeaderIntegrityVerifier struct {
+	genesisTime        time.Time
+	roundDuration      time.Duration
+	timestampTolerance time.Duration
+	chainID            []byte
+	softwareVersion    []byte
+}
+
+// NewHeaderIntegrityVerifier will create a new instance of HeaderIntegrityVerifier
+func NewHeaderIntegrityVerifier(arguments *ArgsHeaderIntegrityVerifier) (*HeaderIntegrityVerifier, error) {
+	if arguments == nil {
+		return nil, process.ErrNilArguments
+	}
+	if arguments.RoundDuration <= 0 {
+		return nil, process.ErrInvalidRoundDuration
+	}
+	if arguments.TimestampTolerance < 0 {
+		return nil, process.ErrInvalidTimestampTolerance
+	}
+	if len(arguments.ChainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
+	if len(arguments.SoftwareVersion) == 0 {
+		return nil, process.ErrInvalidSoftwareVersion
+	}
+
+	return &HeaderIntegrityVerifier{
+		genesisTime:        arguments.GenesisTime,
+		roundDuration:      arguments.RoundDuration,
+		timestampTolerance: arguments.TimestampTolerance,
+		chainID:            arguments.ChainID,
+		softwareVersion:    arguments.SoftwareVersion,
+	}, nil
+}
+
+// Verify checks that the header was proposed on the node's chain, with the node's software version, and that its
+// timestamp matches the start time of its round. The genesis headers are not checked
+func (hiv *HeaderIntegrityVerifier) Verify(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+	if header.GetNonce() == 0 {
+		return nil
+	}
+	if !bytes.Equal(header.GetChainID(), hiv.chainID) {
+		return process.ErrHeaderChainIDMismatch
+	}
+	if !bytes.Equal(header.GetSoftwareVersion(), hiv.softwareVersion) {
+		return process.ErrHeaderSoftwareVersionMismatch
+	}
+
+	roundStart := hiv.genesisTime.Add(time.Duration(header.GetRound()) * hiv.roundDuration)
+	deviation := time.Duration(int64(header.GetTimeStamp())-roundStart.Unix()) * time.Second
+	if deviation < 0 {
+		deviation = -deviation
+	}
+	if deviation > hiv.timestampTolerance {
+		return process.ErrHeaderTimestampMismatch
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hiv *HeaderIntegrityVerifier) IsInterfaceNil() bool {
+	if hiv == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+package headerCheck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+var genesisTime = time.Unix(1000, 0)
+var chainID = []byte("chain ID")
+var softwareVersion = []byte("1")
+
+func createHeaderIntegrityVerifierArgs() *ArgsHeaderIntegrityVerifier {
+	return &ArgsHeaderIntegrityVerifier{
+		GenesisTime:        genesisTime,
+		RoundDuration:      4 * time.Second,
+		TimestampTolerance: time.Second,
+		ChainID:            chainID,
+		SoftwareVersion:    softwareVersion,
+	}
+}
+
+//------- NewHeaderIntegrityVerifier
+
+func TestNewHeaderIntegrityVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hiv, err := NewHeaderIntegrityVerifier(nil)
+
+	assert.Nil(t, hiv)
+	assert.Equal(t, process.ErrNilArguments, err)
+}
+
+func TestNewHeaderIntegrityVerifier_InvalidRoundDurationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderIntegrityVerifierArgs()
+	args.RoundDuration = 0
+	hiv, err := NewHeaderIntegrityVerifier(args)
+
+	assert.Nil(t, hiv)
+	assert.Equal(t, process.ErrInvalidRoundDuration, err)
+}
+
+func TestNewHeaderIntegrityVerifier_InvalidTimestampToleranceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderIntegrityVerifierArgs()
+	args.TimestampTolerance = -time.Second
+	hiv, err := NewHeaderIntegrityVerifier(args)
+
+	assert.Nil(t, hiv)
+	assert.Equal(t, process.ErrInvalidTimestampTolerance, err)
+}
+
+func TestNewHeaderIntegrityVerifier_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderIntegrityVerifierArgs()
+	args.ChainID = nil
+	hiv, err := NewHeaderIntegrityVerifier(args)
+
+	assert.Nil(t, hiv)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestNewHeaderIntegrityVerifier_EmptySoftwareVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderIntegrityVerifierArgs()
+	args.SoftwareVersion = nil
+	hiv, err := NewHeaderIntegrityVerifier(args)
+
+	assert.Nil(t, hiv)
+	assert.Equal(t, process.ErrInvalidSoftwareVersion, err)
+}
+
+func TestNewHeaderIntegrityVerifier_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hiv, err := NewHeaderIntegrityVerifier(createHeaderIntegrityVerifierArgs())
+
+	assert.Nil(t, err)
+	assert.False(t, hiv.IsInterfaceNil())
+}
+
+//------- Verify
+
+func TestHeaderIntegrityVerifier_VerifyNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hiv, _ := NewHeaderIntegrityVerifier(createHeaderIntegrityVerifierArgs())
+
+	assert.Equal(t, process.ErrNilBlockHeader, hiv.Verify(nil))
+}
+
+func TestHeaderIntegrityVerifier_VerifyTimestampOfTheRoundShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hiv, _ := NewHeaderIntegrityVerifier(createHeaderIntegrityVerifierArgs())
+	hdr := &block.Header{
+		Nonce:           5,
+		Round:           10,
+		TimeStamp:       1040,
+		ChainID:         chainID,
+		SoftwareVersion: softwareVersion,
+	}
+
+	assert.Nil(t, hiv.Verify(hdr))
+	hdr.TimeStamp = 1041
+	assert.Nil(t, hiv.Verify(hdr))
+	hdr.TimeStamp = 1039
+	assert.Nil(t, hiv.Verify(hdr))
+}
+
+func TestHeaderIntegrityVerifier_VerifyTimestampOutOfToleranceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hiv, _ := NewHeaderIntegrityVerifier(createHeaderIntegrityVerifierArgs())
+	hdr := &block.MetaBlock{
+		Nonce:           5,
+		Round:           10,
+		TimeStamp:       1044,
+		ChainID:         chainID,
+		SoftwareVersion: softwareVersion,
+	}
+
+	assert.Equal(t, process.ErrHeaderTimestampMismatch, hiv.Verify(hdr))
+	hdr.TimeStamp = 1036
+	assert.Equal(t, process.ErrHeaderTimestampMismatch, hiv.Verify(hdr))
+}
+
+func TestHeaderIntegrityVerifier_VerifyChainIDMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hiv, _ := NewHeaderIntegrityVerifier(createHeaderIntegrityVerifierArgs())
+	hdr := &block.Header{
+		Nonce:           5,
+		Round:           10,
+		TimeStamp:       1040,
+		ChainID:         []byte("other chain ID"),
+		SoftwareVersion: softwareVersion,
+	}
+
+	assert.Equal(t, process.ErrHeaderChainIDMismatch, hiv.Verify(hdr))
+	hdr.ChainID = nil
+	assert.Equal(t, process.ErrHeaderChainIDMismatch, hiv.Verify(hdr))
+}
+
+func TestHeaderIntegrityVerifier_VerifySoftwareVersionMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hiv, _ := NewHeaderIntegrityVerifier(createHeaderIntegrityVerifierArgs())
+	hdr := &block.MetaBlock{
+		Nonce:           5,
+		Round:           10,
+		TimeStamp:       1040,
+		ChainID:         chainID,
+		SoftwareVersion: []byte("2"),
+	}
+
+	assert.Equal(t, process.ErrHeaderSoftwareVersionMismatch, hiv.Verify(hdr))
+}
+
+func TestHeaderIntegrityVerifier_VerifyGenesisHeaderShouldNotCheckTheTimestamp(t *testing.T) {
+	t.Parallel()
+
+	hiv, _ := NewHeaderIntegrityVerifier(createHeaderIntegrityVerifierArgs())
+	hdr := &block.Header{
+		Nonce:     0,
+		Round:     10,
+		TimeStamp: 5,
+	}
+
+	assert.Nil(t, hiv.Verify(hdr))
+}
@@ -0,0 +1,183 @@
+package headerCheck
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsHeaderSigVerifier is used to store all components that are needed to create a new HeaderSigVerifier
+type ArgsHeaderSigVerifier struct {
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	NodesCoordinator  sharding.NodesCoordinator
+	MultiSigVerifier  crypto.MultiSigVerifier
+	SingleSigVerifier crypto.SingleSigner
+	KeyGen            crypto.KeyGenerator
+}
+
+// HeaderSigVerifier is component used to check if a header is valid: its random seed has to be the leader's
+// signature on the previous random seed and its aggregated signature has to belong to the consensus group
+type HeaderSigVerifier struct {
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	nodesCoordinator  sharding.NodesCoordinator
+	multiSigVerifier  crypto.MultiSigVerifier
+	singleSigVerifier crypto.SingleSigner
+	keyGen            crypto.KeyGenerator
+}
+
+// NewHeaderSigVerifier will create a new instance of HeaderSigVerifier
+func NewHeaderSigVerifier(arguments *ArgsHeaderSigVerifier) (*HeaderSigVerifier, error) {
+	err := checkArgsHeaderSigVerifier(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HeaderSigVerifier{
+		marshalizer:       arguments.Marshalizer,
+		hasher:            arguments.Hasher,
+		nodesCoordinator:  arguments.NodesCoordinator,
+		multiSigVerifier:  arguments.MultiSigVerifier,
+		singleSigVerifier: arguments.SingleSigVerifier,
+		keyGen:            arguments.KeyGen,
+	}, nil
+}
+
+func checkArgsHeaderSigVerifier(arguments *ArgsHeaderSigVerifier) error {
+	if arguments == nil {
+		return process.ErrNilArguments
+	}
+	if check.IfNil(arguments.Marshalizer) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(arguments.Hasher) {
+		return process.ErrNilHasher
+	}
+	if check.IfNil(arguments.NodesCoordinator) {
+		return process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(arguments.MultiSigVerifier) {
+		return process.ErrNilMultiSigVerifier
+	}
+	if check.IfNil(arguments.SingleSigVerifier) {
+		return process.ErrNilSingleSigner
+	}
+	if check.IfNil(arguments.KeyGen) {
+		return process.ErrNilKeyGen
+	}
+
+	return nil
+}
+
+// VerifyRandSeed verifies that the random seed of the header is the leader's signature on the previous random seed
+func (hsv *HeaderSigVerifier) VerifyRandSeed(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+
+	leaderPubKey, err := hsv.getLeader(header)
+	if err != nil {
+		return err
+	}
+
+	return hsv.singleSigVerifier.Verify(leaderPubKey, header.GetPrevRandSeed(), header.GetRandSeed())
+}
+
+// VerifySignature verifies that the aggregated signature of the header was produced by the consensus group selected
+// for the header's round and that the leader took part in it
+func (hsv *HeaderSigVerifier) VerifySignature(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+
+	bitmap := header.GetPubKeysBitmap()
+	if len(bitmap) == 0 {
+		return process.ErrNilPubKeysBitmap
+	}
+	if bitmap[0]&1 == 0 {
+		return process.ErrBlockProposerSignatureMissing
+	}
+
+	// get marshalled block header without signature and bitmap
+	// as this is the message that was signed
+	headerCopy, err := copyHeaderWithoutSig(header)
+	if err != nil {
+		return err
+	}
+
+	consensusPubKeys, err := hsv.getConsensusPubKeys(header)
+	if err != nil {
+		return err
+	}
+
+	verifier, err := hsv.multiSigVerifier.Create(consensusPubKeys, 0)
+	if err != nil {
+		return err
+	}
+
+	err = verifier.SetAggregatedSig(header.GetSignature())
+	if err != nil {
+		return err
+	}
+
+	hash, err := core.CalculateHash(hsv.marshalizer, hsv.hasher, headerCopy)
+	if err != nil {
+		return err
+	}
+
+	return verifier.Verify(hash, bitmap)
+}
+
+// getConsensusPubKeys returns the public keys of the consensus group selected for the header's round, the leader
+// being the first one
+func (hsv *HeaderSigVerifier) getConsensusPubKeys(header data.HeaderHandler) ([]string, error) {
+	return hsv.nodesCoordinator.GetValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		header.GetShardID(),
+	)
+}
+
+func (hsv *HeaderSigVerifier) getLeader(header data.HeaderHandler) (crypto.PublicKey, error) {
+	consensusPubKeys, err := hsv.getConsensusPubKeys(header)
+	if err != nil {
+		return nil, err
+	}
+	if len(consensusPubKeys) == 0 {
+		return nil, process.ErrEmptyConsensusGroup
+	}
+
+	return hsv.keyGen.PublicKeyFromByteArray([]byte(consensusPubKeys[0]))
+}
+
+func copyHeaderWithoutSig(header data.HeaderHandler) (data.HeaderHandler, error) {
+	switch hdr := header.(type) {
+	case *block.Header:
+		headerCopy := *hdr
+		headerCopy.Signature = nil
+		headerCopy.PubKeysBitmap = nil
+		return &headerCopy, nil
+	case *block.MetaBlock:
+		headerCopy := *hdr
+		headerCopy.Signature = nil
+		headerCopy.PubKeysBitmap = nil
+		return &headerCopy, nil
+	default:
+		return nil, process.ErrWrongTypeAssertion
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hsv *HeaderSigVerifier) IsInterfaceNil() bool {
+	if hsv == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,294 @@
+package headerCheck
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createHeaderSigVerifierArgs() *ArgsHeaderSigVerifier {
+	return &ArgsHeaderSigVerifier{
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &mock.HasherMock{},
+		NodesCoordinator: mock.NewNodesCoordinatorMock(),
+		MultiSigVerifier: mock.NewMultiSigner(),
+		SingleSigVerifier: &mock.SignerMock{
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				return nil
+			},
+		},
+		KeyGen: &mock.SingleSignKeyGenMock{
+			PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.SingleSignPublicKey{}, nil
+			},
+		},
+	}
+}
+
+func createHeaderWithSignature() *block.Header {
+	return &block.Header{
+		Round:         10,
+		PrevRandSeed:  []byte("prev rand seed"),
+		RandSeed:      []byte("rand seed"),
+		Signature:     []byte("signature"),
+		PubKeysBitmap: []byte{1},
+	}
+}
+
+//------- NewHeaderSigVerifier
+
+func TestNewHeaderSigVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hsv, err := NewHeaderSigVerifier(nil)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilArguments, err)
+}
+
+func TestNewHeaderSigVerifier_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.Marshalizer = nil
+	hsv, err := NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewHeaderSigVerifier_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.Hasher = nil
+	hsv, err := NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewHeaderSigVerifier_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.NodesCoordinator = nil
+	hsv, err := NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+}
+
+func TestNewHeaderSigVerifier_NilMultiSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.MultiSigVerifier = nil
+	hsv, err := NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+}
+
+func TestNewHeaderSigVerifier_NilSingleSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.SingleSigVerifier = nil
+	hsv, err := NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilSingleSigner, err)
+}
+
+func TestNewHeaderSigVerifier_NilKeyGenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.KeyGen = nil
+	hsv, err := NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilKeyGen, err)
+}
+
+func TestNewHeaderSigVerifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hsv, err := NewHeaderSigVerifier(createHeaderSigVerifierArgs())
+
+	assert.Nil(t, err)
+	assert.False(t, hsv.IsInterfaceNil())
+}
+
+//------- VerifyRandSeed
+
+func TestHeaderSigVerifier_VerifyRandSeedNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hsv, _ := NewHeaderSigVerifier(createHeaderSigVerifierArgs())
+
+	err := hsv.VerifyRandSeed(nil)
+
+	assert.Equal(t, process.ErrNilBlockHeader, err)
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedConsensusGroupErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createHeaderSigVerifierArgs()
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+			return nil, expectedErr
+		},
+	}
+	hsv, _ := NewHeaderSigVerifier(args)
+
+	err := hsv.VerifyRandSeed(createHeaderWithSignature())
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedEmptyConsensusGroupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+			return make([]string, 0), nil
+		},
+	}
+	hsv, _ := NewHeaderSigVerifier(args)
+
+	err := hsv.VerifyRandSeed(createHeaderWithSignature())
+
+	assert.Equal(t, process.ErrEmptyConsensusGroup, err)
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedWrongSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.SingleSigVerifier = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			return crypto.ErrSigNotValid
+		},
+	}
+	hsv, _ := NewHeaderSigVerifier(args)
+
+	err := hsv.VerifyRandSeed(createHeaderWithSignature())
+
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedShouldVerifyLeaderSignatureOnPrevRandSeed(t *testing.T) {
+	t.Parallel()
+
+	hdr := createHeaderWithSignature()
+	leaderPubKey := "leader"
+	leaderPk := &mock.SingleSignPublicKey{}
+	args := createHeaderSigVerifierArgs()
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+			assert.Equal(t, hdr.PrevRandSeed, randomness)
+			assert.Equal(t, hdr.Round, round)
+			return []string{leaderPubKey, "validator"}, nil
+		},
+	}
+	args.KeyGen = &mock.SingleSignKeyGenMock{
+		PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+			assert.Equal(t, []byte(leaderPubKey), b)
+			return leaderPk, nil
+		},
+	}
+	verifyCalled := false
+	args.SingleSigVerifier = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			verifyCalled = true
+			assert.True(t, public == leaderPk)
+			assert.Equal(t, hdr.PrevRandSeed, msg)
+			assert.Equal(t, hdr.RandSeed, sig)
+			return nil
+		},
+	}
+	hsv, _ := NewHeaderSigVerifier(args)
+
+	err := hsv.VerifyRandSeed(hdr)
+
+	assert.Nil(t, err)
+	assert.True(t, verifyCalled)
+}
+
+//------- VerifySignature
+
+func TestHeaderSigVerifier_VerifySignatureNilBitmapShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hsv, _ := NewHeaderSigVerifier(createHeaderSigVerifierArgs())
+	hdr := createHeaderWithSignature()
+	hdr.PubKeysBitmap = nil
+
+	err := hsv.VerifySignature(hdr)
+
+	assert.Equal(t, process.ErrNilPubKeysBitmap, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureLeaderNotSignedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hsv, _ := NewHeaderSigVerifier(createHeaderSigVerifierArgs())
+	hdr := createHeaderWithSignature()
+	hdr.PubKeysBitmap = []byte{2}
+
+	err := hsv.VerifySignature(hdr)
+
+	assert.Equal(t, process.ErrBlockProposerSignatureMissing, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureWrongHeaderTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hsv, _ := NewHeaderSigVerifier(createHeaderSigVerifierArgs())
+	hdr := &mock.HeaderHandlerStub{
+		GetPubKeysBitmapCalled: func() []byte {
+			return []byte{1}
+		},
+	}
+
+	err := hsv.VerifySignature(hdr)
+
+	assert.Equal(t, process.ErrWrongTypeAssertion, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hsv, _ := NewHeaderSigVerifier(createHeaderSigVerifierArgs())
+
+	err := hsv.VerifySignature(createHeaderWithSignature())
+
+	assert.Nil(t, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureMetaBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hsv, _ := NewHeaderSigVerifier(createHeaderSigVerifierArgs())
+	hdr := &block.MetaBlock{
+		Round:         10,
+		PrevRandSeed:  []byte("prev rand seed"),
+		RandSeed:      []byte("rand seed"),
+		Signature:     []byte("signature"),
+		PubKeysBitmap: []byte{1},
+	}
+
+	err := hsv.VerifySignature(hdr)
+
+	assert.Nil(t, err)
+}
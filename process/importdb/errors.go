@@ -0,0 +1,19 @@
+package importdb
+
+import (
+	"errors"
+)
+
+// ErrNothingToImport signals that the import database does not hold any block after the current one
+var ErrNothingToImport = errors.New("nothing to import")
+
+// ErrImportedRootHashMismatch signals that the state root hash obtained after re-executing the imported blocks
+// does not match the root hash of the last imported block
+var ErrImportedRootHashMismatch = errors.New("root hash after import does not match the last imported block")
+
+// ErrInvalidMaxTimeToProcessBlock signals that the maximum time to process a block is not positive
+var ErrInvalidMaxTimeToProcessBlock = errors.New("invalid maximum time to process a block")
+
+// ErrMissingFinalityAttestingHeader signals that the import database does not hold the meta header which attests the
+// finality of the meta headers notarized by a block
+var ErrMissingFinalityAttestingHeader = errors.New("missing finality attesting meta header")
@@ -0,0 +1,296 @@
+package importdb
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("process/importdb")
+
+// ArgShardDBImporter holds the arguments needed to create a shard database importer
+type ArgShardDBImporter struct {
+	ImportStore           dataRetriever.StorageService
+	Marshalizer           marshal.Marshalizer
+	Uint64Converter       typeConverters.Uint64ByteSliceConverter
+	BlockProcessor        process.BlockProcessor
+	BlockChain            data.ChainHandler
+	DataPool              dataRetriever.PoolsHolder
+	Accounts              state.AccountsAdapter
+	ShardCoordinator      sharding.Coordinator
+	MaxTimeToProcessBlock time.Duration
+}
+
+// shardDBImporter re-executes the blocks found in the database of another shard node. The blocks are read from
+// the import storage, their transactions and notarized meta headers are placed in the data pools and then they
+// are processed and committed as if they were received from the network
+type shardDBImporter struct {
+	importStore           dataRetriever.StorageService
+	marshalizer           marshal.Marshalizer
+	uint64Converter       typeConverters.Uint64ByteSliceConverter
+	blockProcessor        process.BlockProcessor
+	blockChain            data.ChainHandler
+	dataPool              dataRetriever.PoolsHolder
+	accounts              state.AccountsAdapter
+	shardCoordinator      sharding.Coordinator
+	maxTimeToProcessBlock time.Duration
+}
+
+// NewShardDBImporter creates a new shard database importer
+func NewShardDBImporter(args ArgShardDBImporter) (*shardDBImporter, error) {
+	if args.ImportStore == nil || args.ImportStore.IsInterfaceNil() {
+		return nil, process.ErrNilStorage
+	}
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if args.Uint64Converter == nil || args.Uint64Converter.IsInterfaceNil() {
+		return nil, process.ErrNilUint64Converter
+	}
+	if args.BlockProcessor == nil || args.BlockProcessor.IsInterfaceNil() {
+		return nil, process.ErrNilBlockExecutor
+	}
+	if args.BlockChain == nil || args.BlockChain.IsInterfaceNil() {
+		return nil, process.ErrNilBlockChain
+	}
+	if args.DataPool == nil || args.DataPool.IsInterfaceNil() {
+		return nil, process.ErrNilPoolsHolder
+	}
+	if args.Accounts == nil || args.Accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if args.ShardCoordinator == nil || args.ShardCoordinator.IsInterfaceNil() {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if args.MaxTimeToProcessBlock <= 0 {
+		return nil, ErrInvalidMaxTimeToProcessBlock
+	}
+
+	return &shardDBImporter{
+		importStore:           args.ImportStore,
+		marshalizer:           args.Marshalizer,
+		uint64Converter:       args.Uint64Converter,
+		blockProcessor:        args.BlockProcessor,
+		blockChain:            args.BlockChain,
+		dataPool:              args.DataPool,
+		accounts:              args.Accounts,
+		shardCoordinator:      args.ShardCoordinator,
+		maxTimeToProcessBlock: args.MaxTimeToProcessBlock,
+	}, nil
+}
+
+// ImportDB processes and commits, in order, all the blocks of the import database which follow the current block
+// of the blockchain. After the last block, the state root hash is checked against the one of the last block
+func (sdi *shardDBImporter) ImportDB() error {
+	nonce := uint64(1)
+	currentHeader := sdi.blockChain.GetCurrentBlockHeader()
+	if currentHeader != nil && !currentHeader.IsInterfaceNil() {
+		nonce = currentHeader.GetNonce() + 1
+	}
+
+	var lastHeader *block.Header
+	for ; ; nonce++ {
+		header, _, err := process.GetShardHeaderFromStorageWithNonce(
+			nonce,
+			sdi.shardCoordinator.SelfId(),
+			sdi.importStore,
+			sdi.uint64Converter,
+			sdi.marshalizer,
+		)
+		if err == process.ErrMissingHashForHeaderNonce {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		err = sdi.importBlock(header)
+		if err == ErrMissingFinalityAttestingHeader {
+			log.Warn(fmt.Sprintf("import stopped at block with nonce %d: %s\n", nonce, err.Error()))
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("block with nonce %d could not be imported: %s", nonce, err.Error())
+		}
+
+		log.Info(fmt.Sprintf("imported block with nonce %d and round %d\n", header.Nonce, header.Round))
+		lastHeader = header
+	}
+
+	if lastHeader == nil {
+		return ErrNothingToImport
+	}
+
+	rootHash, err := sdi.accounts.RootHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(rootHash, lastHeader.RootHash) {
+		return ErrImportedRootHashMismatch
+	}
+
+	return nil
+}
+
+func (sdi *shardDBImporter) importBlock(header *block.Header) error {
+	body, err := sdi.loadBlockBody(header)
+	if err != nil {
+		return err
+	}
+
+	err = sdi.loadMetaHeaders(header)
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	haveTime := func() time.Duration {
+		return sdi.maxTimeToProcessBlock - time.Since(startTime)
+	}
+
+	err = sdi.blockProcessor.ProcessBlock(sdi.blockChain, header, body, haveTime)
+	if err != nil {
+		sdi.blockProcessor.RevertAccountState()
+		return err
+	}
+
+	return sdi.blockProcessor.CommitBlock(sdi.blockChain, header, body)
+}
+
+// loadBlockBody reads the miniblocks of the header from the import storage and adds their transactions in the
+// data pools, where the block processor expects to find them
+func (sdi *shardDBImporter) loadBlockBody(header *block.Header) (block.Body, error) {
+	body := make(block.Body, 0, len(header.MiniBlockHeaders))
+	for _, miniBlockHeader := range header.MiniBlockHeaders {
+		buff, err := sdi.importStore.Get(dataRetriever.MiniBlockUnit, miniBlockHeader.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = sdi.marshalizer.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		err = sdi.loadTransactions(miniBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		body = append(body, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (sdi *shardDBImporter) loadTransactions(miniBlock *block.MiniBlock) error {
+	var unitType dataRetriever.UnitType
+	var txPool dataRetriever.ShardedDataCacherNotifier
+	var createTx func() data.TransactionHandler
+
+	switch miniBlock.Type {
+	case block.TxBlock:
+		unitType = dataRetriever.TransactionUnit
+		txPool = sdi.dataPool.Transactions()
+		createTx = func() data.TransactionHandler { return &transaction.Transaction{} }
+	case block.SmartContractResultBlock:
+		unitType = dataRetriever.UnsignedTransactionUnit
+		txPool = sdi.dataPool.UnsignedTransactions()
+		createTx = func() data.TransactionHandler { return &smartContractResult.SmartContractResult{} }
+	case block.RewardsBlock:
+		unitType = dataRetriever.RewardTransactionUnit
+		txPool = sdi.dataPool.RewardTransactions()
+		createTx = func() data.TransactionHandler { return &rewardTx.RewardTx{} }
+	default:
+		return nil
+	}
+
+	cacheID := process.ShardCacherIdentifier(miniBlock.SenderShardID, miniBlock.ReceiverShardID)
+	for _, txHash := range miniBlock.TxHashes {
+		buff, err := sdi.importStore.Get(unitType, txHash)
+		if err != nil {
+			return err
+		}
+
+		tx := createTx()
+		err = sdi.marshalizer.Unmarshal(tx, buff)
+		if err != nil {
+			return err
+		}
+
+		txPool.AddData(txHash, tx, cacheID)
+	}
+
+	return nil
+}
+
+// loadMetaHeaders adds in the data pools the meta headers notarized by the header, together with the meta headers
+// which attest their finality
+func (sdi *shardDBImporter) loadMetaHeaders(header *block.Header) error {
+	highestNonce := uint64(0)
+	for _, metaBlockHash := range header.MetaBlockHashes {
+		metaBlock, err := process.GetMetaHeaderFromStorage(metaBlockHash, sdi.marshalizer, sdi.importStore)
+		if err != nil {
+			return err
+		}
+
+		sdi.addMetaHeaderToPools(metaBlockHash, metaBlock)
+		if metaBlock.Nonce > highestNonce {
+			highestNonce = metaBlock.Nonce
+		}
+	}
+
+	if len(header.MetaBlockHashes) == 0 {
+		return nil
+	}
+
+	for i := uint64(1); i <= process.MetaBlockFinality; i++ {
+		metaBlock, metaBlockHash, err := process.GetMetaHeaderFromStorageWithNonce(
+			highestNonce+i,
+			sdi.importStore,
+			sdi.uint64Converter,
+			sdi.marshalizer,
+		)
+		if err == process.ErrMissingHashForHeaderNonce {
+			return ErrMissingFinalityAttestingHeader
+		}
+		if err != nil {
+			return err
+		}
+
+		sdi.addMetaHeaderToPools(metaBlockHash, metaBlock)
+	}
+
+	return nil
+}
+
+func (sdi *shardDBImporter) addMetaHeaderToPools(hash []byte, metaBlock *block.MetaBlock) {
+	sdi.dataPool.MetaBlocks().Put(hash, metaBlock)
+
+	syncMap := &dataPool.ShardIdHashSyncMap{}
+	syncMap.Store(metaBlock.GetShardID(), hash)
+	sdi.dataPool.HeadersNonces().Merge(metaBlock.Nonce, syncMap)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sdi *shardDBImporter) IsInterfaceNil() bool {
+	if sdi == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,251 @@
+package importdb_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/importdb"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+var marshalizer = &mock.MarshalizerMock{}
+var uint64Converter = uint64ByteSlice.NewBigEndianConverter()
+
+func createMemUnit() *storageUnit.Unit {
+	cache, _ := lrucache.NewCache(10)
+	persist, _ := memorydb.New()
+	unit, _ := storageUnit.NewStorageUnit(cache, persist)
+
+	return unit
+}
+
+func createImportStore() *dataRetriever.ChainStorer {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.TransactionUnit, createMemUnit())
+	store.AddStorer(dataRetriever.MiniBlockUnit, createMemUnit())
+	store.AddStorer(dataRetriever.BlockHeaderUnit, createMemUnit())
+	store.AddStorer(dataRetriever.MetaBlockUnit, createMemUnit())
+	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, createMemUnit())
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, createMemUnit())
+
+	return store
+}
+
+func putHeader(store dataRetriever.StorageService, hash []byte, header *block.Header) {
+	buff, _ := marshalizer.Marshal(header)
+	_ = store.Put(dataRetriever.BlockHeaderUnit, hash, buff)
+	_ = store.Put(dataRetriever.ShardHdrNonceHashDataUnit, uint64Converter.ToByteSlice(header.Nonce), hash)
+}
+
+func putMetaBlock(store dataRetriever.StorageService, hash []byte, metaBlock *block.MetaBlock) {
+	buff, _ := marshalizer.Marshal(metaBlock)
+	_ = store.Put(dataRetriever.MetaBlockUnit, hash, buff)
+	_ = store.Put(dataRetriever.MetaHdrNonceHashDataUnit, uint64Converter.ToByteSlice(metaBlock.Nonce), hash)
+}
+
+func createMockArgument() importdb.ArgShardDBImporter {
+	return importdb.ArgShardDBImporter{
+		ImportStore:     createImportStore(),
+		Marshalizer:     marshalizer,
+		Uint64Converter: uint64Converter,
+		BlockProcessor: &mock.BlockProcessorMock{
+			ProcessBlockCalled: func(blockChain data.ChainHandler, header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+				return nil
+			},
+			CommitBlockCalled: func(blockChain data.ChainHandler, header data.HeaderHandler, body data.BodyHandler) error {
+				return nil
+			},
+		},
+		BlockChain:            &mock.BlockChainMock{},
+		DataPool:              mock.NewPoolsHolderMock(),
+		Accounts:              &mock.AccountsStub{},
+		ShardCoordinator:      mock.NewOneShardCoordinatorMock(),
+		MaxTimeToProcessBlock: time.Second,
+	}
+}
+
+func TestNewShardDBImporter_NilImportStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgument()
+	args.ImportStore = nil
+	sdi, err := importdb.NewShardDBImporter(args)
+
+	assert.Nil(t, sdi)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewShardDBImporter_NilBlockProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgument()
+	args.BlockProcessor = nil
+	sdi, err := importdb.NewShardDBImporter(args)
+
+	assert.Nil(t, sdi)
+	assert.Equal(t, process.ErrNilBlockExecutor, err)
+}
+
+func TestNewShardDBImporter_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgument()
+	args.Accounts = nil
+	sdi, err := importdb.NewShardDBImporter(args)
+
+	assert.Nil(t, sdi)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewShardDBImporter_InvalidMaxTimeToProcessBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgument()
+	args.MaxTimeToProcessBlock = 0
+	sdi, err := importdb.NewShardDBImporter(args)
+
+	assert.Nil(t, sdi)
+	assert.Equal(t, importdb.ErrInvalidMaxTimeToProcessBlock, err)
+}
+
+func TestNewShardDBImporter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sdi, err := importdb.NewShardDBImporter(createMockArgument())
+
+	assert.Nil(t, err)
+	assert.False(t, sdi.IsInterfaceNil())
+}
+
+func TestShardDBImporter_ImportDBEmptyStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sdi, _ := importdb.NewShardDBImporter(createMockArgument())
+
+	err := sdi.ImportDB()
+	assert.Equal(t, importdb.ErrNothingToImport, err)
+}
+
+func TestShardDBImporter_ImportDBShouldProcessAllBlocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgument()
+	tx := &transaction.Transaction{Nonce: 7}
+	txBuff, _ := marshalizer.Marshal(tx)
+	_ = args.ImportStore.Put(dataRetriever.TransactionUnit, []byte("tx hash"), txBuff)
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("tx hash")}, Type: block.TxBlock}
+	miniBlockBuff, _ := marshalizer.Marshal(miniBlock)
+	_ = args.ImportStore.Put(dataRetriever.MiniBlockUnit, []byte("mb hash"), miniBlockBuff)
+
+	putMetaBlock(args.ImportStore, []byte("meta hash 5"), &block.MetaBlock{Nonce: 5})
+	putMetaBlock(args.ImportStore, []byte("meta hash 6"), &block.MetaBlock{Nonce: 6})
+	putHeader(args.ImportStore, []byte("hash 1"), &block.Header{
+		Nonce:            1,
+		MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mb hash")}},
+		MetaBlockHashes:  [][]byte{[]byte("meta hash 5")},
+	})
+	putHeader(args.ImportStore, []byte("hash 2"), &block.Header{Nonce: 2, RootHash: []byte("root hash")})
+
+	processedNonces := make([]uint64, 0)
+	committedNonces := make([]uint64, 0)
+	args.BlockProcessor = &mock.BlockProcessorMock{
+		ProcessBlockCalled: func(blockChain data.ChainHandler, header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+			processedNonces = append(processedNonces, header.GetNonce())
+			if header.GetNonce() == 1 {
+				assert.Equal(t, 1, len(body.(block.Body)))
+			}
+			return nil
+		},
+		CommitBlockCalled: func(blockChain data.ChainHandler, header data.HeaderHandler, body data.BodyHandler) error {
+			committedNonces = append(committedNonces, header.GetNonce())
+			return nil
+		},
+	}
+	args.Accounts = &mock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+	}
+	sdi, _ := importdb.NewShardDBImporter(args)
+
+	err := sdi.ImportDB()
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2}, processedNonces)
+	assert.Equal(t, []uint64{1, 2}, committedNonces)
+
+	cacheID := process.ShardCacherIdentifier(0, 0)
+	_, ok := args.DataPool.Transactions().ShardDataStore(cacheID).Peek([]byte("tx hash"))
+	assert.True(t, ok)
+	assert.True(t, args.DataPool.MetaBlocks().Has([]byte("meta hash 5")))
+	assert.True(t, args.DataPool.MetaBlocks().Has([]byte("meta hash 6")))
+}
+
+func TestShardDBImporter_ImportDBRootHashMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgument()
+	putHeader(args.ImportStore, []byte("hash 1"), &block.Header{Nonce: 1, RootHash: []byte("root hash")})
+	args.Accounts = &mock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("other root hash"), nil
+		},
+	}
+	sdi, _ := importdb.NewShardDBImporter(args)
+
+	err := sdi.ImportDB()
+	assert.Equal(t, importdb.ErrImportedRootHashMismatch, err)
+}
+
+func TestShardDBImporter_ImportDBProcessErrorShouldRevertAndErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgument()
+	putHeader(args.ImportStore, []byte("hash 1"), &block.Header{Nonce: 1})
+	expectedErr := errors.New("expected error")
+	revertCalled := false
+	args.BlockProcessor = &mock.BlockProcessorMock{
+		ProcessBlockCalled: func(blockChain data.ChainHandler, header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+			return expectedErr
+		},
+		RevertAccountStateCalled: func() {
+			revertCalled = true
+		},
+	}
+	sdi, _ := importdb.NewShardDBImporter(args)
+
+	err := sdi.ImportDB()
+	assert.NotNil(t, err)
+	assert.True(t, revertCalled)
+}
+
+func TestShardDBImporter_ImportDBMissingFinalityAttestingHeaderShouldStop(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgument()
+	putMetaBlock(args.ImportStore, []byte("meta hash 5"), &block.MetaBlock{Nonce: 5})
+	putHeader(args.ImportStore, []byte("hash 1"), &block.Header{Nonce: 1, RootHash: []byte("root hash")})
+	putHeader(args.ImportStore, []byte("hash 2"), &block.Header{
+		Nonce:           2,
+		MetaBlockHashes: [][]byte{[]byte("meta hash 5")},
+	})
+	args.Accounts = &mock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+	}
+	sdi, _ := importdb.NewShardDBImporter(args)
+
+	err := sdi.ImportDB()
+	assert.Nil(t, err)
+}
@@ -0,0 +1,179 @@
+package interceptors
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ArgChunkReassembler holds all dependencies and limits required by the chunk reassembler in order to create a new
+// instance
+type ArgChunkReassembler struct {
+	Marshalizer                 marshal.Marshalizer
+	Hasher                      hashing.Hasher
+	MaxChunksPerPayload         uint32
+	MaxChunkSize                int
+	MaxPendingPayloadsPerSource int
+	Timeout                     time.Duration
+}
+
+type pendingPayload struct {
+	maxChunks  uint32
+	chunks     map[uint32][]byte
+	lastUpdate time.Time
+}
+
+// chunkReassembler keeps, for each source peer, the chunks of the payloads which were not fully received yet. The
+// payloads not completed in the configured timeout are dropped so a peer can not fill the buffers with chunks which
+// will never complete
+type chunkReassembler struct {
+	marshalizer                 marshal.Marshalizer
+	hasher                      hashing.Hasher
+	maxChunksPerPayload         uint32
+	maxChunkSize                int
+	maxPendingPayloadsPerSource int
+	timeout                     time.Duration
+
+	mutPending sync.Mutex
+	pending    map[p2p.PeerID]map[string]*pendingPayload
+}
+
+// NewChunkReassembler creates a new chunk reassembler
+func NewChunkReassembler(args ArgChunkReassembler) (*chunkReassembler, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if args.MaxChunksPerPayload < 2 || args.MaxChunkSize < 1 || args.MaxPendingPayloadsPerSource < 1 || args.Timeout <= 0 {
+		return nil, process.ErrInvalidChunkReassemblerLimits
+	}
+
+	return &chunkReassembler{
+		marshalizer:                 args.Marshalizer,
+		hasher:                      args.Hasher,
+		maxChunksPerPayload:         args.MaxChunksPerPayload,
+		maxChunkSize:                args.MaxChunkSize,
+		maxPendingPayloadsPerSource: args.MaxPendingPayloadsPerSource,
+		timeout:                     args.Timeout,
+		pending:                     make(map[p2p.PeerID]map[string]*pendingPayload),
+	}, nil
+}
+
+// AddChunk stores the chunk received from the provided source. It returns the whole payload when the chunk was the
+// last one missing and nil while chunks of the payload are still expected
+func (cr *chunkReassembler) AddChunk(source p2p.PeerID, buff []byte) ([]byte, error) {
+	chunk := &batch.Chunk{}
+	err := cr.marshalizer.Unmarshal(chunk, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cr.checkChunk(chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	cr.mutPending.Lock()
+	defer cr.mutPending.Unlock()
+
+	now := time.Now()
+	cr.removeExpiredPayloads(now)
+
+	payloads, ok := cr.pending[source]
+	if !ok {
+		payloads = make(map[string]*pendingPayload)
+		cr.pending[source] = payloads
+	}
+
+	reference := string(chunk.Reference)
+	payload, ok := payloads[reference]
+	if !ok {
+		if len(payloads) >= cr.maxPendingPayloadsPerSource {
+			return nil, process.ErrTooManyPendingChunkedPayloads
+		}
+
+		payload = &pendingPayload{
+			maxChunks: chunk.MaxChunks,
+			chunks:    make(map[uint32][]byte),
+		}
+		payloads[reference] = payload
+	}
+	if payload.maxChunks != chunk.MaxChunks {
+		return nil, process.ErrInvalidChunk
+	}
+
+	payload.chunks[chunk.ChunkIndex] = chunk.Data
+	payload.lastUpdate = now
+	if uint32(len(payload.chunks)) < payload.maxChunks {
+		return nil, nil
+	}
+
+	delete(payloads, reference)
+	if len(payloads) == 0 {
+		delete(cr.pending, source)
+	}
+
+	return cr.reassemble(chunk.Reference, payload)
+}
+
+func (cr *chunkReassembler) checkChunk(chunk *batch.Chunk) error {
+	if len(chunk.Reference) == 0 {
+		return process.ErrInvalidChunk
+	}
+	if chunk.MaxChunks < 2 || chunk.MaxChunks > cr.maxChunksPerPayload {
+		return process.ErrInvalidChunk
+	}
+	if chunk.ChunkIndex >= chunk.MaxChunks {
+		return process.ErrInvalidChunk
+	}
+	if len(chunk.Data) == 0 || len(chunk.Data) > cr.maxChunkSize {
+		return process.ErrInvalidChunk
+	}
+
+	return nil
+}
+
+func (cr *chunkReassembler) reassemble(reference []byte, payload *pendingPayload) ([]byte, error) {
+	buff := make([]byte, 0)
+	for i := uint32(0); i < payload.maxChunks; i++ {
+		buff = append(buff, payload.chunks[i]...)
+	}
+
+	if !bytes.Equal(reference, cr.hasher.Compute(string(buff))) {
+		return nil, process.ErrChunkedPayloadHashMismatch
+	}
+
+	return buff, nil
+}
+
+func (cr *chunkReassembler) removeExpiredPayloads(now time.Time) {
+	for source, payloads := range cr.pending {
+		for reference, payload := range payloads {
+			if now.Sub(payload.lastUpdate) > cr.timeout {
+				log.Debug("chunked payload expired before all its chunks were received",
+					"source", source.Pretty(), "received", len(payload.chunks), "expected", payload.maxChunks)
+				delete(payloads, reference)
+			}
+		}
+		if len(payloads) == 0 {
+			delete(cr.pending, source)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cr *chunkReassembler) IsInterfaceNil() bool {
+	if cr == nil {
+		return true
+	}
+	return false
+}
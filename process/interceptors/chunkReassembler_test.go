@@ -0,0 +1,195 @@
+package interceptors_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createArgChunkReassembler() interceptors.ArgChunkReassembler {
+	return interceptors.ArgChunkReassembler{
+		Marshalizer:                 &mock.MarshalizerMock{},
+		Hasher:                      mock.HasherMock{},
+		MaxChunksPerPayload:         5,
+		MaxChunkSize:                10,
+		MaxPendingPayloadsPerSource: 1,
+		Timeout:                     time.Minute,
+	}
+}
+
+func splitInChunks(t *testing.T, args interceptors.ArgChunkReassembler, payload []byte) [][]byte {
+	chunker, _ := partitioning.NewPayloadChunker(args.Marshalizer, args.Hasher)
+	chunks, err := chunker.SplitInChunks(payload, args.MaxChunkSize)
+	assert.Nil(t, err)
+
+	return chunks
+}
+
+//------- NewChunkReassembler
+
+func TestNewChunkReassembler_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgChunkReassembler()
+	args.Marshalizer = nil
+	cr, err := interceptors.NewChunkReassembler(args)
+
+	assert.Nil(t, cr)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewChunkReassembler_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgChunkReassembler()
+	args.Hasher = nil
+	cr, err := interceptors.NewChunkReassembler(args)
+
+	assert.Nil(t, cr)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewChunkReassembler_InvalidLimitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgChunkReassembler()
+	args.MaxChunksPerPayload = 1
+	cr, err := interceptors.NewChunkReassembler(args)
+	assert.Nil(t, cr)
+	assert.Equal(t, process.ErrInvalidChunkReassemblerLimits, err)
+
+	args = createArgChunkReassembler()
+	args.Timeout = 0
+	cr, err = interceptors.NewChunkReassembler(args)
+	assert.Nil(t, cr)
+	assert.Equal(t, process.ErrInvalidChunkReassemblerLimits, err)
+}
+
+//------- AddChunk
+
+func TestChunkReassembler_AddChunkShouldReturnThePayloadWhenComplete(t *testing.T) {
+	t.Parallel()
+
+	args := createArgChunkReassembler()
+	cr, _ := interceptors.NewChunkReassembler(args)
+	payload := []byte("0123456789abcdefghijk")
+	chunks := splitInChunks(t, args, payload)
+
+	result, err := cr.AddChunk("peer", chunks[2])
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	result, err = cr.AddChunk("peer", chunks[0])
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	result, err = cr.AddChunk("peer", chunks[1])
+	assert.Nil(t, err)
+	assert.Equal(t, payload, result)
+}
+
+func TestChunkReassembler_AddChunkFromDifferentSourcesShouldNotMix(t *testing.T) {
+	t.Parallel()
+
+	args := createArgChunkReassembler()
+	cr, _ := interceptors.NewChunkReassembler(args)
+	chunks := splitInChunks(t, args, []byte("0123456789abcdefghijk"))
+
+	for _, chunk := range chunks {
+		result, err := cr.AddChunk(p2p.PeerID(chunk), chunk)
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+	}
+}
+
+func TestChunkReassembler_AddChunkInvalidChunksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgChunkReassembler()
+	cr, _ := interceptors.NewChunkReassembler(args)
+
+	invalidChunks := []*batch.Chunk{
+		{Reference: nil, ChunkIndex: 0, MaxChunks: 2, Data: []byte("data")},
+		{Reference: []byte("ref"), ChunkIndex: 0, MaxChunks: 1, Data: []byte("data")},
+		{Reference: []byte("ref"), ChunkIndex: 0, MaxChunks: 6, Data: []byte("data")},
+		{Reference: []byte("ref"), ChunkIndex: 2, MaxChunks: 2, Data: []byte("data")},
+		{Reference: []byte("ref"), ChunkIndex: 0, MaxChunks: 2, Data: []byte("data too large")},
+		{Reference: []byte("ref"), ChunkIndex: 0, MaxChunks: 2, Data: nil},
+	}
+	for _, chunk := range invalidChunks {
+		buff, _ := args.Marshalizer.Marshal(chunk)
+		result, err := cr.AddChunk("peer", buff)
+
+		assert.Nil(t, result)
+		assert.Equal(t, process.ErrInvalidChunk, err)
+	}
+}
+
+func TestChunkReassembler_AddChunkTooManyPendingPayloadsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgChunkReassembler()
+	cr, _ := interceptors.NewChunkReassembler(args)
+	chunks1 := splitInChunks(t, args, []byte("0123456789abcdefghijk"))
+	chunks2 := splitInChunks(t, args, []byte("other payload in chunks"))
+
+	_, err := cr.AddChunk("peer", chunks1[0])
+	assert.Nil(t, err)
+
+	result, err := cr.AddChunk("peer", chunks2[0])
+	assert.Nil(t, result)
+	assert.Equal(t, process.ErrTooManyPendingChunkedPayloads, err)
+
+	_, err = cr.AddChunk("other peer", chunks2[0])
+	assert.Nil(t, err)
+}
+
+func TestChunkReassembler_AddChunkHashMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgChunkReassembler()
+	cr, _ := interceptors.NewChunkReassembler(args)
+	chunks := []*batch.Chunk{
+		{Reference: []byte("wrong ref"), ChunkIndex: 0, MaxChunks: 2, Data: []byte("data")},
+		{Reference: []byte("wrong ref"), ChunkIndex: 1, MaxChunks: 2, Data: []byte("data")},
+	}
+
+	for i, chunk := range chunks {
+		buff, _ := args.Marshalizer.Marshal(chunk)
+		result, err := cr.AddChunk("peer", buff)
+
+		assert.Nil(t, result)
+		if i == len(chunks)-1 {
+			assert.Equal(t, process.ErrChunkedPayloadHashMismatch, err)
+		}
+	}
+}
+
+func TestChunkReassembler_AddChunkExpiredPayloadShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	args := createArgChunkReassembler()
+	args.Timeout = time.Millisecond * 10
+	cr, _ := interceptors.NewChunkReassembler(args)
+	chunks1 := splitInChunks(t, args, []byte("0123456789abcdefghijk"))
+	chunks2 := splitInChunks(t, args, []byte("other payload in chunks"))
+
+	_, err := cr.AddChunk("peer", chunks1[0])
+	assert.Nil(t, err)
+
+	time.Sleep(args.Timeout * 5)
+
+	_, err = cr.AddChunk("peer", chunks2[0])
+	assert.Nil(t, err)
+
+	result, err := cr.AddChunk("peer", chunks1[1])
+	assert.Nil(t, result)
+	assert.Equal(t, process.ErrTooManyPendingChunkedPayloads, err)
+}
@@ -0,0 +1,130 @@
+package interceptors
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// chunkingTopicHandler decorates a topic handler so that each topic gets a companion chunks topic, named with the
+// core.ChunksTopicSuffix, on which the payloads too large for a single p2p message are received split in chunks. The
+// reassembled payloads are passed to the message processor registered on the main topic
+type chunkingTopicHandler struct {
+	process.TopicHandler
+	chunkReassembler process.ChunkReassembler
+}
+
+// NewChunkingTopicHandler creates a new topic handler able to receive large payloads split in chunks
+func NewChunkingTopicHandler(
+	topicHandler process.TopicHandler,
+	chunkReassembler process.ChunkReassembler,
+) (*chunkingTopicHandler, error) {
+	if check.IfNil(topicHandler) {
+		return nil, process.ErrNilMessenger
+	}
+	if check.IfNil(chunkReassembler) {
+		return nil, process.ErrNilChunkReassembler
+	}
+
+	return &chunkingTopicHandler{
+		TopicHandler:     topicHandler,
+		chunkReassembler: chunkReassembler,
+	}, nil
+}
+
+// RegisterMessageProcessor registers the message processor on the topic and a chunks processor, feeding the
+// reassembled payloads to the same message processor, on the chunks topic. The chunks topic is created if missing
+func (cth *chunkingTopicHandler) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	err := cth.TopicHandler.RegisterMessageProcessor(topic, handler)
+	if err != nil || check.IfNil(handler) {
+		return err
+	}
+
+	chunksTopic := topic + core.ChunksTopicSuffix
+	if !cth.TopicHandler.HasTopic(chunksTopic) {
+		err = cth.TopicHandler.CreateTopic(chunksTopic, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	chunksProcessor := &chunksMessageProcessor{
+		MessageProcessor: handler,
+		chunkReassembler: cth.chunkReassembler,
+		topic:            topic,
+	}
+
+	return cth.TopicHandler.RegisterMessageProcessor(chunksTopic, chunksProcessor)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cth *chunkingTopicHandler) IsInterfaceNil() bool {
+	if cth == nil {
+		return true
+	}
+	return false
+}
+
+type chunksMessageProcessor struct {
+	p2p.MessageProcessor
+	chunkReassembler process.ChunkReassembler
+	topic            string
+}
+
+// ProcessReceivedMessage stores the received chunk and, once the payload is complete, calls the wrapped message
+// processor with the reassembled payload. The reassembled payload is not re-broadcast as it does not fit in a message
+func (cmp *chunksMessageProcessor) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+	if check.IfNil(message) {
+		return process.ErrNilMessage
+	}
+	if message.Data() == nil {
+		return process.ErrNilDataToProcess
+	}
+
+	payload, err := cmp.chunkReassembler.AddChunk(message.Peer(), message.Data())
+	if err != nil || payload == nil {
+		return err
+	}
+
+	reassembled := &reassembledMessage{
+		MessageP2P: message,
+		data:       payload,
+		topic:      cmp.topic,
+	}
+
+	return cmp.MessageProcessor.ProcessReceivedMessage(reassembled, nil)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cmp *chunksMessageProcessor) IsInterfaceNil() bool {
+	if cmp == nil {
+		return true
+	}
+	return false
+}
+
+// reassembledMessage presents a reassembled payload as if it was received in a single message on the main topic
+type reassembledMessage struct {
+	p2p.MessageP2P
+	data  []byte
+	topic string
+}
+
+// Data returns the reassembled payload
+func (rm *reassembledMessage) Data() []byte {
+	return rm.data
+}
+
+// TopicIDs returns the main topic of the reassembled payload
+func (rm *reassembledMessage) TopicIDs() []string {
+	return []string{rm.topic}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rm *reassembledMessage) IsInterfaceNil() bool {
+	if rm == nil {
+		return true
+	}
+	return false
+}
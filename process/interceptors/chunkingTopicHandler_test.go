@@ -0,0 +1,83 @@
+package interceptors_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChunkingTopicHandler_NilTopicHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cr, _ := interceptors.NewChunkReassembler(createArgChunkReassembler())
+	cth, err := interceptors.NewChunkingTopicHandler(nil, cr)
+
+	assert.Nil(t, cth)
+	assert.Equal(t, process.ErrNilMessenger, err)
+}
+
+func TestNewChunkingTopicHandler_NilChunkReassemblerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cth, err := interceptors.NewChunkingTopicHandler(&mock.TopicHandlerStub{}, nil)
+
+	assert.Nil(t, cth)
+	assert.Equal(t, process.ErrNilChunkReassembler, err)
+}
+
+func TestChunkingTopicHandler_ChunksShouldReachTheInterceptorReassembled(t *testing.T) {
+	t.Parallel()
+
+	createdTopics := make(map[string]bool)
+	registeredProcessors := make(map[string]p2p.MessageProcessor)
+	topicHandler := &mock.TopicHandlerStub{
+		HasTopicCalled: func(name string) bool {
+			return createdTopics[name]
+		},
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			createdTopics[name] = true
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredProcessors[topic] = handler
+			return nil
+		},
+	}
+	var receivedMessages []p2p.MessageP2P
+	interceptor := &mock.InterceptorStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+			receivedMessages = append(receivedMessages, message)
+			return nil
+		},
+	}
+
+	args := createArgChunkReassembler()
+	cr, _ := interceptors.NewChunkReassembler(args)
+	cth, _ := interceptors.NewChunkingTopicHandler(topicHandler, cr)
+	err := cth.RegisterMessageProcessor("miniBlocks_0", interceptor)
+	assert.Nil(t, err)
+
+	chunksTopic := "miniBlocks_0" + core.ChunksTopicSuffix
+	assert.True(t, createdTopics[chunksTopic])
+	assert.True(t, registeredProcessors["miniBlocks_0"] == interceptor)
+
+	payload := []byte("0123456789abcdefghijk")
+	for _, chunk := range splitInChunks(t, args, payload) {
+		err = registeredProcessors[chunksTopic].ProcessReceivedMessage(&mock.P2PMessageMock{
+			DataField:     chunk,
+			PeerField:     "peer",
+			TopicIDsField: []string{chunksTopic},
+		}, nil)
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, 1, len(receivedMessages))
+	assert.Equal(t, payload, receivedMessages[0].Data())
+	assert.Equal(t, []string{"miniBlocks_0"}, receivedMessages[0].TopicIDs())
+	assert.Equal(t, p2p.PeerID("peer"), receivedMessages[0].Peer())
+}
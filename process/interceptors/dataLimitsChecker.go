@@ -0,0 +1,86 @@
+package interceptors
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ArgDataLimitsChecker is the argument structure used to create a new intercepted data limits checker
+type ArgDataLimitsChecker struct {
+	MaxTxDataBytes        int
+	MaxTxsPerMiniBlock    int
+	MaxMiniBlocksPerBlock int
+	StatusHandler         core.AppStatusHandler
+}
+
+// DataLimitsChecker checks the sizes of the intercepted data against the configured limits. The checks only compare
+// lengths, so the oversized data can be dropped before their signatures are verified. Each kind of rejection is
+// counted on its own metric
+type DataLimitsChecker struct {
+	maxTxDataBytes        int
+	maxTxsPerMiniBlock    int
+	maxMiniBlocksPerBlock int
+	statusHandler         core.AppStatusHandler
+}
+
+// NewDataLimitsChecker creates a new intercepted data limits checker
+func NewDataLimitsChecker(arg ArgDataLimitsChecker) (*DataLimitsChecker, error) {
+	if arg.MaxTxDataBytes <= 0 {
+		return nil, process.ErrInvalidInterceptedDataLimit
+	}
+	if arg.MaxTxsPerMiniBlock <= 0 {
+		return nil, process.ErrInvalidInterceptedDataLimit
+	}
+	if arg.MaxMiniBlocksPerBlock <= 0 {
+		return nil, process.ErrInvalidInterceptedDataLimit
+	}
+	if check.IfNil(arg.StatusHandler) {
+		return nil, process.ErrNilAppStatusHandler
+	}
+
+	return &DataLimitsChecker{
+		maxTxDataBytes:        arg.MaxTxDataBytes,
+		maxTxsPerMiniBlock:    arg.MaxTxsPerMiniBlock,
+		maxMiniBlocksPerBlock: arg.MaxMiniBlocksPerBlock,
+		statusHandler:         arg.StatusHandler,
+	}, nil
+}
+
+// CheckTxDataSize returns an error if the size of a transaction's data field exceeds the limit
+func (dlc *DataLimitsChecker) CheckTxDataSize(dataSize int) error {
+	if dataSize > dlc.maxTxDataBytes {
+		dlc.statusHandler.Increment(core.MetricNumRejectedOversizedTxData)
+		return process.ErrTxDataTooLarge
+	}
+
+	return nil
+}
+
+// CheckNumTxsInMiniBlock returns an error if the number of transactions of a miniblock exceeds the limit
+func (dlc *DataLimitsChecker) CheckNumTxsInMiniBlock(numTxs int) error {
+	if numTxs > dlc.maxTxsPerMiniBlock {
+		dlc.statusHandler.Increment(core.MetricNumRejectedOversizedMiniBlocks)
+		return process.ErrTooManyTxsInMiniBlock
+	}
+
+	return nil
+}
+
+// CheckNumMiniBlocks returns an error if the number of miniblocks of a block exceeds the limit
+func (dlc *DataLimitsChecker) CheckNumMiniBlocks(numMiniBlocks int) error {
+	if numMiniBlocks > dlc.maxMiniBlocksPerBlock {
+		dlc.statusHandler.Increment(core.MetricNumRejectedOversizedBlocks)
+		return process.ErrTooManyMiniBlocksInBlock
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dlc *DataLimitsChecker) IsInterfaceNil() bool {
+	if dlc == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package interceptors_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgDataLimitsChecker(incremented map[string]int) interceptors.ArgDataLimitsChecker {
+	return interceptors.ArgDataLimitsChecker{
+		MaxTxDataBytes:        10,
+		MaxTxsPerMiniBlock:    5,
+		MaxMiniBlocksPerBlock: 2,
+		StatusHandler: &mock.AppStatusHandlerStub{
+			IncrementHandler: func(key string) {
+				incremented[key]++
+			},
+		},
+	}
+}
+
+//------- NewDataLimitsChecker
+
+func TestNewDataLimitsChecker_InvalidMaxTxDataBytesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgDataLimitsChecker(make(map[string]int))
+	arg.MaxTxDataBytes = 0
+	dlc, err := interceptors.NewDataLimitsChecker(arg)
+
+	assert.Nil(t, dlc)
+	assert.Equal(t, process.ErrInvalidInterceptedDataLimit, err)
+}
+
+func TestNewDataLimitsChecker_InvalidMaxTxsPerMiniBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgDataLimitsChecker(make(map[string]int))
+	arg.MaxTxsPerMiniBlock = 0
+	dlc, err := interceptors.NewDataLimitsChecker(arg)
+
+	assert.Nil(t, dlc)
+	assert.Equal(t, process.ErrInvalidInterceptedDataLimit, err)
+}
+
+func TestNewDataLimitsChecker_InvalidMaxMiniBlocksPerBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgDataLimitsChecker(make(map[string]int))
+	arg.MaxMiniBlocksPerBlock = -1
+	dlc, err := interceptors.NewDataLimitsChecker(arg)
+
+	assert.Nil(t, dlc)
+	assert.Equal(t, process.ErrInvalidInterceptedDataLimit, err)
+}
+
+func TestNewDataLimitsChecker_NilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgDataLimitsChecker(make(map[string]int))
+	arg.StatusHandler = nil
+	dlc, err := interceptors.NewDataLimitsChecker(arg)
+
+	assert.Nil(t, dlc)
+	assert.Equal(t, process.ErrNilAppStatusHandler, err)
+}
+
+func TestNewDataLimitsChecker_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dlc, err := interceptors.NewDataLimitsChecker(createMockArgDataLimitsChecker(make(map[string]int)))
+
+	assert.Nil(t, err)
+	assert.False(t, dlc.IsInterfaceNil())
+}
+
+//------- checks
+
+func TestDataLimitsChecker_WithinTheLimitsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	incremented := make(map[string]int)
+	dlc, _ := interceptors.NewDataLimitsChecker(createMockArgDataLimitsChecker(incremented))
+
+	assert.Nil(t, dlc.CheckTxDataSize(10))
+	assert.Nil(t, dlc.CheckNumTxsInMiniBlock(5))
+	assert.Nil(t, dlc.CheckNumMiniBlocks(2))
+	assert.Equal(t, 0, len(incremented))
+}
+
+func TestDataLimitsChecker_OverTheLimitsShouldErrAndIncrementDistinctMetrics(t *testing.T) {
+	t.Parallel()
+
+	incremented := make(map[string]int)
+	dlc, _ := interceptors.NewDataLimitsChecker(createMockArgDataLimitsChecker(incremented))
+
+	assert.Equal(t, process.ErrTxDataTooLarge, dlc.CheckTxDataSize(11))
+	assert.Equal(t, process.ErrTooManyTxsInMiniBlock, dlc.CheckNumTxsInMiniBlock(6))
+	assert.Equal(t, process.ErrTooManyTxsInMiniBlock, dlc.CheckNumTxsInMiniBlock(7))
+	assert.Equal(t, process.ErrTooManyMiniBlocksInBlock, dlc.CheckNumMiniBlocks(3))
+
+	expected := map[string]int{
+		core.MetricNumRejectedOversizedTxData:     1,
+		core.MetricNumRejectedOversizedMiniBlocks: 2,
+		core.MetricNumRejectedOversizedBlocks:     1,
+	}
+	assert.Equal(t, expected, incremented)
+}
@@ -17,3 +17,6 @@ const InterceptedMetaHeader InterceptedDataType = "intercepted meta header"
 
 // InterceptedTxBlockBody is the type for intercepted tx block body
 const InterceptedTxBlockBody InterceptedDataType = "intercepted block body"
+
+// InterceptedPeerAuthentication is the type for intercepted peer authentication message
+const InterceptedPeerAuthentication InterceptedDataType = "intercepted peer authentication"
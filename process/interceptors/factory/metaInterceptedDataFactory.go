@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/interceptedBlocks"
+	"github.com/ElrondNetwork/elrond-go/process/peerAuthentication"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -20,9 +21,12 @@ type metaInterceptedDataFactory struct {
 	addrConverter       state.AddressConverter
 	shardCoordinator    sharding.Coordinator
 	interceptedDataType InterceptedDataType
-	multiSigVerifier    crypto.MultiSigVerifier
-	nodesCoordinator    sharding.NodesCoordinator
+	headerSigVerifier   process.InterceptedHeaderSigVerifier
+	integrityVerifier   process.HeaderIntegrityVerifier
 	feeHandler          process.FeeHandler
+	chainID             []byte
+	limitsChecker       process.InterceptedDataLimitsChecker
+	peerAuthVerifier    process.PeerAuthenticationVerifier
 }
 
 // NewMetaInterceptedDataFactory creates an instance of interceptedDataFactory that can create
@@ -44,15 +48,18 @@ func NewMetaInterceptedDataFactory(
 	if check.IfNil(argument.ShardCoordinator) {
 		return nil, process.ErrNilShardCoordinator
 	}
-	if check.IfNil(argument.MultiSigVerifier) {
-		return nil, process.ErrNilMultiSigVerifier
+	if check.IfNil(argument.HeaderSigVerifier) {
+		return nil, process.ErrNilHeaderSigVerifier
 	}
-	if check.IfNil(argument.NodesCoordinator) {
-		return nil, process.ErrNilNodesCoordinator
+	if check.IfNil(argument.HeaderIntegrityVerifier) {
+		return nil, process.ErrNilHeaderIntegrityVerifier
 	}
 	if check.IfNil(argument.FeeHandler) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if len(argument.ChainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
 	if check.IfNil(argument.KeyGen) {
 		return nil, process.ErrNilKeyGen
 	}
@@ -62,18 +69,27 @@ func NewMetaInterceptedDataFactory(
 	if check.IfNil(argument.AddrConv) {
 		return nil, process.ErrNilAddressConverter
 	}
+	if check.IfNil(argument.LimitsChecker) {
+		return nil, process.ErrNilInterceptedDataLimitsChecker
+	}
+	if check.IfNil(argument.PeerAuthVerifier) {
+		return nil, process.ErrNilPeerAuthenticationVerifier
+	}
 
 	return &metaInterceptedDataFactory{
 		marshalizer:         argument.Marshalizer,
 		hasher:              argument.Hasher,
 		shardCoordinator:    argument.ShardCoordinator,
 		interceptedDataType: dataType,
-		multiSigVerifier:    argument.MultiSigVerifier,
-		nodesCoordinator:    argument.NodesCoordinator,
+		headerSigVerifier:   argument.HeaderSigVerifier,
+		integrityVerifier:   argument.HeaderIntegrityVerifier,
 		feeHandler:          argument.FeeHandler,
+		chainID:             argument.ChainID,
 		keyGen:              argument.KeyGen,
 		singleSigner:        argument.Signer,
 		addrConverter:       argument.AddrConv,
+		limitsChecker:       argument.LimitsChecker,
+		peerAuthVerifier:    argument.PeerAuthVerifier,
 	}, nil
 }
 
@@ -87,6 +103,8 @@ func (midf *metaInterceptedDataFactory) Create(buff []byte) (process.Intercepted
 		return midf.createInterceptedMetaHeader(buff)
 	case InterceptedTx:
 		return midf.createInterceptedTx(buff)
+	case InterceptedPeerAuthentication:
+		return midf.createInterceptedPeerAuthentication(buff)
 	default:
 		return nil, process.ErrInterceptedDataTypeNotDefined
 	}
@@ -94,12 +112,13 @@ func (midf *metaInterceptedDataFactory) Create(buff []byte) (process.Intercepted
 
 func (midf *metaInterceptedDataFactory) createInterceptedShardHeader(buff []byte) (process.InterceptedData, error) {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		HdrBuff:          buff,
-		Marshalizer:      midf.marshalizer,
-		Hasher:           midf.hasher,
-		MultiSigVerifier: midf.multiSigVerifier,
-		NodesCoordinator: midf.nodesCoordinator,
-		ShardCoordinator: midf.shardCoordinator,
+		HdrBuff:                 buff,
+		Marshalizer:             midf.marshalizer,
+		Hasher:                  midf.hasher,
+		ShardCoordinator:        midf.shardCoordinator,
+		HeaderSigVerifier:       midf.headerSigVerifier,
+		HeaderIntegrityVerifier: midf.integrityVerifier,
+		LimitsChecker:           midf.limitsChecker,
 	}
 
 	return interceptedBlocks.NewInterceptedHeader(arg)
@@ -107,12 +126,13 @@ func (midf *metaInterceptedDataFactory) createInterceptedShardHeader(buff []byte
 
 func (midf *metaInterceptedDataFactory) createInterceptedMetaHeader(buff []byte) (process.InterceptedData, error) {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		HdrBuff:          buff,
-		Marshalizer:      midf.marshalizer,
-		Hasher:           midf.hasher,
-		MultiSigVerifier: midf.multiSigVerifier,
-		NodesCoordinator: midf.nodesCoordinator,
-		ShardCoordinator: midf.shardCoordinator,
+		HdrBuff:                 buff,
+		Marshalizer:             midf.marshalizer,
+		Hasher:                  midf.hasher,
+		ShardCoordinator:        midf.shardCoordinator,
+		HeaderSigVerifier:       midf.headerSigVerifier,
+		HeaderIntegrityVerifier: midf.integrityVerifier,
+		LimitsChecker:           midf.limitsChecker,
 	}
 
 	return interceptedBlocks.NewInterceptedMetaHeader(arg)
@@ -128,6 +148,17 @@ func (midf *metaInterceptedDataFactory) createInterceptedTx(buff []byte) (proces
 		midf.addrConverter,
 		midf.shardCoordinator,
 		midf.feeHandler,
+		midf.chainID,
+		midf.limitsChecker,
+	)
+}
+
+func (midf *metaInterceptedDataFactory) createInterceptedPeerAuthentication(buff []byte) (process.InterceptedData, error) {
+	return peerAuthentication.NewInterceptedPeerAuthentication(
+		buff,
+		midf.marshalizer,
+		midf.hasher,
+		midf.peerAuthVerifier,
 	)
 }
 
@@ -5,11 +5,13 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/interceptedBlocks"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/peerAuthentication"
 	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/stretchr/testify/assert"
 )
@@ -59,28 +61,28 @@ func TestNewMetaInterceptedDataFactory_NilShardCoordinatorShouldErr(t *testing.T
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
 }
 
-func TestNewMetaInterceptedDataFactory_NilMultiSigVerifierShouldErr(t *testing.T) {
+func TestNewMetaInterceptedDataFactory_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArgument()
-	arg.MultiSigVerifier = nil
+	arg.HeaderSigVerifier = nil
 
 	midf, err := factory.NewMetaInterceptedDataFactory(arg, factory.InterceptedShardHeader)
 
 	assert.Nil(t, midf)
-	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
 }
 
-func TestNewMetaInterceptedDataFactory_NilNodesCoordinatorShouldErr(t *testing.T) {
+func TestNewMetaInterceptedDataFactory_NilHeaderIntegrityVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArgument()
-	arg.NodesCoordinator = nil
+	arg.HeaderIntegrityVerifier = nil
 
 	midf, err := factory.NewMetaInterceptedDataFactory(arg, factory.InterceptedShardHeader)
 
 	assert.Nil(t, midf)
-	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+	assert.Equal(t, process.ErrNilHeaderIntegrityVerifier, err)
 }
 
 func TestNewMetaInterceptedDataFactory_NilFeeHandlerShouldErr(t *testing.T) {
@@ -95,6 +97,18 @@ func TestNewMetaInterceptedDataFactory_NilFeeHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewMetaInterceptedDataFactory_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.ChainID = nil
+
+	midf, err := factory.NewMetaInterceptedDataFactory(arg, factory.InterceptedShardHeader)
+
+	assert.Nil(t, midf)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
 func TestNewMetaInterceptedDataFactory_NilKeyGenShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -131,6 +145,30 @@ func TestNewMetaInterceptedDataFactory_NilAddressConverterShouldErr(t *testing.T
 	assert.Equal(t, process.ErrNilAddressConverter, err)
 }
 
+func TestNewMetaInterceptedDataFactory_NilLimitsCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.LimitsChecker = nil
+
+	midf, err := factory.NewMetaInterceptedDataFactory(arg, factory.InterceptedShardHeader)
+
+	assert.Nil(t, midf)
+	assert.Equal(t, process.ErrNilInterceptedDataLimitsChecker, err)
+}
+
+func TestNewMetaInterceptedDataFactory_NilPeerAuthVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.PeerAuthVerifier = nil
+
+	midf, err := factory.NewMetaInterceptedDataFactory(arg, factory.InterceptedShardHeader)
+
+	assert.Nil(t, midf)
+	assert.Equal(t, process.ErrNilPeerAuthenticationVerifier, err)
+}
+
 func TestNewMetaInterceptedDataFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -202,6 +240,21 @@ func TestMetaInterceptedDataFactory_CreateInterceptedTxShouldWork(t *testing.T)
 	assert.True(t, ok)
 }
 
+func TestMetaInterceptedDataFactory_CreateInterceptedPeerAuthenticationShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	emptyPeerAuthBuff, _ := marshalizer.Marshal(&heartbeat.PeerAuthentication{})
+	midf, _ := factory.NewMetaInterceptedDataFactory(createMockArgument(), factory.InterceptedPeerAuthentication)
+
+	instance, err := midf.Create(emptyPeerAuthBuff)
+
+	assert.NotNil(t, instance)
+	assert.Nil(t, err)
+	_, ok := instance.(*peerAuthentication.InterceptedPeerAuthentication)
+	assert.True(t, ok)
+}
+
 //------- IsInterfaceNil
 
 func TestMetaInterceptedDataFactory_IsInterfaceNil(t *testing.T) {
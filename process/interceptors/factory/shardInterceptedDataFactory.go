@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/interceptedBlocks"
+	"github.com/ElrondNetwork/elrond-go/process/peerAuthentication"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/unsigned"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -21,9 +22,12 @@ type shardInterceptedDataFactory struct {
 	addrConverter       state.AddressConverter
 	shardCoordinator    sharding.Coordinator
 	interceptedDataType InterceptedDataType
-	multiSigVerifier    crypto.MultiSigVerifier
-	nodesCoordinator    sharding.NodesCoordinator
+	headerSigVerifier   process.InterceptedHeaderSigVerifier
+	integrityVerifier   process.HeaderIntegrityVerifier
 	feeHandler          process.FeeHandler
+	chainID             []byte
+	limitsChecker       process.InterceptedDataLimitsChecker
+	peerAuthVerifier    process.PeerAuthenticationVerifier
 }
 
 // NewShardInterceptedDataFactory creates an instance of interceptedDataFactory that can create
@@ -54,15 +58,24 @@ func NewShardInterceptedDataFactory(
 	if check.IfNil(argument.ShardCoordinator) {
 		return nil, process.ErrNilShardCoordinator
 	}
-	if check.IfNil(argument.MultiSigVerifier) {
-		return nil, process.ErrNilMultiSigVerifier
+	if check.IfNil(argument.HeaderSigVerifier) {
+		return nil, process.ErrNilHeaderSigVerifier
 	}
-	if check.IfNil(argument.NodesCoordinator) {
-		return nil, process.ErrNilNodesCoordinator
+	if check.IfNil(argument.HeaderIntegrityVerifier) {
+		return nil, process.ErrNilHeaderIntegrityVerifier
 	}
 	if check.IfNil(argument.FeeHandler) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if len(argument.ChainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
+	if check.IfNil(argument.LimitsChecker) {
+		return nil, process.ErrNilInterceptedDataLimitsChecker
+	}
+	if check.IfNil(argument.PeerAuthVerifier) {
+		return nil, process.ErrNilPeerAuthenticationVerifier
+	}
 
 	return &shardInterceptedDataFactory{
 		marshalizer:         argument.Marshalizer,
@@ -72,9 +85,12 @@ func NewShardInterceptedDataFactory(
 		addrConverter:       argument.AddrConv,
 		shardCoordinator:    argument.ShardCoordinator,
 		interceptedDataType: dataType,
-		multiSigVerifier:    argument.MultiSigVerifier,
-		nodesCoordinator:    argument.NodesCoordinator,
+		headerSigVerifier:   argument.HeaderSigVerifier,
+		integrityVerifier:   argument.HeaderIntegrityVerifier,
 		feeHandler:          argument.FeeHandler,
+		chainID:             argument.ChainID,
+		limitsChecker:       argument.LimitsChecker,
+		peerAuthVerifier:    argument.PeerAuthVerifier,
 	}, nil
 }
 
@@ -92,6 +108,8 @@ func (sidf *shardInterceptedDataFactory) Create(buff []byte) (process.Intercepte
 		return sidf.createInterceptedMetaHeader(buff)
 	case InterceptedTxBlockBody:
 		return sidf.createInterceptedTxBlockBody(buff)
+	case InterceptedPeerAuthentication:
+		return sidf.createInterceptedPeerAuthentication(buff)
 	default:
 		return nil, process.ErrInterceptedDataTypeNotDefined
 	}
@@ -107,6 +125,8 @@ func (sidf *shardInterceptedDataFactory) createInterceptedTx(buff []byte) (proce
 		sidf.addrConverter,
 		sidf.shardCoordinator,
 		sidf.feeHandler,
+		sidf.chainID,
+		sidf.limitsChecker,
 	)
 }
 
@@ -122,12 +142,13 @@ func (sidf *shardInterceptedDataFactory) createInterceptedUnsignedTx(buff []byte
 
 func (sidf *shardInterceptedDataFactory) createInterceptedShardHeader(buff []byte) (process.InterceptedData, error) {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		HdrBuff:          buff,
-		Marshalizer:      sidf.marshalizer,
-		Hasher:           sidf.hasher,
-		MultiSigVerifier: sidf.multiSigVerifier,
-		NodesCoordinator: sidf.nodesCoordinator,
-		ShardCoordinator: sidf.shardCoordinator,
+		HdrBuff:                 buff,
+		Marshalizer:             sidf.marshalizer,
+		Hasher:                  sidf.hasher,
+		ShardCoordinator:        sidf.shardCoordinator,
+		HeaderSigVerifier:       sidf.headerSigVerifier,
+		HeaderIntegrityVerifier: sidf.integrityVerifier,
+		LimitsChecker:           sidf.limitsChecker,
 	}
 
 	return interceptedBlocks.NewInterceptedHeader(arg)
@@ -135,12 +156,13 @@ func (sidf *shardInterceptedDataFactory) createInterceptedShardHeader(buff []byt
 
 func (sidf *shardInterceptedDataFactory) createInterceptedMetaHeader(buff []byte) (process.InterceptedData, error) {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		HdrBuff:          buff,
-		Marshalizer:      sidf.marshalizer,
-		Hasher:           sidf.hasher,
-		MultiSigVerifier: sidf.multiSigVerifier,
-		NodesCoordinator: sidf.nodesCoordinator,
-		ShardCoordinator: sidf.shardCoordinator,
+		HdrBuff:                 buff,
+		Marshalizer:             sidf.marshalizer,
+		Hasher:                  sidf.hasher,
+		ShardCoordinator:        sidf.shardCoordinator,
+		HeaderSigVerifier:       sidf.headerSigVerifier,
+		HeaderIntegrityVerifier: sidf.integrityVerifier,
+		LimitsChecker:           sidf.limitsChecker,
 	}
 
 	return interceptedBlocks.NewInterceptedMetaHeader(arg)
@@ -152,11 +174,21 @@ func (sidf *shardInterceptedDataFactory) createInterceptedTxBlockBody(buff []byt
 		Marshalizer:      sidf.marshalizer,
 		Hasher:           sidf.hasher,
 		ShardCoordinator: sidf.shardCoordinator,
+		LimitsChecker:    sidf.limitsChecker,
 	}
 
 	return interceptedBlocks.NewInterceptedTxBlockBody(arg)
 }
 
+func (sidf *shardInterceptedDataFactory) createInterceptedPeerAuthentication(buff []byte) (process.InterceptedData, error) {
+	return peerAuthentication.NewInterceptedPeerAuthentication(
+		buff,
+		sidf.marshalizer,
+		sidf.hasher,
+		sidf.peerAuthVerifier,
+	)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sidf *shardInterceptedDataFactory) IsInterfaceNil() bool {
 	if sidf == nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -15,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/interceptedBlocks"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/peerAuthentication"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/unsigned"
 	"github.com/stretchr/testify/assert"
@@ -61,15 +63,18 @@ func createMockFeeHandler() process.FeeHandler {
 
 func createMockArgument() *factory.ArgInterceptedDataFactory {
 	return &factory.ArgInterceptedDataFactory{
-		Marshalizer:      &mock.MarshalizerMock{},
-		Hasher:           mock.HasherMock{},
-		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
-		MultiSigVerifier: mock.NewMultiSigner(),
-		NodesCoordinator: mock.NewNodesCoordinatorMock(),
-		KeyGen:           createMockKeyGen(),
-		Signer:           createMockSigner(),
-		AddrConv:         createMockAddressConverter(),
-		FeeHandler:       createMockFeeHandler(),
+		Marshalizer:             &mock.MarshalizerMock{},
+		Hasher:                  mock.HasherMock{},
+		ShardCoordinator:        mock.NewOneShardCoordinatorMock(),
+		KeyGen:                  createMockKeyGen(),
+		Signer:                  createMockSigner(),
+		AddrConv:                createMockAddressConverter(),
+		FeeHandler:              createMockFeeHandler(),
+		HeaderSigVerifier:       &mock.HeaderSigVerifierStub{},
+		HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
+		ChainID:                 []byte("chain ID"),
+		LimitsChecker:           &mock.InterceptedDataLimitsCheckerStub{},
+		PeerAuthVerifier:        &mock.PeerAuthenticationVerifierStub{},
 	}
 }
 
@@ -154,28 +159,28 @@ func TestNewShardInterceptedDataFactory_NilShardCoordinatorShouldErr(t *testing.
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
 }
 
-func TestNewShardInterceptedDataFactory_NilMultiSigVerifierShouldErr(t *testing.T) {
+func TestNewShardInterceptedDataFactory_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArgument()
-	arg.MultiSigVerifier = nil
+	arg.HeaderSigVerifier = nil
 
 	sidf, err := factory.NewShardInterceptedDataFactory(arg, factory.InterceptedTx)
 
 	assert.Nil(t, sidf)
-	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
 }
 
-func TestNewShardInterceptedDataFactory_NilNodesCoordinatorShouldErr(t *testing.T) {
+func TestNewShardInterceptedDataFactory_NilHeaderIntegrityVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArgument()
-	arg.NodesCoordinator = nil
+	arg.HeaderIntegrityVerifier = nil
 
 	sidf, err := factory.NewShardInterceptedDataFactory(arg, factory.InterceptedTx)
 
 	assert.Nil(t, sidf)
-	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+	assert.Equal(t, process.ErrNilHeaderIntegrityVerifier, err)
 }
 
 func TestNewShardInterceptedDataFactory_NilFeeHandlerShouldErr(t *testing.T) {
@@ -190,6 +195,42 @@ func TestNewShardInterceptedDataFactory_NilFeeHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewShardInterceptedDataFactory_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.ChainID = nil
+
+	sidf, err := factory.NewShardInterceptedDataFactory(arg, factory.InterceptedTx)
+
+	assert.Nil(t, sidf)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestNewShardInterceptedDataFactory_NilLimitsCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.LimitsChecker = nil
+
+	sidf, err := factory.NewShardInterceptedDataFactory(arg, factory.InterceptedTx)
+
+	assert.Nil(t, sidf)
+	assert.Equal(t, process.ErrNilInterceptedDataLimitsChecker, err)
+}
+
+func TestNewShardInterceptedDataFactory_NilPeerAuthVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.PeerAuthVerifier = nil
+
+	sidf, err := factory.NewShardInterceptedDataFactory(arg, factory.InterceptedTx)
+
+	assert.Nil(t, sidf)
+	assert.Equal(t, process.ErrNilPeerAuthenticationVerifier, err)
+}
+
 func TestNewShardInterceptedDataFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -297,6 +338,21 @@ func TestShardInterceptedDataFactory_CreateInterceptedTxBlockBodyShouldWork(t *t
 	assert.True(t, ok)
 }
 
+func TestShardInterceptedDataFactory_CreateInterceptedPeerAuthenticationShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	emptyPeerAuthBuff, _ := marshalizer.Marshal(&heartbeat.PeerAuthentication{})
+	sidf, _ := factory.NewShardInterceptedDataFactory(createMockArgument(), factory.InterceptedPeerAuthentication)
+
+	instance, err := sidf.Create(emptyPeerAuthBuff)
+
+	assert.NotNil(t, instance)
+	assert.Nil(t, err)
+	_, ok := instance.(*peerAuthentication.InterceptedPeerAuthentication)
+	assert.True(t, ok)
+}
+
 //------- IsInterfaceNil
 
 func TestShardInterceptedDataFactory_IsInterfaceNil(t *testing.T) {
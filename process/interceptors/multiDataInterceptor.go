@@ -10,7 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("process/interceptors")
 
 // MultiDataInterceptor is used for intercepting packed multi data
 type MultiDataInterceptor struct {
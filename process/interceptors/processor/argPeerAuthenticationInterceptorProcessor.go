@@ -0,0 +1,13 @@
+package processor
+
+import (
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgPeerAuthenticationInterceptorProcessor is the argument for the interceptor processor used for peer authentication
+// messages
+type ArgPeerAuthenticationInterceptorProcessor struct {
+	PeerAuthentications storage.Cacher
+	NodesCoordinator    sharding.NodesCoordinator
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 )
 
@@ -17,3 +18,8 @@ type InterceptedTransactionHandler interface {
 	TotalValue() *big.Int
 	Transaction() data.TransactionHandler
 }
+
+// InterceptedPeerAuthenticationHandler defines an intercepted data wrapper over a peer authentication message
+type InterceptedPeerAuthenticationHandler interface {
+	PeerAuthentication() *heartbeat.PeerAuthentication
+}
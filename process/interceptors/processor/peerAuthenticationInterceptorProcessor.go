@@ -0,0 +1,81 @@
+package processor
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// PeerAuthenticationInterceptorProcessor is the processor used when intercepting peer authentication messages. It
+// keeps, for each validator public key, the latest message binding it to a p2p peer ID
+type PeerAuthenticationInterceptorProcessor struct {
+	peerAuthentications storage.Cacher
+	nodesCoordinator    sharding.NodesCoordinator
+}
+
+// NewPeerAuthenticationInterceptorProcessor creates a new PeerAuthenticationInterceptorProcessor instance
+func NewPeerAuthenticationInterceptorProcessor(
+	argument *ArgPeerAuthenticationInterceptorProcessor,
+) (*PeerAuthenticationInterceptorProcessor, error) {
+	if argument == nil {
+		return nil, process.ErrNilArguments
+	}
+	if check.IfNil(argument.PeerAuthentications) {
+		return nil, process.ErrNilCacher
+	}
+	if check.IfNil(argument.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+
+	return &PeerAuthenticationInterceptorProcessor{
+		peerAuthentications: argument.PeerAuthentications,
+		nodesCoordinator:    argument.NodesCoordinator,
+	}, nil
+}
+
+// Validate checks that the message was signed by a key of the validators set
+func (paip *PeerAuthenticationInterceptorProcessor) Validate(data process.InterceptedData) error {
+	interceptedPeerAuth, ok := data.(InterceptedPeerAuthenticationHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	_, _, err := paip.nodesCoordinator.GetValidatorWithPublicKey(interceptedPeerAuth.PeerAuthentication().Pubkey)
+	if err != nil {
+		return process.ErrPeerAuthenticationNotFromValidator
+	}
+
+	return nil
+}
+
+// Save will save the received message into the peer authentications cacher as public key<->message, unless a message
+// expiring later was already received for the same key
+func (paip *PeerAuthenticationInterceptorProcessor) Save(data process.InterceptedData) error {
+	interceptedPeerAuth, ok := data.(InterceptedPeerAuthenticationHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	peerAuth := interceptedPeerAuth.PeerAuthentication()
+	existing, found := paip.peerAuthentications.Peek(peerAuth.Pubkey)
+	if found {
+		existingPeerAuth, isPeerAuth := existing.(*heartbeat.PeerAuthentication)
+		if isPeerAuth && existingPeerAuth.Expiry >= peerAuth.Expiry {
+			return nil
+		}
+	}
+
+	paip.peerAuthentications.Put(peerAuth.Pubkey, peerAuth)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (paip *PeerAuthenticationInterceptorProcessor) IsInterfaceNil() bool {
+	if paip == nil {
+		return true
+	}
+	return false
+}
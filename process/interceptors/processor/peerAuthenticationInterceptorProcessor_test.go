@@ -0,0 +1,171 @@
+package processor_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+type interceptedPeerAuthenticationStub struct {
+	mock.InterceptedDataStub
+	peerAuth *heartbeat.PeerAuthentication
+}
+
+func (ipas *interceptedPeerAuthenticationStub) PeerAuthentication() *heartbeat.PeerAuthentication {
+	return ipas.peerAuth
+}
+
+func createInterceptedPeerAuthentication(pid string, expiry int64) *interceptedPeerAuthenticationStub {
+	return &interceptedPeerAuthenticationStub{
+		peerAuth: &heartbeat.PeerAuthentication{
+			Pubkey:    []byte("pubkey"),
+			Signature: []byte("signature"),
+			Pid:       []byte(pid),
+			Expiry:    expiry,
+		},
+	}
+}
+
+func createMockPeerAuthenticationArgument() *processor.ArgPeerAuthenticationInterceptorProcessor {
+	return &processor.ArgPeerAuthenticationInterceptorProcessor{
+		PeerAuthentications: mock.NewCacherMock(),
+		NodesCoordinator:    mock.NewNodesCoordinatorMock(),
+	}
+}
+
+//------- NewPeerAuthenticationInterceptorProcessor
+
+func TestNewPeerAuthenticationInterceptorProcessor_NilArgumentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	paip, err := processor.NewPeerAuthenticationInterceptorProcessor(nil)
+
+	assert.Nil(t, paip)
+	assert.Equal(t, process.ErrNilArguments, err)
+}
+
+func TestNewPeerAuthenticationInterceptorProcessor_NilPeerAuthenticationsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockPeerAuthenticationArgument()
+	arg.PeerAuthentications = nil
+	paip, err := processor.NewPeerAuthenticationInterceptorProcessor(arg)
+
+	assert.Nil(t, paip)
+	assert.Equal(t, process.ErrNilCacher, err)
+}
+
+func TestNewPeerAuthenticationInterceptorProcessor_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockPeerAuthenticationArgument()
+	arg.NodesCoordinator = nil
+	paip, err := processor.NewPeerAuthenticationInterceptorProcessor(arg)
+
+	assert.Nil(t, paip)
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+}
+
+func TestNewPeerAuthenticationInterceptorProcessor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	paip, err := processor.NewPeerAuthenticationInterceptorProcessor(createMockPeerAuthenticationArgument())
+
+	assert.False(t, check.IfNil(paip))
+	assert.Nil(t, err)
+}
+
+//------- Validate
+
+func TestPeerAuthenticationInterceptorProcessor_ValidateWrongTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	paip, _ := processor.NewPeerAuthenticationInterceptorProcessor(createMockPeerAuthenticationArgument())
+
+	err := paip.Validate(&mock.InterceptedDataStub{})
+
+	assert.Equal(t, process.ErrWrongTypeAssertion, err)
+}
+
+func TestPeerAuthenticationInterceptorProcessor_ValidateNotValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockPeerAuthenticationArgument()
+	arg.NodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorWithPublicKeyCalled: func(publicKey []byte) (sharding.Validator, uint32, error) {
+			return nil, 0, errors.New("validator not found")
+		},
+	}
+	paip, _ := processor.NewPeerAuthenticationInterceptorProcessor(arg)
+
+	err := paip.Validate(createInterceptedPeerAuthentication("pid", 100))
+
+	assert.Equal(t, process.ErrPeerAuthenticationNotFromValidator, err)
+}
+
+func TestPeerAuthenticationInterceptorProcessor_ValidateShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockPeerAuthenticationArgument()
+	arg.NodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorWithPublicKeyCalled: func(publicKey []byte) (sharding.Validator, uint32, error) {
+			assert.Equal(t, []byte("pubkey"), publicKey)
+			return nil, 1, nil
+		},
+	}
+	paip, _ := processor.NewPeerAuthenticationInterceptorProcessor(arg)
+
+	err := paip.Validate(createInterceptedPeerAuthentication("pid", 100))
+
+	assert.Nil(t, err)
+}
+
+//------- Save
+
+func TestPeerAuthenticationInterceptorProcessor_SaveWrongTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	paip, _ := processor.NewPeerAuthenticationInterceptorProcessor(createMockPeerAuthenticationArgument())
+
+	err := paip.Save(&mock.InterceptedDataStub{})
+
+	assert.Equal(t, process.ErrWrongTypeAssertion, err)
+}
+
+func TestPeerAuthenticationInterceptorProcessor_SaveShouldKeepTheLatestMessage(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockPeerAuthenticationArgument()
+	paip, _ := processor.NewPeerAuthenticationInterceptorProcessor(arg)
+
+	latest := createInterceptedPeerAuthentication("new pid", 200)
+	err := paip.Save(latest)
+	assert.Nil(t, err)
+
+	err = paip.Save(createInterceptedPeerAuthentication("old pid", 100))
+	assert.Nil(t, err)
+
+	stored, _ := arg.PeerAuthentications.Peek([]byte("pubkey"))
+	assert.Equal(t, latest.PeerAuthentication(), stored)
+}
+
+func TestPeerAuthenticationInterceptorProcessor_SaveShouldReplaceAnOlderMessage(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockPeerAuthenticationArgument()
+	paip, _ := processor.NewPeerAuthenticationInterceptorProcessor(arg)
+
+	_ = paip.Save(createInterceptedPeerAuthentication("old pid", 100))
+	latest := createInterceptedPeerAuthentication("new pid", 200)
+	_ = paip.Save(latest)
+
+	stored, _ := arg.PeerAuthentications.Peek([]byte("pubkey"))
+	assert.Equal(t, latest.PeerAuthentication(), stored)
+}
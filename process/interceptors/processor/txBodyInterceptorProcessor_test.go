@@ -30,6 +30,7 @@ func createInteceptedTxBlockBody(txBlockBody block.Body) *interceptedBlocks.Inte
 		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
 		Hasher:           testHasher,
 		Marshalizer:      testMarshalizer,
+		LimitsChecker:    &mock.InterceptedDataLimitsCheckerStub{},
 	}
 
 	arg.TxBlockBodyBuff, _ = testMarshalizer.Marshal(txBlockBody)
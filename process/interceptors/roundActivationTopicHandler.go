@@ -0,0 +1,87 @@
+package interceptors
+
+import (
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// roundActivationTopicHandler decorates a topic handler so that the messages received on a topic are dropped,
+// before reaching the interceptor, starting with the round configured for the "DisableTopic:<topic>" name. The topic
+// name is the one without the shard suffixes, e.g. "transactions" for "transactions_0_1"
+type roundActivationTopicHandler struct {
+	process.TopicHandler
+	roundActivationHandler core.RoundActivationHandler
+}
+
+// NewRoundActivationTopicHandler creates a new topic handler able to disable topics at configured rounds
+func NewRoundActivationTopicHandler(
+	topicHandler process.TopicHandler,
+	roundActivationHandler core.RoundActivationHandler,
+) (*roundActivationTopicHandler, error) {
+	if check.IfNil(topicHandler) {
+		return nil, process.ErrNilMessenger
+	}
+	if check.IfNil(roundActivationHandler) {
+		return nil, process.ErrNilRoundActivationHandler
+	}
+
+	return &roundActivationTopicHandler{
+		TopicHandler:           topicHandler,
+		roundActivationHandler: roundActivationHandler,
+	}, nil
+}
+
+// RegisterMessageProcessor registers the message processor wrapped so that it is skipped while its topic is disabled
+func (rath *roundActivationTopicHandler) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	if check.IfNil(handler) {
+		return rath.TopicHandler.RegisterMessageProcessor(topic, handler)
+	}
+
+	baseTopic := strings.Split(topic, "_")[0]
+	wrapper := &roundActivationMessageProcessor{
+		MessageProcessor:       handler,
+		disableFlag:            core.DisableTopicRoundFlagPrefix + baseTopic,
+		roundActivationHandler: rath.roundActivationHandler,
+	}
+
+	return rath.TopicHandler.RegisterMessageProcessor(topic, wrapper)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rath *roundActivationTopicHandler) IsInterfaceNil() bool {
+	if rath == nil {
+		return true
+	}
+	return false
+}
+
+type roundActivationMessageProcessor struct {
+	p2p.MessageProcessor
+	disableFlag            string
+	roundActivationHandler core.RoundActivationHandler
+}
+
+// ProcessReceivedMessage drops the message if the topic is disabled in the current round, otherwise calls the
+// wrapped message processor
+func (ramp *roundActivationMessageProcessor) ProcessReceivedMessage(
+	message p2p.MessageP2P,
+	broadcastHandler func(buffToSend []byte),
+) error {
+	if ramp.roundActivationHandler.IsEnabled(ramp.disableFlag) {
+		return process.ErrTopicDisabled
+	}
+
+	return ramp.MessageProcessor.ProcessReceivedMessage(message, broadcastHandler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ramp *roundActivationMessageProcessor) IsInterfaceNil() bool {
+	if ramp == nil {
+		return true
+	}
+	return false
+}
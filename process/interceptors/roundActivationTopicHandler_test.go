@@ -0,0 +1,67 @@
+package interceptors_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRoundActivationTopicHandler_NilTopicHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rath, err := interceptors.NewRoundActivationTopicHandler(nil, &mock.RoundActivationHandlerStub{})
+
+	assert.Nil(t, rath)
+	assert.Equal(t, process.ErrNilMessenger, err)
+}
+
+func TestNewRoundActivationTopicHandler_NilRoundActivationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rath, err := interceptors.NewRoundActivationTopicHandler(&mock.TopicHandlerStub{}, nil)
+
+	assert.Nil(t, rath)
+	assert.Equal(t, process.ErrNilRoundActivationHandler, err)
+}
+
+func TestRoundActivationTopicHandler_DisabledTopicShouldDropTheMessages(t *testing.T) {
+	t.Parallel()
+
+	var registeredProcessor p2p.MessageProcessor
+	topicHandler := &mock.TopicHandlerStub{
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredProcessor = handler
+			return nil
+		},
+	}
+	disabled := false
+	roundActivationHandler := &mock.RoundActivationHandlerStub{
+		IsEnabledCalled: func(name string) bool {
+			return disabled && name == "DisableTopic:transactions"
+		},
+	}
+	numProcessed := 0
+	interceptor := &mock.InterceptorStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+			numProcessed++
+			return nil
+		},
+	}
+
+	rath, _ := interceptors.NewRoundActivationTopicHandler(topicHandler, roundActivationHandler)
+	err := rath.RegisterMessageProcessor("transactions_0_1", interceptor)
+	assert.Nil(t, err)
+
+	err = registeredProcessor.ProcessReceivedMessage(&mock.P2PMessageMock{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numProcessed)
+
+	disabled = true
+	err = registeredProcessor.ProcessReceivedMessage(&mock.P2PMessageMock{}, nil)
+	assert.Equal(t, process.ErrTopicDisabled, err)
+	assert.Equal(t, 1, numProcessed)
+}
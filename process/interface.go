@@ -6,12 +6,18 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/audit"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -117,7 +123,7 @@ type TransactionCoordinator interface {
 	ProcessBlockTransaction(body block.Body, round uint64, haveTime func() time.Duration) error
 
 	CreateBlockStarted()
-	CreateMbsAndProcessCrossShardTransactionsDstMe(header data.HeaderHandler, processedMiniBlocksHashes map[string]struct{}, maxTxSpaceRemained uint32, maxMbSpaceRemained uint32, round uint64, haveTime func() bool) (block.MiniBlockSlice, uint32, bool)
+	CreateMbsAndProcessCrossShardTransactionsDstMe(header data.HeaderHandler, processedMiniBlocksInfo map[string]*processedMb.ProcessedMiniBlockInfo, maxTxSpaceRemained uint32, maxMbSpaceRemained uint32, round uint64, haveTime func() bool) (block.MiniBlockSlice, uint32, bool)
 	CreateMbsAndProcessTransactionsFromMe(maxTxSpaceRemained uint32, maxMbSpaceRemained uint32, round uint64, haveTime func() bool) block.MiniBlockSlice
 
 	CreateMarshalizedData(body block.Body) (map[uint32]block.MiniBlockSlice, map[string][][]byte)
@@ -136,6 +142,58 @@ type SmartContractProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// BuiltInFunction defines the functionality of a protocol function which is executed directly by the transaction
+// processor, without going through a virtual machine
+type BuiltInFunction interface {
+	ProcessBuiltInFunction(tx *transaction.Transaction, acntSnd, acntDst *state.Account, arguments [][]byte) error
+	IsInterfaceNil() bool
+}
+
+// CrossShardBuiltInFunction defines a built-in function which can also be called with the sender and the receiver
+// in different shards. In the sender shard it is called with a nil receiver account and delivers its effects to the
+// receiver shard through smart contract results, which are processed by calling it with a nil sender account
+type CrossShardBuiltInFunction interface {
+	BuiltInFunction
+	IsCrossShardEnabled() bool
+}
+
+// ContractCallBuiltInFunction defines a built-in function which can be followed by a call of the receiver contract
+type ContractCallBuiltInFunction interface {
+	BuiltInFunction
+	ContractCallData(arguments [][]byte) string
+}
+
+// BuiltInFunctionWithGasCost defines a built-in function which costs, on top of the move balance, the gas found in
+// the gas schedule of the current epoch
+type BuiltInFunctionWithGasCost interface {
+	BuiltInFunction
+	GasCost() uint64
+}
+
+// BuiltInFunctionContainer defines a built-in functions holder data type with basic functionality
+type BuiltInFunctionContainer interface {
+	Get(key string) (BuiltInFunction, error)
+	Add(key string, function BuiltInFunction) error
+	Replace(key string, function BuiltInFunction) error
+	Remove(key string)
+	Len() int
+	Keys() []string
+	IsInterfaceNil() bool
+}
+
+// TransactionLogProcessor defines what a component which records the outcome of the smart contract executions
+// should do
+type TransactionLogProcessor interface {
+	SaveLog(txHash []byte, vmOutput *vmcommon.VMOutput, scrs []data.TransactionHandler) error
+	IsInterfaceNil() bool
+}
+
+// EpochHandler defines what a component which provides the current epoch should do
+type EpochHandler interface {
+	Epoch() uint32
+	IsInterfaceNil() bool
+}
+
 // IntermediateTransactionHandler handles transactions which are not resolved in only one step
 type IntermediateTransactionHandler interface {
 	AddIntermediateTransactions(txs []data.TransactionHandler) error
@@ -165,6 +223,15 @@ type TransactionFeeHandler interface {
 	IsInterfaceNil() bool
 }
 
+// BlockFeesHandler provides the fees accumulated by the block being created or processed and the part of them which
+// is burned, once the fee burning is enabled
+type BlockFeesHandler interface {
+	IsFeeBurnEnabled() bool
+	GetAccumulatedFees() *big.Int
+	GetBurnedFees() *big.Int
+	IsInterfaceNil() bool
+}
+
 // SpecialAddressHandler responds with needed special addresses
 type SpecialAddressHandler interface {
 	SetShardConsensusData(randomness []byte, round uint64, epoch uint32, shardID uint32) error
@@ -175,6 +242,7 @@ type SpecialAddressHandler interface {
 	ElrondCommunityAddress() []byte
 	LeaderAddress() []byte
 	BurnAddress() []byte
+	ProtocolSustainabilityAddress() []byte
 	SetElrondCommunityAddress(elrond []byte)
 	ShardIdForAddress([]byte) (uint32, error)
 	Epoch() uint32
@@ -267,6 +335,20 @@ type Bootstrapper interface {
 	IsInterfaceNil() bool
 }
 
+// BlockTracker defines the functionality for tracking the received, the self notarized and the cross notarized
+// headers of every shard
+type BlockTracker interface {
+	AddTrackedHeader(header data.HeaderHandler, hash []byte)
+	AddCrossNotarizedHeader(shardId uint32, crossNotarizedHeader data.HeaderHandler, crossNotarizedHeaderHash []byte)
+	AddSelfNotarizedHeader(shardId uint32, selfNotarizedHeader data.HeaderHandler, selfNotarizedHeaderHash []byte)
+	GetLastCrossNotarizedHeader(shardId uint32) (data.HeaderHandler, []byte, error)
+	GetLastSelfNotarizedHeader(shardId uint32) (data.HeaderHandler, []byte, error)
+	ComputeLongestChain(shardId uint32, header data.HeaderHandler) ([]data.HeaderHandler, [][]byte)
+	CleanupHeadersBehindNonce(shardId uint32, selfNotarizedNonce uint64, crossNotarizedNonce uint64)
+	RemoveLastNotarizedHeaders()
+	IsInterfaceNil() bool
+}
+
 // ForkDetector is an interface that defines the behaviour of a struct that is able
 // to detect forks
 type ForkDetector interface {
@@ -289,6 +371,7 @@ type InterceptorsContainer interface {
 	Replace(key string, val Interceptor) error
 	Remove(key string)
 	Len() int
+	Keys() []string
 	IsInterfaceNil() bool
 }
 
@@ -349,7 +432,7 @@ type VirtualMachinesContainer interface {
 // VirtualMachinesContainerFactory defines the functionality to create a virtual machine container
 type VirtualMachinesContainerFactory interface {
 	Create() (VirtualMachinesContainer, error)
-	VMAccountsDB() *hooks.VMAccountsDB
+	BlockChainHookImpl() *hooks.BlockChainHookImpl
 	IsInterfaceNil() bool
 }
 
@@ -417,11 +500,65 @@ type ArgumentsParser interface {
 
 // TemporaryAccountsHandler defines the functionality to create temporary accounts and pass to VM.
 // This holder will contain usually one account from shard X that calls a SC in shard Y
-// so when executing the code in shard Y, this impl will hold an ephemeral copy of the sender account from shard X
+// so when executing the code in shard Y, this impl will hold an ephemeral copy of the sender account from shard X.
+// It also holds the address of the smart contract currently executed, used to restrict the access to other contracts
 type TemporaryAccountsHandler interface {
 	AddTempAccount(address []byte, balance *big.Int, nonce uint64)
 	CleanTempAccounts()
 	TempAccount(address []byte) state.AccountHandler
+	SetCurrentSmartContract(address []byte)
+	IsInterfaceNil() bool
+}
+
+// ExecutionTracer records the calls made by a VM to the node while a smart contract execution is traced
+type ExecutionTracer interface {
+	StartTracing()
+	StopTracing() []*transaction.SimulationTraceStep
+	IsInterfaceNil() bool
+}
+
+// BlockChainHookHandler defines the functionality of a VM blockchain hook which has to be told about the block
+// currently being built or processed
+type BlockChainHookHandler interface {
+	TemporaryAccountsHandler
+	SetCurrentHeader(hdr data.HeaderHandler)
+}
+
+// InterceptedHeaderSigVerifier is the interface needed at interceptors level to check that a header's random seed
+// and aggregated signature are correct
+type InterceptedHeaderSigVerifier interface {
+	VerifyRandSeed(header data.HeaderHandler) error
+	VerifySignature(header data.HeaderHandler) error
+	IsInterfaceNil() bool
+}
+
+// HeaderIntegrityVerifier is the interface needed at interceptors level to reject the malformed headers before their
+// signatures are verified
+type HeaderIntegrityVerifier interface {
+	Verify(header data.HeaderHandler) error
+	IsInterfaceNil() bool
+}
+
+// InterceptedDataLimitsChecker is the interface needed at interceptors level to drop the oversized data before their
+// signatures are verified
+type InterceptedDataLimitsChecker interface {
+	CheckTxDataSize(dataSize int) error
+	CheckNumTxsInMiniBlock(numTxs int) error
+	CheckNumMiniBlocks(numMiniBlocks int) error
+	IsInterfaceNil() bool
+}
+
+// PeerAuthenticationVerifier is the interface needed at interceptors level to check that a peer authentication message
+// is signed by the public key it carries and has not expired
+type PeerAuthenticationVerifier interface {
+	Verify(peerAuth *heartbeat.PeerAuthentication) error
+	IsInterfaceNil() bool
+}
+
+// EpochNotifier can notify the components interested in the epoch change, like the gas schedule notifier, about
+// the epoch of the block being built or processed
+type EpochNotifier interface {
+	CheckEpoch(epoch uint32)
 	IsInterfaceNil() bool
 }
 
@@ -448,6 +585,8 @@ type RewardsHandler interface {
 	CommunityPercentage() float64
 	LeaderPercentage() float64
 	BurnPercentage() float64
+	ProtocolSustainabilityPercentage() float64
+	FeeBurnEnableEpoch() uint32
 	IsInterfaceNil() bool
 }
 
@@ -455,7 +594,10 @@ type RewardsHandler interface {
 type FeeHandler interface {
 	ComputeGasLimit(tx TransactionWithFeeHandler) uint64
 	ComputeFee(tx TransactionWithFeeHandler) *big.Int
+	ComputeTxFee(tx TransactionWithFeeHandler) *big.Int
+	ComputeFeeForProcessing(tx TransactionWithFeeHandler, gasToUse uint64) *big.Int
 	CheckValidityTxValues(tx TransactionWithFeeHandler) error
+	DeveloperPercentage() float64
 	IsInterfaceNil() bool
 }
 
@@ -473,9 +615,105 @@ type EconomicsAddressesHandler interface {
 	IsInterfaceNil() bool
 }
 
+// BlockGasLimitsHandler defines the maximum gas which can be consumed in one block, separately for move balance
+// transactions and smart contract executions, both in total and per destination shard
+type BlockGasLimitsHandler interface {
+	MaxMoveBalanceGasPerBlock() uint64
+	MaxSCExecutionGasPerBlock() uint64
+	MaxMoveBalanceGasPerShard() uint64
+	MaxSCExecutionGasPerShard() uint64
+	IsInterfaceNil() bool
+}
+
+// GasHandler is able to compute and accumulate the gas consumed by the transactions of a block, while the block
+// is created or processed
+type GasHandler interface {
+	Init()
+	ComputeGasConsumedByTx(tx data.TransactionHandler) (uint64, uint64)
+	ComputeGasConsumedByMiniBlock(miniBlock *block.MiniBlock, mapHashTx map[string]data.TransactionHandler) (uint64, uint64, error)
+	IsMaxGasLimitReached(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64) bool
+	AddGasConsumed(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64)
+	GasConsumed() (uint64, uint64)
+	IsInterfaceNil() bool
+}
+
 // MiniBlocksCompacter defines the functionality that is needed for mini blocks compaction and expansion
 type MiniBlocksCompacter interface {
 	Compact(block.MiniBlockSlice, map[string]data.TransactionHandler) block.MiniBlockSlice
 	Expand(block.MiniBlockSlice, map[string]data.TransactionHandler) (block.MiniBlockSlice, error)
 	IsInterfaceNil() bool
 }
+
+// PendingMiniBlocksHandler keeps track of the cross shard miniblocks notarized by the metachain which were not yet
+// processed by their destination shards
+type PendingMiniBlocksHandler interface {
+	AddCommittedHeaders(metaNonce uint64, shardHdrs []*block.Header)
+	RevertHeaders(metaNonce uint64)
+	OverdueMiniBlockHashes(receiverShardId uint32) [][]byte
+	PendingMiniBlocks() []*block.PendingMiniBlockInfo
+	IsInterfaceNil() bool
+}
+
+// HistoryRepository records, at commit time, the secondary indexes of the committed blocks
+type HistoryRepository interface {
+	RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// ChunkReassembler collects the chunks of the payloads too large for a single p2p message and returns a payload once
+// all its chunks were received
+type ChunkReassembler interface {
+	AddChunk(source p2p.PeerID, buff []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// OutgoingOperationsHandler is the extension point through which the block processors hand the committed blocks to
+// the bridging components, which collect the operations destined to another chain
+type OutgoingOperationsHandler interface {
+	ProcessCommittedBlock(headerHash []byte, header data.HeaderHandler, body data.BodyHandler)
+	GetOutgoingOperationsBatches() ([]*bridge.SignedOutgoingOperationsBatch, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// ValidatorsSelectionAuditor counts, from the committed blocks, how many times each eligible validator was selected as
+// leader and as consensus group member, and flags the validators selected significantly more or less often than expected
+type ValidatorsSelectionAuditor interface {
+	AuditCommittedBlock(header data.HeaderHandler)
+	EpochAudit(epoch uint32) (*audit.EpochSelectionAudit, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// SupplyAccountant keeps, from the committed blocks, the counters of the value minted and burned in the own shard
+type SupplyAccountant interface {
+	AccountCommittedBlock(header data.HeaderHandler, rewardTxs map[string]data.TransactionHandler)
+	CurrentSupply() (*supply.ShardSupply, error)
+	EpochSupply(epoch uint32) (*supply.ShardSupply, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// ESDTSupplyTracker keeps, for each token, the supply changes made by the token built-in functions. The changes are
+// pending until the block processing them is committed and are dropped if the block is reverted
+type ESDTSupplyTracker interface {
+	AddInitialSupply(tokenID []byte, value *big.Int)
+	AddMinted(tokenID []byte, value *big.Int)
+	AddBurned(tokenID []byte, value *big.Int)
+	CommitPendingChanges(header data.HeaderHandler)
+	RevertPendingChanges()
+	GetTokenSupply(tokenID []byte) (*esdt.TokenSupply, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// RaterHandler computes the rating of a validator when it proposes or signs a block and when it misses to do so
+type RaterHandler interface {
+	GetStartRating() uint32
+	ComputeIncreaseProposer(shardId uint32, currentRating uint32) uint32
+	ComputeDecreaseProposer(shardId uint32, currentRating uint32) uint32
+	ComputeIncreaseValidator(shardId uint32, currentRating uint32) uint32
+	ComputeDecreaseValidator(shardId uint32, currentRating uint32) uint32
+	IsInterfaceNil() bool
+}
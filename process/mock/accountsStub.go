@@ -1,8 +1,10 @@
 package mock
 
 import (
+	"context"
 	"errors"
 
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 )
 
@@ -21,6 +23,7 @@ type AccountsStub struct {
 	SaveDataTrieCalled          func(acountWrapper state.AccountHandler) error
 	RootHashCalled              func() ([]byte, error)
 	RecreateTrieCalled          func(rootHash []byte) error
+	GetAllLeavesOnChannelCalled func(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error)
 }
 
 var errNotImplemented = errors.New("not implemented")
@@ -139,6 +142,14 @@ func (aam *AccountsStub) RecreateTrie(rootHash []byte) error {
 	return errNotImplemented
 }
 
+func (aam *AccountsStub) GetAllLeavesOnChannel(ctx context.Context, rootHash []byte) (<-chan data.KeyValueHolder, error) {
+	if aam.GetAllLeavesOnChannelCalled != nil {
+		return aam.GetAllLeavesOnChannelCalled(ctx, rootHash)
+	}
+
+	return nil, errNotImplemented
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (aam *AccountsStub) IsInterfaceNil() bool {
 	if aam == nil {
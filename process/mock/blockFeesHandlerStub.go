@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"math/big"
+)
+
+type BlockFeesHandlerStub struct {
+	IsFeeBurnEnabledCalled   func() bool
+	GetAccumulatedFeesCalled func() *big.Int
+	GetBurnedFeesCalled      func() *big.Int
+}
+
+func (bfhs *BlockFeesHandlerStub) IsFeeBurnEnabled() bool {
+	if bfhs.IsFeeBurnEnabledCalled != nil {
+		return bfhs.IsFeeBurnEnabledCalled()
+	}
+	return false
+}
+
+func (bfhs *BlockFeesHandlerStub) GetAccumulatedFees() *big.Int {
+	if bfhs.GetAccumulatedFeesCalled != nil {
+		return bfhs.GetAccumulatedFeesCalled()
+	}
+	return big.NewInt(0)
+}
+
+func (bfhs *BlockFeesHandlerStub) GetBurnedFees() *big.Int {
+	if bfhs.GetBurnedFeesCalled != nil {
+		return bfhs.GetBurnedFeesCalled()
+	}
+	return big.NewInt(0)
+}
+
+func (bfhs *BlockFeesHandlerStub) IsInterfaceNil() bool {
+	if bfhs == nil {
+		return true
+	}
+	return false
+}
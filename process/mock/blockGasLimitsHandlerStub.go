@@ -0,0 +1,31 @@
+package mock
+
+type BlockGasLimitsHandlerStub struct {
+	MaxMoveBalanceGasPerBlockCalled func() uint64
+	MaxSCExecutionGasPerBlockCalled func() uint64
+	MaxMoveBalanceGasPerShardCalled func() uint64
+	MaxSCExecutionGasPerShardCalled func() uint64
+}
+
+func (bglhs *BlockGasLimitsHandlerStub) MaxMoveBalanceGasPerBlock() uint64 {
+	return bglhs.MaxMoveBalanceGasPerBlockCalled()
+}
+
+func (bglhs *BlockGasLimitsHandlerStub) MaxSCExecutionGasPerBlock() uint64 {
+	return bglhs.MaxSCExecutionGasPerBlockCalled()
+}
+
+func (bglhs *BlockGasLimitsHandlerStub) MaxMoveBalanceGasPerShard() uint64 {
+	return bglhs.MaxMoveBalanceGasPerShardCalled()
+}
+
+func (bglhs *BlockGasLimitsHandlerStub) MaxSCExecutionGasPerShard() uint64 {
+	return bglhs.MaxSCExecutionGasPerShardCalled()
+}
+
+func (bglhs *BlockGasLimitsHandlerStub) IsInterfaceNil() bool {
+	if bglhs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type BlockTrackerStub struct {
+	AddTrackedHeaderCalled            func(header data.HeaderHandler, hash []byte)
+	AddCrossNotarizedHeaderCalled     func(shardId uint32, crossNotarizedHeader data.HeaderHandler, crossNotarizedHeaderHash []byte)
+	AddSelfNotarizedHeaderCalled      func(shardId uint32, selfNotarizedHeader data.HeaderHandler, selfNotarizedHeaderHash []byte)
+	GetLastCrossNotarizedHeaderCalled func(shardId uint32) (data.HeaderHandler, []byte, error)
+	GetLastSelfNotarizedHeaderCalled  func(shardId uint32) (data.HeaderHandler, []byte, error)
+	ComputeLongestChainCalled         func(shardId uint32, header data.HeaderHandler) ([]data.HeaderHandler, [][]byte)
+	CleanupHeadersBehindNonceCalled   func(shardId uint32, selfNotarizedNonce uint64, crossNotarizedNonce uint64)
+	RemoveLastNotarizedHeadersCalled  func()
+}
+
+func (bts *BlockTrackerStub) AddTrackedHeader(header data.HeaderHandler, hash []byte) {
+	if bts.AddTrackedHeaderCalled != nil {
+		bts.AddTrackedHeaderCalled(header, hash)
+	}
+}
+
+func (bts *BlockTrackerStub) AddCrossNotarizedHeader(shardId uint32, crossNotarizedHeader data.HeaderHandler, crossNotarizedHeaderHash []byte) {
+	if bts.AddCrossNotarizedHeaderCalled != nil {
+		bts.AddCrossNotarizedHeaderCalled(shardId, crossNotarizedHeader, crossNotarizedHeaderHash)
+	}
+}
+
+func (bts *BlockTrackerStub) AddSelfNotarizedHeader(shardId uint32, selfNotarizedHeader data.HeaderHandler, selfNotarizedHeaderHash []byte) {
+	if bts.AddSelfNotarizedHeaderCalled != nil {
+		bts.AddSelfNotarizedHeaderCalled(shardId, selfNotarizedHeader, selfNotarizedHeaderHash)
+	}
+}
+
+func (bts *BlockTrackerStub) GetLastCrossNotarizedHeader(shardId uint32) (data.HeaderHandler, []byte, error) {
+	if bts.GetLastCrossNotarizedHeaderCalled != nil {
+		return bts.GetLastCrossNotarizedHeaderCalled(shardId)
+	}
+	return nil, nil, errNotImplemented
+}
+
+func (bts *BlockTrackerStub) GetLastSelfNotarizedHeader(shardId uint32) (data.HeaderHandler, []byte, error) {
+	if bts.GetLastSelfNotarizedHeaderCalled != nil {
+		return bts.GetLastSelfNotarizedHeaderCalled(shardId)
+	}
+	return nil, nil, errNotImplemented
+}
+
+func (bts *BlockTrackerStub) ComputeLongestChain(shardId uint32, header data.HeaderHandler) ([]data.HeaderHandler, [][]byte) {
+	if bts.ComputeLongestChainCalled != nil {
+		return bts.ComputeLongestChainCalled(shardId, header)
+	}
+	return nil, nil
+}
+
+func (bts *BlockTrackerStub) CleanupHeadersBehindNonce(shardId uint32, selfNotarizedNonce uint64, crossNotarizedNonce uint64) {
+	if bts.CleanupHeadersBehindNonceCalled != nil {
+		bts.CleanupHeadersBehindNonceCalled(shardId, selfNotarizedNonce, crossNotarizedNonce)
+	}
+}
+
+func (bts *BlockTrackerStub) RemoveLastNotarizedHeaders() {
+	if bts.RemoveLastNotarizedHeadersCalled != nil {
+		bts.RemoveLastNotarizedHeadersCalled()
+	}
+}
+
+func (bts *BlockTrackerStub) IsInterfaceNil() bool {
+	if bts == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type BuiltInFunctionContainerStub struct {
+	GetCalled     func(key string) (process.BuiltInFunction, error)
+	AddCalled     func(key string, function process.BuiltInFunction) error
+	ReplaceCalled func(key string, function process.BuiltInFunction) error
+	RemoveCalled  func(key string)
+	LenCalled     func() int
+	KeysCalled    func() []string
+}
+
+func (bfcs *BuiltInFunctionContainerStub) Get(key string) (process.BuiltInFunction, error) {
+	if bfcs.GetCalled == nil {
+		return nil, process.ErrInvalidContainerKey
+	}
+	return bfcs.GetCalled(key)
+}
+
+func (bfcs *BuiltInFunctionContainerStub) Add(key string, function process.BuiltInFunction) error {
+	if bfcs.AddCalled == nil {
+		return nil
+	}
+	return bfcs.AddCalled(key, function)
+}
+
+func (bfcs *BuiltInFunctionContainerStub) Replace(key string, function process.BuiltInFunction) error {
+	if bfcs.ReplaceCalled == nil {
+		return nil
+	}
+	return bfcs.ReplaceCalled(key, function)
+}
+
+func (bfcs *BuiltInFunctionContainerStub) Remove(key string) {
+	if bfcs.RemoveCalled != nil {
+		bfcs.RemoveCalled(key)
+	}
+}
+
+func (bfcs *BuiltInFunctionContainerStub) Len() int {
+	if bfcs.LenCalled == nil {
+		return 0
+	}
+	return bfcs.LenCalled()
+}
+
+func (bfcs *BuiltInFunctionContainerStub) Keys() []string {
+	if bfcs.KeysCalled == nil {
+		return make([]string, 0)
+	}
+	return bfcs.KeysCalled()
+}
+
+func (bfcs *BuiltInFunctionContainerStub) IsInterfaceNil() bool {
+	if bfcs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+type BuiltInFunctionStub struct {
+	ProcessBuiltInFunctionCalled func(tx *transaction.Transaction, acntSnd, acntDst *state.Account, arguments [][]byte) error
+	IsCrossShardEnabledCalled    func() bool
+	ContractCallDataCalled       func(arguments [][]byte) string
+	GasCostCalled                func() uint64
+}
+
+func (bfs *BuiltInFunctionStub) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	arguments [][]byte,
+) error {
+	if bfs.ProcessBuiltInFunctionCalled == nil {
+		return nil
+	}
+	return bfs.ProcessBuiltInFunctionCalled(tx, acntSnd, acntDst, arguments)
+}
+
+func (bfs *BuiltInFunctionStub) IsCrossShardEnabled() bool {
+	if bfs.IsCrossShardEnabledCalled == nil {
+		return false
+	}
+	return bfs.IsCrossShardEnabledCalled()
+}
+
+func (bfs *BuiltInFunctionStub) ContractCallData(arguments [][]byte) string {
+	if bfs.ContractCallDataCalled == nil {
+		return ""
+	}
+	return bfs.ContractCallDataCalled(arguments)
+}
+
+func (bfs *BuiltInFunctionStub) GasCost() uint64 {
+	if bfs.GasCostCalled == nil {
+		return 0
+	}
+	return bfs.GasCostCalled()
+}
+
+func (bfs *BuiltInFunctionStub) IsInterfaceNil() bool {
+	if bfs == nil {
+		return true
+	}
+	return false
+}
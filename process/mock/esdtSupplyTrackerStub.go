@@ -0,0 +1,69 @@
+package mock
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+)
+
+type ESDTSupplyTrackerStub struct {
+	AddInitialSupplyCalled     func(tokenID []byte, value *big.Int)
+	AddMintedCalled            func(tokenID []byte, value *big.Int)
+	AddBurnedCalled            func(tokenID []byte, value *big.Int)
+	CommitPendingChangesCalled func(header data.HeaderHandler)
+	RevertPendingChangesCalled func()
+	GetTokenSupplyCalled       func(tokenID []byte) (*esdt.TokenSupply, error)
+	IsEnabledCalled            func() bool
+}
+
+func (ests *ESDTSupplyTrackerStub) AddInitialSupply(tokenID []byte, value *big.Int) {
+	if ests.AddInitialSupplyCalled != nil {
+		ests.AddInitialSupplyCalled(tokenID, value)
+	}
+}
+
+func (ests *ESDTSupplyTrackerStub) AddMinted(tokenID []byte, value *big.Int) {
+	if ests.AddMintedCalled != nil {
+		ests.AddMintedCalled(tokenID, value)
+	}
+}
+
+func (ests *ESDTSupplyTrackerStub) AddBurned(tokenID []byte, value *big.Int) {
+	if ests.AddBurnedCalled != nil {
+		ests.AddBurnedCalled(tokenID, value)
+	}
+}
+
+func (ests *ESDTSupplyTrackerStub) CommitPendingChanges(header data.HeaderHandler) {
+	if ests.CommitPendingChangesCalled != nil {
+		ests.CommitPendingChangesCalled(header)
+	}
+}
+
+func (ests *ESDTSupplyTrackerStub) RevertPendingChanges() {
+	if ests.RevertPendingChangesCalled != nil {
+		ests.RevertPendingChangesCalled()
+	}
+}
+
+func (ests *ESDTSupplyTrackerStub) GetTokenSupply(tokenID []byte) (*esdt.TokenSupply, error) {
+	if ests.GetTokenSupplyCalled != nil {
+		return ests.GetTokenSupplyCalled(tokenID)
+	}
+	return nil, nil
+}
+
+func (ests *ESDTSupplyTrackerStub) IsEnabled() bool {
+	if ests.IsEnabledCalled != nil {
+		return ests.IsEnabledCalled()
+	}
+	return false
+}
+
+func (ests *ESDTSupplyTrackerStub) IsInterfaceNil() bool {
+	if ests == nil {
+		return true
+	}
+	return false
+}
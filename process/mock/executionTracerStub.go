@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+type ExecutionTracerStub struct {
+	StartTracingCalled func()
+	StopTracingCalled  func() []*transaction.SimulationTraceStep
+}
+
+func (ets *ExecutionTracerStub) StartTracing() {
+	if ets.StartTracingCalled != nil {
+		ets.StartTracingCalled()
+	}
+}
+
+func (ets *ExecutionTracerStub) StopTracing() []*transaction.SimulationTraceStep {
+	if ets.StopTracingCalled != nil {
+		return ets.StopTracingCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ets *ExecutionTracerStub) IsInterfaceNil() bool {
+	if ets == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+type GasHandlerMock struct {
+	InitCalled                          func()
+	ComputeGasConsumedByTxCalled        func(tx data.TransactionHandler) (uint64, uint64)
+	ComputeGasConsumedByMiniBlockCalled func(miniBlock *block.MiniBlock, mapHashTx map[string]data.TransactionHandler) (uint64, uint64, error)
+	IsMaxGasLimitReachedCalled          func(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64) bool
+	AddGasConsumedCalled                func(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64)
+	GasConsumedCalled                   func() (uint64, uint64)
+}
+
+func (ghm *GasHandlerMock) Init() {
+	if ghm.InitCalled == nil {
+		return
+	}
+
+	ghm.InitCalled()
+}
+
+func (ghm *GasHandlerMock) ComputeGasConsumedByTx(tx data.TransactionHandler) (uint64, uint64) {
+	if ghm.ComputeGasConsumedByTxCalled == nil {
+		return 0, 0
+	}
+
+	return ghm.ComputeGasConsumedByTxCalled(tx)
+}
+
+func (ghm *GasHandlerMock) ComputeGasConsumedByMiniBlock(miniBlock *block.MiniBlock, mapHashTx map[string]data.TransactionHandler) (uint64, uint64, error) {
+	if ghm.ComputeGasConsumedByMiniBlockCalled == nil {
+		return 0, 0, nil
+	}
+
+	return ghm.ComputeGasConsumedByMiniBlockCalled(miniBlock, mapHashTx)
+}
+
+func (ghm *GasHandlerMock) IsMaxGasLimitReached(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64) bool {
+	if ghm.IsMaxGasLimitReachedCalled == nil {
+		return false
+	}
+
+	return ghm.IsMaxGasLimitReachedCalled(dstShardId, moveBalanceGas, scExecutionGas)
+}
+
+func (ghm *GasHandlerMock) AddGasConsumed(dstShardId uint32, moveBalanceGas uint64, scExecutionGas uint64) {
+	if ghm.AddGasConsumedCalled == nil {
+		return
+	}
+
+	ghm.AddGasConsumedCalled(dstShardId, moveBalanceGas, scExecutionGas)
+}
+
+func (ghm *GasHandlerMock) GasConsumed() (uint64, uint64) {
+	if ghm.GasConsumedCalled == nil {
+		return 0, 0
+	}
+
+	return ghm.GasConsumedCalled()
+}
+
+func (ghm *GasHandlerMock) IsInterfaceNil() bool {
+	if ghm == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+type GasScheduleNotifierMock struct {
+	GasSchedule map[string]map[string]uint64
+}
+
+func NewGasScheduleNotifierMock(gasSchedule map[string]map[string]uint64) *GasScheduleNotifierMock {
+	return &GasScheduleNotifierMock{
+		GasSchedule: gasSchedule,
+	}
+}
+
+func (gsnm *GasScheduleNotifierMock) RegisterNotifyHandler(handler core.GasScheduleSubscribeHandler) {
+	handler.GasScheduleChange(gsnm.GasSchedule)
+}
+
+func (gsnm *GasScheduleNotifierMock) LatestGasSchedule() map[string]map[string]uint64 {
+	return gsnm.GasSchedule
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gsnm *GasScheduleNotifierMock) IsInterfaceNil() bool {
+	if gsnm == nil {
+		return true
+	}
+	return false
+}
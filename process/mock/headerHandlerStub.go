@@ -58,6 +58,14 @@ func (hhs *HeaderHandlerStub) GetTxCount() uint32 {
 	panic("implement me")
 }
 
+func (hhs *HeaderHandlerStub) GetChainID() []byte {
+	panic("implement me")
+}
+
+func (hhs *HeaderHandlerStub) GetSoftwareVersion() []byte {
+	panic("implement me")
+}
+
 func (hhs *HeaderHandlerStub) SetNonce(n uint64) {
 	panic("implement me")
 }
@@ -102,6 +110,14 @@ func (hhs *HeaderHandlerStub) SetTxCount(txCount uint32) {
 	panic("implement me")
 }
 
+func (hhs *HeaderHandlerStub) SetChainID(chainID []byte) {
+	panic("implement me")
+}
+
+func (hhs *HeaderHandlerStub) SetSoftwareVersion(version []byte) {
+	panic("implement me")
+}
+
 func (hhs *HeaderHandlerStub) GetMiniBlockHeadersWithDst(destId uint32) map[string]uint32 {
 	return hhs.GetMiniBlockHeadersWithDstCalled(destId)
 }
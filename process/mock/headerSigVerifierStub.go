@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type HeaderSigVerifierStub struct {
+	VerifyRandSeedCalled  func(header data.HeaderHandler) error
+	VerifySignatureCalled func(header data.HeaderHandler) error
+}
+
+func (hsvs *HeaderSigVerifierStub) VerifyRandSeed(header data.HeaderHandler) error {
+	if hsvs.VerifyRandSeedCalled != nil {
+		return hsvs.VerifyRandSeedCalled(header)
+	}
+
+	return nil
+}
+
+func (hsvs *HeaderSigVerifierStub) VerifySignature(header data.HeaderHandler) error {
+	if hsvs.VerifySignatureCalled != nil {
+		return hsvs.VerifySignatureCalled(header)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hsvs *HeaderSigVerifierStub) IsInterfaceNil() bool {
+	if hsvs == nil {
+		return true
+	}
+	return false
+}
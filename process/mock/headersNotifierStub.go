@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type HeadersNotifierStub struct {
+	RegisterHandlerCalled func(handler func(header data.HeaderHandler, hash []byte))
+}
+
+func (hns *HeadersNotifierStub) RegisterHandler(handler func(header data.HeaderHandler, hash []byte)) {
+	if hns.RegisterHandlerCalled != nil {
+		hns.RegisterHandlerCalled(handler)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hns *HeadersNotifierStub) IsInterfaceNil() bool {
+	if hns == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type HistoryRepositoryStub struct {
+	RecordBlockCalled func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
+	IsEnabledCalled   func() bool
+}
+
+func (hrs *HistoryRepositoryStub) RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
+	if hrs.RecordBlockCalled != nil {
+		return hrs.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody)
+	}
+	return nil
+}
+
+func (hrs *HistoryRepositoryStub) IsEnabled() bool {
+	if hrs.IsEnabledCalled != nil {
+		return hrs.IsEnabledCalled()
+	}
+	return false
+}
+
+func (hrs *HistoryRepositoryStub) IsInterfaceNil() bool {
+	if hrs == nil {
+		return true
+	}
+	return false
+}
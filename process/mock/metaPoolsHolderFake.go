@@ -16,6 +16,7 @@ type MetaPoolsHolderFake struct {
 	headersNonces   dataRetriever.Uint64SyncMapCacher
 	transactions    dataRetriever.ShardedDataCacherNotifier
 	unsigned        dataRetriever.ShardedDataCacherNotifier
+	peerAuthentications storage.Cacher
 }
 
 func NewMetaPoolsHolderFake() *MetaPoolsHolderFake {
@@ -25,6 +26,7 @@ func NewMetaPoolsHolderFake() *MetaPoolsHolderFake {
 	mphf.unsigned, _ = shardedData.NewShardedData(storageUnit.CacheConfig{Size: 10000, Type: storageUnit.LRUCache})
 	mphf.metaBlocks, _ = storageUnit.NewCache(storageUnit.LRUCache, 10000, 1)
 	mphf.shardHeaders, _ = storageUnit.NewCache(storageUnit.LRUCache, 10000, 1)
+	mphf.peerAuthentications, _ = storageUnit.NewCache(storageUnit.LRUCache, 10000, 1)
 
 	cacheShardHdrNonces, _ := storageUnit.NewCache(storageUnit.LRUCache, 10000, 1)
 	mphf.headersNonces, _ = dataPool.NewNonceSyncMapCacher(
@@ -58,6 +60,10 @@ func (mphf *MetaPoolsHolderFake) HeadersNonces() dataRetriever.Uint64SyncMapCach
 	return mphf.headersNonces
 }
 
+func (mphf *MetaPoolsHolderFake) PeerAuthentications() storage.Cacher {
+	return mphf.peerAuthentications
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (mphf *MetaPoolsHolderFake) IsInterfaceNil() bool {
 	if mphf == nil {
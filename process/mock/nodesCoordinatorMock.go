@@ -21,6 +21,7 @@ type NodesCoordinatorMock struct {
 	LoadNodesPerShardsCalled            func(nodes map[uint32][]sharding.Validator) error
 	ComputeValidatorsGroupCalled        func(randomness []byte, round uint64, shardId uint32) (validatorsGroup []sharding.Validator, err error)
 	GetValidatorWithPublicKeyCalled     func(publicKey []byte) (validator sharding.Validator, shardId uint32, err error)
+	GetAllValidatorsPublicKeysCalled    func() map[uint32][][]byte
 }
 
 func NewNodesCoordinatorMock() *NodesCoordinatorMock {
@@ -63,6 +64,10 @@ func NewNodesCoordinatorMock() *NodesCoordinatorMock {
 }
 
 func (ncm *NodesCoordinatorMock) GetAllValidatorsPublicKeys() map[uint32][][]byte {
+	if ncm.GetAllValidatorsPublicKeysCalled != nil {
+		return ncm.GetAllValidatorsPublicKeysCalled()
+	}
+
 	return nil
 }
 
@@ -133,6 +138,18 @@ func (ncm *NodesCoordinatorMock) GetValidatorsRewardsAddresses(
 	return addresses, nil
 }
 
+func (ncm *NodesCoordinatorMock) ConsensusGroupSize(shardId uint32) int {
+	if shardId == sharding.MetachainShardId {
+		return int(ncm.MetaConsensusSize)
+	}
+
+	return int(ncm.ShardConsensusSize)
+}
+
+func (ncm *NodesCoordinatorMock) ConsensusThreshold(shardId uint32) int {
+	return ncm.ConsensusGroupSize(shardId)*2/3 + 1
+}
+
 func (ncm *NodesCoordinatorMock) SetNodesPerShards(nodes map[uint32][]sharding.Validator) error {
 	if ncm.LoadNodesPerShardsCalled != nil {
 		return ncm.LoadNodesPerShardsCalled(nodes)
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
+)
+
+type PeerAuthenticationVerifierStub struct {
+	VerifyCalled func(peerAuth *heartbeat.PeerAuthentication) error
+}
+
+func (pavs *PeerAuthenticationVerifierStub) Verify(peerAuth *heartbeat.PeerAuthentication) error {
+	if pavs.VerifyCalled != nil {
+		return pavs.VerifyCalled(peerAuth)
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pavs *PeerAuthenticationVerifierStub) IsInterfaceNil() bool {
+	if pavs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type PeerDisconnectorStub struct {
+	ClosePeerCalled func(pid p2p.PeerID) error
+}
+
+func (pds *PeerDisconnectorStub) ClosePeer(pid p2p.PeerID) error {
+	return pds.ClosePeerCalled(pid)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pds *PeerDisconnectorStub) IsInterfaceNil() bool {
+	if pds == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+type PendingMiniBlocksHandlerStub struct {
+	AddCommittedHeadersCalled    func(metaNonce uint64, shardHdrs []*block.Header)
+	RevertHeadersCalled          func(metaNonce uint64)
+	OverdueMiniBlockHashesCalled func(receiverShardId uint32) [][]byte
+	PendingMiniBlocksCalled      func() []*block.PendingMiniBlockInfo
+}
+
+func (p *PendingMiniBlocksHandlerStub) AddCommittedHeaders(metaNonce uint64, shardHdrs []*block.Header) {
+	if p.AddCommittedHeadersCalled != nil {
+		p.AddCommittedHeadersCalled(metaNonce, shardHdrs)
+	}
+}
+
+func (p *PendingMiniBlocksHandlerStub) RevertHeaders(metaNonce uint64) {
+	if p.RevertHeadersCalled != nil {
+		p.RevertHeadersCalled(metaNonce)
+	}
+}
+
+func (p *PendingMiniBlocksHandlerStub) OverdueMiniBlockHashes(receiverShardId uint32) [][]byte {
+	if p.OverdueMiniBlockHashesCalled != nil {
+		return p.OverdueMiniBlockHashesCalled(receiverShardId)
+	}
+	return nil
+}
+
+func (p *PendingMiniBlocksHandlerStub) PendingMiniBlocks() []*block.PendingMiniBlockInfo {
+	if p.PendingMiniBlocksCalled != nil {
+		return p.PendingMiniBlocksCalled()
+	}
+	return nil
+}
+
+func (p *PendingMiniBlocksHandlerStub) IsInterfaceNil() bool {
+	if p == nil {
+		return true
+	}
+	return false
+}
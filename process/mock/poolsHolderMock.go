@@ -19,6 +19,7 @@ type PoolsHolderMock struct {
 	miniBlocks           storage.Cacher
 	peerChangesBlocks    storage.Cacher
 	metaHdrNonces        dataRetriever.Uint64SyncMapCacher
+	peerAuthentications  storage.Cacher
 }
 
 func NewPoolsHolderMock() *PoolsHolderMock {
@@ -40,6 +41,7 @@ func NewPoolsHolderMock() *PoolsHolderMock {
 	)
 	phf.miniBlocks, _ = storageUnit.NewCache(storageUnit.LRUCache, 10000, 1)
 	phf.peerChangesBlocks, _ = storageUnit.NewCache(storageUnit.LRUCache, 10000, 1)
+	phf.peerAuthentications, _ = storageUnit.NewCache(storageUnit.LRUCache, 10000, 1)
 	return phf
 }
 
@@ -75,6 +77,10 @@ func (phm *PoolsHolderMock) MetaBlocks() storage.Cacher {
 	return phm.metaBlocks
 }
 
+func (phm *PoolsHolderMock) PeerAuthentications() storage.Cacher {
+	return phm.peerAuthentications
+}
+
 func (phm *PoolsHolderMock) MetaHeadersNonces() dataRetriever.Uint64SyncMapCacher {
 	return phm.metaHdrNonces
 }
@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+type PrivateKeyStub struct {
+	ToByteArrayHandler    func() ([]byte, error)
+	GeneratePublicHandler func() crypto.PublicKey
+	SuiteHandler          func() crypto.Suite
+	ScalarHandler         func() crypto.Scalar
+}
+
+func (sk *PrivateKeyStub) ToByteArray() ([]byte, error) {
+	return sk.ToByteArrayHandler()
+}
+
+func (sk *PrivateKeyStub) GeneratePublic() crypto.PublicKey {
+	return sk.GeneratePublicHandler()
+}
+
+func (sk *PrivateKeyStub) Suite() crypto.Suite {
+	return sk.SuiteHandler()
+}
+
+func (sk *PrivateKeyStub) Scalar() crypto.Scalar {
+	return sk.ScalarHandler()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sk *PrivateKeyStub) IsInterfaceNil() bool {
+	if sk == nil {
+		return true
+	}
+	return false
+}
@@ -1,12 +1,18 @@
 package mock
 
-import "math/big"
+import (
+	"math"
+	"math/big"
+)
 
 type RewardsHandlerMock struct {
 	RewardsValueCalled        func() *big.Int
 	CommunityPercentageCalled func() float64
 	LeaderPercentageCalled    func() float64
 	BurnPercentageCalled      func() float64
+
+	ProtocolSustainabilityPercentageCalled func() float64
+	FeeBurnEnableEpochCalled               func() uint32
 }
 
 func (rhm *RewardsHandlerMock) RewardsValue() *big.Int {
@@ -25,6 +31,20 @@ func (rhm *RewardsHandlerMock) BurnPercentage() float64 {
 	return rhm.BurnPercentageCalled()
 }
 
+func (rhm *RewardsHandlerMock) ProtocolSustainabilityPercentage() float64 {
+	if rhm.ProtocolSustainabilityPercentageCalled == nil {
+		return 0
+	}
+	return rhm.ProtocolSustainabilityPercentageCalled()
+}
+
+func (rhm *RewardsHandlerMock) FeeBurnEnableEpoch() uint32 {
+	if rhm.FeeBurnEnableEpochCalled == nil {
+		return math.MaxUint32
+	}
+	return rhm.FeeBurnEnableEpochCalled()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rhm *RewardsHandlerMock) IsInterfaceNil() bool {
 	if rhm == nil {
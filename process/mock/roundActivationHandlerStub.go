@@ -0,0 +1,30 @@
+package mock
+
+type RoundActivationHandlerStub struct {
+	IsEnabledCalled        func(name string) bool
+	IsEnabledInRoundCalled func(name string, round uint64) bool
+}
+
+func (rahs *RoundActivationHandlerStub) IsEnabled(name string) bool {
+	if rahs.IsEnabledCalled != nil {
+		return rahs.IsEnabledCalled(name)
+	}
+
+	return false
+}
+
+func (rahs *RoundActivationHandlerStub) IsEnabledInRound(name string, round uint64) bool {
+	if rahs.IsEnabledInRoundCalled != nil {
+		return rahs.IsEnabledInRoundCalled(name, round)
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rahs *RoundActivationHandlerStub) IsInterfaceNil() bool {
+	if rahs == nil {
+		return true
+	}
+	return false
+}
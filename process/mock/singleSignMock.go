@@ -10,8 +10,9 @@ type SingleSignKeyGenMock struct {
 }
 
 type SingleSignPublicKey struct {
-	SuiteCalled func() crypto.Suite
-	PointCalled func() crypto.Point
+	ToByteArrayCalled func() ([]byte, error)
+	SuiteCalled       func() crypto.Suite
+	PointCalled       func() crypto.Point
 }
 
 //------- SingleSignKeyGenMock
@@ -43,7 +44,7 @@ func (sskgm *SingleSignKeyGenMock) IsInterfaceNil() bool {
 //------- SingleSignPublicKey
 
 func (sspk *SingleSignPublicKey) ToByteArray() ([]byte, error) {
-	panic("implement me")
+	return sspk.ToByteArrayCalled()
 }
 
 func (sspk *SingleSignPublicKey) Suite() crypto.Suite {
@@ -0,0 +1,47 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
+)
+
+type SupplyAccountantStub struct {
+	AccountCommittedBlockCalled func(header data.HeaderHandler, rewardTxs map[string]data.TransactionHandler)
+	CurrentSupplyCalled         func() (*supply.ShardSupply, error)
+	EpochSupplyCalled           func(epoch uint32) (*supply.ShardSupply, error)
+	IsEnabledCalled             func() bool
+}
+
+func (sas *SupplyAccountantStub) AccountCommittedBlock(header data.HeaderHandler, rewardTxs map[string]data.TransactionHandler) {
+	if sas.AccountCommittedBlockCalled != nil {
+		sas.AccountCommittedBlockCalled(header, rewardTxs)
+	}
+}
+
+func (sas *SupplyAccountantStub) CurrentSupply() (*supply.ShardSupply, error) {
+	if sas.CurrentSupplyCalled != nil {
+		return sas.CurrentSupplyCalled()
+	}
+	return nil, nil
+}
+
+func (sas *SupplyAccountantStub) EpochSupply(epoch uint32) (*supply.ShardSupply, error) {
+	if sas.EpochSupplyCalled != nil {
+		return sas.EpochSupplyCalled(epoch)
+	}
+	return nil, nil
+}
+
+func (sas *SupplyAccountantStub) IsEnabled() bool {
+	if sas.IsEnabledCalled != nil {
+		return sas.IsEnabledCalled()
+	}
+	return false
+}
+
+func (sas *SupplyAccountantStub) IsInterfaceNil() bool {
+	if sas == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type TrieStatisticsStub struct {
+	BlockCommittedCalled func(header data.HeaderHandler)
+}
+
+func (tss *TrieStatisticsStub) BlockCommitted(header data.HeaderHandler) {
+	if tss.BlockCommittedCalled != nil {
+		tss.BlockCommittedCalled(header)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tss *TrieStatisticsStub) IsInterfaceNil() bool {
+	if tss == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,7 @@
+package outgoingOperations
+
+// MessageBroadcaster defines the functionality needed to broadcast the outgoing operations signatures
+type MessageBroadcaster interface {
+	Broadcast(topic string, buff []byte)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,38 @@
+package outgoingOperations
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// nilOutgoingOperationsHandler is used when the outgoing operations are disabled: the committed blocks are ignored
+type nilOutgoingOperationsHandler struct {
+}
+
+// NewNilOutgoingOperationsHandler creates a new outgoing operations handler which does not collect anything
+func NewNilOutgoingOperationsHandler() *nilOutgoingOperationsHandler {
+	return &nilOutgoingOperationsHandler{}
+}
+
+// ProcessCommittedBlock does nothing
+func (nooh *nilOutgoingOperationsHandler) ProcessCommittedBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// GetOutgoingOperationsBatches returns ErrOutgoingOperationsDisabled
+func (nooh *nilOutgoingOperationsHandler) GetOutgoingOperationsBatches() ([]*bridge.SignedOutgoingOperationsBatch, error) {
+	return nil, process.ErrOutgoingOperationsDisabled
+}
+
+// IsEnabled returns false as nothing is collected
+func (nooh *nilOutgoingOperationsHandler) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nooh *nilOutgoingOperationsHandler) IsInterfaceNil() bool {
+	if nooh == nil {
+		return true
+	}
+	return false
+}
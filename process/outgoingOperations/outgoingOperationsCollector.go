@@ -0,0 +1,425 @@
+package outgoingOperations
+
+import (
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("process/outgoingOperations")
+
+// ArgOutgoingOperationsCollector holds all dependencies required by the outgoing operations collector in order to
+// create a new instance
+type ArgOutgoingOperationsCollector struct {
+	Subscriptions    []config.OutgoingOperationSubscription
+	MaxBatches       int
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	Store            dataRetriever.StorageService
+	ShardCoordinator sharding.Coordinator
+	NodesCoordinator sharding.NodesCoordinator
+	KeyGen           crypto.KeyGenerator
+	SingleSigner     crypto.SingleSigner
+	PrivateKey       crypto.PrivateKey
+	PublicKey        crypto.PublicKey
+	Messenger        MessageBroadcaster
+}
+
+// outgoingOperationsCollector gathers, for each committed block, the transactions matching the subscriptions in an
+// outgoing operations batch. The validators of the shard sign the hash of each batch and broadcast their signatures,
+// which are collected until they reach the consensus threshold, so an external relayer can carry the confirmed
+// batches to the other chain
+type outgoingOperationsCollector struct {
+	subscriptions    map[string]map[string]struct{}
+	maxBatches       int
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	store            dataRetriever.StorageService
+	shardCoordinator sharding.Coordinator
+	nodesCoordinator sharding.NodesCoordinator
+	keyGen           crypto.KeyGenerator
+	singleSigner     crypto.SingleSigner
+	privateKey       crypto.PrivateKey
+	publicKey        []byte
+	messenger        MessageBroadcaster
+	topic            string
+
+	mutBatches        sync.RWMutex
+	batches           map[string]*bridge.OutgoingOperationsBatch
+	batchOrder        []string
+	signatures        map[string]map[string][]byte
+	pendingSignatures map[string]map[string][]byte
+	pendingOrder      []string
+}
+
+// NewOutgoingOperationsCollector creates a new outgoing operations collector
+func NewOutgoingOperationsCollector(args ArgOutgoingOperationsCollector) (*outgoingOperationsCollector, error) {
+	if args.MaxBatches < 1 {
+		return nil, process.ErrInvalidMaxOutgoingOperationsBatches
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.Store) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.KeyGen) {
+		return nil, process.ErrNilKeyGen
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, process.ErrNilSingleSigner
+	}
+	if check.IfNil(args.PrivateKey) {
+		return nil, process.ErrNilPrivateKey
+	}
+	if check.IfNil(args.PublicKey) {
+		return nil, process.ErrNilPublicKey
+	}
+	if check.IfNil(args.Messenger) {
+		return nil, process.ErrNilMessenger
+	}
+
+	subscriptions, err := createSubscriptions(args.Subscriptions)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := args.PublicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	selfId := args.ShardCoordinator.SelfId()
+	return &outgoingOperationsCollector{
+		subscriptions:     subscriptions,
+		maxBatches:        args.MaxBatches,
+		marshalizer:       args.Marshalizer,
+		hasher:            args.Hasher,
+		store:             args.Store,
+		shardCoordinator:  args.ShardCoordinator,
+		nodesCoordinator:  args.NodesCoordinator,
+		keyGen:            args.KeyGen,
+		singleSigner:      args.SingleSigner,
+		privateKey:        args.PrivateKey,
+		publicKey:         publicKey,
+		messenger:         args.Messenger,
+		topic:             Topic(args.ShardCoordinator, selfId),
+		batches:           make(map[string]*bridge.OutgoingOperationsBatch),
+		batchOrder:        make([]string, 0),
+		signatures:        make(map[string]map[string][]byte),
+		pendingSignatures: make(map[string]map[string][]byte),
+		pendingOrder:      make([]string, 0),
+	}, nil
+}
+
+// Topic returns the topic on which the validators of the provided shard broadcast their outgoing operations signatures
+func Topic(shardCoordinator sharding.Coordinator, shardId uint32) string {
+	return factory.OutgoingOperationsTopic + shardCoordinator.CommunicationIdentifier(shardId)
+}
+
+func createSubscriptions(configSubscriptions []config.OutgoingOperationSubscription) (map[string]map[string]struct{}, error) {
+	subscriptions := make(map[string]map[string]struct{})
+	for _, subscription := range configSubscriptions {
+		address, err := hex.DecodeString(subscription.Address)
+		if err != nil || len(address) == 0 || len(subscription.Identifiers) == 0 {
+			return nil, process.ErrInvalidOutgoingOperationSubscription
+		}
+
+		identifiers, ok := subscriptions[string(address)]
+		if !ok {
+			identifiers = make(map[string]struct{})
+			subscriptions[string(address)] = identifiers
+		}
+		for _, identifier := range subscription.Identifiers {
+			if len(identifier) == 0 {
+				return nil, process.ErrInvalidOutgoingOperationSubscription
+			}
+			identifiers[identifier] = struct{}{}
+		}
+	}
+
+	return subscriptions, nil
+}
+
+// ProcessCommittedBlock collects the outgoing operations of the committed block and, if the node is a validator of
+// the shard, signs the resulting batch and broadcasts the signature
+func (ooc *outgoingOperationsCollector) ProcessCommittedBlock(
+	headerHash []byte,
+	header data.HeaderHandler,
+	body data.BodyHandler,
+) {
+	if check.IfNil(header) || check.IfNil(body) {
+		return
+	}
+
+	operations := ooc.collectOperations(body)
+	if len(operations) == 0 {
+		return
+	}
+
+	batch := &bridge.OutgoingOperationsBatch{
+		ShardID:    ooc.shardCoordinator.SelfId(),
+		BlockNonce: header.GetNonce(),
+		BlockHash:  headerHash,
+		Operations: operations,
+	}
+	batchHash, err := core.CalculateHash(ooc.marshalizer, ooc.hasher, batch)
+	if err != nil {
+		log.Debug("could not compute the outgoing operations batch hash", "error", err.Error())
+		return
+	}
+
+	ooc.addBatch(string(batchHash), batch)
+
+	log.Debug("outgoing operations collected", "block nonce", batch.BlockNonce, "operations", len(operations))
+
+	ooc.signAndBroadcast(batchHash)
+}
+
+func (ooc *outgoingOperationsCollector) collectOperations(body data.BodyHandler) []*bridge.OutgoingOperation {
+	blockBody, ok := body.(block.Body)
+	if !ok {
+		return nil
+	}
+
+	operations := make([]*bridge.OutgoingOperation, 0)
+	for _, miniBlock := range blockBody {
+		if miniBlock.Type != block.TxBlock || miniBlock.ReceiverShardID != ooc.shardCoordinator.SelfId() {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, err := ooc.getTransaction(txHash)
+			if err != nil {
+				log.Debug("could not get the committed transaction", "hash", txHash, "error", err.Error())
+				continue
+			}
+
+			if ooc.isOutgoingOperation(tx) {
+				operations = append(operations, &bridge.OutgoingOperation{
+					TxHash: txHash,
+					Data:   []byte(tx.Data),
+				})
+			}
+		}
+	}
+
+	return operations
+}
+
+func (ooc *outgoingOperationsCollector) getTransaction(txHash []byte) (*transaction.Transaction, error) {
+	buff, err := ooc.store.Get(dataRetriever.TransactionUnit, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.Transaction{}
+	err = ooc.marshalizer.Unmarshal(tx, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (ooc *outgoingOperationsCollector) isOutgoingOperation(tx *transaction.Transaction) bool {
+	identifiers, ok := ooc.subscriptions[string(tx.RcvAddr)]
+	if !ok {
+		return false
+	}
+
+	function := strings.Split(tx.Data, "@")[0]
+	_, ok = identifiers[function]
+
+	return ok
+}
+
+func (ooc *outgoingOperationsCollector) signAndBroadcast(batchHash []byte) {
+	_, shardId, err := ooc.nodesCoordinator.GetValidatorWithPublicKey(ooc.publicKey)
+	if err != nil || shardId != ooc.shardCoordinator.SelfId() {
+		return
+	}
+
+	signature, err := ooc.singleSigner.Sign(ooc.privateKey, batchHash)
+	if err != nil {
+		log.Debug("could not sign the outgoing operations batch", "error", err.Error())
+		return
+	}
+
+	outgoingSignature := &bridge.OutgoingOperationsSignature{
+		BatchHash: batchHash,
+		PubKey:    ooc.publicKey,
+		Signature: signature,
+	}
+	buff, err := ooc.marshalizer.Marshal(outgoingSignature)
+	if err != nil {
+		log.Debug("could not marshal the outgoing operations signature", "error", err.Error())
+		return
+	}
+
+	ooc.addSignature(outgoingSignature)
+	ooc.messenger.Broadcast(ooc.topic, buff)
+}
+
+// ProcessReceivedMessage verifies and stores an outgoing operations signature received from a validator of the shard
+func (ooc *outgoingOperationsCollector) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+	if check.IfNil(message) {
+		return process.ErrNilMessage
+	}
+
+	outgoingSignature := &bridge.OutgoingOperationsSignature{}
+	err := ooc.marshalizer.Unmarshal(outgoingSignature, message.Data())
+	if err != nil {
+		return err
+	}
+
+	_, shardId, err := ooc.nodesCoordinator.GetValidatorWithPublicKey(outgoingSignature.PubKey)
+	if err != nil || shardId != ooc.shardCoordinator.SelfId() {
+		return process.ErrSignerNotEligible
+	}
+
+	publicKey, err := ooc.keyGen.PublicKeyFromByteArray(outgoingSignature.PubKey)
+	if err != nil {
+		return err
+	}
+
+	err = ooc.singleSigner.Verify(publicKey, outgoingSignature.BatchHash, outgoingSignature.Signature)
+	if err != nil {
+		return err
+	}
+
+	ooc.addSignature(outgoingSignature)
+
+	return nil
+}
+
+// addBatch stores the batch together with the signatures received before the batch was committed locally. The
+// oldest batch is evicted when the limit is reached
+func (ooc *outgoingOperationsCollector) addBatch(batchHash string, batch *bridge.OutgoingOperationsBatch) {
+	ooc.mutBatches.Lock()
+	defer ooc.mutBatches.Unlock()
+
+	_, ok := ooc.batches[batchHash]
+	if ok {
+		return
+	}
+
+	ooc.batches[batchHash] = batch
+	ooc.batchOrder = append(ooc.batchOrder, batchHash)
+	ooc.signatures[batchHash] = make(map[string][]byte)
+	for pubKey, signature := range ooc.pendingSignatures[batchHash] {
+		ooc.signatures[batchHash][pubKey] = signature
+	}
+	delete(ooc.pendingSignatures, batchHash)
+
+	if len(ooc.batchOrder) > ooc.maxBatches {
+		oldest := ooc.batchOrder[0]
+		ooc.batchOrder = ooc.batchOrder[1:]
+		delete(ooc.batches, oldest)
+		delete(ooc.signatures, oldest)
+	}
+}
+
+// addSignature stores the signature. The signatures may arrive before the batch is committed locally, so the ones
+// for unknown batches are kept apart, under their own limit, so they can not evict the collected batches
+func (ooc *outgoingOperationsCollector) addSignature(outgoingSignature *bridge.OutgoingOperationsSignature) {
+	ooc.mutBatches.Lock()
+	defer ooc.mutBatches.Unlock()
+
+	batchHash := string(outgoingSignature.BatchHash)
+	signatures, ok := ooc.signatures[batchHash]
+	if !ok {
+		signatures = ooc.getOrCreatePendingSignatures(batchHash)
+	}
+	signatures[string(outgoingSignature.PubKey)] = outgoingSignature.Signature
+}
+
+// getOrCreatePendingSignatures has to be called under the batches mutex
+func (ooc *outgoingOperationsCollector) getOrCreatePendingSignatures(batchHash string) map[string][]byte {
+	signatures, ok := ooc.pendingSignatures[batchHash]
+	if ok {
+		return signatures
+	}
+
+	signatures = make(map[string][]byte)
+	ooc.pendingSignatures[batchHash] = signatures
+	ooc.pendingOrder = append(ooc.pendingOrder, batchHash)
+
+	for len(ooc.pendingOrder) > ooc.maxBatches {
+		oldest := ooc.pendingOrder[0]
+		ooc.pendingOrder = ooc.pendingOrder[1:]
+		delete(ooc.pendingSignatures, oldest)
+	}
+
+	return signatures
+}
+
+// GetOutgoingOperationsBatches returns the collected batches, from the oldest to the newest, together with their
+// signatures
+func (ooc *outgoingOperationsCollector) GetOutgoingOperationsBatches() ([]*bridge.SignedOutgoingOperationsBatch, error) {
+	threshold := ooc.nodesCoordinator.ConsensusThreshold(ooc.shardCoordinator.SelfId())
+
+	ooc.mutBatches.RLock()
+	defer ooc.mutBatches.RUnlock()
+
+	signedBatches := make([]*bridge.SignedOutgoingOperationsBatch, 0, len(ooc.batches))
+	for _, batchHash := range ooc.batchOrder {
+		batch := ooc.batches[batchHash]
+		signatures := make([]*bridge.OutgoingOperationsSignature, 0, len(ooc.signatures[batchHash]))
+		for pubKey, signature := range ooc.signatures[batchHash] {
+			signatures = append(signatures, &bridge.OutgoingOperationsSignature{
+				BatchHash: []byte(batchHash),
+				PubKey:    []byte(pubKey),
+				Signature: signature,
+			})
+		}
+
+		signedBatches = append(signedBatches, &bridge.SignedOutgoingOperationsBatch{
+			Hash:       []byte(batchHash),
+			Batch:      batch,
+			Signatures: signatures,
+			Confirmed:  len(signatures) >= threshold,
+		})
+	}
+
+	return signedBatches, nil
+}
+
+// IsEnabled returns true as the outgoing operations are collected
+func (ooc *outgoingOperationsCollector) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ooc *outgoingOperationsCollector) IsInterfaceNil() bool {
+	if ooc == nil {
+		return true
+	}
+	return false
+}
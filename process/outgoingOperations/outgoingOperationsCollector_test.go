@@ -0,0 +1,400 @@
+package outgoingOperations_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/bridge"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/outgoingOperations"
+	"github.com/stretchr/testify/assert"
+)
+
+var bridgeAddress = []byte("bridge address")
+
+func createMockArgOutgoingOperationsCollector(txs map[string]*transaction.Transaction) outgoingOperations.ArgOutgoingOperationsCollector {
+	marshalizer := &mock.MarshalizerMock{}
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	nodesCoordinator.ShardConsensusSize = 2
+
+	return outgoingOperations.ArgOutgoingOperationsCollector{
+		Subscriptions: []config.OutgoingOperationSubscription{
+			{
+				Address:     hex.EncodeToString(bridgeAddress),
+				Identifiers: []string{"burn"},
+			},
+		},
+		MaxBatches:  10,
+		Marshalizer: marshalizer,
+		Hasher:      mock.HasherMock{},
+		Store: &mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				tx, ok := txs[string(key)]
+				if !ok {
+					return nil, errors.New("tx not found")
+				}
+				return marshalizer.Marshal(tx)
+			},
+		},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		NodesCoordinator: nodesCoordinator,
+		KeyGen: &mock.SingleSignKeyGenMock{
+			PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.SingleSignPublicKey{}, nil
+			},
+		},
+		SingleSigner: &mock.SignerMock{
+			SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				return []byte("signature"), nil
+			},
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				return nil
+			},
+		},
+		PrivateKey: &mock.PrivateKeyStub{},
+		PublicKey: &mock.SingleSignPublicKey{
+			ToByteArrayCalled: func() ([]byte, error) {
+				return []byte("pubKey00"), nil
+			},
+		},
+		Messenger: &mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {},
+		},
+	}
+}
+
+func createBlockWithTxs(txs map[string]*transaction.Transaction) block.Body {
+	miniBlock := &block.MiniBlock{
+		Type:            block.TxBlock,
+		ReceiverShardID: 0,
+	}
+	for txHash := range txs {
+		miniBlock.TxHashes = append(miniBlock.TxHashes, []byte(txHash))
+	}
+
+	return block.Body{miniBlock}
+}
+
+func createSignatureMessage(marshalizer *mock.MarshalizerMock, batchHash []byte, pubKey []byte) *mock.P2PMessageMock {
+	buff, _ := marshalizer.Marshal(&bridge.OutgoingOperationsSignature{
+		BatchHash: batchHash,
+		PubKey:    pubKey,
+		Signature: []byte("signature"),
+	})
+
+	return &mock.P2PMessageMock{DataField: buff}
+}
+
+//------- NewOutgoingOperationsCollector
+
+func TestNewOutgoingOperationsCollector_InvalidMaxBatchesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgOutgoingOperationsCollector(nil)
+	args.MaxBatches = 0
+	ooc, err := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	assert.Nil(t, ooc)
+	assert.Equal(t, process.ErrInvalidMaxOutgoingOperationsBatches, err)
+}
+
+func TestNewOutgoingOperationsCollector_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgOutgoingOperationsCollector(nil)
+	args.Marshalizer = nil
+	ooc, err := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	assert.Nil(t, ooc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewOutgoingOperationsCollector_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgOutgoingOperationsCollector(nil)
+	args.Store = nil
+	ooc, err := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	assert.Nil(t, ooc)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewOutgoingOperationsCollector_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgOutgoingOperationsCollector(nil)
+	args.NodesCoordinator = nil
+	ooc, err := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	assert.Nil(t, ooc)
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+}
+
+func TestNewOutgoingOperationsCollector_NilPrivateKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgOutgoingOperationsCollector(nil)
+	args.PrivateKey = nil
+	ooc, err := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	assert.Nil(t, ooc)
+	assert.Equal(t, process.ErrNilPrivateKey, err)
+}
+
+func TestNewOutgoingOperationsCollector_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgOutgoingOperationsCollector(nil)
+	args.Messenger = nil
+	ooc, err := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	assert.Nil(t, ooc)
+	assert.Equal(t, process.ErrNilMessenger, err)
+}
+
+func TestNewOutgoingOperationsCollector_InvalidSubscriptionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	invalidSubscriptions := []config.OutgoingOperationSubscription{
+		{Address: "not hex", Identifiers: []string{"burn"}},
+		{Address: "", Identifiers: []string{"burn"}},
+		{Address: hex.EncodeToString(bridgeAddress), Identifiers: nil},
+		{Address: hex.EncodeToString(bridgeAddress), Identifiers: []string{""}},
+	}
+	for _, subscription := range invalidSubscriptions {
+		args := createMockArgOutgoingOperationsCollector(nil)
+		args.Subscriptions = []config.OutgoingOperationSubscription{subscription}
+		ooc, err := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+		assert.Nil(t, ooc)
+		assert.Equal(t, process.ErrInvalidOutgoingOperationSubscription, err)
+	}
+}
+
+func TestNewOutgoingOperationsCollector_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ooc, err := outgoingOperations.NewOutgoingOperationsCollector(createMockArgOutgoingOperationsCollector(nil))
+
+	assert.Nil(t, err)
+	assert.NotNil(t, ooc)
+	assert.True(t, ooc.IsEnabled())
+}
+
+//------- ProcessCommittedBlock
+
+func TestOutgoingOperationsCollector_ProcessCommittedBlockShouldCollectTheSubscribedOperations(t *testing.T) {
+	t.Parallel()
+
+	txs := map[string]*transaction.Transaction{
+		"tx burn":      {RcvAddr: bridgeAddress, Data: "burn@01"},
+		"tx other":     {RcvAddr: bridgeAddress, Data: "mint@01"},
+		"tx elsewhere": {RcvAddr: []byte("other address"), Data: "burn@01"},
+	}
+	args := createMockArgOutgoingOperationsCollector(txs)
+	var broadcastTopic string
+	var broadcastBuff []byte
+	args.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastTopic = topic
+			broadcastBuff = buff
+		},
+	}
+	ooc, _ := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	ooc.ProcessCommittedBlock([]byte("block hash"), &block.Header{Nonce: 37}, createBlockWithTxs(txs))
+
+	batches, err := ooc.GetOutgoingOperationsBatches()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(batches))
+	assert.Equal(t, uint64(37), batches[0].Batch.BlockNonce)
+	assert.Equal(t, []byte("block hash"), batches[0].Batch.BlockHash)
+	assert.Equal(t, []*bridge.OutgoingOperation{{TxHash: []byte("tx burn"), Data: []byte("burn@01")}}, batches[0].Batch.Operations)
+	assert.Equal(t, 1, len(batches[0].Signatures))
+	assert.False(t, batches[0].Confirmed)
+
+	assert.Equal(t, outgoingOperations.Topic(args.ShardCoordinator, 0), broadcastTopic)
+	outgoingSignature := &bridge.OutgoingOperationsSignature{}
+	_ = args.Marshalizer.Unmarshal(outgoingSignature, broadcastBuff)
+	assert.Equal(t, batches[0].Hash, outgoingSignature.BatchHash)
+	assert.Equal(t, []byte("pubKey00"), outgoingSignature.PubKey)
+}
+
+func TestOutgoingOperationsCollector_ProcessCommittedBlockWithoutOperationsShouldNotCreateBatch(t *testing.T) {
+	t.Parallel()
+
+	txs := map[string]*transaction.Transaction{
+		"tx other": {RcvAddr: bridgeAddress, Data: "mint@01"},
+	}
+	ooc, _ := outgoingOperations.NewOutgoingOperationsCollector(createMockArgOutgoingOperationsCollector(txs))
+
+	ooc.ProcessCommittedBlock([]byte("block hash"), &block.Header{Nonce: 37}, createBlockWithTxs(txs))
+
+	batches, _ := ooc.GetOutgoingOperationsBatches()
+	assert.Equal(t, 0, len(batches))
+}
+
+func TestOutgoingOperationsCollector_ProcessCommittedBlockNotValidatorShouldNotSign(t *testing.T) {
+	t.Parallel()
+
+	txs := map[string]*transaction.Transaction{
+		"tx burn": {RcvAddr: bridgeAddress, Data: "burn@01"},
+	}
+	args := createMockArgOutgoingOperationsCollector(txs)
+	args.PublicKey = &mock.SingleSignPublicKey{
+		ToByteArrayCalled: func() ([]byte, error) {
+			return []byte("observer"), nil
+		},
+	}
+	args.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Fail(t, "should not have broadcast")
+		},
+	}
+	ooc, _ := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	ooc.ProcessCommittedBlock([]byte("block hash"), &block.Header{Nonce: 37}, createBlockWithTxs(txs))
+
+	batches, _ := ooc.GetOutgoingOperationsBatches()
+	assert.Equal(t, 1, len(batches))
+	assert.Equal(t, 0, len(batches[0].Signatures))
+}
+
+func TestOutgoingOperationsCollector_ProcessCommittedBlockShouldEvictTheOldestBatches(t *testing.T) {
+	t.Parallel()
+
+	txs := map[string]*transaction.Transaction{
+		"tx burn": {RcvAddr: bridgeAddress, Data: "burn@01"},
+	}
+	args := createMockArgOutgoingOperationsCollector(txs)
+	args.MaxBatches = 2
+	ooc, _ := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		ooc.ProcessCommittedBlock([]byte("block hash"), &block.Header{Nonce: nonce}, createBlockWithTxs(txs))
+	}
+
+	batches, _ := ooc.GetOutgoingOperationsBatches()
+	assert.Equal(t, 2, len(batches))
+	assert.Equal(t, uint64(2), batches[0].Batch.BlockNonce)
+	assert.Equal(t, uint64(3), batches[1].Batch.BlockNonce)
+}
+
+//------- ProcessReceivedMessage
+
+func TestOutgoingOperationsCollector_ProcessReceivedMessageNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ooc, _ := outgoingOperations.NewOutgoingOperationsCollector(createMockArgOutgoingOperationsCollector(nil))
+
+	err := ooc.ProcessReceivedMessage(nil, nil)
+
+	assert.Equal(t, process.ErrNilMessage, err)
+}
+
+func TestOutgoingOperationsCollector_ProcessReceivedMessageNotEligibleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgOutgoingOperationsCollector(nil)
+	ooc, _ := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	message := createSignatureMessage(&mock.MarshalizerMock{}, []byte("batch hash"), []byte("not a validator"))
+	err := ooc.ProcessReceivedMessage(message, nil)
+
+	assert.Equal(t, process.ErrSignerNotEligible, err)
+}
+
+func TestOutgoingOperationsCollector_ProcessReceivedMessageInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("invalid signature")
+	args := createMockArgOutgoingOperationsCollector(nil)
+	args.SingleSigner = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			return errExpected
+		},
+	}
+	ooc, _ := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	message := createSignatureMessage(&mock.MarshalizerMock{}, []byte("batch hash"), []byte("pubKey01"))
+	err := ooc.ProcessReceivedMessage(message, nil)
+
+	assert.Equal(t, errExpected, err)
+}
+
+func TestOutgoingOperationsCollector_ProcessReceivedMessageShouldConfirmTheBatch(t *testing.T) {
+	t.Parallel()
+
+	txs := map[string]*transaction.Transaction{
+		"tx burn": {RcvAddr: bridgeAddress, Data: "burn@01"},
+	}
+	ooc, _ := outgoingOperations.NewOutgoingOperationsCollector(createMockArgOutgoingOperationsCollector(txs))
+	ooc.ProcessCommittedBlock([]byte("block hash"), &block.Header{Nonce: 37}, createBlockWithTxs(txs))
+	batches, _ := ooc.GetOutgoingOperationsBatches()
+
+	message := createSignatureMessage(&mock.MarshalizerMock{}, batches[0].Hash, []byte("pubKey01"))
+	err := ooc.ProcessReceivedMessage(message, nil)
+	assert.Nil(t, err)
+
+	batches, _ = ooc.GetOutgoingOperationsBatches()
+	assert.Equal(t, 2, len(batches[0].Signatures))
+	assert.True(t, batches[0].Confirmed)
+}
+
+func TestOutgoingOperationsCollector_ProcessReceivedMessageBeforeTheBatchShouldBeKept(t *testing.T) {
+	t.Parallel()
+
+	txs := map[string]*transaction.Transaction{
+		"tx burn": {RcvAddr: bridgeAddress, Data: "burn@01"},
+	}
+	args := createMockArgOutgoingOperationsCollector(txs)
+	ooc, _ := outgoingOperations.NewOutgoingOperationsCollector(args)
+
+	var batchHash []byte
+	args.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			outgoingSignature := &bridge.OutgoingOperationsSignature{}
+			_ = args.Marshalizer.Unmarshal(outgoingSignature, buff)
+			batchHash = outgoingSignature.BatchHash
+		},
+	}
+	other, _ := outgoingOperations.NewOutgoingOperationsCollector(args)
+	other.ProcessCommittedBlock([]byte("block hash"), &block.Header{Nonce: 37}, createBlockWithTxs(txs))
+
+	message := createSignatureMessage(&mock.MarshalizerMock{}, batchHash, []byte("pubKey01"))
+	err := ooc.ProcessReceivedMessage(message, nil)
+	assert.Nil(t, err)
+
+	batches, _ := ooc.GetOutgoingOperationsBatches()
+	assert.Equal(t, 0, len(batches))
+
+	ooc.ProcessCommittedBlock([]byte("block hash"), &block.Header{Nonce: 37}, createBlockWithTxs(txs))
+
+	batches, _ = ooc.GetOutgoingOperationsBatches()
+	assert.Equal(t, 1, len(batches))
+	assert.Equal(t, 2, len(batches[0].Signatures))
+	assert.True(t, batches[0].Confirmed)
+}
+
+//------- nilOutgoingOperationsHandler
+
+func TestNilOutgoingOperationsHandler_ShouldBeDisabled(t *testing.T) {
+	t.Parallel()
+
+	nooh := outgoingOperations.NewNilOutgoingOperationsHandler()
+	nooh.ProcessCommittedBlock([]byte("block hash"), &block.Header{}, block.Body{})
+
+	batches, err := nooh.GetOutgoingOperationsBatches()
+	assert.Nil(t, batches)
+	assert.Equal(t, process.ErrOutgoingOperationsDisabled, err)
+	assert.False(t, nooh.IsEnabled())
+	assert.False(t, nooh.IsInterfaceNil())
+}
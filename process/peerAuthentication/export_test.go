@@ -0,0 +1,9 @@
+package peerAuthentication
+
+import (
+	"time"
+)
+
+func (pav *PeerAuthenticationVerifier) SetGetTimeHandler(handler func() time.Time) {
+	pav.getTimeHandler = handler
+}
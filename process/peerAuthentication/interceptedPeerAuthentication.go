@@ -0,0 +1,78 @@
+package peerAuthentication
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// InterceptedPeerAuthentication holds and manages a received peer authentication message
+type InterceptedPeerAuthentication struct {
+	peerAuth *heartbeat.PeerAuthentication
+	verifier process.PeerAuthenticationVerifier
+	hash     []byte
+}
+
+// NewInterceptedPeerAuthentication returns a new instance of InterceptedPeerAuthentication
+func NewInterceptedPeerAuthentication(
+	peerAuthBuff []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	verifier process.PeerAuthenticationVerifier,
+) (*InterceptedPeerAuthentication, error) {
+
+	if peerAuthBuff == nil {
+		return nil, process.ErrNilBuffer
+	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(verifier) {
+		return nil, process.ErrNilPeerAuthenticationVerifier
+	}
+
+	peerAuth := &heartbeat.PeerAuthentication{}
+	err := marshalizer.Unmarshal(peerAuth, peerAuthBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InterceptedPeerAuthentication{
+		peerAuth: peerAuth,
+		verifier: verifier,
+		hash:     hasher.Compute(string(peerAuthBuff)),
+	}, nil
+}
+
+// CheckValidity checks that the message is signed by the public key it carries and has not expired
+func (ipa *InterceptedPeerAuthentication) CheckValidity() error {
+	return ipa.verifier.Verify(ipa.peerAuth)
+}
+
+// IsForCurrentShard returns true as the peers of all the shards need to know the keys behind the connected peers
+func (ipa *InterceptedPeerAuthentication) IsForCurrentShard() bool {
+	return true
+}
+
+// Hash returns the hash of the received buffer
+func (ipa *InterceptedPeerAuthentication) Hash() []byte {
+	return ipa.hash
+}
+
+// PeerAuthentication returns the received peer authentication message
+func (ipa *InterceptedPeerAuthentication) PeerAuthentication() *heartbeat.PeerAuthentication {
+	return ipa.peerAuth
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ipa *InterceptedPeerAuthentication) IsInterfaceNil() bool {
+	if ipa == nil {
+		return true
+	}
+	return false
+}
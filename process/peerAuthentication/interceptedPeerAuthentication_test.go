@@ -0,0 +1,133 @@
+package peerAuthentication_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/peerAuthentication"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPeerAuthenticationBuff() []byte {
+	buff, _ := (&mock.MarshalizerMock{}).Marshal(&heartbeat.PeerAuthentication{
+		Pubkey:    []byte("pubkey"),
+		Signature: []byte("signature"),
+		Pid:       []byte("pid"),
+		Expiry:    1060,
+	})
+
+	return buff
+}
+
+//------- NewInterceptedPeerAuthentication
+
+func TestNewInterceptedPeerAuthentication_NilBufferShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ipa, err := peerAuthentication.NewInterceptedPeerAuthentication(
+		nil,
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, ipa)
+	assert.Equal(t, process.ErrNilBuffer, err)
+}
+
+func TestNewInterceptedPeerAuthentication_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ipa, err := peerAuthentication.NewInterceptedPeerAuthentication(
+		createPeerAuthenticationBuff(),
+		nil,
+		mock.HasherMock{},
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, ipa)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewInterceptedPeerAuthentication_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ipa, err := peerAuthentication.NewInterceptedPeerAuthentication(
+		createPeerAuthenticationBuff(),
+		&mock.MarshalizerMock{},
+		nil,
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, ipa)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewInterceptedPeerAuthentication_NilVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ipa, err := peerAuthentication.NewInterceptedPeerAuthentication(
+		createPeerAuthenticationBuff(),
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		nil,
+	)
+
+	assert.Nil(t, ipa)
+	assert.Equal(t, process.ErrNilPeerAuthenticationVerifier, err)
+}
+
+func TestNewInterceptedPeerAuthentication_UnmarshalErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ipa, err := peerAuthentication.NewInterceptedPeerAuthentication(
+		[]byte("not a message"),
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, ipa)
+	assert.NotNil(t, err)
+}
+
+func TestNewInterceptedPeerAuthentication_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	buff := createPeerAuthenticationBuff()
+	ipa, err := peerAuthentication.NewInterceptedPeerAuthentication(
+		buff,
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.PeerAuthenticationVerifierStub{},
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("pid"), ipa.PeerAuthentication().Pid)
+	assert.Equal(t, mock.HasherMock{}.Compute(string(buff)), ipa.Hash())
+	assert.True(t, ipa.IsForCurrentShard())
+}
+
+//------- CheckValidity
+
+func TestInterceptedPeerAuthentication_CheckValidityShouldCallTheVerifier(t *testing.T) {
+	t.Parallel()
+
+	errVerify := errors.New("verify error")
+	ipa, _ := peerAuthentication.NewInterceptedPeerAuthentication(
+		createPeerAuthenticationBuff(),
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.PeerAuthenticationVerifierStub{
+			VerifyCalled: func(peerAuth *heartbeat.PeerAuthentication) error {
+				assert.Equal(t, []byte("pubkey"), peerAuth.Pubkey)
+				return errVerify
+			},
+		},
+	)
+
+	assert.Equal(t, errVerify, ipa.CheckValidity())
+}
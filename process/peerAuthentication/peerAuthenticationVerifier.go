@@ -0,0 +1,101 @@
+package peerAuthentication
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ArgPeerAuthenticationVerifier is used to store all components that are needed to create a new
+// PeerAuthenticationVerifier
+type ArgPeerAuthenticationVerifier struct {
+	Marshalizer  marshal.Marshalizer
+	KeyGen       crypto.KeyGenerator
+	SingleSigner crypto.SingleSigner
+	MaxValidity  time.Duration
+}
+
+// PeerAuthenticationVerifier checks that a peer authentication message is signed by the validator key it carries and
+// that its expiry is neither passed nor further in the future than the accepted validity
+type PeerAuthenticationVerifier struct {
+	marshalizer    marshal.Marshalizer
+	keyGen         crypto.KeyGenerator
+	singleSigner   crypto.SingleSigner
+	maxValidity    time.Duration
+	getTimeHandler func() time.Time
+}
+
+// NewPeerAuthenticationVerifier will create a new instance of PeerAuthenticationVerifier
+func NewPeerAuthenticationVerifier(argument *ArgPeerAuthenticationVerifier) (*PeerAuthenticationVerifier, error) {
+	if argument == nil {
+		return nil, process.ErrNilArguments
+	}
+	if check.IfNil(argument.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(argument.KeyGen) {
+		return nil, process.ErrNilKeyGen
+	}
+	if check.IfNil(argument.SingleSigner) {
+		return nil, process.ErrNilSingleSigner
+	}
+	if argument.MaxValidity < time.Second {
+		return nil, process.ErrInvalidPeerAuthenticationValidity
+	}
+
+	return &PeerAuthenticationVerifier{
+		marshalizer:    argument.Marshalizer,
+		keyGen:         argument.KeyGen,
+		singleSigner:   argument.SingleSigner,
+		maxValidity:    argument.MaxValidity,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// Verify checks the fields, the expiry and the signature of a peer authentication message
+func (pav *PeerAuthenticationVerifier) Verify(peerAuth *heartbeat.PeerAuthentication) error {
+	if peerAuth == nil {
+		return process.ErrNilPeerAuthentication
+	}
+	if len(peerAuth.Pubkey) == 0 || len(peerAuth.Pid) == 0 || len(peerAuth.Signature) == 0 {
+		return process.ErrInvalidPeerAuthentication
+	}
+
+	now := pav.getTimeHandler()
+	if peerAuth.Expiry <= now.Unix() {
+		return process.ErrPeerAuthenticationExpired
+	}
+	if peerAuth.Expiry > now.Add(pav.maxValidity).Unix() {
+		return process.ErrPeerAuthenticationExpiryTooFar
+	}
+
+	return pav.verifySignature(peerAuth)
+}
+
+func (pav *PeerAuthenticationVerifier) verifySignature(peerAuth *heartbeat.PeerAuthentication) error {
+	pubKey, err := pav.keyGen.PublicKeyFromByteArray(peerAuth.Pubkey)
+	if err != nil {
+		return err
+	}
+
+	copiedPeerAuth := *peerAuth
+	copiedPeerAuth.Signature = nil
+	buffCopiedPeerAuth, err := pav.marshalizer.Marshal(&copiedPeerAuth)
+	if err != nil {
+		return err
+	}
+
+	return pav.singleSigner.Verify(pubKey, buffCopiedPeerAuth, peerAuth.Signature)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pav *PeerAuthenticationVerifier) IsInterfaceNil() bool {
+	if pav == nil {
+		return true
+	}
+	return false
+}
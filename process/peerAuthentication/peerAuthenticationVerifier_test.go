@@ -0,0 +1,211 @@
+package peerAuthentication_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/peerAuthentication"
+	"github.com/stretchr/testify/assert"
+)
+
+var errInvalidSignature = errors.New("invalid signature")
+
+var currentTime = time.Unix(1000, 0)
+
+// createVerifierArgs returns arguments whose signer accepts only the signature equal to the signed buffer
+func createVerifierArgs() *peerAuthentication.ArgPeerAuthenticationVerifier {
+	return &peerAuthentication.ArgPeerAuthenticationVerifier{
+		Marshalizer: &mock.MarshalizerMock{},
+		KeyGen: &mock.SingleSignKeyGenMock{
+			PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.SingleSignPublicKey{}, nil
+			},
+		},
+		SingleSigner: &mock.SignerMock{
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				if !bytes.Equal(msg, sig) {
+					return errInvalidSignature
+				}
+				return nil
+			},
+		},
+		MaxValidity: time.Hour,
+	}
+}
+
+func createVerifier(args *peerAuthentication.ArgPeerAuthenticationVerifier) *peerAuthentication.PeerAuthenticationVerifier {
+	pav, _ := peerAuthentication.NewPeerAuthenticationVerifier(args)
+	pav.SetGetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	return pav
+}
+
+// createSignedPeerAuthentication returns a message signed as the verifier created by createVerifierArgs expects it
+func createSignedPeerAuthentication(expiry int64) *heartbeat.PeerAuthentication {
+	peerAuth := &heartbeat.PeerAuthentication{
+		Pubkey: []byte("pubkey"),
+		Pid:    []byte("pid"),
+		Expiry: expiry,
+	}
+	peerAuth.Signature, _ = (&mock.MarshalizerMock{}).Marshal(peerAuth)
+
+	return peerAuth
+}
+
+//------- NewPeerAuthenticationVerifier
+
+func TestNewPeerAuthenticationVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav, err := peerAuthentication.NewPeerAuthenticationVerifier(nil)
+
+	assert.Nil(t, pav)
+	assert.Equal(t, process.ErrNilArguments, err)
+}
+
+func TestNewPeerAuthenticationVerifier_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createVerifierArgs()
+	args.Marshalizer = nil
+	pav, err := peerAuthentication.NewPeerAuthenticationVerifier(args)
+
+	assert.Nil(t, pav)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewPeerAuthenticationVerifier_NilKeyGenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createVerifierArgs()
+	args.KeyGen = nil
+	pav, err := peerAuthentication.NewPeerAuthenticationVerifier(args)
+
+	assert.Nil(t, pav)
+	assert.Equal(t, process.ErrNilKeyGen, err)
+}
+
+func TestNewPeerAuthenticationVerifier_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createVerifierArgs()
+	args.SingleSigner = nil
+	pav, err := peerAuthentication.NewPeerAuthenticationVerifier(args)
+
+	assert.Nil(t, pav)
+	assert.Equal(t, process.ErrNilSingleSigner, err)
+}
+
+func TestNewPeerAuthenticationVerifier_InvalidMaxValidityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createVerifierArgs()
+	args.MaxValidity = time.Millisecond
+	pav, err := peerAuthentication.NewPeerAuthenticationVerifier(args)
+
+	assert.Nil(t, pav)
+	assert.Equal(t, process.ErrInvalidPeerAuthenticationValidity, err)
+}
+
+func TestNewPeerAuthenticationVerifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pav, err := peerAuthentication.NewPeerAuthenticationVerifier(createVerifierArgs())
+
+	assert.NotNil(t, pav)
+	assert.Nil(t, err)
+}
+
+//------- Verify
+
+func TestPeerAuthenticationVerifier_VerifyNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav := createVerifier(createVerifierArgs())
+
+	assert.Equal(t, process.ErrNilPeerAuthentication, pav.Verify(nil))
+}
+
+func TestPeerAuthenticationVerifier_VerifyMissingFieldsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav := createVerifier(createVerifierArgs())
+
+	peerAuth := createSignedPeerAuthentication(currentTime.Unix() + 60)
+	peerAuth.Pubkey = nil
+	assert.Equal(t, process.ErrInvalidPeerAuthentication, pav.Verify(peerAuth))
+
+	peerAuth = createSignedPeerAuthentication(currentTime.Unix() + 60)
+	peerAuth.Pid = nil
+	assert.Equal(t, process.ErrInvalidPeerAuthentication, pav.Verify(peerAuth))
+
+	peerAuth = createSignedPeerAuthentication(currentTime.Unix() + 60)
+	peerAuth.Signature = nil
+	assert.Equal(t, process.ErrInvalidPeerAuthentication, pav.Verify(peerAuth))
+}
+
+func TestPeerAuthenticationVerifier_VerifyExpiredShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav := createVerifier(createVerifierArgs())
+
+	err := pav.Verify(createSignedPeerAuthentication(currentTime.Unix()))
+
+	assert.Equal(t, process.ErrPeerAuthenticationExpired, err)
+}
+
+func TestPeerAuthenticationVerifier_VerifyExpiryTooFarShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav := createVerifier(createVerifierArgs())
+
+	err := pav.Verify(createSignedPeerAuthentication(currentTime.Add(time.Hour).Unix() + 1))
+
+	assert.Equal(t, process.ErrPeerAuthenticationExpiryTooFar, err)
+}
+
+func TestPeerAuthenticationVerifier_VerifyInvalidPublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errPubKey := errors.New("invalid public key")
+	args := createVerifierArgs()
+	args.KeyGen = &mock.SingleSignKeyGenMock{
+		PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+			return nil, errPubKey
+		},
+	}
+	pav := createVerifier(args)
+
+	err := pav.Verify(createSignedPeerAuthentication(currentTime.Unix() + 60))
+
+	assert.Equal(t, errPubKey, err)
+}
+
+func TestPeerAuthenticationVerifier_VerifyChangedPeerIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav := createVerifier(createVerifierArgs())
+
+	peerAuth := createSignedPeerAuthentication(currentTime.Unix() + 60)
+	peerAuth.Pid = []byte("other pid")
+
+	assert.Equal(t, errInvalidSignature, pav.Verify(peerAuth))
+}
+
+func TestPeerAuthenticationVerifier_VerifyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pav := createVerifier(createVerifierArgs())
+
+	err := pav.Verify(createSignedPeerAuthentication(currentTime.Add(time.Hour).Unix()))
+
+	assert.Nil(t, err)
+}
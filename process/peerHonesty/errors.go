@@ -0,0 +1,26 @@
+package peerHonesty
+
+import (
+	"errors"
+)
+
+// ErrNilPeerDisconnector signals that a nil peer disconnector has been provided
+var ErrNilPeerDisconnector = errors.New("nil peer disconnector")
+
+// ErrNegativeScoreDelta signals that a negative bonus or penalty has been provided
+var ErrNegativeScoreDelta = errors.New("negative bonus or penalty")
+
+// ErrInvalidResponseTimeout signals that an invalid response timeout has been provided
+var ErrInvalidResponseTimeout = errors.New("invalid response timeout")
+
+// ErrInvalidDecayFactor signals that a decay factor outside the (0, 1] interval has been provided
+var ErrInvalidDecayFactor = errors.New("invalid decay factor")
+
+// ErrInvalidSweepInterval signals that an invalid sweep interval has been provided
+var ErrInvalidSweepInterval = errors.New("invalid sweep interval")
+
+// ErrInvalidScoreInterval signals that the minimum score is not lower than the maximum score
+var ErrInvalidScoreInterval = errors.New("invalid score interval")
+
+// ErrInvalidDisconnectThreshold signals that the disconnect threshold is outside the score interval
+var ErrInvalidDisconnectThreshold = errors.New("invalid disconnect threshold")
@@ -0,0 +1,5 @@
+package peerHonesty
+
+func (ph *PeerHonesty) Sweep() {
+	ph.sweep()
+}
@@ -0,0 +1,11 @@
+package peerHonesty
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// PeerDisconnector defines the component able to close the connections held with a peer
+type PeerDisconnector interface {
+	ClosePeer(pid p2p.PeerID) error
+	IsInterfaceNil() bool
+}
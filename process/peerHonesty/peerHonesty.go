@@ -0,0 +1,264 @@
+package peerHonesty
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("process/peerHonesty")
+
+// maxPendingRequestsPerTopic limits the number of unanswered requests remembered for a peer on a topic
+const maxPendingRequestsPerTopic = 100
+
+// minTrackedScore is the absolute score under which a peer without pending requests is forgotten
+const minTrackedScore = 0.01
+
+// ArgPeerHonesty is the argument structure used to create a new peer honesty instance
+type ArgPeerHonesty struct {
+	Disconnector PeerDisconnector
+	Config       config.PeerHonestyConfig
+}
+
+type peerInfo struct {
+	score            float64
+	pendingRequests  map[string][]time.Time
+	maintenanceUntil time.Time
+}
+
+// PeerHonesty rates the peers based on the validity of the messages received from them and on the
+// timeliness of their responses to our requests. The scores decay over time and the peers whose score
+// drops below the disconnect threshold are disconnected. The peers which announced a planned maintenance
+// are not penalized for the requests left unanswered during the announced window
+type PeerHonesty struct {
+	disconnector    PeerDisconnector
+	cfg             config.PeerHonestyConfig
+	responseTimeout time.Duration
+	mutPeers        sync.Mutex
+	peers           map[p2p.PeerID]*peerInfo
+	cancel          func()
+}
+
+// NewPeerHonesty creates a new peer honesty instance and starts its periodic sweep
+func NewPeerHonesty(arg ArgPeerHonesty) (*PeerHonesty, error) {
+	err := checkArgs(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ph := &PeerHonesty{
+		disconnector:    arg.Disconnector,
+		cfg:             arg.Config,
+		responseTimeout: time.Duration(arg.Config.ResponseTimeoutInMs) * time.Millisecond,
+		peers:           make(map[p2p.PeerID]*peerInfo),
+		cancel:          cancel,
+	}
+
+	go ph.sweepLoop(ctx, time.Duration(arg.Config.SweepIntervalInSec)*time.Second)
+
+	return ph, nil
+}
+
+func checkArgs(arg ArgPeerHonesty) error {
+	if arg.Disconnector == nil || arg.Disconnector.IsInterfaceNil() {
+		return ErrNilPeerDisconnector
+	}
+	cfg := arg.Config
+	if cfg.ValidMessageBonus < 0 || cfg.InvalidMessagePenalty < 0 ||
+		cfg.TimelyResponseBonus < 0 || cfg.MissedResponsePenalty < 0 {
+		return ErrNegativeScoreDelta
+	}
+	if cfg.ResponseTimeoutInMs <= 0 {
+		return ErrInvalidResponseTimeout
+	}
+	if cfg.DecayFactor <= 0 || cfg.DecayFactor > 1 {
+		return ErrInvalidDecayFactor
+	}
+	if cfg.SweepIntervalInSec <= 0 {
+		return ErrInvalidSweepInterval
+	}
+	if cfg.MinScore >= cfg.MaxScore {
+		return ErrInvalidScoreInterval
+	}
+	if cfg.DisconnectThreshold < cfg.MinScore || cfg.DisconnectThreshold > cfg.MaxScore {
+		return ErrInvalidDisconnectThreshold
+	}
+
+	return nil
+}
+
+// MessageReceived rates the peer based on the processing outcome of a message received from it.
+// A valid message sent on a topic on which the peer has a pending request from us is considered a response
+func (ph *PeerHonesty) MessageReceived(pid p2p.PeerID, topic string, err error) {
+	if err == process.ErrSystemBusy {
+		// the message was dropped by our own throttlers, the peer is not to blame
+		return
+	}
+
+	ph.mutPeers.Lock()
+	defer ph.mutPeers.Unlock()
+
+	info := ph.getOrCreatePeerInfo(pid)
+	if err != nil {
+		ph.changeScore(info, -ph.cfg.InvalidMessagePenalty)
+		return
+	}
+
+	ph.changeScore(info, ph.cfg.ValidMessageBonus)
+	deadlines := info.pendingRequests[topic]
+	if len(deadlines) == 0 {
+		return
+	}
+
+	// requests on the same topic are answered in order, the oldest one is considered responded
+	now := time.Now()
+	if now.After(deadlines[0]) {
+		if !now.Before(info.maintenanceUntil) {
+			ph.changeScore(info, -ph.cfg.MissedResponsePenalty)
+		}
+	} else {
+		ph.changeScore(info, ph.cfg.TimelyResponseBonus)
+	}
+	ph.removeOldestRequest(info, topic)
+}
+
+// MessageSent records the requests sent to the peer so that their responses can be rated
+func (ph *PeerHonesty) MessageSent(pid p2p.PeerID, topic string) {
+	if !strings.HasSuffix(topic, topicResolverSender.TopicRequestSuffix) {
+		return
+	}
+
+	responseTopic := strings.TrimSuffix(topic, topicResolverSender.TopicRequestSuffix)
+
+	ph.mutPeers.Lock()
+	defer ph.mutPeers.Unlock()
+
+	info := ph.getOrCreatePeerInfo(pid)
+	if len(info.pendingRequests[responseTopic]) >= maxPendingRequestsPerTopic {
+		return
+	}
+
+	info.pendingRequests[responseTopic] = append(info.pendingRequests[responseTopic], time.Now().Add(ph.responseTimeout))
+}
+
+// PeerInMaintenance records that the provided peer announced a planned maintenance lasting until the provided time
+func (ph *PeerHonesty) PeerInMaintenance(pid p2p.PeerID, until time.Time) {
+	ph.mutPeers.Lock()
+	defer ph.mutPeers.Unlock()
+
+	info := ph.getOrCreatePeerInfo(pid)
+	info.maintenanceUntil = until
+}
+
+// Score returns the current score of the provided peer. Unknown peers are rated with 0
+func (ph *PeerHonesty) Score(pid p2p.PeerID) float64 {
+	ph.mutPeers.Lock()
+	defer ph.mutPeers.Unlock()
+
+	info, found := ph.peers[pid]
+	if !found {
+		return 0
+	}
+
+	return info.score
+}
+
+func (ph *PeerHonesty) getOrCreatePeerInfo(pid p2p.PeerID) *peerInfo {
+	info, found := ph.peers[pid]
+	if !found {
+		info = &peerInfo{
+			pendingRequests: make(map[string][]time.Time),
+		}
+		ph.peers[pid] = info
+	}
+
+	return info
+}
+
+func (ph *PeerHonesty) changeScore(info *peerInfo, delta float64) {
+	info.score = math.Max(ph.cfg.MinScore, math.Min(ph.cfg.MaxScore, info.score+delta))
+}
+
+func (ph *PeerHonesty) removeOldestRequest(info *peerInfo, topic string) {
+	deadlines := info.pendingRequests[topic]
+	if len(deadlines) <= 1 {
+		delete(info.pendingRequests, topic)
+		return
+	}
+
+	info.pendingRequests[topic] = deadlines[1:]
+}
+
+func (ph *PeerHonesty) sweepLoop(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-time.After(interval):
+			ph.sweep()
+		case <-ctx.Done():
+			log.Debug("closing the peer honesty sweep loop")
+			return
+		}
+	}
+}
+
+// sweep penalizes the expired requests, decays all the scores and disconnects the low rated peers
+func (ph *PeerHonesty) sweep() {
+	now := time.Now()
+	peersToDisconnect := make([]p2p.PeerID, 0)
+
+	ph.mutPeers.Lock()
+	for pid, info := range ph.peers {
+		isInMaintenance := now.Before(info.maintenanceUntil)
+		for topic := range info.pendingRequests {
+			for len(info.pendingRequests[topic]) > 0 && now.After(info.pendingRequests[topic][0]) {
+				if !isInMaintenance {
+					ph.changeScore(info, -ph.cfg.MissedResponsePenalty)
+				}
+				ph.removeOldestRequest(info, topic)
+			}
+		}
+
+		info.score *= ph.cfg.DecayFactor
+		if info.score < ph.cfg.DisconnectThreshold {
+			peersToDisconnect = append(peersToDisconnect, pid)
+			continue
+		}
+		if math.Abs(info.score) < minTrackedScore && len(info.pendingRequests) == 0 && !isInMaintenance {
+			delete(ph.peers, pid)
+		}
+	}
+	ph.mutPeers.Unlock()
+
+	for _, pid := range peersToDisconnect {
+		log.Debug(fmt.Sprintf("disconnecting peer %s with score %.2f", pid.Pretty(), ph.Score(pid)))
+
+		err := ph.disconnector.ClosePeer(pid)
+		if err != nil {
+			log.Debug(fmt.Sprintf("error disconnecting peer %s: %s", pid.Pretty(), err.Error()))
+		}
+	}
+}
+
+// Close stops the periodic sweep
+func (ph *PeerHonesty) Close() error {
+	ph.cancel()
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ph *PeerHonesty) IsInterfaceNil() bool {
+	if ph == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,304 @@
+package peerHonesty_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/peerHonesty"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTopic = "transactions"
+
+func createMockArgPeerHonesty() peerHonesty.ArgPeerHonesty {
+	return peerHonesty.ArgPeerHonesty{
+		Disconnector: &mock.PeerDisconnectorStub{
+			ClosePeerCalled: func(pid p2p.PeerID) error {
+				return nil
+			},
+		},
+		Config: config.PeerHonestyConfig{
+			Enabled:               true,
+			ValidMessageBonus:     1,
+			InvalidMessagePenalty: 10,
+			TimelyResponseBonus:   2,
+			MissedResponsePenalty: 3,
+			ResponseTimeoutInMs:   1000,
+			DecayFactor:           0.5,
+			SweepIntervalInSec:    1000,
+			MinScore:              -100,
+			MaxScore:              100,
+			DisconnectThreshold:   -20,
+		},
+	}
+}
+
+//------- NewPeerHonesty
+
+func TestNewPeerHonesty_NilDisconnectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerHonesty()
+	arg.Disconnector = nil
+	ph, err := peerHonesty.NewPeerHonesty(arg)
+
+	assert.Nil(t, ph)
+	assert.Equal(t, peerHonesty.ErrNilPeerDisconnector, err)
+}
+
+func TestNewPeerHonesty_NegativePenaltyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerHonesty()
+	arg.Config.InvalidMessagePenalty = -1
+	ph, err := peerHonesty.NewPeerHonesty(arg)
+
+	assert.Nil(t, ph)
+	assert.Equal(t, peerHonesty.ErrNegativeScoreDelta, err)
+}
+
+func TestNewPeerHonesty_InvalidResponseTimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerHonesty()
+	arg.Config.ResponseTimeoutInMs = 0
+	ph, err := peerHonesty.NewPeerHonesty(arg)
+
+	assert.Nil(t, ph)
+	assert.Equal(t, peerHonesty.ErrInvalidResponseTimeout, err)
+}
+
+func TestNewPeerHonesty_InvalidDecayFactorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerHonesty()
+	arg.Config.DecayFactor = 1.1
+	ph, err := peerHonesty.NewPeerHonesty(arg)
+
+	assert.Nil(t, ph)
+	assert.Equal(t, peerHonesty.ErrInvalidDecayFactor, err)
+}
+
+func TestNewPeerHonesty_InvalidSweepIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerHonesty()
+	arg.Config.SweepIntervalInSec = 0
+	ph, err := peerHonesty.NewPeerHonesty(arg)
+
+	assert.Nil(t, ph)
+	assert.Equal(t, peerHonesty.ErrInvalidSweepInterval, err)
+}
+
+func TestNewPeerHonesty_InvalidScoreIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerHonesty()
+	arg.Config.MinScore = arg.Config.MaxScore
+	ph, err := peerHonesty.NewPeerHonesty(arg)
+
+	assert.Nil(t, ph)
+	assert.Equal(t, peerHonesty.ErrInvalidScoreInterval, err)
+}
+
+func TestNewPeerHonesty_ThresholdOutsideTheScoreIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerHonesty()
+	arg.Config.DisconnectThreshold = arg.Config.MinScore - 1
+	ph, err := peerHonesty.NewPeerHonesty(arg)
+
+	assert.Nil(t, ph)
+	assert.Equal(t, peerHonesty.ErrInvalidDisconnectThreshold, err)
+}
+
+func TestNewPeerHonesty_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ph, err := peerHonesty.NewPeerHonesty(createMockArgPeerHonesty())
+
+	assert.Nil(t, err)
+	assert.False(t, ph.IsInterfaceNil())
+	assert.Nil(t, ph.Close())
+}
+
+//------- MessageReceived
+
+func TestPeerHonesty_MessageReceivedShouldChangeTheScore(t *testing.T) {
+	t.Parallel()
+
+	ph, _ := peerHonesty.NewPeerHonesty(createMockArgPeerHonesty())
+	defer func() {
+		_ = ph.Close()
+	}()
+
+	pid := p2p.PeerID("peer")
+	assert.Equal(t, float64(0), ph.Score(pid))
+
+	ph.MessageReceived(pid, testTopic, nil)
+	assert.Equal(t, float64(1), ph.Score(pid))
+
+	ph.MessageReceived(pid, testTopic, errors.New("invalid message"))
+	assert.Equal(t, float64(-9), ph.Score(pid))
+}
+
+func TestPeerHonesty_MessageReceivedSystemBusyShouldNotChangeTheScore(t *testing.T) {
+	t.Parallel()
+
+	ph, _ := peerHonesty.NewPeerHonesty(createMockArgPeerHonesty())
+	defer func() {
+		_ = ph.Close()
+	}()
+
+	pid := p2p.PeerID("peer")
+	ph.MessageReceived(pid, testTopic, process.ErrSystemBusy)
+
+	assert.Equal(t, float64(0), ph.Score(pid))
+}
+
+func TestPeerHonesty_MessageReceivedShouldKeepTheScoreInInterval(t *testing.T) {
+	t.Parallel()
+
+	ph, _ := peerHonesty.NewPeerHonesty(createMockArgPeerHonesty())
+	defer func() {
+		_ = ph.Close()
+	}()
+
+	pid := p2p.PeerID("peer")
+	for i := 0; i < 20; i++ {
+		ph.MessageReceived(pid, testTopic, errors.New("invalid message"))
+	}
+
+	assert.Equal(t, float64(-100), ph.Score(pid))
+}
+
+func TestPeerHonesty_TimelyResponseShouldAddTheBonus(t *testing.T) {
+	t.Parallel()
+
+	ph, _ := peerHonesty.NewPeerHonesty(createMockArgPeerHonesty())
+	defer func() {
+		_ = ph.Close()
+	}()
+
+	pid := p2p.PeerID("peer")
+	ph.MessageSent(pid, testTopic+"_REQUEST")
+	ph.MessageReceived(pid, testTopic, nil)
+	assert.Equal(t, float64(3), ph.Score(pid))
+
+	//the request was answered, a new message is not a response anymore
+	ph.MessageReceived(pid, testTopic, nil)
+	assert.Equal(t, float64(4), ph.Score(pid))
+}
+
+func TestPeerHonesty_MessageSentOnNonRequestTopicShouldNotWaitResponses(t *testing.T) {
+	t.Parallel()
+
+	ph, _ := peerHonesty.NewPeerHonesty(createMockArgPeerHonesty())
+	defer func() {
+		_ = ph.Close()
+	}()
+
+	pid := p2p.PeerID("peer")
+	ph.MessageSent(pid, testTopic)
+	ph.MessageReceived(pid, testTopic, nil)
+
+	assert.Equal(t, float64(1), ph.Score(pid))
+}
+
+//------- sweep
+
+func TestPeerHonesty_SweepShouldPenalizeMissedResponses(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerHonesty()
+	arg.Config.ResponseTimeoutInMs = 1
+	arg.Config.DecayFactor = 1
+	ph, _ := peerHonesty.NewPeerHonesty(arg)
+	defer func() {
+		_ = ph.Close()
+	}()
+
+	pid := p2p.PeerID("peer")
+	ph.MessageSent(pid, testTopic+"_REQUEST")
+	ph.MessageSent(pid, testTopic+"_REQUEST")
+	time.Sleep(time.Millisecond * 10)
+	ph.Sweep()
+
+	assert.Equal(t, float64(-6), ph.Score(pid))
+}
+
+func TestPeerHonesty_SweepShouldNotPenalizePeersInMaintenance(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerHonesty()
+	arg.Config.ResponseTimeoutInMs = 1
+	arg.Config.DecayFactor = 1
+	ph, _ := peerHonesty.NewPeerHonesty(arg)
+	defer func() {
+		_ = ph.Close()
+	}()
+
+	pid := p2p.PeerID("peer")
+	ph.PeerInMaintenance(pid, time.Now().Add(time.Hour))
+	ph.MessageSent(pid, testTopic+"_REQUEST")
+	ph.MessageSent(pid, testTopic+"_REQUEST")
+	time.Sleep(time.Millisecond * 10)
+	ph.Sweep()
+
+	assert.Equal(t, float64(0), ph.Score(pid))
+
+	ph.PeerInMaintenance(pid, time.Now())
+	ph.MessageSent(pid, testTopic+"_REQUEST")
+	time.Sleep(time.Millisecond * 10)
+	ph.Sweep()
+
+	assert.Equal(t, float64(-3), ph.Score(pid))
+}
+
+func TestPeerHonesty_SweepShouldDecayTheScores(t *testing.T) {
+	t.Parallel()
+
+	ph, _ := peerHonesty.NewPeerHonesty(createMockArgPeerHonesty())
+	defer func() {
+		_ = ph.Close()
+	}()
+
+	pid := p2p.PeerID("peer")
+	ph.MessageReceived(pid, testTopic, errors.New("invalid message"))
+	ph.Sweep()
+
+	assert.Equal(t, float64(-5), ph.Score(pid))
+}
+
+func TestPeerHonesty_SweepShouldDisconnectLowRatedPeers(t *testing.T) {
+	t.Parallel()
+
+	disconnected := make([]p2p.PeerID, 0)
+	arg := createMockArgPeerHonesty()
+	arg.Disconnector = &mock.PeerDisconnectorStub{
+		ClosePeerCalled: func(pid p2p.PeerID) error {
+			disconnected = append(disconnected, pid)
+			return nil
+		},
+	}
+	ph, _ := peerHonesty.NewPeerHonesty(arg)
+	defer func() {
+		_ = ph.Close()
+	}()
+
+	honestPid := p2p.PeerID("honest")
+	dishonestPid := p2p.PeerID("dishonest")
+	ph.MessageReceived(honestPid, testTopic, nil)
+	for i := 0; i < 5; i++ {
+		ph.MessageReceived(dishonestPid, testTopic, errors.New("invalid message"))
+	}
+	ph.Sweep()
+
+	assert.Equal(t, []p2p.PeerID{dishonestPid}, disconnected)
+	assert.Equal(t, float64(-25), ph.Score(dishonestPid))
+}
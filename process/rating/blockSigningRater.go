@@ -0,0 +1,100 @@
+package rating
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// blockSigningRater computes the rating of the validators from the blocks they proposed and signed. The rating
+// changes with the steps of the shard chain or of the metachain, depending on the shard of the validator, and is kept
+// between the configured min and max ratings
+type blockSigningRater struct {
+	startRating uint32
+	maxRating   uint32
+	minRating   uint32
+	shardSteps  config.RatingSteps
+	metaSteps   config.RatingSteps
+}
+
+// NewBlockSigningRater creates a new rater from the ratings configuration
+func NewBlockSigningRater(ratingsConfig config.RatingsConfig) (*blockSigningRater, error) {
+	general := ratingsConfig.General
+	if general.MinRating > general.MaxRating {
+		return nil, process.ErrMinRatingGreaterThanMaxRating
+	}
+	if general.StartRating < general.MinRating || general.StartRating > general.MaxRating {
+		return nil, process.ErrStartRatingNotBetweenMinAndMax
+	}
+
+	return &blockSigningRater{
+		startRating: general.StartRating,
+		maxRating:   general.MaxRating,
+		minRating:   general.MinRating,
+		shardSteps:  ratingsConfig.ShardChain,
+		metaSteps:   ratingsConfig.MetaChain,
+	}, nil
+}
+
+// GetStartRating returns the rating of a new validator
+func (bsr *blockSigningRater) GetStartRating() uint32 {
+	return bsr.startRating
+}
+
+// ComputeIncreaseProposer computes the rating of a validator whose proposed block was committed
+func (bsr *blockSigningRater) ComputeIncreaseProposer(shardId uint32, currentRating uint32) uint32 {
+	return bsr.increase(currentRating, bsr.steps(shardId).ProposerIncreaseRatingStep)
+}
+
+// ComputeDecreaseProposer computes the rating of a validator which missed to propose a block
+func (bsr *blockSigningRater) ComputeDecreaseProposer(shardId uint32, currentRating uint32) uint32 {
+	return bsr.decrease(currentRating, bsr.steps(shardId).ProposerDecreaseRatingStep)
+}
+
+// ComputeIncreaseValidator computes the rating of a validator which signed a committed block
+func (bsr *blockSigningRater) ComputeIncreaseValidator(shardId uint32, currentRating uint32) uint32 {
+	return bsr.increase(currentRating, bsr.steps(shardId).ValidatorIncreaseRatingStep)
+}
+
+// ComputeDecreaseValidator computes the rating of a validator which missed to sign a block
+func (bsr *blockSigningRater) ComputeDecreaseValidator(shardId uint32, currentRating uint32) uint32 {
+	return bsr.decrease(currentRating, bsr.steps(shardId).ValidatorDecreaseRatingStep)
+}
+
+func (bsr *blockSigningRater) steps(shardId uint32) config.RatingSteps {
+	if shardId == sharding.MetachainShardId {
+		return bsr.metaSteps
+	}
+
+	return bsr.shardSteps
+}
+
+func (bsr *blockSigningRater) increase(currentRating uint32, step uint32) uint32 {
+	if currentRating >= bsr.maxRating || bsr.maxRating-currentRating <= step {
+		return bsr.maxRating
+	}
+	if currentRating+step < bsr.minRating {
+		return bsr.minRating
+	}
+
+	return currentRating + step
+}
+
+func (bsr *blockSigningRater) decrease(currentRating uint32, step uint32) uint32 {
+	if currentRating <= bsr.minRating || currentRating-bsr.minRating <= step {
+		return bsr.minRating
+	}
+	if currentRating-step > bsr.maxRating {
+		return bsr.maxRating
+	}
+
+	return currentRating - step
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bsr *blockSigningRater) IsInterfaceNil() bool {
+	if bsr == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package rating_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/rating"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createDummyRatingsConfig() config.RatingsConfig {
+	return config.RatingsConfig{
+		General: config.GeneralRatingsConfig{
+			StartRating: 50,
+			MaxRating:   100,
+			MinRating:   1,
+		},
+		ShardChain: config.RatingSteps{
+			ProposerIncreaseRatingStep:  2,
+			ProposerDecreaseRatingStep:  4,
+			ValidatorIncreaseRatingStep: 1,
+			ValidatorDecreaseRatingStep: 2,
+		},
+		MetaChain: config.RatingSteps{
+			ProposerIncreaseRatingStep:  3,
+			ProposerDecreaseRatingStep:  6,
+			ValidatorIncreaseRatingStep: 5,
+			ValidatorDecreaseRatingStep: 7,
+		},
+	}
+}
+
+//------- NewBlockSigningRater
+
+func TestNewBlockSigningRater_MinGreaterThanMaxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ratingsConfig := createDummyRatingsConfig()
+	ratingsConfig.General.MinRating = ratingsConfig.General.MaxRating + 1
+	bsr, err := rating.NewBlockSigningRater(ratingsConfig)
+
+	assert.Nil(t, bsr)
+	assert.Equal(t, process.ErrMinRatingGreaterThanMaxRating, err)
+}
+
+func TestNewBlockSigningRater_StartRatingBelowMinShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ratingsConfig := createDummyRatingsConfig()
+	ratingsConfig.General.StartRating = ratingsConfig.General.MinRating - 1
+	bsr, err := rating.NewBlockSigningRater(ratingsConfig)
+
+	assert.Nil(t, bsr)
+	assert.Equal(t, process.ErrStartRatingNotBetweenMinAndMax, err)
+}
+
+func TestNewBlockSigningRater_StartRatingAboveMaxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ratingsConfig := createDummyRatingsConfig()
+	ratingsConfig.General.StartRating = ratingsConfig.General.MaxRating + 1
+	bsr, err := rating.NewBlockSigningRater(ratingsConfig)
+
+	assert.Nil(t, bsr)
+	assert.Equal(t, process.ErrStartRatingNotBetweenMinAndMax, err)
+}
+
+func TestNewBlockSigningRater_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	bsr, err := rating.NewBlockSigningRater(createDummyRatingsConfig())
+
+	assert.Nil(t, err)
+	assert.False(t, bsr.IsInterfaceNil())
+	assert.Equal(t, uint32(50), bsr.GetStartRating())
+}
+
+//------- Compute
+
+func TestBlockSigningRater_ComputeShouldUseTheShardChainSteps(t *testing.T) {
+	t.Parallel()
+
+	bsr, _ := rating.NewBlockSigningRater(createDummyRatingsConfig())
+
+	assert.Equal(t, uint32(52), bsr.ComputeIncreaseProposer(0, 50))
+	assert.Equal(t, uint32(46), bsr.ComputeDecreaseProposer(0, 50))
+	assert.Equal(t, uint32(51), bsr.ComputeIncreaseValidator(1, 50))
+	assert.Equal(t, uint32(48), bsr.ComputeDecreaseValidator(1, 50))
+}
+
+func TestBlockSigningRater_ComputeShouldUseTheMetaChainSteps(t *testing.T) {
+	t.Parallel()
+
+	bsr, _ := rating.NewBlockSigningRater(createDummyRatingsConfig())
+
+	assert.Equal(t, uint32(53), bsr.ComputeIncreaseProposer(sharding.MetachainShardId, 50))
+	assert.Equal(t, uint32(44), bsr.ComputeDecreaseProposer(sharding.MetachainShardId, 50))
+	assert.Equal(t, uint32(55), bsr.ComputeIncreaseValidator(sharding.MetachainShardId, 50))
+	assert.Equal(t, uint32(43), bsr.ComputeDecreaseValidator(sharding.MetachainShardId, 50))
+}
+
+func TestBlockSigningRater_ComputeIncreaseShouldNotExceedMaxRating(t *testing.T) {
+	t.Parallel()
+
+	bsr, _ := rating.NewBlockSigningRater(createDummyRatingsConfig())
+
+	assert.Equal(t, uint32(100), bsr.ComputeIncreaseProposer(0, 99))
+	assert.Equal(t, uint32(100), bsr.ComputeIncreaseValidator(0, 100))
+	assert.Equal(t, uint32(100), bsr.ComputeIncreaseValidator(0, 150))
+}
+
+func TestBlockSigningRater_ComputeDecreaseShouldNotGoBelowMinRating(t *testing.T) {
+	t.Parallel()
+
+	bsr, _ := rating.NewBlockSigningRater(createDummyRatingsConfig())
+
+	assert.Equal(t, uint32(1), bsr.ComputeDecreaseProposer(0, 3))
+	assert.Equal(t, uint32(1), bsr.ComputeDecreaseValidator(0, 1))
+	assert.Equal(t, uint32(1), bsr.ComputeDecreaseValidator(0, 0))
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("process/rewardTransaction")
 
 // RewardTxInterceptor is used for intercepting reward transactions and storing them into a datapool
 type RewardTxInterceptor struct {
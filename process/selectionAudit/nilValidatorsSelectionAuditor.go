@@ -0,0 +1,39 @@
+package selectionAudit
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// nilValidatorsSelectionAuditor is used when the validators selection audit is disabled: the committed blocks are
+// ignored
+type nilValidatorsSelectionAuditor struct {
+}
+
+// NewNilValidatorsSelectionAuditor creates a new validators selection auditor which does not count anything
+func NewNilValidatorsSelectionAuditor() *nilValidatorsSelectionAuditor {
+	return &nilValidatorsSelectionAuditor{}
+}
+
+// AuditCommittedBlock does nothing
+func (nvsa *nilValidatorsSelectionAuditor) AuditCommittedBlock(_ data.HeaderHandler) {
+}
+
+// EpochAudit returns ErrValidatorsSelectionAuditDisabled
+func (nvsa *nilValidatorsSelectionAuditor) EpochAudit(_ uint32) (*audit.EpochSelectionAudit, error) {
+	return nil, process.ErrValidatorsSelectionAuditDisabled
+}
+
+// IsEnabled returns false as nothing is audited
+func (nvsa *nilValidatorsSelectionAuditor) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nvsa *nilValidatorsSelectionAuditor) IsInterfaceNil() bool {
+	if nvsa == nil {
+		return true
+	}
+	return false
+}
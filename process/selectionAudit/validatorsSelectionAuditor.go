@@ -0,0 +1,304 @@
+package selectionAudit
+
+import (
+	"encoding/hex"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("process/selectionAudit")
+
+// maxMissedRoundsToAudit bounds the number of rounds without blocks audited at once, after a long network stall
+const maxMissedRoundsToAudit = 1000
+
+// ArgValidatorsSelectionAuditor holds all dependencies required by the validators selection auditor in order to
+// create a new instance
+type ArgValidatorsSelectionAuditor struct {
+	NodesCoordinator   sharding.NodesCoordinator
+	ShardCoordinator   sharding.Coordinator
+	DeviationThreshold float64
+	NumEpochsToKeep    int
+}
+
+type selectionCounters struct {
+	numLeaderSelections    uint64
+	numConsensusSelections uint64
+	numSignatures          uint64
+}
+
+type epochCounters struct {
+	epoch      uint32
+	numRounds  uint64
+	validators map[string]*selectionCounters
+}
+
+// validatorsSelectionAuditor counts, for each round of the own shard, the leader and the consensus group computed by
+// the nodes coordinator and the signers of the committed block. The rounds without a committed block are counted too,
+// with the randomness of the next committed block. The counts are compared with the expectation of an uniform
+// selection among the eligible validators: a validator deviating more than the threshold is flagged, which may reveal
+// a nodes coordinator or a randomness bug
+type validatorsSelectionAuditor struct {
+	nodesCoordinator   sharding.NodesCoordinator
+	shardId            uint32
+	deviationThreshold float64
+	numEpochsToKeep    int
+
+	mutAudit         sync.RWMutex
+	current          *epochCounters
+	lastAuditedRound uint64
+	hasAuditedRound  bool
+	endedEpochs      map[uint32]*audit.EpochSelectionAudit
+	endedOrder       []uint32
+}
+
+// NewValidatorsSelectionAuditor creates a new validators selection auditor
+func NewValidatorsSelectionAuditor(args ArgValidatorsSelectionAuditor) (*validatorsSelectionAuditor, error) {
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if args.DeviationThreshold <= 0 {
+		return nil, process.ErrInvalidDeviationThreshold
+	}
+	if args.NumEpochsToKeep < 1 {
+		return nil, process.ErrInvalidNumEpochsToKeep
+	}
+
+	return &validatorsSelectionAuditor{
+		nodesCoordinator:   args.NodesCoordinator,
+		shardId:            args.ShardCoordinator.SelfId(),
+		deviationThreshold: args.DeviationThreshold,
+		numEpochsToKeep:    args.NumEpochsToKeep,
+		endedEpochs:        make(map[uint32]*audit.EpochSelectionAudit),
+	}, nil
+}
+
+// AuditCommittedBlock counts the selections of the round of the committed block and of the rounds without a block
+// since the previously audited one
+func (vsa *validatorsSelectionAuditor) AuditCommittedBlock(header data.HeaderHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	vsa.mutAudit.Lock()
+	defer vsa.mutAudit.Unlock()
+
+	if vsa.current == nil || vsa.current.epoch != header.GetEpoch() {
+		vsa.endCurrentEpoch()
+		vsa.current = &epochCounters{
+			epoch:      header.GetEpoch(),
+			validators: make(map[string]*selectionCounters),
+		}
+		vsa.hasAuditedRound = false
+	}
+
+	round := header.GetRound()
+	if vsa.hasAuditedRound && round <= vsa.lastAuditedRound {
+		return
+	}
+
+	firstRound := round
+	if vsa.hasAuditedRound {
+		firstRound = vsa.lastAuditedRound + 1
+	}
+	if round-firstRound > maxMissedRoundsToAudit {
+		firstRound = round - maxMissedRoundsToAudit
+	}
+
+	for missedRound := firstRound; missedRound < round; missedRound++ {
+		_, _ = vsa.auditRound(header.GetPrevRandSeed(), missedRound)
+	}
+
+	consensusGroup, err := vsa.auditRound(header.GetPrevRandSeed(), round)
+	if err != nil {
+		log.Debug("validators selection audit", "round", round, "error", err.Error())
+		return
+	}
+	vsa.countSignatures(consensusGroup, header.GetPubKeysBitmap())
+
+	vsa.lastAuditedRound = round
+	vsa.hasAuditedRound = true
+}
+
+func (vsa *validatorsSelectionAuditor) auditRound(randomness []byte, round uint64) ([]string, error) {
+	consensusGroup, err := vsa.nodesCoordinator.GetValidatorsPublicKeys(randomness, round, vsa.shardId)
+	if err != nil {
+		return nil, err
+	}
+	if len(consensusGroup) == 0 {
+		return consensusGroup, nil
+	}
+
+	vsa.current.numRounds++
+	vsa.counters(consensusGroup[0]).numLeaderSelections++
+	for _, pubKey := range consensusGroup {
+		vsa.counters(pubKey).numConsensusSelections++
+	}
+
+	return consensusGroup, nil
+}
+
+func (vsa *validatorsSelectionAuditor) countSignatures(consensusGroup []string, pubKeysBitmap []byte) {
+	for i, pubKey := range consensusGroup {
+		if i/8 >= len(pubKeysBitmap) {
+			return
+		}
+		if pubKeysBitmap[i/8]&(1<<uint(i%8)) != 0 {
+			vsa.counters(pubKey).numSignatures++
+		}
+	}
+}
+
+func (vsa *validatorsSelectionAuditor) counters(pubKey string) *selectionCounters {
+	counters, ok := vsa.current.validators[pubKey]
+	if !ok {
+		counters = &selectionCounters{}
+		vsa.current.validators[pubKey] = counters
+	}
+
+	return counters
+}
+
+func (vsa *validatorsSelectionAuditor) endCurrentEpoch() {
+	if vsa.current == nil {
+		return
+	}
+
+	epochAudit := vsa.computeEpochAudit(vsa.current)
+	for _, validator := range epochAudit.Validators {
+		if !validator.Flagged {
+			continue
+		}
+
+		log.Warn("validator selection deviates from the expectation",
+			"epoch", epochAudit.Epoch,
+			"shard", epochAudit.ShardID,
+			"pk", validator.PublicKey,
+			"leader", validator.NumLeaderSelections,
+			"expected leader", validator.ExpectedLeaderSelections,
+			"consensus", validator.NumConsensusSelections,
+			"expected consensus", validator.ExpectedConsensusSelections,
+		)
+	}
+
+	vsa.endedEpochs[epochAudit.Epoch] = epochAudit
+	vsa.endedOrder = append(vsa.endedOrder, epochAudit.Epoch)
+	if len(vsa.endedOrder) > vsa.numEpochsToKeep {
+		delete(vsa.endedEpochs, vsa.endedOrder[0])
+		vsa.endedOrder = vsa.endedOrder[1:]
+	}
+}
+
+func (vsa *validatorsSelectionAuditor) computeEpochAudit(counters *epochCounters) *audit.EpochSelectionAudit {
+	eligible := vsa.nodesCoordinator.GetAllValidatorsPublicKeys()[vsa.shardId]
+	pubKeys := make(map[string]struct{}, len(eligible)+len(counters.validators))
+	for _, pubKey := range eligible {
+		pubKeys[string(pubKey)] = struct{}{}
+	}
+	for pubKey := range counters.validators {
+		pubKeys[pubKey] = struct{}{}
+	}
+
+	numEligible := len(eligible)
+	consensusGroupSize := vsa.nodesCoordinator.ConsensusGroupSize(vsa.shardId)
+	if consensusGroupSize > numEligible {
+		consensusGroupSize = numEligible
+	}
+
+	leaderProbability := 0.0
+	consensusProbability := 0.0
+	if numEligible > 0 {
+		leaderProbability = 1 / float64(numEligible)
+		consensusProbability = float64(consensusGroupSize) / float64(numEligible)
+	}
+
+	validators := make([]*audit.ValidatorSelection, 0, len(pubKeys))
+	for pubKey := range pubKeys {
+		validatorCounters, ok := counters.validators[pubKey]
+		if !ok {
+			validatorCounters = &selectionCounters{}
+		}
+
+		expectedLeader, leaderDeviation := deviation(validatorCounters.numLeaderSelections, counters.numRounds, leaderProbability)
+		expectedConsensus, consensusDeviation := deviation(validatorCounters.numConsensusSelections, counters.numRounds, consensusProbability)
+
+		validators = append(validators, &audit.ValidatorSelection{
+			PublicKey:                   hex.EncodeToString([]byte(pubKey)),
+			NumLeaderSelections:         validatorCounters.numLeaderSelections,
+			ExpectedLeaderSelections:    expectedLeader,
+			LeaderDeviation:             leaderDeviation,
+			NumConsensusSelections:      validatorCounters.numConsensusSelections,
+			ExpectedConsensusSelections: expectedConsensus,
+			ConsensusDeviation:          consensusDeviation,
+			NumSignatures:               validatorCounters.numSignatures,
+			Flagged: math.Abs(leaderDeviation) > vsa.deviationThreshold ||
+				math.Abs(consensusDeviation) > vsa.deviationThreshold,
+		})
+	}
+
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].PublicKey < validators[j].PublicKey
+	})
+
+	return &audit.EpochSelectionAudit{
+		Epoch:              counters.epoch,
+		ShardID:            vsa.shardId,
+		NumRounds:          counters.numRounds,
+		NumEligible:        numEligible,
+		ConsensusGroupSize: consensusGroupSize,
+		Validators:         validators,
+	}
+}
+
+// deviation returns the expected number of selections in the given number of rounds and how many standard
+// deviations the observed selections are away from it
+func deviation(observed uint64, numRounds uint64, probability float64) (float64, float64) {
+	expected := float64(numRounds) * probability
+	stdDev := math.Sqrt(float64(numRounds) * probability * (1 - probability))
+	if stdDev == 0 {
+		return expected, 0
+	}
+
+	return expected, (float64(observed) - expected) / stdDev
+}
+
+// EpochAudit returns the validators selection audit of the given epoch. The audit of the current epoch is computed
+// from the rounds audited so far
+func (vsa *validatorsSelectionAuditor) EpochAudit(epoch uint32) (*audit.EpochSelectionAudit, error) {
+	vsa.mutAudit.RLock()
+	defer vsa.mutAudit.RUnlock()
+
+	if vsa.current != nil && vsa.current.epoch == epoch {
+		return vsa.computeEpochAudit(vsa.current), nil
+	}
+
+	epochAudit, ok := vsa.endedEpochs[epoch]
+	if !ok {
+		return nil, process.ErrEpochSelectionAuditNotFound
+	}
+
+	return epochAudit, nil
+}
+
+// IsEnabled returns true as the committed blocks are audited
+func (vsa *validatorsSelectionAuditor) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vsa *validatorsSelectionAuditor) IsInterfaceNil() bool {
+	if vsa == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,243 @@
+package selectionAudit_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/audit"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/selectionAudit"
+	"github.com/stretchr/testify/assert"
+)
+
+var eligible = [][]byte{[]byte("pk0"), []byte("pk1"), []byte("pk2"), []byte("pk3")}
+
+// createNodesCoordinator selects a consensus group of two validators, led by the validator given by the round
+func createNodesCoordinator(leaderOfRound func(round uint64) int) *mock.NodesCoordinatorMock {
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	nodesCoordinator.ShardConsensusSize = 2
+	nodesCoordinator.GetAllValidatorsPublicKeysCalled = func() map[uint32][][]byte {
+		return map[uint32][][]byte{0: eligible}
+	}
+	nodesCoordinator.GetValidatorsPublicKeysCalled = func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+		leader := leaderOfRound(round)
+		return []string{string(eligible[leader]), string(eligible[(leader+1)%len(eligible)])}, nil
+	}
+
+	return nodesCoordinator
+}
+
+func createMockArgValidatorsSelectionAuditor() selectionAudit.ArgValidatorsSelectionAuditor {
+	return selectionAudit.ArgValidatorsSelectionAuditor{
+		NodesCoordinator: createNodesCoordinator(func(round uint64) int {
+			return int(round % uint64(len(eligible)))
+		}),
+		ShardCoordinator:   mock.NewOneShardCoordinatorMock(),
+		DeviationThreshold: 3,
+		NumEpochsToKeep:    2,
+	}
+}
+
+func validatorAudit(epochAudit *audit.EpochSelectionAudit, pubKey []byte) *audit.ValidatorSelection {
+	for _, validator := range epochAudit.Validators {
+		if validator.PublicKey == hex.EncodeToString(pubKey) {
+			return validator
+		}
+	}
+
+	return nil
+}
+
+//------- NewValidatorsSelectionAuditor
+
+func TestNewValidatorsSelectionAuditor_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgValidatorsSelectionAuditor()
+	args.NodesCoordinator = nil
+	vsa, err := selectionAudit.NewValidatorsSelectionAuditor(args)
+
+	assert.Nil(t, vsa)
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+}
+
+func TestNewValidatorsSelectionAuditor_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgValidatorsSelectionAuditor()
+	args.ShardCoordinator = nil
+	vsa, err := selectionAudit.NewValidatorsSelectionAuditor(args)
+
+	assert.Nil(t, vsa)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestNewValidatorsSelectionAuditor_InvalidDeviationThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgValidatorsSelectionAuditor()
+	args.DeviationThreshold = 0
+	vsa, err := selectionAudit.NewValidatorsSelectionAuditor(args)
+
+	assert.Nil(t, vsa)
+	assert.Equal(t, process.ErrInvalidDeviationThreshold, err)
+}
+
+func TestNewValidatorsSelectionAuditor_InvalidNumEpochsToKeepShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgValidatorsSelectionAuditor()
+	args.NumEpochsToKeep = 0
+	vsa, err := selectionAudit.NewValidatorsSelectionAuditor(args)
+
+	assert.Nil(t, vsa)
+	assert.Equal(t, process.ErrInvalidNumEpochsToKeep, err)
+}
+
+func TestNewValidatorsSelectionAuditor_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vsa, err := selectionAudit.NewValidatorsSelectionAuditor(createMockArgValidatorsSelectionAuditor())
+
+	assert.Nil(t, err)
+	assert.False(t, vsa.IsInterfaceNil())
+	assert.True(t, vsa.IsEnabled())
+}
+
+//------- AuditCommittedBlock
+
+func TestValidatorsSelectionAuditor_AuditCommittedBlockShouldCountSelectionsAndSignatures(t *testing.T) {
+	t.Parallel()
+
+	vsa, _ := selectionAudit.NewValidatorsSelectionAuditor(createMockArgValidatorsSelectionAuditor())
+
+	vsa.AuditCommittedBlock(&block.Header{Round: 1, PubKeysBitmap: []byte{3}})
+	vsa.AuditCommittedBlock(&block.Header{Round: 2, PubKeysBitmap: []byte{1}})
+	vsa.AuditCommittedBlock(&block.Header{Round: 2, PubKeysBitmap: []byte{1}})
+
+	epochAudit, err := vsa.EpochAudit(0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), epochAudit.NumRounds)
+	assert.Equal(t, 4, epochAudit.NumEligible)
+	assert.Equal(t, 2, epochAudit.ConsensusGroupSize)
+	assert.Equal(t, 4, len(epochAudit.Validators))
+
+	pk1 := validatorAudit(epochAudit, eligible[1])
+	assert.Equal(t, uint64(1), pk1.NumLeaderSelections)
+	assert.Equal(t, uint64(1), pk1.NumConsensusSelections)
+	assert.Equal(t, uint64(1), pk1.NumSignatures)
+	assert.Equal(t, 0.5, pk1.ExpectedLeaderSelections)
+
+	pk2 := validatorAudit(epochAudit, eligible[2])
+	assert.Equal(t, uint64(1), pk2.NumLeaderSelections)
+	assert.Equal(t, uint64(2), pk2.NumConsensusSelections)
+	assert.Equal(t, uint64(2), pk2.NumSignatures)
+
+	pk0 := validatorAudit(epochAudit, eligible[0])
+	assert.Equal(t, uint64(0), pk0.NumConsensusSelections)
+	assert.Equal(t, float64(1), pk0.ExpectedConsensusSelections)
+}
+
+func TestValidatorsSelectionAuditor_AuditCommittedBlockShouldCountTheRoundsWithoutBlocks(t *testing.T) {
+	t.Parallel()
+
+	vsa, _ := selectionAudit.NewValidatorsSelectionAuditor(createMockArgValidatorsSelectionAuditor())
+
+	vsa.AuditCommittedBlock(&block.Header{Round: 1})
+	vsa.AuditCommittedBlock(&block.Header{Round: 5})
+
+	epochAudit, _ := vsa.EpochAudit(0)
+	assert.Equal(t, uint64(5), epochAudit.NumRounds)
+	assert.Equal(t, uint64(2), validatorAudit(epochAudit, eligible[1]).NumLeaderSelections)
+}
+
+func TestValidatorsSelectionAuditor_AuditCommittedBlockSelectionErrorShouldNotCount(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgValidatorsSelectionAuditor()
+	nodesCoordinator := createNodesCoordinator(nil)
+	nodesCoordinator.GetValidatorsPublicKeysCalled = func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+		return nil, errors.New("selection error")
+	}
+	args.NodesCoordinator = nodesCoordinator
+	vsa, _ := selectionAudit.NewValidatorsSelectionAuditor(args)
+
+	vsa.AuditCommittedBlock(&block.Header{Round: 1})
+
+	epochAudit, _ := vsa.EpochAudit(0)
+	assert.Equal(t, uint64(0), epochAudit.NumRounds)
+}
+
+func TestValidatorsSelectionAuditor_UnfairSelectionShouldBeFlagged(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgValidatorsSelectionAuditor()
+	args.NodesCoordinator = createNodesCoordinator(func(round uint64) int {
+		return 0
+	})
+	vsa, _ := selectionAudit.NewValidatorsSelectionAuditor(args)
+
+	for round := uint64(1); round <= 100; round++ {
+		vsa.AuditCommittedBlock(&block.Header{Round: round})
+	}
+
+	epochAudit, _ := vsa.EpochAudit(0)
+	assert.True(t, validatorAudit(epochAudit, eligible[0]).Flagged)
+	assert.True(t, validatorAudit(epochAudit, eligible[2]).Flagged)
+	assert.True(t, validatorAudit(epochAudit, eligible[0]).LeaderDeviation > 3)
+}
+
+func TestValidatorsSelectionAuditor_FairSelectionShouldNotBeFlagged(t *testing.T) {
+	t.Parallel()
+
+	vsa, _ := selectionAudit.NewValidatorsSelectionAuditor(createMockArgValidatorsSelectionAuditor())
+
+	for round := uint64(1); round <= 100; round++ {
+		vsa.AuditCommittedBlock(&block.Header{Round: round})
+	}
+
+	epochAudit, _ := vsa.EpochAudit(0)
+	for _, validator := range epochAudit.Validators {
+		assert.False(t, validator.Flagged)
+	}
+}
+
+//------- EpochAudit
+
+func TestValidatorsSelectionAuditor_EpochAuditShouldKeepTheLastEndedEpochs(t *testing.T) {
+	t.Parallel()
+
+	vsa, _ := selectionAudit.NewValidatorsSelectionAuditor(createMockArgValidatorsSelectionAuditor())
+
+	vsa.AuditCommittedBlock(&block.Header{Round: 1, Epoch: 0})
+	vsa.AuditCommittedBlock(&block.Header{Round: 2, Epoch: 1})
+	vsa.AuditCommittedBlock(&block.Header{Round: 3, Epoch: 1})
+	vsa.AuditCommittedBlock(&block.Header{Round: 4, Epoch: 2})
+	vsa.AuditCommittedBlock(&block.Header{Round: 5, Epoch: 3})
+
+	_, err := vsa.EpochAudit(0)
+	assert.Equal(t, process.ErrEpochSelectionAuditNotFound, err)
+
+	epochAudit, err := vsa.EpochAudit(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), epochAudit.NumRounds)
+
+	epochAudit, err = vsa.EpochAudit(3)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), epochAudit.NumRounds)
+}
+
+func TestNilValidatorsSelectionAuditor_EpochAuditShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nvsa := selectionAudit.NewNilValidatorsSelectionAuditor()
+	nvsa.AuditCommittedBlock(&block.Header{Round: 1})
+	epochAudit, err := nvsa.EpochAudit(0)
+
+	assert.Nil(t, epochAudit)
+	assert.Equal(t, process.ErrValidatorsSelectionAuditDisabled, err)
+	assert.False(t, nvsa.IsEnabled())
+}
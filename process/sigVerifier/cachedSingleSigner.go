@@ -0,0 +1,70 @@
+package sigVerifier
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// CachedSingleSigner wraps a single signer so that its verifications are done through a SigVerifier
+type CachedSingleSigner struct {
+	signer   crypto.SingleSigner
+	verifier *SigVerifier
+	hasher   hashing.Hasher
+}
+
+// NewCachedSingleSigner creates a new cached single signer
+func NewCachedSingleSigner(signer crypto.SingleSigner, verifier *SigVerifier, hasher hashing.Hasher) (*CachedSingleSigner, error) {
+	if check.IfNil(signer) {
+		return nil, process.ErrNilSingleSigner
+	}
+	if verifier == nil {
+		return nil, ErrNilSigVerifier
+	}
+	if check.IfNil(hasher) {
+		return nil, process.ErrNilHasher
+	}
+
+	return &CachedSingleSigner{
+		signer:   signer,
+		verifier: verifier,
+		hasher:   hasher,
+	}, nil
+}
+
+// Sign signs the message with the wrapped signer
+func (css *CachedSingleSigner) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	return css.signer.Sign(private, msg)
+}
+
+// Verify checks the signature of the message, skipping the verification if the same public key, message
+// and signature were already verified successfully
+func (css *CachedSingleSigner) Verify(public crypto.PublicKey, msg []byte, sig []byte) error {
+	if check.IfNil(public) {
+		return crypto.ErrNilPublicKey
+	}
+
+	pubKeyBytes, err := public.ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	buff := make([]byte, 0, len(pubKeyBytes)+len(sig)+len(msg))
+	buff = append(buff, pubKeyBytes...)
+	buff = append(buff, sig...)
+	buff = append(buff, msg...)
+	key := css.hasher.Compute(string(buff))
+
+	return css.verifier.Verify(key, func() error {
+		return css.signer.Verify(public, msg, sig)
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (css *CachedSingleSigner) IsInterfaceNil() bool {
+	if css == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package sigVerifier_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/sigVerifier"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPublicKey(pubKeyBytes []byte) crypto.PublicKey {
+	return &mock.SingleSignPublicKey{
+		ToByteArrayCalled: func() ([]byte, error) {
+			return pubKeyBytes, nil
+		},
+	}
+}
+
+func TestNewCachedSingleSigner_NilSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sv, _ := sigVerifier.NewSigVerifier(createMockArgSigVerifier())
+	defer func() {
+		_ = sv.Close()
+	}()
+	css, err := sigVerifier.NewCachedSingleSigner(nil, sv, &mock.HasherMock{})
+
+	assert.Nil(t, css)
+	assert.Equal(t, process.ErrNilSingleSigner, err)
+}
+
+func TestNewCachedSingleSigner_NilSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	css, err := sigVerifier.NewCachedSingleSigner(&mock.SignerMock{}, nil, &mock.HasherMock{})
+
+	assert.Nil(t, css)
+	assert.Equal(t, sigVerifier.ErrNilSigVerifier, err)
+}
+
+func TestNewCachedSingleSigner_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sv, _ := sigVerifier.NewSigVerifier(createMockArgSigVerifier())
+	defer func() {
+		_ = sv.Close()
+	}()
+	css, err := sigVerifier.NewCachedSingleSigner(&mock.SignerMock{}, sv, nil)
+
+	assert.Nil(t, css)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestCachedSingleSigner_VerifyShouldCacheOnlyIdenticalInputs(t *testing.T) {
+	t.Parallel()
+
+	sv, _ := sigVerifier.NewSigVerifier(createMockArgSigVerifier())
+	defer func() {
+		_ = sv.Close()
+	}()
+	numCalls := 0
+	signer := &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			numCalls++
+			return nil
+		},
+	}
+	css, _ := sigVerifier.NewCachedSingleSigner(signer, sv, &mock.HasherMock{})
+
+	pubKey := createPublicKey([]byte("pub key"))
+	_ = css.Verify(pubKey, []byte("msg"), []byte("sig"))
+	_ = css.Verify(pubKey, []byte("msg"), []byte("sig"))
+	assert.Equal(t, 1, numCalls)
+
+	_ = css.Verify(pubKey, []byte("msg"), []byte("other sig"))
+	assert.Equal(t, 2, numCalls)
+
+	_ = css.Verify(createPublicKey([]byte("other pub key")), []byte("msg"), []byte("sig"))
+	assert.Equal(t, 3, numCalls)
+}
+
+func TestCachedSingleSigner_VerifyNilPublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sv, _ := sigVerifier.NewSigVerifier(createMockArgSigVerifier())
+	defer func() {
+		_ = sv.Close()
+	}()
+	css, _ := sigVerifier.NewCachedSingleSigner(&mock.SignerMock{}, sv, &mock.HasherMock{})
+
+	err := css.Verify(nil, []byte("msg"), []byte("sig"))
+
+	assert.Equal(t, crypto.ErrNilPublicKey, err)
+}
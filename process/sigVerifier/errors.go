@@ -0,0 +1,14 @@
+package sigVerifier
+
+import (
+	"errors"
+)
+
+// ErrInvalidNumWorkers signals that an invalid number of verification workers has been provided
+var ErrInvalidNumWorkers = errors.New("invalid number of verification workers")
+
+// ErrSigVerifierClosed signals that a verification was requested after the signature verifier was closed
+var ErrSigVerifierClosed = errors.New("signature verifier is closed")
+
+// ErrNilSigVerifier signals that a nil signature verifier has been provided
+var ErrNilSigVerifier = errors.New("nil signature verifier")
@@ -0,0 +1,134 @@
+package sigVerifier
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("process/sigVerifier")
+
+// ArgSigVerifier is the argument structure used to create a new signature verifier
+type ArgSigVerifier struct {
+	Cacher        storage.Cacher
+	NumWorkers    int
+	StatusHandler core.AppStatusHandler
+}
+
+type verifyJob struct {
+	handler func() error
+	result  chan error
+}
+
+// SigVerifier runs the signature verifications on a fixed size pool of workers and remembers the keys of the
+// successful ones, so that the same data gossiped again by other peers does not get verified twice
+type SigVerifier struct {
+	cacher        storage.Cacher
+	statusHandler core.AppStatusHandler
+	jobs          chan *verifyJob
+	ctx           context.Context
+	cancel        func()
+	hits          uint64
+	misses        uint64
+}
+
+// NewSigVerifier creates a new signature verifier and starts its workers
+func NewSigVerifier(arg ArgSigVerifier) (*SigVerifier, error) {
+	if check.IfNil(arg.Cacher) {
+		return nil, process.ErrNilCacher
+	}
+	if arg.NumWorkers <= 0 {
+		return nil, ErrInvalidNumWorkers
+	}
+	if check.IfNil(arg.StatusHandler) {
+		return nil, process.ErrNilAppStatusHandler
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sv := &SigVerifier{
+		cacher:        arg.Cacher,
+		statusHandler: arg.StatusHandler,
+		jobs:          make(chan *verifyJob, arg.NumWorkers),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+
+	for i := 0; i < arg.NumWorkers; i++ {
+		go sv.worker()
+	}
+
+	return sv, nil
+}
+
+func (sv *SigVerifier) worker() {
+	for {
+		select {
+		case job := <-sv.jobs:
+			job.result <- job.handler()
+		case <-sv.ctx.Done():
+			return
+		}
+	}
+}
+
+// Verify returns nil without calling the handler if a verification with the same key already succeeded.
+// Otherwise, the handler is executed on one of the workers and its result is returned, the key being cached
+// only if the verification succeeded
+func (sv *SigVerifier) Verify(key []byte, handler func() error) error {
+	if sv.cacher.Has(key) {
+		sv.updateMetrics(atomic.AddUint64(&sv.hits, 1), atomic.LoadUint64(&sv.misses))
+		return nil
+	}
+	sv.updateMetrics(atomic.LoadUint64(&sv.hits), atomic.AddUint64(&sv.misses, 1))
+
+	job := &verifyJob{
+		handler: handler,
+		result:  make(chan error, 1),
+	}
+
+	select {
+	case sv.jobs <- job:
+	case <-sv.ctx.Done():
+		return ErrSigVerifierClosed
+	}
+
+	var err error
+	select {
+	case err = <-job.result:
+	case <-sv.ctx.Done():
+		return ErrSigVerifierClosed
+	}
+	if err != nil {
+		return err
+	}
+
+	_ = sv.cacher.Put(key, struct{}{})
+
+	return nil
+}
+
+func (sv *SigVerifier) updateMetrics(hits uint64, misses uint64) {
+	sv.statusHandler.SetUInt64Value(core.MetricSigVerifierCacheHits, hits)
+	sv.statusHandler.SetUInt64Value(core.MetricSigVerifierCacheMisses, misses)
+	sv.statusHandler.SetUInt64Value(core.MetricSigVerifierCacheHitRatio, hits*100/(hits+misses))
+}
+
+// Close stops the workers. The verifications requested afterwards will fail
+func (sv *SigVerifier) Close() error {
+	log.Debug("closing the signature verifier workers")
+	sv.cancel()
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sv *SigVerifier) IsInterfaceNil() bool {
+	if sv == nil {
+		return true
+	}
+	return false
+}
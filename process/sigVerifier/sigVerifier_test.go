@@ -0,0 +1,199 @@
+package sigVerifier_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/sigVerifier"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgSigVerifier() sigVerifier.ArgSigVerifier {
+	cacher, _ := lrucache.NewCache(100)
+
+	return sigVerifier.ArgSigVerifier{
+		Cacher:     cacher,
+		NumWorkers: 2,
+		StatusHandler: &mock.AppStatusHandlerStub{
+			SetUInt64ValueHandler: func(key string, value uint64) {},
+		},
+	}
+}
+
+//------- NewSigVerifier
+
+func TestNewSigVerifier_NilCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSigVerifier()
+	arg.Cacher = nil
+	sv, err := sigVerifier.NewSigVerifier(arg)
+
+	assert.Nil(t, sv)
+	assert.Equal(t, process.ErrNilCacher, err)
+}
+
+func TestNewSigVerifier_InvalidNumWorkersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSigVerifier()
+	arg.NumWorkers = 0
+	sv, err := sigVerifier.NewSigVerifier(arg)
+
+	assert.Nil(t, sv)
+	assert.Equal(t, sigVerifier.ErrInvalidNumWorkers, err)
+}
+
+func TestNewSigVerifier_NilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSigVerifier()
+	arg.StatusHandler = nil
+	sv, err := sigVerifier.NewSigVerifier(arg)
+
+	assert.Nil(t, sv)
+	assert.Equal(t, process.ErrNilAppStatusHandler, err)
+}
+
+func TestNewSigVerifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sv, err := sigVerifier.NewSigVerifier(createMockArgSigVerifier())
+
+	assert.Nil(t, err)
+	assert.False(t, sv.IsInterfaceNil())
+	_ = sv.Close()
+}
+
+//------- Verify
+
+func TestSigVerifier_VerifySameKeyTwiceShouldCallHandlerOnce(t *testing.T) {
+	t.Parallel()
+
+	sv, _ := sigVerifier.NewSigVerifier(createMockArgSigVerifier())
+	defer func() {
+		_ = sv.Close()
+	}()
+
+	numCalls := 0
+	handler := func() error {
+		numCalls++
+		return nil
+	}
+
+	assert.Nil(t, sv.Verify([]byte("key"), handler))
+	assert.Nil(t, sv.Verify([]byte("key"), handler))
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestSigVerifier_VerifyFailedShouldNotCache(t *testing.T) {
+	t.Parallel()
+
+	sv, _ := sigVerifier.NewSigVerifier(createMockArgSigVerifier())
+	defer func() {
+		_ = sv.Close()
+	}()
+
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	handler := func() error {
+		numCalls++
+		return expectedErr
+	}
+
+	assert.Equal(t, expectedErr, sv.Verify([]byte("key"), handler))
+	assert.Equal(t, expectedErr, sv.Verify([]byte("key"), handler))
+	assert.Equal(t, 2, numCalls)
+}
+
+func TestSigVerifier_VerifyShouldUpdateMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := make(map[string]uint64)
+	mutMetrics := sync.Mutex{}
+	arg := createMockArgSigVerifier()
+	arg.StatusHandler = &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			mutMetrics.Lock()
+			metrics[key] = value
+			mutMetrics.Unlock()
+		},
+	}
+	sv, _ := sigVerifier.NewSigVerifier(arg)
+	defer func() {
+		_ = sv.Close()
+	}()
+
+	handler := func() error {
+		return nil
+	}
+	_ = sv.Verify([]byte("key1"), handler)
+	_ = sv.Verify([]byte("key1"), handler)
+	_ = sv.Verify([]byte("key1"), handler)
+	_ = sv.Verify([]byte("key2"), handler)
+
+	mutMetrics.Lock()
+	assert.Equal(t, uint64(2), metrics[core.MetricSigVerifierCacheHits])
+	assert.Equal(t, uint64(2), metrics[core.MetricSigVerifierCacheMisses])
+	assert.Equal(t, uint64(50), metrics[core.MetricSigVerifierCacheHitRatio])
+	mutMetrics.Unlock()
+}
+
+func TestSigVerifier_VerifyConcurrentShouldNotRunMoreHandlersThanWorkers(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSigVerifier()
+	arg.NumWorkers = 3
+	sv, _ := sigVerifier.NewSigVerifier(arg)
+	defer func() {
+		_ = sv.Close()
+	}()
+
+	running := int32(0)
+	maxRunning := int32(0)
+	mutMax := sync.Mutex{}
+	handler := func() error {
+		current := atomic.AddInt32(&running, 1)
+		mutMax.Lock()
+		if current > maxRunning {
+			maxRunning = current
+		}
+		mutMax.Unlock()
+		atomic.AddInt32(&running, -1)
+		return nil
+	}
+
+	numVerifications := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numVerifications)
+	for i := 0; i < numVerifications; i++ {
+		go func(idx int) {
+			_ = sv.Verify([]byte{byte(idx)}, handler)
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	mutMax.Lock()
+	assert.True(t, maxRunning <= int32(arg.NumWorkers))
+	mutMax.Unlock()
+}
+
+func TestSigVerifier_VerifyAfterCloseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sv, _ := sigVerifier.NewSigVerifier(createMockArgSigVerifier())
+	_ = sv.Close()
+
+	err := sv.Verify([]byte("key"), func() error {
+		return nil
+	})
+
+	assert.Equal(t, sigVerifier.ErrSigVerifierClosed, err)
+}
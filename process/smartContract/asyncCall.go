@@ -0,0 +1,390 @@
+package smartContract
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// asyncCallIdentifier is the first topic of the log entry through which a contract requests an asynchronous call.
+// The address of the log entry is the called contract and its data holds the call as function@hexArg1@hexArg2...
+var asyncCallIdentifier = big.NewInt(0).SetBytes([]byte("asyncCall"))
+
+// callBackFunctionName is the function of the calling contract which receives the outcome of an asynchronous call.
+// Its arguments are the return code of the called function followed by its return data
+const callBackFunctionName = "callBack"
+
+type asyncCall struct {
+	destination []byte
+	data        string
+}
+
+// getAsyncCall returns the asynchronous call requested through the VM output logs or nil if there is none
+func (sc *scProcessor) getAsyncCall(logs []*vmcommon.LogEntry) (*asyncCall, error) {
+	var call *asyncCall
+	for _, logEntry := range logs {
+		if logEntry == nil || len(logEntry.Topics) == 0 || logEntry.Topics[0] == nil {
+			continue
+		}
+		if logEntry.Topics[0].Cmp(asyncCallIdentifier) != 0 {
+			continue
+		}
+		if call != nil {
+			return nil, process.ErrMultipleAsyncCalls
+		}
+		if len(logEntry.Data) == 0 || len(logEntry.Address) != sc.adrConv.AddressLen() {
+			return nil, process.ErrInvalidAsyncCall
+		}
+
+		call = &asyncCall{
+			destination: logEntry.Address,
+			data:        string(logEntry.Data),
+		}
+	}
+
+	return call, nil
+}
+
+// createAsyncCallSCR creates the smart contract result which delivers the asynchronous call to the shard of the
+// called contract. The remaining gas is forwarded, except for the gas locked for the callback
+func (sc *scProcessor) createAsyncCallSCR(
+	call *asyncCall,
+	gasRemaining *big.Int,
+	tx *transaction.Transaction,
+	txHash []byte,
+	originalSender []byte,
+) (*smartContractResult.SmartContractResult, error) {
+	address, err := sc.adrConv.CreateAddressFromPublicKeyBytes(call.destination)
+	if err != nil {
+		return nil, err
+	}
+	if sc.shardCoordinator.ComputeId(address) == sc.shardCoordinator.SelfId() {
+		return nil, process.ErrAsyncCallInSameShard
+	}
+	if gasRemaining == nil || !gasRemaining.IsUint64() || gasRemaining.Uint64() <= core.AsyncCallbackGasLock {
+		return nil, process.ErrNotEnoughGasForAsyncCall
+	}
+
+	return &smartContractResult.SmartContractResult{
+		Nonce:          tx.Nonce,
+		Value:          big.NewInt(0),
+		RcvAddr:        call.destination,
+		SndAddr:        tx.RcvAddr,
+		Data:           call.data,
+		TxHash:         txHash,
+		GasLimit:       gasRemaining.Uint64() - core.AsyncCallbackGasLock,
+		GasPrice:       tx.GasPrice,
+		CallType:       smartContractResult.AsynchronousCall,
+		OriginalSender: originalSender,
+	}, nil
+}
+
+// lockCallBackGas saves the gas locked for the callback in the protected storage of the calling contract, under the
+// hash of the transaction which started the asynchronous call
+func (sc *scProcessor) lockCallBackGas(contractAddress []byte, txHash []byte) error {
+	acnt, err := sc.getAccountFromAddress(contractAddress)
+	if err != nil {
+		return err
+	}
+	if acnt == nil || acnt.IsInterfaceNil() {
+		return process.ErrNilSCDestAccount
+	}
+
+	lockedGas := big.NewInt(0).SetUint64(core.AsyncCallbackGasLock)
+	acnt.DataTrieTracker().SaveKeyValue(createGasLockKey(txHash), lockedGas.Bytes())
+
+	return sc.accounts.SaveDataTrie(acnt)
+}
+
+// unlockCallBackGas removes the gas locked by the calling contract for the callback and returns its value
+func (sc *scProcessor) unlockCallBackGas(stAcc *state.Account, txHash []byte) (uint64, error) {
+	key := createGasLockKey(txHash)
+	value, err := stAcc.DataTrieTracker().RetrieveValue(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	stAcc.DataTrieTracker().SaveKeyValue(key, nil)
+	err = sc.accounts.SaveDataTrie(stAcc)
+	if err != nil {
+		return 0, err
+	}
+
+	return big.NewInt(0).SetBytes(value).Uint64(), nil
+}
+
+// processAsynchronousCall executes the function called by a contract from another shard and sends back the outcome
+// through a callback smart contract result, which also carries the gas left unused by the execution
+func (sc *scProcessor) processAsynchronousCall(
+	scr *smartContractResult.SmartContractResult,
+	stAcc *state.Account,
+) error {
+	defer sc.tempAccounts.CleanTempAccounts()
+
+	tx := createTxFromAsyncSCR(scr, scr.GasLimit)
+	returnCode := vmcommon.ContractNotFound
+	returnData := make([]*big.Int, 0)
+	gasRemaining := uint64(0)
+	crossTxs := make([]data.TransactionHandler, 0)
+
+	var vmOutput *vmcommon.VMOutput
+	if len(stAcc.GetCode()) > 0 {
+		var err error
+		vmOutput, err = sc.runAsynchronousExecution(tx)
+		if err != nil {
+			log.Debug("asynchronous call failed", "error", err.Error())
+			returnCode = vmcommon.UserError
+		} else {
+			returnCode = vmOutput.ReturnCode
+			returnData = vmOutput.ReturnData
+			gasRemaining = getGasRemaining(vmOutput, tx.GasLimit)
+		}
+
+		if returnCode == vmcommon.Ok {
+			crossTxs, err = sc.processAsynchronousOutput(vmOutput, tx, scr.TxHash)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	callBack := &smartContractResult.SmartContractResult{
+		Nonce:          scr.Nonce,
+		Value:          big.NewInt(0),
+		RcvAddr:        scr.SndAddr,
+		SndAddr:        scr.RcvAddr,
+		Data:           createCallBackData(returnCode, returnData),
+		TxHash:         scr.TxHash,
+		GasLimit:       gasRemaining,
+		GasPrice:       scr.GasPrice,
+		CallType:       smartContractResult.AsynchronousCallBack,
+		OriginalSender: scr.OriginalSender,
+	}
+	crossTxs = append(crossTxs, callBack)
+
+	err := sc.scrForwarder.AddIntermediateTransactions(crossTxs)
+	if err != nil {
+		return err
+	}
+
+	sc.saveAsynchronousLog(scr, vmOutput, crossTxs)
+
+	consumedGas := tx.GasLimit - gasRemaining
+	sc.txFeeHandler.ProcessTransactionFee(sc.economicsFee.ComputeFeeForProcessing(scr, consumedGas))
+
+	return nil
+}
+
+// processAsynchronousCallBack executes the callback function of the contract which started an asynchronous call,
+// with the gas returned by the called contract and the gas locked for the callback, and gives back the unused gas to
+// the original sender of the transaction
+func (sc *scProcessor) processAsynchronousCallBack(
+	scr *smartContractResult.SmartContractResult,
+	stAcc *state.Account,
+) error {
+	defer sc.tempAccounts.CleanTempAccounts()
+
+	lockedGas, err := sc.unlockCallBackGas(stAcc, scr.TxHash)
+	if err != nil {
+		return err
+	}
+
+	tx := createTxFromAsyncSCR(scr, scr.GasLimit+lockedGas)
+	gasRemaining := uint64(0)
+	crossTxs := make([]data.TransactionHandler, 0)
+
+	vmOutput, err := sc.runAsynchronousCallBack(tx, stAcc)
+	if err != nil {
+		log.Debug("asynchronous callback failed", "error", err.Error())
+	} else if vmOutput.ReturnCode != vmcommon.Ok {
+		log.Debug("asynchronous callback failed", "return code", vmOutput.ReturnCode.String())
+	} else {
+		gasRemaining = getGasRemaining(vmOutput, tx.GasLimit)
+		crossTxs, err = sc.processAsynchronousOutput(vmOutput, tx, scr.TxHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	acntOriginalSender, err := sc.getAccountFromAddress(scr.OriginalSender)
+	if err != nil {
+		return err
+	}
+
+	refundTx := &transaction.Transaction{
+		Nonce:    scr.Nonce,
+		SndAddr:  scr.OriginalSender,
+		RcvAddr:  scr.RcvAddr,
+		GasPrice: scr.GasPrice,
+		GasLimit: tx.GasLimit,
+	}
+	scrRefund, _, err := sc.refundGasToSender(
+		big.NewInt(0).SetUint64(gasRemaining),
+		refundTx,
+		scr.TxHash,
+		acntOriginalSender,
+	)
+	if err != nil {
+		return err
+	}
+	if scrRefund != nil {
+		crossTxs = append(crossTxs, scrRefund)
+	}
+
+	err = sc.scrForwarder.AddIntermediateTransactions(crossTxs)
+	if err != nil {
+		return err
+	}
+
+	sc.saveAsynchronousLog(scr, vmOutput, crossTxs)
+
+	// all the gas of the callback was paid for the smart contract execution
+	consumedFee := sc.economicsFee.ComputeFeeForProcessing(scr, tx.GasLimit-gasRemaining)
+	sc.txFeeHandler.ProcessTransactionFee(consumedFee)
+
+	return nil
+}
+
+// saveAsynchronousLog records the outcome of an asynchronous execution under the hash of the smart contract result
+// which triggered it. Nothing is recorded if the VM could not be run at all
+func (sc *scProcessor) saveAsynchronousLog(
+	scr *smartContractResult.SmartContractResult,
+	vmOutput *vmcommon.VMOutput,
+	crossTxs []data.TransactionHandler,
+) {
+	if vmOutput == nil {
+		return
+	}
+
+	scrHash, err := core.CalculateHash(sc.marshalizer, sc.hasher, scr)
+	if err != nil {
+		log.Debug("could not compute the smart contract result hash", "error", err.Error())
+		return
+	}
+
+	sc.saveTxLog(scrHash, vmOutput, crossTxs)
+}
+
+func (sc *scProcessor) runAsynchronousCallBack(
+	tx *transaction.Transaction,
+	stAcc *state.Account,
+) (*vmcommon.VMOutput, error) {
+	if len(stAcc.GetCode()) == 0 {
+		return nil, process.ErrNilSCDestAccount
+	}
+
+	return sc.runAsynchronousExecution(tx)
+}
+
+func (sc *scProcessor) runAsynchronousExecution(tx *transaction.Transaction) (*vmcommon.VMOutput, error) {
+	err := sc.argsParser.ParseData(tx.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	vmInput, err := sc.createVMCallInput(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	vm, err := sc.getVMFromRecvAddress(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the caller lives in another shard, the VM sees an ephemeral copy of it
+	sc.tempAccounts.AddTempAccount(tx.SndAddr, big.NewInt(0), tx.Nonce)
+	sc.tempAccounts.SetCurrentSmartContract(tx.RcvAddr)
+
+	vmOutput, err := vm.RunSmartContractCall(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput == nil {
+		return nil, process.ErrNilVMOutput
+	}
+
+	return vmOutput, nil
+}
+
+func (sc *scProcessor) processAsynchronousOutput(
+	vmOutput *vmcommon.VMOutput,
+	tx *transaction.Transaction,
+	txHash []byte,
+) ([]data.TransactionHandler, error) {
+	err := sc.processSCOutputAccounts(vmOutput.OutputAccounts, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	crossTxs, err := sc.createSCRTransactions(vmOutput.OutputAccounts, tx, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sc.deleteAccounts(vmOutput.DeletedAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return crossTxs, nil
+}
+
+func createTxFromAsyncSCR(scr *smartContractResult.SmartContractResult, gasLimit uint64) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:    scr.Nonce,
+		Value:    big.NewInt(0),
+		RcvAddr:  scr.RcvAddr,
+		SndAddr:  scr.SndAddr,
+		Data:     scr.Data,
+		GasPrice: scr.GasPrice,
+		GasLimit: gasLimit,
+	}
+}
+
+// createGasLockKey returns the protected data trie key under which the gas for the callback is locked
+func createGasLockKey(txHash []byte) []byte {
+	key := []byte(core.ElrondProtectedKeyPrefix + core.AsyncCallGasLockIdentifier)
+	return append(key, txHash...)
+}
+
+// createCallBackData creates the call of the callback function in the callBack@hexReturnCode@hexReturnData... format
+func createCallBackData(returnCode vmcommon.ReturnCode, returnData []*big.Int) string {
+	tokens := make([]string, 0, len(returnData)+2)
+	tokens = append(tokens, callBackFunctionName)
+	tokens = append(tokens, big.NewInt(int64(returnCode)).Text(16))
+	for _, value := range returnData {
+		if value == nil {
+			value = big.NewInt(0)
+		}
+		tokens = append(tokens, value.Text(16))
+	}
+
+	return strings.Join(tokens, "@")
+}
+
+// getGasRemaining returns the gas left unused by an execution, which can not exceed the provided gas limit
+func getGasRemaining(vmOutput *vmcommon.VMOutput, gasLimit uint64) uint64 {
+	gasRemaining := big.NewInt(0)
+	if vmOutput.GasRemaining != nil {
+		gasRemaining.Add(gasRemaining, vmOutput.GasRemaining)
+	}
+	if vmOutput.GasRefund != nil {
+		gasRemaining.Add(gasRemaining, vmOutput.GasRefund)
+	}
+	if !gasRemaining.IsUint64() || gasRemaining.Uint64() > gasLimit {
+		return gasLimit
+	}
+
+	return gasRemaining.Uint64()
+}
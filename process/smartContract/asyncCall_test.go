@@ -0,0 +1,371 @@
+package smartContract
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+var callerAddress = bytes.Repeat([]byte("A"), 32)
+var calleeAddress = bytes.Repeat([]byte("B"), 32)
+var userAddress = bytes.Repeat([]byte("U"), 32)
+
+type asyncCallTestComponents struct {
+	vm           *mock.VMExecutionHandlerStub
+	accounts     map[string]*state.Account
+	forwarded    []data.TransactionHandler
+	consumedFees *big.Int
+}
+
+// createScProcessorForAsyncCalls creates a processor running in the shard of the given address. Addresses made of
+// 'B' characters are in shard 1, all the others are in shard 0
+func createScProcessorForAsyncCalls(selfAddress []byte) (*scProcessor, *asyncCallTestComponents) {
+	components := &asyncCallTestComponents{
+		vm:           &mock.VMExecutionHandlerStub{},
+		accounts:     make(map[string]*state.Account),
+		consumedFees: big.NewInt(0),
+	}
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		if bytes.Equal(address.Bytes(), calleeAddress) {
+			return 1
+		}
+		return 0
+	}
+	shardCoordinator.CurrentShard = shardCoordinator.ComputeId(mock.NewAddressMock(selfAddress))
+
+	argParser, _ := NewAtArgumentParser()
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+				return components.vm, nil
+			},
+		},
+		argParser,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{
+			GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				acnt, ok := components.accounts[string(addressContainer.Bytes())]
+				if ok {
+					return acnt, nil
+				}
+
+				acnt, _ = state.NewAccount(addressContainer, createAccountTrackerForAsyncCalls())
+				components.accounts[string(addressContainer.Bytes())] = acnt
+				return acnt, nil
+			},
+			SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+				return nil
+			},
+		},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		shardCoordinator,
+		&mock.IntermediateTransactionHandlerMock{
+			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+				components.forwarded = append(components.forwarded, txs...)
+				return nil
+			},
+		},
+		&mock.UnsignedTxHandlerMock{
+			ProcessTransactionFeeCalled: func(cost *big.Int) {
+				components.consumedFees.Add(components.consumedFees, cost)
+			},
+		},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
+	)
+
+	return sc, components
+}
+
+func createAccountTrackerForAsyncCalls() *mock.AccountTrackerStub {
+	return &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+}
+
+func createContractAccount(address []byte) *state.Account {
+	acnt, _ := state.NewAccount(mock.NewAddressMock(address), createAccountTrackerForAsyncCalls())
+	acnt.SetCode([]byte("code"))
+
+	return acnt
+}
+
+func createAsyncCallLog(destination []byte, callData string) *vmcommon.LogEntry {
+	return &vmcommon.LogEntry{
+		Address: destination,
+		Topics:  []*big.Int{asyncCallIdentifier},
+		Data:    []byte(callData),
+	}
+}
+
+func getForwardedSCRs(components *asyncCallTestComponents) []*smartContractResult.SmartContractResult {
+	scrs := make([]*smartContractResult.SmartContractResult, 0, len(components.forwarded))
+	for _, tx := range components.forwarded {
+		scrs = append(scrs, tx.(*smartContractResult.SmartContractResult))
+	}
+
+	return scrs
+}
+
+func TestScProcessor_GetAsyncCallWithoutAsyncLogShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := createScProcessorForAsyncCalls(callerAddress)
+	logs := []*vmcommon.LogEntry{{Address: calleeAddress, Topics: []*big.Int{big.NewInt(1)}, Data: []byte("event")}}
+
+	call, err := sc.getAsyncCall(logs)
+
+	assert.Nil(t, err)
+	assert.Nil(t, call)
+}
+
+func TestScProcessor_GetAsyncCallMultipleCallsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := createScProcessorForAsyncCalls(callerAddress)
+	logs := []*vmcommon.LogEntry{
+		createAsyncCallLog(calleeAddress, "first"),
+		createAsyncCallLog(calleeAddress, "second"),
+	}
+
+	call, err := sc.getAsyncCall(logs)
+
+	assert.Equal(t, process.ErrMultipleAsyncCalls, err)
+	assert.Nil(t, call)
+}
+
+func TestScProcessor_GetAsyncCallInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := createScProcessorForAsyncCalls(callerAddress)
+
+	call, err := sc.getAsyncCall([]*vmcommon.LogEntry{createAsyncCallLog([]byte("short"), "function")})
+
+	assert.Equal(t, process.ErrInvalidAsyncCall, err)
+	assert.Nil(t, call)
+}
+
+func TestScProcessor_CreateAsyncCallSCRSameShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := createScProcessorForAsyncCalls(callerAddress)
+	call := &asyncCall{destination: userAddress, data: "function"}
+	tx := &transaction.Transaction{SndAddr: userAddress, RcvAddr: callerAddress}
+
+	scr, err := sc.createAsyncCallSCR(call, big.NewInt(100000), tx, []byte("hash"), userAddress)
+
+	assert.Equal(t, process.ErrAsyncCallInSameShard, err)
+	assert.Nil(t, scr)
+}
+
+func TestScProcessor_CreateAsyncCallSCRNotEnoughGasShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := createScProcessorForAsyncCalls(callerAddress)
+	call := &asyncCall{destination: calleeAddress, data: "function"}
+	tx := &transaction.Transaction{SndAddr: userAddress, RcvAddr: callerAddress}
+	gasRemaining := big.NewInt(0).SetUint64(core.AsyncCallbackGasLock)
+
+	scr, err := sc.createAsyncCallSCR(call, gasRemaining, tx, []byte("hash"), userAddress)
+
+	assert.Equal(t, process.ErrNotEnoughGasForAsyncCall, err)
+	assert.Nil(t, scr)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionWithAsyncCallShouldLockCallBackGasAndForwardTheRest(t *testing.T) {
+	t.Parallel()
+
+	sc, components := createScProcessorForAsyncCalls(callerAddress)
+	gasRemaining := core.AsyncCallbackGasLock + 500
+	components.vm.RunSmartContractCallCalled = func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+		return &vmcommon.VMOutput{
+			ReturnCode:   vmcommon.Ok,
+			GasRemaining: big.NewInt(0).SetUint64(gasRemaining),
+			GasRefund:    big.NewInt(0),
+			Logs:         []*vmcommon.LogEntry{createAsyncCallLog(calleeAddress, "getValue@05")},
+		}, nil
+	}
+
+	tx := &transaction.Transaction{
+		SndAddr:  userAddress,
+		RcvAddr:  callerAddress,
+		Data:     "callOther",
+		Value:    big.NewInt(0),
+		GasPrice: 2,
+		GasLimit: gasRemaining + 1000,
+	}
+	acntSrc, _ := state.NewAccount(mock.NewAddressMock(userAddress), createAccountTrackerForAsyncCalls())
+	acntSrc.Balance = big.NewInt(0).SetUint64(tx.GasLimit * tx.GasPrice)
+	components.accounts[string(userAddress)] = acntSrc
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, createContractAccount(callerAddress), 10)
+
+	assert.Nil(t, err)
+	scrs := getForwardedSCRs(components)
+	assert.Equal(t, 1, len(scrs))
+	assert.Equal(t, smartContractResult.AsynchronousCall, scrs[0].CallType)
+	assert.Equal(t, calleeAddress, scrs[0].RcvAddr)
+	assert.Equal(t, callerAddress, scrs[0].SndAddr)
+	assert.Equal(t, userAddress, scrs[0].OriginalSender)
+	assert.Equal(t, "getValue@05", scrs[0].Data)
+	assert.Equal(t, uint64(500), scrs[0].GasLimit)
+	assert.Equal(t, tx.GasPrice, scrs[0].GasPrice)
+	assert.Equal(t, uint64(1000*tx.GasPrice), components.consumedFees.Uint64())
+
+	lockedGas := components.accounts[string(callerAddress)].DataTrieTracker().DirtyData()
+	assert.Equal(t, 1, len(lockedGas))
+	for key, value := range lockedGas {
+		assert.True(t, bytes.HasPrefix([]byte(key), createGasLockKey(nil)))
+		assert.Equal(t, core.AsyncCallbackGasLock, big.NewInt(0).SetBytes(value).Uint64())
+	}
+}
+
+func TestScProcessor_ProcessAsynchronousCallShouldExecuteAndSendCallBack(t *testing.T) {
+	t.Parallel()
+
+	sc, components := createScProcessorForAsyncCalls(calleeAddress)
+	components.accounts[string(calleeAddress)] = createContractAccount(calleeAddress)
+	gasLimit := uint64(1000)
+	var callInput *vmcommon.ContractCallInput
+	components.vm.RunSmartContractCallCalled = func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+		callInput = input
+		return &vmcommon.VMOutput{
+			ReturnCode:   vmcommon.Ok,
+			ReturnData:   []*big.Int{big.NewInt(10)},
+			GasRemaining: big.NewInt(400),
+			GasRefund:    big.NewInt(0),
+		}, nil
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:          3,
+		Value:          big.NewInt(0),
+		RcvAddr:        calleeAddress,
+		SndAddr:        callerAddress,
+		Data:           "getValue@05",
+		TxHash:         []byte("tx hash"),
+		GasLimit:       gasLimit,
+		GasPrice:       2,
+		CallType:       smartContractResult.AsynchronousCall,
+		OriginalSender: userAddress,
+	}
+
+	err := sc.ProcessSmartContractResult(scr)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "getValue", callInput.Function)
+	assert.Equal(t, []*big.Int{big.NewInt(5)}, callInput.Arguments)
+	assert.Equal(t, big.NewInt(1000), callInput.GasProvided)
+
+	scrs := getForwardedSCRs(components)
+	assert.Equal(t, 1, len(scrs))
+	callBack := scrs[0]
+	assert.Equal(t, smartContractResult.AsynchronousCallBack, callBack.CallType)
+	assert.Equal(t, callerAddress, callBack.RcvAddr)
+	assert.Equal(t, calleeAddress, callBack.SndAddr)
+	assert.Equal(t, "callBack@0@a", callBack.Data)
+	assert.Equal(t, uint64(400), callBack.GasLimit)
+	assert.Equal(t, userAddress, callBack.OriginalSender)
+	assert.Equal(t, scr.TxHash, callBack.TxHash)
+	assert.Equal(t, uint64(600*2), components.consumedFees.Uint64())
+}
+
+func TestScProcessor_ProcessAsynchronousCallFailedShouldSendErrorCallBack(t *testing.T) {
+	t.Parallel()
+
+	sc, components := createScProcessorForAsyncCalls(calleeAddress)
+	components.accounts[string(calleeAddress)] = createContractAccount(calleeAddress)
+	components.vm.RunSmartContractCallCalled = func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+		return &vmcommon.VMOutput{
+			ReturnCode:   vmcommon.UserError,
+			GasRemaining: big.NewInt(0),
+			GasRefund:    big.NewInt(0),
+		}, nil
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Value:    big.NewInt(0),
+		RcvAddr:  calleeAddress,
+		SndAddr:  callerAddress,
+		Data:     "getValue",
+		GasLimit: 1000,
+		CallType: smartContractResult.AsynchronousCall,
+	}
+
+	err := sc.ProcessSmartContractResult(scr)
+
+	assert.Nil(t, err)
+	scrs := getForwardedSCRs(components)
+	assert.Equal(t, 1, len(scrs))
+	assert.Equal(t, "callBack@4", scrs[0].Data)
+	assert.Equal(t, uint64(0), scrs[0].GasLimit)
+}
+
+func TestScProcessor_ProcessAsynchronousCallBackShouldUnlockGasExecuteAndRefundOriginalSender(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	sc, components := createScProcessorForAsyncCalls(callerAddress)
+	callerAccount := createContractAccount(callerAddress)
+	lockedGas := big.NewInt(0).SetUint64(core.AsyncCallbackGasLock)
+	callerAccount.DataTrieTracker().SaveKeyValue(createGasLockKey(txHash), lockedGas.Bytes())
+	components.accounts[string(callerAddress)] = callerAccount
+	var callInput *vmcommon.ContractCallInput
+	components.vm.RunSmartContractCallCalled = func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+		callInput = input
+		return &vmcommon.VMOutput{
+			ReturnCode:   vmcommon.Ok,
+			GasRemaining: big.NewInt(300),
+			GasRefund:    big.NewInt(0),
+		}, nil
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Value:          big.NewInt(0),
+		RcvAddr:        callerAddress,
+		SndAddr:        calleeAddress,
+		Data:           "callBack@0@a",
+		TxHash:         txHash,
+		GasLimit:       1000,
+		GasPrice:       2,
+		CallType:       smartContractResult.AsynchronousCallBack,
+		OriginalSender: userAddress,
+	}
+
+	err := sc.ProcessSmartContractResult(scr)
+
+	assert.Nil(t, err)
+	assert.Equal(t, callBackFunctionName, callInput.Function)
+	assert.Equal(t, big.NewInt(0).SetUint64(1000+core.AsyncCallbackGasLock), callInput.GasProvided)
+	assert.Equal(t, 2, len(callInput.Arguments))
+	assert.Equal(t, uint64(0), callInput.Arguments[0].Uint64())
+	assert.Equal(t, uint64(10), callInput.Arguments[1].Uint64())
+
+	scrs := getForwardedSCRs(components)
+	assert.Equal(t, 1, len(scrs))
+	assert.Equal(t, userAddress, scrs[0].RcvAddr)
+	assert.Equal(t, uint64(600), scrs[0].Value.Uint64())
+	assert.Equal(t, uint64(600), components.accounts[string(userAddress)].Balance.Uint64())
+	assert.Equal(t, (1000+core.AsyncCallbackGasLock-300)*2, components.consumedFees.Uint64())
+
+	value, _ := callerAccount.DataTrieTracker().RetrieveValue(createGasLockKey(txHash))
+	assert.Equal(t, 0, len(value))
+}
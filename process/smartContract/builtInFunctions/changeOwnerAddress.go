@@ -0,0 +1,54 @@
+package builtInFunctions
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type changeOwnerAddress struct {
+	addrConv state.AddressConverter
+}
+
+// NewChangeOwnerAddressFunc creates a new built-in function which changes the owner of a smart contract
+func NewChangeOwnerAddressFunc(addrConv state.AddressConverter) (*changeOwnerAddress, error) {
+	if addrConv == nil || addrConv.IsInterfaceNil() {
+		return nil, process.ErrNilAddressConverter
+	}
+
+	return &changeOwnerAddress{
+		addrConv: addrConv,
+	}, nil
+}
+
+// ProcessBuiltInFunction sets the address provided as the only argument as the new owner of the destination
+// contract. Only the current owner can change it
+func (coa *changeOwnerAddress) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	arguments [][]byte,
+) error {
+	err := checkAccounts(acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+
+	if len(arguments) != 1 || len(arguments[0]) != coa.addrConv.AddressLen() {
+		return process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	err = checkIsOwner(tx, acntDst)
+	if err != nil {
+		return err
+	}
+
+	return acntDst.SetOwnerAddressWithJournal(arguments[0])
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (coa *changeOwnerAddress) IsInterfaceNil() bool {
+	if coa == nil {
+		return true
+	}
+	return false
+}
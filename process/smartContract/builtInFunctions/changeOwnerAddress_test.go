@@ -0,0 +1,64 @@
+package builtInFunctions
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChangeOwnerAddressFunc_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coa, err := NewChangeOwnerAddressFunc(nil)
+
+	assert.Nil(t, coa)
+	assert.Equal(t, process.ErrNilAddressConverter, err)
+}
+
+func TestChangeOwnerAddress_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coa, _ := NewChangeOwnerAddressFunc(&mock.AddressConverterMock{})
+	tx := &transaction.Transaction{SndAddr: []byte("owner"), RcvAddr: []byte("sc")}
+	acntDst := createAccount(tx.RcvAddr)
+	acntDst.OwnerAddress = tx.SndAddr
+
+	err := coa.ProcessBuiltInFunction(tx, createAccount(tx.SndAddr), acntDst, [][]byte{[]byte("short")})
+
+	assert.Equal(t, process.ErrInvalidBuiltInFunctionArguments, err)
+}
+
+func TestChangeOwnerAddress_NotOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	coa, _ := NewChangeOwnerAddressFunc(addrConv)
+	tx := &transaction.Transaction{SndAddr: []byte("not owner"), RcvAddr: []byte("sc")}
+	acntDst := createAccount(tx.RcvAddr)
+	acntDst.OwnerAddress = []byte("owner")
+	newOwner := make([]byte, addrConv.AddressLen())
+
+	err := coa.ProcessBuiltInFunction(tx, createAccount(tx.SndAddr), acntDst, [][]byte{newOwner})
+
+	assert.Equal(t, process.ErrNotContractOwner, err)
+}
+
+func TestChangeOwnerAddress_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	coa, _ := NewChangeOwnerAddressFunc(addrConv)
+	tx := &transaction.Transaction{SndAddr: []byte("owner"), RcvAddr: []byte("sc")}
+	acntDst := createAccount(tx.RcvAddr)
+	acntDst.OwnerAddress = tx.SndAddr
+	newOwner := make([]byte, addrConv.AddressLen())
+	newOwner[0] = 1
+
+	err := coa.ProcessBuiltInFunction(tx, createAccount(tx.SndAddr), acntDst, [][]byte{newOwner})
+
+	assert.Nil(t, err)
+	assert.Equal(t, newOwner, acntDst.GetOwnerAddress())
+}
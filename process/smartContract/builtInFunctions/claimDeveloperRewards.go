@@ -0,0 +1,56 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+type claimDeveloperRewards struct {
+}
+
+// NewClaimDeveloperRewardsFunc creates a new built-in function which transfers the developer rewards of a
+// smart contract to its owner
+func NewClaimDeveloperRewardsFunc() *claimDeveloperRewards {
+	return &claimDeveloperRewards{}
+}
+
+// ProcessBuiltInFunction moves the reward accumulated by the destination contract to the sender's balance.
+// Only the owner of the contract can claim the rewards
+func (cdr *claimDeveloperRewards) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	_ [][]byte,
+) error {
+	err := checkAccounts(acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+
+	err = checkIsOwner(tx, acntDst)
+	if err != nil {
+		return err
+	}
+
+	reward := acntDst.GetDeveloperReward()
+	if reward.Cmp(big.NewInt(0)) == 0 {
+		return nil
+	}
+
+	err = acntDst.SetDeveloperRewardWithJournal(big.NewInt(0))
+	if err != nil {
+		return err
+	}
+
+	newBalance := big.NewInt(0).Add(acntSnd.Balance, reward)
+	return acntSnd.SetBalanceWithJournal(newBalance)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cdr *claimDeveloperRewards) IsInterfaceNil() bool {
+	if cdr == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimDeveloperRewards_CrossShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cdr := NewClaimDeveloperRewardsFunc()
+	tx := &transaction.Transaction{SndAddr: []byte("owner"), RcvAddr: []byte("sc")}
+
+	err := cdr.ProcessBuiltInFunction(tx, nil, createAccount(tx.RcvAddr), nil)
+
+	assert.Equal(t, process.ErrBuiltInFunctionCalledCrossShard, err)
+}
+
+func TestClaimDeveloperRewards_NotOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cdr := NewClaimDeveloperRewardsFunc()
+	tx := &transaction.Transaction{SndAddr: []byte("not owner"), RcvAddr: []byte("sc")}
+	acntDst := createAccount(tx.RcvAddr)
+	acntDst.OwnerAddress = []byte("owner")
+
+	err := cdr.ProcessBuiltInFunction(tx, createAccount(tx.SndAddr), acntDst, nil)
+
+	assert.Equal(t, process.ErrNotContractOwner, err)
+}
+
+func TestClaimDeveloperRewards_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cdr := NewClaimDeveloperRewardsFunc()
+	tx := &transaction.Transaction{SndAddr: []byte("owner"), RcvAddr: []byte("sc")}
+	acntSnd := createAccount(tx.SndAddr)
+	acntSnd.Balance = big.NewInt(10)
+	acntDst := createAccount(tx.RcvAddr)
+	acntDst.OwnerAddress = tx.SndAddr
+	acntDst.DeveloperReward = big.NewInt(50)
+
+	err := cdr.ProcessBuiltInFunction(tx, acntSnd, acntDst, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(60), acntSnd.Balance)
+	assert.Equal(t, big.NewInt(0), acntDst.GetDeveloperReward())
+}
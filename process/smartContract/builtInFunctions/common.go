@@ -0,0 +1,33 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+func checkAccounts(acntSnd, acntDst *state.Account) error {
+	if acntSnd == nil || acntDst == nil {
+		return process.ErrBuiltInFunctionCalledCrossShard
+	}
+
+	return nil
+}
+
+func checkIsOwner(tx *transaction.Transaction, acntDst *state.Account) error {
+	if !bytes.Equal(acntDst.GetOwnerAddress(), tx.SndAddr) {
+		return process.ErrNotContractOwner
+	}
+
+	return nil
+}
+
+func checkIsSelfCall(tx *transaction.Transaction) error {
+	if !bytes.Equal(tx.SndAddr, tx.RcvAddr) {
+		return process.ErrOperationNotPermitted
+	}
+
+	return nil
+}
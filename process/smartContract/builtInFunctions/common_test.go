@@ -0,0 +1,19 @@
+package builtInFunctions
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+)
+
+func createAccount(address []byte) *state.Account {
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+	acnt, _ := state.NewAccount(mock.NewAddressMock(address), tracker)
+
+	return acnt
+}
@@ -0,0 +1,95 @@
+package builtInFunctions
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// epochGatedFunction wraps a built-in function so that it can be executed only starting with a given epoch. It
+// also tracks the gas cost of the function, as found in the gas schedule of the current epoch
+type epochGatedFunction struct {
+	name         string
+	function     process.BuiltInFunction
+	enableEpoch  uint32
+	epochHandler process.EpochHandler
+
+	mutGasCost sync.RWMutex
+	gasCost    uint64
+}
+
+func newEpochGatedFunction(
+	name string,
+	function process.BuiltInFunction,
+	enableEpoch uint32,
+	epochHandler process.EpochHandler,
+) *epochGatedFunction {
+	return &epochGatedFunction{
+		name:         name,
+		function:     function,
+		enableEpoch:  enableEpoch,
+		epochHandler: epochHandler,
+	}
+}
+
+// GasScheduleChange sets the gas cost of the wrapped function from the new gas schedule. A function missing from
+// the gas schedule costs nothing on top of the move balance
+func (egf *epochGatedFunction) GasScheduleChange(gasSchedule map[string]map[string]uint64) {
+	egf.mutGasCost.Lock()
+	egf.gasCost = gasSchedule[core.GasScheduleBuiltInCost][egf.name]
+	egf.mutGasCost.Unlock()
+}
+
+// GasCost returns the gas cost of the wrapped function in the current epoch
+func (egf *epochGatedFunction) GasCost() uint64 {
+	egf.mutGasCost.RLock()
+	defer egf.mutGasCost.RUnlock()
+
+	return egf.gasCost
+}
+
+// ProcessBuiltInFunction calls the wrapped function if the current epoch is at least the enable epoch
+func (egf *epochGatedFunction) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	arguments [][]byte,
+) error {
+	if egf.epochHandler.Epoch() < egf.enableEpoch {
+		return process.ErrBuiltInFunctionNotActive
+	}
+
+	return egf.function.ProcessBuiltInFunction(tx, acntSnd, acntDst, arguments)
+}
+
+// IsCrossShardEnabled returns true if the wrapped function can be called with the sender and the receiver in
+// different shards
+func (egf *epochGatedFunction) IsCrossShardEnabled() bool {
+	crossShardFunction, ok := egf.function.(process.CrossShardBuiltInFunction)
+	if !ok {
+		return false
+	}
+
+	return crossShardFunction.IsCrossShardEnabled()
+}
+
+// ContractCallData returns the contract call which follows the wrapped function, if the wrapped function supports
+// contract calls, or an empty string otherwise
+func (egf *epochGatedFunction) ContractCallData(arguments [][]byte) string {
+	contractCallFunction, ok := egf.function.(process.ContractCallBuiltInFunction)
+	if !ok {
+		return ""
+	}
+
+	return contractCallFunction.ContractCallData(arguments)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (egf *epochGatedFunction) IsInterfaceNil() bool {
+	if egf == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type esdtNFTAddQuantity struct {
+	*esdtNFTStorage
+	accounts      state.AccountsAdapter
+	supplyTracker process.ESDTSupplyTracker
+}
+
+// NewESDTNFTAddQuantityFunc creates a new built-in function which increases the quantity of a semi fungible token
+func NewESDTNFTAddQuantityFunc(
+	accounts state.AccountsAdapter,
+	marshalizer marshal.Marshalizer,
+	supplyTracker process.ESDTSupplyTracker,
+) (*esdtNFTAddQuantity, error) {
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if supplyTracker == nil || supplyTracker.IsInterfaceNil() {
+		return nil, process.ErrNilESDTSupplyTracker
+	}
+
+	return &esdtNFTAddQuantity{
+		esdtNFTStorage: &esdtNFTStorage{marshalizer: marshalizer},
+		accounts:       accounts,
+		supplyTracker:  supplyTracker,
+	}, nil
+}
+
+// ProcessBuiltInFunction adds the provided quantity to a semi fungible token held by its creator. The arguments
+// are: token identifier, nonce and quantity. The transaction has to be sent by the account to itself
+func (enaq *esdtNFTAddQuantity) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	arguments [][]byte,
+) error {
+	err := checkAccounts(acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+
+	err = checkIsSelfCall(tx)
+	if err != nil {
+		return err
+	}
+
+	if len(arguments) != 3 || len(arguments[0]) == 0 {
+		return process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	tokenID := arguments[0]
+	nonce := big.NewInt(0).SetBytes(arguments[1]).Uint64()
+	quantity := big.NewInt(0).SetBytes(arguments[2])
+	if quantity.Cmp(big.NewInt(0)) <= 0 {
+		return process.ErrInvalidNFTQuantity
+	}
+
+	token, err := enaq.getToken(acntDst, tokenID, nonce)
+	if err != nil {
+		return err
+	}
+	if token.Type != uint32(esdt.SemiFungible) {
+		return process.ErrNFTQuantityNotChangeable
+	}
+	if !bytes.Equal(token.TokenMetaData.Creator, tx.SndAddr) {
+		return process.ErrNotNFTCreator
+	}
+
+	token.Value.Add(token.Value, quantity)
+	err = enaq.saveToken(acntDst, tokenID, nonce, token)
+	if err != nil {
+		return err
+	}
+
+	err = enaq.accounts.SaveDataTrie(acntDst)
+	if err != nil {
+		return err
+	}
+
+	enaq.supplyTracker.AddMinted(tokenID, quantity)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (enaq *esdtNFTAddQuantity) IsInterfaceNil() bool {
+	if enaq == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTNFTAddQuantityFunc_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enaq, err := NewESDTNFTAddQuantityFunc(nil, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+
+	assert.Nil(t, enaq)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewESDTNFTAddQuantityFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enaq, err := NewESDTNFTAddQuantityFunc(&mock.AccountsStub{}, nil, &mock.ESDTSupplyTrackerStub{})
+
+	assert.Nil(t, enaq)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewESDTNFTAddQuantityFunc_NilSupplyTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enaq, err := NewESDTNFTAddQuantityFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, nil)
+
+	assert.Nil(t, enaq)
+	assert.Equal(t, process.ErrNilESDTSupplyTracker, err)
+}
+
+func TestESDTNFTAddQuantity_TokenNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enaq, _ := NewESDTNFTAddQuantityFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := enaq.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{testTokenID, {1}, {5}})
+
+	assert.Equal(t, process.ErrNFTTokenNotFound, err)
+}
+
+func TestESDTNFTAddQuantity_NonFungibleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enaq, _ := NewESDTNFTAddQuantityFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+	_ = enaq.saveToken(acnt, testTokenID, 1, createNFTToken(esdt.NonFungible, 1, tx.SndAddr, 1))
+
+	err := enaq.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{testTokenID, {1}, {5}})
+
+	assert.Equal(t, process.ErrNFTQuantityNotChangeable, err)
+}
+
+func TestESDTNFTAddQuantity_NotCreatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enaq, _ := NewESDTNFTAddQuantityFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+	_ = enaq.saveToken(acnt, testTokenID, 1, createNFTToken(esdt.SemiFungible, 3, []byte("creator"), 1))
+
+	err := enaq.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{testTokenID, {1}, {5}})
+
+	assert.Equal(t, process.ErrNotNFTCreator, err)
+}
+
+func TestESDTNFTAddQuantity_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	minted := big.NewInt(0)
+	enaq, _ := NewESDTNFTAddQuantityFunc(
+		&mock.AccountsStub{
+			SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+				return nil
+			},
+		},
+		&mock.MarshalizerMock{},
+		&mock.ESDTSupplyTrackerStub{
+			AddMintedCalled: func(tokenID []byte, value *big.Int) {
+				minted.Add(minted, value)
+			},
+		},
+	)
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+	_ = enaq.saveToken(acnt, testTokenID, 1, createNFTToken(esdt.SemiFungible, 3, tx.SndAddr, 1))
+
+	err := enaq.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{testTokenID, {1}, {5}})
+	assert.Nil(t, err)
+
+	token, _ := enaq.getToken(acnt, testTokenID, 1)
+	assert.Equal(t, big.NewInt(8), token.Value)
+	assert.Equal(t, big.NewInt(5), minted)
+}
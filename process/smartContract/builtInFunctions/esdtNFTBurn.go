@@ -0,0 +1,99 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type esdtNFTBurn struct {
+	*esdtNFTStorage
+	accounts      state.AccountsAdapter
+	supplyTracker process.ESDTSupplyTracker
+}
+
+// NewESDTNFTBurnFunc creates a new built-in function which burns non fungible and semi fungible tokens
+func NewESDTNFTBurnFunc(
+	accounts state.AccountsAdapter,
+	marshalizer marshal.Marshalizer,
+	supplyTracker process.ESDTSupplyTracker,
+) (*esdtNFTBurn, error) {
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if supplyTracker == nil || supplyTracker.IsInterfaceNil() {
+		return nil, process.ErrNilESDTSupplyTracker
+	}
+
+	return &esdtNFTBurn{
+		esdtNFTStorage: &esdtNFTStorage{marshalizer: marshalizer},
+		accounts:       accounts,
+		supplyTracker:  supplyTracker,
+	}, nil
+}
+
+// ProcessBuiltInFunction removes the provided quantity of a token held by the sender. The arguments are: token
+// identifier, nonce and quantity. The transaction has to be sent by the account to itself
+func (enb *esdtNFTBurn) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	arguments [][]byte,
+) error {
+	err := checkAccounts(acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+
+	err = checkIsSelfCall(tx)
+	if err != nil {
+		return err
+	}
+
+	if len(arguments) != 3 || len(arguments[0]) == 0 {
+		return process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	tokenID := arguments[0]
+	nonce := big.NewInt(0).SetBytes(arguments[1]).Uint64()
+	quantity := big.NewInt(0).SetBytes(arguments[2])
+	if quantity.Cmp(big.NewInt(0)) <= 0 {
+		return process.ErrInvalidNFTQuantity
+	}
+
+	token, err := enb.getToken(acntDst, tokenID, nonce)
+	if err != nil {
+		return err
+	}
+	if token.Value.Cmp(quantity) < 0 {
+		return process.ErrInsufficientNFTQuantity
+	}
+
+	token.Value.Sub(token.Value, quantity)
+	err = enb.saveToken(acntDst, tokenID, nonce, token)
+	if err != nil {
+		return err
+	}
+
+	err = enb.accounts.SaveDataTrie(acntDst)
+	if err != nil {
+		return err
+	}
+
+	enb.supplyTracker.AddBurned(tokenID, quantity)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (enb *esdtNFTBurn) IsInterfaceNil() bool {
+	if enb == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTNFTBurnFunc_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enb, err := NewESDTNFTBurnFunc(nil, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+
+	assert.Nil(t, enb)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewESDTNFTBurnFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enb, err := NewESDTNFTBurnFunc(&mock.AccountsStub{}, nil, &mock.ESDTSupplyTrackerStub{})
+
+	assert.Nil(t, enb)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewESDTNFTBurnFunc_NilSupplyTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enb, err := NewESDTNFTBurnFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, nil)
+
+	assert.Nil(t, enb)
+	assert.Equal(t, process.ErrNilESDTSupplyTracker, err)
+}
+
+func TestESDTNFTBurn_NotSelfCallShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enb, _ := NewESDTNFTBurnFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+
+	err := enb.ProcessBuiltInFunction(tx, createAccount(tx.SndAddr), createAccount(tx.RcvAddr), [][]byte{testTokenID, {1}, {1}})
+
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+}
+
+func TestESDTNFTBurn_InsufficientQuantityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enb, _ := NewESDTNFTBurnFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+	_ = enb.saveToken(acnt, testTokenID, 1, createNFTToken(esdt.SemiFungible, 3, []byte("creator"), 1))
+
+	err := enb.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{testTokenID, {1}, {4}})
+	assert.Equal(t, process.ErrInsufficientNFTQuantity, err)
+
+	err = enb.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{testTokenID, {1}, {0}})
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	err = enb.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{testTokenID, {2}, {1}})
+	assert.Equal(t, process.ErrNFTTokenNotFound, err)
+}
+
+func TestESDTNFTBurn_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	burned := big.NewInt(0)
+	enb, _ := NewESDTNFTBurnFunc(
+		&mock.AccountsStub{
+			SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+				return nil
+			},
+		},
+		&mock.MarshalizerMock{},
+		&mock.ESDTSupplyTrackerStub{
+			AddBurnedCalled: func(tokenID []byte, value *big.Int) {
+				assert.Equal(t, testTokenID, tokenID)
+				burned.Add(burned, value)
+			},
+		},
+	)
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+	_ = enb.saveToken(acnt, testTokenID, 1, createNFTToken(esdt.SemiFungible, 3, []byte("creator"), 1))
+
+	err := enb.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{testTokenID, {1}, {2}})
+	assert.Nil(t, err)
+	token, _ := enb.getToken(acnt, testTokenID, 1)
+	assert.Equal(t, big.NewInt(1), token.Value)
+
+	err = enb.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{testTokenID, {1}, {1}})
+	assert.Nil(t, err)
+	_, err = enb.getToken(acnt, testTokenID, 1)
+	assert.Equal(t, process.ErrNFTTokenNotFound, err)
+	index, _ := enb.getIndex(acnt)
+	assert.Equal(t, 0, len(index))
+
+	assert.Equal(t, big.NewInt(3), burned)
+}
@@ -0,0 +1,136 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const minNumArgumentsNFTCreate = 7
+
+type esdtNFTCreate struct {
+	*esdtNFTStorage
+	accounts      state.AccountsAdapter
+	supplyTracker process.ESDTSupplyTracker
+}
+
+// NewESDTNFTCreateFunc creates a new built-in function which creates non fungible and semi fungible tokens
+func NewESDTNFTCreateFunc(
+	accounts state.AccountsAdapter,
+	marshalizer marshal.Marshalizer,
+	supplyTracker process.ESDTSupplyTracker,
+) (*esdtNFTCreate, error) {
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if supplyTracker == nil || supplyTracker.IsInterfaceNil() {
+		return nil, process.ErrNilESDTSupplyTracker
+	}
+
+	return &esdtNFTCreate{
+		esdtNFTStorage: &esdtNFTStorage{marshalizer: marshalizer},
+		accounts:       accounts,
+		supplyTracker:  supplyTracker,
+	}, nil
+}
+
+// ProcessBuiltInFunction creates a new token in the account of the sender, with the next nonce of the provided
+// token identifier. The arguments are: token identifier, initial quantity, name, royalties, hash, attributes and
+// at least one URI. A token created with a quantity of one is non fungible, otherwise it is semi fungible.
+// The transaction has to be sent by the account to itself
+func (enc *esdtNFTCreate) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	arguments [][]byte,
+) error {
+	err := checkAccounts(acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+
+	err = checkIsSelfCall(tx)
+	if err != nil {
+		return err
+	}
+
+	if len(arguments) < minNumArgumentsNFTCreate || len(arguments[0]) == 0 {
+		return process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	tokenID := arguments[0]
+	quantity := big.NewInt(0).SetBytes(arguments[1])
+	if quantity.Cmp(big.NewInt(0)) <= 0 {
+		return process.ErrInvalidNFTQuantity
+	}
+
+	royalties := big.NewInt(0).SetBytes(arguments[3])
+	if !royalties.IsUint64() || royalties.Uint64() > uint64(core.MaxRoyalty) {
+		return process.ErrInvalidRoyalties
+	}
+
+	nonce, err := enc.getLatestNonce(acntDst, tokenID)
+	if err != nil {
+		return err
+	}
+	nonce++
+
+	tokenType := esdt.SemiFungible
+	if quantity.Cmp(big.NewInt(1)) == 0 {
+		tokenType = esdt.NonFungible
+	}
+
+	token := &esdt.ESDigitalToken{
+		Type:  uint32(tokenType),
+		Value: quantity,
+		TokenMetaData: &esdt.MetaData{
+			Nonce:      nonce,
+			Name:       arguments[2],
+			Creator:    tx.SndAddr,
+			Royalties:  uint32(royalties.Uint64()),
+			Hash:       arguments[4],
+			Attributes: arguments[5],
+			URIs:       arguments[6:],
+		},
+	}
+
+	err = enc.saveToken(acntDst, tokenID, nonce, token)
+	if err != nil {
+		return err
+	}
+
+	acntDst.DataTrieTracker().SaveKeyValue(esdt.LatestNonceKey(tokenID), big.NewInt(0).SetUint64(nonce).Bytes())
+
+	err = enc.accounts.SaveDataTrie(acntDst)
+	if err != nil {
+		return err
+	}
+
+	enc.supplyTracker.AddInitialSupply(tokenID, quantity)
+
+	return nil
+}
+
+func (enc *esdtNFTCreate) getLatestNonce(acnt *state.Account, tokenID []byte) (uint64, error) {
+	value, err := enc.retrieveValue(acnt, esdt.LatestNonceKey(tokenID))
+	if err != nil {
+		return 0, err
+	}
+
+	return big.NewInt(0).SetBytes(value).Uint64(), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (enc *esdtNFTCreate) IsInterfaceNil() bool {
+	if enc == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+var testTokenID = []byte("TOKEN")
+
+func createNFTCreateArguments(quantity int64, royalties uint32) [][]byte {
+	return [][]byte{
+		testTokenID,
+		big.NewInt(quantity).Bytes(),
+		[]byte("name"),
+		big.NewInt(int64(royalties)).Bytes(),
+		[]byte("hash"),
+		[]byte("attributes"),
+		[]byte("uri"),
+	}
+}
+
+func createNFTToken(tokenType esdt.Type, quantity int64, creator []byte, nonce uint64) *esdt.ESDigitalToken {
+	return &esdt.ESDigitalToken{
+		Type:  uint32(tokenType),
+		Value: big.NewInt(quantity),
+		TokenMetaData: &esdt.MetaData{
+			Nonce:   nonce,
+			Name:    []byte("name"),
+			Creator: creator,
+		},
+	}
+}
+
+func TestNewESDTNFTCreateFunc_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enc, err := NewESDTNFTCreateFunc(nil, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+
+	assert.Nil(t, enc)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewESDTNFTCreateFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enc, err := NewESDTNFTCreateFunc(&mock.AccountsStub{}, nil, &mock.ESDTSupplyTrackerStub{})
+
+	assert.Nil(t, enc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewESDTNFTCreateFunc_NilSupplyTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enc, err := NewESDTNFTCreateFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, nil)
+
+	assert.Nil(t, enc)
+	assert.Equal(t, process.ErrNilESDTSupplyTracker, err)
+}
+
+func TestESDTNFTCreate_NotSelfCallShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enc, _ := NewESDTNFTCreateFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+
+	err := enc.ProcessBuiltInFunction(tx, createAccount(tx.SndAddr), createAccount(tx.RcvAddr), createNFTCreateArguments(1, 0))
+
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+}
+
+func TestESDTNFTCreate_NotEnoughArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enc, _ := NewESDTNFTCreateFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := enc.ProcessBuiltInFunction(tx, acnt, acnt, createNFTCreateArguments(1, 0)[:6])
+
+	assert.Equal(t, process.ErrInvalidBuiltInFunctionArguments, err)
+}
+
+func TestESDTNFTCreate_ZeroQuantityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enc, _ := NewESDTNFTCreateFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := enc.ProcessBuiltInFunction(tx, acnt, acnt, createNFTCreateArguments(0, 0))
+
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+}
+
+func TestESDTNFTCreate_RoyaltiesTooHighShouldErr(t *testing.T) {
+	t.Parallel()
+
+	enc, _ := NewESDTNFTCreateFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.ESDTSupplyTrackerStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := enc.ProcessBuiltInFunction(tx, acnt, acnt, createNFTCreateArguments(1, core.MaxRoyalty+1))
+
+	assert.Equal(t, process.ErrInvalidRoyalties, err)
+}
+
+func TestESDTNFTCreate_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	saveDataTrieCalled := false
+	initialSupply := big.NewInt(0)
+	enc, _ := NewESDTNFTCreateFunc(
+		&mock.AccountsStub{
+			SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+				saveDataTrieCalled = true
+				return nil
+			},
+		},
+		&mock.MarshalizerMock{},
+		&mock.ESDTSupplyTrackerStub{
+			AddInitialSupplyCalled: func(tokenID []byte, value *big.Int) {
+				assert.Equal(t, testTokenID, tokenID)
+				initialSupply.Add(initialSupply, value)
+			},
+		},
+	)
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := enc.ProcessBuiltInFunction(tx, acnt, acnt, createNFTCreateArguments(1, 500))
+	assert.Nil(t, err)
+	assert.True(t, saveDataTrieCalled)
+
+	err = enc.ProcessBuiltInFunction(tx, acnt, acnt, createNFTCreateArguments(10, 0))
+	assert.Nil(t, err)
+
+	firstToken, err := enc.getToken(acnt, testTokenID, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(esdt.NonFungible), firstToken.Type)
+	assert.Equal(t, big.NewInt(1), firstToken.Value)
+	assert.Equal(t, uint32(500), firstToken.TokenMetaData.Royalties)
+	assert.Equal(t, tx.SndAddr, firstToken.TokenMetaData.Creator)
+	assert.Equal(t, [][]byte{[]byte("uri")}, firstToken.TokenMetaData.URIs)
+
+	secondToken, err := enc.getToken(acnt, testTokenID, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(esdt.SemiFungible), secondToken.Type)
+	assert.Equal(t, big.NewInt(10), secondToken.Value)
+
+	index, err := enc.getIndex(acnt)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(index))
+	assert.Equal(t, big.NewInt(11), initialSupply)
+}
@@ -0,0 +1,368 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const numArgumentsPerNFTTransfer = 3
+
+// nftTransfer holds one of the tokens moved by a multi transfer. The token carries the transferred quantity as value
+type nftTransfer struct {
+	tokenID  []byte
+	nonce    uint64
+	quantity *big.Int
+	token    *esdt.ESDigitalToken
+}
+
+type esdtNFTMultiTransfer struct {
+	*esdtNFTStorage
+	accounts     state.AccountsAdapter
+	hasher       hashing.Hasher
+	scrForwarder process.IntermediateTransactionHandler
+}
+
+// NewESDTNFTMultiTransferFunc creates a new built-in function which transfers several non fungible and semi
+// fungible tokens in one transaction
+func NewESDTNFTMultiTransferFunc(
+	accounts state.AccountsAdapter,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	scrForwarder process.IntermediateTransactionHandler,
+) (*esdtNFTMultiTransfer, error) {
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, process.ErrNilHasher
+	}
+	if scrForwarder == nil || scrForwarder.IsInterfaceNil() {
+		return nil, process.ErrNilIntermediateTransactionHandler
+	}
+
+	return &esdtNFTMultiTransfer{
+		esdtNFTStorage: &esdtNFTStorage{marshalizer: marshalizer},
+		accounts:       accounts,
+		hasher:         hasher,
+		scrForwarder:   scrForwarder,
+	}, nil
+}
+
+// ProcessBuiltInFunction moves several tokens from the sender to the receiver of the transaction. The arguments
+// are: number of tokens, then the token identifier, nonce and quantity of each token, optionally followed by a
+// function and its arguments which will be called on the receiver contract. Either all the tokens are moved or
+// none of them. If the receiver is in another shard, the tokens are debited here and delivered to the receiver
+// by a smart contract result, which is processed by calling this function with a nil sender account
+func (emt *esdtNFTMultiTransfer) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	arguments [][]byte,
+) error {
+	if acntSnd == nil {
+		return emt.processReceivedTokens(acntDst, arguments)
+	}
+
+	if bytes.Equal(tx.SndAddr, tx.RcvAddr) {
+		return process.ErrInvalidRcvAddr
+	}
+
+	transfers, callArguments, err := parseNFTTransfers(arguments)
+	if err != nil {
+		return err
+	}
+	if acntDst == nil && len(callArguments) > 0 {
+		return process.ErrCrossShardContractCall
+	}
+
+	senderTokens, err := emt.debitTokens(acntSnd, transfers)
+	if err != nil {
+		return err
+	}
+
+	if acntDst == nil {
+		err = emt.saveTokens(acntSnd, senderTokens)
+		if err != nil {
+			return err
+		}
+
+		err = emt.sendCrossShardTokens(tx, transfers)
+		if err != nil {
+			return err
+		}
+
+		return emt.accounts.SaveDataTrie(acntSnd)
+	}
+
+	destinationTokens, err := emt.creditTokens(acntDst, transfers)
+	if err != nil {
+		return err
+	}
+
+	err = emt.saveTokens(acntSnd, senderTokens)
+	if err != nil {
+		return err
+	}
+
+	err = emt.saveTokens(acntDst, destinationTokens)
+	if err != nil {
+		return err
+	}
+
+	err = emt.accounts.SaveDataTrie(acntSnd)
+	if err != nil {
+		return err
+	}
+
+	return emt.accounts.SaveDataTrie(acntDst)
+}
+
+// ContractCallData returns the data of the contract call which follows the transfers, in the FunctionName@hexArg1...
+// format, or an empty string if the arguments do not hold a contract call
+func (emt *esdtNFTMultiTransfer) ContractCallData(arguments [][]byte) string {
+	_, callArguments, err := parseNFTTransfers(arguments)
+	if err != nil || len(callArguments) == 0 {
+		return ""
+	}
+
+	tokens := make([]string, 0, len(callArguments))
+	tokens = append(tokens, string(callArguments[0]))
+	for _, argument := range callArguments[1:] {
+		tokens = append(tokens, hex.EncodeToString(argument))
+	}
+
+	return strings.Join(tokens, "@")
+}
+
+// IsCrossShardEnabled returns true as the tokens can be sent to a receiver from another shard
+func (emt *esdtNFTMultiTransfer) IsCrossShardEnabled() bool {
+	return true
+}
+
+// debitTokens subtracts the transferred quantities from the tokens of the sender, without saving them, and sets
+// on each transfer the token which has to be credited to the receiver
+func (emt *esdtNFTMultiTransfer) debitTokens(acntSnd *state.Account, transfers []*nftTransfer) ([]*nftTransfer, error) {
+	senderTokens := make([]*nftTransfer, 0, len(transfers))
+	tokensByKey := make(map[string]*nftTransfer)
+	for _, transfer := range transfers {
+		key := string(esdt.NFTTokenKey(transfer.tokenID, transfer.nonce))
+		held, ok := tokensByKey[key]
+		if !ok {
+			token, err := emt.getToken(acntSnd, transfer.tokenID, transfer.nonce)
+			if err != nil {
+				return nil, err
+			}
+
+			held = &nftTransfer{tokenID: transfer.tokenID, nonce: transfer.nonce, token: token}
+			tokensByKey[key] = held
+			senderTokens = append(senderTokens, held)
+		}
+
+		if held.token.Value.Cmp(transfer.quantity) < 0 {
+			return nil, process.ErrInsufficientNFTQuantity
+		}
+
+		held.token.Value.Sub(held.token.Value, transfer.quantity)
+		transfer.token = &esdt.ESDigitalToken{
+			Type:          held.token.Type,
+			Value:         big.NewInt(0).Set(transfer.quantity),
+			TokenMetaData: held.token.TokenMetaData,
+		}
+	}
+
+	return senderTokens, nil
+}
+
+// creditTokens adds the transferred tokens to the ones held by the receiver, without saving them
+func (emt *esdtNFTMultiTransfer) creditTokens(acntDst *state.Account, transfers []*nftTransfer) ([]*nftTransfer, error) {
+	destinationTokens := make([]*nftTransfer, 0, len(transfers))
+	tokensByKey := make(map[string]*nftTransfer)
+	for _, transfer := range transfers {
+		key := string(esdt.NFTTokenKey(transfer.tokenID, transfer.nonce))
+		held, ok := tokensByKey[key]
+		if !ok {
+			token, err := emt.getDestinationToken(acntDst, transfer.tokenID, transfer.nonce, transfer.token)
+			if err != nil {
+				return nil, err
+			}
+
+			held = &nftTransfer{tokenID: transfer.tokenID, nonce: transfer.nonce, token: token}
+			tokensByKey[key] = held
+			destinationTokens = append(destinationTokens, held)
+		}
+
+		if !bytes.Equal(held.token.TokenMetaData.Creator, transfer.token.TokenMetaData.Creator) {
+			return nil, process.ErrNFTMetaDataMismatch
+		}
+
+		held.token.Value.Add(held.token.Value, transfer.token.Value)
+	}
+
+	return destinationTokens, nil
+}
+
+func (emt *esdtNFTMultiTransfer) saveTokens(acnt *state.Account, tokens []*nftTransfer) error {
+	for _, held := range tokens {
+		err := emt.saveToken(acnt, held.tokenID, held.nonce, held.token)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendCrossShardTokens creates the smart contract result which delivers the debited tokens, together with their
+// metadata, to the receiver shard. Data format: MultiESDTNFTTransfer@numTokens followed, for each token, by
+// @tokenID@nonce@marshalizedToken
+func (emt *esdtNFTMultiTransfer) sendCrossShardTokens(tx *transaction.Transaction, transfers []*nftTransfer) error {
+	txHash, err := core.CalculateHash(emt.marshalizer, emt.hasher, tx)
+	if err != nil {
+		return err
+	}
+
+	tokens := make([]string, 0, 2+len(transfers)*numArgumentsPerNFTTransfer)
+	tokens = append(tokens, core.BuiltInFunctionMultiESDTNFTTransfer)
+	tokens = append(tokens, hex.EncodeToString(big.NewInt(int64(len(transfers))).Bytes()))
+	for _, transfer := range transfers {
+		buff, err := emt.marshalizer.Marshal(transfer.token)
+		if err != nil {
+			return err
+		}
+
+		tokens = append(tokens,
+			hex.EncodeToString(transfer.tokenID),
+			hex.EncodeToString(big.NewInt(0).SetUint64(transfer.nonce).Bytes()),
+			hex.EncodeToString(buff),
+		)
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:   tx.Nonce,
+		Value:   big.NewInt(0),
+		RcvAddr: tx.RcvAddr,
+		SndAddr: tx.SndAddr,
+		Data:    strings.Join(tokens, "@"),
+		TxHash:  txHash,
+	}
+
+	return emt.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+}
+
+// processReceivedTokens credits the receiver with the tokens delivered by a smart contract result created in the
+// sender shard
+func (emt *esdtNFTMultiTransfer) processReceivedTokens(acntDst *state.Account, arguments [][]byte) error {
+	if acntDst == nil {
+		return process.ErrNilSCDestAccount
+	}
+
+	numTransfers, err := getNumNFTTransfers(arguments)
+	if err != nil {
+		return err
+	}
+	if len(arguments) != 1+numTransfers*numArgumentsPerNFTTransfer {
+		return process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	transfers := make([]*nftTransfer, 0, numTransfers)
+	for i := 1; i < len(arguments); i += numArgumentsPerNFTTransfer {
+		token := &esdt.ESDigitalToken{}
+		err = emt.marshalizer.Unmarshal(token, arguments[i+2])
+		if err != nil {
+			return err
+		}
+		if token.Value == nil || token.TokenMetaData == nil {
+			return process.ErrInvalidBuiltInFunctionArguments
+		}
+
+		transfers = append(transfers, &nftTransfer{
+			tokenID:  arguments[i],
+			nonce:    big.NewInt(0).SetBytes(arguments[i+1]).Uint64(),
+			quantity: token.Value,
+			token:    token,
+		})
+	}
+
+	destinationTokens, err := emt.creditTokens(acntDst, transfers)
+	if err != nil {
+		return err
+	}
+
+	err = emt.saveTokens(acntDst, destinationTokens)
+	if err != nil {
+		return err
+	}
+
+	return emt.accounts.SaveDataTrie(acntDst)
+}
+
+// parseNFTTransfers returns the transfers held by the arguments together with the remaining arguments, which
+// describe the contract call following the transfers
+func parseNFTTransfers(arguments [][]byte) ([]*nftTransfer, [][]byte, error) {
+	numTransfers, err := getNumNFTTransfers(arguments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lastTransferArgument := 1 + numTransfers*numArgumentsPerNFTTransfer
+	transfers := make([]*nftTransfer, 0, numTransfers)
+	for i := 1; i < lastTransferArgument; i += numArgumentsPerNFTTransfer {
+		if len(arguments[i]) == 0 {
+			return nil, nil, process.ErrInvalidBuiltInFunctionArguments
+		}
+
+		quantity := big.NewInt(0).SetBytes(arguments[i+2])
+		if quantity.Cmp(big.NewInt(0)) <= 0 {
+			return nil, nil, process.ErrInvalidNFTQuantity
+		}
+
+		transfers = append(transfers, &nftTransfer{
+			tokenID:  arguments[i],
+			nonce:    big.NewInt(0).SetBytes(arguments[i+1]).Uint64(),
+			quantity: quantity,
+		})
+	}
+
+	callArguments := arguments[lastTransferArgument:]
+	if len(callArguments) > 0 && len(callArguments[0]) == 0 {
+		return nil, nil, process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	return transfers, callArguments, nil
+}
+
+func getNumNFTTransfers(arguments [][]byte) (int, error) {
+	if len(arguments) == 0 {
+		return 0, process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	numTransfers := big.NewInt(0).SetBytes(arguments[0])
+	maxTransfers := int64((len(arguments) - 1) / numArgumentsPerNFTTransfer)
+	if numTransfers.Cmp(big.NewInt(0)) <= 0 || numTransfers.Cmp(big.NewInt(maxTransfers)) > 0 {
+		return 0, process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	return int(numTransfers.Int64()), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (emt *esdtNFTMultiTransfer) IsInterfaceNil() bool {
+	if emt == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,230 @@
+package builtInFunctions
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var secondTestTokenID = []byte("SECOND")
+
+func createMultiTransferAccountsStub() *mock.AccountsStub {
+	return &mock.AccountsStub{
+		SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+			return nil
+		},
+	}
+}
+
+func createMultiTransferArguments(transfers ...[]byte) [][]byte {
+	arguments := [][]byte{big.NewInt(int64(len(transfers) / numArgumentsPerNFTTransfer)).Bytes()}
+	return append(arguments, transfers...)
+}
+
+func TestNewESDTNFTMultiTransferFunc_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	emt, err := NewESDTNFTMultiTransferFunc(nil, &mock.MarshalizerMock{}, &mock.HasherMock{}, &mock.IntermediateTransactionHandlerMock{})
+
+	assert.Nil(t, emt)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewESDTNFTMultiTransferFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	emt, err := NewESDTNFTMultiTransferFunc(&mock.AccountsStub{}, nil, &mock.HasherMock{}, &mock.IntermediateTransactionHandlerMock{})
+
+	assert.Nil(t, emt)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewESDTNFTMultiTransferFunc_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	emt, err := NewESDTNFTMultiTransferFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, nil, &mock.IntermediateTransactionHandlerMock{})
+
+	assert.Nil(t, emt)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewESDTNFTMultiTransferFunc_NilSCRForwarderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	emt, err := NewESDTNFTMultiTransferFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.HasherMock{}, nil)
+
+	assert.Nil(t, emt)
+	assert.Equal(t, process.ErrNilIntermediateTransactionHandler, err)
+}
+
+func TestESDTNFTMultiTransfer_InvalidNumberOfTokensShouldErr(t *testing.T) {
+	t.Parallel()
+
+	emt, _ := NewESDTNFTMultiTransferFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.HasherMock{}, &mock.IntermediateTransactionHandlerMock{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+	arguments := [][]byte{{2}, testTokenID, {1}, {1}}
+
+	err := emt.ProcessBuiltInFunction(tx, createAccount(tx.SndAddr), createAccount(tx.RcvAddr), arguments)
+
+	assert.Equal(t, process.ErrInvalidBuiltInFunctionArguments, err)
+}
+
+func TestESDTNFTMultiTransfer_InsufficientQuantityShouldNotMoveAnyToken(t *testing.T) {
+	t.Parallel()
+
+	emt, _ := NewESDTNFTMultiTransferFunc(createMultiTransferAccountsStub(), &mock.MarshalizerMock{}, &mock.HasherMock{}, &mock.IntermediateTransactionHandlerMock{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+	acntSnd := createAccount(tx.SndAddr)
+	acntDst := createAccount(tx.RcvAddr)
+	_ = emt.saveToken(acntSnd, testTokenID, 1, createNFTToken(esdt.SemiFungible, 3, tx.SndAddr, 1))
+	arguments := createMultiTransferArguments(testTokenID, []byte{1}, []byte{2}, testTokenID, []byte{1}, []byte{2})
+
+	err := emt.ProcessBuiltInFunction(tx, acntSnd, acntDst, arguments)
+
+	assert.Equal(t, process.ErrInsufficientNFTQuantity, err)
+	senderToken, _ := emt.getToken(acntSnd, testTokenID, 1)
+	assert.Equal(t, big.NewInt(3), senderToken.Value)
+	_, err = emt.getToken(acntDst, testTokenID, 1)
+	assert.Equal(t, process.ErrNFTTokenNotFound, err)
+}
+
+func TestESDTNFTMultiTransfer_MetaDataMismatchShouldNotMoveAnyToken(t *testing.T) {
+	t.Parallel()
+
+	emt, _ := NewESDTNFTMultiTransferFunc(createMultiTransferAccountsStub(), &mock.MarshalizerMock{}, &mock.HasherMock{}, &mock.IntermediateTransactionHandlerMock{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+	acntSnd := createAccount(tx.SndAddr)
+	acntDst := createAccount(tx.RcvAddr)
+	_ = emt.saveToken(acntSnd, testTokenID, 1, createNFTToken(esdt.SemiFungible, 3, tx.SndAddr, 1))
+	_ = emt.saveToken(acntSnd, secondTestTokenID, 1, createNFTToken(esdt.NonFungible, 1, tx.SndAddr, 1))
+	_ = emt.saveToken(acntDst, secondTestTokenID, 1, createNFTToken(esdt.NonFungible, 1, tx.RcvAddr, 1))
+	arguments := createMultiTransferArguments(testTokenID, []byte{1}, []byte{2}, secondTestTokenID, []byte{1}, []byte{1})
+
+	err := emt.ProcessBuiltInFunction(tx, acntSnd, acntDst, arguments)
+
+	assert.Equal(t, process.ErrNFTMetaDataMismatch, err)
+	senderToken, _ := emt.getToken(acntSnd, testTokenID, 1)
+	assert.Equal(t, big.NewInt(3), senderToken.Value)
+	_, err = emt.getToken(acntDst, testTokenID, 1)
+	assert.Equal(t, process.ErrNFTTokenNotFound, err)
+}
+
+func TestESDTNFTMultiTransfer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	emt, _ := NewESDTNFTMultiTransferFunc(createMultiTransferAccountsStub(), &mock.MarshalizerMock{}, &mock.HasherMock{}, &mock.IntermediateTransactionHandlerMock{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+	acntSnd := createAccount(tx.SndAddr)
+	acntDst := createAccount(tx.RcvAddr)
+	_ = emt.saveToken(acntSnd, testTokenID, 1, createNFTToken(esdt.SemiFungible, 5, tx.SndAddr, 1))
+	_ = emt.saveToken(acntSnd, secondTestTokenID, 1, createNFTToken(esdt.NonFungible, 1, tx.SndAddr, 1))
+	arguments := createMultiTransferArguments(testTokenID, []byte{1}, []byte{2}, secondTestTokenID, []byte{1}, []byte{1})
+
+	err := emt.ProcessBuiltInFunction(tx, acntSnd, acntDst, arguments)
+	assert.Nil(t, err)
+
+	senderToken, _ := emt.getToken(acntSnd, testTokenID, 1)
+	assert.Equal(t, big.NewInt(3), senderToken.Value)
+	_, err = emt.getToken(acntSnd, secondTestTokenID, 1)
+	assert.Equal(t, process.ErrNFTTokenNotFound, err)
+
+	destinationToken, _ := emt.getToken(acntDst, testTokenID, 1)
+	assert.Equal(t, big.NewInt(2), destinationToken.Value)
+	secondDestinationToken, _ := emt.getToken(acntDst, secondTestTokenID, 1)
+	assert.Equal(t, big.NewInt(1), secondDestinationToken.Value)
+	assert.Equal(t, uint32(esdt.NonFungible), secondDestinationToken.Type)
+	destinationIndex, _ := emt.getIndex(acntDst)
+	assert.Equal(t, 2, len(destinationIndex))
+}
+
+func TestESDTNFTMultiTransfer_CrossShardContractCallShouldErr(t *testing.T) {
+	t.Parallel()
+
+	emt, _ := NewESDTNFTMultiTransferFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.HasherMock{}, &mock.IntermediateTransactionHandlerMock{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+	arguments := append(createMultiTransferArguments(testTokenID, []byte{1}, []byte{1}), []byte("function"))
+
+	err := emt.ProcessBuiltInFunction(tx, createAccount(tx.SndAddr), nil, arguments)
+
+	assert.Equal(t, process.ErrCrossShardContractCall, err)
+}
+
+func TestESDTNFTMultiTransfer_CrossShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var scrs []data.TransactionHandler
+	emt, _ := NewESDTNFTMultiTransferFunc(
+		createMultiTransferAccountsStub(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.IntermediateTransactionHandlerMock{
+			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+				scrs = append(scrs, txs...)
+				return nil
+			},
+		},
+	)
+	tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+	acntSnd := createAccount(tx.SndAddr)
+	_ = emt.saveToken(acntSnd, testTokenID, 1, createNFTToken(esdt.SemiFungible, 5, tx.SndAddr, 1))
+	_ = emt.saveToken(acntSnd, secondTestTokenID, 1, createNFTToken(esdt.NonFungible, 1, tx.SndAddr, 1))
+	arguments := createMultiTransferArguments(testTokenID, []byte{1}, []byte{2}, secondTestTokenID, []byte{1}, []byte{1})
+
+	err := emt.ProcessBuiltInFunction(tx, acntSnd, nil, arguments)
+	assert.Nil(t, err)
+
+	senderToken, _ := emt.getToken(acntSnd, testTokenID, 1)
+	assert.Equal(t, big.NewInt(3), senderToken.Value)
+	_, err = emt.getToken(acntSnd, secondTestTokenID, 1)
+	assert.Equal(t, process.ErrNFTTokenNotFound, err)
+
+	require.Equal(t, 1, len(scrs))
+	scr := scrs[0].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, tx.Nonce, scr.Nonce)
+	assert.Equal(t, tx.RcvAddr, scr.RcvAddr)
+	assert.Equal(t, big.NewInt(0), scr.Value)
+	txHash, _ := core.CalculateHash(&mock.MarshalizerMock{}, &mock.HasherMock{}, tx)
+	assert.Equal(t, txHash, scr.TxHash)
+
+	tokens := strings.Split(scr.Data, "@")
+	assert.Equal(t, core.BuiltInFunctionMultiESDTNFTTransfer, tokens[0])
+	receivedArguments := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		argument, _ := hex.DecodeString(token)
+		receivedArguments = append(receivedArguments, argument)
+	}
+
+	acntDst := createAccount(tx.RcvAddr)
+	err = emt.ProcessBuiltInFunction(&transaction.Transaction{}, nil, acntDst, receivedArguments)
+	assert.Nil(t, err)
+
+	destinationToken, _ := emt.getToken(acntDst, testTokenID, 1)
+	assert.Equal(t, big.NewInt(2), destinationToken.Value)
+	assert.Equal(t, tx.SndAddr, destinationToken.TokenMetaData.Creator)
+	secondDestinationToken, _ := emt.getToken(acntDst, secondTestTokenID, 1)
+	assert.Equal(t, big.NewInt(1), secondDestinationToken.Value)
+}
+
+func TestESDTNFTMultiTransfer_ContractCallData(t *testing.T) {
+	t.Parallel()
+
+	emt, _ := NewESDTNFTMultiTransferFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.HasherMock{}, &mock.IntermediateTransactionHandlerMock{})
+	arguments := createMultiTransferArguments(testTokenID, []byte{1}, []byte{1})
+
+	assert.Equal(t, "", emt.ContractCallData(arguments))
+
+	arguments = append(arguments, []byte("function"), []byte{10}, []byte("arg"))
+	assert.Equal(t, "function@0a@"+hex.EncodeToString([]byte("arg")), emt.ContractCallData(arguments))
+}
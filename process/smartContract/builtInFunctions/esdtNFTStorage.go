@@ -0,0 +1,158 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// esdtNFTStorage reads and writes the non fungible and semi fungible tokens kept in the accounts data tries,
+// together with the index listing the tokens held by each account
+type esdtNFTStorage struct {
+	marshalizer marshal.Marshalizer
+}
+
+func (ens *esdtNFTStorage) retrieveValue(acnt *state.Account, key []byte) ([]byte, error) {
+	value, err := acnt.DataTrieTracker().RetrieveValue(key)
+	if err != nil && err != state.ErrNilTrie {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// getToken returns the token with the provided identifier and nonce held by the account
+func (ens *esdtNFTStorage) getToken(acnt *state.Account, tokenID []byte, nonce uint64) (*esdt.ESDigitalToken, error) {
+	value, err := ens.retrieveValue(acnt, esdt.NFTTokenKey(tokenID, nonce))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, process.ErrNFTTokenNotFound
+	}
+
+	token := &esdt.ESDigitalToken{}
+	err = ens.marshalizer.Unmarshal(token, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// saveToken saves the token in the data trie of the account, removing it when its value reached zero, and keeps
+// the index of the account up to date. The caller has to save the data trie of the account afterwards
+func (ens *esdtNFTStorage) saveToken(acnt *state.Account, tokenID []byte, nonce uint64, token *esdt.ESDigitalToken) error {
+	key := esdt.NFTTokenKey(tokenID, nonce)
+	if token.Value.Cmp(big.NewInt(0)) <= 0 {
+		acnt.DataTrieTracker().SaveKeyValue(key, nil)
+		return ens.removeFromIndex(acnt, tokenID, nonce)
+	}
+
+	buff, err := ens.marshalizer.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	acnt.DataTrieTracker().SaveKeyValue(key, buff)
+	return ens.addToIndex(acnt, tokenID, nonce)
+}
+
+// getDestinationToken returns the token already held by the destination or a new one, with zero value, carrying
+// the metadata of the sender's token
+func (ens *esdtNFTStorage) getDestinationToken(
+	acntDst *state.Account,
+	tokenID []byte,
+	nonce uint64,
+	senderToken *esdt.ESDigitalToken,
+) (*esdt.ESDigitalToken, error) {
+	token, err := ens.getToken(acntDst, tokenID, nonce)
+	if err == process.ErrNFTTokenNotFound {
+		return &esdt.ESDigitalToken{
+			Type:          senderToken.Type,
+			Value:         big.NewInt(0),
+			TokenMetaData: senderToken.TokenMetaData,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(token.TokenMetaData.Creator, senderToken.TokenMetaData.Creator) {
+		return nil, process.ErrNFTMetaDataMismatch
+	}
+
+	return token, nil
+}
+
+func (ens *esdtNFTStorage) getIndex(acnt *state.Account) ([]*esdt.NFTIndexEntry, error) {
+	value, err := ens.retrieveValue(acnt, esdt.NFTIndexKey())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*esdt.NFTIndexEntry, 0)
+	if len(value) == 0 {
+		return entries, nil
+	}
+
+	err = ens.marshalizer.Unmarshal(&entries, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (ens *esdtNFTStorage) saveIndex(acnt *state.Account, entries []*esdt.NFTIndexEntry) error {
+	if len(entries) == 0 {
+		acnt.DataTrieTracker().SaveKeyValue(esdt.NFTIndexKey(), nil)
+		return nil
+	}
+
+	buff, err := ens.marshalizer.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	acnt.DataTrieTracker().SaveKeyValue(esdt.NFTIndexKey(), buff)
+	return nil
+}
+
+func (ens *esdtNFTStorage) addToIndex(acnt *state.Account, tokenID []byte, nonce uint64) error {
+	entries, err := ens.getIndex(acnt)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Nonce == nonce && bytes.Equal(entry.TokenIdentifier, tokenID) {
+			return nil
+		}
+	}
+
+	entries = append(entries, &esdt.NFTIndexEntry{TokenIdentifier: tokenID, Nonce: nonce})
+	return ens.saveIndex(acnt, entries)
+}
+
+func (ens *esdtNFTStorage) removeFromIndex(acnt *state.Account, tokenID []byte, nonce uint64) error {
+	entries, err := ens.getIndex(acnt)
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]*esdt.NFTIndexEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Nonce == nonce && bytes.Equal(entry.TokenIdentifier, tokenID) {
+			continue
+		}
+
+		remaining = append(remaining, entry)
+	}
+
+	return ens.saveIndex(acnt, remaining)
+}
@@ -0,0 +1,100 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type esdtNFTTransfer struct {
+	*esdtNFTStorage
+	accounts state.AccountsAdapter
+}
+
+// NewESDTNFTTransferFunc creates a new built-in function which transfers non fungible and semi fungible tokens
+func NewESDTNFTTransferFunc(accounts state.AccountsAdapter, marshalizer marshal.Marshalizer) (*esdtNFTTransfer, error) {
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	return &esdtNFTTransfer{
+		esdtNFTStorage: &esdtNFTStorage{marshalizer: marshalizer},
+		accounts:       accounts,
+	}, nil
+}
+
+// ProcessBuiltInFunction moves the provided quantity of a token from the sender to the receiver of the
+// transaction, together with the token metadata. The arguments are: token identifier, nonce and quantity
+func (ent *esdtNFTTransfer) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	arguments [][]byte,
+) error {
+	err := checkAccounts(acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(tx.SndAddr, tx.RcvAddr) {
+		return process.ErrInvalidRcvAddr
+	}
+
+	if len(arguments) != 3 || len(arguments[0]) == 0 {
+		return process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	tokenID := arguments[0]
+	nonce := big.NewInt(0).SetBytes(arguments[1]).Uint64()
+	quantity := big.NewInt(0).SetBytes(arguments[2])
+	if quantity.Cmp(big.NewInt(0)) <= 0 {
+		return process.ErrInvalidNFTQuantity
+	}
+
+	senderToken, err := ent.getToken(acntSnd, tokenID, nonce)
+	if err != nil {
+		return err
+	}
+	if senderToken.Value.Cmp(quantity) < 0 {
+		return process.ErrInsufficientNFTQuantity
+	}
+
+	destinationToken, err := ent.getDestinationToken(acntDst, tokenID, nonce, senderToken)
+	if err != nil {
+		return err
+	}
+
+	senderToken.Value.Sub(senderToken.Value, quantity)
+	destinationToken.Value.Add(destinationToken.Value, quantity)
+
+	err = ent.saveToken(acntSnd, tokenID, nonce, senderToken)
+	if err != nil {
+		return err
+	}
+
+	err = ent.saveToken(acntDst, tokenID, nonce, destinationToken)
+	if err != nil {
+		return err
+	}
+
+	err = ent.accounts.SaveDataTrie(acntSnd)
+	if err != nil {
+		return err
+	}
+
+	return ent.accounts.SaveDataTrie(acntDst)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ent *esdtNFTTransfer) IsInterfaceNil() bool {
+	if ent == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTNFTTransferFunc_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ent, err := NewESDTNFTTransferFunc(nil, &mock.MarshalizerMock{})
+
+	assert.Nil(t, ent)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewESDTNFTTransferFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ent, err := NewESDTNFTTransferFunc(&mock.AccountsStub{}, nil)
+
+	assert.Nil(t, ent)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestESDTNFTTransfer_CrossShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ent, _ := NewESDTNFTTransferFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+
+	err := ent.ProcessBuiltInFunction(tx, createAccount(tx.SndAddr), nil, [][]byte{testTokenID, {1}, {1}})
+
+	assert.Equal(t, process.ErrBuiltInFunctionCalledCrossShard, err)
+}
+
+func TestESDTNFTTransfer_SelfTransferShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ent, _ := NewESDTNFTTransferFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := ent.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{testTokenID, {1}, {1}})
+
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+}
+
+func TestESDTNFTTransfer_InsufficientQuantityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ent, _ := NewESDTNFTTransferFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+	acntSnd := createAccount(tx.SndAddr)
+	_ = ent.saveToken(acntSnd, testTokenID, 1, createNFTToken(esdt.SemiFungible, 2, tx.SndAddr, 1))
+
+	err := ent.ProcessBuiltInFunction(tx, acntSnd, createAccount(tx.RcvAddr), [][]byte{testTokenID, {1}, {3}})
+
+	assert.Equal(t, process.ErrInsufficientNFTQuantity, err)
+}
+
+func TestESDTNFTTransfer_MetaDataMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ent, _ := NewESDTNFTTransferFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+	acntSnd := createAccount(tx.SndAddr)
+	acntDst := createAccount(tx.RcvAddr)
+	_ = ent.saveToken(acntSnd, testTokenID, 1, createNFTToken(esdt.SemiFungible, 2, tx.SndAddr, 1))
+	_ = ent.saveToken(acntDst, testTokenID, 1, createNFTToken(esdt.SemiFungible, 2, tx.RcvAddr, 1))
+
+	err := ent.ProcessBuiltInFunction(tx, acntSnd, acntDst, [][]byte{testTokenID, {1}, {1}})
+
+	assert.Equal(t, process.ErrNFTMetaDataMismatch, err)
+}
+
+func TestESDTNFTTransfer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ent, _ := NewESDTNFTTransferFunc(
+		&mock.AccountsStub{
+			SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+				return nil
+			},
+		},
+		&mock.MarshalizerMock{},
+	)
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+	acntSnd := createAccount(tx.SndAddr)
+	acntDst := createAccount(tx.RcvAddr)
+	_ = ent.saveToken(acntSnd, testTokenID, 1, createNFTToken(esdt.SemiFungible, 5, tx.SndAddr, 1))
+
+	err := ent.ProcessBuiltInFunction(tx, acntSnd, acntDst, [][]byte{testTokenID, {1}, {2}})
+	assert.Nil(t, err)
+
+	senderToken, _ := ent.getToken(acntSnd, testTokenID, 1)
+	assert.Equal(t, big.NewInt(3), senderToken.Value)
+	destinationToken, _ := ent.getToken(acntDst, testTokenID, 1)
+	assert.Equal(t, big.NewInt(2), destinationToken.Value)
+	assert.Equal(t, tx.SndAddr, destinationToken.TokenMetaData.Creator)
+
+	err = ent.ProcessBuiltInFunction(tx, acntSnd, acntDst, [][]byte{testTokenID, {1}, {3}})
+	assert.Nil(t, err)
+
+	_, err = ent.getToken(acntSnd, testTokenID, 1)
+	assert.Equal(t, process.ErrNFTTokenNotFound, err)
+	senderIndex, _ := ent.getIndex(acntSnd)
+	assert.Equal(t, 0, len(senderIndex))
+	destinationIndex, _ := ent.getIndex(acntDst)
+	assert.Equal(t, 1, len(destinationIndex))
+}
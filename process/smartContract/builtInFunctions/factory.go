@@ -0,0 +1,125 @@
+package builtInFunctions
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+)
+
+// ArgsCreateBuiltInFunctionContainer defines the arguments needed to create the built-in functions container
+type ArgsCreateBuiltInFunctionContainer struct {
+	Accounts      state.AccountsAdapter
+	AddrConv      state.AddressConverter
+	Marshalizer   marshal.Marshalizer
+	Hasher        hashing.Hasher
+	SCRForwarder  process.IntermediateTransactionHandler
+	EpochHandler  process.EpochHandler
+	GasSchedule   core.GasScheduleNotifier
+	SupplyTracker process.ESDTSupplyTracker
+	Config        config.BuiltInFunctionsConfig
+}
+
+// CreateBuiltInFunctionContainer creates the container holding all the built-in functions. Each function is
+// enabled starting with the epoch set in config, so new built-in functions should be registered here together
+// with their own enable epoch and with their cost in the gas schedule files
+func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (process.BuiltInFunctionContainer, error) {
+	if args.Accounts == nil || args.Accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if args.AddrConv == nil || args.AddrConv.IsInterfaceNil() {
+		return nil, process.ErrNilAddressConverter
+	}
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if args.Hasher == nil || args.Hasher.IsInterfaceNil() {
+		return nil, process.ErrNilHasher
+	}
+	if args.SCRForwarder == nil || args.SCRForwarder.IsInterfaceNil() {
+		return nil, process.ErrNilIntermediateTransactionHandler
+	}
+	if args.EpochHandler == nil || args.EpochHandler.IsInterfaceNil() {
+		return nil, process.ErrNilEpochHandler
+	}
+	if args.GasSchedule == nil || args.GasSchedule.IsInterfaceNil() {
+		return nil, process.ErrNilGasScheduleNotifier
+	}
+	if args.SupplyTracker == nil || args.SupplyTracker.IsInterfaceNil() {
+		return nil, process.ErrNilESDTSupplyTracker
+	}
+
+	container := containers.NewBuiltInFunctionsContainer()
+
+	changeOwner, err := NewChangeOwnerAddressFunc(args.AddrConv)
+	if err != nil {
+		return nil, err
+	}
+
+	saveKeyValueFunc, err := NewSaveKeyValueFunc(args.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	setUserNameFunc, err := NewSetUserNameFunc(args.Accounts, args.AddrConv, vmFactory.DNSSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtNFTCreateFunc, err := NewESDTNFTCreateFunc(args.Accounts, args.Marshalizer, args.SupplyTracker)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtNFTTransferFunc, err := NewESDTNFTTransferFunc(args.Accounts, args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtNFTAddQuantityFunc, err := NewESDTNFTAddQuantityFunc(args.Accounts, args.Marshalizer, args.SupplyTracker)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtNFTBurnFunc, err := NewESDTNFTBurnFunc(args.Accounts, args.Marshalizer, args.SupplyTracker)
+	if err != nil {
+		return nil, err
+	}
+
+	multiESDTNFTTransferFunc, err := NewESDTNFTMultiTransferFunc(args.Accounts, args.Marshalizer, args.Hasher, args.SCRForwarder)
+	if err != nil {
+		return nil, err
+	}
+
+	functions := []struct {
+		name        string
+		function    process.BuiltInFunction
+		enableEpoch uint32
+	}{
+		{core.BuiltInFunctionClaimDeveloperRewards, NewClaimDeveloperRewardsFunc(), args.Config.ClaimDeveloperRewardsEnableEpoch},
+		{core.BuiltInFunctionChangeOwnerAddress, changeOwner, args.Config.ChangeOwnerAddressEnableEpoch},
+		{core.BuiltInFunctionSaveKeyValue, saveKeyValueFunc, args.Config.SaveKeyValueEnableEpoch},
+		{core.BuiltInFunctionSetUserName, setUserNameFunc, args.Config.SetUserNameEnableEpoch},
+		{core.BuiltInFunctionESDTNFTCreate, esdtNFTCreateFunc, args.Config.ESDTNFTCreateEnableEpoch},
+		{core.BuiltInFunctionESDTNFTTransfer, esdtNFTTransferFunc, args.Config.ESDTNFTTransferEnableEpoch},
+		{core.BuiltInFunctionESDTNFTAddQuantity, esdtNFTAddQuantityFunc, args.Config.ESDTNFTAddQuantityEnableEpoch},
+		{core.BuiltInFunctionMultiESDTNFTTransfer, multiESDTNFTTransferFunc, args.Config.MultiESDTNFTTransferEnableEpoch},
+		{core.BuiltInFunctionESDTNFTBurn, esdtNFTBurnFunc, args.Config.ESDTNFTBurnEnableEpoch},
+	}
+
+	for _, f := range functions {
+		function := newEpochGatedFunction(f.name, f.function, f.enableEpoch, args.EpochHandler)
+		args.GasSchedule.RegisterNotifyHandler(function)
+
+		err = container.Add(f.name, function)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return container, nil
+}
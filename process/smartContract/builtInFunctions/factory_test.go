@@ -0,0 +1,175 @@
+package builtInFunctions
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsCreateBuiltInFunctionContainer() ArgsCreateBuiltInFunctionContainer {
+	return ArgsCreateBuiltInFunctionContainer{
+		Accounts:      &mock.AccountsStub{},
+		AddrConv:      &mock.AddressConverterMock{},
+		Marshalizer:   &mock.MarshalizerMock{},
+		Hasher:        &mock.HasherMock{},
+		SCRForwarder:  &mock.IntermediateTransactionHandlerMock{},
+		EpochHandler:  &mock.SpecialAddressHandlerMock{},
+		GasSchedule:   mock.NewGasScheduleNotifierMock(make(map[string]map[string]uint64)),
+		SupplyTracker: &mock.ESDTSupplyTrackerStub{},
+		Config:        config.BuiltInFunctionsConfig{},
+	}
+}
+
+func TestCreateBuiltInFunctionContainer_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCreateBuiltInFunctionContainer()
+	args.Accounts = nil
+	container, err := CreateBuiltInFunctionContainer(args)
+
+	assert.Nil(t, container)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestCreateBuiltInFunctionContainer_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCreateBuiltInFunctionContainer()
+	args.AddrConv = nil
+	container, err := CreateBuiltInFunctionContainer(args)
+
+	assert.Nil(t, container)
+	assert.Equal(t, process.ErrNilAddressConverter, err)
+}
+
+func TestCreateBuiltInFunctionContainer_NilEpochHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCreateBuiltInFunctionContainer()
+	args.EpochHandler = nil
+	container, err := CreateBuiltInFunctionContainer(args)
+
+	assert.Nil(t, container)
+	assert.Equal(t, process.ErrNilEpochHandler, err)
+}
+
+func TestCreateBuiltInFunctionContainer_NilGasScheduleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCreateBuiltInFunctionContainer()
+	args.GasSchedule = nil
+	container, err := CreateBuiltInFunctionContainer(args)
+
+	assert.Nil(t, container)
+	assert.Equal(t, process.ErrNilGasScheduleNotifier, err)
+}
+
+func TestCreateBuiltInFunctionContainer_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCreateBuiltInFunctionContainer()
+	args.Marshalizer = nil
+	container, err := CreateBuiltInFunctionContainer(args)
+
+	assert.Nil(t, container)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestCreateBuiltInFunctionContainer_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCreateBuiltInFunctionContainer()
+	args.Hasher = nil
+	container, err := CreateBuiltInFunctionContainer(args)
+
+	assert.Nil(t, container)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestCreateBuiltInFunctionContainer_NilSCRForwarderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCreateBuiltInFunctionContainer()
+	args.SCRForwarder = nil
+	container, err := CreateBuiltInFunctionContainer(args)
+
+	assert.Nil(t, container)
+	assert.Equal(t, process.ErrNilIntermediateTransactionHandler, err)
+}
+
+func TestCreateBuiltInFunctionContainer_NilSupplyTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCreateBuiltInFunctionContainer()
+	args.SupplyTracker = nil
+	container, err := CreateBuiltInFunctionContainer(args)
+
+	assert.Nil(t, container)
+	assert.Equal(t, process.ErrNilESDTSupplyTracker, err)
+}
+
+func TestCreateBuiltInFunctionContainer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	container, err := CreateBuiltInFunctionContainer(createMockArgsCreateBuiltInFunctionContainer())
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(
+		t,
+		[]string{
+			core.BuiltInFunctionClaimDeveloperRewards,
+			core.BuiltInFunctionChangeOwnerAddress,
+			core.BuiltInFunctionSaveKeyValue,
+			core.BuiltInFunctionSetUserName,
+			core.BuiltInFunctionESDTNFTCreate,
+			core.BuiltInFunctionESDTNFTTransfer,
+			core.BuiltInFunctionESDTNFTAddQuantity,
+			core.BuiltInFunctionMultiESDTNFTTransfer,
+			core.BuiltInFunctionESDTNFTBurn,
+		},
+		container.Keys(),
+	)
+}
+
+func TestCreateBuiltInFunctionContainer_FunctionNotActiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCreateBuiltInFunctionContainer()
+	args.Config.SetUserNameEnableEpoch = 1
+	container, _ := CreateBuiltInFunctionContainer(args)
+
+	function, _ := container.Get(core.BuiltInFunctionSetUserName)
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+	err := function.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{[]byte("alice")})
+
+	assert.Equal(t, process.ErrBuiltInFunctionNotActive, err)
+	assert.Nil(t, acnt.GetUserName())
+}
+
+func TestCreateBuiltInFunctionContainer_ShouldSetTheGasCostFromTheGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCreateBuiltInFunctionContainer()
+	gasSchedule := map[string]map[string]uint64{
+		core.GasScheduleBuiltInCost: {core.BuiltInFunctionSaveKeyValue: 100},
+	}
+	args.GasSchedule = mock.NewGasScheduleNotifierMock(gasSchedule)
+	container, _ := CreateBuiltInFunctionContainer(args)
+
+	saveKeyValue, _ := container.Get(core.BuiltInFunctionSaveKeyValue)
+	assert.Equal(t, uint64(100), saveKeyValue.(process.BuiltInFunctionWithGasCost).GasCost())
+
+	setUserName, _ := container.Get(core.BuiltInFunctionSetUserName)
+	assert.Equal(t, uint64(0), setUserName.(process.BuiltInFunctionWithGasCost).GasCost())
+
+	saveKeyValue.(core.GasScheduleSubscribeHandler).GasScheduleChange(map[string]map[string]uint64{
+		core.GasScheduleBuiltInCost: {core.BuiltInFunctionSaveKeyValue: 200},
+	})
+	assert.Equal(t, uint64(200), saveKeyValue.(process.BuiltInFunctionWithGasCost).GasCost())
+}
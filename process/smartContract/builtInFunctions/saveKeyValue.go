@@ -0,0 +1,68 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type saveKeyValue struct {
+	accounts state.AccountsAdapter
+}
+
+// NewSaveKeyValueFunc creates a new built-in function which saves key-value pairs in the sender's data trie
+func NewSaveKeyValueFunc(accounts state.AccountsAdapter) (*saveKeyValue, error) {
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+
+	return &saveKeyValue{
+		accounts: accounts,
+	}, nil
+}
+
+// ProcessBuiltInFunction saves the arguments, taken in pairs as key and value, in the data trie of the sender.
+// The transaction has to be sent by the account to itself and the keys reserved for the protocol can not be written
+func (skv *saveKeyValue) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	arguments [][]byte,
+) error {
+	err := checkAccounts(acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+
+	err = checkIsSelfCall(tx)
+	if err != nil {
+		return err
+	}
+
+	if len(arguments) == 0 || len(arguments)%2 != 0 {
+		return process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	for i := 0; i < len(arguments); i += 2 {
+		if len(arguments[i]) == 0 {
+			return process.ErrInvalidBuiltInFunctionArguments
+		}
+		if bytes.HasPrefix(arguments[i], []byte(core.ElrondProtectedKeyPrefix)) {
+			return process.ErrProtectedKey
+		}
+
+		acntDst.DataTrieTracker().SaveKeyValue(arguments[i], arguments[i+1])
+	}
+
+	return skv.accounts.SaveDataTrie(acntDst)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (skv *saveKeyValue) IsInterfaceNil() bool {
+	if skv == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package builtInFunctions
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSaveKeyValueFunc_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	skv, err := NewSaveKeyValueFunc(nil)
+
+	assert.Nil(t, skv)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestSaveKeyValue_NotSelfCallShouldErr(t *testing.T) {
+	t.Parallel()
+
+	skv, _ := NewSaveKeyValueFunc(&mock.AccountsStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("dst")}
+
+	err := skv.ProcessBuiltInFunction(tx, createAccount(tx.SndAddr), createAccount(tx.RcvAddr), [][]byte{[]byte("key"), []byte("value")})
+
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+}
+
+func TestSaveKeyValue_OddArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	skv, _ := NewSaveKeyValueFunc(&mock.AccountsStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := skv.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{[]byte("key")})
+
+	assert.Equal(t, process.ErrInvalidBuiltInFunctionArguments, err)
+}
+
+func TestSaveKeyValue_ProtectedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	skv, _ := NewSaveKeyValueFunc(&mock.AccountsStub{})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := skv.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{[]byte(core.ElrondProtectedKeyPrefix + "key"), []byte("value")})
+
+	assert.Equal(t, process.ErrProtectedKey, err)
+}
+
+func TestSaveKeyValue_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	saveDataTrieCalled := false
+	skv, _ := NewSaveKeyValueFunc(&mock.AccountsStub{
+		SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+			saveDataTrieCalled = true
+			return nil
+		},
+	})
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := skv.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{[]byte("key"), []byte("value")})
+
+	assert.Nil(t, err)
+	assert.True(t, saveDataTrieCalled)
+	assert.Equal(t, []byte("value"), acnt.DataTrieTracker().DirtyData()["key"])
+}
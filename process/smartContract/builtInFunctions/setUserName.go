@@ -0,0 +1,125 @@
+package builtInFunctions
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type setUserName struct {
+	accounts   state.AccountsAdapter
+	addrConv   state.AddressConverter
+	dnsAddress []byte
+}
+
+// NewSetUserNameFunc creates a new built-in function which sets the user name of the sender's account. The user
+// names are kept unique by registering them in the data trie of the dns account, using the same layout as the
+// dns system smart contract: the user name is the key and the owner address is the value
+func NewSetUserNameFunc(
+	accounts state.AccountsAdapter,
+	addrConv state.AddressConverter,
+	dnsAddress []byte,
+) (*setUserName, error) {
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if addrConv == nil || addrConv.IsInterfaceNil() {
+		return nil, process.ErrNilAddressConverter
+	}
+	if len(dnsAddress) == 0 {
+		return nil, process.ErrNilDNSAddress
+	}
+
+	return &setUserName{
+		accounts:   accounts,
+		addrConv:   addrConv,
+		dnsAddress: dnsAddress,
+	}, nil
+}
+
+// ProcessBuiltInFunction sets the only argument as the user name of the sender. The transaction has to be sent
+// by the account to itself, the user name has to be valid and not registered by any other account and it can be
+// set only once
+func (sun *setUserName) ProcessBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSnd, acntDst *state.Account,
+	arguments [][]byte,
+) error {
+	err := checkAccounts(acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+
+	err = checkIsSelfCall(tx)
+	if err != nil {
+		return err
+	}
+
+	if len(arguments) != 1 || len(arguments[0]) == 0 {
+		return process.ErrInvalidBuiltInFunctionArguments
+	}
+
+	userName := arguments[0]
+	err = core.CheckUserNameFormat(userName)
+	if err != nil {
+		return err
+	}
+
+	if len(acntDst.GetUserName()) > 0 {
+		return process.ErrUserNameAlreadySet
+	}
+
+	err = sun.registerUserName(userName, tx.SndAddr)
+	if err != nil {
+		return err
+	}
+
+	return acntDst.SetUserNameWithJournal(userName)
+}
+
+func (sun *setUserName) registerUserName(userName []byte, owner []byte) error {
+	dnsAccount, err := sun.getDNSAccount()
+	if err != nil {
+		return err
+	}
+
+	registeredOwner, err := dnsAccount.DataTrieTracker().RetrieveValue(userName)
+	if err != nil && err != state.ErrNilTrie {
+		return err
+	}
+	if len(registeredOwner) > 0 {
+		return process.ErrUserNameAlreadyRegistered
+	}
+
+	dnsAccount.DataTrieTracker().SaveKeyValue(userName, owner)
+
+	return sun.accounts.SaveDataTrie(dnsAccount)
+}
+
+func (sun *setUserName) getDNSAccount() (*state.Account, error) {
+	dnsAddress, err := sun.addrConv.CreateAddressFromPublicKeyBytes(sun.dnsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	accountHandler, err := sun.accounts.GetAccountWithJournal(dnsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := accountHandler.(*state.Account)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sun *setUserName) IsInterfaceNil() bool {
+	if sun == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package builtInFunctions
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+var testDNSAddress = []byte("dns")
+
+func createSetUserNameFunc(dnsAccount *state.Account, saveDataTrieCalled *bool) *setUserName {
+	accounts := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+			return dnsAccount, nil
+		},
+		SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+			*saveDataTrieCalled = true
+			return nil
+		},
+	}
+	sun, _ := NewSetUserNameFunc(accounts, &mock.AddressConverterMock{}, testDNSAddress)
+
+	return sun
+}
+
+func TestNewSetUserNameFunc_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sun, err := NewSetUserNameFunc(nil, &mock.AddressConverterMock{}, testDNSAddress)
+
+	assert.Nil(t, sun)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewSetUserNameFunc_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sun, err := NewSetUserNameFunc(&mock.AccountsStub{}, nil, testDNSAddress)
+
+	assert.Nil(t, sun)
+	assert.Equal(t, process.ErrNilAddressConverter, err)
+}
+
+func TestNewSetUserNameFunc_EmptyDNSAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sun, err := NewSetUserNameFunc(&mock.AccountsStub{}, &mock.AddressConverterMock{}, nil)
+
+	assert.Nil(t, sun)
+	assert.Equal(t, process.ErrNilDNSAddress, err)
+}
+
+func TestSetUserName_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	saveDataTrieCalled := false
+	sun := createSetUserNameFunc(createAccount(testDNSAddress), &saveDataTrieCalled)
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := sun.ProcessBuiltInFunction(tx, acnt, acnt, nil)
+
+	assert.Equal(t, process.ErrInvalidBuiltInFunctionArguments, err)
+}
+
+func TestSetUserName_InvalidFormatShouldErr(t *testing.T) {
+	t.Parallel()
+
+	saveDataTrieCalled := false
+	sun := createSetUserNameFunc(createAccount(testDNSAddress), &saveDataTrieCalled)
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := sun.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{[]byte("Alice")})
+	assert.Equal(t, core.ErrInvalidUserNameCharacters, err)
+
+	err = sun.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{[]byte("elrond")})
+	assert.Equal(t, core.ErrReservedUserName, err)
+	assert.False(t, saveDataTrieCalled)
+}
+
+func TestSetUserName_AlreadySetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	saveDataTrieCalled := false
+	sun := createSetUserNameFunc(createAccount(testDNSAddress), &saveDataTrieCalled)
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+	acnt.UserName = []byte("alice")
+
+	err := sun.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{[]byte("bob")})
+
+	assert.Equal(t, process.ErrUserNameAlreadySet, err)
+}
+
+func TestSetUserName_AlreadyRegisteredShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dnsAccount := createAccount(testDNSAddress)
+	dnsAccount.DataTrieTracker().SaveKeyValue([]byte("alice"), []byte("other"))
+	saveDataTrieCalled := false
+	sun := createSetUserNameFunc(dnsAccount, &saveDataTrieCalled)
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := sun.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{[]byte("alice")})
+
+	assert.Equal(t, process.ErrUserNameAlreadyRegistered, err)
+	assert.Nil(t, acnt.GetUserName())
+	assert.False(t, saveDataTrieCalled)
+}
+
+func TestSetUserName_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dnsAccount := createAccount(testDNSAddress)
+	saveDataTrieCalled := false
+	sun := createSetUserNameFunc(dnsAccount, &saveDataTrieCalled)
+	tx := &transaction.Transaction{SndAddr: []byte("snd"), RcvAddr: []byte("snd")}
+	acnt := createAccount(tx.SndAddr)
+
+	err := sun.ProcessBuiltInFunction(tx, acnt, acnt, [][]byte{[]byte("alice")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("alice"), acnt.GetUserName())
+	assert.True(t, saveDataTrieCalled)
+	registeredOwner, _ := dnsAccount.DataTrieTracker().RetrieveValue([]byte("alice"))
+	assert.Equal(t, tx.SndAddr, registeredOwner)
+}
@@ -3,6 +3,7 @@ package smartContract
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -14,7 +15,7 @@ func (sc *scProcessor) CreateVMCallInput(tx *transaction.Transaction) (*vmcommon
 	return sc.createVMCallInput(tx)
 }
 
-func (sc *scProcessor) CreateVMDeployInput(tx *transaction.Transaction) (*vmcommon.ContractCreateInput, []byte, error) {
+func (sc *scProcessor) CreateVMDeployInput(tx *transaction.Transaction) (*vmcommon.ContractCreateInput, []byte, core.CodeMetadata, error) {
 	return sc.createVMDeployInput(tx)
 }
 
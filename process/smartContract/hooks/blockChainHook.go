@@ -0,0 +1,273 @@
+package hooks
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgBlockChainHook represents the arguments structure for the blockchain hook
+type ArgBlockChainHook struct {
+	Accounts         state.AccountsAdapter
+	AddrConv         state.AddressConverter
+	StorageService   dataRetriever.StorageService
+	BlockChain       data.ChainHandler
+	ShardCoordinator sharding.Coordinator
+	Uint64Converter  typeConverters.Uint64ByteSliceConverter
+}
+
+// BlockChainHookImpl is the VM blockchain hook which, besides the accounts access offered by VMAccountsDB, exposes
+// the last committed block and the block currently being built or processed
+type BlockChainHookImpl struct {
+	*VMAccountsDB
+	storageService   dataRetriever.StorageService
+	blockChain       data.ChainHandler
+	shardCoordinator sharding.Coordinator
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+
+	mutCurrentHdr sync.RWMutex
+	currentHdr    data.HeaderHandler
+
+	mutSystemSCs sync.RWMutex
+	systemSCs    map[string]struct{}
+}
+
+// NewBlockChainHookImpl creates a new BlockChainHookImpl instance
+func NewBlockChainHookImpl(args ArgBlockChainHook) (*BlockChainHookImpl, error) {
+	if args.StorageService == nil || args.StorageService.IsInterfaceNil() {
+		return nil, ErrNilStorageService
+	}
+	if args.BlockChain == nil || args.BlockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+	if args.ShardCoordinator == nil || args.ShardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.Uint64Converter == nil || args.Uint64Converter.IsInterfaceNil() {
+		return nil, ErrNilUint64Converter
+	}
+
+	vmAccountsDB, err := NewVMAccountsDB(args.Accounts, args.AddrConv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlockChainHookImpl{
+		VMAccountsDB:     vmAccountsDB,
+		storageService:   args.StorageService,
+		blockChain:       args.BlockChain,
+		shardCoordinator: args.ShardCoordinator,
+		uint64Converter:  args.Uint64Converter,
+		systemSCs:        make(map[string]struct{}),
+	}, nil
+}
+
+// GetStorageData returns the storage value of a variable held in account's data trie. System smart contracts are
+// part of the protocol, so the storage they read is neither restricted by the readable flag nor charged
+func (bh *BlockChainHookImpl) GetStorageData(accountAddress []byte, index []byte) ([]byte, error) {
+	isSystemSCCaller := bh.IsSystemSmartContract(bh.getCurrentSmartContract())
+	return bh.getStorageData(accountAddress, index, !isSystemSCCaller)
+}
+
+// SetSystemSmartContracts sets the addresses of the system smart contracts hosted by the system VM
+func (bh *BlockChainHookImpl) SetSystemSmartContracts(addresses [][]byte) {
+	systemSCs := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		systemSCs[string(address)] = struct{}{}
+	}
+
+	bh.mutSystemSCs.Lock()
+	bh.systemSCs = systemSCs
+	bh.mutSystemSCs.Unlock()
+}
+
+// IsSystemSmartContract returns true if the provided address belongs to a system smart contract. The system VM does
+// not meter gas, hence the calls to, and the storage accesses of, these contracts are gas free
+func (bh *BlockChainHookImpl) IsSystemSmartContract(address []byte) bool {
+	if len(address) == 0 {
+		return false
+	}
+
+	bh.mutSystemSCs.RLock()
+	_, ok := bh.systemSCs[string(address)]
+	bh.mutSystemSCs.RUnlock()
+
+	return ok
+}
+
+// GetBlockhash returns the hash of the block found offset blocks behind the last committed block, an offset of 0
+// meaning the last committed block itself
+func (bh *BlockChainHookImpl) GetBlockhash(offset *big.Int) ([]byte, error) {
+	if offset == nil || offset.Sign() < 0 || !offset.IsUint64() {
+		return nil, ErrInvalidBlockHashOffset
+	}
+
+	lastNonce := bh.LastNonce()
+	if offset.Uint64() > lastNonce {
+		return nil, ErrInvalidBlockHashOffset
+	}
+	if offset.Uint64() == 0 {
+		return bh.lastBlockHash(), nil
+	}
+
+	nonce := lastNonce - offset.Uint64()
+	if nonce == 0 {
+		return bh.blockChain.GetGenesisHeaderHash(), nil
+	}
+
+	return bh.storageService.Get(bh.hdrNonceHashDataUnit(), bh.uint64Converter.ToByteSlice(nonce))
+}
+
+func (bh *BlockChainHookImpl) lastBlockHash() []byte {
+	hdr := bh.blockChain.GetCurrentBlockHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return bh.blockChain.GetGenesisHeaderHash()
+	}
+
+	return bh.blockChain.GetCurrentBlockHeaderHash()
+}
+
+func (bh *BlockChainHookImpl) hdrNonceHashDataUnit() dataRetriever.UnitType {
+	if bh.shardCoordinator.SelfId() == sharding.MetachainShardId {
+		return dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	return dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(bh.shardCoordinator.SelfId())
+}
+
+// lastHeader returns the last committed header or the genesis header if no block was committed yet
+func (bh *BlockChainHookImpl) lastHeader() data.HeaderHandler {
+	hdr := bh.blockChain.GetCurrentBlockHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return bh.blockChain.GetGenesisHeader()
+	}
+
+	return hdr
+}
+
+// LastNonce returns the nonce of the last committed block
+func (bh *BlockChainHookImpl) LastNonce() uint64 {
+	hdr := bh.lastHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return 0
+	}
+
+	return hdr.GetNonce()
+}
+
+// LastRound returns the round of the last committed block
+func (bh *BlockChainHookImpl) LastRound() uint64 {
+	hdr := bh.lastHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return 0
+	}
+
+	return hdr.GetRound()
+}
+
+// LastTimeStamp returns the timestamp of the last committed block
+func (bh *BlockChainHookImpl) LastTimeStamp() uint64 {
+	hdr := bh.lastHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return 0
+	}
+
+	return hdr.GetTimeStamp()
+}
+
+// LastRandomSeed returns the random seed of the last committed block
+func (bh *BlockChainHookImpl) LastRandomSeed() []byte {
+	hdr := bh.lastHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return nil
+	}
+
+	return hdr.GetRandSeed()
+}
+
+// LastEpoch returns the epoch of the last committed block
+func (bh *BlockChainHookImpl) LastEpoch() uint32 {
+	hdr := bh.lastHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return 0
+	}
+
+	return hdr.GetEpoch()
+}
+
+// SetCurrentHeader sets the header of the block currently being built or processed
+func (bh *BlockChainHookImpl) SetCurrentHeader(hdr data.HeaderHandler) {
+	bh.mutCurrentHdr.Lock()
+	bh.currentHdr = hdr
+	bh.mutCurrentHdr.Unlock()
+}
+
+func (bh *BlockChainHookImpl) currentHeader() data.HeaderHandler {
+	bh.mutCurrentHdr.RLock()
+	defer bh.mutCurrentHdr.RUnlock()
+
+	return bh.currentHdr
+}
+
+// CurrentNonce returns the nonce of the block currently being built or processed
+func (bh *BlockChainHookImpl) CurrentNonce() uint64 {
+	hdr := bh.currentHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return 0
+	}
+
+	return hdr.GetNonce()
+}
+
+// CurrentRound returns the round of the block currently being built or processed
+func (bh *BlockChainHookImpl) CurrentRound() uint64 {
+	hdr := bh.currentHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return 0
+	}
+
+	return hdr.GetRound()
+}
+
+// CurrentTimeStamp returns the timestamp of the block currently being built or processed
+func (bh *BlockChainHookImpl) CurrentTimeStamp() uint64 {
+	hdr := bh.currentHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return 0
+	}
+
+	return hdr.GetTimeStamp()
+}
+
+// CurrentRandomSeed returns the random seed of the block currently being built or processed
+func (bh *BlockChainHookImpl) CurrentRandomSeed() []byte {
+	hdr := bh.currentHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return nil
+	}
+
+	return hdr.GetRandSeed()
+}
+
+// CurrentEpoch returns the epoch of the block currently being built or processed
+func (bh *BlockChainHookImpl) CurrentEpoch() uint32 {
+	hdr := bh.currentHeader()
+	if hdr == nil || hdr.IsInterfaceNil() {
+		return 0
+	}
+
+	return hdr.GetEpoch()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bh *BlockChainHookImpl) IsInterfaceNil() bool {
+	if bh == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,257 @@
+package hooks_test
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgBlockChainHook() hooks.ArgBlockChainHook {
+	return hooks.ArgBlockChainHook{
+		Accounts:         &mock.AccountsStub{},
+		AddrConv:         mock.NewAddressConverterFake(32, ""),
+		StorageService:   &mock.ChainStorerMock{},
+		BlockChain:       &mock.BlockChainMock{},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		Uint64Converter:  &mock.Uint64ByteSliceConverterMock{},
+	}
+}
+
+func createBlockChainWithLastHeader(lastHeader data.HeaderHandler) *mock.BlockChainMock {
+	return &mock.BlockChainMock{
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{}
+		},
+		GetGenesisHeaderHashCalled: func() []byte {
+			return []byte("genesis hash")
+		},
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return lastHeader
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return []byte("last hash")
+		},
+	}
+}
+
+func TestNewBlockChainHookImpl_NilStorageServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgBlockChainHook()
+	args.StorageService = nil
+	bh, err := hooks.NewBlockChainHookImpl(args)
+
+	assert.Nil(t, bh)
+	assert.Equal(t, hooks.ErrNilStorageService, err)
+}
+
+func TestNewBlockChainHookImpl_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgBlockChainHook()
+	args.BlockChain = nil
+	bh, err := hooks.NewBlockChainHookImpl(args)
+
+	assert.Nil(t, bh)
+	assert.Equal(t, hooks.ErrNilBlockChain, err)
+}
+
+func TestNewBlockChainHookImpl_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgBlockChainHook()
+	args.ShardCoordinator = nil
+	bh, err := hooks.NewBlockChainHookImpl(args)
+
+	assert.Nil(t, bh)
+	assert.Equal(t, hooks.ErrNilShardCoordinator, err)
+}
+
+func TestNewBlockChainHookImpl_NilUint64ConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgBlockChainHook()
+	args.Uint64Converter = nil
+	bh, err := hooks.NewBlockChainHookImpl(args)
+
+	assert.Nil(t, bh)
+	assert.Equal(t, hooks.ErrNilUint64Converter, err)
+}
+
+func TestNewBlockChainHookImpl_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgBlockChainHook()
+	args.Accounts = nil
+	bh, err := hooks.NewBlockChainHookImpl(args)
+
+	assert.Nil(t, bh)
+	assert.Equal(t, state.ErrNilAccountsAdapter, err)
+}
+
+func TestNewBlockChainHookImpl_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	bh, err := hooks.NewBlockChainHookImpl(createMockArgBlockChainHook())
+
+	assert.NotNil(t, bh)
+	assert.Nil(t, err)
+}
+
+func TestBlockChainHookImpl_LastBlockInfoShouldReadTheLastCommittedHeader(t *testing.T) {
+	t.Parallel()
+
+	lastHeader := &block.Header{Nonce: 7, Round: 8, TimeStamp: 9, RandSeed: []byte("seed"), Epoch: 2}
+	args := createMockArgBlockChainHook()
+	args.BlockChain = createBlockChainWithLastHeader(lastHeader)
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	assert.Equal(t, uint64(7), bh.LastNonce())
+	assert.Equal(t, uint64(8), bh.LastRound())
+	assert.Equal(t, uint64(9), bh.LastTimeStamp())
+	assert.Equal(t, []byte("seed"), bh.LastRandomSeed())
+	assert.Equal(t, uint32(2), bh.LastEpoch())
+}
+
+func TestBlockChainHookImpl_LastBlockInfoWithoutCommittedBlocksShouldReadTheGenesisHeader(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgBlockChainHook()
+	args.BlockChain = &mock.BlockChainMock{
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Round: 3, RandSeed: []byte("genesis seed")}
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	assert.Equal(t, uint64(0), bh.LastNonce())
+	assert.Equal(t, uint64(3), bh.LastRound())
+	assert.Equal(t, []byte("genesis seed"), bh.LastRandomSeed())
+}
+
+func TestBlockChainHookImpl_CurrentBlockInfoShouldReadTheCurrentHeader(t *testing.T) {
+	t.Parallel()
+
+	bh, _ := hooks.NewBlockChainHookImpl(createMockArgBlockChainHook())
+	assert.Equal(t, uint64(0), bh.CurrentNonce())
+	assert.Nil(t, bh.CurrentRandomSeed())
+
+	bh.SetCurrentHeader(&block.Header{Nonce: 4, Round: 5, TimeStamp: 6, RandSeed: []byte("seed"), Epoch: 1})
+
+	assert.Equal(t, uint64(4), bh.CurrentNonce())
+	assert.Equal(t, uint64(5), bh.CurrentRound())
+	assert.Equal(t, uint64(6), bh.CurrentTimeStamp())
+	assert.Equal(t, []byte("seed"), bh.CurrentRandomSeed())
+	assert.Equal(t, uint32(1), bh.CurrentEpoch())
+}
+
+func TestBlockChainHookImpl_GetBlockhashInvalidOffsetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgBlockChainHook()
+	args.BlockChain = createBlockChainWithLastHeader(&block.Header{Nonce: 3})
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	hash, err := bh.GetBlockhash(big.NewInt(-1))
+	assert.Nil(t, hash)
+	assert.Equal(t, hooks.ErrInvalidBlockHashOffset, err)
+
+	hash, err = bh.GetBlockhash(big.NewInt(4))
+	assert.Nil(t, hash)
+	assert.Equal(t, hooks.ErrInvalidBlockHashOffset, err)
+}
+
+func TestBlockChainHookImpl_GetBlockhashShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgBlockChainHook()
+	args.BlockChain = createBlockChainWithLastHeader(&block.Header{Nonce: 3})
+	args.Uint64Converter = &mock.Uint64ByteSliceConverterMock{
+		ToByteSliceCalled: func(nonce uint64) []byte {
+			return []byte(strconv.Itoa(int(nonce)))
+		},
+	}
+	args.StorageService = &mock.ChainStorerMock{
+		GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+			assert.Equal(t, dataRetriever.ShardHdrNonceHashDataUnit, unitType)
+			return append([]byte("hash of "), key...), nil
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	hash, err := bh.GetBlockhash(big.NewInt(0))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("last hash"), hash)
+
+	hash, err = bh.GetBlockhash(big.NewInt(1))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hash of 2"), hash)
+
+	hash, err = bh.GetBlockhash(big.NewInt(3))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("genesis hash"), hash)
+}
+
+func createBlockChainHookWithContract(metadata core.CodeMetadata) (*hooks.BlockChainHookImpl, []byte) {
+	variableIdentifier := []byte("variable")
+	accnt, _ := state.NewAccount(mock.NewAddressMock([]byte("contract")), &mock.AccountTrackerStub{})
+	accnt.CodeHash = []byte("code hash")
+	accnt.CodeMetadata = metadata.ToBytes()
+	accnt.DataTrieTracker().SaveKeyValue(variableIdentifier, []byte("value"))
+
+	args := createMockArgBlockChainHook()
+	args.Accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return accnt, nil
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	return bh, variableIdentifier
+}
+
+func TestBlockChainHookImpl_GetStorageDataNonReadableFromOtherContractShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bh, variableIdentifier := createBlockChainHookWithContract(core.CodeMetadata{Readable: false})
+	bh.SetCurrentSmartContract([]byte("other contract"))
+
+	value, err := bh.GetStorageData([]byte("contract"), variableIdentifier)
+
+	assert.Equal(t, hooks.ErrStorageReadNotAllowed, err)
+	assert.Nil(t, value)
+}
+
+func TestBlockChainHookImpl_GetStorageDataNonReadableFromSystemContractShouldWork(t *testing.T) {
+	t.Parallel()
+
+	systemSCAddress := []byte("system contract")
+	bh, variableIdentifier := createBlockChainHookWithContract(core.CodeMetadata{Readable: false})
+	bh.SetSystemSmartContracts([][]byte{systemSCAddress})
+	bh.SetCurrentSmartContract(systemSCAddress)
+
+	value, err := bh.GetStorageData([]byte("contract"), variableIdentifier)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestBlockChainHookImpl_IsSystemSmartContract(t *testing.T) {
+	t.Parallel()
+
+	bh, _ := hooks.NewBlockChainHookImpl(createMockArgBlockChainHook())
+	bh.SetSystemSmartContracts([][]byte{[]byte("system contract")})
+
+	assert.True(t, bh.IsSystemSmartContract([]byte("system contract")))
+	assert.False(t, bh.IsSystemSmartContract([]byte("contract")))
+	assert.False(t, bh.IsSystemSmartContract(nil))
+}
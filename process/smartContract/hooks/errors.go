@@ -16,3 +16,21 @@ var ErrAddressIsInUnknownShard = errors.New("address is in unknown shard")
 
 // ErrVMTypeLengthIsNotCorrect signals that the vm type length is not correct
 var ErrVMTypeLengthIsNotCorrect = errors.New("vm type length is not correct")
+
+// ErrStorageReadNotAllowed signals that a smart contract tried to read the storage of a contract not deployed as readable
+var ErrStorageReadNotAllowed = errors.New("reading the storage of a non readable contract is not allowed")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilBlockChain signals that a nil block chain has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilUint64Converter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 byte slice converter")
+
+// ErrInvalidBlockHashOffset signals that the requested block hash is not in the past of the last committed block
+var ErrInvalidBlockHashOffset = errors.New("invalid block hash offset")
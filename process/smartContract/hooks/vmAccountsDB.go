@@ -1,10 +1,12 @@
 package hooks
 
 import (
+	"bytes"
 	"encoding/binary"
 	"math/big"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 )
@@ -14,8 +16,9 @@ type VMAccountsDB struct {
 	accounts state.AccountsAdapter
 	addrConv state.AddressConverter
 
-	mutTempAccounts sync.Mutex
-	tempAccounts    map[string]state.AccountHandler
+	mutTempAccounts      sync.Mutex
+	tempAccounts         map[string]state.AccountHandler
+	currentSmartContract []byte
 }
 
 // NewVMAccountsDB creates a new VMAccountsDB instance
@@ -89,8 +92,13 @@ func (vadb *VMAccountsDB) GetNonce(address []byte) (uint64, error) {
 	return shardAccount.Nonce, nil
 }
 
-// GetStorageData returns the storage value of a variable held in account's data trie
+// GetStorageData returns the storage value of a variable held in account's data trie. The storage of a smart
+// contract can be read by another executing contract only if it was deployed as readable
 func (vadb *VMAccountsDB) GetStorageData(accountAddress []byte, index []byte) ([]byte, error) {
+	return vadb.getStorageData(accountAddress, index, true)
+}
+
+func (vadb *VMAccountsDB) getStorageData(accountAddress []byte, index []byte, checkReadable bool) ([]byte, error) {
 	exists, err := vadb.AccountExists(accountAddress)
 	if err != nil {
 		return nil, err
@@ -104,9 +112,35 @@ func (vadb *VMAccountsDB) GetStorageData(accountAddress []byte, index []byte) ([
 		return nil, err
 	}
 
+	if checkReadable {
+		err = vadb.checkStorageReadAllowed(accountAddress, account)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return account.DataTrieTracker().RetrieveValue(index)
 }
 
+func (vadb *VMAccountsDB) checkStorageReadAllowed(accountAddress []byte, account state.AccountHandler) error {
+	currentSmartContract := vadb.getCurrentSmartContract()
+	if len(currentSmartContract) == 0 || bytes.Equal(currentSmartContract, accountAddress) {
+		return nil
+	}
+
+	shardAccount, ok := account.(*state.Account)
+	if !ok || len(shardAccount.CodeHash) == 0 {
+		return nil
+	}
+
+	metadata := core.CodeMetadataFromBytes(shardAccount.GetCodeMetadata())
+	if !metadata.Readable {
+		return ErrStorageReadNotAllowed
+	}
+
+	return nil
+}
+
 // IsCodeEmpty returns if the code is empty
 func (vadb *VMAccountsDB) IsCodeEmpty(address []byte) (bool, error) {
 	exists, err := vadb.AccountExists(address)
@@ -191,6 +225,19 @@ func createPrefixMask(vmType []byte) []byte {
 	return prefixMask
 }
 
+// VMTypeFromAddress returns the VM type embedded in the prefix of a smart contract address, the reverse of the
+// prefix mask applied when the address was created
+func VMTypeFromAddress(address []byte) ([]byte, error) {
+	if len(address) < NumInitCharactersForScAddress {
+		return nil, ErrAddressLengthNotCorrect
+	}
+
+	vmType := make([]byte, VMTypeLen)
+	copy(vmType, address[NumInitCharactersForScAddress-VMTypeLen:NumInitCharactersForScAddress])
+
+	return vmType, nil
+}
+
 func createSuffixMask(creatorAddress []byte) []byte {
 	return creatorAddress[len(creatorAddress)-2:]
 }
@@ -245,9 +292,25 @@ func (vadb *VMAccountsDB) AddTempAccount(address []byte, balance *big.Int, nonce
 func (vadb *VMAccountsDB) CleanTempAccounts() {
 	vadb.mutTempAccounts.Lock()
 	vadb.tempAccounts = make(map[string]state.AccountHandler, 0)
+	vadb.currentSmartContract = nil
 	vadb.mutTempAccounts.Unlock()
 }
 
+// SetCurrentSmartContract sets the address of the smart contract being executed, until the temporary accounts
+// are cleaned
+func (vadb *VMAccountsDB) SetCurrentSmartContract(address []byte) {
+	vadb.mutTempAccounts.Lock()
+	vadb.currentSmartContract = address
+	vadb.mutTempAccounts.Unlock()
+}
+
+func (vadb *VMAccountsDB) getCurrentSmartContract() []byte {
+	vadb.mutTempAccounts.Lock()
+	defer vadb.mutTempAccounts.Unlock()
+
+	return vadb.currentSmartContract
+}
+
 // TempAccount can retrieve a temporary account from provided address
 func (vadb *VMAccountsDB) TempAccount(address []byte) state.AccountHandler {
 	tempAcc, success := vadb.getAccountFromTemporaryAccounts(address)
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -235,6 +236,72 @@ func TestVMAccountsDB_GetStorageDataShouldWork(t *testing.T) {
 	assert.Equal(t, variableValue, value)
 }
 
+func createVMAccountsDBWithContract(metadata core.CodeMetadata) (*hooks.VMAccountsDB, []byte) {
+	variableIdentifier := []byte("variable")
+	accnt, _ := state.NewAccount(mock.NewAddressMock([]byte("contract")), &mock.AccountTrackerStub{})
+	accnt.CodeHash = []byte("code hash")
+	accnt.CodeMetadata = metadata.ToBytes()
+	accnt.DataTrieTracker().SaveKeyValue(variableIdentifier, []byte("value"))
+
+	vadb, _ := hooks.NewVMAccountsDB(&mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return accnt, nil
+		},
+	}, mock.NewAddressConverterFake(32, ""),
+	)
+
+	return vadb, variableIdentifier
+}
+
+func TestVMAccountsDB_GetStorageDataNonReadableFromOtherContractShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vadb, variableIdentifier := createVMAccountsDBWithContract(core.CodeMetadata{Readable: false})
+	vadb.SetCurrentSmartContract([]byte("other contract"))
+
+	value, err := vadb.GetStorageData([]byte("contract"), variableIdentifier)
+
+	assert.Equal(t, hooks.ErrStorageReadNotAllowed, err)
+	assert.Nil(t, value)
+}
+
+func TestVMAccountsDB_GetStorageDataNonReadableFromSameContractShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vadb, variableIdentifier := createVMAccountsDBWithContract(core.CodeMetadata{Readable: false})
+	vadb.SetCurrentSmartContract([]byte("contract"))
+
+	value, err := vadb.GetStorageData([]byte("contract"), variableIdentifier)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestVMAccountsDB_GetStorageDataReadableFromOtherContractShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vadb, variableIdentifier := createVMAccountsDBWithContract(core.CodeMetadata{Readable: true})
+	vadb.SetCurrentSmartContract([]byte("other contract"))
+
+	value, err := vadb.GetStorageData([]byte("contract"), variableIdentifier)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestVMAccountsDB_CleanTempAccountsShouldResetCurrentSmartContract(t *testing.T) {
+	t.Parallel()
+
+	vadb, variableIdentifier := createVMAccountsDBWithContract(core.CodeMetadata{Readable: false})
+	vadb.SetCurrentSmartContract([]byte("other contract"))
+	vadb.CleanTempAccounts()
+
+	value, err := vadb.GetStorageData([]byte("contract"), variableIdentifier)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
 //------- IsCodeEmpty
 
 func TestVMAccountsDB_IsCodeEmptyAccountErrorsShouldErrAndRetFalse(t *testing.T) {
@@ -467,3 +534,25 @@ func TestVMAccountsDB_NewAddress(t *testing.T) {
 
 	fmt.Printf("%s \n%s \n", hex.EncodeToString(scAddress1), hex.EncodeToString(scAddress2))
 }
+
+func TestVMTypeFromAddress_ShortAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vmType, err := hooks.VMTypeFromAddress(make([]byte, hooks.NumInitCharactersForScAddress-1))
+
+	assert.Nil(t, vmType)
+	assert.Equal(t, hooks.ErrAddressLengthNotCorrect, err)
+}
+
+func TestVMTypeFromAddress_ShouldReturnTheVMTypeFromThePrefix(t *testing.T) {
+	t.Parallel()
+
+	address := make([]byte, 32)
+	address[hooks.NumInitCharactersForScAddress-2] = 5
+	address[hooks.NumInitCharactersForScAddress-1] = 7
+
+	vmType, err := hooks.VMTypeFromAddress(address)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{5, 7}, vmType)
+}
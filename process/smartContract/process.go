@@ -5,8 +5,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
@@ -40,11 +42,17 @@ type scProcessor struct {
 	mutSCState   sync.Mutex
 	mapExecState map[uint64]scExecutionState
 
-	scrForwarder process.IntermediateTransactionHandler
-	txFeeHandler process.TransactionFeeHandler
+	scrForwarder     process.IntermediateTransactionHandler
+	txFeeHandler     process.TransactionFeeHandler
+	builtInFunctions process.BuiltInFunctionContainer
+	txLogsProcessor  process.TransactionLogProcessor
+	economicsFee     process.FeeHandler
 }
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("process/smartContract")
+
+// upgradeFunctionName is the reserved function name used to replace the code of an already deployed contract
+const upgradeFunctionName = "upgradeContract"
 
 // NewSmartContractProcessor create a smart contract processor creates and interprets VM data
 func NewSmartContractProcessor(
@@ -58,6 +66,9 @@ func NewSmartContractProcessor(
 	coordinator sharding.Coordinator,
 	scrForwarder process.IntermediateTransactionHandler,
 	txFeeHandler process.TransactionFeeHandler,
+	builtInFunctions process.BuiltInFunctionContainer,
+	txLogsProcessor process.TransactionLogProcessor,
+	economicsFee process.FeeHandler,
 ) (*scProcessor, error) {
 	if vmContainer == nil || vmContainer.IsInterfaceNil() {
 		return nil, process.ErrNoVM
@@ -89,6 +100,15 @@ func NewSmartContractProcessor(
 	if txFeeHandler == nil {
 		return nil, process.ErrNilUnsignedTxHandler
 	}
+	if builtInFunctions == nil || builtInFunctions.IsInterfaceNil() {
+		return nil, process.ErrNilBuiltInFunctionsContainer
+	}
+	if txLogsProcessor == nil || txLogsProcessor.IsInterfaceNil() {
+		return nil, process.ErrNilTxLogsProcessor
+	}
+	if economicsFee == nil || economicsFee.IsInterfaceNil() {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
 
 	return &scProcessor{
 		vmContainer:      vmContainer,
@@ -101,6 +121,9 @@ func NewSmartContractProcessor(
 		shardCoordinator: coordinator,
 		scrForwarder:     scrForwarder,
 		txFeeHandler:     txFeeHandler,
+		builtInFunctions: builtInFunctions,
+		txLogsProcessor:  txLogsProcessor,
+		economicsFee:     economicsFee,
 		mapExecState:     make(map[uint64]scExecutionState)}, nil
 }
 
@@ -170,12 +193,23 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 	if acntDst.IsInterfaceNil() || acntDst.GetCode() == nil {
 		return process.ErrNilSCDestAccount
 	}
+	if len(tx.Data) == 0 {
+		return sc.processPlainTransferToContract(tx, acntSnd, acntDst)
+	}
 
 	err := sc.prepareSmartContractCall(tx, acntSnd)
 	if err != nil {
 		return err
 	}
 
+	function, err := sc.argsParser.GetFunction()
+	if err != nil {
+		return err
+	}
+	if function == upgradeFunctionName {
+		return sc.upgradeSmartContract(tx, acntSnd, acntDst)
+	}
+
 	vmInput, err := sc.createVMCallInput(tx)
 	if err != nil {
 		return err
@@ -186,6 +220,7 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 		return err
 	}
 
+	sc.tempAccounts.SetCurrentSmartContract(tx.RcvAddr)
 	vmOutput, err := vm.RunSmartContractCall(vmInput)
 	if err != nil {
 		return err
@@ -202,6 +237,79 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 		return err
 	}
 
+	consumedFee, err = sc.accrueDeveloperReward(tx.RcvAddr, consumedFee)
+	if err != nil {
+		return err
+	}
+
+	sc.txFeeHandler.ProcessTransactionFee(consumedFee)
+
+	return nil
+}
+
+// accrueDeveloperReward credits the developer percentage of the fee consumed by a contract call to the called
+// contract, from where its owner can claim it, and returns the remaining fee
+func (sc *scProcessor) accrueDeveloperReward(scAddress []byte, consumedFee *big.Int) (*big.Int, error) {
+	developerReward, _ := big.NewFloat(0).Mul(
+		big.NewFloat(0).SetInt(consumedFee),
+		big.NewFloat(sc.economicsFee.DeveloperPercentage()),
+	).Int(nil)
+	if developerReward.Cmp(big.NewInt(0)) <= 0 {
+		return consumedFee, nil
+	}
+
+	// the contract account is reloaded as the VM output might have changed it
+	acntSC, err := sc.getAccountFromAddress(scAddress)
+	if err != nil {
+		return nil, err
+	}
+	if acntSC == nil || acntSC.IsInterfaceNil() {
+		return consumedFee, nil
+	}
+
+	stAcntSC, ok := acntSC.(*state.Account)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	err = stAcntSC.SetDeveloperRewardWithJournal(big.NewInt(0).Add(stAcntSC.GetDeveloperReward(), developerReward))
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0).Sub(consumedFee, developerReward), nil
+}
+
+// processPlainTransferToContract credits the value of a transaction without data to the destination contract,
+// if the contract was deployed as payable
+func (sc *scProcessor) processPlainTransferToContract(
+	tx *transaction.Transaction,
+	acntSnd, acntDst state.AccountHandler,
+) error {
+	stAcc, ok := acntDst.(*state.Account)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	metadata := core.CodeMetadataFromBytes(stAcc.GetCodeMetadata())
+	if !metadata.Payable {
+		return process.ErrAccountNotPayable
+	}
+
+	err := sc.processSCPayment(tx, acntSnd)
+	if err != nil {
+		return err
+	}
+
+	if tx.Value != nil && tx.Value.Cmp(big.NewInt(0)) > 0 {
+		newBalance := big.NewInt(0).Add(stAcc.Balance, tx.Value)
+		err = stAcc.SetBalanceWithJournal(newBalance)
+		if err != nil {
+			return err
+		}
+	}
+
+	consumedFee := sc.economicsFee.ComputeTxFee(tx)
 	sc.txFeeHandler.ProcessTransactionFee(consumedFee)
 
 	return nil
@@ -242,12 +350,23 @@ func (sc *scProcessor) getVMTypeFromArguments(arg *big.Int) ([]byte, error) {
 	return vmAppendedType, nil
 }
 
+// getVMFromRecvAddress routes the call to the VM whose type is embedded in the address of the called contract
 func (sc *scProcessor) getVMFromRecvAddress(tx *transaction.Transaction) (vmcommon.VMExecutionHandler, error) {
-	vmType := tx.RcvAddr[hooks.NumInitCharactersForScAddress-hooks.VMTypeLen : hooks.NumInitCharactersForScAddress]
-	vm, err := sc.vmContainer.Get(vmType)
+	vmType, err := hooks.VMTypeFromAddress(tx.RcvAddr)
 	if err != nil {
 		return nil, err
 	}
+
+	return sc.getVM(vmType)
+}
+
+func (sc *scProcessor) getVM(vmType []byte) (vmcommon.VMExecutionHandler, error) {
+	vm, err := sc.vmContainer.Get(vmType)
+	if err != nil {
+		log.Debug("no VM registered for the called contract", "vm type", hex.EncodeToString(vmType))
+		return nil, process.ErrUnknownVMType
+	}
+
 	return vm, nil
 }
 
@@ -274,12 +393,12 @@ func (sc *scProcessor) DeploySmartContract(
 		return err
 	}
 
-	vmInput, vmType, err := sc.createVMDeployInput(tx)
+	vmInput, vmType, codeMetadata, err := sc.createVMDeployInput(tx)
 	if err != nil {
 		return err
 	}
 
-	vm, err := sc.vmContainer.Get(vmType)
+	vm, err := sc.getVM(vmType)
 	if err != nil {
 		return err
 	}
@@ -294,6 +413,13 @@ func (sc *scProcessor) DeploySmartContract(
 		return err
 	}
 
+	if vmOutput.ReturnCode == vmcommon.Ok {
+		err = sc.saveContractsMetadata(vmOutput.OutputAccounts, tx.SndAddr, codeMetadata)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = sc.scrForwarder.AddIntermediateTransactions(crossTxs)
 	if err != nil {
 		return err
@@ -304,6 +430,153 @@ func (sc *scProcessor) DeploySmartContract(
 	return nil
 }
 
+// saveContractsMetadata sets the owner and the code metadata on all the accounts which received code from the VM.
+// The address of a new contract is derived by the VM, through the blockchain hook, from the creator's address and nonce
+func (sc *scProcessor) saveContractsMetadata(
+	outputAccounts []*vmcommon.OutputAccount,
+	ownerAddress []byte,
+	codeMetadata core.CodeMetadata,
+) error {
+	for _, outAcc := range outputAccounts {
+		if len(outAcc.Code) == 0 {
+			continue
+		}
+
+		acc, err := sc.getAccountFromAddress(outAcc.Address)
+		if err != nil {
+			return err
+		}
+		if acc == nil || acc.IsInterfaceNil() {
+			continue
+		}
+
+		stAcc, ok := acc.(*state.Account)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		err = stAcc.SetOwnerAddressWithJournal(ownerAddress)
+		if err != nil {
+			return err
+		}
+
+		err = stAcc.SetCodeMetadataWithJournal(codeMetadata.ToBytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upgradeSmartContract replaces the code and the code metadata of an already deployed contract.
+// Data format: upgradeContract@code@codeMetadata. Only the owner can upgrade a contract deployed as upgradeable.
+// A rejected upgrade is a failed execution: the fee is consumed and the value is given back to the sender
+func (sc *scProcessor) upgradeSmartContract(tx *transaction.Transaction, acntSnd, acntDst state.AccountHandler) error {
+	stAcc, ok := acntDst.(*state.Account)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	arguments, err := sc.argsParser.GetArguments()
+	if err == nil {
+		err = checkUpgrade(tx, stAcc, arguments)
+	}
+	if err != nil {
+		return sc.processFailedUpgrade(tx, acntSnd, err)
+	}
+
+	code := arguments[0].Bytes()
+	err = sc.accounts.PutCode(stAcc, code)
+	if err != nil {
+		return err
+	}
+
+	newMetadata := core.CodeMetadataFromBytes(arguments[1].Bytes())
+	err = stAcc.SetCodeMetadataWithJournal(newMetadata.ToBytes())
+	if err != nil {
+		return err
+	}
+
+	if tx.Value != nil && tx.Value.Cmp(big.NewInt(0)) > 0 {
+		newBalance := big.NewInt(0).Add(stAcc.Balance, tx.Value)
+		err = stAcc.SetBalanceWithJournal(newBalance)
+		if err != nil {
+			return err
+		}
+	}
+
+	consumedFee := sc.economicsFee.ComputeTxFee(tx)
+	sc.txFeeHandler.ProcessTransactionFee(consumedFee)
+
+	return nil
+}
+
+func checkUpgrade(tx *transaction.Transaction, stAcc *state.Account, arguments []*big.Int) error {
+	if len(arguments) < 2 {
+		return process.ErrNotEnoughArgumentsToUpgrade
+	}
+	if !bytes.Equal(stAcc.GetOwnerAddress(), tx.SndAddr) {
+		return process.ErrNotContractOwner
+	}
+
+	currentMetadata := core.CodeMetadataFromBytes(stAcc.GetCodeMetadata())
+	if !currentMetadata.Upgradeable {
+		return process.ErrUpgradeNotAllowed
+	}
+	if len(arguments[0].Bytes()) == 0 {
+		return process.ErrNilCode
+	}
+
+	return nil
+}
+
+// processFailedUpgrade consumes the whole fee of a rejected upgrade and gives the value back to the sender through a
+// smart contract result, so that the transaction is included and paid for as any failed execution
+func (sc *scProcessor) processFailedUpgrade(
+	tx *transaction.Transaction,
+	acntSnd state.AccountHandler,
+	upgradeErr error,
+) error {
+	txHash, err := core.CalculateHash(sc.marshalizer, sc.hasher, tx)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("smart contract upgrade failed",
+		"tx hash", hex.EncodeToString(txHash),
+		"error", upgradeErr.Error(),
+	)
+
+	scTx := &smartContractResult.SmartContractResult{}
+	scTx.Value = big.NewInt(0).Set(tx.Value)
+	scTx.RcvAddr = tx.SndAddr
+	scTx.SndAddr = tx.RcvAddr
+	scTx.Nonce = tx.Nonce + 1
+	scTx.TxHash = txHash
+
+	if acntSnd != nil && !acntSnd.IsInterfaceNil() {
+		stAcc, ok := acntSnd.(*state.Account)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		err = stAcc.SetBalanceWithJournal(big.NewInt(0).Add(stAcc.Balance, tx.Value))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = sc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scTx})
+	if err != nil {
+		return err
+	}
+
+	sc.txFeeHandler.ProcessTransactionFee(sc.economicsFee.ComputeTxFee(tx))
+
+	return nil
+}
+
 func (sc *scProcessor) createVMCallInput(tx *transaction.Transaction) (*vmcommon.ContractCallInput, error) {
 	vmInput, err := sc.createVMInput(tx)
 	if err != nil {
@@ -322,39 +595,41 @@ func (sc *scProcessor) createVMCallInput(tx *transaction.Transaction) (*vmcommon
 	return vmCallInput, nil
 }
 
+// createVMDeployInput parses the deploy data which has the format code@vmType@codeMetadata@arg1@arg2...
 func (sc *scProcessor) createVMDeployInput(
 	tx *transaction.Transaction,
-) (*vmcommon.ContractCreateInput, []byte, error) {
+) (*vmcommon.ContractCreateInput, []byte, core.CodeMetadata, error) {
 	vmInput, err := sc.createVMInput(tx)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, core.CodeMetadata{}, err
 	}
 
-	if len(vmInput.Arguments) < 1 {
-		return nil, nil, process.ErrNotEnoughArgumentsToDeploy
+	if len(vmInput.Arguments) < 2 {
+		return nil, nil, core.CodeMetadata{}, process.ErrNotEnoughArgumentsToDeploy
 	}
 
 	vmType, err := sc.getVMTypeFromArguments(vmInput.Arguments[0])
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, core.CodeMetadata{}, err
 	}
-	// delete the first argument as it is the vmType
-	vmInput.Arguments = vmInput.Arguments[1:]
+	codeMetadata := core.CodeMetadataFromBytes(vmInput.Arguments[1].Bytes())
+	// delete the first two arguments as they are the vmType and the code metadata
+	vmInput.Arguments = vmInput.Arguments[2:]
 
 	vmCreateInput := &vmcommon.ContractCreateInput{}
 	hexCode, err := sc.argsParser.GetCode()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, core.CodeMetadata{}, err
 	}
 
 	vmCreateInput.ContractCode, err = hex.DecodeString(string(hexCode))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, core.CodeMetadata{}, err
 	}
 
 	vmCreateInput.VMInput = *vmInput
 
-	return vmCreateInput, vmType, nil
+	return vmCreateInput, vmType, codeMetadata, nil
 }
 
 func (sc *scProcessor) createVMInput(tx *transaction.Transaction) (*vmcommon.VMInput, error) {
@@ -394,8 +669,7 @@ func (sc *scProcessor) processSCPayment(tx *transaction.Transaction, acntSnd sta
 		return err
 	}
 
-	cost := big.NewInt(0)
-	cost = cost.Mul(big.NewInt(0).SetUint64(tx.GasPrice), big.NewInt(0).SetUint64(tx.GasLimit))
+	cost := sc.economicsFee.ComputeTxFee(tx)
 	cost = cost.Add(cost, tx.Value)
 
 	if cost.Cmp(big.NewInt(0)) == 0 {
@@ -462,9 +736,16 @@ func (sc *scProcessor) processVMOutput(
 			return nil, nil, err
 		}
 
+		sc.saveTxLog(txHash, vmOutput, nil)
+
 		return nil, nil, nil
 	}
 
+	asyncCall, err := sc.getAsyncCall(vmOutput.Logs)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = sc.processSCOutputAccounts(vmOutput.OutputAccounts, tx)
 	if err != nil {
 		return nil, nil, err
@@ -475,17 +756,36 @@ func (sc *scProcessor) processVMOutput(
 		return nil, nil, err
 	}
 
+	totalGasRefund := big.NewInt(0)
+	totalGasRefund = totalGasRefund.Add(vmOutput.GasRefund, vmOutput.GasRemaining)
+	forwardedGas := big.NewInt(0)
+	if asyncCall != nil {
+		// the remaining gas pays for the asynchronous call and its callback, only the gas refund goes back now
+		scrAsyncCall, errCreate := sc.createAsyncCallSCR(asyncCall, vmOutput.GasRemaining, tx, txHash, tx.SndAddr)
+		if errCreate != nil {
+			return nil, nil, errCreate
+		}
+
+		err = sc.lockCallBackGas(tx.RcvAddr, txHash)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		scrTxs = append(scrTxs, scrAsyncCall)
+		totalGasRefund = big.NewInt(0).Set(vmOutput.GasRefund)
+		forwardedGas.SetUint64(scrAsyncCall.GasLimit + core.AsyncCallbackGasLock)
+	}
+
 	acntSnd, err = sc.reloadLocalSndAccount(acntSnd)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	totalGasRefund := big.NewInt(0)
-	totalGasRefund = totalGasRefund.Add(vmOutput.GasRefund, vmOutput.GasRemaining)
 	scrRefund, consumedFee, err := sc.refundGasToSender(totalGasRefund, tx, txHash, acntSnd)
 	if err != nil {
 		return nil, nil, err
 	}
+	consumedFee.Sub(consumedFee, sc.economicsFee.ComputeFeeForProcessing(tx, forwardedGas.Uint64()))
 
 	if scrRefund != nil {
 		scrTxs = append(scrTxs, scrRefund)
@@ -501,9 +801,22 @@ func (sc *scProcessor) processVMOutput(
 		return nil, nil, err
 	}
 
+	sc.saveTxLog(txHash, vmOutput, scrTxs)
+
 	return scrTxs, consumedFee, nil
 }
 
+// saveTxLog records the outcome of the execution, a failure to do so does not affect the processing
+func (sc *scProcessor) saveTxLog(txHash []byte, vmOutput *vmcommon.VMOutput, scrs []data.TransactionHandler) {
+	err := sc.txLogsProcessor.SaveLog(txHash, vmOutput, scrs)
+	if err != nil {
+		log.Debug("could not save the transaction log",
+			"tx hash", hex.EncodeToString(txHash),
+			"error", err.Error(),
+		)
+	}
+}
+
 // reloadLocalSndAccount will reload from current account state the sender account
 // this requirement is needed because in the case of refunding the exact account that was previously
 // modified in saveSCOutputToCurrentState, the modifications done there should be visible here
@@ -553,21 +866,19 @@ func (sc *scProcessor) createSCRTransactions(
 	return crossSCTxs, nil
 }
 
-// give back the user the unused gas money
+// give back the user the unused gas money, priced as the gas consumed by the smart contract execution
 func (sc *scProcessor) refundGasToSender(
 	gasRefund *big.Int,
 	tx *transaction.Transaction,
 	txHash []byte,
 	acntSnd state.AccountHandler,
 ) (*smartContractResult.SmartContractResult, *big.Int, error) {
-	consumedFee := big.NewInt(0)
-	consumedFee = consumedFee.Mul(big.NewInt(0).SetUint64(tx.GasPrice), big.NewInt(0).SetUint64(tx.GasLimit))
+	consumedFee := sc.economicsFee.ComputeTxFee(tx)
 	if gasRefund == nil || gasRefund.Cmp(big.NewInt(0)) <= 0 {
 		return nil, consumedFee, nil
 	}
 
-	refundErd := big.NewInt(0)
-	refundErd = refundErd.Mul(gasRefund, big.NewInt(int64(tx.GasPrice)))
+	refundErd := sc.economicsFee.ComputeFeeForProcessing(tx, gasRefund.Uint64())
 	consumedFee = consumedFee.Sub(consumedFee, refundErd)
 
 	scTx := &smartContractResult.SmartContractResult{}
@@ -805,14 +1116,32 @@ func (sc *scProcessor) ProcessSmartContractResult(scr *smartContractResult.Smart
 		return process.ErrWrongTypeAssertion
 	}
 
-	storageUpdates, err := sc.argsParser.GetStorageUpdates(scr.Data)
-	for i := 0; i < len(storageUpdates); i++ {
-		stAcc.DataTrieTracker().SaveKeyValue(storageUpdates[i].Offset, storageUpdates[i].Data)
+	switch scr.CallType {
+	case smartContractResult.AsynchronousCall:
+		return sc.processAsynchronousCall(scr, stAcc)
+	case smartContractResult.AsynchronousCallBack:
+		return sc.processAsynchronousCallBack(scr, stAcc)
 	}
 
-	if len(scr.Data) > 0 {
+	builtInFunction, arguments, err := sc.getBuiltInFunction(scr.Data)
+	if err != nil {
+		return err
+	}
+
+	if builtInFunction != nil {
+		// the built-in function was called cross shard and delivers its effects through this result
+		err = builtInFunction.ProcessBuiltInFunction(createTxFromSCR(scr), nil, stAcc, arguments)
+		if err != nil {
+			return err
+		}
+	} else if len(scr.Data) > 0 {
 		//SC with data variables
-		err := sc.accounts.SaveDataTrie(stAcc)
+		storageUpdates, _ := sc.argsParser.GetStorageUpdates(scr.Data)
+		for i := 0; i < len(storageUpdates); i++ {
+			stAcc.DataTrieTracker().SaveKeyValue(storageUpdates[i].Offset, storageUpdates[i].Data)
+		}
+
+		err = sc.accounts.SaveDataTrie(stAcc)
 		if err != nil {
 			return err
 		}
@@ -839,6 +1168,42 @@ func (sc *scProcessor) ProcessSmartContractResult(scr *smartContractResult.Smart
 	return nil
 }
 
+// getBuiltInFunction returns the built-in function called by the smart contract result, together with its decoded
+// arguments. A nil function is returned if the data holds storage updates
+func (sc *scProcessor) getBuiltInFunction(data string) (process.BuiltInFunction, [][]byte, error) {
+	if len(data) == 0 {
+		return nil, nil, nil
+	}
+
+	tokens := strings.Split(data, "@")
+	builtInFunction, err := sc.builtInFunctions.Get(tokens[0])
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	arguments := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		argument, err := hex.DecodeString(token)
+		if err != nil {
+			return nil, nil, process.ErrInvalidBuiltInFunctionArguments
+		}
+
+		arguments = append(arguments, argument)
+	}
+
+	return builtInFunction, arguments, nil
+}
+
+func createTxFromSCR(scr *smartContractResult.SmartContractResult) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:   scr.Nonce,
+		Value:   scr.Value,
+		RcvAddr: scr.RcvAddr,
+		SndAddr: scr.SndAddr,
+		Data:    scr.Data,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sc *scProcessor) IsInterfaceNil() bool {
 	if sc == nil {
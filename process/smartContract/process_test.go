@@ -3,15 +3,19 @@ package smartContract
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/hex"
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -68,6 +72,9 @@ func TestNewSmartContractProcessorNilVM(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -88,6 +95,9 @@ func TestNewSmartContractProcessorNilArgsParser(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -108,6 +118,9 @@ func TestNewSmartContractProcessorNilHasher(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -128,6 +141,9 @@ func TestNewSmartContractProcessorNilMarshalizer(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -148,6 +164,9 @@ func TestNewSmartContractProcessorNilAccountsDB(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -168,6 +187,9 @@ func TestNewSmartContractProcessorNilAdrConv(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -188,6 +210,9 @@ func TestNewSmartContractProcessorNilShardCoordinator(t *testing.T) {
 		nil,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -208,6 +233,9 @@ func TestNewSmartContractProcessorNilFakeAccountsHandler(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -228,12 +256,84 @@ func TestNewSmartContractProcessor_NilIntermediateMock(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		nil,
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
 	assert.Equal(t, process.ErrNilIntermediateTransactionHandler, err)
 }
 
+func TestNewSmartContractProcessor_NilBuiltInFunctionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, err := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		nil,
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
+	)
+
+	assert.Nil(t, sc)
+	assert.Equal(t, process.ErrNilBuiltInFunctionsContainer, err)
+}
+
+func TestNewSmartContractProcessor_NilTxLogsProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, err := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		nil,
+		&mock.FeeHandlerStub{},
+	)
+
+	assert.Nil(t, sc)
+	assert.Equal(t, process.ErrNilTxLogsProcessor, err)
+}
+
+func TestNewSmartContractProcessor_NilEconomicsFeeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, err := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		nil,
+	)
+
+	assert.Nil(t, sc)
+	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+}
+
 func TestNewSmartContractProcessor(t *testing.T) {
 	t.Parallel()
 
@@ -248,6 +348,9 @@ func TestNewSmartContractProcessor(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -268,6 +371,9 @@ func TestScProcessor_ComputeTransactionTypeNil(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -291,6 +397,9 @@ func TestScProcessor_ComputeTransactionTypeNilTx(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -321,6 +430,9 @@ func TestScProcessor_ComputeTransactionTypeErrWrongTransaction(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -446,6 +558,9 @@ func TestScProcessor_DeploySmartContractBadParse(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -483,6 +598,9 @@ func TestScProcessor_DeploySmartContractRunError(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -509,6 +627,7 @@ func TestScProcessor_DeploySmartContractRunError(t *testing.T) {
 	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
 		args := make([]*big.Int, 0)
 		args = append(args, big.NewInt(0).SetBytes(vmArg))
+		args = append(args, big.NewInt(0))
 		return args, nil
 	}
 
@@ -532,6 +651,9 @@ func TestScProcessor_DeploySmartContractWrongTx(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -566,6 +688,9 @@ func TestScProcessor_DeploySmartContract(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -586,6 +711,7 @@ func TestScProcessor_DeploySmartContract(t *testing.T) {
 	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
 		args := make([]*big.Int, 0)
 		args = append(args, big.NewInt(0).SetBytes(vmArg))
+		args = append(args, big.NewInt(0))
 		return args, nil
 	}
 
@@ -593,6 +719,285 @@ func TestScProcessor_DeploySmartContract(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestScProcessor_DeploySmartContractShouldSaveOwnerAndCodeMetadata(t *testing.T) {
+	t.Parallel()
+
+	addrConverter := &mock.AddressConverterMock{}
+	vmContainer := &mock.VMContainerMock{}
+	argParser := &mock.ArgumentParserMock{}
+	accntState := &mock.AccountsStub{}
+	sc, _ := NewSmartContractProcessor(
+		vmContainer,
+		argParser,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accntState,
+		&mock.TemporaryAccountsHandlerMock{},
+		addrConverter,
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
+	)
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = generateEmptyByteSlice(addrConverter.AddressLen())
+	tx.Data = "data"
+	tx.Value = big.NewInt(0)
+	acntSrc, acntSc := createAccounts(&transaction.Transaction{SndAddr: tx.SndAddr, RcvAddr: []byte("SC"), Value: big.NewInt(0)})
+
+	accntState.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		if bytes.Equal(addressContainer.Bytes(), []byte("SC")) {
+			return acntSc, nil
+		}
+		return acntSrc, nil
+	}
+	accntState.PutCodeCalled = func(accountHandler state.AccountHandler, code []byte) error {
+		accountHandler.SetCode(code)
+		return nil
+	}
+
+	vm := &mock.VMExecutionHandlerStub{}
+	vm.RunSmartContractCreateCalled = func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+		return &vmcommon.VMOutput{
+			ReturnCode:     vmcommon.Ok,
+			OutputAccounts: []*vmcommon.OutputAccount{{Address: []byte("SC"), Code: []byte("code")}},
+			GasRefund:      big.NewInt(0),
+			GasRemaining:   big.NewInt(0),
+		}, nil
+	}
+	vmContainer.GetCalled = func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+		return vm, nil
+	}
+
+	metadata := core.CodeMetadata{Upgradeable: true}
+	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
+		return []*big.Int{big.NewInt(0), big.NewInt(0).SetBytes(metadata.ToBytes())}, nil
+	}
+
+	err := sc.DeploySmartContract(tx, acntSrc, 10)
+
+	assert.Nil(t, err)
+	scAccount := acntSc.(*state.Account)
+	assert.Equal(t, tx.SndAddr, scAccount.GetOwnerAddress())
+	assert.True(t, core.CodeMetadataFromBytes(scAccount.GetCodeMetadata()).Upgradeable)
+}
+
+func createScProcessorForUpgrade(argParser *mock.ArgumentParserMock, accntState *mock.AccountsStub) *scProcessor {
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		argParser,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accntState,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
+	)
+
+	argParser.GetFunctionCalled = func() (string, error) {
+		return upgradeFunctionName, nil
+	}
+	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
+		return []*big.Int{big.NewInt(0).SetBytes([]byte("new code")), big.NewInt(0)}, nil
+	}
+
+	return sc
+}
+
+func createDeployedContract(tx *transaction.Transaction, owner []byte, upgradeable bool) (state.AccountHandler, state.AccountHandler) {
+	acntSrc, acntDst := createAccounts(tx)
+	acntDst.SetCode([]byte("code"))
+
+	metadata := core.CodeMetadata{Upgradeable: upgradeable}
+	_ = acntDst.(*state.Account).SetOwnerAddressWithJournal(owner)
+	_ = acntDst.(*state.Account).SetCodeMetadataWithJournal(metadata.ToBytes())
+
+	return acntSrc, acntDst
+}
+
+func createUpgradeTx() *transaction.Transaction {
+	return &transaction.Transaction{
+		SndAddr:  []byte("SRC"),
+		RcvAddr:  []byte("DST0000000"),
+		Data:     upgradeFunctionName,
+		Value:    big.NewInt(10),
+		GasPrice: 1,
+		GasLimit: 5,
+	}
+}
+
+func checkFailedUpgrade(t *testing.T, sc *scProcessor, tx *transaction.Transaction, acntSrc, acntDst state.AccountHandler) {
+	var scrs []data.TransactionHandler
+	sc.scrForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			scrs = txs
+			return nil
+		},
+	}
+	consumedFee := big.NewInt(0)
+	sc.txFeeHandler = &mock.UnsignedTxHandlerMock{
+		ProcessTransactionFeeCalled: func(cost *big.Int) {
+			consumedFee = cost
+		},
+	}
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("code"), acntDst.GetCode())
+	assert.Equal(t, big.NewInt(10), acntSrc.(*state.Account).Balance)
+	assert.Equal(t, big.NewInt(5), consumedFee)
+	assert.Equal(t, 1, len(scrs))
+	assert.Equal(t, tx.SndAddr, scrs[0].GetRecvAddress())
+	assert.Equal(t, big.NewInt(10), scrs[0].GetValue())
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionUpgradeNotEnoughArgumentsShouldFail(t *testing.T) {
+	t.Parallel()
+
+	argParser := &mock.ArgumentParserMock{}
+	sc := createScProcessorForUpgrade(argParser, &mock.AccountsStub{})
+	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
+		return []*big.Int{big.NewInt(1)}, nil
+	}
+
+	tx := createUpgradeTx()
+	acntSrc, acntDst := createDeployedContract(tx, tx.SndAddr, true)
+
+	checkFailedUpgrade(t, sc, tx, acntSrc, acntDst)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionUpgradeNotOwnerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	sc := createScProcessorForUpgrade(&mock.ArgumentParserMock{}, &mock.AccountsStub{})
+
+	tx := createUpgradeTx()
+	acntSrc, acntDst := createDeployedContract(tx, []byte("OWNER"), true)
+
+	checkFailedUpgrade(t, sc, tx, acntSrc, acntDst)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionUpgradeNotUpgradeableShouldFail(t *testing.T) {
+	t.Parallel()
+
+	sc := createScProcessorForUpgrade(&mock.ArgumentParserMock{}, &mock.AccountsStub{})
+
+	tx := createUpgradeTx()
+	acntSrc, acntDst := createDeployedContract(tx, tx.SndAddr, false)
+
+	checkFailedUpgrade(t, sc, tx, acntSrc, acntDst)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionUpgradeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	accntState := &mock.AccountsStub{}
+	accntState.PutCodeCalled = func(accountHandler state.AccountHandler, code []byte) error {
+		accountHandler.SetCode(code)
+		return nil
+	}
+	sc := createScProcessorForUpgrade(&mock.ArgumentParserMock{}, accntState)
+
+	tx := &transaction.Transaction{SndAddr: []byte("SRC"), RcvAddr: []byte("DST0000000"), Data: upgradeFunctionName, Value: big.NewInt(0)}
+	acntSrc, acntDst := createDeployedContract(tx, tx.SndAddr, true)
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new code"), acntDst.GetCode())
+	assert.False(t, core.CodeMetadataFromBytes(acntDst.(*state.Account).GetCodeMetadata()).Upgradeable)
+}
+
+func createContractForPlainTransfer(tx *transaction.Transaction, payable bool) (state.AccountHandler, state.AccountHandler) {
+	acntSrc, acntDst := createAccounts(tx)
+	acntDst.SetCode([]byte("code"))
+
+	metadata := core.CodeMetadata{Payable: payable}
+	_ = acntDst.(*state.Account).SetCodeMetadataWithJournal(metadata.ToBytes())
+
+	return acntSrc, acntDst
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionPlainTransferToNonPayableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc := createScProcessorForUpgrade(&mock.ArgumentParserMock{}, &mock.AccountsStub{})
+
+	tx := &transaction.Transaction{SndAddr: []byte("SRC"), RcvAddr: []byte("DST0000000"), Value: big.NewInt(10)}
+	acntSrc, acntDst := createContractForPlainTransfer(tx, false)
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Equal(t, process.ErrAccountNotPayable, err)
+	assert.Equal(t, big.NewInt(10), acntSrc.(*state.Account).Balance)
+	assert.Equal(t, big.NewInt(0), acntDst.(*state.Account).Balance)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionPlainTransferToPayableShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sc := createScProcessorForUpgrade(&mock.ArgumentParserMock{}, &mock.AccountsStub{})
+
+	tx := &transaction.Transaction{SndAddr: []byte("SRC"), RcvAddr: []byte("DST0000000"), Value: big.NewInt(10)}
+	acntSrc, acntDst := createContractForPlainTransfer(tx, true)
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), acntSrc.(*state.Account).Balance.Uint64())
+	assert.Equal(t, uint64(10), acntDst.(*state.Account).Balance.Uint64())
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionShouldSetCurrentSmartContract(t *testing.T) {
+	t.Parallel()
+
+	var currentSmartContract []byte
+	tempAccounts := &mock.TemporaryAccountsHandlerMock{
+		SetCurrentSmartContractCalled: func(address []byte) {
+			currentSmartContract = address
+		},
+	}
+	accntState := &mock.AccountsStub{}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accntState,
+		tempAccounts,
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
+	)
+
+	tx := &transaction.Transaction{SndAddr: []byte("SRC"), RcvAddr: []byte("DST0000000"), Data: "data", Value: big.NewInt(0)}
+	acntSrc, acntDst := createAccounts(tx)
+	acntDst.SetCode([]byte("code"))
+	accntState.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, tx.RcvAddr, currentSmartContract)
+}
+
 func TestScProcessor_ExecuteSmartContractTransactionNilTx(t *testing.T) {
 	t.Parallel()
 
@@ -609,6 +1014,9 @@ func TestScProcessor_ExecuteSmartContractTransactionNilTx(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -641,6 +1049,9 @@ func TestScProcessor_ExecuteSmartContractTransactionNilAccount(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -681,6 +1092,9 @@ func TestScProcessor_ExecuteSmartContractTransactionBadParser(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -718,6 +1132,9 @@ func TestScProcessor_ExecuteSmartContractTransactionVMRunError(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -761,6 +1178,9 @@ func TestScProcessor_ExecuteSmartContractTransaction(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -782,6 +1202,166 @@ func TestScProcessor_ExecuteSmartContractTransaction(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestScProcessor_AccrueDeveloperRewardShouldCreditThePercentageToTheContract(t *testing.T) {
+	t.Parallel()
+
+	accntState := &mock.AccountsStub{}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accntState,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{
+			DeveloperPercentageCalled: func() float64 {
+				return 0.3
+			},
+		},
+	)
+
+	_, acntDst, tx := createAccountsAndTransaction()
+	acntDst.DeveloperReward = big.NewInt(50)
+	accntState.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		return acntDst, nil
+	}
+
+	remainingFee, err := sc.accrueDeveloperReward(tx.RcvAddr, big.NewInt(1000))
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(700), remainingFee)
+	assert.Equal(t, big.NewInt(350), acntDst.GetDeveloperReward())
+}
+
+func TestScProcessor_AccrueDeveloperRewardZeroPercentageShouldNotTouchTheContract(t *testing.T) {
+	t.Parallel()
+
+	accntState := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			assert.Fail(t, "the contract account should not have been loaded")
+			return nil, nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accntState,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
+	)
+
+	remainingFee, err := sc.accrueDeveloperReward([]byte("DST"), big.NewInt(1000))
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), remainingFee)
+}
+
+func createScProcessorWithVMContainer(vmContainer process.VirtualMachinesContainer) (*scProcessor, *mock.AccountsStub) {
+	accntState := &mock.AccountsStub{}
+	sc, _ := NewSmartContractProcessor(
+		vmContainer,
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accntState,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
+	)
+
+	return sc, accntState
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionShouldRouteToTheVMFromAddress(t *testing.T) {
+	t.Parallel()
+
+	var requestedVMType []byte
+	sc, accntState := createScProcessorWithVMContainer(&mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			requestedVMType = key
+			return &mock.VMExecutionHandlerStub{}, nil
+		},
+	})
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte("SRC"),
+		RcvAddr: append([]byte("DST00000"), 5, 7),
+		Data:    "data",
+		Value:   big.NewInt(0),
+	}
+	acntSrc, acntDst := createAccounts(tx)
+	accntState.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+	acntDst.SetCode([]byte("code"))
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{5, 7}, requestedVMType)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionUnknownVMTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := createScProcessorWithVMContainer(&mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			return nil, process.ErrInvalidContainerKey
+		},
+	})
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST0000000"),
+		Data:    "data",
+		Value:   big.NewInt(0),
+	}
+	acntSrc, acntDst := createAccounts(tx)
+	acntDst.SetCode([]byte("code"))
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Equal(t, process.ErrUnknownVMType, err)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionShortAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := createScProcessorWithVMContainer(&mock.VMContainerMock{})
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Data:    "data",
+		Value:   big.NewInt(0),
+	}
+	acntSrc, acntDst := createAccounts(tx)
+	acntDst.SetCode([]byte("code"))
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Equal(t, hooks.ErrAddressLengthNotCorrect, err)
+}
+
 func TestScProcessor_CreateVMCallInputWrongCode(t *testing.T) {
 	t.Parallel()
 
@@ -798,6 +1378,9 @@ func TestScProcessor_CreateVMCallInputWrongCode(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -834,6 +1417,9 @@ func TestScProcessor_CreateVMCallInput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -866,6 +1452,9 @@ func TestScProcessor_CreateVMDeployInputBadFunction(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -885,10 +1474,11 @@ func TestScProcessor_CreateVMDeployInputBadFunction(t *testing.T) {
 	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
 		args := make([]*big.Int, 0)
 		args = append(args, big.NewInt(0).SetBytes(vmArg))
+		args = append(args, big.NewInt(0))
 		return args, nil
 	}
 
-	vmInput, vmType, err := sc.CreateVMDeployInput(tx)
+	vmInput, vmType, _, err := sc.CreateVMDeployInput(tx)
 	assert.Nil(t, vmInput)
 	assert.Equal(t, tmpError, err)
 	assert.Nil(t, vmType)
@@ -910,6 +1500,9 @@ func TestScProcessor_CreateVMDeployInput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -925,12 +1518,14 @@ func TestScProcessor_CreateVMDeployInput(t *testing.T) {
 	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
 		args := make([]*big.Int, 0)
 		args = append(args, big.NewInt(0).SetBytes(vmArg))
+		args = append(args, big.NewInt(0))
 		return args, nil
 	}
 
-	vmInput, vmType, err := sc.CreateVMDeployInput(tx)
+	vmInput, vmType, codeMetadata, err := sc.CreateVMDeployInput(tx)
 	assert.NotNil(t, vmInput)
 	assert.True(t, bytes.Equal(vmArg, vmType))
+	assert.False(t, codeMetadata.Upgradeable)
 	assert.Nil(t, err)
 }
 
@@ -950,6 +1545,9 @@ func TestScProcessor_CreateVMDeployInputNotEnoughArguments(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -961,7 +1559,7 @@ func TestScProcessor_CreateVMDeployInputNotEnoughArguments(t *testing.T) {
 	tx.Data = "data@0000"
 	tx.Value = big.NewInt(45)
 
-	vmInput, vmType, err := sc.CreateVMDeployInput(tx)
+	vmInput, vmType, _, err := sc.CreateVMDeployInput(tx)
 	assert.Nil(t, vmInput)
 	assert.Nil(t, vmType)
 	assert.Equal(t, process.ErrNotEnoughArgumentsToDeploy, err)
@@ -983,6 +1581,9 @@ func TestScProcessor_CreateVMInputWrongArgument(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1019,6 +1620,9 @@ func TestScProcessor_CreateVMInput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1064,6 +1668,9 @@ func TestScProcessor_processVMOutputNilVMOutput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1090,6 +1697,9 @@ func TestScProcessor_processVMOutputNilTx(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1117,6 +1727,9 @@ func TestScProcessor_processVMOutputNilSndAcc(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1148,6 +1761,9 @@ func TestScProcessor_processVMOutputNilDstAcc(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1195,6 +1811,9 @@ func TestScProcessor_GetAccountFromAddressAccNotFound(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1235,6 +1854,9 @@ func TestScProcessor_GetAccountFromAddrFaildAddressConv(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1275,6 +1897,9 @@ func TestScProcessor_GetAccountFromAddrFailedGetExistingAccount(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1315,6 +1940,9 @@ func TestScProcessor_GetAccountFromAddrAccNotInShard(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1356,6 +1984,9 @@ func TestScProcessor_GetAccountFromAddr(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1398,6 +2029,9 @@ func TestScProcessor_DeleteAccountsFailedAtRemove(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1440,6 +2074,9 @@ func TestScProcessor_DeleteAccountsNotInShard(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1487,6 +2124,9 @@ func TestScProcessor_DeleteAccountsInShard(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1513,6 +2153,9 @@ func TestScProcessor_ProcessSCPaymentAccNotInShardShouldNotReturnError(t *testin
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1545,6 +2188,9 @@ func TestScProcessor_ProcessSCPaymentWrongTypeAssertion(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1581,6 +2227,9 @@ func TestScProcessor_ProcessSCPaymentNotEnoughBalance(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1620,6 +2269,9 @@ func TestScProcessor_ProcessSCPayment(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1657,6 +2309,9 @@ func TestScProcessor_RefundGasToSenderNilAndZeroRefund(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1699,6 +2354,9 @@ func TestScProcessor_RefundGasToSenderAccNotInShard(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1746,6 +2404,9 @@ func TestScProcessor_RefundGasToSender(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1771,6 +2432,55 @@ func TestScProcessor_RefundGasToSender(t *testing.T) {
 	assert.Equal(t, currBalance+totalRefund, acntSrc.(*state.Account).Balance.Uint64())
 }
 
+func TestScProcessor_RefundGasToSenderShouldUseTheGasPriceForProcessing(t *testing.T) {
+	t.Parallel()
+
+	moveBalanceFee := big.NewInt(50)
+	gasPriceModifier := uint64(10)
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{
+			ComputeTxFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+				processingFee := big.NewInt(0).SetUint64((tx.GetGasLimit() - 5) * tx.GetGasPrice() / gasPriceModifier)
+				return processingFee.Add(processingFee, moveBalanceFee)
+			},
+			ComputeFeeForProcessingCalled: func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
+				return big.NewInt(0).SetUint64(gasToUse * tx.GetGasPrice() / gasPriceModifier)
+			},
+		},
+	)
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 1
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+
+	tx.Value = big.NewInt(45)
+	tx.GasPrice = 10
+	tx.GasLimit = 105
+	txHash := []byte("txHash")
+	acntSrc, _ := createAccounts(tx)
+	currBalance := acntSrc.(*state.Account).Balance.Uint64()
+
+	scrRefund, consumedFee, err := sc.refundGasToSender(big.NewInt(40), tx, txHash, acntSrc)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(40), scrRefund.Value)
+	assert.Equal(t, big.NewInt(110), consumedFee)
+	assert.Equal(t, currBalance+40, acntSrc.(*state.Account).Balance.Uint64())
+}
+
 func TestScProcessor_processVMOutputNilOutput(t *testing.T) {
 	t.Parallel()
 
@@ -1788,6 +2498,9 @@ func TestScProcessor_processVMOutputNilOutput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1814,6 +2527,9 @@ func TestScProcessor_processVMOutputNilTransaction(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1842,6 +2558,9 @@ func TestScProcessor_processVMOutput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1860,6 +2579,58 @@ func TestScProcessor_processVMOutput(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestScProcessor_processVMOutputShouldSaveTxLog(t *testing.T) {
+	t.Parallel()
+
+	round := uint64(10)
+	acntSrc, _, tx := createAccountsAndTransaction()
+
+	accntState := &mock.AccountsStub{}
+	accntState.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+
+	var savedTxHash []byte
+	var savedVMOutput *vmcommon.VMOutput
+	txLogsProcessor := &mock.TxLogsProcessorStub{
+		SaveLogCalled: func(txHash []byte, vmOutput *vmcommon.VMOutput, scrs []data.TransactionHandler) error {
+			savedTxHash = txHash
+			savedVMOutput = vmOutput
+			return nil
+		},
+	}
+
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accntState,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		txLogsProcessor,
+		&mock.FeeHandlerStub{},
+	)
+
+	vmOutput := &vmcommon.VMOutput{
+		GasRefund:    big.NewInt(0),
+		GasRemaining: big.NewInt(0),
+		ReturnCode:   vmcommon.UserError,
+	}
+
+	tx.Value = big.NewInt(0)
+	_, _, err := sc.ProcessVMOutput(vmOutput, tx, acntSrc, round)
+	assert.Nil(t, err)
+
+	txHash, _ := core.CalculateHash(&mock.MarshalizerMock{}, &mock.HasherMock{}, tx)
+	assert.Equal(t, txHash, savedTxHash)
+	assert.True(t, savedVMOutput == vmOutput)
+}
+
 func TestScProcessor_processSCOutputAccounts(t *testing.T) {
 	t.Parallel()
 
@@ -1878,6 +2649,9 @@ func TestScProcessor_processSCOutputAccounts(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1959,6 +2733,9 @@ func TestScProcessor_processSCOutputAccountsNotInShard(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2000,6 +2777,9 @@ func TestScProcessor_CreateCrossShardTransactions(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2045,6 +2825,9 @@ func TestScProcessor_ProcessSmartContractResultNilScr(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2073,6 +2856,9 @@ func TestScProcessor_ProcessSmartContractResultErrGetAccount(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2099,6 +2885,9 @@ func TestScProcessor_ProcessSmartContractResultAccNotInShard(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2130,6 +2919,9 @@ func TestScProcessor_ProcessSmartContractResultBadAccType(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2164,6 +2956,9 @@ func TestScProcessor_ProcessSmartContractResultOutputBalanceNil(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2204,6 +2999,9 @@ func TestScProcessor_ProcessSmartContractResultWithCode(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2248,6 +3046,9 @@ func TestScProcessor_ProcessSmartContractResultWithData(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.BuiltInFunctionContainerStub{},
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2276,3 +3077,65 @@ func TestScProcessor_ProcessSmartContractResultWithData(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 1, saveTrieCalled)
 }
+
+func TestScProcessor_ProcessSmartContractResultWithBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	saveTrieCalled := 0
+	accountsDB := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return state.NewAccount(addressContainer,
+				&mock.AccountTrackerStub{JournalizeCalled: func(entry state.JournalEntry) {},
+					SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+						return nil
+					}})
+		},
+		SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+			saveTrieCalled++
+			return nil
+		},
+	}
+	builtInFunctionCalled := false
+	builtInFunction := &mock.BuiltInFunctionStub{
+		ProcessBuiltInFunctionCalled: func(tx *transaction.Transaction, acntSnd, acntDst *state.Account, arguments [][]byte) error {
+			builtInFunctionCalled = true
+			assert.Nil(t, acntSnd)
+			assert.NotNil(t, acntDst)
+			assert.Equal(t, [][]byte{[]byte("arg")}, arguments)
+			return nil
+		},
+	}
+	builtInFunctions := &mock.BuiltInFunctionContainerStub{
+		GetCalled: func(key string) (process.BuiltInFunction, error) {
+			if key == "builtInFunc" {
+				return builtInFunction, nil
+			}
+			return nil, process.ErrInvalidContainerKey
+		},
+	}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accountsDB,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		builtInFunctions,
+		&mock.TxLogsProcessorStub{},
+		&mock.FeeHandlerStub{},
+	)
+
+	scr := smartContractResult.SmartContractResult{
+		RcvAddr: []byte("recv address"),
+		Data:    "builtInFunc@" + hex.EncodeToString([]byte("arg")),
+		Value:   big.NewInt(0),
+	}
+	err := sc.ProcessSmartContractResult(&scr)
+	assert.Nil(t, err)
+	assert.True(t, builtInFunctionCalled)
+	assert.Equal(t, 0, saveTrieCalled)
+}
@@ -35,6 +35,36 @@ func NewSCDataGetter(
 
 // Get returns the value as byte slice of the invoked func
 func (scdg *scDataGetter) Get(scAddress []byte, funcName string, args ...[]byte) ([]byte, error) {
+	vmOutput, err := scdg.runSmartContractCall(scAddress, funcName, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// VM is formally verified and the output is correct
+	return scdg.checkVMOutput(vmOutput)
+}
+
+// GetAll returns all the values, as byte slices, returned by the invoked func
+func (scdg *scDataGetter) GetAll(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+	vmOutput, err := scdg.runSmartContractCall(scAddress, funcName, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = scdg.checkVMOutput(vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, 0, len(vmOutput.ReturnData))
+	for _, value := range vmOutput.ReturnData {
+		values = append(values, value.Bytes())
+	}
+
+	return values, nil
+}
+
+func (scdg *scDataGetter) runSmartContractCall(scAddress []byte, funcName string, args ...[]byte) (*vmcommon.VMOutput, error) {
 	if scAddress == nil {
 		return nil, process.ErrNilScAddress
 	}
@@ -46,13 +76,8 @@ func (scdg *scDataGetter) Get(scAddress []byte, funcName string, args ...[]byte)
 	defer scdg.mutRunSc.Unlock()
 
 	vmInput := scdg.createVMCallInput(scAddress, funcName, args...)
-	vmOutput, err := scdg.vm.RunSmartContractCall(vmInput)
-	if err != nil {
-		return nil, err
-	}
 
-	// VM is formally verified and the output is correct
-	return scdg.checkVMOutput(vmOutput)
+	return scdg.vm.RunSmartContractCall(vmInput)
 }
 
 func (scdg *scDataGetter) createVMCallInput(
@@ -135,6 +135,46 @@ func TestScDataGetter_GetReturnsNotOkCodeShouldErr(t *testing.T) {
 	assert.Nil(t, returnedData)
 }
 
+func TestScDataGetter_GetAllReturnsAllTheData(t *testing.T) {
+	t.Parallel()
+
+	data := []*big.Int{big.NewInt(90), big.NewInt(91)}
+	scdg, _ := smartContract.NewSCDataGetter(
+		&mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: data,
+				}, nil
+			},
+		},
+	)
+
+	returnedData, err := scdg.GetAll([]byte("sc address"), "function")
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{data[0].Bytes(), data[1].Bytes()}, returnedData)
+}
+
+func TestScDataGetter_GetAllReturnsNotOkCodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	scdg, _ := smartContract.NewSCDataGetter(
+		&mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.UserError,
+				}, nil
+			},
+		},
+	)
+
+	returnedData, err := scdg.GetAll([]byte("sc address"), "function")
+
+	assert.NotNil(t, err)
+	assert.Nil(t, returnedData)
+}
+
 func TestScDataGetter_GetShouldCallRunScSequentially(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,59 @@
+package smartContract
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// VMCreator creates a VM instance which reads the provided accounts state
+type VMCreator func(accounts state.AccountsAdapter) (vmcommon.VMExecutionHandler, error)
+
+// stateScDataGetter can execute Get functions over SC to fetch the values stored in any provided accounts state,
+// like the state committed at a past block
+type stateScDataGetter struct {
+	vmCreator VMCreator
+}
+
+// NewStateSCDataGetter returns a new instance of stateScDataGetter
+func NewStateSCDataGetter(vmCreator VMCreator) (*stateScDataGetter, error) {
+	if vmCreator == nil {
+		return nil, process.ErrNilVMCreator
+	}
+
+	return &stateScDataGetter{
+		vmCreator: vmCreator,
+	}, nil
+}
+
+// GetFromState returns the value as byte slice of the invoked func, executed against the provided accounts state
+func (ssdg *stateScDataGetter) GetFromState(
+	accounts state.AccountsAdapter,
+	scAddress []byte,
+	funcName string,
+	args ...[]byte,
+) ([]byte, error) {
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+
+	vm, err := ssdg.vmCreator(accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	scDataGetter, err := NewSCDataGetter(vm)
+	if err != nil {
+		return nil, err
+	}
+
+	return scDataGetter.Get(scAddress, funcName, args...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssdg *stateScDataGetter) IsInterfaceNil() bool {
+	if ssdg == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package smartContract_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStateSCDataGetter_NilVMCreatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssdg, err := smartContract.NewStateSCDataGetter(nil)
+
+	assert.Nil(t, ssdg)
+	assert.Equal(t, process.ErrNilVMCreator, err)
+}
+
+func TestNewStateSCDataGetter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ssdg, err := smartContract.NewStateSCDataGetter(func(accounts state.AccountsAdapter) (vmcommon.VMExecutionHandler, error) {
+		return &mock.VMExecutionHandlerStub{}, nil
+	})
+
+	assert.NotNil(t, ssdg)
+	assert.Nil(t, err)
+}
+
+//------- GetFromState
+
+func TestStateScDataGetter_GetFromStateNilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssdg, _ := smartContract.NewStateSCDataGetter(func(accounts state.AccountsAdapter) (vmcommon.VMExecutionHandler, error) {
+		return &mock.VMExecutionHandlerStub{}, nil
+	})
+
+	output, err := ssdg.GetFromState(nil, []byte("sc address"), "function")
+
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestStateScDataGetter_GetFromStateVMCreatorErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	ssdg, _ := smartContract.NewStateSCDataGetter(func(accounts state.AccountsAdapter) (vmcommon.VMExecutionHandler, error) {
+		return nil, errExpected
+	})
+
+	output, err := ssdg.GetFromState(&mock.AccountsStub{}, []byte("sc address"), "function")
+
+	assert.Nil(t, output)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestStateScDataGetter_GetFromStateShouldRunOnTheProvidedState(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{}
+	data := []*big.Int{big.NewInt(90), big.NewInt(91)}
+	ssdg, _ := smartContract.NewStateSCDataGetter(func(providedAccounts state.AccountsAdapter) (vmcommon.VMExecutionHandler, error) {
+		assert.True(t, accounts == providedAccounts)
+
+		return &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: data,
+				}, nil
+			},
+		}, nil
+	})
+
+	returnedData, err := ssdg.GetFromState(accounts, []byte("sc address"), "function")
+
+	assert.Nil(t, err)
+	assert.Equal(t, data[0].Bytes(), returnedData)
+}
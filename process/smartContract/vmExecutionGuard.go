@@ -0,0 +1,157 @@
+package smartContract
+
+import (
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ExecutionTooLongMessage is the data of the log entry recorded for the executions aborted by the guard
+const ExecutionTooLongMessage = "execution too long"
+
+type vmResult struct {
+	vmOutput *vmcommon.VMOutput
+	err      error
+}
+
+// vmExecutionGuard wraps a VM and aborts the smart contract executions exceeding a deadline computed from the
+// provided gas. It is only a backstop for the contracts evading the gas accounting: the aborted executions consume
+// all the provided gas, as if they had ran out of gas, so the result is the same regardless of the node speed
+type vmExecutionGuard struct {
+	vm                 vmcommon.VMExecutionHandler
+	minDuration        time.Duration
+	durationPerGasUnit time.Duration
+	busy               chan struct{}
+}
+
+// NewVMExecutionGuard creates a new VM execution guard around the provided VM
+func NewVMExecutionGuard(
+	vm vmcommon.VMExecutionHandler,
+	minDuration time.Duration,
+	durationPerGasUnit time.Duration,
+) (*vmExecutionGuard, error) {
+	if vm == nil {
+		return nil, process.ErrNoVM
+	}
+	if minDuration <= 0 {
+		return nil, process.ErrInvalidVMExecutionDuration
+	}
+	if durationPerGasUnit < 0 {
+		return nil, process.ErrInvalidVMExecutionDuration
+	}
+
+	return &vmExecutionGuard{
+		vm:                 vm,
+		minDuration:        minDuration,
+		durationPerGasUnit: durationPerGasUnit,
+		busy:               make(chan struct{}, 1),
+	}, nil
+}
+
+// RunSmartContractCreate runs the smart contract creation, aborting it if it exceeds the deadline
+func (veg *vmExecutionGuard) RunSmartContractCreate(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+	if input == nil {
+		return veg.vm.RunSmartContractCreate(input)
+	}
+
+	return veg.run(
+		func() (*vmcommon.VMOutput, error) {
+			return veg.vm.RunSmartContractCreate(input)
+		},
+		input.GasProvided,
+		input.CallerAddr,
+	)
+}
+
+// RunSmartContractCall runs the smart contract call, aborting it if it exceeds the deadline
+func (veg *vmExecutionGuard) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	if input == nil {
+		return veg.vm.RunSmartContractCall(input)
+	}
+
+	return veg.run(
+		func() (*vmcommon.VMOutput, error) {
+			return veg.vm.RunSmartContractCall(input)
+		},
+		input.GasProvided,
+		input.RecipientAddr,
+	)
+}
+
+func (veg *vmExecutionGuard) run(
+	execute func() (*vmcommon.VMOutput, error),
+	gasProvided *big.Int,
+	address []byte,
+) (*vmcommon.VMOutput, error) {
+	deadline := veg.computeDeadline(gasProvided)
+	timer := time.NewTimer(deadline)
+	defer timer.Stop()
+
+	// an aborted execution keeps the VM busy until it finishes by itself
+	select {
+	case veg.busy <- struct{}{}:
+	case <-timer.C:
+		return nil, process.ErrVMBusy
+	}
+
+	chResult := make(chan vmResult, 1)
+	go func() {
+		defer func() {
+			<-veg.busy
+		}()
+
+		vmOutput, err := execute()
+		chResult <- vmResult{vmOutput: vmOutput, err: err}
+	}()
+
+	select {
+	case result := <-chResult:
+		return result.vmOutput, result.err
+	case <-timer.C:
+		log.Warn("smart contract execution aborted",
+			"address", address,
+			"gas provided", gasProvided,
+			"deadline", deadline,
+		)
+		return createExecutionTooLongOutput(address), nil
+	}
+}
+
+func (veg *vmExecutionGuard) computeDeadline(gasProvided *big.Int) time.Duration {
+	if gasProvided == nil || !gasProvided.IsUint64() || veg.durationPerGasUnit == 0 {
+		return veg.minDuration
+	}
+
+	maxGas := uint64(math.MaxInt64-int64(veg.minDuration)) / uint64(veg.durationPerGasUnit)
+	gas := gasProvided.Uint64()
+	if gas > maxGas {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return veg.minDuration + time.Duration(gas)*veg.durationPerGasUnit
+}
+
+func createExecutionTooLongOutput(address []byte) *vmcommon.VMOutput {
+	return &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.OutOfGas,
+		GasRemaining: big.NewInt(0),
+		GasRefund:    big.NewInt(0),
+		Logs: []*vmcommon.LogEntry{
+			{
+				Address: address,
+				Data:    []byte(ExecutionTooLongMessage),
+			},
+		},
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (veg *vmExecutionGuard) IsInterfaceNil() bool {
+	if veg == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package smartContract_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createCallInput(gasProvided int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("caller"),
+			GasProvided: big.NewInt(gasProvided),
+		},
+		RecipientAddr: []byte("contract"),
+		Function:      "function",
+	}
+}
+
+func TestNewVMExecutionGuard_NilVMShouldErr(t *testing.T) {
+	t.Parallel()
+
+	veg, err := smartContract.NewVMExecutionGuard(nil, time.Second, time.Nanosecond)
+
+	assert.Nil(t, veg)
+	assert.Equal(t, process.ErrNoVM, err)
+}
+
+func TestNewVMExecutionGuard_InvalidMinDurationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	veg, err := smartContract.NewVMExecutionGuard(&mock.VMExecutionHandlerStub{}, 0, time.Nanosecond)
+
+	assert.Nil(t, veg)
+	assert.Equal(t, process.ErrInvalidVMExecutionDuration, err)
+}
+
+func TestNewVMExecutionGuard_NegativeDurationPerGasUnitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	veg, err := smartContract.NewVMExecutionGuard(&mock.VMExecutionHandlerStub{}, time.Second, -1)
+
+	assert.Nil(t, veg)
+	assert.Equal(t, process.ErrInvalidVMExecutionDuration, err)
+}
+
+func TestNewVMExecutionGuard_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	veg, err := smartContract.NewVMExecutionGuard(&mock.VMExecutionHandlerStub{}, time.Second, time.Nanosecond)
+
+	assert.NotNil(t, veg)
+	assert.Nil(t, err)
+	assert.False(t, veg.IsInterfaceNil())
+}
+
+//------- RunSmartContractCall
+
+func TestVmExecutionGuard_RunSmartContractCallInTimeShouldReturnVMOutput(t *testing.T) {
+	t.Parallel()
+
+	expectedOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return expectedOutput, nil
+		},
+	}
+	veg, _ := smartContract.NewVMExecutionGuard(vm, time.Second, time.Nanosecond)
+
+	vmOutput, err := veg.RunSmartContractCall(createCallInput(1000))
+
+	assert.Nil(t, err)
+	assert.True(t, expectedOutput == vmOutput)
+}
+
+func TestVmExecutionGuard_RunSmartContractCallTooLongShouldAbort(t *testing.T) {
+	t.Parallel()
+
+	chRelease := make(chan struct{})
+	defer close(chRelease)
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			<-chRelease
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	veg, _ := smartContract.NewVMExecutionGuard(vm, 10*time.Millisecond, time.Nanosecond)
+
+	vmOutput, err := veg.RunSmartContractCall(createCallInput(1000))
+
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.OutOfGas, vmOutput.ReturnCode)
+	assert.Equal(t, big.NewInt(0), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(0), vmOutput.GasRefund)
+	assert.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte("contract"), vmOutput.Logs[0].Address)
+	assert.Equal(t, []byte(smartContract.ExecutionTooLongMessage), vmOutput.Logs[0].Data)
+}
+
+func TestVmExecutionGuard_RunSmartContractCallDeadlineShouldGrowWithProvidedGas(t *testing.T) {
+	t.Parallel()
+
+	expectedOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			time.Sleep(50 * time.Millisecond)
+			return expectedOutput, nil
+		},
+	}
+	veg, _ := smartContract.NewVMExecutionGuard(vm, time.Millisecond, time.Millisecond)
+
+	vmOutput, err := veg.RunSmartContractCall(createCallInput(1000))
+
+	assert.Nil(t, err)
+	assert.True(t, expectedOutput == vmOutput)
+}
+
+func TestVmExecutionGuard_RunSmartContractCallWhileAbortedExecutionRunsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	chRelease := make(chan struct{})
+	defer close(chRelease)
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			<-chRelease
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	veg, _ := smartContract.NewVMExecutionGuard(vm, 10*time.Millisecond, time.Nanosecond)
+	_, _ = veg.RunSmartContractCall(createCallInput(1000))
+
+	vmOutput, err := veg.RunSmartContractCall(createCallInput(1000))
+
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, process.ErrVMBusy, err)
+}
+
+//------- RunSmartContractCreate
+
+func TestVmExecutionGuard_RunSmartContractCreateTooLongShouldAbort(t *testing.T) {
+	t.Parallel()
+
+	chRelease := make(chan struct{})
+	defer close(chRelease)
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCreateCalled: func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+			<-chRelease
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	veg, _ := smartContract.NewVMExecutionGuard(vm, 10*time.Millisecond, time.Nanosecond)
+
+	vmOutput, err := veg.RunSmartContractCreate(&vmcommon.ContractCreateInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("caller"),
+			GasProvided: big.NewInt(1000),
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.OutOfGas, vmOutput.ReturnCode)
+	assert.Equal(t, []byte("caller"), vmOutput.Logs[0].Address)
+}
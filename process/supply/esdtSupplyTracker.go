@@ -0,0 +1,186 @@
+package supply
+
+import (
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	tokenTotalKeyPrefix = "total_"
+	tokenEpochKeyPrefix = "epoch_"
+)
+
+// ArgESDTSupplyTracker holds all dependencies required by the token supply tracker in order to create a new instance
+type ArgESDTSupplyTracker struct {
+	Storer      storage.Storer
+	Marshalizer marshal.Marshalizer
+}
+
+// esdtSupplyTracker accumulates the supply changes made by the token built-in functions while a block is processed
+// and saves them once the block is committed: the totals of each token and, as history, the changes made in each
+// epoch. The built-in functions run in the shard of the account holding the tokens, so the counters kept by a node
+// only hold the changes made in its own shard
+type esdtSupplyTracker struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+
+	mutPending sync.Mutex
+	pending    map[string]*esdt.TokenSupply
+}
+
+// NewESDTSupplyTracker creates a new token supply tracker
+func NewESDTSupplyTracker(args ArgESDTSupplyTracker) (*esdtSupplyTracker, error) {
+	if check.IfNil(args.Storer) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	return &esdtSupplyTracker{
+		storer:      args.Storer,
+		marshalizer: args.Marshalizer,
+		pending:     make(map[string]*esdt.TokenSupply),
+	}, nil
+}
+
+// AddInitialSupply adds the quantity of a newly created token to its pending changes
+func (est *esdtSupplyTracker) AddInitialSupply(tokenID []byte, value *big.Int) {
+	est.addPendingChange(tokenID, value, func(change *esdt.TokenSupply) *big.Int {
+		return change.Initial
+	})
+}
+
+// AddMinted adds the quantity added to an existing token to its pending changes
+func (est *esdtSupplyTracker) AddMinted(tokenID []byte, value *big.Int) {
+	est.addPendingChange(tokenID, value, func(change *esdt.TokenSupply) *big.Int {
+		return change.Minted
+	})
+}
+
+// AddBurned adds the burned quantity of a token to its pending changes
+func (est *esdtSupplyTracker) AddBurned(tokenID []byte, value *big.Int) {
+	est.addPendingChange(tokenID, value, func(change *esdt.TokenSupply) *big.Int {
+		return change.Burned
+	})
+}
+
+func (est *esdtSupplyTracker) addPendingChange(tokenID []byte, value *big.Int, counter func(change *esdt.TokenSupply) *big.Int) {
+	if len(tokenID) == 0 || value == nil || value.Sign() <= 0 {
+		return
+	}
+
+	est.mutPending.Lock()
+	defer est.mutPending.Unlock()
+
+	change, ok := est.pending[string(tokenID)]
+	if !ok {
+		change = esdt.NewTokenSupply()
+		est.pending[string(tokenID)] = change
+	}
+
+	changedCounter := counter(change)
+	changedCounter.Add(changedCounter, value)
+}
+
+// CommitPendingChanges adds the pending changes to the totals of each token and to the changes of the epoch of the
+// committed block
+func (est *esdtSupplyTracker) CommitPendingChanges(header data.HeaderHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	est.mutPending.Lock()
+	defer est.mutPending.Unlock()
+
+	for tokenID, change := range est.pending {
+		est.addToStoredSupply(tokenTotalKey([]byte(tokenID)), change)
+		est.addToStoredSupply(tokenEpochKey([]byte(tokenID), header.GetEpoch()), change)
+	}
+
+	est.pending = make(map[string]*esdt.TokenSupply)
+}
+
+func (est *esdtSupplyTracker) addToStoredSupply(key []byte, change *esdt.TokenSupply) {
+	tokenSupply, err := est.getStoredSupply(key)
+	if err != nil {
+		tokenSupply = esdt.NewTokenSupply()
+	}
+	tokenSupply.Add(change)
+
+	buff, err := est.marshalizer.Marshal(tokenSupply)
+	if err != nil {
+		log.Debug("cannot marshal the token supply", "key", key, "error", err.Error())
+		return
+	}
+
+	err = est.storer.Put(key, buff)
+	if err != nil {
+		log.Debug("cannot save the token supply", "key", key, "error", err.Error())
+	}
+}
+
+// RevertPendingChanges drops the changes made by the block which was not committed
+func (est *esdtSupplyTracker) RevertPendingChanges() {
+	est.mutPending.Lock()
+	est.pending = make(map[string]*esdt.TokenSupply)
+	est.mutPending.Unlock()
+}
+
+// GetTokenSupply returns the supply counters of the given token, as they were reached at the last committed block
+func (est *esdtSupplyTracker) GetTokenSupply(tokenID []byte) (*esdt.TokenSupply, error) {
+	tokenSupply, err := est.getStoredSupply(tokenTotalKey(tokenID))
+	if err != nil {
+		return nil, process.ErrTokenSupplyNotFound
+	}
+
+	return tokenSupply, nil
+}
+
+func (est *esdtSupplyTracker) getStoredSupply(key []byte) (*esdt.TokenSupply, error) {
+	buff, err := est.storer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSupply := &esdt.TokenSupply{}
+	err = est.marshalizer.Unmarshal(tokenSupply, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenSupply, nil
+}
+
+func tokenTotalKey(tokenID []byte) []byte {
+	return append([]byte(tokenTotalKeyPrefix), tokenID...)
+}
+
+func tokenEpochKey(tokenID []byte, epoch uint32) []byte {
+	key := make([]byte, len(tokenEpochKeyPrefix)+4)
+	copy(key, tokenEpochKeyPrefix)
+	binary.BigEndian.PutUint32(key[len(tokenEpochKeyPrefix):], epoch)
+
+	return append(key, tokenID...)
+}
+
+// IsEnabled returns true as the token supply changes are tracked
+func (est *esdtSupplyTracker) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (est *esdtSupplyTracker) IsInterfaceNil() bool {
+	if est == nil {
+		return true
+	}
+	return false
+}
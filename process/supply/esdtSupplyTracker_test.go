@@ -0,0 +1,156 @@
+package supply_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/supply"
+	"github.com/stretchr/testify/assert"
+)
+
+var tokenID = []byte("TKN-0123")
+
+func createESDTSupplyTrackerArgs(storage map[string][]byte) supply.ArgESDTSupplyTracker {
+	return supply.ArgESDTSupplyTracker{
+		Storer: &mock.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				storage[string(key)] = data
+				return nil
+			},
+			GetCalled: func(key []byte) ([]byte, error) {
+				buff, ok := storage[string(key)]
+				if !ok {
+					return nil, errors.New("key not found")
+				}
+				return buff, nil
+			},
+		},
+		Marshalizer: &mock.MarshalizerMock{},
+	}
+}
+
+func TestNewESDTSupplyTracker_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createESDTSupplyTrackerArgs(make(map[string][]byte))
+	args.Storer = nil
+	est, err := supply.NewESDTSupplyTracker(args)
+
+	assert.True(t, est == nil)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewESDTSupplyTracker_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createESDTSupplyTrackerArgs(make(map[string][]byte))
+	args.Marshalizer = nil
+	est, err := supply.NewESDTSupplyTracker(args)
+
+	assert.True(t, est == nil)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestESDTSupplyTracker_GetTokenSupplyNotTrackedTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	est, _ := supply.NewESDTSupplyTracker(createESDTSupplyTrackerArgs(make(map[string][]byte)))
+
+	tokenSupply, err := est.GetTokenSupply(tokenID)
+
+	assert.Nil(t, tokenSupply)
+	assert.Equal(t, process.ErrTokenSupplyNotFound, err)
+}
+
+func TestESDTSupplyTracker_PendingChangesShouldBeSavedOnlyOnCommit(t *testing.T) {
+	t.Parallel()
+
+	est, _ := supply.NewESDTSupplyTracker(createESDTSupplyTrackerArgs(make(map[string][]byte)))
+
+	est.AddInitialSupply(tokenID, big.NewInt(100))
+	est.AddMinted(tokenID, big.NewInt(30))
+	est.AddBurned(tokenID, big.NewInt(20))
+	_, err := est.GetTokenSupply(tokenID)
+	assert.Equal(t, process.ErrTokenSupplyNotFound, err)
+
+	est.CommitPendingChanges(&block.Header{Nonce: 1, Epoch: 2})
+
+	tokenSupply, err := est.GetTokenSupply(tokenID)
+	assert.Nil(t, err)
+	assert.Equal(t, &esdt.TokenSupply{
+		Initial: big.NewInt(100),
+		Minted:  big.NewInt(30),
+		Burned:  big.NewInt(20),
+	}, tokenSupply)
+	assert.Equal(t, big.NewInt(110), tokenSupply.CurrentSupply())
+}
+
+func TestESDTSupplyTracker_RevertedChangesShouldNotBeSaved(t *testing.T) {
+	t.Parallel()
+
+	est, _ := supply.NewESDTSupplyTracker(createESDTSupplyTrackerArgs(make(map[string][]byte)))
+
+	est.AddInitialSupply(tokenID, big.NewInt(100))
+	est.CommitPendingChanges(&block.Header{Nonce: 1})
+
+	est.AddMinted(tokenID, big.NewInt(30))
+	est.RevertPendingChanges()
+	est.CommitPendingChanges(&block.Header{Nonce: 2})
+
+	tokenSupply, _ := est.GetTokenSupply(tokenID)
+	assert.Equal(t, big.NewInt(100), tokenSupply.Initial)
+	assert.Equal(t, big.NewInt(0), tokenSupply.Minted)
+}
+
+func TestESDTSupplyTracker_InvalidChangesShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	est, _ := supply.NewESDTSupplyTracker(createESDTSupplyTrackerArgs(storage))
+
+	est.AddMinted(nil, big.NewInt(30))
+	est.AddMinted(tokenID, nil)
+	est.AddBurned(tokenID, big.NewInt(-5))
+	est.CommitPendingChanges(&block.Header{Nonce: 1})
+
+	assert.Equal(t, 0, len(storage))
+}
+
+func TestESDTSupplyTracker_CommitShouldAccumulateTheTotalsAndTheEpochChanges(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	est, _ := supply.NewESDTSupplyTracker(createESDTSupplyTrackerArgs(storage))
+
+	est.AddInitialSupply(tokenID, big.NewInt(100))
+	est.CommitPendingChanges(&block.Header{Nonce: 1, Epoch: 1})
+	est.AddMinted(tokenID, big.NewInt(30))
+	est.CommitPendingChanges(&block.Header{Nonce: 2, Epoch: 2})
+	est.AddBurned(tokenID, big.NewInt(20))
+	est.CommitPendingChanges(&block.Header{Nonce: 3, Epoch: 2})
+
+	tokenSupply, _ := est.GetTokenSupply(tokenID)
+	assert.Equal(t, big.NewInt(110), tokenSupply.CurrentSupply())
+	// the totals and the changes of the epochs 1 and 2
+	assert.Equal(t, 3, len(storage))
+}
+
+func TestNilESDTSupplyTracker(t *testing.T) {
+	t.Parallel()
+
+	nest := supply.NewNilESDTSupplyTracker()
+	nest.AddInitialSupply(tokenID, big.NewInt(100))
+	nest.CommitPendingChanges(&block.Header{Nonce: 1})
+
+	tokenSupply, err := nest.GetTokenSupply(tokenID)
+
+	assert.Nil(t, tokenSupply)
+	assert.Equal(t, process.ErrESDTSupplyTrackingDisabled, err)
+	assert.False(t, nest.IsEnabled())
+	assert.False(t, nest.IsInterfaceNil())
+}
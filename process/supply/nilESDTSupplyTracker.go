@@ -0,0 +1,56 @@
+package supply
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// nilESDTSupplyTracker is used when the token supply tracking is disabled: the supply changes are ignored
+type nilESDTSupplyTracker struct {
+}
+
+// NewNilESDTSupplyTracker creates a new token supply tracker which does not track anything
+func NewNilESDTSupplyTracker() *nilESDTSupplyTracker {
+	return &nilESDTSupplyTracker{}
+}
+
+// AddInitialSupply does nothing
+func (nest *nilESDTSupplyTracker) AddInitialSupply(_ []byte, _ *big.Int) {
+}
+
+// AddMinted does nothing
+func (nest *nilESDTSupplyTracker) AddMinted(_ []byte, _ *big.Int) {
+}
+
+// AddBurned does nothing
+func (nest *nilESDTSupplyTracker) AddBurned(_ []byte, _ *big.Int) {
+}
+
+// CommitPendingChanges does nothing
+func (nest *nilESDTSupplyTracker) CommitPendingChanges(_ data.HeaderHandler) {
+}
+
+// RevertPendingChanges does nothing
+func (nest *nilESDTSupplyTracker) RevertPendingChanges() {
+}
+
+// GetTokenSupply returns ErrESDTSupplyTrackingDisabled
+func (nest *nilESDTSupplyTracker) GetTokenSupply(_ []byte) (*esdt.TokenSupply, error) {
+	return nil, process.ErrESDTSupplyTrackingDisabled
+}
+
+// IsEnabled returns false as nothing is tracked
+func (nest *nilESDTSupplyTracker) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nest *nilESDTSupplyTracker) IsInterfaceNil() bool {
+	if nest == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package supply
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// nilSupplyAccountant is used when the supply accounting is disabled: the committed blocks are ignored
+type nilSupplyAccountant struct {
+}
+
+// NewNilSupplyAccountant creates a new supply accountant which does not count anything
+func NewNilSupplyAccountant() *nilSupplyAccountant {
+	return &nilSupplyAccountant{}
+}
+
+// AccountCommittedBlock does nothing
+func (nsa *nilSupplyAccountant) AccountCommittedBlock(_ data.HeaderHandler, _ map[string]data.TransactionHandler) {
+}
+
+// CurrentSupply returns ErrSupplyAccountingDisabled
+func (nsa *nilSupplyAccountant) CurrentSupply() (*supply.ShardSupply, error) {
+	return nil, process.ErrSupplyAccountingDisabled
+}
+
+// EpochSupply returns ErrSupplyAccountingDisabled
+func (nsa *nilSupplyAccountant) EpochSupply(_ uint32) (*supply.ShardSupply, error) {
+	return nil, process.ErrSupplyAccountingDisabled
+}
+
+// IsEnabled returns false as nothing is accounted
+func (nsa *nilSupplyAccountant) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nsa *nilSupplyAccountant) IsInterfaceNil() bool {
+	if nsa == nil {
+		return true
+	}
+	return false
+}
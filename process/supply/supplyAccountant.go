@@ -0,0 +1,233 @@
+package supply
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/supply"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("process/supply")
+
+// ArgSupplyAccountant holds all dependencies required by the supply accountant in order to create a new instance
+type ArgSupplyAccountant struct {
+	BurnAddress                   []byte
+	CommunityAddress              []byte
+	ProtocolSustainabilityAddress []byte
+	RewardsHandler                process.RewardsHandler
+	ShardCoordinator              sharding.Coordinator
+	Storer                        storage.Storer
+	Marshalizer                   marshal.Marshalizer
+	GenesisSupply                 *big.Int
+}
+
+// supplyAccountant accumulates, from the reward transactions created by the committed blocks of the own shard, the
+// value minted as protocol rewards and the fees sent to the burn address. The fees paid to the leader and to the
+// community are only moved between accounts, so they are not counted. The fees destroyed once the fee burning is
+// enabled are read from the headers. The counters reached at the end of each epoch are saved in the storer.
+// The counters start from the genesis supply on the first committed block, so after a restart they miss the blocks
+// committed before it, which is signaled by the first accounted nonce. The blocks reverted after their commit are not
+// subtracted either
+type supplyAccountant struct {
+	burnAddress                   []byte
+	communityAddress              []byte
+	protocolSustainabilityAddress []byte
+	shardId                       uint32
+	protocolRewardValue           *big.Int
+	storer                        storage.Storer
+	marshalizer                   marshal.Marshalizer
+
+	mutSupply     sync.RWMutex
+	current       *supply.ShardSupply
+	hasAccounting bool
+}
+
+// NewSupplyAccountant creates a new supply accountant
+func NewSupplyAccountant(args ArgSupplyAccountant) (*supplyAccountant, error) {
+	if len(args.BurnAddress) == 0 || len(args.CommunityAddress) == 0 || len(args.ProtocolSustainabilityAddress) == 0 {
+		return nil, process.ErrNilEconomicsAddress
+	}
+	if check.IfNil(args.RewardsHandler) {
+		return nil, process.ErrNilEconomicsRewardsHandler
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Storer) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if args.GenesisSupply == nil {
+		return nil, process.ErrNilGenesisSupply
+	}
+
+	return &supplyAccountant{
+		burnAddress:                   args.BurnAddress,
+		communityAddress:              args.CommunityAddress,
+		protocolSustainabilityAddress: args.ProtocolSustainabilityAddress,
+		shardId:                       args.ShardCoordinator.SelfId(),
+		protocolRewardValue:           computeProtocolRewardValue(args.RewardsHandler),
+		storer:                        args.Storer,
+		marshalizer:                   args.Marshalizer,
+		current:                       supply.NewShardSupply(args.ShardCoordinator.SelfId(), args.GenesisSupply),
+	}, nil
+}
+
+// computeProtocolRewardValue returns the value of the protocol reward of a consensus member, the protocol
+// sustainability percentage of the rewards value being routed to the protocol sustainability address
+func computeProtocolRewardValue(rewardsHandler process.RewardsHandler) *big.Int {
+	rewardsValue := rewardsHandler.RewardsValue()
+	if rewardsValue == nil {
+		return big.NewInt(0)
+	}
+
+	protocolSustainabilityValue, _ := big.NewFloat(0).Mul(
+		big.NewFloat(0).SetInt(rewardsValue),
+		big.NewFloat(rewardsHandler.ProtocolSustainabilityPercentage()),
+	).Int(nil)
+
+	return big.NewInt(0).Sub(rewardsValue, protocolSustainabilityValue)
+}
+
+// AccountCommittedBlock adds the value minted and burned by the reward transactions of the committed block. When the
+// block is the first of a new epoch, the counters reached at the end of the previous epoch are saved first
+func (sa *supplyAccountant) AccountCommittedBlock(header data.HeaderHandler, rewardTxs map[string]data.TransactionHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	sa.mutSupply.Lock()
+	defer sa.mutSupply.Unlock()
+
+	if sa.hasAccounting && header.GetNonce() <= sa.current.LastNonce {
+		log.Debug("block already accounted in the supply", "nonce", header.GetNonce())
+		return
+	}
+
+	if !sa.hasAccounting {
+		sa.current.FirstNonce = header.GetNonce()
+		sa.current.Epoch = header.GetEpoch()
+	}
+	if header.GetEpoch() > sa.current.Epoch {
+		sa.saveEpochSupply(sa.current)
+		sa.current.Epoch = header.GetEpoch()
+	}
+
+	for _, tx := range rewardTxs {
+		sa.accountRewardTx(tx)
+	}
+	sa.accountDestroyedFees(header)
+	// TODO: count the slashed value once the slashing is implemented
+
+	sa.current.LastNonce = header.GetNonce()
+	sa.hasAccounting = true
+}
+
+func (sa *supplyAccountant) accountRewardTx(tx data.TransactionHandler) {
+	rTx, ok := tx.(*rewardTx.RewardTx)
+	if !ok || rTx.ShardId != sa.shardId || rTx.Value == nil {
+		return
+	}
+
+	switch {
+	case bytes.Equal(rTx.RcvAddr, sa.burnAddress):
+		sa.current.FeesBurned.Add(sa.current.FeesBurned, rTx.Value)
+	case bytes.Equal(rTx.RcvAddr, sa.protocolSustainabilityAddress):
+		sa.current.Inflation.Add(sa.current.Inflation, rTx.Value)
+	case bytes.Equal(rTx.RcvAddr, sa.communityAddress):
+		// the community share of the fees is only moved between accounts
+	case rTx.Value.Cmp(sa.protocolRewardValue) == 0:
+		sa.current.Inflation.Add(sa.current.Inflation, rTx.Value)
+	}
+}
+
+func (sa *supplyAccountant) accountDestroyedFees(header data.HeaderHandler) {
+	shardHeader, ok := header.(*block.Header)
+	if !ok || shardHeader.BurnedFees == nil {
+		return
+	}
+
+	sa.current.FeesDestroyed.Add(sa.current.FeesDestroyed, shardHeader.BurnedFees)
+}
+
+func (sa *supplyAccountant) saveEpochSupply(epochSupply *supply.ShardSupply) {
+	buff, err := sa.marshalizer.Marshal(epochSupply)
+	if err != nil {
+		log.Debug("cannot marshal the epoch supply", "epoch", epochSupply.Epoch, "error", err.Error())
+		return
+	}
+
+	err = sa.storer.Put(epochToKey(epochSupply.Epoch), buff)
+	if err != nil {
+		log.Debug("cannot save the epoch supply", "epoch", epochSupply.Epoch, "error", err.Error())
+	}
+}
+
+// CurrentSupply returns the supply counters reached at the last committed block
+func (sa *supplyAccountant) CurrentSupply() (*supply.ShardSupply, error) {
+	sa.mutSupply.RLock()
+	defer sa.mutSupply.RUnlock()
+
+	return sa.current.Clone(), nil
+}
+
+// EpochSupply returns the supply counters reached at the end of the given epoch or, for the current epoch, at the
+// last committed block
+func (sa *supplyAccountant) EpochSupply(epoch uint32) (*supply.ShardSupply, error) {
+	sa.mutSupply.RLock()
+	defer sa.mutSupply.RUnlock()
+
+	if sa.hasAccounting && sa.current.Epoch == epoch {
+		return sa.current.Clone(), nil
+	}
+
+	buff, err := sa.storer.Get(epochToKey(epoch))
+	if err != nil {
+		return nil, process.ErrEpochSupplyNotFound
+	}
+
+	epochSupply := &supply.ShardSupply{}
+	err = sa.marshalizer.Unmarshal(epochSupply, buff)
+	if err != nil {
+		return nil, err
+	}
+	// the epochs saved before the destroyed fees were accounted do not hold them
+	if epochSupply.FeesDestroyed == nil {
+		epochSupply.FeesDestroyed = big.NewInt(0)
+	}
+
+	return epochSupply, nil
+}
+
+func epochToKey(epoch uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, epoch)
+
+	return key
+}
+
+// IsEnabled returns true as the committed blocks are accounted
+func (sa *supplyAccountant) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sa *supplyAccountant) IsInterfaceNil() bool {
+	if sa == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+package supply_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/supply"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	burnAddress                   = []byte("burn address")
+	communityAddress              = []byte("community address")
+	protocolSustainabilityAddress = []byte("protocol sustainability address")
+	leaderAddress                 = []byte("leader address")
+)
+
+func createMockArgs(storage map[string][]byte) supply.ArgSupplyAccountant {
+	return supply.ArgSupplyAccountant{
+		BurnAddress:                   burnAddress,
+		CommunityAddress:              communityAddress,
+		ProtocolSustainabilityAddress: protocolSustainabilityAddress,
+		RewardsHandler: &mock.RewardsHandlerMock{
+			RewardsValueCalled: func() *big.Int {
+				return big.NewInt(100)
+			},
+			ProtocolSustainabilityPercentageCalled: func() float64 {
+				return 0.1
+			},
+		},
+		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
+		Storer: &mock.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				storage[string(key)] = data
+				return nil
+			},
+			GetCalled: func(key []byte) ([]byte, error) {
+				buff, ok := storage[string(key)]
+				if !ok {
+					return nil, errors.New("key not found")
+				}
+				return buff, nil
+			},
+		},
+		Marshalizer:   &mock.MarshalizerMock{},
+		GenesisSupply: big.NewInt(1000),
+	}
+}
+
+// createBlockRewardTxs returns the reward transactions of a block rewarding 2 consensus members and distributing
+// 50 as fees: 25 to the leader, 5 to the community and 20 to the burn address
+func createBlockRewardTxs(shardId uint32) map[string]data.TransactionHandler {
+	return map[string]data.TransactionHandler{
+		"reward1":                 &rewardTx.RewardTx{Value: big.NewInt(90), RcvAddr: []byte("validator1"), ShardId: shardId},
+		"reward2":                 &rewardTx.RewardTx{Value: big.NewInt(90), RcvAddr: leaderAddress, ShardId: shardId},
+		"protocolSustainability":  &rewardTx.RewardTx{Value: big.NewInt(20), RcvAddr: protocolSustainabilityAddress, ShardId: shardId},
+		"leaderFee":               &rewardTx.RewardTx{Value: big.NewInt(25), RcvAddr: leaderAddress, ShardId: shardId},
+		"communityFee":            &rewardTx.RewardTx{Value: big.NewInt(5), RcvAddr: communityAddress, ShardId: shardId},
+		"burnFee":                 &rewardTx.RewardTx{Value: big.NewInt(20), RcvAddr: burnAddress, ShardId: shardId},
+		"fromAnotherShard":        &rewardTx.RewardTx{Value: big.NewInt(90), RcvAddr: []byte("validator2"), ShardId: shardId + 1},
+		"notARewardTx":            &smartContractResult.SmartContractResult{Value: big.NewInt(90)},
+		"rewardTxWithoutAnyValue": &rewardTx.RewardTx{RcvAddr: []byte("validator3"), ShardId: shardId},
+	}
+}
+
+//------- NewSupplyAccountant
+
+func TestNewSupplyAccountant_NilEconomicsAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.BurnAddress = nil
+	sa, err := supply.NewSupplyAccountant(args)
+
+	assert.Nil(t, sa)
+	assert.Equal(t, process.ErrNilEconomicsAddress, err)
+}
+
+func TestNewSupplyAccountant_NilRewardsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.RewardsHandler = nil
+	sa, err := supply.NewSupplyAccountant(args)
+
+	assert.Nil(t, sa)
+	assert.Equal(t, process.ErrNilEconomicsRewardsHandler, err)
+}
+
+func TestNewSupplyAccountant_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.ShardCoordinator = nil
+	sa, err := supply.NewSupplyAccountant(args)
+
+	assert.Nil(t, sa)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestNewSupplyAccountant_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.Storer = nil
+	sa, err := supply.NewSupplyAccountant(args)
+
+	assert.Nil(t, sa)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewSupplyAccountant_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.Marshalizer = nil
+	sa, err := supply.NewSupplyAccountant(args)
+
+	assert.Nil(t, sa)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewSupplyAccountant_NilGenesisSupplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	args.GenesisSupply = nil
+	sa, err := supply.NewSupplyAccountant(args)
+
+	assert.Nil(t, sa)
+	assert.Equal(t, process.ErrNilGenesisSupply, err)
+}
+
+func TestNewSupplyAccountant_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sa, err := supply.NewSupplyAccountant(createMockArgs(nil))
+
+	assert.Nil(t, err)
+	assert.False(t, sa.IsInterfaceNil())
+	assert.True(t, sa.IsEnabled())
+}
+
+//------- AccountCommittedBlock
+
+func TestSupplyAccountant_AccountCommittedBlockShouldCountInflationAndBurnedFees(t *testing.T) {
+	t.Parallel()
+
+	sa, _ := supply.NewSupplyAccountant(createMockArgs(make(map[string][]byte)))
+
+	sa.AccountCommittedBlock(&block.Header{Nonce: 1}, createBlockRewardTxs(0))
+	sa.AccountCommittedBlock(&block.Header{Nonce: 2}, createBlockRewardTxs(0))
+
+	shardSupply, err := sa.CurrentSupply()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), shardSupply.FirstNonce)
+	assert.Equal(t, uint64(2), shardSupply.LastNonce)
+	assert.Equal(t, big.NewInt(1000), shardSupply.Genesis)
+	assert.Equal(t, big.NewInt(400), shardSupply.Inflation)
+	assert.Equal(t, big.NewInt(40), shardSupply.FeesBurned)
+	assert.Equal(t, big.NewInt(1400), shardSupply.TotalSupply())
+	assert.Equal(t, big.NewInt(1360), shardSupply.CirculatingSupply())
+}
+
+func TestSupplyAccountant_AccountCommittedBlockShouldCountTheDestroyedFees(t *testing.T) {
+	t.Parallel()
+
+	sa, _ := supply.NewSupplyAccountant(createMockArgs(make(map[string][]byte)))
+
+	sa.AccountCommittedBlock(&block.Header{Nonce: 1}, createBlockRewardTxs(0))
+	sa.AccountCommittedBlock(&block.Header{Nonce: 2, AccumulatedFees: big.NewInt(50), BurnedFees: big.NewInt(30)}, nil)
+
+	shardSupply, err := sa.CurrentSupply()
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(20), shardSupply.FeesBurned)
+	assert.Equal(t, big.NewInt(30), shardSupply.FeesDestroyed)
+	assert.Equal(t, big.NewInt(1170), shardSupply.TotalSupply())
+	assert.Equal(t, big.NewInt(1150), shardSupply.CirculatingSupply())
+}
+
+func TestSupplyAccountant_AccountCommittedBlockAlreadyAccountedShouldIgnore(t *testing.T) {
+	t.Parallel()
+
+	sa, _ := supply.NewSupplyAccountant(createMockArgs(make(map[string][]byte)))
+
+	sa.AccountCommittedBlock(&block.Header{Nonce: 5}, createBlockRewardTxs(0))
+	sa.AccountCommittedBlock(&block.Header{Nonce: 5}, createBlockRewardTxs(0))
+
+	shardSupply, _ := sa.CurrentSupply()
+	assert.Equal(t, uint64(5), shardSupply.FirstNonce)
+	assert.Equal(t, big.NewInt(200), shardSupply.Inflation)
+}
+
+func TestSupplyAccountant_CurrentSupplyShouldReturnACopy(t *testing.T) {
+	t.Parallel()
+
+	sa, _ := supply.NewSupplyAccountant(createMockArgs(make(map[string][]byte)))
+
+	shardSupply, _ := sa.CurrentSupply()
+	shardSupply.Inflation.SetInt64(500)
+
+	shardSupply, _ = sa.CurrentSupply()
+	assert.Equal(t, big.NewInt(0), shardSupply.Inflation)
+}
+
+//------- EpochSupply
+
+func TestSupplyAccountant_EpochSupplyShouldReturnTheCountersAtTheEndOfTheEpoch(t *testing.T) {
+	t.Parallel()
+
+	sa, _ := supply.NewSupplyAccountant(createMockArgs(make(map[string][]byte)))
+
+	sa.AccountCommittedBlock(&block.Header{Nonce: 1, Epoch: 0}, createBlockRewardTxs(0))
+	sa.AccountCommittedBlock(&block.Header{Nonce: 2, Epoch: 1}, createBlockRewardTxs(0))
+
+	epochSupply, err := sa.EpochSupply(0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), epochSupply.Epoch)
+	assert.Equal(t, uint64(1), epochSupply.LastNonce)
+	assert.Equal(t, big.NewInt(200), epochSupply.Inflation)
+	assert.Equal(t, big.NewInt(20), epochSupply.FeesBurned)
+
+	epochSupply, err = sa.EpochSupply(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), epochSupply.LastNonce)
+	assert.Equal(t, big.NewInt(400), epochSupply.Inflation)
+
+	epochSupply, err = sa.EpochSupply(2)
+	assert.Nil(t, epochSupply)
+	assert.Equal(t, process.ErrEpochSupplyNotFound, err)
+}
+
+//------- nilSupplyAccountant
+
+func TestNilSupplyAccountant_ShouldBeDisabled(t *testing.T) {
+	t.Parallel()
+
+	nsa := supply.NewNilSupplyAccountant()
+	nsa.AccountCommittedBlock(&block.Header{Nonce: 1}, createBlockRewardTxs(0))
+
+	shardSupply, err := nsa.CurrentSupply()
+	assert.Nil(t, shardSupply)
+	assert.Equal(t, process.ErrSupplyAccountingDisabled, err)
+	shardSupply, err = nsa.EpochSupply(0)
+	assert.Nil(t, shardSupply)
+	assert.Equal(t, process.ErrSupplyAccountingDisabled, err)
+	assert.False(t, nsa.IsEnabled())
+	assert.False(t, nsa.IsInterfaceNil())
+}
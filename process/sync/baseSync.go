@@ -20,7 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("process/sync")
 
 // sleepTime defines the time in milliseconds between each iteration made in syncBlocks method
 const sleepTime = 5 * time.Millisecond
@@ -86,6 +86,8 @@ type baseBootstrap struct {
 	uint64Converter       typeConverters.Uint64ByteSliceConverter
 	bootstrapRoundIndex   uint64
 	requestsWithTimeout   uint32
+	requestsTracker       dataRetriever.RequestsTracker
+	headerTopic           string
 
 	requestMiniBlocks func(uint32, uint64)
 	getHeaderFromPool func([]byte) (data.HeaderHandler, error)
@@ -335,6 +337,8 @@ func (boot *baseBootstrap) processReceivedHeader(headerHandler data.HeaderHandle
 		core.ToB64(headerHash),
 		headerHandler.GetNonce()))
 
+	boot.requestsTracker.DataReceived(boot.headerTopic, headerHash)
+
 	err := boot.forkDetector.AddHeader(headerHandler, headerHash, process.BHReceived, nil, nil)
 	if err != nil {
 		log.Debug(err.Error())
@@ -366,6 +370,10 @@ func (boot *baseBootstrap) receivedHeaderNonce(nonce uint64, shardId uint32, has
 		nonce,
 		core.ToB64(hash)))
 
+	if shardId == boot.shardCoordinator.SelfId() {
+		boot.requestsTracker.DataReceived(boot.headerTopic, boot.uint64Converter.ToByteSlice(nonce))
+	}
+
 	header, err := boot.getHeaderFromPool(hash)
 	if err != nil {
 		log.Debug(err.Error())
@@ -413,7 +421,7 @@ func (boot *baseBootstrap) SetStatusHandler(handler core.AppStatusHandler) error
 	}
 	boot.statusHandler = handler
 
-	return nil
+	return boot.requestsTracker.SetStatusHandler(handler)
 }
 
 func (boot *baseBootstrap) notifySyncStateListeners(isNodeSynchronized bool) {
@@ -439,7 +447,7 @@ func (boot *baseBootstrap) waitForHeaderNonce() error {
 	case <-boot.chRcvHdrNonce:
 		return nil
 	case <-time.After(boot.waitTime):
-		return process.ErrTimeIsOut
+		return boot.requestTimeoutError(boot.headerTopic)
 	}
 }
 
@@ -449,10 +457,20 @@ func (boot *baseBootstrap) waitForHeaderHash() error {
 	case <-boot.chRcvHdrHash:
 		return nil
 	case <-time.After(boot.waitTime):
-		return process.ErrTimeIsOut
+		return boot.requestTimeoutError(boot.headerTopic)
 	}
 }
 
+// requestTimeoutError logs if no data at all was received lately on the topic the data was requested on. The timeout
+// is still handled as missing data, as the resolvers do not answer the requests for data they do not have
+func (boot *baseBootstrap) requestTimeoutError(topic string) error {
+	if boot.requestsTracker.IsTopicUnresponsive(topic) {
+		log.Info(fmt.Sprintf("no data was received lately on topic %s\n", topic))
+	}
+
+	return process.ErrTimeIsOut
+}
+
 // ShouldSync method returns the synch state of the node. If it returns 'true', this means that the node
 // is not synchronized yet and it has to continue the bootstrapping mechanism, otherwise the node is already
 // synched and it can participate to the consensus, if it is in the jobDone group of this rounder
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestsTracker"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -86,6 +87,12 @@ func NewMetaBootstrap(
 		shardCoordinator:    shardCoordinator,
 		accounts:            accounts,
 		bootstrapRoundIndex: bootstrapRoundIndex,
+		headerTopic:         factory.MetachainBlocksTopic,
+	}
+
+	base.requestsTracker, err = requestsTracker.NewRequestsTracker(waitTime, process.MaxSilentRequestsTimeouts)
+	if err != nil {
+		return nil, err
 	}
 
 	boot := MetaBootstrap{
@@ -504,6 +511,7 @@ func (boot *MetaBootstrap) SyncBlock() error {
 // requestHeaderWithNonce method requests a block header from network when it is not found in the pool
 func (boot *MetaBootstrap) requestHeaderWithNonce(nonce uint64) {
 	boot.setRequestedHeaderNonce(&nonce)
+	boot.requestsTracker.RequestSent(boot.headerTopic, boot.uint64Converter.ToByteSlice(nonce))
 	err := boot.hdrRes.RequestDataFromNonce(nonce)
 
 	log.Info(fmt.Sprintf("requested header with nonce %d from network and probable highest nonce is %d\n",
@@ -518,6 +526,7 @@ func (boot *MetaBootstrap) requestHeaderWithNonce(nonce uint64) {
 // requestHeaderWithHash method requests a block header from network when it is not found in the pool
 func (boot *MetaBootstrap) requestHeaderWithHash(hash []byte) {
 	boot.setRequestedHeaderHash(hash)
+	boot.requestsTracker.RequestSent(boot.headerTopic, hash)
 	err := boot.hdrRes.RequestDataFromHash(hash)
 
 	log.Info(fmt.Sprintf("requested header with hash %s from network\n", core.ToB64(hash)))
@@ -752,6 +752,62 @@ func TestMetaBootstrap_ShouldReturnTimeIsOutWhenMissingHeader(t *testing.T) {
 	assert.Equal(t, process.ErrTimeIsOut, r)
 }
 
+func TestMetaBootstrap_ShouldKeepReturningTimeIsOutWhenNothingIsReceived(t *testing.T) {
+	t.Parallel()
+
+	hdr := block.MetaBlock{Nonce: 1}
+	blkc := mock.BlockChainMock{}
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return &hdr
+	}
+
+	pools := createMockMetaPools()
+
+	hasher := &mock.HasherMock{}
+	marshalizer := &mock.MarshalizerMock{}
+	forkDetector := &mock.ForkDetectorMock{}
+	forkDetector.CheckForkCalled = func() (bool, uint64, []byte) {
+		return false, math.MaxUint64, nil
+	}
+	forkDetector.ProbableHighestNonceCalled = func() uint64 {
+		return 100
+	}
+	forkDetector.ResetProbableHighestNonceIfNeededCalled = func() {
+	}
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	account := &mock.AccountsStub{}
+
+	rnd, _ := round.NewRound(time.Now(),
+		time.Now().Add(2*time.Duration(100*time.Millisecond)),
+		time.Duration(100*time.Millisecond),
+		&mock.SyncTimerMock{})
+
+	blockProcessorMock := createMetaBlockProcessor()
+
+	bs, _ := sync.NewMetaBootstrap(
+		pools,
+		createStore(),
+		&blkc,
+		rnd,
+		blockProcessorMock,
+		waitTime,
+		hasher,
+		marshalizer,
+		forkDetector,
+		createMockResolversFinderMeta(),
+		shardCoordinator,
+		account,
+		math.MaxUint32,
+	)
+
+	r := bs.SyncBlock()
+	assert.Equal(t, process.ErrTimeIsOut, r)
+
+	r = bs.SyncBlock()
+	assert.Equal(t, process.ErrTimeIsOut, r)
+}
+
 func TestMetaBootstrap_ShouldNotNeedToSync(t *testing.T) {
 	t.Parallel()
 
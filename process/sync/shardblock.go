@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestsTracker"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -96,6 +97,12 @@ func NewShardBootstrap(
 		shardCoordinator:    shardCoordinator,
 		accounts:            accounts,
 		bootstrapRoundIndex: bootstrapRoundIndex,
+		headerTopic:         factory.HeadersTopic,
+	}
+
+	base.requestsTracker, err = requestsTracker.NewRequestsTracker(waitTime, process.MaxSilentRequestsTimeouts)
+	if err != nil {
+		return nil, err
 	}
 
 	boot := ShardBootstrap{
@@ -559,6 +566,8 @@ func (boot *ShardBootstrap) setRequestedMiniBlocks(hashes [][]byte) {
 // receivedBody method is a call back function which is called when a new body is added
 // in the block bodies pool
 func (boot *ShardBootstrap) receivedBodyHash(hash []byte) {
+	boot.requestsTracker.DataReceived(factory.MiniBlocksTopic, hash)
+
 	boot.mutRcvMiniBlocks.Lock()
 	if len(boot.requestedHashes.ExpectedData()) == 0 {
 		boot.mutRcvMiniBlocks.Unlock()
@@ -745,6 +754,7 @@ func (boot *ShardBootstrap) SyncBlock() error {
 // requestHeaderWithNonce method requests a block header from network when it is not found in the pool
 func (boot *ShardBootstrap) requestHeaderWithNonce(nonce uint64) {
 	boot.setRequestedHeaderNonce(&nonce)
+	boot.requestsTracker.RequestSent(boot.headerTopic, boot.uint64Converter.ToByteSlice(nonce))
 	err := boot.hdrRes.RequestDataFromNonce(nonce)
 
 	log.Info(fmt.Sprintf("requested header with nonce %d from network and probable highest nonce is %d\n",
@@ -759,6 +769,7 @@ func (boot *ShardBootstrap) requestHeaderWithNonce(nonce uint64) {
 // requestHeaderWithHash method requests a block header from network when it is not found in the pool
 func (boot *ShardBootstrap) requestHeaderWithHash(hash []byte) {
 	boot.setRequestedHeaderHash(hash)
+	boot.requestsTracker.RequestSent(boot.headerTopic, hash)
 	err := boot.hdrRes.RequestDataFromHash(hash)
 
 	log.Info(fmt.Sprintf("requested header with hash %s from network\n", core.ToB64(hash)))
@@ -821,6 +832,9 @@ func (boot *ShardBootstrap) getHeaderWithHashRequestingIfMissing(hash []byte) (*
 // requestMiniBlocks method requests a block body from network when it is not found in the pool
 func (boot *ShardBootstrap) requestMiniBlocks(hashes [][]byte) {
 	boot.setRequestedMiniBlocks(hashes)
+	for _, hash := range hashes {
+		boot.requestsTracker.RequestSent(factory.MiniBlocksTopic, hash)
+	}
 	err := boot.miniBlockResolver.RequestDataFromHashArray(hashes)
 
 	log.Info(fmt.Sprintf("requested %d mini blocks from network\n", len(hashes)))
@@ -862,7 +876,7 @@ func (boot *ShardBootstrap) waitForMiniBlocks() error {
 	case <-boot.chRcvMiniBlocks:
 		return nil
 	case <-time.After(boot.waitTime):
-		return process.ErrTimeIsOut
+		return boot.requestTimeoutError(factory.MiniBlocksTopic)
 	}
 }
 
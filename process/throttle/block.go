@@ -9,7 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("process/throttle")
 
 const (
 	jumpAbovePercent = 90
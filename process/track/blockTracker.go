@@ -0,0 +1,327 @@
+package track
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+type headerInfo struct {
+	header data.HeaderHandler
+	hash   []byte
+}
+
+// ArgBlockTracker holds all dependencies required by the block tracker in order to create a new instance
+type ArgBlockTracker struct {
+	Hasher           hashing.Hasher
+	Marshalizer      marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+	StartHeaders     map[uint32]data.HeaderHandler
+	HeadersNotifier  dataRetriever.HeadersNotifier
+}
+
+// blockTracker keeps, for every shard, the chain of received headers together with the headers notarized by
+// the self shard (cross notarized) and the self headers notarized by the other shards (self notarized)
+type blockTracker struct {
+	hasher           hashing.Hasher
+	marshalizer      marshal.Marshalizer
+	shardCoordinator sharding.Coordinator
+
+	mutHeaders sync.RWMutex
+	headers    map[uint32]map[uint64][]*headerInfo
+
+	mutNotarizedHeaders   sync.RWMutex
+	crossNotarizedHeaders map[uint32][]*headerInfo
+	selfNotarizedHeaders  map[uint32][]*headerInfo
+}
+
+// NewBlockTracker creates a new block tracker object, starting with the given headers as the last
+// notarized ones for every shard
+func NewBlockTracker(arguments ArgBlockTracker) (*blockTracker, error) {
+	if arguments.Hasher == nil || arguments.Hasher.IsInterfaceNil() {
+		return nil, process.ErrNilHasher
+	}
+	if arguments.Marshalizer == nil || arguments.Marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if arguments.ShardCoordinator == nil || arguments.ShardCoordinator.IsInterfaceNil() {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if arguments.StartHeaders == nil {
+		return nil, process.ErrNotarizedHdrsSliceIsNil
+	}
+	if arguments.HeadersNotifier == nil || arguments.HeadersNotifier.IsInterfaceNil() {
+		return nil, process.ErrNilHeadersNotifier
+	}
+
+	bt := &blockTracker{
+		hasher:                arguments.Hasher,
+		marshalizer:           arguments.Marshalizer,
+		shardCoordinator:      arguments.ShardCoordinator,
+		headers:               make(map[uint32]map[uint64][]*headerInfo),
+		crossNotarizedHeaders: make(map[uint32][]*headerInfo),
+		selfNotarizedHeaders:  make(map[uint32][]*headerInfo),
+	}
+
+	err := bt.initNotarizedHeaders(arguments.StartHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments.HeadersNotifier.RegisterHandler(bt.AddTrackedHeader)
+
+	return bt, nil
+}
+
+func (bt *blockTracker) initNotarizedHeaders(startHeaders map[uint32]data.HeaderHandler) error {
+	selfStartHeader := startHeaders[bt.shardCoordinator.SelfId()]
+	if selfStartHeader == nil || selfStartHeader.IsInterfaceNil() {
+		return process.ErrMissingHeader
+	}
+
+	selfStartHeaderHash, err := core.CalculateHash(bt.marshalizer, bt.hasher, selfStartHeader)
+	if err != nil {
+		return err
+	}
+
+	shardIds := make([]uint32, 0, bt.shardCoordinator.NumberOfShards()+1)
+	for shardId := uint32(0); shardId < bt.shardCoordinator.NumberOfShards(); shardId++ {
+		shardIds = append(shardIds, shardId)
+	}
+	shardIds = append(shardIds, sharding.MetachainShardId)
+
+	for _, shardId := range shardIds {
+		startHeader := startHeaders[shardId]
+		if startHeader == nil || startHeader.IsInterfaceNil() {
+			return process.ErrMissingHeader
+		}
+
+		startHeaderHash, err := core.CalculateHash(bt.marshalizer, bt.hasher, startHeader)
+		if err != nil {
+			return err
+		}
+
+		bt.crossNotarizedHeaders[shardId] = []*headerInfo{{header: startHeader, hash: startHeaderHash}}
+		bt.selfNotarizedHeaders[shardId] = []*headerInfo{{header: selfStartHeader, hash: selfStartHeaderHash}}
+	}
+
+	return nil
+}
+
+// AddTrackedHeader adds the given header, received from the network, to the chain of headers of its shard
+func (bt *blockTracker) AddTrackedHeader(header data.HeaderHandler, hash []byte) {
+	if header == nil || header.IsInterfaceNil() {
+		return
+	}
+
+	shardId := header.GetShardID()
+	lastCrossNotarizedHeader, _, err := bt.GetLastCrossNotarizedHeader(shardId)
+	if err == nil && header.GetNonce() <= lastCrossNotarizedHeader.GetNonce() {
+		return
+	}
+
+	bt.mutHeaders.Lock()
+	defer bt.mutHeaders.Unlock()
+
+	headersForShard, ok := bt.headers[shardId]
+	if !ok {
+		headersForShard = make(map[uint64][]*headerInfo)
+		bt.headers[shardId] = headersForShard
+	}
+
+	for _, hdrInfo := range headersForShard[header.GetNonce()] {
+		if bytes.Equal(hdrInfo.hash, hash) {
+			return
+		}
+	}
+
+	headersForShard[header.GetNonce()] = append(headersForShard[header.GetNonce()], &headerInfo{header: header, hash: hash})
+}
+
+// AddCrossNotarizedHeader adds the given header, from the given shard, as being notarized by the self shard
+func (bt *blockTracker) AddCrossNotarizedHeader(shardId uint32, crossNotarizedHeader data.HeaderHandler, crossNotarizedHeaderHash []byte) {
+	if crossNotarizedHeader == nil || crossNotarizedHeader.IsInterfaceNil() {
+		return
+	}
+
+	bt.mutNotarizedHeaders.Lock()
+	bt.crossNotarizedHeaders[shardId] = appendSortedByNonce(
+		bt.crossNotarizedHeaders[shardId],
+		&headerInfo{header: crossNotarizedHeader, hash: crossNotarizedHeaderHash})
+	bt.mutNotarizedHeaders.Unlock()
+}
+
+// AddSelfNotarizedHeader adds the given self shard header as being notarized by the given shard
+func (bt *blockTracker) AddSelfNotarizedHeader(shardId uint32, selfNotarizedHeader data.HeaderHandler, selfNotarizedHeaderHash []byte) {
+	if selfNotarizedHeader == nil || selfNotarizedHeader.IsInterfaceNil() {
+		return
+	}
+
+	bt.mutNotarizedHeaders.Lock()
+	bt.selfNotarizedHeaders[shardId] = appendSortedByNonce(
+		bt.selfNotarizedHeaders[shardId],
+		&headerInfo{header: selfNotarizedHeader, hash: selfNotarizedHeaderHash})
+	bt.mutNotarizedHeaders.Unlock()
+}
+
+func appendSortedByNonce(hdrInfos []*headerInfo, hdrInfo *headerInfo) []*headerInfo {
+	for _, hdrInfoStored := range hdrInfos {
+		if bytes.Equal(hdrInfoStored.hash, hdrInfo.hash) {
+			return hdrInfos
+		}
+	}
+
+	hdrInfos = append(hdrInfos, hdrInfo)
+	sort.SliceStable(hdrInfos, func(i, j int) bool {
+		return hdrInfos[i].header.GetNonce() < hdrInfos[j].header.GetNonce()
+	})
+
+	return hdrInfos
+}
+
+// GetLastCrossNotarizedHeader returns the highest header, from the given shard, notarized by the self shard
+func (bt *blockTracker) GetLastCrossNotarizedHeader(shardId uint32) (data.HeaderHandler, []byte, error) {
+	bt.mutNotarizedHeaders.RLock()
+	defer bt.mutNotarizedHeaders.RUnlock()
+
+	return lastHeader(bt.crossNotarizedHeaders[shardId])
+}
+
+// GetLastSelfNotarizedHeader returns the highest self shard header notarized by the given shard
+func (bt *blockTracker) GetLastSelfNotarizedHeader(shardId uint32) (data.HeaderHandler, []byte, error) {
+	bt.mutNotarizedHeaders.RLock()
+	defer bt.mutNotarizedHeaders.RUnlock()
+
+	return lastHeader(bt.selfNotarizedHeaders[shardId])
+}
+
+func lastHeader(hdrInfos []*headerInfo) (data.HeaderHandler, []byte, error) {
+	if len(hdrInfos) == 0 {
+		return nil, nil, process.ErrNotarizedHdrsSliceIsNil
+	}
+
+	hdrInfo := hdrInfos[len(hdrInfos)-1]
+	return hdrInfo.header, hdrInfo.hash, nil
+}
+
+// ComputeLongestChain returns the longest chain of tracked headers, from the given shard, which could be built on
+// top of the given header. The returned headers are ordered by nonce
+func (bt *blockTracker) ComputeLongestChain(shardId uint32, header data.HeaderHandler) ([]data.HeaderHandler, [][]byte) {
+	headers := make([]data.HeaderHandler, 0)
+	headersHashes := make([][]byte, 0)
+
+	if header == nil || header.IsInterfaceNil() {
+		return headers, headersHashes
+	}
+
+	headerHash, err := core.CalculateHash(bt.marshalizer, bt.hasher, header)
+	if err != nil {
+		return headers, headersHashes
+	}
+
+	bt.mutHeaders.RLock()
+	longestChain := bt.longestChainFrom(bt.headers[shardId], &headerInfo{header: header, hash: headerHash})
+	bt.mutHeaders.RUnlock()
+
+	for _, hdrInfo := range longestChain {
+		headers = append(headers, hdrInfo.header)
+		headersHashes = append(headersHashes, hdrInfo.hash)
+	}
+
+	return headers, headersHashes
+}
+
+func (bt *blockTracker) longestChainFrom(headersForShard map[uint64][]*headerInfo, prevHdrInfo *headerInfo) []*headerInfo {
+	var longestChain []*headerInfo
+
+	for _, hdrInfo := range headersForShard[prevHdrInfo.header.GetNonce()+1] {
+		if !bytes.Equal(hdrInfo.header.GetPrevHash(), prevHdrInfo.hash) {
+			continue
+		}
+		if hdrInfo.header.GetRound() <= prevHdrInfo.header.GetRound() {
+			continue
+		}
+
+		chain := append([]*headerInfo{hdrInfo}, bt.longestChainFrom(headersForShard, hdrInfo)...)
+		if len(chain) > len(longestChain) {
+			longestChain = chain
+		}
+	}
+
+	return longestChain
+}
+
+// CleanupHeadersBehindNonce removes the tracked and the notarized headers, from the given shard, which are behind
+// the given nonces. The last notarized headers are always preserved
+func (bt *blockTracker) CleanupHeadersBehindNonce(shardId uint32, selfNotarizedNonce uint64, crossNotarizedNonce uint64) {
+	bt.mutNotarizedHeaders.Lock()
+	bt.selfNotarizedHeaders[shardId] = removeHeadersBehindNonce(bt.selfNotarizedHeaders[shardId], selfNotarizedNonce)
+	bt.crossNotarizedHeaders[shardId] = removeHeadersBehindNonce(bt.crossNotarizedHeaders[shardId], crossNotarizedNonce)
+	bt.mutNotarizedHeaders.Unlock()
+
+	bt.mutHeaders.Lock()
+	for nonce := range bt.headers[shardId] {
+		if nonce < crossNotarizedNonce {
+			delete(bt.headers[shardId], nonce)
+		}
+	}
+	bt.mutHeaders.Unlock()
+}
+
+func removeHeadersBehindNonce(hdrInfos []*headerInfo, nonce uint64) []*headerInfo {
+	if len(hdrInfos) == 0 {
+		return hdrInfos
+	}
+
+	preservedHdrInfos := make([]*headerInfo, 0)
+	for _, hdrInfo := range hdrInfos {
+		if hdrInfo.header.GetNonce() < nonce {
+			continue
+		}
+
+		preservedHdrInfos = append(preservedHdrInfos, hdrInfo)
+	}
+
+	if len(preservedHdrInfos) == 0 {
+		preservedHdrInfos = append(preservedHdrInfos, hdrInfos[len(hdrInfos)-1])
+	}
+
+	return preservedHdrInfos
+}
+
+// RemoveLastNotarizedHeaders removes the last cross and self notarized headers for every shard, preserving at least
+// one of them. It is used when the last committed block is rolled back
+func (bt *blockTracker) RemoveLastNotarizedHeaders() {
+	bt.mutNotarizedHeaders.Lock()
+	for shardId := range bt.crossNotarizedHeaders {
+		bt.crossNotarizedHeaders[shardId] = removeLastHeader(bt.crossNotarizedHeaders[shardId])
+	}
+	for shardId := range bt.selfNotarizedHeaders {
+		bt.selfNotarizedHeaders[shardId] = removeLastHeader(bt.selfNotarizedHeaders[shardId])
+	}
+	bt.mutNotarizedHeaders.Unlock()
+}
+
+func removeLastHeader(hdrInfos []*headerInfo) []*headerInfo {
+	if len(hdrInfos) > 1 {
+		return hdrInfos[:len(hdrInfos)-1]
+	}
+
+	return hdrInfos
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bt *blockTracker) IsInterfaceNil() bool {
+	if bt == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,295 @@
+package track_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/track"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createStartHeaders() map[uint32]data.HeaderHandler {
+	return map[uint32]data.HeaderHandler{
+		0:                         &block.Header{ShardId: 0},
+		sharding.MetachainShardId: &block.MetaBlock{},
+	}
+}
+
+func createMockArguments() track.ArgBlockTracker {
+	return track.ArgBlockTracker{
+		Hasher:           &mock.HasherMock{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		StartHeaders:     createStartHeaders(),
+		HeadersNotifier:  &mock.HeadersNotifierStub{},
+	}
+}
+
+func createMetaBlock(nonce uint64, round uint64, prevHash []byte) *block.MetaBlock {
+	return &block.MetaBlock{
+		Nonce:    nonce,
+		Round:    round,
+		PrevHash: prevHash,
+	}
+}
+
+func hashOf(hdr data.HeaderHandler) []byte {
+	hash, _ := core.CalculateHash(&mock.MarshalizerMock{}, &mock.HasherMock{}, hdr)
+	return hash
+}
+
+func TestNewBlockTracker_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	arguments.Hasher = nil
+	bt, err := track.NewBlockTracker(arguments)
+
+	assert.Nil(t, bt)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewBlockTracker_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	arguments.Marshalizer = nil
+	bt, err := track.NewBlockTracker(arguments)
+
+	assert.Nil(t, bt)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewBlockTracker_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	arguments.ShardCoordinator = nil
+	bt, err := track.NewBlockTracker(arguments)
+
+	assert.Nil(t, bt)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestNewBlockTracker_NilStartHeadersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	arguments.StartHeaders = nil
+	bt, err := track.NewBlockTracker(arguments)
+
+	assert.Nil(t, bt)
+	assert.Equal(t, process.ErrNotarizedHdrsSliceIsNil, err)
+}
+
+func TestNewBlockTracker_NilHeadersNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	arguments.HeadersNotifier = nil
+	bt, err := track.NewBlockTracker(arguments)
+
+	assert.Nil(t, bt)
+	assert.Equal(t, process.ErrNilHeadersNotifier, err)
+}
+
+func TestNewBlockTracker_MissingStartHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	delete(arguments.StartHeaders, sharding.MetachainShardId)
+	bt, err := track.NewBlockTracker(arguments)
+
+	assert.Nil(t, bt)
+	assert.Equal(t, process.ErrMissingHeader, err)
+}
+
+func TestNewBlockTracker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	bt, err := track.NewBlockTracker(arguments)
+
+	assert.NotNil(t, bt)
+	assert.Nil(t, err)
+	assert.False(t, bt.IsInterfaceNil())
+
+	lastCrossNotarizedHdr, _, err := bt.GetLastCrossNotarizedHeader(sharding.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, arguments.StartHeaders[sharding.MetachainShardId], lastCrossNotarizedHdr)
+
+	lastSelfNotarizedHdr, _, err := bt.GetLastSelfNotarizedHeader(sharding.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, arguments.StartHeaders[0], lastSelfNotarizedHdr)
+}
+
+func TestBlockTracker_AddCrossNotarizedHeaderShouldKeepHighestAsLast(t *testing.T) {
+	t.Parallel()
+
+	bt, _ := track.NewBlockTracker(createMockArguments())
+	hdr1 := createMetaBlock(1, 1, nil)
+	hdr2 := createMetaBlock(2, 2, nil)
+
+	bt.AddCrossNotarizedHeader(sharding.MetachainShardId, hdr2, []byte("hash2"))
+	bt.AddCrossNotarizedHeader(sharding.MetachainShardId, hdr1, []byte("hash1"))
+
+	lastHdr, lastHdrHash, err := bt.GetLastCrossNotarizedHeader(sharding.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, hdr2, lastHdr)
+	assert.Equal(t, []byte("hash2"), lastHdrHash)
+}
+
+func TestBlockTracker_AddSelfNotarizedHeaderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	bt, _ := track.NewBlockTracker(createMockArguments())
+	hdr := &block.Header{Nonce: 3}
+
+	bt.AddSelfNotarizedHeader(sharding.MetachainShardId, hdr, []byte("hash"))
+
+	lastHdr, lastHdrHash, err := bt.GetLastSelfNotarizedHeader(sharding.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, hdr, lastHdr)
+	assert.Equal(t, []byte("hash"), lastHdrHash)
+}
+
+func TestBlockTracker_GetLastNotarizedHeaderForUnknownShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bt, _ := track.NewBlockTracker(createMockArguments())
+
+	_, _, err := bt.GetLastCrossNotarizedHeader(5)
+	assert.Equal(t, process.ErrNotarizedHdrsSliceIsNil, err)
+
+	_, _, err = bt.GetLastSelfNotarizedHeader(5)
+	assert.Equal(t, process.ErrNotarizedHdrsSliceIsNil, err)
+}
+
+func TestBlockTracker_ComputeLongestChainShouldFollowPrevHashLinks(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	bt, _ := track.NewBlockTracker(arguments)
+	startHdr := arguments.StartHeaders[sharding.MetachainShardId]
+
+	hdr1 := createMetaBlock(1, 1, hashOf(startHdr))
+	hdr2 := createMetaBlock(2, 2, hashOf(hdr1))
+	hdr3 := createMetaBlock(3, 3, hashOf(hdr2))
+	hdr2Fork := createMetaBlock(2, 3, []byte("wrong prev hash"))
+
+	bt.AddTrackedHeader(hdr3, hashOf(hdr3))
+	bt.AddTrackedHeader(hdr1, hashOf(hdr1))
+	bt.AddTrackedHeader(hdr2Fork, hashOf(hdr2Fork))
+	bt.AddTrackedHeader(hdr2, hashOf(hdr2))
+
+	hdrs, hashes := bt.ComputeLongestChain(sharding.MetachainShardId, startHdr)
+
+	assert.Equal(t, []data.HeaderHandler{hdr1, hdr2, hdr3}, hdrs)
+	assert.Equal(t, [][]byte{hashOf(hdr1), hashOf(hdr2), hashOf(hdr3)}, hashes)
+}
+
+func TestBlockTracker_ComputeLongestChainShouldChooseLongestFork(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	bt, _ := track.NewBlockTracker(arguments)
+	startHdr := arguments.StartHeaders[sharding.MetachainShardId]
+
+	hdr1 := createMetaBlock(1, 1, hashOf(startHdr))
+	hdr1Fork := createMetaBlock(1, 2, hashOf(startHdr))
+	hdr2Fork := createMetaBlock(2, 3, hashOf(hdr1Fork))
+
+	bt.AddTrackedHeader(hdr1, hashOf(hdr1))
+	bt.AddTrackedHeader(hdr1Fork, hashOf(hdr1Fork))
+	bt.AddTrackedHeader(hdr2Fork, hashOf(hdr2Fork))
+
+	hdrs, _ := bt.ComputeLongestChain(sharding.MetachainShardId, startHdr)
+
+	assert.Equal(t, []data.HeaderHandler{hdr1Fork, hdr2Fork}, hdrs)
+}
+
+func TestBlockTracker_AddTrackedHeaderBehindLastCrossNotarizedShouldNotBeAdded(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	bt, _ := track.NewBlockTracker(arguments)
+	startHdr := arguments.StartHeaders[sharding.MetachainShardId]
+
+	hdr1 := createMetaBlock(1, 1, hashOf(startHdr))
+	bt.AddCrossNotarizedHeader(sharding.MetachainShardId, hdr1, hashOf(hdr1))
+	bt.AddTrackedHeader(hdr1, hashOf(hdr1))
+
+	hdrs, _ := bt.ComputeLongestChain(sharding.MetachainShardId, startHdr)
+
+	assert.Equal(t, 0, len(hdrs))
+}
+
+func TestBlockTracker_ReceivedHeaderShouldBeTracked(t *testing.T) {
+	t.Parallel()
+
+	var receivedHeaderHandler func(header data.HeaderHandler, hash []byte)
+	arguments := createMockArguments()
+	arguments.HeadersNotifier = &mock.HeadersNotifierStub{
+		RegisterHandlerCalled: func(handler func(header data.HeaderHandler, hash []byte)) {
+			receivedHeaderHandler = handler
+		},
+	}
+	bt, _ := track.NewBlockTracker(arguments)
+	startHdr := arguments.StartHeaders[sharding.MetachainShardId]
+
+	hdr1 := createMetaBlock(1, 1, hashOf(startHdr))
+	receivedHeaderHandler(hdr1, hashOf(hdr1))
+
+	hdrs, _ := bt.ComputeLongestChain(sharding.MetachainShardId, startHdr)
+
+	assert.Equal(t, []data.HeaderHandler{hdr1}, hdrs)
+}
+
+func TestBlockTracker_CleanupHeadersBehindNonceShouldPreserveLastNotarized(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	bt, _ := track.NewBlockTracker(arguments)
+	startHdr := arguments.StartHeaders[sharding.MetachainShardId]
+
+	hdr1 := createMetaBlock(1, 1, hashOf(startHdr))
+	hdr2 := createMetaBlock(2, 2, hashOf(hdr1))
+	bt.AddTrackedHeader(hdr1, hashOf(hdr1))
+	bt.AddTrackedHeader(hdr2, hashOf(hdr2))
+	bt.AddCrossNotarizedHeader(sharding.MetachainShardId, hdr1, hashOf(hdr1))
+
+	bt.CleanupHeadersBehindNonce(sharding.MetachainShardId, 10, 2)
+
+	lastHdr, _, err := bt.GetLastCrossNotarizedHeader(sharding.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, hdr1, lastHdr)
+
+	lastSelfHdr, _, err := bt.GetLastSelfNotarizedHeader(sharding.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, arguments.StartHeaders[0], lastSelfHdr)
+
+	hdrs, _ := bt.ComputeLongestChain(sharding.MetachainShardId, hdr1)
+	assert.Equal(t, []data.HeaderHandler{hdr2}, hdrs)
+}
+
+func TestBlockTracker_RemoveLastNotarizedHeadersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	bt, _ := track.NewBlockTracker(arguments)
+	hdr1 := createMetaBlock(1, 1, nil)
+	bt.AddCrossNotarizedHeader(sharding.MetachainShardId, hdr1, []byte("hash1"))
+
+	bt.RemoveLastNotarizedHeaders()
+	lastHdr, _, _ := bt.GetLastCrossNotarizedHeader(sharding.MetachainShardId)
+	assert.Equal(t, arguments.StartHeaders[sharding.MetachainShardId], lastHdr)
+
+	bt.RemoveLastNotarizedHeaders()
+	lastHdr, _, _ = bt.GetLastCrossNotarizedHeader(sharding.MetachainShardId)
+	assert.Equal(t, arguments.StartHeaders[sharding.MetachainShardId], lastHdr)
+}
@@ -110,7 +110,13 @@ func (txProc *baseTxProcessor) getAddresses(
 	return adrSrc, adrDst, nil
 }
 
-func (txProc *baseTxProcessor) checkTxValues(tx *transaction.Transaction, acntSnd state.AccountHandler) error {
+// checkTxValues verifies the nonce of the transaction and that the sender can afford its value together with the
+// provided maximum fee of the transaction
+func (txProc *baseTxProcessor) checkTxValues(
+	tx *transaction.Transaction,
+	acntSnd state.AccountHandler,
+	maxFee *big.Int,
+) error {
 	if acntSnd == nil || acntSnd.IsInterfaceNil() {
 		// transaction was already done at sender shard
 		return nil
@@ -123,9 +129,7 @@ func (txProc *baseTxProcessor) checkTxValues(tx *transaction.Transaction, acntSn
 		return process.ErrLowerNonceInTransaction
 	}
 
-	cost := big.NewInt(0)
-	cost.Mul(big.NewInt(0).SetUint64(tx.GasPrice), big.NewInt(0).SetUint64(tx.GasLimit))
-	cost.Add(cost, tx.Value)
+	cost := big.NewInt(0).Add(maxFee, tx.Value)
 
 	if cost.Cmp(big.NewInt(0)) == 0 {
 		return nil
@@ -19,7 +19,7 @@ func (txProc *txProcessor) GetAccounts(adrSrc, adrDst state.AddressContainer,
 }
 
 func (txProc *txProcessor) CheckTxValues(tx *transaction.Transaction, acntSnd state.AccountHandler) error {
-	return txProc.checkTxValues(tx, acntSnd)
+	return txProc.checkTxValues(tx, acntSnd, txProc.economicsFee.ComputeTxFee(tx))
 }
 
 func (txProc *txProcessor) MoveBalances(acntSrc, acntDst *state.Account, value *big.Int) error {
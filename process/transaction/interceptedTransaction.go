@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -30,6 +31,8 @@ type InterceptedTransaction struct {
 	isForCurrentShard bool
 	sndAddr           state.AddressContainer
 	feeHandler        process.FeeHandler
+	chainID           []byte
+	limitsChecker     process.InterceptedDataLimitsChecker
 }
 
 // NewInterceptedTransaction returns a new instance of InterceptedTransaction
@@ -42,6 +45,8 @@ func NewInterceptedTransaction(
 	addrConv state.AddressConverter,
 	coordinator sharding.Coordinator,
 	feeHandler process.FeeHandler,
+	chainID []byte,
+	limitsChecker process.InterceptedDataLimitsChecker,
 ) (*InterceptedTransaction, error) {
 
 	if txBuff == nil {
@@ -68,6 +73,12 @@ func NewInterceptedTransaction(
 	if feeHandler == nil || coordinator.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if len(chainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
+	if check.IfNil(limitsChecker) {
+		return nil, process.ErrNilInterceptedDataLimitsChecker
+	}
 
 	tx, err := createTx(marshalizer, txBuff)
 	if err != nil {
@@ -75,14 +86,16 @@ func NewInterceptedTransaction(
 	}
 
 	inTx := &InterceptedTransaction{
-		tx:           tx,
-		marshalizer:  marshalizer,
-		hasher:       hasher,
-		singleSigner: signer,
-		addrConv:     addrConv,
-		keyGen:       keyGen,
-		coordinator:  coordinator,
-		feeHandler:   feeHandler,
+		tx:            tx,
+		marshalizer:   marshalizer,
+		hasher:        hasher,
+		singleSigner:  signer,
+		addrConv:      addrConv,
+		keyGen:        keyGen,
+		coordinator:   coordinator,
+		feeHandler:    feeHandler,
+		chainID:       chainID,
+		limitsChecker: limitsChecker,
 	}
 
 	err = inTx.processFields(txBuff)
@@ -146,8 +159,13 @@ func (inTx *InterceptedTransaction) processFields(txBuff []byte) error {
 	return nil
 }
 
-// integrity checks for not nil fields and negative value
+// integrity checks for oversized data, not nil fields and negative value
 func (inTx *InterceptedTransaction) integrity() error {
+	err := inTx.limitsChecker.CheckTxDataSize(len(inTx.tx.Data))
+	if err != nil {
+		return err
+	}
+
 	if inTx.tx.Signature == nil {
 		return process.ErrNilSignature
 	}
@@ -163,6 +181,12 @@ func (inTx *InterceptedTransaction) integrity() error {
 	if inTx.tx.Value.Cmp(big.NewInt(0)) < 0 {
 		return process.ErrNegativeValue
 	}
+	if !bytes.Equal(inTx.tx.ChainID, inTx.chainID) {
+		return process.ErrInvalidChainID
+	}
+	if inTx.tx.Version < core.MinTransactionVersion {
+		return process.ErrInvalidTransactionVersion
+	}
 
 	return inTx.feeHandler.CheckValidityTxValues(inTx.tx)
 }
@@ -225,14 +249,10 @@ func (inTx *InterceptedTransaction) SenderAddress() state.AddressContainer {
 }
 
 // TotalValue returns the maximum cost of transaction
-// totalValue = txValue + gasPrice*gasLimit
+// totalValue = txValue + the fee of the whole gas limit
 func (inTx *InterceptedTransaction) TotalValue() *big.Int {
 	result := big.NewInt(0).Set(inTx.tx.Value)
-	gasPrice := big.NewInt(int64(inTx.tx.GasPrice))
-	gasLimit := big.NewInt(int64(inTx.tx.GasLimit))
-	mulTxCost := big.NewInt(0)
-	mulTxCost = mulTxCost.Mul(gasPrice, gasLimit)
-	result = result.Add(result, mulTxCost)
+	result = result.Add(result, inTx.feeHandler.ComputeTxFee(inTx.tx))
 
 	return result
 }
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -24,6 +25,7 @@ var recvShard = uint32(3)
 var senderAddress = []byte("sender")
 var recvAddress = []byte("receiver")
 var sigOk = []byte("signature")
+var chainID = []byte("chain ID")
 
 func createDummySigner() crypto.SingleSigner {
 	return &mock.SignerMock{
@@ -86,6 +88,8 @@ func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandle
 		},
 		shardCoordinator,
 		txFeeHandler,
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 }
 
@@ -103,6 +107,8 @@ func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -121,6 +127,8 @@ func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -139,6 +147,8 @@ func TestNewInterceptedTransaction_NilHasherShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -157,6 +167,8 @@ func TestNewInterceptedTransaction_NilKeyGenShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -175,6 +187,8 @@ func TestNewInterceptedTransaction_NilSignerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -193,6 +207,8 @@ func TestNewInterceptedTransaction_NilAddressConverterShouldErr(t *testing.T) {
 		nil,
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -211,6 +227,8 @@ func TestNewInterceptedTransaction_NilCoordinatorShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		nil,
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -229,12 +247,54 @@ func TestNewInterceptedTransaction_NilFeeHandlerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		nil,
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, txi)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewInterceptedTransaction_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txi, err := transaction.NewInterceptedTransaction(
+		make([]byte, 0),
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.FeeHandlerStub{},
+		nil,
+		&mock.InterceptedDataLimitsCheckerStub{},
+	)
+
+	assert.Nil(t, txi)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestNewInterceptedTransaction_NilLimitsCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txi, err := transaction.NewInterceptedTransaction(
+		make([]byte, 0),
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.FeeHandlerStub{},
+		chainID,
+		nil,
+	)
+
+	assert.Nil(t, txi)
+	assert.Equal(t, process.ErrNilInterceptedDataLimitsChecker, err)
+}
+
 func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -253,6 +313,8 @@ func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -275,6 +337,8 @@ func TestNewInterceptedTransaction_AddrConvFailsShouldErr(t *testing.T) {
 		},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -292,6 +356,8 @@ func TestNewInterceptedTransaction_ShouldWork(t *testing.T) {
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 
@@ -315,6 +381,8 @@ func TestInterceptedTransaction_CheckValidityNilSignatureShouldErr(t *testing.T)
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: nil,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -335,6 +403,8 @@ func TestInterceptedTransaction_CheckValidityNilRecvAddressShouldErr(t *testing.
 		GasPrice:  4,
 		RcvAddr:   nil,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -355,6 +425,8 @@ func TestInterceptedTransaction_CheckValidityNilSenderAddressShouldErr(t *testin
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   nil,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -375,6 +447,8 @@ func TestInterceptedTransaction_CheckValidityNilValueShouldErr(t *testing.T) {
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -395,6 +469,8 @@ func TestInterceptedTransaction_CheckValidityNilNegativeValueShouldErr(t *testin
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -404,6 +480,124 @@ func TestInterceptedTransaction_CheckValidityNilNegativeValueShouldErr(t *testin
 	assert.Equal(t, process.ErrNegativeValue, err)
 }
 
+func TestInterceptedTransaction_CheckValidityWrongChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		ChainID:   []byte("another chain ID"),
+		Version:   core.MinTransactionVersion,
+		Signature: sigOk,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMissingChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Version:   core.MinTransactionVersion,
+		Signature: sigOk,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestInterceptedTransaction_CheckValidityLowerVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion - 1,
+		Signature: sigOk,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidTransactionVersion, err)
+}
+
+func TestInterceptedTransaction_CheckValidityDataTooLargeShouldErrBeforeVerifyingTheSignature(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "oversized data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
+		Signature: sigOk,
+	}
+	marshalizer := &mock.MarshalizerMock{}
+	txBuff, _ := marshalizer.Marshal(tx)
+	checkedDataSize := 0
+	verifyCalled := false
+	txi, _ := transaction.NewInterceptedTransaction(
+		txBuff,
+		marshalizer,
+		mock.HasherMock{},
+		createKeyGenMock(),
+		&mock.SignerMock{
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				verifyCalled = true
+				return nil
+			},
+		},
+		&mock.AddressConverterStub{
+			CreateAddressFromPublicKeyBytesCalled: func(pubKey []byte) (container state.AddressContainer, e error) {
+				return mock.NewAddressMock(pubKey), nil
+			},
+		},
+		mock.NewOneShardCoordinatorMock(),
+		createFreeTxFeeHandler(),
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{
+			CheckTxDataSizeCalled: func(dataSize int) error {
+				checkedDataSize = dataSize
+				return process.ErrTxDataTooLarge
+			},
+		},
+	)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrTxDataTooLarge, err)
+	assert.Equal(t, len(tx.Data), checkedDataSize)
+	assert.False(t, verifyCalled)
+}
+
 func TestNewInterceptedTransaction_InsufficientFeeShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -417,6 +611,8 @@ func TestNewInterceptedTransaction_InsufficientFeeShouldErr(t *testing.T) {
 		GasPrice:  gasPrice,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 	errExpected := errors.New("insufficient fee")
@@ -443,6 +639,8 @@ func TestInterceptedTransaction_CheckValidityInvalidSenderShouldErr(t *testing.T
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   []byte(""),
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -463,6 +661,8 @@ func TestInterceptedTransaction_CheckValidityVerifyFailsShouldErr(t *testing.T)
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: []byte("wrong sig"),
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -483,6 +683,8 @@ func TestInterceptedTransaction_CheckValidityOkValsShouldWork(t *testing.T) {
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -503,6 +705,8 @@ func TestInterceptedTransaction_OkValsGettersShouldWork(t *testing.T) {
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 
@@ -530,6 +734,8 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		GasPrice:  4,
 		RcvAddr:   recvAddressDeploy,
 		SndAddr:   senderAddressInShard1,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 	marshalizer := &mock.MarshalizerMock{}
@@ -561,6 +767,8 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		},
 		shardCoordinator,
 		createFreeTxFeeHandler(),
+		chainID,
+		&mock.InterceptedDataLimitsCheckerStub{},
 	)
 
 	assert.Nil(t, err)
@@ -581,6 +789,8 @@ func TestInterceptedTransaction_GetNonce(t *testing.T) {
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 
@@ -601,6 +811,8 @@ func TestInterceptedTransaction_SenderShardId(t *testing.T) {
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 
@@ -629,6 +841,8 @@ func TestInterceptedTransaction_GetTotalValue(t *testing.T) {
 		GasPrice:  gasLimit,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 
@@ -649,6 +863,8 @@ func TestInterceptedTransaction_GetSenderAddress(t *testing.T) {
 		GasPrice:  4,
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
+		ChainID:   chainID,
+		Version:   core.MinTransactionVersion,
 		Signature: sigOk,
 	}
 
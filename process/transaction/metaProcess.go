@@ -1,6 +1,8 @@
 package transaction
 
 import (
+	"math/big"
+
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -68,7 +70,8 @@ func (txProc *metaTxProcessor) ProcessTransaction(tx *transaction.Transaction, r
 		return err
 	}
 
-	err = txProc.checkTxValues(tx, acntSnd)
+	maxFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(tx.GasPrice), big.NewInt(0).SetUint64(tx.GasLimit))
+	err = txProc.checkTxValues(tx, acntSnd, maxFee)
 	if err != nil {
 		return err
 	}
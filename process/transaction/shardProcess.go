@@ -1,8 +1,12 @@
 package transaction
 
 import (
+	"bytes"
+	"encoding/hex"
 	"math/big"
+	"strings"
 
+	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -11,6 +15,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
+var log = logger.GetOrCreate("process/transaction")
+
 // txProcessor implements TransactionProcessor interface and can modify account states according to a transaction
 type txProcessor struct {
 	*baseTxProcessor
@@ -21,6 +27,8 @@ type txProcessor struct {
 	txTypeHandler    process.TxTypeHandler
 	shardCoordinator sharding.Coordinator
 	economicsFee     process.FeeHandler
+	builtInFunctions process.BuiltInFunctionContainer
+	chainID          []byte
 }
 
 // NewTxProcessor creates a new txProcessor engine
@@ -34,6 +42,8 @@ func NewTxProcessor(
 	txFeeHandler process.TransactionFeeHandler,
 	txTypeHandler process.TxTypeHandler,
 	economicsFee process.FeeHandler,
+	builtInFunctions process.BuiltInFunctionContainer,
+	chainID []byte,
 ) (*txProcessor, error) {
 
 	if accounts == nil || accounts.IsInterfaceNil() {
@@ -63,6 +73,12 @@ func NewTxProcessor(
 	if economicsFee == nil || economicsFee.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if builtInFunctions == nil || builtInFunctions.IsInterfaceNil() {
+		return nil, process.ErrNilBuiltInFunctionsContainer
+	}
+	if len(chainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
 
 	baseTxProcess := &baseTxProcessor{
 		accounts:         accounts,
@@ -71,13 +87,15 @@ func NewTxProcessor(
 	}
 
 	return &txProcessor{
-		baseTxProcessor: baseTxProcess,
-		hasher:          hasher,
-		marshalizer:     marshalizer,
-		scProcessor:     scProcessor,
-		txFeeHandler:    txFeeHandler,
-		txTypeHandler:   txTypeHandler,
-		economicsFee:    economicsFee,
+		baseTxProcessor:  baseTxProcess,
+		hasher:           hasher,
+		marshalizer:      marshalizer,
+		scProcessor:      scProcessor,
+		txFeeHandler:     txFeeHandler,
+		txTypeHandler:    txTypeHandler,
+		economicsFee:     economicsFee,
+		builtInFunctions: builtInFunctions,
+		chainID:          chainID,
 	}, nil
 }
 
@@ -86,6 +104,10 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction, round
 	if tx == nil || tx.IsInterfaceNil() {
 		return process.ErrNilTransaction
 	}
+	// the interceptors check the chain ID too, but a block can hold transactions which were not intercepted
+	if !bytes.Equal(tx.ChainID, txProc.chainID) {
+		return process.ErrTransactionChainIDMismatch
+	}
 
 	adrSrc, adrDst, err := txProc.getAddresses(tx)
 	if err != nil {
@@ -97,11 +119,25 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction, round
 		return err
 	}
 
-	err = txProc.checkTxValues(tx, acntSnd)
+	err = txProc.checkTxValues(tx, acntSnd, txProc.economicsFee.ComputeTxFee(tx))
+	if err == process.ErrInsufficientFunds {
+		return txProc.processFailedTransaction(tx, acntSnd, txProc.economicsFee.ComputeFee(tx))
+	}
 	if err != nil {
 		return err
 	}
 
+	builtInFunction, arguments, err := txProc.getBuiltInFunction(tx)
+	if err == process.ErrInvalidBuiltInFunctionArguments && acntSnd != nil && !acntSnd.IsInterfaceNil() {
+		return txProc.processFailedTransaction(tx, acntSnd, txProc.economicsFee.ComputeTxFee(tx))
+	}
+	if err != nil {
+		return err
+	}
+	if builtInFunction != nil {
+		return txProc.processBuiltInFunction(tx, adrSrc, adrDst, builtInFunction, arguments, roundIndex)
+	}
+
 	txType, err := txProc.txTypeHandler.ComputeTransactionType(tx)
 	if err != nil {
 		return err
@@ -119,7 +155,222 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction, round
 	return process.ErrWrongTransaction
 }
 
+// processFailedTransaction consumes the provided fee of a transaction which could not be executed and increases the
+// sender's nonce. If the balance does not cover the whole fee, all of it is consumed. The returned
+// ErrFailedTransaction signals the caller to record the transaction in the invalid miniblock
+func (txProc *txProcessor) processFailedTransaction(
+	tx *transaction.Transaction,
+	acntSnd state.AccountHandler,
+	txFee *big.Int,
+) error {
+	stAcc, ok := acntSnd.(*state.Account)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	if stAcc.Balance.Cmp(txFee) < 0 {
+		txFee = big.NewInt(0).Set(stAcc.Balance)
+	}
+
+	operation := big.NewInt(0)
+	err := stAcc.SetBalanceWithJournal(operation.Sub(stAcc.Balance, txFee))
+	if err != nil {
+		return err
+	}
+
+	err = txProc.increaseNonce(stAcc)
+	if err != nil {
+		return err
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(txFee)
+
+	return process.ErrFailedTransaction
+}
+
+// getBuiltInFunction returns the built-in function called by the transaction, together with its decoded arguments.
+// Data format: FunctionName@hexArg1@hexArg2... A nil function is returned if the transaction is not a built-in call
+func (txProc *txProcessor) getBuiltInFunction(tx *transaction.Transaction) (process.BuiltInFunction, [][]byte, error) {
+	if len(tx.Data) == 0 {
+		return nil, nil, nil
+	}
+
+	tokens := strings.Split(tx.Data, "@")
+	builtInFunction, err := txProc.builtInFunctions.Get(tokens[0])
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	arguments := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		argument, err := hex.DecodeString(token)
+		if err != nil {
+			return nil, nil, process.ErrInvalidBuiltInFunctionArguments
+		}
+
+		arguments = append(arguments, argument)
+	}
+
+	return builtInFunction, arguments, nil
+}
+
+func (txProc *txProcessor) processBuiltInFunction(
+	tx *transaction.Transaction,
+	adrSrc, adrDst state.AddressContainer,
+	builtInFunction process.BuiltInFunction,
+	arguments [][]byte,
+	roundIndex uint64,
+) error {
+	acntSrc, acntDst, err := txProc.getAccounts(adrSrc, adrDst)
+	if err != nil {
+		return err
+	}
+	// built-in functions work on the state of both accounts so they are accepted cross shard only if they
+	// deliver their effects to the receiver shard by themselves
+	isCrossShard := acntSrc == nil || acntDst == nil
+	if acntSrc == nil {
+		// the built-in function was already executed in the sender shard, only the value is credited here
+		return txProc.moveBalances(nil, acntDst, tx.Value)
+	}
+
+	snapshot := txProc.accounts.JournalLen()
+	err = txProc.executeBuiltInFunction(tx, acntSrc, acntDst, isCrossShard, builtInFunction, arguments, roundIndex)
+	if err != nil {
+		return txProc.processFailedBuiltInFunction(tx, adrSrc, snapshot, err)
+	}
+
+	return nil
+}
+
+func (txProc *txProcessor) executeBuiltInFunction(
+	tx *transaction.Transaction,
+	acntSrc, acntDst *state.Account,
+	isCrossShard bool,
+	builtInFunction process.BuiltInFunction,
+	arguments [][]byte,
+	roundIndex uint64,
+) error {
+	if isCrossShard && !isCrossShardBuiltInFunction(builtInFunction) {
+		return process.ErrBuiltInFunctionCalledCrossShard
+	}
+
+	contractCallData := getContractCallData(builtInFunction, arguments)
+	if len(contractCallData) > 0 {
+		return txProc.processBuiltInFunctionWithContractCall(tx, acntSrc, acntDst, builtInFunction, arguments, contractCallData, roundIndex)
+	}
+
+	txFee, err := txProc.processTxFeeWithExtraGas(tx, acntSrc, getBuiltInFunctionGasCost(builtInFunction))
+	if err != nil {
+		return err
+	}
+
+	err = txProc.moveBalances(acntSrc, acntDst, tx.Value)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.increaseNonce(acntSrc)
+	if err != nil {
+		return err
+	}
+
+	err = builtInFunction.ProcessBuiltInFunction(tx, acntSrc, acntDst, arguments)
+	if err != nil {
+		return err
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(txFee)
+
+	return nil
+}
+
+// processFailedBuiltInFunction reverts the changes made by a failed built-in function call and consumes its whole fee,
+// so that the failed calls are included in the blocks and paid for as any failed execution
+func (txProc *txProcessor) processFailedBuiltInFunction(
+	tx *transaction.Transaction,
+	adrSrc state.AddressContainer,
+	snapshot int,
+	builtInErr error,
+) error {
+	log.Debug("built-in function call failed", "error", builtInErr.Error())
+
+	err := txProc.accounts.RevertToSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	acntSnd, err := txProc.getAccountFromAddress(adrSrc)
+	if err != nil {
+		return err
+	}
+
+	return txProc.processFailedTransaction(tx, acntSnd, txProc.economicsFee.ComputeTxFee(tx))
+}
+
+// processBuiltInFunctionWithContractCall executes the built-in function and afterwards calls the receiver contract
+// with the same transaction, holding the contract call as data. The fee, value and nonce are handled by the smart
+// contract processor as for any other contract call
+func (txProc *txProcessor) processBuiltInFunctionWithContractCall(
+	tx *transaction.Transaction,
+	acntSrc, acntDst *state.Account,
+	builtInFunction process.BuiltInFunction,
+	arguments [][]byte,
+	contractCallData string,
+	roundIndex uint64,
+) error {
+	if len(acntDst.GetCode()) == 0 {
+		return process.ErrNilSCDestAccount
+	}
+
+	err := builtInFunction.ProcessBuiltInFunction(tx, acntSrc, acntDst, arguments)
+	if err != nil {
+		return err
+	}
+
+	contractCallTx := *tx
+	contractCallTx.Data = contractCallData
+
+	return txProc.scProcessor.ExecuteSmartContractTransaction(&contractCallTx, acntSrc, acntDst, roundIndex)
+}
+
+func isCrossShardBuiltInFunction(builtInFunction process.BuiltInFunction) bool {
+	crossShardFunction, ok := builtInFunction.(process.CrossShardBuiltInFunction)
+	if !ok {
+		return false
+	}
+
+	return crossShardFunction.IsCrossShardEnabled()
+}
+
+func getContractCallData(builtInFunction process.BuiltInFunction, arguments [][]byte) string {
+	contractCallFunction, ok := builtInFunction.(process.ContractCallBuiltInFunction)
+	if !ok {
+		return ""
+	}
+
+	return contractCallFunction.ContractCallData(arguments)
+}
+
+func getBuiltInFunctionGasCost(builtInFunction process.BuiltInFunction) uint64 {
+	functionWithGasCost, ok := builtInFunction.(process.BuiltInFunctionWithGasCost)
+	if !ok {
+		return 0
+	}
+
+	return functionWithGasCost.GasCost()
+}
+
 func (txProc *txProcessor) processTxFee(tx *transaction.Transaction, acntSnd *state.Account) (*big.Int, error) {
+	return txProc.processTxFeeWithExtraGas(tx, acntSnd, 0)
+}
+
+// processTxFeeWithExtraGas charges the sender the move balance fee together with the fee of the extra gas consumed
+// by the transaction, as the gas cost of a built-in function
+func (txProc *txProcessor) processTxFeeWithExtraGas(
+	tx *transaction.Transaction,
+	acntSnd *state.Account,
+	extraGas uint64,
+) (*big.Int, error) {
 	if acntSnd == nil {
 		return nil, nil
 	}
@@ -130,6 +381,13 @@ func (txProc *txProcessor) processTxFee(tx *transaction.Transaction, acntSnd *st
 	}
 
 	cost := txProc.economicsFee.ComputeFee(tx)
+	if extraGas > 0 {
+		if txProc.economicsFee.ComputeGasLimit(tx)+extraGas > tx.GasLimit {
+			return nil, process.ErrInsufficientGasLimitInTx
+		}
+
+		cost = big.NewInt(0).Add(cost, txProc.economicsFee.ComputeFeeForProcessing(tx, extraGas))
+	}
 	if acntSnd.Balance.Cmp(cost) < 0 {
 		return nil, process.ErrInsufficientFunds
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,6 +33,9 @@ func feeHandlerMock() *mock.FeeHandlerStub {
 		ComputeFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
 			return big.NewInt(0)
 		},
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return 0
+		},
 	}
 }
 
@@ -66,6 +70,8 @@ func createTxProcessor() txproc.TxProcessor {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	return txProc
@@ -86,6 +92,8 @@ func TestNewTxProcessor_NilAccountsShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -105,6 +113,8 @@ func TestNewTxProcessor_NilHasherShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	assert.Equal(t, process.ErrNilHasher, err)
@@ -124,6 +134,8 @@ func TestNewTxProcessor_NilAddressConverterMockShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	assert.Equal(t, process.ErrNilAddressConverter, err)
@@ -143,6 +155,8 @@ func TestNewTxProcessor_NilMarshalizerMockShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	assert.Equal(t, process.ErrNilMarshalizer, err)
@@ -162,6 +176,8 @@ func TestNewTxProcessor_NilShardCoordinatorMockShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -181,6 +197,8 @@ func TestNewTxProcessor_NilSCProcessorShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractProcessor, err)
@@ -200,12 +218,56 @@ func TestNewTxProcessor_NilTxFeeHandlerShouldErr(t *testing.T) {
 		nil,
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	assert.Equal(t, process.ErrNilUnsignedTxHandler, err)
 	assert.Nil(t, txProc)
 }
 
+func TestNewTxProcessor_NilBuiltInFunctionsContainerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProc, err := txproc.NewTxProcessor(
+		&mock.AccountsStub{},
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		nil,
+		chainID,
+	)
+
+	assert.Equal(t, process.ErrNilBuiltInFunctionsContainer, err)
+	assert.Nil(t, txProc)
+}
+
+func TestNewTxProcessor_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProc, err := txproc.NewTxProcessor(
+		&mock.AccountsStub{},
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		nil,
+	)
+
+	assert.Equal(t, process.ErrInvalidChainID, err)
+	assert.Nil(t, txProc)
+}
+
 func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -219,6 +281,8 @@ func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	assert.Nil(t, err)
@@ -242,11 +306,13 @@ func TestTxProcessor_GetAddressErrAddressConvShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	addressConv.Fail = true
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 
 	_, _, err := execTx.GetAddresses(&tx)
 	assert.NotNil(t, err)
@@ -257,7 +323,7 @@ func TestTxProcessor_GetAddressOkValsShouldWork(t *testing.T) {
 
 	execTx := *createTxProcessor()
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.RcvAddr = []byte{65, 66, 67}
 	tx.SndAddr = []byte{32, 33, 34}
 
@@ -284,6 +350,8 @@ func TestTxProcessor_GetAccountsShouldErrNilAddressContainer(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	adr1 := mock.NewAddressMock([]byte{65})
@@ -311,6 +379,8 @@ func TestTxProcessor_GetAccountsMalfunctionAccountsShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	adr1 := mock.NewAddressMock([]byte{65})
@@ -355,6 +425,8 @@ func TestTxProcessor_GetAccountsOkValsSrcShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
@@ -408,6 +480,8 @@ func TestTxProcessor_GetAccountsOkValsDsthouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
@@ -446,6 +520,8 @@ func TestTxProcessor_GetAccountsOkValsShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	a1, a2, err := execTx.GetAccounts(adr1, adr2)
@@ -475,6 +551,8 @@ func TestTxProcessor_GetSameAccountShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	a1, a2, err := execTx.GetAccounts(adr1, adr1)
@@ -544,7 +622,7 @@ func TestTxProcessor_CheckTxValuesOkValsShouldErr(t *testing.T) {
 	assert.Nil(t, err)
 }
 
-//------- moveBalances
+// ------- moveBalances
 func TestTxProcessor_MoveBalancesShouldNotFailWhenAcntSrcIsNotInNodeShard(t *testing.T) {
 	t.Parallel()
 
@@ -703,6 +781,15 @@ func TestTxProcessor_ProcessTransactionNilTxShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilTransaction, err)
 }
 
+func TestTxProcessor_ProcessTransactionOtherChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	execTx := *createTxProcessor()
+
+	err := execTx.ProcessTransaction(&transaction.Transaction{ChainID: []byte("other chain ID")}, 4)
+	assert.Equal(t, process.ErrTransactionChainIDMismatch, err)
+}
+
 func TestTxProcessor_ProcessTransactionErrAddressConvShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -718,11 +805,13 @@ func TestTxProcessor_ProcessTransactionErrAddressConvShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	addressConv.Fail = true
 
-	err := execTx.ProcessTransaction(&transaction.Transaction{}, 4)
+	err := execTx.ProcessTransaction(&transaction.Transaction{ChainID: chainID}, 4)
 	assert.NotNil(t, err)
 }
 
@@ -741,9 +830,11 @@ func TestTxProcessor_ProcessTransactionMalfunctionAccountsShouldErr(t *testing.T
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 1
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -757,7 +848,7 @@ func TestTxProcessor_ProcessCheckNotPassShouldErr(t *testing.T) {
 	t.Parallel()
 
 	//these values will trigger ErrHigherNonceInTransaction
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 1
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -780,6 +871,8 @@ func TestTxProcessor_ProcessCheckNotPassShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -803,7 +896,7 @@ func TestTxProcessor_ProcessCheckShouldPassWhenAdrSrcIsNotInNodeShard(t *testing
 
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 1
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -834,6 +927,8 @@ func TestTxProcessor_ProcessCheckShouldPassWhenAdrSrcIsNotInNodeShard(t *testing
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -857,7 +952,7 @@ func TestTxProcessor_ProcessMoveBalancesShouldWork(t *testing.T) {
 		},
 	}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -880,6 +975,8 @@ func TestTxProcessor_ProcessMoveBalancesShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -905,7 +1002,7 @@ func TestTxProcessor_ProcessMoveBalancesShouldPassWhenAdrSrcIsNotInNodeShard(t *
 
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -936,6 +1033,8 @@ func TestTxProcessor_ProcessMoveBalancesShouldPassWhenAdrSrcIsNotInNodeShard(t *
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -961,7 +1060,7 @@ func TestTxProcessor_ProcessIncreaseNonceShouldPassWhenAdrSrcIsNotInNodeShard(t
 
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -992,6 +1091,8 @@ func TestTxProcessor_ProcessIncreaseNonceShouldPassWhenAdrSrcIsNotInNodeShard(t
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1015,7 +1116,7 @@ func TestTxProcessor_ProcessOkValsShouldWork(t *testing.T) {
 		},
 	}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 4
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -1042,6 +1143,8 @@ func TestTxProcessor_ProcessOkValsShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1066,7 +1169,7 @@ func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 		},
 	}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 4
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -1105,6 +1208,8 @@ func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandler,
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1116,6 +1221,107 @@ func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 	assert.Equal(t, 4, saveAccountCalled)
 }
 
+func createTxProcessorForFailedTransaction(
+	acntSrc, acntDst *state.Account,
+	txFee *big.Int,
+	consumedFee *big.Int,
+) process.TransactionProcessor {
+	accounts := createAccountStub(acntSrc.AddressContainer().Bytes(), acntDst.AddressContainer().Bytes(), acntSrc, acntDst)
+	execTx, _ := txproc.NewTxProcessor(
+		accounts,
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{
+			ProcessTransactionFeeCalled: func(cost *big.Int) {
+				consumedFee.Add(consumedFee, cost)
+			},
+		},
+		&mock.TxTypeHandlerMock{},
+		&mock.FeeHandlerStub{
+			ComputeFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+				return txFee
+			},
+		},
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
+	)
+
+	return execTx
+}
+
+func TestTxProcessor_ProcessTransactionInsufficientFundsShouldConsumeFeeAndFail(t *testing.T) {
+	t.Parallel()
+
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+	tx := &transaction.Transaction{
+		ChainID:  chainID,
+		Nonce:    4,
+		SndAddr:  []byte("SRC"),
+		RcvAddr:  []byte("DST"),
+		Value:    big.NewInt(100),
+		GasPrice: 2,
+		GasLimit: 10,
+	}
+	acntSrc, _ := state.NewAccount(mock.NewAddressMock(tx.SndAddr), tracker)
+	acntSrc.Nonce = 4
+	acntSrc.Balance = big.NewInt(50)
+	acntDst, _ := state.NewAccount(mock.NewAddressMock(tx.RcvAddr), tracker)
+	acntDst.Balance = big.NewInt(10)
+
+	consumedFee := big.NewInt(0)
+	execTx := createTxProcessorForFailedTransaction(acntSrc, acntDst, big.NewInt(16), consumedFee)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, uint64(5), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(34), acntSrc.Balance)
+	assert.Equal(t, big.NewInt(10), acntDst.Balance)
+	assert.Equal(t, big.NewInt(16), consumedFee)
+}
+
+func TestTxProcessor_ProcessTransactionInsufficientFundsForFeeShouldConsumeWholeBalance(t *testing.T) {
+	t.Parallel()
+
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+	tx := &transaction.Transaction{
+		ChainID:  chainID,
+		Nonce:    4,
+		SndAddr:  []byte("SRC"),
+		RcvAddr:  []byte("DST"),
+		Value:    big.NewInt(0),
+		GasPrice: 2,
+		GasLimit: 10,
+	}
+	acntSrc, _ := state.NewAccount(mock.NewAddressMock(tx.SndAddr), tracker)
+	acntSrc.Nonce = 4
+	acntSrc.Balance = big.NewInt(7)
+	acntDst, _ := state.NewAccount(mock.NewAddressMock(tx.RcvAddr), tracker)
+
+	consumedFee := big.NewInt(0)
+	execTx := createTxProcessorForFailedTransaction(acntSrc, acntDst, big.NewInt(16), consumedFee)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, uint64(5), acntSrc.Nonce)
+	assert.Equal(t, uint64(0), acntSrc.Balance.Uint64())
+	assert.Equal(t, big.NewInt(7), consumedFee)
+}
+
 func TestTxProcessor_ProcessTransactionScTxShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1133,7 +1339,7 @@ func TestTxProcessor_ProcessTransactionScTxShouldWork(t *testing.T) {
 
 	addrConverter := &mock.AddressConverterMock{}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = generateRandomByteSlice(addrConverter.AddressLen())
@@ -1173,6 +1379,8 @@ func TestTxProcessor_ProcessTransactionScTxShouldWork(t *testing.T) {
 			},
 		},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1199,7 +1407,7 @@ func TestTxProcessor_ProcessTransactionScTxShouldReturnErrWhenExecutionFails(t *
 
 	addrConverter := &mock.AddressConverterMock{}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = generateRandomByteSlice(addrConverter.AddressLen())
@@ -1234,6 +1442,8 @@ func TestTxProcessor_ProcessTransactionScTxShouldReturnErrWhenExecutionFails(t *
 			return process.SCInvoking, nil
 		}},
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1262,7 +1472,7 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 
 	addrConverter := &mock.AddressConverterMock{}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = generateRandomByteSlice(addrConverter.AddressLen())
@@ -1307,6 +1517,8 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 		&mock.UnsignedTxHandlerMock{},
 		computeType,
 		feeHandlerMock(),
+		&mock.BuiltInFunctionContainerStub{},
+		chainID,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1315,3 +1527,352 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 	assert.Equal(t, 3, journalizeCalled)
 	assert.Equal(t, 3, saveAccountCalled)
 }
+
+func createTxProcessorForBuiltInFunction(
+	tx *transaction.Transaction,
+	shardCoordinator sharding.Coordinator,
+	builtInFunction process.BuiltInFunction,
+) (process.TransactionProcessor, *state.Account, *state.Account) {
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+
+	acntSrc, _ := state.NewAccount(mock.NewAddressMock(tx.SndAddr), tracker)
+	acntSrc.Balance = big.NewInt(100)
+	acntDst, _ := state.NewAccount(mock.NewAddressMock(tx.RcvAddr), tracker)
+
+	accounts := createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	accounts.RevertToSnapshotCalled = func(snapshot int) error {
+		acntSrc.Nonce = 0
+		acntSrc.Balance = big.NewInt(100)
+		acntDst.Balance = big.NewInt(0)
+		return nil
+	}
+	container := &mock.BuiltInFunctionContainerStub{
+		GetCalled: func(key string) (process.BuiltInFunction, error) {
+			if key == "builtInFunc" {
+				return builtInFunction, nil
+			}
+			return nil, process.ErrInvalidContainerKey
+		},
+	}
+
+	execTx, _ := txproc.NewTxProcessor(
+		accounts,
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		container,
+		chainID,
+	)
+
+	return execTx, acntSrc, acntDst
+}
+
+func TestTxProcessor_ProcessTransactionBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		ChainID: chainID,
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(10),
+		Data:    "builtInFunc@aa@bb",
+	}
+
+	var receivedArguments [][]byte
+	builtInFunction := &mock.BuiltInFunctionStub{
+		ProcessBuiltInFunctionCalled: func(tx *transaction.Transaction, acntSnd, acntDst *state.Account, arguments [][]byte) error {
+			receivedArguments = arguments
+			return nil
+		},
+	}
+	execTx, acntSrc, acntDst := createTxProcessorForBuiltInFunction(tx, mock.NewOneShardCoordinatorMock(), builtInFunction)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{{0xaa}, {0xbb}}, receivedArguments)
+	assert.Equal(t, uint64(1), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(90), acntSrc.Balance)
+	assert.Equal(t, big.NewInt(10), acntDst.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionBuiltInFunctionShouldConsumeItsGasCost(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		ChainID:  chainID,
+		SndAddr:  []byte("SRC"),
+		RcvAddr:  []byte("DST"),
+		Value:    big.NewInt(10),
+		GasPrice: 2,
+		GasLimit: 30,
+		Data:     "builtInFunc@aa",
+	}
+
+	builtInFunction := &mock.BuiltInFunctionStub{
+		GasCostCalled: func() uint64 {
+			return 20
+		},
+	}
+	execTx, acntSrc, _ := createTxProcessorForBuiltInFunction(tx, mock.NewOneShardCoordinatorMock(), builtInFunction)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), acntSrc.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionBuiltInFunctionGasLimitBelowGasCostShouldConsumeFeeAndFail(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		ChainID:  chainID,
+		SndAddr:  []byte("SRC"),
+		RcvAddr:  []byte("DST"),
+		Value:    big.NewInt(10),
+		GasPrice: 2,
+		GasLimit: 10,
+		Data:     "builtInFunc@aa",
+	}
+
+	builtInFunction := &mock.BuiltInFunctionStub{
+		GasCostCalled: func() uint64 {
+			return 20
+		},
+	}
+	execTx, acntSrc, _ := createTxProcessorForBuiltInFunction(tx, mock.NewOneShardCoordinatorMock(), builtInFunction)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, uint64(1), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(80), acntSrc.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionBuiltInFunctionErrorShouldRevertAndConsumeFee(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		ChainID:  chainID,
+		SndAddr:  []byte("SRC"),
+		RcvAddr:  []byte("DST"),
+		Value:    big.NewInt(10),
+		GasPrice: 2,
+		GasLimit: 10,
+		Data:     "builtInFunc@aa",
+	}
+
+	builtInFunction := &mock.BuiltInFunctionStub{
+		ProcessBuiltInFunctionCalled: func(tx *transaction.Transaction, acntSnd, acntDst *state.Account, arguments [][]byte) error {
+			return process.ErrNotContractOwner
+		},
+	}
+	execTx, acntSrc, acntDst := createTxProcessorForBuiltInFunction(tx, mock.NewOneShardCoordinatorMock(), builtInFunction)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, uint64(1), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(80), acntSrc.Balance)
+	assert.Equal(t, big.NewInt(0), acntDst.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionBuiltInFunctionInvalidArgumentsShouldConsumeFeeAndFail(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		ChainID: chainID,
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(0),
+		Data:    "builtInFunc@not hex",
+	}
+	execTx, acntSrc, _ := createTxProcessorForBuiltInFunction(tx, mock.NewOneShardCoordinatorMock(), &mock.BuiltInFunctionStub{})
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, uint64(1), acntSrc.Nonce)
+}
+
+func TestTxProcessor_ProcessTransactionBuiltInFunctionCrossShardShouldConsumeFeeAndFail(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		ChainID: chainID,
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(0),
+		Data:    "builtInFunc",
+	}
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), tx.RcvAddr) {
+			return 1
+		}
+
+		return 0
+	}
+	execTx, acntSrc, _ := createTxProcessorForBuiltInFunction(tx, shardCoordinator, &mock.BuiltInFunctionStub{})
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, uint64(1), acntSrc.Nonce)
+}
+
+func TestTxProcessor_ProcessTransactionCrossShardBuiltInFunctionInSenderShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		ChainID: chainID,
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(10),
+		Data:    "builtInFunc",
+	}
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), tx.RcvAddr) {
+			return 1
+		}
+
+		return 0
+	}
+	builtInFunctionCalled := false
+	builtInFunction := &mock.BuiltInFunctionStub{
+		ProcessBuiltInFunctionCalled: func(tx *transaction.Transaction, acntSnd, acntDst *state.Account, arguments [][]byte) error {
+			builtInFunctionCalled = true
+			assert.NotNil(t, acntSnd)
+			assert.Nil(t, acntDst)
+			return nil
+		},
+		IsCrossShardEnabledCalled: func() bool {
+			return true
+		},
+	}
+	execTx, acntSrc, _ := createTxProcessorForBuiltInFunction(tx, shardCoordinator, builtInFunction)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Nil(t, err)
+	assert.True(t, builtInFunctionCalled)
+	assert.Equal(t, uint64(1), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(90), acntSrc.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionCrossShardBuiltInFunctionInReceiverShardShouldOnlyCreditValue(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		ChainID: chainID,
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(10),
+		Data:    "builtInFunc",
+	}
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), tx.SndAddr) {
+			return 1
+		}
+
+		return 0
+	}
+	builtInFunctionCalled := false
+	builtInFunction := &mock.BuiltInFunctionStub{
+		ProcessBuiltInFunctionCalled: func(tx *transaction.Transaction, acntSnd, acntDst *state.Account, arguments [][]byte) error {
+			builtInFunctionCalled = true
+			return nil
+		},
+		IsCrossShardEnabledCalled: func() bool {
+			return true
+		},
+	}
+	execTx, _, acntDst := createTxProcessorForBuiltInFunction(tx, shardCoordinator, builtInFunction)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Nil(t, err)
+	assert.False(t, builtInFunctionCalled)
+	assert.Equal(t, big.NewInt(10), acntDst.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionBuiltInFunctionWithContractCallShouldCallContract(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		ChainID: chainID,
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(10),
+		Data:    "builtInFunc@aa",
+	}
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+	acntSrc, _ := state.NewAccount(mock.NewAddressMock(tx.SndAddr), tracker)
+	acntSrc.Balance = big.NewInt(100)
+	acntDst, _ := state.NewAccount(mock.NewAddressMock(tx.RcvAddr), tracker)
+	acntDst.SetCode([]byte("code"))
+
+	builtInFunctionCalled := false
+	builtInFunction := &mock.BuiltInFunctionStub{
+		ProcessBuiltInFunctionCalled: func(tx *transaction.Transaction, acntSnd, acntDst *state.Account, arguments [][]byte) error {
+			builtInFunctionCalled = true
+			return nil
+		},
+		ContractCallDataCalled: func(arguments [][]byte) string {
+			return "function@aa"
+		},
+	}
+	container := &mock.BuiltInFunctionContainerStub{
+		GetCalled: func(key string) (process.BuiltInFunction, error) {
+			return builtInFunction, nil
+		},
+	}
+	var contractCallTx *transaction.Transaction
+	scProcessor := &mock.SCProcessorMock{
+		ExecuteSmartContractTransactionCalled: func(tx *transaction.Transaction, acntSrc, acntDst state.AccountHandler, round uint64) error {
+			contractCallTx = tx
+			return nil
+		},
+	}
+
+	execTx, _ := txproc.NewTxProcessor(
+		createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst),
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		scProcessor,
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		container,
+		chainID,
+	)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Nil(t, err)
+	assert.True(t, builtInFunctionCalled)
+	assert.Equal(t, "function@aa", contractCallTx.Data)
+	assert.Equal(t, tx.Value, contractCallTx.Value)
+	assert.Equal(t, "builtInFunc@aa", tx.Data)
+}
@@ -0,0 +1,109 @@
+package transactionLog
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// txLogProcessor persists, for each transaction or smart contract result executed through the VM, the return code,
+// the logged events and the hashes of the generated smart contract results. The log is written as soon as the
+// execution ends, a later execution of the same transaction (e.g. after a reverted block) overwrites it
+type txLogProcessor struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewTxLogProcessor creates a new transaction logs processor
+func NewTxLogProcessor(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*txLogProcessor, error) {
+	if storer == nil || storer.IsInterfaceNil() {
+		return nil, process.ErrNilStorage
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, process.ErrNilHasher
+	}
+
+	return &txLogProcessor{
+		storer:      storer,
+		marshalizer: marshalizer,
+		hasher:      hasher,
+	}, nil
+}
+
+// SaveLog saves the outcome of the VM execution of the given transaction and the smart contract results it generated
+func (tlp *txLogProcessor) SaveLog(txHash []byte, vmOutput *vmcommon.VMOutput, scrs []data.TransactionHandler) error {
+	if len(txHash) == 0 {
+		return process.ErrNilTxHash
+	}
+	if vmOutput == nil {
+		return process.ErrNilVMOutput
+	}
+
+	txLog := &transaction.Log{
+		ReturnCode: vmOutput.ReturnCode.String(),
+		Events:     make([]*transaction.Event, 0, len(vmOutput.Logs)),
+		ScrHashes:  make([][]byte, 0, len(scrs)),
+	}
+
+	for _, logEntry := range vmOutput.Logs {
+		if logEntry == nil {
+			continue
+		}
+
+		txLog.Events = append(txLog.Events, createEvent(logEntry))
+	}
+
+	for _, scr := range scrs {
+		scrHash, err := core.CalculateHash(tlp.marshalizer, tlp.hasher, scr)
+		if err != nil {
+			return err
+		}
+
+		txLog.ScrHashes = append(txLog.ScrHashes, scrHash)
+	}
+
+	buff, err := tlp.marshalizer.Marshal(txLog)
+	if err != nil {
+		return err
+	}
+
+	return tlp.storer.Put(txHash, buff)
+}
+
+func createEvent(logEntry *vmcommon.LogEntry) *transaction.Event {
+	topics := make([][]byte, 0, len(logEntry.Topics))
+	for _, topic := range logEntry.Topics {
+		if topic == nil {
+			continue
+		}
+
+		topics = append(topics, topic.Bytes())
+	}
+
+	return &transaction.Event{
+		Address: logEntry.Address,
+		Topics:  topics,
+		Data:    logEntry.Data,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tlp *txLogProcessor) IsInterfaceNil() bool {
+	if tlp == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package transactionLog_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
+	"github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTxLogProcessor_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tlp, err := transactionLog.NewTxLogProcessor(nil, &mock.MarshalizerMock{}, &mock.HasherMock{})
+
+	assert.Nil(t, tlp)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewTxLogProcessor_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tlp, err := transactionLog.NewTxLogProcessor(&mock.StorerStub{}, nil, &mock.HasherMock{})
+
+	assert.Nil(t, tlp)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewTxLogProcessor_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tlp, err := transactionLog.NewTxLogProcessor(&mock.StorerStub{}, &mock.MarshalizerMock{}, nil)
+
+	assert.Nil(t, tlp)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestTxLogProcessor_SaveLogNilVMOutputShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tlp, _ := transactionLog.NewTxLogProcessor(&mock.StorerStub{}, &mock.MarshalizerMock{}, &mock.HasherMock{})
+
+	err := tlp.SaveLog([]byte("tx hash"), nil, nil)
+
+	assert.Equal(t, process.ErrNilVMOutput, err)
+}
+
+func TestTxLogProcessor_SaveLogShouldStoreReturnCodeEventsAndResults(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	txHash := []byte("tx hash")
+	stored := make(map[string][]byte)
+	storer := &mock.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			stored[string(key)] = data
+			return nil
+		},
+	}
+	tlp, _ := transactionLog.NewTxLogProcessor(storer, marshalizer, hasher)
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		Logs: []*vmcommon.LogEntry{
+			{
+				Address: []byte("contract"),
+				Topics:  []*big.Int{big.NewInt(7)},
+				Data:    []byte("data"),
+			},
+		},
+	}
+	scr := &smartContractResult.SmartContractResult{Nonce: 1, Value: big.NewInt(10), TxHash: txHash}
+
+	err := tlp.SaveLog(txHash, vmOutput, []data.TransactionHandler{scr})
+	assert.Nil(t, err)
+
+	txLog := &transaction.Log{}
+	err = marshalizer.Unmarshal(txLog, stored[string(txHash)])
+	assert.Nil(t, err)
+
+	scrHash, _ := core.CalculateHash(marshalizer, hasher, scr)
+	assert.Equal(t, vmcommon.Ok.String(), txLog.ReturnCode)
+	assert.Equal(t, [][]byte{scrHash}, txLog.ScrHashes)
+	assert.Equal(t, 1, len(txLog.Events))
+	assert.Equal(t, []byte("contract"), txLog.Events[0].Address)
+	assert.Equal(t, [][]byte{{7}}, txLog.Events[0].Topics)
+	assert.Equal(t, []byte("data"), txLog.Events[0].Data)
+}
@@ -0,0 +1,157 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// tracingBlockchainHook wraps the blockchain hook of a VM and, while tracing, records each call the VM makes to the
+// node together with its result. When not tracing it only forwards the calls
+type tracingBlockchainHook struct {
+	hook vmcommon.BlockchainHook
+
+	mutTrace  sync.Mutex
+	isTracing bool
+	steps     []*transaction.SimulationTraceStep
+}
+
+// NewTracingBlockchainHook creates a new tracing wrapper around the provided blockchain hook
+func NewTracingBlockchainHook(hook vmcommon.BlockchainHook) (*tracingBlockchainHook, error) {
+	if hook == nil {
+		return nil, process.ErrNilBlockChainHook
+	}
+
+	return &tracingBlockchainHook{
+		hook: hook,
+	}, nil
+}
+
+// StartTracing clears the previous trace and starts recording the calls
+func (tbh *tracingBlockchainHook) StartTracing() {
+	tbh.mutTrace.Lock()
+	tbh.isTracing = true
+	tbh.steps = make([]*transaction.SimulationTraceStep, 0)
+	tbh.mutTrace.Unlock()
+}
+
+// StopTracing stops recording the calls and returns the recorded trace
+func (tbh *tracingBlockchainHook) StopTracing() []*transaction.SimulationTraceStep {
+	tbh.mutTrace.Lock()
+	defer tbh.mutTrace.Unlock()
+
+	steps := tbh.steps
+	tbh.isTracing = false
+	tbh.steps = nil
+
+	return steps
+}
+
+func (tbh *tracingBlockchainHook) record(function string, result string, err error, arguments ...string) {
+	tbh.mutTrace.Lock()
+	defer tbh.mutTrace.Unlock()
+
+	if !tbh.isTracing {
+		return
+	}
+
+	step := &transaction.SimulationTraceStep{
+		Function:  function,
+		Arguments: arguments,
+		Result:    result,
+	}
+	if err != nil {
+		step.Result = ""
+		step.Error = err.Error()
+	}
+	tbh.steps = append(tbh.steps, step)
+}
+
+// AccountExists forwards the call to the wrapped hook
+func (tbh *tracingBlockchainHook) AccountExists(address []byte) (bool, error) {
+	exists, err := tbh.hook.AccountExists(address)
+	tbh.record("accountExists", strconv.FormatBool(exists), err, hex.EncodeToString(address))
+
+	return exists, err
+}
+
+// NewAddress forwards the call to the wrapped hook
+func (tbh *tracingBlockchainHook) NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error) {
+	address, err := tbh.hook.NewAddress(creatorAddress, creatorNonce, vmType)
+	tbh.record("newAddress", hex.EncodeToString(address), err,
+		hex.EncodeToString(creatorAddress),
+		strconv.FormatUint(creatorNonce, 10),
+		hex.EncodeToString(vmType),
+	)
+
+	return address, err
+}
+
+// GetBalance forwards the call to the wrapped hook
+func (tbh *tracingBlockchainHook) GetBalance(address []byte) (*big.Int, error) {
+	balance, err := tbh.hook.GetBalance(address)
+	tbh.record("getBalance", bigIntToString(balance), err, hex.EncodeToString(address))
+
+	return balance, err
+}
+
+// GetNonce forwards the call to the wrapped hook
+func (tbh *tracingBlockchainHook) GetNonce(address []byte) (uint64, error) {
+	nonce, err := tbh.hook.GetNonce(address)
+	tbh.record("getNonce", strconv.FormatUint(nonce, 10), err, hex.EncodeToString(address))
+
+	return nonce, err
+}
+
+// GetStorageData forwards the call to the wrapped hook
+func (tbh *tracingBlockchainHook) GetStorageData(accountAddress []byte, index []byte) ([]byte, error) {
+	data, err := tbh.hook.GetStorageData(accountAddress, index)
+	tbh.record("getStorage", hex.EncodeToString(data), err, hex.EncodeToString(accountAddress), hex.EncodeToString(index))
+
+	return data, err
+}
+
+// IsCodeEmpty forwards the call to the wrapped hook
+func (tbh *tracingBlockchainHook) IsCodeEmpty(address []byte) (bool, error) {
+	isEmpty, err := tbh.hook.IsCodeEmpty(address)
+	tbh.record("isCodeEmpty", strconv.FormatBool(isEmpty), err, hex.EncodeToString(address))
+
+	return isEmpty, err
+}
+
+// GetCode forwards the call to the wrapped hook. Only the length of the code is recorded
+func (tbh *tracingBlockchainHook) GetCode(address []byte) ([]byte, error) {
+	code, err := tbh.hook.GetCode(address)
+	tbh.record("getCode", strconv.Itoa(len(code)), err, hex.EncodeToString(address))
+
+	return code, err
+}
+
+// GetBlockhash forwards the call to the wrapped hook
+func (tbh *tracingBlockchainHook) GetBlockhash(offset *big.Int) ([]byte, error) {
+	hash, err := tbh.hook.GetBlockhash(offset)
+	tbh.record("getBlockhash", hex.EncodeToString(hash), err, bigIntToString(offset))
+
+	return hash, err
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tbh *tracingBlockchainHook) IsInterfaceNil() bool {
+	if tbh == nil {
+		return true
+	}
+	return false
+}
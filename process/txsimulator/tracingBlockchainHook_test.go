@@ -0,0 +1,82 @@
+package txsimulator_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTracingBlockchainHook_NilHookShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tbh, err := txsimulator.NewTracingBlockchainHook(nil)
+
+	assert.Nil(t, tbh)
+	assert.Equal(t, process.ErrNilBlockChainHook, err)
+}
+
+func TestNewTracingBlockchainHook_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tbh, err := txsimulator.NewTracingBlockchainHook(&mock.BlockChainHookStub{})
+
+	assert.Nil(t, err)
+	assert.False(t, tbh.IsInterfaceNil())
+}
+
+func TestTracingBlockchainHook_NotTracingShouldOnlyForwardTheCalls(t *testing.T) {
+	t.Parallel()
+
+	tbh, _ := txsimulator.NewTracingBlockchainHook(&mock.BlockChainHookStub{
+		GetStorageDataCalled: func(accountsAddress []byte, index []byte) ([]byte, error) {
+			return []byte{7}, nil
+		},
+	})
+
+	data, err := tbh.GetStorageData([]byte("address"), []byte("key"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{7}, data)
+	tbh.StartTracing()
+	assert.Equal(t, 0, len(tbh.StopTracing()))
+}
+
+func TestTracingBlockchainHook_TracingShouldRecordTheCallsInOrder(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	tbh, _ := txsimulator.NewTracingBlockchainHook(&mock.BlockChainHookStub{
+		GetStorageDataCalled: func(accountsAddress []byte, index []byte) ([]byte, error) {
+			return []byte{7}, nil
+		},
+		GetBalanceCalled: func(address []byte) (*big.Int, error) {
+			return big.NewInt(100), nil
+		},
+		GetCodeCalled: func(address []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	})
+
+	tbh.StartTracing()
+	_, _ = tbh.GetStorageData([]byte{1}, []byte{2})
+	_, _ = tbh.GetBalance([]byte{1})
+	_, _ = tbh.GetCode([]byte{3})
+	steps := tbh.StopTracing()
+
+	expectedSteps := []*transaction.SimulationTraceStep{
+		{Function: "getStorage", Arguments: []string{"01", "02"}, Result: "07"},
+		{Function: "getBalance", Arguments: []string{"01"}, Result: "100"},
+		{Function: "getCode", Arguments: []string{"03"}, Error: expectedErr.Error()},
+	}
+	assert.Equal(t, expectedSteps, steps)
+
+	_, _ = tbh.GetBalance([]byte{1})
+	tbh.StartTracing()
+	assert.Equal(t, 0, len(tbh.StopTracing()))
+}
@@ -0,0 +1,452 @@
+package txsimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ArgTxSimulator holds all dependencies required by the transaction simulator in order to create a new instance
+type ArgTxSimulator struct {
+	Accounts         state.AccountsAdapter
+	AddrConv         state.AddressConverter
+	ShardCoordinator sharding.Coordinator
+	VM               vmcommon.VMExecutionHandler
+	TempAccounts     process.TemporaryAccountsHandler
+	ArgsParser       process.ArgumentsParser
+	EconomicsFee     process.FeeHandler
+	Tracer           process.ExecutionTracer
+}
+
+// txSimulator executes transactions against the current state without modifying it. The state is only read, the
+// changes that would have been made by the transaction are computed and returned to the caller
+type txSimulator struct {
+	accounts         state.AccountsAdapter
+	adrConv          state.AddressConverter
+	shardCoordinator sharding.Coordinator
+	vm               vmcommon.VMExecutionHandler
+	tempAccounts     process.TemporaryAccountsHandler
+	argsParser       process.ArgumentsParser
+	economicsFee     process.FeeHandler
+	tracer           process.ExecutionTracer
+
+	mutSimulate sync.Mutex
+}
+
+// NewTxSimulator creates a new transaction simulator
+func NewTxSimulator(args ArgTxSimulator) (*txSimulator, error) {
+	if args.Accounts == nil || args.Accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if args.AddrConv == nil || args.AddrConv.IsInterfaceNil() {
+		return nil, process.ErrNilAddressConverter
+	}
+	if args.ShardCoordinator == nil || args.ShardCoordinator.IsInterfaceNil() {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if args.VM == nil {
+		return nil, process.ErrNoVM
+	}
+	if args.TempAccounts == nil || args.TempAccounts.IsInterfaceNil() {
+		return nil, process.ErrNilTemporaryAccountsHandler
+	}
+	if args.ArgsParser == nil || args.ArgsParser.IsInterfaceNil() {
+		return nil, process.ErrNilArgumentParser
+	}
+	if args.EconomicsFee == nil || args.EconomicsFee.IsInterfaceNil() {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+	if args.Tracer == nil || args.Tracer.IsInterfaceNil() {
+		return nil, process.ErrNilExecutionTracer
+	}
+
+	return &txSimulator{
+		accounts:         args.Accounts,
+		adrConv:          args.AddrConv,
+		shardCoordinator: args.ShardCoordinator,
+		vm:               args.VM,
+		tempAccounts:     args.TempAccounts,
+		argsParser:       args.ArgsParser,
+		economicsFee:     args.EconomicsFee,
+		tracer:           args.Tracer,
+	}, nil
+}
+
+// SimulateTransaction executes the provided transaction against the current state and returns the detailed
+// results. The state, the pools and the intermediate results are not affected. An error is returned only if the
+// transaction can not be simulated at all, the failures of the transaction itself are reported in the results.
+// If withTrace is set, the results of a smart contract execution also hold the calls made by the VM to the node,
+// followed by the storage writes of the execution
+func (ts *txSimulator) SimulateTransaction(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+	if tx == nil || tx.IsInterfaceNil() {
+		return nil, process.ErrNilTransaction
+	}
+	if tx.Value == nil || tx.Value.Sign() < 0 {
+		return nil, process.ErrNegativeValue
+	}
+
+	adrSrc, err := ts.adrConv.CreateAddressFromPublicKeyBytes(tx.SndAddr)
+	if err != nil {
+		return nil, err
+	}
+	if ts.shardCoordinator.ComputeId(adrSrc) != ts.shardCoordinator.SelfId() {
+		return nil, process.ErrSimulationSenderNotInSelfShard
+	}
+
+	ts.mutSimulate.Lock()
+	defer ts.mutSimulate.Unlock()
+
+	acntSnd, err := ts.getExistingAccount(tx.SndAddr)
+	if err != nil {
+		return nil, err
+	}
+	if acntSnd == nil {
+		return failedResults(state.ErrAccNotFound), nil
+	}
+
+	err = ts.checkTxValues(tx, acntSnd)
+	if err != nil {
+		return failedResults(err), nil
+	}
+
+	isEmptyAddress := bytes.Equal(tx.RcvAddr, make([]byte, ts.adrConv.AddressLen()))
+	if isEmptyAddress {
+		if len(tx.Data) == 0 {
+			return failedResults(process.ErrWrongTransaction), nil
+		}
+
+		return ts.simulateSCExecution(tx, acntSnd, true, withTrace)
+	}
+
+	acntDst, err := ts.getExistingAccount(tx.RcvAddr)
+	if err != nil {
+		return nil, err
+	}
+	if acntDst != nil && len(acntDst.GetCode()) > 0 {
+		return ts.simulateSCExecution(tx, acntSnd, false, withTrace)
+	}
+
+	return ts.simulateMoveBalance(tx, acntSnd, acntDst), nil
+}
+
+func (ts *txSimulator) checkTxValues(tx *transaction.Transaction, acntSnd *state.Account) error {
+	if acntSnd.Nonce < tx.Nonce {
+		return process.ErrHigherNonceInTransaction
+	}
+	if acntSnd.Nonce > tx.Nonce {
+		return process.ErrLowerNonceInTransaction
+	}
+
+	err := ts.economicsFee.CheckValidityTxValues(tx)
+	if err != nil {
+		return err
+	}
+
+	cost := ts.economicsFee.ComputeTxFee(tx)
+	cost.Add(cost, tx.Value)
+	if acntSnd.Balance.Cmp(cost) < 0 {
+		return process.ErrInsufficientFunds
+	}
+
+	return nil
+}
+
+func (ts *txSimulator) simulateMoveBalance(
+	tx *transaction.Transaction,
+	acntSnd *state.Account,
+	acntDst *state.Account,
+) *transaction.SimulationResults {
+	fee := ts.economicsFee.ComputeFee(tx)
+	senderDelta := big.NewInt(0).Add(tx.Value, fee)
+
+	results := &transaction.SimulationResults{
+		Status:  transaction.SimulationSuccess,
+		GasUsed: ts.economicsFee.ComputeGasLimit(tx),
+		Fee:     fee,
+		AccountChanges: []*transaction.SimulationAccountChange{
+			{
+				Address:      hex.EncodeToString(tx.SndAddr),
+				Nonce:        acntSnd.Nonce + 1,
+				BalanceDelta: senderDelta.Neg(senderDelta),
+			},
+		},
+	}
+
+	isDstInSelfShard, err := ts.isInSelfShard(tx.RcvAddr)
+	if err != nil || !isDstInSelfShard {
+		return results
+	}
+
+	dstNonce := uint64(0)
+	if acntDst != nil {
+		dstNonce = acntDst.Nonce
+	}
+	results.AccountChanges = append(results.AccountChanges, &transaction.SimulationAccountChange{
+		Address:      hex.EncodeToString(tx.RcvAddr),
+		Nonce:        dstNonce,
+		BalanceDelta: big.NewInt(0).Set(tx.Value),
+	})
+
+	return results
+}
+
+func (ts *txSimulator) simulateSCExecution(
+	tx *transaction.Transaction,
+	acntSnd *state.Account,
+	isDeploy bool,
+	withTrace bool,
+) (*transaction.SimulationResults, error) {
+	defer ts.tempAccounts.CleanTempAccounts()
+
+	err := ts.argsParser.ParseData(tx.Data)
+	if err != nil {
+		return failedResults(err), nil
+	}
+
+	// the VM should see the sender as it would be after the payment was made
+	ts.tempAccounts.AddTempAccount(tx.SndAddr, big.NewInt(0).Set(tx.Value), acntSnd.Nonce+1)
+
+	if withTrace {
+		ts.tracer.StartTracing()
+	}
+	vmOutput, err := ts.runVM(tx, isDeploy)
+	var trace []*transaction.SimulationTraceStep
+	if withTrace {
+		trace = ts.tracer.StopTracing()
+	}
+	if err != nil {
+		results := failedResults(err)
+		results.Trace = trace
+		return results, nil
+	}
+
+	results := ts.createResultsFromVMOutput(tx, acntSnd, vmOutput)
+	if withTrace {
+		results.Trace = append(trace, createStorageWritesTrace(vmOutput)...)
+	}
+
+	return results, nil
+}
+
+func (ts *txSimulator) runVM(tx *transaction.Transaction, isDeploy bool) (*vmcommon.VMOutput, error) {
+	vmInput, err := ts.createVMInput(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isDeploy {
+		function, errFunc := ts.argsParser.GetFunction()
+		if errFunc != nil {
+			return nil, errFunc
+		}
+
+		return ts.vm.RunSmartContractCall(&vmcommon.ContractCallInput{
+			VMInput:       *vmInput,
+			RecipientAddr: tx.RcvAddr,
+			Function:      function,
+		})
+	}
+
+	if len(vmInput.Arguments) < 2 {
+		return nil, process.ErrNotEnoughArgumentsToDeploy
+	}
+	// the first two arguments are the vm type, used only for routing, and the code metadata
+	vmInput.Arguments = vmInput.Arguments[2:]
+
+	hexCode, err := ts.argsParser.GetCode()
+	if err != nil {
+		return nil, err
+	}
+	code, err := hex.DecodeString(string(hexCode))
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.vm.RunSmartContractCreate(&vmcommon.ContractCreateInput{
+		VMInput:      *vmInput,
+		ContractCode: code,
+	})
+}
+
+func (ts *txSimulator) createVMInput(tx *transaction.Transaction) (*vmcommon.VMInput, error) {
+	arguments, err := ts.argsParser.GetArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMInput{
+		CallerAddr:  tx.SndAddr,
+		Arguments:   arguments,
+		CallValue:   tx.Value,
+		GasPrice:    big.NewInt(0).SetUint64(tx.GasPrice),
+		GasProvided: big.NewInt(0).SetUint64(tx.GasLimit),
+		Header: &vmcommon.SCCallHeader{
+			GasLimit:    big.NewInt(0),
+			Number:      big.NewInt(0),
+			Timestamp:   big.NewInt(0),
+			Beneficiary: big.NewInt(0),
+		},
+	}, nil
+}
+
+func (ts *txSimulator) createResultsFromVMOutput(
+	tx *transaction.Transaction,
+	acntSnd *state.Account,
+	vmOutput *vmcommon.VMOutput,
+) *transaction.SimulationResults {
+	gasUsed := tx.GasLimit
+	gasRemaining := uint64(0)
+	if vmOutput.GasRemaining != nil && vmOutput.GasRemaining.IsUint64() && vmOutput.GasRemaining.Uint64() < gasUsed {
+		gasRemaining = vmOutput.GasRemaining.Uint64()
+		gasUsed -= gasRemaining
+	}
+	// the remaining gas is refunded at the price of the gas consumed by the smart contract execution
+	fee := ts.economicsFee.ComputeTxFee(tx)
+	fee.Sub(fee, ts.economicsFee.ComputeFeeForProcessing(tx, gasRemaining))
+
+	results := &transaction.SimulationResults{
+		Status:     transaction.SimulationSuccess,
+		GasUsed:    gasUsed,
+		Fee:        fee,
+		ReturnCode: vmOutput.ReturnCode.String(),
+	}
+
+	senderChange := &transaction.SimulationAccountChange{
+		Address:      hex.EncodeToString(tx.SndAddr),
+		Nonce:        acntSnd.Nonce + 1,
+		BalanceDelta: big.NewInt(0).Neg(fee),
+	}
+	results.AccountChanges = append(results.AccountChanges, senderChange)
+
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		results.Status = transaction.SimulationFail
+		results.FailReason = vmOutput.ReturnCode.String()
+		return results
+	}
+
+	senderChange.BalanceDelta.Sub(senderChange.BalanceDelta, tx.Value)
+	for _, returnData := range vmOutput.ReturnData {
+		results.ReturnData = append(results.ReturnData, hex.EncodeToString(returnData.Bytes()))
+	}
+	for _, logEntry := range vmOutput.Logs {
+		results.Logs = append(results.Logs, createSimulationLog(logEntry))
+	}
+	for _, outAcc := range vmOutput.OutputAccounts {
+		results.AccountChanges = append(results.AccountChanges, createAccountChange(outAcc))
+	}
+
+	return results
+}
+
+func createSimulationLog(logEntry *vmcommon.LogEntry) *transaction.SimulationLog {
+	simulationLog := &transaction.SimulationLog{
+		Address: hex.EncodeToString(logEntry.Address),
+		Topics:  make([]string, 0, len(logEntry.Topics)),
+		Data:    hex.EncodeToString(logEntry.Data),
+	}
+	for _, topic := range logEntry.Topics {
+		simulationLog.Topics = append(simulationLog.Topics, hex.EncodeToString(topic.Bytes()))
+	}
+
+	return simulationLog
+}
+
+func createStorageWritesTrace(vmOutput *vmcommon.VMOutput) []*transaction.SimulationTraceStep {
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil
+	}
+
+	steps := make([]*transaction.SimulationTraceStep, 0)
+	for _, outAcc := range vmOutput.OutputAccounts {
+		for _, update := range outAcc.StorageUpdates {
+			steps = append(steps, &transaction.SimulationTraceStep{
+				Function:  "setStorage",
+				Arguments: []string{hex.EncodeToString(outAcc.Address), hex.EncodeToString(update.Offset)},
+				Result:    hex.EncodeToString(update.Data),
+			})
+		}
+	}
+
+	return steps
+}
+
+func createAccountChange(outAcc *vmcommon.OutputAccount) *transaction.SimulationAccountChange {
+	balanceDelta := big.NewInt(0)
+	if outAcc.BalanceDelta != nil {
+		balanceDelta.Set(outAcc.BalanceDelta)
+	}
+
+	accountChange := &transaction.SimulationAccountChange{
+		Address:      hex.EncodeToString(outAcc.Address),
+		Nonce:        outAcc.Nonce,
+		BalanceDelta: balanceDelta,
+		CodeDeployed: len(outAcc.Code) > 0,
+	}
+	if len(outAcc.StorageUpdates) > 0 {
+		accountChange.StorageUpdates = make(map[string]string, len(outAcc.StorageUpdates))
+		for _, update := range outAcc.StorageUpdates {
+			accountChange.StorageUpdates[hex.EncodeToString(update.Offset)] = hex.EncodeToString(update.Data)
+		}
+	}
+
+	return accountChange
+}
+
+func (ts *txSimulator) isInSelfShard(address []byte) (bool, error) {
+	adr, err := ts.adrConv.CreateAddressFromPublicKeyBytes(address)
+	if err != nil {
+		return false, err
+	}
+
+	return ts.shardCoordinator.ComputeId(adr) == ts.shardCoordinator.SelfId(), nil
+}
+
+// getExistingAccount returns nil if the account does not exist or if it is not in the node's shard. It never
+// creates accounts, so the state remains untouched
+func (ts *txSimulator) getExistingAccount(address []byte) (*state.Account, error) {
+	adr, err := ts.adrConv.CreateAddressFromPublicKeyBytes(address)
+	if err != nil {
+		return nil, err
+	}
+	if ts.shardCoordinator.ComputeId(adr) != ts.shardCoordinator.SelfId() {
+		return nil, nil
+	}
+
+	acnt, err := ts.accounts.GetExistingAccount(adr)
+	if err == state.ErrAccNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := acnt.(*state.Account)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return account, nil
+}
+
+func failedResults(err error) *transaction.SimulationResults {
+	return &transaction.SimulationResults{
+		Status:     transaction.SimulationFail,
+		FailReason: err.Error(),
+		Fee:        big.NewInt(0),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *txSimulator) IsInterfaceNil() bool {
+	if ts == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,564 @@
+package txsimulator_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	"github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+var sndAddr = []byte("sender")
+var rcvAddr = []byte("receiver")
+
+func createMockArgs() txsimulator.ArgTxSimulator {
+	return txsimulator.ArgTxSimulator{
+		Accounts:         &mock.AccountsStub{},
+		AddrConv:         &mock.AddressConverterMock{},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		VM:               &mock.VMExecutionHandlerStub{},
+		TempAccounts:     &mock.TemporaryAccountsHandlerMock{},
+		ArgsParser:       &mock.ArgumentParserMock{},
+		EconomicsFee: &mock.FeeHandlerStub{
+			CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+				return nil
+			},
+			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+				return 5
+			},
+			ComputeFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+				return big.NewInt(50)
+			},
+		},
+		Tracer: &mock.ExecutionTracerStub{},
+	}
+}
+
+func createAccountsStub(accounts ...*state.Account) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+			for _, acnt := range accounts {
+				if bytes.Equal(acnt.AddressContainer().Bytes(), addressContainer.Bytes()) {
+					return acnt, nil
+				}
+			}
+			return nil, state.ErrAccNotFound
+		},
+		SaveAccountStateCalled: func(acountWrapper state.AccountHandler) error {
+			panic("the state should not be altered")
+		},
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+			panic("the state should not be altered")
+		},
+	}
+}
+
+func createAccount(address []byte, nonce uint64, balance int64) *state.Account {
+	acnt, _ := state.NewAccount(mock.NewAddressMock(address), &mock.AccountTrackerStub{})
+	acnt.Nonce = nonce
+	acnt.Balance = big.NewInt(balance)
+
+	return acnt
+}
+
+func createTx(nonce uint64, value int64) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(value),
+		SndAddr:  sndAddr,
+		RcvAddr:  rcvAddr,
+		GasPrice: 10,
+		GasLimit: 5,
+	}
+}
+
+func TestNewTxSimulator_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Accounts = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.Nil(t, ts)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewTxSimulator_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.AddrConv = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.Nil(t, ts)
+	assert.Equal(t, process.ErrNilAddressConverter, err)
+}
+
+func TestNewTxSimulator_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ShardCoordinator = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.Nil(t, ts)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestNewTxSimulator_NilVMShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.VM = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.Nil(t, ts)
+	assert.Equal(t, process.ErrNoVM, err)
+}
+
+func TestNewTxSimulator_NilTempAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.TempAccounts = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.Nil(t, ts)
+	assert.Equal(t, process.ErrNilTemporaryAccountsHandler, err)
+}
+
+func TestNewTxSimulator_NilArgsParserShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ArgsParser = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.Nil(t, ts)
+	assert.Equal(t, process.ErrNilArgumentParser, err)
+}
+
+func TestNewTxSimulator_NilEconomicsFeeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.EconomicsFee = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.Nil(t, ts)
+	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+}
+
+func TestNewTxSimulator_NilTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Tracer = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.Nil(t, ts)
+	assert.Equal(t, process.ErrNilExecutionTracer, err)
+}
+
+func TestNewTxSimulator_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts, err := txsimulator.NewTxSimulator(createMockArgs())
+
+	assert.NotNil(t, ts)
+	assert.Nil(t, err)
+	assert.False(t, ts.IsInterfaceNil())
+}
+
+func TestTxSimulator_SimulateTransactionNilTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := txsimulator.NewTxSimulator(createMockArgs())
+	results, err := ts.SimulateTransaction(nil, false)
+
+	assert.Nil(t, results)
+	assert.Equal(t, process.ErrNilTransaction, err)
+}
+
+func TestTxSimulator_SimulateTransactionSenderInOtherShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return 1
+	}
+	args.ShardCoordinator = shardCoordinator
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	results, err := ts.SimulateTransaction(createTx(0, 10), false)
+
+	assert.Nil(t, results)
+	assert.Equal(t, process.ErrSimulationSenderNotInSelfShard, err)
+}
+
+func TestTxSimulator_SimulateTransactionMissingSenderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Accounts = createAccountsStub()
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	results, err := ts.SimulateTransaction(createTx(0, 10), false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.SimulationFail, results.Status)
+	assert.Equal(t, state.ErrAccNotFound.Error(), results.FailReason)
+}
+
+func TestTxSimulator_SimulateTransactionWrongNonceShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Accounts = createAccountsStub(createAccount(sndAddr, 3, 1000))
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	results, err := ts.SimulateTransaction(createTx(4, 10), false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.SimulationFail, results.Status)
+	assert.Equal(t, process.ErrHigherNonceInTransaction.Error(), results.FailReason)
+}
+
+func TestTxSimulator_SimulateTransactionInsufficientFundsShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Accounts = createAccountsStub(createAccount(sndAddr, 0, 10))
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	results, err := ts.SimulateTransaction(createTx(0, 10), false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.SimulationFail, results.Status)
+	assert.Equal(t, process.ErrInsufficientFunds.Error(), results.FailReason)
+}
+
+func TestTxSimulator_SimulateTransactionMoveBalanceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Accounts = createAccountsStub(createAccount(sndAddr, 2, 1000))
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	results, err := ts.SimulateTransaction(createTx(2, 10), false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.SimulationSuccess, results.Status)
+	assert.Equal(t, uint64(5), results.GasUsed)
+	assert.Equal(t, big.NewInt(50), results.Fee)
+	assert.Equal(t, 2, len(results.AccountChanges))
+	assert.Equal(t, uint64(3), results.AccountChanges[0].Nonce)
+	assert.Equal(t, big.NewInt(-60), results.AccountChanges[0].BalanceDelta)
+	assert.Equal(t, big.NewInt(10), results.AccountChanges[1].BalanceDelta)
+}
+
+func TestTxSimulator_SimulateTransactionSCCallShouldUseTempAccountsAndClean(t *testing.T) {
+	t.Parallel()
+
+	scAccount := createAccount(rcvAddr, 0, 0)
+	scAccount.SetCode([]byte("code"))
+
+	tempAccountAdded := false
+	tempAccountsCleaned := false
+	args := createMockArgs()
+	args.Accounts = createAccountsStub(createAccount(sndAddr, 0, 1000), scAccount)
+	args.TempAccounts = &mock.TemporaryAccountsHandlerMock{
+		AddTempAccountCalled: func(address []byte, balance *big.Int, nonce uint64) {
+			tempAccountAdded = true
+			assert.Equal(t, uint64(1), nonce)
+		},
+		CleanTempAccountsCalled: func() {
+			tempAccountsCleaned = true
+		},
+	}
+	args.ArgsParser = &mock.ArgumentParserMock{
+		ParseDataCalled: func(data string) error {
+			return nil
+		},
+		GetArgumentsCalled: func() ([]*big.Int, error) {
+			return make([]*big.Int, 0), nil
+		},
+		GetFunctionCalled: func() (string, error) {
+			return "function", nil
+		},
+	}
+	args.VM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, "function", input.Function)
+			return &vmcommon.VMOutput{
+				ReturnCode:   vmcommon.Ok,
+				GasRemaining: big.NewInt(2),
+				ReturnData:   []*big.Int{big.NewInt(255)},
+				OutputAccounts: []*vmcommon.OutputAccount{
+					{
+						Address:      rcvAddr,
+						BalanceDelta: big.NewInt(10),
+						StorageUpdates: []*vmcommon.StorageUpdate{
+							{Offset: []byte{1}, Data: []byte{2}},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	tx := createTx(0, 10)
+	tx.Data = "function"
+	results, err := ts.SimulateTransaction(tx, false)
+
+	assert.Nil(t, err)
+	assert.True(t, tempAccountAdded)
+	assert.True(t, tempAccountsCleaned)
+	assert.Equal(t, transaction.SimulationSuccess, results.Status)
+	assert.Equal(t, uint64(3), results.GasUsed)
+	assert.Equal(t, big.NewInt(30), results.Fee)
+	assert.Equal(t, []string{"ff"}, results.ReturnData)
+	assert.Equal(t, 2, len(results.AccountChanges))
+	assert.Equal(t, big.NewInt(-40), results.AccountChanges[0].BalanceDelta)
+	assert.Equal(t, "02", results.AccountChanges[1].StorageUpdates["01"])
+}
+
+func TestTxSimulator_SimulateTransactionSCDeployShouldNotPassTheCodeMetadataToTheConstructor(t *testing.T) {
+	t.Parallel()
+
+	vmType := big.NewInt(5)
+	codeMetadata := big.NewInt(1)
+	constructorArg := big.NewInt(7)
+	constructorArgs := make([]*big.Int, 0)
+	args := createMockArgs()
+	args.Accounts = createAccountsStub(createAccount(sndAddr, 0, 1000))
+	args.ArgsParser = &mock.ArgumentParserMock{
+		ParseDataCalled: func(data string) error {
+			return nil
+		},
+		GetArgumentsCalled: func() ([]*big.Int, error) {
+			return []*big.Int{vmType, codeMetadata, constructorArg}, nil
+		},
+		GetCodeCalled: func() ([]byte, error) {
+			return []byte("aabb"), nil
+		},
+	}
+	args.VM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCreateCalled: func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+			constructorArgs = input.Arguments
+			assert.Equal(t, []byte{0xaa, 0xbb}, input.ContractCode)
+			return &vmcommon.VMOutput{
+				ReturnCode:   vmcommon.Ok,
+				GasRemaining: big.NewInt(0),
+			}, nil
+		},
+	}
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	tx := createTx(0, 0)
+	tx.RcvAddr = make([]byte, 32)
+	tx.Data = "aabb@05@01@07"
+	results, err := ts.SimulateTransaction(tx, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.SimulationSuccess, results.Status)
+	assert.Equal(t, []*big.Int{constructorArg}, constructorArgs)
+}
+
+func TestTxSimulator_SimulateTransactionSCCallShouldPriceTheRemainingGasForProcessing(t *testing.T) {
+	t.Parallel()
+
+	scAccount := createAccount(rcvAddr, 0, 0)
+	scAccount.SetCode([]byte("code"))
+
+	args := createMockArgs()
+	args.Accounts = createAccountsStub(createAccount(sndAddr, 0, 1000), scAccount)
+	args.EconomicsFee = &mock.FeeHandlerStub{
+		CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+			return nil
+		},
+		ComputeTxFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(150)
+		},
+		ComputeFeeForProcessingCalled: func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
+			return big.NewInt(0).SetUint64(gasToUse * tx.GetGasPrice() / 10)
+		},
+	}
+	args.ArgsParser = &mock.ArgumentParserMock{
+		ParseDataCalled: func(data string) error {
+			return nil
+		},
+		GetArgumentsCalled: func() ([]*big.Int, error) {
+			return make([]*big.Int, 0), nil
+		},
+		GetFunctionCalled: func() (string, error) {
+			return "function", nil
+		},
+	}
+	args.VM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode:   vmcommon.Ok,
+				GasRemaining: big.NewInt(40),
+			}, nil
+		},
+	}
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	tx := createTx(0, 0)
+	tx.GasLimit = 105
+	tx.Data = "function"
+	results, err := ts.SimulateTransaction(tx, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.SimulationSuccess, results.Status)
+	assert.Equal(t, uint64(65), results.GasUsed)
+	assert.Equal(t, big.NewInt(110), results.Fee)
+}
+
+func TestTxSimulator_SimulateTransactionVMErrorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	scAccount := createAccount(rcvAddr, 0, 0)
+	scAccount.SetCode([]byte("code"))
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgs()
+	args.Accounts = createAccountsStub(createAccount(sndAddr, 0, 1000), scAccount)
+	args.ArgsParser = &mock.ArgumentParserMock{
+		ParseDataCalled: func(data string) error {
+			return nil
+		},
+		GetArgumentsCalled: func() ([]*big.Int, error) {
+			return make([]*big.Int, 0), nil
+		},
+		GetFunctionCalled: func() (string, error) {
+			return "function", nil
+		},
+	}
+	args.VM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	tx := createTx(0, 10)
+	tx.Data = "function"
+	results, err := ts.SimulateTransaction(tx, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.SimulationFail, results.Status)
+	assert.Equal(t, expectedErr.Error(), results.FailReason)
+}
+
+func TestTxSimulator_SimulateTransactionWithTraceShouldReturnTheTrace(t *testing.T) {
+	t.Parallel()
+
+	scAccount := createAccount(rcvAddr, 0, 0)
+	scAccount.SetCode([]byte("code"))
+
+	isTracing := false
+	readStep := &transaction.SimulationTraceStep{Function: "getStorage", Arguments: []string{"aa", "01"}, Result: "05"}
+	args := createMockArgs()
+	args.Accounts = createAccountsStub(createAccount(sndAddr, 0, 1000), scAccount)
+	args.Tracer = &mock.ExecutionTracerStub{
+		StartTracingCalled: func() {
+			isTracing = true
+		},
+		StopTracingCalled: func() []*transaction.SimulationTraceStep {
+			isTracing = false
+			return []*transaction.SimulationTraceStep{readStep}
+		},
+	}
+	args.ArgsParser = &mock.ArgumentParserMock{
+		ParseDataCalled: func(data string) error {
+			return nil
+		},
+		GetArgumentsCalled: func() ([]*big.Int, error) {
+			return make([]*big.Int, 0), nil
+		},
+		GetFunctionCalled: func() (string, error) {
+			return "function", nil
+		},
+	}
+	args.VM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			assert.True(t, isTracing)
+			return &vmcommon.VMOutput{
+				ReturnCode:   vmcommon.Ok,
+				GasRemaining: big.NewInt(0),
+				OutputAccounts: []*vmcommon.OutputAccount{
+					{
+						Address: rcvAddr,
+						StorageUpdates: []*vmcommon.StorageUpdate{
+							{Offset: []byte{1}, Data: []byte{6}},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	tx := createTx(0, 10)
+	tx.Data = "function"
+	results, err := ts.SimulateTransaction(tx, true)
+
+	assert.Nil(t, err)
+	assert.False(t, isTracing)
+	assert.Equal(t, 2, len(results.Trace))
+	assert.True(t, readStep == results.Trace[0])
+	assert.Equal(t, "setStorage", results.Trace[1].Function)
+	assert.Equal(t, []string{hex.EncodeToString(rcvAddr), "01"}, results.Trace[1].Arguments)
+	assert.Equal(t, "06", results.Trace[1].Result)
+}
+
+func TestTxSimulator_SimulateTransactionWithoutTraceShouldNotTrace(t *testing.T) {
+	t.Parallel()
+
+	scAccount := createAccount(rcvAddr, 0, 0)
+	scAccount.SetCode([]byte("code"))
+
+	args := createMockArgs()
+	args.Accounts = createAccountsStub(createAccount(sndAddr, 0, 1000), scAccount)
+	args.Tracer = &mock.ExecutionTracerStub{
+		StartTracingCalled: func() {
+			assert.Fail(t, "the execution should not have been traced")
+		},
+	}
+	args.ArgsParser = &mock.ArgumentParserMock{
+		ParseDataCalled: func(data string) error {
+			return nil
+		},
+		GetArgumentsCalled: func() ([]*big.Int, error) {
+			return make([]*big.Int, 0), nil
+		},
+		GetFunctionCalled: func() (string, error) {
+			return "function", nil
+		},
+	}
+	args.VM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: big.NewInt(0)}, nil
+		},
+	}
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	tx := createTx(0, 10)
+	tx.Data = "function"
+	results, err := ts.SimulateTransaction(tx, false)
+
+	assert.Nil(t, err)
+	assert.Nil(t, results.Trace)
+}
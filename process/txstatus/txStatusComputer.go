@@ -0,0 +1,297 @@
+package txstatus
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ArgTxStatusComputer holds all dependencies required by the transaction status computer in order to create a new
+// instance
+type ArgTxStatusComputer struct {
+	Store            dataRetriever.StorageService
+	TxPool           dataRetriever.ShardedDataCacherNotifier
+	ScrPool          dataRetriever.ShardedDataCacherNotifier
+	Marshalizer      marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+}
+
+// txStatusComputer combines the inclusion of a transaction in a block, the outcome of its execution and the outcome
+// of the smart contract results it generated into a single status. Only the executions done in the node's own shard
+// are known, so a transaction which still waits to be executed in another shard is reported as pending
+type txStatusComputer struct {
+	store            dataRetriever.StorageService
+	txPool           dataRetriever.ShardedDataCacherNotifier
+	scrPool          dataRetriever.ShardedDataCacherNotifier
+	marshalizer      marshal.Marshalizer
+	shardCoordinator sharding.Coordinator
+}
+
+// NewTxStatusComputer creates a new transaction status computer
+func NewTxStatusComputer(args ArgTxStatusComputer) (*txStatusComputer, error) {
+	if args.Store == nil || args.Store.IsInterfaceNil() {
+		return nil, process.ErrNilStore
+	}
+	if args.TxPool == nil || args.TxPool.IsInterfaceNil() {
+		return nil, process.ErrNilTransactionPool
+	}
+	if args.ScrPool == nil || args.ScrPool.IsInterfaceNil() {
+		return nil, process.ErrNilUTxDataPool
+	}
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if args.ShardCoordinator == nil || args.ShardCoordinator.IsInterfaceNil() {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	return &txStatusComputer{
+		store:            args.Store,
+		txPool:           args.TxPool,
+		scrPool:          args.ScrPool,
+		marshalizer:      args.Marshalizer,
+		shardCoordinator: args.ShardCoordinator,
+	}, nil
+}
+
+// GetTransactionWithResults returns the transaction with the provided hash together with its status, the smart
+// contract results it generated and the events logged while executing it. A nil transaction is returned if the
+// transaction is not known by the node
+func (tsc *txStatusComputer) GetTransactionWithResults(
+	txHash []byte,
+) (*transaction.Transaction, *transaction.TransactionResults, error) {
+	tx, err := tsc.getTransactionFromStorage(txHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tx == nil {
+		tx = tsc.getTransactionFromPool(txHash)
+		if tx == nil {
+			return nil, nil, nil
+		}
+
+		return tx, &transaction.TransactionResults{Status: transaction.TxStatusPending}, nil
+	}
+
+	miniBlock, err := tsc.getMiniBlock(txHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if miniBlock != nil && miniBlock.Type == block.InvalidBlock {
+		return tx, &transaction.TransactionResults{Status: transaction.TxStatusInvalid}, nil
+	}
+	if !tsc.isExecutedInSelfShard(tx, miniBlock) {
+		return tx, &transaction.TransactionResults{Status: transaction.TxStatusPending}, nil
+	}
+
+	results := &transaction.TransactionResults{Status: transaction.TxStatusSuccess}
+	txLog, err := tsc.getLog(txHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if txLog == nil {
+		return tx, results, nil
+	}
+
+	results.ReturnCode = txLog.ReturnCode
+	visited := map[string]struct{}{string(txHash): {}}
+	err = tsc.addResults(results, txLog, visited)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tx, results, nil
+}
+
+func (tsc *txStatusComputer) isExecutedInSelfShard(tx *transaction.Transaction, miniBlock *block.MiniBlock) bool {
+	if miniBlock != nil {
+		return miniBlock.ReceiverShardID == tsc.shardCoordinator.SelfId()
+	}
+
+	return tsc.shardCoordinator.ComputeId(state.NewAddress(tx.RcvAddr)) == tsc.shardCoordinator.SelfId()
+}
+
+// addResults walks through the smart contract results generated by an execution, and through the ones generated
+// by their own executions in this shard, collecting the logged events and the failures
+func (tsc *txStatusComputer) addResults(
+	results *transaction.TransactionResults,
+	txLog *transaction.Log,
+	visited map[string]struct{},
+) error {
+	if txLog.ReturnCode != vmcommon.Ok.String() {
+		results.Status = transaction.TxStatusFail
+	}
+
+	for _, event := range txLog.Events {
+		results.Logs = append(results.Logs, createResultsLog(event))
+	}
+
+	for _, scrHash := range txLog.ScrHashes {
+		if _, ok := visited[string(scrHash)]; ok {
+			continue
+		}
+		visited[string(scrHash)] = struct{}{}
+
+		scr, err := tsc.getSmartContractResult(scrHash)
+		if err != nil {
+			return err
+		}
+		if scr == nil {
+			continue
+		}
+
+		scrLog, err := tsc.getLog(scrHash)
+		if err != nil {
+			return err
+		}
+
+		resultsScr := createResultsSmartContract(scrHash, scr)
+		results.SmartContractResults = append(results.SmartContractResults, resultsScr)
+		if scrLog == nil {
+			continue
+		}
+
+		resultsScr.ReturnCode = scrLog.ReturnCode
+		err = tsc.addResults(results, scrLog, visited)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (tsc *txStatusComputer) getTransactionFromStorage(txHash []byte) (*transaction.Transaction, error) {
+	buff, err := tsc.store.Get(dataRetriever.TransactionUnit, txHash)
+	if err != nil {
+		return nil, nil
+	}
+
+	tx := &transaction.Transaction{}
+	err = tsc.marshalizer.Unmarshal(tx, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (tsc *txStatusComputer) getTransactionFromPool(txHash []byte) *transaction.Transaction {
+	value, ok := tsc.txPool.SearchFirstData(txHash)
+	if !ok {
+		return nil
+	}
+
+	tx, ok := value.(*transaction.Transaction)
+	if !ok {
+		return nil
+	}
+
+	return tx
+}
+
+func (tsc *txStatusComputer) getMiniBlock(txHash []byte) (*block.MiniBlock, error) {
+	miniBlockHash, err := tsc.store.Get(dataRetriever.MiniBlockHashByTxHashUnit, txHash)
+	if err != nil {
+		return nil, nil
+	}
+
+	buff, err := tsc.store.Get(dataRetriever.MiniBlockUnit, miniBlockHash)
+	if err != nil {
+		return nil, nil
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = tsc.marshalizer.Unmarshal(miniBlock, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+func (tsc *txStatusComputer) getLog(txHash []byte) (*transaction.Log, error) {
+	buff, err := tsc.store.Get(dataRetriever.TxLogsUnit, txHash)
+	if err != nil {
+		return nil, nil
+	}
+
+	txLog := &transaction.Log{}
+	err = tsc.marshalizer.Unmarshal(txLog, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return txLog, nil
+}
+
+func (tsc *txStatusComputer) getSmartContractResult(
+	scrHash []byte,
+) (*smartContractResult.SmartContractResult, error) {
+	buff, err := tsc.store.Get(dataRetriever.UnsignedTransactionUnit, scrHash)
+	if err != nil {
+		value, ok := tsc.scrPool.SearchFirstData(scrHash)
+		if !ok {
+			return nil, nil
+		}
+
+		scr, _ := value.(*smartContractResult.SmartContractResult)
+		return scr, nil
+	}
+
+	scr := &smartContractResult.SmartContractResult{}
+	err = tsc.marshalizer.Unmarshal(scr, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return scr, nil
+}
+
+func createResultsSmartContract(
+	scrHash []byte,
+	scr *smartContractResult.SmartContractResult,
+) *transaction.ResultsSmartContract {
+	value := "0"
+	if scr.Value != nil {
+		value = scr.Value.String()
+	}
+
+	return &transaction.ResultsSmartContract{
+		Hash:     hex.EncodeToString(scrHash),
+		Nonce:    scr.Nonce,
+		Value:    value,
+		Sender:   hex.EncodeToString(scr.SndAddr),
+		Receiver: hex.EncodeToString(scr.RcvAddr),
+		Data:     scr.Data,
+	}
+}
+
+func createResultsLog(event *transaction.Event) *transaction.ResultsLog {
+	topics := make([]string, 0, len(event.Topics))
+	for _, topic := range event.Topics {
+		topics = append(topics, hex.EncodeToString(topic))
+	}
+
+	return &transaction.ResultsLog{
+		Address: hex.EncodeToString(event.Address),
+		Topics:  topics,
+		Data:    hex.EncodeToString(event.Data),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsc *txStatusComputer) IsInterfaceNil() bool {
+	if tsc == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,269 @@
+package txstatus_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txstatus"
+	"github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+var txHash = []byte("tx hash")
+
+type storeEntries map[dataRetriever.UnitType]map[string][]byte
+
+func (se storeEntries) put(unitType dataRetriever.UnitType, key []byte, object interface{}) {
+	if _, ok := se[unitType]; !ok {
+		se[unitType] = make(map[string][]byte)
+	}
+
+	buff, ok := object.([]byte)
+	if !ok {
+		buff, _ = (&mock.MarshalizerMock{}).Marshal(object)
+	}
+	se[unitType][string(key)] = buff
+}
+
+func createStore(entries storeEntries) dataRetriever.StorageService {
+	return &mock.ChainStorerMock{
+		GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+			buff, ok := entries[unitType][string(key)]
+			if !ok {
+				return nil, errors.New("key not found")
+			}
+			return buff, nil
+		},
+	}
+}
+
+func createEmptyPool() *mock.ShardedDataStub {
+	return &mock.ShardedDataStub{
+		SearchFirstDataCalled: func(key []byte) (value interface{}, ok bool) {
+			return nil, false
+		},
+	}
+}
+
+func createArgs(entries storeEntries) txstatus.ArgTxStatusComputer {
+	return txstatus.ArgTxStatusComputer{
+		Store:            createStore(entries),
+		TxPool:           createEmptyPool(),
+		ScrPool:          createEmptyPool(),
+		Marshalizer:      &mock.MarshalizerMock{},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+	}
+}
+
+func hashOf(object interface{}) []byte {
+	hash, _ := core.CalculateHash(&mock.MarshalizerMock{}, &mock.HasherMock{}, object)
+	return hash
+}
+
+func addExecutedTransaction(entries storeEntries, miniBlockType block.Type, receiverShardID uint32) *transaction.Transaction {
+	tx := &transaction.Transaction{Nonce: 1, Value: big.NewInt(0)}
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{txHash}, Type: miniBlockType, ReceiverShardID: receiverShardID}
+	miniBlockHash := hashOf(miniBlock)
+
+	entries.put(dataRetriever.TransactionUnit, txHash, tx)
+	entries.put(dataRetriever.MiniBlockUnit, miniBlockHash, miniBlock)
+	entries.put(dataRetriever.MiniBlockHashByTxHashUnit, txHash, miniBlockHash)
+
+	return tx
+}
+
+func TestNewTxStatusComputer_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs(storeEntries{})
+	args.Store = nil
+	tsc, err := txstatus.NewTxStatusComputer(args)
+
+	assert.Nil(t, tsc)
+	assert.Equal(t, process.ErrNilStore, err)
+}
+
+func TestNewTxStatusComputer_NilTxPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs(storeEntries{})
+	args.TxPool = nil
+	tsc, err := txstatus.NewTxStatusComputer(args)
+
+	assert.Nil(t, tsc)
+	assert.Equal(t, process.ErrNilTransactionPool, err)
+}
+
+func TestNewTxStatusComputer_NilScrPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs(storeEntries{})
+	args.ScrPool = nil
+	tsc, err := txstatus.NewTxStatusComputer(args)
+
+	assert.Nil(t, tsc)
+	assert.Equal(t, process.ErrNilUTxDataPool, err)
+}
+
+func TestNewTxStatusComputer_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs(storeEntries{})
+	args.Marshalizer = nil
+	tsc, err := txstatus.NewTxStatusComputer(args)
+
+	assert.Nil(t, tsc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewTxStatusComputer_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs(storeEntries{})
+	args.ShardCoordinator = nil
+	tsc, err := txstatus.NewTxStatusComputer(args)
+
+	assert.Nil(t, tsc)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestTxStatusComputer_GetTransactionWithResultsUnknownTxShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	tsc, _ := txstatus.NewTxStatusComputer(createArgs(storeEntries{}))
+
+	tx, results, err := tsc.GetTransactionWithResults(txHash)
+
+	assert.Nil(t, err)
+	assert.Nil(t, tx)
+	assert.Nil(t, results)
+}
+
+func TestTxStatusComputer_GetTransactionWithResultsTxInPoolShouldBePending(t *testing.T) {
+	t.Parallel()
+
+	poolTx := &transaction.Transaction{Nonce: 7}
+	args := createArgs(storeEntries{})
+	args.TxPool = &mock.ShardedDataStub{
+		SearchFirstDataCalled: func(key []byte) (value interface{}, ok bool) {
+			return poolTx, true
+		},
+	}
+	tsc, _ := txstatus.NewTxStatusComputer(args)
+
+	tx, results, err := tsc.GetTransactionWithResults(txHash)
+
+	assert.Nil(t, err)
+	assert.True(t, tx == poolTx)
+	assert.Equal(t, transaction.TxStatusPending, results.Status)
+}
+
+func TestTxStatusComputer_GetTransactionWithResultsInvalidMiniBlockShouldBeInvalid(t *testing.T) {
+	t.Parallel()
+
+	entries := storeEntries{}
+	addExecutedTransaction(entries, block.InvalidBlock, 0)
+	tsc, _ := txstatus.NewTxStatusComputer(createArgs(entries))
+
+	tx, results, err := tsc.GetTransactionWithResults(txHash)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, tx)
+	assert.Equal(t, transaction.TxStatusInvalid, results.Status)
+}
+
+func TestTxStatusComputer_GetTransactionWithResultsCrossShardInSourceShardShouldBePending(t *testing.T) {
+	t.Parallel()
+
+	entries := storeEntries{}
+	addExecutedTransaction(entries, block.TxBlock, 1)
+	tsc, _ := txstatus.NewTxStatusComputer(createArgs(entries))
+
+	_, results, err := tsc.GetTransactionWithResults(txHash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.TxStatusPending, results.Status)
+}
+
+func TestTxStatusComputer_GetTransactionWithResultsWithoutLogShouldBeSuccess(t *testing.T) {
+	t.Parallel()
+
+	entries := storeEntries{}
+	addExecutedTransaction(entries, block.TxBlock, 0)
+	tsc, _ := txstatus.NewTxStatusComputer(createArgs(entries))
+
+	_, results, err := tsc.GetTransactionWithResults(txHash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.TxStatusSuccess, results.Status)
+	assert.Nil(t, results.SmartContractResults)
+}
+
+func TestTxStatusComputer_GetTransactionWithResultsFailedVMExecutionShouldBeFail(t *testing.T) {
+	t.Parallel()
+
+	entries := storeEntries{}
+	addExecutedTransaction(entries, block.TxBlock, 0)
+	entries.put(dataRetriever.TxLogsUnit, txHash, &transaction.Log{ReturnCode: vmcommon.UserError.String()})
+	tsc, _ := txstatus.NewTxStatusComputer(createArgs(entries))
+
+	_, results, err := tsc.GetTransactionWithResults(txHash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.TxStatusFail, results.Status)
+	assert.Equal(t, vmcommon.UserError.String(), results.ReturnCode)
+}
+
+func TestTxStatusComputer_GetTransactionWithResultsShouldCollectResultsAndLogs(t *testing.T) {
+	t.Parallel()
+
+	entries := storeEntries{}
+	addExecutedTransaction(entries, block.TxBlock, 0)
+	scr := &smartContractResult.SmartContractResult{Nonce: 2, Value: big.NewInt(5), TxHash: txHash}
+	scrHash := hashOf(scr)
+	entries.put(dataRetriever.UnsignedTransactionUnit, scrHash, scr)
+	entries.put(dataRetriever.TxLogsUnit, txHash, &transaction.Log{
+		ReturnCode: vmcommon.Ok.String(),
+		Events:     []*transaction.Event{{Address: []byte{1}, Topics: [][]byte{{2}}, Data: []byte{3}}},
+		ScrHashes:  [][]byte{scrHash},
+	})
+	tsc, _ := txstatus.NewTxStatusComputer(createArgs(entries))
+
+	_, results, err := tsc.GetTransactionWithResults(txHash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.TxStatusSuccess, results.Status)
+	assert.Equal(t, 1, len(results.SmartContractResults))
+	assert.Equal(t, "5", results.SmartContractResults[0].Value)
+	assert.Equal(t, []*transaction.ResultsLog{{Address: "01", Topics: []string{"02"}, Data: "03"}}, results.Logs)
+}
+
+func TestTxStatusComputer_GetTransactionWithResultsFailedResultShouldBeFail(t *testing.T) {
+	t.Parallel()
+
+	entries := storeEntries{}
+	addExecutedTransaction(entries, block.TxBlock, 0)
+	scr := &smartContractResult.SmartContractResult{Nonce: 2, Value: big.NewInt(0), TxHash: txHash}
+	scrHash := hashOf(scr)
+	entries.put(dataRetriever.UnsignedTransactionUnit, scrHash, scr)
+	entries.put(dataRetriever.TxLogsUnit, txHash, &transaction.Log{
+		ReturnCode: vmcommon.Ok.String(),
+		ScrHashes:  [][]byte{scrHash},
+	})
+	entries.put(dataRetriever.TxLogsUnit, scrHash, &transaction.Log{ReturnCode: vmcommon.OutOfGas.String()})
+	tsc, _ := txstatus.NewTxStatusComputer(createArgs(entries))
+
+	_, results, err := tsc.GetTransactionWithResults(txHash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.TxStatusFail, results.Status)
+	assert.Equal(t, vmcommon.OutOfGas.String(), results.SmartContractResults[0].ReturnCode)
+}
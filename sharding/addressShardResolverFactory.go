@@ -0,0 +1,38 @@
+package sharding
+
+const (
+	// LastBitsResolver assigns addresses to shards using their last bits
+	LastBitsResolver = "lastBits"
+	// PrefixRangesResolver assigns addresses to shards using contiguous ranges of the address space
+	PrefixRangesResolver = "prefixRanges"
+)
+
+// NewAddressShardResolver creates the address shard resolver described by the provided config
+func NewAddressShardResolver(config AddressShardResolverConfig, numberOfShards uint32) (AddressShardResolver, error) {
+	var addressResolver AddressShardResolver
+	var err error
+
+	switch config.Type {
+	case "", LastBitsResolver:
+		addressResolver, err = NewLastBitsShardResolver(numberOfShards)
+	case PrefixRangesResolver:
+		addressResolver, err = NewPrefixRangesShardResolver(config.PrefixRanges, numberOfShards)
+	default:
+		return nil, ErrInvalidAddressShardResolverType
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(config.Overrides) == 0 {
+		return addressResolver, nil
+	}
+
+	for _, shardId := range config.Overrides {
+		if shardId >= numberOfShards && shardId != MetachainShardId {
+			return nil, ErrInvalidShardId
+		}
+	}
+
+	return NewOverridesShardResolver(addressResolver, config.Overrides)
+}
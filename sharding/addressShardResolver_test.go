@@ -0,0 +1,192 @@
+package sharding_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPrefixRanges() []sharding.PrefixRange {
+	return []sharding.PrefixRange{
+		{StartPrefix: []byte{0x80}, ShardId: 1},
+		{StartPrefix: []byte{}, ShardId: 0},
+		{StartPrefix: []byte{0xC0, 0x01}, ShardId: 2},
+	}
+}
+
+//------- lastBitsShardResolver
+
+func TestNewLastBitsShardResolver_InvalidNumberOfShardsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lbsr, err := sharding.NewLastBitsShardResolver(0)
+
+	assert.Nil(t, lbsr)
+	assert.Equal(t, sharding.ErrInvalidNumberOfShards, err)
+}
+
+func TestLastBitsShardResolver_ComputeIdShouldMatchMultiShardCoordinator(t *testing.T) {
+	t.Parallel()
+
+	numberOfShards := uint32(5)
+	lbsr, _ := sharding.NewLastBitsShardResolver(numberOfShards)
+	msc, _ := sharding.NewMultiShardCoordinator(numberOfShards, 0)
+
+	for i := uint32(0); i < 200; i++ {
+		addr := getAddressFromUint32(i)
+		assert.Equal(t, msc.ComputeId(addr), lbsr.ComputeId(addr.Bytes()))
+	}
+}
+
+//------- prefixRangesShardResolver
+
+func TestNewPrefixRangesShardResolver_InvalidNumberOfShardsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	prsr, err := sharding.NewPrefixRangesShardResolver(createPrefixRanges(), 0)
+
+	assert.Nil(t, prsr)
+	assert.Equal(t, sharding.ErrInvalidNumberOfShards, err)
+}
+
+func TestNewPrefixRangesShardResolver_EmptyRangesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	prsr, err := sharding.NewPrefixRangesShardResolver(nil, 3)
+
+	assert.Nil(t, prsr)
+	assert.Equal(t, sharding.ErrEmptyPrefixRanges, err)
+}
+
+func TestNewPrefixRangesShardResolver_MissingFirstRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ranges := []sharding.PrefixRange{{StartPrefix: []byte{0x01}, ShardId: 0}}
+	prsr, err := sharding.NewPrefixRangesShardResolver(ranges, 3)
+
+	assert.Nil(t, prsr)
+	assert.Equal(t, sharding.ErrMissingFirstPrefixRange, err)
+}
+
+func TestNewPrefixRangesShardResolver_DuplicatedRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ranges := append(createPrefixRanges(), sharding.PrefixRange{StartPrefix: []byte{0x80}, ShardId: 2})
+	prsr, err := sharding.NewPrefixRangesShardResolver(ranges, 3)
+
+	assert.Nil(t, prsr)
+	assert.Equal(t, sharding.ErrDuplicatedPrefixRange, err)
+}
+
+func TestNewPrefixRangesShardResolver_InvalidShardIdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	prsr, err := sharding.NewPrefixRangesShardResolver(createPrefixRanges(), 2)
+
+	assert.Nil(t, prsr)
+	assert.Equal(t, sharding.ErrInvalidShardId, err)
+}
+
+func TestPrefixRangesShardResolver_ComputeIdShouldUseContainingRange(t *testing.T) {
+	t.Parallel()
+
+	prsr, err := sharding.NewPrefixRangesShardResolver(createPrefixRanges(), 3)
+	assert.Nil(t, err)
+	assert.False(t, prsr.IsInterfaceNil())
+
+	assert.Equal(t, uint32(0), prsr.ComputeId([]byte{0x00, 0xFF}))
+	assert.Equal(t, uint32(0), prsr.ComputeId([]byte{0x7F, 0xFF, 0xFF}))
+	assert.Equal(t, uint32(1), prsr.ComputeId([]byte{0x80}))
+	assert.Equal(t, uint32(1), prsr.ComputeId([]byte{0xC0, 0x00, 0xFF}))
+	assert.Equal(t, uint32(2), prsr.ComputeId([]byte{0xC0, 0x01, 0x00}))
+	assert.Equal(t, uint32(2), prsr.ComputeId([]byte{0xFF, 0xFF}))
+}
+
+//------- overridesShardResolver
+
+func TestNewOverridesShardResolver_NilResolverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	osr, err := sharding.NewOverridesShardResolver(nil, nil)
+
+	assert.Nil(t, osr)
+	assert.Equal(t, sharding.ErrNilAddressShardResolver, err)
+}
+
+func TestOverridesShardResolver_ComputeIdShouldPreferOverrides(t *testing.T) {
+	t.Parallel()
+
+	systemScAddress := []byte{0x00, 0x00, 0x01}
+	lbsr, _ := sharding.NewLastBitsShardResolver(2)
+	overrides := map[string]uint32{string(systemScAddress): sharding.MetachainShardId}
+	osr, err := sharding.NewOverridesShardResolver(lbsr, overrides)
+	assert.Nil(t, err)
+	assert.False(t, osr.IsInterfaceNil())
+
+	overrides[string(systemScAddress)] = 0
+
+	assert.Equal(t, sharding.MetachainShardId, osr.ComputeId(systemScAddress))
+	assert.Equal(t, uint32(1), osr.ComputeId([]byte{0x00, 0x00, 0x03}))
+}
+
+//------- NewAddressShardResolver
+
+func TestNewAddressShardResolver_InvalidTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	asr, err := sharding.NewAddressShardResolver(sharding.AddressShardResolverConfig{Type: "invalid"}, 2)
+
+	assert.Nil(t, asr)
+	assert.Equal(t, sharding.ErrInvalidAddressShardResolverType, err)
+}
+
+func TestNewAddressShardResolver_InvalidOverrideShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	config := sharding.AddressShardResolverConfig{
+		Overrides: map[string]uint32{"address": 2},
+	}
+	asr, err := sharding.NewAddressShardResolver(config, 2)
+
+	assert.Nil(t, asr)
+	assert.Equal(t, sharding.ErrInvalidShardId, err)
+}
+
+func TestNewAddressShardResolver_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	configs := []sharding.AddressShardResolverConfig{
+		{},
+		{Type: sharding.LastBitsResolver},
+		{Type: sharding.PrefixRangesResolver, PrefixRanges: createPrefixRanges()},
+		{Type: sharding.LastBitsResolver, Overrides: map[string]uint32{"address": sharding.MetachainShardId}},
+	}
+	for _, config := range configs {
+		asr, err := sharding.NewAddressShardResolver(config, 3)
+
+		assert.Nil(t, err)
+		assert.False(t, asr.IsInterfaceNil())
+	}
+}
+
+//------- NewMultiShardCoordinatorWithAddressResolver
+
+func TestNewMultiShardCoordinatorWithAddressResolver_NilResolverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	msc, err := sharding.NewMultiShardCoordinatorWithAddressResolver(2, 0, nil)
+
+	assert.Nil(t, msc)
+	assert.Equal(t, sharding.ErrNilAddressShardResolver, err)
+}
+
+func TestMultiShardCoordinator_ComputeIdShouldUseAddressResolver(t *testing.T) {
+	t.Parallel()
+
+	prsr, _ := sharding.NewPrefixRangesShardResolver(createPrefixRanges(), 3)
+	msc, _ := sharding.NewMultiShardCoordinatorWithAddressResolver(3, 0, prsr)
+
+	assert.Equal(t, uint32(2), msc.ComputeId(getAddressFromUint32(0xFFFFFFFE)))
+	assert.Equal(t, uint32(0), msc.ComputeId(getAddressFromUint32(0x00000002)))
+}
@@ -81,3 +81,61 @@ var ErrNilAddress = errors.New("nil address")
 
 // ErrValidatorNotFound signals that the validator has not been found
 var ErrValidatorNotFound = errors.New("validator not found")
+
+// ErrInvalidConsensusGroupSelection signals that an unknown consensus group selection strategy has been provided
+var ErrInvalidConsensusGroupSelection = errors.New("invalid consensus group selection")
+
+// ErrSmallEligibleListSize signals that the eligible list is smaller than the consensus group size
+var ErrSmallEligibleListSize = errors.New("small eligible list size")
+
+// ErrNilAddressShardResolver signals that a nil address shard resolver has been provided
+var ErrNilAddressShardResolver = errors.New("nil address shard resolver")
+
+// ErrInvalidAddressShardResolverType signals that an unknown address shard resolver type has been provided
+var ErrInvalidAddressShardResolverType = errors.New("invalid address shard resolver type")
+
+// ErrEmptyPrefixRanges signals that no prefix range has been provided
+var ErrEmptyPrefixRanges = errors.New("empty prefix ranges")
+
+// ErrMissingFirstPrefixRange signals that no prefix range starts with the empty prefix, so some addresses
+// would not be assigned to any shard
+var ErrMissingFirstPrefixRange = errors.New("missing prefix range starting with the empty prefix")
+
+// ErrDuplicatedPrefixRange signals that two prefix ranges start with the same prefix
+var ErrDuplicatedPrefixRange = errors.New("duplicated prefix range")
+
+// ErrInvalidConsensusThreshold signals that a consensus threshold fraction outside the [0, 1] interval was provided
+var ErrInvalidConsensusThreshold = errors.New("invalid consensus threshold fraction")
+
+// ErrDuplicatedConsensusStartEpoch signals that the consensus has been configured twice for the same epoch
+var ErrDuplicatedConsensusStartEpoch = errors.New("duplicated start epoch in consensus by epoch configuration")
+
+// ErrCouldNotParseDelegationAddress signals that the address of a genesis delegation contract could not be parsed
+var ErrCouldNotParseDelegationAddress = errors.New("could not parse delegation contract address")
+
+// ErrCouldNotParseDelegationOwner signals that the owner of a genesis delegation contract could not be parsed
+var ErrCouldNotParseDelegationOwner = errors.New("could not parse delegation contract owner")
+
+// ErrDuplicatedDelegationAddress signals that the same delegation contract address was declared more than once
+var ErrDuplicatedDelegationAddress = errors.New("duplicated delegation contract address")
+
+// ErrEmptyDelegatorsList signals that a genesis delegation contract has no delegators
+var ErrEmptyDelegatorsList = errors.New("empty delegators list")
+
+// ErrDuplicatedDelegator signals that the same delegator was declared more than once for a delegation contract
+var ErrDuplicatedDelegator = errors.New("duplicated delegator")
+
+// ErrInvalidDelegatedAmount signals that a delegated amount is not a strictly positive number
+var ErrInvalidDelegatedAmount = errors.New("invalid delegated amount")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilPeerAuthenticationsCacher signals that a nil peer authentications cacher has been provided
+var ErrNilPeerAuthenticationsCacher = errors.New("nil peer authentications cacher")
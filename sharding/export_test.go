@@ -1,11 +1,12 @@
 package sharding
 
 func (msc *multiShardCoordinator) CalculateMasks() (uint32, uint32) {
-	return msc.calculateMasks()
+	return calculateMasks(msc.numberOfShards)
 }
 
 func (msc *multiShardCoordinator) Masks() (uint32, uint32) {
-	return msc.maskHigh, msc.maskLow
+	addressResolver := msc.addressResolver.(*lastBitsShardResolver)
+	return addressResolver.maskHigh, addressResolver.maskLow
 }
 
 func (g *Genesis) ProcessConfig() error {
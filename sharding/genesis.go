@@ -10,7 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("sharding")
 
 // InitialBalance holds data from json and decoded data from genesis process
 type InitialBalance struct {
@@ -20,9 +20,60 @@ type InitialBalance struct {
 	balance *big.Int
 }
 
+// InitialDelegator holds the data of one delegator of a genesis delegation contract
+type InitialDelegator struct {
+	PubKey string `json:"pubkey"`
+	Amount string `json:"amount"`
+	pubKey []byte
+	amount *big.Int
+}
+
+// PubKeyBytes returns the decoded public key of the delegator
+func (id *InitialDelegator) PubKeyBytes() []byte {
+	return id.pubKey
+}
+
+// AmountValue returns the decoded delegated amount
+func (id *InitialDelegator) AmountValue() *big.Int {
+	return id.amount
+}
+
+// InitialDelegation holds data from json and decoded data of a delegation contract created at genesis
+type InitialDelegation struct {
+	Address    string              `json:"address"`
+	Owner      string              `json:"owner"`
+	Delegators []*InitialDelegator `json:"delegators"`
+	address    []byte
+	owner      []byte
+}
+
+// AddressBytes returns the decoded address of the delegation contract
+func (id *InitialDelegation) AddressBytes() []byte {
+	return id.address
+}
+
+// OwnerBytes returns the decoded address of the delegation contract owner
+func (id *InitialDelegation) OwnerBytes() []byte {
+	return id.owner
+}
+
+// TotalDelegated returns the sum of all amounts delegated to the contract
+func (id *InitialDelegation) TotalDelegated() *big.Int {
+	total := big.NewInt(0)
+	for _, delegator := range id.Delegators {
+		if delegator.amount == nil {
+			continue
+		}
+		total.Add(total, delegator.amount)
+	}
+
+	return total
+}
+
 // Genesis hold data for decoded data from json file
 type Genesis struct {
-	InitialBalances []*InitialBalance `json:"initialBalances"`
+	InitialBalances []*InitialBalance    `json:"initialBalances"`
+	Delegations     []*InitialDelegation `json:"delegations"`
 }
 
 // NewGenesisConfig creates a new decoded genesis structure from json config file
@@ -63,9 +114,67 @@ func (g *Genesis) processConfig() error {
 		}
 	}
 
+	return g.processDelegations()
+}
+
+func (g *Genesis) processDelegations() error {
+	var err error
+	contracts := make(map[string]struct{})
+
+	for _, delegation := range g.Delegations {
+		delegation.address, err = decodeGenesisAddress(delegation.Address)
+		if err != nil {
+			return ErrCouldNotParseDelegationAddress
+		}
+		delegation.owner, err = decodeGenesisAddress(delegation.Owner)
+		if err != nil {
+			return ErrCouldNotParseDelegationOwner
+		}
+
+		_, found := contracts[string(delegation.address)]
+		if found {
+			return ErrDuplicatedDelegationAddress
+		}
+		contracts[string(delegation.address)] = struct{}{}
+
+		if len(delegation.Delegators) == 0 {
+			return ErrEmptyDelegatorsList
+		}
+
+		delegators := make(map[string]struct{})
+		for _, delegator := range delegation.Delegators {
+			delegator.pubKey, err = decodeGenesisAddress(delegator.PubKey)
+			if err != nil {
+				return ErrCouldNotParsePubKey
+			}
+
+			_, found = delegators[string(delegator.pubKey)]
+			if found {
+				return ErrDuplicatedDelegator
+			}
+			delegators[string(delegator.pubKey)] = struct{}{}
+
+			var ok bool
+			delegator.amount, ok = new(big.Int).SetString(delegator.Amount, 10)
+			if !ok || delegator.amount.Sign() <= 0 {
+				delegator.amount = nil
+				return ErrInvalidDelegatedAmount
+			}
+		}
+	}
+
 	return nil
 }
 
+func decodeGenesisAddress(address string) ([]byte, error) {
+	// decoder treats empty string as correct, it is not allowed to have empty string as address
+	if address == "" {
+		return nil, ErrNilAddress
+	}
+
+	return hex.DecodeString(address)
+}
+
 // InitialNodesBalances - gets the initial balances of the nodes
 func (g *Genesis) InitialNodesBalances(shardCoordinator Coordinator, adrConv state.AddressConverter) (map[string]*big.Int, error) {
 	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
@@ -89,3 +198,43 @@ func (g *Genesis) InitialNodesBalances(shardCoordinator Coordinator, adrConv sta
 
 	return balances, nil
 }
+
+// InitialDelegations returns the delegation contracts that have to be created in the current shard
+func (g *Genesis) InitialDelegations(shardCoordinator Coordinator, adrConv state.AddressConverter) ([]*InitialDelegation, error) {
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if adrConv == nil || adrConv.IsInterfaceNil() {
+		return nil, ErrNilAddressConverter
+	}
+
+	delegations := make([]*InitialDelegation, 0)
+	for _, delegation := range g.Delegations {
+		address, err := adrConv.CreateAddressFromPublicKeyBytes(delegation.address)
+		if err != nil {
+			return nil, err
+		}
+		if shardCoordinator.ComputeId(address) == shardCoordinator.SelfId() {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	return delegations, nil
+}
+
+// TotalSupply returns the sum of all the initial balances and delegated amounts, regardless of the shard
+// they belong to
+func (g *Genesis) TotalSupply() *big.Int {
+	totalSupply := big.NewInt(0)
+	for _, in := range g.InitialBalances {
+		if in.balance == nil {
+			continue
+		}
+		totalSupply.Add(totalSupply, in.balance)
+	}
+	for _, delegation := range g.Delegations {
+		totalSupply.Add(totalSupply, delegation.TotalDelegated())
+	}
+
+	return totalSupply
+}
@@ -192,3 +192,116 @@ func TestGenesis_Initial5NodesBalancesGood(t *testing.T) {
 	assert.Equal(t, 3, len(inBalance))
 	assert.Nil(t, err)
 }
+
+func TestGenesis_TotalSupplyShouldSumAllShards(t *testing.T) {
+	genesis := createGenesisTwoShardTwoNodes()
+
+	assert.Equal(t, big.NewInt(4*999), genesis.TotalSupply())
+}
+
+func TestGenesis_TotalSupplyEmptyGenesisShouldBeZero(t *testing.T) {
+	genesis := sharding.Genesis{}
+
+	assert.Equal(t, big.NewInt(0), genesis.TotalSupply())
+}
+
+func createGenesisWithDelegation() *sharding.Genesis {
+	genesis := createGenesisTwoShardTwoNodes()
+	genesis.Delegations = []*sharding.InitialDelegation{
+		{
+			Address: "5126b6505a73e59a994caa8f556f8c335d4399229de42102bb4814ca261c7401",
+			Owner:   "5126b6505a73e59a994caa8f556f8c335d4399229de42102bb4814ca261c7419",
+			Delegators: []*sharding.InitialDelegator{
+				{
+					PubKey: "5126b6505a73e59a994caa8f556f8c335d4399229de42102bb4814ca261c7418",
+					Amount: "100",
+				},
+				{
+					PubKey: "5126b6505a73e59a994caa8f556f8c335d4399229de42102bb4814ca261c7417",
+					Amount: "200",
+				},
+			},
+		},
+	}
+
+	return genesis
+}
+
+//------- delegations
+
+func TestGenesis_ProcessConfigDelegationInvalidAddressShouldErr(t *testing.T) {
+	genesis := createGenesisWithDelegation()
+	genesis.Delegations[0].Address = "not hex"
+
+	err := genesis.ProcessConfig()
+
+	assert.Equal(t, sharding.ErrCouldNotParseDelegationAddress, err)
+}
+
+func TestGenesis_ProcessConfigDelegationEmptyOwnerShouldErr(t *testing.T) {
+	genesis := createGenesisWithDelegation()
+	genesis.Delegations[0].Owner = ""
+
+	err := genesis.ProcessConfig()
+
+	assert.Equal(t, sharding.ErrCouldNotParseDelegationOwner, err)
+}
+
+func TestGenesis_ProcessConfigDuplicatedDelegationShouldErr(t *testing.T) {
+	genesis := createGenesisWithDelegation()
+	genesis.Delegations = append(genesis.Delegations, genesis.Delegations[0])
+
+	err := genesis.ProcessConfig()
+
+	assert.Equal(t, sharding.ErrDuplicatedDelegationAddress, err)
+}
+
+func TestGenesis_ProcessConfigDelegationWithoutDelegatorsShouldErr(t *testing.T) {
+	genesis := createGenesisWithDelegation()
+	genesis.Delegations[0].Delegators = nil
+
+	err := genesis.ProcessConfig()
+
+	assert.Equal(t, sharding.ErrEmptyDelegatorsList, err)
+}
+
+func TestGenesis_ProcessConfigDuplicatedDelegatorShouldErr(t *testing.T) {
+	genesis := createGenesisWithDelegation()
+	genesis.Delegations[0].Delegators[1].PubKey = genesis.Delegations[0].Delegators[0].PubKey
+
+	err := genesis.ProcessConfig()
+
+	assert.Equal(t, sharding.ErrDuplicatedDelegator, err)
+}
+
+func TestGenesis_ProcessConfigInvalidDelegatedAmountShouldErr(t *testing.T) {
+	genesis := createGenesisWithDelegation()
+	genesis.Delegations[0].Delegators[0].Amount = "-1"
+
+	err := genesis.ProcessConfig()
+
+	assert.Equal(t, sharding.ErrInvalidDelegatedAmount, err)
+}
+
+func TestGenesis_InitialDelegationsShouldReturnOnlySelfShardContracts(t *testing.T) {
+	genesis := createGenesisWithDelegation()
+	err := genesis.ProcessConfig()
+	assert.Nil(t, err)
+
+	adrConv := mock.NewAddressConverterFake(32, "")
+	delegations, err := genesis.InitialDelegations(mock.NewMultipleShardsCoordinatorFake(2, 1), adrConv)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(delegations))
+	assert.Equal(t, big.NewInt(300), delegations[0].TotalDelegated())
+
+	delegations, err = genesis.InitialDelegations(mock.NewMultipleShardsCoordinatorFake(2, 0), adrConv)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(delegations))
+}
+
+func TestGenesis_TotalSupplyShouldIncludeDelegatedAmounts(t *testing.T) {
+	genesis := createGenesisWithDelegation()
+	_ = genesis.ProcessConfig()
+
+	assert.Equal(t, big.NewInt(4*999+300), genesis.TotalSupply())
+}
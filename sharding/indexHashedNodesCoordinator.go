@@ -2,22 +2,21 @@ package sharding
 
 import (
 	"bytes"
-	"encoding/binary"
-	"fmt"
-	"math/big"
-
-	"github.com/ElrondNetwork/elrond-go/core"
-	"github.com/ElrondNetwork/elrond-go/hashing"
+	"math"
+	"sort"
+	"sync"
 )
 
 type indexHashedNodesCoordinator struct {
-	nbShards                uint32
-	shardId                 uint32
-	hasher                  hashing.Hasher
-	nodesMap                map[uint32][]Validator
-	shardConsensusGroupSize int
-	metaConsensusGroupSize  int
-	selfPubKey              []byte
+	nbShards           uint32
+	shardId            uint32
+	validatorsSelector ValidatorsSelector
+	nodesMap           map[uint32][]Validator
+	consensusByEpoch   []ConsensusForEpoch
+	selfPubKey         []byte
+
+	mutConsensus    sync.RWMutex
+	activeConsensus ConsensusForEpoch
 }
 
 // NewIndexHashedNodesCoordinator creates a new index hashed group selector
@@ -27,14 +26,24 @@ func NewIndexHashedNodesCoordinator(arguments ArgNodesCoordinator) (*indexHashed
 		return nil, err
 	}
 
+	validatorsSelector, err := NewValidatorsSelector(arguments.ConsensusGroupSelection, arguments.Hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusByEpoch, err := sortedConsensusByEpoch(arguments)
+	if err != nil {
+		return nil, err
+	}
+
 	ihgs := &indexHashedNodesCoordinator{
-		nbShards:                arguments.NbShards,
-		shardId:                 arguments.ShardId,
-		hasher:                  arguments.Hasher,
-		nodesMap:                make(map[uint32][]Validator),
-		shardConsensusGroupSize: arguments.ShardConsensusGroupSize,
-		metaConsensusGroupSize:  arguments.MetaConsensusGroupSize,
-		selfPubKey:              arguments.SelfPublicKey,
+		nbShards:           arguments.NbShards,
+		shardId:            arguments.ShardId,
+		validatorsSelector: validatorsSelector,
+		nodesMap:           make(map[uint32][]Validator),
+		consensusByEpoch:   consensusByEpoch,
+		selfPubKey:         arguments.SelfPublicKey,
+		activeConsensus:    consensusByEpoch[0],
 	}
 
 	err = ihgs.SetNodesPerShards(arguments.Nodes)
@@ -65,21 +74,62 @@ func checkArguments(arguments ArgNodesCoordinator) error {
 	return nil
 }
 
+// sortedConsensusByEpoch returns the consensus configurations sorted by their start epoch. The genesis one is built
+// from the consensus group sizes of the arguments, unless a configuration for epoch 0 is explicitly provided
+func sortedConsensusByEpoch(arguments ArgNodesCoordinator) ([]ConsensusForEpoch, error) {
+	sorted := make([]ConsensusForEpoch, len(arguments.ConsensusByEpoch))
+	copy(sorted, arguments.ConsensusByEpoch)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].StartEpoch < sorted[j].StartEpoch
+	})
+
+	if len(sorted) == 0 || sorted[0].StartEpoch != 0 {
+		genesisConsensus := ConsensusForEpoch{
+			StartEpoch:              0,
+			ShardConsensusGroupSize: arguments.ShardConsensusGroupSize,
+			MetaConsensusGroupSize:  arguments.MetaConsensusGroupSize,
+		}
+		sorted = append([]ConsensusForEpoch{genesisConsensus}, sorted...)
+	}
+
+	for i := 0; i < len(sorted); i++ {
+		if sorted[i].ShardConsensusGroupSize < 1 || sorted[i].MetaConsensusGroupSize < 1 {
+			return nil, ErrInvalidConsensusGroupSize
+		}
+		if !isValidThresholdFraction(sorted[i].ShardThresholdFraction) ||
+			!isValidThresholdFraction(sorted[i].MetaThresholdFraction) {
+			return nil, ErrInvalidConsensusThreshold
+		}
+		if i > 0 && sorted[i].StartEpoch == sorted[i-1].StartEpoch {
+			return nil, ErrDuplicatedConsensusStartEpoch
+		}
+	}
+
+	return sorted, nil
+}
+
+func isValidThresholdFraction(fraction float64) bool {
+	return fraction >= 0 && fraction <= 1
+}
+
 // SetNodesPerShards loads the distribution of nodes per shard into the nodes management component
 func (ihgs *indexHashedNodesCoordinator) SetNodesPerShards(nodes map[uint32][]Validator) error {
 	if nodes == nil {
 		return ErrNilInputNodesMap
 	}
 
-	nodesList, ok := nodes[MetachainShardId]
-	if ok && len(nodesList) < ihgs.metaConsensusGroupSize {
-		return ErrSmallMetachainEligibleListSize
-	}
+	// the eligible lists have to be large enough for the consensus groups of all the configured epochs
+	for _, consensus := range ihgs.consensusByEpoch {
+		nodesList, ok := nodes[MetachainShardId]
+		if ok && len(nodesList) < consensus.MetaConsensusGroupSize {
+			return ErrSmallMetachainEligibleListSize
+		}
 
-	for shardId := uint32(0); shardId < ihgs.nbShards; shardId++ {
-		nbNodesShard := len(nodes[shardId])
-		if nbNodesShard < ihgs.shardConsensusGroupSize {
-			return ErrSmallShardEligibleListSize
+		for shardId := uint32(0); shardId < ihgs.nbShards; shardId++ {
+			nbNodesShard := len(nodes[shardId])
+			if nbNodesShard < consensus.ShardConsensusGroupSize {
+				return ErrSmallShardEligibleListSize
+			}
 		}
 	}
 
@@ -89,14 +139,7 @@ func (ihgs *indexHashedNodesCoordinator) SetNodesPerShards(nodes map[uint32][]Va
 }
 
 // ComputeValidatorsGroup will generate a list of validators based on the the eligible list,
-// consensus group size and a randomness source
-// Steps:
-// 1. generate expanded eligible list by multiplying entries from shards' eligible list according to stake and rating -> TODO
-// 2. for each value in [0, consensusGroupSize), compute proposedindex = Hash( [index as string] CONCAT randomness) % len(eligible list)
-// 3. if proposed index is already in the temp validator list, then proposedIndex++ (and then % len(eligible list) as to not
-//    exceed the maximum index value permitted by the validator list), and then recheck against temp validator list until
-//    the item at the new proposed index is not found in the list. This new proposed index will be called checked index
-// 4. the item at the checked index is appended in the temp validator list
+// consensus group size and a randomness source, using the configured consensus group selection strategy
 func (ihgs *indexHashedNodesCoordinator) ComputeValidatorsGroup(
 	randomness []byte,
 	round uint64,
@@ -114,21 +157,9 @@ func (ihgs *indexHashedNodesCoordinator) ComputeValidatorsGroup(
 		return nil, ErrNilRandomness
 	}
 
-	tempList := make([]Validator, 0)
 	consensusSize := ihgs.consensusGroupSize(shardId)
-	randomness = []byte(fmt.Sprintf("%d-%s", round, core.ToB64(randomness)))
-
-	// TODO: pre-compute eligible list and update only on rating change.
-	expandedList := ihgs.expandEligibleList(shardId)
-	lenExpandedList := len(expandedList)
-
-	for startIdx := 0; startIdx < consensusSize; startIdx++ {
-		proposedIndex := ihgs.computeListIndex(startIdx, lenExpandedList, string(randomness))
-		checkedIndex := ihgs.checkIndex(proposedIndex, expandedList, tempList)
-		tempList = append(tempList, expandedList[checkedIndex])
-	}
 
-	return tempList, nil
+	return ihgs.validatorsSelector.Select(randomness, round, ihgs.nodesMap[shardId], consensusSize)
 }
 
 // GetValidatorWithPublicKey gets the validator with the given public key
@@ -259,65 +290,60 @@ func (ihgs *indexHashedNodesCoordinator) GetValidatorsIndexes(publicKeys []strin
 	return signersIndexes
 }
 
-func (ihgs *indexHashedNodesCoordinator) expandEligibleList(shardId uint32) []Validator {
-	//TODO implement an expand eligible list variant
-	return ihgs.nodesMap[shardId]
-}
-
-// computeListIndex computes a proposed index from expanded eligible list
-func (ihgs *indexHashedNodesCoordinator) computeListIndex(currentIndex int, lenList int, randomSource string) int {
-	buffCurrentIndex := make([]byte, 8)
-	binary.BigEndian.PutUint64(buffCurrentIndex, uint64(currentIndex))
-
-	indexHash := ihgs.hasher.Compute(string(buffCurrentIndex) + randomSource)
-
-	computedLargeIndex := big.NewInt(0)
-	computedLargeIndex.SetBytes(indexHash)
-	lenExpandedEligibleList := big.NewInt(int64(lenList))
-
-	// computedListIndex = computedLargeIndex % len(expandedEligibleList)
-	computedListIndex := big.NewInt(0).Mod(computedLargeIndex, lenExpandedEligibleList).Int64()
+func (ihgs *indexHashedNodesCoordinator) consensusGroupSize(shardId uint32) int {
+	consensus := ihgs.currentConsensus()
+	if shardId == MetachainShardId {
+		return consensus.MetaConsensusGroupSize
+	}
 
-	return int(computedListIndex)
+	return consensus.ShardConsensusGroupSize
 }
 
-// checkIndex returns a checked index starting from a proposed index
-func (ihgs *indexHashedNodesCoordinator) checkIndex(
-	proposedIndex int,
-	eligibleList []Validator,
-	selectedList []Validator,
-) int {
+func (ihgs *indexHashedNodesCoordinator) currentConsensus() ConsensusForEpoch {
+	ihgs.mutConsensus.RLock()
+	defer ihgs.mutConsensus.RUnlock()
 
-	for {
-		v := eligibleList[proposedIndex]
+	return ihgs.activeConsensus
+}
 
-		if ihgs.validatorIsInList(v, selectedList) {
-			proposedIndex++
-			proposedIndex = proposedIndex % len(eligibleList)
-			continue
+// EpochConfirmed is called whenever a new epoch is confirmed and switches to the consensus group sizes and
+// thresholds configured for that epoch
+func (ihgs *indexHashedNodesCoordinator) EpochConfirmed(epoch uint32) {
+	consensus := ihgs.consensusByEpoch[0]
+	for _, consensusForEpoch := range ihgs.consensusByEpoch {
+		if consensusForEpoch.StartEpoch > epoch {
+			break
 		}
-
-		return proposedIndex
+		consensus = consensusForEpoch
 	}
+
+	ihgs.mutConsensus.Lock()
+	ihgs.activeConsensus = consensus
+	ihgs.mutConsensus.Unlock()
 }
 
-// validatorIsInList returns true if a validator has been found in provided list
-func (ihgs *indexHashedNodesCoordinator) validatorIsInList(v Validator, list []Validator) bool {
-	for i := 0; i < len(list); i++ {
-		if bytes.Equal(v.PubKey(), list[i].PubKey()) {
-			return true
-		}
+// ConsensusGroupSize returns the consensus group size of the given shard in the current epoch
+func (ihgs *indexHashedNodesCoordinator) ConsensusGroupSize(shardId uint32) int {
+	return ihgs.consensusGroupSize(shardId)
+}
+
+// ConsensusThreshold returns the minimum number of consensus group members of the given shard which have to sign
+// a block in the current epoch
+func (ihgs *indexHashedNodesCoordinator) ConsensusThreshold(shardId uint32) int {
+	consensus := ihgs.currentConsensus()
+	if shardId == MetachainShardId {
+		return computeConsensusThreshold(consensus.MetaConsensusGroupSize, consensus.MetaThresholdFraction)
 	}
 
-	return false
+	return computeConsensusThreshold(consensus.ShardConsensusGroupSize, consensus.ShardThresholdFraction)
 }
 
-func (ihgs *indexHashedNodesCoordinator) consensusGroupSize(shardId uint32) int {
-	if shardId == MetachainShardId {
-		return ihgs.metaConsensusGroupSize
+func computeConsensusThreshold(consensusGroupSize int, fraction float64) int {
+	if fraction == 0 {
+		return consensusGroupSize*2/3 + 1
 	}
 
-	return ihgs.shardConsensusGroupSize
+	return int(math.Ceil(float64(consensusGroupSize) * fraction))
 }
 
 // GetOwnPublicKey will return current node public key  for block sign
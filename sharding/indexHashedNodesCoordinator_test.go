@@ -71,11 +71,12 @@ func TestNewIndexHashedGroupSelector_InvalidConsensusGroupSizeShouldErr(t *testi
 
 	nodesMap := createDummyNodesMap()
 	arguments := sharding.ArgNodesCoordinator{
-		MetaConsensusGroupSize: 1,
-		Hasher:                 &mock.HasherMock{},
-		NbShards:               1,
-		Nodes:                  nodesMap,
-		SelfPublicKey:          []byte("key"),
+		MetaConsensusGroupSize:  1,
+		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
+		NbShards:                1,
+		Nodes:                   nodesMap,
+		SelfPublicKey:           []byte("key"),
 	}
 	ihgs, err := sharding.NewIndexHashedNodesCoordinator(arguments)
 
@@ -89,6 +90,7 @@ func TestNewIndexHashedNodesCoordinator_ZeroNbShardsShouldErr(t *testing.T) {
 		ShardConsensusGroupSize: 1,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                0,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -105,6 +107,7 @@ func TestNewIndexHashedNodesCoordinator_InvalidShardIdShouldErr(t *testing.T) {
 		ShardConsensusGroupSize: 1,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		ShardId:                 2,
 		NbShards:                1,
 		Nodes:                   nodesMap,
@@ -122,6 +125,7 @@ func TestNewIndexHashedNodesCoordinator_NilSelfPublicKeyShouldErr(t *testing.T)
 		ShardConsensusGroupSize: 1,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           nil,
@@ -132,6 +136,25 @@ func TestNewIndexHashedNodesCoordinator_NilSelfPublicKeyShouldErr(t *testing.T)
 	assert.Equal(t, sharding.ErrNilPubKey, err)
 }
 
+func TestNewIndexHashedNodesCoordinator_InvalidConsensusGroupSelectionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodesMap := createDummyNodesMap()
+	arguments := sharding.ArgNodesCoordinator{
+		ShardConsensusGroupSize: 1,
+		MetaConsensusGroupSize:  1,
+		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: "invalid",
+		NbShards:                1,
+		Nodes:                   nodesMap,
+		SelfPublicKey:           []byte("key"),
+	}
+	ihgs, err := sharding.NewIndexHashedNodesCoordinator(arguments)
+
+	assert.Nil(t, ihgs)
+	assert.Equal(t, sharding.ErrInvalidConsensusGroupSelection, err)
+}
+
 func TestNewIndexHashedGroupSelector_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -140,6 +163,7 @@ func TestNewIndexHashedGroupSelector_OkValsShouldWork(t *testing.T) {
 		ShardConsensusGroupSize: 1,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -160,6 +184,7 @@ func TestIndexHashedGroupSelector_SetNilNodesMapShouldErr(t *testing.T) {
 		ShardConsensusGroupSize: 2,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -177,6 +202,7 @@ func TestIndexHashedGroupSelector_OkValShouldWork(t *testing.T) {
 		ShardConsensusGroupSize: 2,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -194,11 +220,12 @@ func TestIndexHashedGroupSelector_NewCoordinatorGroup0SizeShouldErr(t *testing.T
 
 	nodesMap := createDummyNodesMap()
 	arguments := sharding.ArgNodesCoordinator{
-		MetaConsensusGroupSize: 1,
-		Hasher:                 &mock.HasherMock{},
-		NbShards:               1,
-		Nodes:                  nodesMap,
-		SelfPublicKey:          []byte("key"),
+		MetaConsensusGroupSize:  1,
+		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
+		NbShards:                1,
+		Nodes:                   nodesMap,
+		SelfPublicKey:           []byte("key"),
 	}
 	ihgs, err := sharding.NewIndexHashedNodesCoordinator(arguments)
 
@@ -214,6 +241,7 @@ func TestIndexHashedGroupSelector_NewCoordinatorTooFewNodesShouldErr(t *testing.
 		ShardConsensusGroupSize: 10,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -232,6 +260,7 @@ func TestIndexHashedGroupSelector_ComputeValidatorsGroupNilRandomnessShouldErr(t
 		ShardConsensusGroupSize: 2,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -251,6 +280,7 @@ func TestIndexHashedGroupSelector_ComputeValidatorsGroupInvalidShardIdShouldErr(
 		ShardConsensusGroupSize: 2,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -277,6 +307,7 @@ func TestIndexHashedGroupSelector_ComputeValidatorsGroup1ValidatorShouldReturnSa
 		ShardConsensusGroupSize: 1,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -315,6 +346,7 @@ func TestIndexHashedGroupSelector_ComputeValidatorsGroupTest2Validators(t *testi
 		ShardConsensusGroupSize: 2,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  hasher,
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -366,6 +398,7 @@ func TestIndexHashedGroupSelector_ComputeValidatorsGroupTest2ValidatorsRevertOrd
 		ShardConsensusGroupSize: 2,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  hasher,
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -406,6 +439,7 @@ func TestIndexHashedGroupSelector_ComputeValidatorsGroupTest2ValidatorsSameIndex
 		ShardConsensusGroupSize: 2,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  hasher,
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -485,6 +519,7 @@ func TestIndexHashedGroupSelector_ComputeValidatorsGroupTest6From10ValidatorsSho
 		ShardConsensusGroupSize: 6,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  hasher,
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -520,6 +555,7 @@ func BenchmarkIndexHashedGroupSelector_ComputeValidatorsGroup21of400(b *testing.
 		ShardConsensusGroupSize: consensusGroupSize,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -548,6 +584,7 @@ func TestIndexHashedGroupSelector_GetValidatorWithPublicKeyShouldReturnErrNilPub
 		ShardConsensusGroupSize: 1,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -571,6 +608,7 @@ func TestIndexHashedGroupSelector_GetValidatorWithPublicKeyShouldReturnErrValida
 		ShardConsensusGroupSize: 1,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                1,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -609,6 +647,7 @@ func TestIndexHashedGroupSelector_GetValidatorWithPublicKeyShouldWork(t *testing
 		ShardConsensusGroupSize: 1,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		NbShards:                2,
 		Nodes:                   nodesMap,
 		SelfPublicKey:           []byte("key"),
@@ -667,6 +706,7 @@ func TestIndexHashedGroupSelector_GetAllValidatorsPublicKeys(t *testing.T) {
 		ShardConsensusGroupSize: 1,
 		MetaConsensusGroupSize:  1,
 		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
 		ShardId:                 shardZeroId,
 		NbShards:                2,
 		Nodes:                   nodesMap,
@@ -678,3 +718,112 @@ func TestIndexHashedGroupSelector_GetAllValidatorsPublicKeys(t *testing.T) {
 	allValidatorsPublicKeys := ihgs.GetAllValidatorsPublicKeys()
 	assert.Equal(t, expectedValidatorsPubKeys, allValidatorsPublicKeys)
 }
+
+//------- ConsensusByEpoch
+
+func createArgumentsWithConsensusByEpoch(consensusByEpoch []sharding.ConsensusForEpoch) sharding.ArgNodesCoordinator {
+	return sharding.ArgNodesCoordinator{
+		ShardConsensusGroupSize: 1,
+		MetaConsensusGroupSize:  1,
+		Hasher:                  &mock.HasherMock{},
+		ConsensusGroupSelection: sharding.RandomSelection,
+		NbShards:                1,
+		Nodes:                   createDummyNodesMap(),
+		SelfPublicKey:           []byte("key"),
+		ConsensusByEpoch:        consensusByEpoch,
+	}
+}
+
+func TestNewIndexHashedNodesCoordinator_InvalidConsensusByEpochGroupSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createArgumentsWithConsensusByEpoch([]sharding.ConsensusForEpoch{
+		{StartEpoch: 5, ShardConsensusGroupSize: 0, MetaConsensusGroupSize: 1},
+	})
+	ihgs, err := sharding.NewIndexHashedNodesCoordinator(arguments)
+
+	assert.Nil(t, ihgs)
+	assert.Equal(t, sharding.ErrInvalidConsensusGroupSize, err)
+}
+
+func TestNewIndexHashedNodesCoordinator_InvalidThresholdFractionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createArgumentsWithConsensusByEpoch([]sharding.ConsensusForEpoch{
+		{StartEpoch: 5, ShardConsensusGroupSize: 1, MetaConsensusGroupSize: 1, MetaThresholdFraction: 1.5},
+	})
+	ihgs, err := sharding.NewIndexHashedNodesCoordinator(arguments)
+
+	assert.Nil(t, ihgs)
+	assert.Equal(t, sharding.ErrInvalidConsensusThreshold, err)
+}
+
+func TestNewIndexHashedNodesCoordinator_DuplicatedStartEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createArgumentsWithConsensusByEpoch([]sharding.ConsensusForEpoch{
+		{StartEpoch: 5, ShardConsensusGroupSize: 1, MetaConsensusGroupSize: 1},
+		{StartEpoch: 5, ShardConsensusGroupSize: 2, MetaConsensusGroupSize: 2},
+	})
+	ihgs, err := sharding.NewIndexHashedNodesCoordinator(arguments)
+
+	assert.Nil(t, ihgs)
+	assert.Equal(t, sharding.ErrDuplicatedConsensusStartEpoch, err)
+}
+
+func TestNewIndexHashedNodesCoordinator_ConsensusByEpochTooFewNodesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createArgumentsWithConsensusByEpoch([]sharding.ConsensusForEpoch{
+		{StartEpoch: 5, ShardConsensusGroupSize: 3, MetaConsensusGroupSize: 1},
+	})
+	ihgs, err := sharding.NewIndexHashedNodesCoordinator(arguments)
+
+	assert.Nil(t, ihgs)
+	assert.Equal(t, sharding.ErrSmallShardEligibleListSize, err)
+}
+
+func TestIndexHashedGroupSelector_EpochConfirmedShouldSwitchConsensus(t *testing.T) {
+	t.Parallel()
+
+	arguments := createArgumentsWithConsensusByEpoch([]sharding.ConsensusForEpoch{
+		{StartEpoch: 10, ShardConsensusGroupSize: 2, MetaConsensusGroupSize: 1, ShardThresholdFraction: 0.5},
+		{StartEpoch: 5, ShardConsensusGroupSize: 2, MetaConsensusGroupSize: 2},
+	})
+	ihgs, _ := sharding.NewIndexHashedNodesCoordinator(arguments)
+
+	assert.Equal(t, 1, ihgs.ConsensusGroupSize(0))
+	assert.Equal(t, 1, ihgs.ConsensusThreshold(0))
+	assert.Equal(t, 1, ihgs.ConsensusGroupSize(sharding.MetachainShardId))
+
+	ihgs.EpochConfirmed(7)
+	assert.Equal(t, 2, ihgs.ConsensusGroupSize(0))
+	assert.Equal(t, 2, ihgs.ConsensusThreshold(0))
+	assert.Equal(t, 2, ihgs.ConsensusGroupSize(sharding.MetachainShardId))
+	assert.Equal(t, 2, ihgs.ConsensusThreshold(sharding.MetachainShardId))
+
+	ihgs.EpochConfirmed(10)
+	assert.Equal(t, 2, ihgs.ConsensusGroupSize(0))
+	assert.Equal(t, 1, ihgs.ConsensusThreshold(0))
+	assert.Equal(t, 1, ihgs.ConsensusGroupSize(sharding.MetachainShardId))
+
+	validators, err := ihgs.ComputeValidatorsGroup([]byte("randomness"), 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(validators))
+
+	// blocks can be reverted, so the epoch can go back
+	ihgs.EpochConfirmed(0)
+	assert.Equal(t, 1, ihgs.ConsensusGroupSize(0))
+}
+
+func TestIndexHashedGroupSelector_ConsensusByEpochForGenesisOverridesGroupSizes(t *testing.T) {
+	t.Parallel()
+
+	arguments := createArgumentsWithConsensusByEpoch([]sharding.ConsensusForEpoch{
+		{StartEpoch: 0, ShardConsensusGroupSize: 2, MetaConsensusGroupSize: 2, MetaThresholdFraction: 1},
+	})
+	ihgs, _ := sharding.NewIndexHashedNodesCoordinator(arguments)
+
+	assert.Equal(t, 2, ihgs.ConsensusGroupSize(0))
+	assert.Equal(t, 2, ihgs.ConsensusThreshold(sharding.MetachainShardId))
+}
@@ -19,6 +19,12 @@ type Coordinator interface {
 	IsInterfaceNil() bool
 }
 
+// AddressShardResolver defines the rule by which an address is assigned to a shard
+type AddressShardResolver interface {
+	ComputeId(address []byte) uint32
+	IsInterfaceNil() bool
+}
+
 // Validator defines a node that can be allocated to a shard for participation in a consensus group as validator
 // or block proposer
 type Validator interface {
@@ -34,6 +40,15 @@ type NodesCoordinator interface {
 	SetNodesPerShards(nodes map[uint32][]Validator) error
 	ComputeValidatorsGroup(randomness []byte, round uint64, shardId uint32) (validatorsGroup []Validator, err error)
 	GetValidatorWithPublicKey(publicKey []byte) (validator Validator, shardId uint32, err error)
+	ConsensusGroupSize(shardId uint32) int
+	ConsensusThreshold(shardId uint32) int
+	IsInterfaceNil() bool
+}
+
+// ValidatorsSelector defines a consensus group selection strategy, which has to return the same group when called
+// with the same randomness, round and eligible list
+type ValidatorsSelector interface {
+	Select(randomness []byte, round uint64, eligibleList []Validator, consensusSize int) ([]Validator, error)
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,72 @@
+package sharding
+
+import (
+	"math"
+)
+
+// lastBitsShardResolver assigns an address to a shard using as many of its last bits as needed to represent
+// the number of shards
+type lastBitsShardResolver struct {
+	maskHigh       uint32
+	maskLow        uint32
+	numberOfShards uint32
+}
+
+// NewLastBitsShardResolver returns a new lastBitsShardResolver and initializes the masks
+func NewLastBitsShardResolver(numberOfShards uint32) (*lastBitsShardResolver, error) {
+	if numberOfShards < 1 {
+		return nil, ErrInvalidNumberOfShards
+	}
+
+	lbsr := &lastBitsShardResolver{
+		numberOfShards: numberOfShards,
+	}
+	lbsr.maskHigh, lbsr.maskLow = calculateMasks(numberOfShards)
+
+	return lbsr, nil
+}
+
+// calculateMasks will create two numbers who's binary form is composed from as many
+// ones needed to be taken into consideration for the shard assignment. The result
+// of a bitwise AND operation of an address with this mask will result in the
+// shard id where a transaction from that address will be dispatched
+func calculateMasks(numberOfShards uint32) (uint32, uint32) {
+	n := math.Ceil(math.Log2(float64(numberOfShards)))
+	return (1 << uint(n)) - 1, (1 << uint(n-1)) - 1
+}
+
+// ComputeId calculates the shard for a given address
+func (lbsr *lastBitsShardResolver) ComputeId(address []byte) uint32 {
+	bytesNeed := int(lbsr.numberOfShards/256) + 1
+	startingIndex := 0
+	if len(address) > bytesNeed {
+		startingIndex = len(address) - bytesNeed
+	}
+
+	buffNeeded := address[startingIndex:]
+
+	addr := uint32(0)
+	for i := 0; i < len(buffNeeded); i++ {
+		addr = addr<<8 + uint32(buffNeeded[i])
+	}
+
+	return lbsr.computeIdFromValue(addr)
+}
+
+// computeIdFromValue applies the shard masks on the last bytes of an address
+func (lbsr *lastBitsShardResolver) computeIdFromValue(addr uint32) uint32 {
+	shard := addr & lbsr.maskHigh
+	if shard > lbsr.numberOfShards-1 {
+		shard = addr & lbsr.maskLow
+	}
+
+	return shard
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lbsr *lastBitsShardResolver) IsInterfaceNil() bool {
+	if lbsr == nil {
+		return true
+	}
+	return false
+}
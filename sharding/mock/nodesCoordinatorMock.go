@@ -50,16 +50,16 @@ func (ncm NodesCoordinatorMock) GetValidatorsPublicKeys(randomness []byte) ([]st
 	return pubKeys, nil
 }
 
-func (ncm NodesCoordinatorMock) ConsensusGroupSize() int {
+func (ncm NodesCoordinatorMock) ConsensusGroupSize(shardId uint32) int {
 	panic("implement me")
 }
 
-func (ncm NodesCoordinatorMock) SetNodesPerShards(map[uint32][]sharding.Validator) error {
-	return nil
+func (ncm NodesCoordinatorMock) ConsensusThreshold(shardId uint32) int {
+	panic("implement me")
 }
 
-func (ncm NodesCoordinatorMock) SetConsensusGroupSize(int) error {
-	panic("implement me")
+func (ncm NodesCoordinatorMock) SetNodesPerShards(map[uint32][]sharding.Validator) error {
+	return nil
 }
 
 func (ncm NodesCoordinatorMock) GetSelectedPublicKeys(selection []byte) (publicKeys []string, err error) {
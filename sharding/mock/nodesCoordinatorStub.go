@@ -0,0 +1,64 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+type NodesCoordinatorStub struct {
+	GetValidatorWithPublicKeyCalled func(publicKey []byte) (sharding.Validator, uint32, error)
+}
+
+func (ncs *NodesCoordinatorStub) GetValidatorsIndexes(_ []string) []uint64 {
+	return nil
+}
+
+func (ncs *NodesCoordinatorStub) GetAllValidatorsPublicKeys() map[uint32][][]byte {
+	return nil
+}
+
+func (ncs *NodesCoordinatorStub) GetSelectedPublicKeys(_ []byte, _ uint32) ([]string, error) {
+	return nil, nil
+}
+
+func (ncs *NodesCoordinatorStub) GetValidatorsPublicKeys(_ []byte, _ uint64, _ uint32) ([]string, error) {
+	return nil, nil
+}
+
+func (ncs *NodesCoordinatorStub) GetValidatorsRewardsAddresses(_ []byte, _ uint64, _ uint32) ([]string, error) {
+	return nil, nil
+}
+
+func (ncs *NodesCoordinatorStub) GetOwnPublicKey() []byte {
+	return nil
+}
+
+func (ncs *NodesCoordinatorStub) SetNodesPerShards(_ map[uint32][]sharding.Validator) error {
+	return nil
+}
+
+func (ncs *NodesCoordinatorStub) ComputeValidatorsGroup(_ []byte, _ uint64, _ uint32) ([]sharding.Validator, error) {
+	return nil, nil
+}
+
+func (ncs *NodesCoordinatorStub) GetValidatorWithPublicKey(publicKey []byte) (sharding.Validator, uint32, error) {
+	if ncs.GetValidatorWithPublicKeyCalled != nil {
+		return ncs.GetValidatorWithPublicKeyCalled(publicKey)
+	}
+	return nil, 0, nil
+}
+
+func (ncs *NodesCoordinatorStub) ConsensusGroupSize(_ uint32) int {
+	return 0
+}
+
+func (ncs *NodesCoordinatorStub) ConsensusThreshold(_ uint32) int {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncs *NodesCoordinatorStub) IsInterfaceNil() bool {
+	if ncs == nil {
+		return true
+	}
+	return false
+}
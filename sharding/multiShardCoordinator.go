@@ -3,7 +3,6 @@ package sharding
 import (
 	"bytes"
 	"fmt"
-	"math"
 
 	"github.com/ElrondNetwork/elrond-go/data/state"
 )
@@ -14,38 +13,46 @@ const metaChainIdentifier uint8 = 255
 // the corresponding shards. The number of shards is currently passed as a constructor
 // parameter and later it should be calculated by this structure
 type multiShardCoordinator struct {
-	maskHigh       uint32
-	maskLow        uint32
-	selfId         uint32
-	numberOfShards uint32
+	selfId          uint32
+	numberOfShards  uint32
+	addressResolver AddressShardResolver
 }
 
-// NewMultiShardCoordinator returns a new multiShardCoordinator and initializes the masks
+// NewMultiShardCoordinator returns a new multiShardCoordinator which assigns addresses to shards using their last bits
 func NewMultiShardCoordinator(numberOfShards, selfId uint32) (*multiShardCoordinator, error) {
+	addressResolver, err := NewLastBitsShardResolver(numberOfShards)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMultiShardCoordinatorWithAddressResolver(numberOfShards, selfId, addressResolver)
+}
+
+// NewMultiShardCoordinatorWithAddressResolver returns a new multiShardCoordinator which assigns addresses to shards
+// using the provided address shard resolver
+func NewMultiShardCoordinatorWithAddressResolver(
+	numberOfShards uint32,
+	selfId uint32,
+	addressResolver AddressShardResolver,
+) (*multiShardCoordinator, error) {
 	if numberOfShards < 1 {
 		return nil, ErrInvalidNumberOfShards
 	}
 	if selfId >= numberOfShards && selfId != MetachainShardId {
 		return nil, ErrInvalidShardId
 	}
+	if addressResolver == nil || addressResolver.IsInterfaceNil() {
+		return nil, ErrNilAddressShardResolver
+	}
 
 	sr := &multiShardCoordinator{}
 	sr.selfId = selfId
 	sr.numberOfShards = numberOfShards
-	sr.maskHigh, sr.maskLow = sr.calculateMasks()
+	sr.addressResolver = addressResolver
 
 	return sr, nil
 }
 
-// calculateMasks will create two numbers who's binary form is composed from as many
-// ones needed to be taken into consideration for the shard assignment. The result
-// of a bitwise AND operation of an address with this mask will result in the
-// shard id where a transaction from that address will be dispatched
-func (msc *multiShardCoordinator) calculateMasks() (uint32, uint32) {
-	n := math.Ceil(math.Log2(float64(msc.numberOfShards)))
-	return (1 << uint(n)) - 1, (1 << uint(n-1)) - 1
-}
-
 //TODO: This method should be changed, as value 0xFF in the last byte of the given address could exist also in shards
 func isMetaChainShardId(identifier []byte) bool {
 	for i := 0; i < len(identifier); i++ {
@@ -59,25 +66,7 @@ func isMetaChainShardId(identifier []byte) bool {
 
 // ComputeId calculates the shard for a given address used for transaction dispatching
 func (msc *multiShardCoordinator) ComputeId(address state.AddressContainer) uint32 {
-	bytesNeed := int(msc.numberOfShards/256) + 1
-	startingIndex := 0
-	if len(address.Bytes()) > bytesNeed {
-		startingIndex = len(address.Bytes()) - bytesNeed
-	}
-
-	buffNeeded := address.Bytes()[startingIndex:]
-
-	addr := uint32(0)
-	for i := 0; i < len(buffNeeded); i++ {
-		addr = addr<<8 + uint32(buffNeeded[i])
-	}
-
-	shard := addr & msc.maskHigh
-	if shard > msc.numberOfShards-1 {
-		shard = addr & msc.maskLow
-	}
-
-	return shard
+	return msc.addressResolver.ComputeId(address.Bytes())
 }
 
 // NumberOfShards returns the number of shards
@@ -0,0 +1,156 @@
+package networksharding
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("sharding/networksharding")
+
+// ArgPeerShardMapper is used to store all components that are needed to create a new PeerShardMapper
+type ArgPeerShardMapper struct {
+	NodesCoordinator    sharding.NodesCoordinator
+	ShardCoordinator    sharding.Coordinator
+	PeerAuthentications storage.Cacher
+	Storer              storage.Storer
+	Marshalizer         marshal.Marshalizer
+}
+
+// peerShardData is the persisted mapping of a peer ID
+type peerShardData struct {
+	PublicKey []byte
+	ShardID   uint32
+}
+
+// PeerShardMapper maps the peer IDs to the public keys and the shards of the validators behind them. The mappings are
+// learned from the peer authentication messages, which are signed by the validators keys and carry the peer IDs, and
+// are persisted so they are known right after a restart, before the peers authenticate again. The heartbeats are not
+// used as their signatures do not cover the peer IDs, so a relayed heartbeat would map the validator to the relayer
+type PeerShardMapper struct {
+	nodesCoordinator sharding.NodesCoordinator
+	shardCoordinator sharding.Coordinator
+	peerAuths        storage.Cacher
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+}
+
+// NewPeerShardMapper creates a new PeerShardMapper instance which learns the mappings from the received peer
+// authentication messages
+func NewPeerShardMapper(arg ArgPeerShardMapper) (*PeerShardMapper, error) {
+	if check.IfNil(arg.NodesCoordinator) {
+		return nil, sharding.ErrNilNodesCoordinator
+	}
+	if check.IfNil(arg.ShardCoordinator) {
+		return nil, sharding.ErrNilShardCoordinator
+	}
+	if check.IfNil(arg.PeerAuthentications) {
+		return nil, sharding.ErrNilPeerAuthenticationsCacher
+	}
+	if check.IfNil(arg.Storer) {
+		return nil, sharding.ErrNilStorer
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, sharding.ErrNilMarshalizer
+	}
+
+	psm := &PeerShardMapper{
+		nodesCoordinator: arg.NodesCoordinator,
+		shardCoordinator: arg.ShardCoordinator,
+		peerAuths:        arg.PeerAuthentications,
+		storer:           arg.Storer,
+		marshalizer:      arg.Marshalizer,
+	}
+	psm.peerAuths.RegisterHandler(psm.peerAuthenticationAdded)
+
+	return psm, nil
+}
+
+func (psm *PeerShardMapper) peerAuthenticationAdded(key []byte) {
+	value, ok := psm.peerAuths.Peek(key)
+	if !ok {
+		return
+	}
+
+	peerAuth, ok := value.(*heartbeat.PeerAuthentication)
+	if !ok {
+		return
+	}
+
+	err := psm.UpdatePeerIdPublicKey(p2p.PeerID(peerAuth.Pid), peerAuth.Pubkey)
+	if err != nil {
+		log.Debug("peer shard mapper update", "error", err.Error())
+	}
+}
+
+// UpdatePeerIdPublicKey maps the peer ID to the provided validator public key and to the validator's current shard
+func (psm *PeerShardMapper) UpdatePeerIdPublicKey(pid p2p.PeerID, pk []byte) error {
+	_, shardID, err := psm.nodesCoordinator.GetValidatorWithPublicKey(pk)
+	if err != nil {
+		return err
+	}
+
+	buff, err := psm.marshalizer.Marshal(&peerShardData{
+		PublicKey: pk,
+		ShardID:   shardID,
+	})
+	if err != nil {
+		return err
+	}
+
+	//the validators authenticate periodically, so the unchanged mappings are not written again
+	existingBuff, err := psm.storer.Get(pid.Bytes())
+	if err == nil && bytes.Equal(existingBuff, buff) {
+		return nil
+	}
+
+	return psm.storer.Put(pid.Bytes(), buff)
+}
+
+// GetPeerInfo returns the validator public key and the shard the peer is mapped to or an unknown peer info if the
+// peer was not mapped. The mapped key is checked against the current validators set, as the persisted mapping might
+// predate an epoch change which removed the validator or moved it to another shard
+func (psm *PeerShardMapper) GetPeerInfo(pid p2p.PeerID) p2p.PeerInfo {
+	unknownPeerInfo := p2p.PeerInfo{PeerType: p2p.UnknownPeer}
+
+	buff, err := psm.storer.Get(pid.Bytes())
+	if err != nil {
+		return unknownPeerInfo
+	}
+
+	data := &peerShardData{}
+	err = psm.marshalizer.Unmarshal(data, buff)
+	if err != nil {
+		return unknownPeerInfo
+	}
+
+	_, shardID, err := psm.nodesCoordinator.GetValidatorWithPublicKey(data.PublicKey)
+	if err != nil {
+		return unknownPeerInfo
+	}
+
+	peerType := p2p.CrossShardPeer
+	if shardID == psm.shardCoordinator.SelfId() {
+		peerType = p2p.IntraShardPeer
+	}
+
+	return p2p.PeerInfo{
+		PeerType:  peerType,
+		PublicKey: data.PublicKey,
+		ShardID:   shardID,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (psm *PeerShardMapper) IsInterfaceNil() bool {
+	if psm == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,219 @@
+package networksharding_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/sharding/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding/networksharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+const selfShardId = uint32(0)
+
+var errNotValidator = errors.New("not a validator")
+
+func createStorer() storage.Storer {
+	cacher, _ := lrucache.NewCache(10)
+	memDB, _ := memorydb.New()
+	storer, _ := storageUnit.NewStorageUnit(cacher, memDB)
+
+	return storer
+}
+
+func createMockArgPeerShardMapper() networksharding.ArgPeerShardMapper {
+	validatorsShards := map[string]uint32{
+		"intra pk": selfShardId,
+		"cross pk": 1,
+	}
+
+	return createMockArgPeerShardMapperWithValidators(validatorsShards)
+}
+
+func createMockArgPeerShardMapperWithValidators(validatorsShards map[string]uint32) networksharding.ArgPeerShardMapper {
+	peerAuths, _ := lrucache.NewCache(10)
+
+	return networksharding.ArgPeerShardMapper{
+		NodesCoordinator: &mock.NodesCoordinatorStub{
+			GetValidatorWithPublicKeyCalled: func(publicKey []byte) (sharding.Validator, uint32, error) {
+				shardId, ok := validatorsShards[string(publicKey)]
+				if !ok {
+					return nil, 0, errNotValidator
+				}
+				return nil, shardId, nil
+			},
+		},
+		ShardCoordinator:    mock.NewMultipleShardsCoordinatorFake(2, selfShardId),
+		PeerAuthentications: peerAuths,
+		Storer:              createStorer(),
+		Marshalizer:         &marshal.JsonMarshalizer{},
+	}
+}
+
+//------- NewPeerShardMapper
+
+func TestNewPeerShardMapper_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerShardMapper()
+	arg.NodesCoordinator = nil
+	psm, err := networksharding.NewPeerShardMapper(arg)
+
+	assert.Nil(t, psm)
+	assert.Equal(t, sharding.ErrNilNodesCoordinator, err)
+}
+
+func TestNewPeerShardMapper_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerShardMapper()
+	arg.ShardCoordinator = nil
+	psm, err := networksharding.NewPeerShardMapper(arg)
+
+	assert.Nil(t, psm)
+	assert.Equal(t, sharding.ErrNilShardCoordinator, err)
+}
+
+func TestNewPeerShardMapper_NilPeerAuthenticationsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerShardMapper()
+	arg.PeerAuthentications = nil
+	psm, err := networksharding.NewPeerShardMapper(arg)
+
+	assert.Nil(t, psm)
+	assert.Equal(t, sharding.ErrNilPeerAuthenticationsCacher, err)
+}
+
+func TestNewPeerShardMapper_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerShardMapper()
+	arg.Storer = nil
+	psm, err := networksharding.NewPeerShardMapper(arg)
+
+	assert.Nil(t, psm)
+	assert.Equal(t, sharding.ErrNilStorer, err)
+}
+
+func TestNewPeerShardMapper_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerShardMapper()
+	arg.Marshalizer = nil
+	psm, err := networksharding.NewPeerShardMapper(arg)
+
+	assert.Nil(t, psm)
+	assert.Equal(t, sharding.ErrNilMarshalizer, err)
+}
+
+func TestNewPeerShardMapper_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	psm, err := networksharding.NewPeerShardMapper(createMockArgPeerShardMapper())
+
+	assert.Nil(t, err)
+	assert.False(t, psm.IsInterfaceNil())
+}
+
+//------- GetPeerInfo
+
+func TestPeerShardMapper_GetPeerInfoUnknownPeerShouldReturnUnknown(t *testing.T) {
+	t.Parallel()
+
+	psm, _ := networksharding.NewPeerShardMapper(createMockArgPeerShardMapper())
+
+	assert.Equal(t, p2p.PeerInfo{PeerType: p2p.UnknownPeer}, psm.GetPeerInfo("pid"))
+}
+
+func TestPeerShardMapper_UpdatePeerIdPublicKeyNotValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	psm, _ := networksharding.NewPeerShardMapper(createMockArgPeerShardMapper())
+
+	err := psm.UpdatePeerIdPublicKey("pid", []byte("observer pk"))
+
+	assert.Equal(t, errNotValidator, err)
+	assert.Equal(t, p2p.PeerInfo{PeerType: p2p.UnknownPeer}, psm.GetPeerInfo("pid"))
+}
+
+func TestPeerShardMapper_UpdatePeerIdPublicKeyShouldMapTheValidatorsShards(t *testing.T) {
+	t.Parallel()
+
+	psm, _ := networksharding.NewPeerShardMapper(createMockArgPeerShardMapper())
+
+	err := psm.UpdatePeerIdPublicKey("intra pid", []byte("intra pk"))
+	assert.Nil(t, err)
+	err = psm.UpdatePeerIdPublicKey("cross pid", []byte("cross pk"))
+	assert.Nil(t, err)
+
+	expectedIntraShard := p2p.PeerInfo{PeerType: p2p.IntraShardPeer, PublicKey: []byte("intra pk"), ShardID: selfShardId}
+	assert.Equal(t, expectedIntraShard, psm.GetPeerInfo("intra pid"))
+	expectedCrossShard := p2p.PeerInfo{PeerType: p2p.CrossShardPeer, PublicKey: []byte("cross pk"), ShardID: 1}
+	assert.Equal(t, expectedCrossShard, psm.GetPeerInfo("cross pid"))
+}
+
+func TestPeerShardMapper_MappingsShouldSurviveARestart(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerShardMapper()
+	psm, _ := networksharding.NewPeerShardMapper(arg)
+	_ = psm.UpdatePeerIdPublicKey("cross pid", []byte("cross pk"))
+
+	peerAuths, _ := lrucache.NewCache(10)
+	arg.PeerAuthentications = peerAuths
+	restartedPsm, _ := networksharding.NewPeerShardMapper(arg)
+
+	expectedCrossShard := p2p.PeerInfo{PeerType: p2p.CrossShardPeer, PublicKey: []byte("cross pk"), ShardID: 1}
+	assert.Equal(t, expectedCrossShard, restartedPsm.GetPeerInfo("cross pid"))
+}
+
+func TestPeerShardMapper_GetPeerInfoValidatorNoLongerInTheSetShouldReturnUnknown(t *testing.T) {
+	t.Parallel()
+
+	validatorsShards := map[string]uint32{"intra pk": selfShardId}
+	psm, _ := networksharding.NewPeerShardMapper(createMockArgPeerShardMapperWithValidators(validatorsShards))
+	_ = psm.UpdatePeerIdPublicKey("intra pid", []byte("intra pk"))
+
+	delete(validatorsShards, "intra pk")
+
+	assert.Equal(t, p2p.PeerInfo{PeerType: p2p.UnknownPeer}, psm.GetPeerInfo("intra pid"))
+}
+
+func TestPeerShardMapper_GetPeerInfoValidatorMovedShouldReturnTheCurrentShard(t *testing.T) {
+	t.Parallel()
+
+	validatorsShards := map[string]uint32{"moving pk": selfShardId}
+	psm, _ := networksharding.NewPeerShardMapper(createMockArgPeerShardMapperWithValidators(validatorsShards))
+	_ = psm.UpdatePeerIdPublicKey("moving pid", []byte("moving pk"))
+
+	validatorsShards["moving pk"] = 1
+
+	expectedCrossShard := p2p.PeerInfo{PeerType: p2p.CrossShardPeer, PublicKey: []byte("moving pk"), ShardID: 1}
+	assert.Equal(t, expectedCrossShard, psm.GetPeerInfo("moving pid"))
+}
+
+func TestPeerShardMapper_ShouldLearnFromTheReceivedPeerAuthentications(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerShardMapper()
+	psm, _ := networksharding.NewPeerShardMapper(arg)
+
+	arg.PeerAuthentications.Put([]byte("intra pk"), &heartbeat.PeerAuthentication{
+		Pubkey: []byte("intra pk"),
+		Pid:    []byte("intra pid"),
+	})
+	time.Sleep(time.Millisecond * 100)
+
+	expectedIntraShard := p2p.PeerInfo{PeerType: p2p.IntraShardPeer, PublicKey: []byte("intra pk"), ShardID: selfShardId}
+	assert.Equal(t, expectedIntraShard, psm.GetPeerInfo("intra pid"))
+}
@@ -0,0 +1,46 @@
+package sharding
+
+// overridesShardResolver pins explicit addresses, such as the system smart contracts ones, to a shard and
+// leaves all the other addresses to the wrapped resolver
+type overridesShardResolver struct {
+	addressResolver AddressShardResolver
+	overrides       map[string]uint32
+}
+
+// NewOverridesShardResolver creates a new overrides shard resolver
+func NewOverridesShardResolver(
+	addressResolver AddressShardResolver,
+	overrides map[string]uint32,
+) (*overridesShardResolver, error) {
+	if addressResolver == nil || addressResolver.IsInterfaceNil() {
+		return nil, ErrNilAddressShardResolver
+	}
+
+	overridesCopy := make(map[string]uint32, len(overrides))
+	for address, shardId := range overrides {
+		overridesCopy[address] = shardId
+	}
+
+	return &overridesShardResolver{
+		addressResolver: addressResolver,
+		overrides:       overridesCopy,
+	}, nil
+}
+
+// ComputeId returns the pinned shard of the address, if any, or the shard computed by the wrapped resolver
+func (osr *overridesShardResolver) ComputeId(address []byte) uint32 {
+	shardId, ok := osr.overrides[string(address)]
+	if ok {
+		return shardId
+	}
+
+	return osr.addressResolver.ComputeId(address)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (osr *overridesShardResolver) IsInterfaceNil() bool {
+	if osr == nil {
+		return true
+	}
+	return false
+}
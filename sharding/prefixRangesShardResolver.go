@@ -0,0 +1,62 @@
+package sharding
+
+import (
+	"bytes"
+	"sort"
+)
+
+// prefixRangesShardResolver assigns addresses to shards by splitting the address space in contiguous ranges,
+// which allows moving ranges of accounts between shards without re-mapping all the other addresses
+type prefixRangesShardResolver struct {
+	ranges []PrefixRange
+}
+
+// NewPrefixRangesShardResolver creates a new prefix ranges shard resolver. The ranges have to cover the whole address
+// space, so one of them has to start with the empty prefix
+func NewPrefixRangesShardResolver(ranges []PrefixRange, numberOfShards uint32) (*prefixRangesShardResolver, error) {
+	if numberOfShards < 1 {
+		return nil, ErrInvalidNumberOfShards
+	}
+	if len(ranges) == 0 {
+		return nil, ErrEmptyPrefixRanges
+	}
+
+	sorted := make([]PrefixRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].StartPrefix, sorted[j].StartPrefix) < 0
+	})
+
+	if len(sorted[0].StartPrefix) != 0 {
+		return nil, ErrMissingFirstPrefixRange
+	}
+	for i := 0; i < len(sorted); i++ {
+		if sorted[i].ShardId >= numberOfShards && sorted[i].ShardId != MetachainShardId {
+			return nil, ErrInvalidShardId
+		}
+		if i > 0 && bytes.Equal(sorted[i].StartPrefix, sorted[i-1].StartPrefix) {
+			return nil, ErrDuplicatedPrefixRange
+		}
+	}
+
+	return &prefixRangesShardResolver{
+		ranges: sorted,
+	}, nil
+}
+
+// ComputeId returns the shard of the last range starting with a prefix lower or equal to the address
+func (prsr *prefixRangesShardResolver) ComputeId(address []byte) uint32 {
+	idx := sort.Search(len(prsr.ranges), func(i int) bool {
+		return bytes.Compare(prsr.ranges[i].StartPrefix, address) > 0
+	})
+
+	return prsr.ranges[idx-1].ShardId
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prsr *prefixRangesShardResolver) IsInterfaceNil() bool {
+	if prsr == nil {
+		return true
+	}
+	return false
+}
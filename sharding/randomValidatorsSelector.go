@@ -0,0 +1,129 @@
+package sharding
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+type randomValidatorsSelector struct {
+	hasher hashing.Hasher
+}
+
+// NewRandomValidatorsSelector creates a selector which samples the consensus group from the eligible list
+// using the randomness source
+func NewRandomValidatorsSelector(hasher hashing.Hasher) (*randomValidatorsSelector, error) {
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+
+	return &randomValidatorsSelector{
+		hasher: hasher,
+	}, nil
+}
+
+// Select will generate a list of validators based on the the eligible list, consensus group size and
+// a randomness source
+// Steps:
+// 1. for each value in [0, consensusGroupSize), compute proposedindex = Hash( [index as string] CONCAT randomness) % len(eligible list)
+// 2. if proposed index is already in the temp validator list, then proposedIndex++ (and then % len(eligible list) as to not
+//    exceed the maximum index value permitted by the validator list), and then recheck against temp validator list until
+//    the item at the new proposed index is not found in the list. This new proposed index will be called checked index
+// 3. the item at the checked index is appended in the temp validator list
+func (rvs *randomValidatorsSelector) Select(
+	randomness []byte,
+	round uint64,
+	eligibleList []Validator,
+	consensusSize int,
+) ([]Validator, error) {
+	if randomness == nil {
+		return nil, ErrNilRandomness
+	}
+	if len(eligibleList) < consensusSize {
+		return nil, ErrSmallEligibleListSize
+	}
+
+	return selectFromList(rvs.hasher, randomness, round, eligibleList, consensusSize), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rvs *randomValidatorsSelector) IsInterfaceNil() bool {
+	if rvs == nil {
+		return true
+	}
+	return false
+}
+
+// selectFromList picks consensusSize distinct validators from a list which may contain the same validator
+// several times
+func selectFromList(
+	hasher hashing.Hasher,
+	randomness []byte,
+	round uint64,
+	list []Validator,
+	consensusSize int,
+) []Validator {
+	tempList := make([]Validator, 0)
+	randomSource := fmt.Sprintf("%d-%s", round, core.ToB64(randomness))
+	lenList := len(list)
+
+	for startIdx := 0; startIdx < consensusSize; startIdx++ {
+		proposedIndex := computeListIndex(hasher, startIdx, lenList, randomSource)
+		checkedIndex := checkIndex(proposedIndex, list, tempList)
+		tempList = append(tempList, list[checkedIndex])
+	}
+
+	return tempList
+}
+
+// computeListIndex computes a proposed index from expanded eligible list
+func computeListIndex(hasher hashing.Hasher, currentIndex int, lenList int, randomSource string) int {
+	buffCurrentIndex := make([]byte, 8)
+	binary.BigEndian.PutUint64(buffCurrentIndex, uint64(currentIndex))
+
+	indexHash := hasher.Compute(string(buffCurrentIndex) + randomSource)
+
+	computedLargeIndex := big.NewInt(0)
+	computedLargeIndex.SetBytes(indexHash)
+	lenExpandedEligibleList := big.NewInt(int64(lenList))
+
+	// computedListIndex = computedLargeIndex % len(expandedEligibleList)
+	computedListIndex := big.NewInt(0).Mod(computedLargeIndex, lenExpandedEligibleList).Int64()
+
+	return int(computedListIndex)
+}
+
+// checkIndex returns a checked index starting from a proposed index
+func checkIndex(
+	proposedIndex int,
+	eligibleList []Validator,
+	selectedList []Validator,
+) int {
+
+	for {
+		v := eligibleList[proposedIndex]
+
+		if validatorIsInList(v, selectedList) {
+			proposedIndex++
+			proposedIndex = proposedIndex % len(eligibleList)
+			continue
+		}
+
+		return proposedIndex
+	}
+}
+
+// validatorIsInList returns true if a validator has been found in provided list
+func validatorIsInList(v Validator, list []Validator) bool {
+	for i := 0; i < len(list); i++ {
+		if bytes.Equal(v.PubKey(), list[i].PubKey()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,65 @@
+package sharding
+
+import (
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+type ratingValidatorsSelector struct {
+	hasher hashing.Hasher
+}
+
+// NewRatingValidatorsSelector creates a selector which samples the consensus group from the eligible list,
+// validators with a higher rating having a higher chance of being selected
+func NewRatingValidatorsSelector(hasher hashing.Hasher) (*ratingValidatorsSelector, error) {
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+
+	return &ratingValidatorsSelector{
+		hasher: hasher,
+	}, nil
+}
+
+// Select will generate a list of validators by sampling an expanded eligible list in which every validator appears
+// as many times as its rating. Validators with a rating lower than one appear once so they can still be selected
+func (rvs *ratingValidatorsSelector) Select(
+	randomness []byte,
+	round uint64,
+	eligibleList []Validator,
+	consensusSize int,
+) ([]Validator, error) {
+	if randomness == nil {
+		return nil, ErrNilRandomness
+	}
+	if len(eligibleList) < consensusSize {
+		return nil, ErrSmallEligibleListSize
+	}
+
+	expandedList := expandEligibleList(eligibleList)
+
+	return selectFromList(rvs.hasher, randomness, round, expandedList, consensusSize), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rvs *ratingValidatorsSelector) IsInterfaceNil() bool {
+	if rvs == nil {
+		return true
+	}
+	return false
+}
+
+func expandEligibleList(eligibleList []Validator) []Validator {
+	expandedList := make([]Validator, 0, len(eligibleList))
+	for _, v := range eligibleList {
+		weight := int(v.Rating())
+		if weight < 1 {
+			weight = 1
+		}
+
+		for i := 0; i < weight; i++ {
+			expandedList = append(expandedList, v)
+		}
+	}
+
+	return expandedList
+}
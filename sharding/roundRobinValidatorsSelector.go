@@ -0,0 +1,42 @@
+package sharding
+
+type roundRobinValidatorsSelector struct {
+}
+
+// NewRoundRobinValidatorsSelector creates a selector which ignores the randomness source and rotates through
+// the eligible list, one position each round. It is meant for tests which need a predictable consensus group
+func NewRoundRobinValidatorsSelector() *roundRobinValidatorsSelector {
+	return &roundRobinValidatorsSelector{}
+}
+
+// Select returns consensusSize consecutive validators from the eligible list, starting with the one at
+// the position round % len(eligible list)
+func (rrvs *roundRobinValidatorsSelector) Select(
+	randomness []byte,
+	round uint64,
+	eligibleList []Validator,
+	consensusSize int,
+) ([]Validator, error) {
+	if randomness == nil {
+		return nil, ErrNilRandomness
+	}
+	if len(eligibleList) < consensusSize {
+		return nil, ErrSmallEligibleListSize
+	}
+
+	lenList := uint64(len(eligibleList))
+	selected := make([]Validator, consensusSize)
+	for i := 0; i < consensusSize; i++ {
+		selected[i] = eligibleList[(round+uint64(i))%lenList]
+	}
+
+	return selected, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rrvs *roundRobinValidatorsSelector) IsInterfaceNil() bool {
+	if rrvs == nil {
+		return true
+	}
+	return false
+}
@@ -7,8 +7,35 @@ type ArgNodesCoordinator struct {
 	ShardConsensusGroupSize int
 	MetaConsensusGroupSize  int
 	Hasher                  hashing.Hasher
+	ConsensusGroupSelection string
 	ShardId                 uint32
 	NbShards                uint32
 	Nodes                   map[uint32][]Validator
 	SelfPublicKey           []byte
+	ConsensusByEpoch        []ConsensusForEpoch
+}
+
+// ConsensusForEpoch holds the consensus group sizes and the fractions of the consensus group which have to sign a
+// block, used starting with an epoch. A zero fraction keeps the 2/3 + 1 PBFT threshold
+type ConsensusForEpoch struct {
+	StartEpoch              uint32
+	ShardConsensusGroupSize int
+	MetaConsensusGroupSize  int
+	ShardThresholdFraction  float64
+	MetaThresholdFraction   float64
+}
+
+// AddressShardResolverConfig holds the rule used to assign addresses to shards. An empty type selects the last bits
+// rule. Overrides pin explicit addresses to a shard, whatever the rule would compute for them
+type AddressShardResolverConfig struct {
+	Type         string
+	PrefixRanges []PrefixRange
+	Overrides    map[string]uint32
+}
+
+// PrefixRange assigns to a shard all the addresses greater or equal to its start prefix and lower than the start
+// prefix of the next range
+type PrefixRange struct {
+	StartPrefix []byte
+	ShardId     uint32
 }
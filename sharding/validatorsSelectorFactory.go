@@ -0,0 +1,28 @@
+package sharding
+
+import (
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+const (
+	// RandomSelection selects the consensus group by sampling the eligible list using the randomness source
+	RandomSelection = "random"
+	// RatingSelection selects the consensus group by sampling the eligible list weighted by the validators rating
+	RatingSelection = "rating"
+	// RoundRobinSelection selects the consensus group deterministically, rotating through the eligible list each round
+	RoundRobinSelection = "roundRobin"
+)
+
+// NewValidatorsSelector creates the consensus group selection strategy identified by the provided selection type
+func NewValidatorsSelector(selectionType string, hasher hashing.Hasher) (ValidatorsSelector, error) {
+	switch selectionType {
+	case RandomSelection:
+		return NewRandomValidatorsSelector(hasher)
+	case RatingSelection:
+		return NewRatingValidatorsSelector(hasher)
+	case RoundRobinSelection:
+		return NewRoundRobinValidatorsSelector(), nil
+	}
+
+	return nil, ErrInvalidConsensusGroupSelection
+}
@@ -0,0 +1,161 @@
+package sharding_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/sharding/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createEligibleList(ratings ...int32) []sharding.Validator {
+	list := make([]sharding.Validator, len(ratings))
+	for i, rating := range ratings {
+		pubKey := []byte(fmt.Sprintf("pk%d", i))
+		address := []byte(fmt.Sprintf("addr%d", i))
+		list[i] = mock.NewValidatorMock(big.NewInt(1), rating, pubKey, address)
+	}
+
+	return list
+}
+
+func countOccurrences(selected []sharding.Validator, pubKey []byte) int {
+	count := 0
+	for _, v := range selected {
+		if string(v.PubKey()) == string(pubKey) {
+			count++
+		}
+	}
+
+	return count
+}
+
+//------- NewValidatorsSelector
+
+func TestNewValidatorsSelector_InvalidSelectionTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vs, err := sharding.NewValidatorsSelector("invalid", &mock.HasherMock{})
+
+	assert.Nil(t, vs)
+	assert.Equal(t, sharding.ErrInvalidConsensusGroupSelection, err)
+}
+
+func TestNewValidatorsSelector_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vs, err := sharding.NewValidatorsSelector(sharding.RandomSelection, nil)
+	assert.Nil(t, vs)
+	assert.Equal(t, sharding.ErrNilHasher, err)
+
+	vs, err = sharding.NewValidatorsSelector(sharding.RatingSelection, nil)
+	assert.Nil(t, vs)
+	assert.Equal(t, sharding.ErrNilHasher, err)
+}
+
+func TestNewValidatorsSelector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	selectionTypes := []string{sharding.RandomSelection, sharding.RatingSelection, sharding.RoundRobinSelection}
+	for _, selectionType := range selectionTypes {
+		vs, err := sharding.NewValidatorsSelector(selectionType, &mock.HasherMock{})
+
+		assert.Nil(t, err)
+		assert.False(t, vs.IsInterfaceNil())
+	}
+}
+
+//------- Select
+
+func TestValidatorsSelectors_SelectNilRandomnessShouldErr(t *testing.T) {
+	t.Parallel()
+
+	selectionTypes := []string{sharding.RandomSelection, sharding.RatingSelection, sharding.RoundRobinSelection}
+	for _, selectionType := range selectionTypes {
+		vs, _ := sharding.NewValidatorsSelector(selectionType, &mock.HasherMock{})
+
+		selected, err := vs.Select(nil, 0, createEligibleList(1, 1), 1)
+
+		assert.Nil(t, selected)
+		assert.Equal(t, sharding.ErrNilRandomness, err)
+	}
+}
+
+func TestValidatorsSelectors_SelectSmallEligibleListShouldErr(t *testing.T) {
+	t.Parallel()
+
+	selectionTypes := []string{sharding.RandomSelection, sharding.RatingSelection, sharding.RoundRobinSelection}
+	for _, selectionType := range selectionTypes {
+		vs, _ := sharding.NewValidatorsSelector(selectionType, &mock.HasherMock{})
+
+		selected, err := vs.Select([]byte("randomness"), 0, createEligibleList(1, 1), 3)
+
+		assert.Nil(t, selected)
+		assert.Equal(t, sharding.ErrSmallEligibleListSize, err)
+	}
+}
+
+func TestValidatorsSelectors_SelectShouldReturnDistinctValidatorsAndBeReproducible(t *testing.T) {
+	t.Parallel()
+
+	eligibleList := createEligibleList(1, 5, 0, 3, 2, 10, 1, 4, 7, 1)
+	consensusSize := 6
+	randomness := []byte("randomness")
+	selectionTypes := []string{sharding.RandomSelection, sharding.RatingSelection, sharding.RoundRobinSelection}
+	for _, selectionType := range selectionTypes {
+		vs, _ := sharding.NewValidatorsSelector(selectionType, sha256.Sha256{})
+
+		selected, err := vs.Select(randomness, 7, eligibleList, consensusSize)
+		assert.Nil(t, err)
+		assert.Equal(t, consensusSize, len(selected))
+		for _, v := range selected {
+			assert.Equal(t, 1, countOccurrences(selected, v.PubKey()))
+		}
+
+		selectedAgain, _ := vs.Select(randomness, 7, eligibleList, consensusSize)
+		assert.Equal(t, selected, selectedAgain)
+	}
+}
+
+func TestRoundRobinValidatorsSelector_SelectShouldRotateWithRound(t *testing.T) {
+	t.Parallel()
+
+	eligibleList := createEligibleList(1, 1, 1, 1)
+	vs := sharding.NewRoundRobinValidatorsSelector()
+
+	selected, err := vs.Select([]byte("randomness"), 0, eligibleList, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []sharding.Validator{eligibleList[0], eligibleList[1]}, selected)
+
+	selected, _ = vs.Select([]byte("other randomness"), 3, eligibleList, 2)
+	assert.Equal(t, []sharding.Validator{eligibleList[3], eligibleList[0]}, selected)
+}
+
+func TestRatingValidatorsSelector_SelectShouldFavorHigherRatings(t *testing.T) {
+	t.Parallel()
+
+	eligibleList := createEligibleList(1, 1, 50)
+	randomVs, _ := sharding.NewRandomValidatorsSelector(sha256.Sha256{})
+	ratingVs, _ := sharding.NewRatingValidatorsSelector(sha256.Sha256{})
+
+	numRounds := 300
+	randomLeaderCount := 0
+	ratingLeaderCount := 0
+	for round := 0; round < numRounds; round++ {
+		randomSelected, _ := randomVs.Select([]byte("randomness"), uint64(round), eligibleList, 1)
+		if randomSelected[0] == eligibleList[2] {
+			randomLeaderCount++
+		}
+
+		ratingSelected, _ := ratingVs.Select([]byte("randomness"), uint64(round), eligibleList, 1)
+		if ratingSelected[0] == eligibleList[2] {
+			ratingLeaderCount++
+		}
+	}
+
+	assert.True(t, ratingLeaderCount > randomLeaderCount)
+	assert.True(t, ratingLeaderCount > numRounds*9/10)
+}
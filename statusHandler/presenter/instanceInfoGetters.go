@@ -116,7 +116,7 @@ func (psh *PresenterStatusHandler) CalculateRewardsPerHour() string {
 	totalBlocks := psh.GetProbableHighestNonce()
 	rounds := psh.GetCurrentRound()
 	roundDuration := psh.GetRoundTime()
-	secondsInAHour := uint64(3600)
+	millisecondsInAHour := uint64(3600000)
 	rewardsValue := psh.getBigIntFromStringMetric(core.MetricRewardsValue)
 	communityPercentage := psh.getFloatFromStringMetric(core.MetricCommunityPercentage)
 	leaderPercentage := psh.getFloatFromStringMetric(core.MetricLeaderPercentage)
@@ -129,7 +129,7 @@ func (psh *PresenterStatusHandler) CalculateRewardsPerHour() string {
 	chanceToBeInConsensus := float64(consensusGroupSize) / float64(numValidators)
 	chanceToBeLeader := 1 / float64(numValidators)
 	hitRate := float64(totalBlocks) / float64(rounds)
-	roundsPerHour := float64(secondsInAHour) / float64(roundDuration)
+	roundsPerHour := float64(millisecondsInAHour) / float64(roundDuration)
 
 	consensusCoefficient := (chanceToBeInConsensus - chanceToBeLeader) * hitRate * roundsPerHour * communityPercentage
 	consensusRewardsPerHour := big.NewFloat(consensusCoefficient)
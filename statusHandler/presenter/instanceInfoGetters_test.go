@@ -223,7 +223,7 @@ func TestPresenterStatusHandler_CalculateRewardsPerHourShouldWork(t *testing.T)
 	numValidators := uint64(100)
 	totalBlocks := uint64(100)
 	totalRounds := uint64(1000)
-	roundTime := uint64(6)
+	roundTime := uint64(6000)
 	leaderPercentage := "0.5"
 	communityPercentage := "0.1"
 	rewardsValue := "1000"
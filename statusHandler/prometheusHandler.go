@@ -34,6 +34,11 @@ func (psh *PrometheusStatusHandler) InitMetrics() {
 	psh.addMetric(core.MetricNonce, "The nonce for the node")
 	psh.addMetric(core.MetricCurrentRound, "The current round where the node is")
 	psh.addMetric(core.MetricNumConnectedPeers, "The current number of peers connected")
+	psh.addMetric(core.MetricNumRejectedPrivateNetworkHandshakes, "The number of connections rejected because of a private network key mismatch")
+	psh.addMetric(core.MetricNumRejectedOversizedTxData, "The number of intercepted transactions dropped for their data size")
+	psh.addMetric(core.MetricNumRejectedOversizedMiniBlocks, "The number of intercepted headers and bodies dropped for holding a miniblock with too many transactions")
+	psh.addMetric(core.MetricNumRejectedOversizedBlocks, "The number of intercepted headers and bodies dropped for holding too many miniblocks")
+	psh.addMetric(core.MetricNumThrottledResolverRequests, "The number of requests dropped by the resolvers antiflood")
 	psh.addMetric(core.MetricIsSyncing, "The synchronization state. If it's in process of syncing will be 1"+
 		" and if it's synchronized will be 0")
 
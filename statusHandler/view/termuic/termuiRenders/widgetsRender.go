@@ -215,7 +215,7 @@ func (wr *WidgetsRender) prepareChainInfo() {
 		synchronizedRound, currentRound)}
 
 	consensusRoundTime := wr.presenter.GetRoundTime()
-	rows[6] = []string{fmt.Sprintf("Consensus round time: %ds", consensusRoundTime)}
+	rows[6] = []string{fmt.Sprintf("Consensus round time: %dms", consensusRoundTime)}
 
 	numLiveValidators := wr.presenter.GetLiveValidatorNodes()
 	rows[7] = []string{fmt.Sprintf("Live validator nodes: %d", numLiveValidators)}
@@ -14,7 +14,7 @@ import (
 // read + write + execute for owner only
 const rwxOwner = 0700
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("storage/badgerdb")
 
 // DB holds a pointer to the badger database and the path to where it is stored.
 type DB struct {
@@ -0,0 +1,136 @@
+package encrypteddb
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const encryptionKeyLabel = "value encryption"
+const lookupKeyLabel = "key lookup"
+
+// DB wraps a persister and encrypts the data saved in it. The values are encrypted with AES-GCM while the keys are
+// replaced by their HMAC so the persister holds neither the plain values nor the plain keys
+type DB struct {
+	persister storage.Persister
+	aead      cipher.AEAD
+	lookupKey []byte
+}
+
+// NewDB creates a new encrypted database on top of the provided persister. The master key should have 16, 24 or
+// 32 bytes, selecting AES-128, AES-192 or AES-256
+func NewDB(persister storage.Persister, masterKey []byte) (*DB, error) {
+	if persister == nil || persister.IsInterfaceNil() {
+		return nil, storage.ErrNilPersister
+	}
+
+	switch len(masterKey) {
+	case 16, 24, 32:
+	default:
+		return nil, storage.ErrInvalidEncryptionKey
+	}
+
+	block, err := aes.NewCipher(deriveKey(masterKey, encryptionKeyLabel)[:len(masterKey)])
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DB{
+		persister: persister,
+		aead:      aead,
+		lookupKey: deriveKey(masterKey, lookupKeyLabel),
+	}, nil
+}
+
+// Put encrypts the value and adds it to the (key, val) persistence medium
+func (db *DB) Put(key, val []byte) error {
+	nonce := make([]byte, db.aead.NonceSize())
+	_, err := io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return err
+	}
+
+	lookupKey := db.computeLookupKey(key)
+	encrypted := db.aead.Seal(nonce, nonce, val, lookupKey)
+
+	return db.persister.Put(lookupKey, encrypted)
+}
+
+// Get gets the value associated to the key and decrypts it
+func (db *DB) Get(key []byte) ([]byte, error) {
+	lookupKey := db.computeLookupKey(key)
+	encrypted, err := db.persister.Get(lookupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := db.aead.NonceSize()
+	if len(encrypted) < nonceSize {
+		return nil, storage.ErrInvalidEncryptedData
+	}
+
+	val, err := db.aead.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], lookupKey)
+	if err != nil {
+		return nil, storage.ErrInvalidEncryptedData
+	}
+
+	return val, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (db *DB) Has(key []byte) error {
+	return db.persister.Has(db.computeLookupKey(key))
+}
+
+// Init initializes the persistence medium and prepares it for usage
+func (db *DB) Init() error {
+	return db.persister.Init()
+}
+
+// Close closes the files/resources associated to the persistence medium
+func (db *DB) Close() error {
+	return db.persister.Close()
+}
+
+// Remove removes the data associated to the given key
+func (db *DB) Remove(key []byte) error {
+	return db.persister.Remove(db.computeLookupKey(key))
+}
+
+// Destroy removes the persistence medium stored data
+func (db *DB) Destroy() error {
+	return db.persister.Destroy()
+}
+
+func (db *DB) computeLookupKey(key []byte) []byte {
+	mac := hmac.New(sha256.New, db.lookupKey)
+	_, _ = mac.Write(key)
+
+	return mac.Sum(nil)
+}
+
+// deriveKey computes a key for a single purpose so the master key is never used directly
+func deriveKey(masterKey []byte, label string) []byte {
+	mac := hmac.New(sha256.New, masterKey)
+	_, _ = mac.Write([]byte(label))
+
+	return mac.Sum(nil)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (db *DB) IsInterfaceNil() bool {
+	if db == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package encrypteddb_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/encrypteddb"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+)
+
+var testKey = bytes.Repeat([]byte{1}, 32)
+
+func createEncryptedDB(t *testing.T) (*encrypteddb.DB, *memorydb.DB) {
+	mdb, _ := memorydb.New()
+	db, err := encrypteddb.NewDB(mdb, testKey)
+	assert.Nil(t, err)
+
+	return db, mdb
+}
+
+func TestNewDB_NilPersisterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db, err := encrypteddb.NewDB(nil, testKey)
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrNilPersister, err)
+}
+
+func TestNewDB_InvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mdb, _ := memorydb.New()
+	db, err := encrypteddb.NewDB(mdb, []byte("short key"))
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrInvalidEncryptionKey, err)
+}
+
+func TestNewDB_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	db, _ := createEncryptedDB(t)
+	assert.False(t, db.IsInterfaceNil())
+}
+
+func TestDB_PutGetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	db, _ := createEncryptedDB(t)
+
+	err := db.Put(key, val)
+	assert.Nil(t, err)
+	assert.Nil(t, db.Has(key))
+
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestDB_PutShouldNotSavePlainData(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	db, mdb := createEncryptedDB(t)
+
+	_ = db.Put(key, val)
+
+	assert.NotNil(t, mdb.Has(key))
+}
+
+func TestDB_GetWithOtherKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	db, mdb := createEncryptedDB(t)
+	_ = db.Put(key, val)
+
+	otherDB, _ := encrypteddb.NewDB(mdb, bytes.Repeat([]byte{2}, 32))
+	recovered, err := otherDB.Get(key)
+	assert.Nil(t, recovered)
+	assert.NotNil(t, err)
+}
+
+func TestDB_RemoveShouldWork(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("key")
+	db, _ := createEncryptedDB(t)
+	_ = db.Put(key, []byte("value"))
+
+	err := db.Remove(key)
+	assert.Nil(t, err)
+	assert.NotNil(t, db.Has(key))
+}
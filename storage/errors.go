@@ -33,3 +33,15 @@ var ErrInvalidBatch = errors.New("batch is invalid")
 
 // ErrInvalidNumOpenFiles is raised when the max num of open files is less than 1
 var ErrInvalidNumOpenFiles = errors.New("maxOpenFiles is invalid")
+
+// ErrInvalidEncryptionKey is raised when the provided encryption key does not have a valid AES key length
+var ErrInvalidEncryptionKey = errors.New("invalid encryption key")
+
+// ErrInvalidEncryptedData is raised when the stored data can not be decrypted
+var ErrInvalidEncryptedData = errors.New("invalid encrypted data")
+
+// ErrInvalidTieredDBPath is raised when the hot or the cold path of a tiered database is empty or they are the same
+var ErrInvalidTieredDBPath = errors.New("invalid tiered db path")
+
+// ErrNilPersisterFactory is raised when a nil persister factory is provided
+var ErrNilPersisterFactory = errors.New("nil persister factory")
@@ -7,12 +7,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("storage/fifocache")
 
 // FIFOShardedCache implements a First In First Out eviction cache
 type FIFOShardedCache struct {
-	cache   *cmap.ConcurrentMap
-	maxsize int
+	mutCache sync.RWMutex
+	cache    *cmap.ConcurrentMap
+	maxsize  int
+	shards   int
 
 	mutAddedDataHandlers sync.RWMutex
 	addedDataHandlers    []func(key []byte)
@@ -24,6 +26,7 @@ func NewShardedCache(size int, shards int) (*FIFOShardedCache, error) {
 	fifoShardedCache := &FIFOShardedCache{
 		cache:                cache,
 		maxsize:              size,
+		shards:               shards,
 		mutAddedDataHandlers: sync.RWMutex{},
 		addedDataHandlers:    make([]func(key []byte), 0),
 	}
@@ -33,6 +36,9 @@ func NewShardedCache(size int, shards int) (*FIFOShardedCache, error) {
 
 // Clear is used to completely clear the cache.
 func (c *FIFOShardedCache) Clear() {
+	c.mutCache.RLock()
+	defer c.mutCache.RUnlock()
+
 	keys := c.cache.Keys()
 	for _, key := range keys {
 		c.cache.Remove(key)
@@ -41,7 +47,9 @@ func (c *FIFOShardedCache) Clear() {
 
 // Put adds a value to the cache.  Returns true if an eviction occurred.
 func (c *FIFOShardedCache) Put(key []byte, value interface{}) (evicted bool) {
+	c.mutCache.RLock()
 	c.cache.Set(string(key), value)
+	c.mutCache.RUnlock()
 	c.callAddedDataHandlers(key)
 
 	return true
@@ -61,18 +69,27 @@ func (c *FIFOShardedCache) RegisterHandler(handler func(key []byte)) {
 
 // Get looks up a key's value from the cache.
 func (c *FIFOShardedCache) Get(key []byte) (value interface{}, ok bool) {
+	c.mutCache.RLock()
+	defer c.mutCache.RUnlock()
+
 	return c.cache.Get(string(key))
 }
 
 // Has checks if a key is in the cache, without updating the
 // recent-ness or deleting it for being stale.
 func (c *FIFOShardedCache) Has(key []byte) bool {
+	c.mutCache.RLock()
+	defer c.mutCache.RUnlock()
+
 	return c.cache.Has(string(key))
 }
 
 // Peek returns the key value (or undefined if not found) without updating
 // the "recently used"-ness of the key.
 func (c *FIFOShardedCache) Peek(key []byte) (value interface{}, ok bool) {
+	c.mutCache.RLock()
+	defer c.mutCache.RUnlock()
+
 	return c.cache.Get(string(key))
 }
 
@@ -80,7 +97,9 @@ func (c *FIFOShardedCache) Peek(key []byte) (value interface{}, ok bool) {
 // recent-ness or deleting it for being stale,  and if not, adds the value.
 // Returns whether found and whether an eviction occurred.
 func (c *FIFOShardedCache) HasOrAdd(key []byte, value interface{}) (found, evicted bool) {
+	c.mutCache.RLock()
 	added := c.cache.SetIfAbsent(string(key), value)
+	c.mutCache.RUnlock()
 
 	if added {
 		c.callAddedDataHandlers(key)
@@ -99,7 +118,9 @@ func (c *FIFOShardedCache) callAddedDataHandlers(key []byte) {
 
 // Remove removes the provided key from the cache.
 func (c *FIFOShardedCache) Remove(key []byte) {
+	c.mutCache.RLock()
 	c.cache.Remove(string(key))
+	c.mutCache.RUnlock()
 }
 
 // RemoveOldest removes the oldest item from the cache.
@@ -110,7 +131,9 @@ func (c *FIFOShardedCache) RemoveOldest() {
 
 // Keys returns a slice of the keys in the cache, from oldest to newest.
 func (c *FIFOShardedCache) Keys() [][]byte {
+	c.mutCache.RLock()
 	res := c.cache.Keys()
+	c.mutCache.RUnlock()
 	r := make([][]byte, len(res))
 
 	for i := 0; i < len(res); i++ {
@@ -122,14 +145,41 @@ func (c *FIFOShardedCache) Keys() [][]byte {
 
 // Len returns the number of items in the cache.
 func (c *FIFOShardedCache) Len() int {
+	c.mutCache.RLock()
+	defer c.mutCache.RUnlock()
+
 	return c.cache.Count()
 }
 
 // MaxSize returns the maximum number of items which can be stored in cache.
 func (c *FIFOShardedCache) MaxSize() int {
+	c.mutCache.RLock()
+	defer c.mutCache.RUnlock()
+
 	return c.maxsize
 }
 
+// Resize changes the maximum number of items which can be stored in cache. The items are copied in a new cache of
+// the given size, so when it shrinks, the evicted items are not necessarily the oldest ones
+func (c *FIFOShardedCache) Resize(size int) {
+	if size <= 0 {
+		log.Debug("attempt to resize a cacher object to a size lower than one", "size", size)
+		return
+	}
+
+	c.mutCache.Lock()
+	defer c.mutCache.Unlock()
+
+	if size == c.maxsize {
+		return
+	}
+
+	cache := cmap.New(size, c.shards)
+	cache.MSet(c.cache.Items())
+	c.cache = cache
+	c.maxsize = size
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (c *FIFOShardedCache) IsInterfaceNil() bool {
 	if c == nil {
@@ -380,3 +380,43 @@ func TestFIFOShardedCache_CacherRegisterHasOrAddAddedDataHandlerNotAddedShouldNo
 
 	assert.Equal(t, 1, len(c.AddedDataHandlers()))
 }
+
+func TestFIFOShardedCache_ResizeGrowShouldKeepTheItems(t *testing.T) {
+	t.Parallel()
+
+	c, _ := fifocache.NewShardedCache(3, 1)
+	c.Put([]byte("key0"), 0)
+	c.Put([]byte("key1"), 1)
+
+	c.Resize(5)
+	c.Put([]byte("key2"), 2)
+
+	assert.Equal(t, 5, c.MaxSize())
+	assert.Equal(t, 3, c.Len())
+	assert.True(t, c.Has([]byte("key0")))
+}
+
+func TestFIFOShardedCache_ResizeShrinkShouldEvictTheItemsWhichDoNotFit(t *testing.T) {
+	t.Parallel()
+
+	c, _ := fifocache.NewShardedCache(4, 1)
+	for i := 0; i < 4; i++ {
+		c.Put([]byte(fmt.Sprintf("key%d", i)), i)
+	}
+
+	c.Resize(2)
+	c.Put([]byte("key4"), 4)
+
+	assert.Equal(t, 2, c.MaxSize())
+	assert.True(t, c.Len() <= 2)
+	assert.True(t, c.Has([]byte("key4")))
+}
+
+func TestFIFOShardedCache_ResizeToZeroShouldNotChangeTheSize(t *testing.T) {
+	t.Parallel()
+
+	c, _ := fifocache.NewShardedCache(4, 1)
+	c.Resize(0)
+
+	assert.Equal(t, 4, c.MaxSize())
+}
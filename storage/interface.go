@@ -66,6 +66,14 @@ type Cacher interface {
 	IsInterfaceNil() bool
 }
 
+// ResizableCacher is a cacher whose maximum number of items can be changed at runtime
+type ResizableCacher interface {
+	Cacher
+	// Resize changes the maximum number of items which can be stored in the cache, evicting the ones which do
+	// not fit anymore
+	Resize(size int)
+}
+
 // BloomFilter provides services for filtering database requests
 type BloomFilter interface {
 	//Add adds the value to the bloom filter
@@ -88,5 +96,6 @@ type Storer interface {
 	Remove(key []byte) error
 	ClearCache()
 	DestroyUnit() error
+	Close() error
 	IsInterfaceNil() bool
 }
@@ -7,12 +7,13 @@ import (
 	"github.com/hashicorp/golang-lru"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("storage/lrucache")
 
 // LRUCache implements a Least Recently Used eviction cache
 type LRUCache struct {
-	cache   *lru.Cache
-	maxsize int
+	cache      *lru.Cache
+	mutMaxSize sync.RWMutex
+	maxsize    int
 
 	mutAddedDataHandlers sync.RWMutex
 	addedDataHandlers    []func(key []byte)
@@ -139,9 +140,26 @@ func (c *LRUCache) Len() int {
 
 // MaxSize returns the maximum number of items which can be stored in cache.
 func (c *LRUCache) MaxSize() int {
+	c.mutMaxSize.RLock()
+	defer c.mutMaxSize.RUnlock()
+
 	return c.maxsize
 }
 
+// Resize changes the maximum number of items which can be stored in cache, evicting the least recently used items
+// which do not fit anymore
+func (c *LRUCache) Resize(size int) {
+	if size <= 0 {
+		log.Debug("attempt to resize a cacher object to a size lower than one", "size", size)
+		return
+	}
+
+	c.mutMaxSize.Lock()
+	c.cache.Resize(size)
+	c.maxsize = size
+	c.mutMaxSize.Unlock()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (c *LRUCache) IsInterfaceNil() bool {
 	if c == nil {
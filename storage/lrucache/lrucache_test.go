@@ -399,3 +399,42 @@ func TestLRUCache_CacherRegisterHasOrAddAddedDataHandlerNotAddedShouldNotCall(t
 
 	assert.Equal(t, 1, len(c.AddedDataHandlers()))
 }
+
+func TestLRUCache_ResizeShouldEvictTheLeastRecentlyUsedItems(t *testing.T) {
+	t.Parallel()
+
+	c, _ := lrucache.NewCache(4)
+	for i := 0; i < 4; i++ {
+		c.Put([]byte(fmt.Sprintf("key%d", i)), i)
+	}
+
+	c.Resize(2)
+
+	assert.Equal(t, 2, c.MaxSize())
+	assert.Equal(t, 2, c.Len())
+	assert.False(t, c.Has([]byte("key1")))
+	assert.True(t, c.Has([]byte("key3")))
+}
+
+func TestLRUCache_ResizeToZeroShouldNotChangeTheSize(t *testing.T) {
+	t.Parallel()
+
+	c, _ := lrucache.NewCache(4)
+	c.Resize(0)
+
+	assert.Equal(t, 4, c.MaxSize())
+}
+
+func TestLRUCache_ResizeGrowShouldKeepTheItems(t *testing.T) {
+	t.Parallel()
+
+	c, _ := lrucache.NewCache(2)
+	c.Put([]byte("key0"), 0)
+	c.Put([]byte("key1"), 1)
+
+	c.Resize(3)
+	c.Put([]byte("key2"), 2)
+
+	assert.Equal(t, 3, c.Len())
+	assert.True(t, c.Has([]byte("key0")))
+}
@@ -15,9 +15,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
 	"github.com/ElrondNetwork/elrond-go/storage/boltdb"
+	"github.com/ElrondNetwork/elrond-go/storage/encrypteddb"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/tiereddb"
 )
 
 // CacheType represents the type of the supported caches
@@ -74,6 +76,8 @@ type DBConfig struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Encrypted         bool
+	EncryptionKey     []byte
 }
 
 // BloomConfig holds the configurable elements of a bloom filter
@@ -191,6 +195,14 @@ func (s *Unit) DestroyUnit() error {
 	return s.persister.Destroy()
 }
 
+// Close closes the underlying persister, flushing its pending writes to disk
+func (s *Unit) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.persister.Close()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *Unit) IsInterfaceNil() bool {
 	if s == nil {
@@ -268,7 +280,7 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterC
 		return nil, err
 	}
 
-	db, err = NewDB(dbConf.Type, dbConf.FilePath, dbConf.BatchDelaySeconds, dbConf.MaxBatchSize, dbConf.MaxOpenFiles)
+	db, err = newDBFromConf(dbConf, dbConf.FilePath)
 	if err != nil {
 		return nil, err
 	}
@@ -304,7 +316,7 @@ func NewShardedStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloom
 	}
 
 	filePath := fmt.Sprintf("%s%d", dbConf.FilePath, shardId)
-	db, err = NewDB(dbConf.Type, filePath, dbConf.BatchDelaySeconds, dbConf.MaxBatchSize, dbConf.MaxOpenFiles)
+	db, err = newDBFromConf(dbConf, filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -321,6 +333,49 @@ func NewShardedStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloom
 	return NewStorageUnitWithBloomFilter(cache, db, bf)
 }
 
+// NewTieredStorageUnitFromConf creates a new storage unit whose data is kept in a tiered database: the active epoch
+// under the path of the database config and the older epochs under the provided cold path. The tiered database is
+// returned too, as it needs to be notified when the epoch changes
+func NewTieredStorageUnitFromConf(
+	cacheConf CacheConfig,
+	dbConf DBConfig,
+	bloomFilterConf BloomConfig,
+	coldPath string,
+) (*Unit, *tiereddb.DB, error) {
+	cache, err := NewCache(cacheConf.Type, cacheConf.Size, cacheConf.Shards)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := tiereddb.NewDB(tiereddb.ArgDB{
+		HotPath:  dbConf.FilePath,
+		ColdPath: coldPath,
+		PersisterFactory: func(path string) (storage.Persister, error) {
+			return newDBFromConf(dbConf, path)
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var unit *Unit
+	if reflect.DeepEqual(bloomFilterConf, BloomConfig{}) {
+		unit, err = NewStorageUnit(cache, db)
+	} else {
+		var bf storage.BloomFilter
+		bf, err = NewBloomFilter(bloomFilterConf)
+		if err == nil {
+			unit, err = NewStorageUnitWithBloomFilter(cache, db, bf)
+		}
+	}
+	if err != nil {
+		_ = db.Close()
+		return nil, nil, err
+	}
+
+	return unit, db, nil
+}
+
 //NewCache creates a new cache from a cache config
 //TODO: add a cacher factory or a cacheConfig param instead
 func NewCache(cacheType CacheType, size uint32, shards uint32) (storage.Cacher, error) {
@@ -372,6 +427,25 @@ func NewDB(dbType DBType, path string, batchDelaySeconds int, maxBatchSize int,
 	return db, nil
 }
 
+// newDBFromConf creates a new database at the given path, encrypting its data if the config requires it
+func newDBFromConf(dbConf DBConfig, path string) (storage.Persister, error) {
+	db, err := NewDB(dbConf.Type, path, dbConf.BatchDelaySeconds, dbConf.MaxBatchSize, dbConf.MaxOpenFiles)
+	if err != nil {
+		return nil, err
+	}
+	if !dbConf.Encrypted {
+		return db, nil
+	}
+
+	encryptedDB, err := encrypteddb.NewDB(db, dbConf.EncryptionKey)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return encryptedDB, nil
+}
+
 // NewBloomFilter creates a new bloom filter from bloom filter config
 func NewBloomFilter(conf BloomConfig) (storage.BloomFilter, error) {
 	var bf storage.BloomFilter
@@ -452,6 +452,12 @@ func TestDestroyUnitWithNilBloomFilterNoError(t *testing.T) {
 	assert.Nil(t, err, "no error expected, but got %s", err)
 }
 
+func TestCloseUnitNoError(t *testing.T) {
+	s := initStorageUnitWithBloomFilter(t, 10)
+	err := s.Close()
+	assert.Nil(t, err, "no error expected, but got %s", err)
+}
+
 func TestCreateCacheFromConfWrongType(t *testing.T) {
 
 	cacher, err := storageUnit.NewCache("NotLRU", 100, 1)
@@ -616,6 +622,55 @@ func TestNewStorageUnit_FromConfLvlDBOk(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestNewTieredStorageUnit_FromConfWrongColdPath(t *testing.T) {
+	storer, db, err := storageUnit.NewTieredStorageUnitFromConf(storageUnit.CacheConfig{
+		Size: 10,
+		Type: storageUnit.LRUCache,
+	}, storageUnit.DBConfig{
+		FilePath:          "Blocks",
+		Type:              storageUnit.LvlDB,
+		MaxBatchSize:      1,
+		BatchDelaySeconds: 1,
+		MaxOpenFiles:      10,
+	}, storageUnit.BloomConfig{}, "")
+
+	assert.Equal(t, storage.ErrInvalidTieredDBPath, err)
+	assert.Nil(t, storer)
+	assert.Nil(t, db)
+}
+
+func TestNewTieredStorageUnit_FromConfLvlDBOk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tiereddb_temp")
+	assert.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	storer, db, err := storageUnit.NewTieredStorageUnitFromConf(storageUnit.CacheConfig{
+		Size: 10,
+		Type: storageUnit.LRUCache,
+	}, storageUnit.DBConfig{
+		FilePath:          filepath.Join(dir, "hot"),
+		Type:              storageUnit.LvlDB,
+		MaxBatchSize:      1,
+		BatchDelaySeconds: 1,
+		MaxOpenFiles:      10,
+	}, storageUnit.BloomConfig{}, filepath.Join(dir, "cold"))
+
+	assert.Nil(t, err)
+	assert.NotNil(t, db)
+
+	err = storer.Put([]byte("key"), []byte("value"))
+	assert.Nil(t, err)
+	db.EpochConfirmed(1)
+	storer.ClearCache()
+	val, err := storer.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), val)
+	err = storer.DestroyUnit()
+	assert.Nil(t, err)
+}
+
 func TestNewStorageUnit_FromConfBoltDBOk(t *testing.T) {
 	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
 		Size: 10,
@@ -658,6 +713,56 @@ func TestNewStorageUnit_FromConfBadgerDBOk(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestNewStorageUnit_FromConfEncryptedWithInvalidKeyShouldErr(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "leveldb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
+		Size: 10,
+		Type: storageUnit.LRUCache,
+	}, storageUnit.DBConfig{
+		FilePath:          dir,
+		Type:              storageUnit.LvlDB,
+		MaxBatchSize:      1,
+		BatchDelaySeconds: 1,
+		MaxOpenFiles:      10,
+		Encrypted:         true,
+		EncryptionKey:     []byte("invalid key"),
+	}, storageUnit.BloomConfig{})
+
+	assert.Equal(t, storage.ErrInvalidEncryptionKey, err)
+	assert.Nil(t, storer)
+}
+
+func TestNewStorageUnit_FromConfEncryptedLvlDBOk(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "leveldb_temp")
+	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
+		Size: 10,
+		Type: storageUnit.LRUCache,
+	}, storageUnit.DBConfig{
+		FilePath:          dir,
+		Type:              storageUnit.LvlDB,
+		MaxBatchSize:      1,
+		BatchDelaySeconds: 1,
+		MaxOpenFiles:      10,
+		Encrypted:         true,
+		EncryptionKey:     make([]byte, 32),
+	}, storageUnit.BloomConfig{})
+
+	assert.Nil(t, err, "no error expected but got %s", err)
+	err = storer.Put([]byte("key"), []byte("value"))
+	assert.Nil(t, err)
+	storer.ClearCache()
+	value, err := storer.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	err = storer.DestroyUnit()
+	assert.Nil(t, err, "no error expected destroying the persister")
+}
+
 func TestNewStorageUnit_WithBlankBloomFilterShouldWorkLvlDB(t *testing.T) {
 	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
 		Size: 10,
@@ -0,0 +1,422 @@
+package tiereddb
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("storage/tiereddb")
+
+const epochDirectoryPrefix = "Epoch_"
+
+// PersisterFactory creates the persister of an epoch in the provided directory
+type PersisterFactory func(path string) (storage.Persister, error)
+
+// ArgDB holds all dependencies required by the tiered database in order to create a new instance
+type ArgDB struct {
+	HotPath          string
+	ColdPath         string
+	PersisterFactory PersisterFactory
+}
+
+type epochPersister struct {
+	epoch     uint32
+	path      string
+	persister storage.Persister
+}
+
+// DB keeps the data of each epoch in its own persister. The persister of the active epoch, the only one written, is
+// placed on the hot path while the persisters of the older epochs are moved on the cold path when the epoch changes.
+// The lookups go through the active epoch first and then through the older epochs, the newest first
+// TODO: without a pruning storer copying the still referenced trie nodes in the active epoch, the trie nodes which did
+// not change since an older epoch are read from the cold path. The moved epochs are never removed either
+type DB struct {
+	hotPath          string
+	coldPath         string
+	persisterFactory PersisterFactory
+
+	mutPersisters sync.RWMutex
+	hot           *epochPersister
+	cold          []*epochPersister
+}
+
+// NewDB creates a new tiered database. The active epoch is the newest epoch found on the hot path, the other epochs
+// found on the hot path being moved on the cold path
+func NewDB(args ArgDB) (*DB, error) {
+	if len(args.HotPath) == 0 || len(args.ColdPath) == 0 {
+		return nil, storage.ErrInvalidTieredDBPath
+	}
+	if filepath.Clean(args.HotPath) == filepath.Clean(args.ColdPath) {
+		return nil, storage.ErrInvalidTieredDBPath
+	}
+	if args.PersisterFactory == nil {
+		return nil, storage.ErrNilPersisterFactory
+	}
+
+	db := &DB{
+		hotPath:          args.HotPath,
+		coldPath:         args.ColdPath,
+		persisterFactory: args.PersisterFactory,
+	}
+
+	hotEpochs, err := epochDirectories(args.HotPath)
+	if err != nil {
+		return nil, err
+	}
+
+	activeEpoch := uint32(0)
+	if len(hotEpochs) > 0 {
+		activeEpoch = hotEpochs[len(hotEpochs)-1]
+		hotEpochs = hotEpochs[:len(hotEpochs)-1]
+	}
+	for _, epoch := range hotEpochs {
+		err = moveDirectory(db.epochPath(args.HotPath, epoch), db.epochPath(args.ColdPath, epoch))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = db.openPersisters(activeEpoch)
+	if err != nil {
+		_ = db.closePersisters()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func (db *DB) openPersisters(activeEpoch uint32) error {
+	coldEpochs, err := epochDirectories(db.coldPath)
+	if err != nil {
+		return err
+	}
+
+	for i := len(coldEpochs) - 1; i >= 0; i-- {
+		cold, err := db.openEpoch(db.coldPath, coldEpochs[i])
+		if err != nil {
+			return err
+		}
+		db.cold = append(db.cold, cold)
+	}
+
+	db.hot, err = db.openEpoch(db.hotPath, activeEpoch)
+
+	return err
+}
+
+func (db *DB) openEpoch(basePath string, epoch uint32) (*epochPersister, error) {
+	path := db.epochPath(basePath, epoch)
+	persister, err := db.persisterFactory(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &epochPersister{
+		epoch:     epoch,
+		path:      path,
+		persister: persister,
+	}, nil
+}
+
+func (db *DB) epochPath(basePath string, epoch uint32) string {
+	return filepath.Join(basePath, fmt.Sprintf("%s%d", epochDirectoryPrefix, epoch))
+}
+
+// EpochConfirmed makes the provided epoch the active one, moving the previously active epoch on the cold path. The
+// reads and the writes wait for the movement to finish
+func (db *DB) EpochConfirmed(epoch uint32) {
+	db.mutPersisters.Lock()
+	defer db.mutPersisters.Unlock()
+
+	if db.hot == nil || epoch <= db.hot.epoch {
+		return
+	}
+
+	previous := db.hot
+	newHot, err := db.openEpoch(db.hotPath, epoch)
+	if err != nil {
+		log.Error("tiered db: can not create the persister of the new epoch", "epoch", epoch, "error", err.Error())
+		return
+	}
+	db.hot = newHot
+
+	startTime := time.Now()
+	moved, err := db.moveEpoch(previous)
+	if err != nil {
+		log.Error("tiered db: can not move the epoch on the cold path, keeping it on the hot path",
+			"epoch", previous.epoch,
+			"error", err.Error(),
+		)
+	} else {
+		log.Debug("tiered db: epoch moved on the cold path",
+			"epoch", previous.epoch,
+			"duration", time.Since(startTime),
+		)
+	}
+	db.cold = append([]*epochPersister{moved}, db.cold...)
+}
+
+// moveEpoch closes the persister of the epoch, moves its directory on the cold path and opens it again. If the
+// movement fails, the persister is opened again from the hot path
+func (db *DB) moveEpoch(previous *epochPersister) (*epochPersister, error) {
+	err := previous.persister.Close()
+	if err != nil {
+		return previous, err
+	}
+
+	err = moveDirectory(previous.path, db.epochPath(db.coldPath, previous.epoch))
+	if err == nil {
+		moved, errOpen := db.openEpoch(db.coldPath, previous.epoch)
+		if errOpen == nil {
+			return moved, nil
+		}
+		err = errOpen
+	}
+
+	reopened, errOpen := db.openEpoch(db.hotPath, previous.epoch)
+	if errOpen != nil {
+		log.Error("tiered db: can not open the epoch again", "epoch", previous.epoch, "error", errOpen.Error())
+		return previous, err
+	}
+
+	return reopened, err
+}
+
+// Put adds the value to the persister of the active epoch
+func (db *DB) Put(key, val []byte) error {
+	db.mutPersisters.RLock()
+	defer db.mutPersisters.RUnlock()
+
+	return db.hot.persister.Put(key, val)
+}
+
+// Get gets the value associated to the key from the newest epoch holding it
+func (db *DB) Get(key []byte) ([]byte, error) {
+	db.mutPersisters.RLock()
+	defer db.mutPersisters.RUnlock()
+
+	val, err := db.hot.persister.Get(key)
+	if err == nil {
+		return val, nil
+	}
+
+	for _, cold := range db.cold {
+		val, err = cold.persister.Get(key)
+		if err == nil {
+			return val, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Has returns nil if the key is present in any of the epochs
+func (db *DB) Has(key []byte) error {
+	db.mutPersisters.RLock()
+	defer db.mutPersisters.RUnlock()
+
+	if db.hot.persister.Has(key) == nil {
+		return nil
+	}
+	for _, cold := range db.cold {
+		if cold.persister.Has(key) == nil {
+			return nil
+		}
+	}
+
+	return storage.ErrKeyNotFound
+}
+
+// Init initializes the persisters of all the epochs
+func (db *DB) Init() error {
+	db.mutPersisters.RLock()
+	defer db.mutPersisters.RUnlock()
+
+	for _, epochPersister := range db.allPersisters() {
+		err := epochPersister.persister.Init()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close closes the persisters of all the epochs
+func (db *DB) Close() error {
+	db.mutPersisters.Lock()
+	defer db.mutPersisters.Unlock()
+
+	return db.closePersisters()
+}
+
+func (db *DB) closePersisters() error {
+	var lastErr error
+	for _, epochPersister := range db.allPersisters() {
+		err := epochPersister.persister.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// Remove removes the data associated to the given key from all the epochs
+func (db *DB) Remove(key []byte) error {
+	db.mutPersisters.RLock()
+	defer db.mutPersisters.RUnlock()
+
+	for _, epochPersister := range db.allPersisters() {
+		err := epochPersister.persister.Remove(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Destroy removes the data of all the epochs
+func (db *DB) Destroy() error {
+	db.mutPersisters.Lock()
+	defer db.mutPersisters.Unlock()
+
+	var lastErr error
+	for _, epochPersister := range db.allPersisters() {
+		err := epochPersister.persister.Destroy()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+func (db *DB) allPersisters() []*epochPersister {
+	persisters := make([]*epochPersister, 0, len(db.cold)+1)
+	if db.hot != nil {
+		persisters = append(persisters, db.hot)
+	}
+
+	return append(persisters, db.cold...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (db *DB) IsInterfaceNil() bool {
+	if db == nil {
+		return true
+	}
+	return false
+}
+
+// epochDirectories returns the epochs found in the provided directory, sorted ascending
+func epochDirectories(path string) ([]uint32, error) {
+	entries, err := ioutil.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]uint32, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), epochDirectoryPrefix) {
+			continue
+		}
+
+		epoch, err := strconv.ParseUint(strings.TrimPrefix(entry.Name(), epochDirectoryPrefix), 10, 32)
+		if err != nil {
+			continue
+		}
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, nil
+}
+
+// moveDirectory renames the source directory as the destination one. When the paths are on different devices, the
+// source is copied and then removed
+func moveDirectory(source string, destination string) error {
+	err := os.MkdirAll(filepath.Dir(destination), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(source, destination)
+	if err == nil {
+		return nil
+	}
+
+	err = copyDirectory(source, destination)
+	if err != nil {
+		_ = os.RemoveAll(destination)
+		return err
+	}
+
+	return os.RemoveAll(source)
+}
+
+func copyDirectory(source string, destination string) error {
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(destination, relativePath)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(source string, destination string, mode os.FileMode) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+
+	out, err := os.OpenFile(destination, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(out, in)
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+
+	err = out.Sync()
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+
+	return out.Close()
+}
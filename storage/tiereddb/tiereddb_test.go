@@ -0,0 +1,223 @@
+package tiereddb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/tiereddb"
+	"github.com/stretchr/testify/assert"
+)
+
+func createArgDB(t *testing.T) tiereddb.ArgDB {
+	dir, err := ioutil.TempDir("", "tiereddb_temp")
+	assert.Nil(t, err)
+
+	return tiereddb.ArgDB{
+		HotPath:  filepath.Join(dir, "hot"),
+		ColdPath: filepath.Join(dir, "cold"),
+		PersisterFactory: func(path string) (storage.Persister, error) {
+			return leveldb.NewDB(path, 1, 1, 10)
+		},
+	}
+}
+
+func removeDirectories(args tiereddb.ArgDB) {
+	_ = os.RemoveAll(filepath.Dir(args.HotPath))
+}
+
+func directoryExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+//------- NewDB
+
+func TestNewDB_EmptyPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	args.ColdPath = ""
+	db, err := tiereddb.NewDB(args)
+
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrInvalidTieredDBPath, err)
+}
+
+func TestNewDB_SamePathsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	args.ColdPath = args.HotPath + string(filepath.Separator)
+	db, err := tiereddb.NewDB(args)
+
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrInvalidTieredDBPath, err)
+}
+
+func TestNewDB_NilPersisterFactoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	args.PersisterFactory = nil
+	db, err := tiereddb.NewDB(args)
+
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrNilPersisterFactory, err)
+}
+
+func TestNewDB_ShouldCreateTheFirstEpochOnTheHotPath(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	db, err := tiereddb.NewDB(args)
+
+	assert.Nil(t, err)
+	assert.False(t, db.IsInterfaceNil())
+	assert.True(t, directoryExists(filepath.Join(args.HotPath, "Epoch_0")))
+	_ = db.Close()
+}
+
+//------- EpochConfirmed
+
+func TestDB_EpochConfirmedShouldMoveThePreviousEpochOnTheColdPath(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	db, _ := tiereddb.NewDB(args)
+
+	_ = db.Put([]byte("key0"), []byte("value0"))
+	db.EpochConfirmed(1)
+	_ = db.Put([]byte("key1"), []byte("value1"))
+
+	assert.False(t, directoryExists(filepath.Join(args.HotPath, "Epoch_0")))
+	assert.True(t, directoryExists(filepath.Join(args.ColdPath, "Epoch_0")))
+	assert.True(t, directoryExists(filepath.Join(args.HotPath, "Epoch_1")))
+
+	val, err := db.Get([]byte("key0"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value0"), val)
+	val, err = db.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), val)
+	assert.Nil(t, db.Has([]byte("key0")))
+	_ = db.Close()
+}
+
+func TestDB_EpochConfirmedOlderEpochShouldNotChangeTheActiveEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	db, _ := tiereddb.NewDB(args)
+
+	db.EpochConfirmed(2)
+	db.EpochConfirmed(1)
+	db.EpochConfirmed(2)
+
+	assert.True(t, directoryExists(filepath.Join(args.HotPath, "Epoch_2")))
+	assert.False(t, directoryExists(filepath.Join(args.HotPath, "Epoch_1")))
+	_ = db.Close()
+}
+
+func TestDB_GetNewestEpochShouldWin(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	db, _ := tiereddb.NewDB(args)
+
+	_ = db.Put([]byte("key"), []byte("old value"))
+	db.EpochConfirmed(1)
+	_ = db.Put([]byte("key"), []byte("new value"))
+
+	val, _ := db.Get([]byte("key"))
+	assert.Equal(t, []byte("new value"), val)
+	_ = db.Close()
+}
+
+func TestDB_GetMissingKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	db, _ := tiereddb.NewDB(args)
+	db.EpochConfirmed(1)
+
+	val, err := db.Get([]byte("missing key"))
+	assert.Nil(t, val)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("missing key")))
+	_ = db.Close()
+}
+
+func TestDB_RemoveShouldRemoveFromAllTheEpochs(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	db, _ := tiereddb.NewDB(args)
+
+	_ = db.Put([]byte("key"), []byte("old value"))
+	db.EpochConfirmed(1)
+	_ = db.Put([]byte("key"), []byte("new value"))
+
+	err := db.Remove([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("key")))
+	_ = db.Close()
+}
+
+//------- reopening
+
+func TestNewDB_ReopenShouldResumeTheActiveEpochAndFindTheColdOnes(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	db, _ := tiereddb.NewDB(args)
+	_ = db.Put([]byte("key0"), []byte("value0"))
+	db.EpochConfirmed(3)
+	_ = db.Put([]byte("key3"), []byte("value3"))
+	_ = db.Close()
+
+	db, err := tiereddb.NewDB(args)
+	assert.Nil(t, err)
+
+	val, _ := db.Get([]byte("key0"))
+	assert.Equal(t, []byte("value0"), val)
+	val, _ = db.Get([]byte("key3"))
+	assert.Equal(t, []byte("value3"), val)
+	assert.True(t, directoryExists(filepath.Join(args.HotPath, "Epoch_3")))
+	_ = db.Close()
+}
+
+func TestNewDB_OlderEpochsLeftOnTheHotPathShouldBeMoved(t *testing.T) {
+	t.Parallel()
+
+	args := createArgDB(t)
+	defer removeDirectories(args)
+	for _, epoch := range []string{"Epoch_1", "Epoch_4"} {
+		persister, _ := args.PersisterFactory(filepath.Join(args.HotPath, epoch))
+		_ = persister.Put([]byte(epoch), []byte(epoch))
+		_ = persister.Close()
+	}
+
+	db, err := tiereddb.NewDB(args)
+	assert.Nil(t, err)
+
+	assert.True(t, directoryExists(filepath.Join(args.ColdPath, "Epoch_1")))
+	assert.False(t, directoryExists(filepath.Join(args.HotPath, "Epoch_1")))
+	assert.True(t, directoryExists(filepath.Join(args.HotPath, "Epoch_4")))
+	val, _ := db.Get([]byte("Epoch_1"))
+	assert.Equal(t, []byte("Epoch_1"), val)
+	_ = db.Close()
+}
@@ -52,3 +52,21 @@ var ErrInvalidStakeValue = errors.New("bad config value for initial stake")
 
 // ErrNilInitialStakeValue signals that nil initial stake value was provided
 var ErrNilInitialStakeValue = errors.New("initial stake value is nil")
+
+// ErrInvalidDelegationSCAddressPrefix signals that the prefix of the delegation contracts addresses is invalid
+var ErrInvalidDelegationSCAddressPrefix = errors.New("invalid delegation contracts address prefix")
+
+// ErrNilMessageSignVerifier signals that a nil message sign verifier was provided
+var ErrNilMessageSignVerifier = errors.New("message sign verifier is nil")
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("key generator is nil")
+
+// ErrNilSingleSigner signals that a nil single signer was provided
+var ErrNilSingleSigner = errors.New("single signer is nil")
+
+// ErrBLSKeyNotRegistered signals that the BLS key is not registered by the caller
+var ErrBLSKeyNotRegistered = errors.New("BLS key is not registered by the caller")
+
+// ErrBLSKeyNotJailed signals that the BLS key is not jailed
+var ErrBLSKeyNotJailed = errors.New("BLS key is not jailed")
@@ -4,3 +4,13 @@ package factory
 
 // StakingSCAddress is the hard-coded address for smart contracts
 var StakingSCAddress = []byte("000000000100000000000000000000FF")
+
+// DNSSCAddress is the hard-coded address of the smart contract which keeps the registered user names
+var DNSSCAddress = []byte("000000000100000000000000000001FF")
+
+// DelegationManagerSCAddress is the hard-coded address of the smart contract which creates the delegation contracts
+var DelegationManagerSCAddress = []byte("000000000100000000000000000002FF")
+
+// DelegationSCAddressPrefix is the prefix of the addresses of the delegation contracts created by the delegation
+// manager. The delegation contract template is registered under this prefix
+var DelegationSCAddressPrefix = []byte("00000000010000000001")
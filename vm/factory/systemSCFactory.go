@@ -3,6 +3,9 @@ package factory
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 )
@@ -10,6 +13,16 @@ import (
 // TODO var initialStakeValue = big.NewInt(500000).Mul(core.Erd) and add to config.toml
 var initialStakeValue = "500000000000000000000000"
 
+// InitialStakeValue returns the value which has to be staked in order to become a validator
+func InitialStakeValue() (*big.Int, error) {
+	stakeValue, ok := big.NewInt(0).SetString(initialStakeValue, 10)
+	if !ok {
+		return nil, vm.ErrInvalidStakeValue
+	}
+
+	return stakeValue, nil
+}
+
 type systemSCFactory struct {
 	systemEI vm.SystemEI
 }
@@ -27,12 +40,20 @@ func NewSystemSCFactory(systemEI vm.SystemEI) (*systemSCFactory, error) {
 func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 	scContainer := NewSystemSCContainer()
 
-	initValue, ok := big.NewInt(0).SetString(initialStakeValue, 10)
-	if !ok {
-		return nil, vm.ErrInvalidStakeValue
+	initValue, err := InitialStakeValue()
+	if err != nil {
+		return nil, err
 	}
 
-	sc, err := systemSmartContracts.NewStakingSmartContract(initValue, scf.systemEI)
+	sigVerifier, err := systemSmartContracts.NewMessageSigVerifier(
+		signing.NewKeyGenerator(kyber.NewSuitePairingBn256()),
+		&singlesig.BlsSingleSigner{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sc, err := systemSmartContracts.NewStakingSmartContract(initValue, scf.systemEI, sigVerifier)
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +63,36 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 		return nil, err
 	}
 
+	dns, err := systemSmartContracts.NewDNSSmartContract(scf.systemEI)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scContainer.Add(DNSSCAddress, dns)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationManager, err := systemSmartContracts.NewDelegationManagerSmartContract(scf.systemEI, DelegationSCAddressPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scContainer.Add(DelegationManagerSCAddress, delegationManager)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := systemSmartContracts.NewDelegationSmartContract(scf.systemEI)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scContainer.Add(DelegationSCAddressPrefix, delegation)
+	if err != nil {
+		return nil, err
+	}
+
 	return scContainer, nil
 }
 
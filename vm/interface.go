@@ -36,7 +36,9 @@ type SystemEI interface {
 	GetBalance(addr []byte) *big.Int
 	SetStorage(key []byte, value []byte)
 	GetStorage(key []byte) []byte
+	SetStorageForAddress(address []byte, key []byte, value []byte)
 	SelfDestruct(beneficiary []byte)
+	Finish(value []byte)
 
 	CreateVMOutput() *vmcommon.VMOutput
 	CleanCache()
@@ -54,3 +56,9 @@ type PeerChangesEI interface {
 	CreatePeerChangesOutput()
 	IsInterfaceNil() bool
 }
+
+// MessageSignVerifier is used to verify that a message was signed with the private key of the given public key
+type MessageSignVerifier interface {
+	Verify(message []byte, signedMessage []byte, pubKey []byte) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,19 @@
+package mock
+
+type MessageSignVerifierStub struct {
+	VerifyCalled func(message []byte, signedMessage []byte, pubKey []byte) error
+}
+
+func (m *MessageSignVerifierStub) Verify(message []byte, signedMessage []byte, pubKey []byte) error {
+	if m.VerifyCalled != nil {
+		return m.VerifyCalled(message, signedMessage, pubKey)
+	}
+	return nil
+}
+
+func (m *MessageSignVerifierStub) IsInterfaceNil() bool {
+	if m == nil {
+		return true
+	}
+	return false
+}
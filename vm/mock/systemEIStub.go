@@ -6,13 +6,15 @@ import (
 )
 
 type SystemEIStub struct {
-	TransferCalled       func(destination []byte, sender []byte, value *big.Int, input []byte) error
-	GetBalanceCalled     func(addr []byte) *big.Int
-	SetStorageCalled     func(key []byte, value []byte)
-	GetStorageCalled     func(key []byte) []byte
-	SelfDestructCalled   func(beneficiary []byte)
-	CreateVMOutputCalled func() *vmcommon.VMOutput
-	CleanCacheCalled     func()
+	TransferCalled             func(destination []byte, sender []byte, value *big.Int, input []byte) error
+	GetBalanceCalled           func(addr []byte) *big.Int
+	SetStorageCalled           func(key []byte, value []byte)
+	GetStorageCalled           func(key []byte) []byte
+	SetStorageForAddressCalled func(address []byte, key []byte, value []byte)
+	SelfDestructCalled         func(beneficiary []byte)
+	FinishCalled               func(value []byte)
+	CreateVMOutputCalled       func() *vmcommon.VMOutput
+	CleanCacheCalled           func()
 }
 
 func (s *SystemEIStub) SetSCAddress(addr []byte) {
@@ -45,6 +47,12 @@ func (s *SystemEIStub) GetStorage(key []byte) []byte {
 	return nil
 }
 
+func (s *SystemEIStub) SetStorageForAddress(address []byte, key []byte, value []byte) {
+	if s.SetStorageForAddressCalled != nil {
+		s.SetStorageForAddressCalled(address, key, value)
+	}
+}
+
 func (s *SystemEIStub) SelfDestruct(beneficiary []byte) {
 	if s.SelfDestructCalled != nil {
 		s.SelfDestructCalled(beneficiary)
@@ -52,6 +60,12 @@ func (s *SystemEIStub) SelfDestruct(beneficiary []byte) {
 	return
 }
 
+func (s *SystemEIStub) Finish(value []byte) {
+	if s.FinishCalled != nil {
+		s.FinishCalled(value)
+	}
+}
+
 func (s *SystemEIStub) CreateVMOutput() *vmcommon.VMOutput {
 	if s.CreateVMOutputCalled != nil {
 		return s.CreateVMOutputCalled()
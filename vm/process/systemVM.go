@@ -1,7 +1,10 @@
 package process
 
 import (
+	"bytes"
+
 	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -76,7 +79,7 @@ func (s *systemVM) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmc
 	s.systemEI.CleanCache()
 	s.systemEI.SetSCAddress(input.RecipientAddr)
 
-	contract, err := s.systemContracts.Get(input.RecipientAddr)
+	contract, err := s.getContract(input.RecipientAddr)
 	if err != nil {
 		return nil, vm.ErrUnknownSystemSmartContract
 	}
@@ -92,3 +95,17 @@ func (s *systemVM) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmc
 
 	return vmOutput, nil
 }
+
+// getContract returns the system smart contract deployed at the given address. The delegation contracts created by
+// the delegation manager are run by the delegation contract template
+func (s *systemVM) getContract(address []byte) (vm.SystemSmartContract, error) {
+	contract, err := s.systemContracts.Get(address)
+	if err == nil {
+		return contract, nil
+	}
+	if !bytes.HasPrefix(address, factory.DelegationSCAddressPrefix) {
+		return nil, err
+	}
+
+	return s.systemContracts.Get(factory.DelegationSCAddressPrefix)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/vm"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -92,3 +93,24 @@ func TestSystemVM_RunSmartContractCall(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
 }
+
+func TestSystemVM_RunSmartContractCallOnDelegationContractShouldUseTheTemplate(t *testing.T) {
+	t.Parallel()
+
+	delegationTemplate := &mock.SystemSCStub{ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+		return vmcommon.Ok
+	}}
+	container := &mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, e error) {
+		if bytes.Equal(vmFactory.DelegationSCAddressPrefix, key) {
+			return delegationTemplate, nil
+		}
+		return nil, vm.ErrUnknownSystemSmartContract
+	}}
+
+	systemVM, _ := NewSystemVM(&mock.SystemEIStub{}, container, factory.SystemVirtualMachine)
+
+	delegationSCAddress := append(append([]byte{}, vmFactory.DelegationSCAddressPrefix...), []byte("0000000000FF")...)
+	vmOutput, err := systemVM.RunSmartContractCall(&vmcommon.ContractCallInput{RecipientAddr: delegationSCAddress})
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+}
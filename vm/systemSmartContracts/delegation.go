@@ -0,0 +1,233 @@
+package systemSmartContracts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// maxServiceFee is the service fee of 100%, the service fee being expressed in hundredths of percent
+const maxServiceFee = 10000
+
+const (
+	delegationOwnerKey          = "owner"
+	delegationMaxCapKey         = "maxCap"
+	delegationServiceFeeKey     = "serviceFee"
+	delegationTotalDelegatedKey = "totalDelegated"
+	delegatorKeyPrefix          = "delegator_"
+	delegationNumDelegatorsKey  = "numDelegators"
+	delegatorIndexKeyPrefix     = "delegatorIndex_"
+)
+
+type delegationSC struct {
+	eei vm.SystemEI
+}
+
+// NewDelegationSmartContract creates the delegation contract template which runs all the delegation contracts created
+// by the delegation manager. Each delegation contract keeps its owner, max cap and service fee in its own storage
+func NewDelegationSmartContract(eei vm.SystemEI) (*delegationSC, error) {
+	if eei == nil || eei.IsInterfaceNil() {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+
+	return &delegationSC{eei: eei}, nil
+}
+
+// Execute calls one of the functions from the delegation smart contract and runs the code according to the input
+func (d *delegationSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+	if len(d.eei.GetStorage([]byte(delegationOwnerKey))) == 0 {
+		log.Debug("delegation contract was not created by the delegation manager")
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "delegate":
+		return d.delegate(args)
+	case "getUserStake":
+		return d.getUserStake(args)
+	case "getContractConfig":
+		return d.getContractConfig(args)
+	case "getNumDelegators":
+		return d.getNumDelegators(args)
+	case "getDelegatorsList":
+		return d.getDelegatorsList(args)
+	case "getClaimableRewards":
+		return d.getClaimableRewards(args)
+	}
+
+	return vmcommon.UserError
+}
+
+func (d *delegationSC) delegate(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() <= 0 {
+		log.Debug("delegate needs a positive value")
+		return vmcommon.UserError
+	}
+
+	totalDelegated := d.getBigInt(delegationTotalDelegatedKey)
+	totalDelegated.Add(totalDelegated, args.CallValue)
+	maxCap := d.getBigInt(delegationMaxCapKey)
+	if maxCap.Sign() > 0 && totalDelegated.Cmp(maxCap) > 0 {
+		log.Debug("delegate: the max cap of the delegation contract would be exceeded",
+			"max cap", maxCap.String(),
+			"total delegated", totalDelegated.String(),
+		)
+		return vmcommon.UserError
+	}
+
+	delegatorKey := delegatorKeyPrefix + string(args.CallerAddr)
+	delegatorStake := d.getBigInt(delegatorKey)
+	if delegatorStake.Sign() == 0 {
+		d.addDelegator(args.CallerAddr)
+	}
+	delegatorStake.Add(delegatorStake, args.CallValue)
+
+	d.eei.SetStorage([]byte(delegatorKey), delegatorStake.Bytes())
+	d.eei.SetStorage([]byte(delegationTotalDelegatedKey), totalDelegated.Bytes())
+
+	err := d.eei.Transfer(args.RecipientAddr, args.CallerAddr, args.CallValue, nil)
+	if err != nil {
+		log.Debug("transfer error on delegate function", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// getUserStake returns the value delegated by the address given as argument
+func (d *delegationSC) getUserStake(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("getUserStake does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 || args.Arguments[0] == nil {
+		log.Debug("getUserStake needs exactly one argument")
+		return vmcommon.UserError
+	}
+
+	delegatorStake := d.getBigInt(delegatorKeyPrefix + string(args.Arguments[0].Bytes()))
+	d.eei.Finish(delegatorStake.Bytes())
+
+	return vmcommon.Ok
+}
+
+// getContractConfig returns the owner, the max cap, the service fee and the total delegated value
+func (d *delegationSC) getContractConfig(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("getContractConfig does not accept value")
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(d.eei.GetStorage([]byte(delegationOwnerKey)))
+	d.eei.Finish(d.getBigInt(delegationMaxCapKey).Bytes())
+	d.eei.Finish(d.getBigInt(delegationServiceFeeKey).Bytes())
+	d.eei.Finish(d.getBigInt(delegationTotalDelegatedKey).Bytes())
+
+	return vmcommon.Ok
+}
+
+// getNumDelegators returns how many addresses delegated to the contract
+func (d *delegationSC) getNumDelegators(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("getNumDelegators does not accept value")
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(big.NewInt(0).SetUint64(d.numDelegators()).Bytes())
+
+	return vmcommon.Ok
+}
+
+// getDelegatorsList returns, in the order they first delegated, the address and the stake of at most the given
+// number of delegators, starting with the delegator at the given index
+func (d *delegationSC) getDelegatorsList(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("getDelegatorsList does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 || args.Arguments[0] == nil || args.Arguments[1] == nil {
+		log.Debug("getDelegatorsList needs the start index and the maximum number of delegators")
+		return vmcommon.UserError
+	}
+
+	startIndex := args.Arguments[0].Uint64()
+	endIndex := startIndex + args.Arguments[1].Uint64()
+	numDelegators := d.numDelegators()
+	if endIndex > numDelegators || endIndex < startIndex {
+		endIndex = numDelegators
+	}
+
+	for index := startIndex; index < endIndex; index++ {
+		delegator := d.eei.GetStorage(delegatorIndexKey(index))
+		d.eei.Finish(delegator)
+		d.eei.Finish(d.getBigInt(delegatorKeyPrefix + string(delegator)).Bytes())
+	}
+
+	return vmcommon.Ok
+}
+
+// getClaimableRewards returns the rewards the address given as argument can claim: its share, proportional to its
+// stake, of the contract balance exceeding the total delegated value, after the service fee of the owner
+func (d *delegationSC) getClaimableRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("getClaimableRewards does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 || args.Arguments[0] == nil {
+		log.Debug("getClaimableRewards needs exactly one argument")
+		return vmcommon.UserError
+	}
+
+	delegatorStake := d.getBigInt(delegatorKeyPrefix + string(args.Arguments[0].Bytes()))
+	totalDelegated := d.getBigInt(delegationTotalDelegatedKey)
+	balance := d.eei.GetBalance(args.RecipientAddr)
+	if delegatorStake.Sign() == 0 || balance == nil || balance.Cmp(totalDelegated) <= 0 {
+		d.eei.Finish(big.NewInt(0).Bytes())
+		return vmcommon.Ok
+	}
+
+	rewards := big.NewInt(0).Sub(balance, totalDelegated)
+	rewards.Mul(rewards, big.NewInt(0).Sub(big.NewInt(maxServiceFee), d.getBigInt(delegationServiceFeeKey)))
+	rewards.Div(rewards, big.NewInt(maxServiceFee))
+	rewards.Mul(rewards, delegatorStake)
+	rewards.Div(rewards, totalDelegated)
+	d.eei.Finish(rewards.Bytes())
+
+	return vmcommon.Ok
+}
+
+func (d *delegationSC) addDelegator(address []byte) {
+	numDelegators := d.numDelegators()
+	d.eei.SetStorage(delegatorIndexKey(numDelegators), address)
+	d.eei.SetStorage([]byte(delegationNumDelegatorsKey), big.NewInt(0).SetUint64(numDelegators+1).Bytes())
+}
+
+func (d *delegationSC) numDelegators() uint64 {
+	return d.getBigInt(delegationNumDelegatorsKey).Uint64()
+}
+
+func delegatorIndexKey(index uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", delegatorIndexKeyPrefix, index))
+}
+
+func (d *delegationSC) getBigInt(key string) *big.Int {
+	return big.NewInt(0).SetBytes(d.eei.GetStorage([]byte(key)))
+}
+
+// ValueOf returns the value of a selected key
+func (d *delegationSC) ValueOf(key interface{}) interface{} {
+	return nil
+}
+
+// IsInterfaceNil verifies if the underlying object is nil or not
+func (d *delegationSC) IsInterfaceNil() bool {
+	if d == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package systemSmartContracts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// delegationSCAddressLen is the length of the addresses of the delegation contracts: the prefix, the zero padded
+// index of the contract and the delegationSCAddressSuffix
+const delegationSCAddressLen = 32
+
+const delegationSCAddressSuffix = "FF"
+
+const (
+	numDelegationContractsKey     = "numDelegationContracts"
+	delegationContractKeyPrefix   = "contract_"
+	delegationContractOwnerPrefix = "owner_"
+)
+
+type delegationManagerSC struct {
+	eei                       vm.SystemEI
+	delegationSCAddressPrefix []byte
+}
+
+// NewDelegationManagerSmartContract creates the smart contract which creates the delegation contracts on demand, one
+// for each operator, and keeps the registry of all the created delegation contracts
+func NewDelegationManagerSmartContract(eei vm.SystemEI, delegationSCAddressPrefix []byte) (*delegationManagerSC, error) {
+	if eei == nil || eei.IsInterfaceNil() {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if len(delegationSCAddressPrefix) == 0 || len(delegationSCAddressPrefix) >= delegationSCAddressLen-len(delegationSCAddressSuffix) {
+		return nil, vm.ErrInvalidDelegationSCAddressPrefix
+	}
+
+	return &delegationManagerSC{
+		eei:                       eei,
+		delegationSCAddressPrefix: delegationSCAddressPrefix,
+	}, nil
+}
+
+// Execute calls one of the functions from the delegation manager smart contract and runs the code according to the input
+func (d *delegationManagerSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "createNewDelegationContract":
+		return d.createNewDelegationContract(args)
+	case "getAllContractAddresses":
+		return d.getAllContractAddresses(args)
+	}
+
+	return vmcommon.UserError
+}
+
+// createNewDelegationContract creates a delegation contract owned by the caller, with the max cap and the service fee
+// given as arguments. A zero max cap leaves the delegation contract uncapped
+func (d *delegationManagerSC) createNewDelegationContract(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("createNewDelegationContract does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 || args.Arguments[0] == nil || args.Arguments[1] == nil {
+		log.Debug("createNewDelegationContract needs exactly two arguments: max cap and service fee")
+		return vmcommon.UserError
+	}
+
+	maxCap := args.Arguments[0]
+	serviceFee := args.Arguments[1]
+	if maxCap.Sign() < 0 {
+		log.Debug("createNewDelegationContract: invalid max cap", "max cap", maxCap.String())
+		return vmcommon.UserError
+	}
+	if serviceFee.Sign() < 0 || serviceFee.Cmp(big.NewInt(maxServiceFee)) > 0 {
+		log.Debug("createNewDelegationContract: invalid service fee", "service fee", serviceFee.String())
+		return vmcommon.UserError
+	}
+
+	ownerKey := []byte(delegationContractOwnerPrefix + string(args.CallerAddr))
+	if len(d.eei.GetStorage(ownerKey)) > 0 {
+		log.Debug("createNewDelegationContract: the caller already owns a delegation contract")
+		return vmcommon.UserError
+	}
+
+	numContracts := d.getNumDelegationContracts()
+	contractAddress := d.delegationSCAddress(numContracts)
+
+	d.eei.SetStorageForAddress(contractAddress, []byte(delegationOwnerKey), args.CallerAddr)
+	d.eei.SetStorageForAddress(contractAddress, []byte(delegationMaxCapKey), maxCap.Bytes())
+	d.eei.SetStorageForAddress(contractAddress, []byte(delegationServiceFeeKey), serviceFee.Bytes())
+
+	d.eei.SetStorage(ownerKey, contractAddress)
+	d.eei.SetStorage(delegationContractKey(numContracts), contractAddress)
+	d.eei.SetStorage([]byte(numDelegationContractsKey), big.NewInt(0).SetUint64(numContracts+1).Bytes())
+
+	d.eei.Finish(contractAddress)
+
+	return vmcommon.Ok
+}
+
+// getAllContractAddresses returns the addresses of all the delegation contracts, in their creation order
+func (d *delegationManagerSC) getAllContractAddresses(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("getAllContractAddresses does not accept value")
+		return vmcommon.UserError
+	}
+
+	numContracts := d.getNumDelegationContracts()
+	for index := uint64(0); index < numContracts; index++ {
+		d.eei.Finish(d.eei.GetStorage(delegationContractKey(index)))
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegationManagerSC) getNumDelegationContracts() uint64 {
+	return big.NewInt(0).SetBytes(d.eei.GetStorage([]byte(numDelegationContractsKey))).Uint64()
+}
+
+func (d *delegationManagerSC) delegationSCAddress(index uint64) []byte {
+	indexLen := delegationSCAddressLen - len(d.delegationSCAddressPrefix) - len(delegationSCAddressSuffix)
+
+	return []byte(fmt.Sprintf("%s%0*d%s", d.delegationSCAddressPrefix, indexLen, index, delegationSCAddressSuffix))
+}
+
+func delegationContractKey(index uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", delegationContractKeyPrefix, index))
+}
+
+// ValueOf returns the address of the delegation contract owned by the provided address
+func (d *delegationManagerSC) ValueOf(key interface{}) interface{} {
+	owner, ok := key.([]byte)
+	if !ok {
+		return nil
+	}
+
+	return d.eei.GetStorage([]byte(delegationContractOwnerPrefix + string(owner)))
+}
+
+// IsInterfaceNil verifies if the underlying object is nil or not
+func (d *delegationManagerSC) IsInterfaceNil() bool {
+	if d == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package systemSmartContracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+var delegationManagerAddress = []byte("delegation manager")
+var delegationSCAddressPrefix = []byte("00000000010000000001")
+
+func createDelegationCallInput(function string, caller []byte, recipient []byte, arguments ...*big.Int) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			Arguments:   arguments,
+			CallValue:   big.NewInt(0),
+			GasPrice:    big.NewInt(0),
+			GasProvided: big.NewInt(0),
+		},
+		RecipientAddr: recipient,
+		Function:      function,
+	}
+}
+
+func createDelegationManagerEEI() *vmContext {
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, &mock.CryptoHookStub{})
+	eei.SetSCAddress(delegationManagerAddress)
+
+	return eei
+}
+
+func returnData(eei *vmContext) [][]byte {
+	data := make([][]byte, 0)
+	for _, value := range eei.CreateVMOutput().ReturnData {
+		data = append(data, value.Bytes())
+	}
+
+	return data
+}
+
+func TestNewDelegationManagerSmartContract_NilEEIShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dm, err := NewDelegationManagerSmartContract(nil, delegationSCAddressPrefix)
+
+	assert.Nil(t, dm)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestNewDelegationManagerSmartContract_InvalidAddressPrefixShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dm, err := NewDelegationManagerSmartContract(createDelegationManagerEEI(), nil)
+	assert.Nil(t, dm)
+	assert.Equal(t, vm.ErrInvalidDelegationSCAddressPrefix, err)
+
+	dm, err = NewDelegationManagerSmartContract(createDelegationManagerEEI(), make([]byte, delegationSCAddressLen))
+	assert.Nil(t, dm)
+	assert.Equal(t, vm.ErrInvalidDelegationSCAddressPrefix, err)
+}
+
+func TestDelegationManagerSC_CreateNewDelegationContractShouldWork(t *testing.T) {
+	t.Parallel()
+
+	eei := createDelegationManagerEEI()
+	dm, _ := NewDelegationManagerSmartContract(eei, delegationSCAddressPrefix)
+
+	input := createDelegationCallInput("createNewDelegationContract", []byte("operator"), delegationManagerAddress,
+		big.NewInt(1000), big.NewInt(500))
+	assert.Equal(t, vmcommon.Ok, dm.Execute(input))
+
+	expectedAddress := []byte("000000000100000000010000000000FF")
+	assert.Equal(t, [][]byte{expectedAddress}, returnData(eei))
+	assert.Equal(t, expectedAddress, dm.ValueOf([]byte("operator")))
+
+	eei.SetSCAddress(expectedAddress)
+	assert.Equal(t, []byte("operator"), eei.GetStorage([]byte(delegationOwnerKey)))
+	assert.Equal(t, big.NewInt(1000).Bytes(), eei.GetStorage([]byte(delegationMaxCapKey)))
+	assert.Equal(t, big.NewInt(500).Bytes(), eei.GetStorage([]byte(delegationServiceFeeKey)))
+}
+
+func TestDelegationManagerSC_CreateNewDelegationContractInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dm, _ := NewDelegationManagerSmartContract(createDelegationManagerEEI(), delegationSCAddressPrefix)
+
+	input := createDelegationCallInput("createNewDelegationContract", []byte("operator"), delegationManagerAddress,
+		big.NewInt(1000))
+	assert.Equal(t, vmcommon.UserError, dm.Execute(input))
+
+	input = createDelegationCallInput("createNewDelegationContract", []byte("operator"), delegationManagerAddress,
+		big.NewInt(-1), big.NewInt(500))
+	assert.Equal(t, vmcommon.UserError, dm.Execute(input))
+
+	input = createDelegationCallInput("createNewDelegationContract", []byte("operator"), delegationManagerAddress,
+		big.NewInt(1000), big.NewInt(maxServiceFee+1))
+	assert.Equal(t, vmcommon.UserError, dm.Execute(input))
+
+	input = createDelegationCallInput("createNewDelegationContract", []byte("operator"), delegationManagerAddress,
+		big.NewInt(1000), big.NewInt(500))
+	input.CallValue = big.NewInt(1)
+	assert.Equal(t, vmcommon.UserError, dm.Execute(input))
+}
+
+func TestDelegationManagerSC_CreateNewDelegationContractTwiceForTheSameOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dm, _ := NewDelegationManagerSmartContract(createDelegationManagerEEI(), delegationSCAddressPrefix)
+
+	input := createDelegationCallInput("createNewDelegationContract", []byte("operator"), delegationManagerAddress,
+		big.NewInt(0), big.NewInt(0))
+	assert.Equal(t, vmcommon.Ok, dm.Execute(input))
+	assert.Equal(t, vmcommon.UserError, dm.Execute(input))
+}
+
+func TestDelegationManagerSC_GetAllContractAddressesShouldReturnTheRegistry(t *testing.T) {
+	t.Parallel()
+
+	eei := createDelegationManagerEEI()
+	dm, _ := NewDelegationManagerSmartContract(eei, delegationSCAddressPrefix)
+
+	for _, operator := range []string{"operator1", "operator2", "operator3"} {
+		input := createDelegationCallInput("createNewDelegationContract", []byte(operator), delegationManagerAddress,
+			big.NewInt(0), big.NewInt(0))
+		assert.Equal(t, vmcommon.Ok, dm.Execute(input))
+	}
+
+	eei.output = make([][]byte, 0)
+	input := createDelegationCallInput("getAllContractAddresses", []byte("caller"), delegationManagerAddress)
+	assert.Equal(t, vmcommon.Ok, dm.Execute(input))
+
+	assert.Equal(t, [][]byte{
+		[]byte("000000000100000000010000000000FF"),
+		[]byte("000000000100000000010000000001FF"),
+		[]byte("000000000100000000010000000002FF"),
+	}, returnData(eei))
+}
@@ -0,0 +1,157 @@
+package systemSmartContracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+// createDelegationContract creates, through the delegation manager, a delegation contract with the given max cap
+// and returns its address and the environment set on it
+func createDelegationContract(maxCap int64) ([]byte, *vmContext) {
+	eei := createDelegationManagerEEI()
+	dm, _ := NewDelegationManagerSmartContract(eei, delegationSCAddressPrefix)
+	input := createDelegationCallInput("createNewDelegationContract", []byte("operator"), delegationManagerAddress,
+		big.NewInt(maxCap), big.NewInt(1000))
+	_ = dm.Execute(input)
+
+	contractAddress := returnData(eei)[0]
+	eei.output = make([][]byte, 0)
+	eei.SetSCAddress(contractAddress)
+
+	return contractAddress, eei
+}
+
+func createDelegateCallInput(delegator []byte, contractAddress []byte, value int64) *vmcommon.ContractCallInput {
+	input := createDelegationCallInput("delegate", delegator, contractAddress)
+	input.CallValue = big.NewInt(value)
+
+	return input
+}
+
+func TestNewDelegationSmartContract_NilEEIShouldErr(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewDelegationSmartContract(nil)
+
+	assert.Nil(t, d)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestDelegationSC_ExecuteOnContractNotCreatedByTheManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eei := createDelegationManagerEEI()
+	eei.SetSCAddress([]byte("000000000100000000010000000007FF"))
+	d, _ := NewDelegationSmartContract(eei)
+
+	input := createDelegateCallInput([]byte("delegator"), []byte("000000000100000000010000000007FF"), 10)
+	assert.Equal(t, vmcommon.UserError, d.Execute(input))
+}
+
+func TestDelegationSC_DelegateShouldRespectTheMaxCap(t *testing.T) {
+	t.Parallel()
+
+	contractAddress, eei := createDelegationContract(100)
+	d, _ := NewDelegationSmartContract(eei)
+
+	assert.Equal(t, vmcommon.Ok, d.Execute(createDelegateCallInput([]byte("delegator1"), contractAddress, 60)))
+	assert.Equal(t, vmcommon.UserError, d.Execute(createDelegateCallInput([]byte("delegator2"), contractAddress, 50)))
+	assert.Equal(t, vmcommon.Ok, d.Execute(createDelegateCallInput([]byte("delegator2"), contractAddress, 40)))
+	assert.Equal(t, vmcommon.UserError, d.Execute(createDelegateCallInput([]byte("delegator1"), contractAddress, 1)))
+
+	input := createDelegationCallInput("getUserStake", []byte("caller"), contractAddress,
+		big.NewInt(0).SetBytes([]byte("delegator1")))
+	assert.Equal(t, vmcommon.Ok, d.Execute(input))
+	assert.Equal(t, [][]byte{big.NewInt(60).Bytes()}, returnData(eei))
+
+	eei.output = make([][]byte, 0)
+	input = createDelegationCallInput("getContractConfig", []byte("caller"), contractAddress)
+	assert.Equal(t, vmcommon.Ok, d.Execute(input))
+	assert.Equal(t, [][]byte{
+		[]byte("operator"),
+		big.NewInt(100).Bytes(),
+		big.NewInt(1000).Bytes(),
+		big.NewInt(100).Bytes(),
+	}, returnData(eei))
+}
+
+func TestDelegationSC_DelegateWithoutMaxCapShouldWork(t *testing.T) {
+	t.Parallel()
+
+	contractAddress, eei := createDelegationContract(0)
+	d, _ := NewDelegationSmartContract(eei)
+
+	assert.Equal(t, vmcommon.Ok, d.Execute(createDelegateCallInput([]byte("delegator"), contractAddress, 1000000)))
+	assert.Equal(t, vmcommon.UserError, d.Execute(createDelegateCallInput([]byte("delegator"), contractAddress, 0)))
+}
+
+func TestDelegationSC_GetDelegatorsListShouldReturnThePage(t *testing.T) {
+	t.Parallel()
+
+	contractAddress, eei := createDelegationContract(0)
+	d, _ := NewDelegationSmartContract(eei)
+
+	_ = d.Execute(createDelegateCallInput([]byte("delegator1"), contractAddress, 10))
+	_ = d.Execute(createDelegateCallInput([]byte("delegator2"), contractAddress, 20))
+	_ = d.Execute(createDelegateCallInput([]byte("delegator1"), contractAddress, 5))
+	_ = d.Execute(createDelegateCallInput([]byte("delegator3"), contractAddress, 30))
+
+	eei.output = make([][]byte, 0)
+	input := createDelegationCallInput("getNumDelegators", []byte("caller"), contractAddress)
+	assert.Equal(t, vmcommon.Ok, d.Execute(input))
+	assert.Equal(t, [][]byte{big.NewInt(3).Bytes()}, returnData(eei))
+
+	eei.output = make([][]byte, 0)
+	input = createDelegationCallInput("getDelegatorsList", []byte("caller"), contractAddress, big.NewInt(0), big.NewInt(2))
+	assert.Equal(t, vmcommon.Ok, d.Execute(input))
+	assert.Equal(t, [][]byte{
+		[]byte("delegator1"), big.NewInt(15).Bytes(),
+		[]byte("delegator2"), big.NewInt(20).Bytes(),
+	}, returnData(eei))
+
+	eei.output = make([][]byte, 0)
+	input = createDelegationCallInput("getDelegatorsList", []byte("caller"), contractAddress, big.NewInt(2), big.NewInt(2))
+	assert.Equal(t, vmcommon.Ok, d.Execute(input))
+	assert.Equal(t, [][]byte{[]byte("delegator3"), big.NewInt(30).Bytes()}, returnData(eei))
+
+	eei.output = make([][]byte, 0)
+	input = createDelegationCallInput("getDelegatorsList", []byte("caller"), contractAddress, big.NewInt(3), big.NewInt(2))
+	assert.Equal(t, vmcommon.Ok, d.Execute(input))
+	assert.Equal(t, 0, len(returnData(eei)))
+
+	input = createDelegationCallInput("getDelegatorsList", []byte("caller"), contractAddress, big.NewInt(0))
+	assert.Equal(t, vmcommon.UserError, d.Execute(input))
+}
+
+func TestDelegationSC_GetClaimableRewardsShouldReturnTheDelegatorShare(t *testing.T) {
+	t.Parallel()
+
+	contractAddress, eei := createDelegationContract(0)
+	d, _ := NewDelegationSmartContract(eei)
+
+	_ = d.Execute(createDelegateCallInput([]byte("delegator1"), contractAddress, 100))
+	_ = d.Execute(createDelegateCallInput([]byte("delegator2"), contractAddress, 300))
+
+	eei.output = make([][]byte, 0)
+	input := createDelegationCallInput("getClaimableRewards", []byte("caller"), contractAddress,
+		big.NewInt(0).SetBytes([]byte("delegator2")))
+	assert.Equal(t, vmcommon.Ok, d.Execute(input))
+	assert.Equal(t, [][]byte{big.NewInt(0).Bytes()}, returnData(eei))
+
+	// 1000 received as rewards, the service fee of 10% going to the owner
+	eei.outputAccounts[string(contractAddress)].BalanceDelta.Add(eei.outputAccounts[string(contractAddress)].BalanceDelta, big.NewInt(1000))
+
+	eei.output = make([][]byte, 0)
+	assert.Equal(t, vmcommon.Ok, d.Execute(input))
+	assert.Equal(t, [][]byte{big.NewInt(675).Bytes()}, returnData(eei))
+
+	eei.output = make([][]byte, 0)
+	input = createDelegationCallInput("getClaimableRewards", []byte("caller"), contractAddress,
+		big.NewInt(0).SetBytes([]byte("not a delegator")))
+	assert.Equal(t, vmcommon.Ok, d.Execute(input))
+	assert.Equal(t, [][]byte{big.NewInt(0).Bytes()}, returnData(eei))
+}
@@ -0,0 +1,79 @@
+package systemSmartContracts
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+type dnsSC struct {
+	eei vm.SystemEI
+}
+
+// NewDNSSmartContract creates a smart contract which keeps the unique mapping between user names and addresses
+func NewDNSSmartContract(eei vm.SystemEI) (*dnsSC, error) {
+	if eei == nil || eei.IsInterfaceNil() {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+
+	return &dnsSC{eei: eei}, nil
+}
+
+// Execute calls one of the functions from the dns smart contract and runs the code according to the input
+func (d *dnsSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "register":
+		return d.register(args)
+	}
+
+	return vmcommon.UserError
+}
+
+func (d *dnsSC) register(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("dns register does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 || args.Arguments[0] == nil {
+		log.Debug("dns register needs exactly one argument")
+		return vmcommon.UserError
+	}
+
+	userName := args.Arguments[0].Bytes()
+	err := core.CheckUserNameFormat(userName)
+	if err != nil {
+		log.Debug("dns register", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	if len(d.eei.GetStorage(userName)) > 0 {
+		log.Debug("dns register: user name is already registered", "user name", string(userName))
+		return vmcommon.UserError
+	}
+
+	d.eei.SetStorage(userName, args.CallerAddr)
+
+	return vmcommon.Ok
+}
+
+// ValueOf returns the address registered for the provided user name
+func (d *dnsSC) ValueOf(key interface{}) interface{} {
+	userName, ok := key.([]byte)
+	if !ok {
+		return nil
+	}
+
+	return d.eei.GetStorage(userName)
+}
+
+// IsInterfaceNil verifies if the underlying object is nil or not
+func (d *dnsSC) IsInterfaceNil() bool {
+	if d == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package systemSmartContracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createDNSCallInput(userName string, caller []byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			Arguments:   []*big.Int{big.NewInt(0).SetBytes([]byte(userName))},
+			CallValue:   big.NewInt(0),
+			GasPrice:    big.NewInt(0),
+			GasProvided: big.NewInt(0),
+		},
+		RecipientAddr: []byte("dns"),
+		Function:      "register",
+	}
+}
+
+func createStorageBackedEI() *mock.SystemEIStub {
+	storage := make(map[string][]byte)
+	return &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			return storage[string(key)]
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			storage[string(key)] = value
+		},
+	}
+}
+
+func TestNewDNSSmartContract_NilEEIShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dns, err := NewDNSSmartContract(nil)
+
+	assert.Nil(t, dns)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestDnsSC_ExecuteUnknownFunctionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dns, _ := NewDNSSmartContract(createStorageBackedEI())
+	input := createDNSCallInput("alice", []byte("caller"))
+	input.Function = "unknown"
+
+	assert.Equal(t, vmcommon.UserError, dns.Execute(input))
+}
+
+func TestDnsSC_RegisterInvalidUserNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dns, _ := NewDNSSmartContract(createStorageBackedEI())
+
+	assert.Equal(t, vmcommon.UserError, dns.Execute(createDNSCallInput("Alice!", []byte("caller"))))
+	assert.Equal(t, vmcommon.UserError, dns.Execute(createDNSCallInput("elrond", []byte("caller"))))
+}
+
+func TestDnsSC_RegisterWithValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dns, _ := NewDNSSmartContract(createStorageBackedEI())
+	input := createDNSCallInput("alice", []byte("caller"))
+	input.CallValue = big.NewInt(1)
+
+	assert.Equal(t, vmcommon.UserError, dns.Execute(input))
+}
+
+func TestDnsSC_RegisterShouldWorkAndRejectDuplicates(t *testing.T) {
+	t.Parallel()
+
+	dns, _ := NewDNSSmartContract(createStorageBackedEI())
+
+	retCode := dns.Execute(createDNSCallInput("alice", []byte("caller1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, []byte("caller1"), dns.ValueOf([]byte("alice")))
+
+	retCode = dns.Execute(createDNSCallInput("alice", []byte("caller2")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []byte("caller1"), dns.ValueOf([]byte("alice")))
+}
@@ -15,7 +15,7 @@ type vmContext struct {
 	storageUpdate  map[string]map[string][]byte
 	outputAccounts map[string]*vmcommon.OutputAccount
 
-	output []byte
+	output [][]byte
 
 	selfDestruct map[string][]byte
 }
@@ -59,7 +59,13 @@ func (host *vmContext) GetStorage(key []byte) []byte {
 
 // SetStorage saves the key value storage under the address
 func (host *vmContext) SetStorage(key []byte, value []byte) {
-	strAdr := string(host.scAddress)
+	host.SetStorageForAddress(host.scAddress, key, value)
+}
+
+// SetStorageForAddress saves the key value storage under the given address, used by a system smart contract to
+// initialize the storage of the contracts it creates
+func (host *vmContext) SetStorageForAddress(address []byte, key []byte, value []byte) {
+	strAdr := string(address)
 
 	if _, ok := host.storageUpdate[strAdr]; !ok {
 		host.storageUpdate[strAdr] = make(map[string][]byte, 0)
@@ -70,6 +76,11 @@ func (host *vmContext) SetStorage(key []byte, value []byte) {
 	copy(host.storageUpdate[strAdr][string(key)][:length], value[:length])
 }
 
+// Finish appends the given value to the data returned by the current smart contract call
+func (host *vmContext) Finish(value []byte) {
+	host.output = append(host.output, value)
+}
+
 // GetBalance returns the balance of the given address
 func (host *vmContext) GetBalance(addr []byte) *big.Int {
 	strAdr := string(addr)
@@ -131,7 +142,7 @@ func (host *vmContext) CleanCache() {
 	host.storageUpdate = make(map[string]map[string][]byte, 0)
 	host.selfDestruct = make(map[string][]byte)
 	host.outputAccounts = make(map[string]*vmcommon.OutputAccount, 0)
-	host.output = make([]byte, 0)
+	host.output = make([][]byte, 0)
 }
 
 // CreateVMOutput adapts vm output and all saved data from sc run into VM Output
@@ -181,6 +192,11 @@ func (host *vmContext) CreateVMOutput() *vmcommon.VMOutput {
 		vmOutput.OutputAccounts = append(vmOutput.OutputAccounts, outAcc)
 	}
 
+	// add the returned data
+	for _, value := range host.output {
+		vmOutput.ReturnData = append(vmOutput.ReturnData, big.NewInt(0).SetBytes(value))
+	}
+
 	vmOutput.GasRemaining = big.NewInt(0)
 	vmOutput.GasRefund = big.NewInt(0)
 
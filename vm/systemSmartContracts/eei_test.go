@@ -148,3 +148,32 @@ func TestVmContext_Transfer(t *testing.T) {
 	vmOutput := vmContext.CreateVMOutput()
 	assert.Equal(t, 2, len(vmOutput.OutputAccounts))
 }
+
+func TestVmContext_FinishShouldAddReturnData(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, &mock.CryptoHookStub{})
+
+	vmContext.Finish([]byte{5})
+	vmContext.Finish([]byte{7})
+
+	vmOutput := vmContext.CreateVMOutput()
+	assert.Equal(t, []*big.Int{big.NewInt(5), big.NewInt(7)}, vmOutput.ReturnData)
+
+	vmContext.CleanCache()
+	vmOutput = vmContext.CreateVMOutput()
+	assert.Equal(t, 0, len(vmOutput.ReturnData))
+}
+
+func TestVmContext_SetStorageForAddress(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, &mock.CryptoHookStub{})
+	vmContext.SetSCAddress([]byte("creator"))
+	vmContext.SetStorageForAddress([]byte("created"), []byte("key"), []byte("value"))
+
+	assert.Nil(t, vmContext.GetStorage([]byte("key")))
+
+	vmContext.SetSCAddress([]byte("created"))
+	assert.Equal(t, []byte("value"), vmContext.GetStorage([]byte("key")))
+}
@@ -0,0 +1,45 @@
+package systemSmartContracts
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+type messageSigVerifier struct {
+	keyGen       crypto.KeyGenerator
+	singleSigner crypto.SingleSigner
+}
+
+// NewMessageSigVerifier creates a verifier of the messages signed by the owners of the given public keys
+func NewMessageSigVerifier(keyGen crypto.KeyGenerator, singleSigner crypto.SingleSigner) (*messageSigVerifier, error) {
+	if keyGen == nil || keyGen.IsInterfaceNil() {
+		return nil, vm.ErrNilKeyGenerator
+	}
+	if singleSigner == nil || singleSigner.IsInterfaceNil() {
+		return nil, vm.ErrNilSingleSigner
+	}
+
+	return &messageSigVerifier{
+		keyGen:       keyGen,
+		singleSigner: singleSigner,
+	}, nil
+}
+
+// Verify returns nil if the signed message is the signature of the message with the private key of the given
+// public key
+func (m *messageSigVerifier) Verify(message []byte, signedMessage []byte, pubKey []byte) error {
+	publicKey, err := m.keyGen.PublicKeyFromByteArray(pubKey)
+	if err != nil {
+		return err
+	}
+
+	return m.singleSigner.Verify(publicKey, message, signedMessage)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (m *messageSigVerifier) IsInterfaceNil() bool {
+	if m == nil {
+		return true
+	}
+	return false
+}
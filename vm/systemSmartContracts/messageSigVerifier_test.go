@@ -0,0 +1,48 @@
+package systemSmartContracts
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMessageSigVerifier_NilKeyGeneratorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	verifier, err := NewMessageSigVerifier(nil, &singlesig.BlsSingleSigner{})
+
+	assert.Nil(t, verifier)
+	assert.Equal(t, vm.ErrNilKeyGenerator, err)
+}
+
+func TestNewMessageSigVerifier_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	verifier, err := NewMessageSigVerifier(signing.NewKeyGenerator(kyber.NewSuitePairingBn256()), nil)
+
+	assert.Nil(t, verifier)
+	assert.Equal(t, vm.ErrNilSingleSigner, err)
+}
+
+func TestMessageSigVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(kyber.NewSuitePairingBn256())
+	signer := &singlesig.BlsSingleSigner{}
+	verifier, _ := NewMessageSigVerifier(keyGen, signer)
+
+	privateKey, publicKey := keyGen.GeneratePair()
+	pubKey, _ := publicKey.ToByteArray()
+	_, otherPublicKey := keyGen.GeneratePair()
+	otherPubKey, _ := otherPublicKey.ToByteArray()
+	signature, _ := signer.Sign(privateKey, pubKey)
+
+	assert.Nil(t, verifier.Verify(pubKey, signature, pubKey))
+	assert.NotNil(t, verifier.Verify(otherPubKey, signature, pubKey))
+	assert.NotNil(t, verifier.Verify(pubKey, signature, otherPubKey))
+	assert.NotNil(t, verifier.Verify(pubKey, signature, []byte("invalid key")))
+}
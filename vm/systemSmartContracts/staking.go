@@ -3,6 +3,7 @@ package systemSmartContracts
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core/logger"
@@ -10,35 +11,85 @@ import (
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
-var log = logger.DefaultLogger()
+var log = logger.GetOrCreate("vm/systemSmartContracts")
 
 const ownerKey = "owner"
 
+// blsKeyOwnerPrefix prefixes the keys under which the owner of each registered BLS key is stored
+const blsKeyOwnerPrefix = "blsKey_"
+
+// unJailFineDenominator sets the fine paid to unJail a BLS key to 1% of the stake value
+const unJailFineDenominator = 100
+
+const (
+	blsKeyStatusStaked       = "staked"
+	blsKeyStatusUnStaked     = "unStaked"
+	blsKeyStatusJailed       = "jailed"
+	blsKeyStatusQualified    = "qualified"
+	blsKeyStatusNotQualified = "notQualified"
+)
+
+const (
+	nodePriceKey               = "nodePrice"
+	stakingOwnerKeyPrefix      = "stakingOwner_"
+	numStakingOwnersKey        = "numStakingOwners"
+	stakingOwnerIndexKeyPrefix = "stakingOwnerIndex_"
+	numQualifiedUpdatesKey     = "numQualifiedUpdates"
+)
+
 type stakingData struct {
 	StartNonce    uint64   `json:"StartNonce"`
 	Staked        bool     `json:"Staked"`
 	UnStakedNonce uint64   `json:"UnStakedNonce"`
 	BlsPubKey     []byte   `json:"BlsPubKey"`
 	StakeValue    *big.Int `json:"StakeValue"`
+	// KeyRotationNonce is the nonce of the block in which the BLS key was last replaced by reStakeWithNewKey
+	KeyRotationNonce uint64 `json:"KeyRotationNonce"`
+	// Jailed is set when the rating of the validator fell below the jail threshold and is cleared by paying the fine
+	Jailed      bool   `json:"Jailed"`
+	JailedNonce uint64 `json:"JailedNonce"`
+}
+
+// ownerStakingData is the staking v2 registration of an owner: the lump sum it deposited and its BLS keys, in
+// registration order. The first NumQualified keys which are not jailed are the qualified ones. The unStaked keys wait
+// for the next updateQualifiedNodes call before they can be unBonded
+type ownerStakingData struct {
+	TotalStake   *big.Int       `json:"TotalStake"`
+	BlsKeys      [][]byte       `json:"BlsKeys"`
+	NumQualified uint64         `json:"NumQualified"`
+	UnStakedKeys []*unStakedKey `json:"UnStakedKeys"`
+	JailedKeys   [][]byte       `json:"JailedKeys"`
+}
+
+// unStakedKey is a BLS key removed by its owner from the qualified nodes selection, together with the number of
+// updateQualifiedNodes calls made before it was unStaked
+type unStakedKey struct {
+	BlsKey           []byte `json:"BlsKey"`
+	UnStakedAtUpdate uint64 `json:"UnStakedAtUpdate"`
 }
 
 type stakingSC struct {
-	eei        vm.SystemEI
-	stakeValue *big.Int
+	eei         vm.SystemEI
+	stakeValue  *big.Int
+	sigVerifier vm.MessageSignVerifier
 }
 
 // NewStakingSmartContract creates a staking smart contract
-func NewStakingSmartContract(stakeValue *big.Int, eei vm.SystemEI) (*stakingSC, error) {
+func NewStakingSmartContract(stakeValue *big.Int, eei vm.SystemEI, sigVerifier vm.MessageSignVerifier) (*stakingSC, error) {
 	if stakeValue == nil {
 		return nil, vm.ErrNilInitialStakeValue
 	}
 	if eei == nil || eei.IsInterfaceNil() {
 		return nil, vm.ErrNilSystemEnvironmentInterface
 	}
+	if sigVerifier == nil || sigVerifier.IsInterfaceNil() {
+		return nil, vm.ErrNilMessageSignVerifier
+	}
 
 	reg := &stakingSC{
-		stakeValue: big.NewInt(0).Set(stakeValue),
-		eei:        eei,
+		stakeValue:  big.NewInt(0).Set(stakeValue),
+		eei:         eei,
+		sigVerifier: sigVerifier,
 	}
 	return reg, nil
 }
@@ -60,6 +111,30 @@ func (r *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return r.finalizeUnStake(args)
 	case "slash":
 		return r.slash(args)
+	case "reStakeWithNewKey":
+		return r.reStakeWithNewKey(args)
+	case "jail":
+		return r.jail(args)
+	case "unJail":
+		return r.unJail(args)
+	case "getTotalStakedTopUpStakedBlsKeys":
+		return r.getTotalStakedTopUpStakedBlsKeys(args)
+	case "stakeNodes":
+		return r.stakeNodes(args)
+	case "setNodePrice":
+		return r.setNodePrice(args)
+	case "updateQualifiedNodes":
+		return r.updateQualifiedNodes(args)
+	case "getQualifiedNodes":
+		return r.getQualifiedNodes(args)
+	case "getAuctionList":
+		return r.getAuctionList(args)
+	case "unStakeNodes":
+		return r.unStakeNodes(args)
+	case "unBondNodes":
+		return r.unBondNodes(args)
+	case "unBondTokens":
+		return r.unBondTokens(args)
 	}
 
 	return vmcommon.UserError
@@ -107,6 +182,7 @@ func (r *stakingSC) stake(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 
 	registrationData.StartNonce = args.Header.Number.Uint64()
 	registrationData.BlsPubKey = args.Arguments[0].Bytes()
+	registrationData.StakeValue = big.NewInt(0).Set(args.CallValue)
 	//TODO: verify if blsPubKey is valid
 
 	data, err := json.Marshal(registrationData)
@@ -116,6 +192,7 @@ func (r *stakingSC) stake(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 	}
 
 	r.eei.SetStorage(args.CallerAddr, data)
+	r.eei.SetStorage(blsKeyOwnerKey(registrationData.BlsPubKey), args.CallerAddr)
 
 	err = r.eei.Transfer(args.RecipientAddr, args.CallerAddr, args.CallValue, nil)
 	if err != nil {
@@ -215,6 +292,845 @@ func (r *stakingSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 	return vmcommon.Ok
 }
 
+// reStakeWithNewKey replaces the BLS key of a staked node without unbonding its stake. The arguments are the old key,
+// the new key, the signature of the new key with the old key, proving the old key owner authorizes the rotation, and
+// the signature of the new key with the new key, proving the possession of the new private key. A staking v2 key is
+// replaced in place, keeping its position in the qualified nodes selection, so the new key is qualified by the next
+// updateQualifiedNodes call if the old one was
+// TODO: switch the validator to the new key at the start of the next epoch, once the nodes coordinator computes the
+// eligible lists from the staking data instead of the genesis nodes setup
+func (r *stakingSC) reStakeWithNewKey(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("reStakeWithNewKey does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 4 {
+		log.Debug("reStakeWithNewKey needs exactly four arguments")
+		return vmcommon.UserError
+	}
+	for _, arg := range args.Arguments {
+		if arg == nil {
+			log.Debug("reStakeWithNewKey called with nil argument")
+			return vmcommon.UserError
+		}
+	}
+
+	oldKey := args.Arguments[0].Bytes()
+	newKey := args.Arguments[1].Bytes()
+	oldKeySignature := args.Arguments[2].Bytes()
+	newKeyProofOfPossession := args.Arguments[3].Bytes()
+
+	ownerData, err := r.getOwnerStakingData(args.CallerAddr)
+	if err != nil {
+		log.Debug("reStakeWithNewKey", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	keyIndex := -1
+	if ownerData != nil {
+		keyIndex = indexOfKey(ownerData.BlsKeys, oldKey)
+	}
+
+	var registrationData *stakingData
+	if keyIndex < 0 {
+		registrations, errGet := r.getOwnerRegistrations(args.CallerAddr)
+		if errGet != nil {
+			log.Debug("reStakeWithNewKey", "error", errGet.Error())
+			return vmcommon.UserError
+		}
+		if len(registrations) == 0 {
+			log.Debug("reStakeWithNewKey: the caller has no registered BLS key")
+			return vmcommon.UserError
+		}
+
+		registrationData = registrations[0]
+		if !bytes.Equal(registrationData.BlsPubKey, oldKey) || blsKeyStatus(registrationData) != blsKeyStatusStaked {
+			log.Debug("reStakeWithNewKey: the old BLS key is not staked by the caller")
+			return vmcommon.UserError
+		}
+	} else if indexOfKey(ownerData.JailedKeys, oldKey) >= 0 {
+		log.Debug("reStakeWithNewKey: the old BLS key is jailed")
+		return vmcommon.UserError
+	}
+
+	if len(newKey) == 0 || len(r.eei.GetStorage(blsKeyOwnerKey(newKey))) > 0 || bytes.Equal(newKey, oldKey) {
+		log.Debug("reStakeWithNewKey: the new BLS key is already registered")
+		return vmcommon.UserError
+	}
+
+	err = r.sigVerifier.Verify(newKey, oldKeySignature, oldKey)
+	if err != nil {
+		log.Debug("reStakeWithNewKey: invalid old BLS key signature", "error", err.Error())
+		return vmcommon.UserError
+	}
+	err = r.sigVerifier.Verify(newKey, newKeyProofOfPossession, newKey)
+	if err != nil {
+		log.Debug("reStakeWithNewKey: invalid new BLS key proof of possession", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	if keyIndex >= 0 {
+		ownerData.BlsKeys[keyIndex] = newKey
+		err = r.saveOwnerStakingData(args.CallerAddr, ownerData)
+	} else {
+		registrationData.BlsPubKey = newKey
+		if args.Header != nil && args.Header.Number != nil {
+			registrationData.KeyRotationNonce = args.Header.Number.Uint64()
+		}
+		err = r.saveRegistration(args.CallerAddr, registrationData)
+	}
+	if err != nil {
+		log.Debug("marshal error in reStakeWithNewKey function of staking smart contract", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	r.eei.SetStorage(blsKeyOwnerKey(oldKey), nil)
+	r.eei.SetStorage(blsKeyOwnerKey(newKey), args.CallerAddr)
+
+	return vmcommon.Ok
+}
+
+// jail marks as jailed the BLS keys given as arguments. It can be called only by the owner of the staking contract,
+// the metachain, for the validators whose rating fell below the jail threshold. A jailed validator is not eligible
+// anymore until its owner calls unJail. The jailed staking v2 keys are left out of the qualified nodes selection
+// TODO: call jail from the metachain at the end of each epoch, once the validator statistics processor keeps the
+// ratings of all the validators and the nodes coordinator computes the eligible lists from the qualified nodes
+func (r *stakingSC) jail(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	ownerAddress := r.eei.GetStorage([]byte(ownerKey))
+	if !bytes.Equal(ownerAddress, args.CallerAddr) {
+		log.Debug("jail function called by not the owners address")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) == 0 {
+		log.Debug("jail function needs at least one BLS key")
+		return vmcommon.UserError
+	}
+
+	for _, arg := range args.Arguments {
+		if arg == nil {
+			log.Debug("jail called with nil argument")
+			return vmcommon.UserError
+		}
+
+		blsKey := arg.Bytes()
+		keyOwner := r.eei.GetStorage(blsKeyOwnerKey(blsKey))
+		ownerData, err := r.getOwnerStakingData(keyOwner)
+		if err != nil {
+			log.Debug("jail", "error", err.Error())
+			return vmcommon.UserError
+		}
+		if len(keyOwner) > 0 && ownerData != nil && indexOfKey(ownerData.BlsKeys, blsKey) >= 0 {
+			if indexOfKey(ownerData.JailedKeys, blsKey) < 0 {
+				ownerData.JailedKeys = append(ownerData.JailedKeys, blsKey)
+			}
+
+			err = r.saveOwnerStakingData(keyOwner, ownerData)
+			if err != nil {
+				log.Debug("marshal error in jail function of staking smart contract", "error", err.Error())
+				return vmcommon.UserError
+			}
+			continue
+		}
+
+		registrations, err := r.getOwnerRegistrations(keyOwner)
+		if err != nil {
+			log.Debug("jail", "error", err.Error())
+			return vmcommon.UserError
+		}
+		if len(keyOwner) == 0 || len(registrations) == 0 || !bytes.Equal(registrations[0].BlsPubKey, blsKey) {
+			log.Debug("jail: the BLS key is not registered")
+			return vmcommon.UserError
+		}
+
+		registrationData := registrations[0]
+		registrationData.Jailed = true
+		if args.Header != nil && args.Header.Number != nil {
+			registrationData.JailedNonce = args.Header.Number.Uint64()
+		}
+
+		err = r.saveRegistration(keyOwner, registrationData)
+		if err != nil {
+			log.Debug("marshal error in jail function of staking smart contract", "error", err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	return vmcommon.Ok
+}
+
+// unJail makes eligible again the jailed BLS key of the caller, given as argument. The call value has to be the
+// unJail fine, which remains in the staking contract
+func (r *stakingSC) unJail(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 1 || args.Arguments[0] == nil {
+		log.Debug("unJail needs exactly one argument")
+		return vmcommon.UserError
+	}
+
+	fine := r.unJailFine()
+	if args.CallValue.Cmp(fine) != 0 {
+		log.Debug("unJail: the call value has to be the unJail fine", "fine", fine.String())
+		return vmcommon.UserError
+	}
+
+	blsKey := args.Arguments[0].Bytes()
+	ownerData, err := r.getOwnerStakingData(args.CallerAddr)
+	if err != nil {
+		log.Debug("unJail", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	if ownerData != nil && indexOfKey(ownerData.BlsKeys, blsKey) >= 0 {
+		jailedIndex := indexOfKey(ownerData.JailedKeys, blsKey)
+		if jailedIndex < 0 {
+			log.Debug("unJail: the BLS key is not jailed")
+			return vmcommon.UserError
+		}
+
+		ownerData.JailedKeys = append(ownerData.JailedKeys[:jailedIndex], ownerData.JailedKeys[jailedIndex+1:]...)
+		err = r.saveOwnerStakingData(args.CallerAddr, ownerData)
+	} else {
+		err = r.unJailRegistration(args.CallerAddr, blsKey)
+	}
+	if err != nil {
+		log.Debug("unJail", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	err = r.eei.Transfer(args.RecipientAddr, args.CallerAddr, args.CallValue, nil)
+	if err != nil {
+		log.Debug("transfer error on unJail function", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (r *stakingSC) unJailRegistration(owner []byte, blsKey []byte) error {
+	registrations, err := r.getOwnerRegistrations(owner)
+	if err != nil {
+		return err
+	}
+	if len(registrations) == 0 || !bytes.Equal(registrations[0].BlsPubKey, blsKey) {
+		return vm.ErrBLSKeyNotRegistered
+	}
+
+	registrationData := registrations[0]
+	if !registrationData.Jailed {
+		return vm.ErrBLSKeyNotJailed
+	}
+
+	registrationData.Jailed = false
+	return r.saveRegistration(owner, registrationData)
+}
+
+func (r *stakingSC) unJailFine() *big.Int {
+	return big.NewInt(0).Div(r.stakeValue, big.NewInt(unJailFineDenominator))
+}
+
+func (r *stakingSC) saveRegistration(owner []byte, registrationData *stakingData) error {
+	data, err := json.Marshal(registrationData)
+	if err != nil {
+		return err
+	}
+
+	r.eei.SetStorage(owner, data)
+
+	return nil
+}
+
+func blsKeyOwnerKey(blsKey []byte) []byte {
+	return append([]byte(blsKeyOwnerPrefix), blsKey...)
+}
+
+// getTotalStakedTopUpStakedBlsKeys returns in a single call, for the owner given as argument, the total base stake,
+// the total top-up, the number of registered BLS keys followed by each BLS key and its status
+func (r *stakingSC) getTotalStakedTopUpStakedBlsKeys(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("getTotalStakedTopUpStakedBlsKeys does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 || args.Arguments[0] == nil {
+		log.Debug("getTotalStakedTopUpStakedBlsKeys needs exactly one argument")
+		return vmcommon.UserError
+	}
+
+	registrations, err := r.getOwnerRegistrations(args.Arguments[0].Bytes())
+	if err != nil {
+		log.Debug("getTotalStakedTopUpStakedBlsKeys", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	totalBaseStake := big.NewInt(0)
+	totalTopUp := big.NewInt(0)
+	for _, registrationData := range registrations {
+		baseStake, topUp := r.splitStakeValue(registrationData.StakeValue)
+		totalBaseStake.Add(totalBaseStake, baseStake)
+		totalTopUp.Add(totalTopUp, topUp)
+	}
+
+	r.eei.Finish(totalBaseStake.Bytes())
+	r.eei.Finish(totalTopUp.Bytes())
+	r.eei.Finish(big.NewInt(int64(len(registrations))).Bytes())
+	for _, registrationData := range registrations {
+		r.eei.Finish(registrationData.BlsPubKey)
+		r.eei.Finish([]byte(blsKeyStatus(registrationData)))
+	}
+
+	return vmcommon.Ok
+}
+
+// getOwnerRegistrations returns the BLS key registrations of the given owner. The registrations are stored under the
+// owner address, so an owner has at most one registered BLS key
+func (r *stakingSC) getOwnerRegistrations(owner []byte) ([]*stakingData, error) {
+	data := r.eei.GetStorage(owner)
+	if len(data) == 0 {
+		return make([]*stakingData, 0), nil
+	}
+
+	registrationData := &stakingData{}
+	err := json.Unmarshal(data, registrationData)
+	if err != nil {
+		return nil, err
+	}
+	if len(registrationData.BlsPubKey) == 0 {
+		return make([]*stakingData, 0), nil
+	}
+
+	return []*stakingData{registrationData}, nil
+}
+
+// splitStakeValue splits the staked value in the base stake, capped to the configured stake value, and the top-up
+// which exceeds it
+func (r *stakingSC) splitStakeValue(stakeValue *big.Int) (*big.Int, *big.Int) {
+	if stakeValue == nil || stakeValue.Sign() <= 0 {
+		return big.NewInt(0), big.NewInt(0)
+	}
+	if stakeValue.Cmp(r.stakeValue) <= 0 {
+		return big.NewInt(0).Set(stakeValue), big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(r.stakeValue), big.NewInt(0).Sub(stakeValue, r.stakeValue)
+}
+
+func blsKeyStatus(registrationData *stakingData) string {
+	if registrationData.Jailed {
+		return blsKeyStatusJailed
+	}
+	if registrationData.Staked && registrationData.UnStakedNonce == 0 {
+		return blsKeyStatusStaked
+	}
+
+	return blsKeyStatusUnStaked
+}
+
+// stakeNodes adds the call value to the lump sum staked by the caller and registers the BLS keys given as arguments.
+// The number of qualified keys is not changed until the next updateQualifiedNodes call, at the epoch boundary
+func (r *stakingSC) stakeNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() == 0 && len(args.Arguments) == 0 {
+		log.Debug("stakeNodes needs either value or BLS keys")
+		return vmcommon.UserError
+	}
+
+	blsKeys := make([][]byte, 0, len(args.Arguments))
+	for _, arg := range args.Arguments {
+		if arg == nil || arg.Sign() == 0 {
+			log.Debug("stakeNodes called with empty BLS key")
+			return vmcommon.UserError
+		}
+
+		blsKey := arg.Bytes()
+		if len(r.eei.GetStorage(blsKeyOwnerKey(blsKey))) > 0 {
+			log.Debug("stakeNodes: the BLS key is already registered")
+			return vmcommon.UserError
+		}
+		for _, addedKey := range blsKeys {
+			if bytes.Equal(addedKey, blsKey) {
+				log.Debug("stakeNodes: duplicated BLS key")
+				return vmcommon.UserError
+			}
+		}
+		blsKeys = append(blsKeys, blsKey)
+	}
+
+	ownerData, err := r.getOwnerStakingData(args.CallerAddr)
+	if err != nil {
+		log.Debug("stakeNodes", "error", err.Error())
+		return vmcommon.UserError
+	}
+	if ownerData == nil {
+		ownerData = &ownerStakingData{
+			TotalStake: big.NewInt(0),
+			BlsKeys:    make([][]byte, 0),
+		}
+		r.addStakingOwner(args.CallerAddr)
+	}
+
+	ownerData.TotalStake.Add(ownerData.TotalStake, args.CallValue)
+	ownerData.BlsKeys = append(ownerData.BlsKeys, blsKeys...)
+
+	err = r.saveOwnerStakingData(args.CallerAddr, ownerData)
+	if err != nil {
+		log.Debug("marshal error in stakeNodes function of staking smart contract", "error", err.Error())
+		return vmcommon.UserError
+	}
+	for _, blsKey := range blsKeys {
+		r.eei.SetStorage(blsKeyOwnerKey(blsKey), args.CallerAddr)
+	}
+
+	err = r.eei.Transfer(args.RecipientAddr, args.CallerAddr, args.CallValue, nil)
+	if err != nil {
+		log.Debug("transfer error on stakeNodes function", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// setNodePrice changes the value which has to be staked for each qualified node. It can be called only by the owner
+// of the staking contract and takes effect at the next updateQualifiedNodes call
+func (r *stakingSC) setNodePrice(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	ownerAddress := r.eei.GetStorage([]byte(ownerKey))
+	if !bytes.Equal(ownerAddress, args.CallerAddr) {
+		log.Debug("setNodePrice function called by not the owners address")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 || args.Arguments[0] == nil || args.Arguments[0].Sign() <= 0 {
+		log.Debug("setNodePrice needs exactly one positive argument")
+		return vmcommon.UserError
+	}
+
+	r.eei.SetStorage([]byte(nodePriceKey), args.Arguments[0].Bytes())
+
+	return vmcommon.Ok
+}
+
+// updateQualifiedNodes recomputes, for each owner, the number of qualified BLS keys as floor(total stake / node price),
+// capped to the number of its registered keys. The keys are qualified in their registration order, so an increased
+// stake or a decreased node price activates the next keys, while the opposite demotes the last ones. It can be called
+// only by the owner of the staking contract, the metachain, at the epoch boundaries. Each call also allows the keys
+// unStaked before it to be unBonded
+// TODO: call updateQualifiedNodes from the metachain at the start of each epoch, once the nodes coordinator computes
+// the eligible lists from the staking data instead of the genesis nodes setup
+func (r *stakingSC) updateQualifiedNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	ownerAddress := r.eei.GetStorage([]byte(ownerKey))
+	if !bytes.Equal(ownerAddress, args.CallerAddr) {
+		log.Debug("updateQualifiedNodes function called by not the owners address")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Sign() != 0 {
+		log.Debug("updateQualifiedNodes does not accept value")
+		return vmcommon.UserError
+	}
+
+	nodePrice := r.nodePrice()
+	numOwners := r.numStakingOwners()
+	for index := uint64(0); index < numOwners; index++ {
+		owner := r.eei.GetStorage(stakingOwnerIndexKey(index))
+		ownerData, err := r.getOwnerStakingData(owner)
+		if err != nil {
+			log.Debug("updateQualifiedNodes", "error", err.Error())
+			return vmcommon.UserError
+		}
+		if ownerData == nil {
+			continue
+		}
+
+		numQualified := computeNumQualifiedNodes(ownerData, nodePrice)
+		if numQualified == ownerData.NumQualified {
+			continue
+		}
+
+		ownerData.NumQualified = numQualified
+		err = r.saveOwnerStakingData(owner, ownerData)
+		if err != nil {
+			log.Debug("marshal error in updateQualifiedNodes function of staking smart contract", "error", err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	numUpdates := big.NewInt(0).SetUint64(r.numQualifiedUpdates() + 1)
+	r.eei.SetStorage([]byte(numQualifiedUpdatesKey), numUpdates.Bytes())
+
+	return vmcommon.Ok
+}
+
+// unStakeNodes removes the BLS keys given as arguments from the qualified nodes selection of the caller. The keys
+// stay registered, and the stake locked by them stays in the contract, until the next updateQualifiedNodes call
+// demotes them. They can be unBonded afterwards
+func (r *stakingSC) unStakeNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("unStakeNodes does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) == 0 {
+		log.Debug("unStakeNodes needs at least one BLS key")
+		return vmcommon.UserError
+	}
+
+	ownerData, err := r.getOwnerStakingData(args.CallerAddr)
+	if err != nil {
+		log.Debug("unStakeNodes", "error", err.Error())
+		return vmcommon.UserError
+	}
+	if ownerData == nil {
+		log.Debug("unStakeNodes: the caller did not stake nodes")
+		return vmcommon.UserError
+	}
+
+	numUpdates := r.numQualifiedUpdates()
+	for _, arg := range args.Arguments {
+		if arg == nil {
+			log.Debug("unStakeNodes called with nil argument")
+			return vmcommon.UserError
+		}
+
+		index := indexOfKey(ownerData.BlsKeys, arg.Bytes())
+		if index < 0 {
+			log.Debug("unStakeNodes: the BLS key is not staked by the caller")
+			return vmcommon.UserError
+		}
+		if indexOfKey(ownerData.JailedKeys, arg.Bytes()) >= 0 {
+			log.Debug("unStakeNodes: the BLS key is jailed")
+			return vmcommon.UserError
+		}
+
+		ownerData.BlsKeys = append(ownerData.BlsKeys[:index], ownerData.BlsKeys[index+1:]...)
+		ownerData.UnStakedKeys = append(ownerData.UnStakedKeys, &unStakedKey{
+			BlsKey:           arg.Bytes(),
+			UnStakedAtUpdate: numUpdates,
+		})
+	}
+
+	err = r.saveOwnerStakingData(args.CallerAddr, ownerData)
+	if err != nil {
+		log.Debug("marshal error in unStakeNodes function of staking smart contract", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// unBondNodes releases the unStaked BLS keys given as arguments, once an updateQualifiedNodes call was made after
+// they were unStaked. A released key can be staked again, by any owner
+func (r *stakingSC) unBondNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("unBondNodes does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) == 0 {
+		log.Debug("unBondNodes needs at least one BLS key")
+		return vmcommon.UserError
+	}
+
+	ownerData, err := r.getOwnerStakingData(args.CallerAddr)
+	if err != nil {
+		log.Debug("unBondNodes", "error", err.Error())
+		return vmcommon.UserError
+	}
+	if ownerData == nil {
+		log.Debug("unBondNodes: the caller did not stake nodes")
+		return vmcommon.UserError
+	}
+
+	numUpdates := r.numQualifiedUpdates()
+	for _, arg := range args.Arguments {
+		if arg == nil {
+			log.Debug("unBondNodes called with nil argument")
+			return vmcommon.UserError
+		}
+
+		index := -1
+		for i, key := range ownerData.UnStakedKeys {
+			if bytes.Equal(key.BlsKey, arg.Bytes()) {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			log.Debug("unBondNodes: the BLS key is not unStaked by the caller")
+			return vmcommon.UserError
+		}
+		if ownerData.UnStakedKeys[index].UnStakedAtUpdate >= numUpdates {
+			log.Debug("unBondNodes: the BLS key can be unBonded after the next qualified nodes update")
+			return vmcommon.UserError
+		}
+
+		ownerData.UnStakedKeys = append(ownerData.UnStakedKeys[:index], ownerData.UnStakedKeys[index+1:]...)
+		r.eei.SetStorage(blsKeyOwnerKey(arg.Bytes()), nil)
+	}
+
+	err = r.saveOwnerStakingData(args.CallerAddr, ownerData)
+	if err != nil {
+		log.Debug("marshal error in unBondNodes function of staking smart contract", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// unBondTokens sends back to the caller the value given as argument, out of the part of its total stake which is not
+// locked by its currently qualified nodes, at the current node price
+func (r *stakingSC) unBondTokens(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("unBondTokens does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 || args.Arguments[0] == nil || args.Arguments[0].Sign() <= 0 {
+		log.Debug("unBondTokens needs exactly one positive argument")
+		return vmcommon.UserError
+	}
+
+	ownerData, err := r.getOwnerStakingData(args.CallerAddr)
+	if err != nil {
+		log.Debug("unBondTokens", "error", err.Error())
+		return vmcommon.UserError
+	}
+	if ownerData == nil {
+		log.Debug("unBondTokens: the caller did not stake nodes")
+		return vmcommon.UserError
+	}
+
+	value := args.Arguments[0]
+	lockedStake := big.NewInt(0).Mul(r.nodePrice(), big.NewInt(0).SetUint64(ownerData.NumQualified))
+	unlockedStake := big.NewInt(0).Sub(ownerData.TotalStake, lockedStake)
+	if value.Cmp(unlockedStake) > 0 {
+		log.Debug("unBondTokens: the value is locked by the qualified nodes",
+			"unlocked stake", unlockedStake.String(),
+		)
+		return vmcommon.UserError
+	}
+
+	ownerData.TotalStake.Sub(ownerData.TotalStake, value)
+	err = r.saveOwnerStakingData(args.CallerAddr, ownerData)
+	if err != nil {
+		log.Debug("marshal error in unBondTokens function of staking smart contract", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	err = r.eei.Transfer(args.CallerAddr, args.RecipientAddr, value, nil)
+	if err != nil {
+		log.Debug("transfer error on unBondTokens function", "error", err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func indexOfKey(keys [][]byte, key []byte) int {
+	for i, k := range keys {
+		if bytes.Equal(k, key) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// getQualifiedNodes returns, for the owner given as argument, the total stake, the node price, the number of
+// registered BLS keys followed by each BLS key and whether it is qualified or not
+func (r *stakingSC) getQualifiedNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("getQualifiedNodes does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 || args.Arguments[0] == nil {
+		log.Debug("getQualifiedNodes needs exactly one argument")
+		return vmcommon.UserError
+	}
+
+	ownerData, err := r.getOwnerStakingData(args.Arguments[0].Bytes())
+	if err != nil {
+		log.Debug("getQualifiedNodes", "error", err.Error())
+		return vmcommon.UserError
+	}
+	if ownerData == nil {
+		ownerData = &ownerStakingData{TotalStake: big.NewInt(0)}
+	}
+
+	r.eei.Finish(ownerData.TotalStake.Bytes())
+	r.eei.Finish(r.nodePrice().Bytes())
+	r.eei.Finish(big.NewInt(int64(len(ownerData.BlsKeys))).Bytes())
+	statuses := computeBlsKeysStatus(ownerData, ownerData.NumQualified)
+	for index, blsKey := range ownerData.BlsKeys {
+		r.eei.Finish(blsKey)
+		r.eei.Finish([]byte(statuses[index]))
+	}
+
+	return vmcommon.Ok
+}
+
+// getAuctionList returns the number of staking v2 owners and the node price, followed by at most the given number of
+// owners, starting with the owner at the given index. Each owner comes with its total stake, its top-up per qualified
+// node, the number of its BLS keys and each BLS key with the status it would get at the next updateQualifiedNodes call
+func (r *stakingSC) getAuctionList(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 {
+		log.Debug("getAuctionList does not accept value")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 || args.Arguments[0] == nil || args.Arguments[1] == nil {
+		log.Debug("getAuctionList needs the start index and the maximum number of owners")
+		return vmcommon.UserError
+	}
+
+	nodePrice := r.nodePrice()
+	numOwners := r.numStakingOwners()
+	startIndex := args.Arguments[0].Uint64()
+	endIndex := startIndex + args.Arguments[1].Uint64()
+	if endIndex > numOwners || endIndex < startIndex {
+		endIndex = numOwners
+	}
+
+	r.eei.Finish(big.NewInt(0).SetUint64(numOwners).Bytes())
+	r.eei.Finish(nodePrice.Bytes())
+	for index := startIndex; index < endIndex; index++ {
+		owner := r.eei.GetStorage(stakingOwnerIndexKey(index))
+		ownerData, err := r.getOwnerStakingData(owner)
+		if err != nil {
+			log.Debug("getAuctionList", "error", err.Error())
+			return vmcommon.UserError
+		}
+		if ownerData == nil {
+			ownerData = &ownerStakingData{TotalStake: big.NewInt(0)}
+		}
+
+		numQualified := computeNumQualifiedNodes(ownerData, nodePrice)
+		r.eei.Finish(owner)
+		r.eei.Finish(ownerData.TotalStake.Bytes())
+		r.eei.Finish(computeTopUpPerNode(ownerData, nodePrice, numQualified).Bytes())
+		r.eei.Finish(big.NewInt(int64(len(ownerData.BlsKeys))).Bytes())
+		statuses := computeBlsKeysStatus(ownerData, numQualified)
+		for keyIndex, blsKey := range ownerData.BlsKeys {
+			r.eei.Finish(blsKey)
+			r.eei.Finish([]byte(statuses[keyIndex]))
+		}
+	}
+
+	return vmcommon.Ok
+}
+
+func computeNumQualifiedNodes(ownerData *ownerStakingData, nodePrice *big.Int) uint64 {
+	if nodePrice.Sign() <= 0 || ownerData.TotalStake == nil {
+		return 0
+	}
+
+	numQualified := big.NewInt(0).Div(ownerData.TotalStake, nodePrice)
+	numKeys := big.NewInt(int64(numNotJailedKeys(ownerData)))
+	if numQualified.Cmp(numKeys) > 0 {
+		return numKeys.Uint64()
+	}
+
+	return numQualified.Uint64()
+}
+
+// computeBlsKeysStatus returns the status of each BLS key of the owner: the jailed keys are left out of the selection,
+// the first numQualified remaining keys are qualified and the others are not
+func computeBlsKeysStatus(ownerData *ownerStakingData, numQualified uint64) []string {
+	statuses := make([]string, 0, len(ownerData.BlsKeys))
+	numSelected := uint64(0)
+	for _, blsKey := range ownerData.BlsKeys {
+		switch {
+		case indexOfKey(ownerData.JailedKeys, blsKey) >= 0:
+			statuses = append(statuses, blsKeyStatusJailed)
+		case numSelected < numQualified:
+			statuses = append(statuses, blsKeyStatusQualified)
+			numSelected++
+		default:
+			statuses = append(statuses, blsKeyStatusNotQualified)
+		}
+	}
+
+	return statuses
+}
+
+func numNotJailedKeys(ownerData *ownerStakingData) int {
+	numKeys := 0
+	for _, blsKey := range ownerData.BlsKeys {
+		if indexOfKey(ownerData.JailedKeys, blsKey) < 0 {
+			numKeys++
+		}
+	}
+
+	return numKeys
+}
+
+// computeTopUpPerNode returns the part of the owner's total stake exceeding the node price of its qualified nodes,
+// divided between them. An owner without qualified nodes has no top-up
+func computeTopUpPerNode(ownerData *ownerStakingData, nodePrice *big.Int, numQualified uint64) *big.Int {
+	if numQualified == 0 || ownerData.TotalStake == nil {
+		return big.NewInt(0)
+	}
+
+	numQualifiedValue := big.NewInt(0).SetUint64(numQualified)
+	topUp := big.NewInt(0).Mul(nodePrice, numQualifiedValue)
+	topUp.Sub(ownerData.TotalStake, topUp)
+
+	return topUp.Div(topUp, numQualifiedValue)
+}
+
+// nodePrice returns the value staked for each qualified node, which defaults to the configured stake value
+func (r *stakingSC) nodePrice() *big.Int {
+	data := r.eei.GetStorage([]byte(nodePriceKey))
+	if len(data) == 0 {
+		return big.NewInt(0).Set(r.stakeValue)
+	}
+
+	return big.NewInt(0).SetBytes(data)
+}
+
+// getOwnerStakingData returns the staking v2 registration of the given owner or nil if it did not stake
+func (r *stakingSC) getOwnerStakingData(owner []byte) (*ownerStakingData, error) {
+	data := r.eei.GetStorage(stakingOwnerKey(owner))
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	ownerData := &ownerStakingData{}
+	err := json.Unmarshal(data, ownerData)
+	if err != nil {
+		return nil, err
+	}
+	if ownerData.TotalStake == nil {
+		ownerData.TotalStake = big.NewInt(0)
+	}
+
+	return ownerData, nil
+}
+
+func (r *stakingSC) saveOwnerStakingData(owner []byte, ownerData *ownerStakingData) error {
+	data, err := json.Marshal(ownerData)
+	if err != nil {
+		return err
+	}
+
+	r.eei.SetStorage(stakingOwnerKey(owner), data)
+
+	return nil
+}
+
+// addStakingOwner appends the owner to the indexed list of staking v2 owners, as the system VM can not iterate the
+// contract storage
+func (r *stakingSC) addStakingOwner(owner []byte) {
+	numOwners := r.numStakingOwners()
+	r.eei.SetStorage(stakingOwnerIndexKey(numOwners), owner)
+	r.eei.SetStorage([]byte(numStakingOwnersKey), big.NewInt(0).SetUint64(numOwners+1).Bytes())
+}
+
+func (r *stakingSC) numQualifiedUpdates() uint64 {
+	return big.NewInt(0).SetBytes(r.eei.GetStorage([]byte(numQualifiedUpdatesKey))).Uint64()
+}
+
+func (r *stakingSC) numStakingOwners() uint64 {
+	return big.NewInt(0).SetBytes(r.eei.GetStorage([]byte(numStakingOwnersKey))).Uint64()
+}
+
+func stakingOwnerKey(owner []byte) []byte {
+	return append([]byte(stakingOwnerKeyPrefix), owner...)
+}
+
+func stakingOwnerIndexKey(index uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", stakingOwnerIndexKeyPrefix, index))
+}
+
 // ValueOf returns the value of a selected key
 func (r *stakingSC) ValueOf(key interface{}) interface{} {
 	return nil
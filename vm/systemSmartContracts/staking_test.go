@@ -1 +1,743 @@
 package systemSmartContracts
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createStakingCallInput(function string, caller []byte, callValue *big.Int, args ...*big.Int) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			Arguments:   args,
+			CallValue:   callValue,
+			GasPrice:    big.NewInt(0),
+			GasProvided: big.NewInt(0),
+			Header:      &vmcommon.SCCallHeader{Number: big.NewInt(10)},
+		},
+		RecipientAddr: []byte("staking"),
+		Function:      function,
+	}
+}
+
+func createFinishRecordingEI(returnData *[][]byte) *mock.SystemEIStub {
+	eei := createStorageBackedEI()
+	eei.FinishCalled = func(value []byte) {
+		*returnData = append(*returnData, value)
+	}
+
+	return eei
+}
+
+func TestNewStakingSmartContract_NilStakeValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, err := NewStakingSmartContract(nil, createStorageBackedEI(), &mock.MessageSignVerifierStub{})
+
+	assert.Nil(t, stakingSc)
+	assert.Equal(t, vm.ErrNilInitialStakeValue, err)
+}
+
+func TestNewStakingSmartContract_NilEEIShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, err := NewStakingSmartContract(big.NewInt(100), nil, &mock.MessageSignVerifierStub{})
+
+	assert.Nil(t, stakingSc)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestNewStakingSmartContract_NilSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, err := NewStakingSmartContract(big.NewInt(100), createStorageBackedEI(), nil)
+
+	assert.Nil(t, stakingSc)
+	assert.Equal(t, vm.ErrNilMessageSignVerifier, err)
+}
+
+//------- getTotalStakedTopUpStakedBlsKeys
+
+func TestStakingSC_GetTotalStakedTopUpStakedBlsKeysWrongArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, _ := NewStakingSmartContract(big.NewInt(100), createStorageBackedEI(), &mock.MessageSignVerifierStub{})
+
+	input := createStakingCallInput("getTotalStakedTopUpStakedBlsKeys", []byte("caller"), big.NewInt(0))
+	assert.Equal(t, vmcommon.UserError, stakingSc.Execute(input))
+
+	input = createStakingCallInput("getTotalStakedTopUpStakedBlsKeys", []byte("caller"), big.NewInt(1),
+		big.NewInt(0).SetBytes([]byte("owner")))
+	assert.Equal(t, vmcommon.UserError, stakingSc.Execute(input))
+}
+
+func TestStakingSC_GetTotalStakedTopUpStakedBlsKeysNotRegisteredOwnerShouldReturnZeros(t *testing.T) {
+	t.Parallel()
+
+	returnData := make([][]byte, 0)
+	stakingSc, _ := NewStakingSmartContract(big.NewInt(100), createFinishRecordingEI(&returnData), &mock.MessageSignVerifierStub{})
+
+	input := createStakingCallInput("getTotalStakedTopUpStakedBlsKeys", []byte("caller"), big.NewInt(0),
+		big.NewInt(0).SetBytes([]byte("owner")))
+	retCode := stakingSc.Execute(input)
+
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, 3, len(returnData))
+	for _, value := range returnData {
+		assert.Equal(t, int64(0), big.NewInt(0).SetBytes(value).Int64())
+	}
+}
+
+func TestStakingSC_GetTotalStakedTopUpStakedBlsKeysShouldReturnTheAggregatedValues(t *testing.T) {
+	t.Parallel()
+
+	returnData := make([][]byte, 0)
+	eei := createFinishRecordingEI(&returnData)
+	stakingSc, _ := NewStakingSmartContract(big.NewInt(100), eei, &mock.MessageSignVerifierStub{})
+
+	owner := []byte("owner")
+	blsKey := []byte("bls key")
+	retCode := stakingSc.Execute(createStakingCallInput("stake", owner, big.NewInt(100),
+		big.NewInt(0).SetBytes(blsKey)))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = stakingSc.Execute(createStakingCallInput("getTotalStakedTopUpStakedBlsKeys", []byte("caller"),
+		big.NewInt(0), big.NewInt(0).SetBytes(owner)))
+
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, 5, len(returnData))
+	assert.Equal(t, int64(100), big.NewInt(0).SetBytes(returnData[0]).Int64())
+	assert.Equal(t, int64(0), big.NewInt(0).SetBytes(returnData[1]).Int64())
+	assert.Equal(t, int64(1), big.NewInt(0).SetBytes(returnData[2]).Int64())
+	assert.Equal(t, blsKey, returnData[3])
+	assert.Equal(t, []byte(blsKeyStatusStaked), returnData[4])
+}
+
+func TestStakingSC_SplitStakeValue(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, _ := NewStakingSmartContract(big.NewInt(100), createStorageBackedEI(), &mock.MessageSignVerifierStub{})
+
+	baseStake, topUp := stakingSc.splitStakeValue(big.NewInt(150))
+	assert.Equal(t, big.NewInt(100), baseStake)
+	assert.Equal(t, big.NewInt(50), topUp)
+
+	baseStake, topUp = stakingSc.splitStakeValue(big.NewInt(80))
+	assert.Equal(t, big.NewInt(80), baseStake)
+	assert.Equal(t, big.NewInt(0), topUp)
+
+	baseStake, topUp = stakingSc.splitStakeValue(nil)
+	assert.Equal(t, big.NewInt(0), baseStake)
+	assert.Equal(t, big.NewInt(0), topUp)
+}
+
+func TestBlsKeyStatus(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, blsKeyStatusStaked, blsKeyStatus(&stakingData{Staked: true}))
+	assert.Equal(t, blsKeyStatusUnStaked, blsKeyStatus(&stakingData{Staked: false, UnStakedNonce: 5}))
+	assert.Equal(t, blsKeyStatusUnStaked, blsKeyStatus(&stakingData{Staked: true, UnStakedNonce: 5}))
+	assert.Equal(t, blsKeyStatusJailed, blsKeyStatus(&stakingData{Staked: true, Jailed: true}))
+}
+
+//------- reStakeWithNewKey
+
+func createReStakeWithNewKeyCallInput(owner []byte, oldKey []byte, newKey []byte) *vmcommon.ContractCallInput {
+	return createStakingCallInput("reStakeWithNewKey", owner, big.NewInt(0),
+		big.NewInt(0).SetBytes(oldKey),
+		big.NewInt(0).SetBytes(newKey),
+		big.NewInt(0).SetBytes([]byte("old key signature")),
+		big.NewInt(0).SetBytes([]byte("new key proof")),
+	)
+}
+
+func createStakedStakingSC(owner []byte, blsKey []byte, sigVerifier vm.MessageSignVerifier) (*stakingSC, *mock.SystemEIStub) {
+	eei := createStorageBackedEI()
+	stakingSc, _ := NewStakingSmartContract(big.NewInt(100), eei, sigVerifier)
+	_ = stakingSc.Execute(createStakingCallInput("stake", owner, big.NewInt(100), big.NewInt(0).SetBytes(blsKey)))
+
+	return stakingSc, eei
+}
+
+func TestStakingSC_ReStakeWithNewKeyShouldReplaceTheKey(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	oldKey := []byte("old bls key")
+	newKey := []byte("new bls key")
+	verifiedKeys := make([][]byte, 0)
+	sigVerifier := &mock.MessageSignVerifierStub{
+		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+			assert.Equal(t, newKey, message)
+			verifiedKeys = append(verifiedKeys, pubKey)
+			return nil
+		},
+	}
+	stakingSc, eei := createStakedStakingSC(owner, oldKey, sigVerifier)
+
+	retCode := stakingSc.Execute(createReStakeWithNewKeyCallInput(owner, oldKey, newKey))
+
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{oldKey, newKey}, verifiedKeys)
+	registrations, _ := stakingSc.getOwnerRegistrations(owner)
+	assert.Equal(t, newKey, registrations[0].BlsPubKey)
+	assert.Equal(t, big.NewInt(100), registrations[0].StakeValue)
+	assert.Equal(t, uint64(10), registrations[0].KeyRotationNonce)
+	assert.Equal(t, blsKeyStatusStaked, blsKeyStatus(registrations[0]))
+	assert.Equal(t, owner, eei.GetStorage(blsKeyOwnerKey(newKey)))
+	assert.Equal(t, 0, len(eei.GetStorage(blsKeyOwnerKey(oldKey))))
+}
+
+func TestStakingSC_ReStakeWithNewKeyNotOwnedOldKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, _ := createStakedStakingSC([]byte("owner"), []byte("old bls key"), &mock.MessageSignVerifierStub{})
+
+	retCode := stakingSc.Execute(createReStakeWithNewKeyCallInput([]byte("owner"), []byte("other key"), []byte("new bls key")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSc.Execute(createReStakeWithNewKeyCallInput([]byte("other owner"), []byte("old bls key"), []byte("new bls key")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_ReStakeWithNewKeyAlreadyRegisteredNewKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, _ := createStakedStakingSC([]byte("owner"), []byte("old bls key"), &mock.MessageSignVerifierStub{})
+	_ = stakingSc.Execute(createStakingCallInput("stake", []byte("owner2"), big.NewInt(100),
+		big.NewInt(0).SetBytes([]byte("bls key 2"))))
+
+	retCode := stakingSc.Execute(createReStakeWithNewKeyCallInput([]byte("owner"), []byte("old bls key"), []byte("bls key 2")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSc.Execute(createReStakeWithNewKeyCallInput([]byte("owner"), []byte("old bls key"), []byte("old bls key")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_ReStakeWithNewKeyInvalidProofsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	for _, invalidKey := range []string{"old bls key", "new bls key"} {
+		invalidKey := invalidKey
+		sigVerifier := &mock.MessageSignVerifierStub{
+			VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+				if bytes.Equal(pubKey, []byte(invalidKey)) {
+					return errors.New("invalid signature")
+				}
+				return nil
+			},
+		}
+		stakingSc, _ := createStakedStakingSC([]byte("owner"), []byte("old bls key"), sigVerifier)
+
+		retCode := stakingSc.Execute(createReStakeWithNewKeyCallInput([]byte("owner"), []byte("old bls key"), []byte("new bls key")))
+
+		assert.Equal(t, vmcommon.UserError, retCode)
+		registrations, _ := stakingSc.getOwnerRegistrations([]byte("owner"))
+		assert.Equal(t, []byte("old bls key"), registrations[0].BlsPubKey)
+	}
+}
+
+func TestStakingSC_ReStakeWithNewKeyWrongArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, _ := createStakedStakingSC([]byte("owner"), []byte("old bls key"), &mock.MessageSignVerifierStub{})
+
+	input := createReStakeWithNewKeyCallInput([]byte("owner"), []byte("old bls key"), []byte("new bls key"))
+	input.CallValue = big.NewInt(1)
+	assert.Equal(t, vmcommon.UserError, stakingSc.Execute(input))
+
+	input = createReStakeWithNewKeyCallInput([]byte("owner"), []byte("old bls key"), []byte("new bls key"))
+	input.Arguments = input.Arguments[:3]
+	assert.Equal(t, vmcommon.UserError, stakingSc.Execute(input))
+}
+
+//------- jail
+
+func createJailedStakingSC(owner []byte, blsKey []byte) *stakingSC {
+	stakingSc, _ := createStakedStakingSC(owner, blsKey, &mock.MessageSignVerifierStub{})
+	_ = stakingSc.Execute(createStakingCallInput("_init", []byte("metachain"), big.NewInt(0)))
+	_ = stakingSc.Execute(createStakingCallInput("jail", []byte("metachain"), big.NewInt(0), big.NewInt(0).SetBytes(blsKey)))
+
+	return stakingSc
+}
+
+func TestStakingSC_JailShouldMarkTheKeyAsJailed(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createJailedStakingSC([]byte("node owner"), []byte("bls key"))
+
+	registrations, _ := stakingSc.getOwnerRegistrations([]byte("node owner"))
+	assert.True(t, registrations[0].Jailed)
+	assert.Equal(t, uint64(10), registrations[0].JailedNonce)
+	assert.Equal(t, blsKeyStatusJailed, blsKeyStatus(registrations[0]))
+}
+
+func TestStakingSC_JailNotCalledByTheOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, _ := createStakedStakingSC([]byte("node owner"), []byte("bls key"), &mock.MessageSignVerifierStub{})
+	_ = stakingSc.Execute(createStakingCallInput("_init", []byte("metachain"), big.NewInt(0)))
+
+	retCode := stakingSc.Execute(createStakingCallInput("jail", []byte("node owner"), big.NewInt(0),
+		big.NewInt(0).SetBytes([]byte("bls key"))))
+
+	assert.Equal(t, vmcommon.UserError, retCode)
+	registrations, _ := stakingSc.getOwnerRegistrations([]byte("node owner"))
+	assert.False(t, registrations[0].Jailed)
+}
+
+func TestStakingSC_JailNotRegisteredKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, _ := createStakedStakingSC([]byte("node owner"), []byte("bls key"), &mock.MessageSignVerifierStub{})
+	_ = stakingSc.Execute(createStakingCallInput("_init", []byte("metachain"), big.NewInt(0)))
+
+	retCode := stakingSc.Execute(createStakingCallInput("jail", []byte("metachain"), big.NewInt(0),
+		big.NewInt(0).SetBytes([]byte("other bls key"))))
+
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+//------- unJail
+
+func TestStakingSC_UnJailShouldClearTheJailedFlag(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createJailedStakingSC([]byte("node owner"), []byte("bls key"))
+
+	retCode := stakingSc.Execute(createStakingCallInput("unJail", []byte("node owner"), big.NewInt(1),
+		big.NewInt(0).SetBytes([]byte("bls key"))))
+
+	assert.Equal(t, vmcommon.Ok, retCode)
+	registrations, _ := stakingSc.getOwnerRegistrations([]byte("node owner"))
+	assert.False(t, registrations[0].Jailed)
+	assert.Equal(t, blsKeyStatusStaked, blsKeyStatus(registrations[0]))
+}
+
+func TestStakingSC_UnJailWithoutTheFineShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createJailedStakingSC([]byte("node owner"), []byte("bls key"))
+
+	retCode := stakingSc.Execute(createStakingCallInput("unJail", []byte("node owner"), big.NewInt(0),
+		big.NewInt(0).SetBytes([]byte("bls key"))))
+
+	assert.Equal(t, vmcommon.UserError, retCode)
+	registrations, _ := stakingSc.getOwnerRegistrations([]byte("node owner"))
+	assert.True(t, registrations[0].Jailed)
+}
+
+func TestStakingSC_UnJailNotJailedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc, _ := createStakedStakingSC([]byte("node owner"), []byte("bls key"), &mock.MessageSignVerifierStub{})
+
+	retCode := stakingSc.Execute(createStakingCallInput("unJail", []byte("node owner"), big.NewInt(1),
+		big.NewInt(0).SetBytes([]byte("bls key"))))
+
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_UnJailKeyOfAnotherOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createJailedStakingSC([]byte("node owner"), []byte("bls key"))
+
+	retCode := stakingSc.Execute(createStakingCallInput("unJail", []byte("other node owner"), big.NewInt(1),
+		big.NewInt(0).SetBytes([]byte("bls key"))))
+
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+//------- staking v2
+
+func createStakeNodesCallInput(owner []byte, callValue int64, blsKeys ...string) *vmcommon.ContractCallInput {
+	args := make([]*big.Int, 0, len(blsKeys))
+	for _, blsKey := range blsKeys {
+		args = append(args, big.NewInt(0).SetBytes([]byte(blsKey)))
+	}
+
+	return createStakingCallInput("stakeNodes", owner, big.NewInt(callValue), args...)
+}
+
+func createInitializedStakingSC() *stakingSC {
+	stakingSc, _ := NewStakingSmartContract(big.NewInt(100), createStorageBackedEI(), &mock.MessageSignVerifierStub{})
+	_ = stakingSc.Execute(createStakingCallInput("_init", []byte("metachain"), big.NewInt(0)))
+
+	return stakingSc
+}
+
+func updateQualifiedNodes(stakingSc *stakingSC) vmcommon.ReturnCode {
+	return stakingSc.Execute(createStakingCallInput("updateQualifiedNodes", []byte("metachain"), big.NewInt(0)))
+}
+
+func TestStakingSC_StakeNodesShouldRegisterTheKeysAndTheStake(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+	owner := []byte("node owner")
+
+	retCode := stakingSc.Execute(createStakeNodesCallInput(owner, 150, "bls key 1", "bls key 2"))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = stakingSc.Execute(createStakeNodesCallInput(owner, 70, "bls key 3"))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	ownerData, _ := stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, big.NewInt(220), ownerData.TotalStake)
+	assert.Equal(t, [][]byte{[]byte("bls key 1"), []byte("bls key 2"), []byte("bls key 3")}, ownerData.BlsKeys)
+	assert.Equal(t, uint64(0), ownerData.NumQualified)
+	assert.Equal(t, uint64(1), stakingSc.numStakingOwners())
+	assert.Equal(t, owner, stakingSc.eei.GetStorage(blsKeyOwnerKey([]byte("bls key 3"))))
+}
+
+func TestStakingSC_StakeNodesAlreadyRegisteredKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+	_ = stakingSc.Execute(createStakeNodesCallInput([]byte("node owner"), 100, "bls key 1"))
+
+	retCode := stakingSc.Execute(createStakeNodesCallInput([]byte("other owner"), 100, "bls key 1"))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSc.Execute(createStakeNodesCallInput([]byte("other owner"), 100, "bls key 2", "bls key 2"))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSc.Execute(createStakeNodesCallInput([]byte("other owner"), 0))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	ownerData, _ := stakingSc.getOwnerStakingData([]byte("other owner"))
+	assert.Nil(t, ownerData)
+}
+
+func TestStakingSC_UpdateQualifiedNodesShouldFollowTheStakeAndTheNodePrice(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+	owner := []byte("node owner")
+	_ = stakingSc.Execute(createStakeNodesCallInput(owner, 250, "bls key 1", "bls key 2", "bls key 3"))
+
+	assert.Equal(t, vmcommon.Ok, updateQualifiedNodes(stakingSc))
+	ownerData, _ := stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, uint64(2), ownerData.NumQualified)
+
+	_ = stakingSc.Execute(createStakeNodesCallInput(owner, 100, "bls key 4"))
+	_ = updateQualifiedNodes(stakingSc)
+	ownerData, _ = stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, uint64(3), ownerData.NumQualified)
+
+	retCode := stakingSc.Execute(createStakingCallInput("setNodePrice", []byte("metachain"), big.NewInt(0), big.NewInt(150)))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	ownerData, _ = stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, uint64(3), ownerData.NumQualified)
+
+	_ = updateQualifiedNodes(stakingSc)
+	ownerData, _ = stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, uint64(2), ownerData.NumQualified)
+
+	_ = stakingSc.Execute(createStakingCallInput("setNodePrice", []byte("metachain"), big.NewInt(0), big.NewInt(10)))
+	_ = updateQualifiedNodes(stakingSc)
+	ownerData, _ = stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, uint64(4), ownerData.NumQualified)
+}
+
+func TestStakingSC_UpdateQualifiedNodesAndSetNodePriceNotCalledByTheOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+	owner := []byte("node owner")
+	_ = stakingSc.Execute(createStakeNodesCallInput(owner, 100, "bls key 1"))
+
+	retCode := stakingSc.Execute(createStakingCallInput("updateQualifiedNodes", owner, big.NewInt(0)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	ownerData, _ := stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, uint64(0), ownerData.NumQualified)
+
+	retCode = stakingSc.Execute(createStakingCallInput("setNodePrice", owner, big.NewInt(0), big.NewInt(1)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, big.NewInt(100), stakingSc.nodePrice())
+
+	retCode = stakingSc.Execute(createStakingCallInput("setNodePrice", []byte("metachain"), big.NewInt(0), big.NewInt(0)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, big.NewInt(100), stakingSc.nodePrice())
+}
+
+func TestStakingSC_GetQualifiedNodesShouldReturnTheKeysStatus(t *testing.T) {
+	t.Parallel()
+
+	returnData := make([][]byte, 0)
+	stakingSc, _ := NewStakingSmartContract(big.NewInt(100), createFinishRecordingEI(&returnData), &mock.MessageSignVerifierStub{})
+	_ = stakingSc.Execute(createStakingCallInput("_init", []byte("metachain"), big.NewInt(0)))
+	owner := []byte("node owner")
+	_ = stakingSc.Execute(createStakeNodesCallInput(owner, 120, "bls key 1", "bls key 2"))
+	_ = updateQualifiedNodes(stakingSc)
+
+	retCode := stakingSc.Execute(createStakingCallInput("getQualifiedNodes", []byte("caller"), big.NewInt(0),
+		big.NewInt(0).SetBytes(owner)))
+
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{
+		big.NewInt(120).Bytes(),
+		big.NewInt(100).Bytes(),
+		big.NewInt(2).Bytes(),
+		[]byte("bls key 1"),
+		[]byte(blsKeyStatusQualified),
+		[]byte("bls key 2"),
+		[]byte(blsKeyStatusNotQualified),
+	}, returnData)
+}
+
+func TestStakingSC_GetAuctionListShouldReturnTheProjectedSelection(t *testing.T) {
+	t.Parallel()
+
+	returnData := make([][]byte, 0)
+	stakingSc, _ := NewStakingSmartContract(big.NewInt(100), createFinishRecordingEI(&returnData), &mock.MessageSignVerifierStub{})
+	_ = stakingSc.Execute(createStakingCallInput("_init", []byte("metachain"), big.NewInt(0)))
+	_ = stakingSc.Execute(createStakeNodesCallInput([]byte("owner 1"), 250, "bls key 1", "bls key 2", "bls key 3"))
+	_ = stakingSc.Execute(createStakeNodesCallInput([]byte("owner 2"), 50, "bls key 4"))
+	_ = stakingSc.Execute(createStakeNodesCallInput([]byte("owner 3"), 100, "bls key 5"))
+
+	retCode := stakingSc.Execute(createStakingCallInput("getAuctionList", []byte("caller"), big.NewInt(0),
+		big.NewInt(0), big.NewInt(2)))
+
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{
+		big.NewInt(3).Bytes(),
+		big.NewInt(100).Bytes(),
+		[]byte("owner 1"),
+		big.NewInt(250).Bytes(),
+		big.NewInt(25).Bytes(),
+		big.NewInt(3).Bytes(),
+		[]byte("bls key 1"),
+		[]byte(blsKeyStatusQualified),
+		[]byte("bls key 2"),
+		[]byte(blsKeyStatusQualified),
+		[]byte("bls key 3"),
+		[]byte(blsKeyStatusNotQualified),
+		[]byte("owner 2"),
+		big.NewInt(50).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(1).Bytes(),
+		[]byte("bls key 4"),
+		[]byte(blsKeyStatusNotQualified),
+	}, returnData)
+}
+
+func TestStakingSC_GetAuctionListWrongArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+
+	retCode := stakingSc.Execute(createStakingCallInput("getAuctionList", []byte("caller"), big.NewInt(0), big.NewInt(0)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSc.Execute(createStakingCallInput("getAuctionList", []byte("caller"), big.NewInt(1),
+		big.NewInt(0), big.NewInt(2)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func createBlsKeysCallInput(function string, owner []byte, blsKeys ...string) *vmcommon.ContractCallInput {
+	args := make([]*big.Int, 0, len(blsKeys))
+	for _, blsKey := range blsKeys {
+		args = append(args, big.NewInt(0).SetBytes([]byte(blsKey)))
+	}
+
+	return createStakingCallInput(function, owner, big.NewInt(0), args...)
+}
+
+func TestStakingSC_UnStakeNodesShouldRemoveTheKeysAtTheNextUpdate(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+	owner := []byte("node owner")
+	_ = stakingSc.Execute(createStakeNodesCallInput(owner, 200, "bls key 1", "bls key 2", "bls key 3"))
+	_ = updateQualifiedNodes(stakingSc)
+
+	retCode := stakingSc.Execute(createBlsKeysCallInput("unStakeNodes", owner, "bls key 1"))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	ownerData, _ := stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, [][]byte{[]byte("bls key 2"), []byte("bls key 3")}, ownerData.BlsKeys)
+	assert.Equal(t, uint64(2), ownerData.NumQualified)
+	assert.Equal(t, 1, len(ownerData.UnStakedKeys))
+
+	retCode = stakingSc.Execute(createBlsKeysCallInput("unBondNodes", owner, "bls key 1"))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	_ = updateQualifiedNodes(stakingSc)
+	retCode = stakingSc.Execute(createBlsKeysCallInput("unBondNodes", owner, "bls key 1"))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	ownerData, _ = stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, 0, len(ownerData.UnStakedKeys))
+	assert.Equal(t, 0, len(stakingSc.eei.GetStorage(blsKeyOwnerKey([]byte("bls key 1")))))
+
+	retCode = stakingSc.Execute(createStakeNodesCallInput([]byte("other owner"), 100, "bls key 1"))
+	assert.Equal(t, vmcommon.Ok, retCode)
+}
+
+func TestStakingSC_UnStakeNodesWrongKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+	owner := []byte("node owner")
+	_ = stakingSc.Execute(createStakeNodesCallInput(owner, 100, "bls key 1"))
+	_ = stakingSc.Execute(createStakeNodesCallInput([]byte("other owner"), 100, "bls key 2"))
+
+	retCode := stakingSc.Execute(createBlsKeysCallInput("unStakeNodes", owner, "bls key 2"))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSc.Execute(createBlsKeysCallInput("unStakeNodes", owner))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSc.Execute(createBlsKeysCallInput("unStakeNodes", []byte("not an owner"), "bls key 1"))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSc.Execute(createBlsKeysCallInput("unBondNodes", owner, "bls key 1"))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	ownerData, _ := stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, [][]byte{[]byte("bls key 1")}, ownerData.BlsKeys)
+}
+
+func TestStakingSC_UnBondTokensShouldReturnOnlyTheUnlockedStake(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+	owner := []byte("node owner")
+	_ = stakingSc.Execute(createStakeNodesCallInput(owner, 250, "bls key 1", "bls key 2"))
+	_ = updateQualifiedNodes(stakingSc)
+
+	transferred := big.NewInt(0)
+	stakingSc.eei.(*mock.SystemEIStub).TransferCalled = func(destination []byte, sender []byte, value *big.Int, input []byte) error {
+		assert.Equal(t, owner, destination)
+		transferred.Add(transferred, value)
+		return nil
+	}
+
+	retCode := stakingSc.Execute(createStakingCallInput("unBondTokens", owner, big.NewInt(0), big.NewInt(51)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSc.Execute(createStakingCallInput("unBondTokens", owner, big.NewInt(0), big.NewInt(50)))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(50), transferred)
+
+	_ = stakingSc.Execute(createBlsKeysCallInput("unStakeNodes", owner, "bls key 2"))
+	retCode = stakingSc.Execute(createStakingCallInput("unBondTokens", owner, big.NewInt(0), big.NewInt(100)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	_ = updateQualifiedNodes(stakingSc)
+	retCode = stakingSc.Execute(createStakingCallInput("unBondTokens", owner, big.NewInt(0), big.NewInt(100)))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(150), transferred)
+
+	ownerData, _ := stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, big.NewInt(100), ownerData.TotalStake)
+	assert.Equal(t, uint64(1), ownerData.NumQualified)
+}
+
+func TestStakingSC_JailStakingV2KeyShouldLeaveItOutOfTheSelection(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+	owner := []byte("node owner")
+	_ = stakingSc.Execute(createStakeNodesCallInput(owner, 200, "bls key 1", "bls key 2", "bls key 3"))
+	_ = updateQualifiedNodes(stakingSc)
+
+	retCode := stakingSc.Execute(createBlsKeysCallInput("jail", []byte("metachain"), "bls key 1"))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	_ = updateQualifiedNodes(stakingSc)
+
+	ownerData, _ := stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, uint64(2), ownerData.NumQualified)
+	assert.Equal(t,
+		[]string{blsKeyStatusJailed, blsKeyStatusQualified, blsKeyStatusQualified},
+		computeBlsKeysStatus(ownerData, ownerData.NumQualified),
+	)
+
+	retCode = stakingSc.Execute(createBlsKeysCallInput("unStakeNodes", owner, "bls key 1"))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_UnJailStakingV2KeyShouldMakeItEligibleAgain(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+	owner := []byte("node owner")
+	_ = stakingSc.Execute(createStakeNodesCallInput(owner, 100, "bls key 1"))
+	_ = stakingSc.Execute(createBlsKeysCallInput("jail", []byte("metachain"), "bls key 1"))
+	_ = updateQualifiedNodes(stakingSc)
+
+	ownerData, _ := stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, uint64(0), ownerData.NumQualified)
+
+	unJailInput := createBlsKeysCallInput("unJail", owner, "bls key 1")
+	retCode := stakingSc.Execute(unJailInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	unJailInput.CallValue = stakingSc.unJailFine()
+	retCode = stakingSc.Execute(unJailInput)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = stakingSc.Execute(unJailInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	_ = updateQualifiedNodes(stakingSc)
+	ownerData, _ = stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, 0, len(ownerData.JailedKeys))
+	assert.Equal(t, uint64(1), ownerData.NumQualified)
+}
+
+func TestStakingSC_ReStakeWithNewKeyStakingV2KeyShouldKeepItsPosition(t *testing.T) {
+	t.Parallel()
+
+	stakingSc := createInitializedStakingSC()
+	owner := []byte("node owner")
+	_ = stakingSc.Execute(createStakeNodesCallInput(owner, 150, "bls key 1", "bls key 2"))
+	_ = updateQualifiedNodes(stakingSc)
+
+	retCode := stakingSc.Execute(createReStakeWithNewKeyCallInput(owner, []byte("bls key 1"), []byte("bls key 3")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	ownerData, _ := stakingSc.getOwnerStakingData(owner)
+	assert.Equal(t, [][]byte{[]byte("bls key 3"), []byte("bls key 2")}, ownerData.BlsKeys)
+	assert.Equal(t, uint64(1), ownerData.NumQualified)
+	assert.Equal(t, owner, stakingSc.eei.GetStorage(blsKeyOwnerKey([]byte("bls key 3"))))
+	assert.Equal(t, 0, len(stakingSc.eei.GetStorage(blsKeyOwnerKey([]byte("bls key 1")))))
+
+	retCode = stakingSc.Execute(createReStakeWithNewKeyCallInput(owner, []byte("bls key 2"), []byte("bls key 3")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	_ = stakingSc.Execute(createBlsKeysCallInput("jail", []byte("metachain"), "bls key 2"))
+	retCode = stakingSc.Execute(createReStakeWithNewKeyCallInput(owner, []byte("bls key 2"), []byte("bls key 4")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestComputeNumQualifiedNodes(t *testing.T) {
+	t.Parallel()
+
+	ownerData := &ownerStakingData{
+		TotalStake: big.NewInt(299),
+		BlsKeys:    [][]byte{[]byte("key1"), []byte("key2"), []byte("key3")},
+	}
+	assert.Equal(t, uint64(2), computeNumQualifiedNodes(ownerData, big.NewInt(100)))
+	assert.Equal(t, uint64(3), computeNumQualifiedNodes(ownerData, big.NewInt(1)))
+	assert.Equal(t, uint64(0), computeNumQualifiedNodes(ownerData, big.NewInt(300)))
+	assert.Equal(t, uint64(0), computeNumQualifiedNodes(ownerData, big.NewInt(0)))
+
+	ownerData.JailedKeys = [][]byte{[]byte("key2")}
+	assert.Equal(t, uint64(2), computeNumQualifiedNodes(ownerData, big.NewInt(1)))
+}
+
+func TestComputeTopUpPerNode(t *testing.T) {
+	t.Parallel()
+
+	ownerData := &ownerStakingData{TotalStake: big.NewInt(299)}
+	assert.Equal(t, big.NewInt(49), computeTopUpPerNode(ownerData, big.NewInt(100), 2))
+	assert.Equal(t, big.NewInt(0), computeTopUpPerNode(ownerData, big.NewInt(300), 0))
+}